@@ -2,6 +2,7 @@ package main
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"io"
 	"math/big"
@@ -21,6 +22,7 @@ import (
 	"golang.org/x/crypto/ssh/terminal"
 
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum/keystore"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/remotesigner"
 )
 
 var emptyEthAddress = ethcmn.Address{}
@@ -32,6 +34,7 @@ func initEthereumAccountsManager(
 	ethPassphrase *string,
 	ethPrivKey *string,
 	ethUseLedger *bool,
+	ethRemoteSigner *remotesigner.Client,
 ) (
 	ethKeyFromAddress ethcmn.Address,
 	signerFn bind.SignerFn,
@@ -39,6 +42,21 @@ func initEthereumAccountsManager(
 	err error,
 ) {
 	switch {
+	case ethRemoteSigner != nil:
+		ethKeyFromAddress = ethcmn.HexToAddress(*ethKeyFrom)
+		if ethKeyFromAddress == (ethcmn.Address{}) {
+			err = errors.New("cannot use remote signer without from address specified")
+			return emptyEthAddress, nil, nil, err
+		}
+
+		signerFn, personalSignFn, err = remotesigner.EthereumSigner(context.Background(), ethRemoteSigner, ethChainID, ethKeyFromAddress)
+		if err != nil {
+			err = errors.Wrap(err, "failed to initialize remote signer for Ethereum key")
+			return emptyEthAddress, nil, nil, err
+		}
+
+		return ethKeyFromAddress, signerFn, personalSignFn, nil
+
 	case *ethUseLedger:
 		if ethKeyFrom == nil {
 			err := errors.New("cannot use Ledger without from address specified")
@@ -204,6 +222,32 @@ func initEthereumAccountsManager(
 	}
 }
 
+// initRemoteSigner connects to the remote signer if it is used for any of the keys, otherwise returns nil
+func initRemoteSigner(
+	remoteSignerURL *string,
+	remoteSignerAuthToken *string,
+	cosmosUseRemoteSigner *bool,
+	ethUseRemoteSigner *bool,
+) (*remotesigner.Client, error) {
+	if !*cosmosUseRemoteSigner && !*ethUseRemoteSigner {
+		return nil, nil
+	}
+
+	signer, err := remotesigner.NewClient(remotesigner.Config{
+		URL:       *remoteSignerURL,
+		AuthToken: *remoteSignerAuthToken,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := signer.Upcheck(context.Background()); err != nil {
+		return nil, errors.Wrap(err, "remote signer is not reachable")
+	}
+
+	return signer, nil
+}
+
 func ethPassFromStdin() (string, error) {
 	fmt.Print("Passphrase for Ethereum account: ")
 	bytePassword, err := terminal.ReadPassword(int(syscall.Stdin))
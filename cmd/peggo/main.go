@@ -33,6 +33,7 @@ func main() {
 	app.Command("orchestrator", "Starts the orchestrator main loop.", orchestratorCmd)
 	app.Command("q query", "Query commands that can get state info from Peggy.", queryCmdSubset)
 	app.Command("tx", "Transactions for Peggy governance and maintenance.", txCmdSubset)
+	app.Command("mock-signer", "Starts a local remote signer holding plaintext keys. USE FOR TESTING ONLY!", mockSignerCmd)
 	app.Command("version", "Print the version information and exit.", versionCmd)
 
 	_ = app.Run(os.Args)
@@ -0,0 +1,74 @@
+package main
+
+import (
+	"crypto/ecdsa"
+	"net/http"
+	"strings"
+	"time"
+
+	ethcrypto "github.com/ethereum/go-ethereum/crypto"
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	"github.com/xlab/closer"
+	log "github.com/xlab/suplog"
+
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/remotesigner"
+)
+
+// mockSignerCmd starts a local remote signer holding plaintext keys, for tests and local setups.
+//
+// $ peggo mock-signer
+func mockSignerCmd(cmd *cli.Cmd) {
+	listenAddr := cmd.String(cli.StringOpt{
+		Name:   "listen",
+		Desc:   "Address to serve the remote signer API on.",
+		EnvVar: "PEGGO_MOCK_SIGNER_LISTEN",
+		Value:  "localhost:9000",
+	})
+
+	authToken := cmd.String(cli.StringOpt{
+		Name:   "auth-token",
+		Desc:   "Bearer token required from clients, if set.",
+		EnvVar: "PEGGO_MOCK_SIGNER_AUTH_TOKEN",
+	})
+
+	privKeys := cmd.Strings(cli.StringsOpt{
+		Name:   "pk",
+		Desc:   "Raw secp256k1 private keys in hex served by the signer. USE FOR TESTING ONLY!",
+		EnvVar: "PEGGO_MOCK_SIGNER_PKS",
+		Value:  []string{},
+	})
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		keys := make([]*ecdsa.PrivateKey, 0, len(*privKeys))
+		for _, pk := range *privKeys {
+			key, err := ethcrypto.HexToECDSA(strings.TrimPrefix(pk, "0x"))
+			orShutdown(errors.Wrap(err, "failed to hex-decode private key"))
+
+			log.Infoln("mock signer serving key", ethcrypto.PubkeyToAddress(key.PublicKey).String())
+			keys = append(keys, key)
+		}
+
+		if len(keys) == 0 {
+			log.Fatalln("no private keys provided")
+		}
+
+		server := &http.Server{
+			Addr:              *listenAddr,
+			Handler:           remotesigner.NewMockServer(*authToken, keys...),
+			ReadHeaderTimeout: 10 * time.Second,
+		}
+
+		closer.Bind(func() {
+			_ = server.Close()
+		})
+
+		log.Infoln("mock signer listening on", *listenAddr)
+		if err := server.ListenAndServe(); err != nil && !errors.Is(err, http.ErrServerClosed) {
+			log.WithError(err).Fatalln("mock signer stopped")
+		}
+	}
+}
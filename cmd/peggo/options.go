@@ -175,7 +175,91 @@ func initEthereumKeyOptions(
 	})
 }
 
+// initClaimReplayOptions sets options for the read-only claim dry-run and replay commands.
+func initClaimReplayOptions(
+	cmd *cli.Cmd,
+	ethNodeRPC **string,
+	ethNodeFallbackRPCs **[]string,
+	ethNodeEventQuorum **int,
+	ethFrom **string,
+	fromBlock **int,
+	toBlock **int,
+) {
+	*ethNodeRPC = cmd.String(cli.StringOpt{
+		Name:   "eth-node-http",
+		Desc:   "Specify HTTP endpoint for an Ethereum node.",
+		EnvVar: "PEGGO_ETH_RPC",
+		Value:  "http://localhost:1317",
+	})
+
+	*ethNodeFallbackRPCs = cmd.Strings(cli.StringsOpt{
+		Name:   "eth-node-http-fallbacks",
+		Desc:   "Specify additional HTTP endpoints for Ethereum nodes used for failover and event quorum (comma-separated in env).",
+		EnvVar: "PEGGO_ETH_RPC_FALLBACKS",
+		Value:  []string{},
+	})
+
+	*ethNodeEventQuorum = cmd.Int(cli.IntOpt{
+		Name:   "eth-node-event-quorum",
+		Desc:   "Number of Ethereum endpoints that must return identical events",
+		EnvVar: "PEGGO_ETH_RPC_EVENT_QUORUM",
+		Value:  1,
+	})
+
+	*ethFrom = cmd.String(cli.StringOpt{
+		Name:   "eth-from",
+		Desc:   "Specify the Ethereum address registered by the validator as its orchestrator key.",
+		EnvVar: "PEGGO_ETH_FROM",
+	})
+
+	*fromBlock = cmd.Int(cli.IntOpt{
+		Name:  "from-block",
+		Desc:  "First Ethereum block of the range. Defaults to the block of the validator's last claim.",
+		Value: 0,
+	})
+
+	*toBlock = cmd.Int(cli.IntOpt{
+		Name:  "to-block",
+		Desc:  "Last Ethereum block of the range. Defaults to the latest block with enough confirmations.",
+		Value: 0,
+	})
+}
+
 // initStatsdOptions sets options for StatsD metrics.
+func initRemoteSignerOptions(
+	cmd *cli.Cmd,
+	remoteSignerURL **string,
+	remoteSignerAuthToken **string,
+	cosmosUseRemoteSigner **bool,
+	ethUseRemoteSigner **bool,
+) {
+	*remoteSignerURL = cmd.String(cli.StringOpt{
+		Name:   "remote-signer-url",
+		Desc:   "Specify HTTP endpoint of a Web3Signer-compatible remote signer holding the validator keys.",
+		EnvVar: "PEGGO_REMOTE_SIGNER_URL",
+	})
+
+	*remoteSignerAuthToken = cmd.String(cli.StringOpt{
+		Name:   "remote-signer-auth-token",
+		Desc:   "Bearer token sent to the remote signer, if it requires authorization.",
+		EnvVar: "PEGGO_REMOTE_SIGNER_AUTH_TOKEN",
+	})
+
+	*cosmosUseRemoteSigner = cmd.Bool(cli.BoolOpt{
+		Name:   "cosmos-use-remote-signer",
+		Desc:   "Use the remote signer to sign Cosmos transactions. The cosmos-from address must be held by the signer.",
+		EnvVar: "PEGGO_COSMOS_USE_REMOTE_SIGNER",
+		Value:  false,
+	})
+
+	*ethUseRemoteSigner = cmd.Bool(cli.BoolOpt{
+		Name:   "eth-use-remote-signer",
+		Desc:   "Use the remote signer to sign Ethereum transactions and messages. The eth-from address must be held by the signer.",
+		EnvVar: "PEGGO_ETH_USE_REMOTE_SIGNER",
+		Value:  false,
+	})
+}
+
 func initStatsdOptions(
 	cmd *cli.Cmd,
 	statsdAgent **string,
@@ -250,8 +334,14 @@ type Config struct {
 	ethChainID            *int
 	ethNodeRPC            *string
 	ethNodeAlchemyWS      *string
+	ethNodeFallbackRPCs   *[]string
+	ethNodeMaxBlockLag    *int
+	ethNodeEventQuorum    *int
 	ethGasPriceAdjustment *float64
 	ethMaxGasPrice        *string
+	ethDynamicFeeTx       *bool
+	ethTxReplaceBlocks    *int
+	ethTxFeeBumpPercent   *int
 
 	// Ethereum Key Management
 	ethKeystoreDir *string
@@ -260,16 +350,29 @@ type Config struct {
 	ethPrivKey     *string
 	ethUseLedger   *bool
 
+	// Remote signer
+	remoteSignerURL       *string
+	remoteSignerAuthToken *string
+	cosmosUseRemoteSigner *bool
+	ethUseRemoteSigner    *bool
+
 	// Relayer config
 	relayValsets          *bool
 	relayValsetOffsetDur  *string
 	relayBatches          *bool
 	relayBatchOffsetDur   *string
+	relayLogicCalls       *bool
 	pendingTxWaitDuration *string
 
 	// Batch requester config
 	minBatchFeeUSD *float64
 
+	// Price feeds
+	priceFeeds            *string
+	priceFeedOraclePairs  *string
+	priceFeedOracleMaxAge *string
+	priceFeedStaticPrices *string
+
 	coingeckoApi        *string
 	loopDuration        *string
 	relayerLoopDuration *string
@@ -301,7 +404,7 @@ func initConfig(cmd *cli.Cmd) Config {
 
 	cfg.healthCheckPort = cmd.Int(cli.IntOpt{
 		Name:   "health-check",
-		Desc:   "Port number on which to run the health check HTTP server",
+		Desc:   "Port number on which to run the HTTP server serving /health, /status and Prometheus /metrics",
 		EnvVar: "PEGGO_HEALTH_CHECK_PORT",
 		Value:  7070,
 	})
@@ -376,6 +479,27 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "http://localhost:1317",
 	})
 
+	cfg.ethNodeFallbackRPCs = cmd.Strings(cli.StringsOpt{
+		Name:   "eth-node-http-fallbacks",
+		Desc:   "Specify additional HTTP endpoints for Ethereum nodes used for failover and event quorum (comma-separated in env).",
+		EnvVar: "PEGGO_ETH_RPC_FALLBACKS",
+		Value:  []string{},
+	})
+
+	cfg.ethNodeMaxBlockLag = cmd.Int(cli.IntOpt{
+		Name:   "eth-node-max-block-lag",
+		Desc:   "Number of blocks an Ethereum endpoint can lag behind the other endpoints before it is skipped",
+		EnvVar: "PEGGO_ETH_RPC_MAX_BLOCK_LAG",
+		Value:  5,
+	})
+
+	cfg.ethNodeEventQuorum = cmd.Int(cli.IntOpt{
+		Name:   "eth-node-event-quorum",
+		Desc:   "Number of Ethereum endpoints that must return identical events before a claim is submitted",
+		EnvVar: "PEGGO_ETH_RPC_EVENT_QUORUM",
+		Value:  1,
+	})
+
 	cfg.ethNodeAlchemyWS = cmd.String(cli.StringOpt{
 		Name:   "eth-node-alchemy-ws",
 		Desc:   "Specify websocket url for an Alchemy ethereum node.",
@@ -397,6 +521,27 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "500gwei",
 	})
 
+	cfg.ethDynamicFeeTx = cmd.Bool(cli.BoolOpt{
+		Name:   "eth-dynamic-fee-tx",
+		Desc:   "Send EIP-1559 (type-2) transactions priced from the suggested tip cap and the base fee",
+		EnvVar: "PEGGO_ETH_DYNAMIC_FEE_TX",
+		Value:  true,
+	})
+
+	cfg.ethTxReplaceBlocks = cmd.Int(cli.IntOpt{
+		Name:   "eth-tx-replace-after-blocks",
+		Desc:   "Number of blocks after which a pending Ethereum transaction is replaced with bumped fees (0 disables replacement)",
+		EnvVar: "PEGGO_ETH_TX_REPLACE_AFTER_BLOCKS",
+		Value:  10,
+	})
+
+	cfg.ethTxFeeBumpPercent = cmd.Int(cli.IntOpt{
+		Name:   "eth-tx-fee-bump-percent",
+		Desc:   "Fee increase in percent applied to replacement transactions, capped by eth-max-gas-price (min 10)",
+		EnvVar: "PEGGO_ETH_TX_FEE_BUMP_PERCENT",
+		Value:  15,
+	})
+
 	cfg.ethKeystoreDir = cmd.String(cli.StringOpt{
 		Name:   "eth-keystore-dir",
 		Desc:   "Specify Ethereum keystore dir (Geth-format) prefix.",
@@ -428,6 +573,16 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  false,
 	})
 
+	/** Remote signer **/
+
+	initRemoteSignerOptions(
+		cmd,
+		&cfg.remoteSignerURL,
+		&cfg.remoteSignerAuthToken,
+		&cfg.cosmosUseRemoteSigner,
+		&cfg.ethUseRemoteSigner,
+	)
+
 	/** Relayer **/
 
 	cfg.relayValsets = cmd.Bool(cli.BoolOpt{
@@ -458,6 +613,13 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "5m",
 	})
 
+	cfg.relayLogicCalls = cmd.Bool(cli.BoolOpt{
+		Name:   "relay_logic_calls",
+		Desc:   "If enabled, relayer will relay logic calls to ethereum",
+		EnvVar: "PEGGO_RELAY_LOGIC_CALLS",
+		Value:  false,
+	})
+
 	cfg.pendingTxWaitDuration = cmd.String(cli.StringOpt{
 		Name:   "relay_pending_tx_wait_duration",
 		Desc:   "If set, relayer will broadcast pending batches/valsetupdate only after pendingTxWaitDuration has passed",
@@ -483,6 +645,34 @@ func initConfig(cmd *cli.Cmd) Config {
 		Value:  "https://api.coingecko.com/api/v3",
 	})
 
+	/** Price feeds **/
+
+	cfg.priceFeeds = cmd.String(cli.StringOpt{
+		Name:   "price-feeds",
+		Desc:   "Comma-separated list of price feeds queried in order until one returns a price (coingecko|oracle|static)",
+		EnvVar: "PEGGO_PRICE_FEEDS",
+		Value:  "coingecko",
+	})
+
+	cfg.priceFeedOraclePairs = cmd.String(cli.StringOpt{
+		Name:   "price-feed-oracle-pairs",
+		Desc:   "Comma-separated list of Injective oracle prices of tokens as <erc20>=<oracle_type>:<base>:<quote>",
+		EnvVar: "PEGGO_PRICE_FEED_ORACLE_PAIRS",
+	})
+
+	cfg.priceFeedOracleMaxAge = cmd.String(cli.StringOpt{
+		Name:   "price-feed-oracle-max-age",
+		Desc:   "Injective oracle prices older than this are ignored (0 disables the check)",
+		EnvVar: "PEGGO_PRICE_FEED_ORACLE_MAX_AGE",
+		Value:  "10m",
+	})
+
+	cfg.priceFeedStaticPrices = cmd.String(cli.StringOpt{
+		Name:   "price-feed-static-prices",
+		Desc:   "Comma-separated list of fixed USD prices of tokens as <erc20>=<price>",
+		EnvVar: "PEGGO_PRICE_FEED_STATIC_PRICES",
+	})
+
 	/** Loop durations **/
 	cfg.loopDuration = cmd.String(cli.StringOpt{
 		Name:   "loop_duration",
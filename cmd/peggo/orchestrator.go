@@ -14,7 +14,7 @@ import (
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum"
-	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/pricefeed"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/remotesigner"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/version"
 )
 
@@ -54,6 +54,12 @@ func orchestratorCmd(cmd *cli.Cmd) {
 				MaxGasPrice:           *cfg.ethMaxGasPrice,
 				PendingTxWaitDuration: *cfg.pendingTxWaitDuration,
 				EthNodeAlchemyWS:      *cfg.ethNodeAlchemyWS,
+				DynamicFeeTx:          *cfg.ethDynamicFeeTx,
+				TxReplaceAfterBlocks:  uint64(max(*cfg.ethTxReplaceBlocks, 0)),
+				TxFeeBumpPercent:      uint64(max(*cfg.ethTxFeeBumpPercent, 0)),
+				EthNodeFallbackRPCs:   *cfg.ethNodeFallbackRPCs,
+				MaxBlockLag:           uint64(max(*cfg.ethNodeMaxBlockLag, 0)),
+				EventQuorum:           *cfg.ethNodeEventQuorum,
 			}
 		)
 
@@ -71,6 +77,23 @@ func orchestratorCmd(cmd *cli.Cmd) {
 
 		// 1. Connect to Injective network
 
+		remoteSigner, err := initRemoteSigner(
+			cfg.remoteSignerURL,
+			cfg.remoteSignerAuthToken,
+			cfg.cosmosUseRemoteSigner,
+			cfg.ethUseRemoteSigner,
+		)
+		orShutdown(errors.Wrap(err, "failed to initialize remote signer"))
+
+		var ethRemoteSigner *remotesigner.Client
+		if *cfg.ethUseRemoteSigner {
+			ethRemoteSigner = remoteSigner
+		}
+
+		if *cfg.cosmosUseRemoteSigner {
+			cosmosKeyringCfg.RemoteSigner = remoteSigner
+		}
+
 		cosmosKeyring, err := cosmos.NewKeyring(cosmosKeyringCfg)
 		orShutdown(errors.Wrap(err, "failed to initialize Injective keyring"))
 		log.Infoln("initialized Injective keyring", cosmosKeyring.Addr.String())
@@ -82,6 +105,7 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			cfg.ethPassphrase,
 			cfg.ethPrivKey,
 			cfg.ethUseLedger,
+			ethRemoteSigner,
 		)
 		orShutdown(errors.Wrap(err, "failed to initialize Ethereum keyring"))
 		log.Infoln("initialized Ethereum keyring", ethKeyFromAddress.String())
@@ -112,8 +136,12 @@ func orchestratorCmd(cmd *cli.Cmd) {
 		log.WithFields(log.Fields{
 			"chain_id":             *cfg.ethChainID,
 			"rpc":                  *cfg.ethNodeRPC,
+			"fallback_rpcs":        len(*cfg.ethNodeFallbackRPCs),
+			"event_quorum":         *cfg.ethNodeEventQuorum,
 			"max_gas_price":        *cfg.ethMaxGasPrice,
 			"gas_price_adjustment": *cfg.ethGasPriceAdjustment,
+			"dynamic_fee_tx":       *cfg.ethDynamicFeeTx,
+			"tx_replace_after":     *cfg.ethTxReplaceBlocks,
 		}).Infoln("connected to Ethereum network")
 
 		var isValidator bool
@@ -160,14 +188,19 @@ func orchestratorCmd(cmd *cli.Cmd) {
 			RelayerLoopDuration:  relayerLoopDur,
 			RelayValsets:         *cfg.relayValsets,
 			RelayBatches:         *cfg.relayBatches,
+			RelayLogicCalls:      *cfg.relayLogicCalls,
 			RelayerOnlyMode:      !isValidator,
 		}
 
+		priceFeed, err := initPriceFeed(cfg)
+		orShutdown(errors.Wrap(err, "failed to initialize price feeds"))
+		log.WithField("price_feeds", *cfg.priceFeeds).Infoln("initialized price feeds")
+
 		// Create peggo and run it
 		peggo, err := orchestrator.NewOrchestrator(
 			cosmosNetwork,
 			ethNetwork,
-			pricefeed.NewCoingeckoPriceFeed(100, &pricefeed.Config{BaseURL: *cfg.coingeckoApi}),
+			priceFeed,
 			orchestratorCfg,
 		)
 		orShutdown(err)
@@ -0,0 +1,68 @@
+package main
+
+import (
+	"strings"
+	"time"
+
+	"github.com/pkg/errors"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/client"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/pricefeed"
+)
+
+// initPriceFeed builds the fallback chain of price feeds selected by the config
+func initPriceFeed(cfg Config) (pricefeed.PriceFeed, error) {
+	feeds := make([]pricefeed.NamedPriceFeed, 0)
+
+	for _, name := range strings.Split(*cfg.priceFeeds, ",") {
+		name = strings.TrimSpace(name)
+
+		var feed pricefeed.PriceFeed
+		switch name {
+		case pricefeed.FeedCoingecko:
+			feed = pricefeed.NewCoingeckoPriceFeed(100, &pricefeed.Config{BaseURL: *cfg.coingeckoApi})
+		case pricefeed.FeedOracle:
+			pairs, err := pricefeed.ParseOraclePairs(*cfg.priceFeedOraclePairs)
+			if err != nil {
+				return nil, err
+			}
+
+			maxAge, err := time.ParseDuration(*cfg.priceFeedOracleMaxAge)
+			if err != nil {
+				return nil, errors.Wrap(err, "failed to parse oracle price max age")
+			}
+
+			//nolint:staticcheck // breaks clients with grpc.NewClient
+			conn, err := grpc.Dial(
+				*cfg.cosmosGRPC,
+				grpc.WithTransportCredentials(insecure.NewCredentials()),
+				grpc.WithContextDialer(client.DialerFunc),
+			)
+			if err != nil {
+				return nil, errors.Wrapf(err, "failed to connect to cosmos: %s", *cfg.cosmosGRPC)
+			}
+
+			feed = pricefeed.NewOraclePriceFeed(oracletypes.NewQueryClient(conn), pairs, maxAge)
+		case pricefeed.FeedStatic:
+			prices, err := pricefeed.ParseStaticPrices(*cfg.priceFeedStaticPrices)
+			if err != nil {
+				return nil, err
+			}
+
+			feed = pricefeed.NewStaticPriceFeed(prices)
+		default:
+			return nil, errors.Errorf("unknown price feed %q", name)
+		}
+
+		feeds = append(feeds, pricefeed.NamedPriceFeed{Name: name, PriceFeed: feed})
+	}
+
+	if len(feeds) == 0 {
+		return nil, errors.New("no price feeds configured")
+	}
+
+	return pricefeed.NewFallbackPriceFeed(feeds...), nil
+}
@@ -1,11 +1,244 @@
 package main
 
-import cli "github.com/jawher/mow.cli"
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
+	"github.com/xlab/closer"
+	log "github.com/xlab/suplog"
+
+	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/peggy"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum"
+)
 
 // queryCmdSubset contains actions that query stuff from Peggy module
 // and the Ethereum contract
 //
 // $ peggo q
 func queryCmdSubset(cmd *cli.Cmd) {
+	cmd.Command(
+		"replay-claims",
+		"Re-derives the Ethereum claims for a block range and diffs them against the claims made by the validator on Injective",
+		replayClaimsCmd,
+	)
+}
+
+func replayClaimsCmd(cmd *cli.Cmd) {
+	var (
+		mismatchesOnly *bool
+		replay         = initClaimReplayCmd(cmd)
+	)
+
+	mismatchesOnly = cmd.Bool(cli.BoolOpt{
+		Name:  "mismatches-only",
+		Desc:  "Only print claims that disagree with the validator's votes on Injective.",
+		Value: false,
+	})
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancelFn()
+
+		env, err := replay.init(ctx)
+		orShutdown(err)
+
+		diffs, err := orchestrator.ReplayClaims(ctx, env.injective, env.ethereum, env.orchestrator, env.validator, env.fromBlock, env.toBlock)
+		orShutdown(errors.Wrap(err, "failed to replay claims"))
+
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		_, _ = fmt.Fprintln(w, "EVENT NONCE\tBLOCK\tTYPE\tSTATUS")
+
+		mismatches := 0
+		for _, d := range diffs {
+			if d.IsMismatch() {
+				mismatches++
+			} else if *mismatchesOnly {
+				continue
+			}
+
+			claim := d.Derived
+			if claim == nil {
+				claim = d.OnChain
+			}
+
+			_, _ = fmt.Fprintf(w, "%d\t%d\t%s\t%s\n", d.EventNonce, claim.GetBlockHeight(), claim.GetType(), d.Status)
+		}
+
+		_ = w.Flush()
+
+		for _, d := range diffs {
+			if !d.IsMismatch() {
+				continue
+			}
+
+			fmt.Printf("\nevent nonce %d (%s)\n", d.EventNonce, d.Status)
+			fmt.Printf("  derived:  %s\n", claimString(d.Derived))
+			fmt.Printf("  on chain: %s\n", claimString(d.OnChain))
+		}
+
+		log.WithFields(log.Fields{
+			"from_block": env.fromBlock,
+			"to_block":   env.toBlock,
+			"claims":     len(diffs),
+			"mismatches": mismatches,
+		}).Infoln("claim replay finished")
+
+		if mismatches > 0 {
+			closer.Exit(1)
+		}
+	}
+}
+
+// claimReplayCmd holds the options shared by the read-only claim dry-run and replay commands.
+type claimReplayCmd struct {
+	// Cosmos params
+	cosmosChainID   *string
+	cosmosGRPC      *string
+	tendermintRPC   *string
+	cosmosGasPrices *string
+
+	// Ethereum params
+	ethNodeRPC          *string
+	ethNodeFallbackRPCs *[]string
+	ethNodeEventQuorum  *int
+	ethFrom             *string
+
+	// Block range
+	fromBlock *int
+	toBlock   *int
+}
+
+type claimReplayEnv struct {
+	injective    peggy.QueryClient
+	ethereum     ethereum.Network
+	orchestrator cosmostypes.AccAddress
+	validator    cosmostypes.ValAddress
+	lastClaim    *peggytypes.LastClaimEvent
+	fromBlock    uint64
+	toBlock      uint64
+}
+
+func initClaimReplayCmd(cmd *cli.Cmd) *claimReplayCmd {
+	c := &claimReplayCmd{}
+
+	initCosmosOptions(
+		cmd,
+		&c.cosmosChainID,
+		&c.cosmosGRPC,
+		&c.tendermintRPC,
+		&c.cosmosGasPrices,
+	)
+
+	initClaimReplayOptions(
+		cmd,
+		&c.ethNodeRPC,
+		&c.ethNodeFallbackRPCs,
+		&c.ethNodeEventQuorum,
+		&c.ethFrom,
+		&c.fromBlock,
+		&c.toBlock,
+	)
+
+	return c
+}
+
+// init connects to both networks without any keys and resolves the validator behind eth-from.
+func (c *claimReplayCmd) init(ctx context.Context) (*claimReplayEnv, error) {
+	if !gethcommon.IsHexAddress(*c.ethFrom) {
+		return nil, errors.Errorf("invalid eth-from address: %q", *c.ethFrom)
+	}
+
+	ethFrom := gethcommon.HexToAddress(*c.ethFrom)
+
+	injective, err := cosmos.NewQueryNetwork(cosmos.NetworkConfig{
+		ChainID:       *c.cosmosChainID,
+		CosmosGRPC:    *c.cosmosGRPC,
+		TendermintRPC: *c.tendermintRPC,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Injective network")
+	}
+
+	peggyParams, err := injective.PeggyParams(ctx)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to query peggy params, is injectived running?")
+	}
+
+	eth, err := ethereum.NewQueryNetwork(gethcommon.HexToAddress(peggyParams.BridgeEthereumAddress), ethereum.NetworkConfig{
+		EthNodeRPC:          *c.ethNodeRPC,
+		EthNodeFallbackRPCs: *c.ethNodeFallbackRPCs,
+		EventQuorum:         *c.ethNodeEventQuorum,
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to connect to Ethereum network")
+	}
+
+	orchAddr, err := injective.GetOrchestratorAddress(ctx, ethFrom)
+	if err != nil {
+		return nil, errors.Wrapf(err, "no orchestrator registered for %s", ethFrom.Hex())
+	}
+
+	valAddr, err := injective.GetValidatorAddress(ctx, ethFrom)
+	if err != nil {
+		return nil, errors.Wrapf(err, "no validator registered for %s", ethFrom.Hex())
+	}
+
+	lastClaim, err := injective.LastClaimEventByAddr(ctx, orchAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	env := &claimReplayEnv{
+		injective:    injective,
+		ethereum:     eth,
+		orchestrator: orchAddr,
+		validator:    cosmostypes.ValAddress(valAddr),
+		lastClaim:    lastClaim,
+		fromBlock:    uint64(max(*c.fromBlock, 0)),
+		toBlock:      uint64(max(*c.toBlock, 0)),
+	}
+
+	if env.fromBlock == 0 {
+		env.fromBlock = lastClaim.EthereumEventHeight
+	}
+
+	if env.toBlock == 0 {
+		if env.toBlock, err = orchestrator.LatestConfirmedEthHeight(ctx, eth); err != nil {
+			return nil, err
+		}
+	}
+
+	log.WithFields(log.Fields{
+		"validator":    env.validator.String(),
+		"orchestrator": env.orchestrator.String(),
+		"from_block":   env.fromBlock,
+		"to_block":     env.toBlock,
+	}).Infoln("replaying Peggy.sol events")
+
+	return env, nil
+}
+
+func claimString(claim peggytypes.EthereumClaim) string {
+	if claim == nil {
+		return "<none>"
+	}
+
+	if s, ok := claim.(fmt.Stringer); ok {
+		return s.String()
+	}
 
+	return fmt.Sprintf("%+v", claim)
 }
@@ -2,14 +2,18 @@ package main
 
 import (
 	"context"
+	"fmt"
 	"time"
 
 	cli "github.com/jawher/mow.cli"
+	"github.com/pkg/errors"
 	"github.com/xlab/closer"
 	log "github.com/xlab/suplog"
 
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/peggy"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/remotesigner"
 )
 
 // txCmdSubset contains actions that can sign and send messages to Cosmos module
@@ -22,6 +26,12 @@ func txCmdSubset(cmd *cli.Cmd) {
 		"Submits an Ethereum key that will be used to sign messages on behalf of your Validator",
 		registerEthKeyCmd,
 	)
+
+	cmd.Command(
+		"dry-run-claims",
+		"Prints the Ethereum claims the orchestrator would submit for a block range, without broadcasting them",
+		dryRunClaimsCmd,
+	)
 }
 
 func registerEthKeyCmd(cmd *cli.Cmd) {
@@ -49,6 +59,12 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 		ethPrivKey     *string
 		ethUseLedger   *bool
 
+		// Remote signer
+		remoteSignerURL       *string
+		remoteSignerAuthToken *string
+		cosmosUseRemoteSigner *bool
+		ethUseRemoteSigner    *bool
+
 		// Misc
 		alwaysAutoConfirm *bool
 	)
@@ -81,6 +97,14 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 		&ethUseLedger,
 	)
 
+	initRemoteSignerOptions(
+		cmd,
+		&remoteSignerURL,
+		&remoteSignerAuthToken,
+		&cosmosUseRemoteSigner,
+		&ethUseRemoteSigner,
+	)
+
 	initInteractiveOptions(
 		cmd,
 		&alwaysAutoConfirm,
@@ -94,6 +118,19 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 			log.Warningln("beware: you cannot really use Ledger for orchestrator, so make sure the Ethereum key is accessible outside of it")
 		}
 
+		remoteSigner, err := initRemoteSigner(
+			remoteSignerURL,
+			remoteSignerAuthToken,
+			cosmosUseRemoteSigner,
+			ethUseRemoteSigner,
+		)
+		orShutdown(err)
+
+		var ethRemoteSigner *remotesigner.Client
+		if *ethUseRemoteSigner {
+			ethRemoteSigner = remoteSigner
+		}
+
 		keyringCfg := cosmos.KeyringConfig{
 			KeyringDir:     *cosmosKeyringDir,
 			KeyringAppName: *cosmosKeyringAppName,
@@ -104,6 +141,10 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 			UseLedger:      *cosmosUseLedger,
 		}
 
+		if *cosmosUseRemoteSigner {
+			keyringCfg.RemoteSigner = remoteSigner
+		}
+
 		keyring, err := cosmos.NewKeyring(keyringCfg)
 		orShutdown(err)
 
@@ -114,6 +155,7 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 			ethPassphrase,
 			ethPrivKey,
 			ethUseLedger,
+			ethRemoteSigner,
 		)
 		if err != nil {
 			log.WithError(err).Fatalln("failed to init Ethereum account")
@@ -152,3 +194,38 @@ func registerEthKeyCmd(cmd *cli.Cmd) {
 			ethKeyFromAddress, keyring.Addr.String())
 	}
 }
+
+func dryRunClaimsCmd(cmd *cli.Cmd) {
+	replay := initClaimReplayCmd(cmd)
+
+	cmd.Action = func() {
+		// ensure a clean exit
+		defer closer.Close()
+
+		ctx, cancelFn := context.WithTimeout(context.Background(), 10*time.Minute)
+		defer cancelFn()
+
+		env, err := replay.init(ctx)
+		orShutdown(err)
+
+		claims, err := orchestrator.DeriveClaims(ctx, env.ethereum, env.orchestrator, env.fromBlock, env.toBlock)
+		orShutdown(errors.Wrap(err, "failed to derive claims"))
+
+		pending := 0
+		for _, claim := range claims {
+			// the oracle only submits claims above the last nonce claimed by the validator
+			if claim.GetEventNonce() <= env.lastClaim.EthereumEventNonce {
+				continue
+			}
+
+			pending++
+			fmt.Printf("%d\t%s\t%s\n", claim.GetEventNonce(), claim.GetType(), claimString(claim))
+		}
+
+		log.WithFields(log.Fields{
+			"last_claimed_event_nonce": env.lastClaim.EthereumEventNonce,
+			"derived":                  len(claims),
+			"pending":                  pending,
+		}).Infoln("dry run finished, no claims were sent")
+	}
+}
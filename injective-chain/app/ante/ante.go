@@ -200,7 +200,13 @@ func NewAnteHandler(
 				authante.NewValidateMemoDecorator(ak),
 				txfeeskeeper.NewMempoolFeeDecorator(options.TxFeesKeeper, false),
 				authante.NewConsumeGasForTxSizeDecorator(ak),
-				NewAuctionFeeDecorator(ak, options.BankKeeper, options.FeegrantKeeper, nil),
+				NewAuctionFeeDecorator(
+					ak,
+					options.BankKeeper,
+					options.FeegrantKeeper,
+					options.TxFeesKeeper,
+					txfeeskeeper.NewTxFeeChecker(options.TxFeesKeeper),
+				),
 				authante.NewSetPubKeyDecorator(ak), // SetPubKeyDecorator must be called before all signature verification decorators
 				authante.NewValidateSigCountDecorator(ak),
 				authante.NewSigGasConsumeDecorator(ak, DefaultSigVerificationGasConsumer),
@@ -12,6 +12,7 @@ import (
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 
 	auctiontypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	txfeestypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/types"
 	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
 )
 
@@ -127,11 +128,18 @@ func DeductFees(bankKeeper authtypes.BankKeeper, ctx sdk.Context, acc sdk.Accoun
 	return nil
 }
 
-// AuctionFeeDecorator replaces the original cosmos DeductFeeDecorator so fees are sent to the auction module
+// TxFeesKeeper defines the txfees keeper used to route the fees paid in fee denoms other than INJ
+type TxFeesKeeper interface {
+	IsFeeDenom(ctx sdk.Context, denom string) bool
+}
+
+// AuctionFeeDecorator replaces the original cosmos DeductFeeDecorator so fees are sent to the auction module.
+// Fees paid in one of the txfees fee denoms are sent to the txfees module instead, to be swapped to INJ.
 type AuctionFeeDecorator struct {
 	accountKeeper  authante.AccountKeeper
 	bankKeeper     authtypes.BankKeeper
 	feegrantKeeper authante.FeegrantKeeper
+	txFeesKeeper   TxFeesKeeper
 	txFeeChecker   authante.TxFeeChecker
 }
 
@@ -139,6 +147,7 @@ func NewAuctionFeeDecorator(
 	ak authante.AccountKeeper,
 	bk authtypes.BankKeeper,
 	fk authante.FeegrantKeeper,
+	tk TxFeesKeeper,
 	tfc authante.TxFeeChecker,
 ) AuctionFeeDecorator {
 	if tfc == nil {
@@ -149,6 +158,7 @@ func NewAuctionFeeDecorator(
 		accountKeeper:  ak,
 		bankKeeper:     bk,
 		feegrantKeeper: fk,
+		txFeesKeeper:   tk,
 		txFeeChecker:   tfc,
 	}
 }
@@ -225,10 +235,15 @@ func (afd AuctionFeeDecorator) checkDeductFee(ctx sdk.Context, tx sdk.FeeTx, fee
 			return errors.Wrapf(sdkerrors.ErrInsufficientFee, "invalid fee amount: %s", fee)
 		}
 
+		feeRecipientModule := auctiontypes.ModuleName
+		if afd.txFeesKeeper != nil && len(fee) == 1 && afd.txFeesKeeper.IsFeeDenom(ctx, fee[0].Denom) {
+			feeRecipientModule = txfeestypes.ModuleName
+		}
+
 		if err := afd.bankKeeper.SendCoinsFromAccountToModule(
 			ctx,
 			deductFeesFromAcc.GetAddress(),
-			auctiontypes.ModuleName,
+			feeRecipientModule,
 			fee,
 		); err != nil {
 			return errors.Wrapf(sdkerrors.ErrInsufficientFunds, err.Error())
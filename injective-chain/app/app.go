@@ -285,7 +285,7 @@ var (
 		ocrtypes.ModuleName:            nil,
 		tokenfactorytypes.ModuleName:   {authtypes.Minter, authtypes.Burner},
 		permissionsmodule.ModuleName:   nil,
-		txfees.ModuleName:              nil,
+		txfees.ModuleName:              {authtypes.Burner},
 		wasmtypes.ModuleName:           {authtypes.Burner},
 		wasmxtypes.ModuleName:          {authtypes.Burner},
 		evmtypes.ModuleName:            {authtypes.Minter, authtypes.Burner}, // used for secure addition and subtraction of balance using module account
@@ -305,6 +305,7 @@ var (
 		wasmxtypes.ModuleName:        true,
 		erc20types.ModuleName:        true, // to burn erc20 denom
 		govtypes.ModuleName:          true,
+		txfees.ModuleName:            true, // to receive the proceeds of the collected fees swaps
 	}
 )
 
@@ -1194,6 +1195,7 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 				return exchangepc.NewExchangeContract(
 					app.ExchangeKeeper,
 					&app.AuthzKeeper,
+					app.ERC20Keeper,
 					storetypes.TransientGasConfig(),
 				)
 			},
@@ -1254,6 +1256,9 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 		app.codec,
 		app.keys[txfeestypes.StoreKey],
 		app.ConsensusParamsKeeper,
+		app.AccountKeeper,
+		app.BankKeeper,
+		&app.OracleKeeper,
 		dataDir,
 		authority,
 	)
@@ -1296,6 +1301,11 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 	)
 
 	app.InsuranceKeeper.SetExchangeKeeper(app.ExchangeKeeper)
+	app.TxFeesKeeper.SetExchangeKeeper(app.ExchangeKeeper, exchangekeeper.NewMsgServerImpl(app.ExchangeKeeper))
+
+	app.OracleKeeper.SetHooks(oracletypes.NewMultiOracleHooks(
+		app.ExchangeKeeper.OracleHooks(),
+	))
 
 	app.PeggyKeeper = peggyKeeper.NewKeeper(
 		app.codec,
@@ -1313,7 +1323,11 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 	app.TokenFactoryKeeper = tokenfactorykeeper.NewKeeper(
 		app.keys[tokenfactorytypes.StoreKey],
 		app.AccountKeeper,
-		app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
+		// the bank keeper queues the created denoms for erc20 token pair creation or metadata sync
+		erc20keeper.NewMetadataSyncBankKeeper(
+			app.BankKeeper.(bankkeeper.BaseKeeper).WithMintCoinsRestriction(tokenfactorytypes.NewTokenFactoryDenomMintCoinsRestriction()),
+			&app.ERC20Keeper,
+		),
 		app.DistrKeeper,
 		GetModuleAccAddresses(),
 		authority,
@@ -1367,7 +1381,7 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 		app.IBCKeeper.ChannelKeeper,
 		app.IBCKeeper.PortKeeper,
 		app.AccountKeeper,
-		app.BankKeeper,
+		erc20keeper.NewMetadataSyncBankKeeper(app.BankKeeper, &app.ERC20Keeper),
 		app.ScopedTransferKeeper,
 		authority,
 	)
@@ -1452,6 +1466,11 @@ func (app *InjectiveApp) initKeepers(authority string, appOpts servertypes.AppOp
 		authority,
 	)
 
+	app.ERC20Keeper.SetHooks(erc20types.NewMultiErc20Hooks(
+		app.ExchangeKeeper.Erc20Hooks(),
+		app.PermissionsKeeper.Erc20Hooks(),
+	))
+
 	app.ScopedICAHostKeeper = app.CapabilityKeeper.ScopeToModule(icahosttypes.SubModuleName)
 	app.ICAHostKeeper = icahostkeeper.NewKeeper(
 		app.codec,
@@ -1,6 +1,7 @@
 package auction
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/InjectiveLabs/metrics"
@@ -29,6 +30,21 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 	lastBidAmount := lastBid.Amount.Amount
 
 	maxInjCap := am.keeper.GetParams(ctx).InjBasketMaxCap
+	auctionRound := am.keeper.GetAuctionRound(ctx)
+
+	// refund sealed bid deposits, keeping only the winning bid amount in the module
+	sealedBidRound := am.keeper.GetSealedBidRound(ctx)
+	if sealedBidRound != nil {
+		am.keeper.SettleSealedBidDeposits(ctx, sealedBidRound.Round, lastBid)
+	}
+
+	roundResult := &auctiontypes.AuctionRoundResult{
+		Round:           auctionRound,
+		Amount:          chaintypes.NewInjectiveCoin(math.ZeroInt()),
+		Basket:          sdk.NewCoins(),
+		EndingTimestamp: endingTimeStamp,
+		Sealed:          sealedBidRound != nil,
+	}
 
 	// settle auction round
 	if lastBidAmount.IsPositive() && lastBid.Bidder != "" {
@@ -65,12 +81,14 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 				if err := am.bankKeeper.SendCoinsFromModuleToAccount(ctx, auctiontypes.ModuleName, lastBidder, sdk.NewCoins(coin)); err != nil {
 					metrics.ReportFuncError(am.svcTags)
 					am.keeper.Logger(ctx).Error("Transferring coins to winner failed")
+				} else {
+					roundResult.Basket = roundResult.Basket.Add(coin)
 				}
 			}
 		}
 
-		// emit typed event for auction result
-		auctionRound := am.keeper.GetAuctionRound(ctx)
+		roundResult.Winner = lastBid.Bidder
+		roundResult.Amount = lastBid.Amount
 
 		// Store the auction result, so that it can be queried later
 		am.keeper.SetLastAuctionResult(ctx, auctiontypes.LastAuctionResult{
@@ -90,9 +108,15 @@ func (am AppModule) EndBlocker(ctx sdk.Context) {
 		am.keeper.DeleteBid(ctx)
 	}
 
+	// round 0 is only the placeholder before the first auction starts
+	if auctionRound > 0 {
+		am.keeper.RecordAuctionRoundResult(ctx, roundResult)
+	}
+
 	// advance auctionRound, endingTimestamp
 	nextRound := am.keeper.AdvanceNextAuctionRound(ctx)
 	nextEndingTimestamp := am.keeper.AdvanceNextEndingTimeStamp(ctx)
+	am.keeper.StartSealedBidRound(ctx, nextRound, nextEndingTimestamp)
 	// ping exchange module to flush fee for next round
 	balances := am.exchangeKeeper.WithdrawAllAuctionBalances(ctx)
 
@@ -1,9 +1,15 @@
 package cli
 
 import (
+	"context"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
 	"github.com/InjectiveLabs/injective-core/cli"
+	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
 )
 
@@ -15,6 +21,9 @@ func GetQueryCmd() *cobra.Command {
 		GetAuctionParamsCmd(),
 		GetAuctionInfo(),
 		GetLastAuctionResult(),
+		GetAuctionBidHistory(),
+		GetAuctionRoundResults(),
+		GetSealedBidCommitments(),
 	)
 	return cmd
 }
@@ -46,3 +55,85 @@ func GetLastAuctionResult() *cobra.Command {
 	cmd.Long = "Gets last auction result"
 	return cmd
 }
+
+func GetAuctionBidHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bid-history [round]",
+		Short: "Gets the recorded bids of an auction round",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			round, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAuctionBidHistoryRequest{
+				Round:      round,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.AuctionBidHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "bid-history")
+	return cmd
+}
+
+func GetAuctionRoundResults() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "round-results",
+		Short: "Gets the results of settled auction rounds",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryAuctionRoundResultsRequest{
+				Pagination: pageReq,
+			}
+			res, err := queryClient.AuctionRoundResults(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "round-results")
+	return cmd
+}
+
+func GetSealedBidCommitments() *cobra.Command {
+	cmd := cli.QueryCmd(
+		"sealed-bids",
+		"Gets sealed bid commitments of the current auction round",
+		types.NewQueryClient,
+		&types.QuerySealedBidCommitmentsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{})
+	cmd.Long = "Gets the sealed-bid phases and the bid commitments of the current auction round, if it runs in sealed-bid mode"
+	return cmd
+}
@@ -1,9 +1,13 @@
 package cli
 
 import (
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/spf13/cobra"
 
 	"github.com/InjectiveLabs/injective-core/cli"
+	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
 )
@@ -11,6 +15,8 @@ import (
 const (
 	FlagRoundNumber = "round"
 	FlagBidAmount   = "bid"
+	FlagDeposit     = "deposit"
+	FlagSalt        = "salt"
 )
 
 // NewTxCmd returns a root CLI command handler for certain modules/auction transaction commands.
@@ -19,6 +25,8 @@ func NewTxCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		NewBidCmd(),
+		NewCommitBidCmd(),
+		NewRevealBidCmd(),
 	)
 	return cmd
 }
@@ -35,3 +43,90 @@ func NewBidCmd() *cobra.Command {
 	cmd.Flags().Uint64(FlagRoundNumber, 4, "Auction round number")
 	return cmd
 }
+
+func NewCommitBidCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "commit-bid",
+		Args:  cobra.ExactArgs(0),
+		Short: "commit a sealed bid on current exchange basket",
+		Long: `Commit a sealed bid on current exchange basket. The commitment is computed locally from the sender,
+bid amount and salt, only the commitment and the deposit are broadcasted. Keep the salt to reveal the bid later.`,
+		Example: `injectived tx auction commit-bid --bid="100000000000000000000inj" --deposit="150000000000000000000inj" --salt="secret" --round=4 --from=genesis --keyring-backend=file --yes`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bidAmountStr, err := cmd.Flags().GetString(FlagBidAmount)
+			if err != nil {
+				return err
+			}
+
+			bidAmount, err := sdk.ParseCoinNormalized(bidAmountStr)
+			if err != nil {
+				return err
+			}
+
+			depositStr, err := cmd.Flags().GetString(FlagDeposit)
+			if err != nil {
+				return err
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(depositStr)
+			if err != nil {
+				return err
+			}
+
+			salt, err := cmd.Flags().GetString(FlagSalt)
+			if err != nil {
+				return err
+			}
+
+			round, err := cmd.Flags().GetUint64(FlagRoundNumber)
+			if err != nil {
+				return err
+			}
+
+			sender := clientCtx.GetFromAddress().String()
+			msg := &types.MsgCommitBid{
+				Sender:     sender,
+				Round:      round,
+				Commitment: types.ComputeBidCommitment(sender, bidAmount, salt),
+				Deposit:    deposit,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagBidAmount, "", "Auction bid amount, kept secret until revealed")
+	cmd.Flags().String(FlagDeposit, "", "Amount locked with the commitment, must cover the bid amount")
+	cmd.Flags().String(FlagSalt, "", "Secret salt used to compute the commitment")
+	cmd.Flags().Uint64(FlagRoundNumber, 0, "Auction round number")
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewRevealBidCmd() *cobra.Command {
+	cmd := cli.TxCmd("reveal-bid",
+		"reveal a sealed bid on current exchange basket",
+		&types.MsgRevealBid{},
+		cli.FlagsMapping{
+			"BidAmount": cli.Flag{Flag: FlagBidAmount},
+			"Round":     cli.Flag{Flag: FlagRoundNumber},
+			"Salt":      cli.Flag{Flag: FlagSalt},
+		},
+		cli.ArgsMapping{},
+	)
+	cmd.Example = `injectived tx auction reveal-bid --bid="100000000000000000000inj" --salt="secret" --round=4 --from=genesis --keyring-backend=file --yes`
+	cmd.Flags().String(FlagBidAmount, "", "Auction bid amount used in the commitment")
+	cmd.Flags().String(FlagSalt, "", "Secret salt used in the commitment")
+	cmd.Flags().Uint64(FlagRoundNumber, 0, "Auction round number")
+	return cmd
+}
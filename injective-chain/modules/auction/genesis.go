@@ -30,6 +30,22 @@ func InitGenesis(ctx sdk.Context, keeper auctionkeeper.Keeper, data types.Genesi
 		keeper.SetLastAuctionResult(ctx, *data.LastAuctionResult)
 	}
 
+	if data.SealedBidRound != nil {
+		keeper.SetSealedBidRound(ctx, *data.SealedBidRound)
+	}
+
+	for i := range data.SealedBidCommitments {
+		keeper.SetSealedBidCommitment(ctx, &data.SealedBidCommitments[i])
+	}
+
+	for i := range data.BidHistory {
+		keeper.SetBidRecord(ctx, &data.BidHistory[i])
+	}
+
+	for i := range data.RoundResults {
+		keeper.SetAuctionRoundResult(ctx, &data.RoundResults[i])
+	}
+
 	keeper.CreateModuleAccount(ctx)
 }
 
@@ -40,5 +56,9 @@ func ExportGenesis(ctx sdk.Context, k auctionkeeper.Keeper) *types.GenesisState
 		HighestBid:             k.GetHighestBid(ctx),
 		AuctionEndingTimestamp: k.GetEndingTimeStamp(ctx),
 		LastAuctionResult:      k.GetLastAuctionResult(ctx),
+		SealedBidRound:         k.GetSealedBidRound(ctx),
+		SealedBidCommitments:   k.GetAllSealedBidCommitments(ctx),
+		BidHistory:             k.GetAllBidRecords(ctx),
+		RoundResults:           k.GetAllAuctionRoundResults(ctx),
 	}
 }
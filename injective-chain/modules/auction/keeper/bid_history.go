@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+)
+
+func (k *Keeper) SetBidRecord(ctx sdk.Context, record *types.BidRecord) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(record)
+	ctx.KVStore(k.storeKey).Set(types.GetBidRecordKey(record.Round, record.Id), bz)
+}
+
+// GetLatestBidRecord returns the most recent recorded bid of an auction round
+func (k *Keeper) GetLatestBidRecord(ctx sdk.Context, round uint64) *types.BidRecord {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	historyStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetBidHistoryPrefix(round))
+	iterator := historyStore.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+
+	var record types.BidRecord
+	k.cdc.MustUnmarshal(iterator.Value(), &record)
+	return &record
+}
+
+// GetAllBidRecords is used to export the bid history of all auction rounds
+func (k *Keeper) GetAllBidRecords(ctx sdk.Context) []types.BidRecord {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BidHistoryPrefix)
+	defer iterator.Close()
+
+	records := make([]types.BidRecord, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var record types.BidRecord
+		k.cdc.MustUnmarshal(iterator.Value(), &record)
+		records = append(records, record)
+	}
+
+	return records
+}
+
+// recordBid appends a bid to the history of the current auction round if bid history is enabled
+func (k *Keeper) recordBid(ctx sdk.Context, round uint64, bidder string, amount sdk.Coin, sealed bool) {
+	if !k.GetParams(ctx).BidHistoryEnabled {
+		return
+	}
+
+	nextID := uint64(1)
+	if latest := k.GetLatestBidRecord(ctx, round); latest != nil {
+		nextID = latest.Id + 1
+	}
+
+	k.SetBidRecord(ctx, &types.BidRecord{
+		Round:       round,
+		Id:          nextID,
+		Bidder:      bidder,
+		Amount:      amount,
+		Timestamp:   ctx.BlockTime().Unix(),
+		BlockHeight: ctx.BlockHeight(),
+		Sealed:      sealed,
+	})
+}
+
+func (k *Keeper) SetAuctionRoundResult(ctx sdk.Context, result *types.AuctionRoundResult) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(result)
+	ctx.KVStore(k.storeKey).Set(types.GetAuctionRoundResultKey(result.Round), bz)
+}
+
+func (k *Keeper) GetAuctionRoundResult(ctx sdk.Context, round uint64) *types.AuctionRoundResult {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetAuctionRoundResultKey(round))
+	if bz == nil {
+		return nil
+	}
+
+	var result types.AuctionRoundResult
+	k.cdc.MustUnmarshal(bz, &result)
+	return &result
+}
+
+// GetAllAuctionRoundResults is used to export the results of all settled auction rounds
+func (k *Keeper) GetAllAuctionRoundResults(ctx sdk.Context) []types.AuctionRoundResult {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.AuctionRoundResultsPrefix)
+	defer iterator.Close()
+
+	results := make([]types.AuctionRoundResult, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var result types.AuctionRoundResult
+		k.cdc.MustUnmarshal(iterator.Value(), &result)
+		results = append(results, result)
+	}
+
+	return results
+}
+
+// RecordAuctionRoundResult stores the result of a settled auction round if bid history is enabled
+func (k *Keeper) RecordAuctionRoundResult(ctx sdk.Context, result *types.AuctionRoundResult) {
+	if !k.GetParams(ctx).BidHistoryEnabled {
+		return
+	}
+
+	k.SetAuctionRoundResult(ctx, result)
+}
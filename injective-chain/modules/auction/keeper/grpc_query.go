@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/store/prefix"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/InjectiveLabs/metrics"
 
@@ -38,11 +40,17 @@ func (k *Keeper) CurrentAuctionBasket(c context.Context, _ *types.QueryCurrentAu
 	coins := k.bankKeeper.GetAllBalances(ctx, auctionModuleAddress)
 	lastBid := k.GetHighestBid(ctx)
 
+	// in sealed-bid rounds every deposit is held by the module, including the one backing the highest bid
+	lockedBidAmount := lastBid.Amount.Amount
+	if sealedBidRound := k.GetSealedBidRound(ctx); sealedBidRound != nil {
+		lockedBidAmount = k.GetTotalSealedBidDeposits(ctx, sealedBidRound.Round)
+	}
+
 	currentBasketCoins := make([]sdk.Coin, 0)
 	for _, coin := range coins {
-		// We subtract the current highest bid amount from the basket
+		// We subtract the current locked bid amount from the basket
 		if coin.Denom == chaintypes.InjectiveCoin {
-			coin = coin.SubAmount(lastBid.Amount.Amount)
+			coin = coin.SubAmount(lockedBidAmount)
 			maxCap := k.GetParams(ctx).InjBasketMaxCap
 
 			if coin.Amount.GT(maxCap) {
@@ -90,3 +98,81 @@ func (k *Keeper) LastAuctionResult(c context.Context, _ *types.QueryLastAuctionR
 	}
 	return res, nil
 }
+
+func (k *Keeper) AuctionBidHistory(c context.Context, req *types.QueryAuctionBidHistoryRequest) (*types.QueryAuctionBidHistoryResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	bids := make([]types.BidRecord, 0)
+	historyStore := prefix.NewStore(k.GetStore(ctx), types.GetBidHistoryPrefix(req.Round))
+
+	pageRes, err := query.Paginate(historyStore, req.Pagination, func(_, value []byte) error {
+		var record types.BidRecord
+		if err := k.cdc.Unmarshal(value, &record); err != nil {
+			return err
+		}
+
+		bids = append(bids, record)
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.QueryAuctionBidHistoryResponse{
+		Bids:       bids,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k *Keeper) AuctionRoundResults(c context.Context, req *types.QueryAuctionRoundResultsRequest) (*types.QueryAuctionRoundResultsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	results := make([]types.AuctionRoundResult, 0)
+	resultStore := prefix.NewStore(k.GetStore(ctx), types.AuctionRoundResultsPrefix)
+
+	pageRes, err := query.Paginate(resultStore, req.Pagination, func(_, value []byte) error {
+		var result types.AuctionRoundResult
+		if err := k.cdc.Unmarshal(value, &result); err != nil {
+			return err
+		}
+
+		results = append(results, result)
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.QueryAuctionRoundResultsResponse{
+		Results:    results,
+		Pagination: pageRes,
+	}, nil
+}
+
+func (k *Keeper) SealedBidCommitments(c context.Context, _ *types.QuerySealedBidCommitmentsRequest) (*types.QuerySealedBidCommitmentsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	res := &types.QuerySealedBidCommitmentsResponse{
+		Commitments: make([]types.SealedBidCommitment, 0),
+	}
+
+	sealedBidRound := k.GetSealedBidRound(ctx)
+	if sealedBidRound == nil {
+		return res, nil
+	}
+
+	res.SealedBidRound = sealedBidRound
+	res.Commitments = k.GetSealedBidCommitments(ctx, sealedBidRound.Round)
+	return res, nil
+}
@@ -3,6 +3,7 @@ package keeper
 import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/exported"
 	v2 "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/migrations/v2"
+	v3 "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/migrations/v3"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -26,3 +27,11 @@ func (m Migrator) Migrate1to2(ctx sdk.Context) error {
 		m.keeper.cdc,
 	)
 }
+
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(
+		ctx,
+		ctx.KVStore(m.keeper.storeKey),
+		m.keeper.cdc,
+	)
+}
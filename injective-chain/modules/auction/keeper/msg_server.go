@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 	"slices"
+	"strings"
 
 	"cosmossdk.io/math"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
@@ -62,12 +63,9 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 
 	params := k.GetParams(ctx)
 
-	if len(params.BiddersWhitelist) > 0 {
-		isWhitelisted := slices.Contains(params.BiddersWhitelist, msg.Sender)
-		if !isWhitelisted {
-			metrics.ReportFuncError(k.svcTags)
-			return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is not in bidders whitelist", msg.Sender)
-		}
+	if !isWhitelistedBidder(params, msg.Sender) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is not in bidders whitelist", msg.Sender)
 	}
 
 	round := k.GetAuctionRound(ctx)
@@ -88,6 +86,11 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 		return nil, errors.Wrap(types.ErrBidRound, "Bid round end timestamp is already reached")
 	}
 
+	if k.GetSealedBidRound(ctx) != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalidBidPhase, "current round is a sealed-bid round, use commit and reveal instead")
+	}
+
 	lastBid := k.GetHighestBid(ctx)
 
 	isFirstBidder := !lastBid.Amount.Amount.IsPositive()
@@ -127,6 +130,7 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 	}
 
 	k.SetBid(ctx, msg.Sender, msg.BidAmount)
+	k.recordBid(ctx, round, msg.Sender, msg.BidAmount, false)
 
 	_ = ctx.EventManager().EmitTypedEvent(&types.EventBid{
 		Bidder: msg.Sender,
@@ -136,6 +140,154 @@ func (k msgServer) Bid(goCtx context.Context, msg *types.MsgBid) (*types.MsgBidR
 	return &types.MsgBidResponse{}, nil
 }
 
+func (k msgServer) CommitBid(goCtx context.Context, msg *types.MsgCommitBid) (*types.MsgCommitBidResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// We are sure the Sender is a valid address because it is validated in the ValidateBasic method
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	if !isWhitelistedBidder(k.GetParams(ctx), msg.Sender) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "sender %s is not in bidders whitelist", msg.Sender)
+	}
+
+	round := k.GetAuctionRound(ctx)
+	if msg.Round != round {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrBidRound, "current round is %d but got bid for %d", round, msg.Round)
+	}
+
+	sealedBidRound := k.GetSealedBidRound(ctx)
+	if sealedBidRound == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalidBidPhase, "current round is not a sealed-bid round")
+	}
+
+	if ctx.BlockTime().Unix() >= sealedBidRound.RevealStartTimestamp {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalidBidPhase, "commit phase of the round is over")
+	}
+
+	// a bidder can replace its commitment during the commit phase, only the deposit difference is transferred
+	previousDeposit := math.ZeroInt()
+	if previous := k.GetSealedBidCommitment(ctx, round, senderAddr); previous != nil {
+		previousDeposit = previous.Deposit.Amount
+	}
+
+	switch {
+	case msg.Deposit.Amount.GT(previousDeposit):
+		deposit := sdk.NewCoins(sdk.NewCoin(chaintypes.InjectiveCoin, msg.Deposit.Amount.Sub(previousDeposit)))
+		if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, senderAddr, types.ModuleName, deposit); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			k.Logger(ctx).Error("Bidder deposit failed", "senderAddr", senderAddr.String(), "coin", deposit.String())
+			return nil, errors.Wrap(err, "deposit failed")
+		}
+	case msg.Deposit.Amount.LT(previousDeposit):
+		refund := sdk.NewCoins(sdk.NewCoin(chaintypes.InjectiveCoin, previousDeposit.Sub(msg.Deposit.Amount)))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, senderAddr, refund); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			k.Logger(ctx).Error("Bidder refund failed", "senderAddr", senderAddr.String(), "coin", refund.String())
+			return nil, errors.Wrap(err, "refund failed")
+		}
+	}
+
+	k.SetSealedBidCommitment(ctx, &types.SealedBidCommitment{
+		Bidder:         msg.Sender,
+		Round:          round,
+		Commitment:     strings.ToLower(msg.Commitment),
+		Deposit:        msg.Deposit,
+		Revealed:       false,
+		RevealedAmount: chaintypes.NewInjectiveCoin(math.ZeroInt()),
+	})
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventBidCommitted{
+		Bidder:     msg.Sender,
+		Round:      round,
+		Commitment: strings.ToLower(msg.Commitment),
+		Deposit:    msg.Deposit,
+	})
+
+	return &types.MsgCommitBidResponse{}, nil
+}
+
+func (k msgServer) RevealBid(goCtx context.Context, msg *types.MsgRevealBid) (*types.MsgRevealBidResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	// We are sure the Sender is a valid address because it is validated in the ValidateBasic method
+	senderAddr := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	round := k.GetAuctionRound(ctx)
+	if msg.Round != round {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrBidRound, "current round is %d but got bid for %d", round, msg.Round)
+	}
+
+	sealedBidRound := k.GetSealedBidRound(ctx)
+	if sealedBidRound == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalidBidPhase, "current round is not a sealed-bid round")
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	if blockTime < sealedBidRound.RevealStartTimestamp || blockTime >= k.GetEndingTimeStamp(ctx) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalidBidPhase, "round is not in its reveal phase")
+	}
+
+	commitment := k.GetSealedBidCommitment(ctx, round, senderAddr)
+	if commitment == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrCommitmentNotFound, "no commitment of %s in round %d", msg.Sender, round)
+	}
+
+	if commitment.Revealed {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrBidAlreadyRevealed
+	}
+
+	if types.ComputeBidCommitment(msg.Sender, msg.BidAmount, msg.Salt) != commitment.Commitment {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrInvalidBidReveal
+	}
+
+	if msg.BidAmount.Amount.GT(commitment.Deposit.Amount) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInsufficientBidDeposit, "bid %s exceeds deposit %s", msg.BidAmount, commitment.Deposit)
+	}
+
+	commitment.Revealed = true
+	commitment.RevealedAmount = msg.BidAmount
+	k.SetSealedBidCommitment(ctx, commitment)
+
+	// the whole deposit stays locked until settlement, ties are won by the earliest reveal
+	if msg.BidAmount.Amount.GT(k.GetHighestBid(ctx).Amount.Amount) {
+		k.SetBid(ctx, msg.Sender, msg.BidAmount)
+	}
+
+	k.recordBid(ctx, round, msg.Sender, msg.BidAmount, true)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventBidRevealed{
+		Bidder: msg.Sender,
+		Amount: msg.BidAmount,
+		Round:  round,
+	})
+
+	return &types.MsgRevealBidResponse{}, nil
+}
+
+// isWhitelistedBidder returns true if the bidders whitelist is empty or contains the bidder
+func isWhitelistedBidder(params types.Params, bidder string) bool {
+	return len(params.BiddersWhitelist) == 0 || slices.Contains(params.BiddersWhitelist, bidder)
+}
+
 func (k msgServer) refundLastBidder(ctx sdk.Context) error {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
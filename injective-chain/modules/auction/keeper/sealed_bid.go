@@ -0,0 +1,176 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+// GetSealedBidRound returns the sealed-bid mode of the current auction round, or nil if the round is an open auction
+func (k *Keeper) GetSealedBidRound(ctx sdk.Context) *types.SealedBidRound {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.KeySealedBidRound)
+	if bz == nil {
+		return nil
+	}
+
+	var sealedBidRound types.SealedBidRound
+	k.cdc.MustUnmarshal(bz, &sealedBidRound)
+
+	if sealedBidRound.Round != k.GetAuctionRound(ctx) {
+		return nil
+	}
+
+	return &sealedBidRound
+}
+
+func (k *Keeper) SetSealedBidRound(ctx sdk.Context, sealedBidRound types.SealedBidRound) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(&sealedBidRound)
+	ctx.KVStore(k.storeKey).Set(types.KeySealedBidRound, bz)
+}
+
+func (k *Keeper) DeleteSealedBidRound(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	ctx.KVStore(k.storeKey).Delete(types.KeySealedBidRound)
+}
+
+// StartSealedBidRound fixes the bidding mode of a newly started auction round according to the current params.
+// The reveal period is shortened if the round is shorter than usual (e.g. after a chain halt), so that the
+// commit phase always lasts at least as long as the reveal phase.
+func (k *Keeper) StartSealedBidRound(ctx sdk.Context, round uint64, endingTimestamp int64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	params := k.GetParams(ctx)
+	if !params.SealedBidEnabled {
+		k.DeleteSealedBidRound(ctx)
+		return
+	}
+
+	revealPeriod := min(params.RevealPeriod, (endingTimestamp-ctx.BlockTime().Unix())/2)
+	k.SetSealedBidRound(ctx, types.SealedBidRound{
+		Round:                round,
+		RevealStartTimestamp: endingTimestamp - revealPeriod,
+	})
+}
+
+func (k *Keeper) GetSealedBidCommitment(ctx sdk.Context, round uint64, bidder sdk.AccAddress) *types.SealedBidCommitment {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := ctx.KVStore(k.storeKey).Get(types.GetSealedBidCommitmentKey(round, bidder))
+	if bz == nil {
+		return nil
+	}
+
+	var commitment types.SealedBidCommitment
+	k.cdc.MustUnmarshal(bz, &commitment)
+	return &commitment
+}
+
+func (k *Keeper) SetSealedBidCommitment(ctx sdk.Context, commitment *types.SealedBidCommitment) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bidder := sdk.MustAccAddressFromBech32(commitment.Bidder)
+	bz := k.cdc.MustMarshal(commitment)
+	ctx.KVStore(k.storeKey).Set(types.GetSealedBidCommitmentKey(commitment.Round, bidder), bz)
+}
+
+func (k *Keeper) DeleteSealedBidCommitment(ctx sdk.Context, round uint64, bidder sdk.AccAddress) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	ctx.KVStore(k.storeKey).Delete(types.GetSealedBidCommitmentKey(round, bidder))
+}
+
+// GetSealedBidCommitments returns all sealed bid commitments of an auction round
+func (k *Keeper) GetSealedBidCommitments(ctx sdk.Context, round uint64) []types.SealedBidCommitment {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	commitmentStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetSealedBidCommitmentPrefix(round))
+	iterator := commitmentStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	commitments := make([]types.SealedBidCommitment, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.SealedBidCommitment
+		k.cdc.MustUnmarshal(iterator.Value(), &commitment)
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments
+}
+
+// GetAllSealedBidCommitments is used to export the sealed bid commitments of all rounds
+func (k *Keeper) GetAllSealedBidCommitments(ctx sdk.Context) []types.SealedBidCommitment {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	iterator := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.SealedBidCommitmentsPrefix)
+	defer iterator.Close()
+
+	commitments := make([]types.SealedBidCommitment, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var commitment types.SealedBidCommitment
+		k.cdc.MustUnmarshal(iterator.Value(), &commitment)
+		commitments = append(commitments, commitment)
+	}
+
+	return commitments
+}
+
+// GetTotalSealedBidDeposits returns the amount of INJ locked by the sealed bid commitments of an auction round
+func (k *Keeper) GetTotalSealedBidDeposits(ctx sdk.Context, round uint64) math.Int {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	total := math.ZeroInt()
+	for _, commitment := range k.GetSealedBidCommitments(ctx, round) {
+		total = total.Add(commitment.Deposit.Amount)
+	}
+
+	return total
+}
+
+// SettleSealedBidDeposits refunds the deposits of a sealed-bid round and clears its commitments. The winner
+// is refunded the part of its deposit exceeding the winning bid, which is kept in the module to be burned.
+func (k *Keeper) SettleSealedBidDeposits(ctx sdk.Context, round uint64, winningBid *types.Bid) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	for _, commitment := range k.GetSealedBidCommitments(ctx, round) {
+		bidder := sdk.MustAccAddressFromBech32(commitment.Bidder)
+		refundAmount := commitment.Deposit.Amount
+
+		if commitment.Bidder == winningBid.Bidder && winningBid.Amount.Amount.IsPositive() {
+			refundAmount = refundAmount.Sub(winningBid.Amount.Amount)
+		}
+
+		k.DeleteSealedBidCommitment(ctx, round, bidder)
+
+		if !refundAmount.IsPositive() {
+			continue
+		}
+
+		refund := sdk.NewCoins(sdk.NewCoin(chaintypes.InjectiveCoin, refundAmount))
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, bidder, refund); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			k.Logger(ctx).Error("Sealed bid deposit refund failed", "bidder", commitment.Bidder, "coin", refund.String(), "err", err.Error())
+		}
+	}
+}
@@ -0,0 +1,32 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+)
+
+// Migrate sets the sealed-bid and bid history params introduced in v3 to their default values
+func Migrate(
+	_ sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &currParams)
+
+	currParams.SealedBidEnabled = types.DefaultSealedBidEnabled
+	currParams.RevealPeriod = types.DefaultRevealPeriod
+	currParams.BidHistoryEnabled = types.DefaultBidHistoryEnabled
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	bz := cdc.MustMarshal(&currParams)
+	store.Set(types.ParamsKey, bz)
+
+	return nil
+}
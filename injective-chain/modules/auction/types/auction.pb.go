@@ -39,6 +39,15 @@ type Params struct {
 	// if empty, any address can bid; if populated, only whitelisted addresses can
 	// bid
 	BiddersWhitelist []string `protobuf:"bytes,4,rep,name=bidders_whitelist,json=biddersWhitelist,proto3" json:"bidders_whitelist,omitempty"`
+	// sealed_bid_enabled defines whether newly started auction rounds use the
+	// sealed-bid (commit-reveal) mode instead of the open ascending auction
+	SealedBidEnabled bool `protobuf:"varint,5,opt,name=sealed_bid_enabled,json=sealedBidEnabled,proto3" json:"sealed_bid_enabled,omitempty"`
+	// reveal_period defines the duration in seconds at the end of a sealed-bid
+	// round during which committed bids can be revealed
+	RevealPeriod int64 `protobuf:"varint,6,opt,name=reveal_period,json=revealPeriod,proto3" json:"reveal_period,omitempty"`
+	// bid_history_enabled defines whether every bid and round result is
+	// recorded in state
+	BidHistoryEnabled bool `protobuf:"varint,7,opt,name=bid_history_enabled,json=bidHistoryEnabled,proto3" json:"bid_history_enabled,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -88,6 +97,27 @@ func (m *Params) GetBiddersWhitelist() []string {
 	return nil
 }
 
+func (m *Params) GetSealedBidEnabled() bool {
+	if m != nil {
+		return m.SealedBidEnabled
+	}
+	return false
+}
+
+func (m *Params) GetRevealPeriod() int64 {
+	if m != nil {
+		return m.RevealPeriod
+	}
+	return 0
+}
+
+func (m *Params) GetBidHistoryEnabled() bool {
+	if m != nil {
+		return m.BidHistoryEnabled
+	}
+	return false
+}
+
 type Bid struct {
 	Bidder string                                  `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder" yaml:"bidder"`
 	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
@@ -365,244 +395,652 @@ func (m *EventAuctionStart) GetNewBasket() github_com_cosmos_cosmos_sdk_types.Co
 	return nil
 }
 
-func init() {
-	proto.RegisterType((*Params)(nil), "injective.auction.v1beta1.Params")
-	proto.RegisterType((*Bid)(nil), "injective.auction.v1beta1.Bid")
-	proto.RegisterType((*LastAuctionResult)(nil), "injective.auction.v1beta1.LastAuctionResult")
-	proto.RegisterType((*EventBid)(nil), "injective.auction.v1beta1.EventBid")
-	proto.RegisterType((*EventAuctionResult)(nil), "injective.auction.v1beta1.EventAuctionResult")
-	proto.RegisterType((*EventAuctionStart)(nil), "injective.auction.v1beta1.EventAuctionStart")
+// SealedBidRound describes an auction round running in sealed-bid mode
+type SealedBidRound struct {
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// reveal_start_timestamp describes the time from which committed bids can be
+	// revealed, no new commitments are accepted after it
+	RevealStartTimestamp int64 `protobuf:"varint,2,opt,name=reveal_start_timestamp,json=revealStartTimestamp,proto3" json:"reveal_start_timestamp,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("injective/auction/v1beta1/auction.proto", fileDescriptor_49edfee5f1ef4b5a)
+func (m *SealedBidRound) Reset()         { *m = SealedBidRound{} }
+func (m *SealedBidRound) String() string { return proto.CompactTextString(m) }
+func (*SealedBidRound) ProtoMessage()    {}
+func (*SealedBidRound) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{6}
+}
+func (m *SealedBidRound) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidRound) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidRound.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SealedBidRound) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidRound.Merge(m, src)
+}
+func (m *SealedBidRound) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidRound) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidRound.DiscardUnknown(m)
 }
 
-var fileDescriptor_49edfee5f1ef4b5a = []byte{
-	// 632 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6b, 0x13, 0x41,
-	0x14, 0xce, 0x34, 0x35, 0x98, 0x91, 0xda, 0x66, 0xa8, 0x36, 0x6d, 0x71, 0x13, 0x22, 0xd2, 0xa8,
-	0x74, 0xd7, 0xda, 0x5b, 0x6f, 0x6e, 0xf5, 0x50, 0x69, 0xa5, 0xac, 0x82, 0xe0, 0x65, 0x99, 0xdd,
-	0x7d, 0x24, 0x93, 0x66, 0x66, 0xc2, 0xce, 0xa4, 0x49, 0xff, 0x82, 0x27, 0x7f, 0x80, 0x88, 0x07,
-	0xf5, 0xe0, 0xc9, 0x9f, 0xe0, 0xb1, 0xc7, 0x1e, 0xc5, 0x43, 0x94, 0xf6, 0xa0, 0x78, 0xf4, 0x17,
-	0x48, 0x66, 0x67, 0x43, 0x40, 0x14, 0x4f, 0xc5, 0x4b, 0xb2, 0xef, 0x9b, 0xf7, 0xbe, 0xf9, 0xde,
-	0xf7, 0x98, 0x87, 0xd7, 0x98, 0xe8, 0x40, 0xac, 0xd9, 0x21, 0x78, 0xb4, 0x1f, 0x6b, 0x26, 0x85,
-	0x77, 0xb8, 0x11, 0x81, 0xa6, 0x1b, 0x79, 0xec, 0xf6, 0x52, 0xa9, 0x25, 0x59, 0x9e, 0x24, 0xba,
-	0xf9, 0x81, 0x4d, 0x5c, 0x59, 0x6c, 0xc9, 0x96, 0x34, 0x59, 0xde, 0xf8, 0x2b, 0x2b, 0x58, 0x71,
-	0x62, 0xa9, 0xb8, 0x54, 0x5e, 0x44, 0x15, 0x4c, 0x38, 0x63, 0xc9, 0x2c, 0xe1, 0x4a, 0x85, 0x72,
-	0x26, 0xa4, 0x67, 0x7e, 0x33, 0xa8, 0xf1, 0x66, 0x06, 0x97, 0xf6, 0x69, 0x4a, 0xb9, 0x22, 0x37,
-	0xf0, 0x65, 0x7b, 0x4d, 0xd8, 0x83, 0x94, 0xc9, 0xa4, 0x8a, 0xea, 0xa8, 0x59, 0x0c, 0xe6, 0x2c,
-	0xba, 0x6f, 0x40, 0x42, 0xf1, 0x2a, 0x67, 0x22, 0x14, 0x30, 0xd4, 0x61, 0xc4, 0x92, 0x90, 0x89,
-	0x38, 0x05, 0x0e, 0x42, 0x87, 0x29, 0xd5, 0x50, 0x9d, 0xa9, 0xa3, 0x66, 0xd9, 0xbf, 0x7e, 0x3c,
-	0xaa, 0x15, 0x3e, 0x8f, 0x6a, 0xab, 0x99, 0x22, 0x95, 0x1c, 0xb8, 0x4c, 0x7a, 0x9c, 0xea, 0xb6,
-	0xbb, 0x0b, 0x2d, 0x1a, 0x1f, 0xdd, 0x87, 0x38, 0x58, 0xe2, 0x4c, 0x3c, 0x82, 0xa1, 0xf6, 0x59,
-	0xb2, 0x93, 0x93, 0x04, 0x54, 0x03, 0x79, 0x88, 0x09, 0x13, 0x9d, 0x30, 0xa2, 0xea, 0x00, 0x74,
-	0xc8, 0xe9, 0x30, 0x8c, 0x69, 0xaf, 0x5a, 0x34, 0xcc, 0xd7, 0x2c, 0xf3, 0x95, 0xdf, 0x99, 0x77,
-	0x84, 0x0e, 0xe6, 0x99, 0xe8, 0xf8, 0xa6, 0x6e, 0x8f, 0x0e, 0xb7, 0x69, 0x8f, 0xdc, 0xc6, 0x95,
-	0x88, 0x25, 0x09, 0xa4, 0x2a, 0x1c, 0xb4, 0x99, 0x86, 0x2e, 0x53, 0xba, 0x3a, 0x5b, 0x2f, 0x36,
-	0xcb, 0xc1, 0x82, 0x3d, 0x78, 0x9a, 0xe3, 0x5b, 0x4b, 0xdf, 0x5f, 0xd7, 0xd0, 0xf3, 0x6f, 0x1f,
-	0x6e, 0xe5, 0x4e, 0x78, 0x99, 0x37, 0x8d, 0x57, 0x08, 0x17, 0x7d, 0x96, 0x90, 0x4d, 0x5c, 0xca,
-	0x8a, 0x8c, 0x37, 0x65, 0x7f, 0xf5, 0xc7, 0xa8, 0x66, 0x91, 0x9f, 0xa3, 0xda, 0xdc, 0x11, 0xe5,
-	0xdd, 0xad, 0x46, 0x16, 0x37, 0x02, 0x7b, 0x40, 0x22, 0x5c, 0xa2, 0x5c, 0xf6, 0x85, 0x36, 0xe6,
-	0x5c, 0xba, 0xbb, 0xec, 0x66, 0xda, 0xdd, 0xf1, 0x9c, 0xf2, 0x91, 0xba, 0xdb, 0x92, 0x09, 0xdf,
-	0xb3, 0xdd, 0xad, 0xb5, 0x98, 0x6e, 0xf7, 0x23, 0x37, 0x96, 0xdc, 0xb3, 0x43, 0xcd, 0xfe, 0xd6,
-	0x55, 0x72, 0xe0, 0xe9, 0xa3, 0x1e, 0x28, 0x53, 0x10, 0x58, 0xe6, 0xc6, 0x5b, 0x84, 0x2b, 0xbb,
-	0x54, 0xe9, 0x7b, 0x99, 0xee, 0x00, 0x54, 0xbf, 0xab, 0xc9, 0x55, 0x5c, 0x1a, 0x30, 0x21, 0x72,
-	0xb9, 0x81, 0x8d, 0xce, 0x43, 0x11, 0x59, 0xc4, 0x17, 0x52, 0xd9, 0x17, 0x89, 0x99, 0xdb, 0x6c,
-	0x90, 0x05, 0x8d, 0x97, 0x08, 0x5f, 0x7c, 0x70, 0x08, 0x62, 0x3c, 0xf4, 0xb1, 0xbc, 0x69, 0x37,
-	0xcf, 0xd3, 0xb0, 0x3f, 0xc8, 0x7b, 0x87, 0x30, 0x31, 0xf2, 0xfe, 0x77, 0x1f, 0x3f, 0x22, 0x5c,
-	0x99, 0x16, 0xfa, 0x58, 0xd3, 0x74, 0x2a, 0x17, 0x4d, 0xe5, 0x92, 0x9b, 0x78, 0x01, 0x44, 0xc2,
-	0x44, 0x2b, 0xd4, 0x8c, 0x83, 0xd2, 0x94, 0xf7, 0x8c, 0xde, 0x62, 0x30, 0x9f, 0xe1, 0x4f, 0x72,
-	0x98, 0x74, 0x30, 0x16, 0x30, 0xb0, 0x2f, 0xaf, 0x5a, 0xac, 0x17, 0xff, 0xde, 0xd4, 0x9d, 0x71,
-	0x53, 0xef, 0xbf, 0xd4, 0x9a, 0xff, 0xd8, 0x94, 0x0a, 0xca, 0x02, 0x06, 0xd9, 0xfb, 0xf4, 0x5b,
-	0xc7, 0xa7, 0x0e, 0x3a, 0x39, 0x75, 0xd0, 0xd7, 0x53, 0x07, 0xbd, 0x38, 0x73, 0x0a, 0x27, 0x67,
-	0x4e, 0xe1, 0xd3, 0x99, 0x53, 0x78, 0xb6, 0x37, 0x45, 0xb7, 0x93, 0xef, 0xc0, 0x5d, 0x1a, 0x29,
-	0x6f, 0xb2, 0x11, 0xd7, 0x63, 0x99, 0xc2, 0x74, 0xd8, 0xa6, 0x4c, 0x78, 0x5c, 0x26, 0xfd, 0x2e,
-	0xa8, 0xc9, 0x5e, 0x35, 0x37, 0x47, 0x25, 0xb3, 0xea, 0x36, 0x7f, 0x05, 0x00, 0x00, 0xff, 0xff,
-	0x28, 0xbc, 0x1a, 0xed, 0x79, 0x05, 0x00, 0x00,
+var xxx_messageInfo_SealedBidRound proto.InternalMessageInfo
+
+func (m *SealedBidRound) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
 }
 
-func (this *Params) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
+func (m *SealedBidRound) GetRevealStartTimestamp() int64 {
+	if m != nil {
+		return m.RevealStartTimestamp
 	}
+	return 0
+}
 
-	that1, ok := that.(*Params)
-	if !ok {
-		that2, ok := that.(Params)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+// SealedBidCommitment describes a committed bid of a sealed-bid round
+type SealedBidCommitment struct {
+	// bidder describes the address of bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// commitment is the hex encoded sha256 hash of the bidder, bid amount and
+	// salt
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit describes the amount locked by the bidder, which must cover the
+	// revealed bid amount
+	Deposit github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+	// revealed defines whether the bid has been revealed
+	Revealed bool `protobuf:"varint,5,opt,name=revealed,proto3" json:"revealed,omitempty"`
+	// revealed_amount describes the revealed bid amount
+	RevealedAmount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,6,opt,name=revealed_amount,json=revealedAmount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"revealed_amount"`
+}
+
+func (m *SealedBidCommitment) Reset()         { *m = SealedBidCommitment{} }
+func (m *SealedBidCommitment) String() string { return proto.CompactTextString(m) }
+func (*SealedBidCommitment) ProtoMessage()    {}
+func (*SealedBidCommitment) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{7}
+}
+func (m *SealedBidCommitment) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SealedBidCommitment) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SealedBidCommitment.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if this.AuctionPeriod != that1.AuctionPeriod {
-		return false
+}
+func (m *SealedBidCommitment) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SealedBidCommitment.Merge(m, src)
+}
+func (m *SealedBidCommitment) XXX_Size() int {
+	return m.Size()
+}
+func (m *SealedBidCommitment) XXX_DiscardUnknown() {
+	xxx_messageInfo_SealedBidCommitment.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SealedBidCommitment proto.InternalMessageInfo
+
+func (m *SealedBidCommitment) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	if !this.MinNextBidIncrementRate.Equal(that1.MinNextBidIncrementRate) {
-		return false
+	return ""
+}
+
+func (m *SealedBidCommitment) GetRound() uint64 {
+	if m != nil {
+		return m.Round
 	}
-	if !this.InjBasketMaxCap.Equal(that1.InjBasketMaxCap) {
-		return false
+	return 0
+}
+
+func (m *SealedBidCommitment) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
 	}
-	if len(this.BiddersWhitelist) != len(that1.BiddersWhitelist) {
-		return false
+	return ""
+}
+
+func (m *SealedBidCommitment) GetRevealed() bool {
+	if m != nil {
+		return m.Revealed
 	}
-	for i := range this.BiddersWhitelist {
-		if this.BiddersWhitelist[i] != that1.BiddersWhitelist[i] {
-			return false
+	return false
+}
+
+// BidRecord describes a bid placed or revealed in an auction round
+type BidRecord struct {
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// id defines the sequence number of the bid within the round
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// bidder describes the address of bidder
+	Bidder string `protobuf:"bytes,3,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// amount describes the amount the bidder put on the auction
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// timestamp describes the block time of the bid
+	Timestamp int64 `protobuf:"varint,5,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// block_height describes the block height of the bid
+	BlockHeight int64 `protobuf:"varint,6,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// sealed defines whether the bid was revealed in a sealed-bid round
+	Sealed bool `protobuf:"varint,7,opt,name=sealed,proto3" json:"sealed,omitempty"`
+}
+
+func (m *BidRecord) Reset()         { *m = BidRecord{} }
+func (m *BidRecord) String() string { return proto.CompactTextString(m) }
+func (*BidRecord) ProtoMessage()    {}
+func (*BidRecord) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{8}
+}
+func (m *BidRecord) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BidRecord) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BidRecord.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	return true
 }
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *BidRecord) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BidRecord.Merge(m, src)
 }
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BidRecord) XXX_Size() int {
+	return m.Size()
+}
+func (m *BidRecord) XXX_DiscardUnknown() {
+	xxx_messageInfo_BidRecord.DiscardUnknown(m)
 }
 
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BiddersWhitelist) > 0 {
-		for iNdEx := len(m.BiddersWhitelist) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BiddersWhitelist[iNdEx])
-			copy(dAtA[i:], m.BiddersWhitelist[iNdEx])
-			i = encodeVarintAuction(dAtA, i, uint64(len(m.BiddersWhitelist[iNdEx])))
-			i--
-			dAtA[i] = 0x22
-		}
+var xxx_messageInfo_BidRecord proto.InternalMessageInfo
+
+func (m *BidRecord) GetRound() uint64 {
+	if m != nil {
+		return m.Round
 	}
-	{
-		size := m.InjBasketMaxCap.Size()
-		i -= size
-		if _, err := m.InjBasketMaxCap.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintAuction(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *BidRecord) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	i--
-	dAtA[i] = 0x1a
-	{
-		size := m.MinNextBidIncrementRate.Size()
-		i -= size
-		if _, err := m.MinNextBidIncrementRate.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintAuction(dAtA, i, uint64(size))
+	return 0
+}
+
+func (m *BidRecord) GetBidder() string {
+	if m != nil {
+		return m.Bidder
 	}
-	i--
-	dAtA[i] = 0x12
-	if m.AuctionPeriod != 0 {
-		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionPeriod))
-		i--
-		dAtA[i] = 0x8
+	return ""
+}
+
+func (m *BidRecord) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *Bid) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *BidRecord) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *BidRecord) GetSealed() bool {
+	if m != nil {
+		return m.Sealed
+	}
+	return false
 }
 
-func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
+// AuctionRoundResult describes the settlement of an auction round
+type AuctionRoundResult struct {
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	// winner describes the address of the winner, empty if there were no bids
+	Winner string `protobuf:"bytes,2,opt,name=winner,proto3" json:"winner,omitempty"`
+	// amount describes the winning bid amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// basket describes the coins transferred to the winner
+	Basket github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=basket,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"basket"`
+	// ending_timestamp describes auction end time of the round
+	EndingTimestamp int64 `protobuf:"varint,5,opt,name=ending_timestamp,json=endingTimestamp,proto3" json:"ending_timestamp,omitempty"`
+	// sealed defines whether the round ran in sealed-bid mode
+	Sealed bool `protobuf:"varint,6,opt,name=sealed,proto3" json:"sealed,omitempty"`
+}
+
+func (m *AuctionRoundResult) Reset()         { *m = AuctionRoundResult{} }
+func (m *AuctionRoundResult) String() string { return proto.CompactTextString(m) }
+func (*AuctionRoundResult) ProtoMessage()    {}
+func (*AuctionRoundResult) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{9}
+}
+func (m *AuctionRoundResult) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AuctionRoundResult) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AuctionRoundResult.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i = encodeVarintAuction(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Bidder) > 0 {
-		i -= len(m.Bidder)
-		copy(dAtA[i:], m.Bidder)
-		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *AuctionRoundResult) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AuctionRoundResult.Merge(m, src)
+}
+func (m *AuctionRoundResult) XXX_Size() int {
+	return m.Size()
+}
+func (m *AuctionRoundResult) XXX_DiscardUnknown() {
+	xxx_messageInfo_AuctionRoundResult.DiscardUnknown(m)
 }
 
-func (m *LastAuctionResult) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_AuctionRoundResult proto.InternalMessageInfo
+
+func (m *AuctionRoundResult) GetRound() uint64 {
+	if m != nil {
+		return m.Round
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *LastAuctionResult) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *AuctionRoundResult) GetWinner() string {
+	if m != nil {
+		return m.Winner
+	}
+	return ""
 }
 
-func (m *LastAuctionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *AuctionRoundResult) GetBasket() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Basket
+	}
+	return nil
+}
+
+func (m *AuctionRoundResult) GetEndingTimestamp() int64 {
+	if m != nil {
+		return m.EndingTimestamp
+	}
+	return 0
+}
+
+func (m *AuctionRoundResult) GetSealed() bool {
+	if m != nil {
+		return m.Sealed
+	}
+	return false
+}
+
+type EventBidCommitted struct {
+	// bidder describes the address of bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,2,opt,name=round,proto3" json:"round,omitempty"`
+	// commitment is the hex encoded hash of the sealed bid
+	Commitment string `protobuf:"bytes,3,opt,name=commitment,proto3" json:"commitment,omitempty"`
+	// deposit describes the amount locked by the bidder
+	Deposit github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=deposit,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"deposit"`
+}
+
+func (m *EventBidCommitted) Reset()         { *m = EventBidCommitted{} }
+func (m *EventBidCommitted) String() string { return proto.CompactTextString(m) }
+func (*EventBidCommitted) ProtoMessage()    {}
+func (*EventBidCommitted) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{10}
+}
+func (m *EventBidCommitted) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBidCommitted) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBidCommitted.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBidCommitted) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBidCommitted.Merge(m, src)
+}
+func (m *EventBidCommitted) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBidCommitted) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBidCommitted.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBidCommitted proto.InternalMessageInfo
+
+func (m *EventBidCommitted) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *EventBidCommitted) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *EventBidCommitted) GetCommitment() string {
+	if m != nil {
+		return m.Commitment
+	}
+	return ""
+}
+
+type EventBidRevealed struct {
+	// bidder describes the address of bidder
+	Bidder string `protobuf:"bytes,1,opt,name=bidder,proto3" json:"bidder,omitempty"`
+	// amount describes the revealed bid amount
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// round defines the round number of auction
+	Round uint64 `protobuf:"varint,3,opt,name=round,proto3" json:"round,omitempty"`
+}
+
+func (m *EventBidRevealed) Reset()         { *m = EventBidRevealed{} }
+func (m *EventBidRevealed) String() string { return proto.CompactTextString(m) }
+func (*EventBidRevealed) ProtoMessage()    {}
+func (*EventBidRevealed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_49edfee5f1ef4b5a, []int{11}
+}
+func (m *EventBidRevealed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBidRevealed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBidRevealed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBidRevealed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBidRevealed.Merge(m, src)
+}
+func (m *EventBidRevealed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBidRevealed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBidRevealed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBidRevealed proto.InternalMessageInfo
+
+func (m *EventBidRevealed) GetBidder() string {
+	if m != nil {
+		return m.Bidder
+	}
+	return ""
+}
+
+func (m *EventBidRevealed) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*Params)(nil), "injective.auction.v1beta1.Params")
+	proto.RegisterType((*Bid)(nil), "injective.auction.v1beta1.Bid")
+	proto.RegisterType((*LastAuctionResult)(nil), "injective.auction.v1beta1.LastAuctionResult")
+	proto.RegisterType((*EventBid)(nil), "injective.auction.v1beta1.EventBid")
+	proto.RegisterType((*EventAuctionResult)(nil), "injective.auction.v1beta1.EventAuctionResult")
+	proto.RegisterType((*EventAuctionStart)(nil), "injective.auction.v1beta1.EventAuctionStart")
+	proto.RegisterType((*SealedBidRound)(nil), "injective.auction.v1beta1.SealedBidRound")
+	proto.RegisterType((*SealedBidCommitment)(nil), "injective.auction.v1beta1.SealedBidCommitment")
+	proto.RegisterType((*BidRecord)(nil), "injective.auction.v1beta1.BidRecord")
+	proto.RegisterType((*AuctionRoundResult)(nil), "injective.auction.v1beta1.AuctionRoundResult")
+	proto.RegisterType((*EventBidCommitted)(nil), "injective.auction.v1beta1.EventBidCommitted")
+	proto.RegisterType((*EventBidRevealed)(nil), "injective.auction.v1beta1.EventBidRevealed")
+}
+
+func init() {
+	proto.RegisterFile("injective/auction/v1beta1/auction.proto", fileDescriptor_49edfee5f1ef4b5a)
+}
+
+var fileDescriptor_49edfee5f1ef4b5a = []byte{
+	// 949 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x56, 0x4b, 0x6f, 0x1c, 0x45,
+	0x10, 0xf6, 0xec, 0xd8, 0x1b, 0x6f, 0x25, 0x7e, 0x75, 0x4c, 0xb2, 0xb1, 0x61, 0x77, 0x99, 0x08,
+	0x65, 0x79, 0x64, 0x86, 0x10, 0x4e, 0xb9, 0x65, 0x4d, 0xa4, 0x18, 0x39, 0x28, 0x9a, 0x20, 0x21,
+	0x21, 0xa4, 0x51, 0xcf, 0x74, 0x6b, 0xb7, 0xed, 0x9d, 0xee, 0xd5, 0x74, 0xaf, 0x1f, 0x7f, 0x80,
+	0x03, 0x27, 0x7e, 0x00, 0x42, 0x1c, 0x78, 0x48, 0x9c, 0xf8, 0x09, 0xdc, 0xc8, 0x09, 0xe5, 0x88,
+	0x72, 0x30, 0xc8, 0x3e, 0x80, 0x38, 0xf2, 0x0b, 0xd0, 0xf4, 0x63, 0xbc, 0x51, 0xbc, 0x88, 0x83,
+	0x13, 0x25, 0x97, 0xdd, 0xa9, 0xea, 0xee, 0xaf, 0xbf, 0xfa, 0xba, 0xaa, 0xba, 0xe1, 0x1a, 0xe3,
+	0xdb, 0x34, 0x53, 0x6c, 0x97, 0x46, 0x78, 0x9c, 0x29, 0x26, 0x78, 0xb4, 0x7b, 0x23, 0xa5, 0x0a,
+	0xdf, 0x70, 0x76, 0x38, 0x2a, 0x84, 0x12, 0xe8, 0x4a, 0x35, 0x31, 0x74, 0x03, 0x76, 0xe2, 0xda,
+	0x6a, 0x5f, 0xf4, 0x85, 0x9e, 0x15, 0x95, 0x5f, 0x66, 0xc1, 0x5a, 0x2b, 0x13, 0x32, 0x17, 0x32,
+	0x4a, 0xb1, 0xa4, 0x15, 0x66, 0x26, 0x98, 0x05, 0x5c, 0x5b, 0xc1, 0x39, 0xe3, 0x22, 0xd2, 0xbf,
+	0xc6, 0x15, 0xfc, 0xe0, 0x43, 0xfd, 0x3e, 0x2e, 0x70, 0x2e, 0xd1, 0x1b, 0xb0, 0x68, 0xb7, 0x49,
+	0x46, 0xb4, 0x60, 0x82, 0x34, 0xbd, 0x8e, 0xd7, 0xf5, 0xe3, 0x05, 0xeb, 0xbd, 0xaf, 0x9d, 0x08,
+	0xc3, 0x7a, 0xce, 0x78, 0xc2, 0xe9, 0xbe, 0x4a, 0x52, 0x46, 0x12, 0xc6, 0xb3, 0x82, 0xe6, 0x94,
+	0xab, 0xa4, 0xc0, 0x8a, 0x36, 0x6b, 0x1d, 0xaf, 0xdb, 0xe8, 0x5d, 0x7d, 0x78, 0xd8, 0x9e, 0x79,
+	0x7c, 0xd8, 0x5e, 0x37, 0x8c, 0x24, 0xd9, 0x09, 0x99, 0x88, 0x72, 0xac, 0x06, 0xe1, 0x16, 0xed,
+	0xe3, 0xec, 0xe0, 0x03, 0x9a, 0xc5, 0x97, 0x73, 0xc6, 0x3f, 0xa2, 0xfb, 0xaa, 0xc7, 0xc8, 0xa6,
+	0x03, 0x89, 0xb1, 0xa2, 0xe8, 0x43, 0x40, 0x8c, 0x6f, 0x27, 0x29, 0x96, 0x3b, 0x54, 0x25, 0x39,
+	0xde, 0x4f, 0x32, 0x3c, 0x6a, 0xfa, 0x1a, 0xf9, 0x35, 0x8b, 0xfc, 0xca, 0xd3, 0xc8, 0x9b, 0x5c,
+	0xc5, 0x4b, 0x8c, 0x6f, 0xf7, 0xf4, 0xba, 0x7b, 0x78, 0x7f, 0x03, 0x8f, 0xd0, 0xdb, 0xb0, 0x92,
+	0x32, 0x42, 0x68, 0x21, 0x93, 0xbd, 0x01, 0x53, 0x74, 0xc8, 0xa4, 0x6a, 0xce, 0x76, 0xfc, 0x6e,
+	0x23, 0x5e, 0xb6, 0x03, 0x9f, 0x38, 0x3f, 0x7a, 0x07, 0x90, 0xa4, 0x78, 0x48, 0x89, 0x8e, 0x8c,
+	0x72, 0x9c, 0x0e, 0x29, 0x69, 0xce, 0x75, 0xbc, 0xee, 0x7c, 0xbc, 0x6c, 0x46, 0x7a, 0x8c, 0xdc,
+	0x31, 0x7e, 0x74, 0x15, 0x16, 0x0a, 0xba, 0x4b, 0xf1, 0xd0, 0xe9, 0x55, 0xd7, 0x7a, 0x5d, 0x30,
+	0x4e, 0x2b, 0x57, 0x08, 0x17, 0x4b, 0xac, 0x01, 0x93, 0x4a, 0x14, 0x07, 0x15, 0xe6, 0x39, 0x8d,
+	0x59, 0x52, 0xbb, 0x6b, 0x46, 0x2c, 0xe8, 0xad, 0xcb, 0x7f, 0x7d, 0xd3, 0xf6, 0xbe, 0xf8, 0xf3,
+	0xa7, 0xb7, 0xdc, 0x61, 0x44, 0xe6, 0x78, 0x82, 0xaf, 0x3d, 0xf0, 0x7b, 0x8c, 0xa0, 0x9b, 0x50,
+	0x37, 0xbc, 0xf5, 0xf1, 0x34, 0x7a, 0xeb, 0x7f, 0x1f, 0xb6, 0xad, 0xe7, 0x9f, 0xc3, 0xf6, 0xc2,
+	0x01, 0xce, 0x87, 0xb7, 0x02, 0x63, 0x07, 0xb1, 0x1d, 0x40, 0x29, 0xd4, 0x71, 0x2e, 0xc6, 0x5c,
+	0xe9, 0xf3, 0x39, 0xff, 0xde, 0x95, 0xd0, 0xc8, 0x17, 0x96, 0xa9, 0xe2, 0xb2, 0x2a, 0xdc, 0x10,
+	0x8c, 0xf7, 0x22, 0x2b, 0xf0, 0xb5, 0x3e, 0x53, 0x83, 0x71, 0x1a, 0x66, 0x22, 0x8f, 0x6c, 0x5e,
+	0x99, 0xbf, 0xeb, 0x92, 0xec, 0x44, 0xea, 0x60, 0x44, 0xa5, 0x5e, 0x10, 0x5b, 0xe4, 0xe0, 0x3b,
+	0x0f, 0x56, 0xb6, 0xb0, 0x54, 0xb7, 0x0d, 0xef, 0x98, 0xca, 0xf1, 0x50, 0xa1, 0x4b, 0x50, 0xdf,
+	0x63, 0x9c, 0x3b, 0xba, 0xb1, 0xb5, 0x9e, 0x07, 0x23, 0xb4, 0x0a, 0x73, 0x85, 0x18, 0x73, 0xa2,
+	0x53, 0x67, 0x36, 0x36, 0x46, 0xf0, 0x95, 0x07, 0xf3, 0x77, 0x76, 0x29, 0x2f, 0xf3, 0xae, 0xa4,
+	0x37, 0xa9, 0xe6, 0xf3, 0x14, 0x6c, 0x0a, 0xbd, 0xef, 0x3d, 0x40, 0x9a, 0xde, 0x8b, 0xae, 0xe3,
+	0xcf, 0x1e, 0xac, 0x4c, 0x12, 0x7d, 0xa0, 0x70, 0x31, 0x31, 0xd7, 0x9b, 0x98, 0x8b, 0xde, 0x84,
+	0x65, 0xca, 0x09, 0xe3, 0xfd, 0x44, 0xb1, 0x9c, 0x4a, 0x85, 0xf3, 0x91, 0xe6, 0xeb, 0xc7, 0x4b,
+	0xc6, 0xff, 0xb1, 0x73, 0xa3, 0x6d, 0x00, 0x4e, 0xf7, 0x6c, 0xf1, 0x37, 0xfd, 0x8e, 0xff, 0xdf,
+	0x41, 0xbd, 0x5b, 0x06, 0xf5, 0xe3, 0xef, 0xed, 0xee, 0xff, 0x0c, 0x4a, 0xc6, 0x0d, 0x4e, 0xf7,
+	0x4c, 0x8b, 0x08, 0x3e, 0x83, 0xc5, 0x07, 0xae, 0xaa, 0x63, 0x4d, 0xf4, 0x74, 0xfa, 0xef, 0xc3,
+	0x25, 0x5b, 0xe9, 0xb2, 0x0c, 0xf2, 0xa9, 0x20, 0x56, 0xcd, 0xa8, 0x56, 0xa0, 0x8a, 0x24, 0x78,
+	0x5c, 0x83, 0x8b, 0x15, 0xfc, 0x86, 0xc8, 0x73, 0xa6, 0xca, 0x16, 0x37, 0x35, 0xe7, 0xaa, 0xbd,
+	0x6b, 0x93, 0x7b, 0xb7, 0x00, 0xb2, 0x6a, 0xad, 0x69, 0x82, 0xf1, 0x84, 0x07, 0x11, 0x38, 0x47,
+	0xe8, 0x48, 0x48, 0x56, 0xb6, 0xb5, 0xb3, 0xce, 0x00, 0x07, 0x8d, 0xd6, 0x60, 0xde, 0xc4, 0x58,
+	0xf5, 0xc3, 0xca, 0x46, 0x12, 0x96, 0xdc, 0x77, 0x62, 0x73, 0xb1, 0x7e, 0xe6, 0x4c, 0x16, 0xdd,
+	0x16, 0xb7, 0x4d, 0xb7, 0xf9, 0xbc, 0x06, 0x8d, 0xf2, 0xd4, 0x68, 0x26, 0x8a, 0x69, 0xc7, 0xb6,
+	0x08, 0x35, 0xe6, 0xd4, 0xac, 0x3d, 0x51, 0xec, 0xfe, 0x94, 0x62, 0x9f, 0x7d, 0x66, 0x35, 0xf4,
+	0x2a, 0x34, 0x4e, 0xb2, 0x66, 0x4e, 0x67, 0xcd, 0x89, 0x03, 0xbd, 0x0e, 0x17, 0xd2, 0xa1, 0xc8,
+	0x76, 0x92, 0x01, 0x65, 0xfd, 0x81, 0xb2, 0x37, 0xc9, 0x79, 0xed, 0xbb, 0xab, 0x5d, 0x25, 0x79,
+	0x73, 0x03, 0xd9, 0xbb, 0xc3, 0x5a, 0xc1, 0xaf, 0x35, 0x40, 0xae, 0x55, 0x94, 0x51, 0xdb, 0x7e,
+	0x71, 0xba, 0x22, 0x27, 0x5d, 0xa4, 0x36, 0xa5, 0x8b, 0xf8, 0xcf, 0x4c, 0x81, 0x0c, 0xea, 0xb6,
+	0xa8, 0x67, 0xcf, 0xbe, 0xa8, 0x2d, 0xf4, 0xa9, 0x8d, 0x66, 0xee, 0xf4, 0x46, 0x73, 0x22, 0x68,
+	0xfd, 0x09, 0x41, 0x7f, 0x71, 0x7d, 0xad, 0xaa, 0x5a, 0x45, 0xc9, 0xcb, 0x58, 0xb4, 0xc1, 0xb7,
+	0x1e, 0x2c, 0xbb, 0x48, 0x62, 0x57, 0xad, 0x2f, 0xdc, 0x8d, 0xd7, 0xeb, 0x3f, 0x3c, 0x6a, 0x79,
+	0x8f, 0x8e, 0x5a, 0xde, 0x1f, 0x47, 0x2d, 0xef, 0xcb, 0xe3, 0xd6, 0xcc, 0xa3, 0xe3, 0xd6, 0xcc,
+	0x6f, 0xc7, 0xad, 0x99, 0x4f, 0xef, 0x4d, 0x6c, 0xb0, 0xe9, 0x1e, 0xc3, 0x5b, 0x38, 0x95, 0x51,
+	0xf5, 0x34, 0xbe, 0x9e, 0x89, 0x82, 0x4e, 0x9a, 0x03, 0xcc, 0x78, 0x94, 0x0b, 0x32, 0x1e, 0x52,
+	0x59, 0x3d, 0xb0, 0x35, 0x97, 0xb4, 0xae, 0xdf, 0xbc, 0x37, 0xff, 0x1d, 0x00, 0xbb, 0x9d, 0xc6,
+	0x17, 0x82, 0x0b, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.AuctionPeriod != that1.AuctionPeriod {
+		return false
+	}
+	if !this.MinNextBidIncrementRate.Equal(that1.MinNextBidIncrementRate) {
+		return false
+	}
+	if !this.InjBasketMaxCap.Equal(that1.InjBasketMaxCap) {
+		return false
+	}
+	if len(this.BiddersWhitelist) != len(that1.BiddersWhitelist) {
+		return false
+	}
+	for i := range this.BiddersWhitelist {
+		if this.BiddersWhitelist[i] != that1.BiddersWhitelist[i] {
+			return false
+		}
+	}
+	if this.SealedBidEnabled != that1.SealedBidEnabled {
+		return false
+	}
+	if this.RevealPeriod != that1.RevealPeriod {
+		return false
+	}
+	if this.BidHistoryEnabled != that1.BidHistoryEnabled {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Round != 0 {
-		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+	if m.BidHistoryEnabled {
 		i--
-		dAtA[i] = 0x18
+		if m.BidHistoryEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.RevealPeriod != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.RevealPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.SealedBidEnabled {
+		i--
+		if m.SealedBidEnabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.BiddersWhitelist) > 0 {
+		for iNdEx := len(m.BiddersWhitelist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BiddersWhitelist[iNdEx])
+			copy(dAtA[i:], m.BiddersWhitelist[iNdEx])
+			i = encodeVarintAuction(dAtA, i, uint64(len(m.BiddersWhitelist[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
 	}
 	{
-		size := m.Amount.Size()
+		size := m.InjBasketMaxCap.Size()
 		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.InjBasketMaxCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MinNextBidIncrementRate.Size()
+		i -= size
+		if _, err := m.MinNextBidIncrementRate.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x12
-	if len(m.Winner) > 0 {
-		i -= len(m.Winner)
-		copy(dAtA[i:], m.Winner)
-		i = encodeVarintAuction(dAtA, i, uint64(len(m.Winner)))
+	if m.AuctionPeriod != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.AuctionPeriod))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventBid) Marshal() (dAtA []byte, err error) {
+func (m *Bid) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -612,21 +1050,16 @@ func (m *EventBid) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventBid) MarshalTo(dAtA []byte) (int, error) {
+func (m *Bid) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *Bid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Round != 0 {
-		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
-		i--
-		dAtA[i] = 0x18
-	}
 	{
 		size := m.Amount.Size()
 		i -= size
@@ -647,7 +1080,7 @@ func (m *EventBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAuctionResult) Marshal() (dAtA []byte, err error) {
+func (m *LastAuctionResult) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -657,12 +1090,12 @@ func (m *EventAuctionResult) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAuctionResult) MarshalTo(dAtA []byte) (int, error) {
+func (m *LastAuctionResult) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAuctionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *LastAuctionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -692,7 +1125,7 @@ func (m *EventAuctionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *EventAuctionStart) Marshal() (dAtA []byte, err error) {
+func (m *EventBid) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -702,173 +1135,1371 @@ func (m *EventAuctionStart) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventAuctionStart) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBid) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventAuctionStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBid) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.NewBasket) > 0 {
-		for iNdEx := len(m.NewBasket) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.NewBasket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintAuction(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if m.EndingTimestamp != 0 {
-		i = encodeVarintAuction(dAtA, i, uint64(m.EndingTimestamp))
-		i--
-		dAtA[i] = 0x10
-	}
 	if m.Round != 0 {
 		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
-	offset -= sovAuction(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventAuctionResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventAuctionResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAuctionResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AuctionPeriod != 0 {
-		n += 1 + sovAuction(uint64(m.AuctionPeriod))
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = m.MinNextBidIncrementRate.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	l = m.InjBasketMaxCap.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	if len(m.BiddersWhitelist) > 0 {
-		for _, s := range m.BiddersWhitelist {
-			l = len(s)
-			n += 1 + l + sovAuction(uint64(l))
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	return n
+	i--
+	dAtA[i] = 0x12
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *Bid) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+func (m *EventAuctionStart) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *LastAuctionResult) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *EventAuctionStart) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAuctionStart) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Winner)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	if len(m.NewBasket) > 0 {
+		for iNdEx := len(m.NewBasket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.NewBasket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.EndingTimestamp != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.EndingTimestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovAuction(uint64(l))
 	if m.Round != 0 {
-		n += 1 + sovAuction(uint64(m.Round))
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBid) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SealedBidRound) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidRound) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidRound) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Bidder)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	if m.RevealStartTimestamp != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.RevealStartTimestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovAuction(uint64(l))
 	if m.Round != 0 {
-		n += 1 + sovAuction(uint64(m.Round))
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventAuctionResult) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *SealedBidCommitment) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *SealedBidCommitment) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SealedBidCommitment) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Winner)
-	if l > 0 {
-		n += 1 + l + sovAuction(uint64(l))
+	{
+		size := m.RevealedAmount.Size()
+		i -= size
+		if _, err := m.RevealedAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovAuction(uint64(l))
-	if m.Round != 0 {
-		n += 1 + sovAuction(uint64(m.Round))
+	i--
+	dAtA[i] = 0x32
+	if m.Revealed {
+		i--
+		if m.Revealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
 	}
-	return n
-}
-
-func (m *EventAuctionStart) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	var l int
-	_ = l
 	if m.Round != 0 {
-		n += 1 + sovAuction(uint64(m.Round))
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.EndingTimestamp != 0 {
-		n += 1 + sovAuction(uint64(m.EndingTimestamp))
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
 	}
-	if len(m.NewBasket) > 0 {
-		for _, e := range m.NewBasket {
-			l = e.Size()
-			n += 1 + l + sovAuction(uint64(l))
-		}
+	return len(dAtA) - i, nil
+}
+
+func (m *BidRecord) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func sovAuction(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *BidRecord) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
-func sozAuction(x uint64) (n int) {
+
+func (m *BidRecord) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sealed {
+		i--
+		if m.Sealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Id != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *AuctionRoundResult) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AuctionRoundResult) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AuctionRoundResult) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Sealed {
+		i--
+		if m.Sealed {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.EndingTimestamp != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.EndingTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Basket) > 0 {
+		for iNdEx := len(m.Basket) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Basket[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintAuction(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Winner) > 0 {
+		i -= len(m.Winner)
+		copy(dAtA[i:], m.Winner)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Winner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBidCommitted) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBidCommitted) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBidCommitted) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Deposit.Size()
+		i -= size
+		if _, err := m.Deposit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	if len(m.Commitment) > 0 {
+		i -= len(m.Commitment)
+		copy(dAtA[i:], m.Commitment)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Commitment)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventBidRevealed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBidRevealed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBidRevealed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Round != 0 {
+		i = encodeVarintAuction(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuction(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Bidder) > 0 {
+		i -= len(m.Bidder)
+		copy(dAtA[i:], m.Bidder)
+		i = encodeVarintAuction(dAtA, i, uint64(len(m.Bidder)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintAuction(dAtA []byte, offset int, v uint64) int {
+	offset -= sovAuction(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AuctionPeriod != 0 {
+		n += 1 + sovAuction(uint64(m.AuctionPeriod))
+	}
+	l = m.MinNextBidIncrementRate.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	l = m.InjBasketMaxCap.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if len(m.BiddersWhitelist) > 0 {
+		for _, s := range m.BiddersWhitelist {
+			l = len(s)
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	if m.SealedBidEnabled {
+		n += 2
+	}
+	if m.RevealPeriod != 0 {
+		n += 1 + sovAuction(uint64(m.RevealPeriod))
+	}
+	if m.BidHistoryEnabled {
+		n += 2
+	}
+	return n
+}
+
+func (m *Bid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *LastAuctionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *EventBid) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *EventAuctionResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	return n
+}
+
+func (m *EventAuctionStart) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	if m.EndingTimestamp != 0 {
+		n += 1 + sovAuction(uint64(m.EndingTimestamp))
+	}
+	if len(m.NewBasket) > 0 {
+		for _, e := range m.NewBasket {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *SealedBidRound) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	if m.RevealStartTimestamp != 0 {
+		n += 1 + sovAuction(uint64(m.RevealStartTimestamp))
+	}
+	return n
+}
+
+func (m *SealedBidCommitment) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Revealed {
+		n += 2
+	}
+	l = m.RevealedAmount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *BidRecord) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	if m.Id != 0 {
+		n += 1 + sovAuction(uint64(m.Id))
+	}
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Timestamp != 0 {
+		n += 1 + sovAuction(uint64(m.Timestamp))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovAuction(uint64(m.BlockHeight))
+	}
+	if m.Sealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *AuctionRoundResult) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	l = len(m.Winner)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if len(m.Basket) > 0 {
+		for _, e := range m.Basket {
+			l = e.Size()
+			n += 1 + l + sovAuction(uint64(l))
+		}
+	}
+	if m.EndingTimestamp != 0 {
+		n += 1 + sovAuction(uint64(m.EndingTimestamp))
+	}
+	if m.Sealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventBidCommitted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	l = len(m.Commitment)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	return n
+}
+
+func (m *EventBidRevealed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Bidder)
+	if l > 0 {
+		n += 1 + l + sovAuction(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuction(uint64(l))
+	if m.Round != 0 {
+		n += 1 + sovAuction(uint64(m.Round))
+	}
+	return n
+}
+
+func sovAuction(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozAuction(x uint64) (n int) {
 	return sovAuction(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionPeriod", wireType)
+			}
+			m.AuctionPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinNextBidIncrementRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinNextBidIncrementRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InjBasketMaxCap", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InjBasketMaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BiddersWhitelist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BiddersWhitelist = append(m.BiddersWhitelist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.SealedBidEnabled = bool(v != 0)
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealPeriod", wireType)
+			}
+			m.RevealPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealPeriod |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistoryEnabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BidHistoryEnabled = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Bid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: LastAuctionResult: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: LastAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -891,17 +2522,151 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAuctionResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Winner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAuctionStart: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAuctionStart: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionPeriod", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			m.AuctionPeriod = 0
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -911,16 +2676,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AuctionPeriod |= int64(b&0x7F) << shift
+				m.Round |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinNextBidIncrementRate", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.EndingTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -930,31 +2695,16 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EndingTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinNextBidIncrementRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InjBasketMaxCap", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewBasket", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -964,31 +2714,81 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InjBasketMaxCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.NewBasket = append(m.NewBasket, types.Coin{})
+			if err := m.NewBasket[len(m.NewBasket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BiddersWhitelist", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuction(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			var stringLen uint64
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SealedBidRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuction
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SealedBidRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SealedBidRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -998,24 +2798,30 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Round |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthAuction
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthAuction
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealStartTimestamp", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.RevealStartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RevealStartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.BiddersWhitelist = append(m.BiddersWhitelist, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1037,7 +2843,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Bid) Unmarshal(dAtA []byte) error {
+func (m *SealedBidCommitment) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1060,10 +2866,10 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Bid: wiretype end group for non-group")
+			return fmt.Errorf("proto: SealedBidCommitment: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Bid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SealedBidCommitment: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1099,8 +2905,59 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1127,7 +2984,60 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Revealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Revealed = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RevealedAmount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RevealedAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1152,7 +3062,7 @@ func (m *Bid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
+func (m *BidRecord) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1175,15 +3085,53 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: LastAuctionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: BidRecord: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: LastAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BidRecord: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1211,9 +3159,9 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1246,11 +3194,11 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
 			}
-			m.Round = 0
+			m.Timestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1260,11 +3208,50 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Sealed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1286,7 +3273,7 @@ func (m *LastAuctionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBid) Unmarshal(dAtA []byte) error {
+func (m *AuctionRoundResult) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1309,15 +3296,34 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBid: wiretype end group for non-group")
+			return fmt.Errorf("proto: AuctionRoundResult: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AuctionRoundResult: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1345,9 +3351,9 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Bidder = string(dAtA[iNdEx:postIndex])
+			m.Winner = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
@@ -1380,11 +3386,45 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Basket", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Basket = append(m.Basket, types.Coin{})
+			if err := m.Basket[len(m.Basket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EndingTimestamp", wireType)
 			}
-			m.Round = 0
+			m.EndingTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1394,11 +3434,31 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				m.EndingTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			m.Sealed = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1420,7 +3480,7 @@ func (m *EventBid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
+func (m *EventBidCommitted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1443,15 +3503,15 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAuctionResult: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBidCommitted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAuctionResult: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBidCommitted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Winner", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1479,13 +3539,32 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Winner = string(dAtA[iNdEx:postIndex])
+			m.Bidder = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitment", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1495,30 +3574,29 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthAuction
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthAuction
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Commitment = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
 			}
-			m.Round = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1528,11 +3606,25 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthAuction
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -1554,7 +3646,7 @@ func (m *EventAuctionResult) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
+func (m *EventBidRevealed) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1577,17 +3669,17 @@ func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAuctionStart: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventBidRevealed: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAuctionStart: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventBidRevealed: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Bidder", wireType)
 			}
-			m.Round = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowAuction
@@ -1597,33 +3689,27 @@ func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EndingTimestamp", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuction
 			}
-			m.EndingTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowAuction
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EndingTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuction
 			}
-		case 3:
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Bidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewBasket", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1650,11 +3736,29 @@ func (m *EventAuctionStart) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewBasket = append(m.NewBasket, types.Coin{})
-			if err := m.NewBasket[len(m.NewBasket)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuction
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuction(dAtA[iNdEx:])
@@ -5,4 +5,11 @@ import "cosmossdk.io/errors"
 var (
 	ErrBidInvalid = errors.Register(ModuleName, 1, "invalid bid denom")
 	ErrBidRound   = errors.Register(ModuleName, 2, "invalid bid round")
+
+	ErrInvalidBidPhase        = errors.Register(ModuleName, 3, "bid not allowed in current auction phase")
+	ErrCommitmentNotFound     = errors.Register(ModuleName, 4, "sealed bid commitment not found")
+	ErrInvalidBidReveal       = errors.Register(ModuleName, 5, "revealed bid does not match commitment")
+	ErrBidAlreadyRevealed     = errors.Register(ModuleName, 6, "sealed bid already revealed")
+	ErrInvalidCommitment      = errors.Register(ModuleName, 7, "invalid sealed bid commitment")
+	ErrInsufficientBidDeposit = errors.Register(ModuleName, 8, "bid deposit does not cover bid amount")
 )
@@ -1,5 +1,11 @@
 package types
 
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 func NewGenesisState() GenesisState {
 	return GenesisState{}
 }
@@ -8,6 +14,17 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	for _, commitment := range gs.SealedBidCommitments {
+		if gs.SealedBidRound == nil || commitment.Round != gs.SealedBidRound.Round {
+			return fmt.Errorf("sealed bid commitment of %s does not belong to the current sealed-bid round", commitment.Bidder)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(commitment.Bidder); err != nil {
+			return err
+		}
+	}
+
 	return nil
 }
 
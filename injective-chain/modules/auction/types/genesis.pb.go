@@ -35,6 +35,14 @@ type GenesisState struct {
 	AuctionEndingTimestamp int64 `protobuf:"varint,4,opt,name=auction_ending_timestamp,json=auctionEndingTimestamp,proto3" json:"auction_ending_timestamp,omitempty"`
 	// last auction result
 	LastAuctionResult *LastAuctionResult `protobuf:"bytes,5,opt,name=last_auction_result,json=lastAuctionResult,proto3" json:"last_auction_result,omitempty"`
+	// sealed-bid mode of the current auction round, if any
+	SealedBidRound *SealedBidRound `protobuf:"bytes,6,opt,name=sealed_bid_round,json=sealedBidRound,proto3" json:"sealed_bid_round,omitempty"`
+	// bid commitments of the current sealed-bid round
+	SealedBidCommitments []SealedBidCommitment `protobuf:"bytes,7,rep,name=sealed_bid_commitments,json=sealedBidCommitments,proto3" json:"sealed_bid_commitments"`
+	// recorded bids of all auction rounds
+	BidHistory []BidRecord `protobuf:"bytes,8,rep,name=bid_history,json=bidHistory,proto3" json:"bid_history"`
+	// recorded results of all settled auction rounds
+	RoundResults []AuctionRoundResult `protobuf:"bytes,9,rep,name=round_results,json=roundResults,proto3" json:"round_results"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -105,6 +113,34 @@ func (m *GenesisState) GetLastAuctionResult() *LastAuctionResult {
 	return nil
 }
 
+func (m *GenesisState) GetSealedBidRound() *SealedBidRound {
+	if m != nil {
+		return m.SealedBidRound
+	}
+	return nil
+}
+
+func (m *GenesisState) GetSealedBidCommitments() []SealedBidCommitment {
+	if m != nil {
+		return m.SealedBidCommitments
+	}
+	return nil
+}
+
+func (m *GenesisState) GetBidHistory() []BidRecord {
+	if m != nil {
+		return m.BidHistory
+	}
+	return nil
+}
+
+func (m *GenesisState) GetRoundResults() []AuctionRoundResult {
+	if m != nil {
+		return m.RoundResults
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.auction.v1beta1.GenesisState")
 }
@@ -114,29 +150,37 @@ func init() {
 }
 
 var fileDescriptor_56f095f457353f49 = []byte{
-	// 350 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x91, 0xc1, 0x4a, 0xeb, 0x40,
-	0x14, 0x86, 0x93, 0xb6, 0xb7, 0x8b, 0x69, 0xef, 0xe2, 0xe6, 0x8a, 0xc4, 0x2e, 0x62, 0xd5, 0x85,
-	0x5d, 0x68, 0x42, 0x75, 0xe3, 0xae, 0x18, 0x10, 0x11, 0x2a, 0x48, 0x74, 0x25, 0x42, 0x98, 0x24,
-	0xc3, 0x64, 0x24, 0x99, 0x09, 0x99, 0x93, 0x82, 0x6f, 0xe1, 0x63, 0x75, 0x23, 0x74, 0xe9, 0x4a,
-	0xa4, 0x7d, 0x11, 0xe9, 0x74, 0x12, 0x44, 0xb0, 0xbb, 0x33, 0xff, 0xf9, 0xcf, 0xf9, 0xfe, 0xe1,
-	0xa0, 0x63, 0xc6, 0x9f, 0x49, 0x0c, 0x6c, 0x46, 0x3c, 0x5c, 0xc5, 0xc0, 0x04, 0xf7, 0x66, 0xe3,
-	0x88, 0x00, 0x1e, 0x7b, 0x94, 0x70, 0x22, 0x99, 0x74, 0x8b, 0x52, 0x80, 0xb0, 0xf6, 0x1a, 0xa3,
-	0xab, 0x8d, 0xae, 0x36, 0x0e, 0xb6, 0xec, 0xa8, 0xad, 0x6a, 0xc7, 0x60, 0x87, 0x0a, 0x2a, 0x54,
-	0xe9, 0xad, 0xab, 0x8d, 0x7a, 0xf8, 0xd6, 0x42, 0xfd, 0xeb, 0x0d, 0xeb, 0x1e, 0x30, 0x10, 0x6b,
-	0x82, 0xba, 0x05, 0x2e, 0x71, 0x2e, 0x6d, 0x73, 0x68, 0x8e, 0x7a, 0x67, 0x07, 0xee, 0xaf, 0x6c,
-	0xf7, 0x4e, 0x19, 0xfd, 0xce, 0xfc, 0x63, 0xdf, 0x08, 0xf4, 0x98, 0x75, 0x84, 0xfe, 0x6a, 0x5f,
-	0x58, 0x8a, 0x8a, 0x27, 0x76, 0x6b, 0x68, 0x8e, 0x3a, 0x41, 0x5f, 0x8b, 0xc1, 0x5a, 0xb3, 0x26,
-	0xa8, 0x97, 0x32, 0x9a, 0x12, 0x09, 0x61, 0xc4, 0x12, 0xbb, 0xad, 0x50, 0xce, 0x16, 0x94, 0xcf,
-	0x92, 0x00, 0xe9, 0x11, 0x9f, 0x25, 0xd6, 0x05, 0xb2, 0x6b, 0x0a, 0xe1, 0x09, 0xe3, 0x34, 0x04,
-	0x96, 0x13, 0x09, 0x38, 0x2f, 0xec, 0xce, 0xd0, 0x1c, 0xb5, 0x83, 0x5d, 0xdd, 0xbf, 0x52, 0xed,
-	0x87, 0xba, 0x6b, 0x3d, 0xa1, 0xff, 0x19, 0x96, 0x10, 0x36, 0x21, 0x89, 0xac, 0x32, 0xb0, 0xff,
-	0xa8, 0x08, 0x27, 0x5b, 0x22, 0x4c, 0xb1, 0x84, 0x4b, 0xfd, 0x09, 0x35, 0x13, 0xfc, 0xcb, 0x7e,
-	0x4a, 0x3e, 0x9d, 0x2f, 0x1d, 0x73, 0xb1, 0x74, 0xcc, 0xcf, 0xa5, 0x63, 0xbe, 0xae, 0x1c, 0x63,
-	0xb1, 0x72, 0x8c, 0xf7, 0x95, 0x63, 0x3c, 0xde, 0x52, 0x06, 0x69, 0x15, 0xb9, 0xb1, 0xc8, 0xbd,
-	0x9b, 0x1a, 0x32, 0xc5, 0x91, 0xf4, 0x1a, 0xe4, 0x69, 0x2c, 0x4a, 0xf2, 0xfd, 0x99, 0x62, 0xc6,
-	0xbd, 0x5c, 0x24, 0x55, 0x46, 0x64, 0x73, 0x5e, 0x78, 0x29, 0x88, 0x8c, 0xba, 0xea, 0x7e, 0xe7,
-	0x5f, 0x01, 0x00, 0x00, 0xff, 0xff, 0x91, 0x86, 0x72, 0xfe, 0x44, 0x02, 0x00, 0x00,
+	// 467 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0xcd, 0x6e, 0xd3, 0x40,
+	0x10, 0x8e, 0x49, 0x1a, 0x60, 0x93, 0x22, 0x58, 0xaa, 0xca, 0xf4, 0x60, 0xc2, 0x8f, 0x44, 0x90,
+	0xa8, 0xad, 0x96, 0x0b, 0xb7, 0xaa, 0x41, 0x08, 0x10, 0x45, 0x42, 0x0e, 0x07, 0x84, 0x90, 0xa2,
+	0xb5, 0x77, 0x64, 0x6f, 0x65, 0x7b, 0x23, 0xcf, 0xb8, 0x52, 0xdf, 0x82, 0x97, 0xe1, 0x1d, 0x7a,
+	0xec, 0x91, 0x13, 0x42, 0xc9, 0x8b, 0x20, 0x6f, 0x36, 0x26, 0x80, 0x30, 0xdc, 0xbc, 0xdf, 0x7c,
+	0x3f, 0x33, 0xb3, 0x5e, 0xf6, 0x48, 0x15, 0xa7, 0x10, 0x93, 0x3a, 0x83, 0x40, 0x54, 0x31, 0x29,
+	0x5d, 0x04, 0x67, 0x07, 0x11, 0x90, 0x38, 0x08, 0x12, 0x28, 0x00, 0x15, 0xfa, 0xf3, 0x52, 0x93,
+	0xe6, 0x77, 0x1a, 0xa2, 0x6f, 0x89, 0xbe, 0x25, 0xee, 0xb5, 0x78, 0xac, 0xa9, 0xc6, 0x63, 0x6f,
+	0x27, 0xd1, 0x89, 0x36, 0x9f, 0x41, 0xfd, 0xb5, 0x42, 0xef, 0x7f, 0xd9, 0x62, 0xc3, 0x97, 0xab,
+	0xac, 0x29, 0x09, 0x02, 0x7e, 0xc4, 0xfa, 0x73, 0x51, 0x8a, 0x1c, 0x5d, 0x67, 0xe4, 0x8c, 0x07,
+	0x87, 0xf7, 0xfc, 0xbf, 0x66, 0xfb, 0xef, 0x0c, 0x71, 0xd2, 0xbb, 0xf8, 0x76, 0xb7, 0x13, 0x5a,
+	0x19, 0x7f, 0xc0, 0xb6, 0x2d, 0x6f, 0x56, 0xea, 0xaa, 0x90, 0xee, 0x95, 0x91, 0x33, 0xee, 0x85,
+	0x43, 0x0b, 0x86, 0x35, 0xc6, 0x8f, 0xd8, 0x20, 0x55, 0x49, 0x0a, 0x48, 0xb3, 0x48, 0x49, 0xb7,
+	0x6b, 0xa2, 0xbc, 0x96, 0xa8, 0x89, 0x92, 0x21, 0xb3, 0x92, 0x89, 0x92, 0xfc, 0x19, 0x73, 0xd7,
+	0x29, 0x50, 0x48, 0x55, 0x24, 0x33, 0x52, 0x39, 0x20, 0x89, 0x7c, 0xee, 0xf6, 0x46, 0xce, 0xb8,
+	0x1b, 0xee, 0xda, 0xfa, 0x0b, 0x53, 0x7e, 0xbf, 0xae, 0xf2, 0x4f, 0xec, 0x76, 0x26, 0x90, 0x66,
+	0x4d, 0x93, 0x80, 0x55, 0x46, 0xee, 0x96, 0x69, 0xe1, 0x49, 0x4b, 0x0b, 0x27, 0x02, 0xe9, 0xd8,
+	0x0e, 0x61, 0x34, 0xe1, 0xad, 0xec, 0x77, 0x88, 0x4f, 0xd9, 0x4d, 0x04, 0x91, 0x81, 0xac, 0xe7,
+	0xb2, 0x0b, 0xe8, 0x1b, 0xeb, 0xc7, 0x2d, 0xd6, 0x53, 0x23, 0xa9, 0x67, 0xac, 0x05, 0xe1, 0x0d,
+	0xfc, 0xe5, 0xcc, 0x4f, 0xd9, 0xee, 0x86, 0x69, 0xac, 0xf3, 0x5c, 0x51, 0x0e, 0x05, 0xa1, 0x7b,
+	0x75, 0xd4, 0x1d, 0x0f, 0x0e, 0xfd, 0xff, 0xb1, 0x7e, 0xde, 0xc8, 0xec, 0x85, 0xed, 0xe0, 0x9f,
+	0x25, 0xe4, 0x6f, 0xd8, 0xa0, 0x0e, 0x49, 0x15, 0x92, 0x2e, 0xcf, 0xdd, 0x6b, 0x26, 0xe0, 0xe1,
+	0x3f, 0x6e, 0x06, 0x62, 0x5d, 0x4a, 0x6b, 0xcb, 0x22, 0x25, 0x5f, 0xad, 0xd4, 0xfc, 0x03, 0xdb,
+	0x36, 0x2b, 0xb0, 0x4b, 0x46, 0xf7, 0xba, 0xb1, 0xdb, 0x6f, 0xb1, 0x3b, 0xde, 0xf8, 0x4d, 0x56,
+	0x3b, 0xb5, 0xbe, 0xc3, 0xf2, 0x27, 0x84, 0x93, 0xe4, 0x62, 0xe1, 0x39, 0x97, 0x0b, 0xcf, 0xf9,
+	0xbe, 0xf0, 0x9c, 0xcf, 0x4b, 0xaf, 0x73, 0xb9, 0xf4, 0x3a, 0x5f, 0x97, 0x5e, 0xe7, 0xe3, 0xdb,
+	0x44, 0x51, 0x5a, 0x45, 0x7e, 0xac, 0xf3, 0xe0, 0xf5, 0x3a, 0xe6, 0x44, 0x44, 0x18, 0x34, 0xa1,
+	0xfb, 0xb1, 0x2e, 0x61, 0xf3, 0x98, 0x0a, 0x55, 0x04, 0xb9, 0x96, 0x55, 0x06, 0xd8, 0x3c, 0x23,
+	0x3a, 0x9f, 0x03, 0x46, 0x7d, 0xf3, 0x4e, 0x9e, 0xfe, 0x18, 0x00, 0xc2, 0xb2, 0xfb, 0x04, 0xac,
+	0x03, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -159,6 +203,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RoundResults) > 0 {
+		for iNdEx := len(m.RoundResults) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoundResults[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	if len(m.BidHistory) > 0 {
+		for iNdEx := len(m.BidHistory) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BidHistory[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	if len(m.SealedBidCommitments) > 0 {
+		for iNdEx := len(m.SealedBidCommitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SealedBidCommitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.SealedBidRound != nil {
+		{
+			size, err := m.SealedBidRound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
 	if m.LastAuctionResult != nil {
 		{
 			size, err := m.LastAuctionResult.MarshalToSizedBuffer(dAtA[:i])
@@ -239,6 +337,28 @@ func (m *GenesisState) Size() (n int) {
 		l = m.LastAuctionResult.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	if m.SealedBidRound != nil {
+		l = m.SealedBidRound.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.SealedBidCommitments) > 0 {
+		for _, e := range m.SealedBidCommitments {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.BidHistory) > 0 {
+		for _, e := range m.BidHistory {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.RoundResults) > 0 {
+		for _, e := range m.RoundResults {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -420,6 +540,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidRound", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SealedBidRound == nil {
+				m.SealedBidRound = &SealedBidRound{}
+			}
+			if err := m.SealedBidRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidCommitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SealedBidCommitments = append(m.SealedBidCommitments, SealedBidCommitment{})
+			if err := m.SealedBidCommitments[len(m.SealedBidCommitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BidHistory", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BidHistory = append(m.BidHistory, BidRecord{})
+			if err := m.BidHistory[len(m.BidHistory)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundResults", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoundResults = append(m.RoundResults, AuctionRoundResult{})
+			if err := m.RoundResults[len(m.RoundResults)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
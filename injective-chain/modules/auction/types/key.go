@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	ModuleName = "auction"
 	StoreKey   = ModuleName
@@ -12,5 +16,36 @@ var (
 	AuctionRoundKey      = []byte{0x03}
 	KeyEndingTimeStamp   = []byte{0x04}
 	KeyLastAuctionResult = []byte{0x05}
-	ParamsKey            = []byte{0x10}
+	KeySealedBidRound    = []byte{0x06}
+
+	SealedBidCommitmentsPrefix = []byte{0x07}
+	BidHistoryPrefix           = []byte{0x08}
+	AuctionRoundResultsPrefix  = []byte{0x09}
+
+	ParamsKey = []byte{0x10}
 )
+
+// GetSealedBidCommitmentPrefix provides the prefix key to obtain the sealed bid commitments of an auction round
+func GetSealedBidCommitmentPrefix(round uint64) []byte {
+	return append(SealedBidCommitmentsPrefix, sdk.Uint64ToBigEndian(round)...)
+}
+
+// GetSealedBidCommitmentKey provides the key to obtain the sealed bid commitment of a bidder in an auction round
+func GetSealedBidCommitmentKey(round uint64, bidder sdk.AccAddress) []byte {
+	return append(GetSealedBidCommitmentPrefix(round), bidder.Bytes()...)
+}
+
+// GetBidHistoryPrefix provides the prefix key to obtain the recorded bids of an auction round
+func GetBidHistoryPrefix(round uint64) []byte {
+	return append(BidHistoryPrefix, sdk.Uint64ToBigEndian(round)...)
+}
+
+// GetBidRecordKey provides the key to obtain a recorded bid of an auction round
+func GetBidRecordKey(round, id uint64) []byte {
+	return append(GetBidHistoryPrefix(round), sdk.Uint64ToBigEndian(id)...)
+}
+
+// GetAuctionRoundResultKey provides the key to obtain the result of a settled auction round
+func GetAuctionRoundResultKey(round uint64) []byte {
+	return append(AuctionRoundResultsPrefix, sdk.Uint64ToBigEndian(round)...)
+}
@@ -1,6 +1,9 @@
 package types
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
@@ -12,11 +15,15 @@ const (
 	RouterKey = ModuleName
 
 	TypeMsgBid          = "bid"
+	TypeMsgCommitBid    = "commitBid"
+	TypeMsgRevealBid    = "revealBid"
 	TypeMsgUpdateParams = "updateParams"
 )
 
 var (
 	_ sdk.Msg = &MsgBid{}
+	_ sdk.Msg = &MsgCommitBid{}
+	_ sdk.Msg = &MsgRevealBid{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -93,3 +100,91 @@ func (msg MsgBid) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgCommitBid) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgCommitBid) Type() string { return TypeMsgCommitBid }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgCommitBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if bz, err := hex.DecodeString(msg.Commitment); err != nil || len(bz) != sha256.Size {
+		return errors.Wrapf(ErrInvalidCommitment, "commitment must be a hex encoded sha256 hash: %s", msg.Commitment)
+	}
+
+	if !msg.Deposit.IsValid() || !msg.Deposit.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.Deposit.String())
+	}
+
+	if msg.Deposit.Denom != chaintypes.InjectiveCoin {
+		return errors.Wrap(ErrBidInvalid, msg.Deposit.Denom)
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCommitBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgCommitBid) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgRevealBid) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgRevealBid) Type() string { return TypeMsgRevealBid }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgRevealBid) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, err.Error())
+	}
+
+	if !msg.BidAmount.IsValid() || !msg.BidAmount.IsPositive() {
+		return errors.Wrap(sdkerrors.ErrInvalidCoins, msg.BidAmount.String())
+	}
+
+	if msg.BidAmount.Denom != chaintypes.InjectiveCoin {
+		return errors.Wrap(ErrBidInvalid, msg.BidAmount.Denom)
+	}
+
+	if msg.Salt == "" {
+		return errors.Wrap(ErrInvalidBidReveal, "salt cannot be empty")
+	}
+
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgRevealBid) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgRevealBid) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// ComputeBidCommitment returns the hex encoded commitment of a sealed bid, i.e. sha256(bidder|amount|salt)
+func ComputeBidCommitment(bidder string, amount sdk.Coin, salt string) string {
+	hash := sha256.Sum256([]byte(bidder + "|" + amount.String() + "|" + salt))
+	return hex.EncodeToString(hash[:])
+}
@@ -21,6 +21,12 @@ var (
 	DefaultInjBasketMaxCap = math.NewIntWithDecimal(10_000, 18)
 	// DefaultBiddersWhitelist represents default bidders whitelist (empty = all allowed)
 	DefaultBiddersWhitelist = []string{}
+	// DefaultSealedBidEnabled represents default sealed-bid mode (disabled = open auction)
+	DefaultSealedBidEnabled = false
+	// DefaultRevealPeriod represents the number of seconds in 1 day
+	DefaultRevealPeriod int64 = 60 * 60 * 24
+	// DefaultBidHistoryEnabled represents default bid history recording
+	DefaultBidHistoryEnabled = true
 )
 
 // Parameter keys
@@ -29,6 +35,9 @@ var (
 	KeyMinNextBidIncrementRate = []byte("MinNextBidIncrementRate")
 	KeyInjBasketMaxCap         = []byte("InjBasketMaxCap")
 	KeyBiddersWhitelist        = []byte("BiddersWhitelist")
+	KeySealedBidEnabled        = []byte("SealedBidEnabled")
+	KeyRevealPeriod            = []byte("RevealPeriod")
+	KeyBidHistoryEnabled       = []byte("BidHistoryEnabled")
 )
 
 // ParamKeyTable returns the parameter key table.
@@ -42,12 +51,18 @@ func NewParams(
 	minNextBidIncrementRate math.LegacyDec,
 	injBasketMaxCap math.Int,
 	biddersWhitelist []string,
+	sealedBidEnabled bool,
+	revealPeriod int64,
+	bidHistoryEnabled bool,
 ) Params {
 	return Params{
 		AuctionPeriod:           auctionPeriod,
 		MinNextBidIncrementRate: minNextBidIncrementRate,
 		InjBasketMaxCap:         injBasketMaxCap,
 		BiddersWhitelist:        biddersWhitelist,
+		SealedBidEnabled:        sealedBidEnabled,
+		RevealPeriod:            revealPeriod,
+		BidHistoryEnabled:       bidHistoryEnabled,
 	}
 }
 
@@ -58,6 +73,9 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 		paramtypes.NewParamSetPair(KeyMinNextBidIncrementRate, &p.MinNextBidIncrementRate, validateMinNextBidIncrementRate),
 		paramtypes.NewParamSetPair(KeyInjBasketMaxCap, &p.InjBasketMaxCap, validateInjBasketMaxCap),
 		paramtypes.NewParamSetPair(KeyBiddersWhitelist, &p.BiddersWhitelist, validateBiddersWhitelist),
+		paramtypes.NewParamSetPair(KeySealedBidEnabled, &p.SealedBidEnabled, validateBool),
+		paramtypes.NewParamSetPair(KeyRevealPeriod, &p.RevealPeriod, validateRevealPeriod),
+		paramtypes.NewParamSetPair(KeyBidHistoryEnabled, &p.BidHistoryEnabled, validateBool),
 	}
 }
 
@@ -68,6 +86,9 @@ func DefaultParams() Params {
 		MinNextBidIncrementRate: DefaultMinNextBidIncrementRate,
 		InjBasketMaxCap:         DefaultInjBasketMaxCap,
 		BiddersWhitelist:        DefaultBiddersWhitelist,
+		SealedBidEnabled:        DefaultSealedBidEnabled,
+		RevealPeriod:            DefaultRevealPeriod,
+		BidHistoryEnabled:       DefaultBidHistoryEnabled,
 	}
 }
 
@@ -85,7 +106,25 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return validateBiddersWhitelist(p.BiddersWhitelist)
+	if err := validateBiddersWhitelist(p.BiddersWhitelist); err != nil {
+		return err
+	}
+
+	if err := validateRevealPeriod(p.RevealPeriod); err != nil {
+		return err
+	}
+
+	if p.SealedBidEnabled {
+		if p.RevealPeriod == 0 {
+			return errors.New("RevealPeriod must be positive when sealed bids are enabled")
+		}
+
+		if p.RevealPeriod >= p.AuctionPeriod {
+			return fmt.Errorf("RevealPeriod must be less than AuctionPeriod: %d >= %d", p.RevealPeriod, p.AuctionPeriod)
+		}
+	}
+
+	return nil
 }
 
 func validateAuctionPeriodDuration(i interface{}) error {
@@ -163,3 +202,24 @@ func validateBiddersWhitelist(i any) error {
 
 	return nil
 }
+
+func validateRevealPeriod(i interface{}) error {
+	v, ok := i.(int64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	if v < 0 {
+		return fmt.Errorf("RevealPeriod cannot be negative: %d", v)
+	}
+
+	return nil
+}
+
+func validateBool(i interface{}) error {
+	if _, ok := i.(bool); !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return nil
+}
@@ -9,6 +9,7 @@ import (
 	fmt "fmt"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -394,6 +395,307 @@ func (m *QueryLastAuctionResultResponse) GetLastAuctionResult() *LastAuctionResu
 	return nil
 }
 
+// QueryAuctionBidHistoryRequest is the request type for the
+// Query/AuctionBidHistory RPC method.
+type QueryAuctionBidHistoryRequest struct {
+	Round      uint64             `protobuf:"varint,1,opt,name=round,proto3" json:"round,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionBidHistoryRequest) Reset()         { *m = QueryAuctionBidHistoryRequest{} }
+func (m *QueryAuctionBidHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidHistoryRequest) ProtoMessage()    {}
+func (*QueryAuctionBidHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{8}
+}
+func (m *QueryAuctionBidHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionBidHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionBidHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionBidHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidHistoryRequest.Merge(m, src)
+}
+func (m *QueryAuctionBidHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionBidHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionBidHistoryRequest) GetRound() uint64 {
+	if m != nil {
+		return m.Round
+	}
+	return 0
+}
+
+func (m *QueryAuctionBidHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionBidHistoryResponse is the response type for the
+// Query/AuctionBidHistory RPC method.
+type QueryAuctionBidHistoryResponse struct {
+	Bids       []BidRecord         `protobuf:"bytes,1,rep,name=bids,proto3" json:"bids"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionBidHistoryResponse) Reset()         { *m = QueryAuctionBidHistoryResponse{} }
+func (m *QueryAuctionBidHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionBidHistoryResponse) ProtoMessage()    {}
+func (*QueryAuctionBidHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{9}
+}
+func (m *QueryAuctionBidHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionBidHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionBidHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionBidHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionBidHistoryResponse.Merge(m, src)
+}
+func (m *QueryAuctionBidHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionBidHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionBidHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionBidHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionBidHistoryResponse) GetBids() []BidRecord {
+	if m != nil {
+		return m.Bids
+	}
+	return nil
+}
+
+func (m *QueryAuctionBidHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionRoundResultsRequest is the request type for the
+// Query/AuctionRoundResults RPC method.
+type QueryAuctionRoundResultsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionRoundResultsRequest) Reset()         { *m = QueryAuctionRoundResultsRequest{} }
+func (m *QueryAuctionRoundResultsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRoundResultsRequest) ProtoMessage()    {}
+func (*QueryAuctionRoundResultsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{10}
+}
+func (m *QueryAuctionRoundResultsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRoundResultsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRoundResultsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRoundResultsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRoundResultsRequest.Merge(m, src)
+}
+func (m *QueryAuctionRoundResultsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRoundResultsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRoundResultsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRoundResultsRequest proto.InternalMessageInfo
+
+func (m *QueryAuctionRoundResultsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAuctionRoundResultsResponse is the response type for the
+// Query/AuctionRoundResults RPC method.
+type QueryAuctionRoundResultsResponse struct {
+	Results    []AuctionRoundResult `protobuf:"bytes,1,rep,name=results,proto3" json:"results"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAuctionRoundResultsResponse) Reset()         { *m = QueryAuctionRoundResultsResponse{} }
+func (m *QueryAuctionRoundResultsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAuctionRoundResultsResponse) ProtoMessage()    {}
+func (*QueryAuctionRoundResultsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{11}
+}
+func (m *QueryAuctionRoundResultsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAuctionRoundResultsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAuctionRoundResultsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAuctionRoundResultsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAuctionRoundResultsResponse.Merge(m, src)
+}
+func (m *QueryAuctionRoundResultsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAuctionRoundResultsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAuctionRoundResultsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAuctionRoundResultsResponse proto.InternalMessageInfo
+
+func (m *QueryAuctionRoundResultsResponse) GetResults() []AuctionRoundResult {
+	if m != nil {
+		return m.Results
+	}
+	return nil
+}
+
+func (m *QueryAuctionRoundResultsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySealedBidCommitmentsRequest is the request type for the
+// Query/SealedBidCommitments RPC method.
+type QuerySealedBidCommitmentsRequest struct {
+}
+
+func (m *QuerySealedBidCommitmentsRequest) Reset()         { *m = QuerySealedBidCommitmentsRequest{} }
+func (m *QuerySealedBidCommitmentsRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidCommitmentsRequest) ProtoMessage()    {}
+func (*QuerySealedBidCommitmentsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{12}
+}
+func (m *QuerySealedBidCommitmentsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidCommitmentsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidCommitmentsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidCommitmentsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidCommitmentsRequest.Merge(m, src)
+}
+func (m *QuerySealedBidCommitmentsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidCommitmentsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidCommitmentsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidCommitmentsRequest proto.InternalMessageInfo
+
+// QuerySealedBidCommitmentsResponse is the response type for the
+// Query/SealedBidCommitments RPC method.
+type QuerySealedBidCommitmentsResponse struct {
+	// sealed-bid mode of the current auction round, nil for open rounds
+	SealedBidRound *SealedBidRound       `protobuf:"bytes,1,opt,name=sealed_bid_round,json=sealedBidRound,proto3" json:"sealed_bid_round,omitempty"`
+	Commitments    []SealedBidCommitment `protobuf:"bytes,2,rep,name=commitments,proto3" json:"commitments"`
+}
+
+func (m *QuerySealedBidCommitmentsResponse) Reset()         { *m = QuerySealedBidCommitmentsResponse{} }
+func (m *QuerySealedBidCommitmentsResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySealedBidCommitmentsResponse) ProtoMessage()    {}
+func (*QuerySealedBidCommitmentsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2ae80edbdb9fffb7, []int{13}
+}
+func (m *QuerySealedBidCommitmentsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySealedBidCommitmentsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySealedBidCommitmentsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySealedBidCommitmentsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySealedBidCommitmentsResponse.Merge(m, src)
+}
+func (m *QuerySealedBidCommitmentsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySealedBidCommitmentsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySealedBidCommitmentsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySealedBidCommitmentsResponse proto.InternalMessageInfo
+
+func (m *QuerySealedBidCommitmentsResponse) GetSealedBidRound() *SealedBidRound {
+	if m != nil {
+		return m.SealedBidRound
+	}
+	return nil
+}
+
+func (m *QuerySealedBidCommitmentsResponse) GetCommitments() []SealedBidCommitment {
+	if m != nil {
+		return m.Commitments
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryAuctionParamsRequest)(nil), "injective.auction.v1beta1.QueryAuctionParamsRequest")
 	proto.RegisterType((*QueryAuctionParamsResponse)(nil), "injective.auction.v1beta1.QueryAuctionParamsResponse")
@@ -403,6 +705,12 @@ func init() {
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.auction.v1beta1.QueryModuleStateResponse")
 	proto.RegisterType((*QueryLastAuctionResultRequest)(nil), "injective.auction.v1beta1.QueryLastAuctionResultRequest")
 	proto.RegisterType((*QueryLastAuctionResultResponse)(nil), "injective.auction.v1beta1.QueryLastAuctionResultResponse")
+	proto.RegisterType((*QueryAuctionBidHistoryRequest)(nil), "injective.auction.v1beta1.QueryAuctionBidHistoryRequest")
+	proto.RegisterType((*QueryAuctionBidHistoryResponse)(nil), "injective.auction.v1beta1.QueryAuctionBidHistoryResponse")
+	proto.RegisterType((*QueryAuctionRoundResultsRequest)(nil), "injective.auction.v1beta1.QueryAuctionRoundResultsRequest")
+	proto.RegisterType((*QueryAuctionRoundResultsResponse)(nil), "injective.auction.v1beta1.QueryAuctionRoundResultsResponse")
+	proto.RegisterType((*QuerySealedBidCommitmentsRequest)(nil), "injective.auction.v1beta1.QuerySealedBidCommitmentsRequest")
+	proto.RegisterType((*QuerySealedBidCommitmentsResponse)(nil), "injective.auction.v1beta1.QuerySealedBidCommitmentsResponse")
 }
 
 func init() {
@@ -410,51 +718,70 @@ func init() {
 }
 
 var fileDescriptor_2ae80edbdb9fffb7 = []byte{
-	// 693 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0x13, 0x41,
-	0x14, 0xef, 0x02, 0x25, 0x71, 0x90, 0x44, 0x46, 0x8c, 0xed, 0x2a, 0xdb, 0xb2, 0x6a, 0x28, 0x89,
-	0xec, 0x42, 0x51, 0xa3, 0x51, 0x63, 0x28, 0x07, 0x43, 0x02, 0x89, 0xae, 0x5e, 0x34, 0x1a, 0x32,
-	0xdd, 0x4e, 0xb6, 0x23, 0xdd, 0x99, 0xb2, 0x33, 0x4b, 0xc2, 0xc5, 0x83, 0x9f, 0xc0, 0xc4, 0x0f,
-	0x61, 0xe2, 0xc1, 0x4f, 0xe0, 0xc1, 0x23, 0x17, 0x13, 0x12, 0x2f, 0xc6, 0x03, 0x1a, 0xe0, 0x83,
-	0x98, 0x9d, 0x99, 0x16, 0xb0, 0xdd, 0x6d, 0xca, 0xa9, 0xbb, 0xef, 0xbd, 0xdf, 0xef, 0xfd, 0xde,
-	0xbf, 0x2d, 0xb8, 0x45, 0xe8, 0x3b, 0xec, 0x0b, 0xb2, 0x83, 0x5d, 0x14, 0xfb, 0x82, 0x30, 0xea,
-	0xee, 0x2c, 0xd5, 0xb1, 0x40, 0x4b, 0xee, 0x76, 0x8c, 0xa3, 0x5d, 0xa7, 0x1d, 0x31, 0xc1, 0x60,
-	0xb1, 0x1b, 0xe6, 0xe8, 0x30, 0x47, 0x87, 0x99, 0xd7, 0x03, 0xc6, 0x82, 0x16, 0x76, 0x51, 0x9b,
-	0xb8, 0x88, 0x52, 0x26, 0x50, 0xe2, 0xe6, 0x0a, 0x68, 0xce, 0xa5, 0xf3, 0x77, 0x88, 0x06, 0x06,
-	0x06, 0x98, 0x62, 0x4e, 0x3a, 0x8c, 0xd3, 0x01, 0x0b, 0x98, 0x7c, 0x74, 0x93, 0x27, 0x6d, 0xb5,
-	0x7c, 0xc6, 0x43, 0xc6, 0xdd, 0x3a, 0xe2, 0xb8, 0x0b, 0xf4, 0x19, 0xd1, 0xf4, 0xf6, 0x35, 0x50,
-	0x7c, 0x9e, 0xd4, 0xb3, 0xa2, 0xb8, 0x9f, 0xa1, 0x08, 0x85, 0xdc, 0xc3, 0xdb, 0x31, 0xe6, 0xc2,
-	0x7e, 0x0b, 0xcc, 0x7e, 0x4e, 0xde, 0x66, 0x94, 0x63, 0xf8, 0x04, 0x8c, 0xb7, 0xa5, 0xa5, 0x60,
-	0x94, 0x8d, 0xca, 0x44, 0x75, 0xd6, 0x49, 0x6d, 0x86, 0xa3, 0xa0, 0xb5, 0xb1, 0xbd, 0x83, 0x52,
-	0xce, 0xd3, 0x30, 0xdb, 0x06, 0x65, 0x49, 0xbf, 0x1a, 0x47, 0x11, 0xa6, 0x42, 0x67, 0xa9, 0x21,
-	0xbe, 0x85, 0x45, 0x47, 0xc2, 0x8f, 0x11, 0x30, 0x9b, 0x11, 0xa4, 0xa5, 0xf8, 0x60, 0x1c, 0x85,
-	0x2c, 0xa6, 0xa2, 0x60, 0x94, 0x47, 0x2b, 0x13, 0xd5, 0xa2, 0xa3, 0xca, 0x76, 0x92, 0xb2, 0xbb,
-	0x22, 0x56, 0x19, 0xa1, 0xb5, 0xc5, 0x44, 0xc2, 0x97, 0x3f, 0xa5, 0x4a, 0x40, 0x44, 0x33, 0xae,
-	0x3b, 0x3e, 0x0b, 0x5d, 0xdd, 0x23, 0xf5, 0xb3, 0xc0, 0x1b, 0x5b, 0xae, 0xd8, 0x6d, 0x63, 0x2e,
-	0x01, 0xdc, 0xd3, 0xd4, 0xd0, 0x06, 0x17, 0x75, 0x59, 0x1e, 0x8b, 0x69, 0xa3, 0x30, 0x52, 0x36,
-	0x2a, 0x63, 0xde, 0x19, 0x1b, 0x74, 0x00, 0xd4, 0xef, 0xab, 0x2d, 0xc6, 0x09, 0x0d, 0x5e, 0x92,
-	0x10, 0x17, 0x46, 0x65, 0x64, 0x1f, 0x0f, 0xbc, 0x09, 0x26, 0x9b, 0x24, 0x68, 0x62, 0x2e, 0x6a,
-	0xa4, 0xd1, 0xc0, 0x51, 0x61, 0xac, 0x6c, 0x54, 0x2e, 0x78, 0x67, 0x8d, 0x70, 0x0d, 0x5c, 0x3a,
-	0x31, 0xac, 0xa8, 0x42, 0xf3, 0x49, 0x60, 0x6d, 0x26, 0xa9, 0xe6, 0xf7, 0x41, 0xe9, 0x8a, 0xd2,
-	0xce, 0x1b, 0x5b, 0x0e, 0x61, 0x6e, 0x88, 0x44, 0xd3, 0x59, 0xa3, 0xc2, 0xeb, 0x81, 0xd9, 0x45,
-	0x70, 0x55, 0xb6, 0x73, 0x83, 0x35, 0xe2, 0x16, 0x7e, 0x21, 0x90, 0xc0, 0x9d, 0x56, 0xbf, 0x02,
-	0x85, 0x5e, 0x97, 0x6e, 0xf0, 0x63, 0x90, 0xe7, 0x89, 0x41, 0x8f, 0x7a, 0x2e, 0x63, 0xd4, 0x4f,
-	0xd5, 0x56, 0x2a, 0xbc, 0x42, 0xd9, 0x25, 0x30, 0x23, 0xa9, 0xd7, 0x11, 0xef, 0x4c, 0xd0, 0xc3,
-	0x3c, 0x6e, 0x75, 0xc7, 0xfc, 0x1e, 0x58, 0x69, 0x01, 0x5a, 0xc1, 0x1b, 0x70, 0xb9, 0x85, 0xb8,
-	0xd8, 0xd4, 0xe9, 0x36, 0x23, 0xe9, 0xd6, 0x7a, 0x6e, 0x67, 0xe8, 0xe9, 0xa5, 0x9c, 0x6a, 0xfd,
-	0x6f, 0xaa, 0x1e, 0xe7, 0x41, 0x5e, 0x0a, 0x80, 0x9f, 0x0d, 0x30, 0x79, 0x66, 0xdf, 0xe1, 0x9d,
-	0x0c, 0xf2, 0xd4, 0xdb, 0x31, 0xef, 0x0e, 0x89, 0x52, 0x65, 0xda, 0xf3, 0x1f, 0x7e, 0x1e, 0x7f,
-	0x1a, 0xb9, 0x01, 0x67, 0xdd, 0xf4, 0xbb, 0x57, 0xe7, 0x03, 0xbf, 0x19, 0x60, 0xba, 0xdf, 0x55,
-	0xc0, 0x87, 0x83, 0x52, 0x67, 0x1c, 0x9c, 0xf9, 0xe8, 0x7c, 0xe0, 0x21, 0xe4, 0xd7, 0x95, 0xca,
-	0xaf, 0x06, 0x80, 0x9a, 0xe4, 0xd4, 0xc6, 0xc1, 0xea, 0xa0, 0xfc, 0xbd, 0x9b, 0x6b, 0x2e, 0x0f,
-	0x85, 0xd1, 0x52, 0x5d, 0x29, 0x75, 0x1e, 0xce, 0x65, 0x48, 0x0d, 0x25, 0x6e, 0x53, 0x2e, 0x31,
-	0xfc, 0x6e, 0x80, 0xa9, 0x9e, 0x65, 0x82, 0xf7, 0x07, 0xe5, 0x4e, 0xdb, 0x79, 0xf3, 0xc1, 0x39,
-	0x90, 0x5a, 0xfb, 0x3d, 0xa9, 0x7d, 0x11, 0x3a, 0x19, 0xda, 0xfb, 0x5c, 0x4b, 0x2d, 0xd8, 0x3b,
-	0xb4, 0x8c, 0xfd, 0x43, 0xcb, 0xf8, 0x7b, 0x68, 0x19, 0x1f, 0x8f, 0xac, 0xdc, 0xfe, 0x91, 0x95,
-	0xfb, 0x75, 0x64, 0xe5, 0x5e, 0x6f, 0x9c, 0xfa, 0x1c, 0xae, 0x75, 0x38, 0xd7, 0x51, 0x9d, 0x9f,
-	0x64, 0x58, 0xf0, 0x59, 0x84, 0x4f, 0xbf, 0x36, 0x11, 0xa1, 0xba, 0x45, 0xbc, 0x9b, 0x5e, 0x7e,
-	0x39, 0xeb, 0xe3, 0xf2, 0xdf, 0x65, 0xf9, 0x5f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x8f, 0xf4, 0xc4,
-	0x76, 0x47, 0x07, 0x00, 0x00,
+	// 1008 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x41, 0x6f, 0xe3, 0x44,
+	0x14, 0xee, 0x74, 0xdb, 0x22, 0x26, 0x2c, 0xda, 0x9d, 0x2d, 0x22, 0x35, 0xac, 0x93, 0x9a, 0x85,
+	0xa6, 0x2b, 0x6a, 0xb7, 0x29, 0x20, 0xca, 0x2e, 0xa0, 0x4d, 0x25, 0x96, 0x4a, 0x5b, 0x09, 0x5c,
+	0x84, 0x04, 0x02, 0x45, 0x13, 0x7b, 0xe4, 0x0c, 0x8d, 0x3d, 0x59, 0xcf, 0x78, 0xa5, 0x0a, 0x2d,
+	0x07, 0x7e, 0x01, 0x12, 0x3f, 0x02, 0xc1, 0x81, 0x3b, 0x88, 0x03, 0xc7, 0x3d, 0x80, 0x54, 0x89,
+	0x0b, 0xe2, 0xb0, 0xa0, 0x96, 0x1f, 0x82, 0x3c, 0x33, 0x4e, 0x9c, 0x4d, 0xec, 0x34, 0x11, 0xa7,
+	0xc4, 0x33, 0xef, 0x7b, 0xef, 0xfb, 0xbe, 0x19, 0xbf, 0x67, 0xf8, 0x32, 0x8d, 0xbe, 0x20, 0x9e,
+	0xa0, 0x0f, 0x88, 0x83, 0x13, 0x4f, 0x50, 0x16, 0x39, 0x0f, 0x76, 0x3a, 0x44, 0xe0, 0x1d, 0xe7,
+	0x7e, 0x42, 0xe2, 0x13, 0xbb, 0x1f, 0x33, 0xc1, 0xd0, 0xda, 0x20, 0xcc, 0xd6, 0x61, 0xb6, 0x0e,
+	0x33, 0x5e, 0x0c, 0x18, 0x0b, 0x7a, 0xc4, 0xc1, 0x7d, 0xea, 0xe0, 0x28, 0x62, 0x02, 0xa7, 0xdb,
+	0x5c, 0x01, 0x8d, 0x8d, 0xe2, 0xfc, 0x59, 0xa2, 0xa9, 0x81, 0x01, 0x89, 0x08, 0xa7, 0x59, 0xc6,
+	0xd5, 0x80, 0x05, 0x4c, 0xfe, 0x75, 0xd2, 0x7f, 0x7a, 0xd5, 0xf4, 0x18, 0x0f, 0x19, 0x77, 0x3a,
+	0x98, 0x93, 0x01, 0xd0, 0x63, 0x34, 0x4b, 0x7f, 0x33, 0xbf, 0x2f, 0x95, 0x0d, 0xa2, 0xfa, 0x38,
+	0xa0, 0x11, 0x1e, 0x52, 0xb1, 0x5e, 0x80, 0x6b, 0x1f, 0xa6, 0x11, 0x77, 0x14, 0x8f, 0x0f, 0x70,
+	0x8c, 0x43, 0xee, 0x92, 0xfb, 0x09, 0xe1, 0xc2, 0xfa, 0x1c, 0x1a, 0x93, 0x36, 0x79, 0x9f, 0x45,
+	0x9c, 0xa0, 0x77, 0xe1, 0x4a, 0x5f, 0xae, 0x54, 0x41, 0x1d, 0x34, 0x2a, 0xcd, 0x75, 0xbb, 0xd0,
+	0x38, 0x5b, 0x41, 0x5b, 0x4b, 0x8f, 0x1e, 0xd7, 0x16, 0x5c, 0x0d, 0xb3, 0x2c, 0x58, 0x97, 0xe9,
+	0xf7, 0x93, 0x38, 0x26, 0x91, 0xd0, 0x55, 0x5a, 0x98, 0x1f, 0x13, 0x91, 0x51, 0xf8, 0x7d, 0x11,
+	0xae, 0x97, 0x04, 0x69, 0x2a, 0x1e, 0x5c, 0xc1, 0x21, 0x4b, 0x22, 0x51, 0x05, 0xf5, 0x4b, 0x8d,
+	0x4a, 0x73, 0xcd, 0x56, 0x16, 0xd8, 0xa9, 0x05, 0x03, 0x12, 0xfb, 0x8c, 0x46, 0xad, 0xed, 0x94,
+	0xc2, 0x0f, 0x7f, 0xd7, 0x1a, 0x01, 0x15, 0xdd, 0xa4, 0x63, 0x7b, 0x2c, 0x74, 0xb4, 0x5f, 0xea,
+	0x67, 0x8b, 0xfb, 0xc7, 0x8e, 0x38, 0xe9, 0x13, 0x2e, 0x01, 0xdc, 0xd5, 0xa9, 0x91, 0x05, 0x9f,
+	0xd1, 0xb2, 0x5c, 0x96, 0x44, 0x7e, 0x75, 0xb1, 0x0e, 0x1a, 0x4b, 0xee, 0xc8, 0x1a, 0xb2, 0x21,
+	0xd2, 0xcf, 0xfb, 0x3d, 0xc6, 0x69, 0x14, 0x7c, 0x44, 0x43, 0x52, 0xbd, 0x24, 0x23, 0x27, 0xec,
+	0xa0, 0x1b, 0xf0, 0x72, 0x97, 0x06, 0x5d, 0xc2, 0x45, 0x8b, 0xfa, 0x3e, 0x89, 0xab, 0x4b, 0x75,
+	0xd0, 0x78, 0xda, 0x1d, 0x5d, 0x44, 0x07, 0xf0, 0xca, 0x70, 0xe1, 0x8e, 0x12, 0xba, 0x9c, 0x06,
+	0xb6, 0xae, 0xa7, 0x6a, 0xfe, 0x7a, 0x5c, 0x7b, 0x4e, 0x71, 0xe7, 0xfe, 0xb1, 0x4d, 0x99, 0x13,
+	0x62, 0xd1, 0xb5, 0x0f, 0x22, 0xe1, 0x8e, 0xc1, 0xac, 0x35, 0xf8, 0xbc, 0xb4, 0xf3, 0x90, 0xf9,
+	0x49, 0x8f, 0x1c, 0x09, 0x2c, 0x48, 0x66, 0xf5, 0x27, 0xb0, 0x3a, 0xbe, 0xa5, 0x0d, 0x7e, 0x1b,
+	0x2e, 0xf3, 0x74, 0x41, 0x1f, 0xf5, 0x46, 0xc9, 0x51, 0xdf, 0x55, 0x37, 0x58, 0xe1, 0x15, 0xca,
+	0xaa, 0xc1, 0xeb, 0x32, 0xf5, 0x3d, 0xcc, 0xb3, 0x13, 0x74, 0x09, 0x4f, 0x7a, 0x83, 0x63, 0xfe,
+	0x0a, 0x9a, 0x45, 0x01, 0x9a, 0xc1, 0x67, 0xf0, 0x5a, 0x0f, 0x73, 0xd1, 0xd6, 0xe5, 0xda, 0xb1,
+	0xdc, 0xd6, 0x7c, 0x5e, 0x2d, 0xe1, 0x33, 0x9e, 0xf2, 0x6a, 0xef, 0xc9, 0x25, 0xeb, 0xa1, 0x26,
+	0x98, 0x5d, 0x2f, 0xea, 0xbf, 0x4f, 0xb9, 0x60, 0xf1, 0x89, 0x26, 0x88, 0x56, 0xe1, 0x72, 0x2c,
+	0x4f, 0x1d, 0xc8, 0xb3, 0x54, 0x0f, 0xe8, 0x3d, 0x08, 0x87, 0x6f, 0x94, 0xbc, 0x10, 0x95, 0xe6,
+	0x2b, 0x23, 0x77, 0x4f, 0x35, 0x96, 0xe1, 0x6b, 0x10, 0x64, 0x76, 0xbb, 0x39, 0xa4, 0xf5, 0x3d,
+	0x80, 0x66, 0x51, 0x7d, 0xad, 0xff, 0x1d, 0xb8, 0xd4, 0xa1, 0x3e, 0xd7, 0x17, 0xfc, 0x46, 0x89,
+	0xe0, 0x16, 0xf5, 0x5d, 0xe2, 0xb1, 0xd8, 0xd7, 0xaf, 0x9b, 0xc4, 0xa1, 0xbb, 0x13, 0xa8, 0x6e,
+	0x4c, 0xa5, 0xaa, 0x8a, 0x8f, 0x70, 0xa5, 0xb0, 0x96, 0xa7, 0x2a, 0xef, 0xbd, 0x72, 0x31, 0xeb,
+	0x1b, 0x4f, 0xd8, 0x02, 0xe6, 0xb6, 0xe5, 0x67, 0x00, 0xeb, 0xc5, 0xb5, 0xb4, 0x31, 0x87, 0xf0,
+	0x29, 0x75, 0x17, 0x32, 0x6f, 0xb6, 0x4a, 0xbc, 0x19, 0x4f, 0xa4, 0x4d, 0xca, 0x72, 0xfc, 0x7f,
+	0x3e, 0x65, 0xdd, 0xed, 0x88, 0xe0, 0x1e, 0xf1, 0x5b, 0xd4, 0xdf, 0x67, 0x61, 0x48, 0x45, 0x48,
+	0xa2, 0x81, 0x51, 0xd6, 0x29, 0x80, 0xeb, 0x25, 0x41, 0x5a, 0xe1, 0x11, 0xbc, 0xc2, 0xe5, 0x7e,
+	0xbb, 0x43, 0xfd, 0xf6, 0xf0, 0x1a, 0x56, 0x9a, 0x9b, 0x25, 0x52, 0x07, 0x29, 0x95, 0xd8, 0x67,
+	0xf9, 0xc8, 0x33, 0xfa, 0x18, 0x56, 0xbc, 0x61, 0xad, 0xea, 0xa2, 0xb4, 0xce, 0xbe, 0x48, 0xbe,
+	0x21, 0x45, 0xed, 0x5d, 0x3e, 0x51, 0xf3, 0x27, 0x08, 0x97, 0xa5, 0x24, 0xf4, 0x1d, 0x80, 0x97,
+	0x47, 0x26, 0x07, 0x7a, 0xad, 0x24, 0x7d, 0xe1, 0x14, 0x32, 0x5e, 0x9f, 0x11, 0xa5, 0x5c, 0xb3,
+	0x36, 0xbf, 0xfe, 0xe3, 0xdf, 0x6f, 0x17, 0x5f, 0x42, 0xeb, 0x4e, 0xf1, 0xb4, 0x55, 0x83, 0x08,
+	0xfd, 0x02, 0xe0, 0xea, 0xa4, 0xf9, 0x82, 0x6e, 0x4d, 0x2b, 0x5d, 0x32, 0xba, 0x8c, 0xdb, 0xf3,
+	0x81, 0x67, 0xa0, 0xdf, 0x51, 0x2c, 0x7f, 0x04, 0x10, 0xe9, 0x24, 0xb9, 0xde, 0x8d, 0x9a, 0xd3,
+	0xea, 0x8f, 0xcf, 0x00, 0x63, 0x77, 0x26, 0x8c, 0xa6, 0xea, 0x48, 0xaa, 0x9b, 0x68, 0xa3, 0x84,
+	0x6a, 0x28, 0x71, 0x6d, 0x39, 0x0e, 0xd0, 0xaf, 0x00, 0x5e, 0x1d, 0x6b, 0xcb, 0xe8, 0xcd, 0x69,
+	0xb5, 0x8b, 0xa6, 0x87, 0xb1, 0x37, 0x07, 0x52, 0x73, 0x7f, 0x43, 0x72, 0xdf, 0x46, 0x76, 0x09,
+	0xf7, 0x09, 0x73, 0x47, 0x4a, 0x18, 0x6b, 0xd6, 0xd3, 0x25, 0x14, 0xcd, 0x17, 0x63, 0x6f, 0x0e,
+	0xe4, 0x0c, 0x12, 0xd2, 0xc6, 0xd1, 0x55, 0x38, 0xe7, 0x4b, 0xd9, 0x41, 0x1e, 0xa6, 0x12, 0xae,
+	0x4d, 0x68, 0xac, 0xe8, 0xad, 0x0b, 0x52, 0x99, 0xd0, 0xf9, 0x8d, 0x5b, 0x73, 0x61, 0xb5, 0x90,
+	0x6d, 0x29, 0xe4, 0x26, 0x6a, 0x94, 0x08, 0x91, 0xdc, 0xdb, 0x59, 0xb3, 0xfe, 0x0d, 0xc0, 0xd5,
+	0x49, 0xad, 0x73, 0xfa, 0x8b, 0x5b, 0xd2, 0x95, 0x8d, 0xdb, 0xf3, 0x81, 0xb5, 0x8a, 0x3d, 0xa9,
+	0x62, 0x17, 0xed, 0x94, 0xa8, 0xc8, 0xb5, 0xf3, 0x5c, 0xef, 0x6c, 0x05, 0x8f, 0xce, 0x4c, 0x70,
+	0x7a, 0x66, 0x82, 0x7f, 0xce, 0x4c, 0xf0, 0xcd, 0xb9, 0xb9, 0x70, 0x7a, 0x6e, 0x2e, 0xfc, 0x79,
+	0x6e, 0x2e, 0x7c, 0x7a, 0x98, 0xfb, 0x5a, 0x3d, 0xc8, 0xd2, 0xde, 0xc3, 0x1d, 0x3e, 0x2c, 0xb2,
+	0xe5, 0xb1, 0x98, 0xe4, 0x1f, 0xbb, 0x98, 0x46, 0xfa, 0xbd, 0xe3, 0x03, 0x06, 0xf2, 0xc3, 0xb6,
+	0xb3, 0x22, 0x3f, 0xfe, 0x77, 0xff, 0x1b, 0x00, 0x81, 0xa6, 0x60, 0xfd, 0x12, 0x0d, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -476,6 +803,12 @@ type QueryClient interface {
 	// Retrieves the entire auction module's state
 	AuctionModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 	LastAuctionResult(ctx context.Context, in *QueryLastAuctionResultRequest, opts ...grpc.CallOption) (*QueryLastAuctionResultResponse, error)
+	// Retrieves the recorded bids of an auction round
+	AuctionBidHistory(ctx context.Context, in *QueryAuctionBidHistoryRequest, opts ...grpc.CallOption) (*QueryAuctionBidHistoryResponse, error)
+	// Retrieves the recorded results of settled auction rounds
+	AuctionRoundResults(ctx context.Context, in *QueryAuctionRoundResultsRequest, opts ...grpc.CallOption) (*QueryAuctionRoundResultsResponse, error)
+	// Retrieves the sealed-bid state of the current auction round
+	SealedBidCommitments(ctx context.Context, in *QuerySealedBidCommitmentsRequest, opts ...grpc.CallOption) (*QuerySealedBidCommitmentsResponse, error)
 }
 
 type queryClient struct {
@@ -522,6 +855,33 @@ func (c *queryClient) LastAuctionResult(ctx context.Context, in *QueryLastAuctio
 	return out, nil
 }
 
+func (c *queryClient) AuctionBidHistory(ctx context.Context, in *QueryAuctionBidHistoryRequest, opts ...grpc.CallOption) (*QueryAuctionBidHistoryResponse, error) {
+	out := new(QueryAuctionBidHistoryResponse)
+	err := c.cc.Invoke(ctx, "/injective.auction.v1beta1.Query/AuctionBidHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AuctionRoundResults(ctx context.Context, in *QueryAuctionRoundResultsRequest, opts ...grpc.CallOption) (*QueryAuctionRoundResultsResponse, error) {
+	out := new(QueryAuctionRoundResultsResponse)
+	err := c.cc.Invoke(ctx, "/injective.auction.v1beta1.Query/AuctionRoundResults", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) SealedBidCommitments(ctx context.Context, in *QuerySealedBidCommitmentsRequest, opts ...grpc.CallOption) (*QuerySealedBidCommitmentsResponse, error) {
+	out := new(QuerySealedBidCommitmentsResponse)
+	err := c.cc.Invoke(ctx, "/injective.auction.v1beta1.Query/SealedBidCommitments", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Retrieves auction params
@@ -531,6 +891,12 @@ type QueryServer interface {
 	// Retrieves the entire auction module's state
 	AuctionModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 	LastAuctionResult(context.Context, *QueryLastAuctionResultRequest) (*QueryLastAuctionResultResponse, error)
+	// Retrieves the recorded bids of an auction round
+	AuctionBidHistory(context.Context, *QueryAuctionBidHistoryRequest) (*QueryAuctionBidHistoryResponse, error)
+	// Retrieves the recorded results of settled auction rounds
+	AuctionRoundResults(context.Context, *QueryAuctionRoundResultsRequest) (*QueryAuctionRoundResultsResponse, error)
+	// Retrieves the sealed-bid state of the current auction round
+	SealedBidCommitments(context.Context, *QuerySealedBidCommitmentsRequest) (*QuerySealedBidCommitmentsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -549,6 +915,15 @@ func (*UnimplementedQueryServer) AuctionModuleState(ctx context.Context, req *Qu
 func (*UnimplementedQueryServer) LastAuctionResult(ctx context.Context, req *QueryLastAuctionResultRequest) (*QueryLastAuctionResultResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method LastAuctionResult not implemented")
 }
+func (*UnimplementedQueryServer) AuctionBidHistory(ctx context.Context, req *QueryAuctionBidHistoryRequest) (*QueryAuctionBidHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionBidHistory not implemented")
+}
+func (*UnimplementedQueryServer) AuctionRoundResults(ctx context.Context, req *QueryAuctionRoundResultsRequest) (*QueryAuctionRoundResultsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AuctionRoundResults not implemented")
+}
+func (*UnimplementedQueryServer) SealedBidCommitments(ctx context.Context, req *QuerySealedBidCommitmentsRequest) (*QuerySealedBidCommitmentsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SealedBidCommitments not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -626,6 +1001,60 @@ func _Query_LastAuctionResult_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_AuctionBidHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionBidHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionBidHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.auction.v1beta1.Query/AuctionBidHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionBidHistory(ctx, req.(*QueryAuctionBidHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AuctionRoundResults_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAuctionRoundResultsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AuctionRoundResults(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.auction.v1beta1.Query/AuctionRoundResults",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AuctionRoundResults(ctx, req.(*QueryAuctionRoundResultsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_SealedBidCommitments_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySealedBidCommitmentsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SealedBidCommitments(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.auction.v1beta1.Query/SealedBidCommitments",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SealedBidCommitments(ctx, req.(*QuerySealedBidCommitmentsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.auction.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -646,6 +1075,18 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "LastAuctionResult",
 			Handler:    _Query_LastAuctionResult_Handler,
 		},
+		{
+			MethodName: "AuctionBidHistory",
+			Handler:    _Query_AuctionBidHistory_Handler,
+		},
+		{
+			MethodName: "AuctionRoundResults",
+			Handler:    _Query_AuctionRoundResults_Handler,
+		},
+		{
+			MethodName: "SealedBidCommitments",
+			Handler:    _Query_SealedBidCommitments_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/auction/v1beta1/query.proto",
@@ -910,21 +1351,266 @@ func (m *QueryLastAuctionResultResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryAuctionBidHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *QueryAuctionParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryAuctionBidHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionBidHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Round != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Round))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionBidHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionBidHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionBidHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Bids) > 0 {
+		for iNdEx := len(m.Bids) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Bids[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRoundResultsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRoundResultsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRoundResultsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAuctionRoundResultsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAuctionRoundResultsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAuctionRoundResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Results) > 0 {
+		for iNdEx := len(m.Results) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Results[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidCommitmentsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidCommitmentsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidCommitmentsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QuerySealedBidCommitmentsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QuerySealedBidCommitmentsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QuerySealedBidCommitmentsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Commitments) > 0 {
+		for iNdEx := len(m.Commitments) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Commitments[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.SealedBidRound != nil {
+		{
+			size, err := m.SealedBidRound.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryAuctionParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	return n
@@ -1021,39 +1707,134 @@ func (m *QueryLastAuctionResultResponse) Size() (n int) {
 	return n
 }
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *QueryAuctionBidHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Round != 0 {
+		n += 1 + sovQuery(uint64(m.Round))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
 }
-func (m *QueryAuctionParamsRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowQuery
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: QueryAuctionParamsRequest: wiretype end group for non-group")
+
+func (m *QueryAuctionBidHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Bids) > 0 {
+		for _, e := range m.Bids {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
 		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryAuctionParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionRoundResultsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAuctionRoundResultsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Results) > 0 {
+		for _, e := range m.Results {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySealedBidCommitmentsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QuerySealedBidCommitmentsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.SealedBidRound != nil {
+		l = m.SealedBidRound.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if len(m.Commitments) > 0 {
+		for _, e := range m.Commitments {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryAuctionParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAuctionParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAuctionParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1135,7 +1916,517 @@ func (m *QueryAuctionParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Amount = append(m.Amount, types.Coin{})
+			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRound", wireType)
+			}
+			m.AuctionRound = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionRound |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AuctionClosingTime", wireType)
+			}
+			m.AuctionClosingTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AuctionClosingTime |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.HighestBidder = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.HighestBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.State == nil {
+				m.State = &GenesisState{}
+			}
+			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastAuctionResultRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastAuctionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLastAuctionResultResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLastAuctionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAuctionResult", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastAuctionResult == nil {
+				m.LastAuctionResult = &LastAuctionResult{}
+			}
+			if err := m.LastAuctionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1160,7 +2451,7 @@ func (m *QueryAuctionParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionBidHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1183,12 +2474,67 @@ func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionBidHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionBidHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1210,7 +2556,7 @@ func (m *QueryCurrentAuctionBasketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionBidHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1233,15 +2579,15 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionBidHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryCurrentAuctionBasketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionBidHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Bids", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1268,54 +2614,16 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Amount = append(m.Amount, types.Coin{})
-			if err := m.Amount[len(m.Amount)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Bids = append(m.Bids, BidRecord{})
+			if err := m.Bids[len(m.Bids)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionRound", wireType)
-			}
-			m.AuctionRound = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionRound |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AuctionClosingTime", wireType)
-			}
-			m.AuctionClosingTime = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.AuctionClosingTime |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidder", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -1325,55 +2633,25 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.HighestBidder = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field HighestBidAmount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowQuery
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthQuery
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthQuery
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
 			}
-			if err := m.HighestBidAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1398,7 +2676,7 @@ func (m *QueryCurrentAuctionBasketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRoundResultsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1421,12 +2699,48 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRoundResultsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRoundResultsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1448,7 +2762,7 @@ func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryAuctionRoundResultsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1471,15 +2785,15 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryAuctionRoundResultsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryModuleStateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryAuctionRoundResultsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field State", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Results", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1506,10 +2820,44 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.State == nil {
-				m.State = &GenesisState{}
+			m.Results = append(m.Results, AuctionRoundResult{})
+			if err := m.Results[len(m.Results)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.State.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1534,7 +2882,7 @@ func (m *QueryModuleStateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
+func (m *QuerySealedBidCommitmentsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1557,10 +2905,10 @@ func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastAuctionResultRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySealedBidCommitmentsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastAuctionResultRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySealedBidCommitmentsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1584,7 +2932,7 @@ func (m *QueryLastAuctionResultRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
+func (m *QuerySealedBidCommitmentsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1607,15 +2955,15 @@ func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryLastAuctionResultResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QuerySealedBidCommitmentsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryLastAuctionResultResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QuerySealedBidCommitmentsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LastAuctionResult", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SealedBidRound", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1642,10 +2990,44 @@ func (m *QueryLastAuctionResultResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.LastAuctionResult == nil {
-				m.LastAuctionResult = &LastAuctionResult{}
+			if m.SealedBidRound == nil {
+				m.SealedBidRound = &SealedBidRound{}
 			}
-			if err := m.LastAuctionResult.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SealedBidRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Commitments", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Commitments = append(m.Commitments, SealedBidCommitment{})
+			if err := m.Commitments[len(m.Commitments)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
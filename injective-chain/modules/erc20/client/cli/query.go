@@ -1,9 +1,16 @@
 package cli
 
 import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/spf13/cobra"
 
 	"github.com/InjectiveLabs/injective-core/cli"
+	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
 )
 
@@ -14,6 +21,7 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetParams(),
 		GetTokenPairs(),
+		GetTokenPairsByDenomType(),
 		GetTokenPairByDenom(),
 		GetTokenPairByERC20(),
 	)
@@ -37,6 +45,60 @@ func GetTokenPairs() *cobra.Command {
 	)
 }
 
+func GetTokenPairsByDenomType() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-pairs-by-type <tokenfactory|ibc|peggy|native>",
+		Short: "Returns the token pairs whose bank denom is of the given type",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			denomType, err := parseTokenPairDenomType(args[0])
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryTokenPairsRequest{
+				DenomType:  denomType,
+				Pagination: pageReq,
+			}
+			res, err := queryClient.TokenPairs(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "token-pairs-by-type")
+	return cmd
+}
+
+func parseTokenPairDenomType(denomType string) (types.TokenPairDenomType, error) {
+	switch strings.ToLower(denomType) {
+	case "tokenfactory", "token_factory":
+		return types.TokenPairDenomType_TOKEN_FACTORY, nil
+	case "ibc":
+		return types.TokenPairDenomType_IBC, nil
+	case "peggy":
+		return types.TokenPairDenomType_PEGGY, nil
+	case "native":
+		return types.TokenPairDenomType_NATIVE, nil
+	default:
+		return types.TokenPairDenomType_UNSPECIFIED, fmt.Errorf("invalid denom type %s, expected one of tokenfactory, ibc, peggy, native", denomType)
+	}
+}
+
 func GetTokenPairByDenom() *cobra.Command {
 	return cli.QueryCmd("token-pair-by-denom <denom>",
 		"Returns the token pair associated with denom",
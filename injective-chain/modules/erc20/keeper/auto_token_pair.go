@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/bindings/cosmos/precompile/bank"
+)
+
+// maxPendingDenomsPerBlock bounds the work done by the EndBlocker, as every auto created token pair deploys a contract.
+// Denoms left over are processed in one of the next blocks.
+const maxPendingDenomsPerBlock = 20
+
+// QueueTokenPairSync queues the denom for token pair creation or metadata sync at the end of the block. It is called
+// when the bank metadata of a denom is set and when a denom is moved by the exchange precompile. Denoms that have no
+// token pair are only queued if the auto creation of token pairs is enabled.
+func (k Keeper) QueueTokenPairSync(ctx sdk.Context, denom string) {
+	if pair, _ := k.GetTokenPairForDenom(ctx, denom); pair == nil && !k.canAutoCreateTokenPair(ctx, denom) {
+		return
+	}
+
+	k.getPendingDenomsStore(ctx).Set([]byte(denom), []byte{})
+}
+
+// ProcessPendingTokenPairs creates the token pairs of the queued denoms, or syncs their metadata if the pair already
+// exists. Failures are logged and don't halt the processing of the other denoms.
+func (k Keeper) ProcessPendingTokenPairs(ctx sdk.Context) {
+	store := k.getPendingDenomsStore(ctx)
+	iter := store.Iterator(nil, nil)
+
+	denoms := make([]string, 0)
+	for ; iter.Valid() && len(denoms) < maxPendingDenomsPerBlock; iter.Next() {
+		denoms = append(denoms, string(iter.Key()))
+	}
+	iter.Close()
+
+	for _, denom := range denoms {
+		store.Delete([]byte(denom))
+		k.processPendingDenom(ctx, denom)
+	}
+}
+
+func (k Keeper) processPendingDenom(ctx sdk.Context, denom string) {
+	if pair, _ := k.GetTokenPairForDenom(ctx, denom); pair != nil {
+		k.syncTokenMetadata(ctx, *pair)
+		return
+	}
+
+	if !k.canAutoCreateTokenPair(ctx, denom) {
+		return
+	}
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	pair, err := k.autoCreateTokenPair(cacheCtx, denom)
+	if err != nil {
+		k.Logger(ctx).Error("failed to auto create token pair", "denom", denom, "error", err)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventAutoCreateTokenPairFailed{
+			BankDenom: denom,
+			Reason:    err.Error(),
+		})
+		return
+	}
+	writeCache()
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCreateTokenPair{
+		BankDenom:    pair.BankDenom,
+		Erc20Address: pair.Erc20Address,
+	})
+
+	k.syncTokenMetadata(ctx, *pair)
+	k.afterTokenPairCreated(ctx, *pair)
+}
+
+func (k Keeper) canAutoCreateTokenPair(ctx sdk.Context, denom string) bool {
+	if !k.GetParams(ctx).AutoCreateTokenPairs {
+		return false
+	}
+
+	switch types.GetDenomType(denom) {
+	case types.DenomTypeTokenFactory, types.DenomTypePeggy, types.DenomTypeIBC:
+		return true
+	default:
+		return false
+	}
+}
+
+// autoCreateTokenPair deploys the canonical bank-backed ERC20 of the denom from the module account. Tokenfactory
+// denoms get the MintBurn implementation owned by the denom admin, unless the denom has no admin or its permissions
+// disable minting/burning/superBurning, in which case they get the FixedSupply implementation like the other denoms.
+func (k Keeper) autoCreateTokenPair(ctx sdk.Context, denom string) (*types.TokenPair, error) {
+	pair := &types.TokenPair{BankDenom: denom}
+	if err := pair.Validate(); err != nil {
+		return nil, err
+	}
+
+	if _, found := k.bankKeeper.GetDenomMetaData(ctx, denom); !found && !k.bankKeeper.HasSupply(ctx, denom) {
+		return nil, types.ErrUnknownBankDenom
+	}
+
+	// make sure the deployer is a module account before the EVM creates a base account for it
+	deployer := k.accountKeeper.GetModuleAccount(ctx, types.ModuleName).GetAddress()
+
+	owner, err := k.autoTokenPairOwner(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	var contractAddr common.Address
+	if owner != nil {
+		contractAddr, err = k.DeploySmartContract(ctx, bank.MintBurnBankERC20MetaData, deployer, common.BytesToAddress(owner.Bytes()), "", "", uint8(0))
+	} else {
+		contractAddr, err = k.DeploySmartContract(ctx, bank.FixedSupplyBankERC20MetaData, deployer, "", "", uint8(0), big.NewInt(0))
+	}
+	if err != nil {
+		return nil, errors.Wrap(types.ErrUploadERC20Contract, err.Error())
+	}
+
+	if existing, _ := k.GetTokenPairForERC20(ctx, contractAddr); existing != nil {
+		return nil, errors.Wrapf(types.ErrTokenPairExists, "token pair for ERC20 token %s already exists", contractAddr)
+	}
+
+	pair.Erc20Address = contractAddr.String()
+	k.storeTokenPair(ctx, *pair)
+
+	return pair, nil
+}
+
+// autoTokenPairOwner returns the owner of the MintBurn ERC20 of the denom, or nil if the denom gets a FixedSupply ERC20
+func (k Keeper) autoTokenPairOwner(ctx sdk.Context, denom string) (sdk.AccAddress, error) {
+	if types.GetDenomType(denom) != types.DenomTypeTokenFactory || k.isFixedSupplyDenom(ctx, denom) {
+		return nil, nil
+	}
+
+	authorityMetadata, err := k.tfKeeper.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidTFDenom, err.Error())
+	}
+	if authorityMetadata.Admin == "" {
+		return nil, nil
+	}
+
+	owner, err := sdk.AccAddressFromBech32(authorityMetadata.Admin)
+	if err != nil {
+		return nil, errors.Wrap(types.ErrInvalidTFDenom, err.Error())
+	}
+
+	return owner, nil
+}
+
+// syncTokenMetadata updates the metadata of the token pair when the bank metadata of its denom has changed
+func (k Keeper) syncTokenMetadata(ctx sdk.Context, pair types.TokenPair) {
+	bankMetadata, found := k.bankKeeper.GetDenomMetaData(ctx, pair.BankDenom)
+	if !found {
+		return
+	}
+
+	metadata := types.TokenMetadata{
+		Name:     bankMetadata.Name,
+		Symbol:   bankMetadata.Symbol,
+		Decimals: bankMetadata.Decimals,
+	}
+
+	if pair.Name == metadata.Name && pair.Symbol == metadata.Symbol && pair.Decimals == metadata.Decimals {
+		return
+	}
+
+	k.setTokenMetadata(ctx, pair.BankDenom, metadata)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventTokenPairMetadataUpdated{
+		BankDenom:    pair.BankDenom,
+		Erc20Address: pair.Erc20Address,
+		Name:         metadata.Name,
+		Symbol:       metadata.Symbol,
+		Decimals:     metadata.Decimals,
+	})
+}
@@ -13,6 +13,16 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 
 	for _, pair := range genState.GetTokenPairs() {
 		k.storeTokenPair(ctx, pair)
+
+		if pair.Name != "" || pair.Symbol != "" || pair.Decimals != 0 {
+			k.setTokenMetadata(ctx, pair.BankDenom, types.TokenMetadata{
+				Name:     pair.Name,
+				Symbol:   pair.Symbol,
+				Decimals: pair.Decimals,
+			})
+		}
+
+		k.afterTokenPairCreated(ctx, pair)
 	}
 }
 
@@ -39,6 +39,9 @@ func (q queryServer) AllTokenPairs(c context.Context, req *types.QueryAllTokenPa
 			BankDenom:    string(key),
 			Erc20Address: common.BytesToAddress(value).String(),
 		}
+		if err := q.fillTokenMetadata(ctx, pair); err != nil {
+			return err
+		}
 		pairs = append(pairs, pair)
 		return nil
 	})
@@ -52,6 +55,44 @@ func (q queryServer) AllTokenPairs(c context.Context, req *types.QueryAllTokenPa
 	}, nil
 }
 
+func (q queryServer) TokenPairs(c context.Context, req *types.QueryTokenPairsRequest) (*types.QueryTokenPairsResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(types.ErrInvalidQueryRequest, "no request provided")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	store := q.getTokenPairsStoreByBankDenom(ctx)
+	pairs := make([]*types.TokenPair, 0)
+
+	pageRes, err := query.FilteredPaginate(store, req.Pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		bankDenom := string(key)
+		if req.DenomType != types.TokenPairDenomType_UNSPECIFIED && types.GetTokenPairDenomType(bankDenom) != req.DenomType {
+			return false, nil
+		}
+
+		if accumulate {
+			pair := &types.TokenPair{
+				BankDenom:    bankDenom,
+				Erc20Address: common.BytesToAddress(value).String(),
+			}
+			if err := q.fillTokenMetadata(ctx, pair); err != nil {
+				return false, err
+			}
+			pairs = append(pairs, pair)
+		}
+
+		return true, nil
+	})
+	if err != nil {
+		return nil, errors.Wrap(err, "can't paginate request")
+	}
+
+	return &types.QueryTokenPairsResponse{
+		TokenPairs: pairs,
+		Pagination: pageRes,
+	}, nil
+}
+
 func (q queryServer) TokenPairByDenom(c context.Context, req *types.QueryTokenPairByDenomRequest) (*types.QueryTokenPairByDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
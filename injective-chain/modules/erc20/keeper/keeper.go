@@ -33,6 +33,8 @@ type Keeper struct {
 	communityPoolKeeper types.CommunityPoolKeeper
 	permissionsKeeper   types.PermissionsKeeper
 
+	hooks types.Erc20Hooks
+
 	moduleAddress string
 	authority     string
 }
@@ -66,6 +68,27 @@ func (k Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
 
+// SetHooks sets the erc20 hooks. It must be called before the keeper is copied into the module.
+func (k *Keeper) SetHooks(h types.Erc20Hooks) {
+	if k.hooks != nil {
+		panic("cannot set erc20 hooks twice")
+	}
+
+	k.hooks = h
+}
+
+func (k Keeper) afterTokenPairCreated(ctx sdk.Context, pair types.TokenPair) {
+	if k.hooks != nil {
+		k.hooks.AfterTokenPairCreated(ctx, pair)
+	}
+}
+
+func (k Keeper) afterTokenPairDeleted(ctx sdk.Context, pair types.TokenPair) {
+	if k.hooks != nil {
+		k.hooks.AfterTokenPairDeleted(ctx, pair)
+	}
+}
+
 func (k Keeper) createTokenPair(ctx sdk.Context, sender sdk.AccAddress, pair *types.TokenPair) error {
 	switch types.GetDenomType(pair.BankDenom) {
 	case types.DenomTypeTokenFactory:
@@ -93,19 +116,7 @@ func (k Keeper) createTokenPairTokenFactory(c context.Context, sender sdk.AccAdd
 		return errors.Wrap(types.ErrUnauthorized, "only token factory denom admin can create erc20 pair for it")
 	}
 
-	var (
-		isMintingDisabled      bool
-		isBurningDisabled      bool
-		isSuperBurningDisabled bool
-	)
-
-	if k.permissionsKeeper.HasNamespace(ctx, pair.BankDenom) {
-		isMintingDisabled = k.permissionsKeeper.IsActionDisabledByPolicy(ctx, pair.BankDenom, permissionstypes.Action_MINT)
-		isBurningDisabled = k.permissionsKeeper.IsActionDisabledByPolicy(ctx, pair.BankDenom, permissionstypes.Action_BURN)
-		isSuperBurningDisabled = k.permissionsKeeper.IsActionDisabledByPolicy(ctx, pair.BankDenom, permissionstypes.Action_SUPER_BURN)
-	}
-
-	isFixedSupply := isMintingDisabled || isBurningDisabled || isSuperBurningDisabled
+	isFixedSupply := k.isFixedSupplyDenom(ctx, pair.BankDenom)
 
 	// deploy ERC20 contract if one was not provided in the msg
 	if pair.Erc20Address == "" {
@@ -132,6 +143,18 @@ func (k Keeper) createTokenPairTokenFactory(c context.Context, sender sdk.AccAdd
 	return nil
 }
 
+// isFixedSupplyDenom returns whether the permissions of the denom disable minting/burning/superBurning, so that only
+// the FixedSupplyERC20 implementation can be used for it.
+func (k Keeper) isFixedSupplyDenom(ctx sdk.Context, denom string) bool {
+	if !k.permissionsKeeper.HasNamespace(ctx, denom) {
+		return false
+	}
+
+	return k.permissionsKeeper.IsActionDisabledByPolicy(ctx, denom, permissionstypes.Action_MINT) ||
+		k.permissionsKeeper.IsActionDisabledByPolicy(ctx, denom, permissionstypes.Action_BURN) ||
+		k.permissionsKeeper.IsActionDisabledByPolicy(ctx, denom, permissionstypes.Action_SUPER_BURN)
+}
+
 // createTokenPairPeggy is a permissionless call to create token pair for peggy denoms.
 // Only support deploying owner-less ERC-20 implementation.
 func (k Keeper) createTokenPairPeggy(c context.Context, sender sdk.AccAddress, pair *types.TokenPair) error {
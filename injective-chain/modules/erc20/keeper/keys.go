@@ -10,6 +10,8 @@ var (
 	paramsKey        = []byte{0x01}
 	erc20ByBankDenom = []byte{0x02} // bank_denom => erc20_address
 	bankDenomByERC20 = []byte{0x03} // erc20_address => bank_denom
+	metadataByDenom  = []byte{0x04} // bank_denom => token_metadata
+	pendingDenoms    = []byte{0x05} // bank_denom => nil, denoms awaiting token pair creation or metadata sync
 )
 
 // getTokenPairsStoreByBankDenom returns the store prefix for denom => token map
@@ -23,3 +25,15 @@ func (k Keeper) getTokenPairsStoreByERC20(ctx sdk.Context) storetypes.KVStore {
 	store := ctx.KVStore(k.storeKey)
 	return prefix.NewStore(store, bankDenomByERC20)
 }
+
+// getTokenMetadataStore returns the store prefix for denom => token metadata map
+func (k Keeper) getTokenMetadataStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, metadataByDenom)
+}
+
+// getPendingDenomsStore returns the store prefix for the denoms awaiting token pair creation or metadata sync
+func (k Keeper) getPendingDenomsStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, pendingDenoms)
+}
@@ -0,0 +1,31 @@
+package keeper
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	bankkeeper "github.com/cosmos/cosmos-sdk/x/bank/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
+)
+
+// MetadataSyncBankKeeper wraps the bank keeper of the modules that create denoms (tokenfactory, IBC transfer) so that
+// every denom whose metadata is set gets queued for token pair creation or metadata sync.
+type MetadataSyncBankKeeper struct {
+	bankkeeper.Keeper
+
+	erc20Keeper *Keeper
+}
+
+// NewMetadataSyncBankKeeper returns a bank keeper which queues the denoms whose metadata is set in the erc20 keeper
+func NewMetadataSyncBankKeeper(bankKeeper bankkeeper.Keeper, erc20Keeper *Keeper) MetadataSyncBankKeeper {
+	return MetadataSyncBankKeeper{
+		Keeper:      bankKeeper,
+		erc20Keeper: erc20Keeper,
+	}
+}
+
+// SetDenomMetaData sets the denom metadata and queues the denom for token pair creation or metadata sync
+func (bk MetadataSyncBankKeeper) SetDenomMetaData(ctx context.Context, denomMetaData banktypes.Metadata) {
+	bk.Keeper.SetDenomMetaData(ctx, denomMetaData)
+	bk.erc20Keeper.QueueTokenPairSync(sdk.UnwrapSDKContext(ctx), denomMetaData.Base)
+}
@@ -0,0 +1,43 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 syncs the bank metadata of the existing token pairs
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	pairs, err := m.keeper.GetAllTokenPairs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range pairs {
+		m.keeper.syncTokenMetadata(ctx, *pair)
+	}
+
+	return nil
+}
+
+// Migrate2to3 notifies the hooks of the existing token pairs
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	pairs, err := m.keeper.GetAllTokenPairs(ctx)
+	if err != nil {
+		return err
+	}
+
+	for _, pair := range pairs {
+		m.keeper.afterTokenPairCreated(ctx, *pair)
+	}
+
+	return nil
+}
@@ -75,11 +75,21 @@ func (k msgServer) CreateTokenPair(c context.Context, msg *types.MsgCreateTokenP
 		}
 	}
 
-	pair := msg.TokenPair // copy request token pair
+	pair := types.TokenPair{ // copy request token pair, the metadata is always synced from bank
+		BankDenom:    msg.TokenPair.BankDenom,
+		Erc20Address: msg.TokenPair.Erc20Address,
+	}
 	if err := k.createTokenPair(ctx, sdk.MustAccAddressFromBech32(msg.Sender), &pair); err != nil {
 		return nil, err
 	}
 
+	k.syncTokenMetadata(ctx, pair)
+	if err := k.fillTokenMetadata(ctx, &pair); err != nil {
+		return nil, err
+	}
+
+	k.afterTokenPairCreated(ctx, pair)
+
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventCreateTokenPair{
 		BankDenom:    pair.BankDenom,
@@ -131,6 +141,7 @@ func (k msgServer) DeleteTokenPair(c context.Context, msg *types.MsgDeleteTokenP
 	}
 
 	k.deleteTokenPair(ctx, *pair)
+	k.afterTokenPairDeleted(ctx, *pair)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventDeleteTokenPair{
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
@@ -20,6 +21,10 @@ func (k Keeper) GetTokenPairForDenom(ctx sdk.Context, bankDenom string) (*types.
 		Erc20Address: common.BytesToAddress(bz).String(),
 	}
 
+	if err := k.fillTokenMetadata(ctx, pair); err != nil {
+		return nil, err
+	}
+
 	return pair, nil
 }
 
@@ -36,6 +41,10 @@ func (k Keeper) GetTokenPairForERC20(ctx sdk.Context, erc20Address common.Addres
 		Erc20Address: erc20Address.String(),
 	}
 
+	if err := k.fillTokenMetadata(ctx, pair); err != nil {
+		return nil, err
+	}
+
 	return pair, nil
 }
 
@@ -50,11 +59,47 @@ func (k Keeper) GetAllTokenPairs(ctx sdk.Context) ([]*types.TokenPair, error) {
 			BankDenom:    string(iter.Key()),
 			Erc20Address: common.BytesToAddress(iter.Value()).String(),
 		}
+		if err := k.fillTokenMetadata(ctx, pair); err != nil {
+			return nil, err
+		}
 		pairs = append(pairs, pair)
 	}
 	return pairs, nil
 }
 
+// GetTokenMetadata returns the bank metadata last synced for the token pair of the denom
+func (k Keeper) GetTokenMetadata(ctx sdk.Context, bankDenom string) (*types.TokenMetadata, error) {
+	bz := k.getTokenMetadataStore(ctx).Get([]byte(bankDenom))
+	if bz == nil {
+		return nil, nil
+	}
+
+	var metadata types.TokenMetadata
+	if err := proto.Unmarshal(bz, &metadata); err != nil {
+		return nil, err
+	}
+
+	return &metadata, nil
+}
+
+func (k Keeper) setTokenMetadata(ctx sdk.Context, bankDenom string, metadata types.TokenMetadata) {
+	bz, _ := proto.Marshal(&metadata)
+	k.getTokenMetadataStore(ctx).Set([]byte(bankDenom), bz)
+}
+
+func (k Keeper) fillTokenMetadata(ctx sdk.Context, pair *types.TokenPair) error {
+	metadata, err := k.GetTokenMetadata(ctx, pair.BankDenom)
+	if err != nil || metadata == nil {
+		return err
+	}
+
+	pair.Name = metadata.Name
+	pair.Symbol = metadata.Symbol
+	pair.Decimals = metadata.Decimals
+
+	return nil
+}
+
 func (k Keeper) storeTokenPair(ctx sdk.Context, pair types.TokenPair) {
 	store := k.getTokenPairsStoreByBankDenom(ctx)
 	store.Set([]byte(pair.BankDenom), common.HexToAddress(pair.Erc20Address).Bytes())
@@ -67,4 +112,5 @@ func (k Keeper) deleteTokenPair(ctx sdk.Context, pair types.TokenPair) {
 	store.Delete([]byte(pair.BankDenom))
 	store = k.getTokenPairsStoreByERC20(ctx)
 	store.Delete(common.HexToAddress(pair.Erc20Address).Bytes())
+	k.getTokenMetadataStore(ctx).Delete([]byte(pair.BankDenom))
 }
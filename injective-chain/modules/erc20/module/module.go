@@ -34,10 +34,11 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule     = AppModule{}
+	_ appmodule.HasEndBlocker = AppModule{}
 )
 
-const ConsensusVersion = 1
+const ConsensusVersion = 3
 
 // ----------------------------------------------------------------------------
 // AppModuleBasic
@@ -125,6 +126,14 @@ func (AppModule) QuerierRoute() string { return QuerierRoute }
 func (am AppModule) RegisterServices(cfg module.Configurator) {
 	types.RegisterMsgServer(cfg.MsgServer(), keeper.NewMsgServerImpl(am.keeper))
 	types.RegisterQueryServer(cfg.QueryServer(), keeper.NewQueryServerImpl(am.keeper))
+
+	migrator := keeper.NewMigrator(am.keeper)
+	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
+		panic(fmt.Sprintf("failed to migrate erc20 from version 1 to 2: %v", err))
+	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate erc20 from version 2 to 3: %v", err))
+	}
 }
 
 // InitGenesis performs the x/erc20 module's genesis initialization. It
@@ -143,5 +152,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// EndBlock creates the token pairs of the denoms queued during the block, or syncs their metadata.
+func (am AppModule) EndBlock(ctx context.Context) error {
+	am.keeper.ProcessPendingTokenPairs(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
@@ -22,11 +22,52 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// TokenPairDenomType defines the type of the bank denom of a token pair
+type TokenPairDenomType int32
+
+const (
+	TokenPairDenomType_UNSPECIFIED   TokenPairDenomType = 0
+	TokenPairDenomType_TOKEN_FACTORY TokenPairDenomType = 1
+	TokenPairDenomType_IBC           TokenPairDenomType = 2
+	TokenPairDenomType_PEGGY         TokenPairDenomType = 3
+	// NATIVE are the bank denoms which are neither tokenfactory, IBC nor peggy
+	// denoms, such as inj
+	TokenPairDenomType_NATIVE TokenPairDenomType = 4
+)
+
+var TokenPairDenomType_name = map[int32]string{
+	0: "UNSPECIFIED",
+	1: "TOKEN_FACTORY",
+	2: "IBC",
+	3: "PEGGY",
+	4: "NATIVE",
+}
+
+var TokenPairDenomType_value = map[string]int32{
+	"UNSPECIFIED":   0,
+	"TOKEN_FACTORY": 1,
+	"IBC":           2,
+	"PEGGY":         3,
+	"NATIVE":        4,
+}
+
+func (x TokenPairDenomType) String() string {
+	return proto.EnumName(TokenPairDenomType_name, int32(x))
+}
+
+func (TokenPairDenomType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a54415e94b67e1cf, []int{0}
+}
+
 // TokenPair defines an association of bank denom <-> EVM token (erc20 contract
 // address)
 type TokenPair struct {
 	BankDenom    string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
 	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	// associated bank denom
+	Name     string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol   string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
 }
 
 func (m *TokenPair) Reset()         { *m = TokenPair{} }
@@ -76,8 +117,93 @@ func (m *TokenPair) GetErc20Address() string {
 	return ""
 }
 
+func (m *TokenPair) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenPair) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenPair) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+// TokenMetadata defines the bank metadata of a token pair as last synced by the
+// module
+type TokenMetadata struct {
+	Name     string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol   string `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals uint32 `protobuf:"varint,3,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *TokenMetadata) Reset()         { *m = TokenMetadata{} }
+func (m *TokenMetadata) String() string { return proto.CompactTextString(m) }
+func (*TokenMetadata) ProtoMessage()    {}
+func (*TokenMetadata) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a54415e94b67e1cf, []int{1}
+}
+func (m *TokenMetadata) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TokenMetadata) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TokenMetadata.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TokenMetadata) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TokenMetadata.Merge(m, src)
+}
+func (m *TokenMetadata) XXX_Size() int {
+	return m.Size()
+}
+func (m *TokenMetadata) XXX_DiscardUnknown() {
+	xxx_messageInfo_TokenMetadata.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TokenMetadata proto.InternalMessageInfo
+
+func (m *TokenMetadata) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *TokenMetadata) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *TokenMetadata) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("injective.erc20.v1beta1.TokenPairDenomType", TokenPairDenomType_name, TokenPairDenomType_value)
 	proto.RegisterType((*TokenPair)(nil), "injective.erc20.v1beta1.TokenPair")
+	proto.RegisterType((*TokenMetadata)(nil), "injective.erc20.v1beta1.TokenMetadata")
 }
 
 func init() {
@@ -85,21 +211,30 @@ func init() {
 }
 
 var fileDescriptor_a54415e94b67e1cf = []byte{
-	// 212 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xce, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0x84, 0xf0, 0xf4, 0x0a, 0x8a, 0xf2, 0x4b, 0xf2, 0x85, 0xc4, 0xe1, 0x8a, 0xf4,
-	0x20, 0xc2, 0x50, 0x45, 0x4a, 0xfe, 0x5c, 0x9c, 0x21, 0xf9, 0xd9, 0xa9, 0x79, 0x01, 0x89, 0x99,
-	0x45, 0x42, 0xb2, 0x5c, 0x5c, 0x49, 0x89, 0x79, 0xd9, 0xf1, 0x29, 0xa9, 0x79, 0xf9, 0xb9, 0x12,
-	0x8c, 0x0a, 0x8c, 0x1a, 0x9c, 0x41, 0x9c, 0x20, 0x11, 0x17, 0x90, 0x80, 0x90, 0x32, 0x17, 0x2f,
-	0x58, 0x73, 0x7c, 0x62, 0x4a, 0x4a, 0x51, 0x6a, 0x71, 0xb1, 0x04, 0x13, 0x58, 0x05, 0x0f, 0x58,
-	0xd0, 0x11, 0x22, 0xe6, 0x94, 0x7a, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c, 0x0f, 0x1e,
-	0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72, 0x0c, 0x51,
-	0xde, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0x9e, 0x30, 0xe7, 0xf8,
-	0x24, 0x26, 0x15, 0xeb, 0xc3, 0x1d, 0xa7, 0x9b, 0x9c, 0x5f, 0x94, 0x8a, 0xcc, 0xcd, 0x48, 0xcc,
-	0xcc, 0xd3, 0xcf, 0xcd, 0x4f, 0x29, 0xcd, 0x49, 0x2d, 0x86, 0x7a, 0xaf, 0xa4, 0xb2, 0x20, 0xb5,
-	0x38, 0x89, 0x0d, 0xec, 0x2f, 0x63, 0x40, 0x00, 0x00, 0x00, 0xff, 0xff, 0x5d, 0x4b, 0x8a, 0xdb,
-	0xfe, 0x00, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0xcd, 0x4e, 0xc2, 0x40,
+	0x1c, 0xc4, 0xbb, 0x14, 0xd0, 0xfe, 0xb5, 0xb1, 0xee, 0x41, 0x1b, 0x13, 0x1b, 0x02, 0x17, 0x62,
+	0x22, 0x15, 0x7d, 0x02, 0x3e, 0x0a, 0x69, 0x50, 0x20, 0x58, 0x3f, 0xf0, 0x42, 0xb6, 0xed, 0x46,
+	0x2a, 0xb4, 0x4b, 0xda, 0x42, 0xc2, 0x5b, 0x78, 0xf3, 0x95, 0x3c, 0x72, 0xf4, 0x68, 0xe0, 0x45,
+	0x0c, 0x0b, 0x36, 0x9a, 0xe8, 0x6d, 0xe7, 0xb7, 0xb3, 0x93, 0x9d, 0x0c, 0x14, 0xbc, 0xe0, 0x85,
+	0x3a, 0xb1, 0x37, 0xa3, 0x3a, 0x0d, 0x9d, 0xcb, 0x0b, 0x7d, 0x56, 0xb6, 0x69, 0x4c, 0xca, 0x1b,
+	0x55, 0x9a, 0x84, 0x2c, 0x66, 0xf8, 0x38, 0x31, 0x95, 0x36, 0x78, 0x6b, 0xca, 0xbf, 0x21, 0x90,
+	0x2c, 0x36, 0xa2, 0x41, 0x97, 0x78, 0x21, 0x3e, 0x05, 0xb0, 0x49, 0x30, 0x1a, 0xb8, 0x34, 0x60,
+	0xbe, 0x8a, 0x72, 0xa8, 0x28, 0xf5, 0xa4, 0x35, 0xa9, 0xaf, 0x01, 0x2e, 0x80, 0xcc, 0x5f, 0x0f,
+	0x88, 0xeb, 0x86, 0x34, 0x8a, 0xd4, 0x14, 0x77, 0xec, 0x73, 0x58, 0xd9, 0x30, 0x8c, 0x21, 0x1d,
+	0x10, 0x9f, 0xaa, 0x22, 0xbf, 0xe3, 0x67, 0x7c, 0x04, 0xd9, 0x68, 0xee, 0xdb, 0x6c, 0xac, 0xa6,
+	0x39, 0xdd, 0x2a, 0x7c, 0x02, 0xbb, 0x2e, 0x75, 0x3c, 0x9f, 0x8c, 0x23, 0x35, 0x93, 0x43, 0x45,
+	0xb9, 0x97, 0xe8, 0xfc, 0x03, 0xc8, 0xfc, 0x63, 0x37, 0x34, 0x26, 0x2e, 0x89, 0x49, 0x12, 0x8c,
+	0xfe, 0x0c, 0x4e, 0xfd, 0x1b, 0x2c, 0xfe, 0x0e, 0x3e, 0x7b, 0x04, 0x9c, 0x34, 0xe6, 0xbd, 0xac,
+	0xf9, 0x84, 0xe2, 0x03, 0xd8, 0xbb, 0x6b, 0xdf, 0x76, 0x8d, 0x9a, 0xd9, 0x30, 0x8d, 0xba, 0x22,
+	0xe0, 0x43, 0x90, 0xad, 0x4e, 0xcb, 0x68, 0x0f, 0x1a, 0x95, 0x9a, 0xd5, 0xe9, 0xf5, 0x15, 0x84,
+	0x77, 0x40, 0x34, 0xab, 0x35, 0x25, 0x85, 0x25, 0xc8, 0x74, 0x8d, 0x66, 0xb3, 0xaf, 0x88, 0x18,
+	0x20, 0xdb, 0xae, 0x58, 0xe6, 0xbd, 0xa1, 0xa4, 0xab, 0xf4, 0x7d, 0xa9, 0xa1, 0xc5, 0x52, 0x43,
+	0x9f, 0x4b, 0x0d, 0xbd, 0xae, 0x34, 0x61, 0xb1, 0xd2, 0x84, 0x8f, 0x95, 0x26, 0x3c, 0xb5, 0x9e,
+	0xbd, 0x78, 0x38, 0xb5, 0x4b, 0x0e, 0xf3, 0x75, 0xf3, 0x7b, 0x8a, 0x6b, 0x62, 0x47, 0x7a, 0x32,
+	0xcc, 0xb9, 0xc3, 0x42, 0xfa, 0x53, 0x0e, 0x89, 0x17, 0xe8, 0x3e, 0x73, 0xa7, 0x63, 0x1a, 0x6d,
+	0xa7, 0x8d, 0xe7, 0x13, 0x1a, 0xd9, 0x59, 0xbe, 0xe9, 0xd5, 0xd7, 0x00, 0x0b, 0x4d, 0x38, 0x64,
+	0xfa, 0x01, 0x00, 0x00,
 }
 
 func (m *TokenPair) Marshal() (dAtA []byte, err error) {
@@ -122,6 +257,25 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
 	if len(m.Erc20Address) > 0 {
 		i -= len(m.Erc20Address)
 		copy(dAtA[i:], m.Erc20Address)
@@ -139,6 +293,48 @@ func (m *TokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TokenMetadata) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TokenMetadata) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TokenMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintErc20(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintErc20(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintErc20(dAtA []byte, offset int, v uint64) int {
 	offset -= sovErc20(v)
 	base := offset
@@ -164,6 +360,37 @@ func (m *TokenPair) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovErc20(uint64(l))
 	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovErc20(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *TokenMetadata) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovErc20(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovErc20(uint64(m.Decimals))
+	}
 	return n
 }
 
@@ -266,6 +493,222 @@ func (m *TokenPair) Unmarshal(dAtA []byte) error {
 			}
 			m.Erc20Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipErc20(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TokenMetadata) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowErc20
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TokenMetadata: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TokenMetadata: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthErc20
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthErc20
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowErc20
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipErc20(dAtA[iNdEx:])
@@ -118,9 +118,139 @@ func (m *EventDeleteTokenPair) GetBankDenom() string {
 	return ""
 }
 
+type EventTokenPairMetadataUpdated struct {
+	BankDenom    string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Erc20Address string `protobuf:"bytes,2,opt,name=erc20_address,json=erc20Address,proto3" json:"erc20_address,omitempty"`
+	Name         string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol       string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Decimals     uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+}
+
+func (m *EventTokenPairMetadataUpdated) Reset()         { *m = EventTokenPairMetadataUpdated{} }
+func (m *EventTokenPairMetadataUpdated) String() string { return proto.CompactTextString(m) }
+func (*EventTokenPairMetadataUpdated) ProtoMessage()    {}
+func (*EventTokenPairMetadataUpdated) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a583daf83db5e2f, []int{2}
+}
+func (m *EventTokenPairMetadataUpdated) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventTokenPairMetadataUpdated) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventTokenPairMetadataUpdated.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventTokenPairMetadataUpdated) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventTokenPairMetadataUpdated.Merge(m, src)
+}
+func (m *EventTokenPairMetadataUpdated) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventTokenPairMetadataUpdated) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventTokenPairMetadataUpdated.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventTokenPairMetadataUpdated proto.InternalMessageInfo
+
+func (m *EventTokenPairMetadataUpdated) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *EventTokenPairMetadataUpdated) GetErc20Address() string {
+	if m != nil {
+		return m.Erc20Address
+	}
+	return ""
+}
+
+func (m *EventTokenPairMetadataUpdated) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EventTokenPairMetadataUpdated) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *EventTokenPairMetadataUpdated) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+type EventAutoCreateTokenPairFailed struct {
+	BankDenom string `protobuf:"bytes,1,opt,name=bank_denom,json=bankDenom,proto3" json:"bank_denom,omitempty"`
+	Reason    string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventAutoCreateTokenPairFailed) Reset()         { *m = EventAutoCreateTokenPairFailed{} }
+func (m *EventAutoCreateTokenPairFailed) String() string { return proto.CompactTextString(m) }
+func (*EventAutoCreateTokenPairFailed) ProtoMessage()    {}
+func (*EventAutoCreateTokenPairFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_2a583daf83db5e2f, []int{3}
+}
+func (m *EventAutoCreateTokenPairFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventAutoCreateTokenPairFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventAutoCreateTokenPairFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventAutoCreateTokenPairFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventAutoCreateTokenPairFailed.Merge(m, src)
+}
+func (m *EventAutoCreateTokenPairFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventAutoCreateTokenPairFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventAutoCreateTokenPairFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventAutoCreateTokenPairFailed proto.InternalMessageInfo
+
+func (m *EventAutoCreateTokenPairFailed) GetBankDenom() string {
+	if m != nil {
+		return m.BankDenom
+	}
+	return ""
+}
+
+func (m *EventAutoCreateTokenPairFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*EventCreateTokenPair)(nil), "injective.erc20.v1beta1.EventCreateTokenPair")
 	proto.RegisterType((*EventDeleteTokenPair)(nil), "injective.erc20.v1beta1.EventDeleteTokenPair")
+	proto.RegisterType((*EventTokenPairMetadataUpdated)(nil), "injective.erc20.v1beta1.EventTokenPairMetadataUpdated")
+	proto.RegisterType((*EventAutoCreateTokenPairFailed)(nil), "injective.erc20.v1beta1.EventAutoCreateTokenPairFailed")
 }
 
 func init() {
@@ -128,22 +258,28 @@ func init() {
 }
 
 var fileDescriptor_2a583daf83db5e2f = []byte{
-	// 238 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x2d, 0x4b, 0xcd, 0x2b, 0x29, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xab, 0xd2, 0x03, 0xab, 0xd2, 0x83, 0xaa, 0x52, 0x8a, 0xe2, 0x12, 0x71, 0x05,
-	0x29, 0x74, 0x2e, 0x4a, 0x4d, 0x2c, 0x49, 0x0d, 0xc9, 0xcf, 0x4e, 0xcd, 0x0b, 0x48, 0xcc, 0x2c,
-	0x12, 0x92, 0xe5, 0xe2, 0x4a, 0x4a, 0xcc, 0xcb, 0x8e, 0x4f, 0x49, 0xcd, 0xcb, 0xcf, 0x95, 0x60,
-	0x54, 0x60, 0xd4, 0xe0, 0x0c, 0xe2, 0x04, 0x89, 0xb8, 0x80, 0x04, 0x84, 0x94, 0xb9, 0x78, 0xc1,
-	0xe6, 0xc4, 0x27, 0xa6, 0xa4, 0x14, 0xa5, 0x16, 0x17, 0x4b, 0x30, 0x81, 0x55, 0xf0, 0x80, 0x05,
-	0x1d, 0x21, 0x62, 0x4a, 0xa6, 0x50, 0xb3, 0x5d, 0x52, 0x73, 0x52, 0x89, 0x37, 0xdb, 0x29, 0xf5,
-	0xc4, 0x23, 0x39, 0xc6, 0x0b, 0x8f, 0xe4, 0x18, 0x1f, 0x3c, 0x92, 0x63, 0x9c, 0xf0, 0x58, 0x8e,
-	0xe1, 0xc2, 0x63, 0x39, 0x86, 0x1b, 0x8f, 0xe5, 0x18, 0xa2, 0xbc, 0xd3, 0x33, 0x4b, 0x32, 0x4a,
-	0x93, 0xf4, 0x92, 0xf3, 0x73, 0xf5, 0x3d, 0x61, 0x1e, 0xf2, 0x49, 0x4c, 0x2a, 0xd6, 0x87, 0x7b,
-	0x4f, 0x37, 0x39, 0xbf, 0x28, 0x15, 0x99, 0x9b, 0x91, 0x98, 0x99, 0xa7, 0x9f, 0x9b, 0x9f, 0x52,
-	0x9a, 0x93, 0x5a, 0x0c, 0x0d, 0xa1, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0x70, 0xc8, 0x18,
-	0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x55, 0x9d, 0x46, 0xfd, 0x41, 0x01, 0x00, 0x00,
+	// 333 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x92, 0x41, 0x4a, 0xc3, 0x40,
+	0x14, 0x86, 0x1b, 0xad, 0xc5, 0x0e, 0x76, 0x33, 0x48, 0x0d, 0x42, 0x87, 0x12, 0x5d, 0x74, 0x63,
+	0x62, 0x15, 0x0f, 0x50, 0xad, 0x82, 0xa8, 0x20, 0x45, 0x11, 0xba, 0x29, 0x2f, 0x99, 0x87, 0x1d,
+	0x9b, 0xcc, 0x94, 0x99, 0x69, 0xa1, 0xb7, 0xf0, 0x1e, 0x5e, 0xc4, 0x65, 0x97, 0x2e, 0xa5, 0xbd,
+	0x88, 0x74, 0x8c, 0x41, 0xdc, 0xe8, 0xc2, 0x5d, 0xfe, 0x3f, 0xdf, 0xfb, 0xf9, 0x99, 0xf7, 0xc8,
+	0xbe, 0x90, 0x4f, 0x98, 0x58, 0x31, 0xc5, 0x08, 0x75, 0x72, 0x74, 0x18, 0x4d, 0xdb, 0x31, 0x5a,
+	0x68, 0x47, 0x38, 0x45, 0x69, 0x4d, 0x38, 0xd6, 0xca, 0x2a, 0xba, 0x53, 0x50, 0xa1, 0xa3, 0xc2,
+	0x9c, 0x0a, 0xfa, 0x64, 0xfb, 0x7c, 0x05, 0x9e, 0x69, 0x04, 0x8b, 0x77, 0x6a, 0x84, 0xf2, 0x16,
+	0x84, 0xa6, 0x0d, 0x42, 0x62, 0x90, 0xa3, 0x01, 0x47, 0xa9, 0x32, 0xdf, 0x6b, 0x7a, 0xad, 0x6a,
+	0xaf, 0xba, 0x72, 0xba, 0x2b, 0x83, 0xee, 0x91, 0x9a, 0xcb, 0x19, 0x00, 0xe7, 0x1a, 0x8d, 0xf1,
+	0xd7, 0x1c, 0xb1, 0xe5, 0xcc, 0xce, 0xa7, 0x17, 0x9c, 0xe4, 0xd9, 0x5d, 0x4c, 0xf1, 0xef, 0xd9,
+	0xc1, 0x8b, 0x47, 0x1a, 0x6e, 0xae, 0x98, 0xb8, 0x41, 0x0b, 0x1c, 0x2c, 0xdc, 0x8f, 0x39, 0x58,
+	0xe4, 0xff, 0x51, 0x8e, 0x52, 0x52, 0x96, 0x90, 0xa1, 0xbf, 0xee, 0xfe, 0xb9, 0x6f, 0x5a, 0x27,
+	0x15, 0x33, 0xcb, 0x62, 0x95, 0xfa, 0x65, 0xe7, 0xe6, 0x8a, 0xee, 0x92, 0x4d, 0x8e, 0x89, 0xc8,
+	0x20, 0x35, 0xfe, 0x46, 0xd3, 0x6b, 0xd5, 0x7a, 0x85, 0x0e, 0x1e, 0x08, 0x73, 0x65, 0x3b, 0x13,
+	0xab, 0x7e, 0x3c, 0xe2, 0x05, 0x88, 0xf4, 0xf7, 0xb6, 0x75, 0x52, 0xd1, 0x08, 0x46, 0xc9, 0xbc,
+	0x66, 0xae, 0x4e, 0xf1, 0x75, 0xc1, 0xbc, 0xf9, 0x82, 0x79, 0xef, 0x0b, 0xe6, 0x3d, 0x2f, 0x59,
+	0x69, 0xbe, 0x64, 0xa5, 0xb7, 0x25, 0x2b, 0xf5, 0xaf, 0x1e, 0x85, 0x1d, 0x4e, 0xe2, 0x30, 0x51,
+	0x59, 0x74, 0xf9, 0xb5, 0xd7, 0x6b, 0x88, 0x4d, 0x54, 0x6c, 0xf9, 0x20, 0x51, 0x1a, 0xbf, 0xcb,
+	0x21, 0x08, 0x19, 0x65, 0x8a, 0x4f, 0x52, 0x34, 0xf9, 0xa1, 0xd8, 0xd9, 0x18, 0x4d, 0x5c, 0x71,
+	0x07, 0x72, 0xfc, 0x31, 0x00, 0xea, 0x52, 0x5d, 0x68, 0x48, 0x02, 0x00, 0x00,
 }
 
 func (m *EventCreateTokenPair) Marshal() (dAtA []byte, err error) {
@@ -213,6 +349,99 @@ func (m *EventDeleteTokenPair) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventTokenPairMetadataUpdated) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventTokenPairMetadataUpdated) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventTokenPairMetadataUpdated) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Decimals != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Erc20Address) > 0 {
+		i -= len(m.Erc20Address)
+		copy(dAtA[i:], m.Erc20Address)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Erc20Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventAutoCreateTokenPairFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAutoCreateTokenPairFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventAutoCreateTokenPairFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.BankDenom) > 0 {
+		i -= len(m.BankDenom)
+		copy(dAtA[i:], m.BankDenom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BankDenom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -254,6 +483,51 @@ func (m *EventDeleteTokenPair) Size() (n int) {
 	return n
 }
 
+func (m *EventTokenPairMetadataUpdated) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Erc20Address)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovEvents(uint64(m.Decimals))
+	}
+	return n
+}
+
+func (m *EventAutoCreateTokenPairFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BankDenom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -456,6 +730,317 @@ func (m *EventDeleteTokenPair) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventTokenPairMetadataUpdated) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventTokenPairMetadataUpdated: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventTokenPairMetadataUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Erc20Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Erc20Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventAutoCreateTokenPairFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAutoCreateTokenPairFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAutoCreateTokenPairFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BankDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BankDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
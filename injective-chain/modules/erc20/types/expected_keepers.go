@@ -24,6 +24,7 @@ type BankKeeper interface {
 
 type AccountKeeper interface {
 	GetSequence(ctx context.Context, addr sdk.AccAddress) (uint64, error)
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
 }
 
 type EVMKeeper interface {
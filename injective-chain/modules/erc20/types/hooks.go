@@ -0,0 +1,31 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// Erc20Hooks lets other modules react to token pairs being created or deleted.
+type Erc20Hooks interface {
+	AfterTokenPairCreated(ctx sdk.Context, pair TokenPair)
+	AfterTokenPairDeleted(ctx sdk.Context, pair TokenPair)
+}
+
+var _ Erc20Hooks = MultiErc20Hooks{}
+
+type MultiErc20Hooks []Erc20Hooks
+
+func NewMultiErc20Hooks(hooks ...Erc20Hooks) MultiErc20Hooks {
+	return hooks
+}
+
+func (h MultiErc20Hooks) AfterTokenPairCreated(ctx sdk.Context, pair TokenPair) {
+	for i := range h {
+		h[i].AfterTokenPairCreated(ctx, pair)
+	}
+}
+
+func (h MultiErc20Hooks) AfterTokenPairDeleted(ctx sdk.Context, pair TokenPair) {
+	for i := range h {
+		h[i].AfterTokenPairDeleted(ctx, pair)
+	}
+}
@@ -28,6 +28,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // Params defines the parameters for the erc20 module.
 type Params struct {
 	DenomCreationFee types.Coin `protobuf:"bytes,1,opt,name=denom_creation_fee,json=denomCreationFee,proto3" json:"denom_creation_fee" yaml:"denom_creation_fee"`
+	// auto_create_token_pairs enables the lazy deployment of the canonical
+	// bank-backed ERC20 for IBC, Peggy and tokenfactory denoms that don't have a
+	// token pair yet
+	AutoCreateTokenPairs bool `protobuf:"varint,2,opt,name=auto_create_token_pairs,json=autoCreateTokenPairs,proto3" json:"auto_create_token_pairs,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -70,6 +74,13 @@ func (m *Params) GetDenomCreationFee() types.Coin {
 	return types.Coin{}
 }
 
+func (m *Params) GetAutoCreateTokenPairs() bool {
+	if m != nil {
+		return m.AutoCreateTokenPairs
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.erc20.v1beta1.Params")
 }
@@ -79,26 +90,29 @@ func init() {
 }
 
 var fileDescriptor_020d1de5c91700a2 = []byte{
-	// 296 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xc9, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x4f, 0x2d, 0x4a, 0x36, 0x32, 0xd0, 0x2f, 0x33, 0x4c, 0x4a,
-	0x2d, 0x49, 0x34, 0xd4, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9,
-	0x17, 0x12, 0x87, 0xab, 0xd2, 0x03, 0xab, 0xd2, 0x83, 0xaa, 0x92, 0x12, 0x49, 0xcf, 0x4f, 0xcf,
-	0x07, 0xab, 0xd1, 0x07, 0xb1, 0x20, 0xca, 0xa5, 0xe4, 0x92, 0xf3, 0x8b, 0x73, 0xf3, 0x8b, 0xf5,
-	0x93, 0x12, 0x8b, 0x53, 0xe1, 0x06, 0x26, 0xe7, 0x67, 0xe6, 0x41, 0xe5, 0x05, 0x13, 0x73, 0x33,
-	0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x48, 0xa9, 0x9b, 0x91, 0x8b, 0x2d, 0x00, 0x6c, 0xa5, 0x50,
-	0x16, 0x97, 0x50, 0x4a, 0x6a, 0x5e, 0x7e, 0x6e, 0x7c, 0x72, 0x51, 0x6a, 0x62, 0x49, 0x66, 0x7e,
-	0x5e, 0x7c, 0x5a, 0x6a, 0xaa, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0xb7, 0x91, 0xa4, 0x1e, 0xc4, 0x68,
-	0x3d, 0x90, 0xd1, 0x30, 0x57, 0xe8, 0x39, 0xe7, 0x67, 0xe6, 0x39, 0x29, 0x9e, 0xb8, 0x27, 0xcf,
-	0xf0, 0xe9, 0x9e, 0xbc, 0x64, 0x65, 0x62, 0x6e, 0x8e, 0x95, 0x12, 0xa6, 0x11, 0x4a, 0x41, 0x02,
-	0x60, 0x41, 0x67, 0xa8, 0x98, 0x5b, 0x6a, 0xaa, 0x95, 0xe8, 0x8b, 0x05, 0xf2, 0x8c, 0x5d, 0xcf,
-	0x37, 0x68, 0xf1, 0x40, 0x7c, 0x0f, 0x71, 0x82, 0x53, 0xea, 0x89, 0x47, 0x72, 0x8c, 0x17, 0x1e,
-	0xc9, 0x31, 0x3e, 0x78, 0x24, 0xc7, 0x38, 0xe1, 0xb1, 0x1c, 0xc3, 0x85, 0xc7, 0x72, 0x0c, 0x37,
-	0x1e, 0xcb, 0x31, 0x44, 0x79, 0xa7, 0x67, 0x96, 0x64, 0x94, 0x26, 0xe9, 0x25, 0xe7, 0xe7, 0xea,
-	0x7b, 0xc2, 0x02, 0xc5, 0x27, 0x31, 0xa9, 0x58, 0x1f, 0x1e, 0x44, 0xba, 0xc9, 0xf9, 0x45, 0xa9,
-	0xc8, 0xdc, 0x8c, 0xc4, 0xcc, 0x3c, 0xfd, 0xdc, 0xfc, 0x94, 0xd2, 0x9c, 0xd4, 0x62, 0x68, 0x28,
-	0x97, 0x54, 0x16, 0xa4, 0x16, 0x27, 0xb1, 0x81, 0xfd, 0x6e, 0x0c, 0x08, 0x00, 0x00, 0xff, 0xff,
-	0x01, 0x56, 0x43, 0x50, 0x85, 0x01, 0x00, 0x00,
+	// 337 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xbf, 0x4e, 0x2a, 0x41,
+	0x18, 0xc5, 0x77, 0x6e, 0x41, 0x6e, 0xf6, 0xde, 0xe2, 0xde, 0x0d, 0x86, 0x3f, 0xc5, 0x82, 0xc4,
+	0x82, 0x98, 0xb8, 0x23, 0x18, 0x1b, 0x4a, 0x48, 0x4c, 0x8c, 0x16, 0x84, 0x58, 0xd9, 0x6c, 0x66,
+	0x87, 0x4f, 0x18, 0x64, 0xe6, 0xdb, 0xec, 0x0c, 0x24, 0xbc, 0x82, 0x95, 0x8f, 0xe0, 0x23, 0xf8,
+	0x0c, 0x56, 0x94, 0x94, 0x56, 0xc4, 0x40, 0xa1, 0xb5, 0x4f, 0x60, 0x76, 0x76, 0x21, 0x26, 0x36,
+	0x93, 0x99, 0x73, 0x7e, 0x73, 0xe6, 0xcb, 0x19, 0xf7, 0x48, 0xa8, 0x09, 0x70, 0x23, 0xe6, 0x40,
+	0x21, 0xe1, 0xed, 0x53, 0x3a, 0x6f, 0x45, 0x60, 0x58, 0x8b, 0xc6, 0x2c, 0x61, 0x52, 0x07, 0x71,
+	0x82, 0x06, 0xbd, 0xd2, 0x9e, 0x0a, 0x2c, 0x15, 0xe4, 0x54, 0xb5, 0x38, 0xc2, 0x11, 0x5a, 0x86,
+	0xa6, 0xbb, 0x0c, 0xaf, 0xfa, 0x1c, 0xb5, 0x44, 0x4d, 0x23, 0xa6, 0x61, 0x1f, 0xc8, 0x51, 0xa8,
+	0xdc, 0xff, 0xcf, 0xa4, 0x50, 0x48, 0xed, 0x9a, 0x49, 0x8d, 0x17, 0xe2, 0x16, 0xfa, 0xf6, 0x49,
+	0x6f, 0xe2, 0x7a, 0x43, 0x50, 0x28, 0x43, 0x9e, 0x00, 0x33, 0x02, 0x55, 0x78, 0x07, 0x50, 0x26,
+	0x75, 0xd2, 0xfc, 0xd3, 0xae, 0x04, 0x59, 0x74, 0x90, 0x46, 0xef, 0xa6, 0x08, 0x7a, 0x28, 0x54,
+	0xf7, 0x70, 0xb9, 0xae, 0x39, 0x9f, 0xeb, 0x5a, 0x65, 0xc1, 0xe4, 0xb4, 0xd3, 0xf8, 0x19, 0xd1,
+	0x18, 0xfc, 0xb3, 0x62, 0x2f, 0xd7, 0x2e, 0x00, 0xbc, 0x73, 0xb7, 0xc4, 0x66, 0x06, 0x33, 0x0e,
+	0x42, 0x83, 0xf7, 0xa0, 0xc2, 0x98, 0x89, 0x44, 0x97, 0x7f, 0xd5, 0x49, 0xf3, 0xf7, 0xa0, 0x98,
+	0xda, 0xf6, 0x06, 0xdc, 0xa4, 0x66, 0x3f, 0xf5, 0x3a, 0x07, 0x1f, 0x4f, 0x35, 0xf2, 0xf0, 0xfe,
+	0x7c, 0xfc, 0x37, 0x2b, 0x2d, 0x9b, 0xbc, 0x0b, 0xcb, 0x8d, 0x4f, 0x56, 0x1b, 0x9f, 0xbc, 0x6d,
+	0x7c, 0xf2, 0xb8, 0xf5, 0x9d, 0xd5, 0xd6, 0x77, 0x5e, 0xb7, 0xbe, 0x73, 0x7b, 0x35, 0x12, 0x66,
+	0x3c, 0x8b, 0x02, 0x8e, 0x92, 0x5e, 0xee, 0xba, 0xbc, 0x66, 0x91, 0xa6, 0xfb, 0x66, 0x4f, 0x38,
+	0x26, 0xf0, 0xfd, 0x38, 0x66, 0x42, 0x51, 0x89, 0xc3, 0xd9, 0x14, 0x74, 0xfe, 0x39, 0x66, 0x11,
+	0x83, 0x8e, 0x0a, 0xb6, 0xb2, 0xb3, 0xaf, 0x01, 0x00, 0xb5, 0xdd, 0x17, 0xc1, 0xbc, 0x01, 0x00,
+	0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -123,6 +137,9 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.DenomCreationFee.Equal(&that1.DenomCreationFee) {
 		return false
 	}
+	if this.AutoCreateTokenPairs != that1.AutoCreateTokenPairs {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -145,6 +162,16 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AutoCreateTokenPairs {
+		i--
+		if m.AutoCreateTokenPairs {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
 	{
 		size, err := m.DenomCreationFee.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -177,6 +204,9 @@ func (m *Params) Size() (n int) {
 	_ = l
 	l = m.DenomCreationFee.Size()
 	n += 1 + l + sovParams(uint64(l))
+	if m.AutoCreateTokenPairs {
+		n += 2
+	}
 	return n
 }
 
@@ -248,6 +278,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoCreateTokenPairs", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.AutoCreateTokenPairs = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -216,6 +216,118 @@ func (m *QueryAllTokenPairsResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// QueryTokenPairsRequest is the request type for the Query/TokenPairs RPC
+// method.
+type QueryTokenPairsRequest struct {
+	// denom_type filters the token pairs by the type of their bank denom, all
+	// token pairs are returned if unspecified
+	DenomType TokenPairDenomType `protobuf:"varint,1,opt,name=denom_type,json=denomType,proto3,enum=injective.erc20.v1beta1.TokenPairDenomType" json:"denom_type,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenPairsRequest) Reset()         { *m = QueryTokenPairsRequest{} }
+func (m *QueryTokenPairsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairsRequest) ProtoMessage()    {}
+func (*QueryTokenPairsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{4}
+}
+func (m *QueryTokenPairsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenPairsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairsRequest.Merge(m, src)
+}
+func (m *QueryTokenPairsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenPairsRequest proto.InternalMessageInfo
+
+func (m *QueryTokenPairsRequest) GetDenomType() TokenPairDenomType {
+	if m != nil {
+		return m.DenomType
+	}
+	return TokenPairDenomType_UNSPECIFIED
+}
+
+func (m *QueryTokenPairsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryTokenPairsResponse is the response type for the Query/TokenPairs RPC
+// method.
+type QueryTokenPairsResponse struct {
+	TokenPairs []*TokenPair `protobuf:"bytes,1,rep,name=token_pairs,json=tokenPairs,proto3" json:"token_pairs,omitempty"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryTokenPairsResponse) Reset()         { *m = QueryTokenPairsResponse{} }
+func (m *QueryTokenPairsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryTokenPairsResponse) ProtoMessage()    {}
+func (*QueryTokenPairsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5110d01ee5d7f02e, []int{5}
+}
+func (m *QueryTokenPairsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryTokenPairsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryTokenPairsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryTokenPairsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryTokenPairsResponse.Merge(m, src)
+}
+func (m *QueryTokenPairsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryTokenPairsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryTokenPairsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryTokenPairsResponse proto.InternalMessageInfo
+
+func (m *QueryTokenPairsResponse) GetTokenPairs() []*TokenPair {
+	if m != nil {
+		return m.TokenPairs
+	}
+	return nil
+}
+
+func (m *QueryTokenPairsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
 // QueryTokenPairByDenomRequest is the request type for the
 // Query/TokenPairByDenom RPC method.
 type QueryTokenPairByDenomRequest struct {
@@ -226,7 +338,7 @@ func (m *QueryTokenPairByDenomRequest) Reset()         { *m = QueryTokenPairByDe
 func (m *QueryTokenPairByDenomRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairByDenomRequest) ProtoMessage()    {}
 func (*QueryTokenPairByDenomRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5110d01ee5d7f02e, []int{4}
+	return fileDescriptor_5110d01ee5d7f02e, []int{6}
 }
 func (m *QueryTokenPairByDenomRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -272,7 +384,7 @@ func (m *QueryTokenPairByDenomResponse) Reset()         { *m = QueryTokenPairByD
 func (m *QueryTokenPairByDenomResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairByDenomResponse) ProtoMessage()    {}
 func (*QueryTokenPairByDenomResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5110d01ee5d7f02e, []int{5}
+	return fileDescriptor_5110d01ee5d7f02e, []int{7}
 }
 func (m *QueryTokenPairByDenomResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -316,7 +428,7 @@ func (m *QueryTokenPairByERC20AddressRequest) Reset()         { *m = QueryTokenP
 func (m *QueryTokenPairByERC20AddressRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairByERC20AddressRequest) ProtoMessage()    {}
 func (*QueryTokenPairByERC20AddressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5110d01ee5d7f02e, []int{6}
+	return fileDescriptor_5110d01ee5d7f02e, []int{8}
 }
 func (m *QueryTokenPairByERC20AddressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -360,7 +472,7 @@ func (m *QueryTokenPairByERC20AddressResponse) Reset()         { *m = QueryToken
 func (m *QueryTokenPairByERC20AddressResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryTokenPairByERC20AddressResponse) ProtoMessage()    {}
 func (*QueryTokenPairByERC20AddressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5110d01ee5d7f02e, []int{7}
+	return fileDescriptor_5110d01ee5d7f02e, []int{9}
 }
 func (m *QueryTokenPairByERC20AddressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -401,6 +513,8 @@ func init() {
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.erc20.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryAllTokenPairsRequest)(nil), "injective.erc20.v1beta1.QueryAllTokenPairsRequest")
 	proto.RegisterType((*QueryAllTokenPairsResponse)(nil), "injective.erc20.v1beta1.QueryAllTokenPairsResponse")
+	proto.RegisterType((*QueryTokenPairsRequest)(nil), "injective.erc20.v1beta1.QueryTokenPairsRequest")
+	proto.RegisterType((*QueryTokenPairsResponse)(nil), "injective.erc20.v1beta1.QueryTokenPairsResponse")
 	proto.RegisterType((*QueryTokenPairByDenomRequest)(nil), "injective.erc20.v1beta1.QueryTokenPairByDenomRequest")
 	proto.RegisterType((*QueryTokenPairByDenomResponse)(nil), "injective.erc20.v1beta1.QueryTokenPairByDenomResponse")
 	proto.RegisterType((*QueryTokenPairByERC20AddressRequest)(nil), "injective.erc20.v1beta1.QueryTokenPairByERC20AddressRequest")
@@ -412,48 +526,52 @@ func init() {
 }
 
 var fileDescriptor_5110d01ee5d7f02e = []byte{
-	// 641 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x95, 0xcd, 0x4e, 0xdb, 0x40,
-	0x10, 0xc7, 0x63, 0x5a, 0x90, 0x18, 0x8a, 0x54, 0x6d, 0x91, 0xa0, 0x16, 0x18, 0xea, 0xd0, 0x12,
-	0x51, 0x6a, 0x07, 0x43, 0x39, 0x91, 0x43, 0x42, 0x3f, 0xd4, 0x8f, 0x03, 0xb5, 0x38, 0xf5, 0x12,
-	0xad, 0x9d, 0x95, 0x71, 0x71, 0x76, 0x8d, 0xd7, 0x41, 0xca, 0xb5, 0xe7, 0x1e, 0x2a, 0xf5, 0x2d,
-	0x78, 0x81, 0xbe, 0x02, 0x47, 0xa4, 0x72, 0xe8, 0xa9, 0xaa, 0x92, 0x3e, 0x48, 0xe5, 0xf5, 0xc6,
-	0x49, 0x68, 0x9c, 0x86, 0xaa, 0x37, 0x98, 0xfd, 0xcf, 0xfc, 0x7f, 0x33, 0x9e, 0x51, 0xa0, 0xe8,
-	0xd3, 0x0f, 0xc4, 0x8d, 0xfd, 0x33, 0x62, 0x92, 0xc8, 0xb5, 0xca, 0xe6, 0xd9, 0xb6, 0x43, 0x62,
-	0xbc, 0x6d, 0x9e, 0xb6, 0x48, 0xd4, 0x36, 0xc2, 0x88, 0xc5, 0x0c, 0x2d, 0x66, 0x22, 0x43, 0x88,
-	0x0c, 0x29, 0x52, 0x17, 0x3c, 0xe6, 0x31, 0xa1, 0x31, 0x93, 0xbf, 0x52, 0xb9, 0xba, 0xec, 0x31,
-	0xe6, 0x05, 0xc4, 0xc4, 0xa1, 0x6f, 0x62, 0x4a, 0x59, 0x8c, 0x63, 0x9f, 0x51, 0x2e, 0x5f, 0x35,
-	0x97, 0xf1, 0x26, 0xe3, 0xa6, 0x83, 0x39, 0xc9, 0xdc, 0x5c, 0xe6, 0x53, 0xf9, 0xbe, 0x39, 0xf8,
-	0x2e, 0x28, 0x32, 0x55, 0x88, 0x3d, 0x9f, 0x8a, 0x62, 0x52, 0xbb, 0x9e, 0x47, 0x1f, 0xe2, 0x08,
-	0x37, 0x7b, 0x8e, 0x0f, 0xf3, 0x54, 0x1e, 0xa1, 0x84, 0xfb, 0x3d, 0x59, 0xee, 0x28, 0xd2, 0x9e,
-	0x85, 0x48, 0x5f, 0x00, 0xf4, 0x2e, 0x61, 0x3a, 0x14, 0x06, 0x36, 0x39, 0x6d, 0x11, 0x1e, 0xeb,
-	0x47, 0x70, 0x6f, 0x28, 0xca, 0x43, 0x46, 0x39, 0x41, 0x15, 0x98, 0x49, 0x41, 0x96, 0x94, 0x35,
-	0xa5, 0x34, 0x67, 0xad, 0x1a, 0x39, 0x83, 0x34, 0xd2, 0xc4, 0xda, 0xed, 0x8b, 0x1f, 0xab, 0x05,
-	0x5b, 0x26, 0xe9, 0x2e, 0xdc, 0x17, 0x55, 0xab, 0x41, 0x70, 0xc4, 0x4e, 0x08, 0x3d, 0xc4, 0x7e,
-	0xd4, 0xb3, 0x44, 0x2f, 0x00, 0xfa, 0xe3, 0x90, 0xf5, 0x1f, 0x19, 0xe9, 0xec, 0x8c, 0x64, 0x76,
-	0x46, 0xfa, 0x05, 0xfb, 0x0e, 0x1e, 0x91, 0xb9, 0xf6, 0x40, 0xa6, 0x7e, 0xae, 0x80, 0x3a, 0xca,
-	0x45, 0xb6, 0x70, 0x00, 0x73, 0x71, 0x12, 0xad, 0x87, 0x49, 0x78, 0x49, 0x59, 0xbb, 0x55, 0x9a,
-	0xb3, 0xf4, 0xdc, 0x3e, 0xb2, 0x0a, 0x36, 0xc4, 0x59, 0x31, 0xf4, 0x72, 0x88, 0x75, 0x4a, 0xb0,
-	0x6e, 0xfc, 0x95, 0x35, 0x25, 0x18, 0x82, 0xad, 0xc0, 0xb2, 0x60, 0xcd, 0x6c, 0x6a, 0xed, 0x67,
-	0x84, 0xb2, 0x66, 0x6f, 0x28, 0x2b, 0x00, 0x0e, 0xa6, 0x27, 0xf5, 0x46, 0x12, 0x14, 0x43, 0x99,
-	0xb5, 0x67, 0x93, 0x88, 0x50, 0xe9, 0x0e, 0xac, 0xe4, 0xa4, 0xcb, 0x6e, 0xab, 0x00, 0xfd, 0x6e,
-	0xe5, 0x50, 0x27, 0x69, 0x76, 0x36, 0x6b, 0x56, 0x7f, 0x0d, 0xc5, 0xeb, 0x1e, 0xcf, 0xed, 0x03,
-	0xab, 0x5c, 0x6d, 0x34, 0x22, 0xc2, 0xb3, 0xcf, 0x57, 0x84, 0x79, 0x51, 0xac, 0x8e, 0xd3, 0xb8,
-	0x84, 0xbd, 0x23, 0x82, 0x52, 0xab, 0xfb, 0xb0, 0x3e, 0xbe, 0xd6, 0x7f, 0xc3, 0xb6, 0xae, 0xa6,
-	0x61, 0x5a, 0x78, 0xa1, 0x4f, 0x0a, 0xcc, 0xa4, 0xeb, 0x88, 0x1e, 0xe7, 0xd6, 0xf8, 0xf3, 0x06,
-	0xd4, 0xad, 0xc9, 0xc4, 0x29, 0xb2, 0xbe, 0xf1, 0xf1, 0xdb, 0xaf, 0x2f, 0x53, 0x0f, 0xd0, 0xaa,
-	0x39, 0xfe, 0x84, 0xd1, 0xb9, 0x02, 0xf3, 0x43, 0xab, 0x89, 0xac, 0xf1, 0x46, 0xa3, 0xae, 0x45,
-	0xdd, 0xb9, 0x51, 0x8e, 0x64, 0x2c, 0x0b, 0xc6, 0x4d, 0x54, 0xca, 0x65, 0xc4, 0x41, 0x50, 0x1f,
-	0x38, 0x0f, 0xf4, 0x55, 0x81, 0xbb, 0xd7, 0x97, 0x0b, 0x3d, 0x1d, 0xef, 0x9d, 0xb3, 0xcb, 0xea,
-	0xde, 0x4d, 0xd3, 0x24, 0xf5, 0xae, 0xa0, 0x36, 0xd0, 0x56, 0x2e, 0x75, 0x9f, 0xb8, 0xee, 0xb4,
-	0xd3, 0x5b, 0x41, 0x57, 0x0a, 0x2c, 0xe6, 0xac, 0x19, 0xda, 0x9f, 0x98, 0x64, 0xc4, 0xa6, 0xab,
-	0x95, 0x7f, 0xcc, 0x96, 0xed, 0xec, 0x8b, 0x76, 0xf6, 0xd0, 0xee, 0x84, 0xed, 0x0c, 0x5d, 0x55,
-	0x8d, 0x5c, 0x74, 0x34, 0xe5, 0xb2, 0xa3, 0x29, 0x3f, 0x3b, 0x9a, 0xf2, 0xb9, 0xab, 0x15, 0x2e,
-	0xbb, 0x5a, 0xe1, 0x7b, 0x57, 0x2b, 0xbc, 0x7f, 0xe3, 0xf9, 0xf1, 0x71, 0xcb, 0x31, 0x5c, 0xd6,
-	0x34, 0x5f, 0xf5, 0x2a, 0xbf, 0xc5, 0x0e, 0xef, 0xfb, 0x3c, 0x71, 0x59, 0x44, 0x06, 0xff, 0x3d,
-	0xc6, 0x3e, 0x35, 0x9b, 0xac, 0xd1, 0x0a, 0x08, 0x97, 0x10, 0x71, 0x3b, 0x24, 0xdc, 0x99, 0x11,
-	0x3f, 0x0e, 0x3b, 0xbf, 0x03, 0x00, 0x00, 0xff, 0xff, 0x4a, 0xc5, 0x75, 0x7f, 0x4e, 0x07, 0x00,
-	0x00,
+	// 713 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x96, 0xc1, 0x4f, 0xd4, 0x4e,
+	0x14, 0xc7, 0x77, 0xf8, 0xfd, 0xdc, 0x64, 0x1f, 0x62, 0xcc, 0x48, 0x04, 0x1b, 0x28, 0x58, 0x10,
+	0x08, 0x62, 0xbb, 0x14, 0xe4, 0x04, 0x07, 0x40, 0x31, 0xa2, 0x07, 0x6c, 0x38, 0x79, 0xd9, 0x4c,
+	0xbb, 0x93, 0x52, 0xd9, 0xed, 0x94, 0xb6, 0x4b, 0xb2, 0x57, 0xcf, 0x1e, 0x4c, 0x3c, 0x7b, 0x36,
+	0x21, 0xde, 0xfd, 0x17, 0x38, 0x92, 0xe8, 0xc1, 0x93, 0x31, 0xac, 0x7f, 0x82, 0x7f, 0x80, 0xe9,
+	0x74, 0xb6, 0xbb, 0x65, 0xb7, 0x4b, 0x21, 0x1e, 0xbc, 0x75, 0x5f, 0xbf, 0xef, 0xbd, 0xcf, 0xfb,
+	0xee, 0xbc, 0xd9, 0x85, 0x19, 0xc7, 0x7d, 0x43, 0xad, 0xd0, 0x39, 0xa6, 0x1a, 0xf5, 0x2d, 0xbd,
+	0xac, 0x1d, 0x2f, 0x9b, 0x34, 0x24, 0xcb, 0xda, 0x51, 0x83, 0xfa, 0x4d, 0xd5, 0xf3, 0x59, 0xc8,
+	0xf0, 0x58, 0x22, 0x52, 0xb9, 0x48, 0x15, 0x22, 0x69, 0xd4, 0x66, 0x36, 0xe3, 0x1a, 0x2d, 0x7a,
+	0x8a, 0xe5, 0xd2, 0x84, 0xcd, 0x98, 0x5d, 0xa3, 0x1a, 0xf1, 0x1c, 0x8d, 0xb8, 0x2e, 0x0b, 0x49,
+	0xe8, 0x30, 0x37, 0x10, 0x6f, 0x65, 0x8b, 0x05, 0x75, 0x16, 0x68, 0x26, 0x09, 0x68, 0xd2, 0xcd,
+	0x62, 0x8e, 0x2b, 0xde, 0x2f, 0x76, 0xbf, 0xe7, 0x14, 0x89, 0xca, 0x23, 0xb6, 0xe3, 0xf2, 0x62,
+	0x42, 0x3b, 0x9b, 0x45, 0xef, 0x11, 0x9f, 0xd4, 0xdb, 0x1d, 0x1f, 0x64, 0xa9, 0x6c, 0xea, 0xd2,
+	0xc0, 0x69, 0xcb, 0x32, 0xad, 0x88, 0x67, 0xe6, 0x22, 0x65, 0x14, 0xf0, 0xab, 0x88, 0x69, 0x8f,
+	0x37, 0x30, 0xe8, 0x51, 0x83, 0x06, 0xa1, 0xb2, 0x0f, 0x77, 0x52, 0xd1, 0xc0, 0x63, 0x6e, 0x40,
+	0xf1, 0x06, 0x14, 0x63, 0x90, 0x71, 0x34, 0x8d, 0x16, 0x86, 0xf5, 0x29, 0x35, 0xc3, 0x48, 0x35,
+	0x4e, 0xdc, 0xfa, 0xff, 0xf4, 0xc7, 0x54, 0xc1, 0x10, 0x49, 0x8a, 0x05, 0xf7, 0x78, 0xd5, 0xcd,
+	0x5a, 0x6d, 0x9f, 0x1d, 0x52, 0x77, 0x8f, 0x38, 0x7e, 0xbb, 0x25, 0xde, 0x01, 0xe8, 0xd8, 0x21,
+	0xea, 0xcf, 0xa9, 0xb1, 0x77, 0x6a, 0xe4, 0x9d, 0x1a, 0x7f, 0x83, 0x9d, 0x0e, 0x36, 0x15, 0xb9,
+	0x46, 0x57, 0xa6, 0x72, 0x82, 0x40, 0xea, 0xd7, 0x45, 0x8c, 0xb0, 0x0d, 0xc3, 0x61, 0x14, 0xad,
+	0x78, 0x51, 0x78, 0x1c, 0x4d, 0xff, 0xb7, 0x30, 0xac, 0x2b, 0x99, 0x73, 0x24, 0x15, 0x0c, 0x08,
+	0x93, 0x62, 0xf8, 0x59, 0x8a, 0x75, 0x88, 0xb3, 0xce, 0x5f, 0xca, 0x1a, 0x13, 0xa4, 0x60, 0x3f,
+	0x23, 0xb8, 0xcb, 0x61, 0x7b, 0xfd, 0xd8, 0x05, 0xa8, 0x52, 0x97, 0xd5, 0x2b, 0x61, 0xd3, 0xa3,
+	0xdc, 0x8f, 0x5b, 0xfa, 0xc3, 0xcb, 0x39, 0x9f, 0x44, 0x39, 0xfb, 0x4d, 0x8f, 0x1a, 0xa5, 0x6a,
+	0xfb, 0x11, 0xef, 0xf4, 0xe1, 0xbd, 0x8e, 0xb7, 0x9f, 0x10, 0x8c, 0xf5, 0xe0, 0xfe, 0x93, 0xc6,
+	0x6e, 0xc0, 0x44, 0x1a, 0x74, 0xab, 0xc9, 0x9d, 0x69, 0xbb, 0x3b, 0x09, 0x60, 0x12, 0xf7, 0xb0,
+	0xc2, 0x3d, 0xe2, 0xee, 0x96, 0x8c, 0x52, 0x14, 0xe1, 0x2a, 0xc5, 0x84, 0xc9, 0x8c, 0x74, 0x31,
+	0xed, 0x26, 0x40, 0x67, 0x5a, 0x71, 0x5a, 0xf3, 0x0c, 0x5b, 0x4a, 0x86, 0x55, 0x76, 0x61, 0xe6,
+	0x62, 0x8f, 0xa7, 0xc6, 0xb6, 0x5e, 0xde, 0xac, 0x56, 0x7d, 0x1a, 0x24, 0xe7, 0x60, 0x06, 0x46,
+	0x78, 0xb1, 0x0a, 0x89, 0xe3, 0x02, 0xf6, 0x26, 0x0f, 0x0a, 0xad, 0xe2, 0xc0, 0xec, 0xe0, 0x5a,
+	0x7f, 0x0d, 0x5b, 0xff, 0x5d, 0x84, 0x1b, 0xbc, 0x17, 0x7e, 0x87, 0xa0, 0x18, 0xef, 0x39, 0xce,
+	0x3e, 0x98, 0xbd, 0x97, 0x8b, 0xb4, 0x94, 0x4f, 0x1c, 0x23, 0x2b, 0xf3, 0x6f, 0xbf, 0xfe, 0xfa,
+	0x30, 0x74, 0x1f, 0x4f, 0x69, 0x83, 0xef, 0x46, 0x7c, 0x82, 0x60, 0x24, 0xb5, 0xf3, 0x58, 0x1f,
+	0xdc, 0xa8, 0xdf, 0x35, 0x24, 0xad, 0x5c, 0x29, 0x47, 0x30, 0x96, 0x39, 0xe3, 0x22, 0x5e, 0xc8,
+	0x64, 0x24, 0xb5, 0x5a, 0xa5, 0x6b, 0x3d, 0xf0, 0x47, 0x04, 0xd0, 0x45, 0xaa, 0x0d, 0xee, 0xda,
+	0x8b, 0x59, 0xce, 0x9f, 0x20, 0x18, 0x97, 0x38, 0xe3, 0x1c, 0x9e, 0xcd, 0x64, 0xec, 0xe6, 0xfb,
+	0x82, 0xe0, 0xf6, 0xc5, 0xc3, 0x8f, 0x1f, 0xe7, 0x6c, 0x9a, 0xde, 0x35, 0x69, 0xed, 0xaa, 0x69,
+	0x82, 0x78, 0x95, 0x13, 0xab, 0x78, 0x29, 0x07, 0x71, 0xc5, 0x6c, 0xc6, 0xbb, 0x8c, 0xbf, 0x21,
+	0x18, 0xcb, 0x58, 0x03, 0xbc, 0x9e, 0x9b, 0xa4, 0xcf, 0x26, 0x4a, 0x1b, 0xd7, 0xcc, 0x16, 0xe3,
+	0xac, 0xf3, 0x71, 0xd6, 0xf0, 0x6a, 0xce, 0x71, 0x52, 0x5b, 0xbf, 0x45, 0x4f, 0xcf, 0x65, 0x74,
+	0x76, 0x2e, 0xa3, 0x9f, 0xe7, 0x32, 0x7a, 0xdf, 0x92, 0x0b, 0x67, 0x2d, 0xb9, 0xf0, 0xbd, 0x25,
+	0x17, 0x5e, 0xbf, 0xb0, 0x9d, 0xf0, 0xa0, 0x61, 0xaa, 0x16, 0xab, 0x6b, 0xcf, 0xdb, 0x95, 0x5f,
+	0x12, 0x33, 0xe8, 0xf4, 0x79, 0x64, 0x31, 0x9f, 0x76, 0x7f, 0x3c, 0x20, 0x8e, 0xab, 0xd5, 0x59,
+	0xb5, 0x51, 0xa3, 0x81, 0x80, 0x88, 0x7e, 0x66, 0x02, 0xb3, 0xc8, 0xff, 0x15, 0xac, 0xfc, 0x19,
+	0x00, 0xd2, 0x0f, 0x8d, 0xdf, 0x47, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -474,6 +592,9 @@ type QueryClient interface {
 	// AllTokenPairs defines a gRPC query method that returns the erc20
 	// module's created token pairs.
 	AllTokenPairs(ctx context.Context, in *QueryAllTokenPairsRequest, opts ...grpc.CallOption) (*QueryAllTokenPairsResponse, error)
+	// TokenPairs defines a gRPC query method that returns the erc20 module's
+	// token pairs, optionally filtered by the type of their bank denom.
+	TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error)
 	// TokenPairByDenom defines a gRPC query method that returns the erc20
 	// module's token pair associated with the provided bank denom.
 	TokenPairByDenom(ctx context.Context, in *QueryTokenPairByDenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByDenomResponse, error)
@@ -508,6 +629,15 @@ func (c *queryClient) AllTokenPairs(ctx context.Context, in *QueryAllTokenPairsR
 	return out, nil
 }
 
+func (c *queryClient) TokenPairs(ctx context.Context, in *QueryTokenPairsRequest, opts ...grpc.CallOption) (*QueryTokenPairsResponse, error) {
+	out := new(QueryTokenPairsResponse)
+	err := c.cc.Invoke(ctx, "/injective.erc20.v1beta1.Query/TokenPairs", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TokenPairByDenom(ctx context.Context, in *QueryTokenPairByDenomRequest, opts ...grpc.CallOption) (*QueryTokenPairByDenomResponse, error) {
 	out := new(QueryTokenPairByDenomResponse)
 	err := c.cc.Invoke(ctx, "/injective.erc20.v1beta1.Query/TokenPairByDenom", in, out, opts...)
@@ -534,6 +664,9 @@ type QueryServer interface {
 	// AllTokenPairs defines a gRPC query method that returns the erc20
 	// module's created token pairs.
 	AllTokenPairs(context.Context, *QueryAllTokenPairsRequest) (*QueryAllTokenPairsResponse, error)
+	// TokenPairs defines a gRPC query method that returns the erc20 module's
+	// token pairs, optionally filtered by the type of their bank denom.
+	TokenPairs(context.Context, *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error)
 	// TokenPairByDenom defines a gRPC query method that returns the erc20
 	// module's token pair associated with the provided bank denom.
 	TokenPairByDenom(context.Context, *QueryTokenPairByDenomRequest) (*QueryTokenPairByDenomResponse, error)
@@ -552,6 +685,9 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) AllTokenPairs(ctx context.Context, req *QueryAllTokenPairsRequest) (*QueryAllTokenPairsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AllTokenPairs not implemented")
 }
+func (*UnimplementedQueryServer) TokenPairs(ctx context.Context, req *QueryTokenPairsRequest) (*QueryTokenPairsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TokenPairs not implemented")
+}
 func (*UnimplementedQueryServer) TokenPairByDenom(ctx context.Context, req *QueryTokenPairByDenomRequest) (*QueryTokenPairByDenomResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenPairByDenom not implemented")
 }
@@ -599,6 +735,24 @@ func _Query_AllTokenPairs_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_TokenPairs_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryTokenPairsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TokenPairs(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.erc20.v1beta1.Query/TokenPairs",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TokenPairs(ctx, req.(*QueryTokenPairsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TokenPairByDenom_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryTokenPairByDenomRequest)
 	if err := dec(in); err != nil {
@@ -647,6 +801,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AllTokenPairs",
 			Handler:    _Query_AllTokenPairs_Handler,
 		},
+		{
+			MethodName: "TokenPairs",
+			Handler:    _Query_TokenPairs_Handler,
+		},
 		{
 			MethodName: "TokenPairByDenom",
 			Handler:    _Query_TokenPairByDenom_Handler,
@@ -800,6 +958,95 @@ func (m *QueryAllTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryTokenPairsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DenomType != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DenomType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryTokenPairsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryTokenPairsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryTokenPairsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.TokenPairs) > 0 {
+		for iNdEx := len(m.TokenPairs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.TokenPairs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryTokenPairByDenomRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -993,6 +1240,41 @@ func (m *QueryAllTokenPairsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryTokenPairsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DenomType != 0 {
+		n += 1 + sovQuery(uint64(m.DenomType))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryTokenPairsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.TokenPairs) > 0 {
+		for _, e := range m.TokenPairs {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryTokenPairByDenomRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1390,6 +1672,231 @@ func (m *QueryAllTokenPairsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryTokenPairsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DenomType", wireType)
+			}
+			m.DenomType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DenomType |= TokenPairDenomType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryTokenPairsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryTokenPairsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPairs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPairs = append(m.TokenPairs, &TokenPair{})
+			if err := m.TokenPairs[len(m.TokenPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryTokenPairByDenomRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -56,6 +56,20 @@ func (pair *TokenPair) Validate() error {
 	return nil
 }
 
+// GetTokenPairDenomType returns the type of the bank denom of a token pair
+func GetTokenPairDenomType(bankDenom string) TokenPairDenomType {
+	switch GetDenomType(bankDenom) {
+	case DenomTypeTokenFactory:
+		return TokenPairDenomType_TOKEN_FACTORY
+	case DenomTypeIBC:
+		return TokenPairDenomType_IBC
+	case DenomTypePeggy:
+		return TokenPairDenomType_PEGGY
+	default:
+		return TokenPairDenomType_NATIVE
+	}
+}
+
 func GetDenomType(bankDenom string) denomType {
 	switch {
 	case strings.HasPrefix(bankDenom, "ibc/"):
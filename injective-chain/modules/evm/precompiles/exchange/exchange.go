@@ -23,6 +23,7 @@ import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/bindings/cosmos/precompile/exchange"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/precompiles/types"
+	evmtypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/evm/types"
 )
 
 const (
@@ -85,6 +86,7 @@ type ExchangeContract struct {
 	exchangeKeeper      *exchangekeeper.Keeper
 	exchangeQueryServer exchangetypesv2.QueryServer
 	authzKeeper         *authzkeeper.Keeper
+	erc20Keeper         evmtypes.ERC20Keeper
 	exchangeMsgServer   exchangetypesv2.MsgServer
 	kvGasConfig         storetypes.GasConfig
 }
@@ -92,12 +94,14 @@ type ExchangeContract struct {
 func NewExchangeContract(
 	exchangeKeeper *exchangekeeper.Keeper,
 	authzKeeper *authzkeeper.Keeper,
+	erc20Keeper evmtypes.ERC20Keeper,
 	kvGasConfig storetypes.GasConfig,
 ) vm.PrecompiledContract {
 	return &ExchangeContract{
 		exchangeKeeper:      exchangeKeeper,
 		exchangeQueryServer: exchangekeeper.NewQueryServer(exchangeKeeper),
 		authzKeeper:         authzKeeper,
+		erc20Keeper:         erc20Keeper,
 		exchangeMsgServer:   exchangekeeper.NewMsgServerImpl(exchangeKeeper),
 		kvGasConfig:         kvGasConfig,
 	}
@@ -1511,7 +1515,15 @@ func (ec *ExchangeContract) validateAndDispatchMsg(
 		func(ctx sdk.Context) (err error) {
 			ctx = ctx.WithValue(exchangetypesv2.ContextKeyHold, hold)
 			dispatchResults, err = ec.authzKeeper.DispatchActions(ctx, caller, []sdk.Msg{msg})
-			return err
+			if err != nil {
+				return err
+			}
+
+			// the denoms touched from EVM get their token pair lazily created, if enabled
+			for _, coin := range hold {
+				ec.erc20Keeper.QueueTokenPairSync(ctx, coin.Denom)
+			}
+			return nil
 		},
 	)
 	if err != nil {
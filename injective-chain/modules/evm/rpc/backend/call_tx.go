@@ -415,16 +415,22 @@ func (b *Backend) GasPrice() (*hexutil.Big, error) {
 		result *big.Int
 		err    error
 	)
-	head, err := b.CurrentHeader()
-	if err != nil {
-		return nil, err
+	// price against the consensus base fee of the next block, falling back to the base fee of the latest block
+	baseFee, err := b.NextBaseFee()
+	if err != nil || baseFee == nil {
+		head, err := b.CurrentHeader()
+		if err != nil {
+			return nil, err
+		}
+		baseFee = head.BaseFee
 	}
-	if head.BaseFee != nil {
-		result, err = b.SuggestGasTipCap(head.BaseFee)
+
+	if baseFee != nil {
+		result, err = b.SuggestGasTipCap(baseFee)
 		if err != nil {
 			return nil, err
 		}
-		result = result.Add(result, head.BaseFee)
+		result = result.Add(result, baseFee)
 	} else {
 		result = b.RPCMinGasPrice()
 	}
@@ -228,6 +228,9 @@ func (b *Backend) FeeHistory(
 		}
 	}
 
+	// prefer the consensus base fees recorded by the txfees module over the per-block approximations
+	b.setConsensusBaseFees(blockStart, blockEnd, thisBaseFee)
+
 	feeHistory := rpctypes.FeeHistoryResult{
 		OldestBlock:  oldestBlock,
 		BaseFee:      thisBaseFee,
@@ -287,13 +290,60 @@ func (b *Backend) GlobalMinGasPrice() (sdkmath.LegacyDec, error) {
 	return res.Params.MinGasPrice, nil
 }
 
-// BaseFee returns the base fee tracked by the txfees module.
-// If the base fee is not enabled globally, the query returns nil.
-// If the London hard fork is not activated at the current height, the query will
-// return nil.
+// setConsensusBaseFees sets the base fees of the blocks between blockStart and blockEnd, followed by the base fee
+// of the next block, from the base fee history of the txfees module. Blocks no longer in the history are left as is.
+func (b *Backend) setConsensusBaseFees(blockStart, blockEnd int64, baseFees []*hexutil.Big) {
+	res, err := b.queryClient.TxFeesQueryClient.BaseFeeHistory(b.ctx, &txfeestypes.QueryBaseFeeHistoryRequest{
+		BlockCount: uint64(blockEnd - blockStart + 1),
+		LastHeight: blockEnd,
+	})
+	if err != nil {
+		b.logger.Debug("failed to query base fee history", "error", err.Error())
+		return
+	}
+
+	for _, blockFee := range res.BlockFees {
+		if index := blockFee.Height - blockStart; index >= 0 && index < int64(len(baseFees)) {
+			baseFees[index] = (*hexutil.Big)(blockFee.BaseFee.RoundInt().BigInt())
+		}
+	}
+
+	if !res.NextBaseFee.IsNil() && res.NextBaseFee.IsPositive() {
+		baseFees[len(baseFees)-1] = (*hexutil.Big)(res.NextBaseFee.RoundInt().BigInt())
+	}
+}
+
+// NextBaseFee returns the consensus base fee of the next block, tracked by the txfees module
+func (b *Backend) NextBaseFee() (*big.Int, error) {
+	res, err := b.queryClient.TxFeesQueryClient.GetEipBaseFee(b.ctx, &txfeestypes.QueryEipBaseFeeRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.BaseFee == nil {
+		return nil, nil
+	}
+
+	return res.BaseFee.BaseFee.RoundInt().BigInt(), nil
+}
+
+// BaseFee returns the consensus base fee of the block, tracked by the txfees module.
+// If the block is no longer in the base fee history, the base fee is queried from the state of the previous block
+// and, if that state is pruned, parsed from the block events.
 func (b *Backend) BaseFee(blockRes *cmtrpctypes.ResultBlockResults) (*big.Int, error) {
-	// return BaseFee if London hard fork is activated and feemarket is enabled
-	res, err := b.queryClient.TxFeesQueryClient.GetEipBaseFee(rpctypes.ContextWithHeight(blockRes.Height), &txfeestypes.QueryEipBaseFeeRequest{})
+	historyRes, err := b.queryClient.TxFeesQueryClient.BaseFeeHistory(b.ctx, &txfeestypes.QueryBaseFeeHistoryRequest{
+		BlockCount: 1,
+		LastHeight: blockRes.Height,
+	})
+	if err == nil && len(historyRes.BlockFees) == 1 && historyRes.BlockFees[0].Height == blockRes.Height {
+		return historyRes.BlockFees[0].BaseFee.RoundInt().BigInt(), nil
+	}
+
+	// the base fee kept in state at a height is the base fee of the following block
+	res, err := b.queryClient.TxFeesQueryClient.GetEipBaseFee(
+		rpctypes.ContextWithHeight(max(blockRes.Height-1, 1)),
+		&txfeestypes.QueryEipBaseFeeRequest{},
+	)
 	if err != nil || res.BaseFee == nil {
 		// we can't tell if it's london HF not enabled or the state is pruned,
 		// in either case, we'll fallback to parsing from begin blocker event,
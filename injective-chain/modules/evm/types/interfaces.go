@@ -55,6 +55,7 @@ type StakingKeeper interface {
 type ERC20Keeper interface {
 	MintERC20(c context.Context, erc20Addr common.Address, minter sdk.AccAddress, amt sdkmath.Int) error
 	BurnERC20(c context.Context, erc20Addr common.Address, burner sdk.AccAddress, amt sdkmath.Int) error
+	QueueTokenPairSync(ctx sdk.Context, denom string)
 }
 
 type TFMsgServer interface {
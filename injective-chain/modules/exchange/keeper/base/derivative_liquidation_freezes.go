@@ -0,0 +1,82 @@
+package base
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
+)
+
+// SetDerivativeLiquidationFreeze freezes liquidations of a derivative market until the given block time.
+func (k *BaseKeeper) SetDerivativeLiquidationFreeze(ctx sdk.Context, marketID common.Hash, frozenUntil int64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(k.getStore(ctx), types.DerivativeLiquidationFreezePrefix)
+	store.Set(marketID.Bytes(), sdk.Uint64ToBigEndian(uint64(frozenUntil)))
+}
+
+// GetDerivativeLiquidationFreeze returns the block time until which liquidations of a derivative market are frozen.
+func (k *BaseKeeper) GetDerivativeLiquidationFreeze(ctx sdk.Context, marketID common.Hash) (frozenUntil int64, found bool) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(k.getStore(ctx), types.DerivativeLiquidationFreezePrefix)
+	bz := store.Get(marketID.Bytes())
+	if bz == nil {
+		return 0, false
+	}
+
+	return int64(sdk.BigEndianToUint64(bz)), true
+}
+
+// DeleteDerivativeLiquidationFreeze lifts the liquidation freeze of a derivative market.
+func (k *BaseKeeper) DeleteDerivativeLiquidationFreeze(ctx sdk.Context, marketID common.Hash) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(k.getStore(ctx), types.DerivativeLiquidationFreezePrefix)
+	store.Delete(marketID.Bytes())
+}
+
+// IsDerivativeLiquidationFrozen returns true if liquidations of a derivative market are frozen at the current block time.
+func (k *BaseKeeper) IsDerivativeLiquidationFrozen(ctx sdk.Context, marketID common.Hash) bool {
+	frozenUntil, found := k.GetDerivativeLiquidationFreeze(ctx, marketID)
+	return found && ctx.BlockTime().Unix() < frozenUntil
+}
+
+// IterateDerivativeLiquidationFreezes iterates over the liquidation freezes of all derivative markets.
+func (k *BaseKeeper) IterateDerivativeLiquidationFreezes(ctx sdk.Context, process func(marketID common.Hash, frozenUntil int64) (stop bool)) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(k.getStore(ctx), types.DerivativeLiquidationFreezePrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		if process(common.BytesToHash(iter.Key()), int64(sdk.BigEndianToUint64(iter.Value()))) {
+			return
+		}
+	}
+}
+
+// GetAllDerivativeLiquidationFreezes returns the liquidation freezes of all derivative markets.
+func (k *BaseKeeper) GetAllDerivativeLiquidationFreezes(ctx sdk.Context) []v2.DerivativeLiquidationFreeze {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	freezes := make([]v2.DerivativeLiquidationFreeze, 0)
+	k.IterateDerivativeLiquidationFreezes(ctx, func(marketID common.Hash, frozenUntil int64) (stop bool) {
+		freezes = append(freezes, v2.DerivativeLiquidationFreeze{
+			MarketId:    marketID.Hex(),
+			FrozenUntil: frozenUntil,
+		})
+		return false
+	})
+
+	return freezes
+}
@@ -0,0 +1,42 @@
+package base
+
+import (
+	"cosmossdk.io/store/prefix"
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
+// SetErc20TokenPairDenom stores the bank denom paired with an erc20 contract.
+func (k *BaseKeeper) SetErc20TokenPairDenom(ctx sdk.Context, erc20Address common.Address, bankDenom string) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(k.getStore(ctx), types.Erc20TokenPairDenomPrefix)
+	store.Set(erc20Address.Bytes(), []byte(bankDenom))
+}
+
+// GetErc20TokenPairDenom returns the bank denom paired with an erc20 contract.
+func (k *BaseKeeper) GetErc20TokenPairDenom(ctx sdk.Context, erc20Address common.Address) (bankDenom string, found bool) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(k.getStore(ctx), types.Erc20TokenPairDenomPrefix)
+	bz := store.Get(erc20Address.Bytes())
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
+
+// DeleteErc20TokenPairDenom deletes the bank denom paired with an erc20 contract.
+func (k *BaseKeeper) DeleteErc20TokenPairDenom(ctx sdk.Context, erc20Address common.Address) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(k.getStore(ctx), types.Erc20TokenPairDenomPrefix)
+	store.Delete(erc20Address.Bytes())
+}
@@ -560,6 +560,12 @@ func (k DerivativesMsgServer) liquidatePosition(
 		return nil, errors.Wrapf(types.ErrDerivativeMarketNotFound, "active derivative market for marketID %s not found", marketID.Hex())
 	}
 
+	// liquidations are paused while an oracle price jump of the market awaits confirmation
+	if liquidationMode == LiquidationModeRegular && k.IsDerivativeLiquidationFrozen(cacheCtx, marketID) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrLiquidationFrozen, "marketID %s", marketID.Hex())
+	}
+
 	position := k.GetPosition(cacheCtx, marketID, positionSubaccountID)
 	if position == nil || position.Quantity.IsZero() {
 		metrics.ReportFuncError(k.svcTags)
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"slices"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 
@@ -8,14 +10,26 @@ import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
 )
 
+// getEnforcedContractDenoms returns the bank denoms of the token corresponding to the given EVM contract: its erc20
+// denom and, if the contract is part of an erc20 token pair, the paired bank denom.
+func (k *Keeper) getEnforcedContractDenoms(ctx sdk.Context, contract common.Address) []string {
+	denoms := []string{erc20types.DenomPrefix + contract.Hex()}
+
+	if bankDenom, found := k.GetErc20TokenPairDenom(ctx, contract); found {
+		denoms = append(denoms, bankDenom)
+	}
+
+	return denoms
+}
+
 // OnEnforcedRestrictionsEVMContractPause pauses all derivative and binary options markets
 // denominated in the token corresponding to the given EVM contract.
 func (k *Keeper) OnEnforcedRestrictionsEVMContractPause(ctx sdk.Context, contract common.Address) error {
-	tokenBankDenom := erc20types.DenomPrefix + contract.Hex()
+	tokenBankDenoms := k.getEnforcedContractDenoms(ctx, contract)
 	derivativeMarkets := k.GetAllActiveDerivativeAndBinaryOptionsMarkets(ctx)
 
 	for _, market := range derivativeMarkets {
-		if market.GetQuoteDenom() != tokenBankDenom {
+		if !slices.Contains(tokenBankDenoms, market.GetQuoteDenom()) {
 			continue
 		}
 
@@ -38,13 +52,15 @@ func (k *Keeper) OnEnforcedRestrictionsEVMContractPause(ctx sdk.Context, contrac
 // OnEnforcedRestrictionsEVMContractBlacklist cancels all spot and derivative orders for the blacklisted address
 // across all markets denominated in the token corresponding to the given EVM contract.
 func (k *Keeper) OnEnforcedRestrictionsEVMContractBlacklist(ctx sdk.Context, contract, user common.Address) error {
-	tokenBankDenom := erc20types.DenomPrefix + contract.Hex()
+	tokenBankDenoms := k.getEnforcedContractDenoms(ctx, contract)
 	accountAddress := sdk.AccAddress(user.Bytes())
 
-	k.CancelAllDerivativeOrdersForAddress(ctx, tokenBankDenom, user)
+	for _, tokenBankDenom := range tokenBankDenoms {
+		k.CancelAllDerivativeOrdersForAddress(ctx, tokenBankDenom, user)
+	}
 
 	k.IterateSpotMarkets(ctx, nil, func(market *v2.SpotMarket) (stop bool) {
-		if market.QuoteDenom != tokenBankDenom && market.BaseDenom != tokenBankDenom {
+		if !slices.Contains(tokenBankDenoms, market.QuoteDenom) && !slices.Contains(tokenBankDenoms, market.BaseDenom) {
 			return false
 		}
 
@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
+)
+
+// Erc20Hooks keeps track of the bank denoms paired with erc20 contracts, so that the markets of a paired denom follow
+// the enforced restrictions (pause, blacklist) of its erc20 contract.
+type Erc20Hooks struct {
+	k *Keeper
+}
+
+var _ erc20types.Erc20Hooks = Erc20Hooks{}
+
+func (k *Keeper) Erc20Hooks() Erc20Hooks { return Erc20Hooks{k} }
+
+func (h Erc20Hooks) AfterTokenPairCreated(ctx sdk.Context, pair erc20types.TokenPair) {
+	h.k.SetErc20TokenPairDenom(ctx, common.HexToAddress(pair.Erc20Address), pair.BankDenom)
+}
+
+func (h Erc20Hooks) AfterTokenPairDeleted(ctx sdk.Context, pair erc20types.TokenPair) {
+	h.k.DeleteErc20TokenPairDenom(ctx, common.HexToAddress(pair.Erc20Address))
+}
@@ -279,6 +279,10 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data v2.GenesisState) {
 	for _, denomMinNotional := range data.DenomMinNotionals {
 		k.SetMinNotionalForDenom(ctx, denomMinNotional.Denom, denomMinNotional.MinNotional)
 	}
+
+	for _, freeze := range data.DerivativeLiquidationFreezes {
+		k.SetDerivativeLiquidationFreeze(ctx, common.HexToHash(freeze.MarketId), freeze.FrozenUntil)
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *v2.GenesisState {
@@ -320,5 +324,6 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *v2.GenesisState {
 		GrantAuthorizations:                          k.GetAllGrantAuthorizations(ctx),
 		ActiveGrants:                                 k.GetAllActiveGrants(ctx),
 		DenomMinNotionals:                            k.GetAllDenomMinNotionals(ctx),
+		DerivativeLiquidationFreezes:                 k.GetAllDerivativeLiquidationFreezes(ctx),
 	}
 }
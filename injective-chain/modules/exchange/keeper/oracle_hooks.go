@@ -0,0 +1,93 @@
+package keeper
+
+import (
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// OracleHooks freezes liquidations of derivative markets while one of their oracle prices is held back by the
+// oracle circuit breaker.
+type OracleHooks struct {
+	k *Keeper
+}
+
+var _ oracletypes.OracleHooks = OracleHooks{}
+
+func (k *Keeper) OracleHooks() OracleHooks { return OracleHooks{k} }
+
+func (h OracleHooks) AfterPriceJumpDetected(ctx sdk.Context, pendingUpdate *oracletypes.PendingPriceUpdate) {
+	for _, market := range h.k.getDerivativeMarketsByOracleSymbol(ctx, pendingUpdate.OracleType, pendingUpdate.Symbol) {
+		marketID := market.MarketID()
+
+		// keep the longest freeze when several oracle symbols of the market jump at once
+		if frozenUntil, found := h.k.GetDerivativeLiquidationFreeze(ctx, marketID); found && frozenUntil >= pendingUpdate.ExpiresAt {
+			continue
+		}
+
+		h.k.SetDerivativeLiquidationFreeze(ctx, marketID, pendingUpdate.ExpiresAt)
+		h.k.EmitEvent(ctx, &v2.EventDerivativeLiquidationFreeze{
+			MarketId:    marketID.Hex(),
+			FrozenUntil: pendingUpdate.ExpiresAt,
+		})
+	}
+}
+
+func (h OracleHooks) AfterPendingPriceResolved(
+	ctx sdk.Context, oracleType oracletypes.OracleType, symbol string, _ oracletypes.PendingPriceResolution,
+) {
+	for _, market := range h.k.getDerivativeMarketsByOracleSymbol(ctx, oracleType, symbol) {
+		marketID := market.MarketID()
+		if _, found := h.k.GetDerivativeLiquidationFreeze(ctx, marketID); !found {
+			continue
+		}
+
+		// the other oracle symbol of the market may still be held back
+		if h.k.hasPendingOraclePrice(ctx, market) {
+			continue
+		}
+
+		h.k.DeleteDerivativeLiquidationFreeze(ctx, marketID)
+		h.k.EmitEvent(ctx, &v2.EventDerivativeLiquidationFreeze{
+			MarketId:    marketID.Hex(),
+			FrozenUntil: 0,
+		})
+	}
+}
+
+func (k *Keeper) getDerivativeMarketsByOracleSymbol(
+	ctx sdk.Context, oracleType oracletypes.OracleType, symbol string,
+) []*v2.DerivativeMarket {
+	markets := make([]*v2.DerivativeMarket, 0)
+
+	k.IterateDerivativeMarkets(ctx, nil, func(market *v2.DerivativeMarket) (stop bool) {
+		if market.OracleType != oracleType {
+			return false
+		}
+
+		if strings.EqualFold(market.OracleBase, symbol) || strings.EqualFold(market.OracleQuote, symbol) {
+			markets = append(markets, market)
+		}
+
+		return false
+	})
+
+	return markets
+}
+
+func (k *Keeper) hasPendingOraclePrice(ctx sdk.Context, market *v2.DerivativeMarket) bool {
+	for _, pendingUpdate := range k.OracleKeeper.GetAllPendingPriceUpdates(ctx) {
+		if pendingUpdate.OracleType != market.OracleType {
+			continue
+		}
+
+		if strings.EqualFold(market.OracleBase, pendingUpdate.Symbol) || strings.EqualFold(market.OracleQuote, pendingUpdate.Symbol) {
+			return true
+		}
+	}
+
+	return false
+}
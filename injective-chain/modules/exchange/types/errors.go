@@ -119,4 +119,5 @@ var (
 	ErrInvalidOpenNotionalCap                   = errors.Register(ModuleName, 111, "invalid open notional cap")
 	ErrOpenNotionalCapBreached                  = errors.Register(ModuleName, 112, "open notional cap breached")
 	ErrNoOffsettingPositionsFound               = errors.Register(ModuleName, 113, "no valid offsetting positions found")
+	ErrLiquidationFrozen                        = errors.Register(ModuleName, 114, "liquidations are temporarily frozen for market")
 )
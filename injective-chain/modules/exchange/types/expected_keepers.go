@@ -56,6 +56,7 @@ type OracleKeeper interface {
 	GetProviderInfo(ctx sdk.Context, provider string) *oracletypes.ProviderInfo
 	GetProviderPrice(ctx sdk.Context, provider, symbol string) *sdkmath.LegacyDec
 	GetProviderPriceState(ctx sdk.Context, provider, symbol string) *oracletypes.ProviderPriceState
+	GetAllPendingPriceUpdates(ctx sdk.Context) []*oracletypes.PendingPriceUpdate
 }
 
 // InsuranceKeeper defines the expected insurance keeper methods.
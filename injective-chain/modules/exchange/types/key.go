@@ -126,6 +126,10 @@ var (
 	PostOnlyModeCancellationKey  = []byte{0x87} // key to mark post-only mode cancellation for next BeginBlock
 
 	TransientAtomicPerpetualVwapPrefix = []byte{0x88} // prefix for transient atomic perpetual market VWAP data
+
+	DerivativeLiquidationFreezePrefix = []byte{0x89} // prefix to store the time until which liquidations of a derivative market are frozen
+
+	Erc20TokenPairDenomPrefix = []byte{0x8a} // prefix to store the bank denom paired with an erc20 contract
 )
 
 func GetSubaccountCidKey(subaccountID common.Hash, cid string) []byte {
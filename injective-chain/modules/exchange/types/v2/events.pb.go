@@ -2757,6 +2757,61 @@ func (m *EventPositionTransfer) GetDestinationSubaccountId() string {
 	return ""
 }
 
+// EventDerivativeLiquidationFreeze is emitted when liquidations of a derivative
+// market are frozen or unfrozen because of an oracle price jump
+type EventDerivativeLiquidationFreeze struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// the block time until which liquidations are frozen, 0 if unfrozen
+	FrozenUntil int64 `protobuf:"varint,2,opt,name=frozen_until,json=frozenUntil,proto3" json:"frozen_until,omitempty"`
+}
+
+func (m *EventDerivativeLiquidationFreeze) Reset()         { *m = EventDerivativeLiquidationFreeze{} }
+func (m *EventDerivativeLiquidationFreeze) String() string { return proto.CompactTextString(m) }
+func (*EventDerivativeLiquidationFreeze) ProtoMessage()    {}
+func (*EventDerivativeLiquidationFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ac8f3da550fa1c4, []int{47}
+}
+func (m *EventDerivativeLiquidationFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDerivativeLiquidationFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDerivativeLiquidationFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDerivativeLiquidationFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDerivativeLiquidationFreeze.Merge(m, src)
+}
+func (m *EventDerivativeLiquidationFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDerivativeLiquidationFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDerivativeLiquidationFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDerivativeLiquidationFreeze proto.InternalMessageInfo
+
+func (m *EventDerivativeLiquidationFreeze) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *EventDerivativeLiquidationFreeze) GetFrozenUntil() int64 {
+	if m != nil {
+		return m.FrozenUntil
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventBatchSpotExecution)(nil), "injective.exchange.v2.EventBatchSpotExecution")
 	proto.RegisterType((*EventBatchDerivativeExecution)(nil), "injective.exchange.v2.EventBatchDerivativeExecution")
@@ -2805,6 +2860,7 @@ func init() {
 	proto.RegisterType((*SpotOrderV2Changes)(nil), "injective.exchange.v2.SpotOrderV2Changes")
 	proto.RegisterType((*EventDerivativePositionV2Migration)(nil), "injective.exchange.v2.EventDerivativePositionV2Migration")
 	proto.RegisterType((*EventPositionTransfer)(nil), "injective.exchange.v2.EventPositionTransfer")
+	proto.RegisterType((*EventDerivativeLiquidationFreeze)(nil), "injective.exchange.v2.EventDerivativeLiquidationFreeze")
 }
 
 func init() {
@@ -2812,162 +2868,163 @@ func init() {
 }
 
 var fileDescriptor_8ac8f3da550fa1c4 = []byte{
-	// 2466 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0x4b, 0x6f, 0x1c, 0xc7,
-	0x11, 0xd6, 0x2c, 0x29, 0x9a, 0x5b, 0x4b, 0x91, 0xe2, 0x88, 0x94, 0x28, 0xc9, 0x22, 0xa9, 0xb1,
-	0x5e, 0x96, 0xed, 0x5d, 0x89, 0x46, 0x60, 0x20, 0x2f, 0x83, 0x4f, 0x89, 0x06, 0x29, 0xd3, 0x43,
-	0xc9, 0x0e, 0x12, 0x18, 0x93, 0xde, 0x99, 0xe6, 0x6e, 0x9b, 0xb3, 0xd3, 0xc3, 0xe9, 0x1e, 0x4a,
-	0x9b, 0x9b, 0x83, 0x1c, 0x7c, 0x73, 0x2e, 0x41, 0x7c, 0xc9, 0x2d, 0xb7, 0x5c, 0x92, 0x5b, 0x80,
-	0x1c, 0x82, 0xf8, 0x12, 0x1f, 0x9d, 0x9c, 0x0c, 0x03, 0x31, 0x02, 0xe9, 0x94, 0xdf, 0x90, 0x4b,
-	0xd0, 0x8f, 0x79, 0xec, 0x7b, 0x97, 0x92, 0x91, 0x20, 0xb7, 0x99, 0x9e, 0x7a, 0xf5, 0xd7, 0x55,
-	0xd5, 0x55, 0xb5, 0x0b, 0x16, 0x09, 0x3e, 0xc2, 0x2e, 0x27, 0xc7, 0xb8, 0x82, 0x9f, 0xb8, 0x75,
-	0x14, 0xd4, 0x70, 0xe5, 0x78, 0xa5, 0x82, 0x8f, 0x71, 0xc0, 0x59, 0x39, 0x8c, 0x28, 0xa7, 0xe6,
-	0x7c, 0x4a, 0x53, 0x4e, 0x68, 0xca, 0xc7, 0x2b, 0x97, 0xe6, 0x6a, 0xb4, 0x46, 0x25, 0x45, 0x45,
-	0x3c, 0x29, 0xe2, 0x4b, 0x8b, 0x2e, 0x65, 0x0d, 0xca, 0x2a, 0x55, 0xc4, 0x70, 0xe5, 0xf8, 0x6e,
-	0x15, 0x73, 0x74, 0xb7, 0xe2, 0x52, 0x12, 0xe8, 0xef, 0xd7, 0x33, 0x85, 0x34, 0x42, 0xae, 0x9f,
-	0x11, 0xa9, 0x57, 0x4d, 0x76, 0xad, 0x87, 0x5d, 0x89, 0x7e, 0x45, 0xd5, 0xc3, 0xfa, 0x06, 0x8a,
-	0x0e, 0x31, 0xd7, 0x34, 0x57, 0xbb, 0xd3, 0xd0, 0xc8, 0xc3, 0x91, 0x22, 0xb1, 0xfe, 0x6e, 0xc0,
-	0x85, 0x4d, 0xb1, 0xe3, 0x35, 0xc4, 0xdd, 0xfa, 0x7e, 0x48, 0xf9, 0xe6, 0x13, 0xec, 0xc6, 0x9c,
-	0xd0, 0xc0, 0xbc, 0x0c, 0x45, 0x25, 0xce, 0x21, 0xde, 0x82, 0xb1, 0x6c, 0xdc, 0x2a, 0xda, 0x93,
-	0x6a, 0x61, 0xdb, 0x33, 0xe7, 0x61, 0x82, 0x30, 0xa7, 0x1a, 0x37, 0x17, 0x0a, 0xcb, 0xc6, 0xad,
-	0x49, 0xfb, 0x34, 0x61, 0x6b, 0x71, 0xd3, 0x7c, 0x07, 0xce, 0xe0, 0x44, 0xc0, 0xc3, 0x66, 0x88,
-	0x17, 0xc6, 0x96, 0x8d, 0x5b, 0xd3, 0x2b, 0xd7, 0xca, 0x5d, 0x81, 0x2c, 0x6f, 0xe6, 0x69, 0xed,
-	0x56, 0x56, 0xf3, 0x2d, 0x98, 0xe0, 0x11, 0xf2, 0x30, 0x5b, 0x18, 0x5f, 0x1e, 0xbb, 0x55, 0x5a,
-	0x59, 0xea, 0x21, 0xe4, 0xa1, 0x20, 0xda, 0xa1, 0x35, 0x5b, 0x93, 0x5b, 0xff, 0x28, 0xc0, 0x95,
-	0x6c, 0x53, 0x1b, 0x38, 0x22, 0xc7, 0x48, 0x70, 0x3d, 0xdf, 0xd6, 0xae, 0xc3, 0x34, 0x61, 0x8e,
-	0x4f, 0x8e, 0x62, 0xe2, 0x21, 0x21, 0x45, 0xee, 0x6d, 0xd2, 0x3e, 0x43, 0xd8, 0x4e, 0xb6, 0x68,
-	0xda, 0x60, 0xba, 0x71, 0x23, 0xf6, 0xa5, 0x46, 0xe7, 0x20, 0x0e, 0x3c, 0x12, 0xd4, 0x16, 0xc6,
-	0x85, 0x8e, 0xb5, 0x57, 0xbe, 0xf8, 0x66, 0xc9, 0xf8, 0xfa, 0x9b, 0xa5, 0xcb, 0xca, 0x53, 0x98,
-	0x77, 0x58, 0x26, 0xb4, 0xd2, 0x40, 0xbc, 0x5e, 0xde, 0xc1, 0x35, 0xe4, 0x36, 0x37, 0xb0, 0x6b,
-	0xcf, 0x66, 0xec, 0x5b, 0x8a, 0xbb, 0x13, 0xd5, 0xd3, 0x27, 0x47, 0x75, 0x35, 0x45, 0x75, 0x42,
-	0xa2, 0xfa, 0x6a, 0x0f, 0x21, 0x19, 0x6c, 0x1d, 0xf8, 0x7e, 0x9e, 0xe0, 0xbb, 0x43, 0x19, 0x17,
-	0x36, 0xb2, 0xad, 0x88, 0x36, 0xf2, 0x20, 0xf4, 0xc5, 0xf7, 0x15, 0x38, 0xc3, 0xe2, 0x2a, 0x72,
-	0x5d, 0x1a, 0x07, 0x92, 0x40, 0xc0, 0x3c, 0x65, 0x4f, 0x65, 0x8b, 0xdb, 0x9e, 0xf9, 0x04, 0x6e,
-	0xfa, 0x94, 0x71, 0x09, 0x20, 0x73, 0x0e, 0x22, 0xda, 0x70, 0xd0, 0x31, 0x22, 0x3e, 0xaa, 0xfa,
-	0xd8, 0xf1, 0xe2, 0x88, 0x04, 0x35, 0x27, 0x44, 0x4d, 0x1a, 0x73, 0x79, 0x0c, 0x0a, 0xdb, 0x53,
-	0x83, 0xb0, 0xb5, 0xfc, 0xbc, 0xc5, 0xab, 0x89, 0xc0, 0x0d, 0x29, 0x6f, 0x4f, 0x8a, 0x33, 0x31,
-	0x5c, 0x69, 0xd7, 0x2c, 0x23, 0xc6, 0x71, 0x51, 0xe0, 0x62, 0x9f, 0xe5, 0xce, 0x72, 0xa0, 0xbe,
-	0x8b, 0x2d, 0xfa, 0xde, 0x15, 0x62, 0xd6, 0x95, 0x14, 0xeb, 0x17, 0x06, 0xbc, 0xdc, 0xcd, 0x49,
-	0xf7, 0x28, 0x23, 0x83, 0x31, 0xbc, 0x07, 0xc5, 0x50, 0x13, 0xb2, 0x85, 0x42, 0xdf, 0x83, 0xdc,
-	0x4f, 0x61, 0x4d, 0x44, 0xdb, 0x19, 0xaf, 0xf5, 0x27, 0x03, 0x2e, 0x4b, 0x33, 0x32, 0x0b, 0x76,
-	0xa5, 0x92, 0x3d, 0x14, 0x33, 0xec, 0xf5, 0xb7, 0xe2, 0x2a, 0x4c, 0x31, 0xcc, 0xb9, 0x8f, 0x9d,
-	0x30, 0x22, 0x2e, 0x96, 0x07, 0x59, 0xb4, 0x4b, 0x6a, 0x6d, 0x4f, 0x2c, 0x99, 0x65, 0x38, 0xc7,
-	0x29, 0x47, 0xbe, 0xd3, 0x20, 0x8c, 0x89, 0x43, 0x93, 0xb0, 0xaa, 0x33, 0xb3, 0x67, 0xe5, 0xa7,
-	0x5d, 0xf5, 0x45, 0xc2, 0x64, 0xbe, 0x0e, 0x66, 0x0b, 0xa5, 0x13, 0x21, 0x8e, 0x15, 0xe4, 0xf6,
-	0xd9, 0x46, 0x8e, 0xd2, 0x46, 0x1c, 0x5b, 0x7b, 0x70, 0x51, 0x1a, 0xbf, 0x2f, 0x35, 0x7a, 0xca,
-	0xf2, 0x35, 0xe4, 0x0b, 0x8c, 0xfb, 0x9b, 0x7e, 0x1e, 0x26, 0x50, 0x43, 0x80, 0xa2, 0x8d, 0xd6,
-	0x6f, 0xd6, 0xbe, 0x3e, 0x95, 0x07, 0xf4, 0x05, 0x0a, 0xfd, 0x34, 0x01, 0x59, 0xcb, 0xc2, 0x4d,
-	0x1a, 0x78, 0x6b, 0x28, 0x38, 0x8c, 0xe2, 0x90, 0xbb, 0xcd, 0xe7, 0x06, 0xf9, 0x0e, 0xcc, 0x25,
-	0xa0, 0x69, 0x39, 0x79, 0x94, 0x13, 0x40, 0x95, 0x72, 0x09, 0x9e, 0xf5, 0x89, 0x01, 0x0b, 0xd2,
-	0xa2, 0x55, 0xdf, 0x4f, 0xdc, 0x82, 0xdd, 0x47, 0x24, 0x72, 0x63, 0xfe, 0xdc, 0xe6, 0x74, 0x3f,
-	0xc3, 0xb1, 0x1e, 0x67, 0xf8, 0x11, 0x2c, 0xaa, 0x38, 0x20, 0x01, 0x8a, 0x9a, 0xef, 0x86, 0xd2,
-	0x14, 0x65, 0xeb, 0xa3, 0xd0, 0x43, 0x1c, 0x9b, 0xf7, 0x61, 0x42, 0xa9, 0x97, 0xc6, 0x94, 0x56,
-	0x6e, 0xf7, 0xf0, 0xf4, 0x2e, 0x12, 0xd6, 0xc6, 0x45, 0x98, 0xda, 0x9a, 0xdf, 0xf2, 0x7a, 0x38,
-	0xbb, 0x56, 0xb4, 0xd9, 0xa6, 0xe8, 0xe6, 0xc0, 0xdc, 0xd8, 0x55, 0xcb, 0x9f, 0x0d, 0x30, 0x95,
-	0x13, 0xe1, 0xc7, 0xe2, 0x4a, 0x95, 0x71, 0xcf, 0xfa, 0xc3, 0xba, 0x01, 0x50, 0x8d, 0x9b, 0x2a,
-	0xd3, 0x24, 0x11, 0x7d, 0xbd, 0x57, 0x44, 0x87, 0x94, 0xef, 0x90, 0x06, 0x51, 0x82, 0xed, 0x62,
-	0x35, 0x6e, 0x6a, 0x15, 0x5b, 0x50, 0x62, 0xd8, 0xf7, 0x13, 0x31, 0x63, 0xa3, 0x88, 0x01, 0xc1,
-	0xa9, 0xe4, 0x58, 0x7f, 0x4b, 0xdc, 0xe3, 0x01, 0x7e, 0x9c, 0x6d, 0x76, 0x98, 0x7d, 0xbc, 0xd3,
-	0x65, 0x1f, 0xaf, 0x0d, 0x84, 0xb1, 0xfb, 0x6e, 0x76, 0xba, 0xed, 0x66, 0x24, 0x61, 0xf9, 0x3d,
-	0x1d, 0xc3, 0x9c, 0xdc, 0x92, 0x4a, 0xc0, 0xe9, 0xb9, 0xf4, 0xdf, 0xce, 0x2a, 0x9c, 0x96, 0xda,
-	0xa5, 0x9b, 0x0f, 0x0b, 0xa5, 0x76, 0x07, 0xc5, 0x69, 0xfd, 0x08, 0xe6, 0x55, 0x8e, 0x0a, 0x29,
-	0x6f, 0xf1, 0xb6, 0xb7, 0xdb, 0xbc, 0xed, 0x6a, 0x1f, 0xe1, 0x5d, 0xfd, 0xec, 0xb3, 0x02, 0x5c,
-	0x92, 0xa2, 0xf7, 0x70, 0x14, 0x62, 0x1e, 0x23, 0xff, 0x5b, 0xf0, 0x66, 0xd3, 0x83, 0xf9, 0x30,
-	0x91, 0x9f, 0xa4, 0x17, 0x12, 0x1c, 0x50, 0x0d, 0x49, 0xaf, 0x60, 0x6c, 0xb3, 0x69, 0x3b, 0x38,
-	0xa0, 0x52, 0xb0, 0x61, 0x9f, 0x0b, 0x3b, 0x3f, 0x99, 0xbb, 0xf0, 0x52, 0x52, 0x2b, 0x8d, 0x49,
-	0xb9, 0x6f, 0x0c, 0x27, 0x57, 0x97, 0x48, 0x5a, 0x74, 0x22, 0xc3, 0xfa, 0xda, 0xd0, 0x59, 0x65,
-	0xf3, 0x49, 0x48, 0xa2, 0xe6, 0x56, 0xcc, 0xe3, 0x08, 0xb3, 0x6f, 0x03, 0x9e, 0x23, 0xb8, 0x84,
-	0xa5, 0x0e, 0xe7, 0x40, 0x29, 0x69, 0xc1, 0x48, 0xed, 0xa5, 0xdc, 0xb3, 0x50, 0xeb, 0x30, 0x2e,
-	0x87, 0xd3, 0x05, 0xdc, 0xfd, 0xb3, 0xf5, 0xd7, 0x02, 0x5c, 0xed, 0x76, 0xee, 0x1a, 0x0b, 0xbd,
-	0xbf, 0xbe, 0x7e, 0x9d, 0x83, 0xbb, 0x70, 0x52, 0xb8, 0x4f, 0xa5, 0x70, 0x9b, 0xb7, 0x61, 0x96,
-	0x30, 0xa7, 0x4e, 0xe3, 0xc8, 0x6f, 0x3a, 0xf9, 0x73, 0x9c, 0xb4, 0x67, 0x08, 0xbb, 0x2f, 0xd7,
-	0x93, 0x62, 0x76, 0x0b, 0xa6, 0x34, 0x45, 0xee, 0x6e, 0x1f, 0xae, 0x34, 0x2e, 0x69, 0x46, 0x71,
-	0x6f, 0x98, 0x6b, 0x00, 0x62, 0x3b, 0xfa, 0x1a, 0x3a, 0x3d, 0xbc, 0x14, 0x09, 0x8b, 0xbc, 0xa9,
-	0xac, 0x5f, 0x1b, 0x70, 0x5e, 0x05, 0x67, 0x5a, 0x24, 0x6d, 0x60, 0x59, 0x1c, 0x99, 0x4b, 0x50,
-	0x62, 0x91, 0xeb, 0x20, 0xcf, 0x8b, 0x30, 0x63, 0x1a, 0x40, 0x60, 0x91, 0xbb, 0xaa, 0x56, 0x86,
-	0x2b, 0x63, 0xdf, 0x4a, 0x2b, 0x02, 0xe5, 0x09, 0x17, 0xcb, 0xca, 0xb2, 0xb2, 0x68, 0x12, 0xcb,
-	0xba, 0xff, 0x2b, 0xaf, 0x53, 0x12, 0x24, 0x6e, 0xa5, 0x4b, 0x86, 0xcf, 0x92, 0xc6, 0x2c, 0xb3,
-	0xec, 0x03, 0xc2, 0xeb, 0x5e, 0x84, 0x1e, 0x77, 0x6a, 0x36, 0xba, 0x68, 0x5e, 0x82, 0x92, 0xc7,
-	0x78, 0x6a, 0xbf, 0xba, 0xa6, 0xc1, 0x63, 0x3c, 0xb1, 0xff, 0xc4, 0xa6, 0xfd, 0x21, 0x89, 0xad,
-	0xcc, 0x34, 0x5d, 0x1d, 0x3d, 0x8c, 0x50, 0xc0, 0x0e, 0x70, 0x24, 0xfc, 0x41, 0x80, 0xd7, 0x69,
-	0x65, 0xd1, 0x9e, 0x61, 0x91, 0xbb, 0x9f, 0x37, 0xf4, 0x36, 0xcc, 0x0a, 0x43, 0x3b, 0xb1, 0x2c,
-	0xda, 0x33, 0x1e, 0xe3, 0xfb, 0x2f, 0x04, 0xce, 0x7a, 0xbe, 0xcd, 0xd5, 0x47, 0xac, 0xe3, 0x64,
-	0x17, 0x66, 0x3c, 0xb5, 0xe0, 0xc4, 0x72, 0x45, 0x1c, 0xb6, 0xb8, 0x69, 0xae, 0xf5, 0x4c, 0x08,
-	0x39, 0x76, 0x7b, 0xda, 0xcb, 0xbf, 0x32, 0xeb, 0x73, 0x03, 0x2e, 0xb7, 0xa7, 0x8c, 0x5c, 0xe1,
-	0x6f, 0x3e, 0x82, 0x29, 0x1d, 0x96, 0xea, 0x62, 0x51, 0xc9, 0xe7, 0xf5, 0x21, 0x93, 0x4f, 0x76,
-	0xbf, 0x18, 0x76, 0xa9, 0x91, 0x2d, 0x99, 0x3b, 0x30, 0xa3, 0xfa, 0x13, 0xe7, 0x28, 0x46, 0x01,
-	0x27, 0x5c, 0x75, 0xaf, 0x43, 0xf6, 0x29, 0xd3, 0x8a, 0xf7, 0x3d, 0xcd, 0x6a, 0xfd, 0x26, 0xb9,
-	0x59, 0x94, 0xd1, 0x6d, 0x25, 0x40, 0xff, 0xd4, 0x72, 0x0d, 0x64, 0x47, 0xdc, 0x20, 0x9a, 0x59,
-	0x77, 0xd1, 0xad, 0x8b, 0xa6, 0x0d, 0x25, 0x5f, 0xbc, 0x6a, 0x14, 0xd4, 0x71, 0x8e, 0x72, 0xb7,
-	0x6b, 0x10, 0xc0, 0x4f, 0x57, 0xcc, 0x3a, 0x9c, 0xcb, 0x43, 0xab, 0x1b, 0x36, 0x99, 0x60, 0x4a,
-	0x2b, 0x2b, 0xa3, 0x20, 0xac, 0x8c, 0xd4, 0x2a, 0x66, 0x1b, 0xed, 0x1f, 0xac, 0xaa, 0x2e, 0x8f,
-	0xb6, 0x30, 0xde, 0x20, 0x4c, 0x7a, 0xe7, 0xbe, 0x5b, 0xc7, 0x5e, 0xec, 0x63, 0x73, 0x0b, 0x26,
-	0x99, 0x7e, 0x1e, 0x50, 0xaf, 0x76, 0xe1, 0xb6, 0x53, 0x5e, 0xeb, 0x2b, 0x03, 0x96, 0xa5, 0x12,
-	0xd1, 0x7f, 0x8b, 0xa4, 0x87, 0x1f, 0xa3, 0xc8, 0x5b, 0x47, 0x8d, 0x10, 0x91, 0x5a, 0xa0, 0x9d,
-	0xf7, 0x11, 0x9c, 0x71, 0xf5, 0x8a, 0xba, 0x70, 0x94, 0xc6, 0x3b, 0x7d, 0x46, 0x25, 0x1d, 0xa2,
-	0xc4, 0x9d, 0x62, 0x4f, 0xb9, 0xb9, 0x37, 0xf3, 0x43, 0x98, 0x4f, 0xc5, 0x46, 0x92, 0xd8, 0x09,
-	0x29, 0xf5, 0x07, 0xb5, 0x9a, 0x89, 0x44, 0x25, 0x7f, 0x8f, 0x52, 0xdf, 0x3e, 0xe7, 0x76, 0xac,
-	0x31, 0x2b, 0xd4, 0x09, 0xa4, 0xc5, 0x9c, 0x0d, 0xc2, 0x78, 0x44, 0xaa, 0x6a, 0x40, 0xf3, 0x00,
-	0x66, 0x92, 0x6c, 0xa0, 0xf4, 0x27, 0x41, 0xd9, 0xab, 0x02, 0x5b, 0x55, 0xd4, 0x4a, 0x14, 0xb3,
-	0xa7, 0x51, 0xcb, 0xbb, 0xf5, 0x7b, 0x03, 0xac, 0xa4, 0xa0, 0x5d, 0xa7, 0x81, 0x27, 0x1b, 0x1e,
-	0x34, 0x9a, 0x63, 0x7f, 0xbf, 0xb5, 0x16, 0xbc, 0x31, 0xd0, 0xa1, 0x54, 0x0d, 0xaa, 0x98, 0x4c,
-	0x13, 0xc6, 0xeb, 0x88, 0xd5, 0xa5, 0xa7, 0x4f, 0xd9, 0xf2, 0x59, 0xa8, 0x23, 0x49, 0xbd, 0x20,
-	0xdd, 0x74, 0xd2, 0x9e, 0x24, 0xfa, 0xa6, 0xb7, 0x7e, 0x55, 0x80, 0xeb, 0xb9, 0x18, 0x3c, 0xa9,
-	0xd5, 0xff, 0xbd, 0x70, 0x6c, 0xcf, 0x74, 0xe3, 0x2f, 0x24, 0xd3, 0x59, 0xff, 0x36, 0xe0, 0x86,
-	0xc2, 0xa5, 0x27, 0x22, 0x0f, 0x23, 0x52, 0xab, 0x75, 0x03, 0x66, 0x2a, 0x07, 0xcc, 0x0d, 0x98,
-	0xd6, 0x18, 0x68, 0x72, 0x8d, 0x4c, 0xdb, 0xaa, 0x68, 0xae, 0xb9, 0x7a, 0xc4, 0x9e, 0x4e, 0x2c,
-	0xb9, 0x83, 0x34, 0xd3, 0x6f, 0x52, 0xf3, 0x7d, 0x71, 0xac, 0xb7, 0x61, 0x36, 0xf4, 0x91, 0xdb,
-	0x4a, 0x3e, 0x2e, 0xc9, 0x67, 0xd4, 0x87, 0x8c, 0xb6, 0x0c, 0xe7, 0xda, 0xa5, 0xbb, 0xc4, 0x53,
-	0xe5, 0x8c, 0x3d, 0xdb, 0x2a, 0x7c, 0x9d, 0x78, 0x96, 0x0f, 0xd3, 0x72, 0xf3, 0x72, 0x61, 0x0b,
-	0x11, 0xdf, 0x5c, 0x80, 0x97, 0xb4, 0xb3, 0xeb, 0x2d, 0x26, 0xaf, 0xe6, 0x79, 0x98, 0x10, 0xaa,
-	0xb1, 0x0a, 0xdb, 0x29, 0x5b, 0xbf, 0x99, 0x73, 0x70, 0xfa, 0xc0, 0x47, 0x35, 0xd5, 0x51, 0x9d,
-	0xb1, 0xd5, 0x8b, 0x70, 0x50, 0x97, 0x78, 0x6a, 0xd8, 0x5a, 0xb4, 0xe5, 0xb3, 0xf5, 0xa9, 0x01,
-	0xaf, 0xa9, 0x31, 0x01, 0xa7, 0x0d, 0xe2, 0xe6, 0x4e, 0x66, 0x0b, 0xe3, 0xdd, 0xd8, 0xe7, 0x24,
-	0xf4, 0x09, 0x8e, 0x98, 0xca, 0x46, 0x9e, 0xf9, 0x53, 0x38, 0x9f, 0x0c, 0x20, 0x30, 0x76, 0x1a,
-	0x19, 0x81, 0x8e, 0xde, 0x5e, 0x99, 0x50, 0x17, 0x97, 0x79, 0x99, 0xf6, 0x5c, 0xa3, 0x73, 0x91,
-	0x59, 0x7f, 0x34, 0x74, 0x1b, 0x27, 0xad, 0xa8, 0x52, 0x7a, 0xa8, 0x33, 0xe1, 0x36, 0x4c, 0xb1,
-	0x90, 0xb6, 0xdf, 0xe1, 0xbd, 0x82, 0xb4, 0x8d, 0xdb, 0x2e, 0x09, 0x5e, 0x7d, 0x85, 0x9b, 0x8f,
-	0xc0, 0xf4, 0x52, 0x87, 0x4a, 0x05, 0x16, 0x46, 0x12, 0x38, 0x9b, 0x49, 0x48, 0x2a, 0x03, 0x17,
-	0x66, 0xda, 0x8d, 0x3e, 0x0b, 0x63, 0x0c, 0x1f, 0xc9, 0x73, 0x1b, 0xb7, 0xc5, 0xa3, 0xf9, 0x43,
-	0x28, 0xd2, 0x84, 0x48, 0x27, 0x9a, 0xe5, 0x41, 0x2a, 0xed, 0x8c, 0xc5, 0xfa, 0xad, 0x01, 0xc5,
-	0xf4, 0x43, 0xff, 0x00, 0xf8, 0x9e, 0x6a, 0xd5, 0x7d, 0x7c, 0x8c, 0xd3, 0xcc, 0xfe, 0x72, 0x0f,
-	0x5d, 0x3b, 0x82, 0x48, 0xf6, 0xe6, 0xf2, 0x89, 0x99, 0x3f, 0xd0, 0xbd, 0xb9, 0xe6, 0x1e, 0x1b,
-	0x82, 0x5b, 0x36, 0xe3, 0x8a, 0xdd, 0x7a, 0xac, 0x2f, 0xd0, 0x7b, 0x11, 0x0a, 0xf8, 0x6a, 0xcc,
-	0xeb, 0x34, 0x22, 0x3f, 0x93, 0xb3, 0x63, 0x26, 0x1c, 0xba, 0x26, 0x96, 0x75, 0x71, 0x54, 0xb4,
-	0x93, 0x57, 0x73, 0x15, 0x26, 0xe4, 0xe3, 0xa0, 0x7b, 0xa8, 0x53, 0xaa, 0xad, 0x19, 0xad, 0x8f,
-	0x13, 0xff, 0x51, 0x34, 0x82, 0x57, 0x8d, 0xac, 0x53, 0xad, 0xb8, 0x55, 0x2b, 0xce, 0xdb, 0x53,
-	0x68, 0xb5, 0xe7, 0x3b, 0x2d, 0xe5, 0x68, 0x71, 0xed, 0x8a, 0xae, 0xb5, 0xe6, 0x3b, 0x6b, 0xad,
-	0xed, 0x80, 0xa7, 0xc5, 0xe8, 0x3d, 0x98, 0x95, 0x26, 0x6c, 0x07, 0xc7, 0xc8, 0x27, 0x9e, 0xb4,
-	0xe4, 0x24, 0xfa, 0xad, 0xdf, 0xb5, 0x04, 0x83, 0x4a, 0xe5, 0x32, 0x27, 0x8c, 0x3e, 0x7f, 0x2f,
-	0xb6, 0xb5, 0x0f, 0x57, 0x00, 0xda, 0x72, 0x5d, 0x51, 0xbb, 0x99, 0x4c, 0x5b, 0x67, 0x61, 0x4c,
-	0xa4, 0x29, 0x35, 0x97, 0x15, 0x8f, 0xe6, 0x32, 0x94, 0x3c, 0xcc, 0xdc, 0x88, 0xc8, 0xf1, 0x9b,
-	0x4e, 0x60, 0xf9, 0x25, 0x91, 0xb8, 0x97, 0xdb, 0xa6, 0x6f, 0x6a, 0x34, 0xf3, 0xfe, 0xca, 0x2e,
-	0xa9, 0x45, 0x43, 0xfc, 0x72, 0xf0, 0x13, 0x98, 0x4d, 0x87, 0x4b, 0x8e, 0x3a, 0xee, 0xc4, 0x15,
-	0x2a, 0xc3, 0xdd, 0xc6, 0xef, 0xaf, 0xac, 0x2b, 0x36, 0x7b, 0x26, 0x99, 0x33, 0xe9, 0x05, 0xf3,
-	0x43, 0x30, 0xb3, 0x69, 0x53, 0x2a, 0x7d, 0xec, 0x64, 0xd2, 0xcf, 0xa6, 0x83, 0x27, 0xbd, 0x62,
-	0xfd, 0xa5, 0x00, 0x0b, 0xbd, 0xc8, 0x13, 0x38, 0x8d, 0x0c, 0xce, 0xa4, 0x5c, 0x28, 0xe4, 0xca,
-	0x85, 0xbb, 0x60, 0x84, 0xa3, 0xfc, 0xda, 0x61, 0x84, 0x82, 0xe5, 0x68, 0x94, 0x1f, 0x2c, 0x8c,
-	0x23, 0xc1, 0xd2, 0xc8, 0xb5, 0xd3, 0x83, 0x59, 0x1a, 0x82, 0xe5, 0x60, 0x61, 0x62, 0x04, 0x96,
-	0x03, 0xf3, 0x4d, 0x28, 0xf0, 0x70, 0xe1, 0xa5, 0xe1, 0xbb, 0xf6, 0x02, 0x0f, 0xad, 0x7f, 0x19,
-	0xba, 0x2d, 0xc9, 0xa6, 0xaa, 0x43, 0xfb, 0xce, 0xa3, 0xde, 0xbe, 0xf3, 0x6a, 0x9f, 0xc1, 0xdb,
-	0x20, 0xaf, 0xf9, 0xa0, 0x8f, 0xd7, 0x8c, 0x20, 0xb7, 0xd3, 0x5f, 0x7e, 0x5e, 0x80, 0x5b, 0xba,
-	0x48, 0x96, 0x35, 0x40, 0xae, 0xda, 0xc9, 0x5f, 0xc3, 0x88, 0xf8, 0x83, 0x7e, 0xa5, 0x19, 0x2a,
-	0xde, 0x5b, 0xa7, 0x29, 0x23, 0x38, 0x59, 0x36, 0x4d, 0x69, 0xcb, 0x19, 0xaa, 0xe0, 0xc9, 0xe5,
-	0x8c, 0x25, 0x28, 0xe9, 0x7a, 0xc6, 0xc1, 0x51, 0xa4, 0x33, 0x04, 0xe8, 0xa5, 0xcd, 0x28, 0x4a,
-	0xa2, 0x60, 0x22, 0x8d, 0x02, 0xeb, 0xe3, 0x02, 0xdc, 0xec, 0x01, 0x42, 0x56, 0x7a, 0xfe, 0x9f,
-	0x63, 0xf0, 0x49, 0x01, 0xcc, 0x4e, 0x8f, 0xf9, 0x5f, 0x4b, 0x19, 0x07, 0x23, 0xa5, 0x8c, 0x24,
-	0xfe, 0x27, 0x46, 0x8b, 0xff, 0x43, 0xdd, 0xc4, 0x75, 0xfe, 0x5a, 0x9a, 0x4f, 0x03, 0x9b, 0x30,
-	0x99, 0xfc, 0xbe, 0xa9, 0xdb, 0xe1, 0xc1, 0xbf, 0x71, 0xa7, 0x3f, 0x8d, 0xa6, 0xac, 0xd6, 0x33,
-	0x43, 0x0f, 0xee, 0x93, 0x6f, 0xe9, 0x74, 0xab, 0xaf, 0xa7, 0xdd, 0x81, 0x39, 0x46, 0xe3, 0xc8,
-	0xc5, 0x5d, 0x27, 0x5a, 0xa6, 0xfa, 0xd6, 0x32, 0xd4, 0xfa, 0x2e, 0x5c, 0xf4, 0x30, 0xe3, 0x24,
-	0x90, 0xe6, 0xb7, 0xb1, 0xa9, 0x9b, 0xf7, 0x42, 0x8e, 0xa0, 0x85, 0xf7, 0x6d, 0x98, 0x4c, 0xe7,
-	0x3d, 0x23, 0x9c, 0x59, 0xca, 0xb4, 0x76, 0xf8, 0xc5, 0xd3, 0x45, 0xe3, 0xcb, 0xa7, 0x8b, 0xc6,
-	0x3f, 0x9f, 0x2e, 0x1a, 0xbf, 0x7c, 0xb6, 0x78, 0xea, 0xcb, 0x67, 0x8b, 0xa7, 0xbe, 0x7a, 0xb6,
-	0x78, 0xea, 0xc7, 0xef, 0xd5, 0x08, 0xaf, 0xc7, 0xd5, 0xb2, 0x4b, 0x1b, 0x95, 0xed, 0x04, 0xbe,
-	0x1d, 0x54, 0x65, 0x95, 0x14, 0xcc, 0x37, 0x5c, 0x1a, 0xe1, 0xfc, 0x6b, 0x1d, 0x91, 0xa0, 0xd2,
-	0xa0, 0x5e, 0xec, 0x63, 0x96, 0xfd, 0xe7, 0x84, 0x37, 0x43, 0xcc, 0x2a, 0xc7, 0x2b, 0xd5, 0x09,
-	0xf9, 0xa7, 0x93, 0x37, 0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0x93, 0xa2, 0xd7, 0x95, 0x7b, 0x23,
-	0x00, 0x00,
+	// 2491 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x5a, 0xc9, 0x6f, 0x1c, 0xc7,
+	0xd5, 0x57, 0x0f, 0x17, 0x73, 0xde, 0x50, 0xa4, 0xd8, 0x22, 0xad, 0x91, 0x64, 0x91, 0x54, 0x5b,
+	0x9b, 0x65, 0x7b, 0x46, 0xa2, 0xf1, 0xc1, 0xc0, 0x97, 0xc5, 0xe0, 0x2a, 0xd1, 0x20, 0x65, 0xba,
+	0x29, 0xda, 0x41, 0x02, 0x63, 0x52, 0xd3, 0x5d, 0x9c, 0x29, 0xb3, 0xa7, 0xab, 0xd9, 0x55, 0x4d,
+	0x69, 0x74, 0x73, 0x90, 0x83, 0x6f, 0xc9, 0x25, 0x88, 0x2f, 0xb9, 0xe5, 0x96, 0x4b, 0x72, 0x0b,
+	0x90, 0x43, 0x10, 0x5f, 0xe2, 0xa3, 0x93, 0x93, 0x61, 0x20, 0x46, 0x20, 0x9d, 0xf2, 0x37, 0xf8,
+	0x12, 0xd4, 0xd2, 0xcb, 0xec, 0x33, 0x94, 0x8c, 0x04, 0xb9, 0x75, 0x57, 0xbf, 0xad, 0x7e, 0xf5,
+	0xde, 0xab, 0xf7, 0xde, 0x0c, 0x58, 0xc4, 0xff, 0x18, 0x3b, 0x9c, 0x9c, 0xe0, 0x32, 0x7e, 0xec,
+	0xd4, 0x91, 0x5f, 0xc3, 0xe5, 0x93, 0x95, 0x32, 0x3e, 0xc1, 0x3e, 0x67, 0xa5, 0x20, 0xa4, 0x9c,
+	0x9a, 0x0b, 0x09, 0x4d, 0x29, 0xa6, 0x29, 0x9d, 0xac, 0x5c, 0x9a, 0xaf, 0xd1, 0x1a, 0x95, 0x14,
+	0x65, 0xf1, 0xa4, 0x88, 0x2f, 0x2d, 0x3a, 0x94, 0x35, 0x28, 0x2b, 0x57, 0x11, 0xc3, 0xe5, 0x93,
+	0xbb, 0x55, 0xcc, 0xd1, 0xdd, 0xb2, 0x43, 0x89, 0xaf, 0xbf, 0x5f, 0x4f, 0x15, 0xd2, 0x10, 0x39,
+	0x5e, 0x4a, 0xa4, 0x5e, 0x35, 0xd9, 0xb5, 0x1e, 0x76, 0xc5, 0xfa, 0x15, 0x55, 0x0f, 0xeb, 0x1b,
+	0x28, 0x3c, 0xc2, 0x5c, 0xd3, 0x5c, 0xed, 0x4e, 0x43, 0x43, 0x17, 0x87, 0x8a, 0xc4, 0xfa, 0xbb,
+	0x01, 0x17, 0x36, 0xc5, 0x8e, 0xd7, 0x10, 0x77, 0xea, 0xfb, 0x01, 0xe5, 0x9b, 0x8f, 0xb1, 0x13,
+	0x71, 0x42, 0x7d, 0xf3, 0x32, 0xe4, 0x95, 0xb8, 0x0a, 0x71, 0x8b, 0xc6, 0xb2, 0x71, 0x2b, 0x6f,
+	0x4f, 0xa9, 0x85, 0x6d, 0xd7, 0x5c, 0x80, 0x49, 0xc2, 0x2a, 0xd5, 0xa8, 0x59, 0xcc, 0x2d, 0x1b,
+	0xb7, 0xa6, 0xec, 0x09, 0xc2, 0xd6, 0xa2, 0xa6, 0xf9, 0x2e, 0x9c, 0xc5, 0xb1, 0x80, 0x87, 0xcd,
+	0x00, 0x17, 0xc7, 0x96, 0x8d, 0x5b, 0x33, 0x2b, 0xd7, 0x4a, 0x5d, 0x81, 0x2c, 0x6d, 0x66, 0x69,
+	0xed, 0x56, 0x56, 0xf3, 0x6d, 0x98, 0xe4, 0x21, 0x72, 0x31, 0x2b, 0x8e, 0x2f, 0x8f, 0xdd, 0x2a,
+	0xac, 0x2c, 0xf5, 0x10, 0xf2, 0x50, 0x10, 0xed, 0xd0, 0x9a, 0xad, 0xc9, 0xad, 0x7f, 0xe4, 0xe0,
+	0x4a, 0xba, 0xa9, 0x0d, 0x1c, 0x92, 0x13, 0x24, 0xb8, 0x9e, 0x6f, 0x6b, 0xd7, 0x61, 0x86, 0xb0,
+	0x8a, 0x47, 0x8e, 0x23, 0xe2, 0x22, 0x21, 0x45, 0xee, 0x6d, 0xca, 0x3e, 0x4b, 0xd8, 0x4e, 0xba,
+	0x68, 0xda, 0x60, 0x3a, 0x51, 0x23, 0xf2, 0xa4, 0xc6, 0xca, 0x61, 0xe4, 0xbb, 0xc4, 0xaf, 0x15,
+	0xc7, 0x85, 0x8e, 0xb5, 0x57, 0xbf, 0xf8, 0x66, 0xc9, 0xf8, 0xfa, 0x9b, 0xa5, 0xcb, 0xca, 0x53,
+	0x98, 0x7b, 0x54, 0x22, 0xb4, 0xdc, 0x40, 0xbc, 0x5e, 0xda, 0xc1, 0x35, 0xe4, 0x34, 0x37, 0xb0,
+	0x63, 0xcf, 0xa5, 0xec, 0x5b, 0x8a, 0xbb, 0x13, 0xd5, 0x89, 0xd3, 0xa3, 0xba, 0x9a, 0xa0, 0x3a,
+	0x29, 0x51, 0x7d, 0xad, 0x87, 0x90, 0x14, 0xb6, 0x0e, 0x7c, 0x3f, 0x8f, 0xf1, 0xdd, 0xa1, 0x8c,
+	0x0b, 0x1b, 0xd9, 0x56, 0x48, 0x1b, 0x59, 0x10, 0xfa, 0xe2, 0xfb, 0x2a, 0x9c, 0x65, 0x51, 0x15,
+	0x39, 0x0e, 0x8d, 0x7c, 0x49, 0x20, 0x60, 0x9e, 0xb6, 0xa7, 0xd3, 0xc5, 0x6d, 0xd7, 0x7c, 0x0c,
+	0x37, 0x3d, 0xca, 0xb8, 0x04, 0x90, 0x55, 0x0e, 0x43, 0xda, 0xa8, 0xa0, 0x13, 0x44, 0x3c, 0x54,
+	0xf5, 0x70, 0xc5, 0x8d, 0x42, 0xe2, 0xd7, 0x2a, 0x01, 0x6a, 0xd2, 0x88, 0x17, 0xc7, 0x12, 0x6c,
+	0xcf, 0x0c, 0xc2, 0xd6, 0xf2, 0xb2, 0x16, 0xaf, 0xc6, 0x02, 0x37, 0xa4, 0xbc, 0x3d, 0x29, 0xce,
+	0xc4, 0x70, 0xa5, 0x5d, 0xb3, 0x8c, 0x98, 0x8a, 0x83, 0x7c, 0x07, 0x7b, 0xac, 0x38, 0x3e, 0xbc,
+	0xbe, 0x8b, 0x2d, 0xfa, 0xde, 0x13, 0x62, 0xd6, 0x95, 0x14, 0xeb, 0xe7, 0x06, 0xbc, 0xd2, 0xcd,
+	0x49, 0xf7, 0x28, 0x23, 0x83, 0x31, 0xbc, 0x07, 0xf9, 0x40, 0x13, 0xb2, 0x62, 0xae, 0xef, 0x41,
+	0xee, 0x27, 0xb0, 0xc6, 0xa2, 0xed, 0x94, 0xd7, 0xfa, 0x93, 0x01, 0x97, 0xa5, 0x19, 0xa9, 0x05,
+	0xbb, 0x52, 0xc9, 0x1e, 0x8a, 0x18, 0x76, 0xfb, 0x5b, 0x71, 0x15, 0xa6, 0x19, 0xe6, 0xdc, 0xc3,
+	0x95, 0x20, 0x24, 0x0e, 0x96, 0x07, 0x99, 0xb7, 0x0b, 0x6a, 0x6d, 0x4f, 0x2c, 0x99, 0x25, 0x38,
+	0xcf, 0x29, 0x47, 0x5e, 0xa5, 0x41, 0x18, 0x13, 0x87, 0x26, 0x61, 0x55, 0x67, 0x66, 0xcf, 0xc9,
+	0x4f, 0xbb, 0xea, 0x8b, 0x84, 0xc9, 0x7c, 0x03, 0xcc, 0x16, 0xca, 0x4a, 0x88, 0x38, 0x56, 0x90,
+	0xdb, 0xe7, 0x1a, 0x19, 0x4a, 0x1b, 0x71, 0x6c, 0xed, 0xc1, 0x45, 0x69, 0xfc, 0xbe, 0xd4, 0xe8,
+	0x2a, 0xcb, 0xd7, 0x90, 0x27, 0x30, 0xee, 0x6f, 0xfa, 0xcb, 0x30, 0x89, 0x1a, 0x02, 0x14, 0x6d,
+	0xb4, 0x7e, 0xb3, 0xf6, 0xf5, 0xa9, 0x3c, 0xa0, 0x2f, 0x50, 0xe8, 0x2f, 0x62, 0x90, 0xb5, 0x2c,
+	0xdc, 0xa4, 0xbe, 0xbb, 0x86, 0xfc, 0xa3, 0x30, 0x0a, 0xb8, 0xd3, 0x7c, 0x6e, 0x90, 0xef, 0xc0,
+	0x7c, 0x0c, 0x9a, 0x96, 0x93, 0x45, 0x39, 0x06, 0x54, 0x29, 0x97, 0xe0, 0x59, 0x9f, 0x1a, 0x50,
+	0x94, 0x16, 0xad, 0x7a, 0x5e, 0xec, 0x16, 0xec, 0x3e, 0x22, 0xa1, 0x13, 0xf1, 0xe7, 0x36, 0xa7,
+	0xfb, 0x19, 0x8e, 0xf5, 0x38, 0xc3, 0x8f, 0x61, 0x51, 0xc5, 0x01, 0xf1, 0x51, 0xd8, 0x7c, 0x2f,
+	0x90, 0xa6, 0x28, 0x5b, 0x0f, 0x02, 0x17, 0x71, 0x6c, 0xde, 0x87, 0x49, 0xa5, 0x5e, 0x1a, 0x53,
+	0x58, 0xb9, 0xdd, 0xc3, 0xd3, 0xbb, 0x48, 0x58, 0x1b, 0x17, 0x61, 0x6a, 0x6b, 0x7e, 0xcb, 0xed,
+	0xe1, 0xec, 0x5a, 0xd1, 0x66, 0x9b, 0xa2, 0x9b, 0x03, 0x73, 0x63, 0x57, 0x2d, 0x7f, 0x36, 0xc0,
+	0x54, 0x4e, 0x84, 0x1f, 0x89, 0x2b, 0x55, 0xc6, 0x3d, 0xeb, 0x0f, 0xeb, 0x06, 0x40, 0x35, 0x6a,
+	0xaa, 0x4c, 0x13, 0x47, 0xf4, 0xf5, 0x5e, 0x11, 0x1d, 0x50, 0xbe, 0x43, 0x1a, 0x44, 0x09, 0xb6,
+	0xf3, 0xd5, 0xa8, 0xa9, 0x55, 0x6c, 0x41, 0x81, 0x61, 0xcf, 0x8b, 0xc5, 0x8c, 0x8d, 0x22, 0x06,
+	0x04, 0xa7, 0x92, 0x63, 0xfd, 0x2d, 0x76, 0x8f, 0x07, 0xf8, 0x51, 0xba, 0xd9, 0x61, 0xf6, 0xf1,
+	0x6e, 0x97, 0x7d, 0xbc, 0x3e, 0x10, 0xc6, 0xee, 0xbb, 0xd9, 0xe9, 0xb6, 0x9b, 0x91, 0x84, 0x65,
+	0xf7, 0x74, 0x02, 0xf3, 0x72, 0x4b, 0x2a, 0x01, 0x27, 0xe7, 0xd2, 0x7f, 0x3b, 0xab, 0x30, 0x21,
+	0xb5, 0x4b, 0x37, 0x1f, 0x16, 0x4a, 0xed, 0x0e, 0x8a, 0xd3, 0xfa, 0x11, 0x2c, 0xa8, 0x1c, 0x15,
+	0x50, 0xde, 0xe2, 0x6d, 0xef, 0xb4, 0x79, 0xdb, 0xd5, 0x3e, 0xc2, 0xbb, 0xfa, 0xd9, 0x67, 0x39,
+	0xb8, 0x24, 0x45, 0xef, 0xe1, 0x30, 0xc0, 0x3c, 0x42, 0xde, 0x77, 0xe0, 0xcd, 0xa6, 0x0b, 0x0b,
+	0x41, 0x2c, 0x3f, 0x4e, 0x2f, 0xc4, 0x3f, 0xa4, 0xc5, 0x5c, 0xdf, 0x60, 0x6c, 0xb3, 0x69, 0xdb,
+	0x3f, 0xa4, 0x52, 0xb0, 0x61, 0x9f, 0x0f, 0x3a, 0x3f, 0x99, 0xbb, 0xf0, 0x52, 0x5c, 0x2b, 0x8d,
+	0x49, 0xb9, 0x6f, 0x0e, 0x27, 0x57, 0x97, 0x48, 0x5a, 0x74, 0x2c, 0xc3, 0xfa, 0xda, 0xd0, 0x59,
+	0x65, 0xf3, 0x71, 0x40, 0xc2, 0xe6, 0x56, 0xc4, 0xa3, 0x10, 0xb3, 0xef, 0x02, 0x9e, 0x63, 0xb8,
+	0x84, 0xa5, 0x8e, 0xca, 0xa1, 0x52, 0xd2, 0x82, 0x91, 0xda, 0x4b, 0xa9, 0x67, 0xa1, 0xd6, 0x61,
+	0x5c, 0x06, 0xa7, 0x0b, 0xb8, 0xfb, 0x67, 0xeb, 0xaf, 0x39, 0xb8, 0xda, 0xed, 0xdc, 0x35, 0x16,
+	0x7a, 0x7f, 0x7d, 0xfd, 0x3a, 0x03, 0x77, 0xee, 0xb4, 0x70, 0x9f, 0x49, 0xe0, 0x36, 0x6f, 0xc3,
+	0x1c, 0x61, 0x95, 0x3a, 0x8d, 0x42, 0xaf, 0x59, 0xc9, 0x9e, 0xe3, 0x94, 0x3d, 0x4b, 0xd8, 0x7d,
+	0xb9, 0xae, 0x59, 0xcd, 0x2d, 0x98, 0xd6, 0x14, 0x99, 0xbb, 0x7d, 0xb8, 0xd2, 0xb8, 0xa0, 0x19,
+	0xc5, 0xbd, 0x61, 0xae, 0x01, 0x88, 0xed, 0xe8, 0x6b, 0x68, 0x62, 0x78, 0x29, 0x12, 0x16, 0x79,
+	0x53, 0x59, 0xbf, 0x36, 0xe0, 0x65, 0x15, 0x9c, 0x49, 0x91, 0xb4, 0x81, 0x65, 0x71, 0x64, 0x2e,
+	0x41, 0x81, 0x85, 0x4e, 0x05, 0xb9, 0x6e, 0x88, 0x19, 0xd3, 0x00, 0x02, 0x0b, 0x9d, 0x55, 0xb5,
+	0x32, 0x5c, 0x19, 0xfb, 0x76, 0x52, 0x11, 0x28, 0x4f, 0xb8, 0x58, 0x52, 0x96, 0x95, 0x44, 0x93,
+	0x58, 0xd2, 0xfd, 0x5f, 0x69, 0x9d, 0x12, 0x3f, 0x76, 0x2b, 0x5d, 0x32, 0x7c, 0x16, 0x37, 0x66,
+	0xa9, 0x65, 0x1f, 0x12, 0x5e, 0x77, 0x43, 0xf4, 0xa8, 0x53, 0xb3, 0xd1, 0x45, 0xf3, 0x12, 0x14,
+	0x5c, 0xc6, 0x13, 0xfb, 0xd5, 0x35, 0x0d, 0x2e, 0xe3, 0xb1, 0xfd, 0xa7, 0x36, 0xed, 0x0f, 0x71,
+	0x6c, 0xa5, 0xa6, 0xe9, 0xea, 0xe8, 0x61, 0x88, 0x7c, 0x76, 0x88, 0x43, 0xe1, 0x0f, 0x02, 0xbc,
+	0x4e, 0x2b, 0xf3, 0xf6, 0x2c, 0x0b, 0x9d, 0xfd, 0xac, 0xa1, 0xb7, 0x61, 0x4e, 0x18, 0xda, 0x89,
+	0x65, 0xde, 0x9e, 0x75, 0x19, 0xdf, 0x7f, 0x21, 0x70, 0xd6, 0xb3, 0x6d, 0xae, 0x3e, 0x62, 0x1d,
+	0x27, 0xbb, 0x30, 0xeb, 0xaa, 0x85, 0x4a, 0x24, 0x57, 0xc4, 0x61, 0x8b, 0x9b, 0xe6, 0x5a, 0xcf,
+	0x84, 0x90, 0x61, 0xb7, 0x67, 0xdc, 0xec, 0x2b, 0xb3, 0x3e, 0x37, 0xe0, 0x72, 0x7b, 0xca, 0xc8,
+	0x14, 0xfe, 0xe6, 0x01, 0x4c, 0xeb, 0xb0, 0x54, 0x17, 0x8b, 0x4a, 0x3e, 0x6f, 0x0c, 0x99, 0x7c,
+	0xd2, 0xfb, 0xc5, 0xb0, 0x0b, 0x8d, 0x74, 0xc9, 0xdc, 0x81, 0x59, 0xd5, 0x9f, 0x54, 0x8e, 0x23,
+	0xe4, 0x73, 0xc2, 0x55, 0xf7, 0x3a, 0x64, 0x9f, 0x32, 0xa3, 0x78, 0xdf, 0xd7, 0xac, 0xd6, 0x6f,
+	0xe2, 0x9b, 0x45, 0x19, 0xdd, 0x56, 0x02, 0xf4, 0x4f, 0x2d, 0xd7, 0x40, 0x76, 0xc4, 0x0d, 0xa2,
+	0x99, 0x75, 0x17, 0xdd, 0xba, 0x68, 0xda, 0x50, 0xf0, 0xc4, 0xab, 0x46, 0x41, 0x1d, 0xe7, 0x28,
+	0x77, 0xbb, 0x06, 0x01, 0xbc, 0x64, 0xc5, 0xac, 0xc3, 0xf9, 0x2c, 0xb4, 0xba, 0x61, 0x93, 0x09,
+	0xa6, 0xb0, 0xb2, 0x32, 0x0a, 0xc2, 0xca, 0x48, 0xad, 0x62, 0xae, 0xd1, 0xfe, 0xc1, 0xaa, 0xea,
+	0xf2, 0x68, 0x0b, 0xe3, 0x0d, 0xc2, 0xa4, 0x77, 0xee, 0x3b, 0x75, 0xec, 0x46, 0x1e, 0x36, 0xb7,
+	0x60, 0x8a, 0xe9, 0xe7, 0x01, 0xf5, 0x6a, 0x17, 0x6e, 0x3b, 0xe1, 0xb5, 0xbe, 0x32, 0x60, 0x59,
+	0x2a, 0x11, 0xfd, 0xb7, 0x48, 0x7a, 0xf8, 0x11, 0x0a, 0xdd, 0x75, 0xd4, 0x08, 0x10, 0xa9, 0xf9,
+	0xda, 0x79, 0x0f, 0xe0, 0xac, 0xa3, 0x57, 0xd4, 0x85, 0xa3, 0x34, 0xde, 0xe9, 0x33, 0x2a, 0xe9,
+	0x10, 0x25, 0xee, 0x14, 0x7b, 0xda, 0xc9, 0xbc, 0x99, 0x1f, 0xc1, 0x42, 0x22, 0x36, 0x94, 0xc4,
+	0x95, 0x80, 0x52, 0x6f, 0x50, 0xab, 0x19, 0x4b, 0x54, 0xf2, 0xf7, 0x28, 0xf5, 0xec, 0xf3, 0x4e,
+	0xc7, 0x1a, 0xb3, 0x02, 0x9d, 0x40, 0x5a, 0xcc, 0xd9, 0x20, 0x8c, 0x87, 0xa4, 0xaa, 0x06, 0x34,
+	0x0f, 0x60, 0x36, 0xce, 0x06, 0x4a, 0x7f, 0x1c, 0x94, 0xbd, 0x2a, 0xb0, 0x55, 0x45, 0xad, 0x44,
+	0x31, 0x7b, 0x06, 0xb5, 0xbc, 0x5b, 0xbf, 0x37, 0xc0, 0x8a, 0x0b, 0xda, 0x75, 0xea, 0xbb, 0xb2,
+	0xe1, 0x41, 0xa3, 0x39, 0xf6, 0xf7, 0x5b, 0x6b, 0xc1, 0x1b, 0x03, 0x1d, 0x4a, 0xd5, 0xa0, 0x8a,
+	0xc9, 0x34, 0x61, 0xbc, 0x8e, 0x58, 0x5d, 0x7a, 0xfa, 0xb4, 0x2d, 0x9f, 0x85, 0x3a, 0x12, 0xd7,
+	0x0b, 0xd2, 0x4d, 0xa7, 0xec, 0x29, 0xa2, 0x6f, 0x7a, 0xeb, 0x57, 0x39, 0xb8, 0x9e, 0x89, 0xc1,
+	0xd3, 0x5a, 0xfd, 0x9f, 0x0b, 0xc7, 0xf6, 0x4c, 0x37, 0xfe, 0x42, 0x32, 0x9d, 0xf5, 0xad, 0x01,
+	0x37, 0x14, 0x2e, 0x3d, 0x11, 0x79, 0x18, 0x92, 0x5a, 0xad, 0x1b, 0x30, 0xd3, 0x19, 0x60, 0x6e,
+	0x88, 0x79, 0x9e, 0xdc, 0x80, 0x26, 0xd7, 0xc8, 0xb4, 0xad, 0x8a, 0xe6, 0x9a, 0xab, 0x47, 0xec,
+	0xea, 0xc4, 0x92, 0x39, 0x48, 0x33, 0xf9, 0x26, 0x35, 0xdf, 0x17, 0xc7, 0x7a, 0x1b, 0xe6, 0x02,
+	0x0f, 0x39, 0xad, 0xe4, 0xe3, 0x92, 0x7c, 0x56, 0x7d, 0x48, 0x69, 0xc5, 0x7c, 0xa4, 0x4d, 0xba,
+	0x43, 0x5c, 0x55, 0xce, 0xd8, 0x73, 0xad, 0xc2, 0xd7, 0x89, 0x6b, 0x79, 0x30, 0x23, 0x37, 0x2f,
+	0x17, 0xb6, 0x10, 0xf1, 0xcc, 0x22, 0xbc, 0xa4, 0x9d, 0x5d, 0x6f, 0x31, 0x7e, 0x15, 0xe3, 0x08,
+	0xa1, 0x1a, 0xab, 0xb0, 0x9d, 0xb6, 0xf5, 0x9b, 0x39, 0x0f, 0x13, 0x87, 0x1e, 0xaa, 0xa9, 0x8e,
+	0xea, 0xac, 0xad, 0x5e, 0x84, 0x83, 0x3a, 0xc4, 0x55, 0xc3, 0xd6, 0xbc, 0x2d, 0x9f, 0xc5, 0xe0,
+	0xe2, 0x75, 0x35, 0x26, 0xe0, 0xb4, 0x41, 0x9c, 0xcc, 0xc9, 0x6c, 0x61, 0xbc, 0x1b, 0x79, 0x9c,
+	0x04, 0x1e, 0xc1, 0x21, 0x53, 0xd9, 0xc8, 0x35, 0x7f, 0x0a, 0x2f, 0xc7, 0x03, 0x08, 0x8c, 0x2b,
+	0x8d, 0x94, 0x40, 0x47, 0x6f, 0xaf, 0x4c, 0xa8, 0x8b, 0xcb, 0xac, 0x4c, 0x7b, 0xbe, 0xd1, 0xb9,
+	0xc8, 0xac, 0x3f, 0x1a, 0xba, 0x8d, 0x93, 0x56, 0x54, 0x29, 0x3d, 0xd2, 0x99, 0x70, 0x1b, 0xa6,
+	0x59, 0x40, 0xdb, 0xef, 0xf0, 0x5e, 0x41, 0xda, 0xc6, 0x6d, 0x17, 0x04, 0xaf, 0x7a, 0x66, 0xe6,
+	0x01, 0x98, 0x6e, 0xe2, 0x50, 0x89, 0xc0, 0xdc, 0x48, 0x02, 0xe7, 0x52, 0x09, 0x71, 0x65, 0xe0,
+	0xc0, 0x6c, 0xbb, 0xd1, 0xe7, 0x60, 0x8c, 0xe1, 0x63, 0x79, 0x6e, 0xe3, 0xb6, 0x78, 0x34, 0x7f,
+	0x08, 0x79, 0x1a, 0x13, 0xe9, 0x44, 0xb3, 0x3c, 0x48, 0xa5, 0x9d, 0xb2, 0x58, 0xbf, 0x35, 0x20,
+	0x9f, 0x7c, 0xe8, 0x1f, 0x00, 0xdf, 0x53, 0xad, 0xba, 0x87, 0x4f, 0x70, 0x92, 0xd9, 0x5f, 0xe9,
+	0xa1, 0x6b, 0x47, 0x10, 0xc9, 0xde, 0x5c, 0x3e, 0x31, 0xf3, 0x07, 0xba, 0x37, 0xd7, 0xdc, 0x63,
+	0x43, 0x70, 0xcb, 0x66, 0x5c, 0xb1, 0x5b, 0x8f, 0xf4, 0x05, 0x7a, 0x2f, 0x44, 0x3e, 0x5f, 0x8d,
+	0x78, 0x9d, 0x86, 0xe4, 0x89, 0x9c, 0x1d, 0x33, 0xe1, 0xd0, 0x35, 0xb1, 0xac, 0x8b, 0xa3, 0xbc,
+	0x1d, 0xbf, 0x8a, 0xd9, 0xb5, 0x7c, 0x1c, 0x74, 0x0f, 0x75, 0x4a, 0xb5, 0x35, 0xa3, 0xf5, 0x49,
+	0xec, 0x3f, 0x8a, 0x46, 0xf0, 0x4a, 0x82, 0x54, 0x2b, 0x6e, 0xd5, 0x8a, 0xb3, 0xf6, 0xe4, 0x5a,
+	0xed, 0xf9, 0xbf, 0x96, 0x72, 0x34, 0xbf, 0x76, 0x45, 0xd7, 0x5a, 0x0b, 0x9d, 0xb5, 0xd6, 0xb6,
+	0xcf, 0x93, 0x62, 0xf4, 0x1e, 0xcc, 0x49, 0x13, 0xb6, 0xfd, 0x13, 0xe4, 0x11, 0x57, 0x5a, 0x72,
+	0x1a, 0xfd, 0xd6, 0xef, 0x5a, 0x82, 0x41, 0xa5, 0x72, 0x99, 0x13, 0x46, 0x9f, 0xbf, 0xe7, 0xdb,
+	0xda, 0x87, 0x2b, 0x00, 0x6d, 0xb9, 0x2e, 0xaf, 0xdd, 0x4c, 0xa6, 0xad, 0x73, 0x30, 0x26, 0xd2,
+	0x94, 0x9a, 0xcb, 0x8a, 0x47, 0x73, 0x19, 0x0a, 0x2e, 0x66, 0x4e, 0x48, 0xe4, 0xf8, 0x4d, 0x27,
+	0xb0, 0xec, 0x92, 0xf5, 0x6d, 0x5c, 0xd0, 0xb4, 0x4f, 0x94, 0x3e, 0x58, 0xd9, 0x25, 0xb5, 0x70,
+	0x88, 0x5f, 0x0e, 0x7e, 0x02, 0x73, 0xc9, 0x70, 0xa9, 0xa2, 0x8e, 0x3b, 0x76, 0x85, 0xf2, 0x70,
+	0xb7, 0xf1, 0x07, 0x2b, 0xeb, 0x8a, 0xcd, 0x9e, 0x8d, 0xe7, 0x4c, 0x7a, 0xc1, 0xfc, 0x08, 0xcc,
+	0x74, 0xda, 0x94, 0x48, 0x1f, 0x3b, 0x9d, 0xf4, 0x73, 0xc9, 0xe0, 0x49, 0xaf, 0x58, 0x7f, 0xc9,
+	0x41, 0xb1, 0x17, 0x79, 0x0c, 0xa7, 0x91, 0xc2, 0x19, 0x97, 0x0b, 0xb9, 0x4c, 0xb9, 0x70, 0x17,
+	0x8c, 0x60, 0x94, 0x5f, 0x3b, 0x8c, 0x40, 0xb0, 0x1c, 0x8f, 0xf2, 0x83, 0x85, 0x71, 0x2c, 0x58,
+	0x1a, 0xc5, 0x89, 0x11, 0x58, 0x1a, 0x82, 0xe5, 0xb0, 0x38, 0x39, 0x02, 0xcb, 0xa1, 0xf9, 0x16,
+	0xe4, 0x78, 0x50, 0x7c, 0x69, 0xf8, 0xae, 0x3d, 0xc7, 0x03, 0xeb, 0x5f, 0x86, 0x6e, 0x4b, 0xd2,
+	0xa9, 0xea, 0xd0, 0xbe, 0x73, 0xd0, 0xdb, 0x77, 0x5e, 0xeb, 0x33, 0x78, 0x1b, 0xe4, 0x35, 0x1f,
+	0xf6, 0xf1, 0x9a, 0x11, 0xe4, 0x76, 0xfa, 0xcb, 0xcf, 0x72, 0x70, 0x4b, 0x17, 0xc9, 0xb2, 0x06,
+	0xc8, 0x54, 0x3b, 0xd9, 0x6b, 0x18, 0x11, 0x0f, 0xbb, 0x2f, 0x20, 0xde, 0x5b, 0xa7, 0x29, 0x23,
+	0x38, 0x59, 0x3a, 0x4d, 0x69, 0xcb, 0x19, 0xaa, 0xe0, 0xc9, 0xe4, 0x8c, 0x25, 0x28, 0xe8, 0x7a,
+	0xa6, 0x82, 0xc3, 0x50, 0x67, 0x08, 0xd0, 0x4b, 0x9b, 0x61, 0x18, 0x47, 0xc1, 0x64, 0x12, 0x05,
+	0xd6, 0x27, 0x39, 0xb8, 0xd9, 0x03, 0x84, 0xb4, 0xf4, 0xfc, 0x1f, 0xc7, 0xe0, 0xd3, 0x1c, 0x98,
+	0x9d, 0x1e, 0xf3, 0xdf, 0x96, 0x32, 0x0e, 0x8b, 0x13, 0xa7, 0x88, 0xff, 0xc9, 0xd1, 0xe2, 0xff,
+	0x48, 0x37, 0x71, 0x9d, 0xbf, 0x96, 0x66, 0xd3, 0xc0, 0x26, 0x4c, 0xc5, 0xbf, 0x6f, 0xea, 0x76,
+	0x78, 0xf0, 0x6f, 0xdc, 0xb1, 0x1c, 0x3b, 0x61, 0xb5, 0x9e, 0x19, 0x7a, 0x70, 0x1f, 0x7f, 0x4b,
+	0xa6, 0x5b, 0x7d, 0x3d, 0xed, 0x0e, 0xcc, 0x33, 0x1a, 0x85, 0x0e, 0xee, 0x3a, 0xd1, 0x32, 0xd5,
+	0xb7, 0x96, 0xa1, 0xd6, 0xff, 0xc3, 0x45, 0x17, 0x33, 0x4e, 0x7c, 0x69, 0x7e, 0x1b, 0x9b, 0xba,
+	0x79, 0x2f, 0x64, 0x08, 0x5a, 0x78, 0xdf, 0x81, 0xa9, 0x64, 0xde, 0x33, 0xc2, 0x99, 0x25, 0x4c,
+	0x56, 0xb5, 0xe3, 0x4e, 0xce, 0xfc, 0x8e, 0xbf, 0x15, 0x62, 0xfc, 0x04, 0x0f, 0xfc, 0x3d, 0xf0,
+	0x30, 0xa4, 0x4f, 0xb0, 0x5f, 0x89, 0x7c, 0x4e, 0x54, 0x7b, 0x39, 0x66, 0x17, 0xd4, 0xda, 0x81,
+	0x58, 0x5a, 0x3b, 0xfa, 0xe2, 0xe9, 0xa2, 0xf1, 0xe5, 0xd3, 0x45, 0xe3, 0x9f, 0x4f, 0x17, 0x8d,
+	0x5f, 0x3e, 0x5b, 0x3c, 0xf3, 0xe5, 0xb3, 0xc5, 0x33, 0x5f, 0x3d, 0x5b, 0x3c, 0xf3, 0xe3, 0xf7,
+	0x6b, 0x84, 0xd7, 0xa3, 0x6a, 0xc9, 0xa1, 0x8d, 0xf2, 0x76, 0x7c, 0x44, 0x3b, 0xa8, 0xca, 0xca,
+	0xc9, 0x81, 0xbd, 0xe9, 0xd0, 0x10, 0x67, 0x5f, 0xeb, 0x88, 0xf8, 0xe5, 0x06, 0x15, 0x33, 0x12,
+	0x96, 0xfe, 0xaf, 0x85, 0x37, 0x03, 0xcc, 0xca, 0x27, 0x2b, 0xd5, 0x49, 0xf9, 0xc7, 0x96, 0xb7,
+	0xfe, 0x3d, 0x00, 0xa0, 0x7c, 0x2b, 0xf6, 0xdf, 0x23, 0x00, 0x00,
 }
 
 func (m *EventBatchSpotExecution) Marshal() (dAtA []byte, err error) {
@@ -5394,6 +5451,41 @@ func (m *EventPositionTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventDerivativeLiquidationFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDerivativeLiquidationFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDerivativeLiquidationFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FrozenUntil != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.FrozenUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -6392,6 +6484,22 @@ func (m *EventPositionTransfer) Size() (n int) {
 	return n
 }
 
+func (m *EventDerivativeLiquidationFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.FrozenUntil != 0 {
+		n += 1 + sovEvents(uint64(m.FrozenUntil))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -13441,6 +13549,107 @@ func (m *EventPositionTransfer) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventDerivativeLiquidationFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventDerivativeLiquidationFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventDerivativeLiquidationFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenUntil", wireType)
+			}
+			m.FrozenUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrozenUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
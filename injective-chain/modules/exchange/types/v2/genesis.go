@@ -1,5 +1,11 @@
 package v2
 
+import (
+	"cosmossdk.io/errors"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+)
+
 func NewGenesisState() GenesisState {
 	return GenesisState{}
 }
@@ -17,5 +23,22 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	frozenMarkets := make(map[string]struct{}, len(gs.DerivativeLiquidationFreezes))
+	for _, freeze := range gs.DerivativeLiquidationFreezes {
+		if !types.IsHexHash(freeze.MarketId) {
+			return errors.Wrapf(types.ErrMarketInvalid, "invalid liquidation freeze market id %s", freeze.MarketId)
+		}
+
+		if _, ok := frozenMarkets[freeze.MarketId]; ok {
+			return errors.Wrapf(types.ErrMarketInvalid, "duplicate liquidation freeze for market %s", freeze.MarketId)
+		}
+		frozenMarkets[freeze.MarketId] = struct{}{}
+
+		if freeze.FrozenUntil <= 0 {
+			return errors.Wrapf(types.ErrInvalidExpiry, "invalid liquidation freeze time %d for market %s", freeze.FrozenUntil, freeze.MarketId)
+		}
+	}
+
 	return nil
 }
@@ -105,6 +105,9 @@ type GenesisState struct {
 	GrantAuthorizations  []*FullGrantAuthorizations         `protobuf:"bytes,35,rep,name=grant_authorizations,json=grantAuthorizations,proto3" json:"grant_authorizations,omitempty"`
 	ActiveGrants         []*FullActiveGrant                 `protobuf:"bytes,36,rep,name=active_grants,json=activeGrants,proto3" json:"active_grants,omitempty"`
 	DenomMinNotionals    []*DenomMinNotional                `protobuf:"bytes,37,rep,name=denom_min_notionals,json=denomMinNotionals,proto3" json:"denom_min_notionals,omitempty"`
+	// derivative_liquidation_freezes defines the derivative markets whose
+	// liquidations are frozen at genesis
+	DerivativeLiquidationFreezes []DerivativeLiquidationFreeze `protobuf:"bytes,38,rep,name=derivative_liquidation_freezes,json=derivativeLiquidationFreezes,proto3" json:"derivative_liquidation_freezes"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -399,6 +402,13 @@ func (m *GenesisState) GetDenomMinNotionals() []*DenomMinNotional {
 	return nil
 }
 
+func (m *GenesisState) GetDerivativeLiquidationFreezes() []DerivativeLiquidationFreeze {
+	if m != nil {
+		return m.DerivativeLiquidationFreezes
+	}
+	return nil
+}
+
 type OrderbookSequence struct {
 	Sequence uint64 `protobuf:"varint,1,opt,name=sequence,proto3" json:"sequence,omitempty"`
 	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
@@ -451,6 +461,59 @@ func (m *OrderbookSequence) GetMarketId() string {
 	return ""
 }
 
+type DerivativeLiquidationFreeze struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// the block time until which liquidations are frozen
+	FrozenUntil int64 `protobuf:"varint,2,opt,name=frozen_until,json=frozenUntil,proto3" json:"frozen_until,omitempty"`
+}
+
+func (m *DerivativeLiquidationFreeze) Reset()         { *m = DerivativeLiquidationFreeze{} }
+func (m *DerivativeLiquidationFreeze) String() string { return proto.CompactTextString(m) }
+func (*DerivativeLiquidationFreeze) ProtoMessage()    {}
+func (*DerivativeLiquidationFreeze) Descriptor() ([]byte, []int) {
+	return fileDescriptor_fff40080d86ae941, []int{2}
+}
+func (m *DerivativeLiquidationFreeze) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *DerivativeLiquidationFreeze) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_DerivativeLiquidationFreeze.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *DerivativeLiquidationFreeze) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DerivativeLiquidationFreeze.Merge(m, src)
+}
+func (m *DerivativeLiquidationFreeze) XXX_Size() int {
+	return m.Size()
+}
+func (m *DerivativeLiquidationFreeze) XXX_DiscardUnknown() {
+	xxx_messageInfo_DerivativeLiquidationFreeze.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DerivativeLiquidationFreeze proto.InternalMessageInfo
+
+func (m *DerivativeLiquidationFreeze) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *DerivativeLiquidationFreeze) GetFrozenUntil() int64 {
+	if m != nil {
+		return m.FrozenUntil
+	}
+	return 0
+}
+
 type FeeDiscountAccountTierTTL struct {
 	Account string              `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
 	TierTtl *FeeDiscountTierTTL `protobuf:"bytes,2,opt,name=tier_ttl,json=tierTtl,proto3" json:"tier_ttl,omitempty"`
@@ -460,7 +523,7 @@ func (m *FeeDiscountAccountTierTTL) Reset()         { *m = FeeDiscountAccountTie
 func (m *FeeDiscountAccountTierTTL) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountAccountTierTTL) ProtoMessage()    {}
 func (*FeeDiscountAccountTierTTL) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{2}
+	return fileDescriptor_fff40080d86ae941, []int{3}
 }
 func (m *FeeDiscountAccountTierTTL) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -512,7 +575,7 @@ func (m *FeeDiscountBucketVolumeAccounts) Reset()         { *m = FeeDiscountBuck
 func (m *FeeDiscountBucketVolumeAccounts) String() string { return proto.CompactTextString(m) }
 func (*FeeDiscountBucketVolumeAccounts) ProtoMessage()    {}
 func (*FeeDiscountBucketVolumeAccounts) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{3}
+	return fileDescriptor_fff40080d86ae941, []int{4}
 }
 func (m *FeeDiscountBucketVolumeAccounts) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -564,7 +627,7 @@ func (m *AccountVolume) Reset()         { *m = AccountVolume{} }
 func (m *AccountVolume) String() string { return proto.CompactTextString(m) }
 func (*AccountVolume) ProtoMessage()    {}
 func (*AccountVolume) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{4}
+	return fileDescriptor_fff40080d86ae941, []int{5}
 }
 func (m *AccountVolume) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -609,7 +672,7 @@ func (m *TradingRewardCampaignAccountPoints) Reset()         { *m = TradingRewar
 func (m *TradingRewardCampaignAccountPoints) String() string { return proto.CompactTextString(m) }
 func (*TradingRewardCampaignAccountPoints) ProtoMessage()    {}
 func (*TradingRewardCampaignAccountPoints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{5}
+	return fileDescriptor_fff40080d86ae941, []int{6}
 }
 func (m *TradingRewardCampaignAccountPoints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -658,7 +721,7 @@ func (m *TradingRewardCampaignAccountPendingPoints) String() string {
 }
 func (*TradingRewardCampaignAccountPendingPoints) ProtoMessage() {}
 func (*TradingRewardCampaignAccountPendingPoints) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{6}
+	return fileDescriptor_fff40080d86ae941, []int{7}
 }
 func (m *TradingRewardCampaignAccountPendingPoints) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -712,7 +775,7 @@ func (m *SubaccountNonce) Reset()         { *m = SubaccountNonce{} }
 func (m *SubaccountNonce) String() string { return proto.CompactTextString(m) }
 func (*SubaccountNonce) ProtoMessage()    {}
 func (*SubaccountNonce) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{7}
+	return fileDescriptor_fff40080d86ae941, []int{8}
 }
 func (m *SubaccountNonce) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -752,7 +815,7 @@ func (m *FullGrantAuthorizations) Reset()         { *m = FullGrantAuthorizations
 func (m *FullGrantAuthorizations) String() string { return proto.CompactTextString(m) }
 func (*FullGrantAuthorizations) ProtoMessage()    {}
 func (*FullGrantAuthorizations) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{8}
+	return fileDescriptor_fff40080d86ae941, []int{9}
 }
 func (m *FullGrantAuthorizations) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -811,7 +874,7 @@ func (m *FullActiveGrant) Reset()         { *m = FullActiveGrant{} }
 func (m *FullActiveGrant) String() string { return proto.CompactTextString(m) }
 func (*FullActiveGrant) ProtoMessage()    {}
 func (*FullActiveGrant) Descriptor() ([]byte, []int) {
-	return fileDescriptor_fff40080d86ae941, []int{9}
+	return fileDescriptor_fff40080d86ae941, []int{10}
 }
 func (m *FullActiveGrant) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -857,6 +920,7 @@ func (m *FullActiveGrant) GetActiveGrant() *ActiveGrant {
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.exchange.v2.GenesisState")
 	proto.RegisterType((*OrderbookSequence)(nil), "injective.exchange.v2.OrderbookSequence")
+	proto.RegisterType((*DerivativeLiquidationFreeze)(nil), "injective.exchange.v2.DerivativeLiquidationFreeze")
 	proto.RegisterType((*FeeDiscountAccountTierTTL)(nil), "injective.exchange.v2.FeeDiscountAccountTierTTL")
 	proto.RegisterType((*FeeDiscountBucketVolumeAccounts)(nil), "injective.exchange.v2.FeeDiscountBucketVolumeAccounts")
 	proto.RegisterType((*AccountVolume)(nil), "injective.exchange.v2.AccountVolume")
@@ -872,121 +936,125 @@ func init() {
 }
 
 var fileDescriptor_fff40080d86ae941 = []byte{
-	// 1823 bytes of a gzipped FileDescriptorProto
+	// 1887 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x58, 0x5b, 0x6f, 0x1c, 0x49,
-	0x15, 0x76, 0xc7, 0xc1, 0xb1, 0xcb, 0x76, 0xb2, 0x29, 0x5f, 0xd2, 0xb6, 0xe3, 0x19, 0x67, 0x9c,
-	0x84, 0x09, 0xb0, 0x33, 0x2b, 0x2f, 0x17, 0x2d, 0x0b, 0xd2, 0xfa, 0x36, 0x2b, 0x93, 0x64, 0xe3,
-	0x6d, 0x8f, 0x16, 0x81, 0x04, 0xbd, 0x35, 0xdd, 0x35, 0x33, 0x85, 0xbb, 0xbb, 0x7a, 0xab, 0xaa,
-	0x4d, 0x4c, 0xc4, 0x03, 0x08, 0x21, 0x84, 0x84, 0xb4, 0xbf, 0x00, 0xad, 0x04, 0x2f, 0xfc, 0x93,
-	0x7d, 0xe0, 0x61, 0x1f, 0x11, 0x0f, 0x11, 0x4a, 0x5e, 0xf8, 0x19, 0xa8, 0x2e, 0xdd, 0x3d, 0xb7,
-	0xee, 0x71, 0xd8, 0xb7, 0xe9, 0x3a, 0xe7, 0x7c, 0xdf, 0xa9, 0x3a, 0xa7, 0xce, 0x39, 0x35, 0x60,
-	0x97, 0x44, 0xbf, 0xc2, 0x9e, 0x20, 0x17, 0xb8, 0x89, 0x9f, 0x7b, 0x7d, 0x14, 0xf5, 0x70, 0xf3,
-	0x62, 0xaf, 0xd9, 0xc3, 0x11, 0xe6, 0x84, 0x37, 0x62, 0x46, 0x05, 0x85, 0x6b, 0x99, 0x52, 0x23,
-	0x55, 0x6a, 0x5c, 0xec, 0x6d, 0xae, 0xf6, 0x68, 0x8f, 0x2a, 0x8d, 0xa6, 0xfc, 0xa5, 0x95, 0x37,
-	0xef, 0x4f, 0x46, 0xcc, 0x0c, 0xb5, 0x56, 0x6d, 0xb2, 0x56, 0x88, 0xd8, 0x39, 0x16, 0x46, 0xe7,
-	0xc1, 0x64, 0x1d, 0xca, 0x7c, 0xcc, 0x3a, 0x94, 0x9e, 0x1b, 0xb5, 0xca, 0x64, 0x35, 0xf1, 0x5c,
-	0xcb, 0x6b, 0x7f, 0xdd, 0x06, 0x4b, 0x1f, 0xea, 0xfd, 0x9c, 0x09, 0x24, 0x30, 0x7c, 0x1f, 0xcc,
-	0xc5, 0x88, 0xa1, 0x90, 0xdb, 0xd6, 0x8e, 0x55, 0x5f, 0xdc, 0xdb, 0x6e, 0x4c, 0xdc, 0x5f, 0xe3,
-	0x54, 0x29, 0x1d, 0x5c, 0xff, 0xf2, 0x65, 0x75, 0xc6, 0x31, 0x26, 0xf0, 0x08, 0x2c, 0xf1, 0x98,
-	0x0a, 0x57, 0x7b, 0xca, 0xed, 0x6b, 0x3b, 0xb3, 0xf5, 0xc5, 0xbd, 0x7b, 0x05, 0x10, 0x67, 0x31,
-	0x15, 0x4f, 0x95, 0xa6, 0xb3, 0xc8, 0xb3, 0xdf, 0x1c, 0x7e, 0x02, 0xa0, 0x8f, 0x19, 0xb9, 0x40,
-	0xd2, 0x22, 0xc3, 0x9a, 0x55, 0x58, 0xdf, 0x2c, 0xc0, 0x3a, 0xca, 0x0c, 0x0c, 0xe2, 0x6d, 0x7f,
-	0x64, 0x85, 0xc3, 0x8f, 0xc1, 0x4d, 0xe5, 0x5d, 0x76, 0x46, 0xf6, 0x75, 0x85, 0x79, 0xbf, 0xc4,
-	0xbf, 0x67, 0x52, 0xf7, 0x80, 0xd2, 0x73, 0xb3, 0xd3, 0x65, 0x9e, 0x2e, 0x4a, 0x00, 0xe8, 0x81,
-	0xd5, 0x01, 0x57, 0x73, 0xe0, 0x6f, 0x28, 0xe0, 0x6f, 0x4d, 0x75, 0x76, 0x14, 0x7e, 0xc5, 0x1f,
-	0x16, 0x29, 0x92, 0x0f, 0xc0, 0x7c, 0x07, 0x05, 0x28, 0xf2, 0x30, 0xb7, 0xe7, 0x14, 0x70, 0xa5,
-	0x00, 0xf8, 0x40, 0xab, 0x19, 0xb0, 0xcc, 0x0a, 0x3e, 0x05, 0x0b, 0x31, 0xe5, 0x44, 0x10, 0x1a,
-	0x71, 0xfb, 0x86, 0x82, 0x78, 0x34, 0xd5, 0xb7, 0x53, 0x63, 0x61, 0xd0, 0x72, 0x04, 0xe8, 0x83,
-	0x3b, 0x3c, 0xe9, 0x20, 0xcf, 0xa3, 0x49, 0x24, 0x5c, 0xc1, 0x90, 0x8f, 0xdd, 0x88, 0x2a, 0xff,
-	0xe6, 0x15, 0xf8, 0xc3, 0xa2, 0x13, 0xcd, 0xac, 0x3e, 0xa2, 0xb9, 0x9f, 0x6b, 0x39, 0x58, 0x5b,
-	0x62, 0x29, 0x19, 0x87, 0xbf, 0xb3, 0xc0, 0x0e, 0x7e, 0x1e, 0x13, 0x76, 0xe9, 0x76, 0x13, 0x91,
-	0x30, 0xcc, 0x4d, 0x2e, 0xb8, 0x24, 0xea, 0x52, 0x97, 0xcb, 0x74, 0xb5, 0x17, 0x14, 0xdf, 0xbb,
-	0x05, 0x7c, 0xc7, 0xca, 0xbc, 0xa5, 0xad, 0x75, 0x1a, 0x9c, 0x44, 0x5d, 0xaa, 0x32, 0xdd, 0x90,
-	0xdf, 0xc5, 0x25, 0x3a, 0xd0, 0x07, 0x6b, 0x31, 0x66, 0x31, 0x16, 0x09, 0x0a, 0x06, 0xd9, 0x6d,
-	0x50, 0x1a, 0xe0, 0xd3, 0xd4, 0x26, 0xc7, 0x4b, 0x03, 0x1c, 0x8f, 0x8b, 0xe0, 0x6f, 0x41, 0x65,
-	0x8c, 0xa5, 0x9b, 0x44, 0x3e, 0x89, 0x7a, 0x66, 0x9b, 0x8b, 0x8a, 0x6e, 0xef, 0x6a, 0x74, 0x2d,
-	0x6d, 0x3a, 0xb8, 0xcb, 0xad, 0xb8, 0x58, 0x05, 0x7e, 0x6e, 0x81, 0x87, 0x63, 0x17, 0xce, 0xe5,
-	0x58, 0x88, 0x00, 0x87, 0x38, 0x12, 0x2e, 0xf7, 0xfa, 0xd8, 0x4f, 0x02, 0xec, 0xdb, 0x4b, 0xca,
-	0x8f, 0xef, 0x5d, 0xf1, 0x12, 0x9e, 0x65, 0x10, 0x03, 0x27, 0xb0, 0xeb, 0x17, 0x6a, 0x9d, 0xa5,
-	0x3c, 0xf0, 0x07, 0xc0, 0x26, 0xdc, 0x55, 0xb7, 0x35, 0x25, 0x70, 0x71, 0x84, 0x3a, 0xd2, 0x87,
-	0xe5, 0x1d, 0xab, 0x3e, 0xef, 0xac, 0x11, 0x2e, 0xef, 0xe7, 0xb1, 0x91, 0x1e, 0x6b, 0x21, 0x3c,
-	0x06, 0x55, 0xc2, 0xdd, 0x9c, 0x82, 0x8f, 0xdb, 0xdf, 0x54, 0xf6, 0x77, 0x09, 0xcf, 0xdd, 0xe5,
-	0xa3, 0x30, 0x9f, 0x81, 0xbb, 0x32, 0xad, 0x65, 0x00, 0x18, 0xfe, 0x35, 0x62, 0xbe, 0xeb, 0xa1,
-	0x30, 0x46, 0xa4, 0x17, 0xe9, 0xf0, 0xdf, 0x52, 0xb5, 0xf1, 0x9d, 0x82, 0x73, 0x68, 0x6b, 0x53,
-	0x47, 0x59, 0x1e, 0x1a, 0x43, 0x79, 0x04, 0xce, 0x86, 0x28, 0x12, 0xc1, 0x17, 0xe0, 0xc1, 0x08,
-	0x65, 0x4c, 0x69, 0x90, 0xf3, 0xa6, 0x41, 0xb0, 0xdf, 0x2a, 0xbd, 0xbf, 0x29, 0xa6, 0x66, 0x38,
-	0xa5, 0x34, 0x70, 0xee, 0x0d, 0x91, 0xca, 0xa5, 0x54, 0x29, 0x3d, 0x70, 0xf8, 0x17, 0x0b, 0x3c,
-	0x2c, 0xda, 0x70, 0x7a, 0xcf, 0x63, 0x4a, 0x22, 0xc1, 0xed, 0xdb, 0x8a, 0xfe, 0xbd, 0x37, 0xd9,
-	0xfa, 0xbe, 0x46, 0x38, 0x55, 0x00, 0x4e, 0x4d, 0x4c, 0xd5, 0x81, 0xbf, 0x04, 0x6b, 0x5d, 0x8c,
-	0x5d, 0x9f, 0x70, 0xcd, 0x9d, 0x6d, 0x1e, 0xaa, 0x83, 0x2f, 0xba, 0x77, 0x2d, 0x8c, 0x8f, 0x8c,
-	0x49, 0xba, 0x35, 0x67, 0xa5, 0x3b, 0xbe, 0x08, 0x19, 0xd8, 0x1e, 0xc2, 0xcf, 0x6a, 0x19, 0xc1,
-	0xcc, 0x15, 0x22, 0xb0, 0x57, 0xd4, 0x2e, 0xdf, 0x99, 0xce, 0x63, 0xfc, 0x6e, 0x13, 0xcc, 0xda,
-	0xed, 0x27, 0xce, 0x46, 0x77, 0xb2, 0x48, 0x04, 0xf0, 0x0f, 0x16, 0xd8, 0x1d, 0x22, 0xed, 0x24,
-	0x9e, 0xbc, 0x68, 0x17, 0x34, 0x48, 0x42, 0x9c, 0xba, 0xc0, 0xed, 0x55, 0x45, 0xfd, 0xfd, 0xe9,
-	0xd4, 0x07, 0xca, 0xfe, 0x13, 0x65, 0x6e, 0xb8, 0xb8, 0x53, 0xed, 0x96, 0x2b, 0xc0, 0x1f, 0x81,
-	0x2d, 0xc2, 0xdd, 0x2e, 0x61, 0x5c, 0xb8, 0xd2, 0x1d, 0xef, 0xd2, 0x0b, 0xb0, 0xdb, 0x25, 0x11,
-	0xe1, 0x7d, 0xec, 0xdb, 0x6b, 0xea, 0x76, 0xdc, 0x21, 0xbc, 0x25, 0x35, 0x5a, 0x18, 0x1f, 0x4a,
-	0x79, 0xcb, 0x88, 0xe1, 0x9f, 0x2d, 0xf0, 0x76, 0x8c, 0x75, 0x69, 0xba, 0x5a, 0xba, 0xae, 0xbf,
-	0x69, 0xba, 0xd6, 0x0d, 0x7e, 0x7b, 0x6a, 0xd6, 0xfe, 0xcd, 0x02, 0x8d, 0x02, 0x67, 0x8a, 0xb2,
-	0xf7, 0x8e, 0xf2, 0xe6, 0x83, 0xff, 0x27, 0x7b, 0x35, 0x91, 0x49, 0xe2, 0x47, 0x93, 0x9c, 0x9c,
-	0x9c, 0xcb, 0xef, 0x81, 0x0d, 0xed, 0x14, 0x77, 0x69, 0x2c, 0x5c, 0x9a, 0x08, 0x17, 0xf9, 0x3e,
-	0xc3, 0x9c, 0x63, 0x6e, 0xdb, 0x3b, 0xb3, 0xf5, 0x05, 0x67, 0xdd, 0x28, 0x3c, 0x8b, 0xc5, 0xb3,
-	0x44, 0xec, 0xa7, 0x52, 0xf8, 0x0b, 0x60, 0xf7, 0x09, 0x17, 0x94, 0x11, 0x0f, 0x05, 0xa6, 0xd1,
-	0x32, 0xec, 0x51, 0xe6, 0x73, 0x7b, 0x43, 0xed, 0x64, 0xb7, 0x64, 0x27, 0xd8, 0xd1, 0xaa, 0xce,
-	0x7a, 0x0e, 0x32, 0xb8, 0x0e, 0x3f, 0x05, 0xeb, 0x1d, 0x12, 0x21, 0x76, 0x29, 0x1d, 0x93, 0x9d,
-	0x3d, 0x1b, 0xb6, 0x36, 0x4b, 0xdb, 0xdb, 0x81, 0x32, 0x7a, 0xa6, 0x6d, 0xcc, 0xbc, 0xb5, 0xda,
-	0x19, 0x5f, 0xe4, 0xb0, 0x0f, 0xf6, 0x26, 0x32, 0xb8, 0xc4, 0xe7, 0x79, 0x5b, 0x71, 0xbb, 0x94,
-	0x0d, 0xf4, 0x1b, 0x7b, 0x4b, 0x1d, 0xca, 0x77, 0x26, 0x20, 0x9e, 0xf8, 0x3c, 0x6b, 0x12, 0x2d,
-	0xca, 0xf2, 0xd6, 0x01, 0xdb, 0xa0, 0x3e, 0x30, 0x7a, 0x8e, 0xe0, 0x0b, 0x2a, 0x29, 0x3c, 0xec,
-	0x7a, 0x01, 0xe5, 0xd8, 0xbe, 0xab, 0xf0, 0x6b, 0xf9, 0xcc, 0x39, 0x08, 0xdb, 0xa6, 0x2d, 0xa9,
-	0x7a, 0x28, 0x35, 0xe1, 0xef, 0x2d, 0x50, 0x47, 0x89, 0x27, 0x3d, 0xc8, 0x1b, 0x89, 0x60, 0x28,
-	0xe2, 0x5d, 0xcc, 0x5c, 0x1f, 0x47, 0x34, 0x74, 0x7d, 0xec, 0x91, 0x10, 0x05, 0xdc, 0xde, 0x2e,
-	0x9d, 0x26, 0x8f, 0xa4, 0xf2, 0x91, 0xd1, 0x35, 0xbd, 0xf0, 0xbe, 0xc1, 0x4e, 0xdb, 0x4f, 0xdb,
-	0x20, 0x0f, 0xe9, 0xca, 0x41, 0xe8, 0x9e, 0x47, 0x23, 0x5f, 0x4d, 0x5f, 0x28, 0x70, 0x27, 0x4d,
-	0x9c, 0xdc, 0xae, 0x94, 0xb6, 0xe6, 0xc3, 0xdc, 0x7e, 0xc2, 0xf4, 0xe9, 0x54, 0xbd, 0x42, 0xb9,
-	0x42, 0x97, 0xa9, 0x92, 0x0e, 0x26, 0x18, 0xbb, 0x61, 0x12, 0x08, 0x12, 0x07, 0x04, 0x33, 0x6e,
-	0x57, 0x4b, 0x53, 0xc5, 0x8c, 0x1b, 0x18, 0x3f, 0xcd, 0x4c, 0x9c, 0xd5, 0x70, 0x7c, 0x91, 0xc3,
-	0x9f, 0x81, 0x95, 0x6c, 0x37, 0x2e, 0xc7, 0x9f, 0x25, 0x58, 0x0d, 0x94, 0x3b, 0x0a, 0xbe, 0x5e,
-	0x00, 0x9f, 0x79, 0x78, 0x66, 0x0c, 0x1c, 0x48, 0x47, 0x97, 0x38, 0xc4, 0x00, 0x0e, 0xcc, 0xab,
-	0xba, 0xde, 0x72, 0xfb, 0x5e, 0x69, 0x9d, 0xdd, 0xef, 0xf5, 0x18, 0xee, 0x21, 0x81, 0xf3, 0x99,
-	0x55, 0x17, 0x52, 0x7d, 0x79, 0x9c, 0xdb, 0x7c, 0x64, 0x9d, 0xc3, 0x9f, 0x80, 0x9b, 0xe6, 0x8c,
-	0x52, 0x8a, 0x5a, 0xe9, 0x1d, 0xd5, 0x67, 0x63, 0x50, 0x97, 0xc3, 0x81, 0x2f, 0x0e, 0x11, 0x58,
-	0xed, 0x31, 0x24, 0x3b, 0x53, 0x22, 0xfa, 0x94, 0x91, 0xdf, 0x20, 0x3d, 0xbc, 0xef, 0x2a, 0xc4,
-	0x46, 0x51, 0x73, 0x48, 0x82, 0xe0, 0x43, 0x69, 0xb6, 0x3f, 0x64, 0xe5, 0xac, 0xf4, 0xc6, 0x17,
-	0xe1, 0x63, 0xb0, 0x8c, 0x14, 0x84, 0xab, 0xa4, 0xdc, 0xbe, 0x5f, 0x3a, 0xbb, 0x4b, 0xec, 0x7d,
-	0xb5, 0xac, 0x18, 0x9c, 0x25, 0x94, 0x7f, 0x70, 0xf8, 0x53, 0xb0, 0xa2, 0x6f, 0x43, 0x48, 0x22,
-	0x37, 0xa2, 0x3a, 0x93, 0xb8, 0xfd, 0x60, 0xca, 0xa3, 0x2d, 0xa2, 0xe1, 0x53, 0x12, 0x7d, 0x64,
-	0xf4, 0xe5, 0xa3, 0x6d, 0x78, 0x85, 0xd7, 0x9e, 0x80, 0xdb, 0x63, 0x41, 0x86, 0x9b, 0x60, 0x3e,
-	0xcd, 0x10, 0xf5, 0x4c, 0xbd, 0xee, 0x64, 0xdf, 0x70, 0x0b, 0x2c, 0x64, 0x35, 0xc0, 0xbe, 0xb6,
-	0x63, 0xd5, 0x17, 0x9c, 0xf9, 0xd0, 0xdc, 0xf2, 0xda, 0x0b, 0xb0, 0x51, 0xd8, 0xbb, 0xa1, 0x0d,
-	0x6e, 0x98, 0x88, 0x2a, 0xd0, 0x05, 0x27, 0xfd, 0x84, 0x47, 0x60, 0x3e, 0x9b, 0x0c, 0xae, 0xa9,
-	0x09, 0xe4, 0xd1, 0xf4, 0xf6, 0x9c, 0x8e, 0x04, 0x37, 0x84, 0x1e, 0x00, 0x6a, 0x7f, 0xb7, 0x40,
-	0x75, 0x4a, 0xfb, 0x86, 0xdf, 0x05, 0xeb, 0x66, 0x2c, 0xe0, 0x02, 0x31, 0x39, 0x90, 0x84, 0x98,
-	0x0b, 0x14, 0xc6, 0xca, 0xa5, 0x59, 0x67, 0x55, 0x4b, 0xcf, 0xa4, 0xb0, 0x9d, 0xca, 0xe0, 0x63,
-	0x70, 0x73, 0x38, 0xbb, 0xcd, 0xcb, 0xbb, 0xa8, 0x16, 0xed, 0x0f, 0x25, 0xf4, 0xf2, 0x50, 0x1e,
-	0xd7, 0xba, 0x60, 0x79, 0x48, 0x5e, 0x72, 0x2e, 0xef, 0x83, 0xb9, 0x8c, 0xcf, 0xaa, 0x2f, 0x1c,
-	0xec, 0xca, 0xaa, 0xf6, 0xef, 0x97, 0xd5, 0x2d, 0x8f, 0xf2, 0x90, 0x72, 0xee, 0x9f, 0x37, 0x08,
-	0x6d, 0x86, 0x48, 0xf4, 0x1b, 0x4f, 0x70, 0x0f, 0x79, 0x97, 0x47, 0xd8, 0x73, 0x8c, 0x49, 0xed,
-	0x05, 0xa8, 0x5d, 0xa1, 0x7b, 0x96, 0x92, 0x9b, 0xa6, 0xfe, 0x26, 0xe4, 0xda, 0xa4, 0xf6, 0x4f,
-	0x0b, 0x3c, 0xba, 0x72, 0xb7, 0x87, 0x3f, 0x06, 0x5b, 0x83, 0x43, 0xce, 0xe4, 0xd0, 0xd8, 0x2c,
-	0x9b, 0x54, 0x46, 0xc2, 0xf3, 0x69, 0x1e, 0x9e, 0xcc, 0xe3, 0xaf, 0x39, 0x44, 0xa7, 0x31, 0xd3,
-	0x9f, 0xb5, 0x7f, 0x58, 0xe0, 0xd6, 0xc8, 0xe3, 0x1a, 0xee, 0x82, 0xe5, 0x81, 0xaa, 0x47, 0x7c,
-	0x73, 0x7e, 0x4b, 0xf9, 0xe2, 0x89, 0x0f, 0x7b, 0x60, 0x7d, 0xf2, 0x53, 0xde, 0xe4, 0xf9, 0xb7,
-	0xa7, 0xbe, 0xe4, 0xf3, 0x27, 0xbb, 0x69, 0x6a, 0xab, 0x93, 0x9e, 0xf3, 0x3f, 0x9c, 0xff, 0xd3,
-	0x17, 0xd5, 0x99, 0xff, 0x7e, 0x51, 0x9d, 0xa9, 0xfd, 0xf1, 0x1a, 0xb8, 0x53, 0x50, 0xa8, 0x64,
-	0xb4, 0x55, 0x31, 0xc2, 0x2c, 0x8d, 0xb6, 0xf9, 0x84, 0x8f, 0x01, 0x14, 0x54, 0xa0, 0xc0, 0x35,
-	0x65, 0x31, 0x54, 0x29, 0xa1, 0x23, 0xbf, 0x6d, 0x22, 0xbf, 0x36, 0x1e, 0xf9, 0x93, 0x48, 0x38,
-	0x6f, 0x29, 0x43, 0x4d, 0xa7, 0xcc, 0xe0, 0x3e, 0xd8, 0x0e, 0x10, 0x17, 0xae, 0x8f, 0x03, 0x59,
-	0xe3, 0xd5, 0x60, 0xe2, 0xf5, 0xb1, 0x77, 0x2e, 0x67, 0x05, 0x12, 0x62, 0x7b, 0x56, 0x45, 0x74,
-	0x53, 0x2a, 0x1d, 0xe5, 0x3a, 0x87, 0x5a, 0x45, 0x06, 0x16, 0xee, 0x83, 0x39, 0x53, 0x36, 0xaf,
-	0x97, 0x0e, 0xb8, 0xe3, 0xbb, 0x74, 0x8c, 0x61, 0x8d, 0x81, 0x5b, 0x23, 0x45, 0x35, 0xdf, 0x3f,
-	0x1e, 0xde, 0x3f, 0x86, 0xc7, 0x60, 0x69, 0xb0, 0x5a, 0x9b, 0xf0, 0xd4, 0x0a, 0x2f, 0x78, 0x5e,
-	0xa8, 0x17, 0x07, 0x0a, 0xf5, 0xc1, 0xf9, 0x97, 0xaf, 0x2a, 0xd6, 0x57, 0xaf, 0x2a, 0xd6, 0x7f,
-	0x5e, 0x55, 0xac, 0xcf, 0x5f, 0x57, 0x66, 0xbe, 0x7a, 0x5d, 0x99, 0xf9, 0xd7, 0xeb, 0xca, 0xcc,
-	0xcf, 0x3f, 0xee, 0x11, 0xd1, 0x4f, 0x3a, 0x0d, 0x8f, 0x86, 0xcd, 0x93, 0x14, 0xf4, 0x09, 0xea,
-	0xf0, 0x66, 0x46, 0xf1, 0xb6, 0x47, 0x19, 0x1e, 0xfc, 0xec, 0x23, 0x12, 0x35, 0x43, 0x2a, 0xe7,
-	0x26, 0x9e, 0xff, 0xbf, 0x28, 0x2e, 0x63, 0xcc, 0x9b, 0x17, 0x7b, 0x9d, 0x39, 0xf5, 0x1f, 0xe3,
-	0xbb, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0x10, 0x54, 0x69, 0xce, 0x48, 0x15, 0x00, 0x00,
+	0x15, 0x76, 0xdb, 0xc1, 0xb1, 0xcb, 0x76, 0xb2, 0x29, 0x5f, 0xd2, 0xb6, 0xe3, 0x99, 0xf1, 0x38,
+	0x09, 0x13, 0x60, 0x67, 0x90, 0x97, 0x8b, 0x96, 0x05, 0x69, 0x7d, 0x9b, 0x95, 0x89, 0xb3, 0xf1,
+	0xb6, 0x87, 0x45, 0x20, 0x2d, 0xbd, 0x35, 0xdd, 0x35, 0x33, 0x85, 0xbb, 0xbb, 0x3a, 0x55, 0xd5,
+	0x26, 0x4e, 0x04, 0x12, 0x08, 0x21, 0x84, 0x84, 0xb4, 0xbf, 0x00, 0xad, 0x04, 0x2f, 0xfc, 0x93,
+	0x7d, 0xe0, 0x61, 0x1f, 0x11, 0x0f, 0x2b, 0x94, 0xbc, 0xf0, 0x33, 0x50, 0x55, 0x57, 0x5f, 0xe6,
+	0xd2, 0x6d, 0x07, 0xde, 0xa6, 0xeb, 0x9c, 0xef, 0x3b, 0xa7, 0xea, 0x9c, 0x53, 0xe7, 0x4c, 0x81,
+	0x1d, 0x12, 0xfc, 0x12, 0x3b, 0x82, 0x5c, 0xe0, 0x16, 0x7e, 0xee, 0x0c, 0x50, 0xd0, 0xc7, 0xad,
+	0x8b, 0xdd, 0x56, 0x1f, 0x07, 0x98, 0x13, 0xde, 0x0c, 0x19, 0x15, 0x14, 0xae, 0xa6, 0x4a, 0xcd,
+	0x44, 0xa9, 0x79, 0xb1, 0xbb, 0xb1, 0xd2, 0xa7, 0x7d, 0xaa, 0x34, 0x5a, 0xf2, 0x57, 0xac, 0xbc,
+	0x71, 0x7f, 0x32, 0x63, 0x0a, 0x8c, 0xb5, 0xea, 0x93, 0xb5, 0x7c, 0xc4, 0xce, 0xb1, 0xd0, 0x3a,
+	0x0f, 0x26, 0xeb, 0x50, 0xe6, 0x62, 0xd6, 0xa5, 0xf4, 0x5c, 0xab, 0x55, 0x26, 0xab, 0x89, 0xe7,
+	0xb1, 0xbc, 0xfe, 0x97, 0x0a, 0x58, 0xfc, 0x20, 0xde, 0xcf, 0x99, 0x40, 0x02, 0xc3, 0xf7, 0xc0,
+	0x6c, 0x88, 0x18, 0xf2, 0xb9, 0x69, 0xd4, 0x8c, 0xc6, 0xc2, 0xee, 0x56, 0x73, 0xe2, 0xfe, 0x9a,
+	0xa7, 0x4a, 0x69, 0xff, 0xc6, 0x17, 0x5f, 0x55, 0xa7, 0x2c, 0x0d, 0x81, 0x87, 0x60, 0x91, 0x87,
+	0x54, 0xd8, 0xb1, 0xa7, 0xdc, 0x9c, 0xae, 0xcd, 0x34, 0x16, 0x76, 0xb7, 0x0b, 0x28, 0xce, 0x42,
+	0x2a, 0x9e, 0x28, 0x4d, 0x6b, 0x81, 0xa7, 0xbf, 0x39, 0xfc, 0x18, 0x40, 0x17, 0x33, 0x72, 0x81,
+	0x24, 0x22, 0xe5, 0x9a, 0x51, 0x5c, 0x5f, 0x2f, 0xe0, 0x3a, 0x4c, 0x01, 0x9a, 0xf1, 0x8e, 0x3b,
+	0xb2, 0xc2, 0xe1, 0x47, 0xe0, 0x96, 0xf2, 0x2e, 0x3d, 0x23, 0xf3, 0x86, 0xe2, 0xbc, 0x5f, 0xe2,
+	0xdf, 0x53, 0xa9, 0xbb, 0x4f, 0xe9, 0xb9, 0xde, 0xe9, 0x12, 0x4f, 0x16, 0x25, 0x01, 0x74, 0xc0,
+	0x4a, 0xce, 0xd5, 0x8c, 0xf8, 0x6b, 0x8a, 0xf8, 0x1b, 0x57, 0x3a, 0x3b, 0x4a, 0xbf, 0xec, 0x0e,
+	0x8b, 0x94, 0x91, 0xf7, 0xc1, 0x5c, 0x17, 0x79, 0x28, 0x70, 0x30, 0x37, 0x67, 0x15, 0x71, 0xa5,
+	0x80, 0x78, 0x3f, 0x56, 0xd3, 0x64, 0x29, 0x0a, 0x3e, 0x01, 0xf3, 0x21, 0xe5, 0x44, 0x10, 0x1a,
+	0x70, 0xf3, 0xa6, 0xa2, 0x78, 0x74, 0xa5, 0x6f, 0xa7, 0x1a, 0xa1, 0xd9, 0x32, 0x06, 0xe8, 0x82,
+	0xbb, 0x3c, 0xea, 0x22, 0xc7, 0xa1, 0x51, 0x20, 0x6c, 0xc1, 0x90, 0x8b, 0xed, 0x80, 0x2a, 0xff,
+	0xe6, 0x14, 0xf9, 0xc3, 0xa2, 0x13, 0x4d, 0x51, 0x1f, 0xd2, 0xcc, 0xcf, 0xd5, 0x8c, 0xac, 0x23,
+	0xb9, 0x94, 0x8c, 0xc3, 0xdf, 0x1a, 0xa0, 0x86, 0x9f, 0x87, 0x84, 0x5d, 0xda, 0xbd, 0x48, 0x44,
+	0x0c, 0x73, 0x9d, 0x0b, 0x36, 0x09, 0x7a, 0xd4, 0xe6, 0x02, 0x09, 0x6c, 0xce, 0x2b, 0x7b, 0xef,
+	0x14, 0xd8, 0x3b, 0x52, 0xf0, 0x76, 0x8c, 0x8e, 0xd3, 0xe0, 0x38, 0xe8, 0x51, 0x95, 0xe9, 0xda,
+	0xf8, 0x3d, 0x5c, 0xa2, 0x03, 0x5d, 0xb0, 0x1a, 0x62, 0x16, 0x62, 0x11, 0x21, 0x2f, 0x6f, 0xdd,
+	0x04, 0xa5, 0x01, 0x3e, 0x4d, 0x30, 0x19, 0x5f, 0x12, 0xe0, 0x70, 0x5c, 0x04, 0x7f, 0x0d, 0x2a,
+	0x63, 0x56, 0x7a, 0x51, 0xe0, 0x92, 0xa0, 0xaf, 0xb7, 0xb9, 0xa0, 0xcc, 0xed, 0x5e, 0xcf, 0x5c,
+	0x3b, 0x86, 0xe6, 0x77, 0xb9, 0x19, 0x16, 0xab, 0xc0, 0xcf, 0x0c, 0xf0, 0x70, 0xac, 0xe0, 0x6c,
+	0x8e, 0x85, 0xf0, 0xb0, 0x8f, 0x03, 0x61, 0x73, 0x67, 0x80, 0xdd, 0xc8, 0xc3, 0xae, 0xb9, 0xa8,
+	0xfc, 0xf8, 0xee, 0x35, 0x8b, 0xf0, 0x2c, 0xa5, 0xc8, 0x9d, 0xc0, 0x8e, 0x5b, 0xa8, 0x75, 0x96,
+	0xd8, 0x81, 0xdf, 0x07, 0x26, 0xe1, 0xb6, 0xaa, 0xd6, 0xc4, 0x80, 0x8d, 0x03, 0xd4, 0x95, 0x3e,
+	0x2c, 0xd5, 0x8c, 0xc6, 0x9c, 0xb5, 0x4a, 0xb8, 0xac, 0xcf, 0x23, 0x2d, 0x3d, 0x8a, 0x85, 0xf0,
+	0x08, 0x54, 0x09, 0xb7, 0x33, 0x13, 0x7c, 0x1c, 0x7f, 0x4b, 0xe1, 0xef, 0x11, 0x9e, 0xb9, 0xcb,
+	0x47, 0x69, 0x9e, 0x81, 0x7b, 0x32, 0xad, 0x65, 0x00, 0x18, 0xfe, 0x15, 0x62, 0xae, 0xed, 0x20,
+	0x3f, 0x44, 0xa4, 0x1f, 0xc4, 0xe1, 0xbf, 0xad, 0xee, 0xc6, 0x6f, 0x17, 0x9c, 0x43, 0x27, 0x86,
+	0x5a, 0x0a, 0x79, 0xa0, 0x81, 0xf2, 0x08, 0xac, 0x75, 0x51, 0x24, 0x82, 0x2f, 0xc1, 0x83, 0x11,
+	0x93, 0x21, 0xa5, 0x5e, 0x66, 0x37, 0x09, 0x82, 0xf9, 0x56, 0x69, 0xfd, 0x26, 0x9c, 0xb1, 0x85,
+	0x53, 0x4a, 0x3d, 0x6b, 0x7b, 0xc8, 0xa8, 0x5c, 0x4a, 0x94, 0x92, 0x03, 0x87, 0x7f, 0x36, 0xc0,
+	0xc3, 0xa2, 0x0d, 0x27, 0x75, 0x1e, 0x52, 0x12, 0x08, 0x6e, 0xde, 0x51, 0xe6, 0xdf, 0x7d, 0x93,
+	0xad, 0xef, 0xc5, 0x0c, 0xa7, 0x8a, 0xc0, 0xaa, 0x8b, 0x2b, 0x75, 0xe0, 0x2f, 0xc0, 0x6a, 0x0f,
+	0x63, 0xdb, 0x25, 0x3c, 0xb6, 0x9d, 0x6e, 0x1e, 0xd6, 0x8c, 0x92, 0xba, 0x6b, 0x63, 0x7c, 0xa8,
+	0x21, 0xc9, 0xd6, 0xac, 0xe5, 0xde, 0xf8, 0x22, 0x64, 0x60, 0x6b, 0x88, 0x3f, 0xbd, 0xcb, 0x08,
+	0x66, 0xb6, 0x10, 0x9e, 0xb9, 0x5c, 0x9b, 0x29, 0x09, 0x70, 0xce, 0x8e, 0xf6, 0xbb, 0x43, 0x30,
+	0xeb, 0x74, 0x4e, 0xac, 0xf5, 0xde, 0x64, 0x91, 0xf0, 0xe0, 0xef, 0x0d, 0xb0, 0x33, 0x64, 0xb4,
+	0x1b, 0x39, 0xb2, 0xd0, 0x2e, 0xa8, 0x17, 0xf9, 0x38, 0x71, 0x81, 0x9b, 0x2b, 0xca, 0xf4, 0xf7,
+	0xae, 0x36, 0xbd, 0xaf, 0xf0, 0x1f, 0x2b, 0xb8, 0xb6, 0xc5, 0xad, 0x6a, 0xaf, 0x5c, 0x01, 0xfe,
+	0x10, 0x6c, 0x12, 0x6e, 0xf7, 0x08, 0xe3, 0xc2, 0x96, 0xee, 0x38, 0x97, 0x8e, 0x87, 0xed, 0x1e,
+	0x09, 0x08, 0x1f, 0x60, 0xd7, 0x5c, 0x55, 0xd5, 0x71, 0x97, 0xf0, 0xb6, 0xd4, 0x68, 0x63, 0x7c,
+	0x20, 0xe5, 0x6d, 0x2d, 0x86, 0x7f, 0x32, 0xc0, 0xdb, 0x21, 0x8e, 0xaf, 0xa6, 0xeb, 0xa5, 0xeb,
+	0xda, 0x9b, 0xa6, 0x6b, 0x43, 0xf3, 0x77, 0xae, 0xcc, 0xda, 0xbf, 0x1a, 0xa0, 0x59, 0xe0, 0x4c,
+	0x51, 0xf6, 0xde, 0x55, 0xde, 0xbc, 0xff, 0xbf, 0x64, 0x6f, 0x6c, 0x48, 0x27, 0xf1, 0xa3, 0x49,
+	0x4e, 0x4e, 0xce, 0xe5, 0x77, 0xc1, 0x7a, 0xec, 0x14, 0xb7, 0x69, 0x28, 0x6c, 0x1a, 0x09, 0x1b,
+	0xb9, 0x2e, 0xc3, 0x9c, 0x63, 0x6e, 0x9a, 0xb5, 0x99, 0xc6, 0xbc, 0xb5, 0xa6, 0x15, 0x9e, 0x86,
+	0xe2, 0x69, 0x24, 0xf6, 0x12, 0x29, 0xfc, 0x04, 0x98, 0x03, 0xc2, 0x05, 0x65, 0xc4, 0x41, 0x9e,
+	0x6e, 0xb4, 0x0c, 0x3b, 0x94, 0xb9, 0xdc, 0x5c, 0x57, 0x3b, 0xd9, 0x29, 0xd9, 0x09, 0xb6, 0x62,
+	0x55, 0x6b, 0x2d, 0x23, 0xc9, 0xaf, 0xc3, 0x4f, 0xc1, 0x5a, 0x97, 0x04, 0x88, 0x5d, 0x4a, 0xc7,
+	0x64, 0x67, 0x4f, 0x87, 0xad, 0x8d, 0xd2, 0xf6, 0xb6, 0xaf, 0x40, 0x4f, 0x63, 0x8c, 0x9e, 0xb7,
+	0x56, 0xba, 0xe3, 0x8b, 0x1c, 0x0e, 0xc0, 0xee, 0x44, 0x0b, 0x36, 0x71, 0x79, 0xd6, 0x56, 0xec,
+	0x1e, 0x65, 0xb9, 0x7e, 0x63, 0x6e, 0xaa, 0x43, 0xf9, 0xd6, 0x04, 0xc6, 0x63, 0x97, 0xa7, 0x4d,
+	0xa2, 0x4d, 0x59, 0xd6, 0x3a, 0x60, 0x07, 0x34, 0x72, 0xa3, 0xe7, 0x08, 0xbf, 0xa0, 0xd2, 0x84,
+	0x83, 0x6d, 0xc7, 0xa3, 0x1c, 0x9b, 0xf7, 0x14, 0x7f, 0x3d, 0x9b, 0x39, 0xf3, 0xb4, 0x1d, 0xda,
+	0x96, 0xaa, 0x07, 0x52, 0x13, 0xfe, 0xce, 0x00, 0x0d, 0x14, 0x39, 0xd2, 0x83, 0xac, 0x91, 0x08,
+	0x86, 0x02, 0xde, 0xc3, 0xcc, 0x76, 0x71, 0x40, 0x7d, 0xdb, 0xc5, 0x0e, 0xf1, 0x91, 0xc7, 0xcd,
+	0xad, 0xd2, 0x69, 0xf2, 0x50, 0x2a, 0x1f, 0x6a, 0x5d, 0xdd, 0x0b, 0xef, 0x6b, 0xee, 0xa4, 0xfd,
+	0x74, 0x34, 0xf3, 0x90, 0xae, 0x1c, 0x84, 0xb6, 0x1d, 0x1a, 0xb8, 0x6a, 0xfa, 0x42, 0x9e, 0x3d,
+	0x69, 0xe2, 0xe4, 0x66, 0xa5, 0xb4, 0x35, 0x1f, 0x64, 0xf8, 0x09, 0xd3, 0xa7, 0x55, 0x75, 0x0a,
+	0xe5, 0x8a, 0x5d, 0xa6, 0x4a, 0x32, 0x98, 0x60, 0x6c, 0xfb, 0x91, 0x27, 0x48, 0xe8, 0x11, 0xcc,
+	0xb8, 0x59, 0x2d, 0x4d, 0x15, 0x3d, 0x6e, 0x60, 0xfc, 0x24, 0x85, 0x58, 0x2b, 0xfe, 0xf8, 0x22,
+	0x87, 0x3f, 0x03, 0xcb, 0xe9, 0x6e, 0x6c, 0x8e, 0x9f, 0x45, 0x58, 0x0d, 0x94, 0x35, 0x45, 0xdf,
+	0x28, 0xa0, 0x4f, 0x3d, 0x3c, 0xd3, 0x00, 0x0b, 0xd2, 0xd1, 0x25, 0x0e, 0x31, 0x80, 0xb9, 0x79,
+	0x35, 0xbe, 0x6f, 0xb9, 0xb9, 0x5d, 0x7a, 0xcf, 0xee, 0xf5, 0xfb, 0x0c, 0xf7, 0x91, 0xc0, 0xd9,
+	0xcc, 0x1a, 0x5f, 0xa4, 0x71, 0xf1, 0x58, 0x77, 0xf8, 0xc8, 0x3a, 0x87, 0x3f, 0x06, 0xb7, 0xf4,
+	0x19, 0x25, 0x26, 0xea, 0xa5, 0x35, 0x1a, 0x9f, 0x8d, 0x66, 0x5d, 0xf2, 0x73, 0x5f, 0x1c, 0x22,
+	0xb0, 0xd2, 0x67, 0x48, 0x76, 0xa6, 0x48, 0x0c, 0x28, 0x23, 0x2f, 0x50, 0x3c, 0xbc, 0xef, 0x28,
+	0xc6, 0x66, 0x51, 0x73, 0x88, 0x3c, 0xef, 0x03, 0x09, 0xdb, 0x1b, 0x42, 0x59, 0xcb, 0xfd, 0xf1,
+	0x45, 0xf8, 0x18, 0x2c, 0x21, 0x45, 0x61, 0x2b, 0x29, 0x37, 0xef, 0x97, 0xce, 0xee, 0x92, 0x7b,
+	0x4f, 0x2d, 0x2b, 0x0b, 0xd6, 0x22, 0xca, 0x3e, 0x38, 0xfc, 0x29, 0x58, 0x8e, 0xab, 0xc1, 0x27,
+	0x81, 0x1d, 0xd0, 0x38, 0x93, 0xb8, 0xf9, 0xe0, 0x8a, 0x3f, 0x6d, 0x01, 0xf5, 0x9f, 0x90, 0xe0,
+	0x43, 0xad, 0x2f, 0xff, 0xb4, 0x0d, 0xaf, 0x70, 0xf8, 0x1b, 0x50, 0xc9, 0xe5, 0xbb, 0x47, 0x9e,
+	0x45, 0xc4, 0x55, 0x1b, 0xb0, 0x7b, 0x0c, 0xe3, 0x17, 0x98, 0x9b, 0x0f, 0x4b, 0x67, 0xe3, 0x2c,
+	0x9b, 0x4f, 0x32, 0x6c, 0x5b, 0x41, 0x93, 0x7f, 0x00, 0x6e, 0xb1, 0x0a, 0xaf, 0x9f, 0x80, 0x3b,
+	0x63, 0x49, 0x06, 0x37, 0xc0, 0x5c, 0x92, 0xa1, 0xea, 0x6f, 0xf2, 0x0d, 0x2b, 0xfd, 0x86, 0x9b,
+	0x60, 0x3e, 0xbd, 0x83, 0xcc, 0xe9, 0x9a, 0xd1, 0x98, 0xb7, 0xe6, 0x7c, 0x7d, 0xcb, 0xd4, 0x3f,
+	0x01, 0x9b, 0x25, 0x0e, 0x0d, 0x63, 0x8d, 0x61, 0x2c, 0xdc, 0x06, 0x8b, 0x3d, 0x46, 0x5f, 0xe0,
+	0xc0, 0x8e, 0x02, 0x41, 0x3c, 0xc5, 0x3d, 0x63, 0x2d, 0xc4, 0x6b, 0x3f, 0x91, 0x4b, 0xf5, 0x97,
+	0x60, 0xbd, 0x70, 0x34, 0x81, 0x26, 0xb8, 0xa9, 0x13, 0x56, 0x53, 0x27, 0x9f, 0xf0, 0x10, 0xcc,
+	0xa5, 0x83, 0xcf, 0x74, 0xcd, 0x28, 0x69, 0xd7, 0x39, 0xf6, 0x64, 0xe2, 0xb9, 0x29, 0xe2, 0xf9,
+	0xa6, 0xfe, 0x37, 0x03, 0x54, 0xaf, 0x98, 0x4e, 0xe0, 0x77, 0xc0, 0x9a, 0x9e, 0x7a, 0xb8, 0x40,
+	0x4c, 0xce, 0x5b, 0x3e, 0xe6, 0x02, 0xf9, 0xa1, 0x72, 0x69, 0xc6, 0x5a, 0x89, 0xa5, 0x67, 0x52,
+	0xd8, 0x49, 0x64, 0xf0, 0x31, 0xb8, 0x35, 0x5c, 0xbc, 0xe6, 0x74, 0xe9, 0x55, 0xbb, 0x37, 0x54,
+	0xaf, 0x4b, 0x43, 0x65, 0x5a, 0xef, 0x81, 0xa5, 0x21, 0x79, 0xc9, 0xb9, 0xbc, 0x07, 0x66, 0x53,
+	0x7b, 0x46, 0x63, 0x7e, 0x7f, 0x47, 0xe6, 0xcb, 0xbf, 0xbe, 0xaa, 0x6e, 0x3a, 0x94, 0xfb, 0x94,
+	0x73, 0xf7, 0xbc, 0x49, 0x68, 0xcb, 0x47, 0x62, 0xd0, 0x3c, 0xc1, 0x7d, 0xe4, 0x5c, 0x1e, 0x62,
+	0xc7, 0xd2, 0x90, 0xfa, 0x4b, 0x50, 0xbf, 0xc6, 0x70, 0x50, 0x6a, 0x5c, 0xcf, 0x2c, 0x6f, 0x62,
+	0x3c, 0x86, 0xd4, 0xff, 0x61, 0x80, 0x47, 0xd7, 0x1e, 0x66, 0xe0, 0x8f, 0xc0, 0x66, 0x7e, 0x86,
+	0x9b, 0x1c, 0x1a, 0x93, 0xa5, 0x83, 0xd8, 0x48, 0x78, 0x3e, 0xcd, 0xc2, 0x93, 0x7a, 0xfc, 0x7f,
+	0xfe, 0x47, 0x58, 0x42, 0xf9, 0xcf, 0xfa, 0xdf, 0x0d, 0x70, 0x7b, 0xe4, 0xed, 0x00, 0xee, 0x80,
+	0xa5, 0xdc, 0xa5, 0x9e, 0xd6, 0xcb, 0x62, 0xb6, 0x78, 0xec, 0xc2, 0x3e, 0x58, 0x9b, 0xfc, 0x52,
+	0xa1, 0xf3, 0xfc, 0x9b, 0x57, 0x3e, 0x54, 0x64, 0x2f, 0x12, 0xfa, 0xba, 0x58, 0x99, 0xf4, 0x5a,
+	0xf1, 0x83, 0xb9, 0x3f, 0x7e, 0x5e, 0x9d, 0xfa, 0xcf, 0xe7, 0xd5, 0xa9, 0xfa, 0x1f, 0xa6, 0xc1,
+	0xdd, 0x82, 0x7b, 0x58, 0x46, 0x5b, 0xdd, 0xb5, 0x98, 0x25, 0xd1, 0xd6, 0x9f, 0xf0, 0x31, 0x80,
+	0x82, 0x0a, 0xe4, 0xd9, 0xfa, 0xd6, 0xf7, 0x55, 0x4a, 0xc4, 0x91, 0xdf, 0xd2, 0x91, 0x5f, 0x1d,
+	0x8f, 0xfc, 0x71, 0x20, 0xac, 0xb7, 0x14, 0x30, 0x36, 0xa7, 0x60, 0x70, 0x0f, 0x6c, 0x79, 0x88,
+	0x0b, 0xdb, 0xc5, 0x1e, 0xee, 0xc7, 0xa6, 0x6d, 0x67, 0x80, 0x9d, 0x73, 0x39, 0x0a, 0x11, 0x1f,
+	0x9b, 0x33, 0x2a, 0xa2, 0x1b, 0x52, 0xe9, 0x30, 0xd3, 0x39, 0x88, 0x55, 0x64, 0x60, 0xe1, 0x1e,
+	0x98, 0xd5, 0x5d, 0xe1, 0x46, 0xe9, 0xfc, 0x3e, 0xbe, 0x4b, 0x4b, 0x03, 0xeb, 0x0c, 0xdc, 0x1e,
+	0xe9, 0x19, 0xd9, 0xfe, 0xf1, 0xf0, 0xfe, 0x31, 0x3c, 0x02, 0x8b, 0xf9, 0x66, 0xa4, 0xc3, 0x53,
+	0x2f, 0x2c, 0xf0, 0xac, 0x0f, 0x2d, 0xe4, 0xfa, 0xd0, 0xfe, 0xf9, 0x17, 0xaf, 0x2a, 0xc6, 0x97,
+	0xaf, 0x2a, 0xc6, 0xbf, 0x5f, 0x55, 0x8c, 0xcf, 0x5e, 0x57, 0xa6, 0xbe, 0x7c, 0x5d, 0x99, 0xfa,
+	0xe7, 0xeb, 0xca, 0xd4, 0xcf, 0x3f, 0xea, 0x13, 0x31, 0x88, 0xba, 0x4d, 0x87, 0xfa, 0xad, 0xe3,
+	0x84, 0xf4, 0x04, 0x75, 0x79, 0x2b, 0x35, 0xf1, 0xb6, 0x43, 0x19, 0xce, 0x7f, 0x0e, 0x10, 0x09,
+	0x5a, 0x3e, 0x95, 0x63, 0x21, 0xcf, 0x9e, 0x4f, 0xc5, 0x65, 0x88, 0x79, 0xeb, 0x62, 0xb7, 0x3b,
+	0xab, 0x9e, 0x50, 0xdf, 0xf9, 0xef, 0x00, 0xab, 0x4f, 0x05, 0xd2, 0x27, 0x16, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -1009,6 +1077,22 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.DerivativeLiquidationFreezes) > 0 {
+		for iNdEx := len(m.DerivativeLiquidationFreezes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.DerivativeLiquidationFreezes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2
+			i--
+			dAtA[i] = 0xb2
+		}
+	}
 	if len(m.DenomMinNotionals) > 0 {
 		for iNdEx := len(m.DenomMinNotionals) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1574,6 +1658,41 @@ func (m *OrderbookSequence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *DerivativeLiquidationFreeze) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *DerivativeLiquidationFreeze) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *DerivativeLiquidationFreeze) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.FrozenUntil != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.FrozenUntil))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintGenesis(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *FeeDiscountAccountTierTTL) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2143,6 +2262,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.DerivativeLiquidationFreezes) > 0 {
+		for _, e := range m.DerivativeLiquidationFreezes {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -2162,6 +2287,22 @@ func (m *OrderbookSequence) Size() (n int) {
 	return n
 }
 
+func (m *DerivativeLiquidationFreeze) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if m.FrozenUntil != 0 {
+		n += 1 + sovGenesis(uint64(m.FrozenUntil))
+	}
+	return n
+}
+
 func (m *FeeDiscountAccountTierTTL) Size() (n int) {
 	if m == nil {
 		return 0
@@ -3549,6 +3690,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 38:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DerivativeLiquidationFreezes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DerivativeLiquidationFreezes = append(m.DerivativeLiquidationFreezes, DerivativeLiquidationFreeze{})
+			if err := m.DerivativeLiquidationFreezes[len(m.DerivativeLiquidationFreezes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3671,6 +3846,107 @@ func (m *OrderbookSequence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *DerivativeLiquidationFreeze) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowGenesis
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: DerivativeLiquidationFreeze: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: DerivativeLiquidationFreeze: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenUntil", wireType)
+			}
+			m.FrozenUntil = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FrozenUntil |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipGenesis(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *FeeDiscountAccountTierTTL) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -13,4 +13,6 @@ const (
 	FlagDeposit        = "deposit"
 	FlagShareToken     = "share-token"
 	FlagAmount         = "amount"
+	FlagLookback       = "lookback"
+	FlagRedemptionId   = "redemption-id"
 )
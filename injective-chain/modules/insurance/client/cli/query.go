@@ -6,6 +6,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
 
 	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
@@ -25,6 +26,8 @@ func GetQueryCmd() *cobra.Command {
 		GetInsuranceParamsCmd(),
 		GetEstimatedRedemptionsCmd(),
 		GetPendingRedemptionsCmd(),
+		GetSharePriceHistoryCmd(),
+		GetInsuranceFundApyCmd(),
 	)
 	return cmd
 }
@@ -115,3 +118,75 @@ func GetPendingRedemptionsCmd() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetSharePriceHistoryCmd queries the share price history of an insurance fund
+func GetSharePriceHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "share-price-history [marketId]",
+		Short: "Get the share price history of an insurance fund.",
+		Long:  "Get the share price history of an insurance fund. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			req := &types.QuerySharePriceHistoryRequest{
+				MarketId:   args[0],
+				Pagination: pageReq,
+			}
+			res, err := queryClient.SharePriceHistory(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "share-price-history")
+	return cmd
+}
+
+// GetInsuranceFundApyCmd queries the estimated APY of an insurance fund
+func GetInsuranceFundApyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "insurance-fund-apy [marketId]",
+		Short: "Get the estimated APY of an insurance fund.",
+		Long:  "Get the estimated APY of an insurance fund, annualized from its share price history over the lookback window (30 days by default). If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			lookback, err := cmd.Flags().GetDuration(FlagLookback)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryInsuranceFundApyRequest{
+				MarketId:        args[0],
+				LookbackSeconds: int64(lookback.Seconds()),
+			}
+			res, err := queryClient.InsuranceFundApy(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().Duration(FlagLookback, 0, "lookback window of the estimate (e.g. 720h), defaults to 30 days")
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -25,6 +25,8 @@ func NewTxCmd() *cobra.Command {
 		NewCreateInsuranceFundTxCmd(),
 		NewUnderwriteInsuranceFundTxCmd(),
 		NewRequestRedemptionTxCmd(),
+		NewCancelRedemptionTxCmd(),
+		NewEarlyRedemptionTxCmd(),
 	)
 	return txCmd
 }
@@ -256,3 +258,108 @@ func NewRequestRedemptionTxCmd() *cobra.Command {
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func NewCancelRedemptionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "cancel-redemption [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Create and broadcast a message to cancel a pending redemption",
+		Long: `Create and broadcast a message to cancel a pending redemption. The locked share tokens are returned to the sender.
+
+		Example:
+		$ %s tx insurance cancel-redemption
+			--market-id="0x000001"
+			--redemption-id=1
+			--from=genesis --keyring-backend=file --yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			marketId, err := cmd.Flags().GetString(FlagMarketId)
+			if err != nil {
+				return err
+			}
+
+			redemptionId, err := cmd.Flags().GetUint64(FlagRedemptionId)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgCancelRedemption{
+				Sender:       from.String(),
+				MarketId:     marketId,
+				RedemptionId: redemptionId,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMarketId, "", "marketId of the insurance fund.")
+	cmd.Flags().Uint64(FlagRedemptionId, 0, "id of the pending redemption to cancel.")
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func NewEarlyRedemptionTxCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "early-redemption [flags]",
+		Args:  cobra.ExactArgs(0),
+		Short: "Create and broadcast a message to withdraw a pending redemption early",
+		Long: `Create and broadcast a message to withdraw a pending redemption before its claimable time.
+		The insurance fund's early redemption penalty is deducted from the redeemed amount.
+
+		Example:
+		$ %s tx insurance early-redemption
+			--market-id="0x000001"
+			--redemption-id=1
+			--from=genesis --keyring-backend=file --yes
+		`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			from := clientCtx.GetFromAddress()
+
+			marketId, err := cmd.Flags().GetString(FlagMarketId)
+			if err != nil {
+				return err
+			}
+
+			redemptionId, err := cmd.Flags().GetUint64(FlagRedemptionId)
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgEarlyRedemption{
+				Sender:       from.String(),
+				MarketId:     marketId,
+				RedemptionId: redemptionId,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().String(FlagMarketId, "", "marketId of the insurance fund.")
+	cmd.Flags().Uint64(FlagRedemptionId, 0, "id of the pending redemption to withdraw early.")
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -16,6 +16,9 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 	for _, schedule := range data.RedemptionSchedule {
 		k.SetRedemptionSchedule(ctx, schedule)
 	}
+	for i := range data.SharePriceSnapshots {
+		k.SetSharePriceSnapshot(ctx, &data.SharePriceSnapshots[i])
+	}
 	k.SetNextShareDenomId(ctx, data.NextShareDenomId)
 	k.SetNextRedemptionScheduleId(ctx, data.NextRedemptionScheduleId)
 
@@ -30,5 +33,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		RedemptionSchedule:       k.GetAllInsuranceFundRedemptions(ctx),
 		NextShareDenomId:         k.ExportNextShareDenomId(ctx),
 		NextRedemptionScheduleId: k.ExportNextRedemptionScheduleId(ctx),
+		SharePriceSnapshots:      k.GetAllSharePriceSnapshots(ctx),
 	}
 }
@@ -2,10 +2,14 @@ package keeper
 
 import (
 	"context"
+	"time"
 
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
 	"github.com/ethereum/go-ethereum/common"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
 	"github.com/InjectiveLabs/metrics"
@@ -110,8 +114,53 @@ func (k *Keeper) InsuranceModuleState(c context.Context, req *types.QueryModuleS
 			RedemptionSchedule:       k.GetAllInsuranceFundRedemptions(ctx),
 			NextShareDenomId:         k.ExportNextShareDenomId(ctx),
 			NextRedemptionScheduleId: k.ExportNextRedemptionScheduleId(ctx),
+			SharePriceSnapshots:      k.GetAllSharePriceSnapshots(ctx),
 		},
 	}
 
 	return res, nil
 }
+
+// SharePriceHistory is grpc implementation to return the paginated share price history of an insurance fund
+func (k *Keeper) SharePriceHistory(c context.Context, req *types.QuerySharePriceHistoryRequest) (*types.QuerySharePriceHistoryResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	marketID := common.HexToHash(req.MarketId)
+
+	snapshotStore := prefix.NewStore(k.GetStore(ctx), types.GetSharePriceSnapshotPrefix(marketID))
+	snapshots := make([]types.SharePriceSnapshot, 0)
+
+	pageRes, err := query.Paginate(snapshotStore, req.Pagination, func(_, value []byte) error {
+		var snapshot types.SharePriceSnapshot
+		if err := k.cdc.Unmarshal(value, &snapshot); err != nil {
+			return err
+		}
+
+		snapshots = append(snapshots, snapshot)
+		return nil
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(err, "can't paginate request")
+	}
+
+	res := &types.QuerySharePriceHistoryResponse{
+		Snapshots:  snapshots,
+		Pagination: pageRes,
+	}
+
+	return res, nil
+}
+
+// InsuranceFundApy is grpc implementation to return an annualized yield estimate of an insurance fund
+func (k *Keeper) InsuranceFundApy(c context.Context, req *types.QueryInsuranceFundApyRequest) (*types.QueryInsuranceFundApyResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	lookback := time.Duration(req.LookbackSeconds) * time.Second
+
+	return k.EstimateInsuranceFundApy(ctx, common.HexToHash(req.MarketId), lookback)
+}
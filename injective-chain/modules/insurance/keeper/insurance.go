@@ -97,6 +97,7 @@ func (k *Keeper) SetRedemptionSchedule(ctx sdk.Context, schedule types.Redemptio
 
 	key := schedule.GetRedemptionScheduleKey()
 	store.Set(key, bz)
+	store.Set(schedule.GetRedemptionScheduleByRedeemerKey(), key)
 }
 
 func (k *Keeper) deleteRedemptionSchedule(ctx sdk.Context, schedule types.RedemptionSchedule) {
@@ -106,6 +107,7 @@ func (k *Keeper) deleteRedemptionSchedule(ctx sdk.Context, schedule types.Redemp
 	store := ctx.KVStore(k.storeKey)
 	key := schedule.GetRedemptionScheduleKey()
 	store.Delete(key)
+	store.Delete(schedule.GetRedemptionScheduleByRedeemerKey())
 }
 
 func (k *Keeper) globalRedemptionIterator(ctx sdk.Context) db.Iterator {
@@ -248,6 +250,7 @@ func (k *Keeper) DepositIntoInsuranceFund(ctx sdk.Context, marketID common.Hash,
 
 	fund.Balance = fund.Balance.Add(amount)
 	k.SetInsuranceFund(ctx, fund)
+	k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_LiquidationDeposit, amount)
 	return nil
 }
 
@@ -268,6 +271,7 @@ func (k *Keeper) WithdrawFromInsuranceFund(ctx sdk.Context, marketID common.Hash
 
 	fund.Balance = fund.Balance.Sub(amount)
 	k.SetInsuranceFund(ctx, fund)
+	k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_LiquidationPayout, amount)
 	coinAmount := sdk.NewCoin(fund.DepositDenom, amount)
 
 	// nolint:errcheck //ignored on purpose
@@ -368,6 +372,7 @@ func (k *Keeper) CreateInsuranceFund(
 	}
 
 	k.SetInsuranceFund(ctx, fund)
+	k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_Creation, deposit.Amount)
 
 	// set metadata for share denom
 	shareDisplayDenom := fmt.Sprintf("INSURANCE-%s", marketID.String())
@@ -439,6 +444,8 @@ func (k *Keeper) UnderwriteInsuranceFund(ctx sdk.Context, underwriter sdk.AccAdd
 	}
 
 	k.SetInsuranceFund(ctx, fund)
+	k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_Underwrite, deposit.Amount)
+
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventUnderwrite{
 		Underwriter: underwriter.String(),
@@ -570,6 +577,8 @@ func (k *Keeper) RequestInsuranceFundRedemption(ctx sdk.Context, sender sdk.AccA
 	}
 
 	k.SetRedemptionSchedule(ctx, *schedule)
+	k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_RedemptionRequest, shares.Amount)
+
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventRequestRedemption{Schedule: schedule})
 
@@ -678,6 +687,7 @@ func (k *Keeper) withdrawRedemption(ctx sdk.Context, schedule *types.RedemptionS
 	fund.Balance = fund.Balance.Sub(redeemCoin.Amount)
 
 	k.SetInsuranceFund(ctx, fund)
+	k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_RedemptionWithdrawal, redeemCoin.Amount)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventWithdrawRedemption{
@@ -687,6 +697,142 @@ func (k *Keeper) withdrawRedemption(ctx sdk.Context, schedule *types.RedemptionS
 	return nil
 }
 
+// getOwnRedemptionSchedule returns the pending redemption schedule with the given id if it belongs to the
+// redeemer and insurance fund
+func (k *Keeper) getOwnRedemptionSchedule(
+	ctx sdk.Context,
+	redeemer sdk.AccAddress,
+	marketID common.Hash,
+	redemptionID uint64,
+) (*types.RedemptionSchedule, error) {
+	store := ctx.KVStore(k.storeKey)
+	if key := store.Get(types.GetRedemptionScheduleByRedeemerKey(redeemer, marketID, redemptionID)); key != nil {
+		if schedule := k.unmarshalRedemptionSchedule(store.Get(key)); schedule != nil {
+			return schedule, nil
+		}
+	}
+
+	return nil, errors.Wrapf(types.ErrRedemptionNotFound, "redemption %d of %s for insurance fund %s", redemptionID, redeemer, marketID.Hex())
+}
+
+// CancelInsuranceFundRedemption cancels a pending redemption and returns the locked share tokens to the redeemer
+func (k *Keeper) CancelInsuranceFundRedemption(ctx sdk.Context, sender sdk.AccAddress, marketID common.Hash, redemptionID uint64) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	schedule, err := k.getOwnRedemptionSchedule(ctx, sender, marketID, redemptionID)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	k.deleteRedemptionSchedule(ctx, *schedule)
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{schedule.RedemptionAmount}); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	if fund := k.GetInsuranceFund(ctx, marketID); fund != nil {
+		k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_RedemptionCancellation, schedule.RedemptionAmount.Amount)
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventCancelRedemption{Schedule: schedule})
+	return nil
+}
+
+// EarlyInsuranceFundRedemption withdraws a pending redemption before its claimable time. The fund's early redemption
+// penalty is deducted from the redeemed amount and stays in the fund, accruing to the remaining underwriters.
+func (k *Keeper) EarlyInsuranceFundRedemption(
+	ctx sdk.Context,
+	sender sdk.AccAddress,
+	marketID common.Hash,
+	redemptionID uint64,
+) (redeemCoin, penalty sdk.Coin, err error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	fund := k.GetInsuranceFund(ctx, marketID)
+	if fund == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return redeemCoin, penalty, errors.Wrapf(types.ErrInsuranceFundNotFound, "insurance fund %s not found", marketID.Hex())
+	}
+
+	if fund.EarlyRedemptionPenaltyRate == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return redeemCoin, penalty, errors.Wrap(types.ErrEarlyRedemptionDisabled, marketID.Hex())
+	}
+
+	schedule, err := k.getOwnRedemptionSchedule(ctx, sender, marketID, redemptionID)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return redeemCoin, penalty, err
+	}
+
+	// shares of a refreshed fund are worthless and get burned by the regular withdrawal
+	if fund.ShareDenom() != schedule.RedemptionAmount.Denom {
+		metrics.ReportFuncError(k.svcTags)
+		return redeemCoin, penalty, errors.Wrapf(types.ErrInvalidShareDenom, "insurance fund share denom %s doesnt match redemption share denom %s", fund.ShareDenom(), schedule.RedemptionAmount.Denom)
+	}
+
+	k.deleteRedemptionSchedule(ctx, *schedule)
+
+	shareAmount := schedule.RedemptionAmount.Amount
+	redemptionValue := k.getRedemptionAmountFromShare(ctx, marketID, *fund, shareAmount)
+
+	penalty = sdk.NewCoin(fund.DepositDenom, redemptionValue.Amount.ToLegacyDec().Mul(*fund.EarlyRedemptionPenaltyRate).Ceil().TruncateInt())
+	if penalty.Amount.GT(redemptionValue.Amount) {
+		penalty.Amount = redemptionValue.Amount
+	}
+	redeemCoin = redemptionValue.Sub(penalty)
+
+	if redeemCoin.Amount.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sender, sdk.Coins{redeemCoin}); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return redeemCoin, penalty, err
+		}
+	}
+
+	fund, err = k.BurnShareTokens(ctx, fund, shareAmount)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return redeemCoin, penalty, err
+	}
+
+	// the penalty stays in the fund balance while the redeemed shares are burned
+	fund.Balance = fund.Balance.Sub(redeemCoin.Amount)
+
+	k.SetInsuranceFund(ctx, fund)
+	k.recordSharePriceSnapshot(ctx, fund, types.SharePriceChangeCause_EarlyRedemption, redeemCoin.Amount)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventEarlyRedemption{
+		Schedule:   schedule,
+		RedeemCoin: redeemCoin,
+		Penalty:    penalty,
+	})
+
+	return redeemCoin, penalty, nil
+}
+
+// SetEarlyRedemptionPenalty enables early redemptions of an insurance fund with the given penalty rate,
+// or disables them if the rate is nil
+func (k *Keeper) SetEarlyRedemptionPenalty(ctx sdk.Context, marketID common.Hash, penaltyRate *math.LegacyDec) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	fund := k.GetInsuranceFund(ctx, marketID)
+	if fund == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrap(types.ErrInsuranceFundNotFound, marketID.Hex())
+	}
+
+	fund.EarlyRedemptionPenaltyRate = penaltyRate
+	k.SetInsuranceFund(ctx, fund)
+	return nil
+}
+
 // UpdateInsuranceFundOracleParams updates the insurance fund's oracle parameters
 func (k *Keeper) UpdateInsuranceFundOracleParams(
 	ctx sdk.Context,
@@ -122,3 +122,74 @@ func (k msgServer) RequestRedemption(goCtx context.Context, msg *types.MsgReques
 
 	return &types.MsgRequestRedemptionResponse{}, nil
 }
+
+// CancelRedemption is wrapper of keeper.CancelInsuranceFundRedemption
+func (k msgServer) CancelRedemption(goCtx context.Context, msg *types.MsgCancelRedemption) (*types.MsgCancelRedemptionResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	marketID := common.HexToHash(msg.MarketId)
+	if err := k.Keeper.CancelInsuranceFundRedemption(ctx, sender, marketID, msg.RedemptionId); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("cancelling redemption for insurance fund failed", err)
+		return nil, err
+	}
+
+	return &types.MsgCancelRedemptionResponse{}, nil
+}
+
+// EarlyRedemption is wrapper of keeper.EarlyInsuranceFundRedemption
+func (k msgServer) EarlyRedemption(goCtx context.Context, msg *types.MsgEarlyRedemption) (*types.MsgEarlyRedemptionResponse, error) {
+	goCtx, doneFn := metrics.ReportFuncCallAndTimingCtx(goCtx, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	marketID := common.HexToHash(msg.MarketId)
+	redeemCoin, penalty, err := k.Keeper.EarlyInsuranceFundRedemption(ctx, sender, marketID, msg.RedemptionId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		k.Logger(ctx).Error("early redemption for insurance fund failed", err)
+		return nil, err
+	}
+
+	return &types.MsgEarlyRedemptionResponse{
+		RedeemCoin: redeemCoin,
+		Penalty:    penalty,
+	}, nil
+}
+
+func (k msgServer) SetEarlyRedemptionPenalty(
+	c context.Context, msg *types.MsgSetEarlyRedemptionPenalty,
+) (*types.MsgSetEarlyRedemptionPenaltyResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	if msg.Authority != k.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", k.authority, msg.Authority)
+	}
+
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	if err := k.Keeper.SetEarlyRedemptionPenalty(sdk.UnwrapSDKContext(c), common.HexToHash(msg.MarketId), msg.PenaltyRate); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetEarlyRedemptionPenaltyResponse{}, nil
+}
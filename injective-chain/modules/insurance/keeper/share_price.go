@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"time"
+
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/metrics"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
+)
+
+const (
+	// DefaultApyLookbackPeriod is the default window used to estimate insurance fund yields
+	DefaultApyLookbackPeriod = 30 * 24 * time.Hour
+
+	secondsPerYear = int64(365 * 24 * 60 * 60)
+)
+
+// SetSharePriceSnapshot stores a share price snapshot of an insurance fund
+func (k *Keeper) SetSharePriceSnapshot(ctx sdk.Context, snapshot *types.SharePriceSnapshot) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	key := types.GetSharePriceSnapshotKey(common.HexToHash(snapshot.MarketId), snapshot.Id)
+	bz := k.cdc.MustMarshal(snapshot)
+	k.GetStore(ctx).Set(key, bz)
+}
+
+// GetLatestSharePriceSnapshot returns the most recent share price snapshot of an insurance fund
+func (k *Keeper) GetLatestSharePriceSnapshot(ctx sdk.Context, marketID common.Hash) *types.SharePriceSnapshot {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	snapshotStore := prefix.NewStore(k.GetStore(ctx), types.GetSharePriceSnapshotPrefix(marketID))
+	iterator := snapshotStore.ReverseIterator(nil, nil)
+	defer iterator.Close()
+
+	if !iterator.Valid() {
+		return nil
+	}
+
+	var snapshot types.SharePriceSnapshot
+	k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+
+	return &snapshot
+}
+
+// IterateSharePriceSnapshots iterates over the share price snapshots of an insurance fund from oldest to newest
+func (k *Keeper) IterateSharePriceSnapshots(
+	ctx sdk.Context,
+	marketID common.Hash,
+	process func(*types.SharePriceSnapshot) (stop bool),
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	snapshotStore := prefix.NewStore(k.GetStore(ctx), types.GetSharePriceSnapshotPrefix(marketID))
+	iterator := snapshotStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.SharePriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		if process(&snapshot) {
+			return
+		}
+	}
+}
+
+// GetAllSharePriceSnapshots is used to export the share price history of all insurance funds
+func (k *Keeper) GetAllSharePriceSnapshots(ctx sdk.Context) []types.SharePriceSnapshot {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	snapshots := make([]types.SharePriceSnapshot, 0)
+	iterator := storetypes.KVStorePrefixIterator(k.GetStore(ctx), types.SharePriceSnapshotPrefixKey)
+	defer iterator.Close()
+
+	for ; iterator.Valid(); iterator.Next() {
+		var snapshot types.SharePriceSnapshot
+		k.cdc.MustUnmarshal(iterator.Value(), &snapshot)
+		snapshots = append(snapshots, snapshot)
+	}
+
+	return snapshots
+}
+
+// recordSharePriceSnapshot stores the share price of the fund after a change caused by the given action
+func (k *Keeper) recordSharePriceSnapshot(
+	ctx sdk.Context,
+	fund *types.InsuranceFund,
+	cause types.SharePriceChangeCause,
+	amount math.Int,
+) {
+	marketID := common.HexToHash(fund.MarketId)
+
+	nextID := uint64(1)
+	if latest := k.GetLatestSharePriceSnapshot(ctx, marketID); latest != nil {
+		nextID = latest.Id + 1
+	}
+
+	k.SetSharePriceSnapshot(ctx, &types.SharePriceSnapshot{
+		MarketId:    fund.MarketId,
+		Id:          nextID,
+		BlockHeight: ctx.BlockHeight(),
+		Timestamp:   ctx.BlockTime().Unix(),
+		ShareDenom:  fund.ShareDenom(),
+		Balance:     fund.Balance,
+		TotalShare:  fund.TotalShare,
+		SharePrice:  fund.SharePrice(),
+		Cause:       cause,
+		Amount:      amount,
+	})
+}
+
+// EstimateInsuranceFundApy annualizes the share price return of an insurance fund over the lookback period.
+// Only snapshots of the current share denom are taken into account, since the share price restarts whenever
+// the fund is refreshed with a new share denom.
+func (k *Keeper) EstimateInsuranceFundApy(
+	ctx sdk.Context,
+	marketID common.Hash,
+	lookback time.Duration,
+) (*types.QueryInsuranceFundApyResponse, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	fund := k.GetInsuranceFund(ctx, marketID)
+	if fund == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, types.ErrInsuranceFundNotFound
+	}
+
+	if lookback <= 0 {
+		lookback = DefaultApyLookbackPeriod
+	}
+
+	endTimestamp := ctx.BlockTime().Unix()
+	windowStart := endTimestamp - int64(lookback.Seconds())
+
+	var start *types.SharePriceSnapshot
+	k.IterateSharePriceSnapshots(ctx, marketID, func(snapshot *types.SharePriceSnapshot) (stop bool) {
+		if snapshot.ShareDenom != fund.ShareDenom() {
+			return false
+		}
+
+		// the latest snapshot before the window holds the share price at the start of the window
+		if snapshot.Timestamp <= windowStart || start == nil {
+			start = snapshot
+		}
+
+		return snapshot.Timestamp > windowStart
+	})
+
+	res := &types.QueryInsuranceFundApyResponse{
+		Apy:             math.LegacyZeroDec(),
+		StartSharePrice: fund.SharePrice(),
+		EndSharePrice:   fund.SharePrice(),
+		StartTimestamp:  endTimestamp,
+		EndTimestamp:    endTimestamp,
+	}
+
+	if start == nil {
+		return res, nil
+	}
+
+	res.StartSharePrice = start.SharePrice
+	res.StartTimestamp = max(start.Timestamp, windowStart)
+
+	elapsed := res.EndTimestamp - res.StartTimestamp
+	if elapsed <= 0 || !res.StartSharePrice.IsPositive() {
+		return res, nil
+	}
+
+	res.Apy = res.EndSharePrice.Quo(res.StartSharePrice).Sub(math.LegacyOneDec()).
+		MulInt64(secondsPerYear).
+		QuoInt64(elapsed)
+
+	return res, nil
+}
@@ -0,0 +1,32 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/insurance/types"
+)
+
+// Migrate indexes the pending redemptions by redeemer, as introduced in v3
+func Migrate(
+	_ sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	iterator := storetypes.KVStorePrefixIterator(store, types.RedemptionSchedulePrefixKey)
+	defer iterator.Close()
+
+	schedules := make([]types.RedemptionSchedule, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.RedemptionSchedule
+		cdc.MustUnmarshal(iterator.Value(), &schedule)
+		schedules = append(schedules, schedule)
+	}
+
+	for _, schedule := range schedules {
+		store.Set(schedule.GetRedemptionScheduleByRedeemerKey(), schedule.GetRedemptionScheduleKey())
+	}
+
+	return nil
+}
@@ -38,7 +38,7 @@ var (
 	_ appmodule.HasEndBlocker = AppModule{}
 )
 
-const ConsensusVersion = 2
+const ConsensusVersion = 3
 
 // app module Basics object
 type AppModuleBasic struct{}
@@ -140,6 +140,9 @@ func (am AppModule) RegisterServices(cfg module.Configurator) {
 	if err := cfg.RegisterMigration(types.ModuleName, 1, migrator.Migrate1to2); err != nil {
 		panic(fmt.Sprintf("failed to migrate insurance from version 1 to 2: %v", err))
 	}
+	if err := cfg.RegisterMigration(types.ModuleName, 2, migrator.Migrate2to3); err != nil {
+		panic(fmt.Sprintf("failed to migrate insurance from version 2 to 3: %v", err))
+	}
 }
 
 func (am AppModule) EndBlock(ctx context.Context) error {
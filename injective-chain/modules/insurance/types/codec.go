@@ -15,6 +15,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateInsuranceFund{}, "insurance/MsgCreateInsuranceFund", nil)
 	cdc.RegisterConcrete(&MsgUnderwrite{}, "insurance/MsgUnderwrite", nil)
 	cdc.RegisterConcrete(&MsgRequestRedemption{}, "insurance/MsgRequestRedemption", nil)
+	cdc.RegisterConcrete(&MsgCancelRedemption{}, "insurance/MsgCancelRedemption", nil)
+	cdc.RegisterConcrete(&MsgEarlyRedemption{}, "insurance/MsgEarlyRedemption", nil)
+	cdc.RegisterConcrete(&MsgSetEarlyRedemptionPenalty{}, "insurance/MsgSetEarlyRedemptionPenalty", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "insurance/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&Params{}, "insurance/Params", nil)
 
@@ -25,6 +28,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateInsuranceFund{},
 		&MsgUnderwrite{},
 		&MsgRequestRedemption{},
+		&MsgCancelRedemption{},
+		&MsgEarlyRedemption{},
+		&MsgSetEarlyRedemptionPenalty{},
 		&MsgUpdateParams{},
 	)
 
@@ -17,4 +17,7 @@ var (
 	ErrInvalidExpirationTime      = errors.Register(ModuleName, 10, "invalid expiration time")
 	ErrInvalidMarketID            = errors.Register(ModuleName, 11, "invalid marketID")
 	ErrInvalidShareDenom          = errors.Register(ModuleName, 12, "invalid share denom")
+	ErrRedemptionNotFound         = errors.Register(ModuleName, 13, "redemption not found")
+	ErrEarlyRedemptionDisabled    = errors.Register(ModuleName, 14, "early redemption is disabled for insurance fund")
+	ErrInvalidPenaltyRate         = errors.Register(ModuleName, 15, "invalid early redemption penalty rate")
 )
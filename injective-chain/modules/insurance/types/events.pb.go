@@ -238,6 +238,114 @@ func (m *EventUnderwrite) GetShares() types.Coin {
 	return types.Coin{}
 }
 
+type EventCancelRedemption struct {
+	// redemption schedule that was cancelled
+	Schedule *RedemptionSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+}
+
+func (m *EventCancelRedemption) Reset()         { *m = EventCancelRedemption{} }
+func (m *EventCancelRedemption) String() string { return proto.CompactTextString(m) }
+func (*EventCancelRedemption) ProtoMessage()    {}
+func (*EventCancelRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_238c43c591e30770, []int{4}
+}
+func (m *EventCancelRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventCancelRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventCancelRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventCancelRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventCancelRedemption.Merge(m, src)
+}
+func (m *EventCancelRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventCancelRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventCancelRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventCancelRedemption proto.InternalMessageInfo
+
+func (m *EventCancelRedemption) GetSchedule() *RedemptionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+type EventEarlyRedemption struct {
+	// redemption schedule withdrawn before its claimable time
+	Schedule *RedemptionSchedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule,omitempty"`
+	// redeem coin amount paid out after the penalty
+	RedeemCoin types.Coin `protobuf:"bytes,2,opt,name=redeem_coin,json=redeemCoin,proto3" json:"redeem_coin"`
+	// penalty amount kept by the insurance fund
+	Penalty types.Coin `protobuf:"bytes,3,opt,name=penalty,proto3" json:"penalty"`
+}
+
+func (m *EventEarlyRedemption) Reset()         { *m = EventEarlyRedemption{} }
+func (m *EventEarlyRedemption) String() string { return proto.CompactTextString(m) }
+func (*EventEarlyRedemption) ProtoMessage()    {}
+func (*EventEarlyRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_238c43c591e30770, []int{5}
+}
+func (m *EventEarlyRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventEarlyRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventEarlyRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventEarlyRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventEarlyRedemption.Merge(m, src)
+}
+func (m *EventEarlyRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventEarlyRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventEarlyRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventEarlyRedemption proto.InternalMessageInfo
+
+func (m *EventEarlyRedemption) GetSchedule() *RedemptionSchedule {
+	if m != nil {
+		return m.Schedule
+	}
+	return nil
+}
+
+func (m *EventEarlyRedemption) GetRedeemCoin() types.Coin {
+	if m != nil {
+		return m.RedeemCoin
+	}
+	return types.Coin{}
+}
+
+func (m *EventEarlyRedemption) GetPenalty() types.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return types.Coin{}
+}
+
 type EventInsuranceWithdraw struct {
 	MarketId     string     `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
 	MarketTicker string     `protobuf:"bytes,2,opt,name=market_ticker,json=marketTicker,proto3" json:"market_ticker,omitempty"`
@@ -248,7 +356,7 @@ func (m *EventInsuranceWithdraw) Reset()         { *m = EventInsuranceWithdraw{}
 func (m *EventInsuranceWithdraw) String() string { return proto.CompactTextString(m) }
 func (*EventInsuranceWithdraw) ProtoMessage()    {}
 func (*EventInsuranceWithdraw) Descriptor() ([]byte, []int) {
-	return fileDescriptor_238c43c591e30770, []int{4}
+	return fileDescriptor_238c43c591e30770, []int{6}
 }
 func (m *EventInsuranceWithdraw) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -303,6 +411,8 @@ func init() {
 	proto.RegisterType((*EventRequestRedemption)(nil), "injective.insurance.v1beta1.EventRequestRedemption")
 	proto.RegisterType((*EventWithdrawRedemption)(nil), "injective.insurance.v1beta1.EventWithdrawRedemption")
 	proto.RegisterType((*EventUnderwrite)(nil), "injective.insurance.v1beta1.EventUnderwrite")
+	proto.RegisterType((*EventCancelRedemption)(nil), "injective.insurance.v1beta1.EventCancelRedemption")
+	proto.RegisterType((*EventEarlyRedemption)(nil), "injective.insurance.v1beta1.EventEarlyRedemption")
 	proto.RegisterType((*EventInsuranceWithdraw)(nil), "injective.insurance.v1beta1.EventInsuranceWithdraw")
 }
 
@@ -311,37 +421,39 @@ func init() {
 }
 
 var fileDescriptor_238c43c591e30770 = []byte{
-	// 471 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x53, 0x41, 0x8b, 0xd4, 0x4c,
-	0x10, 0x9d, 0x7c, 0xdf, 0xb0, 0xee, 0xd4, 0x28, 0x42, 0x23, 0x1a, 0x67, 0x21, 0x2e, 0xf1, 0xb2,
-	0x28, 0xa6, 0x59, 0x3d, 0x88, 0x17, 0x95, 0x15, 0x85, 0x41, 0x41, 0x88, 0x2e, 0xc2, 0x5e, 0x96,
-	0x4e, 0xba, 0x9c, 0xb4, 0xbb, 0xe9, 0x8e, 0xdd, 0x9d, 0x19, 0xfc, 0x17, 0xde, 0xfd, 0x03, 0xfe,
-	0x0f, 0x2f, 0x7b, 0xdc, 0xa3, 0x27, 0x91, 0x99, 0x3f, 0x22, 0xe9, 0xf4, 0x64, 0xc6, 0xcb, 0x30,
-	0x17, 0x6f, 0xa9, 0xca, 0x7b, 0xaf, 0x5e, 0xf5, 0xeb, 0x86, 0x03, 0x21, 0x3f, 0x61, 0x6e, 0xc5,
-	0x14, 0xa9, 0x90, 0xa6, 0xd6, 0x4c, 0xe6, 0x48, 0xa7, 0x87, 0x19, 0x5a, 0x76, 0x48, 0x71, 0x8a,
-	0xd2, 0x9a, 0xa4, 0xd2, 0xca, 0x2a, 0xb2, 0xd7, 0x21, 0x93, 0x0e, 0x99, 0x78, 0xe4, 0xe8, 0xc6,
-	0x44, 0x4d, 0x94, 0xc3, 0xd1, 0xe6, 0xab, 0xa5, 0x8c, 0xa2, 0x5c, 0x99, 0x52, 0x19, 0x9a, 0x31,
-	0xb3, 0x12, 0xcd, 0x95, 0x90, 0xfe, 0xff, 0xfd, 0x4d, 0xc3, 0x57, 0x43, 0x1c, 0x38, 0x3e, 0x81,
-	0xf0, 0x65, 0xe3, 0x67, 0xbc, 0xec, 0xbf, 0xaa, 0x25, 0x3f, 0xae, 0x38, 0xb3, 0x48, 0x9e, 0x42,
-	0xff, 0x63, 0x2d, 0x79, 0x18, 0xec, 0x07, 0x07, 0xc3, 0x87, 0xf7, 0x92, 0x0d, 0x56, 0x93, 0xbf,
-	0xf8, 0xa9, 0xe3, 0xc5, 0x08, 0x37, 0x9d, 0x76, 0x8a, 0x9f, 0x6b, 0x34, 0x36, 0x45, 0x8e, 0x65,
-	0x65, 0x85, 0x92, 0xe4, 0x35, 0xec, 0x9a, 0xbc, 0x40, 0x5e, 0x9f, 0xa3, 0x57, 0xa7, 0x1b, 0xd5,
-	0x57, 0xd4, 0x77, 0x9e, 0x96, 0x76, 0x02, 0xf1, 0xf7, 0x00, 0x6e, 0xb9, 0x39, 0x1f, 0x84, 0x2d,
-	0xb8, 0x66, 0xb3, 0x7f, 0x34, 0x88, 0x3c, 0x87, 0xa1, 0x46, 0x8e, 0x58, 0x9e, 0x36, 0xa7, 0x1d,
-	0xfe, 0xe7, 0xf4, 0x6e, 0x27, 0x6d, 0x1c, 0x49, 0x13, 0x47, 0xa7, 0xf3, 0x42, 0x09, 0x79, 0xd4,
-	0xbf, 0xf8, 0x75, 0xa7, 0x97, 0x42, 0xcb, 0x69, 0x3a, 0xf1, 0x8f, 0x00, 0xae, 0x3b, 0xab, 0xc7,
-	0x92, 0xa3, 0x9e, 0x69, 0x61, 0x91, 0xec, 0xc3, 0xb0, 0xee, 0x2a, 0xed, 0x5c, 0x0e, 0xd2, 0xf5,
-	0x16, 0x19, 0xc1, 0x6e, 0xc9, 0xf4, 0x19, 0xda, 0x31, 0x77, 0x43, 0x07, 0x69, 0x57, 0x93, 0x27,
-	0x70, 0x85, 0x63, 0xa5, 0x8c, 0xb0, 0xe1, 0xff, 0xdb, 0xf9, 0x59, 0xe2, 0xc9, 0x63, 0xd8, 0x31,
-	0x05, 0xd3, 0x68, 0xc2, 0xfe, 0x76, 0x4c, 0x0f, 0x8f, 0xbf, 0x05, 0x3e, 0xd8, 0x2e, 0xf4, 0xe5,
-	0xc9, 0x93, 0x3d, 0x18, 0xb4, 0xd6, 0x4e, 0x05, 0xf7, 0xab, 0xac, 0xbc, 0xde, 0x85, 0x6b, 0xfe,
-	0xa7, 0x15, 0xf9, 0x19, 0x6a, 0xbf, 0xcc, 0xd5, 0xb6, 0xf9, 0xde, 0xf5, 0xc8, 0x33, 0x80, 0x99,
-	0x57, 0x63, 0xe7, 0xdb, 0xee, 0xb4, 0x46, 0x39, 0x12, 0x17, 0xf3, 0x28, 0xb8, 0x9c, 0x47, 0xc1,
-	0xef, 0x79, 0x14, 0x7c, 0x5d, 0x44, 0xbd, 0xcb, 0x45, 0xd4, 0xfb, 0xb9, 0x88, 0x7a, 0x27, 0x6f,
-	0x27, 0xc2, 0x16, 0x75, 0x96, 0xe4, 0xaa, 0xa4, 0xe3, 0xe5, 0x25, 0x78, 0xc3, 0x32, 0x43, 0xbb,
-	0x2b, 0xf1, 0x20, 0x57, 0x1a, 0xd7, 0xcb, 0x82, 0x09, 0x49, 0x4b, 0xd5, 0xe4, 0x6f, 0xd6, 0x9e,
-	0x93, 0xfd, 0x52, 0xa1, 0xc9, 0x76, 0xdc, 0x1b, 0x7a, 0xf4, 0x27, 0x00, 0x00, 0xff, 0xff, 0xdf,
-	0x1c, 0x0d, 0x6e, 0xef, 0x03, 0x00, 0x00,
+	// 505 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x54, 0x41, 0x6f, 0xd3, 0x30,
+	0x18, 0xad, 0x61, 0x1a, 0xab, 0x0b, 0x42, 0xb2, 0x06, 0x84, 0x4e, 0x0a, 0x53, 0xb8, 0x4c, 0x20,
+	0x12, 0x0d, 0x0e, 0x88, 0x0b, 0xa0, 0x4d, 0x43, 0xaa, 0x40, 0x42, 0x0a, 0x4c, 0x48, 0xbb, 0x4c,
+	0x4e, 0xfc, 0xd1, 0x98, 0x25, 0x76, 0xb0, 0x9d, 0x56, 0xfd, 0x17, 0xdc, 0xf9, 0x03, 0xfc, 0x0f,
+	0x2e, 0x3b, 0xee, 0xc8, 0x09, 0x4d, 0xed, 0x1f, 0x41, 0x71, 0xdc, 0xb4, 0x70, 0xa8, 0x7a, 0x99,
+	0xc4, 0x2d, 0xfe, 0xfc, 0xde, 0xfb, 0x5e, 0xbe, 0x67, 0x1b, 0xef, 0x71, 0xf1, 0x05, 0x52, 0xc3,
+	0x47, 0x10, 0x71, 0xa1, 0x2b, 0x45, 0x45, 0x0a, 0xd1, 0x68, 0x3f, 0x01, 0x43, 0xf7, 0x23, 0x18,
+	0x81, 0x30, 0x3a, 0x2c, 0x95, 0x34, 0x92, 0xec, 0xb4, 0xc8, 0xb0, 0x45, 0x86, 0x0e, 0xd9, 0xdf,
+	0x1e, 0xca, 0xa1, 0xb4, 0xb8, 0xa8, 0xfe, 0x6a, 0x28, 0x7d, 0x3f, 0x95, 0xba, 0x90, 0x3a, 0x4a,
+	0xa8, 0x5e, 0x88, 0xa6, 0x92, 0x0b, 0xb7, 0xff, 0x78, 0x55, 0xf3, 0x45, 0x13, 0x0b, 0x0e, 0x4e,
+	0xb0, 0x77, 0x54, 0xfb, 0x19, 0xcc, 0xeb, 0x6f, 0x2a, 0xc1, 0x8e, 0x4b, 0x46, 0x0d, 0x90, 0x97,
+	0x78, 0xe3, 0x73, 0x25, 0x98, 0x87, 0x76, 0xd1, 0x5e, 0xef, 0xe9, 0xa3, 0x70, 0x85, 0xd5, 0xf0,
+	0x2f, 0x7e, 0x6c, 0x79, 0x01, 0xe0, 0xbb, 0x56, 0x3b, 0x86, 0xaf, 0x15, 0x68, 0x13, 0x03, 0x83,
+	0xa2, 0x34, 0x5c, 0x0a, 0xf2, 0x16, 0x6f, 0xe9, 0x34, 0x03, 0x56, 0xe5, 0xe0, 0xd4, 0xa3, 0x95,
+	0xea, 0x0b, 0xea, 0x07, 0x47, 0x8b, 0x5b, 0x81, 0xe0, 0x07, 0xc2, 0xf7, 0x6c, 0x9f, 0x4f, 0xdc,
+	0x64, 0x4c, 0xd1, 0xf1, 0x15, 0x35, 0x22, 0xaf, 0x71, 0x4f, 0x01, 0x03, 0x28, 0x4e, 0xeb, 0x69,
+	0x7b, 0xd7, 0xac, 0xde, 0xfd, 0xb0, 0x89, 0x23, 0xac, 0xe3, 0x68, 0x75, 0x0e, 0x25, 0x17, 0x07,
+	0x1b, 0xe7, 0xbf, 0x1f, 0x74, 0x62, 0xdc, 0x70, 0xea, 0x4a, 0xf0, 0x13, 0xe1, 0xdb, 0xd6, 0xea,
+	0xb1, 0x60, 0xa0, 0xc6, 0x8a, 0x1b, 0x20, 0xbb, 0xb8, 0x57, 0xb5, 0x2b, 0x65, 0x5d, 0x76, 0xe3,
+	0xe5, 0x12, 0xe9, 0xe3, 0xad, 0x82, 0xaa, 0x33, 0x30, 0x03, 0x66, 0x9b, 0x76, 0xe3, 0x76, 0x4d,
+	0x5e, 0xe0, 0x1b, 0x0c, 0x4a, 0xa9, 0xb9, 0xf1, 0xae, 0xaf, 0xe7, 0x67, 0x8e, 0x27, 0xcf, 0xf1,
+	0xa6, 0xce, 0xa8, 0x02, 0xed, 0x6d, 0xac, 0xc7, 0x74, 0xf0, 0x80, 0xe1, 0x3b, 0xf6, 0x27, 0x0e,
+	0xeb, 0xd1, 0xe5, 0x57, 0x15, 0xeb, 0x25, 0xc2, 0xdb, 0xb6, 0xcd, 0x11, 0x55, 0xf9, 0xe4, 0xbf,
+	0xcd, 0xb4, 0x4e, 0xa0, 0x04, 0x41, 0x73, 0x33, 0x59, 0x3b, 0x01, 0x87, 0x0f, 0xbe, 0x23, 0x77,
+	0x43, 0xda, 0xdb, 0x33, 0x3f, 0xc2, 0x64, 0x07, 0x77, 0x9b, 0x8c, 0x4f, 0x39, 0xf3, 0xd0, 0x3f,
+	0xa1, 0x3f, 0xc4, 0xb7, 0xdc, 0xa6, 0xe1, 0xe9, 0x19, 0x28, 0x77, 0x2a, 0x6e, 0x36, 0xc5, 0x8f,
+	0xb6, 0x46, 0x5e, 0x61, 0x3c, 0x76, 0x6a, 0x34, 0x5f, 0xd7, 0xda, 0x12, 0xe5, 0x80, 0x9f, 0x4f,
+	0x7d, 0x74, 0x31, 0xf5, 0xd1, 0xe5, 0xd4, 0x47, 0xdf, 0x66, 0x7e, 0xe7, 0x62, 0xe6, 0x77, 0x7e,
+	0xcd, 0xfc, 0xce, 0xc9, 0xfb, 0x21, 0x37, 0x59, 0x95, 0x84, 0xa9, 0x2c, 0xa2, 0xc1, 0x7c, 0xf2,
+	0xef, 0x68, 0xa2, 0xa3, 0x36, 0x87, 0x27, 0xa9, 0x54, 0xb0, 0xbc, 0xcc, 0x28, 0x17, 0x51, 0x21,
+	0xeb, 0xa1, 0xeb, 0xa5, 0x77, 0xc9, 0x4c, 0x4a, 0xd0, 0xc9, 0xa6, 0x7d, 0x8c, 0x9e, 0xfd, 0x19,
+	0x00, 0xdb, 0xe8, 0x8c, 0x3b, 0x38, 0x05, 0x00, 0x00,
 }
 
 func (m *EventInsuranceFundUpdate) Marshal() (dAtA []byte, err error) {
@@ -516,6 +628,96 @@ func (m *EventUnderwrite) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventCancelRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventCancelRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventCancelRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventEarlyRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventEarlyRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventEarlyRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size, err := m.RedeemCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Schedule != nil {
+		{
+			size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventInsuranceWithdraw) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -636,6 +838,36 @@ func (m *EventUnderwrite) Size() (n int) {
 	return n
 }
 
+func (m *EventCancelRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventEarlyRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Schedule != nil {
+		l = m.Schedule.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.RedeemCoin.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
 func (m *EventInsuranceWithdraw) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1132,6 +1364,244 @@ func (m *EventUnderwrite) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventCancelRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventCancelRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventCancelRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &RedemptionSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventEarlyRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventEarlyRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventEarlyRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Schedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Schedule == nil {
+				m.Schedule = &RedemptionSchedule{}
+			}
+			if err := m.Schedule.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *EventInsuranceWithdraw) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -51,6 +51,15 @@ func (fund *InsuranceFund) SubTotalShare(shares math.Int) {
 	fund.TotalShare = fund.TotalShare.Sub(shares)
 }
 
+// SharePrice returns the amount of deposit tokens backing a single share token.
+func (fund InsuranceFund) SharePrice() math.LegacyDec {
+	if fund.TotalShare.IsNil() || !fund.TotalShare.IsPositive() || fund.Balance.IsNil() {
+		return math.LegacyZeroDec()
+	}
+
+	return fund.Balance.ToLegacyDec().Quo(fund.TotalShare.ToLegacyDec())
+}
+
 func ShareDenomFromId(id uint64) string {
 	return fmt.Sprintf("share%d", id)
 }
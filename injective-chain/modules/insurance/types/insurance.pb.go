@@ -32,6 +32,62 @@ var _ = time.Kitchen
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SharePriceChangeCause describes the action that triggered an insurance fund
+// share price snapshot
+type SharePriceChangeCause int32
+
+const (
+	SharePriceChangeCause_Unspecified SharePriceChangeCause = 0
+	// fund creation with the initial deposit
+	SharePriceChangeCause_Creation SharePriceChangeCause = 1
+	// underwriter deposit
+	SharePriceChangeCause_Underwrite SharePriceChangeCause = 2
+	// redemption request locking shares
+	SharePriceChangeCause_RedemptionRequest SharePriceChangeCause = 3
+	// matured redemption paid out to the redeemer
+	SharePriceChangeCause_RedemptionWithdrawal SharePriceChangeCause = 4
+	// liquidation surplus or settlement deposited into the fund
+	SharePriceChangeCause_LiquidationDeposit SharePriceChangeCause = 5
+	// liquidation loss covered by the fund
+	SharePriceChangeCause_LiquidationPayout SharePriceChangeCause = 6
+	// pending redemption cancelled, returning the locked shares
+	SharePriceChangeCause_RedemptionCancellation SharePriceChangeCause = 7
+	// pending redemption withdrawn before its claimable time with a penalty
+	SharePriceChangeCause_EarlyRedemption SharePriceChangeCause = 8
+)
+
+var SharePriceChangeCause_name = map[int32]string{
+	0: "Unspecified",
+	1: "Creation",
+	2: "Underwrite",
+	3: "RedemptionRequest",
+	4: "RedemptionWithdrawal",
+	5: "LiquidationDeposit",
+	6: "LiquidationPayout",
+	7: "RedemptionCancellation",
+	8: "EarlyRedemption",
+}
+
+var SharePriceChangeCause_value = map[string]int32{
+	"Unspecified":            0,
+	"Creation":               1,
+	"Underwrite":             2,
+	"RedemptionRequest":      3,
+	"RedemptionWithdrawal":   4,
+	"LiquidationDeposit":     5,
+	"LiquidationPayout":      6,
+	"RedemptionCancellation": 7,
+	"EarlyRedemption":        8,
+}
+
+func (x SharePriceChangeCause) String() string {
+	return proto.EnumName(SharePriceChangeCause_name, int32(x))
+}
+
+func (SharePriceChangeCause) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_dbc47a7b76393948, []int{0}
+}
+
 type Params struct {
 	// default_redemption_notice_period_duration defines the default minimum
 	// notice period duration that must pass after an underwriter sends a
@@ -107,6 +163,10 @@ type InsuranceFund struct {
 	// Expiration time of the derivative market. Should be -1 for perpetual or -2
 	// for binary options markets.
 	Expiry int64 `protobuf:"varint,11,opt,name=expiry,proto3" json:"expiry,omitempty"`
+	// early_redemption_penalty_rate is the share of the redeemed amount kept by
+	// the fund when a pending redemption is withdrawn before its claimable time.
+	// Early redemptions are disabled for the fund if not set.
+	EarlyRedemptionPenaltyRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=early_redemption_penalty_rate,json=earlyRedemptionPenaltyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"early_redemption_penalty_rate,omitempty"`
 }
 
 func (m *InsuranceFund) Reset()         { *m = InsuranceFund{} }
@@ -286,10 +346,111 @@ func (m *RedemptionSchedule) GetRedemptionAmount() types1.Coin {
 	return types1.Coin{}
 }
 
+type SharePriceSnapshot struct {
+	// marketId of the insurance fund
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// id of the snapshot, incremented per fund
+	Id uint64 `protobuf:"varint,2,opt,name=id,proto3" json:"id,omitempty"`
+	// block height at which the snapshot was taken
+	BlockHeight int64 `protobuf:"varint,3,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block time in unix seconds at which the snapshot was taken
+	Timestamp int64 `protobuf:"varint,4,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	// share denom of the fund at the time of the snapshot
+	ShareDenom string `protobuf:"bytes,5,opt,name=share_denom,json=shareDenom,proto3" json:"share_denom,omitempty"`
+	// balance of the fund after the change
+	Balance cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=balance,proto3,customtype=cosmossdk.io/math.Int" json:"balance"`
+	// total share tokens after the change
+	TotalShare cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=total_share,json=totalShare,proto3,customtype=cosmossdk.io/math.Int" json:"total_share"`
+	// deposit tokens per share token after the change
+	SharePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=share_price,json=sharePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share_price"`
+	// action that triggered the snapshot
+	Cause SharePriceChangeCause `protobuf:"varint,9,opt,name=cause,proto3,enum=injective.insurance.v1beta1.SharePriceChangeCause" json:"cause,omitempty"`
+	// deposit token amount (or share amount for redemption requests) moved by
+	// the action
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *SharePriceSnapshot) Reset()         { *m = SharePriceSnapshot{} }
+func (m *SharePriceSnapshot) String() string { return proto.CompactTextString(m) }
+func (*SharePriceSnapshot) ProtoMessage()    {}
+func (*SharePriceSnapshot) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dbc47a7b76393948, []int{3}
+}
+func (m *SharePriceSnapshot) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SharePriceSnapshot) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SharePriceSnapshot.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SharePriceSnapshot) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SharePriceSnapshot.Merge(m, src)
+}
+func (m *SharePriceSnapshot) XXX_Size() int {
+	return m.Size()
+}
+func (m *SharePriceSnapshot) XXX_DiscardUnknown() {
+	xxx_messageInfo_SharePriceSnapshot.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SharePriceSnapshot proto.InternalMessageInfo
+
+func (m *SharePriceSnapshot) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *SharePriceSnapshot) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *SharePriceSnapshot) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *SharePriceSnapshot) GetTimestamp() int64 {
+	if m != nil {
+		return m.Timestamp
+	}
+	return 0
+}
+
+func (m *SharePriceSnapshot) GetShareDenom() string {
+	if m != nil {
+		return m.ShareDenom
+	}
+	return ""
+}
+
+func (m *SharePriceSnapshot) GetCause() SharePriceChangeCause {
+	if m != nil {
+		return m.Cause
+	}
+	return SharePriceChangeCause_Unspecified
+}
+
 func init() {
+	proto.RegisterEnum("injective.insurance.v1beta1.SharePriceChangeCause", SharePriceChangeCause_name, SharePriceChangeCause_value)
 	proto.RegisterType((*Params)(nil), "injective.insurance.v1beta1.Params")
 	proto.RegisterType((*InsuranceFund)(nil), "injective.insurance.v1beta1.InsuranceFund")
 	proto.RegisterType((*RedemptionSchedule)(nil), "injective.insurance.v1beta1.RedemptionSchedule")
+	proto.RegisterType((*SharePriceSnapshot)(nil), "injective.insurance.v1beta1.SharePriceSnapshot")
 }
 
 func init() {
@@ -297,55 +458,74 @@ func init() {
 }
 
 var fileDescriptor_dbc47a7b76393948 = []byte{
-	// 759 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x41, 0x6f, 0xd3, 0x48,
-	0x14, 0x8e, 0xd3, 0x6c, 0xda, 0x4c, 0xda, 0xaa, 0x1d, 0xed, 0x6e, 0xdd, 0x54, 0xeb, 0xa4, 0xd9,
-	0xad, 0x94, 0xdd, 0x05, 0x9b, 0x16, 0x24, 0xa4, 0x82, 0x90, 0x08, 0x05, 0x29, 0x52, 0x45, 0x8b,
-	0x9b, 0x13, 0x17, 0x6b, 0x6c, 0x4f, 0x93, 0x21, 0xb6, 0xc7, 0xd8, 0xe3, 0x8a, 0xdc, 0x39, 0x71,
-	0xea, 0x11, 0x71, 0xe2, 0x27, 0xd0, 0x7f, 0xd1, 0x63, 0x6f, 0x20, 0x0e, 0x05, 0xb5, 0x07, 0x38,
-	0x70, 0xe2, 0x17, 0xa0, 0x19, 0x8f, 0xed, 0x08, 0x04, 0xed, 0xc5, 0xf2, 0x7b, 0xef, 0x7b, 0x6f,
-	0xde, 0xfb, 0xde, 0x37, 0x03, 0xfe, 0x27, 0xc1, 0x13, 0xec, 0x30, 0x72, 0x80, 0x0d, 0x12, 0xc4,
-	0x49, 0x84, 0x02, 0x07, 0x1b, 0x07, 0xeb, 0x36, 0x66, 0x68, 0xbd, 0xf0, 0xe8, 0x61, 0x44, 0x19,
-	0x85, 0x2b, 0x39, 0x58, 0x2f, 0x42, 0x12, 0xdc, 0xf8, 0x7d, 0x40, 0x07, 0x54, 0xe0, 0x0c, 0xfe,
-	0x97, 0xa6, 0x34, 0xb4, 0x01, 0xa5, 0x03, 0x0f, 0x1b, 0xc2, 0xb2, 0x93, 0x7d, 0xc3, 0x4d, 0x22,
-	0xc4, 0x08, 0x0d, 0x64, 0xbc, 0xf9, 0x7d, 0x9c, 0x11, 0x1f, 0xc7, 0x0c, 0xf9, 0x61, 0x56, 0xc0,
-	0xa1, 0xb1, 0x4f, 0x63, 0xc3, 0x46, 0x71, 0xd1, 0x98, 0x43, 0x49, 0x56, 0x60, 0xad, 0x18, 0x80,
-	0x46, 0xc8, 0xf1, 0x0a, 0x50, 0x6a, 0x4a, 0xd8, 0x22, 0xf2, 0x49, 0x40, 0x0d, 0xf1, 0x4d, 0x5d,
-	0xed, 0xb7, 0x0a, 0xa8, 0xee, 0xa2, 0x08, 0xf9, 0x31, 0x3c, 0x52, 0xc0, 0xbf, 0x2e, 0xde, 0x47,
-	0x89, 0xc7, 0xac, 0x08, 0xbb, 0xd8, 0x0f, 0x79, 0x8b, 0x56, 0x40, 0x19, 0x71, 0xb0, 0x15, 0xe2,
-	0x88, 0x50, 0xd7, 0xca, 0x3a, 0x57, 0x95, 0x96, 0xd2, 0xa9, 0x6f, 0x2c, 0xeb, 0x69, 0xeb, 0x7a,
-	0xd6, 0xba, 0xbe, 0x25, 0x01, 0xdd, 0xdb, 0xc7, 0xa7, 0xcd, 0xd2, 0xd7, 0xd3, 0xe6, 0xb5, 0x31,
-	0xf2, 0xbd, 0xcd, 0xf6, 0xa5, 0x2b, 0xb7, 0x5f, 0x7e, 0x68, 0x2a, 0xe6, 0x9a, 0xc4, 0x9b, 0x39,
-	0xfc, 0xa1, 0x40, 0xef, 0x0a, 0x70, 0x76, 0xc8, 0xe6, 0xf2, 0xe7, 0xd7, 0x4d, 0xe5, 0xc5, 0xa7,
-	0x37, 0xff, 0x2d, 0x14, 0x8b, 0x4b, 0xc7, 0x69, 0x7f, 0xa9, 0x80, 0xb9, 0x5e, 0xe6, 0x7c, 0x90,
-	0x04, 0x2e, 0xfc, 0x1b, 0xcc, 0xb9, 0x38, 0xa4, 0x31, 0x61, 0x96, 0x8b, 0x03, 0xea, 0x8b, 0x19,
-	0x6a, 0xe6, 0xac, 0x74, 0x6e, 0x71, 0x1f, 0xbc, 0x05, 0x1a, 0x79, 0x29, 0x2b, 0xa4, 0xd4, 0xb3,
-	0x18, 0x1d, 0xe1, 0x40, 0x66, 0x94, 0x45, 0xc6, 0x52, 0x8e, 0xd8, 0xa5, 0xd4, 0xeb, 0xf3, 0x78,
-	0x9a, 0xfc, 0x4a, 0x01, 0xab, 0x17, 0x53, 0x37, 0x75, 0x11, 0x75, 0x37, 0x24, 0x75, 0x9d, 0x94,
-	0xba, 0x4b, 0x52, 0xa6, 0x45, 0xbf, 0xe4, 0x0a, 0xde, 0x04, 0xd3, 0x36, 0xf2, 0x78, 0xd7, 0x6a,
-	0x85, 0x8f, 0xd1, 0xfd, 0x8b, 0x1f, 0xf3, 0xfe, 0xb4, 0xf9, 0x47, 0xaa, 0xae, 0xd8, 0x1d, 0xe9,
-	0x84, 0x1a, 0x3e, 0x62, 0x43, 0xbd, 0x17, 0x30, 0x33, 0x43, 0xc3, 0x3b, 0xa0, 0xce, 0x28, 0x43,
-	0x9e, 0x15, 0x0f, 0x51, 0x84, 0xd5, 0xdf, 0x2e, 0x93, 0x0c, 0x44, 0xc6, 0x1e, 0x4f, 0x80, 0x2b,
-	0xa0, 0xe6, 0xa3, 0x68, 0x84, 0x99, 0x45, 0x5c, 0xb5, 0x2a, 0x18, 0x9c, 0x49, 0x1d, 0x3d, 0xb1,
-	0x14, 0x19, 0x64, 0xc4, 0x19, 0xe1, 0x48, 0x9d, 0x4e, 0x97, 0x92, 0x3a, 0xfb, 0xc2, 0x07, 0x9b,
-	0xa0, 0x9e, 0x0a, 0xd9, 0xe2, 0x37, 0x40, 0x9d, 0x11, 0x10, 0x90, 0xba, 0xba, 0x28, 0xc6, 0x70,
-	0x15, 0xcc, 0x4a, 0xc0, 0xd3, 0x84, 0x32, 0xac, 0xd6, 0x04, 0x42, 0x26, 0x3d, 0xe2, 0x2e, 0x78,
-	0x3f, 0xaf, 0xc1, 0xc6, 0x21, 0x56, 0x41, 0x4b, 0xe9, 0xcc, 0x6f, 0xfc, 0xa3, 0x17, 0xb7, 0x59,
-	0x5e, 0x15, 0x79, 0x73, 0xf4, 0x1d, 0x61, 0xf6, 0xc7, 0x21, 0xce, 0x4e, 0xe2, 0xff, 0xf0, 0x4f,
-	0x50, 0xc5, 0xcf, 0x42, 0x12, 0x8d, 0xd5, 0x7a, 0x4b, 0xe9, 0x4c, 0x99, 0xd2, 0x6a, 0x1f, 0x95,
-	0x01, 0x2c, 0xc4, 0xba, 0xe7, 0x0c, 0xb1, 0x9b, 0x78, 0x18, 0xce, 0x83, 0x32, 0x71, 0x85, 0xd0,
-	0x2a, 0x66, 0x99, 0xb8, 0xb0, 0x01, 0xf2, 0xd1, 0xa5, 0x98, 0x0a, 0x2a, 0x1a, 0x60, 0x86, 0xaf,
-	0x10, 0xfb, 0x38, 0x12, 0x1a, 0xa9, 0x99, 0xb9, 0x0d, 0x9f, 0x2b, 0x60, 0xd9, 0xf1, 0x10, 0xf1,
-	0x91, 0xed, 0xe1, 0xc9, 0x4b, 0xc4, 0x9f, 0x0a, 0xb1, 0xcf, 0xfa, 0x46, 0xe3, 0x07, 0x45, 0xf5,
-	0xb3, 0x77, 0xa4, 0x7b, 0x45, 0x4a, 0xaa, 0x95, 0x4a, 0xea, 0xa7, 0xa5, 0xda, 0x87, 0x5c, 0x4a,
-	0x4b, 0x79, 0xbc, 0x18, 0x89, 0xd7, 0x82, 0xdb, 0x60, 0x71, 0x22, 0x01, 0xf9, 0x34, 0x09, 0x98,
-	0x10, 0x04, 0xd7, 0x73, 0xaa, 0x04, 0x9d, 0xaf, 0x28, 0x67, 0xf1, 0x1e, 0x25, 0x41, 0xb7, 0xc2,
-	0x0f, 0x37, 0x17, 0x8a, 0xcc, 0xbb, 0x22, 0xb1, 0x4b, 0x8e, 0xcf, 0x34, 0xe5, 0xe4, 0x4c, 0x53,
-	0x3e, 0x9e, 0x69, 0xca, 0xe1, 0xb9, 0x56, 0x3a, 0x39, 0xd7, 0x4a, 0xef, 0xce, 0xb5, 0xd2, 0xe3,
-	0x9d, 0x01, 0x61, 0xc3, 0xc4, 0xd6, 0x1d, 0xea, 0x1b, 0xbd, 0x6c, 0x43, 0xdb, 0xc8, 0x8e, 0x8d,
-	0x7c, 0x5f, 0x57, 0x1d, 0x1a, 0xe1, 0x49, 0x73, 0x88, 0x48, 0x60, 0xf8, 0x94, 0xd3, 0x1e, 0x4f,
-	0xbc, 0xe3, 0x7c, 0xdb, 0xb1, 0x5d, 0x15, 0x9c, 0x5c, 0xff, 0x16, 0x00, 0x00, 0xff, 0xff, 0x4b,
-	0x5b, 0xe9, 0xd1, 0xeb, 0x05, 0x00, 0x00,
+	// 1065 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x14, 0xf6, 0xda, 0xb1, 0xe3, 0x8c, 0xd3, 0xd4, 0x19, 0x9a, 0x74, 0xe3, 0x50, 0x3b, 0x71, 0xa9,
+	0x14, 0x0a, 0xac, 0x69, 0x00, 0x21, 0x15, 0x84, 0x84, 0x93, 0xa2, 0x46, 0x8a, 0xa8, 0xd9, 0xa4,
+	0x42, 0xe2, 0xb2, 0x1a, 0xef, 0xbe, 0xd8, 0x43, 0x76, 0x77, 0x36, 0xb3, 0xb3, 0x2d, 0xbe, 0x73,
+	0xe2, 0xd4, 0x23, 0xe2, 0xc4, 0x9f, 0x40, 0xff, 0x8b, 0x1c, 0x7b, 0x40, 0x2a, 0xea, 0x21, 0xa0,
+	0xe4, 0x00, 0x67, 0xfe, 0x02, 0x34, 0x33, 0xfb, 0xc3, 0x6d, 0xa1, 0x09, 0xea, 0x25, 0xf2, 0x7c,
+	0xf3, 0xbd, 0x37, 0x6f, 0xbe, 0xf9, 0xde, 0xdb, 0xa0, 0x77, 0x68, 0xf8, 0x2d, 0xb8, 0x82, 0x3e,
+	0x80, 0x1e, 0x0d, 0xe3, 0x84, 0x93, 0xd0, 0x85, 0xde, 0x83, 0x5b, 0x43, 0x10, 0xe4, 0x56, 0x81,
+	0x58, 0x11, 0x67, 0x82, 0xe1, 0xd5, 0x9c, 0x6c, 0x15, 0x5b, 0x29, 0xb9, 0x75, 0x65, 0xc4, 0x46,
+	0x4c, 0xf1, 0x7a, 0xf2, 0x97, 0x0e, 0x69, 0xb5, 0x47, 0x8c, 0x8d, 0x7c, 0xe8, 0xa9, 0xd5, 0x30,
+	0x39, 0xe8, 0x79, 0x09, 0x27, 0x82, 0xb2, 0x30, 0xdd, 0xef, 0xbc, 0xb8, 0x2f, 0x68, 0x00, 0xb1,
+	0x20, 0x41, 0x94, 0x25, 0x70, 0x59, 0x1c, 0xb0, 0xb8, 0x37, 0x24, 0x71, 0x51, 0x98, 0xcb, 0x68,
+	0x96, 0xe0, 0x46, 0x71, 0x01, 0xc6, 0x89, 0xeb, 0x17, 0x24, 0xbd, 0x4c, 0x69, 0x8b, 0x24, 0xa0,
+	0x21, 0xeb, 0xa9, 0xbf, 0x1a, 0xea, 0x3e, 0x35, 0x50, 0x6d, 0x40, 0x38, 0x09, 0x62, 0xfc, 0xd8,
+	0x40, 0x6f, 0x7b, 0x70, 0x40, 0x12, 0x5f, 0x38, 0x1c, 0x3c, 0x08, 0x22, 0x59, 0xa2, 0x13, 0x32,
+	0x41, 0x5d, 0x70, 0x22, 0xe0, 0x94, 0x79, 0x4e, 0x56, 0xb9, 0x69, 0xac, 0x19, 0x1b, 0x8d, 0xcd,
+	0x15, 0x4b, 0x97, 0x6e, 0x65, 0xa5, 0x5b, 0xdb, 0x29, 0xa1, 0xff, 0xe9, 0xf1, 0x49, 0xa7, 0xf4,
+	0xf7, 0x49, 0xe7, 0xfd, 0x09, 0x09, 0xfc, 0xdb, 0xdd, 0x0b, 0x67, 0xee, 0xfe, 0xf8, 0x7b, 0xc7,
+	0xb0, 0x6f, 0xa4, 0x7c, 0x3b, 0xa7, 0x7f, 0xa9, 0xd8, 0x03, 0x45, 0xce, 0x0e, 0xb9, 0xbd, 0xf2,
+	0xd7, 0xcf, 0x1d, 0xe3, 0x87, 0x3f, 0x7f, 0xb9, 0xd9, 0x2c, 0x1e, 0x4e, 0x5f, 0xa7, 0xfb, 0x6b,
+	0x15, 0x5d, 0xda, 0xc9, 0xc0, 0x2f, 0x92, 0xd0, 0xc3, 0xd7, 0xd1, 0x25, 0x0f, 0x22, 0x16, 0x53,
+	0xe1, 0x78, 0x10, 0xb2, 0x40, 0xdd, 0x61, 0xce, 0x9e, 0x4f, 0xc1, 0x6d, 0x89, 0xe1, 0x4f, 0x50,
+	0x2b, 0x4f, 0xe5, 0x44, 0x8c, 0xf9, 0x8e, 0x60, 0x87, 0x10, 0xa6, 0x11, 0x65, 0x15, 0x71, 0x35,
+	0x67, 0x0c, 0x18, 0xf3, 0xf7, 0xe5, 0xbe, 0x0e, 0xfe, 0xc9, 0x40, 0xeb, 0xe7, 0x4b, 0x57, 0x39,
+	0x4f, 0xba, 0x0f, 0x53, 0xe9, 0x36, 0xb4, 0x74, 0x17, 0x94, 0xac, 0xcd, 0x5f, 0xa9, 0x15, 0xfe,
+	0x18, 0xcd, 0x0e, 0x89, 0x2f, 0xab, 0x36, 0x67, 0xe4, 0x35, 0xfa, 0xd7, 0xe4, 0x31, 0xcf, 0x4e,
+	0x3a, 0x4b, 0xda, 0x5d, 0xb1, 0x77, 0x68, 0x51, 0xd6, 0x0b, 0x88, 0x18, 0x5b, 0x3b, 0xa1, 0xb0,
+	0x33, 0x36, 0xfe, 0x0c, 0x35, 0x04, 0x13, 0xc4, 0x77, 0xe2, 0x31, 0xe1, 0x60, 0x56, 0x2f, 0x12,
+	0x8c, 0x54, 0xc4, 0x9e, 0x0c, 0xc0, 0xab, 0x68, 0x2e, 0x20, 0xfc, 0x10, 0x84, 0x43, 0x3d, 0xb3,
+	0xa6, 0x14, 0xac, 0x6b, 0x60, 0x47, 0x3d, 0x4a, 0xba, 0x29, 0xa8, 0x7b, 0x08, 0xdc, 0x9c, 0xd5,
+	0x8f, 0xa2, 0xc1, 0x7d, 0x85, 0xe1, 0x0e, 0x6a, 0x68, 0x23, 0x3b, 0xb2, 0x03, 0xcc, 0xba, 0xa2,
+	0x20, 0x0d, 0xf5, 0x49, 0x0c, 0x78, 0x1d, 0xcd, 0xa7, 0x84, 0xa3, 0x84, 0x09, 0x30, 0xe7, 0x14,
+	0x23, 0x0d, 0xfa, 0x4a, 0x42, 0xf8, 0x4e, 0x9e, 0x43, 0x4c, 0x22, 0x30, 0xd1, 0x9a, 0xb1, 0xb1,
+	0xb0, 0xf9, 0x96, 0x55, 0x74, 0x73, 0xda, 0x2a, 0x69, 0xe7, 0x58, 0xf7, 0xd4, 0x72, 0x7f, 0x12,
+	0x41, 0x76, 0x92, 0xfc, 0x8d, 0x97, 0x51, 0x0d, 0xbe, 0x8b, 0x28, 0x9f, 0x98, 0x8d, 0x35, 0x63,
+	0xa3, 0x62, 0xa7, 0x2b, 0x7c, 0x80, 0xae, 0x01, 0xe1, 0xfe, 0x64, 0xda, 0xe0, 0x11, 0x84, 0xc4,
+	0x17, 0x13, 0x87, 0x13, 0x01, 0xe6, 0xbc, 0x92, 0xed, 0xfa, 0xf1, 0x49, 0xc7, 0x78, 0x76, 0xd2,
+	0x59, 0x7d, 0x59, 0xb6, 0x5d, 0x18, 0x11, 0x77, 0xb2, 0x0d, 0xae, 0xdd, 0x52, 0x99, 0x0a, 0xeb,
+	0x0f, 0x74, 0x1e, 0x9b, 0x08, 0xe8, 0x3e, 0x2e, 0x23, 0x5c, 0xec, 0xec, 0xb9, 0x63, 0xf0, 0x12,
+	0x1f, 0xf0, 0x02, 0x2a, 0x53, 0x4f, 0x19, 0x7a, 0xc6, 0x2e, 0x53, 0x0f, 0xb7, 0x50, 0x2e, 0x71,
+	0x6a, 0xda, 0x42, 0xf2, 0x16, 0xaa, 0xcb, 0x22, 0x21, 0x00, 0xae, 0xbc, 0x38, 0x67, 0xe7, 0x6b,
+	0xfc, 0xbd, 0x81, 0x56, 0x5c, 0x9f, 0xd0, 0x80, 0x0c, 0x7d, 0x98, 0xbe, 0x8b, 0x1c, 0x49, 0xca,
+	0x37, 0x8d, 0xcd, 0xd6, 0x4b, 0xce, 0xdd, 0xcf, 0xe6, 0x55, 0xff, 0xdd, 0xd4, 0xba, 0x6b, 0xda,
+	0xba, 0xff, 0x99, 0xaa, 0xfb, 0x48, 0x5a, 0xf6, 0x6a, 0xbe, 0x5f, 0x5c, 0x49, 0xe6, 0xc2, 0xbb,
+	0x68, 0x71, 0x2a, 0x80, 0x04, 0x2c, 0x09, 0x85, 0x59, 0x4d, 0xfb, 0x46, 0x4b, 0x67, 0x49, 0x2b,
+	0xe4, 0xaf, 0xb5, 0xc5, 0x68, 0xd8, 0x9f, 0x91, 0x87, 0xdb, 0xcd, 0x22, 0xf2, 0x73, 0x15, 0xd8,
+	0x3d, 0xab, 0x20, 0xac, 0xac, 0x38, 0xe0, 0xd4, 0x85, 0xbd, 0x90, 0x44, 0xf1, 0x98, 0x89, 0xe7,
+	0x7d, 0x69, 0xbc, 0x20, 0x92, 0x16, 0xb4, 0x9c, 0x0b, 0xba, 0x8e, 0xe6, 0x87, 0x3e, 0x73, 0x0f,
+	0x9d, 0x31, 0xd0, 0xd1, 0x58, 0x28, 0xe1, 0x2a, 0x76, 0x43, 0x61, 0x77, 0x15, 0x84, 0xdf, 0x44,
+	0x73, 0xf9, 0xe0, 0x56, 0x52, 0x55, 0xec, 0x02, 0x90, 0x1e, 0x56, 0xfd, 0x93, 0x4e, 0x92, 0xaa,
+	0xf6, 0xb0, 0x82, 0xf4, 0xf0, 0x98, 0xea, 0xcf, 0xda, 0xeb, 0xf4, 0xe7, 0xec, 0xff, 0xed, 0xcf,
+	0xed, 0xac, 0xb2, 0x48, 0xca, 0x63, 0xd6, 0x73, 0xa3, 0x96, 0xce, 0x33, 0x2a, 0x8a, 0x73, 0x55,
+	0xf1, 0x5d, 0x54, 0x75, 0x49, 0x12, 0xeb, 0xde, 0x5b, 0xd8, 0xdc, 0xb4, 0x5e, 0xf1, 0x9d, 0xb4,
+	0x8a, 0xd7, 0xd8, 0x1a, 0x93, 0x70, 0x04, 0x5b, 0x32, 0xd2, 0xd6, 0x09, 0xf0, 0x47, 0xa8, 0x96,
+	0xbe, 0x38, 0xba, 0xc8, 0x55, 0x52, 0xf2, 0xcd, 0xa7, 0x06, 0x5a, 0xfa, 0xd7, 0xbc, 0xf8, 0x32,
+	0x6a, 0xdc, 0x0f, 0xe3, 0x08, 0x5c, 0x7a, 0x40, 0xc1, 0x6b, 0x96, 0xf0, 0x3c, 0xaa, 0x6f, 0x71,
+	0x50, 0x63, 0xb1, 0x69, 0xe0, 0x05, 0x84, 0xee, 0x87, 0x1e, 0xf0, 0x87, 0x9c, 0x0a, 0x68, 0x96,
+	0xf1, 0x12, 0x5a, 0x2c, 0xec, 0x68, 0xc3, 0x51, 0x02, 0xb1, 0x68, 0x56, 0xb0, 0x89, 0xae, 0x14,
+	0xf0, 0xd7, 0x54, 0x8c, 0x3d, 0x4e, 0x1e, 0x12, 0xbf, 0x39, 0x83, 0x97, 0x11, 0xde, 0xa5, 0x47,
+	0x09, 0xf5, 0x54, 0xc6, 0x6d, 0xfd, 0x39, 0x69, 0x56, 0x65, 0xa2, 0x29, 0x7c, 0x40, 0x26, 0x2c,
+	0x11, 0xcd, 0x1a, 0x6e, 0xa1, 0xe5, 0x22, 0xd1, 0x96, 0xd4, 0xc5, 0xf7, 0x75, 0x2d, 0xb3, 0xf8,
+	0x0d, 0x74, 0xf9, 0xce, 0xf3, 0xcd, 0xdf, 0xac, 0xf7, 0xe9, 0xf1, 0x69, 0xdb, 0x78, 0x72, 0xda,
+	0x36, 0xfe, 0x38, 0x6d, 0x1b, 0x8f, 0xce, 0xda, 0xa5, 0x27, 0x67, 0xed, 0xd2, 0x6f, 0x67, 0xed,
+	0xd2, 0x37, 0xf7, 0x46, 0x54, 0x8c, 0x93, 0xa1, 0xe5, 0xb2, 0xa0, 0xb7, 0x93, 0xe9, 0xbd, 0x4b,
+	0x86, 0x71, 0x2f, 0x57, 0xff, 0x3d, 0x97, 0x71, 0x98, 0x5e, 0x8e, 0x09, 0x0d, 0x7b, 0x01, 0x93,
+	0x63, 0x23, 0x9e, 0xfa, 0x7f, 0x47, 0x4e, 0xc5, 0x78, 0x58, 0x53, 0x3d, 0xfd, 0xc1, 0x3f, 0x03,
+	0x00, 0xe0, 0x07, 0x8b, 0xe9, 0x13, 0x09, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -423,6 +603,18 @@ func (m *InsuranceFund) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.EarlyRedemptionPenaltyRate != nil {
+		{
+			size := m.EarlyRedemptionPenaltyRate.Size()
+			i -= size
+			if _, err := m.EarlyRedemptionPenaltyRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintInsurance(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x62
+	}
 	if m.Expiry != 0 {
 		i = encodeVarintInsurance(dAtA, i, uint64(m.Expiry))
 		i--
@@ -566,6 +758,103 @@ func (m *RedemptionSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *SharePriceSnapshot) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SharePriceSnapshot) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SharePriceSnapshot) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if m.Cause != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Cause))
+		i--
+		dAtA[i] = 0x48
+	}
+	{
+		size := m.SharePrice.Size()
+		i -= size
+		if _, err := m.SharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.TotalShare.Size()
+		i -= size
+		if _, err := m.TotalShare.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.Balance.Size()
+		i -= size
+		if _, err := m.Balance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintInsurance(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.ShareDenom) > 0 {
+		i -= len(m.ShareDenom)
+		copy(dAtA[i:], m.ShareDenom)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.ShareDenom)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.Timestamp != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Timestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Id != 0 {
+		i = encodeVarintInsurance(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintInsurance(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintInsurance(dAtA []byte, offset int, v uint64) int {
 	offset -= sovInsurance(v)
 	base := offset
@@ -630,6 +919,10 @@ func (m *InsuranceFund) Size() (n int) {
 	if m.Expiry != 0 {
 		n += 1 + sovInsurance(uint64(m.Expiry))
 	}
+	if m.EarlyRedemptionPenaltyRate != nil {
+		l = m.EarlyRedemptionPenaltyRate.Size()
+		n += 1 + l + sovInsurance(uint64(l))
+	}
 	return n
 }
 
@@ -657,6 +950,43 @@ func (m *RedemptionSchedule) Size() (n int) {
 	return n
 }
 
+func (m *SharePriceSnapshot) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	if m.Id != 0 {
+		n += 1 + sovInsurance(uint64(m.Id))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovInsurance(uint64(m.BlockHeight))
+	}
+	if m.Timestamp != 0 {
+		n += 1 + sovInsurance(uint64(m.Timestamp))
+	}
+	l = len(m.ShareDenom)
+	if l > 0 {
+		n += 1 + l + sovInsurance(uint64(l))
+	}
+	l = m.Balance.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	l = m.TotalShare.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	l = m.SharePrice.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	if m.Cause != 0 {
+		n += 1 + sovInsurance(uint64(m.Cause))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovInsurance(uint64(l))
+	return n
+}
+
 func sovInsurance(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1106,6 +1436,42 @@ func (m *InsuranceFund) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EarlyRedemptionPenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.EarlyRedemptionPenaltyRate = &v
+			if err := m.EarlyRedemptionPenaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipInsurance(dAtA[iNdEx:])
@@ -1326,6 +1692,332 @@ func (m *RedemptionSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *SharePriceSnapshot) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowInsurance
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SharePriceSnapshot: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SharePriceSnapshot: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timestamp", wireType)
+			}
+			m.Timestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ShareDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ShareDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Balance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Balance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalShare", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalShare.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Cause", wireType)
+			}
+			m.Cause = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Cause |= SharePriceChangeCause(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowInsurance
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipInsurance(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthInsurance
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipInsurance(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,8 @@ import (
 	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	"github.com/ethereum/go-ethereum/common"
 )
 
 const (
@@ -21,6 +23,12 @@ var (
 	GlobalShareDenomIdPrefixKey         = []byte{0x04, 0x00}
 	GlobalRedemptionScheduleIdPrefixKey = []byte{0x05, 0x00}
 
+	// Key for insurance fund share price snapshot prefixes
+	SharePriceSnapshotPrefixKey = []byte{0x06}
+
+	// Key for the index of pending redemptions by redeemer
+	RedemptionScheduleByRedeemerPrefixKey = []byte{0x07}
+
 	ParamsKey = []byte{0x10}
 )
 
@@ -36,3 +44,29 @@ func GetRedemptionScheduleKey(redemptionID uint64, claimTime time.Time) []byte {
 func (sh RedemptionSchedule) GetRedemptionScheduleKey() []byte {
 	return GetRedemptionScheduleKey(sh.Id, sh.ClaimableRedemptionTime)
 }
+
+// GetRedemptionScheduleByRedeemerKey provides the key indexing a single pending redemption by its redeemer
+// and insurance fund
+func GetRedemptionScheduleByRedeemerKey(redeemer sdk.AccAddress, marketID common.Hash, redemptionID uint64) []byte {
+	key := append([]byte{}, RedemptionScheduleByRedeemerPrefixKey...)
+	key = append(key, address.MustLengthPrefix(redeemer)...)
+	key = append(key, marketID.Bytes()...)
+	key = append(key, sdk.Uint64ToBigEndian(redemptionID)...)
+	return key
+}
+
+// GetRedemptionScheduleByRedeemerKey provides the key indexing a single pending redemption by its redeemer
+// and insurance fund
+func (sh RedemptionSchedule) GetRedemptionScheduleByRedeemerKey() []byte {
+	return GetRedemptionScheduleByRedeemerKey(sdk.MustAccAddressFromBech32(sh.Redeemer), common.HexToHash(sh.MarketId), sh.Id)
+}
+
+// GetSharePriceSnapshotPrefix provides the prefix of all share price snapshots of an insurance fund
+func GetSharePriceSnapshotPrefix(marketID common.Hash) []byte {
+	return append(SharePriceSnapshotPrefixKey, marketID.Bytes()...)
+}
+
+// GetSharePriceSnapshotKey provides the key to store a single share price snapshot
+func GetSharePriceSnapshotKey(marketID common.Hash, snapshotID uint64) []byte {
+	return append(GetSharePriceSnapshotPrefix(marketID), sdk.Uint64ToBigEndian(snapshotID)...)
+}
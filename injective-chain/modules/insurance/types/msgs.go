@@ -2,6 +2,7 @@ package types
 
 import (
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
@@ -14,6 +15,9 @@ var (
 	_ sdk.Msg = &MsgCreateInsuranceFund{}
 	_ sdk.Msg = &MsgUnderwrite{}
 	_ sdk.Msg = &MsgRequestRedemption{}
+	_ sdk.Msg = &MsgCancelRedemption{}
+	_ sdk.Msg = &MsgEarlyRedemption{}
+	_ sdk.Msg = &MsgSetEarlyRedemptionPenalty{}
 	_ sdk.Msg = &MsgUpdateParams{}
 )
 
@@ -182,3 +186,105 @@ func (msg MsgRequestRedemption) GetSigners() []sdk.AccAddress {
 	}
 	return []sdk.AccAddress{sender}
 }
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgCancelRedemption) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgCancelRedemption) Type() string { return "cancelRedemption" }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgCancelRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.MarketId == "" {
+		return errors.Wrap(ErrInvalidMarketID, msg.MarketId)
+	}
+	if msg.RedemptionId == 0 {
+		return errors.Wrap(ErrRedemptionNotFound, "redemption id cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgCancelRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgCancelRedemption) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgEarlyRedemption) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgEarlyRedemption) Type() string { return "earlyRedemption" }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgEarlyRedemption) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Sender)
+	}
+	if msg.MarketId == "" {
+		return errors.Wrap(ErrInvalidMarketID, msg.MarketId)
+	}
+	if msg.RedemptionId == 0 {
+		return errors.Wrap(ErrRedemptionNotFound, "redemption id cannot be zero")
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgEarlyRedemption) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgEarlyRedemption) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
+
+// Route implements the sdk.Msg interface. It should return the name of the module
+func (msg MsgSetEarlyRedemptionPenalty) Route() string { return RouterKey }
+
+// Type implements the sdk.Msg interface. It should return the action.
+func (msg MsgSetEarlyRedemptionPenalty) Type() string { return "setEarlyRedemptionPenalty" }
+
+// ValidateBasic implements the sdk.Msg interface. It runs stateless checks on the message
+func (msg MsgSetEarlyRedemptionPenalty) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+	if msg.MarketId == "" {
+		return errors.Wrap(ErrInvalidMarketID, msg.MarketId)
+	}
+	if msg.PenaltyRate != nil && (msg.PenaltyRate.IsNil() || msg.PenaltyRate.IsNegative() || msg.PenaltyRate.GTE(math.LegacyOneDec())) {
+		return errors.Wrapf(ErrInvalidPenaltyRate, "penalty rate must be in [0, 1): %s", msg.PenaltyRate)
+	}
+	return nil
+}
+
+// GetSignBytes implements the sdk.Msg interface. It encodes the message for signing
+func (msg *MsgSetEarlyRedemptionPenalty) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners implements the sdk.Msg interface. It defines whose signature is required
+func (msg MsgSetEarlyRedemptionPenalty) GetSigners() []sdk.AccAddress {
+	sender, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{sender}
+}
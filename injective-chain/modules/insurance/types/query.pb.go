@@ -5,8 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -491,6 +493,231 @@ func (m *QueryPendingRedemptionsResponse) GetAmount() []types.Coin {
 	return nil
 }
 
+// QuerySharePriceHistoryRequest is the request type for the
+// Query/SharePriceHistory RPC method.
+type QuerySharePriceHistoryRequest struct {
+	MarketId   string             `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySharePriceHistoryRequest) Reset()         { *m = QuerySharePriceHistoryRequest{} }
+func (m *QuerySharePriceHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QuerySharePriceHistoryRequest) ProtoMessage()    {}
+func (*QuerySharePriceHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cebfe4cd18bca2, []int{10}
+}
+func (m *QuerySharePriceHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharePriceHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharePriceHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySharePriceHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharePriceHistoryRequest.Merge(m, src)
+}
+func (m *QuerySharePriceHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharePriceHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharePriceHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharePriceHistoryRequest proto.InternalMessageInfo
+
+func (m *QuerySharePriceHistoryRequest) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *QuerySharePriceHistoryRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QuerySharePriceHistoryResponse is the response type for the
+// Query/SharePriceHistory RPC method.
+type QuerySharePriceHistoryResponse struct {
+	Snapshots  []SharePriceSnapshot `protobuf:"bytes,1,rep,name=snapshots,proto3" json:"snapshots"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QuerySharePriceHistoryResponse) Reset()         { *m = QuerySharePriceHistoryResponse{} }
+func (m *QuerySharePriceHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QuerySharePriceHistoryResponse) ProtoMessage()    {}
+func (*QuerySharePriceHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cebfe4cd18bca2, []int{11}
+}
+func (m *QuerySharePriceHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QuerySharePriceHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QuerySharePriceHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QuerySharePriceHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QuerySharePriceHistoryResponse.Merge(m, src)
+}
+func (m *QuerySharePriceHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QuerySharePriceHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QuerySharePriceHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QuerySharePriceHistoryResponse proto.InternalMessageInfo
+
+func (m *QuerySharePriceHistoryResponse) GetSnapshots() []SharePriceSnapshot {
+	if m != nil {
+		return m.Snapshots
+	}
+	return nil
+}
+
+func (m *QuerySharePriceHistoryResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryInsuranceFundApyRequest is the request type for the
+// Query/InsuranceFundApy RPC method.
+type QueryInsuranceFundApyRequest struct {
+	MarketId string `protobuf:"bytes,1,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// lookback window in seconds, defaults to 30 days
+	LookbackSeconds int64 `protobuf:"varint,2,opt,name=lookback_seconds,json=lookbackSeconds,proto3" json:"lookback_seconds,omitempty"`
+}
+
+func (m *QueryInsuranceFundApyRequest) Reset()         { *m = QueryInsuranceFundApyRequest{} }
+func (m *QueryInsuranceFundApyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundApyRequest) ProtoMessage()    {}
+func (*QueryInsuranceFundApyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cebfe4cd18bca2, []int{12}
+}
+func (m *QueryInsuranceFundApyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundApyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundApyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundApyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundApyRequest.Merge(m, src)
+}
+func (m *QueryInsuranceFundApyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundApyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundApyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundApyRequest proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundApyRequest) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+func (m *QueryInsuranceFundApyRequest) GetLookbackSeconds() int64 {
+	if m != nil {
+		return m.LookbackSeconds
+	}
+	return 0
+}
+
+// QueryInsuranceFundApyResponse is the response type for the
+// Query/InsuranceFundApy RPC method.
+type QueryInsuranceFundApyResponse struct {
+	// annualized (non-compounded) share price return over the window
+	Apy cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=apy,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"apy"`
+	// share price at the start of the window
+	StartSharePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=start_share_price,json=startSharePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"start_share_price"`
+	// current share price
+	EndSharePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=end_share_price,json=endSharePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"end_share_price"`
+	// timestamp of the snapshot the window starts at
+	StartTimestamp int64 `protobuf:"varint,4,opt,name=start_timestamp,json=startTimestamp,proto3" json:"start_timestamp,omitempty"`
+	// timestamp the window ends at
+	EndTimestamp int64 `protobuf:"varint,5,opt,name=end_timestamp,json=endTimestamp,proto3" json:"end_timestamp,omitempty"`
+}
+
+func (m *QueryInsuranceFundApyResponse) Reset()         { *m = QueryInsuranceFundApyResponse{} }
+func (m *QueryInsuranceFundApyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryInsuranceFundApyResponse) ProtoMessage()    {}
+func (*QueryInsuranceFundApyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_74cebfe4cd18bca2, []int{13}
+}
+func (m *QueryInsuranceFundApyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryInsuranceFundApyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryInsuranceFundApyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryInsuranceFundApyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryInsuranceFundApyResponse.Merge(m, src)
+}
+func (m *QueryInsuranceFundApyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryInsuranceFundApyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryInsuranceFundApyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryInsuranceFundApyResponse proto.InternalMessageInfo
+
+func (m *QueryInsuranceFundApyResponse) GetStartTimestamp() int64 {
+	if m != nil {
+		return m.StartTimestamp
+	}
+	return 0
+}
+
+func (m *QueryInsuranceFundApyResponse) GetEndTimestamp() int64 {
+	if m != nil {
+		return m.EndTimestamp
+	}
+	return 0
+}
+
 // QueryModuleStateRequest is the request type for the
 // Query/InsuranceModuleState RPC method.
 type QueryModuleStateRequest struct {
@@ -500,7 +727,7 @@ func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest
 func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateRequest) ProtoMessage()    {}
 func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cebfe4cd18bca2, []int{10}
+	return fileDescriptor_74cebfe4cd18bca2, []int{14}
 }
 func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -539,7 +766,7 @@ func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateRespon
 func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateResponse) ProtoMessage()    {}
 func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_74cebfe4cd18bca2, []int{11}
+	return fileDescriptor_74cebfe4cd18bca2, []int{15}
 }
 func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -586,6 +813,10 @@ func init() {
 	proto.RegisterType((*QueryEstimatedRedemptionsResponse)(nil), "injective.insurance.v1beta1.QueryEstimatedRedemptionsResponse")
 	proto.RegisterType((*QueryPendingRedemptionsRequest)(nil), "injective.insurance.v1beta1.QueryPendingRedemptionsRequest")
 	proto.RegisterType((*QueryPendingRedemptionsResponse)(nil), "injective.insurance.v1beta1.QueryPendingRedemptionsResponse")
+	proto.RegisterType((*QuerySharePriceHistoryRequest)(nil), "injective.insurance.v1beta1.QuerySharePriceHistoryRequest")
+	proto.RegisterType((*QuerySharePriceHistoryResponse)(nil), "injective.insurance.v1beta1.QuerySharePriceHistoryResponse")
+	proto.RegisterType((*QueryInsuranceFundApyRequest)(nil), "injective.insurance.v1beta1.QueryInsuranceFundApyRequest")
+	proto.RegisterType((*QueryInsuranceFundApyResponse)(nil), "injective.insurance.v1beta1.QueryInsuranceFundApyResponse")
 	proto.RegisterType((*QueryModuleStateRequest)(nil), "injective.insurance.v1beta1.QueryModuleStateRequest")
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.insurance.v1beta1.QueryModuleStateResponse")
 }
@@ -595,53 +826,74 @@ func init() {
 }
 
 var fileDescriptor_74cebfe4cd18bca2 = []byte{
-	// 727 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xdf, 0x4b, 0x14, 0x41,
-	0x1c, 0xbf, 0x35, 0xb5, 0x9c, 0xa8, 0x60, 0x10, 0x3a, 0x57, 0x5b, 0x6d, 0x25, 0xca, 0xac, 0xdd,
-	0xbc, 0x2c, 0xcf, 0x7e, 0x28, 0x19, 0x19, 0x42, 0x91, 0x5d, 0x10, 0x61, 0xc2, 0x31, 0x77, 0x3b,
-	0xad, 0x53, 0xee, 0xcc, 0xb9, 0x33, 0x2b, 0x48, 0xf4, 0xd2, 0x5f, 0x10, 0x04, 0xfd, 0x23, 0x41,
-	0xd0, 0x63, 0x6f, 0xf6, 0x66, 0xf4, 0xd2, 0x53, 0x84, 0xf6, 0x87, 0xc4, 0xce, 0xce, 0xae, 0x77,
-	0x7a, 0x8e, 0xed, 0xd5, 0xdb, 0xed, 0xcc, 0x7c, 0x7e, 0x7d, 0x67, 0xbe, 0x5f, 0x0e, 0x9c, 0x27,
-	0xf4, 0x25, 0xae, 0x0b, 0xb2, 0x8e, 0x5d, 0x42, 0x79, 0x14, 0x22, 0x5a, 0xc7, 0xee, 0xfa, 0x44,
-	0x0d, 0x0b, 0x34, 0xe1, 0xae, 0x45, 0x38, 0xdc, 0x70, 0x1a, 0x21, 0x13, 0x0c, 0x0e, 0x66, 0x07,
-	0x9d, 0xec, 0xa0, 0xa3, 0x0e, 0x9a, 0x43, 0x3e, 0x63, 0xfe, 0x2a, 0x76, 0x51, 0x83, 0xb8, 0x88,
-	0x52, 0x26, 0x90, 0x20, 0x8c, 0xf2, 0x04, 0x6a, 0x8e, 0xeb, 0x34, 0x76, 0xc9, 0x92, 0xc3, 0xfd,
-	0x3e, 0xf3, 0x99, 0xfc, 0xe9, 0xc6, 0xbf, 0xd4, 0xaa, 0x55, 0x67, 0x3c, 0x60, 0xdc, 0xad, 0x21,
-	0xbe, 0x0b, 0xad, 0x33, 0x42, 0xd5, 0xfe, 0x98, 0x4e, 0xc2, 0xc7, 0x14, 0x73, 0xa2, 0xdc, 0xd8,
-	0x67, 0xc0, 0xe0, 0xe3, 0x38, 0xd7, 0x42, 0x7a, 0x6e, 0x11, 0x85, 0x28, 0xe0, 0x15, 0xbc, 0x16,
-	0x61, 0x2e, 0x6c, 0x04, 0x86, 0xda, 0x6f, 0xf3, 0x06, 0xa3, 0x1c, 0xc3, 0x3b, 0xa0, 0xb7, 0x21,
-	0x57, 0x8a, 0xc6, 0x88, 0x71, 0xe1, 0x78, 0x69, 0xd4, 0xd1, 0x14, 0xc6, 0x49, 0xc0, 0x73, 0xdd,
-	0x9b, 0x3f, 0x87, 0x0b, 0x15, 0x05, 0xb4, 0xcb, 0x60, 0xa0, 0x55, 0x62, 0x3e, 0xa2, 0x9e, 0xd2,
-	0x87, 0x83, 0xa0, 0x2f, 0x40, 0xe1, 0x2b, 0x2c, 0xaa, 0xc4, 0x93, 0x12, 0x7d, 0x95, 0x63, 0xc9,
-	0xc2, 0x82, 0x67, 0x2f, 0x03, 0xb3, 0x1d, 0x52, 0x59, 0x9b, 0x01, 0xdd, 0x2f, 0x22, 0xea, 0x29,
-	0x63, 0x17, 0xb5, 0xc6, 0x5a, 0x19, 0x24, 0xce, 0x1e, 0x6a, 0xc7, 0x9e, 0x15, 0x06, 0xef, 0xad,
-	0x9b, 0xda, 0x55, 0xe2, 0xf3, 0xa0, 0x27, 0x26, 0x89, 0xcb, 0x72, 0x24, 0x9f, 0xba, 0xaa, 0x4e,
-	0x02, 0xb7, 0x9f, 0x81, 0x11, 0x29, 0x73, 0x8f, 0x0b, 0x12, 0x20, 0x81, 0xbd, 0x0a, 0xf6, 0x70,
-	0xd0, 0x90, 0xef, 0x29, 0xad, 0x91, 0x09, 0xb2, 0x92, 0xec, 0x2d, 0x11, 0x2c, 0x82, 0xa3, 0xc8,
-	0xf3, 0x42, 0xcc, 0x79, 0xb1, 0x4b, 0x6e, 0xa5, 0x9f, 0xf6, 0x32, 0x38, 0xab, 0x61, 0x56, 0x31,
-	0xa6, 0x40, 0x2f, 0x0a, 0x58, 0x44, 0x85, 0xca, 0x31, 0xe0, 0x24, 0x2f, 0xcf, 0x89, 0x5f, 0x5e,
-	0xe6, 0xff, 0x2e, 0x23, 0x34, 0xbd, 0xd4, 0xe4, 0xb8, 0xfd, 0x14, 0x58, 0x92, 0x7d, 0x11, 0x53,
-	0x8f, 0x50, 0xff, 0xbf, 0xb9, 0x5e, 0x02, 0xc3, 0x07, 0xf2, 0xfe, 0xab, 0xe7, 0x01, 0x70, 0x5a,
-	0x72, 0x3f, 0x64, 0x5e, 0xb4, 0x8a, 0x9f, 0x08, 0x24, 0x70, 0x7a, 0xdb, 0xcf, 0x41, 0x71, 0xff,
-	0x96, 0xd2, 0x9b, 0x05, 0x3d, 0x3c, 0x5e, 0x50, 0x0f, 0x6d, 0x4c, 0x7b, 0xd5, 0xf7, 0x93, 0xe6,
-	0x4b, 0x18, 0x12, 0x5c, 0xe9, 0x43, 0x1f, 0xe8, 0x91, 0xec, 0xf0, 0xa3, 0x01, 0x4e, 0xed, 0xe9,
-	0x34, 0x58, 0xd6, 0xf2, 0x69, 0x7a, 0xd7, 0x9c, 0xee, 0x00, 0x99, 0x64, 0xb2, 0xc7, 0xdf, 0x7e,
-	0xff, 0xfd, 0xbe, 0xeb, 0x1c, 0x1c, 0x75, 0x75, 0x93, 0x24, 0x69, 0x60, 0xf8, 0xc5, 0x00, 0x27,
-	0x5a, 0x9e, 0x30, 0xbc, 0x9e, 0x43, 0xb9, 0xa9, 0xdb, 0xcd, 0xa9, 0xdc, 0x38, 0xe5, 0x77, 0x56,
-	0xfa, 0x9d, 0x86, 0x53, 0xee, 0x5f, 0x0d, 0xd7, 0x6a, 0xdc, 0x5c, 0xee, 0xeb, 0x6c, 0xb2, 0xbc,
-	0x81, 0x9f, 0x0d, 0x70, 0xb2, 0xb5, 0x95, 0x61, 0x5e, 0x33, 0x59, 0xdd, 0xcb, 0xf9, 0x81, 0x2a,
-	0xc6, 0xa4, 0x8c, 0xe1, 0xc0, 0x4b, 0x39, 0x62, 0x70, 0xf8, 0xcd, 0x00, 0xfd, 0xed, 0xba, 0x18,
-	0xde, 0x3e, 0xdc, 0x88, 0x66, 0xae, 0x98, 0x33, 0x9d, 0xc2, 0x55, 0x9a, 0x1b, 0x32, 0xcd, 0x24,
-	0x2c, 0x69, 0xd3, 0xe0, 0x94, 0xa2, 0x1a, 0x36, 0x59, 0xff, 0x6a, 0x00, 0xb8, 0xbf, 0xc7, 0xe1,
-	0xcd, 0xc3, 0x2d, 0x1d, 0x38, 0x71, 0xcc, 0x5b, 0x9d, 0x81, 0x55, 0x9a, 0xb2, 0x4c, 0x53, 0x82,
-	0x57, 0xf4, 0x2d, 0x91, 0x10, 0xb4, 0x64, 0xf9, 0x64, 0x80, 0xfe, 0xec, 0xc2, 0x9b, 0x26, 0x08,
-	0x9c, 0x3c, 0xdc, 0xd0, 0xfe, 0x59, 0x64, 0x5e, 0xcb, 0x89, 0x52, 0xfe, 0x27, 0xa4, 0xff, 0x71,
-	0x38, 0xa6, 0xf5, 0x1f, 0x48, 0x64, 0x55, 0x0e, 0xa6, 0x39, 0xb2, 0xb9, 0x6d, 0x19, 0x5b, 0xdb,
-	0x96, 0xf1, 0x6b, 0xdb, 0x32, 0xde, 0xed, 0x58, 0x85, 0xad, 0x1d, 0xab, 0xf0, 0x63, 0xc7, 0x2a,
-	0x2c, 0x3d, 0xf2, 0x89, 0x58, 0x89, 0x6a, 0x4e, 0x9d, 0x05, 0xee, 0x42, 0x4a, 0xf7, 0x00, 0xd5,
-	0xf8, 0x2e, 0xf9, 0xe5, 0x3a, 0x0b, 0x71, 0xf3, 0xe7, 0x0a, 0x22, 0x54, 0xf1, 0xf3, 0x26, 0x65,
-	0xb1, 0xd1, 0xc0, 0xbc, 0xd6, 0x2b, 0xff, 0x8d, 0x5c, 0xfd, 0x13, 0x00, 0x00, 0xff, 0xff, 0x23,
-	0x60, 0x60, 0x91, 0x81, 0x09, 0x00, 0x00,
+	// 1060 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x57, 0x5d, 0x6f, 0xdc, 0x44,
+	0x14, 0x8d, 0xf3, 0x05, 0xb9, 0xfd, 0x48, 0x3b, 0x8a, 0xc4, 0xc6, 0x49, 0x36, 0xc5, 0x11, 0x84,
+	0x34, 0x60, 0x93, 0x90, 0x92, 0xaf, 0x36, 0x55, 0xd3, 0x92, 0x12, 0x51, 0xd4, 0xb0, 0x41, 0x08,
+	0x95, 0x4a, 0xab, 0x59, 0x7b, 0xea, 0x35, 0x89, 0x67, 0x5c, 0xcf, 0x6c, 0xa5, 0x08, 0xf1, 0x82,
+	0xf8, 0x01, 0x48, 0xfc, 0x14, 0x24, 0x24, 0x24, 0x84, 0x04, 0x4f, 0xe5, 0x05, 0x15, 0x78, 0x41,
+	0x3c, 0x54, 0x28, 0xe1, 0x87, 0x20, 0xcf, 0x8c, 0xbd, 0x76, 0xb2, 0x71, 0xb2, 0xa1, 0x6f, 0xbb,
+	0x77, 0xee, 0x39, 0xf7, 0x9c, 0x9b, 0x3b, 0x73, 0xb3, 0x30, 0x1d, 0xd0, 0xcf, 0x89, 0x2b, 0x82,
+	0x27, 0xc4, 0x09, 0x28, 0x6f, 0xc5, 0x98, 0xba, 0xc4, 0x79, 0x32, 0xd7, 0x20, 0x02, 0xcf, 0x39,
+	0x8f, 0x5b, 0x24, 0xde, 0xb3, 0xa3, 0x98, 0x09, 0x86, 0xc6, 0xb2, 0x44, 0x3b, 0x4b, 0xb4, 0x75,
+	0xa2, 0x39, 0xee, 0x33, 0xe6, 0xef, 0x12, 0x07, 0x47, 0x81, 0x83, 0x29, 0x65, 0x02, 0x8b, 0x80,
+	0x51, 0xae, 0xa0, 0xe6, 0x6c, 0x59, 0x8d, 0x36, 0x99, 0x4a, 0x1e, 0xf1, 0x99, 0xcf, 0xe4, 0x47,
+	0x27, 0xf9, 0xa4, 0xa3, 0x55, 0x97, 0xf1, 0x90, 0x71, 0xa7, 0x81, 0x79, 0x1b, 0xea, 0xb2, 0x80,
+	0xea, 0xf3, 0x99, 0xb2, 0x12, 0x3e, 0xa1, 0x84, 0x07, 0xa9, 0x9a, 0xab, 0x79, 0x2a, 0xe9, 0x30,
+	0x4b, 0x8c, 0xb0, 0x1f, 0x50, 0x29, 0x5d, 0xe5, 0x5a, 0x13, 0x30, 0xf6, 0x51, 0x92, 0xb1, 0x99,
+	0x72, 0x6e, 0xe1, 0x18, 0x87, 0xbc, 0x46, 0x1e, 0xb7, 0x08, 0x17, 0x16, 0x86, 0xf1, 0xce, 0xc7,
+	0x3c, 0x62, 0x94, 0x13, 0x74, 0x0b, 0x06, 0x23, 0x19, 0xa9, 0x18, 0x57, 0x8c, 0x37, 0xce, 0xcd,
+	0x4f, 0xd9, 0x25, 0x4d, 0xb4, 0x15, 0x78, 0xbd, 0xff, 0xe9, 0xf3, 0xc9, 0x9e, 0x9a, 0x06, 0x5a,
+	0x4b, 0x30, 0x5a, 0x2c, 0xb1, 0xd1, 0xa2, 0x9e, 0xae, 0x8f, 0xc6, 0x60, 0x28, 0xc4, 0xf1, 0x0e,
+	0x11, 0xf5, 0xc0, 0x93, 0x25, 0x86, 0x6a, 0x2f, 0xab, 0xc0, 0xa6, 0x67, 0x3d, 0x04, 0xb3, 0x13,
+	0x52, 0x4b, 0x5b, 0x83, 0xfe, 0x47, 0x2d, 0xea, 0x69, 0x61, 0x57, 0x4b, 0x85, 0x15, 0x19, 0x24,
+	0xce, 0x1a, 0xef, 0xc4, 0x9e, 0x35, 0x86, 0xc0, 0x58, 0xc7, 0x53, 0x5d, 0x7c, 0x03, 0x06, 0x12,
+	0x92, 0xa4, 0x2d, 0x7d, 0xdd, 0x55, 0xd7, 0xdd, 0x51, 0x70, 0xeb, 0x53, 0xb8, 0x22, 0xcb, 0xbc,
+	0xc7, 0x45, 0x10, 0x62, 0x41, 0xbc, 0x1a, 0xf1, 0x48, 0x18, 0xc9, 0xd9, 0x4b, 0x7b, 0x64, 0x42,
+	0xd6, 0x92, 0xc3, 0x2d, 0x42, 0x15, 0x78, 0x09, 0x7b, 0x5e, 0x4c, 0x38, 0xaf, 0xf4, 0xca, 0xa3,
+	0xf4, 0xab, 0xf5, 0x10, 0x5e, 0x2d, 0x61, 0xd6, 0x36, 0x16, 0x61, 0x10, 0x87, 0xac, 0x45, 0x85,
+	0xf6, 0x31, 0x6a, 0xab, 0xd1, 0xb2, 0x93, 0xd1, 0xca, 0xf4, 0xdf, 0x66, 0x01, 0x4d, 0xff, 0xa8,
+	0x2a, 0xdd, 0xfa, 0x04, 0xaa, 0x92, 0x7d, 0x8b, 0x50, 0x2f, 0xa0, 0xfe, 0x0b, 0x53, 0xfd, 0x00,
+	0x26, 0x8f, 0xe5, 0xfd, 0xbf, 0x9a, 0xbf, 0x36, 0x60, 0x42, 0x92, 0x6f, 0x37, 0x71, 0x4c, 0xb6,
+	0xe2, 0xc0, 0x25, 0xef, 0x07, 0x5c, 0xb0, 0x78, 0xef, 0x34, 0xd3, 0x88, 0x36, 0x00, 0xda, 0xb7,
+	0x4b, 0xea, 0x3e, 0x37, 0xff, 0x7a, 0xa1, 0xb6, 0x7a, 0x6c, 0xda, 0x97, 0xc1, 0x27, 0x9a, 0xb8,
+	0x96, 0x43, 0x5a, 0x3f, 0x19, 0x50, 0x3d, 0x4e, 0x86, 0xb6, 0xb8, 0x0d, 0x43, 0x9c, 0xe2, 0x88,
+	0x37, 0x99, 0x48, 0x27, 0xcc, 0x29, 0x9d, 0xb0, 0x36, 0xd5, 0xb6, 0xc6, 0x69, 0xef, 0x6d, 0x1e,
+	0x74, 0xb7, 0x83, 0xfe, 0xe9, 0x13, 0xf5, 0x2b, 0x45, 0x05, 0x03, 0x8f, 0x0e, 0xbf, 0x19, 0xc9,
+	0x58, 0xdf, 0x8a, 0x4e, 0xd7, 0xc5, 0x19, 0xb8, 0xb4, 0xcb, 0xd8, 0x4e, 0x03, 0xbb, 0x3b, 0x75,
+	0x4e, 0x5c, 0x96, 0xdc, 0xa1, 0x44, 0x4b, 0x5f, 0x6d, 0x38, 0x8d, 0x6f, 0xab, 0xb0, 0xf5, 0x4b,
+	0x2f, 0x4c, 0x1c, 0x53, 0x48, 0xf7, 0xe9, 0x1a, 0xf4, 0xe1, 0x68, 0x4f, 0xd5, 0x58, 0x9f, 0x4a,
+	0x0c, 0xff, 0xfd, 0x7c, 0x72, 0x4c, 0x59, 0xe2, 0xde, 0x8e, 0x1d, 0x30, 0x27, 0xc4, 0xa2, 0x69,
+	0xdf, 0x23, 0x3e, 0x76, 0xf7, 0xee, 0x10, 0xb7, 0x96, 0xe4, 0xa3, 0xfb, 0x70, 0x99, 0x0b, 0x1c,
+	0x8b, 0x3a, 0x4f, 0xda, 0x56, 0x8f, 0x92, 0xbe, 0x55, 0x7a, 0x4f, 0x4f, 0x32, 0x2c, 0xd1, 0xed,
+	0x9e, 0xa3, 0x0f, 0x60, 0x98, 0x50, 0xaf, 0x40, 0xd7, 0x77, 0x7a, 0xba, 0x0b, 0x84, 0x7a, 0x39,
+	0xb2, 0x69, 0x50, 0xfc, 0x75, 0x11, 0x84, 0x84, 0x0b, 0x1c, 0x46, 0x95, 0x7e, 0xd9, 0xa0, 0x8b,
+	0x32, 0xfc, 0x71, 0x1a, 0x45, 0x53, 0x90, 0x20, 0x73, 0x69, 0x03, 0x32, 0xed, 0x3c, 0xa1, 0x5e,
+	0x96, 0x64, 0x8d, 0xc2, 0x2b, 0xb2, 0x87, 0x1f, 0x32, 0xaf, 0xb5, 0x4b, 0xb6, 0x05, 0x16, 0xe9,
+	0x50, 0x5a, 0x9f, 0x41, 0xe5, 0xe8, 0x91, 0xee, 0xec, 0x4d, 0x18, 0xe0, 0x49, 0x40, 0xbf, 0xae,
+	0x33, 0xa5, 0xd3, 0x77, 0x57, 0x6d, 0x27, 0xc5, 0xa0, 0x70, 0xf3, 0x3f, 0x9e, 0x87, 0x01, 0xc9,
+	0x8e, 0xbe, 0x33, 0x60, 0xf8, 0xd0, 0x7a, 0x41, 0x4b, 0xa5, 0x7c, 0x25, 0x0b, 0xcb, 0x5c, 0x3e,
+	0x03, 0x52, 0x79, 0xb2, 0x66, 0xbf, 0xfa, 0xf3, 0xdf, 0x6f, 0x7b, 0x5f, 0x43, 0x53, 0x4e, 0xd9,
+	0xaa, 0x55, 0x5b, 0x0b, 0xfd, 0x6c, 0xc0, 0x85, 0xc2, 0xdc, 0xa1, 0x77, 0xbb, 0xa8, 0x9c, 0x5b,
+	0x71, 0xe6, 0x62, 0xd7, 0x38, 0xad, 0xf7, 0xa6, 0xd4, 0xbb, 0x8c, 0x16, 0x9d, 0x53, 0xfd, 0xf7,
+	0x51, 0x4f, 0x36, 0x8a, 0xf3, 0x45, 0x76, 0xf5, 0xbe, 0x44, 0x3f, 0x18, 0x70, 0xb1, 0x40, 0xcd,
+	0x51, 0xb7, 0x62, 0xb2, 0xbe, 0x2f, 0x75, 0x0f, 0xd4, 0x36, 0x16, 0xa4, 0x0d, 0x1b, 0xbd, 0xd9,
+	0x85, 0x0d, 0x8e, 0x7e, 0x37, 0x60, 0xa4, 0xd3, 0xea, 0x42, 0x37, 0x4e, 0x16, 0x52, 0xb2, 0x4c,
+	0xcd, 0xb5, 0xb3, 0xc2, 0xb5, 0x9b, 0x15, 0xe9, 0x66, 0x01, 0xcd, 0x97, 0xba, 0x21, 0x29, 0x45,
+	0x3d, 0xce, 0x49, 0xff, 0xd5, 0x00, 0x74, 0x74, 0xb1, 0xa1, 0xd5, 0x93, 0x25, 0x1d, 0xbb, 0x66,
+	0xcd, 0xeb, 0x67, 0x03, 0x6b, 0x37, 0x4b, 0xd2, 0xcd, 0x3c, 0x7a, 0xbb, 0xfc, 0x4a, 0x28, 0x82,
+	0x82, 0x97, 0x3f, 0x0c, 0xb8, 0x7c, 0x64, 0x81, 0xa1, 0x95, 0x93, 0xd5, 0x1c, 0xb7, 0x7c, 0xcd,
+	0xd5, 0x33, 0x61, 0xb5, 0x91, 0x3b, 0xd2, 0xc8, 0x1a, 0xba, 0x5e, 0x6a, 0x24, 0xf7, 0x40, 0xd7,
+	0x9b, 0x8a, 0xa1, 0x70, 0x61, 0x7e, 0x33, 0xe0, 0xd2, 0xe1, 0x65, 0x83, 0x96, 0xbb, 0x9c, 0xfc,
+	0xf6, 0x26, 0x34, 0x57, 0xce, 0x02, 0xd5, 0x8e, 0x6e, 0x4b, 0x47, 0x37, 0xd0, 0x6a, 0x17, 0xd7,
+	0xa6, 0x8e, 0xa3, 0xa2, 0xa1, 0xef, 0x0d, 0x18, 0xc9, 0x2a, 0xe4, 0xde, 0x79, 0xb4, 0x70, 0xb2,
+	0xb2, 0xa3, 0x1b, 0xc3, 0xbc, 0xd6, 0x25, 0x4a, 0x5b, 0x99, 0x93, 0x56, 0x66, 0xd1, 0x4c, 0xa9,
+	0x95, 0x50, 0x22, 0xeb, 0x72, 0x7d, 0xac, 0x07, 0x4f, 0xf7, 0xab, 0xc6, 0xb3, 0xfd, 0xaa, 0xf1,
+	0xcf, 0x7e, 0xd5, 0xf8, 0xe6, 0xa0, 0xda, 0xf3, 0xec, 0xa0, 0xda, 0xf3, 0xd7, 0x41, 0xb5, 0xe7,
+	0xc1, 0x7d, 0x3f, 0x10, 0xcd, 0x56, 0xc3, 0x76, 0x59, 0xe8, 0x6c, 0xa6, 0x74, 0xf7, 0x70, 0x83,
+	0xb7, 0xc9, 0xdf, 0x72, 0x59, 0x4c, 0xf2, 0x5f, 0x9b, 0x38, 0xa0, 0x9a, 0x9f, 0xe7, 0x2a, 0x8b,
+	0xbd, 0x88, 0xf0, 0xc6, 0xa0, 0xfc, 0xa1, 0xf4, 0xce, 0x7f, 0x03, 0x00, 0x2a, 0x51, 0xb0, 0xfa,
+	0x48, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -667,6 +919,11 @@ type QueryClient interface {
 	EstimatedRedemptions(ctx context.Context, in *QueryEstimatedRedemptionsRequest, opts ...grpc.CallOption) (*QueryEstimatedRedemptionsResponse, error)
 	// Retrieves pending redemptions' share token at current price
 	PendingRedemptions(ctx context.Context, in *QueryPendingRedemptionsRequest, opts ...grpc.CallOption) (*QueryPendingRedemptionsResponse, error)
+	// Retrieves the share price history of an insurance fund
+	SharePriceHistory(ctx context.Context, in *QuerySharePriceHistoryRequest, opts ...grpc.CallOption) (*QuerySharePriceHistoryResponse, error)
+	// Retrieves an annualized yield estimate of an insurance fund based on its
+	// share price history
+	InsuranceFundApy(ctx context.Context, in *QueryInsuranceFundApyRequest, opts ...grpc.CallOption) (*QueryInsuranceFundApyResponse, error)
 	// Retrieves the entire insurance module's state
 	InsuranceModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 }
@@ -724,6 +981,24 @@ func (c *queryClient) PendingRedemptions(ctx context.Context, in *QueryPendingRe
 	return out, nil
 }
 
+func (c *queryClient) SharePriceHistory(ctx context.Context, in *QuerySharePriceHistoryRequest, opts ...grpc.CallOption) (*QuerySharePriceHistoryResponse, error) {
+	out := new(QuerySharePriceHistoryResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Query/SharePriceHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) InsuranceFundApy(ctx context.Context, in *QueryInsuranceFundApyRequest, opts ...grpc.CallOption) (*QueryInsuranceFundApyResponse, error) {
+	out := new(QueryInsuranceFundApyResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Query/InsuranceFundApy", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) InsuranceModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
 	out := new(QueryModuleStateResponse)
 	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Query/InsuranceModuleState", in, out, opts...)
@@ -746,6 +1021,11 @@ type QueryServer interface {
 	EstimatedRedemptions(context.Context, *QueryEstimatedRedemptionsRequest) (*QueryEstimatedRedemptionsResponse, error)
 	// Retrieves pending redemptions' share token at current price
 	PendingRedemptions(context.Context, *QueryPendingRedemptionsRequest) (*QueryPendingRedemptionsResponse, error)
+	// Retrieves the share price history of an insurance fund
+	SharePriceHistory(context.Context, *QuerySharePriceHistoryRequest) (*QuerySharePriceHistoryResponse, error)
+	// Retrieves an annualized yield estimate of an insurance fund based on its
+	// share price history
+	InsuranceFundApy(context.Context, *QueryInsuranceFundApyRequest) (*QueryInsuranceFundApyResponse, error)
 	// Retrieves the entire insurance module's state
 	InsuranceModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 }
@@ -769,6 +1049,12 @@ func (*UnimplementedQueryServer) EstimatedRedemptions(ctx context.Context, req *
 func (*UnimplementedQueryServer) PendingRedemptions(ctx context.Context, req *QueryPendingRedemptionsRequest) (*QueryPendingRedemptionsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PendingRedemptions not implemented")
 }
+func (*UnimplementedQueryServer) SharePriceHistory(ctx context.Context, req *QuerySharePriceHistoryRequest) (*QuerySharePriceHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SharePriceHistory not implemented")
+}
+func (*UnimplementedQueryServer) InsuranceFundApy(ctx context.Context, req *QueryInsuranceFundApyRequest) (*QueryInsuranceFundApyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method InsuranceFundApy not implemented")
+}
 func (*UnimplementedQueryServer) InsuranceModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method InsuranceModuleState not implemented")
 }
@@ -867,6 +1153,42 @@ func _Query_PendingRedemptions_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_SharePriceHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QuerySharePriceHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).SharePriceHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Query/SharePriceHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).SharePriceHistory(ctx, req.(*QuerySharePriceHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_InsuranceFundApy_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryInsuranceFundApyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).InsuranceFundApy(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Query/InsuranceFundApy",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).InsuranceFundApy(ctx, req.(*QueryInsuranceFundApyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_InsuranceModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleStateRequest)
 	if err := dec(in); err != nil {
@@ -909,6 +1231,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PendingRedemptions",
 			Handler:    _Query_PendingRedemptions_Handler,
 		},
+		{
+			MethodName: "SharePriceHistory",
+			Handler:    _Query_SharePriceHistory_Handler,
+		},
+		{
+			MethodName: "InsuranceFundApy",
+			Handler:    _Query_InsuranceFundApy_Handler,
+		},
 		{
 			MethodName: "InsuranceModuleState",
 			Handler:    _Query_InsuranceModuleState_Handler,
@@ -1247,7 +1577,7 @@ func (m *QueryPendingRedemptionsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
+func (m *QuerySharePriceHistoryRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1257,20 +1587,39 @@ func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySharePriceHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySharePriceHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
 	return len(dAtA) - i, nil
 }
 
-func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
+func (m *QuerySharePriceHistoryResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1280,19 +1629,19 @@ func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *QueryModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *QuerySharePriceHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *QuerySharePriceHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.State != nil {
+	if m.Pagination != nil {
 		{
-			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
 			if err != nil {
 				return 0, err
 			}
@@ -1300,54 +1649,224 @@ func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 			i = encodeVarintQuery(dAtA, i, uint64(size))
 		}
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Snapshots) > 0 {
+		for iNdEx := len(m.Snapshots) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Snapshots[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
 	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryInsuranceParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryInsuranceFundApyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryInsuranceParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryInsuranceFundApyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryInsuranceFundRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryInsuranceFundApyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MarketId)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.LookbackSeconds != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LookbackSeconds))
+		i--
+		dAtA[i] = 0x10
 	}
-	return n
-}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryInsuranceFundApyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryInsuranceFundApyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryInsuranceFundApyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EndTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.EndTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.StartTimestamp != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.StartTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.EndSharePrice.Size()
+		i -= size
+		if _, err := m.EndSharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.StartSharePrice.Size()
+		i -= size
+		if _, err := m.StartSharePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Apy.Size()
+		i -= size
+		if _, err := m.Apy.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryModuleStateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryModuleStateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryModuleStateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.State != nil {
+		{
+			size, err := m.State.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryInsuranceParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryInsuranceParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryInsuranceFundRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
 
 func (m *QueryInsuranceFundResponse) Size() (n int) {
 	if m == nil {
@@ -1450,6 +1969,79 @@ func (m *QueryPendingRedemptionsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QuerySharePriceHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QuerySharePriceHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Snapshots) > 0 {
+		for _, e := range m.Snapshots {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundApyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.LookbackSeconds != 0 {
+		n += 1 + sovQuery(uint64(m.LookbackSeconds))
+	}
+	return n
+}
+
+func (m *QueryInsuranceFundApyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Apy.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.StartSharePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.EndSharePrice.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.StartTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.StartTimestamp))
+	}
+	if m.EndTimestamp != 0 {
+		n += 1 + sovQuery(uint64(m.EndTimestamp))
+	}
+	return n
+}
+
 func (m *QueryModuleStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2309,6 +2901,535 @@ func (m *QueryPendingRedemptionsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QuerySharePriceHistoryRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySharePriceHistoryRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySharePriceHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QuerySharePriceHistoryResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QuerySharePriceHistoryResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QuerySharePriceHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Snapshots", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Snapshots = append(m.Snapshots, SharePriceSnapshot{})
+			if err := m.Snapshots[len(m.Snapshots)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundApyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundApyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundApyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LookbackSeconds", wireType)
+			}
+			m.LookbackSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LookbackSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryInsuranceFundApyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryInsuranceFundApyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryInsuranceFundApyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Apy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Apy.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartSharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.StartSharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndSharePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EndSharePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartTimestamp", wireType)
+			}
+			m.StartTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StartTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndTimestamp", wireType)
+			}
+			m.EndTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EndTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
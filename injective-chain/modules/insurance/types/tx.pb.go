@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 	_ "github.com/cosmos/cosmos-proto"
@@ -378,6 +379,277 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgCancelRedemption defines a message for cancelling a pending redemption of
+// the sender's insurance fund tokens
+type MsgCancelRedemption struct {
+	// Address of the underwriter that requested the redemption.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// MarketID of the insurance fund.
+	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ID of the redemption schedule to cancel.
+	RedemptionId uint64 `protobuf:"varint,3,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+}
+
+func (m *MsgCancelRedemption) Reset()         { *m = MsgCancelRedemption{} }
+func (m *MsgCancelRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemption) ProtoMessage()    {}
+func (*MsgCancelRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{8}
+}
+func (m *MsgCancelRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemption.Merge(m, src)
+}
+func (m *MsgCancelRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRedemption proto.InternalMessageInfo
+
+type MsgCancelRedemptionResponse struct {
+}
+
+func (m *MsgCancelRedemptionResponse) Reset()         { *m = MsgCancelRedemptionResponse{} }
+func (m *MsgCancelRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgCancelRedemptionResponse) ProtoMessage()    {}
+func (*MsgCancelRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{9}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgCancelRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgCancelRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgCancelRedemptionResponse.Merge(m, src)
+}
+func (m *MsgCancelRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgCancelRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgCancelRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgCancelRedemptionResponse proto.InternalMessageInfo
+
+// MsgEarlyRedemption defines a message for withdrawing a pending redemption
+// before its claimable time
+type MsgEarlyRedemption struct {
+	// Address of the underwriter that requested the redemption.
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// MarketID of the insurance fund.
+	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// ID of the redemption schedule to withdraw early.
+	RedemptionId uint64 `protobuf:"varint,3,opt,name=redemption_id,json=redemptionId,proto3" json:"redemption_id,omitempty"`
+}
+
+func (m *MsgEarlyRedemption) Reset()         { *m = MsgEarlyRedemption{} }
+func (m *MsgEarlyRedemption) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyRedemption) ProtoMessage()    {}
+func (*MsgEarlyRedemption) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{10}
+}
+func (m *MsgEarlyRedemption) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyRedemption) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyRedemption.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEarlyRedemption) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyRedemption.Merge(m, src)
+}
+func (m *MsgEarlyRedemption) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyRedemption) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyRedemption.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEarlyRedemption proto.InternalMessageInfo
+
+type MsgEarlyRedemptionResponse struct {
+	// redeem coin amount paid out after the penalty
+	RedeemCoin types1.Coin `protobuf:"bytes,1,opt,name=redeem_coin,json=redeemCoin,proto3" json:"redeem_coin"`
+	// penalty amount kept by the insurance fund
+	Penalty types1.Coin `protobuf:"bytes,2,opt,name=penalty,proto3" json:"penalty"`
+}
+
+func (m *MsgEarlyRedemptionResponse) Reset()         { *m = MsgEarlyRedemptionResponse{} }
+func (m *MsgEarlyRedemptionResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgEarlyRedemptionResponse) ProtoMessage()    {}
+func (*MsgEarlyRedemptionResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{11}
+}
+func (m *MsgEarlyRedemptionResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgEarlyRedemptionResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgEarlyRedemptionResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgEarlyRedemptionResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgEarlyRedemptionResponse.Merge(m, src)
+}
+func (m *MsgEarlyRedemptionResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgEarlyRedemptionResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgEarlyRedemptionResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgEarlyRedemptionResponse proto.InternalMessageInfo
+
+func (m *MsgEarlyRedemptionResponse) GetRedeemCoin() types1.Coin {
+	if m != nil {
+		return m.RedeemCoin
+	}
+	return types1.Coin{}
+}
+
+func (m *MsgEarlyRedemptionResponse) GetPenalty() types1.Coin {
+	if m != nil {
+		return m.Penalty
+	}
+	return types1.Coin{}
+}
+
+type MsgSetEarlyRedemptionPenalty struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// MarketID of the insurance fund.
+	MarketId string `protobuf:"bytes,2,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty"`
+	// penalty_rate is the share of the redeemed amount kept by the fund on early
+	// redemptions. Early redemptions are disabled if not set.
+	PenaltyRate *cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=penalty_rate,json=penaltyRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"penalty_rate,omitempty"`
+}
+
+func (m *MsgSetEarlyRedemptionPenalty) Reset()         { *m = MsgSetEarlyRedemptionPenalty{} }
+func (m *MsgSetEarlyRedemptionPenalty) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEarlyRedemptionPenalty) ProtoMessage()    {}
+func (*MsgSetEarlyRedemptionPenalty) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{12}
+}
+func (m *MsgSetEarlyRedemptionPenalty) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEarlyRedemptionPenalty) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEarlyRedemptionPenalty.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEarlyRedemptionPenalty) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEarlyRedemptionPenalty.Merge(m, src)
+}
+func (m *MsgSetEarlyRedemptionPenalty) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEarlyRedemptionPenalty) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEarlyRedemptionPenalty.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEarlyRedemptionPenalty proto.InternalMessageInfo
+
+func (m *MsgSetEarlyRedemptionPenalty) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgSetEarlyRedemptionPenalty) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+type MsgSetEarlyRedemptionPenaltyResponse struct {
+}
+
+func (m *MsgSetEarlyRedemptionPenaltyResponse) Reset()         { *m = MsgSetEarlyRedemptionPenaltyResponse{} }
+func (m *MsgSetEarlyRedemptionPenaltyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetEarlyRedemptionPenaltyResponse) ProtoMessage()    {}
+func (*MsgSetEarlyRedemptionPenaltyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7e1fa941c3fd0dc4, []int{13}
+}
+func (m *MsgSetEarlyRedemptionPenaltyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetEarlyRedemptionPenaltyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetEarlyRedemptionPenaltyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetEarlyRedemptionPenaltyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetEarlyRedemptionPenaltyResponse.Merge(m, src)
+}
+func (m *MsgSetEarlyRedemptionPenaltyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetEarlyRedemptionPenaltyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetEarlyRedemptionPenaltyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetEarlyRedemptionPenaltyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateInsuranceFund)(nil), "injective.insurance.v1beta1.MsgCreateInsuranceFund")
 	proto.RegisterType((*MsgCreateInsuranceFundResponse)(nil), "injective.insurance.v1beta1.MsgCreateInsuranceFundResponse")
@@ -387,6 +659,12 @@ func init() {
 	proto.RegisterType((*MsgRequestRedemptionResponse)(nil), "injective.insurance.v1beta1.MsgRequestRedemptionResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "injective.insurance.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.insurance.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCancelRedemption)(nil), "injective.insurance.v1beta1.MsgCancelRedemption")
+	proto.RegisterType((*MsgCancelRedemptionResponse)(nil), "injective.insurance.v1beta1.MsgCancelRedemptionResponse")
+	proto.RegisterType((*MsgEarlyRedemption)(nil), "injective.insurance.v1beta1.MsgEarlyRedemption")
+	proto.RegisterType((*MsgEarlyRedemptionResponse)(nil), "injective.insurance.v1beta1.MsgEarlyRedemptionResponse")
+	proto.RegisterType((*MsgSetEarlyRedemptionPenalty)(nil), "injective.insurance.v1beta1.MsgSetEarlyRedemptionPenalty")
+	proto.RegisterType((*MsgSetEarlyRedemptionPenaltyResponse)(nil), "injective.insurance.v1beta1.MsgSetEarlyRedemptionPenaltyResponse")
 }
 
 func init() {
@@ -394,56 +672,72 @@ func init() {
 }
 
 var fileDescriptor_7e1fa941c3fd0dc4 = []byte{
-	// 779 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0xe3, 0x46,
-	0x14, 0x8f, 0x1b, 0x08, 0x30, 0x50, 0x10, 0x2e, 0x25, 0x4e, 0xa8, 0x9c, 0x34, 0xa5, 0x52, 0x94,
-	0x82, 0xad, 0x84, 0x8a, 0x96, 0xf4, 0x44, 0xa0, 0x55, 0x91, 0x1a, 0xd1, 0xa6, 0xed, 0xa5, 0x97,
-	0x68, 0x62, 0x8f, 0xcc, 0x94, 0xd8, 0x63, 0x3c, 0x63, 0x96, 0xdc, 0x76, 0xf7, 0xb2, 0xab, 0x3d,
-	0xf1, 0x11, 0xf8, 0x08, 0x39, 0xec, 0x69, 0xb5, 0x1f, 0x80, 0x23, 0xda, 0xd3, 0x9e, 0x56, 0x2b,
-	0x38, 0xb0, 0x1f, 0x62, 0x0f, 0x2b, 0x7b, 0xc6, 0x76, 0xb2, 0x84, 0xbf, 0x17, 0xf0, 0xfb, 0xbd,
-	0xdf, 0x7b, 0xef, 0xf7, 0xf3, 0x9b, 0x8c, 0xc1, 0x32, 0x76, 0xfe, 0x47, 0x06, 0xc3, 0x87, 0x48,
-	0xc7, 0x0e, 0xf5, 0x3d, 0xe8, 0x18, 0x48, 0x3f, 0xac, 0x76, 0x10, 0x83, 0x55, 0x9d, 0x1d, 0x69,
-	0xae, 0x47, 0x18, 0x91, 0x97, 0x62, 0x96, 0x16, 0xb3, 0x34, 0xc1, 0xca, 0x2f, 0x58, 0xc4, 0x22,
-	0x21, 0x4f, 0x0f, 0x9e, 0x78, 0x49, 0x5e, 0x35, 0x08, 0xb5, 0x09, 0xd5, 0x3b, 0x90, 0x26, 0x0d,
-	0x0d, 0x82, 0x1d, 0x91, 0xcf, 0x8a, 0xbc, 0x4d, 0x2d, 0xfd, 0xb0, 0x1a, 0xfc, 0x13, 0x89, 0x1c,
-	0x4f, 0xb4, 0x79, 0x47, 0x1e, 0x88, 0xd4, 0x0f, 0x37, 0x89, 0x4d, 0x84, 0x71, 0xf2, 0xf7, 0x09,
-	0x99, 0x78, 0xd0, 0xe8, 0x26, 0x4c, 0x1e, 0x0a, 0xda, 0x3c, 0xb4, 0xb1, 0x43, 0xf4, 0xf0, 0x2f,
-	0x87, 0x4a, 0xc7, 0x69, 0xb0, 0xd8, 0xa4, 0xd6, 0x96, 0x87, 0x20, 0x43, 0x3b, 0x51, 0xdb, 0xdf,
-	0x7c, 0xc7, 0x94, 0x17, 0x41, 0x86, 0x22, 0xc7, 0x44, 0x9e, 0x22, 0x15, 0xa5, 0xf2, 0x54, 0x4b,
-	0x44, 0x01, 0xce, 0xb0, 0xb1, 0x8f, 0x3c, 0xe5, 0x0b, 0x8e, 0xf3, 0x48, 0x2e, 0x80, 0xe9, 0x03,
-	0x9f, 0x30, 0xd4, 0x36, 0x91, 0x43, 0x6c, 0x25, 0x1d, 0x26, 0x41, 0x08, 0x6d, 0x07, 0x48, 0x40,
-	0xe0, 0x72, 0xda, 0xc1, 0x8b, 0x52, 0xc6, 0x38, 0x81, 0x43, 0x0d, 0x48, 0x91, 0xfc, 0x2d, 0x98,
-	0x11, 0x84, 0xb0, 0x4a, 0x19, 0x0f, 0x19, 0xa2, 0xe8, 0xaf, 0x00, 0x92, 0x7f, 0x8d, 0x7b, 0xb0,
-	0x9e, 0x8b, 0x94, 0x4c, 0x51, 0x2a, 0xcf, 0xd6, 0x96, 0xb5, 0x64, 0x67, 0xc2, 0xb0, 0xf0, 0xaf,
-	0xed, 0x86, 0xe1, 0x3f, 0x3d, 0x17, 0x45, 0x93, 0x82, 0xe7, 0xc0, 0x03, 0x3a, 0x72, 0xb1, 0xd7,
-	0x53, 0x26, 0x8a, 0x52, 0x39, 0xdd, 0x12, 0x91, 0xfc, 0x3b, 0x98, 0xc3, 0x0e, 0x66, 0x18, 0x76,
-	0xdb, 0x26, 0x72, 0x09, 0xc5, 0x4c, 0x99, 0x2c, 0x4a, 0xe5, 0xe9, 0x5a, 0x4e, 0x13, 0xdb, 0x09,
-	0xa4, 0xc7, 0xdd, 0xb7, 0x08, 0x76, 0x1a, 0x63, 0xa7, 0xef, 0x0a, 0xa9, 0xd6, 0xac, 0xa8, 0xdb,
-	0xe6, 0x65, 0xf5, 0x9f, 0x9f, 0x9f, 0x14, 0x52, 0x1f, 0x4e, 0x0a, 0xa9, 0xa7, 0x97, 0xfd, 0x8a,
-	0x78, 0x75, 0x2f, 0x2e, 0xfb, 0x95, 0x62, 0xb2, 0xcd, 0xd1, 0xef, 0xbd, 0x54, 0x04, 0xea, 0xe8,
-	0x4c, 0x0b, 0x51, 0x97, 0x38, 0x14, 0x95, 0xfa, 0x12, 0xf8, 0xb2, 0x49, 0xad, 0x7f, 0x83, 0x9e,
-	0x8f, 0x3c, 0xcc, 0xd0, 0xb5, 0xbb, 0x5a, 0x02, 0x53, 0x36, 0xf4, 0xf6, 0x11, 0x6b, 0x63, 0x53,
-	0xac, 0x6b, 0x92, 0x03, 0x3b, 0xa6, 0xbc, 0x01, 0x26, 0x22, 0x93, 0xe9, 0xbb, 0x99, 0x8c, 0xf8,
-	0x75, 0xfd, 0x1a, 0x77, 0xd9, 0x21, 0x77, 0x89, 0xc0, 0x52, 0x16, 0x7c, 0x3d, 0x04, 0xc4, 0x5e,
-	0x5e, 0x4b, 0x60, 0xa1, 0x49, 0xad, 0x16, 0x3a, 0xf0, 0x11, 0x65, 0x2d, 0x64, 0x22, 0xdb, 0x65,
-	0x98, 0x38, 0x0f, 0xb3, 0xf4, 0x13, 0xc8, 0x40, 0x9b, 0xf8, 0xce, 0x9d, 0x1d, 0x09, 0x7a, 0x7d,
-	0xfd, 0x1a, 0x43, 0xea, 0x90, 0xa1, 0x2b, 0x2a, 0x4b, 0x2a, 0xf8, 0x66, 0x14, 0x1e, 0xdb, 0x7b,
-	0x25, 0x81, 0xb9, 0xc0, 0xb8, 0x6b, 0x42, 0x86, 0xfe, 0x84, 0x1e, 0xb4, 0xa9, 0xbc, 0x0e, 0xa6,
-	0xa0, 0xcf, 0xf6, 0x88, 0x87, 0x59, 0x8f, 0x9b, 0x6b, 0x28, 0x6f, 0x5e, 0xae, 0x2e, 0x08, 0xa9,
-	0x9b, 0xa6, 0xe9, 0x21, 0x4a, 0xff, 0x66, 0x1e, 0x76, 0xac, 0x56, 0x42, 0x95, 0x37, 0x41, 0xc6,
-	0x0d, 0x3b, 0x84, 0xb6, 0xa7, 0x6b, 0xdf, 0x69, 0x37, 0x5c, 0x55, 0x1a, 0x1f, 0x16, 0xd9, 0xe4,
-	0x85, 0xf5, 0x95, 0xc0, 0x5e, 0xd2, 0x32, 0x70, 0x98, 0x1b, 0x5e, 0xd9, 0x80, 0xd0, 0x52, 0x0e,
-	0x64, 0x3f, 0x83, 0x22, 0x5f, 0xb5, 0x8f, 0x69, 0x90, 0x6e, 0x52, 0x4b, 0x7e, 0x26, 0x81, 0xaf,
-	0x46, 0x5d, 0x1e, 0x6b, 0x37, 0x6a, 0x1b, 0x7d, 0xbe, 0xf3, 0xbf, 0x3c, 0xa0, 0x28, 0x52, 0x24,
-	0x77, 0x01, 0x18, 0xf8, 0x41, 0x54, 0x6e, 0x6b, 0x95, 0x70, 0xf3, 0xb5, 0xbb, 0x73, 0xe3, 0x69,
-	0x4f, 0x24, 0x30, 0x7f, 0xf5, 0xcc, 0x56, 0x6f, 0xeb, 0x74, 0xa5, 0x24, 0xbf, 0x71, 0xef, 0x92,
-	0x58, 0x83, 0x07, 0x66, 0x86, 0xce, 0xd5, 0xca, 0xad, 0x3e, 0x06, 0xd8, 0xf9, 0x1f, 0xef, 0xc3,
-	0x8e, 0x66, 0xe6, 0xc7, 0x1f, 0x5f, 0xf6, 0x2b, 0x52, 0x03, 0x9f, 0x9e, 0xab, 0xd2, 0xd9, 0xb9,
-	0x2a, 0xbd, 0x3f, 0x57, 0xa5, 0xe3, 0x0b, 0x35, 0x75, 0x76, 0xa1, 0xa6, 0xde, 0x5e, 0xa8, 0xa9,
-	0xff, 0x76, 0x2d, 0xcc, 0xf6, 0xfc, 0x8e, 0x66, 0x10, 0x5b, 0xdf, 0x89, 0x06, 0xfc, 0x01, 0x3b,
-	0x54, 0x8f, 0xc7, 0xad, 0x1a, 0xc4, 0x43, 0x83, 0xe1, 0x1e, 0xc4, 0x8e, 0x6e, 0x13, 0xd3, 0xef,
-	0x22, 0x3a, 0xf0, 0xb5, 0x0b, 0x6e, 0x78, 0xda, 0xc9, 0x84, 0x1f, 0xaa, 0xb5, 0x4f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x5f, 0x13, 0xc4, 0xc0, 0xbe, 0x07, 0x00, 0x00,
+	// 1027 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x56, 0x4f, 0x4f, 0xdc, 0xc6,
+	0x1b, 0xde, 0x09, 0x04, 0xc2, 0x40, 0xc2, 0x2f, 0x0e, 0xbf, 0x60, 0x4c, 0xe2, 0xdd, 0x1a, 0x1a,
+	0x21, 0x9a, 0xd8, 0x85, 0xa4, 0x49, 0xd8, 0x5e, 0x0a, 0x21, 0x51, 0x91, 0x82, 0x92, 0x3a, 0xed,
+	0xa5, 0x97, 0xd5, 0x60, 0x8f, 0xcc, 0x94, 0xb5, 0xc7, 0xf1, 0xcc, 0xd2, 0xac, 0x2a, 0x55, 0x6d,
+	0x2f, 0xad, 0x7a, 0xca, 0xa5, 0x87, 0xdc, 0x72, 0xa8, 0x7a, 0xe6, 0xd0, 0x53, 0xd5, 0x0f, 0xc0,
+	0x31, 0xea, 0xa9, 0xea, 0x01, 0x55, 0x70, 0xa0, 0x9f, 0xa0, 0xe7, 0x6a, 0x3c, 0x63, 0x9b, 0xfd,
+	0xc7, 0x02, 0x95, 0x7a, 0x81, 0x9d, 0x67, 0x9e, 0xe7, 0x7d, 0xdf, 0x67, 0xde, 0xf9, 0x63, 0x38,
+	0x4b, 0xa2, 0xcf, 0xb0, 0xc7, 0xc9, 0x36, 0x76, 0x48, 0xc4, 0x1a, 0x09, 0x8a, 0x3c, 0xec, 0x6c,
+	0x2f, 0x6c, 0x60, 0x8e, 0x16, 0x1c, 0xfe, 0xc2, 0x8e, 0x13, 0xca, 0xa9, 0x36, 0x9d, 0xb3, 0xec,
+	0x9c, 0x65, 0x2b, 0x96, 0x31, 0x11, 0xd0, 0x80, 0xa6, 0x3c, 0x47, 0xfc, 0x92, 0x12, 0xc3, 0xf4,
+	0x28, 0x0b, 0x29, 0x73, 0x36, 0x10, 0x2b, 0x02, 0x7a, 0x94, 0x44, 0x6a, 0x7e, 0x52, 0xcd, 0x87,
+	0x2c, 0x70, 0xb6, 0x17, 0xc4, 0x3f, 0x35, 0x31, 0x25, 0x27, 0x6a, 0x32, 0xa2, 0x1c, 0xa8, 0xa9,
+	0x77, 0x8e, 0x2b, 0xb6, 0x28, 0x4c, 0x92, 0xdf, 0x2e, 0xc8, 0x34, 0x41, 0x5e, 0xbd, 0x60, 0xca,
+	0xa1, 0xa2, 0x5d, 0x46, 0x21, 0x89, 0xa8, 0x93, 0xfe, 0x95, 0x90, 0xf5, 0x72, 0x00, 0x5e, 0x5d,
+	0x67, 0xc1, 0x83, 0x04, 0x23, 0x8e, 0xd7, 0xb2, 0xb0, 0x8f, 0x1a, 0x91, 0xaf, 0x5d, 0x85, 0x43,
+	0x0c, 0x47, 0x3e, 0x4e, 0x74, 0x50, 0x01, 0x73, 0x23, 0xae, 0x1a, 0x09, 0x9c, 0x13, 0x6f, 0x0b,
+	0x27, 0xfa, 0x39, 0x89, 0xcb, 0x91, 0x56, 0x86, 0xa3, 0xcf, 0x1b, 0x94, 0xe3, 0x9a, 0x8f, 0x23,
+	0x1a, 0xea, 0x03, 0xe9, 0x24, 0x4c, 0xa1, 0x55, 0x81, 0x08, 0x82, 0x2c, 0xa7, 0x26, 0x16, 0x4a,
+	0x1f, 0x94, 0x04, 0x09, 0xad, 0x20, 0x86, 0xb5, 0xb7, 0xe0, 0x98, 0x22, 0xa4, 0x2a, 0xfd, 0x7c,
+	0xca, 0x50, 0xa2, 0x8f, 0x04, 0xa4, 0x3d, 0xcc, 0x63, 0xf0, 0x66, 0x8c, 0xf5, 0xa1, 0x0a, 0x98,
+	0xbb, 0xb4, 0x38, 0x6b, 0x17, 0x3d, 0x53, 0x86, 0x95, 0x7f, 0xfb, 0x49, 0x3a, 0xfc, 0xb8, 0x19,
+	0xe3, 0x2c, 0x93, 0xf8, 0x2d, 0x3c, 0xe0, 0x17, 0x31, 0x49, 0x9a, 0xfa, 0x70, 0x05, 0xcc, 0x0d,
+	0xb8, 0x6a, 0xa4, 0x7d, 0x08, 0xc7, 0x49, 0x44, 0x38, 0x41, 0xf5, 0x9a, 0x8f, 0x63, 0xca, 0x08,
+	0xd7, 0x2f, 0x54, 0xc0, 0xdc, 0xe8, 0xe2, 0x94, 0xad, 0xba, 0x23, 0x4a, 0xcf, 0xa3, 0x3f, 0xa0,
+	0x24, 0x5a, 0x19, 0xdc, 0xdd, 0x2b, 0x97, 0xdc, 0x4b, 0x4a, 0xb7, 0x2a, 0x65, 0xd5, 0xfb, 0xdf,
+	0xbd, 0x2e, 0x97, 0xfe, 0x7a, 0x5d, 0x2e, 0x7d, 0x73, 0xb8, 0x33, 0xaf, 0x96, 0xee, 0xfb, 0xc3,
+	0x9d, 0xf9, 0x4a, 0xd1, 0xcd, 0xee, 0xeb, 0x6e, 0x55, 0xa0, 0xd9, 0x7d, 0xc6, 0xc5, 0x2c, 0xa6,
+	0x11, 0xc3, 0xd6, 0x0e, 0x80, 0x17, 0xd7, 0x59, 0xf0, 0x89, 0x88, 0xf9, 0x79, 0x42, 0x38, 0xee,
+	0xd9, 0xab, 0x69, 0x38, 0x12, 0xa2, 0x64, 0x0b, 0xf3, 0x1a, 0xf1, 0x55, 0xbb, 0x2e, 0x48, 0x60,
+	0xcd, 0xd7, 0x96, 0xe0, 0x70, 0x66, 0x72, 0xe0, 0x64, 0x26, 0x33, 0x7e, 0xd5, 0xe9, 0xe1, 0x6e,
+	0xb2, 0xc5, 0x5d, 0x51, 0xa0, 0x35, 0x09, 0xff, 0xdf, 0x02, 0xe4, 0x5e, 0x7e, 0x05, 0x70, 0x62,
+	0x9d, 0x05, 0x2e, 0x7e, 0xde, 0xc0, 0x8c, 0xbb, 0xd8, 0xc7, 0x61, 0xcc, 0x09, 0x8d, 0xce, 0x66,
+	0xe9, 0x1e, 0x1c, 0x42, 0x21, 0x6d, 0x44, 0x27, 0x76, 0xa4, 0xe8, 0xd5, 0xbb, 0x3d, 0x0c, 0x99,
+	0x2d, 0x86, 0x3a, 0xaa, 0xb4, 0x4c, 0x78, 0xad, 0x1b, 0x9e, 0xdb, 0xfb, 0x05, 0xc0, 0x71, 0x61,
+	0x3c, 0xf6, 0x11, 0xc7, 0x4f, 0x51, 0x82, 0x42, 0xa6, 0xdd, 0x85, 0x23, 0xa8, 0xc1, 0x37, 0x69,
+	0x42, 0x78, 0x53, 0x9a, 0x5b, 0xd1, 0x7f, 0xfb, 0xf9, 0xd6, 0x84, 0x2a, 0x75, 0xd9, 0xf7, 0x13,
+	0xcc, 0xd8, 0x33, 0x9e, 0x90, 0x28, 0x70, 0x0b, 0xaa, 0xb6, 0x0c, 0x87, 0xe2, 0x34, 0x42, 0x6a,
+	0x7b, 0x74, 0x71, 0xc6, 0x3e, 0xe6, 0xaa, 0xb2, 0x65, 0xb2, 0xcc, 0xa6, 0x14, 0x56, 0x6f, 0x0a,
+	0x7b, 0x45, 0x48, 0xe1, 0x70, 0xaa, 0xb5, 0x65, 0x47, 0x0a, 0xb5, 0xa6, 0xe0, 0x64, 0x1b, 0x94,
+	0xfb, 0xfa, 0x09, 0xc0, 0x2b, 0x62, 0x97, 0x0a, 0x5d, 0xfd, 0xdf, 0x76, 0x6d, 0x06, 0x5e, 0x4c,
+	0xf2, 0x10, 0x82, 0x20, 0x9a, 0x37, 0xe8, 0x8e, 0x15, 0xe0, 0x9a, 0x5f, 0x7d, 0xaf, 0x47, 0x87,
+	0xae, 0xb7, 0x1e, 0xa8, 0xb6, 0x82, 0xac, 0xeb, 0x70, 0xba, 0x0b, 0x9c, 0xfb, 0xf8, 0x11, 0x40,
+	0x6d, 0x9d, 0x05, 0x0f, 0x51, 0x52, 0x6f, 0xfe, 0x27, 0x36, 0xee, 0xf4, 0xb0, 0x71, 0xad, 0xc5,
+	0x46, 0x5b, 0x3d, 0xd6, 0x2b, 0x00, 0x8d, 0x4e, 0x38, 0x73, 0xa1, 0x7d, 0x00, 0x47, 0x45, 0x12,
+	0x1c, 0xd6, 0xc4, 0xab, 0xa3, 0x83, 0x93, 0xed, 0x7d, 0x28, 0x35, 0x02, 0x11, 0x77, 0x41, 0x8c,
+	0x23, 0x54, 0xe7, 0x4d, 0xfd, 0xdc, 0xc9, 0xd4, 0x19, 0xdf, 0xfa, 0x1b, 0xa4, 0x67, 0xe0, 0x19,
+	0xe6, 0x6d, 0xe5, 0x3d, 0x95, 0x84, 0x33, 0xef, 0xf7, 0x63, 0x17, 0xfb, 0x11, 0x1c, 0x53, 0x05,
+	0xd4, 0x12, 0xc4, 0xb1, 0x7c, 0x6e, 0x56, 0x66, 0x76, 0xf7, 0xca, 0xe0, 0x8f, 0xbd, 0xf2, 0xb4,
+	0x8c, 0xcd, 0xfc, 0x2d, 0x9b, 0x50, 0x27, 0x44, 0x7c, 0xd3, 0x7e, 0x8c, 0x03, 0xe4, 0x35, 0x57,
+	0xb1, 0xe7, 0x8e, 0x2a, 0xa1, 0x8b, 0x38, 0xae, 0x2e, 0x75, 0x9e, 0x88, 0x1b, 0x2d, 0xad, 0xe8,
+	0xe9, 0xcb, 0xba, 0x01, 0x67, 0x8f, 0x9b, 0xcf, 0xba, 0xb3, 0xf8, 0xc3, 0x30, 0x1c, 0x58, 0x67,
+	0x81, 0xf6, 0x2d, 0x80, 0x57, 0xba, 0x3d, 0xb4, 0xb7, 0x8f, 0x3d, 0xc7, 0xdd, 0xdf, 0x02, 0xe3,
+	0xfd, 0x33, 0x88, 0xf2, 0xfd, 0x52, 0x87, 0xf0, 0xc8, 0xe3, 0x31, 0xdf, 0x2f, 0x54, 0xc1, 0x35,
+	0x16, 0x4f, 0xce, 0xcd, 0xb3, 0x7d, 0x0d, 0xe0, 0xe5, 0xce, 0xfb, 0x7d, 0xa1, 0x5f, 0xa4, 0x0e,
+	0x89, 0xb1, 0x74, 0x6a, 0x49, 0x5e, 0xc3, 0x97, 0xf0, 0x7f, 0x1d, 0x77, 0xd5, 0xbb, 0x7d, 0x97,
+	0xb0, 0x4d, 0x61, 0xdc, 0x3f, 0xad, 0x22, 0xcf, 0xff, 0x05, 0x1c, 0x6f, 0xbf, 0x63, 0x9c, 0x7e,
+	0xc1, 0xda, 0x04, 0xc6, 0xbd, 0x53, 0x0a, 0xf2, 0xe4, 0xaf, 0x00, 0x9c, 0xea, 0x7d, 0x3c, 0xfb,
+	0xae, 0x6a, 0x4f, 0xa9, 0xb1, 0x7c, 0x66, 0x69, 0x5e, 0x5b, 0x02, 0xc7, 0x5a, 0x1e, 0xc7, 0x9b,
+	0x7d, 0x37, 0xd8, 0x11, 0xb6, 0x71, 0xe7, 0x34, 0xec, 0x2c, 0xa7, 0x71, 0xfe, 0xab, 0xc3, 0x9d,
+	0x79, 0xb0, 0x42, 0x76, 0xf7, 0x4d, 0xf0, 0x66, 0xdf, 0x04, 0x7f, 0xee, 0x9b, 0xe0, 0xe5, 0x81,
+	0x59, 0x7a, 0x73, 0x60, 0x96, 0x7e, 0x3f, 0x30, 0x4b, 0x9f, 0x3e, 0x09, 0x08, 0xdf, 0x6c, 0x6c,
+	0xd8, 0x1e, 0x0d, 0x9d, 0xb5, 0x2c, 0xc1, 0x63, 0xb4, 0xc1, 0x9c, 0x3c, 0xdd, 0x2d, 0x8f, 0x26,
+	0xf8, 0xe8, 0x70, 0x13, 0x91, 0xc8, 0x09, 0xa9, 0xdf, 0xa8, 0x63, 0x76, 0xe4, 0x93, 0x5d, 0x7c,
+	0xa6, 0xb2, 0x8d, 0xa1, 0xf4, 0x6b, 0xfb, 0xf6, 0x3f, 0x03, 0x00, 0x49, 0xbd, 0x8d, 0x5f, 0x83,
+	0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -466,6 +760,15 @@ type MsgClient interface {
 	// RequestRedemption defines a method for requesting a redemption of the
 	// sender's insurance fund tokens
 	RequestRedemption(ctx context.Context, in *MsgRequestRedemption, opts ...grpc.CallOption) (*MsgRequestRedemptionResponse, error)
+	// CancelRedemption defines a method for cancelling a pending redemption and
+	// returning the locked insurance fund tokens to the sender
+	CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error)
+	// EarlyRedemption defines a method for withdrawing a pending redemption
+	// before its claimable time against the fund's early redemption penalty
+	EarlyRedemption(ctx context.Context, in *MsgEarlyRedemption, opts ...grpc.CallOption) (*MsgEarlyRedemptionResponse, error)
+	// SetEarlyRedemptionPenalty defines a governance operation for enabling,
+	// updating or disabling early redemptions of an insurance fund
+	SetEarlyRedemptionPenalty(ctx context.Context, in *MsgSetEarlyRedemptionPenalty, opts ...grpc.CallOption) (*MsgSetEarlyRedemptionPenaltyResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
@@ -504,6 +807,33 @@ func (c *msgClient) RequestRedemption(ctx context.Context, in *MsgRequestRedempt
 	return out, nil
 }
 
+func (c *msgClient) CancelRedemption(ctx context.Context, in *MsgCancelRedemption, opts ...grpc.CallOption) (*MsgCancelRedemptionResponse, error) {
+	out := new(MsgCancelRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Msg/CancelRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) EarlyRedemption(ctx context.Context, in *MsgEarlyRedemption, opts ...grpc.CallOption) (*MsgEarlyRedemptionResponse, error) {
+	out := new(MsgEarlyRedemptionResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Msg/EarlyRedemption", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetEarlyRedemptionPenalty(ctx context.Context, in *MsgSetEarlyRedemptionPenalty, opts ...grpc.CallOption) (*MsgSetEarlyRedemptionPenaltyResponse, error) {
+	out := new(MsgSetEarlyRedemptionPenaltyResponse)
+	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Msg/SetEarlyRedemptionPenalty", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/injective.insurance.v1beta1.Msg/UpdateParams", in, out, opts...)
@@ -523,6 +853,15 @@ type MsgServer interface {
 	// RequestRedemption defines a method for requesting a redemption of the
 	// sender's insurance fund tokens
 	RequestRedemption(context.Context, *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error)
+	// CancelRedemption defines a method for cancelling a pending redemption and
+	// returning the locked insurance fund tokens to the sender
+	CancelRedemption(context.Context, *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error)
+	// EarlyRedemption defines a method for withdrawing a pending redemption
+	// before its claimable time against the fund's early redemption penalty
+	EarlyRedemption(context.Context, *MsgEarlyRedemption) (*MsgEarlyRedemptionResponse, error)
+	// SetEarlyRedemptionPenalty defines a governance operation for enabling,
+	// updating or disabling early redemptions of an insurance fund
+	SetEarlyRedemptionPenalty(context.Context, *MsgSetEarlyRedemptionPenalty) (*MsgSetEarlyRedemptionPenaltyResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
@@ -539,6 +878,15 @@ func (*UnimplementedMsgServer) Underwrite(ctx context.Context, req *MsgUnderwrit
 func (*UnimplementedMsgServer) RequestRedemption(ctx context.Context, req *MsgRequestRedemption) (*MsgRequestRedemptionResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RequestRedemption not implemented")
 }
+func (*UnimplementedMsgServer) CancelRedemption(ctx context.Context, req *MsgCancelRedemption) (*MsgCancelRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelRedemption not implemented")
+}
+func (*UnimplementedMsgServer) EarlyRedemption(ctx context.Context, req *MsgEarlyRedemption) (*MsgEarlyRedemptionResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EarlyRedemption not implemented")
+}
+func (*UnimplementedMsgServer) SetEarlyRedemptionPenalty(ctx context.Context, req *MsgSetEarlyRedemptionPenalty) (*MsgSetEarlyRedemptionPenaltyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetEarlyRedemptionPenalty not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -601,6 +949,60 @@ func _Msg_RequestRedemption_Handler(srv interface{}, ctx context.Context, dec fu
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_CancelRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).CancelRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Msg/CancelRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelRedemption(ctx, req.(*MsgCancelRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_EarlyRedemption_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgEarlyRedemption)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).EarlyRedemption(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Msg/EarlyRedemption",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).EarlyRedemption(ctx, req.(*MsgEarlyRedemption))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetEarlyRedemptionPenalty_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetEarlyRedemptionPenalty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetEarlyRedemptionPenalty(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.insurance.v1beta1.Msg/SetEarlyRedemptionPenalty",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetEarlyRedemptionPenalty(ctx, req.(*MsgSetEarlyRedemptionPenalty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(MsgUpdateParams)
 	if err := dec(in); err != nil {
@@ -635,6 +1037,18 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RequestRedemption",
 			Handler:    _Msg_RequestRedemption_Handler,
 		},
+		{
+			MethodName: "CancelRedemption",
+			Handler:    _Msg_CancelRedemption_Handler,
+		},
+		{
+			MethodName: "EarlyRedemption",
+			Handler:    _Msg_EarlyRedemption_Handler,
+		},
+		{
+			MethodName: "SetEarlyRedemptionPenalty",
+			Handler:    _Msg_SetEarlyRedemptionPenalty_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -948,32 +1362,254 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgCancelRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgCreateInsuranceFund) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgCancelRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Ticker)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x18
 	}
-	l = len(m.QuoteDenom)
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCancelRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCancelRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyRedemption) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyRedemption) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyRedemption) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RedemptionId != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.RedemptionId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgEarlyRedemptionResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgEarlyRedemptionResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgEarlyRedemptionResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Penalty.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RedeemCoin.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEarlyRedemptionPenalty) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEarlyRedemptionPenalty) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEarlyRedemptionPenalty) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PenaltyRate != nil {
+		{
+			size := m.PenaltyRate.Size()
+			i -= size
+			if _, err := m.PenaltyRate.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetEarlyRedemptionPenaltyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetEarlyRedemptionPenaltyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetEarlyRedemptionPenaltyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgCreateInsuranceFund) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Ticker)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.QuoteDenom)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
@@ -1085,13 +1721,436 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
+func (m *MsgCancelRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	return n
 }
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+
+func (m *MsgCancelRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgEarlyRedemption) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.RedemptionId != 0 {
+		n += 1 + sovTx(uint64(m.RedemptionId))
+	}
+	return n
+}
+
+func (m *MsgEarlyRedemptionResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RedeemCoin.Size()
+	n += 1 + l + sovTx(uint64(l))
+	l = m.Penalty.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetEarlyRedemptionPenalty) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.PenaltyRate != nil {
+		l = m.PenaltyRate.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSetEarlyRedemptionPenaltyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFund: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Ticker = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			m.Expiry = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Expiry |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.InitialDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
+func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1114,10 +2173,10 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFund: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUnderwrite: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFund: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUnderwrite: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1154,7 +2213,7 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Ticker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1182,11 +2241,144 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Ticker = string(dAtA[iNdEx:postIndex])
+			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field QuoteDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUnderwriteResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUnderwriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1214,11 +2406,11 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.QuoteDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1243,14 +2435,147 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleBase = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1278,49 +2603,11 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
-			}
-			m.OracleType = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OracleType |= types.OracleType(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
-			}
-			m.Expiry = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Expiry |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field InitialDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1347,7 +2634,7 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.InitialDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1372,7 +2659,7 @@ func (m *MsgCreateInsuranceFund) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1395,10 +2682,10 @@ func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateInsuranceFundResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1422,7 +2709,7 @@ func (m *MsgCreateInsuranceFundResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRedemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1445,10 +2732,10 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnderwrite: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRedemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnderwrite: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1516,10 +2803,10 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
-			var msglen int
+			m.RedemptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1529,25 +2816,11 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RedemptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1569,7 +2842,7 @@ func (m *MsgUnderwrite) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1592,10 +2865,10 @@ func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUnderwriteResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUnderwriteResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1619,7 +2892,7 @@ func (m *MsgUnderwriteResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
+func (m *MsgEarlyRedemption) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1642,10 +2915,10 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRedemption: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEarlyRedemption: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEarlyRedemption: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1713,10 +2986,10 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 			m.MarketId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedemptionId", wireType)
 			}
-			var msglen int
+			m.RedemptionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1726,25 +2999,11 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.RedemptionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1766,7 +3025,7 @@ func (m *MsgRequestRedemption) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgEarlyRedemptionResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1789,12 +3048,78 @@ func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestRedemptionResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgEarlyRedemptionResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgEarlyRedemptionResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RedeemCoin", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RedeemCoin.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Penalty", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Penalty.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1816,7 +3141,7 @@ func (m *MsgRequestRedemptionResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEarlyRedemptionPenalty) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1839,10 +3164,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEarlyRedemptionPenalty: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEarlyRedemptionPenalty: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1879,9 +3204,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1891,22 +3216,57 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PenaltyRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.PenaltyRate = &v
+			if err := m.PenaltyRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1931,7 +3291,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetEarlyRedemptionPenaltyResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1954,10 +3314,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetEarlyRedemptionPenaltyResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetEarlyRedemptionPenaltyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
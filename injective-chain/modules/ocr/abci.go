@@ -26,4 +26,8 @@ func (am AppModule) BeginBlocker(ctx sdk.Context, k keeper.Keeper) {
 
 // EndBlocker runs on every end block
 func (am AppModule) EndBlocker(ctx sdk.Context, k keeper.Keeper) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, am.svcTags)
+	defer doneFn()
+
+	k.MarkStaleFeeds(ctx)
 }
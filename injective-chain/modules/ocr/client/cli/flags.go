@@ -19,4 +19,6 @@ const (
 	FlagFeedConfigDescription = "feed-config-description"
 	FlagFeedAdmin             = "feed-admin"
 	FlagBillingAdmin          = "billing-admin"
+	FlagHeartbeat             = "heartbeat"
+	FlagDeviationThreshold    = "deviation-threshold"
 )
@@ -26,6 +26,7 @@ func GetQueryCmd() *cobra.Command {
 		GetLatestRoundCmd(),
 		GetLatestTransmissionDetailsCmd(),
 		GetOwedAmountCmd(),
+		GetFeedHealthCmd(),
 		GetOcrModuleStateCmd(),
 	)
 	return cmd
@@ -203,6 +204,35 @@ func GetOwedAmountCmd() *cobra.Command {
 	return cmd
 }
 
+// GetFeedHealthCmd queries feed health by feed id
+func GetFeedHealthCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "feed-health [feed_id]",
+		Short: "Gets ocr feed health",
+		Long:  "Gets ocr feed health: last transmission age, missed rounds and transmitter participation rates. If the height is not provided, it will use the latest height from context.",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeedHealthRequest{
+				FeedId: args[0],
+			}
+			res, err := queryClient.FeedHealth(context.Background(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // GetOcrModuleStateCmd queries ocr module state
 func GetOcrModuleStateCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -172,6 +172,8 @@ func NewCreateFeedTxCmd() *cobra.Command {
 	cmd.Flags().String(FlagFeedConfigDescription, "", "feed config description")
 	cmd.Flags().String(FlagFeedAdmin, "", "feed admin")
 	cmd.Flags().String(FlagBillingAdmin, "", "feed billing admin")
+	cmd.Flags().Int64(FlagHeartbeat, 0, "max seconds between transmissions before the feed is marked as stale (0 disables)")
+	cmd.Flags().String(FlagDeviationThreshold, "", "relative answer change that should trigger a new round")
 	cmd.Flags().String(FlagOnchainConfig, "", "onchain config (base64)")
 	cmd.Flags().String(FlagOffchainConfig, "", "offchain config (base64)")
 
@@ -263,6 +265,8 @@ func NewSetConfigProposalTxCmd() *cobra.Command {
 	cmd.Flags().String(FlagFeedConfigDescription, "", "feed config description")
 	cmd.Flags().String(FlagFeedAdmin, "", "feed admin")
 	cmd.Flags().String(FlagBillingAdmin, "", "feed billing admin")
+	cmd.Flags().Int64(FlagHeartbeat, 0, "max seconds between transmissions before the feed is marked as stale (0 disables)")
+	cmd.Flags().String(FlagDeviationThreshold, "", "relative answer change that should trigger a new round")
 	cmd.Flags().String(FlagOnchainConfig, "", "onchain config (base64)")
 	cmd.Flags().String(FlagOffchainConfig, "", "offchain config (base64)")
 
@@ -377,6 +381,23 @@ func getFeedConfigFromArgs(cmd *cobra.Command) (*types.FeedConfig, error) {
 		return nil, err
 	}
 
+	heartbeat, err := cmd.Flags().GetInt64(FlagHeartbeat)
+	if err != nil {
+		return nil, err
+	}
+
+	deviationThresholdStr, err := cmd.Flags().GetString(FlagDeviationThreshold)
+	if err != nil {
+		return nil, err
+	}
+	deviationThreshold := math.LegacyZeroDec()
+	if deviationThresholdStr != "" {
+		deviationThreshold, err = math.LegacyNewDecFromStr(deviationThresholdStr)
+		if err != nil {
+			return nil, err
+		}
+	}
+
 	return &types.FeedConfig{
 		Signers:               signers,
 		Transmitters:          transmitters,
@@ -395,6 +416,8 @@ func getFeedConfigFromArgs(cmd *cobra.Command) (*types.FeedConfig, error) {
 			Description:         configDescription,
 			FeedAdmin:           feedAdmin,
 			BillingAdmin:        billingAdmin,
+			Heartbeat:           heartbeat,
+			DeviationThreshold:  deviationThreshold,
 		},
 	}, nil
 }
@@ -59,6 +59,10 @@ func InitGenesis(ctx sdk.Context, k keeper.Keeper, data types.GenesisState) {
 		}
 	}
 
+	for _, v := range data.FeedHealthStates {
+		k.SetFeedHealthState(ctx, v.FeedId, v)
+	}
+
 	k.CreateModuleAccount(ctx)
 }
 
@@ -73,5 +77,6 @@ func ExportGenesis(ctx sdk.Context, k keeper.Keeper) *types.GenesisState {
 		FeedObservationCounts:    k.GetAllFeedObservationCounts(ctx),
 		FeedTransmissionCounts:   k.GetAllFeedTransmissionCounts(ctx),
 		PendingPayeeships:        k.GetAllPendingPayeeships(ctx),
+		FeedHealthStates:         k.GetAllFeedHealthStates(ctx),
 	}
 }
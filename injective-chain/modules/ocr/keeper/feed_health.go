@@ -0,0 +1,304 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/ocr/types"
+	"github.com/InjectiveLabs/metrics"
+)
+
+type FeedHealthMonitoring interface {
+	SetFeedHealthState(
+		ctx sdk.Context,
+		feedId string,
+		state *types.FeedHealthState,
+	)
+
+	GetFeedHealthState(
+		ctx sdk.Context,
+		feedId string,
+	) *types.FeedHealthState
+
+	GetAllFeedHealthStates(
+		ctx sdk.Context,
+	) []*types.FeedHealthState
+
+	DeleteFeedHealthState(
+		ctx sdk.Context,
+		feedId string,
+	)
+
+	IsFeedStale(
+		ctx sdk.Context,
+		feedId string,
+	) bool
+
+	MarkStaleFeeds(
+		ctx sdk.Context,
+	)
+
+	GetFeedHealth(
+		ctx sdk.Context,
+		feedId string,
+	) *types.FeedHealth
+}
+
+func (k *Keeper) SetFeedHealthState(
+	ctx sdk.Context,
+	feedId string,
+	state *types.FeedHealthState,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.cdc.MustMarshal(state)
+	k.getStore(ctx).Set(types.GetFeedHealthStateKey(feedId), bz)
+}
+
+func (k *Keeper) GetFeedHealthState(
+	ctx sdk.Context,
+	feedId string,
+) *types.FeedHealthState {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	bz := k.getStore(ctx).Get(types.GetFeedHealthStateKey(feedId))
+	if bz == nil {
+		return nil
+	}
+
+	var state types.FeedHealthState
+	k.cdc.MustUnmarshal(bz, &state)
+	return &state
+}
+
+func (k *Keeper) GetAllFeedHealthStates(
+	ctx sdk.Context,
+) []*types.FeedHealthState {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := k.getStore(ctx)
+	healthStore := prefix.NewStore(store, types.FeedHealthStatePrefix)
+
+	iterator := healthStore.Iterator(nil, nil)
+	defer iterator.Close()
+
+	states := make([]*types.FeedHealthState, 0)
+	for ; iterator.Valid(); iterator.Next() {
+		var state types.FeedHealthState
+		k.cdc.MustUnmarshal(iterator.Value(), &state)
+		states = append(states, &state)
+	}
+
+	return states
+}
+
+func (k *Keeper) DeleteFeedHealthState(
+	ctx sdk.Context,
+	feedId string,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.getStore(ctx).Delete(types.GetFeedHealthStateKey(feedId))
+}
+
+// IsFeedStale returns true if the feed has not received a transmission within its heartbeat.
+func (k *Keeper) IsFeedStale(
+	ctx sdk.Context,
+	feedId string,
+) bool {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	state := k.GetFeedHealthState(ctx, feedId)
+	return state != nil && state.StaleSince > 0
+}
+
+// recordTransmissionHealth refreshes the health state of a feed after a new answer has been transmitted.
+// An answer that moved more than the deviation threshold means the feed missed the rounds the threshold
+// should have triggered, which is reported with an EventFeedDeviationExceeded.
+func (k *Keeper) recordTransmissionHealth(
+	ctx sdk.Context,
+	feedId string,
+	deviationThreshold math.LegacyDec,
+	previous *types.Transmission,
+	answer math.LegacyDec,
+) {
+	state := k.GetFeedHealthState(ctx, feedId)
+	if state == nil {
+		state = &types.FeedHealthState{
+			FeedId: feedId,
+		}
+	}
+
+	if state.StaleSince > 0 {
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventFeedRecovered{
+			FeedId:     feedId,
+			StaleSince: state.StaleSince,
+		})
+		state.StaleSince = 0
+	}
+
+	state.LastAnswerDeviation = math.LegacyZeroDec()
+	if previous != nil && !previous.Answer.IsNil() && previous.Answer.IsPositive() {
+		state.LastAnswerDeviation = answer.Sub(previous.Answer).Abs().Quo(previous.Answer)
+	}
+
+	if isDeviationExceeded(state.LastAnswerDeviation, deviationThreshold) {
+		k.Logger(ctx).Info("OCR feed answer exceeded its deviation threshold", "feedId", feedId, "deviation", state.LastAnswerDeviation, "threshold", deviationThreshold)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventFeedDeviationExceeded{
+			FeedId:             feedId,
+			Deviation:          state.LastAnswerDeviation,
+			DeviationThreshold: deviationThreshold,
+		})
+	}
+
+	k.SetFeedHealthState(ctx, feedId, state)
+}
+
+// MarkStaleFeeds marks every feed whose last transmission is older than its heartbeat as stale.
+func (k *Keeper) MarkStaleFeeds(
+	ctx sdk.Context,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, feedConfig := range k.GetAllFeedConfigs(ctx) {
+		heartbeat := feedConfig.ModuleParams.Heartbeat
+		if heartbeat == 0 {
+			continue
+		}
+
+		feedId := feedConfig.ModuleParams.FeedId
+		transmission := k.GetTransmission(ctx, feedId)
+		if transmission == nil || blockTime-transmission.TransmissionTimestamp <= heartbeat {
+			continue
+		}
+
+		state := k.GetFeedHealthState(ctx, feedId)
+		if state == nil {
+			state = &types.FeedHealthState{
+				FeedId:              feedId,
+				LastAnswerDeviation: math.LegacyZeroDec(),
+			}
+		}
+
+		if state.StaleSince > 0 {
+			continue
+		}
+
+		state.StaleSince = blockTime
+		k.SetFeedHealthState(ctx, feedId, state)
+
+		k.Logger(ctx).Info("marking OCR feed as stale", "feedId", feedId, "lastTransmission", transmission.TransmissionTimestamp, "heartbeat", heartbeat)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventFeedStale{
+			FeedId:                    feedId,
+			LastTransmissionTimestamp: transmission.TransmissionTimestamp,
+			Heartbeat:                 heartbeat,
+		})
+	}
+}
+
+// GetFeedHealth returns the health of a feed, or nil if the feed does not exist.
+func (k *Keeper) GetFeedHealth(
+	ctx sdk.Context,
+	feedId string,
+) *types.FeedHealth {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	feedConfig := k.GetFeedConfig(ctx, feedId)
+	if feedConfig == nil {
+		return nil
+	}
+
+	health := &types.FeedHealth{
+		FeedId:              feedId,
+		Heartbeat:           feedConfig.ModuleParams.Heartbeat,
+		DeviationThreshold:  feedConfig.ModuleParams.DeviationThreshold,
+		LastAnswerDeviation: math.LegacyZeroDec(),
+	}
+
+	if health.DeviationThreshold.IsNil() {
+		health.DeviationThreshold = math.LegacyZeroDec()
+	}
+
+	if transmission := k.GetTransmission(ctx, feedId); transmission != nil {
+		health.LastTransmissionTimestamp = transmission.TransmissionTimestamp
+		health.LastTransmissionAge = ctx.BlockTime().Unix() - transmission.TransmissionTimestamp
+
+		if health.Heartbeat > 0 && health.LastTransmissionAge > 0 {
+			health.MissedRounds = uint64(health.LastTransmissionAge / health.Heartbeat)
+		}
+	}
+
+	if state := k.GetFeedHealthState(ctx, feedId); state != nil {
+		health.IsStale = state.StaleSince > 0
+		health.StaleSince = state.StaleSince
+		health.LastAnswerDeviation = state.LastAnswerDeviation
+	}
+
+	health.DeviationExceeded = isDeviationExceeded(health.LastAnswerDeviation, health.DeviationThreshold)
+
+	health.ParticipationRates = k.getParticipationRates(ctx, feedConfig)
+	return health
+}
+
+// isDeviationExceeded returns true if the deviation is above the threshold, a nil or zero threshold disables the check.
+func isDeviationExceeded(deviation, threshold math.LegacyDec) bool {
+	if threshold.IsNil() || !threshold.IsPositive() || deviation.IsNil() {
+		return false
+	}
+
+	return deviation.GT(threshold)
+}
+
+// getParticipationRates computes the observation and transmission rates of every transmitter of the feed
+// over the rounds reported since the last reward payout.
+func (k *Keeper) getParticipationRates(
+	ctx sdk.Context,
+	feedConfig *types.FeedConfig,
+) []*types.ParticipationRate {
+	feedId := feedConfig.ModuleParams.FeedId
+
+	totalRounds := uint64(0)
+	for _, c := range k.GetFeedTransmissionCounts(ctx, feedId).Counts {
+		totalRounds += c.Count
+	}
+
+	rates := make([]*types.ParticipationRate, 0, len(feedConfig.Transmitters))
+	for _, transmitter := range feedConfig.Transmitters {
+		rate := &types.ParticipationRate{
+			Address:          transmitter,
+			ObservationRate:  math.LegacyZeroDec(),
+			TransmissionRate: math.LegacyZeroDec(),
+		}
+
+		addr, err := sdk.AccAddressFromBech32(transmitter)
+		if err != nil || totalRounds == 0 {
+			rates = append(rates, rate)
+			continue
+		}
+
+		total := math.LegacyNewDec(int64(totalRounds))
+		observations := k.GetFeedObservationsCount(ctx, feedId, addr)
+		transmissions := k.GetFeedTransmissionsCount(ctx, feedId, addr)
+
+		rate.ObservationRate = math.LegacyMinDec(math.LegacyNewDec(int64(observations)).Quo(total), math.LegacyOneDec())
+		rate.TransmissionRate = math.LegacyNewDec(int64(transmissions)).Quo(total)
+		rates = append(rates, rate)
+	}
+
+	return rates
+}
@@ -113,6 +113,30 @@ func (k *Keeper) OwedAmount(c context.Context, req *types.QueryOwedAmountRequest
 	panic("not implemented")
 }
 
+// FeedHealth retrieves the health of a feed
+func (k *Keeper) FeedHealth(c context.Context, req *types.QueryFeedHealthRequest) (*types.QueryFeedHealthResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	feedId := req.FeedId
+	if feedId == "" {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "failed to read feed_id")
+	}
+
+	health := k.GetFeedHealth(ctx, feedId)
+	if health == nil {
+		return nil, errors.Wrapf(types.ErrFeedHealthNotFound, "feed_id %s", feedId)
+	}
+
+	res := &types.QueryFeedHealthResponse{
+		Health: health,
+	}
+
+	return res, nil
+}
+
 // OcrModuleState retrieves the entire OCR module's state
 func (k *Keeper) OcrModuleState(c context.Context, _ *types.QueryModuleStateRequest) (*types.QueryModuleStateResponse, error) {
 	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
@@ -131,6 +155,7 @@ func (k *Keeper) OcrModuleState(c context.Context, _ *types.QueryModuleStateRequ
 			FeedObservationCounts:    k.GetAllFeedObservationCounts(ctx),
 			FeedTransmissionCounts:   k.GetAllFeedTransmissionCounts(ctx),
 			PendingPayeeships:        k.GetAllPendingPayeeships(ctx),
+			FeedHealthStates:         k.GetAllFeedHealthStates(ctx),
 		},
 	}
 
@@ -22,6 +22,7 @@ type Keeper struct {
 	RewardPool
 	FeedObservations
 	FeedTransmissions
+	FeedHealthMonitoring
 	OcrHooks
 
 	bankKeeper types.BankKeeper
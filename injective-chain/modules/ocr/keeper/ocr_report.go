@@ -98,12 +98,15 @@ func (k *Keeper) TransmitterReport(
 		return types.ErrMedianValueOutOfBounds
 	}
 
+	previousTransmission := k.GetTransmission(ctx, feedId)
+
 	aggregatorRoundID := k.IncreaseAggregatorRoundID(ctx, feedId)
 	k.SetTransmission(ctx, feedId, &types.Transmission{
 		Answer:                median,
 		ObservationsTimestamp: report.ObservationsTimestamp,
 		TransmissionTimestamp: ctx.BlockTime().Unix(),
 	})
+	k.recordTransmissionHealth(ctx, feedId, feedConfig.ModuleParams.DeviationThreshold, previousTransmission, median)
 
 	// nolint:errcheck //ignored on purpose
 	ctx.EventManager().EmitTypedEvent(&types.EventNewTransmission{
@@ -76,6 +76,8 @@ func handleBatchSetConfigProposal(ctx sdk.Context, k keeper.Keeper, p *types.Set
 				LinkDenom:           p.LinkDenom,
 				UniqueReports:       feed.UniqueReports,
 				Description:         feed.Description,
+				Heartbeat:           feed.Heartbeat,
+				DeviationThreshold:  feed.DeviationThreshold,
 			},
 		}
 
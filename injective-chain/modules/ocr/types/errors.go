@@ -28,4 +28,5 @@ var (
 	ErrPayeeAlreadySet           = errors.Register(ModuleName, 22, "payee already set")
 	ErrPayeeRestricted           = errors.Register(ModuleName, 23, "action is payee-restricted")
 	ErrFeedConfigNotFound        = errors.Register(ModuleName, 24, "feed config not found")
+	ErrFeedHealthNotFound        = errors.Register(ModuleName, 25, "feed health not found")
 )
@@ -45,6 +45,8 @@ type GenesisState struct {
 	FeedTransmissionCounts []*FeedCounts `protobuf:"bytes,8,rep,name=feed_transmission_counts,json=feedTransmissionCounts,proto3" json:"feed_transmission_counts,omitempty"`
 	// pending_payeeships stores the pending payeeships
 	PendingPayeeships []*PendingPayeeship `protobuf:"bytes,9,rep,name=pending_payeeships,json=pendingPayeeships,proto3" json:"pending_payeeships,omitempty"`
+	// feed_health_states stores the health state of each feed
+	FeedHealthStates []*FeedHealthState `protobuf:"bytes,10,rep,name=feed_health_states,json=feedHealthStates,proto3" json:"feed_health_states,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -143,6 +145,13 @@ func (m *GenesisState) GetPendingPayeeships() []*PendingPayeeship {
 	return nil
 }
 
+func (m *GenesisState) GetFeedHealthStates() []*FeedHealthState {
+	if m != nil {
+		return m.FeedHealthStates
+	}
+	return nil
+}
+
 type FeedTransmission struct {
 	FeedId       string        `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
 	Transmission *Transmission `protobuf:"bytes,2,opt,name=transmission,proto3" json:"transmission,omitempty"`
@@ -531,52 +540,54 @@ func init() {
 }
 
 var fileDescriptor_918762553ccd204f = []byte{
-	// 712 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0xcd, 0x4e, 0x1b, 0x3b,
-	0x18, 0x4d, 0x20, 0x84, 0x8b, 0x03, 0xf7, 0xde, 0xf8, 0xc2, 0x65, 0x4a, 0x69, 0xa0, 0xa1, 0x55,
-	0xd9, 0x74, 0x46, 0x40, 0x25, 0x16, 0x5d, 0xf1, 0xd3, 0xa2, 0x48, 0x48, 0x8d, 0xdc, 0x0a, 0xa9,
-	0x45, 0xea, 0xc8, 0x19, 0x3b, 0x13, 0x57, 0x89, 0x3d, 0xf2, 0xe7, 0x50, 0xd1, 0xa7, 0xe8, 0xa2,
-	0x0f, 0xc5, 0x92, 0x65, 0x57, 0x55, 0x05, 0x2f, 0x52, 0x8d, 0x67, 0x32, 0x24, 0x21, 0x13, 0xb1,
-	0xb3, 0xbf, 0x39, 0xe7, 0x7c, 0x3f, 0x3e, 0xf6, 0xa0, 0x2d, 0x21, 0xbf, 0xf0, 0xc0, 0x88, 0x0b,
-	0xee, 0xa9, 0x40, 0x7b, 0x17, 0x3b, 0x2d, 0x6e, 0xe8, 0x8e, 0x17, 0x72, 0xc9, 0x41, 0x80, 0x1b,
-	0x69, 0x65, 0x14, 0x5e, 0xc9, 0x40, 0xae, 0x0a, 0xb4, 0x9b, 0x82, 0xd6, 0x36, 0x26, 0x73, 0x63,
-	0x88, 0xe5, 0xad, 0x2d, 0x87, 0x2a, 0x54, 0x76, 0xe9, 0xc5, 0xab, 0x34, 0x5a, 0x0b, 0x14, 0xf4,
-	0x14, 0x78, 0x2d, 0x0a, 0x3c, 0x23, 0x05, 0x4a, 0xc8, 0xe4, 0x7b, 0xfd, 0x47, 0x19, 0x2d, 0x9e,
-	0x24, 0xf9, 0xdf, 0x1b, 0x6a, 0x38, 0x7e, 0x8d, 0xca, 0x11, 0xd5, 0xb4, 0x07, 0x4e, 0x71, 0xb3,
-	0xb8, 0x5d, 0xd9, 0x7d, 0xe2, 0x4e, 0xac, 0xc7, 0x6d, 0x5a, 0xd0, 0x61, 0xe9, 0xea, 0xd7, 0x46,
-	0x81, 0xa4, 0x14, 0x7c, 0x8c, 0x16, 0xdb, 0x9c, 0x33, 0x3f, 0x50, 0xb2, 0x2d, 0x42, 0x70, 0x66,
-	0x36, 0x67, 0xb7, 0x2b, 0xbb, 0x4f, 0x73, 0x24, 0xde, 0x72, 0xce, 0x8e, 0x2c, 0x92, 0x54, 0xda,
-	0xd9, 0x1a, 0xb0, 0x8f, 0x56, 0xbb, 0xd4, 0x70, 0x30, 0x3e, 0x8f, 0x54, 0xd0, 0xf1, 0xa9, 0x64,
-	0xbe, 0x56, 0x7d, 0xc9, 0xc0, 0x99, 0xb5, 0x82, 0xdb, 0x53, 0x04, 0xdf, 0xc4, 0x94, 0x03, 0xc9,
-	0x48, 0x4c, 0x20, 0xcb, 0x89, 0xd0, 0x48, 0x10, 0xf0, 0x19, 0xc2, 0xb6, 0x4c, 0xa3, 0xa9, 0x84,
-	0x9e, 0x00, 0x10, 0x4a, 0x82, 0x53, 0xb2, 0xda, 0x2f, 0xa6, 0x68, 0x7f, 0x18, 0xc2, 0x93, 0x6a,
-	0x7b, 0x2c, 0x02, 0x58, 0xa3, 0xc7, 0x69, 0xe1, 0x34, 0x0c, 0x35, 0x0f, 0xa9, 0x51, 0x3a, 0xa9,
-	0xdc, 0x17, 0x0c, 0x9c, 0x39, 0x9b, 0x60, 0x6f, 0x4a, 0x82, 0x53, 0xcb, 0x3e, 0xc8, 0xc8, 0xb6,
-	0xde, 0xc6, 0x31, 0x10, 0xa7, 0x3b, 0xf1, 0x0b, 0xb3, 0x23, 0xd7, 0xfc, 0x2b, 0xd5, 0xcc, 0x8f,
-	0x94, 0xea, 0x82, 0x53, 0x9e, 0x3a, 0x72, 0x62, 0xa1, 0x4d, 0xa5, 0xba, 0xa4, 0xa2, 0xb3, 0x35,
-	0xe0, 0x8f, 0x68, 0xd5, 0x4e, 0x44, 0xb5, 0x80, 0xeb, 0x0b, 0x6a, 0x84, 0x92, 0x7e, 0xa0, 0xfa,
-	0xd2, 0x80, 0x33, 0xff, 0x80, 0x33, 0x8c, 0x81, 0x64, 0x25, 0x56, 0x78, 0x77, 0x27, 0x90, 0x84,
-	0xf1, 0x39, 0x72, 0xee, 0x0d, 0x7b, 0xa0, 0xfd, 0xd7, 0x43, 0xb5, 0xff, 0x1f, 0x1f, 0x76, 0x2a,
-	0x7e, 0x86, 0x70, 0xc4, 0x25, 0x13, 0x32, 0xf4, 0x23, 0x7a, 0xc9, 0x39, 0x74, 0x44, 0x04, 0xce,
-	0xc2, 0xd4, 0x93, 0x6c, 0x26, 0x84, 0xe6, 0x00, 0x4f, 0xaa, 0xd1, 0x58, 0x04, 0xea, 0x06, 0xfd,
-	0x3b, 0x7e, 0xe0, 0x78, 0x15, 0xcd, 0xdb, 0x46, 0x04, 0xb3, 0x57, 0x63, 0x81, 0x94, 0xe3, 0x6d,
-	0x83, 0xe1, 0x13, 0xb4, 0x38, 0xdc, 0x9c, 0x33, 0x63, 0x2f, 0xce, 0x56, 0x4e, 0xfa, 0x11, 0x13,
-	0x8d, 0x10, 0xeb, 0xdf, 0x50, 0xf5, 0x9e, 0x85, 0xf3, 0xd3, 0x9e, 0xa2, 0x7f, 0xc6, 0xee, 0x47,
-	0x9a, 0xf9, 0x59, 0x4e, 0xe6, 0xd1, 0xab, 0xb1, 0xc4, 0x87, 0xb7, 0xf5, 0x10, 0xad, 0x4f, 0x73,
-	0x60, 0x7e, 0x19, 0x2e, 0xfa, 0x6f, 0x82, 0xdb, 0x6d, 0x29, 0x25, 0x52, 0xa5, 0xe3, 0x8e, 0xad,
-	0x7f, 0x46, 0xe8, 0xce, 0x85, 0xf9, 0xb2, 0xfb, 0xa8, 0x4c, 0x7b, 0xf1, 0x21, 0xa7, 0x4d, 0x3d,
-	0x72, 0x93, 0x97, 0xcc, 0x8d, 0x5f, 0xb2, 0xac, 0xa5, 0x23, 0x25, 0xe4, 0xe0, 0x0d, 0x4a, 0xe0,
-	0xf5, 0x73, 0x84, 0xee, 0x8c, 0x93, 0xaf, 0xff, 0x0a, 0x95, 0x53, 0x13, 0x26, 0x8f, 0xd4, 0x7a,
-	0xce, 0xd0, 0xac, 0x0e, 0x49, 0xb1, 0xf5, 0x7d, 0x34, 0x67, 0x03, 0xd8, 0x41, 0xf3, 0x94, 0x31,
-	0xcd, 0x01, 0x52, 0xdd, 0xc1, 0x16, 0x2f, 0xa3, 0xb9, 0x20, 0xab, 0xbb, 0x44, 0x92, 0x4d, 0x6c,
-	0xa8, 0x71, 0xdf, 0xe5, 0xd7, 0xb6, 0x89, 0x2a, 0xa9, 0x2f, 0x8c, 0xe1, 0xda, 0x0a, 0x2d, 0x90,
-	0xe1, 0x10, 0x7e, 0x8e, 0xfe, 0x8e, 0xb4, 0x8a, 0x14, 0x70, 0x96, 0x18, 0xdf, 0x99, 0xb5, 0xa0,
-	0xa5, 0x41, 0xd4, 0x66, 0x39, 0x0c, 0xae, 0x6e, 0x6a, 0xc5, 0xeb, 0x9b, 0x5a, 0xf1, 0xf7, 0x4d,
-	0xad, 0xf8, 0xfd, 0xb6, 0x56, 0xb8, 0xbe, 0xad, 0x15, 0x7e, 0xde, 0xd6, 0x0a, 0x9f, 0x1a, 0xa1,
-	0x30, 0x9d, 0x7e, 0xcb, 0x0d, 0x54, 0xcf, 0x6b, 0x0c, 0x1a, 0x3f, 0xa5, 0x2d, 0xf0, 0xb2, 0x31,
-	0xbc, 0x0c, 0x94, 0xe6, 0xc3, 0xdb, 0x0e, 0x15, 0xd2, 0xeb, 0x29, 0xd6, 0xef, 0x72, 0xb0, 0x3f,
-	0x21, 0x73, 0x19, 0x71, 0x68, 0x95, 0xed, 0x9f, 0x64, 0xef, 0x4f, 0x00, 0x00, 0x00, 0xff, 0xff,
-	0x4a, 0x42, 0x30, 0xba, 0xde, 0x06, 0x00, 0x00,
+	// 739 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x55, 0x5d, 0x4f, 0xdb, 0x48,
+	0x14, 0x4d, 0x20, 0x84, 0x65, 0x02, 0xbb, 0x64, 0x16, 0x16, 0x2f, 0xcb, 0x06, 0x36, 0xec, 0x07,
+	0x2f, 0x6b, 0x0b, 0xa8, 0xc4, 0x43, 0x9f, 0xf8, 0x68, 0x69, 0x24, 0xa4, 0x46, 0x53, 0x84, 0xd4,
+	0x22, 0xd5, 0x9a, 0x78, 0x26, 0xce, 0x54, 0xc9, 0x8c, 0x35, 0x77, 0x42, 0x45, 0x7f, 0x45, 0x7f,
+	0x16, 0x8f, 0x3c, 0xf6, 0xa9, 0xaa, 0xe0, 0xad, 0xbf, 0xa2, 0xf2, 0xd8, 0x31, 0x49, 0x88, 0x23,
+	0xde, 0xe6, 0x5e, 0x9f, 0x7b, 0xee, 0xf5, 0xf5, 0x39, 0x63, 0xb4, 0x2d, 0xe4, 0x07, 0x1e, 0x18,
+	0x71, 0xc5, 0x3d, 0x15, 0x68, 0xef, 0x6a, 0xb7, 0xc5, 0x0d, 0xdd, 0xf5, 0x42, 0x2e, 0x39, 0x08,
+	0x70, 0x23, 0xad, 0x8c, 0xc2, 0xab, 0x19, 0xc8, 0x55, 0x81, 0x76, 0x53, 0xd0, 0xfa, 0xe6, 0xe4,
+	0xda, 0x18, 0x62, 0xeb, 0xd6, 0x57, 0x42, 0x15, 0x2a, 0x7b, 0xf4, 0xe2, 0x53, 0x9a, 0xad, 0x05,
+	0x0a, 0x7a, 0x0a, 0xbc, 0x16, 0x05, 0x9e, 0x15, 0x05, 0x4a, 0xc8, 0xe4, 0x79, 0xfd, 0x7b, 0x19,
+	0x2d, 0x9e, 0x26, 0xfd, 0xdf, 0x18, 0x6a, 0x38, 0x7e, 0x8e, 0xca, 0x11, 0xd5, 0xb4, 0x07, 0x4e,
+	0x71, 0xab, 0xb8, 0x53, 0xd9, 0xfb, 0xd3, 0x9d, 0x38, 0x8f, 0xdb, 0xb4, 0xa0, 0xa3, 0xd2, 0xcd,
+	0xd7, 0xcd, 0x02, 0x49, 0x4b, 0xf0, 0x09, 0x5a, 0x6c, 0x73, 0xce, 0xfc, 0x40, 0xc9, 0xb6, 0x08,
+	0xc1, 0x99, 0xd9, 0x9a, 0xdd, 0xa9, 0xec, 0xfd, 0x95, 0x43, 0xf1, 0x92, 0x73, 0x76, 0x6c, 0x91,
+	0xa4, 0xd2, 0xce, 0xce, 0x80, 0x7d, 0xb4, 0xd6, 0xa5, 0x86, 0x83, 0xf1, 0x79, 0xa4, 0x82, 0x8e,
+	0x4f, 0x25, 0xf3, 0xb5, 0xea, 0x4b, 0x06, 0xce, 0xac, 0x25, 0xdc, 0x99, 0x42, 0xf8, 0x22, 0x2e,
+	0x39, 0x94, 0x8c, 0xc4, 0x05, 0x64, 0x25, 0x21, 0x1a, 0x49, 0x02, 0xbe, 0x40, 0xd8, 0x8e, 0x69,
+	0x34, 0x95, 0xd0, 0x13, 0x00, 0x42, 0x49, 0x70, 0x4a, 0x96, 0xfb, 0xbf, 0x29, 0xdc, 0xe7, 0x43,
+	0x78, 0x52, 0x6d, 0x8f, 0x65, 0x00, 0x6b, 0xf4, 0x47, 0x3a, 0x38, 0x0d, 0x43, 0xcd, 0x43, 0x6a,
+	0x94, 0x4e, 0x26, 0xf7, 0x05, 0x03, 0x67, 0xce, 0x36, 0xd8, 0x9f, 0xd2, 0xe0, 0xcc, 0x56, 0x1f,
+	0x66, 0xc5, 0x76, 0xde, 0xc6, 0x09, 0x10, 0xa7, 0x3b, 0xf1, 0x09, 0xb3, 0x2b, 0xd7, 0xfc, 0x23,
+	0xd5, 0xcc, 0x8f, 0x94, 0xea, 0x82, 0x53, 0x9e, 0xba, 0x72, 0x62, 0xa1, 0x4d, 0xa5, 0xba, 0xa4,
+	0xa2, 0xb3, 0x33, 0xe0, 0xb7, 0x68, 0xcd, 0x6e, 0x44, 0xb5, 0x80, 0xeb, 0x2b, 0x6a, 0x84, 0x92,
+	0x7e, 0xa0, 0xfa, 0xd2, 0x80, 0x33, 0xff, 0x84, 0x6f, 0x18, 0x03, 0xc9, 0x6a, 0xcc, 0xf0, 0xfa,
+	0x81, 0x20, 0x49, 0xe3, 0x4b, 0xe4, 0x3c, 0x5a, 0xf6, 0x80, 0xfb, 0xa7, 0xa7, 0x72, 0xff, 0x36,
+	0xbe, 0xec, 0x94, 0xfc, 0x02, 0xe1, 0x88, 0x4b, 0x26, 0x64, 0xe8, 0x47, 0xf4, 0x9a, 0x73, 0xe8,
+	0x88, 0x08, 0x9c, 0x85, 0xa9, 0x5f, 0xb2, 0x99, 0x14, 0x34, 0x07, 0x78, 0x52, 0x8d, 0xc6, 0x32,
+	0x80, 0xcf, 0x53, 0x85, 0x74, 0x38, 0xed, 0x9a, 0x8e, 0x0f, 0xb1, 0x35, 0xc0, 0x41, 0x96, 0xf7,
+	0xdf, 0x29, 0xe3, 0xbe, 0xb2, 0x78, 0xeb, 0x24, 0xb2, 0xdc, 0x1e, 0x4d, 0x40, 0xdd, 0xa0, 0xe5,
+	0x71, 0x19, 0xe1, 0x35, 0x34, 0x6f, 0x3b, 0x09, 0x66, 0x0d, 0xb7, 0x40, 0xca, 0x71, 0xd8, 0x60,
+	0xf8, 0x14, 0x2d, 0x0e, 0xaf, 0xcc, 0x99, 0xb1, 0x76, 0xdc, 0xce, 0x69, 0x3e, 0x22, 0xcd, 0x91,
+	0xc2, 0xfa, 0x27, 0x54, 0x7d, 0x64, 0x8c, 0xfc, 0xb6, 0x67, 0xe8, 0x97, 0x31, 0xd7, 0xa5, 0x9d,
+	0xff, 0xce, 0xe9, 0x3c, 0x6a, 0xb8, 0x25, 0x3e, 0x1c, 0xd6, 0x43, 0xb4, 0x31, 0x4d, 0xd7, 0xf9,
+	0x63, 0xb8, 0xe8, 0xd7, 0x09, 0x1e, 0xb2, 0xa3, 0x94, 0x48, 0x95, 0x8e, 0xfb, 0xa0, 0xfe, 0x1e,
+	0xa1, 0x07, 0x6d, 0xe7, 0xd3, 0x1e, 0xa0, 0x32, 0xed, 0xc5, 0xd2, 0x49, 0x5f, 0xea, 0x77, 0x37,
+	0xb9, 0x1f, 0xdd, 0xf8, 0x7e, 0xcc, 0x5e, 0xe9, 0x58, 0x09, 0x39, 0xb8, 0xd9, 0x12, 0x78, 0xfd,
+	0x12, 0xa1, 0x07, 0x39, 0xe6, 0xf3, 0x3f, 0x43, 0xe5, 0x54, 0xda, 0xc9, 0xd5, 0xb7, 0x91, 0xb3,
+	0x34, 0xcb, 0x43, 0x52, 0x6c, 0xfd, 0x00, 0xcd, 0xd9, 0x04, 0x76, 0xd0, 0x3c, 0x65, 0x4c, 0x73,
+	0x80, 0x94, 0x77, 0x10, 0xe2, 0x15, 0x34, 0x17, 0x64, 0x73, 0x97, 0x48, 0x12, 0xc4, 0x82, 0x1a,
+	0x57, 0x73, 0xfe, 0x6c, 0x5b, 0xa8, 0x92, 0xea, 0xc2, 0x18, 0xae, 0x2d, 0xd1, 0x02, 0x19, 0x4e,
+	0xe1, 0x7f, 0xd0, 0xcf, 0x91, 0x56, 0x91, 0x02, 0xce, 0x12, 0x3b, 0x39, 0xb3, 0x16, 0xb4, 0x34,
+	0xc8, 0xda, 0x2e, 0x47, 0xc1, 0xcd, 0x5d, 0xad, 0x78, 0x7b, 0x57, 0x2b, 0x7e, 0xbb, 0xab, 0x15,
+	0x3f, 0xdf, 0xd7, 0x0a, 0xb7, 0xf7, 0xb5, 0xc2, 0x97, 0xfb, 0x5a, 0xe1, 0x5d, 0x23, 0x14, 0xa6,
+	0xd3, 0x6f, 0xb9, 0x81, 0xea, 0x79, 0x8d, 0xc1, 0x8b, 0x9f, 0xd1, 0x16, 0x78, 0xd9, 0x1a, 0xfe,
+	0x0f, 0x94, 0xe6, 0xc3, 0x61, 0x87, 0x0a, 0xe9, 0xf5, 0x14, 0xeb, 0x77, 0x39, 0xd8, 0x5f, 0x9b,
+	0xb9, 0x8e, 0x38, 0xb4, 0xca, 0xf6, 0xff, 0xb4, 0xff, 0x63, 0x00, 0x99, 0xed, 0xed, 0xe4, 0x34,
+	0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -599,6 +610,20 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FeedHealthStates) > 0 {
+		for iNdEx := len(m.FeedHealthStates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeedHealthStates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
 	if len(m.PendingPayeeships) > 0 {
 		for iNdEx := len(m.PendingPayeeships) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -1073,6 +1098,12 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.FeedHealthStates) > 0 {
+		for _, e := range m.FeedHealthStates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1537,6 +1568,40 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedHealthStates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedHealthStates = append(m.FeedHealthStates, &FeedHealthState{})
+			if err := m.FeedHealthStates[len(m.FeedHealthStates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -36,6 +36,7 @@ var (
 	PayeePrefix                = []byte{0x09}
 	PendingPayeeTransferPrefix = []byte{0x10}
 	ParamsKey                  = []byte{0x11}
+	FeedHealthStatePrefix      = []byte{0x12}
 )
 
 func GetFeedConfigKey(feedId string) []byte {
@@ -157,6 +158,15 @@ func GetPendingPayeeshipTransferPrefix(feedId string, transmitter sdk.AccAddress
 	return buf
 }
 
+func GetFeedHealthStateKey(feedId string) []byte {
+	feedIdBz := getPaddedFeedIdBz(feedId)
+
+	buf := make([]byte, 0, len(FeedHealthStatePrefix)+len(feedIdBz))
+	buf = append(buf, FeedHealthStatePrefix...)
+	buf = append(buf, feedIdBz...)
+	return buf
+}
+
 func getPaddedFeedIdBz(feedId string) string {
 	return fmt.Sprintf("%20s", feedId)
 }
@@ -297,6 +297,12 @@ type ModuleParams struct {
 	FeedAdmin string `protobuf:"bytes,9,opt,name=feed_admin,json=feedAdmin,proto3" json:"feed_admin,omitempty"`
 	// feed billing administrator
 	BillingAdmin string `protobuf:"bytes,10,opt,name=billing_admin,json=billingAdmin,proto3" json:"billing_admin,omitempty"`
+	// heartbeat maximum number of seconds between two transmissions before the
+	// feed is marked as stale, zero disables staleness checks
+	Heartbeat int64 `protobuf:"varint,11,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// deviation_threshold relative change of the answer that should trigger a
+	// new round before the heartbeat elapses
+	DeviationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=deviation_threshold,json=deviationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation_threshold"`
 }
 
 func (m *ModuleParams) Reset()         { *m = ModuleParams{} }
@@ -374,6 +380,13 @@ func (m *ModuleParams) GetBillingAdmin() string {
 	return ""
 }
 
+func (m *ModuleParams) GetHeartbeat() int64 {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return 0
+}
+
 type ContractConfig struct {
 	// config_count ordinal number of this config setting among all config
 	// settings
@@ -544,6 +557,12 @@ type FeedProperties struct {
 	// short human-readable description of observable this feed's answers pertain
 	// to
 	Description string `protobuf:"bytes,11,opt,name=description,proto3" json:"description,omitempty"`
+	// heartbeat maximum number of seconds between two transmissions before the
+	// feed is marked as stale, zero disables staleness checks
+	Heartbeat int64 `protobuf:"varint,12,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	// deviation_threshold relative change of the answer that should trigger a
+	// new round before the heartbeat elapses
+	DeviationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=deviation_threshold,json=deviationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation_threshold"`
 }
 
 func (m *FeedProperties) Reset()         { *m = FeedProperties{} }
@@ -628,6 +647,13 @@ func (m *FeedProperties) GetDescription() string {
 	return ""
 }
 
+func (m *FeedProperties) GetHeartbeat() int64 {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return 0
+}
+
 type SetBatchConfigProposal struct {
 	Title       string `protobuf:"bytes,1,opt,name=title,proto3" json:"title,omitempty"`
 	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
@@ -1430,6 +1456,390 @@ func (m *EventConfigSet) GetConfigInfo() *FeedConfigInfo {
 	return nil
 }
 
+type EventFeedStale struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// last_transmission_timestamp block time of the last transmission
+	LastTransmissionTimestamp int64 `protobuf:"varint,2,opt,name=last_transmission_timestamp,json=lastTransmissionTimestamp,proto3" json:"last_transmission_timestamp,omitempty"`
+	Heartbeat                 int64 `protobuf:"varint,3,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+}
+
+func (m *EventFeedStale) Reset()         { *m = EventFeedStale{} }
+func (m *EventFeedStale) String() string { return proto.CompactTextString(m) }
+func (*EventFeedStale) ProtoMessage()    {}
+func (*EventFeedStale) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{21}
+}
+func (m *EventFeedStale) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeedStale) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeedStale.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeedStale) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeedStale.Merge(m, src)
+}
+func (m *EventFeedStale) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeedStale) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeedStale.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeedStale proto.InternalMessageInfo
+
+func (m *EventFeedStale) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *EventFeedStale) GetLastTransmissionTimestamp() int64 {
+	if m != nil {
+		return m.LastTransmissionTimestamp
+	}
+	return 0
+}
+
+func (m *EventFeedStale) GetHeartbeat() int64 {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return 0
+}
+
+type EventFeedRecovered struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// stale_since block time at which the feed was marked as stale
+	StaleSince int64 `protobuf:"varint,2,opt,name=stale_since,json=staleSince,proto3" json:"stale_since,omitempty"`
+}
+
+func (m *EventFeedRecovered) Reset()         { *m = EventFeedRecovered{} }
+func (m *EventFeedRecovered) String() string { return proto.CompactTextString(m) }
+func (*EventFeedRecovered) ProtoMessage()    {}
+func (*EventFeedRecovered) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{22}
+}
+func (m *EventFeedRecovered) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeedRecovered) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeedRecovered.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeedRecovered) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeedRecovered.Merge(m, src)
+}
+func (m *EventFeedRecovered) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeedRecovered) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeedRecovered.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeedRecovered proto.InternalMessageInfo
+
+func (m *EventFeedRecovered) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *EventFeedRecovered) GetStaleSince() int64 {
+	if m != nil {
+		return m.StaleSince
+	}
+	return 0
+}
+
+type EventFeedDeviationExceeded struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// deviation relative change of the answer since the previous transmission
+	Deviation          cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=deviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation"`
+	DeviationThreshold cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=deviation_threshold,json=deviationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation_threshold"`
+}
+
+func (m *EventFeedDeviationExceeded) Reset()         { *m = EventFeedDeviationExceeded{} }
+func (m *EventFeedDeviationExceeded) String() string { return proto.CompactTextString(m) }
+func (*EventFeedDeviationExceeded) ProtoMessage()    {}
+func (*EventFeedDeviationExceeded) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{23}
+}
+func (m *EventFeedDeviationExceeded) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventFeedDeviationExceeded) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventFeedDeviationExceeded.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventFeedDeviationExceeded) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventFeedDeviationExceeded.Merge(m, src)
+}
+func (m *EventFeedDeviationExceeded) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventFeedDeviationExceeded) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventFeedDeviationExceeded.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventFeedDeviationExceeded proto.InternalMessageInfo
+
+func (m *EventFeedDeviationExceeded) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+// FeedHealthState tracks the health of a feed between transmissions
+type FeedHealthState struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	// stale_since block time at which the feed was marked as stale, zero if the
+	// feed is healthy
+	StaleSince int64 `protobuf:"varint,2,opt,name=stale_since,json=staleSince,proto3" json:"stale_since,omitempty"`
+	// last_answer_deviation relative change of the latest answer compared to
+	// the answer of the previous round
+	LastAnswerDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=last_answer_deviation,json=lastAnswerDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_answer_deviation"`
+}
+
+func (m *FeedHealthState) Reset()         { *m = FeedHealthState{} }
+func (m *FeedHealthState) String() string { return proto.CompactTextString(m) }
+func (*FeedHealthState) ProtoMessage()    {}
+func (*FeedHealthState) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{24}
+}
+func (m *FeedHealthState) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedHealthState) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedHealthState.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedHealthState) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedHealthState.Merge(m, src)
+}
+func (m *FeedHealthState) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedHealthState) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedHealthState.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedHealthState proto.InternalMessageInfo
+
+func (m *FeedHealthState) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *FeedHealthState) GetStaleSince() int64 {
+	if m != nil {
+		return m.StaleSince
+	}
+	return 0
+}
+
+type ParticipationRate struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// observation_rate share of the rounds since the last payout the oracle
+	// contributed an observation to
+	ObservationRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=observation_rate,json=observationRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"observation_rate"`
+	// transmission_rate share of the rounds since the last payout the oracle
+	// transmitted
+	TransmissionRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=transmission_rate,json=transmissionRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"transmission_rate"`
+}
+
+func (m *ParticipationRate) Reset()         { *m = ParticipationRate{} }
+func (m *ParticipationRate) String() string { return proto.CompactTextString(m) }
+func (*ParticipationRate) ProtoMessage()    {}
+func (*ParticipationRate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{25}
+}
+func (m *ParticipationRate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ParticipationRate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ParticipationRate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ParticipationRate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ParticipationRate.Merge(m, src)
+}
+func (m *ParticipationRate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ParticipationRate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ParticipationRate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ParticipationRate proto.InternalMessageInfo
+
+func (m *ParticipationRate) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+type FeedHealth struct {
+	FeedId                    string                      `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+	Heartbeat                 int64                       `protobuf:"varint,2,opt,name=heartbeat,proto3" json:"heartbeat,omitempty"`
+	DeviationThreshold        cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=deviation_threshold,json=deviationThreshold,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deviation_threshold"`
+	LastTransmissionTimestamp int64                       `protobuf:"varint,4,opt,name=last_transmission_timestamp,json=lastTransmissionTimestamp,proto3" json:"last_transmission_timestamp,omitempty"`
+	// last_transmission_age number of seconds since the last transmission
+	LastTransmissionAge int64 `protobuf:"varint,5,opt,name=last_transmission_age,json=lastTransmissionAge,proto3" json:"last_transmission_age,omitempty"`
+	// missed_rounds number of heartbeats elapsed since the last transmission
+	MissedRounds        uint64                      `protobuf:"varint,6,opt,name=missed_rounds,json=missedRounds,proto3" json:"missed_rounds,omitempty"`
+	IsStale             bool                        `protobuf:"varint,7,opt,name=is_stale,json=isStale,proto3" json:"is_stale,omitempty"`
+	StaleSince          int64                       `protobuf:"varint,8,opt,name=stale_since,json=staleSince,proto3" json:"stale_since,omitempty"`
+	LastAnswerDeviation cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=last_answer_deviation,json=lastAnswerDeviation,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_answer_deviation"`
+	ParticipationRates  []*ParticipationRate        `protobuf:"bytes,10,rep,name=participation_rates,json=participationRates,proto3" json:"participation_rates,omitempty"`
+	// deviation_exceeded true if the last answer moved more than the deviation
+	// threshold, meaning the feed missed the rounds the threshold should have
+	// triggered
+	DeviationExceeded bool `protobuf:"varint,11,opt,name=deviation_exceeded,json=deviationExceeded,proto3" json:"deviation_exceeded,omitempty"`
+}
+
+func (m *FeedHealth) Reset()         { *m = FeedHealth{} }
+func (m *FeedHealth) String() string { return proto.CompactTextString(m) }
+func (*FeedHealth) ProtoMessage()    {}
+func (*FeedHealth) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0acb79560f1720fa, []int{26}
+}
+func (m *FeedHealth) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeedHealth) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeedHealth.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeedHealth) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeedHealth.Merge(m, src)
+}
+func (m *FeedHealth) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeedHealth) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeedHealth.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeedHealth proto.InternalMessageInfo
+
+func (m *FeedHealth) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+func (m *FeedHealth) GetHeartbeat() int64 {
+	if m != nil {
+		return m.Heartbeat
+	}
+	return 0
+}
+
+func (m *FeedHealth) GetLastTransmissionTimestamp() int64 {
+	if m != nil {
+		return m.LastTransmissionTimestamp
+	}
+	return 0
+}
+
+func (m *FeedHealth) GetLastTransmissionAge() int64 {
+	if m != nil {
+		return m.LastTransmissionAge
+	}
+	return 0
+}
+
+func (m *FeedHealth) GetMissedRounds() uint64 {
+	if m != nil {
+		return m.MissedRounds
+	}
+	return 0
+}
+
+func (m *FeedHealth) GetIsStale() bool {
+	if m != nil {
+		return m.IsStale
+	}
+	return false
+}
+
+func (m *FeedHealth) GetStaleSince() int64 {
+	if m != nil {
+		return m.StaleSince
+	}
+	return 0
+}
+
+func (m *FeedHealth) GetParticipationRates() []*ParticipationRate {
+	if m != nil {
+		return m.ParticipationRates
+	}
+	return nil
+}
+
+func (m *FeedHealth) GetDeviationExceeded() bool {
+	if m != nil {
+		return m.DeviationExceeded
+	}
+	return false
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.ocr.v1beta1.Params")
 	proto.RegisterType((*FeedConfig)(nil), "injective.ocr.v1beta1.FeedConfig")
@@ -1452,115 +1862,144 @@ func init() {
 	proto.RegisterType((*EventTransmitted)(nil), "injective.ocr.v1beta1.EventTransmitted")
 	proto.RegisterType((*EventNewTransmission)(nil), "injective.ocr.v1beta1.EventNewTransmission")
 	proto.RegisterType((*EventConfigSet)(nil), "injective.ocr.v1beta1.EventConfigSet")
+	proto.RegisterType((*EventFeedStale)(nil), "injective.ocr.v1beta1.EventFeedStale")
+	proto.RegisterType((*EventFeedRecovered)(nil), "injective.ocr.v1beta1.EventFeedRecovered")
+	proto.RegisterType((*EventFeedDeviationExceeded)(nil), "injective.ocr.v1beta1.EventFeedDeviationExceeded")
+	proto.RegisterType((*FeedHealthState)(nil), "injective.ocr.v1beta1.FeedHealthState")
+	proto.RegisterType((*ParticipationRate)(nil), "injective.ocr.v1beta1.ParticipationRate")
+	proto.RegisterType((*FeedHealth)(nil), "injective.ocr.v1beta1.FeedHealth")
 }
 
 func init() { proto.RegisterFile("injective/ocr/v1beta1/ocr.proto", fileDescriptor_0acb79560f1720fa) }
 
 var fileDescriptor_0acb79560f1720fa = []byte{
-	// 1648 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x3b, 0x6f, 0x1b, 0xc7,
-	0x16, 0xd6, 0x92, 0x14, 0x25, 0x1e, 0x3e, 0x64, 0xad, 0x24, 0x9b, 0xd6, 0xb5, 0x44, 0x7a, 0x7d,
-	0x8d, 0xab, 0x7b, 0x01, 0x93, 0xd7, 0x0a, 0x12, 0x27, 0x76, 0x91, 0x88, 0xf2, 0x4b, 0x80, 0x1f,
-	0xca, 0x4a, 0x76, 0x91, 0x66, 0x31, 0xdc, 0x1d, 0x92, 0x1b, 0x73, 0x67, 0x98, 0xd9, 0xa1, 0x6c,
-	0xfd, 0x82, 0x04, 0xa9, 0x0c, 0x24, 0x48, 0xed, 0x2a, 0x45, 0x80, 0x00, 0x29, 0xdc, 0xa4, 0x49,
-	0x95, 0xc2, 0x08, 0x02, 0xc4, 0x65, 0x90, 0xc2, 0x09, 0xec, 0x22, 0xfe, 0x0b, 0xa9, 0x12, 0xcc,
-	0x83, 0xe4, 0xf2, 0x21, 0x5a, 0xb4, 0xd5, 0x10, 0x3b, 0xdf, 0x79, 0xcc, 0xd9, 0xf3, 0xf8, 0x66,
-	0x96, 0x50, 0xf0, 0xc9, 0xc7, 0xd8, 0xe5, 0xfe, 0x1e, 0x2e, 0x53, 0x97, 0x95, 0xf7, 0xce, 0x57,
-	0x31, 0x47, 0xe7, 0xc5, 0x73, 0xa9, 0xc5, 0x28, 0xa7, 0xe6, 0x52, 0x57, 0xa1, 0x24, 0x40, 0xad,
-	0xb0, 0xbc, 0xea, 0xd2, 0x30, 0xa0, 0x61, 0xb9, 0x8a, 0x42, 0xdc, 0xb5, 0x72, 0xa9, 0x4f, 0x94,
-	0xd9, 0xf2, 0x49, 0x25, 0x77, 0xe4, 0xaa, 0xac, 0x16, 0x5a, 0xb4, 0x58, 0xa7, 0x75, 0xaa, 0x70,
-	0xf1, 0xa4, 0xd1, 0x42, 0x9d, 0xd2, 0x7a, 0x13, 0x97, 0xe5, 0xaa, 0xda, 0xae, 0x95, 0xb9, 0x1f,
-	0xe0, 0x90, 0xa3, 0xa0, 0xa5, 0x15, 0xe6, 0x51, 0xe0, 0x13, 0x5a, 0x96, 0xbf, 0x0a, 0xb2, 0xbe,
-	0x30, 0x20, 0xb9, 0x8d, 0x18, 0x0a, 0x42, 0x73, 0x05, 0xa0, 0xe9, 0x93, 0x7b, 0x8e, 0x87, 0x09,
-	0x0d, 0xf2, 0x46, 0xd1, 0x58, 0x4b, 0xd9, 0x29, 0x81, 0x5c, 0x16, 0x80, 0xb9, 0x0e, 0x4b, 0x2d,
-	0xb4, 0x4f, 0xdb, 0xdc, 0xa9, 0x36, 0xa9, 0x7b, 0xcf, 0xf1, 0x09, 0xc7, 0x6c, 0x0f, 0x35, 0xf3,
-	0xb1, 0xa2, 0xb1, 0x96, 0xb0, 0x17, 0x94, 0xb0, 0x22, 0x64, 0x5b, 0x5a, 0x64, 0x9e, 0x86, 0x4c,
-	0x40, 0xbd, 0x76, 0x13, 0x3b, 0xc8, 0x0b, 0x7c, 0x92, 0x8f, 0x4b, 0xa7, 0x69, 0x85, 0x6d, 0x08,
-	0xe8, 0xe2, 0xc2, 0xcb, 0x47, 0x05, 0xe3, 0xf3, 0x3f, 0xbf, 0xfb, 0x1f, 0x88, 0xe4, 0xa9, 0x50,
-	0xac, 0x6f, 0x62, 0x00, 0x57, 0x31, 0xf6, 0x36, 0x29, 0xa9, 0xf9, 0x75, 0x33, 0x0f, 0x33, 0xa1,
-	0x5f, 0x27, 0x98, 0x85, 0x79, 0xa3, 0x18, 0x5f, 0x4b, 0xd9, 0x9d, 0xa5, 0x69, 0x41, 0x86, 0x33,
-	0x44, 0xc2, 0xc0, 0xe7, 0x5c, 0x88, 0x63, 0x52, 0xdc, 0x87, 0x99, 0x19, 0x30, 0x6a, 0x72, 0xe7,
-	0xac, 0x6d, 0xd4, 0xcc, 0xb3, 0x90, 0xa3, 0xc4, 0x6d, 0x20, 0x9f, 0x38, 0xae, 0xf4, 0x9e, 0x4f,
-	0x14, 0x8d, 0xb5, 0x8c, 0x9d, 0xd5, 0xa8, 0xde, 0xf2, 0x1d, 0x38, 0x41, 0x6b, 0xb5, 0xa8, 0x9e,
-	0xb3, 0x87, 0x59, 0xe8, 0x53, 0x92, 0x9f, 0x96, 0xef, 0xbb, 0xd4, 0x11, 0x2b, 0x83, 0xbb, 0x4a,
-	0x68, 0xfe, 0x07, 0xe6, 0x06, 0xec, 0xf2, 0x49, 0xe9, 0x3f, 0xd7, 0xaf, 0x6f, 0x5e, 0x87, 0xac,
-	0x4e, 0x4d, 0x4b, 0xbe, 0x73, 0x7e, 0xa6, 0x68, 0xac, 0xa5, 0xd7, 0xcf, 0x94, 0x46, 0x36, 0x4b,
-	0xe9, 0xa6, 0xd4, 0x55, 0xe9, 0xb1, 0x75, 0x52, 0x75, 0xb2, 0x7e, 0x30, 0x20, 0xd7, 0x4b, 0xd6,
-	0x16, 0xa9, 0x51, 0xf3, 0xff, 0xb0, 0xd8, 0x44, 0x1c, 0x87, 0xbc, 0x13, 0xbb, 0xe7, 0xd7, 0x71,
-	0xc8, 0x65, 0x51, 0x33, 0xb6, 0xa9, 0x64, 0x4a, 0xff, 0xb2, 0x94, 0xa8, 0x24, 0xc5, 0x3a, 0x49,
-	0xca, 0x80, 0x41, 0x3a, 0x29, 0x23, 0xa2, 0x8a, 0xda, 0x8d, 0x4b, 0xdb, 0x84, 0xcb, 0x84, 0x25,
-	0xec, 0xb4, 0xc2, 0x36, 0x05, 0x64, 0x5e, 0x82, 0xe5, 0xfe, 0x0d, 0x55, 0x8f, 0x90, 0x76, 0x50,
-	0xc5, 0x4c, 0x66, 0x2c, 0x6e, 0x9f, 0x88, 0x6e, 0x2b, 0xfb, 0xe4, 0x96, 0x14, 0x5b, 0x7f, 0xc7,
-	0x21, 0x13, 0x7d, 0x3f, 0xf3, 0x04, 0xcc, 0xd4, 0x30, 0xf6, 0x1c, 0xdf, 0xd3, 0x6d, 0x98, 0x14,
-	0xcb, 0x2d, 0xcf, 0xac, 0x00, 0x04, 0x3e, 0x71, 0x10, 0x09, 0xef, 0x63, 0x26, 0xc3, 0x4d, 0x55,
-	0xce, 0x3c, 0x79, 0x56, 0x98, 0xfa, 0xed, 0x59, 0xe1, 0x5f, 0x6a, 0x42, 0x42, 0xef, 0x5e, 0xc9,
-	0xa7, 0xe5, 0x00, 0xf1, 0x46, 0xe9, 0x06, 0xae, 0x23, 0x77, 0xff, 0x32, 0x76, 0xed, 0x54, 0xe0,
-	0x93, 0x0d, 0x69, 0x25, 0x7d, 0xa0, 0x07, 0x1d, 0x1f, 0xf1, 0x49, 0x7c, 0xa0, 0x07, 0xda, 0xc7,
-	0x6d, 0x58, 0x94, 0xa3, 0xd2, 0xc2, 0xcc, 0xa1, 0xd5, 0x50, 0x34, 0x3b, 0x17, 0xad, 0x91, 0x90,
-	0xde, 0x56, 0xb4, 0xb7, 0xa5, 0x61, 0x6f, 0x5b, 0x84, 0xdb, 0xa6, 0x30, 0xdd, 0xc6, 0xec, 0x76,
-	0xcf, 0xd0, 0xfc, 0x10, 0x96, 0xba, 0x0e, 0x75, 0xf3, 0x86, 0xdd, 0x66, 0x7b, 0xa5, 0xc7, 0x05,
-	0xed, 0x71, 0x37, 0x62, 0x39, 0x30, 0xce, 0xc9, 0xc1, 0x71, 0x3e, 0x0b, 0xb9, 0x36, 0xf1, 0x3f,
-	0x69, 0x63, 0x87, 0xe1, 0x16, 0x65, 0x5c, 0x35, 0xe0, 0xac, 0x9d, 0x55, 0xa8, 0xad, 0x40, 0xb3,
-	0x08, 0x69, 0x0f, 0x87, 0x2e, 0xf3, 0x5b, 0xf2, 0x05, 0x67, 0xd5, 0x00, 0x47, 0x20, 0xb1, 0x8f,
-	0x2c, 0x96, 0x9a, 0xf0, 0x94, 0xda, 0x47, 0x20, 0x72, 0xbe, 0xcd, 0x33, 0x90, 0xad, 0xfa, 0xcd,
-	0xa6, 0x4f, 0xea, 0x5a, 0x03, 0xa4, 0x46, 0x46, 0x83, 0x52, 0xc9, 0xfa, 0x34, 0x06, 0xb9, 0x4d,
-	0x4a, 0x38, 0x43, 0xae, 0xee, 0x8f, 0xa1, 0xa6, 0x33, 0x86, 0x9b, 0x2e, 0x42, 0x0b, 0xb1, 0xf1,
-	0xb4, 0x10, 0x3f, 0x88, 0x16, 0x12, 0x07, 0xd3, 0xc2, 0xf4, 0x84, 0xb4, 0x90, 0x9c, 0x90, 0x16,
-	0x66, 0x46, 0xd1, 0x82, 0xf5, 0xc4, 0x80, 0xf9, 0x1d, 0xac, 0x93, 0xb0, 0xcd, 0x68, 0x8b, 0x86,
-	0xa8, 0x69, 0x2e, 0xc2, 0x34, 0xf7, 0x79, 0x13, 0xeb, 0x71, 0x50, 0x8b, 0xc1, 0xda, 0xc4, 0x86,
-	0x6b, 0xf3, 0x1e, 0x24, 0xf5, 0x6e, 0x71, 0xc9, 0x2e, 0xa7, 0x0f, 0x60, 0x97, 0x1e, 0x7d, 0xd8,
-	0xda, 0xe0, 0xe2, 0x07, 0x9f, 0x3d, 0x2a, 0x4c, 0xbd, 0x7c, 0x54, 0x98, 0xfa, 0xe9, 0xf1, 0xb9,
-	0x65, 0x7d, 0xf8, 0xd4, 0xe9, 0x5e, 0xd7, 0x44, 0x94, 0x0b, 0x13, 0x2e, 0x98, 0x7b, 0x49, 0x30,
-	0xf7, 0x50, 0xd0, 0xd6, 0xe3, 0x84, 0xe2, 0x25, 0x01, 0x60, 0xc6, 0x7d, 0x3c, 0x66, 0xb0, 0xfb,
-	0xe9, 0x67, 0xb8, 0x18, 0xf1, 0x09, 0x8b, 0x91, 0x98, 0xb0, 0x18, 0xd3, 0x23, 0x39, 0xba, 0x9f,
-	0x6e, 0x92, 0x47, 0x40, 0x37, 0x33, 0x47, 0x4a, 0x37, 0xb3, 0x47, 0x4e, 0x37, 0xa9, 0xd7, 0xa6,
-	0x9b, 0x61, 0x3e, 0x81, 0x43, 0xf0, 0x49, 0x7a, 0xa8, 0x67, 0xad, 0x1f, 0x63, 0x70, 0x7c, 0x07,
-	0xf3, 0x0a, 0xe2, 0x6e, 0xe3, 0x88, 0xc6, 0x20, 0x42, 0x14, 0xf1, 0xf1, 0x44, 0x91, 0x18, 0x41,
-	0x14, 0xfd, 0x44, 0x3a, 0x3d, 0x48, 0xa4, 0xb7, 0x60, 0x4e, 0xf6, 0x74, 0xab, 0xdb, 0xe6, 0xf9,
-	0x64, 0x31, 0xbe, 0x96, 0x5e, 0x3f, 0x3b, 0x66, 0xd8, 0x7a, 0x33, 0x61, 0xe7, 0x6a, 0x7d, 0xeb,
-	0x8b, 0x57, 0x0e, 0x3f, 0x78, 0xcb, 0x7a, 0xf0, 0x46, 0xe4, 0xca, 0x5a, 0x87, 0xfc, 0x6d, 0x86,
-	0xdc, 0x26, 0x8e, 0xd4, 0x3d, 0x94, 0xbc, 0x19, 0x9a, 0xc7, 0x05, 0x2d, 0x88, 0x27, 0x79, 0x9d,
-	0xca, 0xda, 0x7a, 0x65, 0xdd, 0x85, 0xf9, 0x6b, 0x28, 0xb4, 0xb1, 0x1f, 0x54, 0xdb, 0x2c, 0xc4,
-	0x01, 0x16, 0xca, 0x1b, 0x90, 0x63, 0x7d, 0x88, 0x34, 0x4a, 0xaf, 0x9f, 0x2c, 0xe9, 0xe0, 0xc4,
-	0xfd, 0x35, 0x12, 0x9d, 0x4f, 0xec, 0x01, 0x03, 0xeb, 0x0e, 0x4c, 0x6f, 0xa3, 0x7d, 0x8c, 0xcd,
-	0xff, 0xc2, 0xb1, 0x48, 0x6a, 0x1d, 0xe4, 0x79, 0x4c, 0xd7, 0x72, 0x2e, 0x82, 0x6f, 0x78, 0x1e,
-	0x13, 0xfc, 0xdf, 0x42, 0xfb, 0xc2, 0x5e, 0xa9, 0xe9, 0xb2, 0x6a, 0x4c, 0xa8, 0x58, 0xdf, 0x1b,
-	0x90, 0xe9, 0xeb, 0xc1, 0x4b, 0x90, 0xd4, 0x73, 0x66, 0x1c, 0x7e, 0xce, 0xb4, 0x89, 0xf9, 0x36,
-	0x1c, 0x8f, 0xcc, 0x56, 0xe8, 0x74, 0x2f, 0xcf, 0x72, 0xeb, 0xb8, 0xbd, 0x14, 0x95, 0xee, 0x76,
-	0x84, 0xc2, 0x2c, 0x3a, 0x41, 0x11, 0xb3, 0xb8, 0x32, 0x8b, 0x4a, 0xbb, 0x66, 0xd6, 0x25, 0xc8,
-	0x5e, 0x69, 0x51, 0xb7, 0xb1, 0x41, 0x3c, 0x9b, 0xb6, 0x89, 0x27, 0x7a, 0x1b, 0x0b, 0x40, 0x1f,
-	0x74, 0x6a, 0x21, 0x50, 0x26, 0xc4, 0xfa, 0x92, 0xad, 0x16, 0xd6, 0xd7, 0x06, 0x24, 0xd5, 0x40,
-	0x8d, 0x89, 0xda, 0x18, 0x17, 0xf5, 0x29, 0x48, 0x29, 0x81, 0x3a, 0x3c, 0x05, 0xf9, 0xf5, 0x00,
-	0xf3, 0x1a, 0x64, 0xa2, 0x66, 0x6a, 0x68, 0x0e, 0x97, 0xcd, 0x3e, 0x43, 0xeb, 0x2b, 0x03, 0x32,
-	0x2a, 0xd0, 0x5d, 0xba, 0xe3, 0xd7, 0xe5, 0x6d, 0x60, 0xd4, 0x8d, 0x54, 0x1f, 0xf5, 0xfa, 0x2e,
-	0xda, 0x4d, 0x45, 0x6c, 0x64, 0x2a, 0xe2, 0x91, 0x54, 0x88, 0xe1, 0xc4, 0x0f, 0x38, 0x43, 0x4e,
-	0x03, 0x85, 0x0d, 0x7d, 0x95, 0x4f, 0x49, 0xe4, 0x3a, 0x0a, 0x1b, 0xa2, 0xd3, 0x15, 0x1d, 0x69,
-	0x86, 0xd7, 0x2b, 0xeb, 0x4b, 0x03, 0xe6, 0xae, 0xec, 0x61, 0xc2, 0xd5, 0x8c, 0x6c, 0x23, 0xdf,
-	0x9b, 0xa4, 0x39, 0x57, 0x00, 0x5a, 0xa2, 0xa1, 0xa3, 0xad, 0x99, 0x92, 0x88, 0x14, 0x5f, 0x80,
-	0x24, 0x0a, 0xe4, 0xad, 0x45, 0x1d, 0xbb, 0x07, 0x8f, 0x4a, 0x25, 0x21, 0x92, 0x6a, 0x6b, 0x75,
-	0xeb, 0x67, 0x03, 0x4c, 0x19, 0x96, 0x22, 0xfe, 0x3b, 0x2d, 0x0f, 0x71, 0xec, 0x99, 0x17, 0x60,
-	0xc6, 0x6d, 0x33, 0x86, 0xf5, 0x35, 0xe8, 0x95, 0x04, 0xdd, 0xd1, 0x36, 0xdf, 0x85, 0x59, 0x99,
-	0x26, 0x71, 0xe0, 0xc6, 0x0e, 0x65, 0x29, 0xd5, 0xb7, 0x3c, 0x73, 0x13, 0xa0, 0xad, 0x76, 0x77,
-	0x50, 0xe7, 0x35, 0x96, 0x4b, 0xea, 0x03, 0xb3, 0xd4, 0xf9, 0xc0, 0x2c, 0x75, 0x1b, 0xaa, 0x32,
-	0x2b, 0xfc, 0x3e, 0xfc, 0xbd, 0x60, 0xd8, 0x29, 0x6d, 0xb7, 0xc1, 0xad, 0x6f, 0x0d, 0xc8, 0xca,
-	0xd7, 0xb9, 0x85, 0xef, 0xab, 0x2e, 0x8f, 0x06, 0x64, 0x4c, 0x14, 0xd0, 0x0a, 0x40, 0xc8, 0x11,
-	0x13, 0x01, 0x55, 0xf7, 0x3b, 0x29, 0xd7, 0x48, 0x65, 0x5f, 0xc4, 0xdb, 0x11, 0x4f, 0x1a, 0xaf,
-	0xb6, 0xdb, 0xe0, 0xd6, 0x4d, 0x38, 0x26, 0xc3, 0xdd, 0xed, 0x96, 0xdb, 0x7b, 0x83, 0x8e, 0xb5,
-	0x7e, 0x89, 0xc3, 0x62, 0xe7, 0xf5, 0xfb, 0x78, 0xea, 0xc0, 0x6b, 0x50, 0x09, 0x16, 0x50, 0xbd,
-	0xce, 0x70, 0x1d, 0x71, 0xca, 0x9c, 0xbe, 0xd2, 0x65, 0xed, 0xf9, 0x9e, 0xc8, 0xd6, 0x49, 0xe9,
-	0x11, 0x5e, 0x7c, 0x72, 0xc2, 0x2b, 0x42, 0x3a, 0xd2, 0xd7, 0xea, 0xdb, 0xc5, 0x8e, 0x42, 0x63,
-	0xc8, 0x65, 0x7a, 0x1c, 0xb9, 0x0c, 0xd2, 0x47, 0xf2, 0x35, 0xe9, 0xa3, 0x9f, 0xa5, 0x66, 0x06,
-	0x59, 0x6a, 0xa8, 0x32, 0xb3, 0x23, 0x2a, 0x73, 0x03, 0xe6, 0x64, 0x31, 0x1c, 0x44, 0x3c, 0x95,
-	0x50, 0x79, 0xc7, 0x49, 0xaf, 0xff, 0xfb, 0x80, 0xd3, 0xb9, 0x8f, 0x95, 0xed, 0x2c, 0x8e, 0x2e,
-	0xad, 0xbf, 0x0c, 0xc8, 0xc9, 0x8a, 0xaa, 0xc3, 0x76, 0x07, 0xf3, 0xc3, 0xf5, 0xc7, 0xfb, 0x70,
-	0xaa, 0xc5, 0xf0, 0x9e, 0x4f, 0xdb, 0xe1, 0xc8, 0x0f, 0x64, 0x75, 0xc2, 0x9c, 0xec, 0xe8, 0x0c,
-	0x7d, 0x22, 0xbf, 0xc1, 0x45, 0xde, 0xbc, 0x0a, 0xfa, 0xa3, 0xc9, 0xf1, 0x49, 0x8d, 0xca, 0x32,
-	0x8f, 0xbf, 0x9b, 0xf4, 0xfe, 0x47, 0xb0, 0xc1, 0xed, 0x3e, 0x57, 0xdc, 0x27, 0xcf, 0x57, 0x8d,
-	0xa7, 0xcf, 0x57, 0x8d, 0x3f, 0x9e, 0xaf, 0x1a, 0x0f, 0x5f, 0xac, 0x4e, 0x3d, 0x7d, 0xb1, 0x3a,
-	0xf5, 0xeb, 0x8b, 0xd5, 0xa9, 0x8f, 0xb6, 0xea, 0x3e, 0x6f, 0xb4, 0xab, 0x25, 0x97, 0x06, 0xe5,
-	0xad, 0x8e, 0xdb, 0x1b, 0xa8, 0x1a, 0x96, 0xbb, 0x9b, 0x9c, 0x73, 0x29, 0xc3, 0xd1, 0xa5, 0xb8,
-	0x65, 0x97, 0xd5, 0xdf, 0x18, 0xa1, 0xfc, 0xdb, 0x8c, 0xef, 0xb7, 0x70, 0x58, 0x4d, 0xca, 0x51,
-	0x7d, 0xeb, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xc9, 0x77, 0x1a, 0x63, 0x54, 0x13, 0x00, 0x00,
+	// 2016 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
+	0x15, 0x77, 0xcf, 0x8c, 0xc7, 0x9e, 0x37, 0x33, 0x76, 0x5c, 0xb6, 0x93, 0x89, 0x37, 0xb1, 0xbd,
+	0x1d, 0x22, 0x0c, 0x52, 0x66, 0x58, 0x23, 0x58, 0x48, 0x24, 0xc0, 0x4e, 0xb2, 0x1b, 0x4b, 0x59,
+	0xc7, 0xb4, 0xbd, 0x8b, 0xe0, 0xd2, 0xaa, 0xe9, 0xae, 0x99, 0x29, 0x32, 0xd3, 0x35, 0x74, 0xd5,
+	0xcc, 0xc6, 0x7f, 0xc1, 0x22, 0x4e, 0x2b, 0x81, 0x38, 0xe7, 0x80, 0x10, 0x42, 0x42, 0xe2, 0xc0,
+	0x85, 0x0b, 0x27, 0x0e, 0x11, 0x42, 0xec, 0x72, 0x43, 0x1c, 0x16, 0x94, 0x1c, 0xc8, 0x9d, 0x13,
+	0x37, 0x54, 0x1f, 0xdd, 0xd3, 0x3d, 0x5f, 0x99, 0x89, 0x73, 0xb1, 0xa6, 0xde, 0xab, 0xf7, 0xea,
+	0xd5, 0xab, 0xdf, 0xfb, 0xbd, 0xaa, 0x36, 0xec, 0xd0, 0xe0, 0xc7, 0xc4, 0x13, 0xb4, 0x4f, 0x6a,
+	0xcc, 0x0b, 0x6b, 0xfd, 0x77, 0xea, 0x44, 0xe0, 0x77, 0xe4, 0xef, 0x6a, 0x37, 0x64, 0x82, 0xa1,
+	0xcd, 0x78, 0x42, 0x55, 0x0a, 0xcd, 0x84, 0xad, 0x6d, 0x8f, 0xf1, 0x0e, 0xe3, 0xb5, 0x3a, 0xe6,
+	0x24, 0xb6, 0xf2, 0x18, 0x0d, 0xb4, 0xd9, 0xd6, 0x55, 0xad, 0x77, 0xd5, 0xa8, 0xa6, 0x07, 0x46,
+	0xb5, 0xd1, 0x64, 0x4d, 0xa6, 0xe5, 0xf2, 0x97, 0x91, 0xee, 0x34, 0x19, 0x6b, 0xb6, 0x49, 0x4d,
+	0x8d, 0xea, 0xbd, 0x46, 0x4d, 0xd0, 0x0e, 0xe1, 0x02, 0x77, 0xba, 0x66, 0xc2, 0x1a, 0xee, 0xd0,
+	0x80, 0xd5, 0xd4, 0x5f, 0x2d, 0xb2, 0x7f, 0x6e, 0x41, 0xfe, 0x04, 0x87, 0xb8, 0xc3, 0xd1, 0x75,
+	0x80, 0x36, 0x0d, 0x1e, 0xbb, 0x3e, 0x09, 0x58, 0xa7, 0x62, 0xed, 0x5a, 0x7b, 0x05, 0xa7, 0x20,
+	0x25, 0xf7, 0xa4, 0x00, 0xed, 0xc3, 0x66, 0x17, 0x9f, 0xb3, 0x9e, 0x70, 0xeb, 0x6d, 0xe6, 0x3d,
+	0x76, 0x69, 0x20, 0x48, 0xd8, 0xc7, 0xed, 0x4a, 0x66, 0xd7, 0xda, 0xcb, 0x39, 0xeb, 0x5a, 0x79,
+	0x28, 0x75, 0x47, 0x46, 0x85, 0xde, 0x86, 0x52, 0x87, 0xf9, 0xbd, 0x36, 0x71, 0xb1, 0xdf, 0xa1,
+	0x41, 0x25, 0xab, 0x9c, 0x16, 0xb5, 0xec, 0x40, 0x8a, 0x6e, 0xaf, 0xbf, 0x7c, 0xba, 0x63, 0xfd,
+	0xec, 0x3f, 0xbf, 0xff, 0x2a, 0xc8, 0xe4, 0xe9, 0x50, 0xec, 0xdf, 0x66, 0x00, 0xde, 0x23, 0xc4,
+	0xbf, 0xcb, 0x82, 0x06, 0x6d, 0xa2, 0x0a, 0x2c, 0x71, 0xda, 0x0c, 0x48, 0xc8, 0x2b, 0xd6, 0x6e,
+	0x76, 0xaf, 0xe0, 0x44, 0x43, 0x64, 0x43, 0x49, 0x84, 0x38, 0xe0, 0x1d, 0x2a, 0x84, 0x54, 0x67,
+	0x94, 0x3a, 0x25, 0x43, 0x25, 0xb0, 0x1a, 0x6a, 0xe5, 0xb2, 0x63, 0x35, 0xd0, 0x4d, 0x58, 0x61,
+	0x81, 0xd7, 0xc2, 0x34, 0x70, 0x3d, 0xe5, 0xbd, 0x92, 0xdb, 0xb5, 0xf6, 0x4a, 0x4e, 0xd9, 0x48,
+	0xcd, 0x92, 0xdf, 0x84, 0x2b, 0xac, 0xd1, 0x48, 0xce, 0x73, 0xfb, 0x24, 0xe4, 0x94, 0x05, 0x95,
+	0x45, 0xb5, 0xdf, 0xcd, 0x48, 0xad, 0x0d, 0x3e, 0xd2, 0x4a, 0xf4, 0x65, 0x58, 0x1d, 0xb2, 0xab,
+	0xe4, 0x95, 0xff, 0x95, 0xf4, 0x7c, 0xf4, 0x00, 0xca, 0x26, 0x35, 0x5d, 0xb5, 0xe7, 0xca, 0xd2,
+	0xae, 0xb5, 0x57, 0xdc, 0xbf, 0x51, 0x1d, 0x0b, 0x96, 0xea, 0x07, 0x6a, 0xae, 0x4e, 0x8f, 0x53,
+	0xea, 0x24, 0x46, 0xf6, 0x9f, 0x2c, 0x58, 0x19, 0x24, 0xeb, 0x28, 0x68, 0x30, 0xf4, 0x35, 0xd8,
+	0x68, 0x63, 0x41, 0xb8, 0x88, 0x62, 0xf7, 0x69, 0x93, 0x70, 0xa1, 0x0e, 0xb5, 0xe4, 0x20, 0xad,
+	0xd3, 0xf3, 0xef, 0x29, 0x8d, 0x4e, 0x52, 0x26, 0x4a, 0x52, 0x09, 0xac, 0x20, 0x4a, 0x59, 0x20,
+	0x4f, 0xd1, 0xb8, 0xf1, 0x58, 0x2f, 0x10, 0x2a, 0x61, 0x39, 0xa7, 0xa8, 0x65, 0x77, 0xa5, 0x08,
+	0xdd, 0x81, 0xad, 0xf4, 0x82, 0x1a, 0x23, 0x41, 0xaf, 0x53, 0x27, 0xa1, 0xca, 0x58, 0xd6, 0xb9,
+	0x92, 0x5c, 0x56, 0xe1, 0xe4, 0x58, 0xa9, 0xed, 0xff, 0xe6, 0xa0, 0x94, 0xdc, 0x1f, 0xba, 0x02,
+	0x4b, 0x0d, 0x42, 0x7c, 0x97, 0xfa, 0x06, 0x86, 0x79, 0x39, 0x3c, 0xf2, 0xd1, 0x21, 0x40, 0x87,
+	0x06, 0x2e, 0x0e, 0xf8, 0xc7, 0x24, 0x54, 0xe1, 0x16, 0x0e, 0x6f, 0x3c, 0xfb, 0x62, 0x67, 0xe1,
+	0x9f, 0x5f, 0xec, 0xbc, 0xa5, 0x2b, 0x84, 0xfb, 0x8f, 0xab, 0x94, 0xd5, 0x3a, 0x58, 0xb4, 0xaa,
+	0x0f, 0x49, 0x13, 0x7b, 0xe7, 0xf7, 0x88, 0xe7, 0x14, 0x3a, 0x34, 0x38, 0x50, 0x56, 0xca, 0x07,
+	0x7e, 0x12, 0xf9, 0xc8, 0xce, 0xe3, 0x03, 0x3f, 0x31, 0x3e, 0x1e, 0xc1, 0x86, 0x2a, 0x95, 0x2e,
+	0x09, 0x5d, 0x56, 0xe7, 0x12, 0xec, 0x42, 0x42, 0x23, 0xa7, 0xbc, 0x5d, 0x37, 0xde, 0x36, 0x47,
+	0xbd, 0x1d, 0x05, 0xc2, 0x41, 0xd2, 0xf4, 0x84, 0x84, 0x8f, 0x06, 0x86, 0xe8, 0xfb, 0xb0, 0x19,
+	0x3b, 0x34, 0xe0, 0xe5, 0x31, 0xd8, 0x5e, 0xe9, 0x71, 0xdd, 0x78, 0x3c, 0x4b, 0x58, 0x0e, 0x95,
+	0x73, 0x7e, 0xb8, 0x9c, 0x6f, 0xc2, 0x4a, 0x2f, 0xa0, 0x3f, 0xe9, 0x11, 0x37, 0x24, 0x5d, 0x16,
+	0x0a, 0x0d, 0xc0, 0x65, 0xa7, 0xac, 0xa5, 0x8e, 0x16, 0xa2, 0x5d, 0x28, 0xfa, 0x84, 0x7b, 0x21,
+	0xed, 0xaa, 0x0d, 0x2e, 0xeb, 0x02, 0x4e, 0x88, 0xe4, 0x3a, 0xea, 0xb0, 0x74, 0x85, 0x17, 0xf4,
+	0x3a, 0x52, 0xa2, 0xea, 0x1b, 0xdd, 0x80, 0x72, 0x9d, 0xb6, 0xdb, 0x34, 0x68, 0x9a, 0x19, 0xa0,
+	0x66, 0x94, 0x8c, 0x50, 0x4f, 0xba, 0x06, 0x85, 0x16, 0xc1, 0xa1, 0xa8, 0x13, 0x2c, 0x2a, 0x45,
+	0x85, 0x96, 0x81, 0x00, 0x9d, 0xc1, 0xba, 0x4f, 0xfa, 0x54, 0x65, 0xca, 0x15, 0xad, 0x90, 0xf0,
+	0x16, 0x6b, 0xfb, 0x95, 0xd2, 0xec, 0x47, 0x87, 0x62, 0xfb, 0xb3, 0xc8, 0xdc, 0xfe, 0x24, 0x03,
+	0x2b, 0x77, 0x59, 0x20, 0x42, 0xec, 0x19, 0x4c, 0x8e, 0x00, 0xdd, 0x1a, 0x05, 0x7a, 0x82, 0x8a,
+	0x32, 0xd3, 0xa9, 0x28, 0x3b, 0x89, 0x8a, 0x72, 0x93, 0xa9, 0x68, 0x71, 0x4e, 0x2a, 0xca, 0xcf,
+	0x49, 0x45, 0x4b, 0xe3, 0xa8, 0xc8, 0x7e, 0x66, 0xc1, 0xda, 0x29, 0x31, 0x49, 0x38, 0x09, 0x59,
+	0x97, 0x71, 0xdc, 0x46, 0x1b, 0xb0, 0x28, 0xa8, 0x68, 0x13, 0x53, 0x82, 0x7a, 0x30, 0x8c, 0x87,
+	0xcc, 0x28, 0x1e, 0xbe, 0x0d, 0x79, 0xb3, 0x5a, 0x56, 0x31, 0xda, 0xdb, 0x13, 0x18, 0x6d, 0x40,
+	0x59, 0x8e, 0x31, 0xb8, 0xfd, 0xbd, 0x9f, 0x3e, 0xdd, 0x59, 0x78, 0xf9, 0x74, 0x67, 0xe1, 0x2f,
+	0x7f, 0xb8, 0xb5, 0x65, 0x1a, 0x5e, 0x93, 0xf5, 0x63, 0x13, 0x79, 0x5c, 0x24, 0x10, 0xb2, 0x5b,
+	0x6c, 0xca, 0x6e, 0x31, 0x12, 0xb4, 0xfd, 0x9b, 0x45, 0xcd, 0x85, 0x52, 0x40, 0x42, 0x41, 0xc9,
+	0x14, 0x32, 0x49, 0x53, 0xde, 0xe8, 0x61, 0x64, 0xe7, 0x3c, 0x8c, 0xdc, 0x9c, 0x87, 0xb1, 0x38,
+	0xb6, 0x2f, 0xa4, 0x29, 0x2e, 0xff, 0x06, 0x28, 0x6e, 0xe9, 0x8d, 0x52, 0xdc, 0xf2, 0x1b, 0xa7,
+	0xb8, 0xc2, 0x6b, 0x53, 0xdc, 0x28, 0x87, 0xc1, 0x0c, 0x1c, 0x56, 0x1c, 0xc5, 0x6c, 0x8a, 0x7f,
+	0x4a, 0x33, 0xf2, 0x4f, 0xf9, 0x62, 0xfc, 0xf3, 0xe7, 0x0c, 0x5c, 0x3e, 0x25, 0xe2, 0x10, 0x0b,
+	0xaf, 0xf5, 0x86, 0x4a, 0x2f, 0x41, 0x4e, 0xd9, 0xe9, 0xe4, 0x94, 0x1b, 0x43, 0x4e, 0xe9, 0x86,
+	0xb1, 0x38, 0xdc, 0x30, 0x8e, 0x61, 0x55, 0xd5, 0x51, 0x37, 0x2e, 0xad, 0x4a, 0x7e, 0x37, 0xbb,
+	0x57, 0xdc, 0xbf, 0x39, 0xa5, 0xc0, 0x07, 0x75, 0xe8, 0xac, 0x34, 0x52, 0xe3, 0xdb, 0xf7, 0x67,
+	0x2f, 0xf6, 0x2d, 0x53, 0xec, 0x63, 0x72, 0x65, 0xef, 0x43, 0xe5, 0x51, 0x88, 0xbd, 0x36, 0x49,
+	0x60, 0x8d, 0x2b, 0xae, 0xe6, 0xe8, 0xb2, 0xa4, 0x22, 0xf9, 0x4b, 0x5d, 0x1b, 0xcb, 0x8e, 0x19,
+	0xd9, 0x1f, 0xc1, 0xda, 0xfb, 0x98, 0x3b, 0x84, 0x76, 0xea, 0xbd, 0x90, 0x93, 0x0e, 0x91, 0x93,
+	0x0f, 0x60, 0x25, 0x4c, 0x49, 0x94, 0x51, 0x71, 0xff, 0x6a, 0xd5, 0x04, 0x27, 0xef, 0xe9, 0x89,
+	0xe8, 0x68, 0xe0, 0x0c, 0x19, 0xd8, 0x1f, 0xc2, 0xe2, 0x09, 0x3e, 0x27, 0x04, 0x7d, 0x05, 0x2e,
+	0x25, 0x52, 0xeb, 0x62, 0xdf, 0x0f, 0xcd, 0x59, 0xae, 0x26, 0xe4, 0x07, 0xbe, 0x1f, 0xca, 0x9e,
+	0xd3, 0xc5, 0xe7, 0xd2, 0x5e, 0x4f, 0x33, 0xc7, 0x6a, 0x64, 0x72, 0x8a, 0xfd, 0x47, 0x0b, 0x4a,
+	0x29, 0xdc, 0xdf, 0x81, 0xbc, 0xa9, 0x6d, 0x6b, 0x76, 0x0c, 0x1a, 0x13, 0xf4, 0x0d, 0xb8, 0x9c,
+	0xa8, 0x67, 0xee, 0xc6, 0x8f, 0x04, 0xb5, 0x74, 0xd6, 0xd9, 0x4c, 0x6a, 0xcf, 0x22, 0xa5, 0x34,
+	0x4b, 0x56, 0x6d, 0xc2, 0x2c, 0xab, 0xcd, 0x92, 0xda, 0xd8, 0xcc, 0xbe, 0x03, 0xe5, 0xfb, 0x5d,
+	0xe6, 0xb5, 0x0e, 0x02, 0xdf, 0x61, 0xbd, 0xc0, 0x97, 0xd8, 0x26, 0x52, 0x60, 0x9a, 0xab, 0x1e,
+	0x48, 0x69, 0x28, 0xd5, 0xe6, 0x31, 0xa1, 0x07, 0xf6, 0xaf, 0x2d, 0xc8, 0xeb, 0x22, 0x9e, 0x12,
+	0xb5, 0x35, 0x2d, 0xea, 0x6b, 0x50, 0xd0, 0x0a, 0xdd, 0xb0, 0x25, 0xe1, 0x0e, 0x04, 0xe8, 0x7d,
+	0x28, 0x25, 0xcd, 0x74, 0xd1, 0xcc, 0x96, 0xcd, 0x94, 0xa1, 0xfd, 0x4b, 0x0b, 0x4a, 0x3a, 0xd0,
+	0x33, 0x76, 0x4a, 0x9b, 0xea, 0xd6, 0x33, 0xee, 0xe6, 0x5d, 0xf2, 0x92, 0x77, 0xee, 0x38, 0x15,
+	0x99, 0xb1, 0xa9, 0xc8, 0x26, 0x52, 0x21, 0x8b, 0x93, 0x3c, 0x11, 0x21, 0x76, 0x5b, 0x98, 0xb7,
+	0xcc, 0x93, 0xa5, 0xa0, 0x24, 0x0f, 0x30, 0x6f, 0x49, 0xa4, 0x6b, 0x0a, 0x34, 0x5d, 0xc5, 0x8c,
+	0xec, 0x5f, 0x58, 0xb0, 0x7a, 0xbf, 0x4f, 0x02, 0xa1, 0x6b, 0xe4, 0x04, 0x53, 0x7f, 0x1e, 0x70,
+	0x5e, 0x07, 0xe8, 0x4a, 0x40, 0x27, 0xa1, 0x59, 0x50, 0x12, 0xa5, 0x7e, 0x17, 0xf2, 0xb8, 0xa3,
+	0x6e, 0x4a, 0xba, 0xd5, 0x4f, 0x2e, 0x95, 0xc3, 0x9c, 0x4c, 0xaa, 0x63, 0xa6, 0xdb, 0x7f, 0xb5,
+	0x00, 0xa9, 0xb0, 0x74, 0xb3, 0xf9, 0xb0, 0xeb, 0x63, 0x41, 0x7c, 0xf4, 0x2e, 0x2c, 0x79, 0xbd,
+	0x30, 0x24, 0xe6, 0xea, 0xf5, 0xca, 0xa6, 0x10, 0xcd, 0x46, 0xdf, 0x82, 0x65, 0x95, 0x26, 0xd9,
+	0xe4, 0x33, 0x33, 0x59, 0xaa, 0xe9, 0x47, 0x3e, 0xba, 0x0b, 0xd0, 0xd3, 0xab, 0xbb, 0x38, 0xda,
+	0xc6, 0x56, 0x55, 0x3f, 0xa4, 0xab, 0xd1, 0x43, 0xba, 0x1a, 0x03, 0xea, 0x70, 0x59, 0xfa, 0xfd,
+	0xf4, 0x5f, 0x3b, 0x96, 0x53, 0x30, 0x76, 0x07, 0xc2, 0xfe, 0x9d, 0x05, 0x65, 0xb5, 0x9d, 0x63,
+	0xf2, 0xb1, 0x46, 0x79, 0x32, 0x20, 0x6b, 0xae, 0x80, 0xae, 0x03, 0x70, 0x81, 0x43, 0x19, 0x50,
+	0xfd, 0x3c, 0x4a, 0xb9, 0x91, 0x1c, 0x9e, 0xcb, 0x78, 0x23, 0xf5, 0xbc, 0xf1, 0x1a, 0xbb, 0x03,
+	0x61, 0x7f, 0x00, 0x97, 0x54, 0xb8, 0x67, 0xf1, 0x71, 0xfb, 0x17, 0x40, 0xac, 0xfd, 0x59, 0x16,
+	0x36, 0xa2, 0xed, 0xa7, 0x78, 0x6a, 0xe2, 0xd5, 0xab, 0x0a, 0xeb, 0xb8, 0xd9, 0x0c, 0x49, 0x13,
+	0x0b, 0x16, 0xba, 0xa9, 0xa3, 0x2b, 0x3b, 0x6b, 0x03, 0x95, 0x63, 0x92, 0x32, 0x20, 0xbc, 0xec,
+	0xfc, 0x84, 0xb7, 0x0b, 0xc5, 0x04, 0xae, 0xf5, 0x1b, 0xcd, 0x49, 0x8a, 0xa6, 0x90, 0xcb, 0xe2,
+	0x34, 0x72, 0x19, 0xa6, 0x8f, 0xfc, 0x6b, 0xd2, 0x47, 0x9a, 0xa5, 0x96, 0x86, 0x59, 0x6a, 0xe4,
+	0x64, 0x96, 0xc7, 0x9c, 0xcc, 0x43, 0x58, 0x55, 0x87, 0xe1, 0xe2, 0xc0, 0xd7, 0x09, 0x55, 0xf7,
+	0xaa, 0xe2, 0xfe, 0x97, 0x26, 0x74, 0xe7, 0x14, 0x2b, 0x3b, 0x65, 0x92, 0x1c, 0xda, 0xff, 0xb3,
+	0x60, 0x45, 0x9d, 0xa8, 0x6e, 0xb6, 0xa7, 0x44, 0xcc, 0x86, 0x8f, 0xef, 0xc2, 0xb5, 0x6e, 0x48,
+	0xfa, 0x94, 0xf5, 0xf8, 0xd8, 0x0f, 0x01, 0xba, 0xc3, 0x5c, 0x8d, 0xe6, 0x8c, 0x7c, 0x0a, 0xb8,
+	0xc0, 0xe3, 0x01, 0xbd, 0x07, 0xe6, 0xa1, 0xe6, 0xd2, 0xa0, 0xc1, 0xd4, 0x31, 0x4f, 0xbf, 0x9b,
+	0x0c, 0xbe, 0x97, 0x38, 0xe0, 0xc5, 0xbf, 0xed, 0x4f, 0xa2, 0xbd, 0xcb, 0x39, 0xa7, 0x02, 0xb7,
+	0xc9, 0x64, 0x1c, 0x7f, 0x07, 0xde, 0x6a, 0x63, 0x2e, 0xdc, 0x09, 0x9d, 0xd1, 0x6c, 0x57, 0x4e,
+	0x39, 0x1b, 0xd7, 0x1d, 0xd3, 0xf7, 0xce, 0xec, 0xd0, 0xbd, 0xd3, 0x3e, 0x06, 0x14, 0x07, 0xe2,
+	0x10, 0x8f, 0xf5, 0x49, 0x48, 0xfc, 0xc9, 0xc1, 0xec, 0x40, 0x91, 0xcb, 0x70, 0x5d, 0x4e, 0x03,
+	0x8f, 0x98, 0xc5, 0x41, 0x89, 0x4e, 0xa5, 0xc4, 0xfe, 0x9b, 0x05, 0x5b, 0xb1, 0xc3, 0x7b, 0xd1,
+	0x8d, 0xf4, 0xfe, 0x13, 0x8f, 0x10, 0x7f, 0x9a, 0xe3, 0x03, 0x28, 0xc4, 0xf7, 0xd7, 0xb9, 0x3e,
+	0xba, 0xc4, 0x56, 0x93, 0xae, 0xd0, 0xd9, 0x8b, 0x5d, 0xa1, 0x7f, 0x65, 0xc1, 0xaa, 0xdc, 0xcb,
+	0x03, 0x82, 0xdb, 0xa2, 0x75, 0x2a, 0xb0, 0x20, 0xaf, 0x9f, 0x1e, 0xf4, 0x03, 0xd8, 0x54, 0x87,
+	0xa9, 0x69, 0xc3, 0x1d, 0x6c, 0x79, 0x8e, 0x28, 0xd7, 0xa5, 0x07, 0xdd, 0xd4, 0xe2, 0x04, 0xdb,
+	0x9f, 0x59, 0xb0, 0x76, 0x82, 0x43, 0x41, 0x3d, 0xda, 0x55, 0x12, 0x47, 0x06, 0x5a, 0x81, 0x25,
+	0xd9, 0x55, 0x09, 0xe7, 0x26, 0xd0, 0x68, 0x88, 0x8e, 0xe1, 0x52, 0x82, 0x1e, 0xdc, 0x10, 0x0b,
+	0x32, 0x4f, 0xda, 0x57, 0x13, 0xc6, 0x6a, 0xa5, 0x13, 0x58, 0x4b, 0x01, 0x54, 0x39, 0x9c, 0x63,
+	0x53, 0x97, 0x92, 0xd6, 0xd2, 0xa3, 0xfd, 0xf7, 0x1c, 0xc0, 0x20, 0xf1, 0x93, 0x73, 0x9e, 0xc2,
+	0x77, 0x66, 0xc6, 0x77, 0xd5, 0xc5, 0x40, 0xf1, 0xaa, 0x9a, 0xcc, 0xbd, 0xaa, 0x26, 0xf7, 0x61,
+	0x73, 0xd4, 0x1e, 0x37, 0x89, 0xe9, 0x05, 0xeb, 0xc3, 0x96, 0x07, 0x4d, 0x22, 0xc9, 0x51, 0x8e,
+	0x88, 0xa1, 0x5e, 0x6e, 0x3e, 0xcc, 0x94, 0xb4, 0x50, 0x71, 0x2a, 0x47, 0x57, 0x61, 0x99, 0x72,
+	0x57, 0x01, 0xce, 0x7c, 0x6b, 0x5b, 0xa2, 0x5c, 0x13, 0xcc, 0x10, 0x36, 0x97, 0x67, 0xc7, 0x66,
+	0xe1, 0x62, 0xd8, 0x44, 0x3f, 0x84, 0xf5, 0x6e, 0x12, 0x9a, 0x0a, 0x1c, 0xf2, 0x1d, 0x2d, 0x9f,
+	0x3e, 0x7b, 0x13, 0xd8, 0x73, 0x04, 0xcc, 0x0e, 0xea, 0x0e, 0x8b, 0x38, 0xba, 0x05, 0x83, 0xe3,
+	0x71, 0x89, 0x61, 0x19, 0xf5, 0xfa, 0x5e, 0x76, 0xd6, 0xfc, 0x61, 0xfa, 0x39, 0xf4, 0x9e, 0x3d,
+	0xdf, 0xb6, 0x3e, 0x7f, 0xbe, 0x6d, 0xfd, 0xfb, 0xf9, 0xb6, 0xf5, 0xe9, 0x8b, 0xed, 0x85, 0xcf,
+	0x5f, 0x6c, 0x2f, 0xfc, 0xe3, 0xc5, 0xf6, 0xc2, 0x8f, 0x8e, 0x9a, 0x54, 0xb4, 0x7a, 0xf5, 0xaa,
+	0xc7, 0x3a, 0xb5, 0xa3, 0x28, 0xa0, 0x87, 0xb8, 0xce, 0x6b, 0x71, 0x78, 0xb7, 0x3c, 0x16, 0x92,
+	0xe4, 0x50, 0x7e, 0x51, 0xa9, 0xe9, 0xcf, 0xe4, 0x5c, 0xfd, 0x5b, 0x46, 0x9c, 0x77, 0x09, 0xaf,
+	0xe7, 0xd5, 0x15, 0xe9, 0xeb, 0xff, 0x1f, 0x00, 0xc9, 0xad, 0x8d, 0x46, 0xb4, 0x19, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -1782,6 +2221,21 @@ func (m *ModuleParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DeviationThreshold.Size()
+		i -= size
+		if _, err := m.DeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	if m.Heartbeat != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.Heartbeat))
+		i--
+		dAtA[i] = 0x58
+	}
 	if len(m.BillingAdmin) > 0 {
 		i -= len(m.BillingAdmin)
 		copy(dAtA[i:], m.BillingAdmin)
@@ -2009,6 +2463,21 @@ func (m *FeedProperties) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.DeviationThreshold.Size()
+		i -= size
+		if _, err := m.DeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.Heartbeat != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.Heartbeat))
+		i--
+		dAtA[i] = 0x60
+	}
 	if len(m.Description) > 0 {
 		i -= len(m.Description)
 		copy(dAtA[i:], m.Description)
@@ -2807,168 +3276,502 @@ func (m *EventConfigSet) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintOcr(dAtA []byte, offset int, v uint64) int {
-	offset -= sovOcr(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventFeedStale) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventFeedStale) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeedStale) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LinkDenom)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
+	if m.Heartbeat != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.Heartbeat))
+		i--
+		dAtA[i] = 0x18
 	}
-	if m.PayoutBlockInterval != 0 {
-		n += 1 + sovOcr(uint64(m.PayoutBlockInterval))
+	if m.LastTransmissionTimestamp != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.LastTransmissionTimestamp))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.ModuleAdmin)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FeedConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFeedRecovered) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeedRecovered) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeedRecovered) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovOcr(uint64(l))
-		}
-	}
-	if len(m.Transmitters) > 0 {
-		for _, s := range m.Transmitters {
-			l = len(s)
-			n += 1 + l + sovOcr(uint64(l))
-		}
-	}
-	if m.F != 0 {
-		n += 1 + sovOcr(uint64(m.F))
-	}
-	l = len(m.OnchainConfig)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
-	}
-	if m.OffchainConfigVersion != 0 {
-		n += 1 + sovOcr(uint64(m.OffchainConfigVersion))
-	}
-	l = len(m.OffchainConfig)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
+	if m.StaleSince != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.StaleSince))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.ModuleParams != nil {
-		l = m.ModuleParams.Size()
-		n += 1 + l + sovOcr(uint64(l))
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *FeedConfigInfo) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *EventFeedDeviationExceeded) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *EventFeedDeviationExceeded) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventFeedDeviationExceeded) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.LatestConfigDigest)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
-	}
-	if m.F != 0 {
-		n += 1 + sovOcr(uint64(m.F))
-	}
-	if m.N != 0 {
-		n += 1 + sovOcr(uint64(m.N))
+	{
+		size := m.DeviationThreshold.Size()
+		i -= size
+		if _, err := m.DeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
 	}
-	if m.ConfigCount != 0 {
-		n += 1 + sovOcr(uint64(m.ConfigCount))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Deviation.Size()
+		i -= size
+		if _, err := m.Deviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
 	}
-	if m.LatestConfigBlockNumber != 0 {
-		n += 1 + sovOcr(uint64(m.LatestConfigBlockNumber))
+	i--
+	dAtA[i] = 0x12
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ModuleParams) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FeedHealthState) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedHealthState) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedHealthState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.FeedId)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
-	}
-	l = m.MinAnswer.Size()
-	n += 1 + l + sovOcr(uint64(l))
-	l = m.MaxAnswer.Size()
-	n += 1 + l + sovOcr(uint64(l))
-	l = m.LinkPerObservation.Size()
-	n += 1 + l + sovOcr(uint64(l))
-	l = m.LinkPerTransmission.Size()
-	n += 1 + l + sovOcr(uint64(l))
-	l = len(m.LinkDenom)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
-	}
-	if m.UniqueReports {
-		n += 2
-	}
-	l = len(m.Description)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
+	{
+		size := m.LastAnswerDeviation.Size()
+		i -= size
+		if _, err := m.LastAnswerDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
 	}
-	l = len(m.FeedAdmin)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.StaleSince != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.StaleSince))
+		i--
+		dAtA[i] = 0x10
 	}
-	l = len(m.BillingAdmin)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *ContractConfig) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *ParticipationRate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *ParticipationRate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ParticipationRate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.ConfigCount != 0 {
-		n += 1 + sovOcr(uint64(m.ConfigCount))
-	}
-	if len(m.Signers) > 0 {
-		for _, s := range m.Signers {
-			l = len(s)
-			n += 1 + l + sovOcr(uint64(l))
+	{
+		size := m.TransmissionRate.Size()
+		i -= size
+		if _, err := m.TransmissionRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
 	}
-	if len(m.Transmitters) > 0 {
-		for _, s := range m.Transmitters {
-			l = len(s)
-			n += 1 + l + sovOcr(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.ObservationRate.Size()
+		i -= size
+		if _, err := m.ObservationRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
 	}
-	if m.F != 0 {
-		n += 1 + sovOcr(uint64(m.F))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = len(m.OnchainConfig)
-	if l > 0 {
-		n += 1 + l + sovOcr(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *FeedHealth) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeedHealth) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeedHealth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DeviationExceeded {
+		i--
+		if m.DeviationExceeded {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.ParticipationRates) > 0 {
+		for iNdEx := len(m.ParticipationRates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ParticipationRates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintOcr(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	{
+		size := m.LastAnswerDeviation.Size()
+		i -= size
+		if _, err := m.LastAnswerDeviation.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.StaleSince != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.StaleSince))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.IsStale {
+		i--
+		if m.IsStale {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MissedRounds != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.MissedRounds))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.LastTransmissionAge != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.LastTransmissionAge))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.LastTransmissionTimestamp != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.LastTransmissionTimestamp))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.DeviationThreshold.Size()
+		i -= size
+		if _, err := m.DeviationThreshold.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOcr(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.Heartbeat != 0 {
+		i = encodeVarintOcr(dAtA, i, uint64(m.Heartbeat))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintOcr(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintOcr(dAtA []byte, offset int, v uint64) int {
+	offset -= sovOcr(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LinkDenom)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.PayoutBlockInterval != 0 {
+		n += 1 + sovOcr(uint64(m.PayoutBlockInterval))
+	}
+	l = len(m.ModuleAdmin)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovOcr(uint64(l))
+		}
+	}
+	if len(m.Transmitters) > 0 {
+		for _, s := range m.Transmitters {
+			l = len(s)
+			n += 1 + l + sovOcr(uint64(l))
+		}
+	}
+	if m.F != 0 {
+		n += 1 + sovOcr(uint64(m.F))
+	}
+	l = len(m.OnchainConfig)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.OffchainConfigVersion != 0 {
+		n += 1 + sovOcr(uint64(m.OffchainConfigVersion))
+	}
+	l = len(m.OffchainConfig)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.ModuleParams != nil {
+		l = m.ModuleParams.Size()
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	return n
+}
+
+func (m *FeedConfigInfo) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LatestConfigDigest)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.F != 0 {
+		n += 1 + sovOcr(uint64(m.F))
+	}
+	if m.N != 0 {
+		n += 1 + sovOcr(uint64(m.N))
+	}
+	if m.ConfigCount != 0 {
+		n += 1 + sovOcr(uint64(m.ConfigCount))
+	}
+	if m.LatestConfigBlockNumber != 0 {
+		n += 1 + sovOcr(uint64(m.LatestConfigBlockNumber))
+	}
+	return n
+}
+
+func (m *ModuleParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = m.MinAnswer.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	l = m.MaxAnswer.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	l = m.LinkPerObservation.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	l = m.LinkPerTransmission.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	l = len(m.LinkDenom)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.UniqueReports {
+		n += 2
+	}
+	l = len(m.Description)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = len(m.FeedAdmin)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = len(m.BillingAdmin)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.Heartbeat != 0 {
+		n += 1 + sovOcr(uint64(m.Heartbeat))
+	}
+	l = m.DeviationThreshold.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	return n
+}
+
+func (m *ContractConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.ConfigCount != 0 {
+		n += 1 + sovOcr(uint64(m.ConfigCount))
+	}
+	if len(m.Signers) > 0 {
+		for _, s := range m.Signers {
+			l = len(s)
+			n += 1 + l + sovOcr(uint64(l))
+		}
+	}
+	if len(m.Transmitters) > 0 {
+		for _, s := range m.Transmitters {
+			l = len(s)
+			n += 1 + l + sovOcr(uint64(l))
+		}
+	}
+	if m.F != 0 {
+		n += 1 + sovOcr(uint64(m.F))
+	}
+	l = len(m.OnchainConfig)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
 	}
 	if m.OffchainConfigVersion != 0 {
 		n += 1 + sovOcr(uint64(m.OffchainConfigVersion))
@@ -3040,6 +3843,11 @@ func (m *FeedProperties) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovOcr(uint64(l))
 	}
+	if m.Heartbeat != 0 {
+		n += 1 + sovOcr(uint64(m.Heartbeat))
+	}
+	l = m.DeviationThreshold.Size()
+	n += 1 + l + sovOcr(uint64(l))
 	return n
 }
 
@@ -3345,11 +4153,142 @@ func (m *EventConfigSet) Size() (n int) {
 	return n
 }
 
-func sovOcr(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozOcr(x uint64) (n int) {
-	return sovOcr(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *EventFeedStale) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.LastTransmissionTimestamp != 0 {
+		n += 1 + sovOcr(uint64(m.LastTransmissionTimestamp))
+	}
+	if m.Heartbeat != 0 {
+		n += 1 + sovOcr(uint64(m.Heartbeat))
+	}
+	return n
+}
+
+func (m *EventFeedRecovered) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.StaleSince != 0 {
+		n += 1 + sovOcr(uint64(m.StaleSince))
+	}
+	return n
+}
+
+func (m *EventFeedDeviationExceeded) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = m.Deviation.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	l = m.DeviationThreshold.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	return n
+}
+
+func (m *FeedHealthState) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.StaleSince != 0 {
+		n += 1 + sovOcr(uint64(m.StaleSince))
+	}
+	l = m.LastAnswerDeviation.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	return n
+}
+
+func (m *ParticipationRate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	l = m.ObservationRate.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	l = m.TransmissionRate.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	return n
+}
+
+func (m *FeedHealth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovOcr(uint64(l))
+	}
+	if m.Heartbeat != 0 {
+		n += 1 + sovOcr(uint64(m.Heartbeat))
+	}
+	l = m.DeviationThreshold.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	if m.LastTransmissionTimestamp != 0 {
+		n += 1 + sovOcr(uint64(m.LastTransmissionTimestamp))
+	}
+	if m.LastTransmissionAge != 0 {
+		n += 1 + sovOcr(uint64(m.LastTransmissionAge))
+	}
+	if m.MissedRounds != 0 {
+		n += 1 + sovOcr(uint64(m.MissedRounds))
+	}
+	if m.IsStale {
+		n += 2
+	}
+	if m.StaleSince != 0 {
+		n += 1 + sovOcr(uint64(m.StaleSince))
+	}
+	l = m.LastAnswerDeviation.Size()
+	n += 1 + l + sovOcr(uint64(l))
+	if len(m.ParticipationRates) > 0 {
+		for _, e := range m.ParticipationRates {
+			l = e.Size()
+			n += 1 + l + sovOcr(uint64(l))
+		}
+	}
+	if m.DeviationExceeded {
+		n += 2
+	}
+	return n
+}
+
+func sovOcr(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozOcr(x uint64) (n int) {
+	return sovOcr(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
 func (m *Params) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
@@ -3361,28 +4300,861 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if shift >= 64 {
 				return ErrIntOverflowOcr
 			}
-			if iNdEx >= l {
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayoutBlockInterval", wireType)
+			}
+			m.PayoutBlockInterval = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayoutBlockInterval |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAdmin", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ModuleAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OnchainConfig == nil {
+				m.OnchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
+			}
+			m.OffchainConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OffchainConfig == nil {
+				m.OffchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ModuleParams", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ModuleParams == nil {
+				m.ModuleParams = &ModuleParams{}
+			}
+			if err := m.ModuleParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeedConfigInfo) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeedConfigInfo: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeedConfigInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestConfigDigest", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LatestConfigDigest = append(m.LatestConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.LatestConfigDigest == nil {
+				m.LatestConfigDigest = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
+			}
+			m.N = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.N |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
+			}
+			m.ConfigCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LatestConfigBlockNumber", wireType)
+			}
+			m.LatestConfigBlockNumber = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LatestConfigBlockNumber |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ModuleParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ModuleParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ModuleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAnswer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnswer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerObservation", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LinkPerObservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerTransmission", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LinkPerTransmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LinkDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UniqueReports", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.UniqueReports = bool(v != 0)
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedAdmin", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedAdmin = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BillingAdmin", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3410,13 +5182,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkDenom = string(dAtA[iNdEx:postIndex])
+			m.BillingAdmin = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 11:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayoutBlockInterval", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
 			}
-			m.PayoutBlockInterval = 0
+			m.Heartbeat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -3426,14 +5198,14 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.PayoutBlockInterval |= uint64(b&0x7F) << shift
+				m.Heartbeat |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 12:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3461,7 +5233,9 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ModuleAdmin = string(dAtA[iNdEx:postIndex])
+			if err := m.DeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -3484,7 +5258,7 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedConfig) Unmarshal(dAtA []byte) error {
+func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3507,13 +5281,32 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: ContractConfig: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ContractConfig: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
+			}
+			m.ConfigCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfigCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
@@ -3545,7 +5338,7 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
 			}
@@ -3577,7 +5370,7 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 			}
 			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
 			}
@@ -3596,7 +5389,7 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 4:
+		case 5:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
 			}
@@ -3630,7 +5423,7 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 				m.OnchainConfig = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
 			}
@@ -3649,7 +5442,7 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		case 6:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
 			}
@@ -3683,42 +5476,6 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 				m.OffchainConfig = []byte{}
 			}
 			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ModuleParams", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ModuleParams == nil {
-				m.ModuleParams = &ModuleParams{}
-			}
-			if err := m.ModuleParams.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -3740,7 +5497,7 @@ func (m *FeedConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedConfigInfo) Unmarshal(dAtA []byte) error {
+func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3763,17 +5520,17 @@ func (m *FeedConfigInfo) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedConfigInfo: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetConfigProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedConfigInfo: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestConfigDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -3783,31 +5540,29 @@ func (m *FeedConfigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LatestConfigDigest = append(m.LatestConfigDigest[:0], dAtA[iNdEx:postIndex]...)
-			if m.LatestConfigDigest == nil {
-				m.LatestConfigDigest = []byte{}
-			}
+			m.Title = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
-			m.F = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -3817,54 +5572,29 @@ func (m *FeedConfigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.F |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field N", wireType)
-			}
-			m.N = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.N |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
 			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
 			}
-			m.ConfigCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ConfigCount |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
-		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LatestConfigBlockNumber", wireType)
+			m.Description = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			m.LatestConfigBlockNumber = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -3874,11 +5604,28 @@ func (m *FeedConfigInfo) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.LatestConfigBlockNumber |= int64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Config == nil {
+				m.Config = &FeedConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -3900,7 +5647,7 @@ func (m *FeedConfigInfo) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ModuleParams) Unmarshal(dAtA []byte) error {
+func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3923,10 +5670,10 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ModuleParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedProperties: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ModuleParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedProperties: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -3962,10 +5709,29 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			}
+			m.F = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.F |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinAnswer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -3975,29 +5741,82 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MinAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OnchainConfig == nil {
+				m.OnchainConfig = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
+			}
+			m.OffchainConfigVersion = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnswer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
+			if m.OffchainConfig == nil {
+				m.OffchainConfig = []byte{}
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinAnswer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4025,13 +5844,13 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MinAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerObservation", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnswer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4059,13 +5878,13 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LinkPerObservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.MaxAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerTransmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerObservation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4093,13 +5912,13 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LinkPerTransmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LinkPerObservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerTransmission", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4127,9 +5946,11 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkDenom = string(dAtA[iNdEx:postIndex])
+			if err := m.LinkPerTransmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 10:
 			if wireType != 0 {
 				return fmt.Errorf("proto: wrong wireType = %d for field UniqueReports", wireType)
 			}
@@ -4149,7 +5970,7 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.UniqueReports = bool(v != 0)
-		case 8:
+		case 11:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
@@ -4181,11 +6002,11 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			}
 			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedAdmin", wireType)
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
 			}
-			var stringLen uint64
+			m.Heartbeat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4195,27 +6016,14 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Heartbeat |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.FeedAdmin = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 10:
+		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BillingAdmin", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4243,7 +6051,9 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BillingAdmin = string(dAtA[iNdEx:postIndex])
+			if err := m.DeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4266,7 +6076,7 @@ func (m *ModuleParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ContractConfig) Unmarshal(dAtA []byte) error {
+func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4289,17 +6099,17 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ContractConfig: wiretype end group for non-group")
+			return fmt.Errorf("proto: SetBatchConfigProposal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ContractConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: SetBatchConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
 			}
-			m.ConfigCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4309,14 +6119,27 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ConfigCount |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Title = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4344,11 +6167,11 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Description = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4376,13 +6199,13 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
 			}
-			m.F = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4392,16 +6215,29 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.F |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4411,50 +6247,29 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
-			if m.OnchainConfig == nil {
-				m.OnchainConfig = []byte{}
-			}
+			m.LinkDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
-			}
-			m.OffchainConfigVersion = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedProperties", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4464,24 +6279,24 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
-			if m.OffchainConfig == nil {
-				m.OffchainConfig = []byte{}
+			m.FeedProperties = append(m.FeedProperties, &FeedProperties{})
+			if err := m.FeedProperties[len(m.FeedProperties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -4505,7 +6320,7 @@ func (m *ContractConfig) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
+func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4528,79 +6343,141 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetConfigProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: OracleObservationsCounts: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: OracleObservationsCounts: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOcr
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
+				m.Counts = append(m.Counts, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowOcr
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				if packedLen < 0 {
+					return ErrInvalidLengthOcr
 				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Title = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthOcr
 				}
-				if iNdEx >= l {
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.Counts) == 0 {
+					m.Counts = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowOcr
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.Counts = append(m.Counts, v)
 				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcr
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GasReimbursements: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GasReimbursements: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reimbursements", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -4627,10 +6504,8 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &FeedConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reimbursements = append(m.Reimbursements, &types.Coin{})
+			if err := m.Reimbursements[len(m.Reimbursements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -4655,7 +6530,7 @@ func (m *SetConfigProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *FeedProperties) Unmarshal(dAtA []byte) error {
+func (m *Payee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4678,15 +6553,15 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: FeedProperties: wiretype end group for non-group")
+			return fmt.Errorf("proto: Payee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: FeedProperties: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Payee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4714,13 +6589,13 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedId = string(dAtA[iNdEx:postIndex])
+			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field F", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddr", wireType)
 			}
-			m.F = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4730,16 +6605,79 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.F |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PaymentAddr = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Transmission) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Transmission: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Transmission: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OnchainConfig", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4749,31 +6687,31 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OnchainConfig = append(m.OnchainConfig[:0], dAtA[iNdEx:postIndex]...)
-			if m.OnchainConfig == nil {
-				m.OnchainConfig = []byte{}
+			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfigVersion", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
 			}
-			m.OffchainConfigVersion = 0
+			m.ObservationsTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4783,16 +6721,16 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OffchainConfigVersion |= uint64(b&0x7F) << shift
+				m.ObservationsTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OffchainConfig", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmissionTimestamp", wireType)
 			}
-			var byteLen int
+			m.TransmissionTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4802,31 +6740,66 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.TransmissionTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOcr
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcr
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OffchainConfig = append(m.OffchainConfig[:0], dAtA[iNdEx:postIndex]...)
-			if m.OffchainConfig == nil {
-				m.OffchainConfig = []byte{}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
 			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MinAnswer", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EpochAndRound: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EpochAndRound: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4836,31 +6809,16 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.MinAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxAnswer", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
 			}
-			var stringLen uint64
+			m.Round = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4870,31 +6828,66 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Round |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcr
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.MaxAnswer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Report) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
 			}
-			iNdEx = postIndex
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerObservation", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var stringLen uint64
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Report: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+			}
+			m.ObservationsTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4904,31 +6897,16 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.ObservationsTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if err := m.LinkPerObservation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkPerTransmission", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -4938,49 +6916,29 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.LinkPerTransmission.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UniqueReports", wireType)
-			}
-			var v int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				v |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			m.UniqueReports = bool(v != 0)
-		case 11:
+			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
+			if m.Observers == nil {
+				m.Observers = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5008,7 +6966,11 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			var v cosmossdk_io_math.LegacyDec
+			m.Observations = append(m.Observations, v)
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5031,7 +6993,7 @@ func (m *FeedProperties) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
+func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5054,17 +7016,17 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetBatchConfigProposal: wiretype end group for non-group")
+			return fmt.Errorf("proto: ReportToSign: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetBatchConfigProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ReportToSign: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Title", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5074,29 +7036,69 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Title = string(dAtA[iNdEx:postIndex])
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			}
+			m.Epoch = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Epoch |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+			}
+			m.Round = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Round |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Description", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExtraHash", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5106,29 +7108,31 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Description = string(dAtA[iNdEx:postIndex])
+			m.ExtraHash = append(m.ExtraHash[:0], dAtA[iNdEx:postIndex]...)
+			if m.ExtraHash == nil {
+				m.ExtraHash = []byte{}
+			}
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5138,27 +7142,79 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signers = append(m.Signers, string(dAtA[iNdEx:postIndex]))
+			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
+			if m.Report == nil {
+				m.Report = []byte{}
+			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOraclePaid: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOraclePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transmitters", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5186,11 +7242,11 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transmitters = append(m.Transmitters, string(dAtA[iNdEx:postIndex]))
+			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field LinkDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddr", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5218,11 +7274,11 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.LinkDenom = string(dAtA[iNdEx:postIndex])
+			m.PayeeAddr = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field FeedProperties", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5249,8 +7305,7 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.FeedProperties = append(m.FeedProperties, &FeedProperties{})
-			if err := m.FeedProperties[len(m.FeedProperties)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5275,7 +7330,7 @@ func (m *SetBatchConfigProposal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
+func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5298,88 +7353,113 @@ func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: OracleObservationsCounts: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAnswerUpdated: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: OracleObservationsCounts: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAnswerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOcr
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
 				}
-				m.Counts = append(m.Counts, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowOcr
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-				if packedLen < 0 {
-					return ErrInvalidLengthOcr
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthOcr
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
 				}
-				if postIndex > l {
+				if iNdEx >= l {
 					return io.ErrUnexpectedEOF
 				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-				elementCount = count
-				if elementCount != 0 && len(m.Counts) == 0 {
-					m.Counts = make([]uint32, 0, elementCount)
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
 				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowOcr
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.Counts = append(m.Counts, v)
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field Counts", wireType)
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -5401,7 +7481,7 @@ func (m *OracleObservationsCounts) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
+func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5424,15 +7504,81 @@ func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GasReimbursements: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewRound: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GasReimbursements: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewRound: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reimbursements", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5459,8 +7605,7 @@ func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reimbursements = append(m.Reimbursements, &types.Coin{})
-			if err := m.Reimbursements[len(m.Reimbursements)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5485,7 +7630,7 @@ func (m *GasReimbursements) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Payee) Unmarshal(dAtA []byte) error {
+func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5508,17 +7653,17 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Payee: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventTransmitted: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Payee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventTransmitted: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5528,29 +7673,31 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PaymentAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
 			}
-			var stringLen uint64
+			m.Epoch = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5560,24 +7707,11 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Epoch |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.PaymentAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -5599,7 +7733,7 @@ func (m *Payee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Transmission) Unmarshal(dAtA []byte) error {
+func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5622,13 +7756,64 @@ func (m *Transmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Transmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventNewTransmission: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Transmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventNewTransmission: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AggregatorRoundId", wireType)
+			}
+			m.AggregatorRoundId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AggregatorRoundId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
 			}
@@ -5662,30 +7847,11 @@ func (m *Transmission) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
-			}
-			m.ObservationsTimestamp = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.ObservationsTimestamp |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransmissionTimestamp", wireType)
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transmitter", wireType)
 			}
-			m.TransmissionTimestamp = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5695,66 +7861,29 @@ func (m *Transmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TransmissionTimestamp |= int64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcr
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EpochAndRound: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EpochAndRound: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.Transmitter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
 			}
-			m.Epoch = 0
+			m.ObservationsTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5764,16 +7893,16 @@ func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.ObservationsTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
 			}
-			m.Round = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5783,66 +7912,33 @@ func (m *EpochAndRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *Report) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcr
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			var v cosmossdk_io_math.LegacyDec
+			m.Observations = append(m.Observations, v)
+			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: Report: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Report: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
 			}
-			m.ObservationsTimestamp = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5852,14 +7948,29 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservationsTimestamp |= int64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
+			if m.Observers == nil {
+				m.Observers = []byte{}
+			}
+			iNdEx = postIndex
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
 			}
 			var byteLen int
 			for shift := uint(0); ; shift += 7 {
@@ -5886,16 +7997,16 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
-			if m.Observers == nil {
-				m.Observers = []byte{}
+			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
+			if m.ConfigDigest == nil {
+				m.ConfigDigest = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EpochAndRound", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -5905,25 +8016,25 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Observations = append(m.Observations, v)
-			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.EpochAndRound == nil {
+				m.EpochAndRound = &EpochAndRound{}
+			}
+			if err := m.EpochAndRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -5948,7 +8059,7 @@ func (m *Report) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ReportToSign) Unmarshal(dAtA []byte) error {
+func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5971,10 +8082,10 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ReportToSign: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConfigSet: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ReportToSign: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConfigSet: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6013,9 +8124,9 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConfigBlockNumber", wireType)
 			}
-			m.Epoch = 0
+			m.PreviousConfigBlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6025,35 +8136,16 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				m.PreviousConfigBlockNumber |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Round", wireType)
-			}
-			m.Round = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Round |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ExtraHash", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6063,31 +8155,33 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ExtraHash = append(m.ExtraHash[:0], dAtA[iNdEx:postIndex]...)
-			if m.ExtraHash == nil {
-				m.ExtraHash = []byte{}
+			if m.Config == nil {
+				m.Config = &FeedConfig{}
+			}
+			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Report", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfigInfo", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6097,24 +8191,26 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Report = append(m.Report[:0], dAtA[iNdEx:postIndex]...)
-			if m.Report == nil {
-				m.Report = []byte{}
+			if m.ConfigInfo == nil {
+				m.ConfigInfo = &FeedConfigInfo{}
+			}
+			if err := m.ConfigInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
@@ -6138,7 +8234,7 @@ func (m *ReportToSign) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
+func (m *EventFeedStale) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6161,15 +8257,15 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOraclePaid: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeedStale: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOraclePaid: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeedStale: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransmitterAddr", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6197,13 +8293,13 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TransmitterAddr = string(dAtA[iNdEx:postIndex])
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PayeeAddr", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransmissionTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.LastTransmissionTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6213,29 +8309,85 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastTransmissionTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
+			m.Heartbeat = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Heartbeat |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOcr(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthOcr
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PayeeAddr = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventFeedRecovered) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOcr
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventFeedRecovered: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventFeedRecovered: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6245,25 +8397,43 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleSince", wireType)
+			}
+			m.StaleSince = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.StaleSince |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -6285,7 +8455,7 @@ func (m *EventOraclePaid) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
+func (m *EventFeedDeviationExceeded) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6308,15 +8478,15 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAnswerUpdated: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventFeedDeviationExceeded: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAnswerUpdated: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventFeedDeviationExceeded: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Current", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6344,13 +8514,11 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Current.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Deviation", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6378,15 +8546,15 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Deviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UpdatedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6396,22 +8564,23 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.UpdatedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6436,7 +8605,7 @@ func (m *EventAnswerUpdated) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewRound) Unmarshal(dAtA []byte) error {
+func (m *FeedHealthState) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6459,15 +8628,15 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewRound: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedHealthState: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewRound: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedHealthState: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoundId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6495,15 +8664,13 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RoundId.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedBy", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleSince", wireType)
 			}
-			var stringLen uint64
+			m.StaleSince = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6513,29 +8680,16 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.StaleSince |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.StartedBy = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field StartedAt", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAnswerDeviation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6545,22 +8699,23 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.StartedAt, dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.LastAnswerDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6585,7 +8740,7 @@ func (m *EventNewRound) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
+func (m *ParticipationRate) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6608,17 +8763,17 @@ func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventTransmitted: wiretype end group for non-group")
+			return fmt.Errorf("proto: ParticipationRate: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventTransmitted: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ParticipationRate: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6628,31 +8783,29 @@ func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConfigDigest == nil {
-				m.ConfigDigest = []byte{}
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Epoch", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ObservationRate", wireType)
 			}
-			m.Epoch = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6662,11 +8815,60 @@ func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Epoch |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ObservationRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransmissionRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOcr
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOcr
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOcr
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TransmissionRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -6688,7 +8890,7 @@ func (m *EventTransmitted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
+func (m *FeedHealth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6711,10 +8913,10 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventNewTransmission: wiretype end group for non-group")
+			return fmt.Errorf("proto: FeedHealth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventNewTransmission: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FeedHealth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6751,9 +8953,9 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AggregatorRoundId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Heartbeat", wireType)
 			}
-			m.AggregatorRoundId = 0
+			m.Heartbeat = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6763,14 +8965,14 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AggregatorRoundId |= uint32(b&0x7F) << shift
+				m.Heartbeat |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Answer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationThreshold", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6798,15 +9000,15 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Answer.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DeviationThreshold.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
 		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transmitter", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransmissionTimestamp", wireType)
 			}
-			var stringLen uint64
+			m.LastTransmissionTimestamp = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6816,29 +9018,16 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.LastTransmissionTimestamp |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Transmitter = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 5:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ObservationsTimestamp", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastTransmissionAge", wireType)
 			}
-			m.ObservationsTimestamp = 0
+			m.LastTransmissionAge = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6848,16 +9037,16 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ObservationsTimestamp |= int64(b&0x7F) << shift
+				m.LastTransmissionAge |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observations", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissedRounds", wireType)
 			}
-			var stringLen uint64
+			m.MissedRounds = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6867,33 +9056,16 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.MissedRounds |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Observations = append(m.Observations, v)
-			if err := m.Observations[len(m.Observations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
 		case 7:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Observers", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsStale", wireType)
 			}
-			var byteLen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6903,31 +9075,17 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Observers = append(m.Observers[:0], dAtA[iNdEx:postIndex]...)
-			if m.Observers == nil {
-				m.Observers = []byte{}
-			}
-			iNdEx = postIndex
+			m.IsStale = bool(v != 0)
 		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StaleSince", wireType)
 			}
-			var byteLen int
+			m.StaleSince = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6937,31 +9095,16 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				m.StaleSince |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConfigDigest == nil {
-				m.ConfigDigest = []byte{}
-			}
-			iNdEx = postIndex
 		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EpochAndRound", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LastAnswerDeviation", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -6971,134 +9114,29 @@ func (m *EventNewTransmission) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthOcr
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthOcr
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EpochAndRound == nil {
-				m.EpochAndRound = &EpochAndRound{}
-			}
-			if err := m.EpochAndRound.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipOcr(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.LastAnswerDeviation.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowOcr
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventConfigSet: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConfigSet: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigDigest", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConfigDigest = append(m.ConfigDigest[:0], dAtA[iNdEx:postIndex]...)
-			if m.ConfigDigest == nil {
-				m.ConfigDigest = []byte{}
-			}
 			iNdEx = postIndex
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PreviousConfigBlockNumber", wireType)
-			}
-			m.PreviousConfigBlockNumber = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowOcr
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.PreviousConfigBlockNumber |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Config", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ParticipationRates", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7125,18 +9163,16 @@ func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Config == nil {
-				m.Config = &FeedConfig{}
-			}
-			if err := m.Config.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.ParticipationRates = append(m.ParticipationRates, &ParticipationRate{})
+			if err := m.ParticipationRates[len(m.ParticipationRates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConfigInfo", wireType)
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DeviationExceeded", wireType)
 			}
-			var msglen int
+			var v int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowOcr
@@ -7146,28 +9182,12 @@ func (m *EventConfigSet) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				v |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthOcr
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthOcr
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.ConfigInfo == nil {
-				m.ConfigInfo = &FeedConfigInfo{}
-			}
-			if err := m.ConfigInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
+			m.DeviationExceeded = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipOcr(dAtA[iNdEx:])
@@ -92,6 +92,8 @@ func (p *SetBatchConfigProposal) ValidateBasic() error {
 				LinkDenom:           p.LinkDenom,
 				UniqueReports:       feed.UniqueReports,
 				Description:         feed.Description,
+				Heartbeat:           feed.Heartbeat,
+				DeviationThreshold:  feed.DeviationThreshold,
 			},
 		}
 
@@ -592,6 +592,94 @@ func (m *QueryOwedAmountResponse) GetAmount() types.Coin {
 	return types.Coin{}
 }
 
+type QueryFeedHealthRequest struct {
+	FeedId string `protobuf:"bytes,1,opt,name=feed_id,json=feedId,proto3" json:"feed_id,omitempty"`
+}
+
+func (m *QueryFeedHealthRequest) Reset()         { *m = QueryFeedHealthRequest{} }
+func (m *QueryFeedHealthRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedHealthRequest) ProtoMessage()    {}
+func (*QueryFeedHealthRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9921480020d97b2c, []int{12}
+}
+func (m *QueryFeedHealthRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedHealthRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedHealthRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedHealthRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedHealthRequest.Merge(m, src)
+}
+func (m *QueryFeedHealthRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedHealthRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedHealthRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedHealthRequest proto.InternalMessageInfo
+
+func (m *QueryFeedHealthRequest) GetFeedId() string {
+	if m != nil {
+		return m.FeedId
+	}
+	return ""
+}
+
+type QueryFeedHealthResponse struct {
+	Health *FeedHealth `protobuf:"bytes,1,opt,name=health,proto3" json:"health,omitempty"`
+}
+
+func (m *QueryFeedHealthResponse) Reset()         { *m = QueryFeedHealthResponse{} }
+func (m *QueryFeedHealthResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeedHealthResponse) ProtoMessage()    {}
+func (*QueryFeedHealthResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_9921480020d97b2c, []int{13}
+}
+func (m *QueryFeedHealthResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeedHealthResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeedHealthResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeedHealthResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeedHealthResponse.Merge(m, src)
+}
+func (m *QueryFeedHealthResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeedHealthResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeedHealthResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeedHealthResponse proto.InternalMessageInfo
+
+func (m *QueryFeedHealthResponse) GetHealth() *FeedHealth {
+	if m != nil {
+		return m.Health
+	}
+	return nil
+}
+
 type QueryModuleStateRequest struct {
 }
 
@@ -599,7 +687,7 @@ func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest
 func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateRequest) ProtoMessage()    {}
 func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9921480020d97b2c, []int{12}
+	return fileDescriptor_9921480020d97b2c, []int{14}
 }
 func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -636,7 +724,7 @@ func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateRespon
 func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateResponse) ProtoMessage()    {}
 func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_9921480020d97b2c, []int{13}
+	return fileDescriptor_9921480020d97b2c, []int{15}
 }
 func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -685,6 +773,8 @@ func init() {
 	proto.RegisterType((*QueryLatestTransmissionDetailsResponse)(nil), "injective.ocr.v1beta1.QueryLatestTransmissionDetailsResponse")
 	proto.RegisterType((*QueryOwedAmountRequest)(nil), "injective.ocr.v1beta1.QueryOwedAmountRequest")
 	proto.RegisterType((*QueryOwedAmountResponse)(nil), "injective.ocr.v1beta1.QueryOwedAmountResponse")
+	proto.RegisterType((*QueryFeedHealthRequest)(nil), "injective.ocr.v1beta1.QueryFeedHealthRequest")
+	proto.RegisterType((*QueryFeedHealthResponse)(nil), "injective.ocr.v1beta1.QueryFeedHealthResponse")
 	proto.RegisterType((*QueryModuleStateRequest)(nil), "injective.ocr.v1beta1.QueryModuleStateRequest")
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.ocr.v1beta1.QueryModuleStateResponse")
 }
@@ -692,63 +782,66 @@ func init() {
 func init() { proto.RegisterFile("injective/ocr/v1beta1/query.proto", fileDescriptor_9921480020d97b2c) }
 
 var fileDescriptor_9921480020d97b2c = []byte{
-	// 891 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x23, 0x35,
-	0x14, 0xce, 0x2c, 0xdd, 0xa0, 0x75, 0xb6, 0x5d, 0x64, 0x16, 0xb6, 0x1d, 0xd8, 0xb4, 0x9d, 0x90,
-	0x8a, 0x5f, 0x9d, 0x21, 0x29, 0x55, 0x29, 0x14, 0x44, 0x7f, 0x00, 0x8a, 0x54, 0x54, 0x18, 0xe0,
-	0xc2, 0x25, 0x72, 0x66, 0x9c, 0xa9, 0x21, 0xb1, 0xd3, 0xb1, 0xd3, 0xaa, 0xaa, 0x7a, 0xe1, 0xc0,
-	0x19, 0x89, 0x3b, 0x12, 0x07, 0xae, 0x1c, 0xb8, 0xc2, 0x1f, 0xd0, 0x63, 0x25, 0x38, 0x70, 0xaa,
-	0x50, 0xcb, 0x1f, 0x82, 0xc6, 0x76, 0x12, 0x87, 0xc9, 0x24, 0x29, 0x62, 0x6f, 0xf1, 0x9b, 0xf7,
-	0x7d, 0xef, 0x7b, 0xcf, 0xcf, 0x9f, 0x02, 0x96, 0x09, 0xfd, 0x0a, 0x07, 0x82, 0x1c, 0x63, 0x8f,
-	0x05, 0xb1, 0x77, 0x5c, 0x69, 0x60, 0x81, 0x2a, 0xde, 0x51, 0x17, 0xc7, 0xa7, 0x6e, 0x27, 0x66,
-	0x82, 0xc1, 0xe7, 0xfa, 0x29, 0x2e, 0x0b, 0x62, 0x57, 0xa7, 0xd8, 0x2f, 0x46, 0x8c, 0x45, 0x2d,
-	0xec, 0xa1, 0x0e, 0xf1, 0x10, 0xa5, 0x4c, 0x20, 0x41, 0x18, 0xe5, 0x0a, 0x64, 0x2f, 0x8e, 0xe6,
-	0x4d, 0x08, 0x54, 0xc2, 0xc3, 0x88, 0x45, 0x4c, 0xfe, 0xf4, 0x92, 0x5f, 0x3a, 0x5a, 0x0c, 0x18,
-	0x6f, 0x33, 0xee, 0x35, 0x10, 0xc7, 0x7d, 0x50, 0xc0, 0x08, 0xd5, 0xdf, 0x4b, 0xa3, 0x69, 0x23,
-	0x4c, 0x31, 0x27, 0xba, 0xb6, 0xf3, 0x10, 0xc0, 0x4f, 0x13, 0xfd, 0x9f, 0xa0, 0x18, 0xb5, 0xb9,
-	0x8f, 0x8f, 0xba, 0x98, 0x0b, 0xc7, 0x07, 0xcf, 0x0e, 0x45, 0x79, 0x87, 0x51, 0x8e, 0xe1, 0x3b,
-	0x20, 0xdf, 0x91, 0x91, 0x79, 0x6b, 0xc9, 0x7a, 0xb9, 0x50, 0x7d, 0xec, 0x8e, 0x6c, 0xd7, 0x55,
-	0xb0, 0x9d, 0x99, 0x8b, 0xab, 0xc5, 0x9c, 0xaf, 0x21, 0x4e, 0x05, 0x3c, 0x2f, 0x39, 0x3f, 0xc4,
-	0x38, 0xdc, 0x65, 0xb4, 0x49, 0x22, 0x5d, 0x0d, 0x3e, 0x02, 0x4f, 0x37, 0x31, 0x0e, 0xeb, 0x24,
-	0x94, 0xbc, 0xf7, 0xfc, 0x7c, 0x72, 0xac, 0x85, 0xce, 0xcf, 0x16, 0x78, 0x94, 0xc2, 0x68, 0x2d,
-	0x07, 0xe0, 0x19, 0x09, 0x0a, 0x64, 0xb8, 0x4e, 0x68, 0x93, 0x69, 0x55, 0xe5, 0x0c, 0x55, 0x03,
-	0x92, 0x1a, 0x6d, 0x32, 0x7f, 0xae, 0x39, 0x74, 0x86, 0x3b, 0xa0, 0x60, 0x10, 0xce, 0xdf, 0x91,
-	0x5c, 0xcb, 0x13, 0xb9, 0x7c, 0x30, 0xe0, 0x71, 0xd6, 0x81, 0xfd, 0x2f, 0xbd, 0xb2, 0xd4, 0xa4,
-	0x3e, 0x7f, 0xb3, 0xc0, 0x0b, 0x23, 0x71, 0x4f, 0xaa, 0xd7, 0x7d, 0xf0, 0x00, 0x77, 0x58, 0x70,
-	0x58, 0x47, 0x34, 0xac, 0xc7, 0xac, 0x4b, 0x43, 0xdd, 0xef, 0x4b, 0x19, 0x7c, 0x1f, 0x24, 0xd9,
-	0xdb, 0x34, 0xf4, 0x93, 0x5c, 0x7f, 0x16, 0x9b, 0x47, 0xa7, 0xaa, 0x6f, 0x69, 0x1f, 0x09, 0xcc,
-	0x85, 0x4a, 0x99, 0xd4, 0xf2, 0x19, 0x98, 0x4f, 0x63, 0x74, 0xbb, 0x2b, 0xe0, 0x41, 0x4b, 0x86,
-	0x95, 0xb4, 0x1e, 0x78, 0xc6, 0x9f, 0x6d, 0x0d, 0xb2, 0x6b, 0x21, 0xdc, 0x00, 0x33, 0x21, 0x12,
-	0x48, 0x4b, 0x2f, 0x65, 0x48, 0xff, 0x3c, 0x46, 0x94, 0xb7, 0x09, 0xe7, 0x84, 0x51, 0x5f, 0x02,
-	0x9c, 0xf7, 0x41, 0xd9, 0x28, 0x6e, 0x26, 0xec, 0x61, 0x81, 0x48, 0x8b, 0x4f, 0x94, 0xff, 0x87,
-	0x05, 0x56, 0x26, 0x51, 0xe8, 0x6e, 0x4a, 0x60, 0x56, 0xdf, 0x5b, 0x48, 0x22, 0xcc, 0x85, 0x64,
-	0xba, 0xef, 0xdf, 0x57, 0xc1, 0x3d, 0x19, 0xfb, 0x7f, 0x2f, 0xa4, 0x3f, 0x98, 0xa7, 0x6e, 0x3b,
-	0x98, 0xb7, 0xf5, 0x1b, 0x3d, 0x38, 0xc1, 0xe1, 0x76, 0x9b, 0x75, 0xa9, 0xe8, 0x4d, 0x62, 0x09,
-	0x14, 0x84, 0xca, 0x17, 0x02, 0xc7, 0x7a, 0x1a, 0x66, 0xc8, 0xf1, 0xf5, 0x16, 0x98, 0x58, 0x3d,
-	0x82, 0x0d, 0x90, 0x47, 0x32, 0xa2, 0xb7, 0x76, 0xc1, 0x55, 0xd6, 0xe5, 0x26, 0xd6, 0xd5, 0xd7,
-	0xb3, 0xcb, 0x08, 0xed, 0x79, 0x86, 0x4a, 0x77, 0x16, 0x34, 0xe7, 0xc7, 0x2c, 0xec, 0xb6, 0xf0,
-	0x67, 0x02, 0x09, 0xdc, 0xb3, 0xa8, 0x2f, 0xf4, 0x02, 0x0d, 0x7d, 0xd2, 0xf5, 0x36, 0xc1, 0x5d,
-	0x9e, 0x04, 0x74, 0xb9, 0xac, 0x01, 0x7c, 0xa4, 0x9c, 0x50, 0x61, 0x15, 0xa2, 0xfa, 0xeb, 0x3d,
-	0x70, 0x57, 0xf2, 0xc2, 0x6f, 0x2d, 0x90, 0x57, 0x46, 0x06, 0x5f, 0xc9, 0x20, 0x48, 0x3b, 0xa7,
-	0xfd, 0xea, 0x34, 0xa9, 0x4a, 0xa6, 0x53, 0xfe, 0xe6, 0xf7, 0xbf, 0xbf, 0xbf, 0xb3, 0x08, 0x1f,
-	0x7b, 0xc1, 0x21, 0x22, 0xb4, 0x45, 0xe8, 0xd7, 0x43, 0x4e, 0xad, 0x8c, 0x13, 0xfe, 0x68, 0x01,
-	0x30, 0x78, 0xcf, 0x70, 0x75, 0x5c, 0x85, 0x94, 0xb9, 0xda, 0xee, 0xb4, 0xe9, 0x5a, 0xd4, 0x9b,
-	0x52, 0x94, 0x0b, 0x5f, 0xcf, 0x10, 0x65, 0x18, 0x91, 0x77, 0xa6, 0x1f, 0xc7, 0x39, 0xfc, 0xc5,
-	0x02, 0x73, 0xc3, 0x9e, 0x03, 0x2b, 0xd3, 0x15, 0x36, 0x0c, 0xd2, 0xae, 0xde, 0x06, 0xa2, 0xf5,
-	0x6e, 0x4a, 0xbd, 0x6b, 0xb0, 0x32, 0x59, 0xaf, 0x34, 0x4e, 0x43, 0xf4, 0x4f, 0x16, 0x28, 0x18,
-	0xfe, 0x03, 0xc7, 0x8e, 0x2a, 0x6d, 0x6e, 0xb6, 0x37, 0x75, 0xbe, 0xd6, 0xba, 0x2e, 0xb5, 0x7a,
-	0x70, 0x35, 0x43, 0xab, 0xe9, 0x7a, 0x86, 0xce, 0x2b, 0x0b, 0x2c, 0x64, 0xfa, 0x0c, 0xdc, 0x9a,
-	0xac, 0x22, 0xdb, 0xe1, 0xec, 0x77, 0xff, 0x23, 0x5a, 0x77, 0xb4, 0x27, 0x3b, 0x7a, 0x0f, 0x6e,
-	0x8d, 0xef, 0x48, 0x18, 0x14, 0xf5, 0x50, 0x71, 0x0c, 0x5f, 0x04, 0x18, 0xd8, 0xc6, 0xf8, 0x0d,
-	0x4f, 0x59, 0xd3, 0xf8, 0x0d, 0x4f, 0xbb, 0x91, 0xf3, 0x96, 0xd4, 0x5c, 0x85, 0x6f, 0x64, 0x68,
-	0x66, 0x27, 0x38, 0xac, 0x2b, 0x03, 0xf2, 0xce, 0x0c, 0x87, 0x3b, 0x87, 0x3f, 0x58, 0x60, 0xee,
-	0x20, 0x88, 0x0d, 0xcb, 0x19, 0xbf, 0x33, 0x69, 0xdb, 0x1a, 0xbf, 0x33, 0x23, 0xbc, 0xcc, 0x79,
-	0x4d, 0xaa, 0x2d, 0xc3, 0x52, 0x86, 0xda, 0xb6, 0xc4, 0xd4, 0xa5, 0x7b, 0xed, 0x04, 0x17, 0xd7,
-	0x45, 0xeb, 0xf2, 0xba, 0x68, 0xfd, 0x75, 0x5d, 0xb4, 0xbe, 0xbb, 0x29, 0xe6, 0x2e, 0x6f, 0x8a,
-	0xb9, 0x3f, 0x6f, 0x8a, 0xb9, 0x2f, 0x6b, 0x11, 0x11, 0x87, 0xdd, 0x86, 0x1b, 0xb0, 0xb6, 0x57,
-	0xeb, 0x29, 0xd8, 0x47, 0x0d, 0xee, 0xf5, 0xf5, 0xac, 0x06, 0x2c, 0xc6, 0xe6, 0x31, 0xa9, 0xa7,
-	0xf9, 0xb9, 0xac, 0x29, 0x4e, 0x3b, 0x98, 0x37, 0xf2, 0xf2, 0x9f, 0xe3, 0xda, 0x3f, 0x01, 0x00,
-	0x00, 0xff, 0xff, 0x43, 0xc1, 0x8b, 0x1b, 0x0f, 0x0b, 0x00, 0x00,
+	// 933 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcf, 0x6f, 0x1b, 0x45,
+	0x18, 0xcd, 0x96, 0xd4, 0x88, 0x2f, 0x4d, 0x8a, 0x86, 0x42, 0x9c, 0x85, 0x3a, 0xed, 0x86, 0x54,
+	0xfc, 0xca, 0x2e, 0x76, 0xa9, 0x4a, 0xa1, 0x20, 0x9a, 0x86, 0x1f, 0x96, 0x82, 0x02, 0x4b, 0xb9,
+	0x70, 0xb1, 0xc6, 0xbb, 0xe3, 0xf5, 0x80, 0x3d, 0xe3, 0xee, 0x8c, 0x5b, 0x55, 0x51, 0x2f, 0x1c,
+	0x38, 0x23, 0x71, 0x47, 0xe2, 0xc0, 0x95, 0x03, 0x67, 0xfe, 0x80, 0x1e, 0x2b, 0xc1, 0x81, 0x53,
+	0x40, 0x09, 0x7f, 0x08, 0xda, 0x99, 0xb1, 0x3d, 0x66, 0xbd, 0x5e, 0x07, 0xc1, 0xcd, 0xf3, 0xed,
+	0x7b, 0xef, 0x7b, 0xdf, 0xfc, 0x78, 0x09, 0x5c, 0xa6, 0xec, 0x4b, 0x12, 0x49, 0x7a, 0x8f, 0x04,
+	0x3c, 0x4a, 0x83, 0x7b, 0xf5, 0x36, 0x91, 0xb8, 0x1e, 0xdc, 0x1d, 0x92, 0xf4, 0x81, 0x3f, 0x48,
+	0xb9, 0xe4, 0xe8, 0xd9, 0x31, 0xc4, 0xe7, 0x51, 0xea, 0x1b, 0x88, 0xfb, 0x42, 0xc2, 0x79, 0xd2,
+	0x23, 0x01, 0x1e, 0xd0, 0x00, 0x33, 0xc6, 0x25, 0x96, 0x94, 0x33, 0xa1, 0x49, 0xee, 0xe6, 0x6c,
+	0xdd, 0x4c, 0x40, 0x03, 0x2e, 0x24, 0x3c, 0xe1, 0xea, 0x67, 0x90, 0xfd, 0x32, 0xd5, 0x5a, 0xc4,
+	0x45, 0x9f, 0x8b, 0xa0, 0x8d, 0x05, 0x19, 0x93, 0x22, 0x4e, 0x99, 0xf9, 0xbe, 0x35, 0x5b, 0x36,
+	0x21, 0x8c, 0x08, 0x6a, 0x7a, 0x7b, 0x17, 0x00, 0x7d, 0x9a, 0xf9, 0xff, 0x04, 0xa7, 0xb8, 0x2f,
+	0x42, 0x72, 0x77, 0x48, 0x84, 0xf4, 0x42, 0x78, 0x66, 0xaa, 0x2a, 0x06, 0x9c, 0x09, 0x82, 0xde,
+	0x86, 0xca, 0x40, 0x55, 0xaa, 0xce, 0x25, 0xe7, 0xa5, 0x95, 0xc6, 0x45, 0x7f, 0xe6, 0xb8, 0xbe,
+	0xa6, 0xed, 0x2e, 0x3f, 0x3a, 0xda, 0x5c, 0x0a, 0x0d, 0xc5, 0xab, 0xc3, 0x73, 0x4a, 0xf3, 0x03,
+	0x42, 0xe2, 0xdb, 0x9c, 0x75, 0x68, 0x62, 0xba, 0xa1, 0x75, 0x78, 0xb2, 0x43, 0x48, 0xdc, 0xa2,
+	0xb1, 0xd2, 0x7d, 0x2a, 0xac, 0x64, 0xcb, 0x66, 0xec, 0xfd, 0xe4, 0xc0, 0x7a, 0x8e, 0x63, 0xbc,
+	0x1c, 0xc0, 0xd3, 0x8a, 0x14, 0xa9, 0x72, 0x8b, 0xb2, 0x0e, 0x37, 0xae, 0xb6, 0x0b, 0x5c, 0x4d,
+	0x44, 0x9a, 0xac, 0xc3, 0xc3, 0xb5, 0xce, 0xd4, 0x1a, 0xed, 0xc2, 0x8a, 0x25, 0x58, 0x3d, 0xa3,
+	0xb4, 0x2e, 0x97, 0x6a, 0x85, 0x30, 0xd1, 0xf1, 0xae, 0x81, 0xfb, 0x0f, 0xbf, 0xaa, 0x55, 0xd9,
+	0x9c, 0xbf, 0x38, 0xf0, 0xfc, 0x4c, 0xde, 0xff, 0x35, 0xeb, 0x3e, 0x9c, 0x27, 0x03, 0x1e, 0x75,
+	0x5b, 0x98, 0xc5, 0xad, 0x94, 0x0f, 0x59, 0x6c, 0xe6, 0x7d, 0xb1, 0x40, 0xef, 0xfd, 0x0c, 0x7d,
+	0x8b, 0xc5, 0x61, 0x86, 0x0d, 0x57, 0x89, 0xbd, 0xf4, 0x1a, 0xe6, 0x94, 0xf6, 0xb1, 0x24, 0x42,
+	0x6a, 0x48, 0xd9, 0xc8, 0x87, 0x50, 0xcd, 0x73, 0xcc, 0xb8, 0x57, 0xe0, 0x7c, 0x4f, 0x95, 0xb5,
+	0xb5, 0x11, 0x79, 0x39, 0x5c, 0xed, 0x4d, 0xd0, 0xcd, 0x18, 0x5d, 0x87, 0xe5, 0x18, 0x4b, 0x6c,
+	0xac, 0x6f, 0x15, 0x58, 0xbf, 0x93, 0x62, 0x26, 0xfa, 0x54, 0x08, 0xca, 0x59, 0xa8, 0x08, 0xde,
+	0x7b, 0xb0, 0x6d, 0x35, 0xb7, 0x01, 0x7b, 0x44, 0x62, 0xda, 0x13, 0xa5, 0xf6, 0x7f, 0x73, 0xe0,
+	0x4a, 0x99, 0x84, 0x99, 0x66, 0x0b, 0x56, 0xcd, 0xb9, 0xc5, 0x34, 0x21, 0x42, 0x2a, 0xa5, 0x73,
+	0xe1, 0x39, 0x5d, 0xdc, 0x53, 0xb5, 0xff, 0xf6, 0x40, 0xc6, 0x1b, 0xf3, 0xc4, 0x69, 0x37, 0xe6,
+	0x2d, 0xf3, 0x46, 0x0f, 0xee, 0x93, 0xf8, 0x56, 0x9f, 0x0f, 0x99, 0x1c, 0xed, 0xc4, 0x25, 0x58,
+	0x91, 0x1a, 0x2f, 0x25, 0x49, 0xcd, 0x6e, 0xd8, 0x25, 0x2f, 0x84, 0xf5, 0x1c, 0xd7, 0x6c, 0xc1,
+	0x75, 0xa8, 0x60, 0x55, 0x31, 0xb7, 0x76, 0xc3, 0xd7, 0xd1, 0xe5, 0x67, 0xd1, 0x35, 0xf6, 0x73,
+	0x9b, 0x53, 0x36, 0xca, 0x0c, 0x0d, 0x9f, 0xca, 0x8c, 0x8f, 0x08, 0xee, 0xc9, 0x6e, 0xe9, 0xc9,
+	0xdc, 0x81, 0xf5, 0x1c, 0xc5, 0xd8, 0xb8, 0x01, 0x95, 0xae, 0xaa, 0x54, 0x9d, 0xd2, 0xc7, 0x6d,
+	0xa8, 0x86, 0xe0, 0x6d, 0x18, 0xd5, 0x8f, 0x79, 0x3c, 0xec, 0x91, 0xcf, 0x24, 0x96, 0x64, 0x94,
+	0x95, 0x9f, 0x43, 0x35, 0xff, 0x69, 0xdc, 0xf1, 0xac, 0xc8, 0x0a, 0x55, 0x67, 0xee, 0x49, 0x7c,
+	0xa8, 0x23, 0x59, 0x73, 0x35, 0xa3, 0xf1, 0x07, 0xc0, 0x59, 0xa5, 0x8b, 0xbe, 0x71, 0xa0, 0xa2,
+	0x13, 0x15, 0xbd, 0x5c, 0x20, 0x90, 0x8f, 0x70, 0xf7, 0x95, 0x45, 0xa0, 0xda, 0xa6, 0xb7, 0xfd,
+	0xf5, 0xaf, 0x7f, 0x7d, 0x77, 0x66, 0x13, 0x5d, 0x0c, 0xa2, 0x2e, 0xa6, 0xac, 0x47, 0xd9, 0x57,
+	0x53, 0x7f, 0x32, 0x74, 0x82, 0xa3, 0x1f, 0x1c, 0x80, 0x49, 0xb0, 0xa0, 0x9d, 0x79, 0x1d, 0x72,
+	0x29, 0xef, 0xfa, 0x8b, 0xc2, 0x8d, 0xa9, 0x37, 0x94, 0x29, 0x1f, 0xbd, 0x56, 0x60, 0xca, 0x4a,
+	0xc4, 0xe0, 0xd0, 0xdc, 0x85, 0x87, 0xe8, 0x67, 0x07, 0xd6, 0xa6, 0xc3, 0x0f, 0xd5, 0x17, 0x6b,
+	0x6c, 0x25, 0xb5, 0xdb, 0x38, 0x0d, 0xc5, 0xf8, 0xbd, 0xa1, 0xfc, 0x5e, 0x45, 0xf5, 0x72, 0xbf,
+	0x2a, 0xc1, 0x2d, 0xd3, 0x3f, 0x3a, 0xb0, 0x62, 0x05, 0x21, 0x9a, 0xbb, 0x55, 0xf9, 0x94, 0x75,
+	0x83, 0x85, 0xf1, 0xc6, 0xeb, 0x35, 0xe5, 0x35, 0x40, 0x3b, 0x05, 0x5e, 0xed, 0xf8, 0xb5, 0x7c,
+	0x1e, 0x39, 0xb0, 0x51, 0x18, 0x78, 0xe8, 0x66, 0xb9, 0x8b, 0xe2, 0xa8, 0x75, 0xdf, 0xf9, 0x97,
+	0x6c, 0x33, 0xd1, 0x9e, 0x9a, 0xe8, 0x5d, 0x74, 0x73, 0xfe, 0x44, 0xd2, 0x92, 0x68, 0xc5, 0x5a,
+	0x63, 0xfa, 0x20, 0x60, 0x92, 0x5f, 0xf3, 0x6f, 0x78, 0x2e, 0x23, 0x5d, 0x7f, 0x51, 0xb8, 0xf1,
+	0xfc, 0xa6, 0xf2, 0xdc, 0x40, 0xaf, 0x17, 0x78, 0xe6, 0xf7, 0x49, 0xdc, 0xd2, 0x49, 0x18, 0x1c,
+	0x5a, 0x51, 0xfb, 0x70, 0xfc, 0x12, 0x75, 0x4a, 0x95, 0xbf, 0xc4, 0xa9, 0xec, 0x74, 0xfd, 0x45,
+	0xe1, 0xa7, 0x79, 0x89, 0x3a, 0x28, 0xad, 0xbd, 0xfc, 0xde, 0x81, 0xb5, 0x83, 0x28, 0xb5, 0x62,
+	0x71, 0xfe, 0xbd, 0xce, 0x47, 0xab, 0x1b, 0x2c, 0x8c, 0x37, 0x4e, 0x5f, 0x55, 0x4e, 0xb7, 0xd1,
+	0x56, 0x81, 0xd3, 0xbe, 0xe2, 0xb4, 0x54, 0xc2, 0xee, 0x46, 0x8f, 0x8e, 0x6b, 0xce, 0xe3, 0xe3,
+	0x9a, 0xf3, 0xe7, 0x71, 0xcd, 0xf9, 0xf6, 0xa4, 0xb6, 0xf4, 0xf8, 0xa4, 0xb6, 0xf4, 0xfb, 0x49,
+	0x6d, 0xe9, 0x8b, 0x66, 0x42, 0x65, 0x77, 0xd8, 0xf6, 0x23, 0xde, 0x0f, 0x9a, 0x23, 0x07, 0xfb,
+	0xb8, 0x2d, 0x82, 0xb1, 0x9f, 0x9d, 0x88, 0xa7, 0xc4, 0x5e, 0x66, 0xfd, 0x8c, 0xbe, 0x50, 0x3d,
+	0xe5, 0x83, 0x01, 0x11, 0xed, 0x8a, 0xfa, 0x37, 0xfb, 0xea, 0xdf, 0x03, 0x00, 0xba, 0xca, 0x22,
+	0x37, 0x3c, 0x0c, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -775,6 +868,9 @@ type QueryClient interface {
 	LatestTransmissionDetails(ctx context.Context, in *QueryLatestTransmissionDetailsRequest, opts ...grpc.CallOption) (*QueryLatestTransmissionDetailsResponse, error)
 	// Retrieves transmitter's owed amount
 	OwedAmount(ctx context.Context, in *QueryOwedAmountRequest, opts ...grpc.CallOption) (*QueryOwedAmountResponse, error)
+	// Retrieves the health of the given feed: last transmission age, missed
+	// rounds and oracle participation rates
+	FeedHealth(ctx context.Context, in *QueryFeedHealthRequest, opts ...grpc.CallOption) (*QueryFeedHealthResponse, error)
 	// Retrieves the entire OCR module's state
 	OcrModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 }
@@ -841,6 +937,15 @@ func (c *queryClient) OwedAmount(ctx context.Context, in *QueryOwedAmountRequest
 	return out, nil
 }
 
+func (c *queryClient) FeedHealth(ctx context.Context, in *QueryFeedHealthRequest, opts ...grpc.CallOption) (*QueryFeedHealthResponse, error) {
+	out := new(QueryFeedHealthResponse)
+	err := c.cc.Invoke(ctx, "/injective.ocr.v1beta1.Query/FeedHealth", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) OcrModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
 	out := new(QueryModuleStateResponse)
 	err := c.cc.Invoke(ctx, "/injective.ocr.v1beta1.Query/OcrModuleState", in, out, opts...)
@@ -864,6 +969,9 @@ type QueryServer interface {
 	LatestTransmissionDetails(context.Context, *QueryLatestTransmissionDetailsRequest) (*QueryLatestTransmissionDetailsResponse, error)
 	// Retrieves transmitter's owed amount
 	OwedAmount(context.Context, *QueryOwedAmountRequest) (*QueryOwedAmountResponse, error)
+	// Retrieves the health of the given feed: last transmission age, missed
+	// rounds and oracle participation rates
+	FeedHealth(context.Context, *QueryFeedHealthRequest) (*QueryFeedHealthResponse, error)
 	// Retrieves the entire OCR module's state
 	OcrModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 }
@@ -890,6 +998,9 @@ func (*UnimplementedQueryServer) LatestTransmissionDetails(ctx context.Context,
 func (*UnimplementedQueryServer) OwedAmount(ctx context.Context, req *QueryOwedAmountRequest) (*QueryOwedAmountResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OwedAmount not implemented")
 }
+func (*UnimplementedQueryServer) FeedHealth(ctx context.Context, req *QueryFeedHealthRequest) (*QueryFeedHealthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeedHealth not implemented")
+}
 func (*UnimplementedQueryServer) OcrModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method OcrModuleState not implemented")
 }
@@ -1006,6 +1117,24 @@ func _Query_OwedAmount_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_FeedHealth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeedHealthRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeedHealth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.ocr.v1beta1.Query/FeedHealth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeedHealth(ctx, req.(*QueryFeedHealthRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_OcrModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleStateRequest)
 	if err := dec(in); err != nil {
@@ -1052,6 +1181,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "OwedAmount",
 			Handler:    _Query_OwedAmount_Handler,
 		},
+		{
+			MethodName: "FeedHealth",
+			Handler:    _Query_FeedHealth_Handler,
+		},
 		{
 			MethodName: "OcrModuleState",
 			Handler:    _Query_OcrModuleState_Handler,
@@ -1488,6 +1621,71 @@ func (m *QueryOwedAmountResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryFeedHealthRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedHealthRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedHealthRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.FeedId) > 0 {
+		i -= len(m.FeedId)
+		copy(dAtA[i:], m.FeedId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.FeedId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryFeedHealthResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeedHealthResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeedHealthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Health != nil {
+		{
+			size, err := m.Health.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1724,6 +1922,32 @@ func (m *QueryOwedAmountResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryFeedHealthRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.FeedId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeedHealthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Health != nil {
+		l = m.Health.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
 func (m *QueryModuleStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -2883,6 +3107,174 @@ func (m *QueryOwedAmountResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryFeedHealthRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedHealthRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedHealthRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeedId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeedId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryFeedHealthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryFeedHealthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryFeedHealthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Health", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Health == nil {
+				m.Health = &FeedHealth{}
+			}
+			if err := m.Health.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -100,6 +100,14 @@ func (cfg *FeedConfig) ValidateBasic() error {
 		return errors.Wrap(ErrIncorrectConfig, "MinAnswer and MaxAnswer cannot be nil")
 	}
 
+	if cfg.ModuleParams.Heartbeat < 0 {
+		return errors.Wrap(ErrIncorrectConfig, "Heartbeat cannot be negative")
+	}
+
+	if !cfg.ModuleParams.DeviationThreshold.IsNil() && cfg.ModuleParams.DeviationThreshold.IsNegative() {
+		return errors.Wrap(ErrIncorrectConfig, "DeviationThreshold cannot be negative")
+	}
+
 	if cfg.ModuleParams.LinkPerTransmission.IsNil() || !cfg.ModuleParams.LinkPerTransmission.IsPositive() {
 		return errors.Wrap(ErrIncorrectConfig, "LinkPerTransmission must be positive")
 	}
@@ -31,3 +31,10 @@ func (h *BlockHandler) BeginBlocker(ctx sdk.Context) {
 		h.k.CleanupHistoricalPriceRecords(ctx)
 	}
 }
+
+func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
+	defer doneFn()
+
+	h.k.ExpirePendingPriceUpdates(ctx)
+}
@@ -32,6 +32,8 @@ func GetQueryCmd() *cobra.Command {
 		GetStorkPriceStates(),
 		GetStorkPublishers(),
 		GetCoinbasePriceStates(),
+		GetPriceJumpThresholdsCmd(),
+		GetPendingPriceUpdatesCmd(),
 	)
 	return cmd
 }
@@ -223,3 +225,23 @@ func GetCoinbasePriceStates() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetPriceJumpThresholdsCmd queries the circuit breaker thresholds
+func GetPriceJumpThresholdsCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"price-jump-thresholds",
+		"Gets the price jump circuit breaker thresholds",
+		types.NewQueryClient,
+		&types.QueryPriceJumpThresholdsRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+}
+
+// GetPendingPriceUpdatesCmd queries the price updates held back by the circuit breaker
+func GetPendingPriceUpdatesCmd() *cobra.Command {
+	return cli.QueryCmd(
+		"pending-price-updates",
+		"Gets the price updates held back by the price jump circuit breaker",
+		types.NewQueryClient,
+		&types.QueryPendingPriceUpdatesRequest{}, cli.FlagsMapping{}, cli.ArgsMapping{},
+	)
+}
@@ -15,11 +15,15 @@ type ChainlinkKeeper interface {
 	HasChainlinkPriceState(ctx sdk.Context, key string) bool
 }
 
-// GetChainlinkPrice gets the price for a given base quote pair.
+// GetChainlinkPrice gets the price for a given base quote pair. Returns nil if either feed is stale.
 func (k *Keeper) GetChainlinkPrice(ctx sdk.Context, base, quote string) *math.LegacyDec {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
+	if k.ocrKeeper.IsFeedStale(ctx, base) || k.ocrKeeper.IsFeedStale(ctx, quote) {
+		return nil
+	}
+
 	basePrice := k.ocrKeeper.GetTransmission(ctx, base)
 
 	if basePrice == nil || basePrice.Answer.IsNil() || !basePrice.Answer.IsPositive() {
@@ -72,6 +72,14 @@ func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 		k.SetChainlinkDataStreamsPriceState(ctx, chainlinkDataStreamsPriceState)
 	}
 
+	for _, threshold := range data.PriceJumpThresholds {
+		k.SetPriceJumpThreshold(ctx, threshold)
+	}
+
+	for _, pendingUpdate := range data.PendingPriceUpdates {
+		k.SetPendingPriceUpdate(ctx, pendingUpdate)
+	}
+
 	if len(data.ChainlinkDataStreamsPriceStates) > 0 {
 		// nolint:errcheck //ignored on purpose
 		ctx.EventManager().EmitTypedEvent(&types.EventSetChainlinkDataStreamsPrices{
@@ -100,5 +108,7 @@ func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		StorkPriceStates:                k.GetAllStorkPriceStates(ctx),
 		StorkPublishers:                 k.GetAllStorkPublishers(ctx),
 		ChainlinkDataStreamsPriceStates: k.GetAllChainlinkDataStreamsPriceStates(ctx),
+		PriceJumpThresholds:             k.GetAllPriceJumpThresholds(ctx),
+		PendingPriceUpdates:             k.GetAllPendingPriceUpdates(ctx),
 	}
 }
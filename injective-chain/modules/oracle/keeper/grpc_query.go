@@ -295,6 +295,32 @@ func (k *Keeper) PythPrice(c context.Context, req *types.QueryPythPriceRequest)
 	return &types.QueryPythPriceResponse{PriceState: priceState}, nil
 }
 
+func (k *Keeper) PriceJumpThresholds(
+	c context.Context, _ *types.QueryPriceJumpThresholdsRequest,
+) (*types.QueryPriceJumpThresholdsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPriceJumpThresholdsResponse{
+		Thresholds: k.GetAllPriceJumpThresholds(ctx),
+	}, nil
+}
+
+func (k *Keeper) PendingPriceUpdates(
+	c context.Context, _ *types.QueryPendingPriceUpdatesRequest,
+) (*types.QueryPendingPriceUpdatesResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	return &types.QueryPendingPriceUpdatesResponse{
+		PendingUpdates: k.GetAllPendingPriceUpdates(ctx),
+	}, nil
+}
+
 func (k *Keeper) ChainlinkDataStreamsPriceStates(
 	c context.Context, _ *types.QueryChainlinkDataStreamsPriceStatesRequest,
 ) (*types.QueryChainlinkDataStreamsPriceStatesResponse, error) {
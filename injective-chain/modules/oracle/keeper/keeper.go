@@ -22,6 +22,7 @@ type Keeper struct {
 	PythKeeper
 	StorkKeeper
 	ChainlinkDataStreamsKeeper
+	PriceJumpKeeper
 	types.QueryServer
 
 	storeKey storetypes.StoreKey
@@ -38,6 +39,8 @@ type Keeper struct {
 	ocrKeeper types.OcrKeeper
 	evmKeeper types.EVMKeeper
 
+	hooks types.OracleHooks
+
 	svcTags metrics.Tags
 
 	authority string
@@ -82,3 +85,12 @@ func (k *Keeper) Logger(ctx sdk.Context) log.Logger {
 func (k *Keeper) getStore(ctx sdk.Context) storetypes.KVStore {
 	return ctx.KVStore(k.storeKey)
 }
+
+// SetHooks sets the oracle hooks. It must be called before the keeper is copied into the module.
+func (k *Keeper) SetHooks(h types.OracleHooks) {
+	if k.hooks != nil {
+		panic("cannot set oracle hooks twice")
+	}
+
+	k.hooks = h
+}
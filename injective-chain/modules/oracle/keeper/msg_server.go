@@ -59,3 +59,43 @@ func (m MsgServer) UpdateParams(c context.Context, msg *types.MsgUpdateParams) (
 
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (m MsgServer) UpdatePriceJumpThresholds(
+	c context.Context, msg *types.MsgUpdatePriceJumpThresholds,
+) (*types.MsgUpdatePriceJumpThresholdsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, m.svcTags)
+	defer doneFn()
+
+	if msg.Authority != m.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	for _, info := range msg.RemoveThresholds {
+		m.DeletePriceJumpThreshold(ctx, info.OracleType, info.Symbol)
+	}
+
+	for i := range msg.SetThresholds {
+		m.SetPriceJumpThreshold(ctx, &msg.SetThresholds[i])
+	}
+
+	return &types.MsgUpdatePriceJumpThresholdsResponse{}, nil
+}
+
+func (m MsgServer) ResolvePendingPrice(
+	c context.Context, msg *types.MsgResolvePendingPrice,
+) (*types.MsgResolvePendingPriceResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, m.svcTags)
+	defer doneFn()
+
+	if msg.Authority != m.authority {
+		return nil, errors.Wrapf(govtypes.ErrInvalidSigner, "invalid authority: expected %s, got %s", m.authority, msg.Authority)
+	}
+
+	if err := m.ResolvePendingPriceUpdate(sdk.UnwrapSDKContext(c), msg.OracleType, msg.Symbol, msg.Accept); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgResolvePendingPriceResponse{}, nil
+}
@@ -0,0 +1,275 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// PriceJumpKeeper defines the interface for the oracle price jump circuit breaker.
+type PriceJumpKeeper interface {
+	SetPriceJumpThreshold(ctx sdk.Context, threshold *types.PriceJumpThreshold)
+	GetPriceJumpThreshold(ctx sdk.Context, oracleType types.OracleType, symbol string) *types.PriceJumpThreshold
+	DeletePriceJumpThreshold(ctx sdk.Context, oracleType types.OracleType, symbol string)
+	GetAllPriceJumpThresholds(ctx sdk.Context) []*types.PriceJumpThreshold
+
+	SetPendingPriceUpdate(ctx sdk.Context, pendingUpdate *types.PendingPriceUpdate)
+	GetPendingPriceUpdate(ctx sdk.Context, oracleType types.OracleType, symbol string) *types.PendingPriceUpdate
+	GetAllPendingPriceUpdates(ctx sdk.Context) []*types.PendingPriceUpdate
+	ResolvePendingPriceUpdate(ctx sdk.Context, oracleType types.OracleType, symbol string, accept bool) error
+	ExpirePendingPriceUpdates(ctx sdk.Context)
+}
+
+// SetPriceJumpThreshold stores the circuit breaker threshold of a symbol.
+func (k *Keeper) SetPriceJumpThreshold(ctx sdk.Context, threshold *types.PriceJumpThreshold) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.cdc.MustMarshal(threshold)
+	k.getStore(ctx).Set(types.GetPriceJumpThresholdKey(threshold.OracleType, threshold.Symbol), bz)
+}
+
+// GetPriceJumpThreshold returns the circuit breaker threshold of a symbol, or nil if the symbol is not guarded.
+func (k *Keeper) GetPriceJumpThreshold(ctx sdk.Context, oracleType types.OracleType, symbol string) *types.PriceJumpThreshold {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetPriceJumpThresholdKey(oracleType, symbol))
+	if bz == nil {
+		return nil
+	}
+
+	var threshold types.PriceJumpThreshold
+	k.cdc.MustUnmarshal(bz, &threshold)
+	return &threshold
+}
+
+// DeletePriceJumpThreshold removes the circuit breaker threshold of a symbol.
+func (k *Keeper) DeletePriceJumpThreshold(ctx sdk.Context, oracleType types.OracleType, symbol string) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	k.getStore(ctx).Delete(types.GetPriceJumpThresholdKey(oracleType, symbol))
+}
+
+// GetAllPriceJumpThresholds returns all circuit breaker thresholds.
+func (k *Keeper) GetAllPriceJumpThresholds(ctx sdk.Context) []*types.PriceJumpThreshold {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	thresholds := make([]*types.PriceJumpThreshold, 0)
+	thresholdStore := prefix.NewStore(k.getStore(ctx), types.PriceJumpThresholdPrefix)
+
+	iter := thresholdStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var threshold types.PriceJumpThreshold
+		k.cdc.MustUnmarshal(iter.Value(), &threshold)
+		thresholds = append(thresholds, &threshold)
+	}
+
+	return thresholds
+}
+
+// SetPendingPriceUpdate stores a price update held back by the circuit breaker.
+func (k *Keeper) SetPendingPriceUpdate(ctx sdk.Context, pendingUpdate *types.PendingPriceUpdate) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.cdc.MustMarshal(pendingUpdate)
+	k.getStore(ctx).Set(types.GetPendingPriceUpdateKey(pendingUpdate.OracleType, pendingUpdate.Symbol), bz)
+}
+
+// GetPendingPriceUpdate returns the pending price update of a symbol, or nil if there is none.
+func (k *Keeper) GetPendingPriceUpdate(ctx sdk.Context, oracleType types.OracleType, symbol string) *types.PendingPriceUpdate {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	bz := k.getStore(ctx).Get(types.GetPendingPriceUpdateKey(oracleType, symbol))
+	if bz == nil {
+		return nil
+	}
+
+	var pendingUpdate types.PendingPriceUpdate
+	k.cdc.MustUnmarshal(bz, &pendingUpdate)
+	return &pendingUpdate
+}
+
+func (k *Keeper) deletePendingPriceUpdate(ctx sdk.Context, oracleType types.OracleType, symbol string) {
+	k.getStore(ctx).Delete(types.GetPendingPriceUpdateKey(oracleType, symbol))
+}
+
+// GetAllPendingPriceUpdates returns all price updates held back by the circuit breaker.
+func (k *Keeper) GetAllPendingPriceUpdates(ctx sdk.Context) []*types.PendingPriceUpdate {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	pendingUpdates := make([]*types.PendingPriceUpdate, 0)
+	pendingStore := prefix.NewStore(k.getStore(ctx), types.PendingPriceUpdatePrefix)
+
+	iter := pendingStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var pendingUpdate types.PendingPriceUpdate
+		k.cdc.MustUnmarshal(iter.Value(), &pendingUpdate)
+		pendingUpdates = append(pendingUpdates, &pendingUpdate)
+	}
+
+	return pendingUpdates
+}
+
+// checkPriceJump runs a new price through the circuit breaker and returns true if it can become the active price.
+// A price that jumps beyond the symbol's threshold is held as pending. The next update either confirms it (it is
+// close to the pending price), reverts it (it is close to the active price) or replaces it.
+func (k *Keeper) checkPriceJump(
+	ctx sdk.Context,
+	oracleType types.OracleType,
+	symbol string,
+	activePrice, newPrice math.LegacyDec,
+	priceTimestamp uint64,
+) bool {
+	threshold := k.GetPriceJumpThreshold(ctx, oracleType, symbol)
+	if threshold == nil {
+		return true
+	}
+
+	pendingUpdate := k.GetPendingPriceUpdate(ctx, oracleType, symbol)
+	if pendingUpdate != nil {
+		if !types.IsPriceJump(pendingUpdate.PendingPrice, newPrice, threshold.MaxJumpRate) {
+			k.resolvePendingPriceUpdate(ctx, pendingUpdate, types.PendingPriceResolution_Confirmed)
+			return true
+		}
+
+		if !types.IsPriceJump(activePrice, newPrice, threshold.MaxJumpRate) {
+			k.resolvePendingPriceUpdate(ctx, pendingUpdate, types.PendingPriceResolution_Reverted)
+			return true
+		}
+	} else if !types.IsPriceJump(activePrice, newPrice, threshold.MaxJumpRate) {
+		return true
+	}
+
+	blockTime := ctx.BlockTime().Unix()
+	pendingUpdate = &types.PendingPriceUpdate{
+		OracleType:     oracleType,
+		Symbol:         symbol,
+		ActivePrice:    activePrice,
+		PendingPrice:   newPrice,
+		PriceTimestamp: priceTimestamp,
+		DetectedAt:     blockTime,
+		ExpiresAt:      blockTime + threshold.ConfirmationWindow,
+	}
+
+	k.SetPendingPriceUpdate(ctx, pendingUpdate)
+
+	k.Logger(ctx).Info("holding back oracle price update - jump exceeds threshold",
+		"oracleType", oracleType.String(), "symbol", symbol, "active", activePrice.String(), "new", newPrice.String())
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventPriceJumpDetected{
+		PendingUpdate: pendingUpdate,
+	})
+
+	if k.hooks != nil {
+		k.hooks.AfterPriceJumpDetected(ctx, pendingUpdate)
+	}
+
+	return false
+}
+
+func (k *Keeper) resolvePendingPriceUpdate(
+	ctx sdk.Context, pendingUpdate *types.PendingPriceUpdate, resolution types.PendingPriceResolution,
+) {
+	k.deletePendingPriceUpdate(ctx, pendingUpdate.OracleType, pendingUpdate.Symbol)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventPendingPriceResolved{
+		OracleType: pendingUpdate.OracleType,
+		Symbol:     pendingUpdate.Symbol,
+		Resolution: resolution,
+	})
+
+	if k.hooks != nil {
+		k.hooks.AfterPendingPriceResolved(ctx, pendingUpdate.OracleType, pendingUpdate.Symbol, resolution)
+	}
+}
+
+// ResolvePendingPriceUpdate accepts or rejects a pending price update. An accepted price becomes the active price.
+func (k *Keeper) ResolvePendingPriceUpdate(ctx sdk.Context, oracleType types.OracleType, symbol string, accept bool) error {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	pendingUpdate := k.GetPendingPriceUpdate(ctx, oracleType, symbol)
+	if pendingUpdate == nil {
+		return errors.Wrapf(types.ErrPendingPriceNotFound, "%s %s", oracleType.String(), symbol)
+	}
+
+	if !accept {
+		k.resolvePendingPriceUpdate(ctx, pendingUpdate, types.PendingPriceResolution_Rejected)
+		return nil
+	}
+
+	if err := k.applyPendingPrice(ctx, pendingUpdate); err != nil {
+		return err
+	}
+
+	k.resolvePendingPriceUpdate(ctx, pendingUpdate, types.PendingPriceResolution_Accepted)
+	return nil
+}
+
+func (k *Keeper) applyPendingPrice(ctx sdk.Context, pendingUpdate *types.PendingPriceUpdate) error {
+	blockTime := ctx.BlockTime().Unix()
+
+	switch pendingUpdate.OracleType {
+	case types.OracleType_Pyth:
+		pythPriceState := k.GetPythPriceState(ctx, common.HexToHash(pendingUpdate.Symbol))
+		if pythPriceState == nil {
+			return errors.Wrapf(types.ErrOraclePriceNotFound, "pyth price state for %s", pendingUpdate.Symbol)
+		}
+
+		pythPriceState.Update(
+			pythPriceState.EmaPrice,
+			pythPriceState.EmaConf,
+			pythPriceState.Conf,
+			pendingUpdate.PriceTimestamp,
+			pendingUpdate.PendingPrice,
+			blockTime,
+		)
+		k.SetPythPriceState(ctx, pythPriceState)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventSetPythPrices{
+			Prices: []*types.PythPriceState{pythPriceState},
+		})
+	case types.OracleType_Stork:
+		storkPriceState := k.GetStorkPriceState(ctx, pendingUpdate.Symbol)
+		if storkPriceState == nil {
+			return errors.Wrapf(types.ErrOraclePriceNotFound, "stork price state for %s", pendingUpdate.Symbol)
+		}
+
+		storkPriceState.Update(pendingUpdate.PendingPrice, pendingUpdate.PriceTimestamp, blockTime)
+		k.SetStorkPriceState(ctx, storkPriceState)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventSetStorkPrices{
+			Prices: []*types.StorkPriceState{storkPriceState},
+		})
+	default:
+		return errors.Wrapf(types.ErrUnsupportedOracleType, "%s", pendingUpdate.OracleType.String())
+	}
+
+	return nil
+}
+
+// ExpirePendingPriceUpdates discards pending price updates that were not confirmed within their confirmation window.
+func (k *Keeper) ExpirePendingPriceUpdates(ctx sdk.Context) {
+	defer metrics.ReportFuncCallAndTiming(k.svcTags)()
+
+	blockTime := ctx.BlockTime().Unix()
+
+	for _, pendingUpdate := range k.GetAllPendingPriceUpdates(ctx) {
+		if pendingUpdate.ExpiresAt > blockTime {
+			continue
+		}
+
+		k.resolvePendingPriceUpdate(ctx, pendingUpdate, types.PendingPriceResolution_Expired)
+	}
+}
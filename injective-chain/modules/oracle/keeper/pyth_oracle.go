@@ -53,6 +53,11 @@ func (k *Keeper) ProcessPythPriceAttestations(ctx sdk.Context, priceAttestations
 			continue
 		}
 
+		// hold back the price update if it jumps beyond the configured circuit breaker threshold
+		if pythPriceState != nil && !k.checkPriceJump(ctx, types.OracleType_Pyth, priceID.Hex(), pythPriceState.PriceState.Price, price, uint64(publishTime)) {
+			continue
+		}
+
 		blockTime := ctx.BlockTime().Unix()
 
 		if pythPriceState == nil {
@@ -189,6 +189,11 @@ func (k *Keeper) ProcessStorkAssetPairsData(ctx sdk.Context, assetPairs []*types
 			continue
 		}
 
+		// hold back the price update if it jumps beyond the configured circuit breaker threshold
+		if storkPriceState != nil && !k.checkPriceJump(ctx, types.OracleType_Stork, pair.AssetId, storkPriceState.PriceState.Price, price, latestTimestamp) {
+			continue
+		}
+
 		blockTime := ctx.BlockTime().Unix()
 
 		if storkPriceState == nil {
@@ -156,6 +156,7 @@ func (am AppModule) BeginBlock(ctx context.Context) error {
 }
 
 func (am AppModule) EndBlock(ctx context.Context) error {
+	am.blockHandler.EndBlocker(sdk.UnwrapSDKContext(ctx))
 	return nil
 }
 
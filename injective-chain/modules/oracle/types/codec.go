@@ -21,6 +21,8 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgRelayPythPrices{}, "oracle/MsgRelayPythPrices", nil)
 	cdc.RegisterConcrete(&MsgRelayStorkPrices{}, "oracle/MsgRelayStorkPrices", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "oracle/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgUpdatePriceJumpThresholds{}, "oracle/MsgUpdatePriceJumpThresholds", nil)
+	cdc.RegisterConcrete(&MsgResolvePendingPrice{}, "oracle/MsgResolvePendingPrice", nil)
 
 	cdc.RegisterConcrete(&GrantPriceFeederPrivilegeProposal{}, "oracle/GrantPriceFeederPrivilegeProposal", nil)
 	cdc.RegisterConcrete(&RevokePriceFeederPrivilegeProposal{}, "oracle/RevokePriceFeederPrivilegeProposal", nil)
@@ -46,6 +48,8 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgRelayPythPrices{},
 		&MsgRelayStorkPrices{},
 		&MsgUpdateParams{},
+		&MsgUpdatePriceJumpThresholds{},
+		&MsgResolvePendingPrice{},
 	)
 
 	registry.RegisterImplementations((*govtypes.Content)(nil),
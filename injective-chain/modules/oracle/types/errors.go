@@ -50,4 +50,6 @@ var (
 	ErrStorkAssetIdNotUnique       = errors.Register(ModuleName, 43, "stork asset id not unique")
 	ErrChainlinkVerificationFailed = errors.Register(ModuleName, 44, "chainlink report verification failed")
 	ErrBandOracleDeprecated        = errors.Register(ModuleName, 45, "Band oracle is deprecated and no longer supported")
+	ErrInvalidPriceJumpThreshold   = errors.Register(ModuleName, 46, "invalid price jump threshold")
+	ErrPendingPriceNotFound        = errors.Register(ModuleName, 47, "pending price update not found")
 )
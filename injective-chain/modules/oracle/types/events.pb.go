@@ -681,6 +681,110 @@ func (m *EventSetChainlinkDataStreamsPrices) GetPrices() []*ChainlinkDataStreams
 	return nil
 }
 
+type EventPriceJumpDetected struct {
+	PendingUpdate *PendingPriceUpdate `protobuf:"bytes,1,opt,name=pending_update,json=pendingUpdate,proto3" json:"pending_update,omitempty"`
+}
+
+func (m *EventPriceJumpDetected) Reset()         { *m = EventPriceJumpDetected{} }
+func (m *EventPriceJumpDetected) String() string { return proto.CompactTextString(m) }
+func (*EventPriceJumpDetected) ProtoMessage()    {}
+func (*EventPriceJumpDetected) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c42b07097291dfa0, []int{12}
+}
+func (m *EventPriceJumpDetected) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPriceJumpDetected) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPriceJumpDetected.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPriceJumpDetected) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPriceJumpDetected.Merge(m, src)
+}
+func (m *EventPriceJumpDetected) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPriceJumpDetected) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPriceJumpDetected.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPriceJumpDetected proto.InternalMessageInfo
+
+func (m *EventPriceJumpDetected) GetPendingUpdate() *PendingPriceUpdate {
+	if m != nil {
+		return m.PendingUpdate
+	}
+	return nil
+}
+
+type EventPendingPriceResolved struct {
+	OracleType OracleType             `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	Symbol     string                 `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Resolution PendingPriceResolution `protobuf:"varint,3,opt,name=resolution,proto3,enum=injective.oracle.v1beta1.PendingPriceResolution" json:"resolution,omitempty"`
+}
+
+func (m *EventPendingPriceResolved) Reset()         { *m = EventPendingPriceResolved{} }
+func (m *EventPendingPriceResolved) String() string { return proto.CompactTextString(m) }
+func (*EventPendingPriceResolved) ProtoMessage()    {}
+func (*EventPendingPriceResolved) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c42b07097291dfa0, []int{13}
+}
+func (m *EventPendingPriceResolved) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventPendingPriceResolved) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventPendingPriceResolved.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventPendingPriceResolved) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventPendingPriceResolved.Merge(m, src)
+}
+func (m *EventPendingPriceResolved) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventPendingPriceResolved) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventPendingPriceResolved.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventPendingPriceResolved proto.InternalMessageInfo
+
+func (m *EventPendingPriceResolved) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
+	}
+	return OracleType_Unspecified
+}
+
+func (m *EventPendingPriceResolved) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *EventPendingPriceResolved) GetResolution() PendingPriceResolution {
+	if m != nil {
+		return m.Resolution
+	}
+	return PendingPriceResolution_Confirmed
+}
+
 func init() {
 	proto.RegisterType((*SetChainlinkPriceEvent)(nil), "injective.oracle.v1beta1.SetChainlinkPriceEvent")
 	proto.RegisterType((*SetBandPriceEvent)(nil), "injective.oracle.v1beta1.SetBandPriceEvent")
@@ -694,6 +798,8 @@ func init() {
 	proto.RegisterType((*EventSetStorkPrices)(nil), "injective.oracle.v1beta1.EventSetStorkPrices")
 	proto.RegisterType((*EventSetPythPrices)(nil), "injective.oracle.v1beta1.EventSetPythPrices")
 	proto.RegisterType((*EventSetChainlinkDataStreamsPrices)(nil), "injective.oracle.v1beta1.EventSetChainlinkDataStreamsPrices")
+	proto.RegisterType((*EventPriceJumpDetected)(nil), "injective.oracle.v1beta1.EventPriceJumpDetected")
+	proto.RegisterType((*EventPendingPriceResolved)(nil), "injective.oracle.v1beta1.EventPendingPriceResolved")
 }
 
 func init() {
@@ -701,52 +807,59 @@ func init() {
 }
 
 var fileDescriptor_c42b07097291dfa0 = []byte{
-	// 716 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0xbd, 0x6e, 0x13, 0x4b,
-	0x14, 0xf6, 0xda, 0x8e, 0x13, 0x4f, 0x6e, 0x73, 0xf7, 0xfa, 0x86, 0x55, 0x02, 0x8e, 0x31, 0x42,
-	0x32, 0x05, 0xbb, 0x0a, 0x34, 0xfc, 0x34, 0xc4, 0x49, 0x90, 0x2c, 0xa5, 0xb0, 0xd6, 0x11, 0x42,
-	0x34, 0xd1, 0x78, 0xf6, 0xc4, 0x1e, 0xbc, 0xbb, 0xe3, 0xcc, 0x8c, 0x8d, 0xfc, 0x06, 0x14, 0x14,
-	0x74, 0xb4, 0x3c, 0x0b, 0x55, 0xca, 0x94, 0x88, 0x22, 0x42, 0x89, 0xc4, 0x73, 0xa0, 0x99, 0x9d,
-	0xb5, 0x1d, 0x2b, 0x8b, 0x1c, 0xd1, 0xf9, 0xfc, 0x7d, 0xdf, 0x77, 0x8e, 0xcf, 0x99, 0x45, 0x0f,
-	0x69, 0xfc, 0x1e, 0x88, 0xa4, 0x63, 0xf0, 0x18, 0xc7, 0x24, 0x04, 0x6f, 0xbc, 0xd3, 0x05, 0x89,
-	0x77, 0x3c, 0x18, 0x43, 0x2c, 0x85, 0x3b, 0xe4, 0x4c, 0x32, 0xdb, 0x99, 0xa6, 0xb9, 0x49, 0x9a,
-	0x6b, 0xd2, 0x36, 0x2b, 0x3d, 0xd6, 0x63, 0x3a, 0xc9, 0x53, 0xbf, 0x92, 0xfc, 0xcd, 0x2a, 0x61,
-	0x22, 0x62, 0xc2, 0xeb, 0x62, 0x31, 0x43, 0x24, 0x8c, 0xc6, 0x26, 0x9e, 0x4d, 0x6b, 0xe0, 0x75,
-	0x5a, 0xfd, 0x93, 0x85, 0x36, 0x3a, 0x20, 0xf7, 0xfa, 0x98, 0xc6, 0x21, 0x8d, 0x07, 0x6d, 0x4e,
-	0x09, 0x1c, 0x28, 0x61, 0xf6, 0x1d, 0xb4, 0x7a, 0x02, 0x10, 0x1c, 0xd3, 0xc0, 0xb1, 0x6a, 0x56,
-	0xa3, 0xec, 0x97, 0x94, 0xd9, 0x0a, 0xec, 0x97, 0xa8, 0x84, 0x63, 0xf1, 0x01, 0xb8, 0x93, 0x57,
-	0xfe, 0xe6, 0x83, 0xb3, 0x8b, 0xed, 0xdc, 0x8f, 0x8b, 0xed, 0xad, 0x44, 0x92, 0x08, 0x06, 0x2e,
-	0x65, 0x5e, 0x84, 0x65, 0xdf, 0x3d, 0x84, 0x1e, 0x26, 0x93, 0x7d, 0x20, 0xbe, 0x29, 0xb1, 0xef,
-	0xa2, 0xb2, 0xa4, 0x11, 0x08, 0x89, 0xa3, 0xa1, 0x53, 0xa8, 0x59, 0x8d, 0xa2, 0x3f, 0x73, 0xd4,
-	0xbf, 0x59, 0xe8, 0xdf, 0x0e, 0xc8, 0x26, 0x8e, 0x83, 0x39, 0x25, 0x0e, 0x5a, 0xe5, 0x10, 0xe2,
-	0x09, 0x70, 0xa3, 0x24, 0x35, 0xed, 0x0d, 0x54, 0x12, 0x93, 0xa8, 0xcb, 0xc2, 0x44, 0x8a, 0x6f,
-	0x2c, 0xfb, 0x39, 0x5a, 0x19, 0xaa, 0x7a, 0xcd, 0xb0, 0xa4, 0xc2, 0xa4, 0xc2, 0xbe, 0x8f, 0xfe,
-	0xe1, 0x20, 0x58, 0x38, 0x86, 0x63, 0xa5, 0xcb, 0x29, 0x6a, 0x8d, 0xeb, 0xc6, 0x77, 0x44, 0x23,
-	0xb0, 0xef, 0x21, 0xc4, 0xe1, 0x74, 0x04, 0x42, 0xaa, 0xe1, 0xac, 0x24, 0x4d, 0x18, 0x4f, 0x2b,
-	0xa8, 0xff, 0xb2, 0x50, 0xc5, 0x34, 0xd1, 0x6a, 0xee, 0x2d, 0xd5, 0x87, 0x83, 0x56, 0x13, 0xe5,
-	0xc2, 0xc9, 0xd7, 0x0a, 0x2a, 0x62, 0x4c, 0x35, 0x6c, 0xad, 0x4b, 0x38, 0x05, 0x15, 0x58, 0x72,
-	0xd8, 0x49, 0xc9, 0xdf, 0xf7, 0x62, 0x6f, 0xa1, 0x32, 0x09, 0x29, 0xc4, 0x3a, 0x5a, 0xaa, 0x59,
-	0x8d, 0x82, 0xbf, 0x96, 0x38, 0x5a, 0x41, 0xfd, 0x08, 0x6d, 0xe8, 0xc6, 0x4c, 0xa7, 0xbb, 0x64,
-	0xd0, 0x19, 0x11, 0x02, 0x42, 0x28, 0x54, 0x4c, 0x06, 0xc7, 0x1c, 0xc4, 0x28, 0x94, 0xa6, 0xd9,
-	0x32, 0x26, 0x03, 0x5f, 0x3b, 0xae, 0xa3, 0xe6, 0x17, 0x50, 0xdb, 0xa8, 0xb2, 0x80, 0x7a, 0xc0,
-	0x39, 0xe3, 0xaa, 0x48, 0x61, 0x82, 0x32, 0x0c, 0xe4, 0x1a, 0x9e, 0x0b, 0x66, 0x23, 0xbe, 0x40,
-	0x5b, 0xf3, 0x88, 0x3e, 0x88, 0x21, 0x8b, 0x85, 0xee, 0x9f, 0x8d, 0x16, 0xd4, 0x58, 0x0b, 0xb5,
-	0x5f, 0x92, 0x03, 0xd1, 0xff, 0xe2, 0x6b, 0x80, 0xe5, 0xd6, 0xd2, 0x46, 0x45, 0x75, 0x97, 0x66,
-	0x29, 0xf5, 0x6f, 0xbb, 0x82, 0x56, 0x4e, 0x47, 0x4c, 0x9a, 0x95, 0xf4, 0x13, 0x63, 0xb6, 0xa8,
-	0xc5, 0xdb, 0x2e, 0x6a, 0xfd, 0xab, 0x85, 0xfe, 0xd7, 0xca, 0xd8, 0x98, 0x06, 0xc0, 0xe7, 0x84,
-	0x6d, 0xa2, 0xb5, 0xa1, 0xf1, 0xa6, 0x83, 0x4a, 0xed, 0x79, 0xd1, 0xf9, 0xac, 0x5b, 0x2a, 0xdc,
-	0x7c, 0x4b, 0xb7, 0x97, 0xf8, 0x31, 0x91, 0xb8, 0xc7, 0x68, 0xac, 0x66, 0x30, 0x27, 0x71, 0x46,
-	0x66, 0xdd, 0x4c, 0x96, 0xbf, 0xf5, 0xe1, 0xfe, 0xf9, 0x65, 0x79, 0x8b, 0xfe, 0xd3, 0xcc, 0x1d,
-	0x90, 0x1d, 0xc9, 0x78, 0xf2, 0xd0, 0x09, 0x7b, 0x77, 0x7a, 0x5e, 0x56, 0xad, 0xd0, 0x58, 0x7f,
-	0xf2, 0xc8, 0xcd, 0x7a, 0x87, 0xdd, 0x59, 0x59, 0x47, 0x62, 0x09, 0xe9, 0x91, 0xd5, 0xdf, 0x20,
-	0x3b, 0x45, 0x6e, 0x4f, 0x64, 0xdf, 0x00, 0xbf, 0x5a, 0x00, 0x6e, 0x64, 0x03, 0x4f, 0xab, 0xae,
-	0xe3, 0x8e, 0x51, 0x3d, 0xc5, 0x9d, 0x3e, 0xcf, 0xfb, 0x58, 0xe2, 0x8e, 0xe4, 0x80, 0x23, 0x61,
-	0x78, 0xda, 0x0b, 0x3c, 0xcf, 0xb2, 0x79, 0x32, 0x51, 0xae, 0xf1, 0x36, 0x4f, 0xce, 0x2e, 0xab,
-	0xd6, 0xf9, 0x65, 0xd5, 0xfa, 0x79, 0x59, 0xb5, 0x3e, 0x5f, 0x55, 0x73, 0xe7, 0x57, 0xd5, 0xdc,
-	0xf7, 0xab, 0x6a, 0xee, 0xdd, 0x61, 0x8f, 0xca, 0xfe, 0xa8, 0xeb, 0x12, 0x16, 0x79, 0xad, 0x94,
-	0xe5, 0x10, 0x77, 0x85, 0x37, 0xe5, 0x7c, 0x4c, 0x18, 0x87, 0x79, 0x53, 0x71, 0x7a, 0x11, 0x0b,
-	0x46, 0x21, 0x88, 0xf4, 0x4b, 0x24, 0x27, 0x43, 0x10, 0xdd, 0x92, 0xfe, 0x02, 0x3d, 0xfd, 0x1d,
-	0x00, 0x00, 0xff, 0xff, 0x82, 0x00, 0xff, 0xbc, 0x21, 0x07, 0x00, 0x00,
+	// 826 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4f, 0x6f, 0xe3, 0x44,
+	0x14, 0xaf, 0x93, 0x36, 0x6d, 0xa7, 0x50, 0x09, 0x53, 0x8a, 0x69, 0x21, 0x1b, 0x02, 0x48, 0x41,
+	0x02, 0x9b, 0x2d, 0x17, 0xfe, 0x5c, 0xd8, 0xfe, 0x41, 0x0a, 0xaa, 0x44, 0x64, 0x17, 0x84, 0xb8,
+	0x44, 0x93, 0xf1, 0xdb, 0x64, 0x88, 0xed, 0xf1, 0xce, 0x8c, 0x83, 0xf2, 0x0d, 0x38, 0x70, 0xe0,
+	0xc6, 0x95, 0xcf, 0xc2, 0x69, 0x0f, 0x1c, 0xf6, 0x88, 0x38, 0xac, 0x50, 0x2b, 0xf1, 0x39, 0xd0,
+	0xfc, 0x71, 0xe2, 0x46, 0xeb, 0x2a, 0xd5, 0xde, 0xfc, 0xde, 0xbc, 0xf7, 0xfb, 0xfd, 0xde, 0xf8,
+	0xbd, 0x37, 0xe8, 0x03, 0x9a, 0xfd, 0x04, 0x44, 0xd2, 0x19, 0x04, 0x8c, 0x63, 0x92, 0x40, 0x30,
+	0x7b, 0x38, 0x02, 0x89, 0x1f, 0x06, 0x30, 0x83, 0x4c, 0x0a, 0x3f, 0xe7, 0x4c, 0x32, 0xd7, 0x5b,
+	0x84, 0xf9, 0x26, 0xcc, 0xb7, 0x61, 0x47, 0x07, 0x63, 0x36, 0x66, 0x3a, 0x28, 0x50, 0x5f, 0x26,
+	0xfe, 0xa8, 0x4d, 0x98, 0x48, 0x99, 0x08, 0x46, 0x58, 0x2c, 0x11, 0x09, 0xa3, 0x99, 0x3d, 0xaf,
+	0xa7, 0xb5, 0xf0, 0x3a, 0xac, 0xfb, 0xab, 0x83, 0x0e, 0x23, 0x90, 0x67, 0x13, 0x4c, 0xb3, 0x84,
+	0x66, 0xd3, 0x01, 0xa7, 0x04, 0x2e, 0x94, 0x30, 0xf7, 0x4d, 0xb4, 0xfd, 0x18, 0x20, 0x1e, 0xd2,
+	0xd8, 0x73, 0x3a, 0x4e, 0x6f, 0x37, 0x6c, 0x29, 0xb3, 0x1f, 0xbb, 0x5f, 0xa2, 0x16, 0xce, 0xc4,
+	0xcf, 0xc0, 0xbd, 0x86, 0xf2, 0x9f, 0xbe, 0xf7, 0xf4, 0xf9, 0x83, 0x8d, 0x7f, 0x9e, 0x3f, 0x38,
+	0x36, 0x92, 0x44, 0x3c, 0xf5, 0x29, 0x0b, 0x52, 0x2c, 0x27, 0xfe, 0x25, 0x8c, 0x31, 0x99, 0x9f,
+	0x03, 0x09, 0x6d, 0x8a, 0xfb, 0x36, 0xda, 0x95, 0x34, 0x05, 0x21, 0x71, 0x9a, 0x7b, 0xcd, 0x8e,
+	0xd3, 0xdb, 0x0c, 0x97, 0x8e, 0xee, 0x9f, 0x0e, 0x7a, 0x2d, 0x02, 0x79, 0x8a, 0xb3, 0xb8, 0xa2,
+	0xc4, 0x43, 0xdb, 0x1c, 0x12, 0x3c, 0x07, 0x6e, 0x95, 0x94, 0xa6, 0x7b, 0x88, 0x5a, 0x62, 0x9e,
+	0x8e, 0x58, 0x62, 0xa4, 0x84, 0xd6, 0x72, 0x3f, 0x47, 0x5b, 0xb9, 0xca, 0xf7, 0x9a, 0xeb, 0x2b,
+	0x34, 0x19, 0xee, 0xbb, 0xe8, 0x15, 0x0e, 0x82, 0x25, 0x33, 0x18, 0x2a, 0x5d, 0xde, 0xa6, 0xd6,
+	0xb8, 0x67, 0x7d, 0x57, 0x34, 0x05, 0xf7, 0x1d, 0x84, 0x38, 0x3c, 0x29, 0x40, 0x48, 0x75, 0x39,
+	0x5b, 0xa6, 0x08, 0xeb, 0xe9, 0xc7, 0xdd, 0xff, 0x1c, 0x74, 0x60, 0x8b, 0xe8, 0x9f, 0x9e, 0xad,
+	0x55, 0x87, 0x87, 0xb6, 0x8d, 0x72, 0xe1, 0x35, 0x3a, 0x4d, 0x75, 0x62, 0x4d, 0x75, 0xd9, 0x5a,
+	0x97, 0xf0, 0x9a, 0x9d, 0xe6, 0xba, 0xa5, 0xd8, 0x94, 0x97, 0xaf, 0xc5, 0x3d, 0x46, 0xbb, 0x24,
+	0xa1, 0x90, 0xe9, 0xd3, 0x56, 0xc7, 0xe9, 0x35, 0xc3, 0x1d, 0xe3, 0xe8, 0xc7, 0xdd, 0x2b, 0x74,
+	0xa8, 0x0b, 0xb3, 0x95, 0x3e, 0x22, 0xd3, 0xa8, 0x20, 0x04, 0x84, 0x50, 0xa8, 0x98, 0x4c, 0x87,
+	0x1c, 0x44, 0x91, 0x48, 0x5b, 0xec, 0x2e, 0x26, 0xd3, 0x50, 0x3b, 0x6e, 0xa3, 0x36, 0x56, 0x50,
+	0x07, 0xe8, 0x60, 0x05, 0xf5, 0x82, 0x73, 0xc6, 0x55, 0x92, 0xc2, 0x04, 0x65, 0x58, 0xc8, 0x1d,
+	0x5c, 0x39, 0xac, 0x47, 0xfc, 0x02, 0x1d, 0x57, 0x11, 0x43, 0x10, 0x39, 0xcb, 0x84, 0xae, 0x9f,
+	0x15, 0x2b, 0x6a, 0x9c, 0x95, 0xdc, 0xdf, 0xcd, 0x80, 0xe8, 0xbf, 0xf8, 0x35, 0xc0, 0x7a, 0x6d,
+	0xe9, 0xa2, 0x4d, 0x35, 0x97, 0xb6, 0x29, 0xf5, 0xb7, 0x7b, 0x80, 0xb6, 0x9e, 0x14, 0x4c, 0xda,
+	0x96, 0x0c, 0x8d, 0xb1, 0x6c, 0xd4, 0xcd, 0xfb, 0x36, 0x6a, 0xf7, 0x0f, 0x07, 0xbd, 0xa1, 0x95,
+	0xb1, 0x19, 0x8d, 0x81, 0x57, 0x84, 0x1d, 0xa1, 0x9d, 0xdc, 0x7a, 0xcb, 0x8b, 0x2a, 0xed, 0xaa,
+	0xe8, 0x46, 0xdd, 0x2c, 0x35, 0x5f, 0x3c, 0x4b, 0xf7, 0x97, 0xf8, 0x8b, 0x91, 0x78, 0xc6, 0x68,
+	0xa6, 0xee, 0xa0, 0x22, 0x71, 0x49, 0xe6, 0xbc, 0x98, 0xac, 0x71, 0xef, 0xc1, 0xbd, 0x7b, 0xb3,
+	0xfc, 0x80, 0x5e, 0xd7, 0xcc, 0x11, 0xc8, 0x48, 0x32, 0x6e, 0x16, 0x9d, 0x70, 0x1f, 0x2d, 0xc6,
+	0xcb, 0xe9, 0x34, 0x7b, 0x7b, 0x27, 0x1f, 0xfa, 0x75, 0x7b, 0xd8, 0x5f, 0xa6, 0x45, 0x12, 0x4b,
+	0x28, 0x87, 0xac, 0xfb, 0x3d, 0x72, 0x4b, 0xe4, 0xc1, 0x5c, 0x4e, 0x2c, 0xf0, 0x57, 0x2b, 0xc0,
+	0xbd, 0x7a, 0xe0, 0x45, 0xd6, 0x6d, 0xdc, 0x19, 0xea, 0x96, 0xb8, 0x8b, 0xf5, 0x7c, 0x8e, 0x25,
+	0x8e, 0x24, 0x07, 0x9c, 0x0a, 0xcb, 0x33, 0x58, 0xe1, 0xf9, 0xac, 0x9e, 0xa7, 0x16, 0xe5, 0x36,
+	0x6f, 0x6a, 0xa7, 0x5a, 0x1f, 0x7d, 0x53, 0xa4, 0xf9, 0x39, 0x48, 0x20, 0x12, 0x62, 0x37, 0x42,
+	0xfb, 0x39, 0x64, 0x31, 0xcd, 0xc6, 0xc3, 0x22, 0x8f, 0xb1, 0x04, 0xfd, 0xf3, 0xf6, 0x4e, 0x3e,
+	0xba, 0xa3, 0x36, 0x13, 0xaf, 0xb1, 0xbe, 0xd3, 0x39, 0xe1, 0xab, 0x16, 0xc3, 0x98, 0xdd, 0xbf,
+	0x1c, 0xf4, 0x96, 0xe1, 0xab, 0x84, 0x86, 0x66, 0x43, 0xc5, 0xee, 0x05, 0xda, 0x33, 0x88, 0x43,
+	0x39, 0xcf, 0x0d, 0xdf, 0xfe, 0xc9, 0xfb, 0xf5, 0x7c, 0xdf, 0x6a, 0xf3, 0x6a, 0x9e, 0x43, 0x88,
+	0xd8, 0xe2, 0xbb, 0xf6, 0x9d, 0x18, 0xa8, 0xed, 0x27, 0x58, 0x52, 0x48, 0xca, 0x32, 0xdd, 0x34,
+	0xfb, 0x27, 0x9f, 0xac, 0x57, 0x4d, 0xb8, 0xc8, 0x0b, 0x2b, 0x18, 0xa7, 0x8f, 0x9f, 0x5e, 0xb7,
+	0x9d, 0x67, 0xd7, 0x6d, 0xe7, 0xdf, 0xeb, 0xb6, 0xf3, 0xdb, 0x4d, 0x7b, 0xe3, 0xd9, 0x4d, 0x7b,
+	0xe3, 0xef, 0x9b, 0xf6, 0xc6, 0x8f, 0x97, 0x63, 0x2a, 0x27, 0xc5, 0xc8, 0x27, 0x2c, 0x0d, 0xfa,
+	0x25, 0xc3, 0x25, 0x1e, 0x89, 0x60, 0xc1, 0xf7, 0x31, 0x61, 0x1c, 0xaa, 0xa6, 0xfa, 0x63, 0x41,
+	0xca, 0xe2, 0x22, 0x01, 0x51, 0xbe, 0xe3, 0xea, 0x22, 0xc4, 0xa8, 0xa5, 0xdf, 0xef, 0x4f, 0xff,
+	0x1f, 0x00, 0xc5, 0xa3, 0x50, 0x52, 0x5f, 0x08, 0x00, 0x00,
 }
 
 func (m *SetChainlinkPriceEvent) Marshal() (dAtA []byte, err error) {
@@ -1281,6 +1394,81 @@ func (m *EventSetChainlinkDataStreamsPrices) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
+func (m *EventPriceJumpDetected) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPriceJumpDetected) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPriceJumpDetected) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.PendingUpdate != nil {
+		{
+			size, err := m.PendingUpdate.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintEvents(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventPendingPriceResolved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventPendingPriceResolved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventPendingPriceResolved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Resolution != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Resolution))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -1522,6 +1710,38 @@ func (m *EventSetChainlinkDataStreamsPrices) Size() (n int) {
 	return n
 }
 
+func (m *EventPriceJumpDetected) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PendingUpdate != nil {
+		l = m.PendingUpdate.Size()
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventPendingPriceResolved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovEvents(uint64(m.OracleType))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Resolution != 0 {
+		n += 1 + sovEvents(uint64(m.Resolution))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -3074,6 +3294,212 @@ func (m *EventSetChainlinkDataStreamsPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventPriceJumpDetected) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPriceJumpDetected: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPriceJumpDetected: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdate", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PendingUpdate == nil {
+				m.PendingUpdate = &PendingPriceUpdate{}
+			}
+			if err := m.PendingUpdate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventPendingPriceResolved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventPendingPriceResolved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventPendingPriceResolved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Resolution", wireType)
+			}
+			m.Resolution = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Resolution |= PendingPriceResolution(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
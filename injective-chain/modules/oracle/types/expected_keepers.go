@@ -44,6 +44,7 @@ type PortKeeper interface {
 
 type OcrKeeper interface {
 	GetTransmission(ctx sdk.Context, feedId string) *ocrtypes.Transmission
+	IsFeedStale(ctx sdk.Context, feedId string) bool
 }
 
 // EVMKeeper defines the expected EVM keeper methods for Chainlink Data Streams verification
@@ -1,5 +1,9 @@
 package types
 
+import (
+	"cosmossdk.io/errors"
+)
+
 // DefaultIndex is the default capability global index
 const DefaultIndex uint64 = 1
 
@@ -12,6 +16,19 @@ func (gs GenesisState) Validate() error {
 	if err := gs.Params.Validate(); err != nil {
 		return err
 	}
+
+	for _, threshold := range gs.PriceJumpThresholds {
+		if err := threshold.Validate(); err != nil {
+			return err
+		}
+	}
+
+	for _, pendingUpdate := range gs.PendingPriceUpdates {
+		if !IsPriceJumpGuardedOracle(pendingUpdate.OracleType) || pendingUpdate.Symbol == "" {
+			return errors.Wrapf(ErrInvalidPriceJumpThreshold, "invalid pending price update for %s %s", pendingUpdate.OracleType.String(), pendingUpdate.Symbol)
+		}
+	}
+
 	return nil
 }
 
@@ -44,6 +44,8 @@ type GenesisState struct {
 	StorkPriceStates                []*StorkPriceState                `protobuf:"bytes,16,rep,name=stork_price_states,json=storkPriceStates,proto3" json:"stork_price_states,omitempty"`
 	StorkPublishers                 []string                          `protobuf:"bytes,17,rep,name=stork_publishers,json=storkPublishers,proto3" json:"stork_publishers,omitempty"`
 	ChainlinkDataStreamsPriceStates []*ChainlinkDataStreamsPriceState `protobuf:"bytes,18,rep,name=chainlink_data_streams_price_states,json=chainlinkDataStreamsPriceStates,proto3" json:"chainlink_data_streams_price_states,omitempty"`
+	PriceJumpThresholds             []*PriceJumpThreshold             `protobuf:"bytes,19,rep,name=price_jump_thresholds,json=priceJumpThresholds,proto3" json:"price_jump_thresholds,omitempty"`
+	PendingPriceUpdates             []*PendingPriceUpdate             `protobuf:"bytes,20,rep,name=pending_price_updates,json=pendingPriceUpdates,proto3" json:"pending_price_updates,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -213,6 +215,20 @@ func (m *GenesisState) GetChainlinkDataStreamsPriceStates() []*ChainlinkDataStre
 	return nil
 }
 
+func (m *GenesisState) GetPriceJumpThresholds() []*PriceJumpThreshold {
+	if m != nil {
+		return m.PriceJumpThresholds
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingPriceUpdates() []*PendingPriceUpdate {
+	if m != nil {
+		return m.PendingPriceUpdates
+	}
+	return nil
+}
+
 type CalldataRecord struct {
 	ClientId uint64 `protobuf:"varint,1,opt,name=client_id,json=clientId,proto3" json:"client_id,omitempty"`
 	Calldata []byte `protobuf:"bytes,2,opt,name=calldata,proto3" json:"calldata,omitempty"`
@@ -275,53 +291,57 @@ func init() {
 }
 
 var fileDescriptor_f7e14cf80151b4d2 = []byte{
-	// 734 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x4f, 0xdb, 0x4e,
-	0x10, 0x8d, 0x21, 0xbf, 0xfc, 0x60, 0x09, 0x24, 0x6c, 0x81, 0xba, 0xa9, 0x14, 0x22, 0xaa, 0x42,
-	0x50, 0x4b, 0x2c, 0xe8, 0xa5, 0x87, 0x8a, 0x43, 0x52, 0xb5, 0x8a, 0x84, 0x54, 0x64, 0x2a, 0x51,
-	0xf5, 0xe2, 0xae, 0xd7, 0x4b, 0xbc, 0xad, 0x63, 0xbb, 0xbb, 0x1b, 0xa4, 0x7c, 0x81, 0x5e, 0x7a,
-	0xe9, 0xc7, 0xe2, 0xc8, 0xb1, 0xa7, 0xaa, 0x82, 0x2f, 0x52, 0xed, 0xfa, 0x4f, 0xbc, 0xa0, 0x24,
-	0x55, 0x6f, 0x9e, 0xf1, 0xcc, 0x7b, 0x6f, 0xc6, 0x6f, 0xbd, 0x60, 0x97, 0x86, 0x9f, 0x09, 0x16,
-	0xf4, 0x92, 0x58, 0x11, 0x43, 0x38, 0x20, 0xd6, 0xe5, 0xa1, 0x4b, 0x04, 0x3a, 0xb4, 0x06, 0x24,
-	0x24, 0x9c, 0xf2, 0x4e, 0xcc, 0x22, 0x11, 0x41, 0x33, 0xaf, 0xeb, 0x24, 0x75, 0x9d, 0xb4, 0xae,
-	0xf1, 0x74, 0x2a, 0x42, 0x5a, 0xa8, 0x00, 0x1a, 0x1b, 0x83, 0x68, 0x10, 0xa9, 0x47, 0x4b, 0x3e,
-	0x25, 0xd9, 0x9d, 0xef, 0x55, 0x50, 0x7d, 0x9b, 0x10, 0x9d, 0x09, 0x24, 0x08, 0x3c, 0x06, 0x95,
-	0x18, 0x31, 0x34, 0xe4, 0xa6, 0xd1, 0x32, 0xda, 0x2b, 0x47, 0xad, 0xce, 0x34, 0xe2, 0xce, 0xa9,
-	0xaa, 0xeb, 0x96, 0xaf, 0x7e, 0x6d, 0x97, 0xec, 0xb4, 0x0b, 0xee, 0x81, 0x55, 0x17, 0x85, 0x9e,
-	0xc3, 0x48, 0x80, 0xc6, 0x84, 0x71, 0x73, 0xa1, 0xb5, 0xd8, 0x5e, 0xee, 0x2e, 0x98, 0x86, 0x5d,
-	0x95, 0x2f, 0xec, 0x34, 0x0f, 0x3f, 0x80, 0x75, 0x55, 0x18, 0x33, 0x8a, 0x89, 0xc3, 0x25, 0x39,
-	0x37, 0x17, 0x5b, 0x8b, 0xed, 0x95, 0xa3, 0xf6, 0x74, 0xce, 0x2e, 0x0a, 0xbd, 0x53, 0xd9, 0xa1,
-	0xd4, 0x2a, 0xd8, 0x9a, 0xab, 0xe5, 0x38, 0x74, 0xc0, 0xc3, 0x04, 0xf4, 0x82, 0x90, 0x3b, 0xf8,
-	0xe5, 0x79, 0xf8, 0x0a, 0xe7, 0x0d, 0x21, 0x9e, 0xc2, 0xb2, 0x37, 0xe2, 0x2c, 0x2e, 0x12, 0x7c,
-	0x02, 0x9b, 0x38, 0xa2, 0xa1, 0x8b, 0x38, 0xd1, 0xe1, 0xff, 0x53, 0xf0, 0xcf, 0xa7, 0xc3, 0xf7,
-	0xd2, 0xb6, 0x09, 0x9a, 0xfd, 0x00, 0xdf, 0xcb, 0xc9, 0x11, 0x36, 0xd5, 0x72, 0xa8, 0x8b, 0x75,
-	0x86, 0xca, 0x3f, 0x2c, 0x08, 0x4a, 0xa8, 0xbe, 0x8b, 0x8b, 0x04, 0x3e, 0x30, 0x73, 0x82, 0x04,
-	0xc1, 0x61, 0xe4, 0xeb, 0x88, 0x70, 0xc1, 0xcd, 0xff, 0x15, 0xc7, 0xb3, 0xd9, 0x1c, 0xef, 0x54,
-	0xca, 0x4e, 0x7a, 0x14, 0xcd, 0x66, 0x4a, 0xa3, 0xbd, 0xe1, 0xf0, 0x1c, 0xd4, 0x26, 0xa3, 0x24,
-	0xce, 0x5a, 0x52, 0xce, 0xda, 0x9b, 0x4d, 0xd0, 0xef, 0xf6, 0x52, 0x83, 0x55, 0xa4, 0xc1, 0x4c,
-	0xc3, 0x5e, 0xcd, 0xe6, 0x48, 0x9c, 0xf6, 0x0a, 0x3c, 0xca, 0x81, 0x03, 0x39, 0x94, 0x70, 0x70,
-	0x40, 0x49, 0x28, 0x1c, 0xea, 0x99, 0xcb, 0x2d, 0xa3, 0x5d, 0xd6, 0x64, 0x9d, 0xa8, 0x92, 0x9e,
-	0xaa, 0xe8, 0x7b, 0xf0, 0x1c, 0xd4, 0x31, 0x0a, 0x02, 0x0f, 0x09, 0xe4, 0x30, 0x82, 0x23, 0xe6,
-	0x71, 0x13, 0xcc, 0x5b, 0x6e, 0x2f, 0xed, 0xb0, 0x55, 0x43, 0xe2, 0x3e, 0xac, 0xe5, 0x38, 0x3c,
-	0x06, 0x8d, 0xbb, 0xb2, 0xd2, 0xcd, 0x4a, 0x5d, 0x2b, 0xb9, 0xae, 0x2d, 0x4d, 0x57, 0xba, 0xae,
-	0xbe, 0x07, 0x31, 0xd8, 0xc2, 0x3e, 0xa2, 0x61, 0x40, 0xc3, 0x2f, 0xfa, 0xb7, 0xaf, 0x2a, 0x79,
-	0x07, 0x33, 0xe4, 0x65, 0x7d, 0x05, 0x7b, 0x6d, 0xe0, 0xfb, 0x49, 0xe9, 0x60, 0xd3, 0xa7, 0x5c,
-	0x44, 0x8c, 0x62, 0x14, 0xa4, 0x2c, 0xd9, 0x16, 0x56, 0x15, 0xcd, 0xee, 0x9c, 0x33, 0x92, 0x8e,
-	0x6b, 0x6f, 0x4d, 0x70, 0x8a, 0x79, 0x78, 0x0a, 0x6a, 0x31, 0x8b, 0x2e, 0xa9, 0x47, 0x58, 0xa6,
-	0x7f, 0x4d, 0x01, 0xef, 0xcd, 0x02, 0x4e, 0x1a, 0x12, 0xe5, 0x6b, 0x71, 0x31, 0xe4, 0xf0, 0x3d,
-	0x58, 0x8f, 0xc7, 0xc2, 0xd7, 0x77, 0x52, 0x9b, 0x7b, 0xa0, 0xc7, 0xc2, 0x2f, 0xac, 0xa3, 0x16,
-	0x6b, 0xb1, 0xb4, 0x27, 0x94, 0xfa, 0xef, 0xac, 0xba, 0xae, 0x60, 0xf7, 0xa7, 0xc3, 0x9e, 0xc9,
-	0x9e, 0x02, 0x6e, 0x9d, 0xeb, 0x09, 0x0e, 0xf7, 0x41, 0x3d, 0x05, 0x1e, 0xb9, 0x01, 0xe5, 0xbe,
-	0xfc, 0x17, 0xae, 0xcb, 0x7f, 0xa1, 0x5d, 0x4b, 0x6a, 0xf3, 0x34, 0xfc, 0x66, 0x80, 0x27, 0x93,
-	0x6f, 0xae, 0x2c, 0xc9, 0x05, 0x23, 0x68, 0xc8, 0x75, 0x55, 0x50, 0xa9, 0x7a, 0xf9, 0x17, 0x06,
-	0x78, 0x8d, 0x04, 0x3a, 0x4b, 0x20, 0x0a, 0x22, 0xb7, 0xf1, 0xcc, 0xf7, 0x7c, 0xa7, 0x0f, 0xd6,
-	0x74, 0x8b, 0xc3, 0xc7, 0x60, 0x79, 0x72, 0xa8, 0xe4, 0x8d, 0x50, 0xb6, 0x97, 0x70, 0x76, 0x86,
-	0x1a, 0x60, 0x29, 0x73, 0xbf, 0xb9, 0xd0, 0x32, 0xda, 0x55, 0x3b, 0x8f, 0xbb, 0x17, 0x57, 0x37,
-	0x4d, 0xe3, 0xfa, 0xa6, 0x69, 0xfc, 0xbe, 0x69, 0x1a, 0x3f, 0x6e, 0x9b, 0xa5, 0xeb, 0xdb, 0x66,
-	0xe9, 0xe7, 0x6d, 0xb3, 0xf4, 0xf1, 0x64, 0x40, 0x85, 0x3f, 0x72, 0x3b, 0x38, 0x1a, 0x5a, 0xfd,
-	0x6c, 0x92, 0x13, 0xe4, 0x72, 0x2b, 0x9f, 0xeb, 0x00, 0x47, 0x8c, 0x14, 0x43, 0xa9, 0xdb, 0x1a,
-	0x46, 0xde, 0x28, 0x20, 0x3c, 0xbb, 0xe5, 0xc4, 0x38, 0x26, 0xdc, 0xad, 0xa8, 0x7b, 0xec, 0xc5,
-	0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa3, 0xa9, 0x50, 0x8f, 0x48, 0x07, 0x00, 0x00,
+	// 790 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x4f, 0x6f, 0xda, 0x4a,
+	0x10, 0xc7, 0x81, 0xc7, 0x4b, 0x36, 0x10, 0xc8, 0x42, 0xf2, 0xfc, 0xa8, 0x44, 0x50, 0xaa, 0x26,
+	0x44, 0x6d, 0x40, 0x49, 0x2f, 0x3d, 0x54, 0x39, 0x40, 0xd5, 0x8a, 0x2a, 0x52, 0x91, 0x93, 0x2a,
+	0x55, 0x2f, 0xee, 0x7a, 0xbd, 0xc1, 0x9b, 0x1a, 0xdb, 0xf5, 0x2e, 0x91, 0xf8, 0x02, 0x3d, 0xf7,
+	0x63, 0xe5, 0xd6, 0x1c, 0x7b, 0xaa, 0xaa, 0xe4, 0x8b, 0x54, 0xbb, 0x5e, 0x83, 0x1d, 0x04, 0x54,
+	0xbd, 0x79, 0xc7, 0xf3, 0xfb, 0x33, 0xe3, 0xd9, 0x31, 0xd8, 0xa3, 0xde, 0x15, 0xc1, 0x9c, 0x5e,
+	0x93, 0xb6, 0x1f, 0x22, 0xec, 0x92, 0xf6, 0xf5, 0x91, 0x45, 0x38, 0x3a, 0x6a, 0x0f, 0x88, 0x47,
+	0x18, 0x65, 0xad, 0x20, 0xf4, 0xb9, 0x0f, 0xf5, 0x49, 0x5e, 0x2b, 0xca, 0x6b, 0xa9, 0xbc, 0xda,
+	0x93, 0xb9, 0x0c, 0x2a, 0x51, 0x12, 0xd4, 0xaa, 0x03, 0x7f, 0xe0, 0xcb, 0xc7, 0xb6, 0x78, 0x8a,
+	0xa2, 0xbb, 0xdf, 0x8b, 0xa0, 0xf0, 0x26, 0x12, 0x3a, 0xe3, 0x88, 0x13, 0x78, 0x02, 0xf2, 0x01,
+	0x0a, 0xd1, 0x90, 0xe9, 0x5a, 0x43, 0x6b, 0xae, 0x1f, 0x37, 0x5a, 0xf3, 0x84, 0x5b, 0x7d, 0x99,
+	0xd7, 0xc9, 0xdd, 0xfc, 0xdc, 0xc9, 0x18, 0x0a, 0x05, 0xf7, 0x41, 0xd1, 0x42, 0x9e, 0x6d, 0x86,
+	0xc4, 0x45, 0x63, 0x12, 0x32, 0x7d, 0xa5, 0x91, 0x6d, 0xae, 0x75, 0x56, 0x74, 0xcd, 0x28, 0x88,
+	0x17, 0x86, 0x8a, 0xc3, 0x0f, 0x60, 0x53, 0x26, 0x06, 0x21, 0xc5, 0xc4, 0x64, 0x42, 0x9c, 0xe9,
+	0xd9, 0x46, 0xb6, 0xb9, 0x7e, 0xdc, 0x9c, 0xaf, 0xd9, 0x41, 0x9e, 0xdd, 0x17, 0x08, 0xe9, 0x56,
+	0xd2, 0x96, 0xac, 0x54, 0x8c, 0x41, 0x13, 0xfc, 0x17, 0x91, 0x5e, 0x12, 0xf2, 0x80, 0x3f, 0xb7,
+	0x8c, 0x5f, 0xf2, 0xbc, 0x26, 0xc4, 0x96, 0x5c, 0x46, 0x35, 0x88, 0xcf, 0x49, 0x81, 0x4f, 0x60,
+	0x0b, 0xfb, 0xd4, 0xb3, 0x10, 0x23, 0x69, 0xfa, 0x7f, 0x24, 0xfd, 0xb3, 0xf9, 0xf4, 0x5d, 0x05,
+	0x9b, 0xb2, 0x19, 0x15, 0x3c, 0x13, 0x13, 0x25, 0x6c, 0xc9, 0xe6, 0x50, 0x0b, 0xa7, 0x15, 0xf2,
+	0x7f, 0xd1, 0x20, 0x28, 0xa8, 0x7a, 0x16, 0x4e, 0x0a, 0x38, 0x40, 0x9f, 0x08, 0x44, 0x0c, 0x66,
+	0x48, 0xbe, 0x8c, 0x08, 0xe3, 0x4c, 0xff, 0x57, 0x6a, 0x3c, 0x5d, 0xac, 0xf1, 0x4e, 0x86, 0x8c,
+	0x08, 0x23, 0x65, 0xb6, 0x94, 0x4c, 0xea, 0x0d, 0x83, 0x17, 0xa0, 0x34, 0x2d, 0x25, 0x9a, 0xac,
+	0x55, 0x39, 0x59, 0xfb, 0x8b, 0x05, 0x7a, 0x9d, 0xae, 0x1a, 0xb0, 0xbc, 0x18, 0x30, 0x5d, 0x33,
+	0x8a, 0x71, 0x1d, 0xd1, 0xa4, 0xbd, 0x04, 0xff, 0x4f, 0x88, 0x5d, 0x51, 0x14, 0x37, 0xb1, 0x4b,
+	0x89, 0xc7, 0x4d, 0x6a, 0xeb, 0x6b, 0x0d, 0xad, 0x99, 0x4b, 0xd9, 0x3a, 0x95, 0x29, 0x5d, 0x99,
+	0xd1, 0xb3, 0xe1, 0x05, 0x28, 0x63, 0xe4, 0xba, 0x36, 0xe2, 0xc8, 0x0c, 0x09, 0xf6, 0x43, 0x9b,
+	0xe9, 0x60, 0x59, 0x73, 0xbb, 0x0a, 0x61, 0x48, 0x40, 0x34, 0x7d, 0x38, 0x15, 0x63, 0xf0, 0x04,
+	0xd4, 0x1e, 0xda, 0x52, 0x9d, 0x15, 0xbe, 0xd6, 0x27, 0xbe, 0xb6, 0x53, 0xbe, 0x54, 0xbb, 0x7a,
+	0x36, 0xc4, 0x60, 0x1b, 0x3b, 0x88, 0x7a, 0x2e, 0xf5, 0x3e, 0xa7, 0xbf, 0x7d, 0x41, 0xda, 0x3b,
+	0x5c, 0x60, 0x2f, 0xc6, 0x25, 0xc6, 0xab, 0x8a, 0x67, 0x83, 0x62, 0x82, 0x75, 0x87, 0x32, 0xee,
+	0x87, 0x14, 0x23, 0x57, 0xa9, 0xc4, 0x5d, 0x28, 0x4a, 0x99, 0xbd, 0x25, 0x77, 0x44, 0x95, 0x6b,
+	0x6c, 0x4f, 0x79, 0x92, 0x71, 0xd8, 0x07, 0xa5, 0x20, 0xf4, 0xaf, 0xa9, 0x4d, 0xc2, 0xd8, 0xff,
+	0x46, 0x23, 0xbb, 0xf8, 0xb3, 0xf7, 0x15, 0x20, 0x72, 0xbe, 0x11, 0x24, 0x8f, 0x0c, 0x9e, 0x83,
+	0xcd, 0x60, 0xcc, 0x9d, 0x74, 0x4f, 0x4a, 0x4b, 0x2f, 0xf4, 0x98, 0x3b, 0x89, 0x76, 0x94, 0x82,
+	0xd4, 0x59, 0x8c, 0x27, 0x14, 0xfe, 0x1f, 0xb4, 0xba, 0x2c, 0x69, 0x0f, 0xe6, 0xd3, 0x9e, 0x09,
+	0x4c, 0x82, 0xb7, 0xcc, 0xd2, 0x01, 0x06, 0x0f, 0x40, 0x59, 0x11, 0x8f, 0x2c, 0x97, 0x32, 0x47,
+	0xec, 0xc2, 0x4d, 0xb1, 0x0b, 0x8d, 0x52, 0x94, 0x3b, 0x09, 0xc3, 0xaf, 0x1a, 0x78, 0x3c, 0xfd,
+	0xe6, 0x72, 0x24, 0x19, 0x0f, 0x09, 0x1a, 0xb2, 0xb4, 0x2b, 0x28, 0x5d, 0xbd, 0xf8, 0x83, 0x01,
+	0x78, 0x85, 0x38, 0x3a, 0x8b, 0x28, 0x12, 0x26, 0x77, 0xf0, 0xc2, 0xf7, 0x72, 0xb1, 0x45, 0x82,
+	0x57, 0xa3, 0x61, 0x60, 0x72, 0x27, 0x24, 0xcc, 0xf1, 0x5d, 0x9b, 0xe9, 0x95, 0x65, 0x8b, 0x4d,
+	0xb2, 0xbc, 0x1d, 0x0d, 0x83, 0xf3, 0x18, 0x64, 0x54, 0x82, 0x99, 0x58, 0xa4, 0x40, 0x3c, 0x9b,
+	0x7a, 0x03, 0x55, 0xda, 0x28, 0xb0, 0x65, 0x6d, 0xd5, 0xa5, 0x0a, 0x11, 0x4c, 0x0a, 0xbd, 0x97,
+	0x20, 0xa3, 0x12, 0xcc, 0xc4, 0xd8, 0x6e, 0x0f, 0x6c, 0xa4, 0xaf, 0x29, 0x7c, 0x04, 0xd6, 0xa6,
+	0x8b, 0x41, 0xfc, 0xd5, 0x72, 0xc6, 0x2a, 0x8e, 0xf7, 0x40, 0x0d, 0xac, 0xc6, 0x37, 0x58, 0x5f,
+	0x69, 0x68, 0xcd, 0x82, 0x31, 0x39, 0x77, 0x2e, 0x6f, 0xee, 0xea, 0xda, 0xed, 0x5d, 0x5d, 0xfb,
+	0x75, 0x57, 0xd7, 0xbe, 0xdd, 0xd7, 0x33, 0xb7, 0xf7, 0xf5, 0xcc, 0x8f, 0xfb, 0x7a, 0xe6, 0xe3,
+	0xe9, 0x80, 0x72, 0x67, 0x64, 0xb5, 0xb0, 0x3f, 0x6c, 0xf7, 0x62, 0xc7, 0xa7, 0xc8, 0x62, 0xed,
+	0x89, 0xff, 0x43, 0xec, 0x87, 0x24, 0x79, 0x14, 0xbd, 0x6f, 0x0f, 0x7d, 0x7b, 0xe4, 0x12, 0x16,
+	0xff, 0xa9, 0xf9, 0x38, 0x20, 0xcc, 0xca, 0xcb, 0x7f, 0xf1, 0xf3, 0xdf, 0x03, 0x00, 0x98, 0x50,
+	0x58, 0x40, 0x0c, 0x08, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -344,6 +364,38 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingPriceUpdates) > 0 {
+		for iNdEx := len(m.PendingPriceUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingPriceUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xa2
+		}
+	}
+	if len(m.PriceJumpThresholds) > 0 {
+		for iNdEx := len(m.PriceJumpThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PriceJumpThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
 	if len(m.ChainlinkDataStreamsPriceStates) > 0 {
 		for iNdEx := len(m.ChainlinkDataStreamsPriceStates) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -715,6 +767,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.PriceJumpThresholds) > 0 {
+		for _, e := range m.PriceJumpThresholds {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingPriceUpdates) > 0 {
+		for _, e := range m.PendingPriceUpdates {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1345,6 +1409,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceJumpThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PriceJumpThresholds = append(m.PriceJumpThresholds, &PriceJumpThreshold{})
+			if err := m.PriceJumpThresholds[len(m.PriceJumpThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPriceUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingPriceUpdates = append(m.PendingPriceUpdates, &PendingPriceUpdate{})
+			if err := m.PendingPriceUpdates[len(m.PendingPriceUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
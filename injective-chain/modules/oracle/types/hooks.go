@@ -0,0 +1,33 @@
+package types
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// OracleHooks lets other modules react to prices being held back by the circuit breaker.
+type OracleHooks interface {
+	AfterPriceJumpDetected(ctx sdk.Context, pendingUpdate *PendingPriceUpdate)
+	AfterPendingPriceResolved(ctx sdk.Context, oracleType OracleType, symbol string, resolution PendingPriceResolution)
+}
+
+var _ OracleHooks = MultiOracleHooks{}
+
+type MultiOracleHooks []OracleHooks
+
+func NewMultiOracleHooks(hooks ...OracleHooks) MultiOracleHooks {
+	return hooks
+}
+
+func (h MultiOracleHooks) AfterPriceJumpDetected(ctx sdk.Context, pendingUpdate *PendingPriceUpdate) {
+	for i := range h {
+		h[i].AfterPriceJumpDetected(ctx, pendingUpdate)
+	}
+}
+
+func (h MultiOracleHooks) AfterPendingPriceResolved(
+	ctx sdk.Context, oracleType OracleType, symbol string, resolution PendingPriceResolution,
+) {
+	for i := range h {
+		h[i].AfterPendingPriceResolved(ctx, oracleType, symbol, resolution)
+	}
+}
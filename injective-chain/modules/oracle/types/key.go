@@ -65,6 +65,11 @@ var (
 
 	// ChainlinkDataStreamsPriceKey is the prefix for the feedID => ChainlinkDataStreamsPriceState store.
 	ChainlinkDataStreamsPriceKey = []byte{0x91}
+
+	// PriceJumpThresholdPrefix is the prefix for the oracleType + symbol => PriceJumpThreshold store.
+	PriceJumpThresholdPrefix = []byte{0xa1}
+	// PendingPriceUpdatePrefix is the prefix for the oracleType + symbol => PendingPriceUpdate store.
+	PendingPriceUpdatePrefix = []byte{0xa2}
 )
 
 func GetBandPriceStoreKey(symbol string) []byte {
@@ -177,3 +182,21 @@ func GetPythPriceStoreKey(priceID common.Hash) []byte {
 func GetChainlinkDataStreamsPriceStoreKey(feedID string) []byte {
 	return append(ChainlinkDataStreamsPriceKey, []byte(feedID)...)
 }
+
+func getOracleSymbolKey(prefix []byte, oracleType OracleType, symbol string) []byte {
+	buf := make([]byte, 0, len(prefix)+8+len(symbol))
+	buf = append(buf, prefix...)
+	buf = append(buf, sdk.Uint64ToBigEndian(uint64(oracleType))...)
+	buf = append(buf, []byte(symbol)...)
+	return buf
+}
+
+// GetPriceJumpThresholdKey returns the store key for the circuit breaker threshold of a symbol.
+func GetPriceJumpThresholdKey(oracleType OracleType, symbol string) []byte {
+	return getOracleSymbolKey(PriceJumpThresholdPrefix, oracleType, symbol)
+}
+
+// GetPendingPriceUpdateKey returns the store key for the pending price update of a symbol.
+func GetPendingPriceUpdateKey(oracleType OracleType, symbol string) []byte {
+	return getOracleSymbolKey(PendingPriceUpdatePrefix, oracleType, symbol)
+}
@@ -26,6 +26,9 @@ const (
 	TypeMsgRelayStorkPrices      = "relayStorkPrices"
 	TypeMsgRelayChainlinkPrices  = "relayChainlinkPrices"
 	TypeMsgUpdateParams          = "updateParams"
+
+	TypeMsgUpdatePriceJumpThresholds = "updatePriceJumpThresholds"
+	TypeMsgResolvePendingPrice       = "resolvePendingPrice"
 )
 
 var (
@@ -36,6 +39,8 @@ var (
 	_ sdk.Msg = &MsgRelayStorkPrices{}
 	_ sdk.Msg = &MsgRelayChainlinkPrices{}
 	_ sdk.Msg = &MsgUpdateParams{}
+	_ sdk.Msg = &MsgUpdatePriceJumpThresholds{}
+	_ sdk.Msg = &MsgResolvePendingPrice{}
 )
 
 func (msg MsgUpdateParams) Route() string { return RouterKey }
@@ -63,6 +68,89 @@ func (msg MsgUpdateParams) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{addr}
 }
 
+func (msg MsgUpdatePriceJumpThresholds) Route() string { return RouterKey }
+
+func (msg MsgUpdatePriceJumpThresholds) Type() string { return TypeMsgUpdatePriceJumpThresholds }
+
+func (msg MsgUpdatePriceJumpThresholds) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if len(msg.SetThresholds) == 0 && len(msg.RemoveThresholds) == 0 {
+		return errors.Wrap(ErrInvalidPriceJumpThreshold, "no thresholds to set or remove")
+	}
+
+	seen := make(map[string]struct{}, len(msg.SetThresholds)+len(msg.RemoveThresholds))
+	checkUnique := func(oracleType OracleType, symbol string) error {
+		key := string(GetPriceJumpThresholdKey(oracleType, symbol))
+		if _, ok := seen[key]; ok {
+			return errors.Wrapf(ErrInvalidPriceJumpThreshold, "duplicate threshold for %s %s", oracleType.String(), symbol)
+		}
+		seen[key] = struct{}{}
+		return nil
+	}
+
+	for i := range msg.SetThresholds {
+		threshold := &msg.SetThresholds[i]
+		if err := threshold.Validate(); err != nil {
+			return err
+		}
+		if err := checkUnique(threshold.OracleType, threshold.Symbol); err != nil {
+			return err
+		}
+	}
+
+	for _, info := range msg.RemoveThresholds {
+		if info.Symbol == "" {
+			return errors.Wrap(ErrInvalidSymbol, "symbol cannot be empty")
+		}
+		if err := checkUnique(info.OracleType, info.Symbol); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (msg *MsgUpdatePriceJumpThresholds) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgUpdatePriceJumpThresholds) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
+func (msg MsgResolvePendingPrice) Route() string { return RouterKey }
+
+func (msg MsgResolvePendingPrice) Type() string { return TypeMsgResolvePendingPrice }
+
+func (msg MsgResolvePendingPrice) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "invalid authority address")
+	}
+
+	if !IsPriceJumpGuardedOracle(msg.OracleType) {
+		return errors.Wrapf(ErrUnsupportedOracleType, "price jump thresholds are not supported for %s", msg.OracleType.String())
+	}
+
+	if msg.Symbol == "" {
+		return errors.Wrap(ErrInvalidSymbol, "symbol cannot be empty")
+	}
+
+	return nil
+}
+
+func (msg *MsgResolvePendingPrice) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(msg))
+}
+
+func (msg MsgResolvePendingPrice) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(msg.Authority)
+	return []sdk.AccAddress{addr}
+}
+
 // Route implements the sdk.Msg interface. It should return the name of the module
 func (msg MsgRelayPriceFeedPrice) Route() string { return RouterKey }
 
@@ -90,6 +90,45 @@ func (OracleType) EnumDescriptor() ([]byte, []int) {
 	return fileDescriptor_1c8fbf1e7a765423, []int{0}
 }
 
+type PendingPriceResolution int32
+
+const (
+	// the pending price was confirmed by a second update
+	PendingPriceResolution_Confirmed PendingPriceResolution = 0
+	// a second update returned close to the active price
+	PendingPriceResolution_Reverted PendingPriceResolution = 1
+	// the pending price was not confirmed within the confirmation window
+	PendingPriceResolution_Expired PendingPriceResolution = 2
+	// the pending price was accepted by governance
+	PendingPriceResolution_Accepted PendingPriceResolution = 3
+	// the pending price was rejected by governance
+	PendingPriceResolution_Rejected PendingPriceResolution = 4
+)
+
+var PendingPriceResolution_name = map[int32]string{
+	0: "Confirmed",
+	1: "Reverted",
+	2: "Expired",
+	3: "Accepted",
+	4: "Rejected",
+}
+
+var PendingPriceResolution_value = map[string]int32{
+	"Confirmed": 0,
+	"Reverted":  1,
+	"Expired":   2,
+	"Accepted":  3,
+	"Rejected":  4,
+}
+
+func (x PendingPriceResolution) String() string {
+	return proto.EnumName(PendingPriceResolution_name, int32(x))
+}
+
+func (PendingPriceResolution) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{1}
+}
+
 type Params struct {
 	PythContract                                string `protobuf:"bytes,1,opt,name=pyth_contract,json=pythContract,proto3" json:"pyth_contract,omitempty"`
 	ChainlinkVerifierProxyContract              string `protobuf:"bytes,2,opt,name=chainlink_verifier_proxy_contract,json=chainlinkVerifierProxyContract,proto3" json:"chainlink_verifier_proxy_contract,omitempty"`
@@ -1779,9 +1818,162 @@ func (m *ChainlinkReport) GetObservationsTimestamp() uint64 {
 	return 0
 }
 
+// PriceJumpThreshold configures the circuit breaker for a single oracle symbol.
+type PriceJumpThreshold struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	Symbol     string     `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// max_jump_rate is the largest relative change between the active price and
+	// a new update that is applied without confirmation, e.g. 0.4 for 40%
+	MaxJumpRate cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=max_jump_rate,json=maxJumpRate,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_jump_rate"`
+	// confirmation_window is the time in seconds a pending price waits for a
+	// confirming update before it is discarded
+	ConfirmationWindow int64 `protobuf:"varint,4,opt,name=confirmation_window,json=confirmationWindow,proto3" json:"confirmation_window,omitempty"`
+}
+
+func (m *PriceJumpThreshold) Reset()         { *m = PriceJumpThreshold{} }
+func (m *PriceJumpThreshold) String() string { return proto.CompactTextString(m) }
+func (*PriceJumpThreshold) ProtoMessage()    {}
+func (*PriceJumpThreshold) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{26}
+}
+func (m *PriceJumpThreshold) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceJumpThreshold) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceJumpThreshold.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceJumpThreshold) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceJumpThreshold.Merge(m, src)
+}
+func (m *PriceJumpThreshold) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceJumpThreshold) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceJumpThreshold.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceJumpThreshold proto.InternalMessageInfo
+
+func (m *PriceJumpThreshold) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
+	}
+	return OracleType_Unspecified
+}
+
+func (m *PriceJumpThreshold) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *PriceJumpThreshold) GetConfirmationWindow() int64 {
+	if m != nil {
+		return m.ConfirmationWindow
+	}
+	return 0
+}
+
+// PendingPriceUpdate is a price update held back by the circuit breaker until
+// it is confirmed by a second update or resolved by governance.
+type PendingPriceUpdate struct {
+	OracleType OracleType `protobuf:"varint,1,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	Symbol     string     `protobuf:"bytes,2,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// the price that was active when the jump was detected
+	ActivePrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=active_price,json=activePrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"active_price"`
+	// the price that exceeded the jump threshold
+	PendingPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=pending_price,json=pendingPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"pending_price"`
+	// the timestamp reported by the oracle for the pending price
+	PriceTimestamp uint64 `protobuf:"varint,5,opt,name=price_timestamp,json=priceTimestamp,proto3" json:"price_timestamp,omitempty"`
+	// the block time at which the jump was detected
+	DetectedAt int64 `protobuf:"varint,6,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	// the block time after which the pending price is discarded
+	ExpiresAt int64 `protobuf:"varint,7,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+}
+
+func (m *PendingPriceUpdate) Reset()         { *m = PendingPriceUpdate{} }
+func (m *PendingPriceUpdate) String() string { return proto.CompactTextString(m) }
+func (*PendingPriceUpdate) ProtoMessage()    {}
+func (*PendingPriceUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1c8fbf1e7a765423, []int{27}
+}
+func (m *PendingPriceUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PendingPriceUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PendingPriceUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PendingPriceUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PendingPriceUpdate.Merge(m, src)
+}
+func (m *PendingPriceUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *PendingPriceUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_PendingPriceUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PendingPriceUpdate proto.InternalMessageInfo
+
+func (m *PendingPriceUpdate) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
+	}
+	return OracleType_Unspecified
+}
+
+func (m *PendingPriceUpdate) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *PendingPriceUpdate) GetPriceTimestamp() uint64 {
+	if m != nil {
+		return m.PriceTimestamp
+	}
+	return 0
+}
+
+func (m *PendingPriceUpdate) GetDetectedAt() int64 {
+	if m != nil {
+		return m.DetectedAt
+	}
+	return 0
+}
+
+func (m *PendingPriceUpdate) GetExpiresAt() int64 {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
 	golang_proto.RegisterEnum("injective.oracle.v1beta1.OracleType", OracleType_name, OracleType_value)
+	proto.RegisterEnum("injective.oracle.v1beta1.PendingPriceResolution", PendingPriceResolution_name, PendingPriceResolution_value)
+	golang_proto.RegisterEnum("injective.oracle.v1beta1.PendingPriceResolution", PendingPriceResolution_name, PendingPriceResolution_value)
 	proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
 	golang_proto.RegisterType((*Params)(nil), "injective.oracle.v1beta1.Params")
 	proto.RegisterType((*OracleInfo)(nil), "injective.oracle.v1beta1.OracleInfo")
@@ -1834,6 +2026,10 @@ func init() {
 	golang_proto.RegisterType((*SignedPriceOfAssetPair)(nil), "injective.oracle.v1beta1.SignedPriceOfAssetPair")
 	proto.RegisterType((*ChainlinkReport)(nil), "injective.oracle.v1beta1.ChainlinkReport")
 	golang_proto.RegisterType((*ChainlinkReport)(nil), "injective.oracle.v1beta1.ChainlinkReport")
+	proto.RegisterType((*PriceJumpThreshold)(nil), "injective.oracle.v1beta1.PriceJumpThreshold")
+	golang_proto.RegisterType((*PriceJumpThreshold)(nil), "injective.oracle.v1beta1.PriceJumpThreshold")
+	proto.RegisterType((*PendingPriceUpdate)(nil), "injective.oracle.v1beta1.PendingPriceUpdate")
+	golang_proto.RegisterType((*PendingPriceUpdate)(nil), "injective.oracle.v1beta1.PendingPriceUpdate")
 }
 
 func init() {
@@ -1844,134 +2040,147 @@ func init() {
 }
 
 var fileDescriptor_1c8fbf1e7a765423 = []byte{
-	// 2029 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x58, 0x4f, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0xcf, 0x8c, 0xe7, 0xcf, 0x9b, 0x19, 0xbb, 0x53, 0x71, 0xc2, 0x24, 0xbb, 0x3b, 0xce,
-	0xce, 0x12, 0xb0, 0xc2, 0x66, 0x26, 0xc9, 0x0a, 0xa1, 0x04, 0x84, 0x12, 0x3b, 0xc9, 0x6a, 0x94,
-	0xc0, 0x5a, 0xed, 0x24, 0x48, 0x5c, 0x9a, 0x9a, 0xee, 0x1a, 0xbb, 0xd6, 0xdd, 0x5d, 0xbd, 0x5d,
-	0x3d, 0x5e, 0x4f, 0x24, 0xbe, 0x40, 0x2e, 0xf0, 0x05, 0x90, 0x38, 0x73, 0x02, 0x09, 0x4e, 0x48,
-	0x08, 0x71, 0xda, 0x03, 0x12, 0x7b, 0x5a, 0xad, 0x38, 0x2c, 0x90, 0x1c, 0xe0, 0xc4, 0x85, 0x2f,
-	0x80, 0x5e, 0x55, 0x75, 0x4f, 0xfb, 0x5f, 0xec, 0x21, 0xec, 0x5e, 0xec, 0xae, 0x57, 0xef, 0xbd,
-	0x7a, 0xff, 0xea, 0xbd, 0x5f, 0x0d, 0x5c, 0xe1, 0xd1, 0x87, 0xcc, 0x4b, 0xf9, 0x2e, 0x1b, 0x88,
-	0x84, 0x7a, 0x01, 0x1b, 0xec, 0xde, 0x18, 0xb1, 0x94, 0xde, 0x30, 0xcb, 0x7e, 0x9c, 0x88, 0x54,
-	0x90, 0x4e, 0xce, 0xd6, 0x37, 0x74, 0xc3, 0x76, 0x69, 0x79, 0x4b, 0x6c, 0x09, 0xc5, 0x34, 0xc0,
-	0x2f, 0xcd, 0x7f, 0xa9, 0xeb, 0x09, 0x19, 0x0a, 0x39, 0x18, 0x51, 0x39, 0xd3, 0xe8, 0x09, 0x1e,
-	0x99, 0xfd, 0xb3, 0x34, 0xe4, 0x91, 0x18, 0xa8, 0xbf, 0x9a, 0xd4, 0xfb, 0xbc, 0x04, 0xd5, 0x0d,
-	0x9a, 0xd0, 0x50, 0x92, 0x77, 0xa0, 0x1d, 0x4f, 0xd3, 0x6d, 0xd7, 0x13, 0x51, 0x9a, 0x50, 0x2f,
-	0xed, 0x58, 0x97, 0xad, 0xd5, 0x86, 0xd3, 0x42, 0xe2, 0xba, 0xa1, 0x91, 0x21, 0xbc, 0xed, 0x6d,
-	0x53, 0x1e, 0x05, 0x3c, 0xda, 0x71, 0x77, 0x59, 0xc2, 0xc7, 0x9c, 0x25, 0x6e, 0x9c, 0x88, 0xbd,
-	0xe9, 0x4c, 0xb0, 0xa4, 0x04, 0xbb, 0x39, 0xe3, 0x53, 0xc3, 0xb7, 0x81, 0x6c, 0xb9, 0x2a, 0x06,
-	0xd7, 0xa9, 0xe7, 0xb1, 0x38, 0x75, 0x27, 0x91, 0xd1, 0xe4, 0xbb, 0x33, 0xe5, 0x3e, 0x4d, 0xa9,
-	0x2b, 0xd3, 0x84, 0xd1, 0x50, 0xba, 0x09, 0x8b, 0x45, 0x92, 0xca, 0x4e, 0xf9, 0xb2, 0xb5, 0x5a,
-	0x77, 0xbe, 0xa5, 0xe5, 0x9e, 0xe4, 0x62, 0xeb, 0x99, 0xd4, 0x3d, 0x9a, 0xd2, 0x4d, 0x2d, 0xe3,
-	0x68, 0x11, 0xe2, 0xc2, 0xb5, 0x63, 0x94, 0x6a, 0x69, 0x8f, 0xa6, 0x5c, 0x44, 0xee, 0x16, 0x95,
-	0x6e, 0xc0, 0x43, 0x9e, 0x76, 0x2a, 0x97, 0xad, 0xd5, 0x8a, 0xb3, 0xea, 0x1d, 0xa1, 0xf3, 0x69,
-	0x41, 0xe2, 0x7d, 0x2a, 0x1f, 0x21, 0xff, 0xed, 0x0b, 0xff, 0xfa, 0xe5, 0x8a, 0xf5, 0xfc, 0x9f,
-	0xbf, 0xbe, 0xda, 0x36, 0xb9, 0xd4, 0xf1, 0xec, 0xed, 0x00, 0x7c, 0xa0, 0x08, 0xc3, 0x68, 0x2c,
-	0xc8, 0x05, 0xa8, 0xca, 0x69, 0x38, 0x12, 0x81, 0x09, 0xab, 0x59, 0x91, 0xfb, 0xd0, 0xd4, 0x62,
-	0x6e, 0x3a, 0x8d, 0x99, 0x0a, 0xdd, 0xe2, 0xcd, 0xaf, 0xf7, 0x8f, 0xcb, 0x7c, 0x5f, 0xab, 0x7c,
-	0x3c, 0x8d, 0x99, 0x03, 0x22, 0xff, 0xee, 0x7d, 0x66, 0xc1, 0xb9, 0x3c, 0x0a, 0x1b, 0x09, 0xf7,
-	0xd8, 0x66, 0x4a, 0x53, 0x46, 0xbe, 0x06, 0xb5, 0x31, 0x63, 0xbe, 0xcb, 0xfd, 0xec, 0x5c, 0x5c,
-	0x0e, 0x7d, 0xf2, 0x5d, 0xa8, 0xd2, 0x48, 0x7e, 0xcc, 0x12, 0x9d, 0xad, 0xb5, 0x77, 0x3e, 0xf9,
-	0x62, 0xe5, 0xcc, 0x5f, 0xbf, 0x58, 0x79, 0x43, 0xd7, 0x90, 0xf4, 0x77, 0xfa, 0x5c, 0x0c, 0x42,
-	0x9a, 0x6e, 0xf7, 0x1f, 0xb1, 0x2d, 0xea, 0x4d, 0xef, 0x31, 0xcf, 0x31, 0x22, 0xe4, 0x4d, 0x68,
-	0xa4, 0x3c, 0x64, 0x32, 0xa5, 0x61, 0xac, 0x72, 0x52, 0x71, 0x66, 0x04, 0xf2, 0x10, 0x9a, 0x31,
-	0x5a, 0xe0, 0x4a, 0x34, 0x41, 0xc5, 0xb3, 0xf9, 0x2a, 0x97, 0x66, 0xe6, 0xae, 0x55, 0xd0, 0x0a,
-	0x07, 0xe2, 0x9c, 0xd2, 0xfb, 0xb7, 0x05, 0x8b, 0x6b, 0x34, 0xf2, 0x0b, 0x3e, 0x1d, 0x17, 0xca,
-	0x1b, 0x50, 0x49, 0xf0, 0x40, 0xed, 0xd0, 0x5b, 0xc6, 0xa1, 0xf3, 0x87, 0x1d, 0x1a, 0x46, 0xa9,
-	0xa3, 0x58, 0xc9, 0xdb, 0xd0, 0x4a, 0x98, 0x14, 0xc1, 0x2e, 0x73, 0xd1, 0x7e, 0xe3, 0x4b, 0xd3,
-	0xd0, 0x1e, 0xf3, 0x90, 0x91, 0xb7, 0x00, 0x12, 0xf6, 0xd1, 0x84, 0xc9, 0xd4, 0x1d, 0xde, 0x33,
-	0xc5, 0xd1, 0x30, 0x94, 0xe1, 0xbd, 0x83, 0xce, 0x2e, 0xbc, 0x8e, 0xb3, 0xb7, 0x4b, 0x1d, 0xab,
-	0xf7, 0x0b, 0x0b, 0x16, 0x15, 0xd3, 0x03, 0xc6, 0x7c, 0xed, 0x30, 0x81, 0x0a, 0x5e, 0x69, 0xe3,
-	0xae, 0xfa, 0x26, 0xcb, 0xb0, 0xf0, 0xd1, 0x44, 0x64, 0xde, 0x3a, 0x7a, 0x81, 0xd5, 0x54, 0xb4,
-	0xa6, 0x7c, 0x7a, 0x6b, 0x8a, 0x76, 0x90, 0x4b, 0x50, 0x4f, 0x58, 0x40, 0xa7, 0x2c, 0x91, 0x9d,
-	0xca, 0xe5, 0xf2, 0x6a, 0xc3, 0xc9, 0xd7, 0xbd, 0x07, 0xd0, 0xda, 0x48, 0xc4, 0x2e, 0xf7, 0x59,
-	0xa2, 0x0a, 0xfb, 0x12, 0xd4, 0x63, 0xb3, 0x36, 0x06, 0xe6, 0xeb, 0x7d, 0x7a, 0x4a, 0x07, 0xf4,
-	0xfc, 0xc1, 0x82, 0x76, 0xa6, 0x48, 0x9f, 0xfa, 0x10, 0xda, 0x99, 0xa4, 0xcb, 0xa3, 0xb1, 0x50,
-	0xea, 0x9a, 0x37, 0xbf, 0xf1, 0x2a, 0xf3, 0x67, 0x86, 0x38, 0xad, 0xb8, 0x68, 0xd6, 0x4f, 0xe0,
-	0x7c, 0xae, 0xac, 0x10, 0x12, 0x6d, 0x47, 0xf3, 0xe6, 0xbb, 0x27, 0x2b, 0x2d, 0xc4, 0xe6, 0x5c,
-	0x7c, 0x88, 0x26, 0x7b, 0xdb, 0x40, 0x0e, 0xb3, 0x1e, 0x5b, 0x9c, 0xb7, 0x61, 0x41, 0xe7, 0xa4,
-	0x34, 0x47, 0x4e, 0xb4, 0x48, 0xef, 0x16, 0x46, 0xca, 0x54, 0x84, 0x72, 0xee, 0xd4, 0x05, 0xd1,
-	0x7b, 0x58, 0x28, 0x26, 0xf5, 0x41, 0x6e, 0xc1, 0x82, 0x8a, 0x87, 0x16, 0x3e, 0xdd, 0xbd, 0xd7,
-	0x12, 0xbd, 0xdf, 0x5b, 0x40, 0xd6, 0x05, 0x8f, 0xf0, 0xbc, 0x82, 0xcb, 0x04, 0x2a, 0x3b, 0x3c,
-	0xca, 0x1a, 0x8c, 0xfa, 0xde, 0xdf, 0x21, 0x4a, 0x07, 0x3b, 0x84, 0x0d, 0xe5, 0x1d, 0x36, 0x55,
-	0xe5, 0xd9, 0x70, 0xf0, 0x13, 0xad, 0xdf, 0xa5, 0xc1, 0x84, 0x99, 0x0b, 0xa6, 0x17, 0xff, 0xd7,
-	0xcb, 0xd5, 0xfb, 0x8b, 0x05, 0x4b, 0x9b, 0xa9, 0x48, 0x8a, 0xed, 0x71, 0x9f, 0x99, 0xd6, 0x41,
-	0x33, 0x67, 0xb9, 0x2c, 0xed, 0xcb, 0xe5, 0xad, 0xcc, 0xd8, 0xf2, 0x1c, 0x21, 0xfc, 0x12, 0x3c,
-	0xfa, 0x9d, 0x05, 0x50, 0x70, 0xe6, 0x7f, 0xcf, 0x2c, 0xf9, 0x21, 0xd8, 0xde, 0x24, 0x9c, 0x04,
-	0x14, 0x6d, 0xd0, 0xf7, 0x65, 0x9e, 0xb9, 0xb0, 0x34, 0x13, 0xd6, 0x45, 0x76, 0x68, 0x40, 0x94,
-	0x0b, 0x71, 0xed, 0x7d, 0x56, 0x82, 0xc5, 0x8d, 0x69, 0xba, 0x5d, 0xb0, 0xfd, 0x22, 0x76, 0x11,
-	0x8c, 0x4b, 0x3e, 0xa8, 0x6a, 0x6a, 0x3d, 0xf4, 0xc9, 0x1d, 0x68, 0xb0, 0x90, 0xce, 0x6f, 0x54,
-	0x9d, 0x85, 0x54, 0x5b, 0xf3, 0x7d, 0xc0, 0x6f, 0xc4, 0x27, 0xe3, 0x79, 0x52, 0x56, 0x63, 0x21,
-	0x5d, 0x17, 0xd1, 0x98, 0x7c, 0x07, 0x2a, 0x4a, 0xb6, 0x72, 0x7a, 0x59, 0x25, 0x80, 0xe3, 0x25,
-	0x9e, 0x8c, 0x02, 0x2e, 0xb7, 0xf5, 0x78, 0x59, 0xd0, 0xe3, 0xc5, 0xd0, 0xd4, 0x78, 0x39, 0x50,
-	0x10, 0xd5, 0xd7, 0x2a, 0x88, 0xdf, 0x94, 0xa0, 0x7b, 0x14, 0x16, 0x3a, 0x0d, 0x20, 0xb8, 0x83,
-	0xa3, 0x10, 0x21, 0xd3, 0xbe, 0x48, 0x9f, 0x30, 0x45, 0x9b, 0x5a, 0x44, 0x87, 0xf9, 0x3a, 0x2c,
-	0xef, 0xd2, 0x80, 0xfb, 0xee, 0x38, 0x11, 0xa1, 0x7b, 0x10, 0x20, 0x10, 0xb5, 0xf7, 0x20, 0x11,
-	0xe1, 0xe3, 0xfc, 0x82, 0x7d, 0x1b, 0x2e, 0x88, 0x91, 0x64, 0xc9, 0xae, 0x42, 0x54, 0xb2, 0x20,
-	0xa3, 0xdb, 0xc0, 0xf9, 0xe2, 0xee, 0xe3, 0xe3, 0x00, 0xc6, 0xeb, 0x5d, 0xa2, 0xe7, 0x65, 0x38,
-	0x8b, 0x00, 0x43, 0x03, 0x2b, 0x47, 0x0f, 0xf6, 0xe2, 0xd4, 0x37, 0x91, 0x2a, 0x4c, 0x7d, 0x9f,
-	0xac, 0x82, 0x6d, 0x50, 0x9b, 0xf4, 0x12, 0x1e, 0x2b, 0xa6, 0x92, 0x2a, 0xf3, 0x45, 0x4d, 0xdf,
-	0x54, 0xe4, 0xa1, 0x4f, 0x3a, 0x50, 0xd3, 0x5d, 0x03, 0xc1, 0x2b, 0x4e, 0xc0, 0x6c, 0x49, 0xde,
-	0x80, 0x06, 0x95, 0x3b, 0xae, 0x27, 0x26, 0x51, 0x06, 0x3a, 0xeb, 0x54, 0xee, 0xac, 0xe3, 0x1a,
-	0x37, 0x43, 0x1e, 0x99, 0x4d, 0x5d, 0x36, 0xf5, 0x90, 0x47, 0x7a, 0x73, 0x1b, 0x1a, 0x63, 0xc6,
-	0x0c, 0x5c, 0xad, 0xaa, 0x79, 0x76, 0xb1, 0xaf, 0x13, 0xd4, 0xc7, 0xde, 0x9c, 0x3b, 0x8e, 0xcd,
-	0x7a, 0xed, 0x3a, 0xba, 0xfc, 0xab, 0xbf, 0xad, 0xac, 0x6e, 0xf1, 0x74, 0x7b, 0x32, 0xea, 0x7b,
-	0x22, 0x1c, 0x98, 0x87, 0x82, 0xfe, 0x77, 0x4d, 0xfa, 0x3b, 0x03, 0x44, 0x9f, 0x52, 0x09, 0x48,
-	0xa7, 0x3e, 0x66, 0x4c, 0x61, 0x5b, 0xb2, 0x82, 0x91, 0x66, 0x31, 0x4d, 0x18, 0x02, 0xe4, 0x4e,
-	0x4d, 0x19, 0x02, 0x86, 0xf4, 0x3e, 0x95, 0xc8, 0xc0, 0xf6, 0x98, 0x37, 0x49, 0x35, 0x43, 0x5d,
-	0x33, 0x18, 0x12, 0x32, 0xac, 0x82, 0x8d, 0x8e, 0x48, 0x31, 0x49, 0x3c, 0x66, 0xfc, 0x69, 0x28,
-	0xae, 0xc5, 0x90, 0x47, 0x9b, 0x8a, 0xac, 0xbc, 0x52, 0xe0, 0xe7, 0x79, 0x09, 0xda, 0x98, 0x8c,
-	0xe1, 0xda, 0xba, 0x79, 0x95, 0xac, 0x82, 0x3d, 0xa2, 0x91, 0xef, 0xf2, 0x91, 0xe7, 0xb2, 0x88,
-	0x8e, 0x02, 0xa6, 0xd3, 0x51, 0x77, 0x16, 0x91, 0x3e, 0x1c, 0x79, 0xf7, 0x35, 0x15, 0xcb, 0x0f,
-	0x99, 0xf2, 0xb4, 0x45, 0x29, 0x96, 0x4e, 0x60, 0xf2, 0x42, 0xf8, 0xc8, 0x33, 0xc9, 0x1d, 0x9a,
-	0x1d, 0xf2, 0x2e, 0x20, 0x35, 0xb7, 0x6d, 0x9b, 0x46, 0x11, 0x0b, 0xcc, 0x54, 0xb2, 0xf9, 0xc8,
-	0x33, 0xd6, 0x69, 0x3a, 0xba, 0x8a, 0xdc, 0xbb, 0x2c, 0x91, 0x5c, 0x44, 0xba, 0x19, 0x38, 0xc0,
-	0x47, 0xde, 0x53, 0x4d, 0x21, 0x5d, 0xcd, 0xa0, 0xee, 0x10, 0xf7, 0x55, 0xd6, 0x1a, 0x4e, 0x83,
-	0x8f, 0xbc, 0x0d, 0x91, 0x60, 0x29, 0x5c, 0x85, 0xb3, 0x81, 0x6a, 0x10, 0xae, 0xa9, 0x1d, 0xee,
-	0x4b, 0x95, 0xbe, 0xb2, 0xb3, 0xa4, 0x37, 0xcc, 0x7b, 0xc1, 0x97, 0x2a, 0x18, 0x3f, 0xb3, 0x60,
-	0x79, 0x53, 0x15, 0x8b, 0x2a, 0xe0, 0x59, 0xfd, 0x7f, 0x0f, 0xaa, 0x5a, 0x83, 0x8a, 0xc4, 0x69,
-	0x9f, 0x0b, 0x46, 0x06, 0x4b, 0x4b, 0x97, 0x60, 0x56, 0xb4, 0x0d, 0xa7, 0xae, 0x09, 0x43, 0xff,
-	0x84, 0xc6, 0x3d, 0x85, 0x73, 0x8f, 0xa8, 0x4c, 0xf7, 0x9b, 0x23, 0xc9, 0x08, 0xce, 0x07, 0x54,
-	0x9a, 0xc6, 0x31, 0xbb, 0xc4, 0xb2, 0x63, 0xa9, 0xda, 0xec, 0x1f, 0x6f, 0xde, 0x51, 0xee, 0x39,
-	0xe7, 0x82, 0xc3, 0x67, 0xf4, 0xfe, 0x64, 0x21, 0xee, 0xe4, 0x1e, 0x73, 0x98, 0x27, 0x12, 0x5f,
-	0x7e, 0x99, 0x41, 0xf8, 0x11, 0x2c, 0x07, 0x08, 0xf1, 0x32, 0x8f, 0x12, 0x7d, 0xa4, 0xba, 0xc0,
-	0xcd, 0x9b, 0x57, 0x4e, 0x68, 0x34, 0xda, 0x40, 0x87, 0x68, 0x15, 0x45, 0x9b, 0x7b, 0x63, 0x68,
-	0x16, 0xd6, 0x87, 0xd1, 0x47, 0x31, 0xd8, 0xb3, 0x71, 0x5e, 0x9a, 0x1b, 0xa8, 0xfd, 0xa7, 0x0c,
-	0xe4, 0x07, 0x2c, 0xa5, 0xbe, 0x1a, 0x01, 0x34, 0xe5, 0x32, 0xe5, 0x9e, 0xba, 0xac, 0x5b, 0x89,
-	0x98, 0xc4, 0xe6, 0x1a, 0xe2, 0x89, 0x6d, 0x07, 0x14, 0x49, 0x37, 0x96, 0x3e, 0x9c, 0x33, 0xbe,
-	0xba, 0x92, 0x86, 0x31, 0xb6, 0x37, 0xfe, 0x4c, 0x1b, 0xd0, 0x76, 0xce, 0x9a, 0xad, 0x4d, 0xb5,
-	0xb3, 0xc9, 0x9f, 0x31, 0x1c, 0x8c, 0x21, 0xa3, 0xd1, 0x3c, 0x43, 0x55, 0x09, 0xa0, 0x60, 0xfa,
-	0x31, 0x8d, 0xe7, 0x9a, 0xa8, 0x28, 0x40, 0xbe, 0x09, 0x4b, 0x63, 0x9e, 0xc8, 0xb4, 0x30, 0x2a,
-	0x16, 0x74, 0xdf, 0x55, 0xe4, 0xd9, 0x1d, 0xb9, 0x02, 0x8b, 0xaa, 0x26, 0x67, 0x7c, 0x55, 0xc5,
-	0xd7, 0x46, 0xea, 0x8c, 0xed, 0x8e, 0xee, 0xb3, 0x3a, 0xd0, 0xb5, 0x39, 0xc0, 0x45, 0xc8, 0x23,
-	0x3d, 0xf5, 0x50, 0x03, 0xdd, 0x33, 0x1a, 0xea, 0xf3, 0x68, 0xa0, 0x7b, 0x5a, 0xc3, 0x03, 0x68,
-	0x85, 0xcc, 0xe7, 0x34, 0x33, 0xa3, 0x71, 0x7a, 0x25, 0x4d, 0x2d, 0xa8, 0xf4, 0xf4, 0xfe, 0x61,
-	0x81, 0xad, 0xbe, 0xee, 0xa6, 0x58, 0x79, 0x6a, 0x6a, 0xbe, 0x0a, 0x58, 0x2d, 0x17, 0x0b, 0xac,
-	0x9c, 0x41, 0x41, 0x62, 0xc0, 0x8e, 0x9e, 0xda, 0x1a, 0xc7, 0x10, 0xa8, 0xb0, 0xbd, 0x58, 0xa8,
-	0x74, 0x2d, 0x38, 0xea, 0x1b, 0x6f, 0xd0, 0x0c, 0x96, 0xe9, 0x1c, 0xcc, 0x10, 0xd7, 0xc5, 0x02,
-	0xe2, 0xaa, 0x2a, 0x45, 0x39, 0x98, 0x32, 0x5b, 0x4a, 0x5f, 0x4d, 0xe9, 0xc3, 0xad, 0xfb, 0xa8,
-	0xf2, 0x20, 0x5c, 0xaa, 0x2b, 0xad, 0x45, 0xb8, 0xd4, 0xfb, 0x29, 0x34, 0xee, 0x4a, 0xc9, 0xd2,
-	0x0d, 0xca, 0x13, 0x54, 0x45, 0x71, 0x51, 0xf0, 0x4d, 0xad, 0x87, 0x3e, 0x79, 0x02, 0x6d, 0xc9,
-	0xb7, 0x22, 0xe6, 0x6b, 0x03, 0xb3, 0x67, 0xdf, 0xf5, 0x57, 0xb4, 0x22, 0xc5, 0xae, 0xcc, 0xff,
-	0x60, 0x9c, 0x9f, 0xe1, 0xb4, 0xe4, 0x8c, 0x2e, 0x7b, 0xbf, 0xb5, 0xe0, 0xc2, 0xd1, 0x8c, 0xea,
-	0xe7, 0x33, 0x6d, 0x28, 0x4b, 0x5c, 0x7c, 0xdd, 0x64, 0x3f, 0x9f, 0x65, 0xc4, 0x87, 0x6c, 0x7a,
-	0xc2, 0xb3, 0x28, 0xbf, 0xf1, 0xe5, 0xb9, 0x01, 0xfc, 0x9b, 0xd0, 0x40, 0x43, 0x69, 0x3a, 0x49,
-	0xf4, 0x1b, 0xaa, 0xe5, 0xcc, 0x08, 0x68, 0xf6, 0x52, 0x8e, 0x0b, 0xf5, 0x0f, 0x63, 0x07, 0x81,
-	0x60, 0x2b, 0x07, 0x82, 0x2b, 0xd0, 0x1c, 0x4f, 0x82, 0xc0, 0xfc, 0xe6, 0xa6, 0xac, 0x6c, 0x39,
-	0x80, 0x24, 0x23, 0xf9, 0x55, 0xe1, 0xbc, 0xab, 0x7f, 0xb6, 0xb2, 0x9f, 0xd0, 0xb0, 0x77, 0x93,
-	0x25, 0x68, 0x3e, 0x89, 0x64, 0xcc, 0x3c, 0xf5, 0x9b, 0x9f, 0x7d, 0x86, 0xb4, 0xa0, 0x82, 0x60,
-	0xc1, 0xb6, 0x2e, 0x95, 0xea, 0x16, 0x69, 0x43, 0x23, 0x7f, 0xea, 0xda, 0x25, 0xd2, 0x82, 0x7a,
-	0xf6, 0x56, 0xb5, 0xcb, 0xb8, 0x99, 0x07, 0xc0, 0xae, 0x90, 0x06, 0x2c, 0x38, 0xf4, 0x99, 0x48,
-	0xec, 0x05, 0x52, 0x83, 0xf2, 0x3d, 0x4e, 0xed, 0x2a, 0xa9, 0x43, 0xe5, 0xee, 0xc6, 0xf0, 0x3d,
-	0xbb, 0x86, 0xa4, 0x27, 0x21, 0xb5, 0xeb, 0x48, 0xc2, 0x77, 0x8a, 0xdd, 0x20, 0x4b, 0x50, 0x33,
-	0xb8, 0xc4, 0x06, 0x75, 0x5a, 0x0b, 0xea, 0xd9, 0xeb, 0xdf, 0x6e, 0xa2, 0x3e, 0xf5, 0xb4, 0xb4,
-	0x5b, 0xa4, 0x03, 0xcb, 0x47, 0x41, 0x70, 0xbb, 0xbd, 0xf6, 0xe1, 0x27, 0x2f, 0xba, 0xd6, 0xa7,
-	0x2f, 0xba, 0xd6, 0xdf, 0x5f, 0x74, 0xad, 0x9f, 0xbf, 0xec, 0x9e, 0xf9, 0xe3, 0xcb, 0xae, 0xf5,
-	0xe9, 0xcb, 0xee, 0x99, 0xcf, 0x5f, 0x76, 0xcf, 0xfc, 0xf8, 0x51, 0x01, 0x9e, 0x0d, 0xb3, 0x22,
-	0x7d, 0x44, 0x47, 0x72, 0x90, 0x97, 0xec, 0x35, 0x4f, 0x24, 0xac, 0xb8, 0xc4, 0x73, 0x06, 0xa1,
-	0xf0, 0x27, 0x01, 0x93, 0xd9, 0x2f, 0xc9, 0x0a, 0xc8, 0x8d, 0xaa, 0xea, 0xe7, 0xdd, 0xf7, 0xfe,
-	0x1b, 0x00, 0x00, 0xff, 0xff, 0xc0, 0x82, 0xc0, 0x20, 0x6a, 0x16, 0x00, 0x00,
+	// 2237 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0x4f, 0x8f, 0x1b, 0x49,
+	0x15, 0x4f, 0xdb, 0x9e, 0xb1, 0xfd, 0x6c, 0xcf, 0x74, 0x6a, 0x26, 0xc1, 0x9b, 0xdd, 0xf5, 0x64,
+	0xbd, 0x04, 0x46, 0x61, 0x63, 0x27, 0x59, 0x21, 0x94, 0x80, 0x50, 0x66, 0x26, 0x7f, 0x30, 0x09,
+	0xec, 0xa8, 0x27, 0xc9, 0x4a, 0x1c, 0x68, 0xca, 0xdd, 0xe5, 0x71, 0x65, 0xfa, 0xdf, 0x56, 0x95,
+	0x9d, 0x71, 0x24, 0xbe, 0x40, 0x2e, 0xf0, 0x05, 0x90, 0x38, 0x73, 0x02, 0x09, 0x4e, 0x48, 0x08,
+	0x71, 0xda, 0x03, 0x12, 0x7b, 0x5a, 0xad, 0x38, 0x2c, 0x90, 0x1c, 0xe0, 0xc2, 0x5e, 0xf8, 0x02,
+	0xa8, 0xfe, 0x74, 0xbb, 0xc7, 0x93, 0xc9, 0x8c, 0x37, 0x84, 0xcb, 0x4c, 0xd5, 0xab, 0xf7, 0x5e,
+	0xbf, 0xf7, 0xea, 0xd5, 0x7b, 0xbf, 0x2a, 0xc3, 0x05, 0x1a, 0x3d, 0x22, 0x9e, 0xa0, 0x63, 0xd2,
+	0x8d, 0x19, 0xf6, 0x02, 0xd2, 0x1d, 0x5f, 0xe9, 0x13, 0x81, 0xaf, 0x98, 0x69, 0x27, 0x61, 0xb1,
+	0x88, 0x51, 0x33, 0x63, 0xeb, 0x18, 0xba, 0x61, 0x3b, 0xb7, 0xba, 0x1b, 0xef, 0xc6, 0x8a, 0xa9,
+	0x2b, 0x47, 0x9a, 0xff, 0x5c, 0xcb, 0x8b, 0x79, 0x18, 0xf3, 0x6e, 0x1f, 0xf3, 0xa9, 0x46, 0x2f,
+	0xa6, 0x91, 0x59, 0x3f, 0x8d, 0x43, 0x1a, 0xc5, 0x5d, 0xf5, 0x57, 0x93, 0xda, 0x9f, 0x15, 0x60,
+	0x71, 0x1b, 0x33, 0x1c, 0x72, 0xf4, 0x2e, 0x34, 0x92, 0x89, 0x18, 0xba, 0x5e, 0x1c, 0x09, 0x86,
+	0x3d, 0xd1, 0xb4, 0xce, 0x5b, 0xeb, 0x55, 0xa7, 0x2e, 0x89, 0x5b, 0x86, 0x86, 0x7a, 0xf0, 0x8e,
+	0x37, 0xc4, 0x34, 0x0a, 0x68, 0xb4, 0xe7, 0x8e, 0x09, 0xa3, 0x03, 0x4a, 0x98, 0x9b, 0xb0, 0x78,
+	0x7f, 0x32, 0x15, 0x2c, 0x28, 0xc1, 0x56, 0xc6, 0xf8, 0xd0, 0xf0, 0x6d, 0x4b, 0xb6, 0x4c, 0x15,
+	0x81, 0xcb, 0xd8, 0xf3, 0x48, 0x22, 0xdc, 0x51, 0x64, 0x34, 0xf9, 0xee, 0x54, 0xb9, 0x8f, 0x05,
+	0x76, 0xb9, 0x60, 0x04, 0x87, 0xdc, 0x65, 0x24, 0x89, 0x99, 0xe0, 0xcd, 0xe2, 0x79, 0x6b, 0xbd,
+	0xe2, 0x7c, 0x43, 0xcb, 0x3d, 0xc8, 0xc4, 0xb6, 0x52, 0xa9, 0x9b, 0x58, 0xe0, 0x1d, 0x2d, 0xe3,
+	0x68, 0x11, 0xe4, 0xc2, 0xa5, 0x23, 0x94, 0x6a, 0x69, 0x0f, 0x0b, 0x1a, 0x47, 0xee, 0x2e, 0xe6,
+	0x6e, 0x40, 0x43, 0x2a, 0x9a, 0xa5, 0xf3, 0xd6, 0x7a, 0xc9, 0x59, 0xf7, 0x5e, 0xa0, 0xf3, 0x61,
+	0x4e, 0xe2, 0x0e, 0xe6, 0xf7, 0x24, 0xff, 0xf5, 0xb3, 0xff, 0xfa, 0xe5, 0x9a, 0xf5, 0xf4, 0x9f,
+	0xbf, 0xbe, 0xd8, 0x30, 0x7b, 0xa9, 0xe3, 0xd9, 0xde, 0x03, 0xf8, 0x40, 0x11, 0x7a, 0xd1, 0x20,
+	0x46, 0x67, 0x61, 0x91, 0x4f, 0xc2, 0x7e, 0x1c, 0x98, 0xb0, 0x9a, 0x19, 0xba, 0x05, 0x35, 0x2d,
+	0xe6, 0x8a, 0x49, 0x42, 0x54, 0xe8, 0x96, 0xae, 0x7e, 0xb5, 0x73, 0xd4, 0xce, 0x77, 0xb4, 0xca,
+	0xfb, 0x93, 0x84, 0x38, 0x10, 0x67, 0xe3, 0xf6, 0xa7, 0x16, 0xac, 0x64, 0x51, 0xd8, 0x66, 0xd4,
+	0x23, 0x3b, 0x02, 0x0b, 0x82, 0xbe, 0x02, 0xe5, 0x01, 0x21, 0xbe, 0x4b, 0xfd, 0xf4, 0xbb, 0x72,
+	0xda, 0xf3, 0xd1, 0xb7, 0x61, 0x11, 0x47, 0xfc, 0x31, 0x61, 0x7a, 0xb7, 0x36, 0xdf, 0xfd, 0xf8,
+	0xf3, 0xb5, 0x53, 0x7f, 0xfd, 0x7c, 0xed, 0x4d, 0x9d, 0x43, 0xdc, 0xdf, 0xeb, 0xd0, 0xb8, 0x1b,
+	0x62, 0x31, 0xec, 0xdc, 0x23, 0xbb, 0xd8, 0x9b, 0xdc, 0x24, 0x9e, 0x63, 0x44, 0xd0, 0x5b, 0x50,
+	0x15, 0x34, 0x24, 0x5c, 0xe0, 0x30, 0x51, 0x7b, 0x52, 0x72, 0xa6, 0x04, 0x74, 0x17, 0x6a, 0x89,
+	0xb4, 0xc0, 0xe5, 0xd2, 0x04, 0x15, 0xcf, 0xda, 0xcb, 0x5c, 0x9a, 0x9a, 0xbb, 0x59, 0x92, 0x56,
+	0x38, 0x90, 0x64, 0x94, 0xf6, 0x17, 0x16, 0x2c, 0x6d, 0xe2, 0xc8, 0xcf, 0xf9, 0x74, 0x54, 0x28,
+	0xaf, 0x40, 0x89, 0xc9, 0x0f, 0x6a, 0x87, 0xde, 0x36, 0x0e, 0x9d, 0x39, 0xec, 0x50, 0x2f, 0x12,
+	0x8e, 0x62, 0x45, 0xef, 0x40, 0x9d, 0x11, 0x1e, 0x07, 0x63, 0xe2, 0x4a, 0xfb, 0x8d, 0x2f, 0x35,
+	0x43, 0xbb, 0x4f, 0x43, 0x82, 0xde, 0x06, 0x60, 0xe4, 0xa3, 0x11, 0xe1, 0xc2, 0xed, 0xdd, 0x34,
+	0xc9, 0x51, 0x35, 0x94, 0xde, 0xcd, 0x59, 0x67, 0x17, 0x5e, 0xc5, 0xd9, 0xeb, 0x85, 0xa6, 0xd5,
+	0xfe, 0x85, 0x05, 0x4b, 0x8a, 0xe9, 0x36, 0x21, 0xbe, 0x76, 0x18, 0x41, 0x49, 0x1e, 0x69, 0xe3,
+	0xae, 0x1a, 0xa3, 0x55, 0x58, 0xf8, 0x68, 0x14, 0xa7, 0xde, 0x3a, 0x7a, 0x22, 0xb3, 0x29, 0x6f,
+	0x4d, 0xf1, 0xe4, 0xd6, 0xe4, 0xed, 0x40, 0xe7, 0xa0, 0xc2, 0x48, 0x80, 0x27, 0x84, 0xf1, 0x66,
+	0xe9, 0x7c, 0x71, 0xbd, 0xea, 0x64, 0xf3, 0xf6, 0x6d, 0xa8, 0x6f, 0xb3, 0x78, 0x4c, 0x7d, 0xc2,
+	0x54, 0x62, 0x9f, 0x83, 0x4a, 0x62, 0xe6, 0xc6, 0xc0, 0x6c, 0x7e, 0x40, 0x4f, 0x61, 0x46, 0xcf,
+	0x1f, 0x2c, 0x68, 0xa4, 0x8a, 0xf4, 0x57, 0xef, 0x42, 0x23, 0x95, 0x74, 0x69, 0x34, 0x88, 0x95,
+	0xba, 0xda, 0xd5, 0xaf, 0xbd, 0xcc, 0xfc, 0xa9, 0x21, 0x4e, 0x3d, 0xc9, 0x9b, 0xf5, 0x13, 0x38,
+	0x93, 0x29, 0xcb, 0x85, 0x44, 0xdb, 0x51, 0xbb, 0xfa, 0xde, 0xf1, 0x4a, 0x73, 0xb1, 0x59, 0x49,
+	0x0e, 0xd1, 0x78, 0x7b, 0x08, 0xe8, 0x30, 0xeb, 0x91, 0xc9, 0x79, 0x1d, 0x16, 0xf4, 0x9e, 0x14,
+	0xe6, 0xd8, 0x13, 0x2d, 0xd2, 0xbe, 0x06, 0x8d, 0x2c, 0x23, 0x94, 0x73, 0x27, 0x4e, 0x88, 0xf6,
+	0xdd, 0x5c, 0x32, 0xa9, 0x01, 0xba, 0x06, 0x0b, 0x2a, 0x1e, 0x4d, 0xeb, 0xe4, 0xe7, 0x5e, 0x4b,
+	0xb4, 0x7f, 0x6f, 0x01, 0xda, 0x8a, 0x69, 0x24, 0xbf, 0x97, 0x73, 0x19, 0x41, 0x69, 0x8f, 0x46,
+	0x69, 0x81, 0x51, 0xe3, 0x83, 0x15, 0xa2, 0x30, 0x5b, 0x21, 0x6c, 0x28, 0xee, 0x91, 0x89, 0x4a,
+	0xcf, 0xaa, 0x23, 0x87, 0xd2, 0xfa, 0x31, 0x0e, 0x46, 0xc4, 0x1c, 0x30, 0x3d, 0xf9, 0x9f, 0x1e,
+	0xae, 0xf6, 0x5f, 0x2c, 0x58, 0xde, 0x11, 0x31, 0xcb, 0x97, 0xc7, 0x03, 0x66, 0x5a, 0xb3, 0x66,
+	0x4e, 0xf7, 0xb2, 0x70, 0x60, 0x2f, 0xaf, 0xa5, 0xc6, 0x16, 0xe7, 0x08, 0xe1, 0x6b, 0xf0, 0xe8,
+	0x77, 0x16, 0x40, 0xce, 0x99, 0x2f, 0xbf, 0xb3, 0xe8, 0x87, 0x60, 0x7b, 0xa3, 0x70, 0x14, 0x60,
+	0x69, 0x83, 0x3e, 0x2f, 0xf3, 0xf4, 0x85, 0xe5, 0xa9, 0xb0, 0x4e, 0xb2, 0x43, 0x0d, 0xa2, 0x98,
+	0x8b, 0x6b, 0xfb, 0xd3, 0x02, 0x2c, 0x6d, 0x4f, 0xc4, 0x30, 0x67, 0xfb, 0x1b, 0x50, 0xd1, 0x71,
+	0xc9, 0x1a, 0x55, 0x59, 0xcd, 0x7b, 0x3e, 0xba, 0x01, 0x55, 0x12, 0xe2, 0xf9, 0x8d, 0xaa, 0x90,
+	0x10, 0x6b, 0x6b, 0xbe, 0x0b, 0x72, 0x2c, 0xf1, 0xc9, 0x60, 0x9e, 0x2d, 0x2b, 0x93, 0x10, 0x6f,
+	0xc5, 0xd1, 0x00, 0x7d, 0x0b, 0x4a, 0x4a, 0xb6, 0x74, 0x72, 0x59, 0x25, 0x20, 0xdb, 0x4b, 0x32,
+	0xea, 0x07, 0x94, 0x0f, 0x75, 0x7b, 0x59, 0xd0, 0xed, 0xc5, 0xd0, 0x54, 0x7b, 0x99, 0x49, 0x88,
+	0xc5, 0x57, 0x4a, 0x88, 0xdf, 0x14, 0xa0, 0xf5, 0x22, 0x2c, 0x74, 0x12, 0x40, 0x70, 0x43, 0xb6,
+	0x42, 0x09, 0x99, 0x0e, 0x44, 0xfa, 0x98, 0x2e, 0x5a, 0xd3, 0x22, 0x3a, 0xcc, 0x97, 0x61, 0x75,
+	0x8c, 0x03, 0xea, 0xbb, 0x03, 0x16, 0x87, 0xee, 0x2c, 0x40, 0x40, 0x6a, 0xed, 0x36, 0x8b, 0xc3,
+	0xfb, 0xe9, 0x0a, 0xfa, 0x26, 0x9c, 0x8d, 0xfb, 0x9c, 0xb0, 0xb1, 0x42, 0x54, 0x3c, 0x27, 0xa3,
+	0xcb, 0xc0, 0x99, 0xfc, 0xea, 0xfd, 0xa3, 0x00, 0xc6, 0xab, 0x1d, 0xa2, 0xa7, 0x45, 0x38, 0x2d,
+	0x01, 0x86, 0x06, 0x56, 0x8e, 0x6e, 0xec, 0xf9, 0xae, 0x6f, 0x22, 0x95, 0xeb, 0xfa, 0x3e, 0x5a,
+	0x07, 0xdb, 0xa0, 0x36, 0xee, 0x31, 0x9a, 0x28, 0xa6, 0x82, 0x4a, 0xf3, 0x25, 0x4d, 0xdf, 0x51,
+	0xe4, 0x9e, 0x8f, 0x9a, 0x50, 0xd6, 0x55, 0x43, 0x82, 0x57, 0xd9, 0x01, 0xd3, 0x29, 0x7a, 0x13,
+	0xaa, 0x98, 0xef, 0xb9, 0x5e, 0x3c, 0x8a, 0x52, 0xd0, 0x59, 0xc1, 0x7c, 0x6f, 0x4b, 0xce, 0xe5,
+	0x62, 0x48, 0x23, 0xb3, 0xa8, 0xd3, 0xa6, 0x12, 0xd2, 0x48, 0x2f, 0x0e, 0xa1, 0x3a, 0x20, 0xc4,
+	0xc0, 0xd5, 0x45, 0xd5, 0xcf, 0xde, 0xe8, 0xe8, 0x0d, 0xea, 0xc8, 0xda, 0x9c, 0x39, 0x2e, 0x8b,
+	0xf5, 0xe6, 0x65, 0xe9, 0xf2, 0xaf, 0xfe, 0xb6, 0xb6, 0xbe, 0x4b, 0xc5, 0x70, 0xd4, 0xef, 0x78,
+	0x71, 0xd8, 0x35, 0x17, 0x05, 0xfd, 0xef, 0x12, 0xf7, 0xf7, 0xba, 0x12, 0x7d, 0x72, 0x25, 0xc0,
+	0x9d, 0xca, 0x80, 0x10, 0x85, 0x6d, 0xd1, 0x9a, 0x8c, 0x34, 0x49, 0x30, 0x23, 0x12, 0x20, 0x37,
+	0xcb, 0xca, 0x10, 0x30, 0xa4, 0x3b, 0x98, 0x4b, 0x06, 0xb2, 0x4f, 0xbc, 0x91, 0xd0, 0x0c, 0x15,
+	0xcd, 0x60, 0x48, 0x92, 0x61, 0x1d, 0x6c, 0xe9, 0x08, 0x8f, 0x47, 0xcc, 0x23, 0xc6, 0x9f, 0xaa,
+	0xe2, 0x5a, 0x0a, 0x69, 0xb4, 0xa3, 0xc8, 0xca, 0x2b, 0x05, 0x7e, 0x9e, 0x16, 0xa0, 0x21, 0x37,
+	0xa3, 0xb7, 0xb9, 0x65, 0x6e, 0x25, 0xeb, 0x60, 0xf7, 0x71, 0xe4, 0xbb, 0xb4, 0xef, 0xb9, 0x24,
+	0xc2, 0xfd, 0x80, 0xe8, 0xed, 0xa8, 0x38, 0x4b, 0x92, 0xde, 0xeb, 0x7b, 0xb7, 0x34, 0x55, 0xa6,
+	0x9f, 0x64, 0xca, 0xb6, 0x2d, 0x12, 0x32, 0x75, 0x02, 0xb3, 0x2f, 0x88, 0xf6, 0x3d, 0xb3, 0xb9,
+	0x3d, 0xb3, 0x82, 0xde, 0x03, 0x49, 0xcd, 0x6c, 0x1b, 0xe2, 0x28, 0x22, 0x81, 0xe9, 0x4a, 0x36,
+	0xed, 0x7b, 0xc6, 0x3a, 0x4d, 0x97, 0xae, 0x4a, 0xee, 0x31, 0x61, 0x9c, 0xc6, 0x91, 0x2e, 0x06,
+	0x0e, 0xd0, 0xbe, 0xf7, 0x50, 0x53, 0x50, 0x4b, 0x33, 0xa8, 0x33, 0x44, 0x7d, 0xb5, 0x6b, 0x55,
+	0xa7, 0x4a, 0xfb, 0xde, 0x76, 0xcc, 0x64, 0x2a, 0x5c, 0x84, 0xd3, 0x81, 0x2a, 0x10, 0xae, 0xc9,
+	0x1d, 0xea, 0x73, 0xb5, 0x7d, 0x45, 0x67, 0x59, 0x2f, 0x98, 0xfb, 0x82, 0xcf, 0x55, 0x30, 0x7e,
+	0x66, 0xc1, 0xea, 0x8e, 0x4a, 0x16, 0x95, 0xc0, 0xd3, 0xfc, 0xff, 0x0e, 0x2c, 0x6a, 0x0d, 0x4d,
+	0x6b, 0x8e, 0xeb, 0x82, 0x91, 0x91, 0xa9, 0xa5, 0x53, 0x30, 0x4d, 0xda, 0xaa, 0x53, 0xd1, 0x84,
+	0x9e, 0x7f, 0x4c, 0xe1, 0x9e, 0xc0, 0xca, 0x3d, 0xcc, 0xc5, 0x41, 0x73, 0x38, 0xea, 0xc3, 0x99,
+	0x00, 0x73, 0x53, 0x38, 0xa6, 0x87, 0x98, 0x37, 0x2d, 0x95, 0x9b, 0x9d, 0xa3, 0xcd, 0x7b, 0x91,
+	0x7b, 0xce, 0x4a, 0x70, 0xf8, 0x1b, 0xed, 0x3f, 0x59, 0x12, 0x77, 0x52, 0x8f, 0x38, 0xc4, 0x8b,
+	0x99, 0xcf, 0x5f, 0x67, 0x10, 0x3e, 0x84, 0xd5, 0x00, 0x0b, 0x92, 0x79, 0xc4, 0xf4, 0x27, 0xd5,
+	0x01, 0xae, 0x5d, 0xbd, 0x70, 0x4c, 0xa1, 0xd1, 0x06, 0x3a, 0x48, 0xab, 0xc8, 0xdb, 0xdc, 0x1e,
+	0x40, 0x2d, 0x37, 0x3f, 0x8c, 0x3e, 0xf2, 0xc1, 0x9e, 0xb6, 0xf3, 0xc2, 0xdc, 0x40, 0xed, 0x3f,
+	0x45, 0x40, 0x3f, 0x20, 0x02, 0xfb, 0xaa, 0x05, 0x60, 0x41, 0xb9, 0xa0, 0x9e, 0x3a, 0xac, 0xbb,
+	0x2c, 0x1e, 0x25, 0xe6, 0x18, 0xca, 0x2f, 0x36, 0x1c, 0x50, 0x24, 0x5d, 0x58, 0x3a, 0xb0, 0x62,
+	0x7c, 0x75, 0x39, 0x0e, 0x13, 0x59, 0xde, 0xe8, 0x13, 0x6d, 0x40, 0xc3, 0x39, 0x6d, 0x96, 0x76,
+	0xd4, 0xca, 0x0e, 0x7d, 0x42, 0x64, 0x63, 0x0c, 0x09, 0x8e, 0xe6, 0x69, 0xaa, 0x4a, 0x40, 0x0a,
+	0x8a, 0xc7, 0x38, 0x99, 0xab, 0xa3, 0x4a, 0x01, 0xf4, 0x75, 0x58, 0x1e, 0x50, 0xc6, 0x45, 0xae,
+	0x55, 0x2c, 0xe8, 0xba, 0xab, 0xc8, 0xd3, 0x33, 0x72, 0x01, 0x96, 0x02, 0x7c, 0x80, 0x6f, 0x51,
+	0xf1, 0x35, 0x02, 0x9c, 0x67, 0xbb, 0xa1, 0xeb, 0xac, 0x0e, 0x74, 0x79, 0x0e, 0x70, 0x11, 0xd2,
+	0x48, 0x77, 0x3d, 0xa9, 0x01, 0xef, 0x1b, 0x0d, 0x95, 0x79, 0x34, 0xe0, 0x7d, 0xad, 0xe1, 0x36,
+	0xd4, 0x43, 0xe2, 0x53, 0x9c, 0x9a, 0x51, 0x3d, 0xb9, 0x92, 0x9a, 0x16, 0x54, 0x7a, 0xda, 0xff,
+	0xb0, 0xc0, 0x56, 0xa3, 0x0d, 0x21, 0x33, 0x4f, 0x75, 0xcd, 0x97, 0x01, 0xab, 0xd5, 0x7c, 0x82,
+	0x15, 0x53, 0x28, 0x88, 0x0c, 0xd8, 0xd1, 0x5d, 0x5b, 0x8d, 0x25, 0x8d, 0xec, 0x27, 0xb1, 0xda,
+	0xae, 0x05, 0x47, 0x8d, 0xe5, 0x09, 0x9a, 0xc2, 0x32, 0xbd, 0x07, 0x53, 0xc4, 0xf5, 0x46, 0x0e,
+	0x71, 0x2d, 0x2a, 0x45, 0x19, 0x98, 0x32, 0x4b, 0x4a, 0x5f, 0x59, 0xe9, 0x93, 0x4b, 0xb7, 0xa4,
+	0xca, 0x59, 0xb8, 0x54, 0x51, 0x5a, 0xf3, 0x70, 0xa9, 0xfd, 0x53, 0xa8, 0x6e, 0x70, 0x4e, 0xc4,
+	0x36, 0xa6, 0x4c, 0xaa, 0xc2, 0x72, 0x92, 0xf3, 0x4d, 0xcd, 0x7b, 0x3e, 0x7a, 0x00, 0x0d, 0x4e,
+	0x77, 0x23, 0xe2, 0x6b, 0x03, 0xd3, 0x6b, 0xdf, 0xe5, 0x97, 0x94, 0x22, 0xc5, 0xae, 0xcc, 0xff,
+	0x60, 0x90, 0x7d, 0xc3, 0xa9, 0xf3, 0x29, 0x9d, 0xb7, 0x7f, 0x6b, 0xc1, 0xd9, 0x17, 0x33, 0xaa,
+	0xe7, 0x33, 0x6d, 0x28, 0x61, 0xae, 0xbc, 0xdd, 0xa4, 0xcf, 0x67, 0x29, 0xf1, 0x2e, 0x99, 0x1c,
+	0x73, 0x2d, 0xca, 0x4e, 0x7c, 0x71, 0x6e, 0x00, 0xff, 0x16, 0x54, 0xa5, 0xa1, 0x58, 0x8c, 0x98,
+	0xbe, 0x43, 0xd5, 0x9d, 0x29, 0x41, 0x9a, 0xbd, 0x9c, 0xe1, 0x42, 0xfd, 0x30, 0x36, 0x0b, 0x04,
+	0xeb, 0x19, 0x10, 0x5c, 0x83, 0xda, 0x60, 0x14, 0x04, 0xe6, 0xcd, 0x4d, 0x59, 0x59, 0x77, 0x40,
+	0x92, 0x8c, 0xe4, 0xff, 0x0b, 0xe7, 0xb5, 0xff, 0x6d, 0xc9, 0x2b, 0x36, 0xf5, 0xc8, 0xf7, 0x47,
+	0x61, 0x72, 0x7f, 0xc8, 0x08, 0x1f, 0xc6, 0x81, 0x3f, 0xfb, 0x64, 0x66, 0x7d, 0xb9, 0x27, 0xb3,
+	0x23, 0x6f, 0x77, 0x77, 0xa0, 0x21, 0x0f, 0xf4, 0xa3, 0x51, 0x98, 0xb8, 0x0c, 0x8b, 0xb9, 0x76,
+	0xa3, 0x16, 0xe2, 0x7d, 0x69, 0xac, 0x83, 0x05, 0x41, 0x5d, 0x58, 0x91, 0x07, 0x80, 0xb2, 0x50,
+	0x3f, 0x31, 0x3e, 0xa6, 0x91, 0x1f, 0x3f, 0x56, 0x2e, 0x17, 0x1d, 0x94, 0x5f, 0xfa, 0x50, 0xad,
+	0xb4, 0xbf, 0x28, 0x00, 0xda, 0x26, 0x91, 0x4f, 0xa3, 0x5d, 0xe5, 0xf6, 0x83, 0xc4, 0xc7, 0xfa,
+	0x51, 0xe7, 0x75, 0xfa, 0x7b, 0x1b, 0xea, 0xd8, 0xcb, 0xdd, 0xfb, 0xe6, 0x71, 0x57, 0x0b, 0xea,
+	0x33, 0xff, 0x3d, 0x68, 0x24, 0xda, 0x78, 0xa3, 0x68, 0x8e, 0xe2, 0x5e, 0x4f, 0x72, 0x6e, 0xcb,
+	0x22, 0x3f, 0x03, 0x25, 0x0c, 0x04, 0x5e, 0x4a, 0x0e, 0x02, 0xa1, 0x35, 0xa8, 0xf9, 0x44, 0x10,
+	0x4f, 0x10, 0xdf, 0xc5, 0xc2, 0x54, 0x78, 0x48, 0x49, 0x1b, 0x0a, 0xc6, 0x93, 0xfd, 0x84, 0x32,
+	0xc2, 0xe5, 0x7a, 0x59, 0xb7, 0x58, 0x43, 0xd9, 0x10, 0x17, 0xff, 0x6c, 0xa5, 0x6f, 0xb4, 0x2a,
+	0x42, 0xcb, 0x50, 0x7b, 0x10, 0xf1, 0x84, 0x78, 0xea, 0x51, 0xd9, 0x3e, 0x85, 0xea, 0x50, 0x92,
+	0x68, 0xd4, 0xb6, 0xce, 0x15, 0x2a, 0x16, 0x6a, 0x40, 0x35, 0x7b, 0x4b, 0xb1, 0x0b, 0xa8, 0x0e,
+	0x95, 0xf4, 0x31, 0xc4, 0x2e, 0xca, 0xc5, 0xec, 0x84, 0xd9, 0x25, 0x54, 0x85, 0x05, 0x07, 0x3f,
+	0x89, 0x99, 0xbd, 0x80, 0xca, 0x50, 0xbc, 0x49, 0xb1, 0xbd, 0x88, 0x2a, 0x50, 0xda, 0xd8, 0xee,
+	0xbd, 0x6f, 0x97, 0x25, 0xe9, 0x41, 0x88, 0xed, 0x8a, 0x24, 0xc9, 0x8b, 0xb0, 0x5d, 0x45, 0xcb,
+	0x50, 0x36, 0xc0, 0xd7, 0x06, 0xf5, 0xb5, 0x3a, 0x54, 0xd2, 0xe7, 0x25, 0xbb, 0x26, 0xf5, 0xa9,
+	0xb7, 0x0b, 0xbb, 0x8e, 0x9a, 0xb0, 0xfa, 0xa2, 0x3b, 0x9e, 0xdd, 0xb8, 0xf8, 0x63, 0x38, 0x9b,
+	0x4f, 0x1f, 0x47, 0xbe, 0x62, 0x8e, 0x54, 0x17, 0x90, 0xd6, 0xe9, 0x7c, 0x33, 0x7e, 0x55, 0x1c,
+	0x32, 0x26, 0x4c, 0x10, 0xdf, 0xb6, 0x50, 0x0d, 0xca, 0xb7, 0x54, 0x48, 0x8c, 0x57, 0x1b, 0xea,
+	0x75, 0x9d, 0xf8, 0x76, 0x51, 0x33, 0x3e, 0x52, 0xd1, 0xb4, 0x4b, 0x9b, 0x8f, 0x3e, 0x7e, 0xd6,
+	0xb2, 0x3e, 0x79, 0xd6, 0xb2, 0xfe, 0xfe, 0xac, 0x65, 0xfd, 0xfc, 0x79, 0xeb, 0xd4, 0x1f, 0x9f,
+	0xb7, 0xac, 0x4f, 0x9e, 0xb7, 0x4e, 0x7d, 0xf6, 0xbc, 0x75, 0xea, 0x47, 0xf7, 0x72, 0xf7, 0x8b,
+	0x5e, 0x9a, 0x9b, 0xf7, 0x70, 0x9f, 0x77, 0xb3, 0x4c, 0xbd, 0xe4, 0xc5, 0x8c, 0xe4, 0xa7, 0xd2,
+	0x8f, 0x6e, 0x18, 0xfb, 0xa3, 0x80, 0xf0, 0xf4, 0xa7, 0x10, 0x75, 0x13, 0xe9, 0x2f, 0xaa, 0xdf,
+	0x27, 0xde, 0xff, 0xef, 0x00, 0x3b, 0x11, 0x8d, 0x27, 0x2b, 0x19, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -3386,6 +3595,126 @@ func (m *ChainlinkReport) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *PriceJumpThreshold) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PriceJumpThreshold) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PriceJumpThreshold) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ConfirmationWindow != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ConfirmationWindow))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.MaxJumpRate.Size()
+		i -= size
+		if _, err := m.MaxJumpRate.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *PendingPriceUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PendingPriceUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PendingPriceUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ExpiresAt != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.ExpiresAt))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.DetectedAt != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.DetectedAt))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PriceTimestamp != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.PriceTimestamp))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.PendingPrice.Size()
+		i -= size
+		if _, err := m.PendingPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.ActivePrice.Size()
+		i -= size
+		if _, err := m.ActivePrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintOracle(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintOracle(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintOracle(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintOracle(dAtA []byte, offset int, v uint64) int {
 	offset -= sovOracle(v)
 	base := offset
@@ -3972,6 +4301,56 @@ func (m *ChainlinkReport) Size() (n int) {
 	return n
 }
 
+func (m *PriceJumpThreshold) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.MaxJumpRate.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.ConfirmationWindow != 0 {
+		n += 1 + sovOracle(uint64(m.ConfirmationWindow))
+	}
+	return n
+}
+
+func (m *PendingPriceUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.OracleType != 0 {
+		n += 1 + sovOracle(uint64(m.OracleType))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovOracle(uint64(l))
+	}
+	l = m.ActivePrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	l = m.PendingPrice.Size()
+	n += 1 + l + sovOracle(uint64(l))
+	if m.PriceTimestamp != 0 {
+		n += 1 + sovOracle(uint64(m.PriceTimestamp))
+	}
+	if m.DetectedAt != 0 {
+		n += 1 + sovOracle(uint64(m.DetectedAt))
+	}
+	if m.ExpiresAt != 0 {
+		n += 1 + sovOracle(uint64(m.ExpiresAt))
+	}
+	return n
+}
+
 func sovOracle(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -8136,6 +8515,386 @@ func (m *ChainlinkReport) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *PriceJumpThreshold) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceJumpThreshold: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceJumpThreshold: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxJumpRate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxJumpRate.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConfirmationWindow", wireType)
+			}
+			m.ConfirmationWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConfirmationWindow |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PendingPriceUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowOracle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PendingPriceUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PendingPriceUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivePrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ActivePrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthOracle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PendingPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceTimestamp", wireType)
+			}
+			m.PriceTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceTimestamp |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DetectedAt", wireType)
+			}
+			m.DetectedAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DetectedAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			m.ExpiresAt = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowOracle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiresAt |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipOracle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthOracle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipOracle(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
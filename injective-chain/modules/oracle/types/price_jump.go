@@ -0,0 +1,45 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// IsPriceJumpGuardedOracle returns true if the circuit breaker can hold back updates for the oracle type.
+func IsPriceJumpGuardedOracle(oracleType OracleType) bool {
+	switch oracleType {
+	case OracleType_Pyth, OracleType_Stork:
+		return true
+	default:
+		return false
+	}
+}
+
+// IsPriceJump returns true if newPrice deviates from lastPrice by more than maxJumpRate.
+func IsPriceJump(lastPrice, newPrice, maxJumpRate math.LegacyDec) bool {
+	if lastPrice.IsNil() || !lastPrice.IsPositive() {
+		return false
+	}
+
+	return newPrice.Sub(lastPrice).Abs().Quo(lastPrice).GT(maxJumpRate)
+}
+
+func (t *PriceJumpThreshold) Validate() error {
+	if !IsPriceJumpGuardedOracle(t.OracleType) {
+		return errors.Wrapf(ErrUnsupportedOracleType, "price jump thresholds are not supported for %s", t.OracleType.String())
+	}
+
+	if t.Symbol == "" {
+		return errors.Wrap(ErrInvalidSymbol, "symbol cannot be empty")
+	}
+
+	if t.MaxJumpRate.IsNil() || !t.MaxJumpRate.IsPositive() {
+		return errors.Wrap(ErrInvalidPriceJumpThreshold, "max jump rate must be positive")
+	}
+
+	if t.ConfirmationWindow <= 0 {
+		return errors.Wrap(ErrInvalidPriceJumpThreshold, "confirmation window must be positive")
+	}
+
+	return nil
+}
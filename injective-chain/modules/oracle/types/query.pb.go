@@ -1826,6 +1826,174 @@ func (m *QueryOraclePriceResponse) GetPricePairState() *PricePairState {
 	return nil
 }
 
+// QueryPriceJumpThresholdsRequest is the request type for the
+// Query/PriceJumpThresholds RPC method.
+type QueryPriceJumpThresholdsRequest struct {
+}
+
+func (m *QueryPriceJumpThresholdsRequest) Reset()         { *m = QueryPriceJumpThresholdsRequest{} }
+func (m *QueryPriceJumpThresholdsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceJumpThresholdsRequest) ProtoMessage()    {}
+func (*QueryPriceJumpThresholdsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{39}
+}
+func (m *QueryPriceJumpThresholdsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceJumpThresholdsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceJumpThresholdsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceJumpThresholdsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceJumpThresholdsRequest.Merge(m, src)
+}
+func (m *QueryPriceJumpThresholdsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceJumpThresholdsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceJumpThresholdsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceJumpThresholdsRequest proto.InternalMessageInfo
+
+// QueryPriceJumpThresholdsResponse is the response type for the
+// Query/PriceJumpThresholds RPC method.
+type QueryPriceJumpThresholdsResponse struct {
+	Thresholds []*PriceJumpThreshold `protobuf:"bytes,1,rep,name=thresholds,proto3" json:"thresholds,omitempty"`
+}
+
+func (m *QueryPriceJumpThresholdsResponse) Reset()         { *m = QueryPriceJumpThresholdsResponse{} }
+func (m *QueryPriceJumpThresholdsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPriceJumpThresholdsResponse) ProtoMessage()    {}
+func (*QueryPriceJumpThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{40}
+}
+func (m *QueryPriceJumpThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPriceJumpThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPriceJumpThresholdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPriceJumpThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPriceJumpThresholdsResponse.Merge(m, src)
+}
+func (m *QueryPriceJumpThresholdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPriceJumpThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPriceJumpThresholdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPriceJumpThresholdsResponse proto.InternalMessageInfo
+
+func (m *QueryPriceJumpThresholdsResponse) GetThresholds() []*PriceJumpThreshold {
+	if m != nil {
+		return m.Thresholds
+	}
+	return nil
+}
+
+// QueryPendingPriceUpdatesRequest is the request type for the
+// Query/PendingPriceUpdates RPC method.
+type QueryPendingPriceUpdatesRequest struct {
+}
+
+func (m *QueryPendingPriceUpdatesRequest) Reset()         { *m = QueryPendingPriceUpdatesRequest{} }
+func (m *QueryPendingPriceUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPriceUpdatesRequest) ProtoMessage()    {}
+func (*QueryPendingPriceUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{41}
+}
+func (m *QueryPendingPriceUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPriceUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPriceUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPriceUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPriceUpdatesRequest.Merge(m, src)
+}
+func (m *QueryPendingPriceUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPriceUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPriceUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPriceUpdatesRequest proto.InternalMessageInfo
+
+// QueryPendingPriceUpdatesResponse is the response type for the
+// Query/PendingPriceUpdates RPC method.
+type QueryPendingPriceUpdatesResponse struct {
+	PendingUpdates []*PendingPriceUpdate `protobuf:"bytes,1,rep,name=pending_updates,json=pendingUpdates,proto3" json:"pending_updates,omitempty"`
+}
+
+func (m *QueryPendingPriceUpdatesResponse) Reset()         { *m = QueryPendingPriceUpdatesResponse{} }
+func (m *QueryPendingPriceUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingPriceUpdatesResponse) ProtoMessage()    {}
+func (*QueryPendingPriceUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_52f5d6f9962923ad, []int{42}
+}
+func (m *QueryPendingPriceUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingPriceUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingPriceUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingPriceUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingPriceUpdatesResponse.Merge(m, src)
+}
+func (m *QueryPendingPriceUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingPriceUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingPriceUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingPriceUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingPriceUpdatesResponse) GetPendingUpdates() []*PendingPriceUpdate {
+	if m != nil {
+		return m.PendingUpdates
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*QueryPythPriceRequest)(nil), "injective.oracle.v1beta1.QueryPythPriceRequest")
 	proto.RegisterType((*QueryPythPriceResponse)(nil), "injective.oracle.v1beta1.QueryPythPriceResponse")
@@ -1866,6 +2034,10 @@ func init() {
 	proto.RegisterType((*QueryOraclePriceRequest)(nil), "injective.oracle.v1beta1.QueryOraclePriceRequest")
 	proto.RegisterType((*PricePairState)(nil), "injective.oracle.v1beta1.PricePairState")
 	proto.RegisterType((*QueryOraclePriceResponse)(nil), "injective.oracle.v1beta1.QueryOraclePriceResponse")
+	proto.RegisterType((*QueryPriceJumpThresholdsRequest)(nil), "injective.oracle.v1beta1.QueryPriceJumpThresholdsRequest")
+	proto.RegisterType((*QueryPriceJumpThresholdsResponse)(nil), "injective.oracle.v1beta1.QueryPriceJumpThresholdsResponse")
+	proto.RegisterType((*QueryPendingPriceUpdatesRequest)(nil), "injective.oracle.v1beta1.QueryPendingPriceUpdatesRequest")
+	proto.RegisterType((*QueryPendingPriceUpdatesResponse)(nil), "injective.oracle.v1beta1.QueryPendingPriceUpdatesResponse")
 }
 
 func init() {
@@ -1873,128 +2045,136 @@ func init() {
 }
 
 var fileDescriptor_52f5d6f9962923ad = []byte{
-	// 1931 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xdd, 0x6f, 0x1b, 0x4b,
-	0x15, 0xcf, 0xe6, 0xab, 0xf1, 0x71, 0xf3, 0x71, 0x27, 0xbe, 0xb9, 0xe9, 0xf6, 0x5e, 0x27, 0xdd,
-	0x34, 0x5f, 0x24, 0xb1, 0x1b, 0xb7, 0xb4, 0xa5, 0x84, 0xa0, 0x26, 0x69, 0x21, 0x90, 0xaa, 0x61,
-	0x53, 0x28, 0x5f, 0x92, 0x35, 0x5e, 0x4f, 0xec, 0x25, 0xf6, 0xee, 0x76, 0x77, 0x9d, 0xd6, 0xaa,
-	0x2a, 0x04, 0x8f, 0x80, 0x04, 0x12, 0xaf, 0xf0, 0x8e, 0x78, 0x43, 0x82, 0x87, 0xbe, 0x22, 0x81,
-	0xca, 0x5b, 0x11, 0x42, 0x42, 0x3c, 0x54, 0x28, 0xe1, 0x6f, 0xe0, 0x19, 0xed, 0xcc, 0xec, 0x66,
-	0xd7, 0xde, 0xf5, 0xac, 0x83, 0x78, 0xf3, 0xce, 0x9c, 0xf3, 0x9b, 0xdf, 0x39, 0x33, 0xe7, 0xcc,
-	0x9c, 0x63, 0xb8, 0xa9, 0x1b, 0x3f, 0x20, 0x9a, 0xab, 0x9f, 0x92, 0xa2, 0x69, 0x63, 0xad, 0x41,
-	0x8a, 0xa7, 0x9b, 0x15, 0xe2, 0xe2, 0xcd, 0xe2, 0x8b, 0x16, 0xb1, 0xdb, 0x05, 0xcb, 0x36, 0x5d,
-	0x13, 0xcd, 0x06, 0x52, 0x05, 0x26, 0x55, 0xe0, 0x52, 0xf2, 0xa7, 0x35, 0xd3, 0xac, 0x35, 0x48,
-	0x11, 0x5b, 0x7a, 0x11, 0x1b, 0x86, 0xe9, 0x62, 0x57, 0x37, 0x0d, 0x87, 0xe9, 0xc9, 0x8b, 0x89,
-	0xe8, 0x1c, 0x86, 0x89, 0x2d, 0x25, 0x8a, 0xd5, 0x88, 0x41, 0x1c, 0xdd, 0x87, 0xcb, 0xd5, 0xcc,
-	0x9a, 0x49, 0x7f, 0x16, 0xbd, 0x5f, 0x6c, 0x54, 0x29, 0xc1, 0xc7, 0xdf, 0xf0, 0xb8, 0x1e, 0xb6,
-	0xdd, 0xfa, 0xa1, 0xad, 0x6b, 0x44, 0x25, 0x2f, 0x5a, 0xc4, 0x71, 0xd1, 0x35, 0x18, 0xb3, 0xbc,
-	0xef, 0xb2, 0x5e, 0x9d, 0x95, 0xe6, 0xa5, 0x95, 0x8c, 0x7a, 0x85, 0x7e, 0xef, 0x57, 0x15, 0x0d,
-	0x66, 0x3a, 0x75, 0x1c, 0xcb, 0x34, 0x1c, 0x82, 0xf6, 0x21, 0xcb, 0x94, 0x1c, 0x17, 0xbb, 0x84,
-	0xea, 0x65, 0x4b, 0x2b, 0x85, 0x24, 0x07, 0x14, 0x02, 0x84, 0x23, 0x4f, 0x5e, 0x05, 0x2b, 0xf8,
-	0xad, 0xe4, 0x00, 0xb1, 0x45, 0xb0, 0x8d, 0x9b, 0x0e, 0x67, 0xa5, 0x7c, 0x13, 0xa6, 0x23, 0xa3,
-	0x7c, 0xdd, 0x6d, 0x18, 0xb5, 0xe8, 0x08, 0x5f, 0x72, 0xbe, 0xc7, 0x92, 0x54, 0x6e, 0x67, 0xf8,
-	0xdd, 0x87, 0xb9, 0x01, 0x95, 0x6b, 0x29, 0x32, 0xcc, 0x52, 0xd8, 0x1d, 0x6c, 0x54, 0x55, 0xd2,
-	0xc0, 0x6d, 0x62, 0x07, 0x4b, 0xde, 0x83, 0x6b, 0x31, 0x73, 0x7c, 0x61, 0x19, 0xc6, 0x6c, 0x3e,
-	0x36, 0x2b, 0xcd, 0x0f, 0xad, 0x64, 0xd4, 0xe0, 0x5b, 0xf9, 0x0c, 0xae, 0x07, 0x8a, 0x17, 0x46,
-	0x06, 0xb8, 0x27, 0xf0, 0x69, 0xfc, 0x34, 0x87, 0xfe, 0x3a, 0x5c, 0x0d, 0xf9, 0x92, 0xc1, 0xf7,
-	0x74, 0x66, 0x14, 0x48, 0xcd, 0x5e, 0x38, 0xd3, 0x51, 0xe6, 0x21, 0x1f, 0x2c, 0xb6, 0xbf, 0xb3,
-	0x1b, 0x43, 0xc7, 0x80, 0xb9, 0x44, 0x89, 0xff, 0x07, 0x23, 0x05, 0xe6, 0xd9, 0x4e, 0x7a, 0x63,
-	0x8f, 0x09, 0x89, 0x73, 0x91, 0x05, 0x37, 0x7a, 0xc8, 0x5c, 0x96, 0x55, 0x80, 0x16, 0xc3, 0xea,
-	0x06, 0xf7, 0xc2, 0xae, 0xa9, 0x1b, 0x15, 0xec, 0x90, 0x18, 0x52, 0x0e, 0x27, 0x1e, 0x2b, 0xc2,
-	0x39, 0x3d, 0x8d, 0xe5, 0xb4, 0x9e, 0xcc, 0xa9, 0x1b, 0x2c, 0xca, 0xcb, 0x3f, 0x4b, 0xd1, 0x80,
-	0xe9, 0x3a, 0x4b, 0x5d, 0xd3, 0x97, 0xf6, 0x51, 0x34, 0x30, 0x23, 0x5c, 0xf2, 0x7c, 0xb1, 0x23,
-	0xd7, 0xb4, 0x4f, 0x62, 0xc8, 0x34, 0xe1, 0xb3, 0x84, 0x79, 0xce, 0xe6, 0x20, 0x96, 0xcd, 0x6a,
-	0x32, 0x9b, 0x0e, 0xa4, 0x78, 0xd7, 0x30, 0xa1, 0x56, 0xa5, 0xa1, 0x3b, 0xf5, 0x50, 0xf8, 0x6e,
-	0x47, 0xd8, 0x86, 0xa6, 0x39, 0x99, 0x3c, 0x80, 0x15, 0x8c, 0xf2, 0x18, 0x0e, 0x8d, 0x28, 0xcf,
-	0x78, 0xe4, 0x1c, 0xda, 0xe6, 0xa9, 0x5e, 0x25, 0x76, 0x88, 0x06, 0xcf, 0x94, 0xb2, 0x97, 0x29,
-	0xd9, 0x24, 0xcf, 0x94, 0xc1, 0x37, 0x9a, 0x81, 0x51, 0xa7, 0xdd, 0xac, 0x98, 0x8d, 0xd9, 0x41,
-	0x3a, 0xc3, 0xbf, 0x94, 0x3a, 0x3f, 0x67, 0x71, 0xa8, 0x9c, 0xd8, 0xa3, 0xb8, 0x5c, 0x7a, 0x53,
-	0x70, 0xac, 0xbb, 0xf3, 0xe8, 0x06, 0xac, 0xb1, 0xe3, 0x5a, 0xc7, 0xba, 0xd1, 0xd0, 0x8d, 0x93,
-	0x3d, 0xec, 0xe2, 0x23, 0xd7, 0x26, 0xb8, 0xe9, 0xc4, 0x6c, 0xde, 0x4f, 0x25, 0x58, 0x4f, 0x27,
-	0xcf, 0x69, 0x7e, 0x2f, 0x76, 0x33, 0xef, 0xf7, 0x38, 0xea, 0x3d, 0x81, 0xa3, 0x7b, 0x7b, 0x0d,
-	0x3e, 0xa1, 0x64, 0x9e, 0x98, 0xd5, 0x56, 0x23, 0xe2, 0x75, 0xe5, 0xdb, 0x3c, 0x65, 0x47, 0xa6,
-	0x38, 0xa7, 0x2d, 0x18, 0x09, 0x3b, 0x6d, 0x29, 0x99, 0xcc, 0x57, 0xd8, 0x15, 0xc9, 0xd4, 0x99,
-	0x92, 0xf2, 0x43, 0x50, 0x28, 0xf2, 0x57, 0x75, 0xc7, 0x35, 0x6d, 0x5d, 0xc3, 0x0d, 0x7e, 0xc9,
-	0x69, 0xa6, 0x5d, 0xf5, 0x1d, 0x85, 0xb6, 0x60, 0x94, 0x61, 0xd1, 0x45, 0x26, 0x7a, 0xed, 0xcc,
-	0x53, 0xfa, 0xf9, 0xac, 0x6d, 0x11, 0x95, 0xeb, 0xa0, 0xeb, 0x90, 0x61, 0x27, 0xc1, 0xbb, 0x5e,
-	0xd9, 0xd1, 0x18, 0x63, 0x03, 0xfb, 0x55, 0xc5, 0x86, 0x85, 0x9e, 0x04, 0x82, 0xa0, 0x1e, 0x67,
-	0x9e, 0xb7, 0xd9, 0x04, 0x77, 0xfd, 0x92, 0xe0, 0x88, 0xf8, 0x30, 0x6c, 0xdb, 0xf8, 0x97, 0xf2,
-	0x13, 0x09, 0x72, 0x8c, 0x27, 0x5b, 0xb5, 0xfd, 0xd4, 0xa2, 0x6f, 0x11, 0xf4, 0x09, 0x5c, 0x69,
-	0xe2, 0x57, 0x65, 0x5c, 0x63, 0x86, 0x0e, 0xab, 0xa3, 0x4d, 0xfc, 0xea, 0x61, 0x8d, 0xa0, 0x02,
-	0x4c, 0xeb, 0x86, 0xd6, 0x68, 0x55, 0x49, 0xd9, 0xc6, 0x2f, 0xcb, 0x75, 0xa6, 0x46, 0x8d, 0x19,
-	0x53, 0x3f, 0xe2, 0x53, 0x2a, 0x7e, 0xc9, 0xf1, 0xd0, 0x2a, 0x4c, 0xf9, 0xf2, 0x4d, 0xe2, 0xe2,
-	0x2a, 0x76, 0xf1, 0xec, 0x10, 0x15, 0x9e, 0xe4, 0xe3, 0x4f, 0xf8, 0xb0, 0xf2, 0xb3, 0x41, 0x1e,
-	0xb4, 0x8c, 0xd1, 0xb7, 0xcc, 0x06, 0x76, 0xf5, 0x86, 0xee, 0xb6, 0x7d, 0xe7, 0x3f, 0x84, 0x8c,
-	0x97, 0x2d, 0xcb, 0xba, 0x71, 0x6c, 0x8a, 0x23, 0x83, 0xa1, 0xec, 0x1b, 0xc7, 0xa6, 0x3a, 0xe6,
-	0xa9, 0x79, 0xbf, 0xd0, 0x2e, 0xc0, 0x8b, 0x96, 0xe9, 0x72, 0x8c, 0xc1, 0x3e, 0x30, 0x32, 0x54,
-	0x8f, 0x82, 0x54, 0x61, 0x86, 0xc9, 0xf9, 0xe6, 0x97, 0x4d, 0xe6, 0x36, 0x6a, 0x59, 0xb6, 0x54,
-	0x10, 0x01, 0x46, 0x9d, 0xad, 0xe6, 0xcc, 0x98, 0x51, 0xe5, 0x47, 0x83, 0x3c, 0xa3, 0x76, 0xbb,
-	0x83, 0x1f, 0x85, 0x2f, 0x03, 0x9c, 0x06, 0xa3, 0x2c, 0x09, 0xed, 0xcc, 0xfd, 0xf3, 0xc3, 0xdc,
-	0x75, 0xcd, 0x74, 0x9a, 0xa6, 0xe3, 0x54, 0x4f, 0x0a, 0xba, 0x59, 0x6c, 0x62, 0xb7, 0x5e, 0x38,
-	0x20, 0x35, 0xac, 0xb5, 0xf7, 0x88, 0xa6, 0x86, 0x54, 0xd0, 0x73, 0x98, 0xf2, 0x2d, 0x08, 0x36,
-	0x87, 0xf9, 0xa4, 0xc7, 0xa5, 0xe5, 0xef, 0x97, 0x17, 0x3d, 0xba, 0xe3, 0xea, 0x9a, 0xa3, 0x4e,
-	0x72, 0x14, 0x7f, 0x0a, 0x3d, 0x86, 0x6c, 0xf8, 0x74, 0x0c, 0xd1, 0x23, 0xba, 0x98, 0xea, 0x88,
-	0xaa, 0x60, 0x07, 0xa7, 0x27, 0xb8, 0x98, 0x99, 0x0b, 0xfc, 0xb4, 0xe9, 0xd0, 0x0d, 0xe1, 0x19,
-	0xa1, 0xce, 0x2f, 0xe6, 0x58, 0x11, 0xee, 0xa8, 0x3d, 0xc8, 0xf8, 0xb9, 0x39, 0x55, 0xbc, 0x30,
-	0x51, 0xb6, 0xed, 0x81, 0xa2, 0xb2, 0x1d, 0xbb, 0x12, 0xa5, 0xee, 0xa4, 0xb8, 0x15, 0x14, 0x9b,
-	0xbf, 0x6b, 0xe2, 0xf5, 0x39, 0xd5, 0x27, 0x5e, 0x78, 0xb3, 0x99, 0x23, 0x9e, 0xcc, 0x3c, 0xba,
-	0xcb, 0x62, 0xba, 0x2c, 0x9b, 0x45, 0xb5, 0x95, 0xef, 0xc3, 0xc4, 0x91, 0x86, 0x1b, 0xba, 0x51,
-	0xf3, 0x23, 0x7b, 0x01, 0xc6, 0x69, 0x10, 0x55, 0x89, 0xa6, 0x37, 0x71, 0x83, 0xbd, 0x9d, 0xc7,
-	0xd5, 0xab, 0xde, 0xe0, 0x1e, 0x1f, 0x43, 0x8b, 0x30, 0xc1, 0xc2, 0x24, 0x90, 0x1a, 0xa4, 0x52,
-	0xe3, 0x74, 0xd4, 0x17, 0x53, 0xce, 0x25, 0x9e, 0xa9, 0x7d, 0x93, 0x42, 0x95, 0xc4, 0x23, 0xc8,
-	0xf2, 0x20, 0x71, 0xdb, 0x56, 0x7f, 0xe9, 0x12, 0xcc, 0xe0, 0x37, 0x42, 0x30, 0xec, 0x31, 0xe3,
-	0xd9, 0x92, 0xfe, 0x46, 0x39, 0x18, 0xa1, 0x3c, 0x68, 0xb8, 0x65, 0x54, 0xf6, 0x81, 0x9e, 0xc3,
-	0xa4, 0xc3, 0x4c, 0x0d, 0xc2, 0x71, 0x58, 0x54, 0x89, 0x44, 0x7d, 0x43, 0xcb, 0x03, 0x49, 0x9d,
-	0x70, 0x22, 0xa3, 0xca, 0xd9, 0x10, 0x4c, 0x50, 0xd3, 0x0e, 0xb1, 0xce, 0xdc, 0x8a, 0x76, 0x00,
-	0x2c, 0xac, 0xdb, 0x65, 0x9a, 0x4c, 0x79, 0xe4, 0x2d, 0x78, 0xb5, 0x85, 0x28, 0xfa, 0x32, 0x9e,
-	0x1a, 0x05, 0xf3, 0x30, 0xe8, 0x46, 0x30, 0x8c, 0xc1, 0x3e, 0x30, 0x82, 0x27, 0x23, 0xda, 0x83,
-	0x2c, 0xdb, 0x27, 0x06, 0x32, 0x94, 0x1e, 0x84, 0xa5, 0x41, 0x86, 0xf2, 0x1c, 0x3e, 0xa6, 0x4c,
-	0xb4, 0x56, 0xb3, 0xe5, 0xe5, 0x86, 0x53, 0x1f, 0x6f, 0x38, 0x3d, 0xde, 0xb4, 0x87, 0xb0, 0x1b,
-	0x00, 0x30, 0xe0, 0xef, 0xc0, 0x0c, 0xa3, 0xd7, 0x85, 0x3c, 0x92, 0x1e, 0x39, 0x47, 0x21, 0x3a,
-	0xa1, 0x17, 0x61, 0x82, 0x72, 0x76, 0xf5, 0x26, 0x71, 0x5c, 0xdc, 0xb4, 0x66, 0x47, 0xe7, 0xa5,
-	0x95, 0x21, 0x95, 0x1e, 0xee, 0x67, 0xfe, 0x20, 0x5a, 0x86, 0x49, 0xc6, 0xe0, 0x42, 0xee, 0x0a,
-	0x95, 0x63, 0xe7, 0x3b, 0x10, 0x54, 0x0c, 0xfe, 0xb0, 0x88, 0x9c, 0x64, 0x1e, 0x93, 0x2a, 0x4c,
-	0xb1, 0x2b, 0x97, 0xee, 0x79, 0xda, 0x22, 0x37, 0x72, 0x62, 0xd4, 0x09, 0x2b, 0xf2, 0x5d, 0xfa,
-	0xb3, 0x0c, 0x23, 0x74, 0x41, 0xf4, 0x73, 0x09, 0x46, 0x59, 0x79, 0x8a, 0x7a, 0x64, 0xdd, 0xee,
-	0xaa, 0x58, 0xde, 0x48, 0x29, 0xcd, 0xac, 0x50, 0x56, 0x7e, 0xfc, 0xb7, 0x7f, 0xff, 0x72, 0x50,
-	0x41, 0xf3, 0xc5, 0xc4, 0xd6, 0x01, 0xab, 0x8b, 0xd1, 0x6f, 0x24, 0xb8, 0x1a, 0xae, 0x7b, 0x51,
-	0x49, 0xb0, 0x52, 0x4c, 0x01, 0x2d, 0xdf, 0xee, 0x4b, 0x87, 0x73, 0x2c, 0x52, 0x8e, 0xab, 0x68,
-	0x39, 0x99, 0x63, 0x05, 0x1b, 0xd5, 0xb2, 0x5f, 0x6d, 0xa3, 0x3f, 0x48, 0x30, 0xd9, 0x51, 0x4a,
-	0xa3, 0xcf, 0xa7, 0x58, 0xb9, 0xfb, 0x0d, 0x2c, 0xdf, 0xed, 0x57, 0x8d, 0x73, 0xbe, 0x4d, 0x39,
-	0x6f, 0xa0, 0x35, 0x01, 0xe7, 0xf0, 0x7b, 0x19, 0xfd, 0x51, 0x02, 0xd4, 0x5d, 0x73, 0xa3, 0xfb,
-	0x29, 0x38, 0xc4, 0x16, 0xf2, 0xf2, 0x17, 0x2e, 0xa1, 0xc9, 0x0d, 0xb8, 0x47, 0x0d, 0xd8, 0x44,
-	0x45, 0x81, 0x01, 0x7a, 0x45, 0x8b, 0x1a, 0xf1, 0x17, 0x09, 0x72, 0x71, 0x45, 0x3a, 0x7a, 0x20,
-	0x3a, 0x99, 0xc9, 0xd5, 0xbf, 0xfc, 0xc5, 0x4b, 0xe9, 0x72, 0x53, 0xee, 0x53, 0x53, 0x4a, 0xe8,
-	0x56, 0x8f, 0x33, 0xee, 0xa9, 0x1d, 0x13, 0xd2, 0xb1, 0x21, 0x7f, 0x92, 0x60, 0x3a, 0xa6, 0xb6,
-	0x47, 0x22, 0xbf, 0x26, 0xb7, 0x0c, 0xe4, 0x07, 0x97, 0x51, 0x4d, 0xbf, 0x27, 0x1a, 0x57, 0x8f,
-	0xda, 0xe1, 0x05, 0x44, 0x47, 0x3f, 0x40, 0x18, 0x10, 0xf1, 0xed, 0x05, 0x61, 0x40, 0x24, 0xb4,
-	0x1d, 0xd2, 0x04, 0x84, 0xd5, 0x76, 0xeb, 0x51, 0xde, 0x6f, 0x25, 0x98, 0xea, 0x6c, 0x1d, 0x20,
-	0x11, 0x83, 0x84, 0x5e, 0x84, 0x7c, 0xaf, 0x6f, 0x3d, 0x4e, 0xfd, 0x0e, 0xa5, 0x5e, 0x40, 0xeb,
-	0xc9, 0xd4, 0xbd, 0x87, 0xe9, 0x49, 0x94, 0xfb, 0xef, 0x25, 0x98, 0xec, 0x68, 0x34, 0x08, 0x7d,
-	0x1e, 0xdf, 0xb7, 0x90, 0xef, 0xf6, 0xab, 0xc6, 0x89, 0x97, 0x28, 0xf1, 0x75, 0xf4, 0x39, 0x21,
-	0xf1, 0x0b, 0x8a, 0x7f, 0x97, 0x00, 0x75, 0x77, 0x22, 0x84, 0x39, 0x28, 0xb1, 0x25, 0x22, 0xcc,
-	0x41, 0xc9, 0x6d, 0x0f, 0xe5, 0x6b, 0x94, 0xff, 0x1e, 0xda, 0xe9, 0x15, 0xb8, 0x4c, 0x3b, 0xec,
-	0xfb, 0xe2, 0x6b, 0x7f, 0xf4, 0x4d, 0xf1, 0x35, 0xab, 0xa4, 0xdf, 0xa0, 0xff, 0x48, 0x30, 0x27,
-	0xe8, 0x63, 0xa0, 0x47, 0xa2, 0xd8, 0x4c, 0xd5, 0x37, 0x91, 0x1f, 0xff, 0xaf, 0x30, 0xdc, 0xfc,
-	0x3d, 0x6a, 0xfe, 0x36, 0xda, 0xea, 0x11, 0xee, 0x3e, 0x54, 0xd9, 0xab, 0xb0, 0xca, 0x0e, 0x05,
-	0x8b, 0x9e, 0xc3, 0xdf, 0x4a, 0xf0, 0x11, 0x7b, 0xbf, 0x84, 0xda, 0x23, 0x68, 0x53, 0xc0, 0xb1,
-	0xbb, 0xcb, 0x22, 0x97, 0xfa, 0x51, 0xe1, 0x26, 0x14, 0xa8, 0x09, 0x2b, 0x68, 0x29, 0xd9, 0x84,
-	0x26, 0x55, 0x63, 0x6c, 0xd1, 0x5f, 0x25, 0x98, 0x89, 0x6f, 0x75, 0xa0, 0x2d, 0xc1, 0xf2, 0x3d,
-	0x5b, 0x34, 0xf2, 0x97, 0x2e, 0xa9, 0xcd, 0xed, 0x78, 0x40, 0xed, 0xb8, 0x83, 0x4a, 0xc9, 0x76,
-	0xd4, 0x03, 0x84, 0x72, 0xa4, 0x15, 0x83, 0x7e, 0x27, 0xc1, 0x54, 0x67, 0xb5, 0x2e, 0x4c, 0x62,
-	0x09, 0xdd, 0x0e, 0x61, 0x12, 0x4b, 0x6a, 0x0b, 0x28, 0xeb, 0xd4, 0x82, 0x25, 0x74, 0x33, 0xd9,
-	0x82, 0x50, 0x0f, 0xe0, 0xad, 0x04, 0xd3, 0x31, 0xb5, 0xb3, 0xf0, 0xe2, 0x4b, 0x2e, 0xc9, 0x85,
-	0x17, 0x5f, 0x8f, 0x52, 0x5d, 0x59, 0xa3, 0xe4, 0x17, 0xd1, 0x82, 0x38, 0x11, 0xd0, 0x57, 0x54,
-	0x2e, 0xae, 0x9a, 0x46, 0xfd, 0x31, 0x88, 0x94, 0xf0, 0xc2, 0x07, 0x48, 0xaf, 0xf2, 0x5d, 0xd9,
-	0xa4, 0xf4, 0xd7, 0xd0, 0x6a, 0xda, 0x3c, 0xe6, 0xa0, 0x5f, 0x4b, 0x90, 0x0d, 0x55, 0x1d, 0xc2,
-	0x78, 0xed, 0xae, 0xb5, 0x85, 0xf1, 0x1a, 0x53, 0xd4, 0x28, 0xcb, 0x94, 0xe9, 0x0d, 0x34, 0x27,
-	0x78, 0x2a, 0xa1, 0x5f, 0x49, 0x90, 0x09, 0xae, 0x7a, 0x54, 0x4c, 0xfb, 0x28, 0xf0, 0xb9, 0xdd,
-	0x4a, 0xaf, 0x90, 0xfe, 0xfc, 0x5e, 0xbc, 0x1f, 0x76, 0x8e, 0xdf, 0x9d, 0xe5, 0xa5, 0xf7, 0x67,
-	0x79, 0xe9, 0x5f, 0x67, 0x79, 0xe9, 0x17, 0xe7, 0xf9, 0x81, 0xf7, 0xe7, 0xf9, 0x81, 0x7f, 0x9c,
-	0xe7, 0x07, 0xbe, 0x7b, 0x50, 0xd3, 0xdd, 0x7a, 0xab, 0x52, 0xd0, 0xcc, 0x66, 0x71, 0xdf, 0x47,
-	0x3a, 0xc0, 0x15, 0xe7, 0x02, 0x77, 0x43, 0x33, 0x6d, 0x12, 0xfe, 0xf4, 0x92, 0x2c, 0xcf, 0x53,
-	0x8e, 0xbf, 0xa8, 0xdb, 0xb6, 0x88, 0x53, 0x19, 0xa5, 0xff, 0x9c, 0xde, 0xfe, 0x6f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xe9, 0xfe, 0x8e, 0x10, 0xfe, 0x1d, 0x00, 0x00,
+	// 2049 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x59, 0xcd, 0x6f, 0xdb, 0xc8,
+	0x15, 0x0f, 0x6d, 0xc7, 0xb1, 0x9e, 0x13, 0xdb, 0x3b, 0xf6, 0x3a, 0x0e, 0x93, 0xc8, 0x0a, 0x13,
+	0x3b, 0x4e, 0xe3, 0x48, 0x6b, 0x65, 0x9b, 0x0f, 0x6f, 0x9a, 0x62, 0x1d, 0x27, 0xad, 0xb7, 0x09,
+	0x92, 0xd2, 0x49, 0xd3, 0x2f, 0x40, 0x18, 0x51, 0x13, 0x89, 0x6b, 0xf1, 0x23, 0x24, 0xe5, 0x5d,
+	0x61, 0xb1, 0x28, 0xda, 0x63, 0x5b, 0xa0, 0x05, 0x7a, 0x6d, 0xef, 0x45, 0x6f, 0x05, 0xda, 0xc3,
+	0x5e, 0x0b, 0x14, 0xd8, 0xde, 0xb6, 0x28, 0x5a, 0x14, 0x3d, 0x04, 0x45, 0xd2, 0xbf, 0xa1, 0xe7,
+	0x82, 0x33, 0x8f, 0x34, 0x29, 0x92, 0x22, 0xa5, 0x62, 0x6f, 0xe2, 0xcc, 0x7b, 0xbf, 0xf9, 0xbd,
+	0x37, 0xef, 0xbd, 0x99, 0x79, 0x82, 0x4b, 0xba, 0xf9, 0x21, 0xd3, 0x3c, 0xfd, 0x90, 0xd5, 0x2c,
+	0x87, 0x6a, 0x5d, 0x56, 0x3b, 0xdc, 0x6a, 0x32, 0x8f, 0x6e, 0xd5, 0x5e, 0xf6, 0x98, 0xd3, 0xaf,
+	0xda, 0x8e, 0xe5, 0x59, 0x64, 0x25, 0x94, 0xaa, 0x0a, 0xa9, 0x2a, 0x4a, 0xc9, 0xe7, 0xda, 0x96,
+	0xd5, 0xee, 0xb2, 0x1a, 0xb5, 0xf5, 0x1a, 0x35, 0x4d, 0xcb, 0xa3, 0x9e, 0x6e, 0x99, 0xae, 0xd0,
+	0x93, 0xd7, 0x32, 0xd1, 0x11, 0x46, 0x88, 0xad, 0x67, 0x8a, 0xb5, 0x99, 0xc9, 0x5c, 0x3d, 0x80,
+	0x5b, 0x6a, 0x5b, 0x6d, 0x8b, 0xff, 0xac, 0xf9, 0xbf, 0xc4, 0xa8, 0x52, 0x87, 0xb7, 0xbf, 0xed,
+	0x73, 0x7d, 0xd2, 0xf7, 0x3a, 0x4f, 0x1c, 0x5d, 0x63, 0x2a, 0x7b, 0xd9, 0x63, 0xae, 0x47, 0xce,
+	0xc0, 0x8c, 0xed, 0x7f, 0x37, 0xf4, 0xd6, 0x8a, 0x54, 0x91, 0x36, 0x4a, 0xea, 0x09, 0xfe, 0xbd,
+	0xd7, 0x52, 0x34, 0x58, 0x1e, 0xd4, 0x71, 0x6d, 0xcb, 0x74, 0x19, 0xd9, 0x83, 0x59, 0xa1, 0xe4,
+	0x7a, 0xd4, 0x63, 0x5c, 0x6f, 0xb6, 0xbe, 0x51, 0xcd, 0x72, 0x40, 0x35, 0x44, 0xd8, 0xf7, 0xe5,
+	0x55, 0xb0, 0xc3, 0xdf, 0xca, 0x12, 0x10, 0xb1, 0x08, 0x75, 0xa8, 0xe1, 0x22, 0x2b, 0xe5, 0x19,
+	0x2c, 0xc6, 0x46, 0x71, 0xdd, 0xbb, 0x30, 0x6d, 0xf3, 0x11, 0x5c, 0xb2, 0x32, 0x64, 0x49, 0x2e,
+	0xb7, 0x33, 0xf5, 0xf9, 0xab, 0xd5, 0x63, 0x2a, 0x6a, 0x29, 0x32, 0xac, 0x70, 0xd8, 0x1d, 0x6a,
+	0xb6, 0x54, 0xd6, 0xa5, 0x7d, 0xe6, 0x84, 0x4b, 0xde, 0x84, 0x33, 0x29, 0x73, 0xb8, 0xb0, 0x0c,
+	0x33, 0x0e, 0x8e, 0xad, 0x48, 0x95, 0xc9, 0x8d, 0x92, 0x1a, 0x7e, 0x2b, 0xe7, 0xe1, 0x6c, 0xa8,
+	0x78, 0x64, 0x64, 0x88, 0x7b, 0x00, 0xe7, 0xd2, 0xa7, 0x11, 0xfa, 0x5b, 0x70, 0x32, 0xe2, 0x4b,
+	0x01, 0x3f, 0xd4, 0x99, 0x71, 0x20, 0x75, 0xf6, 0xc8, 0x99, 0xae, 0x52, 0x81, 0x72, 0xb8, 0xd8,
+	0xde, 0xce, 0xbd, 0x14, 0x3a, 0x26, 0xac, 0x66, 0x4a, 0x7c, 0x19, 0x8c, 0x14, 0xa8, 0x88, 0x9d,
+	0xf4, 0xc7, 0x1e, 0x30, 0x96, 0xe6, 0x22, 0x1b, 0x2e, 0x0c, 0x91, 0x19, 0x97, 0x55, 0x88, 0x96,
+	0xc2, 0xea, 0x02, 0x7a, 0xe1, 0x9e, 0xa5, 0x9b, 0x4d, 0xea, 0xb2, 0x14, 0x52, 0x2e, 0x54, 0xb2,
+	0x45, 0x90, 0xd3, 0xe3, 0x54, 0x4e, 0x9b, 0xd9, 0x9c, 0x92, 0x60, 0x71, 0x5e, 0x41, 0x2c, 0xc5,
+	0x13, 0x26, 0x11, 0x4b, 0x89, 0xe9, 0xb1, 0x7d, 0x14, 0x4f, 0xcc, 0x18, 0x97, 0x32, 0x2e, 0xb6,
+	0xef, 0x59, 0xce, 0x41, 0x0a, 0x19, 0x03, 0xce, 0x67, 0xcc, 0x23, 0x9b, 0x87, 0xa9, 0x6c, 0xae,
+	0x64, 0xb3, 0x19, 0x40, 0x4a, 0x77, 0x8d, 0x10, 0xea, 0x35, 0xbb, 0xba, 0xdb, 0x89, 0xa4, 0xef,
+	0x5d, 0x38, 0x97, 0x3e, 0x8d, 0x64, 0xca, 0x00, 0x76, 0x38, 0x8a, 0x39, 0x1c, 0x19, 0x51, 0x9e,
+	0x62, 0xe6, 0x3c, 0x71, 0xac, 0x43, 0xbd, 0xc5, 0x9c, 0x08, 0x0d, 0xac, 0x94, 0xb2, 0x5f, 0x29,
+	0xc5, 0x24, 0x56, 0xca, 0xf0, 0x9b, 0x2c, 0xc3, 0xb4, 0xdb, 0x37, 0x9a, 0x56, 0x77, 0x65, 0x82,
+	0xcf, 0xe0, 0x97, 0xd2, 0x81, 0xd5, 0x4c, 0x54, 0x24, 0x76, 0x3f, 0xad, 0x96, 0x5e, 0xca, 0x09,
+	0xeb, 0x64, 0x1d, 0xbd, 0x06, 0x57, 0x45, 0xb8, 0x76, 0xa8, 0x6e, 0x76, 0x75, 0xf3, 0x60, 0x97,
+	0x7a, 0x74, 0xdf, 0x73, 0x18, 0x35, 0xdc, 0x94, 0xcd, 0xfb, 0x99, 0x04, 0x9b, 0xc5, 0xe4, 0x91,
+	0xe6, 0x0f, 0x52, 0x37, 0xf3, 0xd6, 0x90, 0x50, 0x1f, 0x0a, 0x1c, 0xdf, 0xdb, 0x33, 0x70, 0x9a,
+	0x93, 0x79, 0x64, 0xb5, 0x7a, 0xdd, 0x98, 0xd7, 0x95, 0xef, 0xc2, 0x4a, 0x72, 0x0a, 0x39, 0xdd,
+	0x81, 0xe3, 0x51, 0xa7, 0xad, 0x67, 0x93, 0xf9, 0x86, 0x38, 0x22, 0x85, 0xba, 0x50, 0x52, 0x7e,
+	0x04, 0x0a, 0x47, 0xfe, 0xa6, 0xee, 0x7a, 0x96, 0xa3, 0x6b, 0xb4, 0x8b, 0x87, 0x9c, 0x66, 0x39,
+	0xad, 0xc0, 0x51, 0xe4, 0x0e, 0x4c, 0x0b, 0x2c, 0xbe, 0xc8, 0xdc, 0xb0, 0x9d, 0x79, 0xcc, 0x3f,
+	0x9f, 0xf6, 0x6d, 0xa6, 0xa2, 0x0e, 0x39, 0x0b, 0x25, 0x11, 0x09, 0xfe, 0xf1, 0x2a, 0x42, 0x63,
+	0x46, 0x0c, 0xec, 0xb5, 0x14, 0x07, 0x2e, 0x0e, 0x25, 0x10, 0x26, 0xf5, 0x29, 0xe1, 0x79, 0x47,
+	0x4c, 0xa0, 0xeb, 0xd7, 0x73, 0x42, 0x24, 0x80, 0x39, 0x69, 0x47, 0xbe, 0x94, 0x9f, 0x4a, 0xb0,
+	0x24, 0x78, 0x8a, 0x55, 0xfb, 0x8f, 0x6d, 0x7e, 0x17, 0x21, 0xa7, 0xe1, 0x84, 0x41, 0x3f, 0x6e,
+	0xd0, 0xb6, 0x30, 0x74, 0x4a, 0x9d, 0x36, 0xe8, 0xc7, 0xef, 0xb7, 0x19, 0xa9, 0xc2, 0xa2, 0x6e,
+	0x6a, 0xdd, 0x5e, 0x8b, 0x35, 0x1c, 0xfa, 0x51, 0xa3, 0x23, 0xd4, 0xb8, 0x31, 0x33, 0xea, 0x5b,
+	0x38, 0xa5, 0xd2, 0x8f, 0x10, 0x8f, 0x5c, 0x81, 0x85, 0x40, 0xde, 0x60, 0x1e, 0x6d, 0x51, 0x8f,
+	0xae, 0x4c, 0x72, 0xe1, 0x79, 0x1c, 0x7f, 0x84, 0xc3, 0xca, 0xcf, 0x27, 0x30, 0x69, 0x05, 0xa3,
+	0xef, 0x58, 0x5d, 0xea, 0xe9, 0x5d, 0xdd, 0xeb, 0x07, 0xce, 0x7f, 0x1f, 0x4a, 0x7e, 0xb5, 0x6c,
+	0xe8, 0xe6, 0x0b, 0x2b, 0x3f, 0x33, 0x04, 0xca, 0x9e, 0xf9, 0xc2, 0x52, 0x67, 0x7c, 0x35, 0xff,
+	0x17, 0xb9, 0x07, 0xf0, 0xb2, 0x67, 0x79, 0x88, 0x31, 0x31, 0x02, 0x46, 0x89, 0xeb, 0x71, 0x90,
+	0x16, 0x2c, 0x0b, 0xb9, 0xc0, 0xfc, 0x86, 0x25, 0xdc, 0xc6, 0x2d, 0x9b, 0xad, 0x57, 0xf3, 0x00,
+	0xe3, 0xce, 0x56, 0x97, 0xac, 0x94, 0x51, 0xe5, 0xc7, 0x13, 0x58, 0x51, 0x93, 0xee, 0xc0, 0x50,
+	0xf8, 0x3a, 0xc0, 0x61, 0x38, 0x2a, 0x8a, 0xd0, 0xce, 0xea, 0xbf, 0x5e, 0xad, 0x9e, 0xd5, 0x2c,
+	0xd7, 0xb0, 0x5c, 0xb7, 0x75, 0x50, 0xd5, 0xad, 0x9a, 0x41, 0xbd, 0x4e, 0xf5, 0x21, 0x6b, 0x53,
+	0xad, 0xbf, 0xcb, 0x34, 0x35, 0xa2, 0x42, 0x9e, 0xc3, 0x42, 0x60, 0x41, 0xb8, 0x39, 0xc2, 0x27,
+	0x43, 0x0e, 0xad, 0x60, 0xbf, 0xfc, 0xec, 0xd1, 0x5d, 0x4f, 0xd7, 0x5c, 0x75, 0x1e, 0x51, 0x82,
+	0x29, 0xf2, 0x00, 0x66, 0xa3, 0xd1, 0x31, 0xc9, 0x43, 0x74, 0xad, 0x50, 0x88, 0xaa, 0xe0, 0x84,
+	0xd1, 0x13, 0x1e, 0xcc, 0xc2, 0x05, 0x41, 0xd9, 0x74, 0xf9, 0x86, 0x60, 0x45, 0xe8, 0x40, 0x25,
+	0x5b, 0x04, 0x1d, 0xb5, 0x0b, 0xa5, 0xa0, 0x36, 0x17, 0xca, 0x17, 0x21, 0x2a, 0xb6, 0x3d, 0x54,
+	0x54, 0xee, 0xa6, 0xae, 0xc4, 0xa9, 0xbb, 0x05, 0x4e, 0x05, 0xc5, 0x81, 0x0b, 0x43, 0xf4, 0x91,
+	0xea, 0x23, 0x3f, 0xbd, 0xc5, 0xcc, 0x3e, 0x16, 0x33, 0x9f, 0xee, 0xe5, 0x7c, 0xba, 0xa2, 0x9a,
+	0xc5, 0xb5, 0x95, 0x1f, 0xc2, 0xdc, 0xbe, 0x46, 0xbb, 0xba, 0xd9, 0x0e, 0x32, 0xfb, 0x22, 0x9c,
+	0xe2, 0x49, 0xd4, 0x62, 0x9a, 0x6e, 0xd0, 0xae, 0xb8, 0x3b, 0x9f, 0x52, 0x4f, 0xfa, 0x83, 0xbb,
+	0x38, 0x46, 0xd6, 0x60, 0x4e, 0xa4, 0x49, 0x28, 0x35, 0xc1, 0xa5, 0x4e, 0xf1, 0xd1, 0x40, 0x4c,
+	0x79, 0x23, 0xc1, 0xe9, 0x98, 0x49, 0x91, 0x97, 0xc4, 0x7d, 0x98, 0xc5, 0x24, 0xf1, 0xfa, 0xf6,
+	0x68, 0xe5, 0x12, 0xac, 0xf0, 0x37, 0x21, 0x30, 0xe5, 0x33, 0xc3, 0x6a, 0xc9, 0x7f, 0x93, 0x25,
+	0x38, 0xce, 0x79, 0xf0, 0x74, 0x2b, 0xa9, 0xe2, 0x83, 0x3c, 0x87, 0x79, 0x57, 0x98, 0x1a, 0xa6,
+	0xe3, 0x54, 0xde, 0x4b, 0x24, 0xee, 0x1b, 0xfe, 0x3c, 0x90, 0xd4, 0x39, 0x37, 0x36, 0xaa, 0xbc,
+	0x9e, 0x84, 0x39, 0x6e, 0xda, 0x13, 0xaa, 0x0b, 0xb7, 0x92, 0x1d, 0x00, 0x9b, 0xea, 0x4e, 0x83,
+	0x17, 0x53, 0xcc, 0xbc, 0x8b, 0xfe, 0xdb, 0x22, 0x2f, 0xfb, 0x4a, 0xbe, 0x1a, 0x07, 0xf3, 0x31,
+	0xf8, 0x46, 0x08, 0x8c, 0x89, 0x11, 0x30, 0xc2, 0x2b, 0x23, 0xd9, 0x85, 0x59, 0xb1, 0x4f, 0x02,
+	0x64, 0xb2, 0x38, 0x88, 0x28, 0x83, 0x02, 0xe5, 0x39, 0xbc, 0xcd, 0x99, 0x68, 0x3d, 0xa3, 0xe7,
+	0xd7, 0x86, 0xc3, 0x00, 0x6f, 0xaa, 0x38, 0xde, 0xa2, 0x8f, 0x70, 0x2f, 0x04, 0x10, 0xc0, 0xdf,
+	0x83, 0x65, 0x41, 0x2f, 0x81, 0x7c, 0xbc, 0x38, 0xf2, 0x12, 0x87, 0x18, 0x84, 0x5e, 0x83, 0x39,
+	0xce, 0xd9, 0xd3, 0x0d, 0xe6, 0x7a, 0xd4, 0xb0, 0x57, 0xa6, 0x2b, 0xd2, 0xc6, 0xa4, 0xca, 0x83,
+	0xfb, 0x69, 0x30, 0x48, 0x2e, 0xc3, 0xbc, 0x60, 0x70, 0x24, 0x77, 0x82, 0xcb, 0x89, 0xf8, 0x0e,
+	0x05, 0x15, 0x13, 0x2f, 0x16, 0xb1, 0x48, 0xc6, 0x9c, 0x54, 0x61, 0x41, 0x1c, 0xb9, 0x7c, 0xcf,
+	0x8b, 0x3e, 0x72, 0x63, 0x11, 0xa3, 0xce, 0xd9, 0xb1, 0xef, 0xb0, 0xb2, 0x71, 0xb1, 0x0f, 0x7a,
+	0x86, 0xfd, 0xb4, 0xe3, 0x30, 0xb7, 0x63, 0x75, 0x5b, 0x91, 0x77, 0x50, 0x25, 0x5b, 0x24, 0xbc,
+	0x54, 0x83, 0x17, 0x8e, 0xe6, 0x3f, 0x38, 0x92, 0x50, 0x6a, 0x44, 0xff, 0x88, 0x14, 0x33, 0x5b,
+	0xba, 0xd9, 0xe6, 0xd2, 0xcf, 0xec, 0x56, 0xf4, 0xa6, 0xd8, 0x87, 0x4a, 0xb6, 0x08, 0x92, 0x7a,
+	0x06, 0xf3, 0xb6, 0x98, 0x6e, 0xf4, 0xc4, 0x54, 0x01, 0x66, 0x09, 0x3c, 0x75, 0x0e, 0x41, 0x10,
+	0xbe, 0xfe, 0x8f, 0xf3, 0x70, 0x9c, 0xaf, 0x4d, 0x7e, 0x21, 0xc1, 0xb4, 0x78, 0xd1, 0x93, 0x21,
+	0x90, 0xc9, 0x46, 0x82, 0x7c, 0xad, 0xa0, 0xb4, 0x30, 0x44, 0xd9, 0xf8, 0xc9, 0xdf, 0xfe, 0xf3,
+	0xab, 0x09, 0x85, 0x54, 0x6a, 0x99, 0xdd, 0x16, 0xd1, 0x4a, 0x20, 0xbf, 0x95, 0xe0, 0x64, 0xb4,
+	0x55, 0x40, 0xea, 0x39, 0x2b, 0xa5, 0xf4, 0x1c, 0xe4, 0xeb, 0x23, 0xe9, 0x20, 0xc7, 0x1a, 0xe7,
+	0x78, 0x85, 0x5c, 0xce, 0xe6, 0xd8, 0xa4, 0x66, 0xab, 0x11, 0x34, 0x28, 0xc8, 0x1f, 0x25, 0x98,
+	0x1f, 0xe8, 0x3e, 0x90, 0xaf, 0x16, 0x58, 0x39, 0xf9, 0x6c, 0x90, 0x6f, 0x8c, 0xaa, 0x86, 0x9c,
+	0xaf, 0x73, 0xce, 0xd7, 0xc8, 0xd5, 0x1c, 0xce, 0xd1, 0x27, 0x06, 0xf9, 0x93, 0x04, 0x24, 0xd9,
+	0xa6, 0x20, 0xb7, 0x0a, 0x70, 0x48, 0xed, 0x7d, 0xc8, 0xb7, 0xc7, 0xd0, 0x44, 0x03, 0x6e, 0x72,
+	0x03, 0xb6, 0x48, 0x2d, 0xc7, 0x00, 0xbd, 0xa9, 0xc5, 0x8d, 0xf8, 0x8b, 0x04, 0x4b, 0x69, 0x7d,
+	0x0d, 0xb2, 0x9d, 0x17, 0x99, 0xd9, 0x0d, 0x13, 0xf9, 0xbd, 0xb1, 0x74, 0xd1, 0x94, 0x5b, 0xdc,
+	0x94, 0x3a, 0x79, 0x67, 0x48, 0x8c, 0xfb, 0x6a, 0x2f, 0x18, 0x1b, 0xd8, 0x90, 0x3f, 0x4b, 0xb0,
+	0x98, 0xd2, 0x0e, 0x21, 0x79, 0x7e, 0xcd, 0xee, 0xb2, 0xc8, 0xdb, 0xe3, 0xa8, 0x16, 0xdf, 0x13,
+	0x0d, 0xd5, 0xe3, 0x76, 0xf8, 0x09, 0x31, 0xd0, 0x42, 0xc9, 0x4d, 0x88, 0xf4, 0x8e, 0x8c, 0x7c,
+	0x63, 0x54, 0xb5, 0xe2, 0x09, 0x61, 0xf7, 0xbd, 0x4e, 0x9c, 0xf7, 0x67, 0x12, 0x2c, 0x0c, 0x76,
+	0x5b, 0x48, 0x1e, 0x83, 0x8c, 0xf6, 0x8d, 0x7c, 0x73, 0x64, 0x3d, 0xa4, 0xfe, 0x2e, 0xa7, 0x5e,
+	0x25, 0x9b, 0xd9, 0xd4, 0xfd, 0xbb, 0xfc, 0x41, 0x9c, 0xfb, 0x1f, 0x24, 0x98, 0x1f, 0xe8, 0xcd,
+	0xe4, 0xfa, 0x3c, 0xbd, 0xd5, 0x23, 0xdf, 0x18, 0x55, 0x0d, 0x89, 0xd7, 0x39, 0xf1, 0x4d, 0xf2,
+	0x95, 0x5c, 0xe2, 0x47, 0x14, 0xff, 0x2e, 0x01, 0x49, 0x36, 0x6f, 0x72, 0x6b, 0x50, 0x66, 0x17,
+	0x49, 0xbe, 0x3d, 0x86, 0x26, 0xf2, 0xff, 0x80, 0xf3, 0xdf, 0x25, 0x3b, 0xc3, 0x12, 0x57, 0x68,
+	0x47, 0x7d, 0x5f, 0xfb, 0x24, 0x18, 0xfd, 0xb4, 0xf6, 0x89, 0x68, 0x3e, 0x7c, 0x4a, 0xfe, 0x2b,
+	0xc1, 0x6a, 0x4e, 0xeb, 0x87, 0xdc, 0xcf, 0xcb, 0xcd, 0x42, 0xad, 0x26, 0xf9, 0xc1, 0xff, 0x0b,
+	0x83, 0xe6, 0xef, 0x72, 0xf3, 0xef, 0x92, 0x3b, 0x43, 0xd2, 0x3d, 0x80, 0x6a, 0xf8, 0x8f, 0xd2,
+	0x86, 0xcb, 0xc1, 0xe2, 0x71, 0xf8, 0x3b, 0x09, 0xde, 0x12, 0x57, 0xbe, 0x48, 0x47, 0x89, 0x6c,
+	0xe5, 0x70, 0x4c, 0x36, 0xa6, 0xe4, 0xfa, 0x28, 0x2a, 0x68, 0x42, 0x95, 0x9b, 0xb0, 0x41, 0xd6,
+	0xb3, 0x4d, 0x30, 0xb8, 0x9a, 0x60, 0x4b, 0xfe, 0x2a, 0xc1, 0x72, 0x7a, 0x77, 0x88, 0xdc, 0xc9,
+	0x59, 0x7e, 0x68, 0x57, 0x4b, 0xfe, 0xda, 0x98, 0xda, 0x68, 0xc7, 0x36, 0xb7, 0xe3, 0x5d, 0x52,
+	0xcf, 0xb6, 0xa3, 0x13, 0x22, 0x34, 0x62, 0xdd, 0x2b, 0xf2, 0x7b, 0x09, 0x16, 0x06, 0x1b, 0x1c,
+	0xb9, 0x45, 0x2c, 0xa3, 0x41, 0x24, 0xdf, 0x1c, 0x59, 0x0f, 0x2d, 0xd8, 0xe4, 0x16, 0xac, 0x93,
+	0x4b, 0xd9, 0x16, 0x44, 0xda, 0x26, 0x9f, 0x49, 0xb0, 0x98, 0xd2, 0x6e, 0xc8, 0x3d, 0xf8, 0xb2,
+	0xbb, 0x18, 0xf2, 0xf6, 0x38, 0xaa, 0x48, 0xfe, 0x2a, 0x27, 0xbf, 0x46, 0x2e, 0xe6, 0x17, 0x02,
+	0x7e, 0x8b, 0x5a, 0x4a, 0x6b, 0x40, 0x90, 0xd1, 0x18, 0xc4, 0xba, 0x1e, 0xf2, 0x7b, 0x63, 0xe9,
+	0x22, 0xfd, 0x2d, 0x4e, 0xff, 0x2a, 0xb9, 0x52, 0xb4, 0x8e, 0xb9, 0xe4, 0x37, 0x12, 0xcc, 0x46,
+	0x1e, 0x6a, 0xb9, 0xf9, 0x9a, 0x6c, 0x4f, 0xc8, 0xf5, 0x51, 0x54, 0x90, 0xe9, 0x65, 0xce, 0xf4,
+	0x02, 0x59, 0xcd, 0xb9, 0x2a, 0x91, 0x5f, 0x4b, 0x50, 0x0a, 0x8f, 0x7a, 0x52, 0x2b, 0x7a, 0x29,
+	0x08, 0xb8, 0xbd, 0x53, 0x5c, 0xa1, 0x78, 0xfc, 0x1e, 0xdd, 0x1f, 0xf8, 0xc5, 0x2d, 0xe5, 0x51,
+	0x49, 0x6e, 0x17, 0xb9, 0x47, 0xa6, 0xbe, 0x55, 0xe5, 0xed, 0x71, 0x54, 0x8b, 0x5f, 0xdc, 0x44,
+	0xcd, 0xf8, 0xb0, 0x67, 0xd8, 0x8d, 0xa3, 0xe7, 0xaa, 0xb0, 0x23, 0xf9, 0x0e, 0xcd, 0xb7, 0x23,
+	0xf3, 0x79, 0x2b, 0x6f, 0x8f, 0xa3, 0x3a, 0x82, 0x1d, 0xf8, 0x2c, 0x16, 0xf6, 0xe0, 0xe3, 0x78,
+	0xe7, 0xc5, 0xe7, 0xaf, 0xcb, 0xd2, 0x17, 0xaf, 0xcb, 0xd2, 0xbf, 0x5f, 0x97, 0xa5, 0x5f, 0xbe,
+	0x29, 0x1f, 0xfb, 0xe2, 0x4d, 0xf9, 0xd8, 0x3f, 0xdf, 0x94, 0x8f, 0x7d, 0xff, 0x61, 0x5b, 0xf7,
+	0x3a, 0xbd, 0x66, 0x55, 0xb3, 0x8c, 0xda, 0x5e, 0x00, 0xfa, 0x90, 0x36, 0xdd, 0xa3, 0x25, 0xae,
+	0x69, 0x96, 0xc3, 0xa2, 0x9f, 0xfe, 0xa1, 0x87, 0xe7, 0x86, 0x1b, 0xac, 0xef, 0xb7, 0xe0, 0xdc,
+	0xe6, 0x34, 0xff, 0xf3, 0xff, 0xfa, 0xff, 0x06, 0x00, 0xef, 0xdf, 0xeb, 0x65, 0xc1, 0x20, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2041,6 +2221,10 @@ type QueryClient interface {
 	OracleProviderPrices(ctx context.Context, in *QueryOracleProviderPricesRequest, opts ...grpc.CallOption) (*QueryOracleProviderPricesResponse, error)
 	OraclePrice(ctx context.Context, in *QueryOraclePriceRequest, opts ...grpc.CallOption) (*QueryOraclePriceResponse, error)
 	PythPrice(ctx context.Context, in *QueryPythPriceRequest, opts ...grpc.CallOption) (*QueryPythPriceResponse, error)
+	// Retrieves the circuit breaker thresholds for all guarded symbols
+	PriceJumpThresholds(ctx context.Context, in *QueryPriceJumpThresholdsRequest, opts ...grpc.CallOption) (*QueryPriceJumpThresholdsResponse, error)
+	// Retrieves the price updates held back by the circuit breaker
+	PendingPriceUpdates(ctx context.Context, in *QueryPendingPriceUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingPriceUpdatesResponse, error)
 }
 
 type queryClient struct {
@@ -2213,6 +2397,24 @@ func (c *queryClient) PythPrice(ctx context.Context, in *QueryPythPriceRequest,
 	return out, nil
 }
 
+func (c *queryClient) PriceJumpThresholds(ctx context.Context, in *QueryPriceJumpThresholdsRequest, opts ...grpc.CallOption) (*QueryPriceJumpThresholdsResponse, error) {
+	out := new(QueryPriceJumpThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PriceJumpThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingPriceUpdates(ctx context.Context, in *QueryPendingPriceUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingPriceUpdatesResponse, error) {
+	out := new(QueryPendingPriceUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Query/PendingPriceUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Retrieves oracle params
@@ -2247,6 +2449,10 @@ type QueryServer interface {
 	OracleProviderPrices(context.Context, *QueryOracleProviderPricesRequest) (*QueryOracleProviderPricesResponse, error)
 	OraclePrice(context.Context, *QueryOraclePriceRequest) (*QueryOraclePriceResponse, error)
 	PythPrice(context.Context, *QueryPythPriceRequest) (*QueryPythPriceResponse, error)
+	// Retrieves the circuit breaker thresholds for all guarded symbols
+	PriceJumpThresholds(context.Context, *QueryPriceJumpThresholdsRequest) (*QueryPriceJumpThresholdsResponse, error)
+	// Retrieves the price updates held back by the circuit breaker
+	PendingPriceUpdates(context.Context, *QueryPendingPriceUpdatesRequest) (*QueryPendingPriceUpdatesResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -2307,6 +2513,12 @@ func (*UnimplementedQueryServer) OraclePrice(ctx context.Context, req *QueryOrac
 func (*UnimplementedQueryServer) PythPrice(ctx context.Context, req *QueryPythPriceRequest) (*QueryPythPriceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method PythPrice not implemented")
 }
+func (*UnimplementedQueryServer) PriceJumpThresholds(ctx context.Context, req *QueryPriceJumpThresholdsRequest) (*QueryPriceJumpThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PriceJumpThresholds not implemented")
+}
+func (*UnimplementedQueryServer) PendingPriceUpdates(ctx context.Context, req *QueryPendingPriceUpdatesRequest) (*QueryPendingPriceUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingPriceUpdates not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -2636,6 +2848,42 @@ func _Query_PythPrice_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_PriceJumpThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPriceJumpThresholdsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PriceJumpThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/PriceJumpThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PriceJumpThresholds(ctx, req.(*QueryPriceJumpThresholdsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingPriceUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingPriceUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingPriceUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Query/PendingPriceUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingPriceUpdates(ctx, req.(*QueryPendingPriceUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.oracle.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -2712,6 +2960,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "PythPrice",
 			Handler:    _Query_PythPrice_Handler,
 		},
+		{
+			MethodName: "PriceJumpThresholds",
+			Handler:    _Query_PriceJumpThresholds_Handler,
+		},
+		{
+			MethodName: "PendingPriceUpdates",
+			Handler:    _Query_PendingPriceUpdates_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/oracle/v1beta1/query.proto",
@@ -4070,6 +4326,126 @@ func (m *QueryOraclePriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryPriceJumpThresholdsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceJumpThresholdsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceJumpThresholdsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPriceJumpThresholdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPriceJumpThresholdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPriceJumpThresholdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Thresholds) > 0 {
+		for iNdEx := len(m.Thresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Thresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPriceUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPriceUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPriceUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingPriceUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingPriceUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingPriceUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PendingUpdates) > 0 {
+		for iNdEx := len(m.PendingUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQuery(v)
 	base := offset
@@ -4610,9 +4986,57 @@ func (m *QueryOraclePriceResponse) Size() (n int) {
 	}
 	var l int
 	_ = l
-	if m.PricePairState != nil {
-		l = m.PricePairState.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if m.PricePairState != nil {
+		l = m.PricePairState.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPriceJumpThresholdsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPriceJumpThresholdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Thresholds) > 0 {
+		for _, e := range m.Thresholds {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryPendingPriceUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPendingPriceUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.PendingUpdates) > 0 {
+		for _, e := range m.PendingUpdates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
 	}
 	return n
 }
@@ -7971,6 +8395,274 @@ func (m *QueryOraclePriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryPriceJumpThresholdsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceJumpThresholdsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceJumpThresholdsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPriceJumpThresholdsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPriceJumpThresholdsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPriceJumpThresholdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Thresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Thresholds = append(m.Thresholds, &PriceJumpThreshold{})
+			if err := m.Thresholds[len(m.Thresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPriceUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPriceUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPriceUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingPriceUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingPriceUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingPriceUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingUpdates = append(m.PendingUpdates, &PendingPriceUpdate{})
+			if err := m.PendingUpdates[len(m.PendingUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
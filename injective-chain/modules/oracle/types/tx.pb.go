@@ -588,6 +588,212 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+type MsgUpdatePriceJumpThresholds struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// thresholds to create or replace
+	SetThresholds []PriceJumpThreshold `protobuf:"bytes,2,rep,name=set_thresholds,json=setThresholds,proto3" json:"set_thresholds"`
+	// thresholds to remove
+	RemoveThresholds []OracleInfo `protobuf:"bytes,3,rep,name=remove_thresholds,json=removeThresholds,proto3" json:"remove_thresholds"`
+}
+
+func (m *MsgUpdatePriceJumpThresholds) Reset()         { *m = MsgUpdatePriceJumpThresholds{} }
+func (m *MsgUpdatePriceJumpThresholds) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceJumpThresholds) ProtoMessage()    {}
+func (*MsgUpdatePriceJumpThresholds) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fdf1c490eba4310, []int{14}
+}
+func (m *MsgUpdatePriceJumpThresholds) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceJumpThresholds) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceJumpThresholds.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePriceJumpThresholds) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceJumpThresholds.Merge(m, src)
+}
+func (m *MsgUpdatePriceJumpThresholds) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceJumpThresholds) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceJumpThresholds.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePriceJumpThresholds proto.InternalMessageInfo
+
+func (m *MsgUpdatePriceJumpThresholds) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdatePriceJumpThresholds) GetSetThresholds() []PriceJumpThreshold {
+	if m != nil {
+		return m.SetThresholds
+	}
+	return nil
+}
+
+func (m *MsgUpdatePriceJumpThresholds) GetRemoveThresholds() []OracleInfo {
+	if m != nil {
+		return m.RemoveThresholds
+	}
+	return nil
+}
+
+type MsgUpdatePriceJumpThresholdsResponse struct {
+}
+
+func (m *MsgUpdatePriceJumpThresholdsResponse) Reset()         { *m = MsgUpdatePriceJumpThresholdsResponse{} }
+func (m *MsgUpdatePriceJumpThresholdsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdatePriceJumpThresholdsResponse) ProtoMessage()    {}
+func (*MsgUpdatePriceJumpThresholdsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fdf1c490eba4310, []int{15}
+}
+func (m *MsgUpdatePriceJumpThresholdsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdatePriceJumpThresholdsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdatePriceJumpThresholdsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdatePriceJumpThresholdsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdatePriceJumpThresholdsResponse.Merge(m, src)
+}
+func (m *MsgUpdatePriceJumpThresholdsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdatePriceJumpThresholdsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdatePriceJumpThresholdsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdatePriceJumpThresholdsResponse proto.InternalMessageInfo
+
+type MsgResolvePendingPrice struct {
+	// authority is the address of the governance account.
+	Authority  string     `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	OracleType OracleType `protobuf:"varint,2,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty"`
+	Symbol     string     `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	// accept sets the pending price as the active price when true, otherwise
+	// the pending price is discarded
+	Accept bool `protobuf:"varint,4,opt,name=accept,proto3" json:"accept,omitempty"`
+}
+
+func (m *MsgResolvePendingPrice) Reset()         { *m = MsgResolvePendingPrice{} }
+func (m *MsgResolvePendingPrice) String() string { return proto.CompactTextString(m) }
+func (*MsgResolvePendingPrice) ProtoMessage()    {}
+func (*MsgResolvePendingPrice) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fdf1c490eba4310, []int{16}
+}
+func (m *MsgResolvePendingPrice) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolvePendingPrice) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolvePendingPrice.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolvePendingPrice) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolvePendingPrice.Merge(m, src)
+}
+func (m *MsgResolvePendingPrice) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolvePendingPrice) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolvePendingPrice.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolvePendingPrice proto.InternalMessageInfo
+
+func (m *MsgResolvePendingPrice) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResolvePendingPrice) GetOracleType() OracleType {
+	if m != nil {
+		return m.OracleType
+	}
+	return OracleType_Unspecified
+}
+
+func (m *MsgResolvePendingPrice) GetSymbol() string {
+	if m != nil {
+		return m.Symbol
+	}
+	return ""
+}
+
+func (m *MsgResolvePendingPrice) GetAccept() bool {
+	if m != nil {
+		return m.Accept
+	}
+	return false
+}
+
+type MsgResolvePendingPriceResponse struct {
+}
+
+func (m *MsgResolvePendingPriceResponse) Reset()         { *m = MsgResolvePendingPriceResponse{} }
+func (m *MsgResolvePendingPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResolvePendingPriceResponse) ProtoMessage()    {}
+func (*MsgResolvePendingPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5fdf1c490eba4310, []int{17}
+}
+func (m *MsgResolvePendingPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResolvePendingPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResolvePendingPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResolvePendingPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResolvePendingPriceResponse.Merge(m, src)
+}
+func (m *MsgResolvePendingPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResolvePendingPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResolvePendingPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResolvePendingPriceResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgRelayProviderPrices)(nil), "injective.oracle.v1beta1.MsgRelayProviderPrices")
 	proto.RegisterType((*MsgRelayProviderPricesResponse)(nil), "injective.oracle.v1beta1.MsgRelayProviderPricesResponse")
@@ -603,68 +809,85 @@ func init() {
 	proto.RegisterType((*MsgRelayChainlinkPricesResponse)(nil), "injective.oracle.v1beta1.MsgRelayChainlinkPricesResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "injective.oracle.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.oracle.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgUpdatePriceJumpThresholds)(nil), "injective.oracle.v1beta1.MsgUpdatePriceJumpThresholds")
+	proto.RegisterType((*MsgUpdatePriceJumpThresholdsResponse)(nil), "injective.oracle.v1beta1.MsgUpdatePriceJumpThresholdsResponse")
+	proto.RegisterType((*MsgResolvePendingPrice)(nil), "injective.oracle.v1beta1.MsgResolvePendingPrice")
+	proto.RegisterType((*MsgResolvePendingPriceResponse)(nil), "injective.oracle.v1beta1.MsgResolvePendingPriceResponse")
 }
 
 func init() { proto.RegisterFile("injective/oracle/v1beta1/tx.proto", fileDescriptor_5fdf1c490eba4310) }
 
 var fileDescriptor_5fdf1c490eba4310 = []byte{
-	// 895 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x5e, 0x37, 0xbf, 0xc8, 0x4b, 0xa4, 0x2a, 0x4e, 0x48, 0x1d, 0x97, 0x7a, 0xb7, 0xae, 0x90,
-	0x9a, 0x88, 0xac, 0xd9, 0x40, 0x0b, 0x5d, 0x24, 0xa4, 0xa4, 0x15, 0x12, 0x52, 0x22, 0x45, 0xae,
-	0x38, 0xc0, 0x25, 0x9a, 0xb5, 0x07, 0xef, 0xd0, 0xb5, 0xc7, 0xcc, 0xcc, 0x46, 0xec, 0x0d, 0x10,
-	0x88, 0x8a, 0x13, 0x7f, 0x42, 0x6f, 0xdc, 0x50, 0x0e, 0x3d, 0xf1, 0x17, 0xf4, 0xc0, 0xa1, 0xe2,
-	0x84, 0x7a, 0xa8, 0x50, 0x72, 0x08, 0x7f, 0x06, 0xf2, 0xd8, 0x9e, 0xf5, 0x6e, 0xed, 0xec, 0xee,
-	0x65, 0x77, 0xde, 0xbc, 0xef, 0x7b, 0xf3, 0x7d, 0x7e, 0xe3, 0x27, 0xc3, 0x6d, 0x12, 0x7d, 0x83,
-	0x3d, 0x41, 0x4e, 0xb1, 0x43, 0x19, 0xf2, 0x7a, 0xd8, 0x39, 0x6d, 0x75, 0xb0, 0x40, 0x2d, 0x47,
-	0x7c, 0xd7, 0x8c, 0x19, 0x15, 0x54, 0x37, 0x14, 0xa4, 0x99, 0x42, 0x9a, 0x19, 0xc4, 0xdc, 0x08,
-	0x68, 0x40, 0x25, 0xc8, 0x49, 0x56, 0x29, 0xde, 0x7c, 0xb7, 0xb2, 0x64, 0x46, 0x4f, 0x61, 0x37,
-	0x3c, 0xca, 0x43, 0xca, 0x9d, 0x90, 0x07, 0xce, 0x69, 0x2b, 0xf9, 0xcb, 0x12, 0x5b, 0x69, 0xe2,
-	0x24, 0x2d, 0x9c, 0x06, 0x59, 0x6a, 0x0d, 0x85, 0x24, 0xa2, 0x8e, 0xfc, 0x4d, 0xb7, 0xec, 0x57,
-	0x1a, 0x6c, 0x1e, 0xf1, 0xc0, 0xc5, 0x3d, 0x34, 0x38, 0x66, 0xf4, 0x94, 0xf8, 0x98, 0x1d, 0x33,
-	0xe2, 0x61, 0xae, 0x6f, 0xc2, 0x22, 0xc7, 0x91, 0x8f, 0x99, 0xa1, 0x35, 0xb4, 0xbb, 0xcb, 0x6e,
-	0x16, 0xe9, 0x26, 0xbc, 0x15, 0x67, 0x48, 0xe3, 0x9a, 0xcc, 0xa8, 0x58, 0x37, 0x60, 0x89, 0x0f,
-	0xc2, 0x0e, 0xed, 0x71, 0x63, 0xae, 0x31, 0x77, 0x77, 0xd9, 0xcd, 0x43, 0xfd, 0x13, 0x58, 0x8c,
-	0x65, 0x5d, 0x63, 0x3e, 0x49, 0x1c, 0xdc, 0x79, 0xf1, 0xba, 0x5e, 0x7b, 0xf5, 0xba, 0x7e, 0x33,
-	0x55, 0xc8, 0xfd, 0x27, 0x4d, 0x42, 0x9d, 0x10, 0x89, 0x6e, 0xf3, 0x10, 0x07, 0xc8, 0x1b, 0x3c,
-	0xc2, 0x9e, 0x9b, 0x51, 0xda, 0xf7, 0x9e, 0x3e, 0xab, 0xd7, 0xfe, 0x7b, 0x56, 0xaf, 0xfd, 0x78,
-	0x79, 0xb6, 0x93, 0xe9, 0xf8, 0xf5, 0xf2, 0x6c, 0xe7, 0x56, 0xf6, 0x84, 0xca, 0x1d, 0xd8, 0x0d,
-	0xb0, 0xca, 0x33, 0x2e, 0xe6, 0x31, 0x8d, 0x38, 0xb6, 0xff, 0x1a, 0xb1, 0x4f, 0x3c, 0xfc, 0x19,
-	0xc6, 0xbe, 0x5c, 0x54, 0xda, 0xd7, 0x61, 0xbe, 0x83, 0x38, 0x36, 0xae, 0x49, 0x7f, 0x72, 0xad,
-	0x6f, 0xc0, 0xc2, 0xb7, 0x7d, 0x2a, 0x70, 0x66, 0x3a, 0x0d, 0xf4, 0x07, 0xb0, 0x20, 0xf5, 0xcf,
-	0xe2, 0x38, 0x65, 0xcc, 0x60, 0xb8, 0xa8, 0x79, 0xd4, 0x70, 0x31, 0xa3, 0x0c, 0xff, 0xae, 0x81,
-	0x91, 0x43, 0x1e, 0x52, 0x12, 0x25, 0xf2, 0x8f, 0x30, 0xe7, 0x28, 0xb8, 0xba, 0xe3, 0x61, 0x86,
-	0x91, 0xb6, 0x57, 0x5d, 0x15, 0xeb, 0x16, 0x00, 0x27, 0x41, 0x84, 0x44, 0x9f, 0xe1, 0xb4, 0xe9,
-	0xab, 0x6e, 0x61, 0xa7, 0xfd, 0x51, 0x85, 0x93, 0xfa, 0x98, 0x93, 0x71, 0x31, 0xb6, 0x0d, 0x8d,
-	0xaa, 0x9c, 0x72, 0xf3, 0x54, 0x83, 0xf5, 0x1c, 0xf4, 0x58, 0x50, 0xf6, 0x64, 0xc2, 0xd5, 0x7d,
-	0x04, 0x2b, 0x88, 0x73, 0x2c, 0x4e, 0x62, 0x44, 0x58, 0xea, 0x65, 0x65, 0xef, 0x4e, 0xb3, 0xea,
-	0x0d, 0x6d, 0xee, 0x27, 0xe0, 0x63, 0x44, 0x98, 0x0b, 0x28, 0x5f, 0xf2, 0xf6, 0x7a, 0x89, 0x25,
-	0xfb, 0x16, 0xdc, 0x2c, 0x51, 0xa2, 0x94, 0xfe, 0xa9, 0x81, 0xae, 0x5a, 0x33, 0x10, 0xdd, 0x09,
-	0x42, 0xbf, 0x04, 0x5d, 0x5e, 0x84, 0x13, 0x24, 0x04, 0xe6, 0x02, 0x09, 0x42, 0xa3, 0x5c, 0xef,
-	0x4e, 0xb5, 0x5e, 0x59, 0x75, 0x7f, 0x48, 0x71, 0xd7, 0xe2, 0xb1, 0x1d, 0xde, 0x6e, 0x55, 0x34,
-	0x64, 0x6b, 0xfc, 0x6a, 0x29, 0x95, 0xf6, 0x3b, 0x60, 0xbe, 0xb9, 0xab, 0xac, 0xfd, 0xa1, 0xc1,
-	0x0d, 0xd5, 0xa9, 0x2e, 0x22, 0x51, 0x8f, 0x44, 0x93, 0x1a, 0xf1, 0x10, 0x96, 0x18, 0x8e, 0x29,
-	0x13, 0xb9, 0xa9, 0xed, 0x6a, 0x53, 0xaa, 0xa6, 0x2b, 0x19, 0x6e, 0xce, 0x6c, 0xdf, 0xaf, 0x70,
-	0x62, 0x8d, 0x5f, 0xad, 0x51, 0x51, 0xf6, 0x6d, 0xa8, 0x57, 0xa4, 0x94, 0xa7, 0xe7, 0x1a, 0x5c,
-	0x3f, 0xe2, 0xc1, 0x17, 0xb1, 0x8f, 0x04, 0x3e, 0x46, 0x0c, 0x85, 0x5c, 0xbf, 0x0f, 0xcb, 0xa8,
-	0x2f, 0xba, 0x94, 0x11, 0x31, 0x48, 0xed, 0x1c, 0x18, 0x7f, 0x3f, 0xdf, 0xdd, 0xc8, 0x46, 0xec,
-	0xbe, 0xef, 0x33, 0xcc, 0xf9, 0x63, 0xc1, 0x48, 0x14, 0xb8, 0x43, 0xa8, 0xfe, 0x29, 0x2c, 0xc6,
-	0xb2, 0x82, 0x9c, 0x96, 0x2b, 0x7b, 0x8d, 0x2b, 0xfa, 0x27, 0x71, 0x07, 0xf3, 0xc9, 0xa4, 0x70,
-	0x33, 0x56, 0x7b, 0x3b, 0xb1, 0x37, 0xac, 0x97, 0x38, 0xdc, 0x1c, 0x3a, 0x2c, 0x4a, 0xb4, 0xb7,
-	0x64, 0x27, 0x8a, 0x5b, 0xb9, 0xa3, 0xbd, 0x9f, 0x97, 0x60, 0xee, 0x88, 0x07, 0xfa, 0x0f, 0x1a,
-	0xac, 0x97, 0x4d, 0xfb, 0xf7, 0xab, 0x55, 0x95, 0xcf, 0x50, 0xf3, 0xe3, 0x59, 0x19, 0xb9, 0x96,
-	0xa2, 0x86, 0x91, 0x91, 0x3b, 0x95, 0x86, 0x22, 0x63, 0x3a, 0x0d, 0x65, 0x83, 0x50, 0xff, 0x45,
-	0x83, 0xb7, 0xcb, 0xa7, 0xe0, 0xde, 0xe4, 0x9a, 0xe3, 0x1c, 0xb3, 0x3d, 0x3b, 0x47, 0x29, 0x19,
-	0xc0, 0xda, 0x70, 0x6c, 0x64, 0x59, 0x7d, 0x77, 0x72, 0xc1, 0xc2, 0x98, 0x31, 0xef, 0xcd, 0x04,
-	0x57, 0x47, 0xf7, 0xe1, 0xfa, 0xf8, 0x44, 0x7a, 0x6f, 0x8a, 0x27, 0xaa, 0xd0, 0xe6, 0x87, 0xb3,
-	0xa0, 0xd5, 0xb1, 0x3f, 0x69, 0xb0, 0x51, 0x3a, 0x2e, 0x5a, 0x53, 0x3c, 0xc6, 0x51, 0x8a, 0xf9,
-	0x60, 0x66, 0x8a, 0x92, 0xd1, 0x83, 0xd5, 0x91, 0x17, 0x7c, 0xfb, 0xca, 0x52, 0x45, 0xa8, 0xd9,
-	0x9a, 0x1a, 0x9a, 0x9f, 0x66, 0x2e, 0x7c, 0x7f, 0x79, 0xb6, 0xa3, 0x1d, 0x7c, 0xfd, 0xe2, 0xdc,
-	0xd2, 0x5e, 0x9e, 0x5b, 0xda, 0xbf, 0xe7, 0x96, 0xf6, 0xdb, 0x85, 0x55, 0x7b, 0x79, 0x61, 0xd5,
-	0xfe, 0xb9, 0xb0, 0x6a, 0x5f, 0x1d, 0x06, 0x44, 0x74, 0xfb, 0x9d, 0xa6, 0x47, 0x43, 0xe7, 0xf3,
-	0xbc, 0xfa, 0x21, 0xea, 0x70, 0x47, 0x9d, 0xb5, 0xeb, 0x51, 0x86, 0x8b, 0x61, 0xe2, 0xcc, 0x09,
-	0xa9, 0xdf, 0xef, 0x61, 0x9e, 0x7f, 0x2e, 0x8a, 0x41, 0x8c, 0x79, 0x67, 0x51, 0x7e, 0xdf, 0x7d,
-	0xf0, 0x7f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xd5, 0x9a, 0xd6, 0x88, 0xa2, 0x0a, 0x00, 0x00,
+	// 1101 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x57, 0xbf, 0x6f, 0xdb, 0xc6,
+	0x17, 0x17, 0xfd, 0x2b, 0xf1, 0xb3, 0xbf, 0xc9, 0xd7, 0xb4, 0x6b, 0xd3, 0x4c, 0x22, 0x29, 0x72,
+	0x5a, 0xd8, 0x46, 0x2c, 0x46, 0x6e, 0xe3, 0x3a, 0x2a, 0x10, 0xc0, 0x4e, 0x5a, 0x20, 0x85, 0x8d,
+	0x1a, 0x4c, 0x8a, 0x22, 0x5d, 0x8c, 0x13, 0x79, 0xa1, 0xd8, 0x88, 0x3c, 0x96, 0x77, 0x12, 0xaa,
+	0xad, 0x2d, 0x0a, 0x34, 0xe8, 0x54, 0xa0, 0x7f, 0x40, 0xb3, 0x75, 0x2b, 0x3c, 0x64, 0xea, 0xd8,
+	0x29, 0x43, 0x87, 0xa0, 0x53, 0x91, 0x21, 0x28, 0xec, 0xc1, 0xfd, 0x17, 0xba, 0x15, 0x3c, 0x92,
+	0x27, 0x4a, 0x14, 0x2d, 0xc9, 0x8b, 0x7d, 0xef, 0xde, 0x7b, 0x9f, 0xfb, 0x7c, 0xde, 0xfd, 0x78,
+	0x22, 0x5c, 0xb7, 0xdd, 0x2f, 0xb0, 0xc1, 0xec, 0x16, 0xd6, 0x88, 0x8f, 0x8c, 0x06, 0xd6, 0x5a,
+	0x95, 0x1a, 0x66, 0xa8, 0xa2, 0xb1, 0xaf, 0xca, 0x9e, 0x4f, 0x18, 0x91, 0x15, 0x11, 0x52, 0x0e,
+	0x43, 0xca, 0x51, 0x88, 0xba, 0x60, 0x11, 0x8b, 0xf0, 0x20, 0x2d, 0x18, 0x85, 0xf1, 0xea, 0xdb,
+	0x99, 0x90, 0x51, 0x7a, 0x18, 0xb6, 0x64, 0x10, 0xea, 0x10, 0xaa, 0x39, 0xd4, 0xd2, 0x5a, 0x95,
+	0xe0, 0x5f, 0xe4, 0x58, 0x0e, 0x1d, 0x87, 0x21, 0x70, 0x68, 0x44, 0xae, 0x39, 0xe4, 0xd8, 0x2e,
+	0xd1, 0xf8, 0xdf, 0x70, 0xaa, 0xf4, 0x5a, 0x82, 0xc5, 0x7d, 0x6a, 0xe9, 0xb8, 0x81, 0xda, 0x07,
+	0x3e, 0x69, 0xd9, 0x26, 0xf6, 0x0f, 0x7c, 0xdb, 0xc0, 0x54, 0x5e, 0x84, 0x29, 0x8a, 0x5d, 0x13,
+	0xfb, 0x8a, 0x54, 0x94, 0x56, 0xa7, 0xf5, 0xc8, 0x92, 0x55, 0xb8, 0xe8, 0x45, 0x91, 0xca, 0x18,
+	0xf7, 0x08, 0x5b, 0x56, 0xe0, 0x02, 0x6d, 0x3b, 0x35, 0xd2, 0xa0, 0xca, 0x78, 0x71, 0x7c, 0x75,
+	0x5a, 0x8f, 0x4d, 0xf9, 0x03, 0x98, 0xf2, 0x38, 0xae, 0x32, 0x11, 0x38, 0x76, 0x57, 0x5e, 0xbe,
+	0x29, 0xe4, 0x5e, 0xbf, 0x29, 0x5c, 0x09, 0x19, 0x52, 0xf3, 0x69, 0xd9, 0x26, 0x9a, 0x83, 0x58,
+	0xbd, 0xbc, 0x87, 0x2d, 0x64, 0xb4, 0xef, 0x63, 0x43, 0x8f, 0x52, 0xaa, 0xb7, 0x9f, 0x3d, 0x2f,
+	0xe4, 0xfe, 0x79, 0x5e, 0xc8, 0x7d, 0x7b, 0x7a, 0xb4, 0x1e, 0xf1, 0xf8, 0xe1, 0xf4, 0x68, 0xfd,
+	0x5a, 0x54, 0xa1, 0xfe, 0x0a, 0x4a, 0x45, 0xc8, 0xf7, 0xf7, 0xe8, 0x98, 0x7a, 0xc4, 0xa5, 0xb8,
+	0xf4, 0x47, 0x97, 0x7c, 0xdb, 0xc0, 0x1f, 0x61, 0x6c, 0xf2, 0x41, 0xa6, 0x7c, 0x19, 0x26, 0x6a,
+	0x88, 0x62, 0x65, 0x8c, 0xeb, 0xe3, 0x63, 0x79, 0x01, 0x26, 0xbf, 0x6c, 0x12, 0x86, 0x23, 0xd1,
+	0xa1, 0x21, 0xdf, 0x81, 0x49, 0xce, 0x7f, 0x14, 0xc5, 0x61, 0xc6, 0x08, 0x82, 0x93, 0x9c, 0xbb,
+	0x05, 0x27, 0x3d, 0x42, 0xf0, 0x2f, 0x12, 0x28, 0x71, 0xc8, 0x3d, 0x62, 0xbb, 0x01, 0xfd, 0x7d,
+	0x4c, 0x29, 0xb2, 0xce, 0xde, 0x71, 0x27, 0x8a, 0xe1, 0xb2, 0x67, 0x75, 0x61, 0xcb, 0x79, 0x00,
+	0x6a, 0x5b, 0x2e, 0x62, 0x4d, 0x1f, 0x87, 0x9b, 0x3e, 0xab, 0x27, 0x66, 0xaa, 0xef, 0x67, 0x28,
+	0x29, 0xf4, 0x28, 0xe9, 0x25, 0x53, 0x2a, 0x41, 0x31, 0xcb, 0x27, 0xd4, 0x3c, 0x93, 0x60, 0x3e,
+	0x0e, 0x7a, 0xc8, 0x88, 0xff, 0x74, 0xc0, 0xd1, 0xbd, 0x0f, 0x33, 0x88, 0x52, 0xcc, 0x0e, 0x3d,
+	0x64, 0xfb, 0xa1, 0x96, 0x99, 0xcd, 0x95, 0x72, 0xd6, 0x0d, 0x2d, 0xef, 0x04, 0xc1, 0x07, 0xc8,
+	0xf6, 0x75, 0x40, 0xf1, 0x90, 0x56, 0xe7, 0xfb, 0x48, 0x2a, 0x5d, 0x83, 0x2b, 0x7d, 0x98, 0x08,
+	0xa6, 0xbf, 0x49, 0x20, 0x8b, 0xad, 0x69, 0xb3, 0xfa, 0x00, 0xa2, 0x8f, 0x41, 0xe6, 0x07, 0xe1,
+	0x10, 0x31, 0x86, 0x29, 0x43, 0xcc, 0x26, 0x6e, 0xcc, 0x77, 0x3d, 0x9b, 0x2f, 0x47, 0xdd, 0xe9,
+	0xa4, 0xe8, 0x73, 0x5e, 0xcf, 0x0c, 0xad, 0x56, 0x32, 0x36, 0x64, 0xb9, 0xf7, 0x68, 0x09, 0x96,
+	0xa5, 0xab, 0xa0, 0xa6, 0x67, 0x85, 0xb4, 0x5f, 0x25, 0x58, 0x12, 0x3b, 0x55, 0x47, 0xb6, 0xdb,
+	0xb0, 0xdd, 0x41, 0x1b, 0x71, 0x0f, 0x2e, 0xf8, 0xd8, 0x23, 0x3e, 0x8b, 0x45, 0xad, 0x65, 0x8b,
+	0x12, 0x98, 0x3a, 0xcf, 0xd0, 0xe3, 0xcc, 0xea, 0x56, 0x86, 0x92, 0x7c, 0xef, 0xd1, 0xea, 0x26,
+	0x55, 0xba, 0x0e, 0x85, 0x0c, 0x97, 0xd0, 0xf4, 0x42, 0x82, 0xcb, 0xfb, 0xd4, 0xfa, 0xd4, 0x33,
+	0x11, 0xc3, 0x07, 0xc8, 0x47, 0x0e, 0x95, 0xb7, 0x60, 0x1a, 0x35, 0x59, 0x9d, 0xf8, 0x36, 0x6b,
+	0x87, 0x72, 0x76, 0x95, 0x3f, 0x5f, 0x6c, 0x2c, 0x44, 0x4f, 0xec, 0x8e, 0x69, 0xfa, 0x98, 0xd2,
+	0x87, 0xcc, 0xb7, 0x5d, 0x4b, 0xef, 0x84, 0xca, 0x77, 0x61, 0xca, 0xe3, 0x08, 0xfc, 0xb5, 0x9c,
+	0xd9, 0x2c, 0x9e, 0xb1, 0x7f, 0x3c, 0x6e, 0x77, 0x22, 0x78, 0x29, 0xf4, 0x28, 0xab, 0xba, 0x16,
+	0xc8, 0xeb, 0xe0, 0x05, 0x0a, 0x17, 0x3b, 0x0a, 0x93, 0x14, 0x4b, 0xcb, 0xb0, 0xd4, 0x33, 0x25,
+	0x14, 0xfd, 0x3e, 0x06, 0x57, 0x3b, 0xbe, 0x40, 0xed, 0xc7, 0x4d, 0xc7, 0x7b, 0x54, 0xf7, 0x31,
+	0xad, 0x93, 0x86, 0x79, 0x7e, 0x79, 0x8f, 0xe1, 0x52, 0x70, 0xa3, 0x98, 0x40, 0x8a, 0x76, 0xf4,
+	0xe6, 0x80, 0x63, 0xda, 0xb5, 0x7c, 0x24, 0xf9, 0x7f, 0x14, 0xb3, 0x04, 0xa5, 0xcf, 0x60, 0xce,
+	0xc7, 0x0e, 0x69, 0xe1, 0x24, 0xfa, 0x38, 0x47, 0xbf, 0x91, 0x8d, 0xfe, 0x09, 0x37, 0x1f, 0xb8,
+	0x4f, 0x48, 0x84, 0xfa, 0xff, 0x10, 0xa4, 0x03, 0x5c, 0xdd, 0x4a, 0x97, 0x74, 0x25, 0x55, 0xd2,
+	0x74, 0x8d, 0x4a, 0xef, 0xc0, 0x8d, 0xb3, 0xfc, 0xa2, 0xd8, 0xff, 0xc6, 0x6d, 0x85, 0x92, 0x46,
+	0x0b, 0x1f, 0x60, 0xd7, 0xb4, 0x5d, 0x2b, 0x6c, 0x2b, 0xe7, 0x2d, 0xf3, 0x87, 0x30, 0x13, 0x32,
+	0x3c, 0x64, 0x6d, 0x0f, 0xf3, 0xa3, 0x74, 0x69, 0x70, 0x15, 0x1e, 0xb5, 0x3d, 0xac, 0x03, 0x11,
+	0x63, 0x7e, 0x21, 0x79, 0x47, 0x56, 0xc6, 0xa3, 0x0b, 0xc9, 0xad, 0x60, 0x1e, 0x19, 0x06, 0xf6,
+	0x98, 0x32, 0x51, 0x94, 0x56, 0x2f, 0xea, 0x91, 0x55, 0xbd, 0x95, 0xae, 0x54, 0x57, 0x0f, 0x4a,
+	0x09, 0x14, 0x3d, 0x28, 0xe5, 0x89, 0xab, 0xb3, 0xf9, 0xf3, 0x34, 0x8c, 0xef, 0x53, 0x4b, 0xfe,
+	0x46, 0x82, 0xf9, 0x7e, 0x3f, 0x3c, 0x6e, 0x65, 0xab, 0xea, 0xdf, 0xce, 0xd5, 0xed, 0x51, 0x33,
+	0x62, 0x2e, 0x49, 0x0e, 0x5d, 0xdd, 0x7f, 0x28, 0x0e, 0xc9, 0x0c, 0x75, 0x7b, 0xd4, 0x0c, 0xc1,
+	0xe1, 0x7b, 0x09, 0xde, 0xea, 0xdf, 0x90, 0x37, 0x07, 0x63, 0xf6, 0xe6, 0xa8, 0xd5, 0xd1, 0x73,
+	0x04, 0x93, 0x36, 0xcc, 0x75, 0x3a, 0x58, 0xe4, 0x95, 0x37, 0x06, 0x03, 0x26, 0x3a, 0x9e, 0x7a,
+	0x7b, 0xa4, 0x70, 0xb1, 0x74, 0x13, 0x2e, 0xf7, 0x36, 0xc7, 0x9b, 0x43, 0x54, 0x54, 0x44, 0xab,
+	0xef, 0x8d, 0x12, 0x2d, 0x96, 0xfd, 0x4e, 0x82, 0x85, 0xbe, 0x9d, 0xab, 0x32, 0x44, 0x19, 0xbb,
+	0x53, 0xd4, 0x3b, 0x23, 0xa7, 0x08, 0x1a, 0x0d, 0x98, 0xed, 0xea, 0x35, 0x6b, 0x67, 0x42, 0x25,
+	0x43, 0xd5, 0xca, 0xd0, 0xa1, 0x62, 0xb5, 0x9f, 0x24, 0x58, 0x3e, 0xa3, 0x11, 0x0c, 0x03, 0x98,
+	0xce, 0x53, 0xef, 0x9e, 0x2f, 0xaf, 0xe7, 0x2a, 0xa6, 0x5f, 0xcc, 0x41, 0x57, 0x31, 0x95, 0xa1,
+	0x6e, 0x8f, 0x9a, 0x11, 0x73, 0x50, 0x27, 0xbf, 0x3e, 0x3d, 0x5a, 0x97, 0x76, 0x9f, 0xbc, 0x3c,
+	0xce, 0x4b, 0xaf, 0x8e, 0xf3, 0xd2, 0xdf, 0xc7, 0x79, 0xe9, 0xc7, 0x93, 0x7c, 0xee, 0xd5, 0x49,
+	0x3e, 0xf7, 0xd7, 0x49, 0x3e, 0xf7, 0xf9, 0x9e, 0x65, 0xb3, 0x7a, 0xb3, 0x56, 0x36, 0x88, 0xa3,
+	0x3d, 0x88, 0x17, 0xd9, 0x43, 0x35, 0xaa, 0x89, 0x25, 0x37, 0x0c, 0xe2, 0xe3, 0xa4, 0x19, 0xec,
+	0xb9, 0xe6, 0x10, 0xb3, 0xd9, 0xc0, 0x34, 0xfe, 0xa6, 0x0b, 0x1e, 0x71, 0x5a, 0x9b, 0xe2, 0x1f,
+	0x61, 0xef, 0xfe, 0x37, 0x00, 0x3a, 0x99, 0x11, 0x36, 0x47, 0x0e, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -698,6 +921,12 @@ type MsgClient interface {
 	RelayChainlinkPrices(ctx context.Context, in *MsgRelayChainlinkPrices, opts ...grpc.CallOption) (*MsgRelayChainlinkPricesResponse, error)
 	//  UpdateParams enables updating oracle module's params via governance
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// UpdatePriceJumpThresholds sets or removes circuit breaker thresholds via
+	// governance
+	UpdatePriceJumpThresholds(ctx context.Context, in *MsgUpdatePriceJumpThresholds, opts ...grpc.CallOption) (*MsgUpdatePriceJumpThresholdsResponse, error)
+	// ResolvePendingPrice accepts or rejects a price held back by the circuit
+	// breaker via governance
+	ResolvePendingPrice(ctx context.Context, in *MsgResolvePendingPrice, opts ...grpc.CallOption) (*MsgResolvePendingPriceResponse, error)
 }
 
 type msgClient struct {
@@ -771,6 +1000,24 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) UpdatePriceJumpThresholds(ctx context.Context, in *MsgUpdatePriceJumpThresholds, opts ...grpc.CallOption) (*MsgUpdatePriceJumpThresholdsResponse, error) {
+	out := new(MsgUpdatePriceJumpThresholdsResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Msg/UpdatePriceJumpThresholds", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResolvePendingPrice(ctx context.Context, in *MsgResolvePendingPrice, opts ...grpc.CallOption) (*MsgResolvePendingPriceResponse, error) {
+	out := new(MsgResolvePendingPriceResponse)
+	err := c.cc.Invoke(ctx, "/injective.oracle.v1beta1.Msg/ResolvePendingPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// RelayProviderPrice defines a method for relaying a price for a
@@ -792,6 +1039,12 @@ type MsgServer interface {
 	RelayChainlinkPrices(context.Context, *MsgRelayChainlinkPrices) (*MsgRelayChainlinkPricesResponse, error)
 	//  UpdateParams enables updating oracle module's params via governance
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// UpdatePriceJumpThresholds sets or removes circuit breaker thresholds via
+	// governance
+	UpdatePriceJumpThresholds(context.Context, *MsgUpdatePriceJumpThresholds) (*MsgUpdatePriceJumpThresholdsResponse, error)
+	// ResolvePendingPrice accepts or rejects a price held back by the circuit
+	// breaker via governance
+	ResolvePendingPrice(context.Context, *MsgResolvePendingPrice) (*MsgResolvePendingPriceResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -819,6 +1072,12 @@ func (*UnimplementedMsgServer) RelayChainlinkPrices(ctx context.Context, req *Ms
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) UpdatePriceJumpThresholds(ctx context.Context, req *MsgUpdatePriceJumpThresholds) (*MsgUpdatePriceJumpThresholdsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdatePriceJumpThresholds not implemented")
+}
+func (*UnimplementedMsgServer) ResolvePendingPrice(ctx context.Context, req *MsgResolvePendingPrice) (*MsgResolvePendingPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResolvePendingPrice not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -950,6 +1209,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_UpdatePriceJumpThresholds_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdatePriceJumpThresholds)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdatePriceJumpThresholds(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Msg/UpdatePriceJumpThresholds",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdatePriceJumpThresholds(ctx, req.(*MsgUpdatePriceJumpThresholds))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResolvePendingPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResolvePendingPrice)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResolvePendingPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.oracle.v1beta1.Msg/ResolvePendingPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResolvePendingPrice(ctx, req.(*MsgResolvePendingPrice))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.oracle.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -982,6 +1277,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "UpdatePriceJumpThresholds",
+			Handler:    _Msg_UpdatePriceJumpThresholds_Handler,
+		},
+		{
+			MethodName: "ResolvePendingPrice",
+			Handler:    _Msg_ResolvePendingPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/oracle/v1beta1/tx.proto",
@@ -1490,57 +1793,213 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdatePriceJumpThresholds) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgRelayProviderPrices) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdatePriceJumpThresholds) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceJumpThresholds) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Provider)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.Symbols) > 0 {
-		for _, s := range m.Symbols {
-			l = len(s)
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.RemoveThresholds) > 0 {
+		for iNdEx := len(m.RemoveThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RemoveThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
 		}
 	}
-	if len(m.Prices) > 0 {
-		for _, e := range m.Prices {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	if len(m.SetThresholds) > 0 {
+		for iNdEx := len(m.SetThresholds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SetThresholds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
 		}
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRelayProviderPricesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdatePriceJumpThresholdsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdatePriceJumpThresholdsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdatePriceJumpThresholdsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRelayPriceFeedPrice) Size() (n int) {
-	if m == nil {
+func (m *MsgResolvePendingPrice) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolvePendingPrice) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolvePendingPrice) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Accept {
+		i--
+		if m.Accept {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResolvePendingPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResolvePendingPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResolvePendingPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgRelayProviderPrices) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Symbols) > 0 {
+		for _, s := range m.Symbols {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.Prices) > 0 {
+		for _, e := range m.Prices {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRelayProviderPricesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRelayPriceFeedPrice) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -1721,6 +2180,72 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgUpdatePriceJumpThresholds) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.SetThresholds) > 0 {
+		for _, e := range m.SetThresholds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RemoveThresholds) > 0 {
+		for _, e := range m.RemoveThresholds {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdatePriceJumpThresholdsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResolvePendingPrice) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovTx(uint64(m.OracleType))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Accept {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgResolvePendingPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2047,14 +2572,378 @@ func (m *MsgRelayPriceFeedPrice) Unmarshal(dAtA []byte) error {
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = append(m.Base, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = append(m.Quote, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.LegacyDec
+			m.Price = append(m.Price, v)
+			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRelayPriceFeedPriceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelayPriceFeedPriceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelayPriceFeedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRelayCoinbaseMessages) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelayCoinbaseMessages: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelayCoinbaseMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Messages = append(m.Messages, make([]byte, postIndex-iNdEx))
+			copy(m.Messages[len(m.Messages)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
+			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRelayCoinbaseMessagesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelayCoinbaseMessagesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelayCoinbaseMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRelayStorkPrices) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Base = append(m.Base, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		case 3:
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRelayStorkPrices: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRelayStorkPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2082,13 +2971,13 @@ func (m *MsgRelayPriceFeedPrice) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Quote = append(m.Quote, string(dAtA[iNdEx:postIndex]))
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AssetPairs", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2098,25 +2987,23 @@ func (m *MsgRelayPriceFeedPrice) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			var v cosmossdk_io_math.LegacyDec
-			m.Price = append(m.Price, v)
-			if err := m.Price[len(m.Price)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.AssetPairs = append(m.AssetPairs, &AssetPair{})
+			if err := m.AssetPairs[len(m.AssetPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2141,7 +3028,7 @@ func (m *MsgRelayPriceFeedPrice) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPriceFeedPriceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayStorkPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2164,10 +3051,10 @@ func (m *MsgRelayPriceFeedPriceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPriceFeedPriceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayStorkPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPriceFeedPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayStorkPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2191,7 +3078,7 @@ func (m *MsgRelayPriceFeedPriceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayCoinbaseMessages) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayPythPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2214,10 +3101,10 @@ func (m *MsgRelayCoinbaseMessages) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayCoinbaseMessages: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayPythPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayCoinbaseMessages: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayPythPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2254,9 +3141,9 @@ func (m *MsgRelayCoinbaseMessages) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Messages", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceAttestations", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2266,55 +3153,25 @@ func (m *MsgRelayCoinbaseMessages) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Messages = append(m.Messages, make([]byte, postIndex-iNdEx))
-			copy(m.Messages[len(m.Messages)-1], dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signatures", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.PriceAttestations = append(m.PriceAttestations, &PriceAttestation{})
+			if err := m.PriceAttestations[len(m.PriceAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Signatures = append(m.Signatures, make([]byte, postIndex-iNdEx))
-			copy(m.Signatures[len(m.Signatures)-1], dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2337,7 +3194,7 @@ func (m *MsgRelayCoinbaseMessages) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayCoinbaseMessagesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayPythPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2360,10 +3217,10 @@ func (m *MsgRelayCoinbaseMessagesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayCoinbaseMessagesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayPythPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayCoinbaseMessagesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayPythPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2387,7 +3244,7 @@ func (m *MsgRelayCoinbaseMessagesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayStorkPrices) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayChainlinkPrices) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2410,10 +3267,10 @@ func (m *MsgRelayStorkPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayStorkPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayChainlinkPrices: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayStorkPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayChainlinkPrices: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2450,7 +3307,7 @@ func (m *MsgRelayStorkPrices) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AssetPairs", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2477,8 +3334,8 @@ func (m *MsgRelayStorkPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AssetPairs = append(m.AssetPairs, &AssetPair{})
-			if err := m.AssetPairs[len(m.AssetPairs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Reports = append(m.Reports, &ChainlinkReport{})
+			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2503,7 +3360,7 @@ func (m *MsgRelayStorkPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayStorkPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRelayChainlinkPricesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2526,10 +3383,10 @@ func (m *MsgRelayStorkPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayStorkPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRelayChainlinkPricesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayStorkPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRelayChainlinkPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2553,7 +3410,7 @@ func (m *MsgRelayStorkPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPythPrices) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2576,15 +3433,15 @@ func (m *MsgRelayPythPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPythPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPythPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2612,11 +3469,11 @@ func (m *MsgRelayPythPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PriceAttestations", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2643,8 +3500,7 @@ func (m *MsgRelayPythPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PriceAttestations = append(m.PriceAttestations, &PriceAttestation{})
-			if err := m.PriceAttestations[len(m.PriceAttestations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2669,7 +3525,7 @@ func (m *MsgRelayPythPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayPythPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2692,10 +3548,10 @@ func (m *MsgRelayPythPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayPythPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayPythPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2719,7 +3575,7 @@ func (m *MsgRelayPythPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayChainlinkPrices) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceJumpThresholds) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2742,15 +3598,15 @@ func (m *MsgRelayChainlinkPrices) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayChainlinkPrices: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceJumpThresholds: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayChainlinkPrices: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceJumpThresholds: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2778,11 +3634,11 @@ func (m *MsgRelayChainlinkPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reports", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SetThresholds", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2809,8 +3665,42 @@ func (m *MsgRelayChainlinkPrices) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reports = append(m.Reports, &ChainlinkReport{})
-			if err := m.Reports[len(m.Reports)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.SetThresholds = append(m.SetThresholds, PriceJumpThreshold{})
+			if err := m.SetThresholds[len(m.SetThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RemoveThresholds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RemoveThresholds = append(m.RemoveThresholds, OracleInfo{})
+			if err := m.RemoveThresholds[len(m.RemoveThresholds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -2835,7 +3725,7 @@ func (m *MsgRelayChainlinkPrices) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRelayChainlinkPricesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdatePriceJumpThresholdsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2858,10 +3748,10 @@ func (m *MsgRelayChainlinkPricesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRelayChainlinkPricesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdatePriceJumpThresholdsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRelayChainlinkPricesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdatePriceJumpThresholdsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2885,7 +3775,7 @@ func (m *MsgRelayChainlinkPricesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgResolvePendingPrice) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2908,10 +3798,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolvePendingPrice: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolvePendingPrice: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2947,10 +3837,29 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2960,25 +3869,44 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Accept", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Accept = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3000,7 +3928,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgResolvePendingPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3023,10 +3951,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgResolvePendingPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgResolvePendingPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
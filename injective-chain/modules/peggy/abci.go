@@ -44,10 +44,12 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 	h.slashing(ctx, params)
 	h.attestationTally(ctx)
 	h.cleanupTimedOutBatches(ctx)
+	h.cleanupTimedOutLogicCalls(ctx)
 	h.createValsets(ctx)
 	h.pruneValsets(ctx, params)
 	h.pruneAttestations(ctx)
 	h.refreshRateLimits(ctx)
+	h.processQueuedDeposits(ctx)
 }
 
 func (h *BlockHandler) createValsets(ctx sdk.Context) {
@@ -115,6 +117,7 @@ func (h *BlockHandler) slashing(ctx sdk.Context, params *types.Params) {
 
 	h.valsetSlashing(ctx, params)
 	h.batchSlashing(ctx, params)
+	h.logicCallSlashing(ctx, params)
 
 	// See https://github.com/Gravity-Bridge/Gravity-Bridge/blob/main/spec/slashing-spec.md#gravslash-05-failure-to-submit-eth-oracle-claims---intentionally-not-implemented
 	// if params.ClaimSlashingEnabled {
@@ -198,6 +201,14 @@ func (h *BlockHandler) cleanupTimedOutBatches(ctx sdk.Context) {
 	}
 }
 
+func (h *BlockHandler) cleanupTimedOutLogicCalls(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
+	defer doneFn()
+
+	ethereumHeight := h.k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
+	h.k.CancelTimedOutLogicCalls(ctx, ethereumHeight)
+}
+
 func (h *BlockHandler) valsetSlashing(ctx sdk.Context, params *types.Params) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
 	defer doneFn()
@@ -394,6 +405,73 @@ func (h *BlockHandler) batchSlashing(ctx sdk.Context, params *types.Params) {
 	}
 }
 
+func (h *BlockHandler) logicCallSlashing(ctx sdk.Context, params *types.Params) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
+	defer doneFn()
+
+	// a zero window disables logic call slashing
+	if params.SignedLogicCallsWindow == 0 {
+		return
+	}
+
+	// we can't slash anyone if this window has not yet passed
+	if uint64(ctx.BlockHeight()) <= params.SignedLogicCallsWindow {
+		return
+	}
+
+	maxHeight := uint64(ctx.BlockHeight()) - params.SignedLogicCallsWindow
+	unslashedLogicCalls := h.k.GetUnslashedLogicCalls(ctx, maxHeight)
+	if len(unslashedLogicCalls) == 0 {
+		return
+	}
+
+	// SLASH BONDED VALIDTORS who didn't attest logic calls
+	currentBondedSet, _ := h.k.StakingKeeper.GetBondedValidatorsByPower(ctx)
+
+	for _, call := range unslashedLogicCalls {
+		confirms := h.k.GetLogicCallConfirms(ctx, call.InvalidationId, call.InvalidationNonce)
+		for i := range currentBondedSet {
+			// Don't slash validators who joined after the logic call is created
+			consAddr, _ := currentBondedSet[i].GetConsAddr()
+
+			valSigningInfo, err := h.k.SlashingKeeper.GetValidatorSigningInfo(ctx, consAddr)
+			if exist := err == nil; exist && valSigningInfo.StartHeight > int64(call.Block) {
+				continue
+			}
+
+			found := false
+			for _, confirm := range confirms {
+				orchestratorAcc, _ := sdk.AccAddressFromBech32(confirm.Orchestrator)
+				delegatedOperator, delegatedFound := h.k.GetOrchestratorValidator(ctx, orchestratorAcc)
+				operatorAddr, _ := sdk.ValAddressFromBech32(currentBondedSet[i].GetOperator())
+				if delegatedFound && delegatedOperator.Equals(operatorAddr) {
+					found = true
+					break
+				}
+			}
+
+			if !found && !currentBondedSet[i].IsJailed() {
+				consPower := currentBondedSet[i].ConsensusPower(h.k.StakingKeeper.PowerReduction(ctx))
+
+				_ = h.k.StakingKeeper.Jail(ctx, consAddr)
+				_ = ctx.EventManager().EmitTypedEvent(&types.EventValidatorJailed{
+					Power:            consPower,
+					Reason:           types.JailReason_MissingLogicCallConfirm,
+					ConsensusAddress: currentBondedSet[i].String(),
+					OperatorAddress:  currentBondedSet[i].OperatorAddress,
+					Moniker:          currentBondedSet[i].GetMoniker(),
+				})
+
+				// the bonded set is fetched once for all logic calls, so keep it in sync to not jail twice
+				currentBondedSet[i].Jailed = true
+			}
+		}
+
+		// then we set the latest slashed logic call block
+		h.k.SetLastSlashedLogicCallBlock(ctx, call.Block)
+	}
+}
+
 func (h *BlockHandler) pruneValsets(ctx sdk.Context, params *types.Params) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
 	defer doneFn()
@@ -423,7 +501,7 @@ func (h *BlockHandler) refreshRateLimits(ctx sdk.Context) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
 	defer doneFn()
 
-	// prune outdated records for each rate limit
+	// prune outdated records and record the last known price for each rate limit
 	currentBlock := uint64(ctx.BlockHeight())
 	for _, rateLimit := range h.k.GetRateLimits(ctx) {
 		transfersWithinSlidingWindow := make([]*types.BridgeTransfer, 0, len(rateLimit.Transfers))
@@ -434,7 +512,16 @@ func (h *BlockHandler) refreshRateLimits(ctx sdk.Context) {
 		}
 
 		rateLimit.Transfers = transfersWithinSlidingWindow
+		h.k.RecordLastKnownPrice(ctx, rateLimit)
 
 		h.k.SetRateLimit(ctx, rateLimit)
 	}
 }
+
+// processQueuedDeposits credits the deposits that fit in the inbound rate limits after the sliding windows were refreshed
+func (h *BlockHandler) processQueuedDeposits(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
+	defer doneFn()
+
+	h.k.ProcessQueuedDeposits(ctx)
+}
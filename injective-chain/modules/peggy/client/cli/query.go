@@ -24,6 +24,11 @@ func GetQueryCmd() *cobra.Command {
 		CmdGetValsetConfirm(),
 		CmdGetPendingValsetRequest(),
 		CmdGetPendingOutgoingTXBatchRequest(),
+		CmdGetOutgoingLogicCalls(),
+		CmdGetPendingLogicCallRequest(),
+		CmdGetLogicCallConfirms(),
+		CmdGetRateLimits(),
+		CmdGetQueuedDeposits(),
 		// CmdGetAllOutgoingTXBatchRequest(),
 		// CmdGetOutgoingTXBatchByNonceRequest(),
 		// CmdGetAllAttestationsRequest(),
@@ -207,6 +212,133 @@ func CmdGetPendingOutgoingTXBatchRequest() *cobra.Command {
 	return cmd
 }
 
+func CmdGetOutgoingLogicCalls() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logic-calls",
+		Short: "Get the pending outgoing logic calls",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.OutgoingLogicCalls(cmd.Context(), &types.QueryOutgoingLogicCallsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetPendingLogicCallRequest() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-logic-call [bech32 orchestrator address]",
+		Short: "Get the oldest outgoing logic call which has not been signed by a particular orchestrator",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryLastPendingLogicCallByAddrRequest{
+				Address: args[0],
+			}
+
+			res, err := queryClient.LastPendingLogicCallByAddr(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetLogicCallConfirms() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "logic-call-confirms [hex invalidation id] [invalidation nonce]",
+		Short: "Get the validator signatures over an outgoing logic call",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			invalidationNonce, err := strconv.ParseUint(args[1], 10, 64)
+			if err != nil {
+				return err
+			}
+
+			req := &types.QueryLogicCallConfirmsRequest{
+				InvalidationId:    args[0],
+				InvalidationNonce: invalidationNonce,
+			}
+
+			res, err := queryClient.LogicCallConfirms(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetRateLimits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "rate-limits",
+		Short: "Get all rate limits together with the transfers in their sliding windows",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.RateLimits(cmd.Context(), &types.QueryRateLimitsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func CmdGetQueuedDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "queued-deposits [token contract] [receiver]",
+		Short: "Get the deposits held back by inbound rate limits, optionally filtered by token and receiver",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryQueuedDepositsRequest{}
+			if len(args) > 0 {
+				req.TokenContract = args[0]
+			}
+			if len(args) > 1 {
+				req.Receiver = args[1]
+			}
+
+			res, err := queryClient.QueuedDeposits(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 // QueryPeggyParams queries peggy module params info
 func QueryPeggyParams() *cobra.Command {
 	cmd := &cobra.Command{
@@ -23,6 +23,21 @@ import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
 )
 
+const (
+	FlagDepositLimitUSD             = "deposit-limit-usd"
+	FlagPerRecipientDepositLimitUSD = "per-recipient-deposit-limit-usd"
+	FlagOracleType                  = "oracle-type"
+	FlagOracleBase                  = "oracle-base"
+	FlagOracleQuote                 = "oracle-quote"
+	FlagOracleProvider              = "oracle-provider"
+	FlagFallbackOracleType          = "fallback-oracle-type"
+	FlagFallbackOracleBase          = "fallback-oracle-base"
+	FlagFallbackOracleQuote         = "fallback-oracle-quote"
+	FlagFallbackOracleProvider      = "fallback-oracle-provider"
+	FlagMissingPriceBehaviour       = "missing-price-behaviour"
+	FlagMaxPriceAge                 = "max-price-age"
+)
+
 func GetTxCmd(storeKey string) *cobra.Command {
 	peggyTxCmd := &cobra.Command{
 		Use:                        types.ModuleName,
@@ -38,11 +53,14 @@ func GetTxCmd(storeKey string) *cobra.Command {
 		CmdSetOrchestratorAddress(),
 		GetUnsafeTestingCmd(),
 		NewCancelSendToEth(),
+		NewBumpSendToEthFee(),
 		BlacklistEthereumAddresses(),
 		RevokeBlacklistEthereumAddresses(),
 		CmdCreateRateLimit(),
 		CmdUpdateRateLimit(),
 		CmdRemoveRateLimit(),
+		CmdReleaseQueuedDeposits(),
+		CmdRequestLogicCall(),
 	}...)
 
 	return peggyTxCmd
@@ -176,6 +194,41 @@ func NewCancelSendToEth() *cobra.Command {
 	return cmd
 }
 
+func NewBumpSendToEthFee() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "bump-send-to-eth-fee [id] [fee-increase]",
+		Short: "Adds to the bridge fee of a send to eth that has not been batched yet",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+			cosmosAddr := cliCtx.GetFromAddress()
+
+			id, err := strconv.ParseUint(args[0], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid id")
+			}
+
+			feeIncrease, err := sdk.ParseCoinNormalized(args[1])
+			if err != nil {
+				return errors.Wrap(err, "fee increase")
+			}
+
+			// Make the message
+			msg := types.NewMsgBumpSendToEthFee(cosmosAddr, id, feeIncrease)
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+			// Send it
+			return tx.GenerateOrBroadcastTxCLI(cliCtx, cmd.Flags(), msg)
+		},
+	}
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
 func CmdRequestBatch() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "build-batch [denom]",
@@ -325,14 +378,30 @@ func CmdCreateRateLimit() *cobra.Command {
 				return errors.Wrap(err, "invalid notional limit")
 			}
 
+			depositLimitUSD, perRecipientDepositLimitUSD, err := parseDepositLimitFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			priceSource, fallbackPriceSource, missingPriceBehaviour, maxPriceAge, err := parsePriceSourceFlags(cmd)
+			if err != nil {
+				return err
+			}
+
 			// Make the message
 			msg := &types.MsgCreateRateLimit{
-				Authority:       clientCtx.GetFromAddress().String(),
-				TokenAddress:    tokenContract.Hex(),
-				TokenDecimals:   uint32(tokenDecimals),
-				TokenPriceId:    tokenPriceID,
-				RateLimitUsd:    rateLimitUSD,
-				RateLimitWindow: rateLimitWindow,
+				Authority:                   clientCtx.GetFromAddress().String(),
+				TokenAddress:                tokenContract.Hex(),
+				TokenDecimals:               uint32(tokenDecimals),
+				TokenPriceId:                tokenPriceID,
+				RateLimitUsd:                rateLimitUSD,
+				RateLimitWindow:             rateLimitWindow,
+				DepositLimitUsd:             depositLimitUSD,
+				PerRecipientDepositLimitUsd: perRecipientDepositLimitUSD,
+				PriceSource:                 priceSource,
+				FallbackPriceSource:         fallbackPriceSource,
+				MissingPriceBehaviour:       missingPriceBehaviour,
+				MaxPriceAge:                 maxPriceAge,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -343,6 +412,8 @@ func CmdCreateRateLimit() *cobra.Command {
 		},
 	}
 
+	addPriceSourceFlags(cmd)
+	addDepositLimitFlags(cmd)
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -351,7 +422,10 @@ func CmdUpdateRateLimit() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "update-rate-limit [token-contract] [new-token-price-id] [new-rate-limit-usd] [new-rate-limit-window]",
 		Short: "Updates fields of a particular rate limit (admin/gov only)",
-		Args:  cobra.ExactArgs(4),
+		Long: `Updates fields of a particular rate limit (admin/gov only).
+The token price id is left unchanged if empty, and so are the deposit limits if their flags are not set.
+The price sources, missing price behaviour and max price age are replaced together when any of their flags is set.`,
+		Args: cobra.ExactArgs(4),
 		RunE: func(cmd *cobra.Command, args []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -375,13 +449,37 @@ func CmdUpdateRateLimit() *cobra.Command {
 				return errors.Wrap(err, "invalid rate limit window")
 			}
 
+			newDepositLimitUSD, newPerRecipientDepositLimitUSD, err := parseDepositLimitFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			// the price config is only replaced when one of its flags is set
+			var newPriceConfig *types.RateLimitPriceConfig
+			if priceSourceFlagsChanged(cmd) {
+				priceSource, fallbackPriceSource, missingPriceBehaviour, maxPriceAge, err := parsePriceSourceFlags(cmd)
+				if err != nil {
+					return err
+				}
+
+				newPriceConfig = &types.RateLimitPriceConfig{
+					PriceSource:           priceSource,
+					FallbackPriceSource:   fallbackPriceSource,
+					MissingPriceBehaviour: missingPriceBehaviour,
+					MaxPriceAge:           maxPriceAge,
+				}
+			}
+
 			// Make the message
 			msg := &types.MsgUpdateRateLimit{
-				Authority:          clientCtx.GetFromAddress().String(),
-				TokenAddress:       tokenContract.Hex(),
-				NewTokenPriceId:    newTokenPriceID,
-				NewRateLimitUsd:    newRateLimitUSD,
-				NewRateLimitWindow: newRateLimitWindow,
+				Authority:                      clientCtx.GetFromAddress().String(),
+				TokenAddress:                   tokenContract.Hex(),
+				NewTokenPriceId:                newTokenPriceID,
+				NewRateLimitUsd:                newRateLimitUSD,
+				NewRateLimitWindow:             newRateLimitWindow,
+				NewDepositLimitUsd:             newDepositLimitUSD,
+				NewPerRecipientDepositLimitUsd: newPerRecipientDepositLimitUSD,
+				NewPriceConfig:                 newPriceConfig,
 			}
 
 			if err := msg.ValidateBasic(); err != nil {
@@ -392,6 +490,9 @@ func CmdUpdateRateLimit() *cobra.Command {
 		},
 	}
 
+	addPriceSourceFlags(cmd)
+	cmd.Flags().String(FlagDepositLimitUSD, "", "new notional USD limit on deposits within the window (0 disables inbound limits, unchanged if empty)")
+	cmd.Flags().String(FlagPerRecipientDepositLimitUSD, "", "new notional USD limit on deposits of a single recipient within the window (0 disables it, unchanged if empty)")
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -429,3 +530,206 @@ func CmdRemoveRateLimit() *cobra.Command {
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func CmdReleaseQueuedDeposits() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "release-queued-deposits [deposit-id]...",
+		Short: "Releases deposits held back by inbound rate limits regardless of the remaining limits (admin/gov only)",
+		Args:  cobra.MinimumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			depositIDs := make([]uint64, 0, len(args))
+			for _, arg := range args {
+				id, err := strconv.ParseUint(arg, 10, 64)
+				if err != nil {
+					return errors.Wrapf(err, "invalid deposit id: %s", arg)
+				}
+				depositIDs = append(depositIDs, id)
+			}
+
+			msg := &types.MsgReleaseQueuedDeposits{
+				Authority:  clientCtx.GetFromAddress().String(),
+				DepositIds: depositIDs,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+func addDepositLimitFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagDepositLimitUSD, "0", "notional USD limit on deposits within the window (0 disables inbound limits)")
+	cmd.Flags().String(FlagPerRecipientDepositLimitUSD, "0", "notional USD limit on deposits of a single recipient within the window (0 disables it)")
+}
+
+func parseDepositLimitFlags(cmd *cobra.Command) (depositLimit, perRecipientLimit sdkmath.LegacyDec, err error) {
+	depositLimitStr, err := cmd.Flags().GetString(FlagDepositLimitUSD)
+	if err != nil {
+		return depositLimit, perRecipientLimit, err
+	}
+
+	perRecipientLimitStr, err := cmd.Flags().GetString(FlagPerRecipientDepositLimitUSD)
+	if err != nil {
+		return depositLimit, perRecipientLimit, err
+	}
+
+	// empty limits are left unset
+	if depositLimitStr != "" {
+		if depositLimit, err = sdkmath.LegacyNewDecFromStr(depositLimitStr); err != nil {
+			return depositLimit, perRecipientLimit, errors.Wrap(err, "invalid deposit limit")
+		}
+	}
+
+	if perRecipientLimitStr != "" {
+		if perRecipientLimit, err = sdkmath.LegacyNewDecFromStr(perRecipientLimitStr); err != nil {
+			return depositLimit, perRecipientLimit, errors.Wrap(err, "invalid per recipient deposit limit")
+		}
+	}
+
+	return depositLimit, perRecipientLimit, nil
+}
+
+func addPriceSourceFlags(cmd *cobra.Command) {
+	cmd.Flags().String(FlagOracleType, "", "oracle type of the token price (pyth, stork, chainlinkdatastreams, provider, pricefeed, coinbase). The Pyth price of the token price id is used if empty")
+	cmd.Flags().String(FlagOracleBase, "", "base symbol (or price/feed id) of the token price")
+	cmd.Flags().String(FlagOracleQuote, "USD", "quote symbol of the token price")
+	cmd.Flags().String(FlagOracleProvider, "", "provider name of the token price (provider oracles only)")
+	cmd.Flags().String(FlagFallbackOracleType, "", "oracle type of the fallback token price")
+	cmd.Flags().String(FlagFallbackOracleBase, "", "base symbol (or price/feed id) of the fallback token price")
+	cmd.Flags().String(FlagFallbackOracleQuote, "USD", "quote symbol of the fallback token price")
+	cmd.Flags().String(FlagFallbackOracleProvider, "", "provider name of the fallback token price (provider oracles only)")
+	cmd.Flags().String(FlagMissingPriceBehaviour, "block", "behaviour when the token price cannot be resolved (block, allow, last-known-price)")
+	cmd.Flags().Uint64(FlagMaxPriceAge, 0, "maximum age in seconds of the last known price (last-known-price only)")
+}
+
+func priceSourceFlagsChanged(cmd *cobra.Command) bool {
+	for _, name := range []string{
+		FlagOracleType, FlagOracleBase, FlagOracleQuote, FlagOracleProvider,
+		FlagFallbackOracleType, FlagFallbackOracleBase, FlagFallbackOracleQuote, FlagFallbackOracleProvider,
+		FlagMissingPriceBehaviour, FlagMaxPriceAge,
+	} {
+		if cmd.Flags().Changed(name) {
+			return true
+		}
+	}
+
+	return false
+}
+
+func parsePriceSourceFlags(cmd *cobra.Command) (
+	priceSource, fallbackPriceSource *types.PriceSource,
+	behaviour types.MissingPriceBehaviour,
+	maxPriceAge uint64,
+	err error,
+) {
+	flags := cmd.Flags()
+	getString := func(name string) string {
+		// flags are registered by addPriceSourceFlags
+		value, _ := flags.GetString(name)
+		return value
+	}
+
+	if oracleType := getString(FlagOracleType); oracleType != "" {
+		priceSource = &types.PriceSource{
+			OracleType: oracleType,
+			Base:       getString(FlagOracleBase),
+			Quote:      getString(FlagOracleQuote),
+			Provider:   getString(FlagOracleProvider),
+		}
+	}
+
+	if oracleType := getString(FlagFallbackOracleType); oracleType != "" {
+		fallbackPriceSource = &types.PriceSource{
+			OracleType: oracleType,
+			Base:       getString(FlagFallbackOracleBase),
+			Quote:      getString(FlagFallbackOracleQuote),
+			Provider:   getString(FlagFallbackOracleProvider),
+		}
+	}
+
+	switch behaviourStr := getString(FlagMissingPriceBehaviour); behaviourStr {
+	case "block":
+		behaviour = types.MissingPriceBehaviour_BlockOnMissingPrice
+	case "allow":
+		behaviour = types.MissingPriceBehaviour_AllowOnMissingPrice
+	case "last-known-price":
+		behaviour = types.MissingPriceBehaviour_UseLastKnownPrice
+	default:
+		return nil, nil, behaviour, 0, fmt.Errorf("invalid missing price behaviour: %s", behaviourStr)
+	}
+
+	if maxPriceAge, err = flags.GetUint64(FlagMaxPriceAge); err != nil {
+		return nil, nil, behaviour, 0, err
+	}
+
+	return priceSource, fallbackPriceSource, behaviour, maxPriceAge, nil
+}
+
+func CmdRequestLogicCall() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "request-logic-call [logic-contract] [hex-payload] [eth-timeout-height] [hex-invalidation-id] [invalidation-nonce]",
+		Short: "Queue an arbitrary contract call to be executed on Ethereum by the Peggy contract (admin/gov/whitelisted requesters only)",
+		Example: "injectived tx peggy request-logic-call 0xdAC17F958D2ee523a2206206994597C13D831ec7 0xa9059cbb... 21000000 " +
+			"0x0000000000000000000000000000000000000000000000000000000000000001 1",
+		Args: cobra.ExactArgs(5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			if !gethcommon.IsHexAddress(args[0]) {
+				return fmt.Errorf("invalid logic contract address: %s", args[0])
+			}
+
+			payload, err := hex.DecodeString(strings.TrimPrefix(args[1], "0x"))
+			if err != nil {
+				return errors.Wrap(err, "invalid payload")
+			}
+
+			timeout, err := strconv.ParseUint(args[2], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid timeout")
+			}
+
+			invalidationID, err := types.DecodeInvalidationID(args[3])
+			if err != nil {
+				return err
+			}
+
+			invalidationNonce, err := strconv.ParseUint(args[4], 10, 64)
+			if err != nil {
+				return errors.Wrap(err, "invalid invalidation nonce")
+			}
+
+			msg := &types.MsgRequestLogicCall{
+				Sender:               clientCtx.GetFromAddress().String(),
+				LogicContractAddress: gethcommon.HexToAddress(args[0]).Hex(),
+				Payload:              payload,
+				Timeout:              timeout,
+				InvalidationId:       invalidationID,
+				InvalidationNonce:    invalidationNonce,
+			}
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cliflags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -2,13 +2,10 @@ package keeper
 
 import (
 	"fmt"
-	"math/big"
 
 	"cosmossdk.io/errors"
-	sdkmath "cosmossdk.io/math"
 	"github.com/InjectiveLabs/metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
-	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
 	"github.com/ethereum/go-ethereum/common"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
@@ -64,83 +61,24 @@ func (h AttestationHandler) handleDepositClaim(ctx sdk.Context, claim *types.Msg
 		return errors.Wrap(err, "failed to parse ethereum sender in claim")
 	}
 
-	// Check if coin is Cosmos-originated asset and get denom
 	tokenContract := common.HexToAddress(claim.TokenContract)
-	isCosmosOriginated, denom := h.keeper.ERC20ToDenomLookup(ctx, tokenContract)
-	depositCoin := sdk.NewCoin(denom, claim.Amount)
 
-	rateLimit := h.keeper.GetRateLimit(ctx, tokenContract)
-	withRateLimit := rateLimit != nil
-
-	if !isCosmosOriginated {
-		var currentMintAmount sdkmath.Int
-
-		// Check if supply overflows with claim amount
-		currentSupply := h.bankKeeper.GetSupply(ctx, denom)
-		newSupply := new(big.Int).Add(currentSupply.Amount.BigInt(), claim.Amount.BigInt())
-		if newSupply.BitLen() > 256 {
-			metrics.ReportFuncError(h.svcTags)
-			return errors.Wrap(types.ErrSupplyOverflow, "invalid coin supply")
-		}
-
-		// check absolute limit
-		if withRateLimit {
-			currentMintAmount = h.keeper.GetMintAmountERC20(ctx, tokenContract)
-			absoluteLimit := rateLimit.AbsoluteMintLimit
-			if remaining := absoluteLimit.Sub(currentMintAmount); remaining.LT(claim.Amount) {
-				return ErrAbsoluteMintLimitOverflow
-			}
-		}
-
-		if err := h.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(depositCoin)); err != nil {
-			metrics.ReportFuncError(h.svcTags)
-			return errors.Wrapf(err, "failed to mint deposit coin: %s", depositCoin.String())
+	// deposits exceeding the inbound rate limit are queued and credited once the sliding window rolls.
+	// Queued deposits are processed in order, so new deposits wait behind existing ones
+	if rateLimit := h.keeper.GetRateLimit(ctx, tokenContract); rateLimit != nil {
+		shouldQueue := h.keeper.hasQueuedDeposits(ctx, tokenContract)
+		if !shouldQueue {
+			err := h.keeper.CheckDepositRateLimit(ctx, rateLimit, claim.CosmosReceiver, claim.Amount)
+			shouldQueue = errors.IsOf(err, ErrDepositRateLimitOverflow)
 		}
 
-		// track new mint
-		if withRateLimit {
-			newAmount := currentMintAmount.Add(claim.Amount)
-			h.keeper.SetMintAmountERC20(ctx, tokenContract, newAmount)
+		if shouldQueue {
+			h.keeper.QueueDeposit(ctx, claim)
+			return nil
 		}
 	}
 
-	receiver, err := sdk.AccAddressFromBech32(claim.CosmosReceiver)
-	if err != nil {
-		// #1: receiver address is malformed, deposit into community pool
-		if err := h.keeper.SendToCommunityPool(ctx, sdk.NewCoins(depositCoin)); err != nil {
-			return errors.Wrap(err, "failed to send deposit to community pool")
-		}
-
-		receiver = h.keeper.accountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName).GetAddress()
-		_ = ctx.EventManager().EmitTypedEvent(types.NewEventDepositReceived(*sender, receiver, depositCoin))
-		return nil
-	}
-
-	if h.keeper.IsOnBlacklist(ctx, *sender) {
-		// #2: sender is blacklister, we deposit to segregated wallet
-		receiver = sdk.MustAccAddressFromBech32(h.keeper.GetParams(ctx).SegregatedWalletAddress)
-		if err := h.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(depositCoin)); err != nil {
-			return errors.Wrap(err, "failed to send sanctioned deposit to segregated wallet")
-		}
-
-		_ = ctx.EventManager().EmitTypedEvent(types.NewEventDepositReceived(*sender, receiver, depositCoin))
-		return nil
-	}
-
-	// #3: address appears valid, attempt to send minted/locked coins to receiver
-	if err := h.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(depositCoin)); err != nil {
-		// last attempt (default behavior)
-		if err := h.keeper.SendToCommunityPool(ctx, sdk.NewCoins(depositCoin)); err != nil {
-			return errors.Wrap(err, "failed to send deposit to community pool")
-		}
-
-		receiver = h.keeper.accountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName).GetAddress()
-	}
-
-	h.keeper.TrackTokenInflow(ctx, tokenContract, depositCoin.Amount)
-
-	_ = ctx.EventManager().EmitTypedEvent(types.NewEventDepositReceived(*sender, receiver, depositCoin))
-	return nil
+	return h.keeper.creditDeposit(ctx, *sender, tokenContract, claim.Amount, claim.CosmosReceiver)
 }
 
 func (h AttestationHandler) handleWithdrawClaim(ctx sdk.Context, claim *types.MsgWithdrawClaim) {
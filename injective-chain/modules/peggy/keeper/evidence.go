@@ -32,6 +32,8 @@ func (k *Keeper) CheckBadSignatureEvidence(
 		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
 	case *types.Valset:
 		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
+	case *types.OutgoingLogicCall:
+		return k.checkBadSignatureEvidenceInternal(ctx, subject, msg.Signature)
 
 	default:
 		metrics.ReportFuncError(k.svcTags)
@@ -47,6 +47,14 @@ func NormalizeGenesis(data *types.GenesisState) {
 	for _, token := range data.Erc20ToDenoms {
 		token.Erc20 = common.HexToAddress(token.Erc20).Hex()
 	}
+
+	for _, call := range data.LogicCalls {
+		call.LogicContractAddress = common.HexToAddress(call.LogicContractAddress).Hex()
+	}
+
+	for _, logicCallConfirm := range data.LogicCallConfirms {
+		logicCallConfirm.EthSigner = common.HexToAddress(logicCallConfirm.EthSigner).Hex()
+	}
 }
 
 // InitGenesis starts a chain from a genesis state
@@ -153,6 +161,20 @@ func InitGenesis(ctx sdk.Context, k Keeper, data *types.GenesisState) {
 	for _, limit := range data.RateLimits {
 		k.SetRateLimit(ctx, limit)
 	}
+
+	for _, call := range data.LogicCalls {
+		k.SetOutgoingLogicCall(ctx, call)
+	}
+
+	for _, logicCallConfirm := range data.LogicCallConfirms {
+		k.SetLogicCallConfirm(ctx, logicCallConfirm)
+	}
+
+	for _, deposit := range data.QueuedDeposits {
+		k.SetQueuedDeposit(ctx, deposit)
+	}
+
+	k.SetLastQueuedDepositID(ctx, data.LastQueuedDepositId)
 }
 
 // ExportGenesis exports all the state needed to restart the chain
@@ -173,6 +195,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		unbatchedTransfers              = k.GetPoolTransactions(ctx)
 		ethereumBlacklistAddresses      = k.GetAllEthereumBlacklistAddresses(ctx)
 		rateLimits                      = k.GetRateLimits(ctx)
+		logicCalls                      = k.GetOutgoingLogicCalls(ctx)
+		logicCallConfirms               = k.GetAllLogicCallConfirms(ctx)
+		queuedDeposits                  = k.GetQueuedDeposits(ctx)
 	)
 
 	// export valset confirmations from state
@@ -225,5 +250,9 @@ func ExportGenesis(ctx sdk.Context, k Keeper) types.GenesisState {
 		LastObservedValset:         *lastObservedValset,
 		EthereumBlacklist:          ethereumBlacklistAddresses,
 		RateLimits:                 rateLimits,
+		LogicCalls:                 logicCalls,
+		LogicCallConfirms:          logicCallConfirms,
+		QueuedDeposits:             queuedDeposits,
+		LastQueuedDepositId:        k.GetLastQueuedDepositID(ctx),
 	}
 }
@@ -479,3 +479,89 @@ func (k *Keeper) MissingPeggoNonces(
 
 	return &types.MissingNoncesResponse{OperatorAddresses: res}, nil
 }
+
+// OutgoingLogicCalls queries the pending outgoing logic calls of the peggy module
+func (k *Keeper) OutgoingLogicCalls(c context.Context, _ *types.QueryOutgoingLogicCallsRequest) (*types.QueryOutgoingLogicCallsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.grpcTags)
+	defer doneFn()
+
+	calls := make([]*types.OutgoingLogicCall, 0)
+	k.IterateOutgoingLogicCalls(sdk.UnwrapSDKContext(c), func(_ []byte, call *types.OutgoingLogicCall) bool {
+		calls = append(calls, call)
+		return len(calls) == MaxResults
+	})
+
+	return &types.QueryOutgoingLogicCallsResponse{Calls: calls}, nil
+}
+
+// LastPendingLogicCallByAddr queries the oldest logic call not yet signed by the given orchestrator
+func (k *Keeper) LastPendingLogicCallByAddr(c context.Context, req *types.QueryLastPendingLogicCallByAddrRequest) (*types.QueryLastPendingLogicCallByAddrResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.grpcTags)
+	defer doneFn()
+
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "address invalid")
+	}
+
+	call := k.GetLastPendingLogicCallByAddr(sdk.UnwrapSDKContext(c), addr)
+
+	return &types.QueryLastPendingLogicCallByAddrResponse{Call: call}, nil
+}
+
+// LogicCallConfirms queries the validator signatures over a logic call
+func (k *Keeper) LogicCallConfirms(c context.Context, req *types.QueryLogicCallConfirmsRequest) (*types.QueryLogicCallConfirmsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.grpcTags)
+	defer doneFn()
+
+	invalidationID, err := types.DecodeInvalidationID(req.InvalidationId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	confirms := make([]*types.MsgConfirmLogicCall, 0)
+	k.IterateLogicCallConfirms(sdk.UnwrapSDKContext(c), invalidationID, req.InvalidationNonce,
+		func(_ []byte, confirm *types.MsgConfirmLogicCall) (stop bool) {
+			confirms = append(confirms, confirm)
+			return false
+		})
+
+	return &types.QueryLogicCallConfirmsResponse{Confirms: confirms}, nil
+}
+
+func (k *Keeper) RateLimits(c context.Context, _ *types.QueryRateLimitsRequest) (*types.QueryRateLimitsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.grpcTags)
+	defer doneFn()
+
+	return &types.QueryRateLimitsResponse{RateLimits: k.GetRateLimits(sdk.UnwrapSDKContext(c))}, nil
+}
+
+func (k *Keeper) QueuedDeposits(c context.Context, req *types.QueryQueuedDepositsRequest) (*types.QueryQueuedDepositsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.grpcTags)
+	defer doneFn()
+
+	if req.TokenContract != "" {
+		if err := types.ValidateEthAddress(req.TokenContract); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+		}
+	}
+
+	deposits := make([]*types.QueuedDeposit, 0)
+	k.IterateQueuedDeposits(sdk.UnwrapSDKContext(c), func(deposit *types.QueuedDeposit) (stop bool) {
+		if req.TokenContract != "" && gethcommon.HexToAddress(req.TokenContract).Hex() != deposit.TokenContract {
+			return false
+		}
+
+		if req.Receiver != "" && req.Receiver != deposit.CosmosReceiver {
+			return false
+		}
+
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	return &types.QueryQueuedDepositsResponse{Deposits: deposits}, nil
+}
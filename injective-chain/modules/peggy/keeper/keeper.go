@@ -24,8 +24,17 @@ import (
 
 	exchangekeeper "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/keeper"
 	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 )
 
+// OracleKeeper defines the expected oracle keeper methods used to price rate limited tokens.
+// It is declared here rather than in types since the oracle types depend on the peggy types.
+type OracleKeeper interface {
+	GetPythPrice(ctx sdk.Context, base, quote string) *math.LegacyDec
+	GetPrice(ctx sdk.Context, oracletype oracletypes.OracleType, base, quote string) *math.LegacyDec
+	GetProviderPrice(ctx sdk.Context, provider, symbol string) *math.LegacyDec
+}
+
 // Keeper maintains the link to storage and exposes getter/setter methods for the various parts of the state machine
 type Keeper struct {
 	cdc      codec.Codec         // The wire codec for binary encoding/decoding.
@@ -36,7 +45,7 @@ type Keeper struct {
 	DistKeeper        distrkeeper.Keeper
 	SlashingKeeper    types.SlashingKeeper
 	exchangeMsgServer exchangetypes.MsgServer
-	OracleKeeper      types.OracleKeeper
+	OracleKeeper      OracleKeeper
 
 	AttestationHandler interface {
 		Handle(sdk.Context, types.EthereumClaim) error
@@ -59,7 +68,7 @@ func NewKeeper(
 	slashingKeeper types.SlashingKeeper,
 	distKeeper distrkeeper.Keeper,
 	exchangeKeeper *exchangekeeper.Keeper,
-	oracleKeeper types.OracleKeeper,
+	oracleKeeper OracleKeeper,
 	authority string,
 	accountKeeper keeper.AccountKeeper,
 ) Keeper {
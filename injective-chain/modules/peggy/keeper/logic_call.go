@@ -0,0 +1,367 @@
+package keeper
+
+import (
+	"bytes"
+	"sort"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+	"github.com/InjectiveLabs/metrics"
+)
+
+// AddLogicCall queues an outgoing logic call to be signed by the validators and relayed to Ethereum.
+// It is exported so that other modules (e.g. wasm bindings) can request calls directly.
+func (k *Keeper) AddLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	if err := types.ValidateInvalidationID(call.InvalidationId); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	ethereumHeight := k.GetLastObservedEthereumBlockHeight(ctx).EthereumBlockHeight
+	if call.Timeout <= ethereumHeight {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrTimeout, "logic call timeout %d must be greater than the last observed Ethereum height %d", call.Timeout, ethereumHeight)
+	}
+
+	var err error
+	k.IterateOutgoingLogicCallsByInvalidationID(ctx, call.InvalidationId, func(_ []byte, pending *types.OutgoingLogicCall) bool {
+		if pending.InvalidationNonce >= call.InvalidationNonce {
+			err = errors.Wrapf(types.ErrOutdated, "invalidation nonce must be greater than %d", pending.InvalidationNonce)
+			return true
+		}
+		return false
+	})
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return err
+	}
+
+	call.LogicContractAddress = common.HexToAddress(call.LogicContractAddress).Hex()
+	call.Block = uint64(ctx.BlockHeight())
+	k.SetOutgoingLogicCall(ctx, call)
+
+	// Get the checkpoint and store it as a legit past logic call
+	checkpoint := call.GetCheckpoint(k.GetPeggyID(ctx))
+	k.SetPastEthSignatureCheckpoint(ctx, checkpoint)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventOutgoingLogicCall{
+		Requester:            call.Requester,
+		LogicContractAddress: call.LogicContractAddress,
+		InvalidationId:       call.InvalidationId,
+		InvalidationNonce:    call.InvalidationNonce,
+		Timeout:              call.Timeout,
+	})
+
+	return nil
+}
+
+// SetOutgoingLogicCall stores an outgoing logic call
+func (k *Keeper) SetOutgoingLogicCall(ctx sdk.Context, call *types.OutgoingLogicCall) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetOutgoingLogicCallKey(call.InvalidationId, call.InvalidationNonce), k.cdc.MustMarshal(call))
+}
+
+// GetOutgoingLogicCall returns an outgoing logic call given its invalidation id and nonce
+func (k *Keeper) GetOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) *types.OutgoingLogicCall {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+	if bz == nil {
+		return nil
+	}
+
+	var call types.OutgoingLogicCall
+	k.cdc.MustUnmarshal(bz, &call)
+
+	return &call
+}
+
+// DeleteOutgoingLogicCall removes an outgoing logic call along with all of its confirmations
+func (k *Keeper) DeleteOutgoingLogicCall(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetOutgoingLogicCallKey(invalidationID, invalidationNonce))
+
+	var confirmKeys [][]byte
+	k.IterateLogicCallConfirms(ctx, invalidationID, invalidationNonce, func(key []byte, _ *types.MsgConfirmLogicCall) bool {
+		confirmKeys = append(confirmKeys, key)
+		return false
+	})
+
+	confirmStore := prefix.NewStore(store, types.LogicCallConfirmKey)
+	for _, key := range confirmKeys {
+		confirmStore.Delete(key)
+	}
+}
+
+// IterateOutgoingLogicCalls iterates through all outgoing logic calls ordered by invalidation id and nonce
+func (k *Keeper) IterateOutgoingLogicCalls(ctx sdk.Context, cb func(key []byte, call *types.OutgoingLogicCall) bool) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.iterateOutgoingLogicCallsByPrefix(ctx, nil, cb)
+}
+
+// IterateOutgoingLogicCallsByInvalidationID iterates through the outgoing logic calls sharing the invalidation id
+func (k *Keeper) IterateOutgoingLogicCallsByInvalidationID(
+	ctx sdk.Context,
+	invalidationID []byte,
+	cb func(key []byte, call *types.OutgoingLogicCall) bool,
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.iterateOutgoingLogicCallsByPrefix(ctx, invalidationID, cb)
+}
+
+func (k *Keeper) iterateOutgoingLogicCallsByPrefix(ctx sdk.Context, keyPrefix []byte, cb func(key []byte, call *types.OutgoingLogicCall) bool) {
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.OutgoingLogicCallKey)
+
+	var start, end []byte
+	if len(keyPrefix) > 0 {
+		start, end = PrefixRange(keyPrefix)
+	}
+
+	iter := prefixStore.Iterator(start, end)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var call types.OutgoingLogicCall
+		k.cdc.MustUnmarshal(iter.Value(), &call)
+		// cb returns true to stop early
+		if cb(iter.Key(), &call) {
+			break
+		}
+	}
+}
+
+// GetOutgoingLogicCalls returns all outgoing logic calls
+func (k *Keeper) GetOutgoingLogicCalls(ctx sdk.Context) (out []*types.OutgoingLogicCall) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+		out = append(out, call)
+		return false
+	})
+
+	return
+}
+
+// CancelTimedOutLogicCalls removes the logic calls whose timeout is below the given Ethereum height
+func (k *Keeper) CancelTimedOutLogicCalls(ctx sdk.Context, ethereumHeight uint64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	for _, call := range k.GetOutgoingLogicCalls(ctx) {
+		if call.Timeout >= ethereumHeight {
+			continue
+		}
+
+		k.DeleteOutgoingLogicCall(ctx, call.InvalidationId, call.InvalidationNonce)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventLogicCallTimeout{
+			InvalidationId:    call.InvalidationId,
+			InvalidationNonce: call.InvalidationNonce,
+			Timeout:           call.Timeout,
+		})
+	}
+}
+
+// GetUnslashedLogicCalls returns the logic calls created after the last slashed logic call block
+// and before maxHeight, in ASC block order
+func (k *Keeper) GetUnslashedLogicCalls(ctx sdk.Context, maxHeight uint64) (out []*types.OutgoingLogicCall) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	lastSlashedLogicCallBlock := k.GetLastSlashedLogicCallBlock(ctx)
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+		if call.Block > lastSlashedLogicCallBlock && call.Block < maxHeight {
+			out = append(out, call)
+		}
+		return false
+	})
+
+	sort.SliceStable(out, func(i, j int) bool {
+		return out[i].Block < out[j].Block
+	})
+
+	return
+}
+
+// SetLastSlashedLogicCallBlock sets the latest slashed logic call block height
+func (k *Keeper) SetLastSlashedLogicCallBlock(ctx sdk.Context, blockHeight uint64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastSlashedLogicCallBlock, types.UInt64Bytes(blockHeight))
+}
+
+// GetLastSlashedLogicCallBlock returns the latest slashed logic call block
+func (k *Keeper) GetLastSlashedLogicCallBlock(ctx sdk.Context) uint64 {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	storedBytes := store.Get(types.LastSlashedLogicCallBlock)
+
+	if len(storedBytes) == 0 {
+		return 0
+	}
+
+	return types.UInt64FromBytes(storedBytes)
+}
+
+// GetLastPendingLogicCallByAddr returns the oldest logic call the given orchestrator has not signed yet
+func (k *Keeper) GetLastPendingLogicCallByAddr(ctx sdk.Context, orchestrator sdk.AccAddress) *types.OutgoingLogicCall {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	var pendingCall *types.OutgoingLogicCall
+	k.IterateOutgoingLogicCalls(ctx, func(_ []byte, call *types.OutgoingLogicCall) bool {
+		if k.GetLogicCallConfirm(ctx, call.InvalidationId, call.InvalidationNonce, orchestrator) != nil {
+			return false
+		}
+
+		if pendingCall == nil || call.Block < pendingCall.Block {
+			pendingCall = call
+		}
+		return false
+	})
+
+	return pendingCall
+}
+
+/////////////////////////////
+//   LOGIC CALL CONFIRMS   //
+/////////////////////////////
+
+// GetLogicCallConfirm returns a logic call confirmation given its invalidation id and nonce, and a validator address
+func (k *Keeper) GetLogicCallConfirm(
+	ctx sdk.Context,
+	invalidationID []byte,
+	invalidationNonce uint64,
+	validator sdk.AccAddress,
+) *types.MsgConfirmLogicCall {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	entity := store.Get(types.GetLogicCallConfirmKey(invalidationID, invalidationNonce, validator))
+	if entity == nil {
+		return nil
+	}
+
+	confirm := types.MsgConfirmLogicCall{}
+	k.cdc.MustUnmarshal(entity, &confirm)
+
+	return &confirm
+}
+
+// SetLogicCallConfirm sets a logic call confirmation by a validator
+func (k *Keeper) SetLogicCallConfirm(ctx sdk.Context, confirm *types.MsgConfirmLogicCall) []byte {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	confirm.EthSigner = common.HexToAddress(confirm.EthSigner).Hex()
+
+	invalidationID, err := types.DecodeInvalidationID(confirm.InvalidationId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		panic(err)
+	}
+
+	acc, err := sdk.AccAddressFromBech32(confirm.Orchestrator)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		panic(err)
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	key := types.GetLogicCallConfirmKey(invalidationID, confirm.InvalidationNonce, acc)
+	store.Set(key, k.cdc.MustMarshal(confirm))
+
+	return key
+}
+
+// IterateLogicCallConfirms iterates through all confirmations of a logic call
+func (k *Keeper) IterateLogicCallConfirms(
+	ctx sdk.Context,
+	invalidationID []byte,
+	invalidationNonce uint64,
+	cb func(k []byte, v *types.MsgConfirmLogicCall) (stop bool),
+) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LogicCallConfirmKey)
+	callPrefix := append(bytes.Clone(invalidationID), types.UInt64Bytes(invalidationNonce)...)
+	iter := prefixStore.Iterator(PrefixRange(callPrefix))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		confirm := types.MsgConfirmLogicCall{}
+		k.cdc.MustUnmarshal(iter.Value(), &confirm)
+
+		if cb(iter.Key(), &confirm) {
+			break
+		}
+	}
+}
+
+// GetLogicCallConfirms returns the confirmations of a logic call
+func (k *Keeper) GetLogicCallConfirms(ctx sdk.Context, invalidationID []byte, invalidationNonce uint64) (out []*types.MsgConfirmLogicCall) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	k.IterateLogicCallConfirms(ctx, invalidationID, invalidationNonce, func(_ []byte, confirm *types.MsgConfirmLogicCall) bool {
+		out = append(out, confirm)
+		return false
+	})
+
+	return
+}
+
+// GetAllLogicCallConfirms returns the confirmations of all logic calls
+func (k *Keeper) GetAllLogicCallConfirms(ctx sdk.Context) (out []*types.MsgConfirmLogicCall) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	prefixStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.LogicCallConfirmKey)
+	iter := prefixStore.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		confirm := types.MsgConfirmLogicCall{}
+		k.cdc.MustUnmarshal(iter.Value(), &confirm)
+		out = append(out, &confirm)
+	}
+
+	return
+}
+
+func (k *Keeper) isLogicCallRequester(ctx sdk.Context, addr string) bool {
+	for _, requester := range k.GetParams(ctx).LogicCallRequesters {
+		if requester == addr {
+			return true
+		}
+	}
+	return false
+}
@@ -536,6 +536,34 @@ func (k msgServer) CancelSendToEth(c context.Context, msg *types.MsgCancelSendTo
 	return &types.MsgCancelSendToEthResponse{}, nil
 }
 
+func (k msgServer) BumpSendToEthFee(c context.Context, msg *types.MsgBumpSendToEthFee) (*types.MsgBumpSendToEthFeeResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	sender, err := sdk.AccAddressFromBech32(msg.Sender)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	tx, err := k.BumpOutgoingTxFee(ctx, msg.TransactionId, sender, msg.FeeIncrease)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventBumpSendToEthFee{
+		OutgoingTxId: msg.TransactionId,
+		Sender:       msg.Sender,
+		FeeIncrease:  msg.FeeIncrease,
+		BridgeFee:    sdk.NewCoin(msg.FeeIncrease.Denom, tx.Erc20Fee.Amount),
+	})
+
+	return &types.MsgBumpSendToEthFeeResponse{}, nil
+}
+
 func (k msgServer) SubmitBadSignatureEvidence(c context.Context, msg *types.MsgSubmitBadSignatureEvidence) (*types.MsgSubmitBadSignatureEvidenceResponse, error) {
 	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
 	defer doneFn()
@@ -663,6 +691,19 @@ func (k msgServer) CreateRateLimit(
 		TokenPriceId:      msg.TokenPriceId,
 		TokenDecimals:     msg.TokenDecimals,
 		AbsoluteMintLimit: msg.AbsoluteMintLimit,
+
+		DepositLimitUsd:             msg.DepositLimitUsd,
+		PerRecipientDepositLimitUsd: msg.PerRecipientDepositLimitUsd,
+
+		PriceSource:           msg.PriceSource,
+		FallbackPriceSource:   msg.FallbackPriceSource,
+		MissingPriceBehaviour: msg.MissingPriceBehaviour,
+		MaxPriceAge:           msg.MaxPriceAge,
+	}
+
+	if err := k.validatePriceSources(ctx, rateLimit); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
 	}
 
 	k.SetRateLimit(ctx, rateLimit)
@@ -702,6 +743,34 @@ func (k msgServer) UpdateRateLimit(
 	rateLimit.RateLimitUsd = msg.NewRateLimitUsd
 	rateLimit.RateLimitWindow = msg.NewRateLimitWindow
 
+	// optional fields are only updated when set
+	if !msg.NewDepositLimitUsd.IsNil() {
+		rateLimit.DepositLimitUsd = msg.NewDepositLimitUsd
+	}
+
+	if !msg.NewPerRecipientDepositLimitUsd.IsNil() {
+		rateLimit.PerRecipientDepositLimitUsd = msg.NewPerRecipientDepositLimitUsd
+	}
+
+	if msg.NewTokenPriceId != "" {
+		rateLimit.TokenPriceId = msg.NewTokenPriceId
+	}
+
+	if config := msg.NewPriceConfig; config != nil {
+		rateLimit.PriceSource = config.PriceSource
+		rateLimit.FallbackPriceSource = config.FallbackPriceSource
+		rateLimit.MissingPriceBehaviour = config.MissingPriceBehaviour
+		rateLimit.MaxPriceAge = config.MaxPriceAge
+	}
+
+	// the price only has to resolve again when the way the token is priced changed
+	if msg.NewTokenPriceId != "" || msg.NewPriceConfig != nil {
+		if err := k.validatePriceSources(ctx, rateLimit); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, err
+		}
+	}
+
 	k.SetRateLimit(ctx, rateLimit)
 
 	return &types.MsgUpdateRateLimitResponse{}, nil
@@ -727,3 +796,156 @@ func (k msgServer) RemoveRateLimit(
 
 	return &types.MsgRemoveRateLimitResponse{}, nil
 }
+
+func (k msgServer) ReleaseQueuedDeposits(
+	c context.Context,
+	msg *types.MsgReleaseQueuedDeposits,
+) (*types.MsgReleaseQueuedDepositsResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if isAuthority := k.authority == msg.Authority || k.isAdmin(ctx, msg.Authority); !isAuthority {
+		return nil, errors.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"sender %s is not the valid authority or one of the Peggy module admins",
+			msg.Authority,
+		)
+	}
+
+	for _, id := range msg.DepositIds {
+		if err := k.ForceReleaseQueuedDeposit(ctx, id); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return nil, errors.Wrapf(err, "failed to release queued deposit %d", id)
+		}
+	}
+
+	return &types.MsgReleaseQueuedDepositsResponse{}, nil
+}
+
+func (k msgServer) RequestLogicCall(
+	c context.Context,
+	msg *types.MsgRequestLogicCall,
+) (*types.MsgRequestLogicCallResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	isRequester := k.authority == msg.Sender || k.isAdmin(ctx, msg.Sender) || k.isLogicCallRequester(ctx, msg.Sender)
+	if !isRequester {
+		return nil, errors.Wrapf(
+			govtypes.ErrInvalidSigner,
+			"sender %s is not the valid authority, one of the Peggy module admins or a logic call requester",
+			msg.Sender,
+		)
+	}
+
+	call := &types.OutgoingLogicCall{
+		LogicContractAddress: msg.LogicContractAddress,
+		Payload:              msg.Payload,
+		Timeout:              msg.Timeout,
+		InvalidationId:       msg.InvalidationId,
+		InvalidationNonce:    msg.InvalidationNonce,
+		Requester:            msg.Sender,
+	}
+
+	if err := k.AddLogicCall(ctx, call); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	return &types.MsgRequestLogicCallResponse{}, nil
+}
+
+func (k msgServer) ConfirmLogicCall(c context.Context, msg *types.MsgConfirmLogicCall) (*types.MsgConfirmLogicCallResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, k.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+
+	invalidationID, err := types.DecodeInvalidationID(msg.InvalidationId)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	// fetch the outgoing logic call given the invalidation id and nonce
+	call := k.GetOutgoingLogicCall(ctx, invalidationID, msg.InvalidationNonce)
+	if call == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalid, "couldn't find logic call")
+	}
+
+	peggyID := k.GetPeggyID(ctx)
+	checkpoint := call.GetCheckpoint(peggyID)
+
+	sigBytes, err := hex.DecodeString(msg.Signature)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalid, "signature decoding")
+	}
+
+	orchaddr := sdk.MustAccAddressFromBech32(msg.Orchestrator)
+	validator, found := k.GetOrchestratorValidator(ctx, orchaddr)
+	if !found {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrUnknown, "validator")
+	}
+
+	ethAddress, found := k.GetEthAddressByValidator(ctx, validator)
+	if !found {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrEmpty, "eth address not found")
+	}
+
+	// associated eth address must match provided
+	if badEthAddress := !bytes.Equal(common.HexToAddress(msg.EthSigner).Bytes(), ethAddress.Bytes()); badEthAddress {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalid,
+			"eth address does not match provided: got %s, want %s",
+			msg.EthSigner,
+			ethAddress.Hex(),
+		)
+	}
+
+	v, err := k.StakingKeeper.Validator(ctx, validator)
+	if err != nil || v == nil {
+		metrics.ReportFuncError(k.svcTags)
+		if err == nil {
+			err = types.ErrUnknown
+		}
+
+		return nil, errors.Wrap(err, "validator can't be retrieved")
+	}
+
+	if v.IsUnbonded() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrUnbondedValidator, "validator must be bonded to send logic call confirm")
+	}
+
+	if err := types.ValidateEthereumSignature(checkpoint, sigBytes, ethAddress); err != nil {
+		description := fmt.Sprintf(
+			"signature verification failed expected sig by %s with peggy-id %s with checkpoint %s found %s",
+			ethAddress, peggyID, checkpoint.Hex(), msg.Signature,
+		)
+
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrInvalid, description)
+	}
+
+	// check if we already have this confirm
+	if k.GetLogicCallConfirm(ctx, invalidationID, msg.InvalidationNonce, orchaddr) != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrDuplicate, "duplicate signature")
+	}
+	k.SetLogicCallConfirm(ctx, msg)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventConfirmLogicCall{
+		InvalidationId:      invalidationID,
+		InvalidationNonce:   msg.InvalidationNonce,
+		OrchestratorAddress: orchaddr.String(),
+	})
+
+	return &types.MsgConfirmLogicCallResponse{}, nil
+}
@@ -180,6 +180,92 @@ func (k *Keeper) RemoveFromOutgoingPoolAndRefund(ctx sdk.Context, txId uint64, s
 	return nil
 }
 
+// BumpOutgoingTxFee
+// - checks that the provided tx is still in the unbatched pool and belongs to the sender
+// - burns the fee increase
+// - moves the tx to the index of its new fee, keeping its position among txs with the same fee
+func (k *Keeper) BumpOutgoingTxFee(ctx sdk.Context, txID uint64, sender sdk.AccAddress, feeIncrease sdk.Coin) (*types.OutgoingTransferTx, error) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	tx, err := k.getPoolEntry(ctx, txID)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if tx.Sender != sender.String() {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalid, "Invalid sender address")
+	}
+
+	isCosmosOriginated, tokenContract, err := k.DenomToERC20Lookup(ctx, feeIncrease.Denom)
+	if err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, err
+	}
+
+	if tokenContract != common.HexToAddress(tx.Erc20Fee.Contract) {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalid, "fee increase denom %s does not match the withdrawn token", feeIncrease.Denom)
+	}
+
+	if isCosmosOriginated {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrap(types.ErrUnsupported, "withdrawing Injective-native tokens is disabled")
+	}
+
+	// delete this tx from the index of its current fee, which fails if the tx is in a batch
+	if err := k.removeFromUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, txID); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		return nil, errors.Wrapf(types.ErrInvalid, "txId %d not in unbatched index! Must be in a batch!", txID)
+	}
+
+	feeInVouchers := sdk.NewCoins(feeIncrease)
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, sender, types.ModuleName, feeInVouchers); err != nil {
+		return nil, err
+	}
+
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, feeInVouchers); err != nil {
+		metrics.ReportFuncError(k.svcTags)
+		panic(err)
+	}
+
+	tx.Erc20Fee = types.NewSDKIntERC20Token(tx.Erc20Fee.Amount.Add(feeIncrease.Amount), tokenContract)
+	if err := k.setPoolEntry(ctx, tx); err != nil {
+		return nil, err
+	}
+
+	k.insertIntoUnbatchedTXIndex(ctx, tokenContract, tx.Erc20Fee, txID)
+
+	return tx, nil
+}
+
+// insertIntoUnbatchedTXIndex adds the tx before any newer tx with the same fee
+func (k *Keeper) insertIntoUnbatchedTXIndex(ctx sdk.Context, tokenContract common.Address, fee *types.ERC20Token, txID uint64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	idxKey := types.GetFeeSecondIndexKey(tokenContract, fee)
+	var idSet types.IDSet
+	if store.Has(idxKey) {
+		bz := store.Get(idxKey)
+		k.cdc.MustUnmarshal(bz, &idSet)
+	}
+
+	pos := len(idSet.Ids)
+	for i, id := range idSet.Ids {
+		if id > txID {
+			pos = i
+			break
+		}
+	}
+
+	idSet.Ids = append(idSet.Ids[:pos], append([]uint64{txID}, idSet.Ids[pos:]...)...)
+	store.Set(idxKey, k.cdc.MustMarshal(&idSet))
+}
+
 // appendToUnbatchedTXIndex add at the end when tx with same fee exists
 func (k *Keeper) appendToUnbatchedTXIndex(ctx sdk.Context, tokenContract common.Address, fee *types.ERC20Token, txID uint64) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
@@ -0,0 +1,429 @@
+package keeper
+
+import (
+	"encoding/binary"
+	"fmt"
+	"math/big"
+
+	"cosmossdk.io/errors"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/InjectiveLabs/metrics"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	gethcommon "github.com/ethereum/go-ethereum/common"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+)
+
+// creditDeposit mints (or unlocks) the deposited tokens and sends them to the receiver of the deposit
+func (k *Keeper) creditDeposit(
+	ctx sdk.Context,
+	sender gethcommon.Address,
+	tokenContract gethcommon.Address,
+	amount sdkmath.Int,
+	cosmosReceiver string,
+) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	// Check if coin is Cosmos-originated asset and get denom
+	isCosmosOriginated, denom := k.ERC20ToDenomLookup(ctx, tokenContract)
+	depositCoin := sdk.NewCoin(denom, amount)
+
+	rateLimit := k.GetRateLimit(ctx, tokenContract)
+	withRateLimit := rateLimit != nil
+
+	if !isCosmosOriginated {
+		var currentMintAmount sdkmath.Int
+
+		// Check if supply overflows with claim amount
+		currentSupply := k.bankKeeper.GetSupply(ctx, denom)
+		newSupply := new(big.Int).Add(currentSupply.Amount.BigInt(), amount.BigInt())
+		if newSupply.BitLen() > 256 {
+			metrics.ReportFuncError(k.svcTags)
+			return errors.Wrap(types.ErrSupplyOverflow, "invalid coin supply")
+		}
+
+		// check absolute limit
+		if withRateLimit {
+			currentMintAmount = k.GetMintAmountERC20(ctx, tokenContract)
+			absoluteLimit := rateLimit.AbsoluteMintLimit
+			if remaining := absoluteLimit.Sub(currentMintAmount); remaining.LT(amount) {
+				return ErrAbsoluteMintLimitOverflow
+			}
+		}
+
+		if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, sdk.NewCoins(depositCoin)); err != nil {
+			metrics.ReportFuncError(k.svcTags)
+			return errors.Wrapf(err, "failed to mint deposit coin: %s", depositCoin.String())
+		}
+
+		// track new mint
+		if withRateLimit {
+			newAmount := currentMintAmount.Add(amount)
+			k.SetMintAmountERC20(ctx, tokenContract, newAmount)
+		}
+	}
+
+	receiver, err := sdk.AccAddressFromBech32(cosmosReceiver)
+	if err != nil {
+		// #1: receiver address is malformed, deposit into community pool
+		if err := k.SendToCommunityPool(ctx, sdk.NewCoins(depositCoin)); err != nil {
+			return errors.Wrap(err, "failed to send deposit to community pool")
+		}
+
+		receiver = k.accountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName).GetAddress()
+		_ = ctx.EventManager().EmitTypedEvent(types.NewEventDepositReceived(sender, receiver, depositCoin))
+		return nil
+	}
+
+	if k.IsOnBlacklist(ctx, sender) {
+		// #2: sender is blacklister, we deposit to segregated wallet
+		receiver = sdk.MustAccAddressFromBech32(k.GetParams(ctx).SegregatedWalletAddress)
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(depositCoin)); err != nil {
+			return errors.Wrap(err, "failed to send sanctioned deposit to segregated wallet")
+		}
+
+		_ = ctx.EventManager().EmitTypedEvent(types.NewEventDepositReceived(sender, receiver, depositCoin))
+		return nil
+	}
+
+	// #3: address appears valid, attempt to send minted/locked coins to receiver
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(depositCoin)); err != nil {
+		// last attempt (default behavior)
+		if err := k.SendToCommunityPool(ctx, sdk.NewCoins(depositCoin)); err != nil {
+			return errors.Wrap(err, "failed to send deposit to community pool")
+		}
+
+		receiver = k.accountKeeper.GetModuleAccount(ctx, distrtypes.ModuleName).GetAddress()
+	}
+
+	k.TrackTokenInflow(ctx, tokenContract, cosmosReceiver, amount)
+
+	_ = ctx.EventManager().EmitTypedEvent(types.NewEventDepositReceived(sender, receiver, depositCoin))
+	return nil
+}
+
+// QueueDeposit holds back a deposit that exceeds the inbound rate limit of its token
+func (k *Keeper) QueueDeposit(ctx sdk.Context, claim *types.MsgDepositClaim) *types.QueuedDeposit {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	id := k.GetLastQueuedDepositID(ctx) + 1
+	k.SetLastQueuedDepositID(ctx, id)
+
+	deposit := &types.QueuedDeposit{
+		Id:             id,
+		TokenContract:  gethcommon.HexToAddress(claim.TokenContract).Hex(),
+		Amount:         claim.Amount,
+		EthereumSender: claim.EthereumSender,
+		CosmosReceiver: claim.CosmosReceiver,
+		EventNonce:     claim.EventNonce,
+		QueuedAtBlock:  uint64(ctx.BlockHeight()),
+	}
+
+	k.SetQueuedDeposit(ctx, deposit)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventDepositQueued{
+		DepositId:      deposit.Id,
+		TokenContract:  deposit.TokenContract,
+		Amount:         deposit.Amount,
+		EthereumSender: deposit.EthereumSender,
+		CosmosReceiver: deposit.CosmosReceiver,
+		EventNonce:     deposit.EventNonce,
+	})
+
+	if rateLimit := k.GetRateLimit(ctx, gethcommon.HexToAddress(deposit.TokenContract)); rateLimit != nil {
+		if reason, exceeds := k.exceedsDepositLimits(ctx, rateLimit, deposit.Amount); exceeds {
+			k.holdQueuedDeposit(ctx, deposit, reason)
+		}
+	}
+
+	return deposit
+}
+
+// ProcessQueuedDeposits credits queued deposits, oldest first, as long as they fit in the inbound
+// rate limits of their token. Once a deposit is held back by the limit of its token, newer deposits
+// of the same token keep waiting. Deposits held back only by the per-recipient limit do not block
+// deposits to other recipients. Deposits that can't be released by the sliding window are held for
+// a manual release instead of blocking the newer deposits of their token.
+func (k *Keeper) ProcessQueuedDeposits(ctx sdk.Context) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	released := 0
+	for _, tokenContract := range k.getQueuedDepositTokens(ctx) {
+		released += k.processQueuedDepositsOfToken(ctx, tokenContract)
+	}
+
+	if released > 0 {
+		k.Logger(ctx).Info("released queued deposits", "count", released)
+	}
+}
+
+func (k *Keeper) processQueuedDepositsOfToken(ctx sdk.Context, tokenContract gethcommon.Address) (released int) {
+	blockedRecipients := make(map[string]struct{})
+
+	for _, deposit := range k.getQueuedDepositsByToken(ctx, tokenContract) {
+		if _, blocked := blockedRecipients[deposit.CosmosReceiver]; blocked {
+			continue
+		}
+
+		// deposits of a token whose rate limit was removed in the meantime are no longer held back.
+		// The rate limit is read again for each deposit since the inflow of released ones counts against the next
+		if rateLimit := k.GetRateLimit(ctx, tokenContract); rateLimit != nil {
+			if reason, exceeds := k.exceedsDepositLimits(ctx, rateLimit, deposit.Amount); exceeds {
+				k.holdQueuedDeposit(ctx, deposit, reason)
+				continue
+			}
+
+			if err := k.checkTokenDepositLimit(ctx, rateLimit, deposit.Amount); err != nil {
+				return released
+			}
+
+			if err := k.checkRecipientDepositLimit(ctx, rateLimit, deposit.CosmosReceiver, deposit.Amount); err != nil {
+				blockedRecipients[deposit.CosmosReceiver] = struct{}{}
+				continue
+			}
+		}
+
+		if err := k.releaseQueuedDeposit(ctx, deposit, false); err != nil {
+			// release errors (e.g. the absolute mint limit) don't clear as the sliding window rolls
+			k.holdQueuedDeposit(ctx, deposit, err.Error())
+			continue
+		}
+
+		released++
+	}
+
+	return released
+}
+
+// exceedsDepositLimits returns true if the deposit alone is worth more than the inbound limits of its token at
+// the current price, in which case it would never fit in the sliding window
+func (k *Keeper) exceedsDepositLimits(ctx sdk.Context, rateLimit *types.RateLimit, amount sdkmath.Int) (reason string, exceeds bool) {
+	notional, err := k.notionalUSD(ctx, rateLimit, amount)
+	if err != nil || notional == nil {
+		return "", false
+	}
+
+	if rateLimit.HasDepositLimit() && notional.GT(rateLimit.DepositLimitUsd) {
+		return fmt.Sprintf("deposit of %sUSD exceeds the deposit limit of %sUSD", notional, rateLimit.DepositLimitUsd), true
+	}
+
+	if rateLimit.HasPerRecipientDepositLimit() && notional.GT(rateLimit.PerRecipientDepositLimitUsd) {
+		return fmt.Sprintf(
+			"deposit of %sUSD exceeds the per recipient deposit limit of %sUSD",
+			notional,
+			rateLimit.PerRecipientDepositLimitUsd,
+		), true
+	}
+
+	return "", false
+}
+
+// holdQueuedDeposit takes the deposit out of the sliding window release so that only a Peggy admin can release it
+func (k *Keeper) holdQueuedDeposit(ctx sdk.Context, deposit *types.QueuedDeposit, reason string) {
+	deposit.ManualRelease = true
+	k.SetQueuedDeposit(ctx, deposit)
+
+	k.Logger(ctx).Info("queued deposit held for manual release", "id", deposit.Id, "reason", reason)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventQueuedDepositHeld{
+		DepositId:     deposit.Id,
+		TokenContract: deposit.TokenContract,
+		Reason:        reason,
+	})
+}
+
+// ForceReleaseQueuedDeposit credits a queued deposit regardless of the remaining inbound limits
+func (k *Keeper) ForceReleaseQueuedDeposit(ctx sdk.Context, id uint64) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	deposit := k.GetQueuedDeposit(ctx, id)
+	if deposit == nil {
+		metrics.ReportFuncError(k.svcTags)
+		return errors.Wrapf(types.ErrUnknown, "queued deposit %d not found", id)
+	}
+
+	return k.releaseQueuedDeposit(ctx, deposit, true)
+}
+
+// releaseQueuedDeposit credits the deposit and removes it from the queue. State is left untouched on failure.
+func (k *Keeper) releaseQueuedDeposit(ctx sdk.Context, deposit *types.QueuedDeposit, forced bool) error {
+	sender, err := types.NewEthAddress(deposit.EthereumSender)
+	if err != nil {
+		return errors.Wrap(err, "failed to parse ethereum sender of queued deposit")
+	}
+
+	xCtx, commit := ctx.CacheContext()
+	if err := k.creditDeposit(xCtx, *sender, gethcommon.HexToAddress(deposit.TokenContract), deposit.Amount, deposit.CosmosReceiver); err != nil {
+		return err
+	}
+
+	commit()
+	k.DeleteQueuedDeposit(ctx, deposit.Id)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventQueuedDepositReleased{
+		DepositId:      deposit.Id,
+		TokenContract:  deposit.TokenContract,
+		Amount:         deposit.Amount,
+		CosmosReceiver: deposit.CosmosReceiver,
+		Forced:         forced,
+	})
+
+	return nil
+}
+
+// hasQueuedDeposits returns true if deposits of the token wait for the sliding window to roll
+func (k *Keeper) hasQueuedDeposits(ctx sdk.Context, tokenContract gethcommon.Address) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueuedDepositByTokenPrefix(tokenContract))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	return iter.Valid()
+}
+
+// getQueuedDepositTokens returns the tokens with deposits waiting for the sliding window to roll
+func (k *Keeper) getQueuedDepositTokens(ctx sdk.Context) []gethcommon.Address {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedDepositByTokenKey)
+	tokens := make([]gethcommon.Address, 0)
+
+	var start []byte
+	for {
+		iter := store.Iterator(start, nil)
+		if !iter.Valid() {
+			iter.Close()
+			return tokens
+		}
+
+		tokenContract := gethcommon.BytesToAddress(iter.Key()[:gethcommon.AddressLength])
+		iter.Close()
+
+		tokens = append(tokens, tokenContract)
+		// skip the remaining deposits of the token
+		start = storetypes.PrefixEndBytes(tokenContract.Bytes())
+	}
+}
+
+// getQueuedDepositsByToken returns the deposits of the token waiting for the sliding window to roll in ascending id order
+func (k *Keeper) getQueuedDepositsByToken(ctx sdk.Context, tokenContract gethcommon.Address) []*types.QueuedDeposit {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.GetQueuedDepositByTokenPrefix(tokenContract))
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	deposits := make([]*types.QueuedDeposit, 0)
+	for ; iter.Valid(); iter.Next() {
+		if deposit := k.GetQueuedDeposit(ctx, types.UInt64FromBytes(iter.Key())); deposit != nil {
+			deposits = append(deposits, deposit)
+		}
+	}
+
+	return deposits
+}
+
+func (k *Keeper) SetQueuedDeposit(ctx sdk.Context, deposit *types.QueuedDeposit) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.GetQueuedDepositKey(deposit.Id), k.cdc.MustMarshal(deposit))
+
+	// deposits held for a manual release are not indexed so that they don't hold back newer deposits
+	indexKey := types.GetQueuedDepositByTokenKey(gethcommon.HexToAddress(deposit.TokenContract), deposit.Id)
+	if deposit.ManualRelease {
+		store.Delete(indexKey)
+	} else {
+		store.Set(indexKey, []byte{})
+	}
+}
+
+func (k *Keeper) GetQueuedDeposit(ctx sdk.Context, id uint64) *types.QueuedDeposit {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	bz := store.Get(types.GetQueuedDepositKey(id))
+	if len(bz) == 0 {
+		return nil
+	}
+
+	var deposit types.QueuedDeposit
+	k.cdc.MustUnmarshal(bz, &deposit)
+
+	return &deposit
+}
+
+func (k *Keeper) DeleteQueuedDeposit(ctx sdk.Context, id uint64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	deposit := k.GetQueuedDeposit(ctx, id)
+	if deposit == nil {
+		return
+	}
+
+	store := ctx.KVStore(k.storeKey)
+	store.Delete(types.GetQueuedDepositKey(id))
+	store.Delete(types.GetQueuedDepositByTokenKey(gethcommon.HexToAddress(deposit.TokenContract), id))
+}
+
+// IterateQueuedDeposits iterates through all queued deposits in ascending id order
+func (k *Keeper) IterateQueuedDeposits(ctx sdk.Context, cb func(deposit *types.QueuedDeposit) (stop bool)) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.QueuedDepositKey)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var deposit types.QueuedDeposit
+		k.cdc.MustUnmarshal(iter.Value(), &deposit)
+
+		if cb(&deposit) {
+			break
+		}
+	}
+}
+
+// GetQueuedDeposits returns all queued deposits in ascending id order
+func (k *Keeper) GetQueuedDeposits(ctx sdk.Context) []*types.QueuedDeposit {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	deposits := make([]*types.QueuedDeposit, 0)
+	k.IterateQueuedDeposits(ctx, func(deposit *types.QueuedDeposit) (stop bool) {
+		deposits = append(deposits, deposit)
+		return false
+	})
+
+	return deposits
+}
+
+func (k *Keeper) GetLastQueuedDepositID(ctx sdk.Context) uint64 {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	var id uint64
+	bz := store.Get(types.LastQueuedDepositIDKey)
+	if bz != nil {
+		id = binary.BigEndian.Uint64(bz)
+	}
+	return id
+}
+
+func (k *Keeper) SetLastQueuedDepositID(ctx sdk.Context, id uint64) {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	store := ctx.KVStore(k.storeKey)
+	store.Set(types.LastQueuedDepositIDKey, sdk.Uint64ToBigEndian(id))
+}
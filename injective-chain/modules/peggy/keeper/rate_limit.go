@@ -10,12 +10,15 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	gethcommon "github.com/ethereum/go-ethereum/common"
 
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
 )
 
 var (
 	ErrRateLimitOverflow         = errors.New("rate limit overflow")
 	ErrAbsoluteMintLimitOverflow = errors.New("absolute mint limit overflow")
+	ErrDepositRateLimitOverflow  = errors.New("deposit rate limit overflow")
+	ErrMissingTokenPrice         = errors.New("missing token price")
 )
 
 func (k *Keeper) CheckRateLimit(
@@ -67,16 +70,15 @@ func (k *Keeper) CheckRateLimit(
 	}
 
 	entireWithdrawAmountSoFar := surplus.Add(totalInBatches).Add(totalInNewTxs)
-	quantity := entireWithdrawAmountSoFar.ToLegacyDec()
-	quantity = quantity.Quo(sdkmath.LegacyNewDec(10).Power(uint64(rateLimit.TokenDecimals))) // human-readable
+	notional, err := k.notionalUSD(ctx, rateLimit, entireWithdrawAmountSoFar)
+	if err != nil {
+		return err
+	}
 
-	valueInUSD := k.OracleKeeper.GetPythPrice(ctx, rateLimit.TokenPriceId, "USD")
-	if valueInUSD == nil {
-		// todo(dusan): perform check during MsgServer CreateRateLimit?
-		return errors.New("nil Pyth price")
+	if notional == nil {
+		return nil // token cannot be priced and the rate limit lets transfers through
 	}
 
-	notional := quantity.Mul(*valueInUSD)
 	if notional.GTE(rateLimit.RateLimitUsd) {
 		return sdkerrors.Wrapf(ErrRateLimitOverflow, "configured limit: %sUSD", rateLimit.RateLimitUsd.String())
 	}
@@ -86,7 +88,195 @@ func (k *Keeper) CheckRateLimit(
 	return nil
 }
 
-func (k *Keeper) TrackTokenInflow(ctx sdk.Context, tokenAddress gethcommon.Address, in sdkmath.Int) {
+// CheckDepositRateLimit returns ErrDepositRateLimitOverflow if crediting amount to the recipient
+// would exceed the inbound limits configured on the rate limit. Deposits are also held back when
+// the token cannot be priced.
+func (k *Keeper) CheckDepositRateLimit(
+	ctx sdk.Context,
+	rateLimit *types.RateLimit,
+	recipient string,
+	amount sdkmath.Int,
+) error {
+	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
+	defer doneFn()
+
+	if err := k.checkTokenDepositLimit(ctx, rateLimit, amount); err != nil {
+		return err
+	}
+
+	return k.checkRecipientDepositLimit(ctx, rateLimit, recipient, amount)
+}
+
+func (k *Keeper) checkTokenDepositLimit(ctx sdk.Context, rateLimit *types.RateLimit, amount sdkmath.Int) error {
+	if !rateLimit.HasDepositLimit() {
+		return nil
+	}
+
+	notional, err := k.notionalUSD(ctx, rateLimit, rateLimit.TotalInflow().Add(amount))
+	if err != nil {
+		return sdkerrors.Wrap(ErrDepositRateLimitOverflow, err.Error())
+	}
+
+	if notional == nil {
+		return nil
+	}
+
+	if notional.GT(rateLimit.DepositLimitUsd) {
+		return sdkerrors.Wrapf(ErrDepositRateLimitOverflow, "configured limit: %sUSD", rateLimit.DepositLimitUsd.String())
+	}
+
+	return nil
+}
+
+func (k *Keeper) checkRecipientDepositLimit(
+	ctx sdk.Context,
+	rateLimit *types.RateLimit,
+	recipient string,
+	amount sdkmath.Int,
+) error {
+	if !rateLimit.HasPerRecipientDepositLimit() {
+		return nil
+	}
+
+	notional, err := k.notionalUSD(ctx, rateLimit, rateLimit.TotalInflowOf(recipient).Add(amount))
+	if err != nil {
+		return sdkerrors.Wrap(ErrDepositRateLimitOverflow, err.Error())
+	}
+
+	if notional == nil {
+		return nil
+	}
+
+	if notional.GT(rateLimit.PerRecipientDepositLimitUsd) {
+		return sdkerrors.Wrapf(
+			ErrDepositRateLimitOverflow,
+			"configured per recipient limit: %sUSD",
+			rateLimit.PerRecipientDepositLimitUsd.String(),
+		)
+	}
+
+	return nil
+}
+
+// notionalUSD returns the USD value of the given amount (chain format) of the rate limited token.
+// A nil value (and no error) means the token cannot be priced and the rate limit lets transfers through.
+func (k *Keeper) notionalUSD(ctx sdk.Context, rateLimit *types.RateLimit, amount sdkmath.Int) (*sdkmath.LegacyDec, error) {
+	price, err := k.resolveTokenPrice(ctx, rateLimit)
+	if err != nil || price == nil {
+		return nil, err
+	}
+
+	quantity := amount.ToLegacyDec()
+	quantity = quantity.Quo(sdkmath.LegacyNewDec(10).Power(uint64(rateLimit.TokenDecimals))) // human-readable
+	notional := quantity.Mul(*price)
+
+	return &notional, nil
+}
+
+// resolveTokenPrice returns the USD price of the rate limited token from its price source, or from its fallback
+// price source if the former cannot be resolved. When neither resolves, the missing price behaviour of the rate
+// limit decides whether transfers are blocked, let through (nil price) or valued at the last known price.
+func (k *Keeper) resolveTokenPrice(ctx sdk.Context, rateLimit *types.RateLimit) (*sdkmath.LegacyDec, error) {
+	if price := k.getTokenPrice(ctx, rateLimit); price != nil {
+		return price, nil
+	}
+
+	switch rateLimit.MissingPriceBehaviour {
+	case types.MissingPriceBehaviour_AllowOnMissingPrice:
+		return nil, nil
+	case types.MissingPriceBehaviour_UseLastKnownPrice:
+		if rateLimit.LastKnownPrice.IsNil() || !rateLimit.LastKnownPrice.IsPositive() {
+			return nil, sdkerrors.Wrap(ErrMissingTokenPrice, "no last known price")
+		}
+
+		if age := ctx.BlockTime().Unix() - rateLimit.LastKnownPriceTimestamp; age > int64(rateLimit.MaxPriceAge) {
+			return nil, sdkerrors.Wrapf(ErrMissingTokenPrice, "last known price is %ds old", age)
+		}
+
+		lastKnownPrice := rateLimit.LastKnownPrice
+		return &lastKnownPrice, nil
+	default:
+		return nil, sdkerrors.Wrapf(ErrMissingTokenPrice, "token %s", rateLimit.TokenAddress)
+	}
+}
+
+// getTokenPrice returns the price of the rate limited token from its price source or fallback price source, or nil
+func (k *Keeper) getTokenPrice(ctx sdk.Context, rateLimit *types.RateLimit) *sdkmath.LegacyDec {
+	price := k.getSourcePrice(ctx, rateLimit.PriceSource, rateLimit.TokenPriceId)
+	if price == nil && rateLimit.FallbackPriceSource != nil {
+		price = k.getSourcePrice(ctx, rateLimit.FallbackPriceSource, "")
+	}
+
+	return price
+}
+
+// RecordLastKnownPrice sets the current price of the rate limited token as its last known price, if the rate limit
+// falls back to it. The rate limit is only updated in memory, it is persisted along with the refreshed sliding window.
+func (k *Keeper) RecordLastKnownPrice(ctx sdk.Context, rateLimit *types.RateLimit) {
+	if rateLimit.MissingPriceBehaviour != types.MissingPriceBehaviour_UseLastKnownPrice {
+		return
+	}
+
+	if price := k.getTokenPrice(ctx, rateLimit); price != nil {
+		rateLimit.LastKnownPrice = *price
+		rateLimit.LastKnownPriceTimestamp = ctx.BlockTime().Unix()
+	}
+}
+
+// getSourcePrice returns the (positive) price of the given price source or nil. The Pyth price of pythID is
+// used when no price source is set.
+func (k *Keeper) getSourcePrice(ctx sdk.Context, source *types.PriceSource, pythID string) *sdkmath.LegacyDec {
+	var price *sdkmath.LegacyDec
+
+	switch {
+	case source == nil && pythID == "":
+		return nil
+	case source == nil:
+		price = k.OracleKeeper.GetPythPrice(ctx, pythID, "USD")
+	default:
+		oracleType, err := oracletypes.GetOracleType(source.OracleType)
+		if err != nil {
+			return nil
+		}
+
+		if oracleType == oracletypes.OracleType_Provider {
+			price = k.OracleKeeper.GetProviderPrice(ctx, source.Provider, source.Base)
+		} else {
+			price = k.OracleKeeper.GetPrice(ctx, oracleType, source.Base, source.GetQuoteOrDefault())
+		}
+	}
+
+	if price == nil || price.IsNil() || !price.IsPositive() {
+		return nil
+	}
+
+	return price
+}
+
+// validatePriceSources checks that the oracle types of the rate limit are supported and that its price resolves
+func (k *Keeper) validatePriceSources(ctx sdk.Context, rateLimit *types.RateLimit) error {
+	for _, source := range []*types.PriceSource{rateLimit.PriceSource, rateLimit.FallbackPriceSource} {
+		if source == nil {
+			continue
+		}
+
+		if _, err := oracletypes.GetOracleType(source.OracleType); err != nil {
+			return sdkerrors.Wrap(types.ErrInvalid, err.Error())
+		}
+	}
+
+	price := k.getTokenPrice(ctx, rateLimit)
+	if price == nil {
+		return sdkerrors.Wrapf(types.ErrInvalid, "price of token %s cannot be resolved", rateLimit.TokenAddress)
+	}
+
+	rateLimit.LastKnownPrice = *price
+	rateLimit.LastKnownPriceTimestamp = ctx.BlockTime().Unix()
+
+	return nil
+}
+
+func (k *Keeper) TrackTokenInflow(ctx sdk.Context, tokenAddress gethcommon.Address, recipient string, in sdkmath.Int) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, k.svcTags)
 	defer doneFn()
 
@@ -99,6 +289,7 @@ func (k *Keeper) TrackTokenInflow(ctx sdk.Context, tokenAddress gethcommon.Addre
 		BlockNumber: uint64(ctx.BlockHeight()),
 		Amount:      in,
 		IsDeposit:   true,
+		Recipient:   recipient,
 	})
 
 	k.SetRateLimit(ctx, rateLimit)
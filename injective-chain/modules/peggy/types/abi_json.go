@@ -52,4 +52,23 @@ const (
 			{ "internalType": "bytes32", "name": "", "type": "bytes32" }
 		]
 	}]`
+
+	// OutgoingLogicCallCheckpointABIJSON checks the ETH ABI for compatibility of the OutgoingLogicCall message
+	OutgoingLogicCallCheckpointABIJSON = `[{
+		"name": "submitLogicCall",
+		"stateMutability": "pure",
+		"type": "function",
+		"inputs": [
+			{ "internalType": "bytes32", "name": "_peggyId",              "type": "bytes32" },
+			{ "internalType": "bytes32", "name": "_methodName",           "type": "bytes32" },
+			{ "internalType": "address", "name": "_logicContractAddress", "type": "address" },
+			{ "internalType": "bytes",   "name": "_payload",              "type": "bytes"   },
+			{ "internalType": "uint256", "name": "_timeout",              "type": "uint256" },
+			{ "internalType": "bytes32", "name": "_invalidationId",       "type": "bytes32" },
+			{ "internalType": "uint256", "name": "_invalidationNonce",    "type": "uint256" }
+		],
+		"outputs": [
+			{ "internalType": "bytes32", "name": "", "type": "bytes32" }
+		]
+	}]`
 )
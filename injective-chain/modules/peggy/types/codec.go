@@ -33,6 +33,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgSetOrchestratorAddresses{},
 		&MsgValsetUpdatedClaim{},
 		&MsgCancelSendToEth{},
+		&MsgBumpSendToEthFee{},
 		&MsgSubmitBadSignatureEvidence{},
 		&MsgUpdateParams{},
 		&MsgBlacklistEthereumAddresses{},
@@ -40,6 +41,9 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgCreateRateLimit{},
 		&MsgUpdateRateLimit{},
 		&MsgRemoveRateLimit{},
+		&MsgReleaseQueuedDeposits{},
+		&MsgRequestLogicCall{},
+		&MsgConfirmLogicCall{},
 	)
 
 	registry.RegisterInterface(
@@ -56,6 +60,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		(*EthereumSigned)(nil),
 		&OutgoingTxBatch{},
 		&Valset{},
+		&OutgoingLogicCall{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -67,6 +72,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgValsetConfirm{}, "peggy/MsgValsetConfirm", nil)
 	cdc.RegisterConcrete(&MsgSendToEth{}, "peggy/MsgSendToEth", nil)
 	cdc.RegisterConcrete(&MsgCancelSendToEth{}, "peggy/MsgCancelSendToEth", nil)
+	cdc.RegisterConcrete(&MsgBumpSendToEthFee{}, "peggy/MsgBumpSendToEthFee", nil)
 	cdc.RegisterConcrete(&MsgRequestBatch{}, "peggy/MsgRequestBatch", nil)
 	cdc.RegisterConcrete(&MsgConfirmBatch{}, "peggy/MsgConfirmBatch", nil)
 	cdc.RegisterConcrete(&Valset{}, "peggy/Valset", nil)
@@ -87,5 +93,9 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateRateLimit{}, "peggy/MsgCreateRateLimit", nil)
 	cdc.RegisterConcrete(&MsgUpdateRateLimit{}, "peggy/MsgUpdateRateLimit", nil)
 	cdc.RegisterConcrete(&MsgRemoveRateLimit{}, "peggy/MsgRemoveRateLimit", nil)
+	cdc.RegisterConcrete(&MsgReleaseQueuedDeposits{}, "peggy/MsgReleaseQueuedDeposits", nil)
 	cdc.RegisterConcrete(&RateLimit{}, "peggy/RateLimit", nil)
+	cdc.RegisterConcrete(&MsgRequestLogicCall{}, "peggy/MsgRequestLogicCall", nil)
+	cdc.RegisterConcrete(&MsgConfirmLogicCall{}, "peggy/MsgConfirmLogicCall", nil)
+	cdc.RegisterConcrete(&OutgoingLogicCall{}, "peggy/OutgoingLogicCall", nil)
 }
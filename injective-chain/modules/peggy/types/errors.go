@@ -22,4 +22,5 @@ var (
 	ErrNoLastClaimForValidator = errors.Register(ModuleName, 15, "missing previous claim for validator")
 	ErrDuplicateEthAddress     = errors.Register(ModuleName, 16, "eth address already in use")
 	ErrUnbondedValidator       = errors.Register(ModuleName, 17, "validator is unbonded")
+	ErrInvalidLogicCall        = errors.Register(ModuleName, 18, "invalid logic call")
 )
@@ -28,18 +28,21 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type JailReason int32
 
 const (
-	JailReason_MissingValsetConfirm JailReason = 0
-	JailReason_MissingBatchConfirm  JailReason = 1
+	JailReason_MissingValsetConfirm    JailReason = 0
+	JailReason_MissingBatchConfirm     JailReason = 1
+	JailReason_MissingLogicCallConfirm JailReason = 2
 )
 
 var JailReason_name = map[int32]string{
 	0: "MissingValsetConfirm",
 	1: "MissingBatchConfirm",
+	2: "MissingLogicCallConfirm",
 }
 
 var JailReason_value = map[string]int32{
-	"MissingValsetConfirm": 0,
-	"MissingBatchConfirm":  1,
+	"MissingValsetConfirm":    0,
+	"MissingBatchConfirm":     1,
+	"MissingLogicCallConfirm": 2,
 }
 
 func (x JailReason) String() string {
@@ -1107,6 +1110,60 @@ func (m *EventCancelSendToEth) GetOutgoingTxId() uint64 {
 	return 0
 }
 
+type EventBumpSendToEthFee struct {
+	OutgoingTxId uint64                                  `protobuf:"varint,1,opt,name=outgoing_tx_id,json=outgoingTxId,proto3" json:"outgoing_tx_id,omitempty"`
+	Sender       string                                  `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	FeeIncrease  github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=fee_increase,json=feeIncrease,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"fee_increase"`
+	BridgeFee    github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,4,opt,name=bridge_fee,json=bridgeFee,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"bridge_fee"`
+}
+
+func (m *EventBumpSendToEthFee) Reset()         { *m = EventBumpSendToEthFee{} }
+func (m *EventBumpSendToEthFee) String() string { return proto.CompactTextString(m) }
+func (*EventBumpSendToEthFee) ProtoMessage()    {}
+func (*EventBumpSendToEthFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{15}
+}
+func (m *EventBumpSendToEthFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventBumpSendToEthFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventBumpSendToEthFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventBumpSendToEthFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventBumpSendToEthFee.Merge(m, src)
+}
+func (m *EventBumpSendToEthFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventBumpSendToEthFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventBumpSendToEthFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventBumpSendToEthFee proto.InternalMessageInfo
+
+func (m *EventBumpSendToEthFee) GetOutgoingTxId() uint64 {
+	if m != nil {
+		return m.OutgoingTxId
+	}
+	return 0
+}
+
+func (m *EventBumpSendToEthFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
 type EventSubmitBadSignatureEvidence struct {
 	BadEthSignature        string `protobuf:"bytes,1,opt,name=bad_eth_signature,json=badEthSignature,proto3" json:"bad_eth_signature,omitempty"`
 	BadEthSignatureSubject string `protobuf:"bytes,2,opt,name=bad_eth_signature_subject,json=badEthSignatureSubject,proto3" json:"bad_eth_signature_subject,omitempty"`
@@ -1116,7 +1173,7 @@ func (m *EventSubmitBadSignatureEvidence) Reset()         { *m = EventSubmitBadS
 func (m *EventSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*EventSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*EventSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{15}
+	return fileDescriptor_95f217691d2f42c2, []int{16}
 }
 func (m *EventSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1171,7 +1228,7 @@ func (m *EventValidatorSlash) Reset()         { *m = EventValidatorSlash{} }
 func (m *EventValidatorSlash) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorSlash) ProtoMessage()    {}
 func (*EventValidatorSlash) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{16}
+	return fileDescriptor_95f217691d2f42c2, []int{17}
 }
 func (m *EventValidatorSlash) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1248,7 +1305,7 @@ func (m *EventDepositReceived) Reset()         { *m = EventDepositReceived{} }
 func (m *EventDepositReceived) String() string { return proto.CompactTextString(m) }
 func (*EventDepositReceived) ProtoMessage()    {}
 func (*EventDepositReceived) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{17}
+	return fileDescriptor_95f217691d2f42c2, []int{18}
 }
 func (m *EventDepositReceived) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1302,7 +1359,7 @@ func (m *EventWithdrawalsCompleted) Reset()         { *m = EventWithdrawalsCompl
 func (m *EventWithdrawalsCompleted) String() string { return proto.CompactTextString(m) }
 func (*EventWithdrawalsCompleted) ProtoMessage()    {}
 func (*EventWithdrawalsCompleted) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{18}
+	return fileDescriptor_95f217691d2f42c2, []int{19}
 }
 func (m *EventWithdrawalsCompleted) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1358,7 +1415,7 @@ func (m *Withdrawal) Reset()         { *m = Withdrawal{} }
 func (m *Withdrawal) String() string { return proto.CompactTextString(m) }
 func (*Withdrawal) ProtoMessage()    {}
 func (*Withdrawal) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{19}
+	return fileDescriptor_95f217691d2f42c2, []int{20}
 }
 func (m *Withdrawal) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1413,7 +1470,7 @@ func (m *EventValidatorJailed) Reset()         { *m = EventValidatorJailed{} }
 func (m *EventValidatorJailed) String() string { return proto.CompactTextString(m) }
 func (*EventValidatorJailed) ProtoMessage()    {}
 func (*EventValidatorJailed) Descriptor() ([]byte, []int) {
-	return fileDescriptor_95f217691d2f42c2, []int{20}
+	return fileDescriptor_95f217691d2f42c2, []int{21}
 }
 func (m *EventValidatorJailed) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1477,293 +1534,570 @@ func (m *EventValidatorJailed) GetMoniker() string {
 	return ""
 }
 
-func init() {
-	proto.RegisterEnum("injective.peggy.v1.JailReason", JailReason_name, JailReason_value)
-	proto.RegisterType((*EventAttestationObserved)(nil), "injective.peggy.v1.EventAttestationObserved")
-	proto.RegisterType((*EventBridgeWithdrawCanceled)(nil), "injective.peggy.v1.EventBridgeWithdrawCanceled")
-	proto.RegisterType((*EventOutgoingBatch)(nil), "injective.peggy.v1.EventOutgoingBatch")
-	proto.RegisterType((*EventOutgoingBatchCanceled)(nil), "injective.peggy.v1.EventOutgoingBatchCanceled")
-	proto.RegisterType((*EventValsetUpdateRequest)(nil), "injective.peggy.v1.EventValsetUpdateRequest")
-	proto.RegisterType((*EventSetOrchestratorAddresses)(nil), "injective.peggy.v1.EventSetOrchestratorAddresses")
-	proto.RegisterType((*EventValsetConfirm)(nil), "injective.peggy.v1.EventValsetConfirm")
-	proto.RegisterType((*EventSendToEth)(nil), "injective.peggy.v1.EventSendToEth")
-	proto.RegisterType((*EventConfirmBatch)(nil), "injective.peggy.v1.EventConfirmBatch")
-	proto.RegisterType((*EventAttestationVote)(nil), "injective.peggy.v1.EventAttestationVote")
-	proto.RegisterType((*EventDepositClaim)(nil), "injective.peggy.v1.EventDepositClaim")
-	proto.RegisterType((*EventWithdrawClaim)(nil), "injective.peggy.v1.EventWithdrawClaim")
-	proto.RegisterType((*EventERC20DeployedClaim)(nil), "injective.peggy.v1.EventERC20DeployedClaim")
-	proto.RegisterType((*EventValsetUpdateClaim)(nil), "injective.peggy.v1.EventValsetUpdateClaim")
-	proto.RegisterType((*EventCancelSendToEth)(nil), "injective.peggy.v1.EventCancelSendToEth")
-	proto.RegisterType((*EventSubmitBadSignatureEvidence)(nil), "injective.peggy.v1.EventSubmitBadSignatureEvidence")
-	proto.RegisterType((*EventValidatorSlash)(nil), "injective.peggy.v1.EventValidatorSlash")
-	proto.RegisterType((*EventDepositReceived)(nil), "injective.peggy.v1.EventDepositReceived")
-	proto.RegisterType((*EventWithdrawalsCompleted)(nil), "injective.peggy.v1.EventWithdrawalsCompleted")
-	proto.RegisterType((*Withdrawal)(nil), "injective.peggy.v1.Withdrawal")
-	proto.RegisterType((*EventValidatorJailed)(nil), "injective.peggy.v1.EventValidatorJailed")
+type EventOutgoingLogicCall struct {
+	Requester            string `protobuf:"bytes,1,opt,name=requester,proto3" json:"requester,omitempty"`
+	LogicContractAddress string `protobuf:"bytes,2,opt,name=logic_contract_address,json=logicContractAddress,proto3" json:"logic_contract_address,omitempty"`
+	InvalidationId       []byte `protobuf:"bytes,3,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	InvalidationNonce    uint64 `protobuf:"varint,4,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	Timeout              uint64 `protobuf:"varint,5,opt,name=timeout,proto3" json:"timeout,omitempty"`
 }
 
-func init() { proto.RegisterFile("injective/peggy/v1/events.proto", fileDescriptor_95f217691d2f42c2) }
-
-var fileDescriptor_95f217691d2f42c2 = []byte{
-	// 1407 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x58, 0xc1, 0x6f, 0x1b, 0x45,
-	0x17, 0xcf, 0xda, 0x4e, 0xd2, 0xbc, 0x38, 0x4e, 0x3a, 0xcd, 0xd7, 0xba, 0xf9, 0x54, 0xc7, 0xdd,
-	0xb6, 0x5f, 0xf2, 0x15, 0xd5, 0x6e, 0x8b, 0x8a, 0x84, 0x84, 0x04, 0x8d, 0x1b, 0x68, 0x0a, 0x6d,
-	0xa5, 0x4d, 0x28, 0x12, 0x17, 0x6b, 0xec, 0x79, 0xf5, 0x4e, 0xe3, 0xdd, 0x31, 0x3b, 0x63, 0xa7,
-	0x39, 0x73, 0xe1, 0xc0, 0x01, 0x09, 0x71, 0xe5, 0x7f, 0xe0, 0xc0, 0x9f, 0x80, 0x54, 0x6e, 0x3d,
-	0x21, 0xc4, 0xa1, 0x42, 0xed, 0x7f, 0x80, 0xc4, 0x85, 0x13, 0xda, 0x99, 0xd9, 0xb5, 0x93, 0xb5,
-	0x45, 0x9a, 0x42, 0x39, 0xc5, 0xf3, 0xe6, 0xbd, 0x37, 0x6f, 0x7e, 0xef, 0x37, 0xef, 0xbd, 0x0d,
-	0xac, 0xf2, 0xf0, 0x11, 0xb6, 0x15, 0x1f, 0x60, 0xbd, 0x87, 0x9d, 0xce, 0x7e, 0x7d, 0x70, 0xad,
-	0x8e, 0x03, 0x0c, 0x95, 0xac, 0xf5, 0x22, 0xa1, 0x04, 0x21, 0xa9, 0x42, 0x4d, 0x2b, 0xd4, 0x06,
-	0xd7, 0x56, 0x96, 0x3b, 0xa2, 0x23, 0xf4, 0x76, 0x3d, 0xfe, 0x65, 0x34, 0x57, 0x2e, 0x8e, 0x71,
-	0x45, 0x95, 0x42, 0xa9, 0xa8, 0xe2, 0x22, 0xb4, 0x5a, 0x95, 0x31, 0x5a, 0x6a, 0xbf, 0x87, 0xf6,
-	0x3c, 0xf7, 0x37, 0x07, 0xca, 0x9b, 0x71, 0x00, 0x37, 0x87, 0xa6, 0xf7, 0x5b, 0x12, 0xa3, 0x01,
-	0x32, 0x72, 0x1b, 0x96, 0x46, 0x3c, 0x36, 0x63, 0xbb, 0xb2, 0x53, 0x75, 0xd6, 0x4b, 0xd7, 0xcf,
-	0xd5, 0xb2, 0x71, 0xd6, 0x1a, 0x5d, 0xca, 0x83, 0x9d, 0xfd, 0x1e, 0x7a, 0x8b, 0x23, 0x66, 0xb1,
-	0x80, 0xac, 0xc1, 0x62, 0x2b, 0xe2, 0xac, 0x83, 0xcd, 0xb6, 0x08, 0x55, 0x44, 0xdb, 0xaa, 0x9c,
-	0xab, 0x3a, 0xeb, 0x73, 0x5e, 0xc9, 0x88, 0x1b, 0x56, 0x4a, 0xfe, 0x37, 0x54, 0xf4, 0x29, 0x0f,
-	0x9b, 0x9c, 0x95, 0xf3, 0x55, 0x67, 0xbd, 0xe0, 0x2d, 0x58, 0xc5, 0x58, 0xba, 0xc5, 0xc8, 0x25,
-	0x28, 0x8d, 0x86, 0xc6, 0x59, 0xb9, 0x50, 0x75, 0xd6, 0x8b, 0xde, 0xc2, 0x88, 0x74, 0x8b, 0x91,
-	0x65, 0x98, 0x0e, 0x45, 0xd8, 0xc6, 0xf2, 0xb4, 0x76, 0x62, 0x16, 0x6e, 0x08, 0xff, 0xd5, 0x77,
-	0xde, 0xd0, 0x2e, 0x3f, 0xe1, 0xca, 0x67, 0x11, 0xdd, 0x6b, 0xd0, 0xb0, 0x8d, 0x5d, 0x64, 0xe3,
-	0x82, 0x75, 0x8e, 0x1a, 0x6c, 0x6e, 0x4c, 0xb0, 0xee, 0x0f, 0x0e, 0x10, 0x7d, 0xe0, 0xfd, 0xbe,
-	0xea, 0x08, 0x1e, 0x76, 0x36, 0xa8, 0x6a, 0xfb, 0x71, 0x70, 0x0c, 0x43, 0x11, 0x58, 0xef, 0x66,
-	0x41, 0xae, 0xc1, 0xb2, 0x88, 0xda, 0x3e, 0x4a, 0x15, 0x51, 0x25, 0xa2, 0x26, 0x65, 0x2c, 0x42,
-	0x29, 0x2d, 0x5e, 0xa7, 0x46, 0xf7, 0x6e, 0x9a, 0x2d, 0xb2, 0x0a, 0xf3, 0xad, 0xd8, 0x63, 0xd3,
-	0xdc, 0xd5, 0x00, 0x06, 0x5a, 0x74, 0x2f, 0x96, 0x90, 0x0b, 0xb0, 0x60, 0x14, 0x14, 0x0f, 0x50,
-	0xf4, 0x95, 0x06, 0xab, 0xe0, 0x15, 0xb5, 0x70, 0xc7, 0xc8, 0x48, 0x15, 0x8a, 0x56, 0xe9, 0x71,
-	0x93, 0x33, 0x59, 0x9e, 0xae, 0xe6, 0x53, 0x37, 0x3b, 0x8f, 0xb7, 0x98, 0x74, 0xbf, 0x75, 0x60,
-	0x25, 0x7b, 0x8f, 0x7f, 0x0c, 0x37, 0x72, 0x16, 0x4e, 0x98, 0x88, 0x52, 0x16, 0xcc, 0xea, 0xf5,
-	0x68, 0x62, 0x0b, 0xa3, 0x89, 0xfd, 0x26, 0x67, 0xd9, 0xfc, 0x80, 0x76, 0x25, 0xaa, 0x8f, 0x7b,
-	0x8c, 0x2a, 0xf4, 0xf0, 0xb3, 0x3e, 0x4a, 0x45, 0xce, 0x43, 0x71, 0xa0, 0xc5, 0x16, 0x26, 0x47,
-	0x5b, 0xce, 0x1b, 0x59, 0x8a, 0x93, 0x55, 0xf1, 0x91, 0x77, 0x7c, 0x65, 0xc3, 0xb2, 0x76, 0xb7,
-	0xb5, 0x8c, 0xdc, 0x81, 0x92, 0x55, 0x0a, 0x30, 0x68, 0x61, 0x24, 0xcb, 0xf9, 0x6a, 0x7e, 0x7d,
-	0xfe, 0xfa, 0x85, 0x71, 0x6f, 0xc2, 0x50, 0xec, 0x01, 0xed, 0x72, 0x16, 0x67, 0xcc, 0xb3, 0xfe,
-	0xef, 0x1a, 0x4b, 0xb2, 0x01, 0x0b, 0x11, 0xee, 0xd1, 0x88, 0x35, 0x69, 0x20, 0xfa, 0xa1, 0x49,
-	0xcc, 0xdc, 0xc6, 0xb9, 0x27, 0xcf, 0x56, 0xa7, 0x7e, 0x79, 0xb6, 0xfa, 0x9f, 0xb6, 0x90, 0x81,
-	0x90, 0x92, 0xed, 0xd6, 0xb8, 0xa8, 0x07, 0x54, 0xf9, 0xb5, 0xad, 0x50, 0x79, 0x45, 0x63, 0x73,
-	0x53, 0x9b, 0xc4, 0xf7, 0xb2, 0x3e, 0x94, 0xd8, 0xc5, 0x50, 0x53, 0x7d, 0xce, 0x9b, 0x37, 0xb2,
-	0x9d, 0x58, 0xe4, 0x7e, 0xe7, 0xc0, 0x39, 0x8d, 0xcb, 0x36, 0xaa, 0xfb, 0x59, 0x02, 0xa1, 0x24,
-	0x6f, 0xc0, 0xc9, 0x41, 0x12, 0x64, 0x4a, 0x39, 0x93, 0xbd, 0xa5, 0x74, 0x23, 0xe1, 0xdb, 0x31,
-	0x28, 0x7a, 0x15, 0x96, 0x45, 0x0f, 0x8d, 0x3a, 0x2a, 0x3f, 0x35, 0xc9, 0x6b, 0x13, 0x92, 0xec,
-	0x6d, 0x2a, 0xdf, 0x5a, 0xb8, 0x8f, 0xec, 0x9b, 0x31, 0xa9, 0x6c, 0x88, 0xf0, 0x21, 0x8f, 0x82,
-	0xa3, 0x24, 0xf1, 0xe5, 0xa3, 0x73, 0x3f, 0xcf, 0x41, 0xc9, 0xe2, 0x13, 0xb2, 0x1d, 0xb1, 0xa9,
-	0x7c, 0x72, 0x11, 0x4a, 0xc2, 0xb2, 0xdc, 0x3c, 0x08, 0x7b, 0x54, 0x31, 0x91, 0xc6, 0x4f, 0x82,
-	0x9c, 0x86, 0x19, 0x89, 0x21, 0xc3, 0xc8, 0x7a, 0xb7, 0x2b, 0xb2, 0x02, 0x27, 0x22, 0x6c, 0x23,
-	0x1f, 0x60, 0x64, 0xaf, 0x98, 0xae, 0xc9, 0x07, 0x30, 0x73, 0x20, 0xd9, 0x75, 0x9b, 0xec, 0xb5,
-	0x0e, 0x57, 0x7e, 0xbf, 0x55, 0x6b, 0x8b, 0xa0, 0x6e, 0xf2, 0x6e, 0xff, 0x5c, 0x91, 0x6c, 0xd7,
-	0x16, 0xed, 0x86, 0xe0, 0xa1, 0x67, 0xcd, 0xc9, 0x3d, 0x00, 0xfb, 0x8c, 0x1e, 0xa2, 0xa9, 0x70,
-	0xc7, 0x70, 0x36, 0x67, 0x5c, 0xbc, 0x8f, 0xe8, 0x76, 0xe0, 0xa4, 0x06, 0xc1, 0x62, 0x6d, 0x8a,
-	0xd4, 0xa1, 0xda, 0xe2, 0x64, 0x6a, 0xcb, 0x31, 0xe0, 0x56, 0xb0, 0x7c, 0xb8, 0xe7, 0x3c, 0x10,
-	0x0a, 0xe3, 0xb3, 0x74, 0x33, 0x3c, 0x78, 0x96, 0x16, 0x99, 0xb3, 0xb2, 0x55, 0x3f, 0x37, 0xa1,
-	0xea, 0x0f, 0x84, 0x4a, 0xa1, 0x37, 0x0b, 0xf7, 0xf7, 0x9c, 0xbd, 0xdf, 0x2d, 0xec, 0x09, 0xc9,
-	0x95, 0x6e, 0x57, 0x7f, 0x7d, 0xe6, 0x79, 0x28, 0x1a, 0x85, 0x03, 0x25, 0xc1, 0x18, 0xd9, 0x8a,
-	0x90, 0x0d, 0x2b, 0x3f, 0x2e, 0xac, 0x35, 0x58, 0x44, 0xe5, 0x63, 0x84, 0xfd, 0xa0, 0x69, 0x59,
-	0x53, 0x30, 0xf5, 0x31, 0x11, 0x6f, 0x1b, 0xf6, 0xac, 0xc1, 0xa2, 0x49, 0x56, 0x33, 0x25, 0x91,
-	0x79, 0xd4, 0x25, 0x23, 0xf6, 0x12, 0x2a, 0x5d, 0x82, 0x92, 0x7e, 0xf3, 0xc3, 0x82, 0x3b, 0xa3,
-	0xf5, 0x16, 0xb4, 0x34, 0xad, 0xb7, 0x37, 0x52, 0xc6, 0xcd, 0x1e, 0xa5, 0xbc, 0x24, 0xfc, 0x9a,
-	0x94, 0xd9, 0x13, 0x93, 0x9f, 0x39, 0x81, 0x02, 0xa3, 0x8a, 0x96, 0xe7, 0xb4, 0x8a, 0xfe, 0xed,
-	0xfe, 0x91, 0x74, 0xbf, 0xb4, 0xd1, 0xbe, 0x6e, 0xe0, 0x0f, 0x71, 0xb8, 0x90, 0xe1, 0x70, 0x16,
-	0xc7, 0xe9, 0x71, 0x38, 0x4e, 0x02, 0x64, 0x66, 0x32, 0xd5, 0x7f, 0xcc, 0xc1, 0x19, 0x7d, 0xf9,
-	0x4d, 0xaf, 0x71, 0xfd, 0xea, 0x2d, 0xec, 0x75, 0xc5, 0x3e, 0xb2, 0xd7, 0x8e, 0xc0, 0x79, 0x28,
-	0x5a, 0x46, 0x99, 0x89, 0xc3, 0xf0, 0x6e, 0xde, 0xc8, 0x6e, 0xe9, 0xb9, 0xe3, 0x88, 0x18, 0x10,
-	0x28, 0x84, 0x34, 0x40, 0x7b, 0x67, 0xfd, 0x5b, 0x57, 0xc1, 0xfd, 0xa0, 0x25, 0xba, 0x86, 0x5f,
-	0x9e, 0x5d, 0xc5, 0x55, 0x90, 0x61, 0x9b, 0x07, 0xb4, 0x6b, 0x48, 0x53, 0xf0, 0xd2, 0xf5, 0x44,
-	0x2c, 0xe7, 0x26, 0x63, 0xf9, 0x65, 0x1e, 0x4e, 0x67, 0xba, 0xfb, 0xbf, 0x01, 0xe5, 0x81, 0x0e,
-	0x54, 0xc8, 0x76, 0xa0, 0xec, 0x84, 0x30, 0xfd, 0xf7, 0x4d, 0x08, 0x33, 0xaf, 0x3e, 0x21, 0xcc,
-	0x66, 0x26, 0x84, 0x63, 0xbc, 0x75, 0xf7, 0x1d, 0x5b, 0xc5, 0xcd, 0xfc, 0xf7, 0x92, 0x9d, 0xd3,
-	0xfd, 0xc2, 0x81, 0x55, 0xd3, 0x72, 0xfb, 0xad, 0x80, 0xab, 0x0d, 0xca, 0xb6, 0x79, 0x27, 0xa4,
-	0xaa, 0x1f, 0xe1, 0xe6, 0x80, 0x33, 0x8c, 0x71, 0xbc, 0x0c, 0x27, 0x5b, 0x94, 0xe9, 0x79, 0x41,
-	0x26, 0x9b, 0x76, 0x28, 0x59, 0x6c, 0x51, 0xb6, 0xa9, 0xfc, 0xd4, 0x86, 0xbc, 0x0d, 0x67, 0x33,
-	0xba, 0x4d, 0xd9, 0x6f, 0xc5, 0x78, 0xdb, 0x5e, 0x74, 0xfa, 0x90, 0xcd, 0xb6, 0xd9, 0x75, 0xbf,
-	0x77, 0xe0, 0x54, 0xc2, 0x2b, 0x93, 0x84, 0xed, 0x2e, 0x95, 0x7a, 0x3e, 0xef, 0x89, 0x3d, 0x8c,
-	0xf4, 0x91, 0x79, 0xcf, 0x2c, 0x62, 0xb2, 0x47, 0x48, 0xa5, 0x08, 0x93, 0x96, 0x6f, 0x56, 0xf1,
-	0x04, 0xd5, 0x16, 0xa1, 0xc4, 0x50, 0xf6, 0xe5, 0xa1, 0xf1, 0x66, 0x29, 0xdd, 0x48, 0xea, 0xe4,
-	0xff, 0x61, 0x29, 0x1d, 0x87, 0x12, 0x5d, 0xf3, 0x26, 0x17, 0x13, 0x79, 0xa2, 0x5a, 0x86, 0xd9,
-	0x40, 0x84, 0x7c, 0x37, 0x6d, 0x02, 0xc9, 0xd2, 0xfd, 0xda, 0xb1, 0x19, 0xb0, 0x0d, 0xcd, 0xb6,
-	0x85, 0xd1, 0xa9, 0xc4, 0x99, 0x38, 0x95, 0xe4, 0x26, 0x4e, 0x25, 0xf9, 0x57, 0x9a, 0x4a, 0x5c,
-	0x09, 0x67, 0x0f, 0x54, 0x7b, 0xda, 0x95, 0x0d, 0x11, 0xf4, 0xba, 0xa8, 0x90, 0x4d, 0xf8, 0xe4,
-	0x79, 0x0f, 0xe6, 0xf7, 0x86, 0xda, 0xe5, 0x9c, 0x7e, 0x2c, 0x95, 0x71, 0x8f, 0x65, 0xe8, 0xd4,
-	0x1b, 0x35, 0x71, 0xf7, 0x00, 0x86, 0x5b, 0xc7, 0xba, 0xff, 0x8d, 0x43, 0xf7, 0x3f, 0x5a, 0x8f,
-	0x74, 0x7f, 0x4a, 0x72, 0x90, 0x72, 0xe7, 0x0e, 0xe5, 0xf1, 0xc7, 0xd0, 0x5b, 0x29, 0x4d, 0xcc,
-	0x17, 0xf3, 0xd8, 0xeb, 0xc4, 0xba, 0x9e, 0xd6, 0x4a, 0x69, 0x94, 0x92, 0x2e, 0x37, 0x4a, 0xba,
-	0xd7, 0x4e, 0xae, 0xcb, 0xef, 0x02, 0x0c, 0xa3, 0x23, 0x65, 0x58, 0xbe, 0xcb, 0xa5, 0xe4, 0x61,
-	0xe7, 0xc0, 0x38, 0xbe, 0x34, 0x45, 0xce, 0xc0, 0x29, 0xbb, 0x63, 0x3e, 0x06, 0xed, 0x86, 0xb3,
-	0x81, 0x4f, 0x9e, 0x57, 0x9c, 0xa7, 0xcf, 0x2b, 0xce, 0xaf, 0xcf, 0x2b, 0xce, 0x57, 0x2f, 0x2a,
-	0x53, 0x4f, 0x5f, 0x54, 0xa6, 0x7e, 0x7e, 0x51, 0x99, 0xfa, 0xf4, 0xc3, 0x11, 0x4a, 0x6d, 0x25,
-	0xa0, 0x7c, 0x44, 0x5b, 0xb2, 0x9e, 0x42, 0x74, 0xa5, 0x2d, 0x22, 0x1c, 0x5d, 0xc6, 0x5f, 0x84,
-	0xf5, 0x40, 0xb0, 0x7e, 0x17, 0xa5, 0xfd, 0x4f, 0x86, 0xe6, 0x5e, 0x6b, 0x46, 0xff, 0x1f, 0xe3,
-	0xcd, 0x3f, 0x03, 0x00, 0x00, 0xff, 0xff, 0x1f, 0x16, 0xfe, 0x9f, 0x5a, 0x11, 0x00, 0x00,
+func (m *EventOutgoingLogicCall) Reset()         { *m = EventOutgoingLogicCall{} }
+func (m *EventOutgoingLogicCall) String() string { return proto.CompactTextString(m) }
+func (*EventOutgoingLogicCall) ProtoMessage()    {}
+func (*EventOutgoingLogicCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{22}
 }
-
-func (m *EventAttestationObserved) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOutgoingLogicCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventOutgoingLogicCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventOutgoingLogicCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
 }
-
-func (m *EventAttestationObserved) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOutgoingLogicCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventOutgoingLogicCall.Merge(m, src)
+}
+func (m *EventOutgoingLogicCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventOutgoingLogicCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventOutgoingLogicCall.DiscardUnknown(m)
 }
 
-func (m *EventAttestationObserved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x28
-	}
-	if len(m.AttestationId) > 0 {
-		i -= len(m.AttestationId)
-		copy(dAtA[i:], m.AttestationId)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.AttestationId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.BridgeChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.BridgeContract) > 0 {
-		i -= len(m.BridgeContract)
-		copy(dAtA[i:], m.BridgeContract)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BridgeContract)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if m.AttestationType != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.AttestationType))
-		i--
-		dAtA[i] = 0x8
+var xxx_messageInfo_EventOutgoingLogicCall proto.InternalMessageInfo
+
+func (m *EventOutgoingLogicCall) GetRequester() string {
+	if m != nil {
+		return m.Requester
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *EventBridgeWithdrawCanceled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOutgoingLogicCall) GetLogicContractAddress() string {
+	if m != nil {
+		return m.LogicContractAddress
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *EventBridgeWithdrawCanceled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *EventOutgoingLogicCall) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
+	}
+	return nil
 }
 
-func (m *EventBridgeWithdrawCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.BridgeChainId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BridgeChainId))
-		i--
-		dAtA[i] = 0x10
-	}
-	if len(m.BridgeContract) > 0 {
-		i -= len(m.BridgeContract)
-		copy(dAtA[i:], m.BridgeContract)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.BridgeContract)))
-		i--
-		dAtA[i] = 0xa
+func (m *EventOutgoingLogicCall) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	return len(dAtA) - i, nil
+	return 0
 }
 
-func (m *EventOutgoingBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventOutgoingLogicCall) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventOutgoingBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+type EventConfirmLogicCall struct {
+	InvalidationId      []byte `protobuf:"bytes,1,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	InvalidationNonce   uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	OrchestratorAddress string `protobuf:"bytes,3,opt,name=orchestrator_address,json=orchestratorAddress,proto3" json:"orchestrator_address,omitempty"`
 }
 
-func (m *EventOutgoingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.BatchTxIds) > 0 {
-		dAtA2 := make([]byte, len(m.BatchTxIds)*10)
-		var j1 int
-		for _, num := range m.BatchTxIds {
-			for num >= 1<<7 {
-				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
-				num >>= 7
-				j1++
-			}
-			dAtA2[j1] = uint8(num)
-			j1++
+func (m *EventConfirmLogicCall) Reset()         { *m = EventConfirmLogicCall{} }
+func (m *EventConfirmLogicCall) String() string { return proto.CompactTextString(m) }
+func (*EventConfirmLogicCall) ProtoMessage()    {}
+func (*EventConfirmLogicCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{23}
+}
+func (m *EventConfirmLogicCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventConfirmLogicCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventConfirmLogicCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i -= j1
-		copy(dAtA[i:], dAtA2[:j1])
-		i = encodeVarintEvents(dAtA, i, uint64(j1))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if m.BatchTimeout != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BatchTimeout))
-		i--
-		dAtA[i] = 0x20
-	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BatchNonce))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.OrchestratorAddress) > 0 {
-		i -= len(m.OrchestratorAddress)
-		copy(dAtA[i:], m.OrchestratorAddress)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrchestratorAddress)))
-		i--
-		dAtA[i] = 0x12
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *EventConfirmLogicCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventConfirmLogicCall.Merge(m, src)
+}
+func (m *EventConfirmLogicCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventConfirmLogicCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventConfirmLogicCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventConfirmLogicCall proto.InternalMessageInfo
+
+func (m *EventConfirmLogicCall) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
 	}
-	return len(dAtA) - i, nil
+	return nil
 }
 
-func (m *EventOutgoingBatchCanceled) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *EventConfirmLogicCall) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	return dAtA[:n], nil
+	return 0
 }
 
-func (m *EventOutgoingBatchCanceled) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
+func (m *EventConfirmLogicCall) GetOrchestratorAddress() string {
+	if m != nil {
+		return m.OrchestratorAddress
+	}
+	return ""
+}
+
+type EventLogicCallTimeout struct {
+	InvalidationId    []byte `protobuf:"bytes,1,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	Timeout           uint64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+}
+
+func (m *EventLogicCallTimeout) Reset()         { *m = EventLogicCallTimeout{} }
+func (m *EventLogicCallTimeout) String() string { return proto.CompactTextString(m) }
+func (*EventLogicCallTimeout) ProtoMessage()    {}
+func (*EventLogicCallTimeout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{24}
+}
+func (m *EventLogicCallTimeout) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventLogicCallTimeout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventLogicCallTimeout.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventLogicCallTimeout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventLogicCallTimeout.Merge(m, src)
+}
+func (m *EventLogicCallTimeout) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventLogicCallTimeout) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventLogicCallTimeout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventLogicCallTimeout proto.InternalMessageInfo
+
+func (m *EventLogicCallTimeout) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
+	}
+	return nil
+}
+
+func (m *EventLogicCallTimeout) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
+}
+
+func (m *EventLogicCallTimeout) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+type EventDepositQueued struct {
+	DepositId      uint64                `protobuf:"varint,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	TokenContract  string                `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	EthereumSender string                `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	CosmosReceiver string                `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	EventNonce     uint64                `protobuf:"varint,6,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+}
+
+func (m *EventDepositQueued) Reset()         { *m = EventDepositQueued{} }
+func (m *EventDepositQueued) String() string { return proto.CompactTextString(m) }
+func (*EventDepositQueued) ProtoMessage()    {}
+func (*EventDepositQueued) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{25}
+}
+func (m *EventDepositQueued) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventDepositQueued) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventDepositQueued.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventDepositQueued) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventDepositQueued.Merge(m, src)
+}
+func (m *EventDepositQueued) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventDepositQueued) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventDepositQueued.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventDepositQueued proto.InternalMessageInfo
+
+func (m *EventDepositQueued) GetDepositId() uint64 {
+	if m != nil {
+		return m.DepositId
+	}
+	return 0
+}
+
+func (m *EventDepositQueued) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *EventDepositQueued) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *EventDepositQueued) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *EventDepositQueued) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+type EventQueuedDepositReleased struct {
+	DepositId      uint64                `protobuf:"varint,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	TokenContract  string                `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	Amount         cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	CosmosReceiver string                `protobuf:"bytes,4,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	// true if the deposit was released by a Peggy admin rather than the
+	// sliding window
+	Forced bool `protobuf:"varint,5,opt,name=forced,proto3" json:"forced,omitempty"`
+}
+
+func (m *EventQueuedDepositReleased) Reset()         { *m = EventQueuedDepositReleased{} }
+func (m *EventQueuedDepositReleased) String() string { return proto.CompactTextString(m) }
+func (*EventQueuedDepositReleased) ProtoMessage()    {}
+func (*EventQueuedDepositReleased) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{26}
+}
+func (m *EventQueuedDepositReleased) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueuedDepositReleased) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueuedDepositReleased.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQueuedDepositReleased) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueuedDepositReleased.Merge(m, src)
+}
+func (m *EventQueuedDepositReleased) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueuedDepositReleased) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueuedDepositReleased.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQueuedDepositReleased proto.InternalMessageInfo
+
+func (m *EventQueuedDepositReleased) GetDepositId() uint64 {
+	if m != nil {
+		return m.DepositId
+	}
+	return 0
+}
+
+func (m *EventQueuedDepositReleased) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *EventQueuedDepositReleased) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *EventQueuedDepositReleased) GetForced() bool {
+	if m != nil {
+		return m.Forced
+	}
+	return false
+}
+
+type EventQueuedDepositHeld struct {
+	DepositId     uint64 `protobuf:"varint,1,opt,name=deposit_id,json=depositId,proto3" json:"deposit_id,omitempty"`
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// why the deposit can only be released by a Peggy admin
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+}
+
+func (m *EventQueuedDepositHeld) Reset()         { *m = EventQueuedDepositHeld{} }
+func (m *EventQueuedDepositHeld) String() string { return proto.CompactTextString(m) }
+func (*EventQueuedDepositHeld) ProtoMessage()    {}
+func (*EventQueuedDepositHeld) Descriptor() ([]byte, []int) {
+	return fileDescriptor_95f217691d2f42c2, []int{27}
+}
+func (m *EventQueuedDepositHeld) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventQueuedDepositHeld) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventQueuedDepositHeld.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventQueuedDepositHeld) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventQueuedDepositHeld.Merge(m, src)
+}
+func (m *EventQueuedDepositHeld) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventQueuedDepositHeld) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventQueuedDepositHeld.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventQueuedDepositHeld proto.InternalMessageInfo
+
+func (m *EventQueuedDepositHeld) GetDepositId() uint64 {
+	if m != nil {
+		return m.DepositId
+	}
+	return 0
+}
+
+func (m *EventQueuedDepositHeld) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *EventQueuedDepositHeld) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterEnum("injective.peggy.v1.JailReason", JailReason_name, JailReason_value)
+	proto.RegisterType((*EventAttestationObserved)(nil), "injective.peggy.v1.EventAttestationObserved")
+	proto.RegisterType((*EventBridgeWithdrawCanceled)(nil), "injective.peggy.v1.EventBridgeWithdrawCanceled")
+	proto.RegisterType((*EventOutgoingBatch)(nil), "injective.peggy.v1.EventOutgoingBatch")
+	proto.RegisterType((*EventOutgoingBatchCanceled)(nil), "injective.peggy.v1.EventOutgoingBatchCanceled")
+	proto.RegisterType((*EventValsetUpdateRequest)(nil), "injective.peggy.v1.EventValsetUpdateRequest")
+	proto.RegisterType((*EventSetOrchestratorAddresses)(nil), "injective.peggy.v1.EventSetOrchestratorAddresses")
+	proto.RegisterType((*EventValsetConfirm)(nil), "injective.peggy.v1.EventValsetConfirm")
+	proto.RegisterType((*EventSendToEth)(nil), "injective.peggy.v1.EventSendToEth")
+	proto.RegisterType((*EventConfirmBatch)(nil), "injective.peggy.v1.EventConfirmBatch")
+	proto.RegisterType((*EventAttestationVote)(nil), "injective.peggy.v1.EventAttestationVote")
+	proto.RegisterType((*EventDepositClaim)(nil), "injective.peggy.v1.EventDepositClaim")
+	proto.RegisterType((*EventWithdrawClaim)(nil), "injective.peggy.v1.EventWithdrawClaim")
+	proto.RegisterType((*EventERC20DeployedClaim)(nil), "injective.peggy.v1.EventERC20DeployedClaim")
+	proto.RegisterType((*EventValsetUpdateClaim)(nil), "injective.peggy.v1.EventValsetUpdateClaim")
+	proto.RegisterType((*EventCancelSendToEth)(nil), "injective.peggy.v1.EventCancelSendToEth")
+	proto.RegisterType((*EventBumpSendToEthFee)(nil), "injective.peggy.v1.EventBumpSendToEthFee")
+	proto.RegisterType((*EventSubmitBadSignatureEvidence)(nil), "injective.peggy.v1.EventSubmitBadSignatureEvidence")
+	proto.RegisterType((*EventValidatorSlash)(nil), "injective.peggy.v1.EventValidatorSlash")
+	proto.RegisterType((*EventDepositReceived)(nil), "injective.peggy.v1.EventDepositReceived")
+	proto.RegisterType((*EventWithdrawalsCompleted)(nil), "injective.peggy.v1.EventWithdrawalsCompleted")
+	proto.RegisterType((*Withdrawal)(nil), "injective.peggy.v1.Withdrawal")
+	proto.RegisterType((*EventValidatorJailed)(nil), "injective.peggy.v1.EventValidatorJailed")
+	proto.RegisterType((*EventOutgoingLogicCall)(nil), "injective.peggy.v1.EventOutgoingLogicCall")
+	proto.RegisterType((*EventConfirmLogicCall)(nil), "injective.peggy.v1.EventConfirmLogicCall")
+	proto.RegisterType((*EventLogicCallTimeout)(nil), "injective.peggy.v1.EventLogicCallTimeout")
+	proto.RegisterType((*EventDepositQueued)(nil), "injective.peggy.v1.EventDepositQueued")
+	proto.RegisterType((*EventQueuedDepositReleased)(nil), "injective.peggy.v1.EventQueuedDepositReleased")
+	proto.RegisterType((*EventQueuedDepositHeld)(nil), "injective.peggy.v1.EventQueuedDepositHeld")
+}
+
+func init() { proto.RegisterFile("injective/peggy/v1/events.proto", fileDescriptor_95f217691d2f42c2) }
+
+var fileDescriptor_95f217691d2f42c2 = []byte{
+	// 1670 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xcc, 0x58, 0xcf, 0x6f, 0x1b, 0x4f,
+	0x15, 0xcf, 0xda, 0x8e, 0x13, 0xbf, 0x38, 0x4e, 0x32, 0xf5, 0x37, 0x75, 0x53, 0xe2, 0xa4, 0xdb,
+	0x42, 0x42, 0x51, 0xed, 0xb6, 0x50, 0x24, 0x24, 0x0e, 0x34, 0x6e, 0x4a, 0x53, 0xfa, 0x43, 0x6c,
+	0x42, 0x91, 0x10, 0x92, 0xb5, 0xde, 0x79, 0xb1, 0xb7, 0xd9, 0xdd, 0x31, 0x3b, 0x63, 0xa7, 0x39,
+	0xc3, 0x01, 0x24, 0x0e, 0x48, 0x88, 0x2b, 0x07, 0xfe, 0x03, 0x0e, 0xfc, 0x09, 0x48, 0xe5, 0xd6,
+	0x13, 0x42, 0x3d, 0x54, 0xa8, 0xfd, 0x0f, 0x90, 0x38, 0xc0, 0x09, 0xed, 0xcc, 0xec, 0x7a, 0xfd,
+	0x0b, 0xf2, 0xa3, 0x2d, 0xdf, 0x93, 0x3d, 0x6f, 0xde, 0x9b, 0x79, 0xef, 0xcd, 0x67, 0xde, 0xe7,
+	0xed, 0xc0, 0x86, 0x1b, 0xbc, 0x44, 0x47, 0xb8, 0x7d, 0xac, 0x77, 0xb1, 0xdd, 0x3e, 0xa9, 0xf7,
+	0xef, 0xd4, 0xb1, 0x8f, 0x81, 0xe0, 0xb5, 0x6e, 0xc8, 0x04, 0x23, 0x24, 0x51, 0xa8, 0x49, 0x85,
+	0x5a, 0xff, 0xce, 0x5a, 0xb9, 0xcd, 0xda, 0x4c, 0x4e, 0xd7, 0xa3, 0x7f, 0x4a, 0x73, 0xed, 0xc6,
+	0x84, 0xa5, 0x6c, 0x21, 0x90, 0x0b, 0x5b, 0xb8, 0x2c, 0xd0, 0x5a, 0xd5, 0x09, 0x5a, 0xe2, 0xa4,
+	0x8b, 0x7a, 0x3f, 0xf3, 0x1f, 0x06, 0x54, 0x76, 0x23, 0x07, 0xee, 0x0f, 0x4c, 0x9f, 0xb7, 0x38,
+	0x86, 0x7d, 0xa4, 0xe4, 0x11, 0x2c, 0xa7, 0x56, 0x6c, 0x46, 0x76, 0x15, 0x63, 0xd3, 0xd8, 0x2e,
+	0xdd, 0x5d, 0xaf, 0x8d, 0xfb, 0x59, 0x6b, 0x78, 0xb6, 0xeb, 0x1f, 0x9c, 0x74, 0xd1, 0x5a, 0x4a,
+	0x99, 0x45, 0x02, 0xb2, 0x05, 0x4b, 0xad, 0xd0, 0xa5, 0x6d, 0x6c, 0x3a, 0x2c, 0x10, 0xa1, 0xed,
+	0x88, 0x4a, 0x66, 0xd3, 0xd8, 0x2e, 0x58, 0x25, 0x25, 0x6e, 0x68, 0x29, 0xf9, 0xda, 0x40, 0xb1,
+	0x63, 0xbb, 0x41, 0xd3, 0xa5, 0x95, 0xec, 0xa6, 0xb1, 0x9d, 0xb3, 0x16, 0xb5, 0x62, 0x24, 0xdd,
+	0xa3, 0xe4, 0xab, 0x50, 0x4a, 0xbb, 0xe6, 0xd2, 0x4a, 0x6e, 0xd3, 0xd8, 0x2e, 0x5a, 0x8b, 0x29,
+	0xe9, 0x1e, 0x25, 0x65, 0x98, 0x0d, 0x58, 0xe0, 0x60, 0x65, 0x56, 0x2e, 0xa2, 0x06, 0x66, 0x00,
+	0x57, 0x65, 0xcc, 0x3b, 0x72, 0xc9, 0x1f, 0xbb, 0xa2, 0x43, 0x43, 0xfb, 0xb8, 0x61, 0x07, 0x0e,
+	0x7a, 0x48, 0x27, 0x39, 0x6b, 0x9c, 0xd6, 0xd9, 0xcc, 0x04, 0x67, 0xcd, 0x3f, 0x1b, 0x40, 0xe4,
+	0x86, 0xcf, 0x7b, 0xa2, 0xcd, 0xdc, 0xa0, 0xbd, 0x63, 0x0b, 0xa7, 0x13, 0x39, 0x47, 0x31, 0x60,
+	0xbe, 0x5e, 0x5d, 0x0d, 0xc8, 0x1d, 0x28, 0xb3, 0xd0, 0xe9, 0x20, 0x17, 0xa1, 0x2d, 0x58, 0xd8,
+	0xb4, 0x29, 0x0d, 0x91, 0x73, 0x9d, 0xaf, 0x4b, 0xe9, 0xb9, 0xfb, 0x6a, 0x8a, 0x6c, 0xc0, 0x42,
+	0x2b, 0x5a, 0xb1, 0xa9, 0x62, 0x55, 0x09, 0x03, 0x29, 0x7a, 0x16, 0x49, 0xc8, 0x75, 0x58, 0x54,
+	0x0a, 0xc2, 0xf5, 0x91, 0xf5, 0x84, 0x4c, 0x56, 0xce, 0x2a, 0x4a, 0xe1, 0x81, 0x92, 0x91, 0x4d,
+	0x28, 0x6a, 0xa5, 0x57, 0x4d, 0x97, 0xf2, 0xca, 0xec, 0x66, 0x36, 0x59, 0xe6, 0xe0, 0xd5, 0x1e,
+	0xe5, 0xe6, 0xef, 0x0d, 0x58, 0x1b, 0x8f, 0xe3, 0x93, 0xe5, 0x8d, 0x5c, 0x81, 0x79, 0xe5, 0x51,
+	0x82, 0x82, 0x39, 0x39, 0x4e, 0x1f, 0x6c, 0x2e, 0x7d, 0xb0, 0xbf, 0xcb, 0x68, 0x34, 0xbf, 0xb0,
+	0x3d, 0x8e, 0xe2, 0x47, 0x5d, 0x6a, 0x0b, 0xb4, 0xf0, 0x67, 0x3d, 0xe4, 0x82, 0x5c, 0x83, 0x62,
+	0x5f, 0x8a, 0x75, 0x9a, 0x0c, 0x69, 0xb9, 0xa0, 0x64, 0x49, 0x9e, 0xb4, 0x4a, 0x07, 0xdd, 0x76,
+	0x47, 0x68, 0xb7, 0xb4, 0xdd, 0x23, 0x29, 0x23, 0x8f, 0xa1, 0xa4, 0x95, 0x7c, 0xf4, 0x5b, 0x18,
+	0xf2, 0x4a, 0x76, 0x33, 0xbb, 0xbd, 0x70, 0xf7, 0xfa, 0xa4, 0x3b, 0xa1, 0x20, 0xf6, 0xc2, 0xf6,
+	0x5c, 0x1a, 0x9d, 0x98, 0xa5, 0xd7, 0x7f, 0xaa, 0x2c, 0xc9, 0x0e, 0x2c, 0x86, 0x78, 0x6c, 0x87,
+	0xb4, 0x69, 0xfb, 0xac, 0x17, 0xa8, 0x83, 0x29, 0xec, 0xac, 0xbf, 0x7e, 0xb7, 0x31, 0xf3, 0xf6,
+	0xdd, 0xc6, 0x17, 0x0e, 0xe3, 0x3e, 0xe3, 0x9c, 0x1e, 0xd5, 0x5c, 0x56, 0xf7, 0x6d, 0xd1, 0xa9,
+	0xed, 0x05, 0xc2, 0x2a, 0x2a, 0x9b, 0xfb, 0xd2, 0x24, 0x8a, 0x4b, 0xaf, 0x21, 0xd8, 0x11, 0x06,
+	0x12, 0xea, 0x05, 0x6b, 0x41, 0xc9, 0x0e, 0x22, 0x91, 0xf9, 0x47, 0x03, 0xd6, 0x65, 0x5e, 0xf6,
+	0x51, 0x3c, 0x1f, 0x07, 0x10, 0x72, 0xf2, 0x0d, 0x58, 0xe9, 0xc7, 0x4e, 0x26, 0x90, 0x53, 0xa7,
+	0xb7, 0x9c, 0x4c, 0xc4, 0x78, 0x3b, 0x07, 0x44, 0x6f, 0x43, 0x99, 0x75, 0x51, 0xa9, 0xa3, 0xe8,
+	0x24, 0x26, 0x59, 0x69, 0x42, 0xe2, 0xb9, 0x5d, 0xd1, 0xd1, 0x16, 0xe6, 0x4b, 0x20, 0xa9, 0xa3,
+	0x6c, 0xb0, 0xe0, 0xd0, 0x0d, 0xfd, 0xd3, 0x1c, 0xe2, 0xd9, 0xbd, 0x33, 0x7f, 0x9e, 0x81, 0x92,
+	0xce, 0x4f, 0x40, 0x0f, 0xd8, 0xae, 0xe8, 0x90, 0x1b, 0x50, 0x62, 0x1a, 0xe5, 0xea, 0x42, 0xe8,
+	0xad, 0x8a, 0xb1, 0x34, 0xba, 0x12, 0x64, 0x15, 0xf2, 0x1c, 0x03, 0x8a, 0xa1, 0x5e, 0x5d, 0x8f,
+	0xc8, 0x1a, 0xcc, 0x87, 0xe8, 0xa0, 0xdb, 0xc7, 0x50, 0x87, 0x98, 0x8c, 0xc9, 0xf7, 0x21, 0x3f,
+	0x74, 0xd8, 0x75, 0x7d, 0xd8, 0x5b, 0x6d, 0x57, 0x74, 0x7a, 0xad, 0x9a, 0xc3, 0xfc, 0xba, 0x3a,
+	0x77, 0xfd, 0x73, 0x8b, 0xd3, 0x23, 0x5d, 0xb4, 0x1b, 0xcc, 0x0d, 0x2c, 0x6d, 0x4e, 0x9e, 0x01,
+	0xe8, 0x6b, 0x74, 0x88, 0xaa, 0xc2, 0x9d, 0x63, 0xb1, 0x82, 0x5a, 0xe2, 0x21, 0xa2, 0xd9, 0x86,
+	0x15, 0x99, 0x04, 0x9d, 0x6b, 0x55, 0xa4, 0x46, 0x6a, 0x8b, 0x31, 0x56, 0x5b, 0xce, 0x91, 0x6e,
+	0x01, 0xe5, 0x51, 0xce, 0x79, 0xc1, 0x04, 0x46, 0x7b, 0x49, 0x32, 0x1c, 0xde, 0x4b, 0x8a, 0xd4,
+	0x5e, 0xe3, 0x55, 0x3f, 0x33, 0xa5, 0xea, 0xf7, 0x99, 0x48, 0x52, 0xaf, 0x06, 0xe6, 0x3f, 0x33,
+	0x3a, 0xbe, 0x07, 0xd8, 0x65, 0xdc, 0x15, 0x92, 0xae, 0xfe, 0xf7, 0x9e, 0xd7, 0xa0, 0xa8, 0x14,
+	0x86, 0x4a, 0x82, 0x32, 0xd2, 0x15, 0x61, 0xdc, 0xad, 0xec, 0x24, 0xb7, 0xb6, 0x60, 0x09, 0x45,
+	0x07, 0x43, 0xec, 0xf9, 0x4d, 0x8d, 0x9a, 0x9c, 0xaa, 0x8f, 0xb1, 0x78, 0x5f, 0x4a, 0x23, 0x45,
+	0x75, 0x58, 0xcd, 0x04, 0x44, 0xea, 0x52, 0x97, 0x94, 0xd8, 0xd2, 0xd2, 0x68, 0x63, 0x79, 0xe7,
+	0x07, 0x05, 0x37, 0x2f, 0xf5, 0x16, 0xa5, 0x34, 0xa9, 0xb7, 0xf7, 0x12, 0xc4, 0xcd, 0x9d, 0xa6,
+	0xbc, 0xc4, 0xf8, 0x9a, 0x76, 0xb2, 0xf3, 0xd3, 0xaf, 0x39, 0x81, 0x1c, 0xb5, 0x85, 0x5d, 0x29,
+	0x48, 0x15, 0xf9, 0xdf, 0xfc, 0x77, 0xcc, 0x7e, 0x09, 0xd1, 0x7e, 0xee, 0xc4, 0x8f, 0x60, 0x38,
+	0x37, 0x86, 0xe1, 0xf1, 0x3c, 0xce, 0x4e, 0xca, 0xe3, 0xb4, 0x84, 0xe4, 0xa7, 0x43, 0xfd, 0x2f,
+	0x19, 0xb8, 0x2c, 0x83, 0xdf, 0xb5, 0x1a, 0x77, 0x6f, 0x3f, 0xc0, 0xae, 0xc7, 0x4e, 0x90, 0x7e,
+	0xf6, 0x0c, 0x5c, 0x83, 0xa2, 0x46, 0x94, 0xea, 0x38, 0x14, 0xee, 0x16, 0x94, 0xec, 0x41, 0x24,
+	0x3a, 0x6d, 0x0e, 0x08, 0xe4, 0x02, 0xdb, 0x47, 0x1d, 0xb3, 0xfc, 0x2f, 0xab, 0xe0, 0x89, 0xdf,
+	0x62, 0x9e, 0xc2, 0x97, 0xa5, 0x47, 0x51, 0x15, 0xa4, 0xe8, 0xb8, 0xbe, 0xed, 0x29, 0xd0, 0xe4,
+	0xac, 0x64, 0x3c, 0x35, 0x97, 0x85, 0xe9, 0xb9, 0xfc, 0x75, 0x16, 0x56, 0xc7, 0xd8, 0xfd, 0xff,
+	0x91, 0xca, 0x21, 0x06, 0xca, 0x8d, 0x33, 0xd0, 0x78, 0x87, 0x30, 0xfb, 0xf1, 0x3a, 0x84, 0xfc,
+	0xc5, 0x3b, 0x84, 0xb9, 0xb1, 0x0e, 0xe1, 0x1c, 0x77, 0xdd, 0xfc, 0xae, 0xae, 0xe2, 0xaa, 0xff,
+	0x3b, 0x23, 0x73, 0x9a, 0xff, 0x32, 0xe0, 0x0b, 0xd5, 0x84, 0xf7, 0xfc, 0x6e, 0x62, 0xfc, 0x10,
+	0xf1, 0x82, 0xcc, 0x6b, 0x41, 0xf1, 0x10, 0xb1, 0xe9, 0x06, 0x4e, 0x88, 0x36, 0x57, 0xcd, 0xf0,
+	0x39, 0x68, 0x71, 0xe1, 0x10, 0x71, 0x4f, 0xaf, 0x31, 0x42, 0xb4, 0xb9, 0x0b, 0x13, 0xed, 0x2f,
+	0x0d, 0xd8, 0x50, 0xed, 0x46, 0xaf, 0xe5, 0xbb, 0x62, 0xc7, 0xa6, 0xfb, 0x6e, 0x3b, 0xb0, 0x45,
+	0x2f, 0xc4, 0xdd, 0xbe, 0x4b, 0x31, 0xc2, 0xd0, 0x4d, 0x58, 0x69, 0xd9, 0x54, 0xf6, 0x4a, 0x3c,
+	0x9e, 0xd4, 0x0d, 0xd9, 0x52, 0xcb, 0xa6, 0xbb, 0xa2, 0x93, 0xd8, 0x90, 0xef, 0xc0, 0x95, 0x31,
+	0xdd, 0x26, 0xef, 0xb5, 0x22, 0xac, 0xe9, 0xf4, 0xac, 0x8e, 0xd8, 0xec, 0xab, 0x59, 0xf3, 0x4f,
+	0x06, 0x5c, 0x8a, 0xef, 0x94, 0x02, 0xe0, 0xbe, 0x67, 0x73, 0xf9, 0x6d, 0xd2, 0x65, 0xc7, 0x18,
+	0xca, 0x2d, 0xb3, 0x96, 0x1a, 0x44, 0x49, 0x8f, 0x32, 0xc2, 0x82, 0x38, 0xe9, 0x6a, 0x14, 0x75,
+	0x8f, 0x0e, 0x0b, 0x38, 0x06, 0xbc, 0xc7, 0x47, 0x5a, 0xbb, 0xe5, 0x64, 0x22, 0xe6, 0x88, 0xaf,
+	0xc3, 0x72, 0xd2, 0x0a, 0xc6, 0xba, 0xaa, 0x1e, 0x2d, 0xc5, 0xf2, 0x58, 0xb5, 0x02, 0x73, 0x3e,
+	0x0b, 0xdc, 0xa3, 0x84, 0x00, 0xe3, 0xa1, 0xf9, 0x5b, 0x03, 0xca, 0x69, 0x32, 0xd7, 0x94, 0x98,
+	0xc6, 0x85, 0x31, 0xb5, 0x23, 0xcb, 0x4c, 0xed, 0xc8, 0xb2, 0x17, 0xea, 0xc8, 0x4c, 0x0e, 0x57,
+	0x86, 0x98, 0xce, 0xf6, 0x78, 0x83, 0xf9, 0x5d, 0x0f, 0x05, 0xd2, 0x29, 0x9f, 0x7b, 0xdf, 0x83,
+	0x85, 0xe3, 0x81, 0x76, 0x25, 0x23, 0x0b, 0x45, 0x75, 0x52, 0xa1, 0x18, 0x2c, 0x6a, 0xa5, 0x4d,
+	0xcc, 0x63, 0x80, 0xc1, 0xd4, 0xb9, 0xe2, 0xbf, 0x37, 0x12, 0xff, 0xe9, 0xfa, 0x03, 0xf3, 0xaf,
+	0xf1, 0x19, 0x24, 0xd8, 0x79, 0x6c, 0xbb, 0xd1, 0x87, 0xe0, 0xb7, 0x13, 0x98, 0xa8, 0xd7, 0x82,
+	0x89, 0xe1, 0x44, 0xba, 0x96, 0xd4, 0x4a, 0x60, 0x94, 0x80, 0x2e, 0x93, 0x06, 0xdd, 0xe7, 0x07,
+	0xd7, 0x3b, 0x03, 0x56, 0x87, 0xbe, 0x73, 0x9f, 0xb0, 0xb6, 0xeb, 0x34, 0x6c, 0xcf, 0x23, 0x5f,
+	0x81, 0x42, 0xa8, 0xbe, 0x27, 0x93, 0x0c, 0x0f, 0x04, 0xe4, 0x5b, 0xb0, 0xea, 0x45, 0xaa, 0x09,
+	0x87, 0x8e, 0x74, 0xc3, 0x65, 0x39, 0x1b, 0x73, 0x69, 0xec, 0xc8, 0x16, 0x2c, 0xb9, 0x81, 0xfe,
+	0xc8, 0x1a, 0x62, 0x9e, 0x52, 0x5a, 0xbc, 0x47, 0xc9, 0x2d, 0x20, 0x43, 0x8a, 0x69, 0x02, 0x5a,
+	0x49, 0xcf, 0x28, 0x1a, 0xaa, 0xc0, 0x5c, 0xfc, 0xbd, 0xaf, 0x9e, 0x3f, 0xe2, 0xa1, 0xf9, 0x87,
+	0xb8, 0xf8, 0xea, 0x56, 0x7f, 0x10, 0xdf, 0x04, 0x5f, 0x8c, 0x33, 0xf8, 0x92, 0x99, 0xe6, 0xcb,
+	0x34, 0x7e, 0xc9, 0x4e, 0xe7, 0x97, 0x5f, 0xc5, 0x4e, 0x26, 0xde, 0xc5, 0x2f, 0x15, 0x9f, 0xca,
+	0xc9, 0x54, 0xc2, 0xb2, 0xc3, 0x09, 0xfb, 0x45, 0x06, 0x48, 0xba, 0xdc, 0xfc, 0xb0, 0x87, 0x3d,
+	0xa4, 0x64, 0x1d, 0x80, 0x2a, 0xc1, 0x80, 0xa6, 0x0a, 0x5a, 0xa2, 0x1e, 0xa9, 0x46, 0x5a, 0xaa,
+	0xcc, 0x7f, 0x6f, 0xcf, 0xcf, 0x72, 0xfd, 0x3e, 0xc1, 0xe7, 0xc4, 0x48, 0x17, 0x95, 0x1f, 0xed,
+	0xa2, 0xcc, 0xb7, 0xf1, 0x03, 0x90, 0x8a, 0x3f, 0xa9, 0xbd, 0x1e, 0xda, 0xfc, 0x4b, 0x90, 0x8e,
+	0xd1, 0x28, 0x73, 0x13, 0xa3, 0x5c, 0x85, 0xfc, 0x21, 0x0b, 0x1d, 0xa4, 0x32, 0x0b, 0xf3, 0x96,
+	0x1e, 0x99, 0x7d, 0x58, 0x1d, 0x8f, 0xed, 0x11, 0x7a, 0x1f, 0x2b, 0xae, 0x01, 0x79, 0x66, 0xd3,
+	0xe4, 0x79, 0xf3, 0xa7, 0x00, 0x83, 0x5a, 0x48, 0x2a, 0x50, 0x7e, 0xea, 0x72, 0xee, 0x06, 0xed,
+	0xa1, 0x87, 0x8f, 0xe5, 0x19, 0x72, 0x19, 0x2e, 0xe9, 0x19, 0xf5, 0xec, 0xa6, 0x27, 0x0c, 0x72,
+	0x15, 0x2e, 0xeb, 0x89, 0xe4, 0xa6, 0xc4, 0x93, 0x99, 0x1d, 0x7c, 0xfd, 0xbe, 0x6a, 0xbc, 0x79,
+	0x5f, 0x35, 0xfe, 0xfe, 0xbe, 0x6a, 0xfc, 0xe6, 0x43, 0x75, 0xe6, 0xcd, 0x87, 0xea, 0xcc, 0xdf,
+	0x3e, 0x54, 0x67, 0x7e, 0xf2, 0x83, 0x14, 0xbb, 0xed, 0xc5, 0xf5, 0xf9, 0x89, 0xdd, 0xe2, 0xf5,
+	0xa4, 0x5a, 0xdf, 0x72, 0x58, 0x88, 0xe9, 0x61, 0xf4, 0x30, 0x57, 0xf7, 0x19, 0xed, 0x79, 0xc8,
+	0xf5, 0x83, 0xb2, 0xa4, 0xc1, 0x56, 0x5e, 0x3e, 0x27, 0x7f, 0xf3, 0x3f, 0x03, 0x00, 0x46, 0x50,
+	0xd7, 0xd0, 0xe1, 0x16, 0x00, 0x00,
+}
+
+func (m *EventAttestationObserved) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventAttestationObserved) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventOutgoingBatchCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventAttestationObserved) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -1771,29 +2105,36 @@ func (m *EventOutgoingBatchCanceled) MarshalToSizedBuffer(dAtA []byte) (int, err
 	if m.Nonce != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0x20
+		dAtA[i] = 0x28
 	}
-	if m.BatchId != 0 {
-		i = encodeVarintEvents(dAtA, i, uint64(m.BatchId))
+	if len(m.AttestationId) > 0 {
+		i -= len(m.AttestationId)
+		copy(dAtA[i:], m.AttestationId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.AttestationId)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x22
 	}
 	if m.BridgeChainId != 0 {
 		i = encodeVarintEvents(dAtA, i, uint64(m.BridgeChainId))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x18
 	}
 	if len(m.BridgeContract) > 0 {
 		i -= len(m.BridgeContract)
 		copy(dAtA[i:], m.BridgeContract)
 		i = encodeVarintEvents(dAtA, i, uint64(len(m.BridgeContract)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if m.AttestationType != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.AttestationType))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *EventValsetUpdateRequest) Marshal() (dAtA []byte, err error) {
+func (m *EventBridgeWithdrawCanceled) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -1803,30 +2144,175 @@ func (m *EventValsetUpdateRequest) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *EventValsetUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+func (m *EventBridgeWithdrawCanceled) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *EventValsetUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *EventBridgeWithdrawCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.RewardToken) > 0 {
-		i -= len(m.RewardToken)
-		copy(dAtA[i:], m.RewardToken)
-		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardToken)))
+	if m.BridgeChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BridgeChainId))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x10
 	}
-	{
-		size := m.RewardAmount.Size()
-		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintEvents(dAtA, i, uint64(size))
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOutgoingBatch) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutgoingBatch) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutgoingBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.BatchTxIds) > 0 {
+		dAtA2 := make([]byte, len(m.BatchTxIds)*10)
+		var j1 int
+		for _, num := range m.BatchTxIds {
+			for num >= 1<<7 {
+				dAtA2[j1] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j1++
+			}
+			dAtA2[j1] = uint8(num)
+			j1++
+		}
+		i -= j1
+		copy(dAtA[i:], dAtA2[:j1])
+		i = encodeVarintEvents(dAtA, i, uint64(j1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.BatchTimeout != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BatchTimeout))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventOutgoingBatchCanceled) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventOutgoingBatchCanceled) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutgoingBatchCanceled) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Nonce))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.BatchId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BatchId))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BridgeChainId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.BridgeChainId))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.BridgeContract) > 0 {
+		i -= len(m.BridgeContract)
+		copy(dAtA[i:], m.BridgeContract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.BridgeContract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventValsetUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventValsetUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventValsetUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.RewardToken)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
 	}
 	i--
 	dAtA[i] = 0x22
@@ -2410,6 +2896,61 @@ func (m *EventCancelSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventBumpSendToEthFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventBumpSendToEthFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventBumpSendToEthFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BridgeFee.Size()
+		i -= size
+		if _, err := m.BridgeFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size := m.FeeIncrease.Size()
+		i -= size
+		if _, err := m.FeeIncrease.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.OutgoingTxId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.OutgoingTxId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *EventSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -2695,45 +3236,349 @@ func (m *EventValidatorJailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
-	offset -= sovEvents(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EventOutgoingLogicCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EventAttestationObserved) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EventOutgoingLogicCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventOutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.AttestationType != 0 {
-		n += 1 + sovEvents(uint64(m.AttestationType))
+	if m.Timeout != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x28
 	}
-	l = len(m.BridgeContract)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x20
 	}
-	if m.BridgeChainId != 0 {
-		n += 1 + sovEvents(uint64(m.BridgeChainId))
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.AttestationId)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if len(m.LogicContractAddress) > 0 {
+		i -= len(m.LogicContractAddress)
+		copy(dAtA[i:], m.LogicContractAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.LogicContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		n += 1 + sovEvents(uint64(m.Nonce))
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *EventBridgeWithdrawCanceled) Size() (n int) {
-	if m == nil {
+func (m *EventConfirmLogicCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventConfirmLogicCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventConfirmLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.OrchestratorAddress) > 0 {
+		i -= len(m.OrchestratorAddress)
+		copy(dAtA[i:], m.OrchestratorAddress)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.OrchestratorAddress)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventLogicCallTimeout) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventLogicCallTimeout) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventLogicCallTimeout) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Timeout != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventDepositQueued) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventDepositQueued) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventDepositQueued) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DepositId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueuedDepositReleased) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueuedDepositReleased) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueuedDepositReleased) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Forced {
+		i--
+		if m.Forced {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DepositId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventQueuedDepositHeld) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventQueuedDepositHeld) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventQueuedDepositHeld) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.DepositId != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.DepositId))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
+	offset -= sovEvents(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EventAttestationObserved) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.AttestationType != 0 {
+		n += 1 + sovEvents(uint64(m.AttestationType))
+	}
+	l = len(m.BridgeContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.BridgeChainId != 0 {
+		n += 1 + sovEvents(uint64(m.BridgeChainId))
+	}
+	l = len(m.AttestationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Nonce != 0 {
+		n += 1 + sovEvents(uint64(m.Nonce))
+	}
+	return n
+}
+
+func (m *EventBridgeWithdrawCanceled) Size() (n int) {
+	if m == nil {
 		return 0
 	}
 	var l int
@@ -3087,17 +3932,37 @@ func (m *EventCancelSendToEth) Size() (n int) {
 	return n
 }
 
-func (m *EventSubmitBadSignatureEvidence) Size() (n int) {
+func (m *EventBumpSendToEthFee) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.BadEthSignature)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if m.OutgoingTxId != 0 {
+		n += 1 + sovEvents(uint64(m.OutgoingTxId))
 	}
-	l = len(m.BadEthSignatureSubject)
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.FeeIncrease.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventSubmitBadSignatureEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.BadEthSignature)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.BadEthSignatureSubject)
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
@@ -3129,100 +3994,1316 @@ func (m *EventValidatorSlash) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovEvents(uint64(l))
 	}
-	return n
-}
+	return n
+}
+
+func (m *EventDepositReceived) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventWithdrawalsCompleted) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if len(m.Withdrawals) > 0 {
+		for _, e := range m.Withdrawals {
+			l = e.Size()
+			n += 1 + l + sovEvents(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *Withdrawal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Receiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventValidatorJailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Reason != 0 {
+		n += 1 + sovEvents(uint64(m.Reason))
+	}
+	if m.Power != 0 {
+		n += 1 + sovEvents(uint64(m.Power))
+	}
+	l = len(m.ConsensusAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.OperatorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Moniker)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventOutgoingLogicCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.LogicContractAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovEvents(uint64(m.InvalidationNonce))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovEvents(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *EventConfirmLogicCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovEvents(uint64(m.InvalidationNonce))
+	}
+	l = len(m.OrchestratorAddress)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventLogicCallTimeout) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovEvents(uint64(m.InvalidationNonce))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovEvents(uint64(m.Timeout))
+	}
+	return n
+}
+
+func (m *EventDepositQueued) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositId != 0 {
+		n += 1 + sovEvents(uint64(m.DepositId))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovEvents(uint64(m.EventNonce))
+	}
+	return n
+}
+
+func (m *EventQueuedDepositReleased) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositId != 0 {
+		n += 1 + sovEvents(uint64(m.DepositId))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.Forced {
+		n += 2
+	}
+	return n
+}
+
+func (m *EventQueuedDepositHeld) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DepositId != 0 {
+		n += 1 + sovEvents(uint64(m.DepositId))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func sovEvents(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozEvents(x uint64) (n int) {
+	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventAttestationObserved: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventAttestationObserved: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+			}
+			m.AttestationType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationType |= ClaimType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationId == nil {
+				m.AttestationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBridgeWithdrawCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBridgeWithdrawCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBridgeWithdrawCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutgoingBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutgoingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			}
+			m.BatchTimeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchTimeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.BatchTxIds = append(m.BatchTxIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowEvents
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthEvents
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthEvents
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.BatchTxIds) == 0 {
+					m.BatchTxIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowEvents
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.BatchTxIds = append(m.BatchTxIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchTxIds", wireType)
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventOutgoingBatchCanceled: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventOutgoingBatchCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			}
+			m.BridgeChainId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BridgeChainId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			}
+			m.BatchId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValsetUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValsetUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHeight", wireType)
+			}
+			m.ValsetHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetMembers = append(m.ValsetMembers, &BridgeValidator{})
+			if err := m.ValsetMembers[len(m.ValsetMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetOrchestratorAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetOrchestratorAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorEthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OperatorEthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *EventDepositReceived) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
+	return nil
 }
-
-func (m *EventWithdrawalsCompleted) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	if len(m.Withdrawals) > 0 {
-		for _, e := range m.Withdrawals {
-			l = e.Size()
-			n += 1 + l + sovEvents(uint64(l))
+func (m *EventValsetConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValsetConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *Withdrawal) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Receiver)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovEvents(uint64(l))
-	return n
-}
 
-func (m *EventValidatorJailed) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Reason != 0 {
-		n += 1 + sovEvents(uint64(m.Reason))
-	}
-	if m.Power != 0 {
-		n += 1 + sovEvents(uint64(m.Power))
-	}
-	l = len(m.ConsensusAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.OperatorAddress)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
-	}
-	l = len(m.Moniker)
-	if l > 0 {
-		n += 1 + l + sovEvents(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovEvents(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozEvents(x uint64) (n int) {
-	return sovEvents(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
+func (m *EventSendToEth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3245,17 +5326,17 @@ func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttestationObserved: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventSendToEth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttestationObserved: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
 			}
-			m.AttestationType = 0
+			m.OutgoingTxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3265,14 +5346,14 @@ func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.AttestationType |= ClaimType(b&0x7F) << shift
+				m.OutgoingTxId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3300,13 +5381,13 @@ func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BridgeContract = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.BridgeChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3316,16 +5397,29 @@ func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3335,31 +5429,31 @@ func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttestationId == nil {
-				m.AttestationId = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3369,11 +5463,26 @@ func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3395,7 +5504,7 @@ func (m *EventAttestationObserved) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventBridgeWithdrawCanceled) Unmarshal(dAtA []byte) error {
+func (m *EventConfirmBatch) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3418,17 +5527,17 @@ func (m *EventBridgeWithdrawCanceled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventBridgeWithdrawCanceled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConfirmBatch: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventBridgeWithdrawCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			var stringLen uint64
+			m.BatchNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3438,29 +5547,16 @@ func (m *EventBridgeWithdrawCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BatchNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BridgeContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
-			m.BridgeChainId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3470,11 +5566,24 @@ func (m *EventBridgeWithdrawCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3496,7 +5605,7 @@ func (m *EventBridgeWithdrawCanceled) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
+func (m *EventAttestationVote) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3519,17 +5628,17 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutgoingBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventAttestationVote: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutgoingBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventAttestationVote: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3539,29 +5648,16 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3571,48 +5667,31 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationId == nil {
+				m.AttestationId = []byte{}
+			}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
-			}
-			m.BatchNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchTimeout", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
 			}
-			m.BatchTimeout = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3622,87 +5701,24 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchTimeout |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
-			if wireType == 0 {
-				var v uint64
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint64(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.BatchTxIds = append(m.BatchTxIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowEvents
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthEvents
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthEvents
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.BatchTxIds) == 0 {
-					m.BatchTxIds = make([]uint64, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint64
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowEvents
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint64(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.BatchTxIds = append(m.BatchTxIds, v)
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
 				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchTxIds", wireType)
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Voter = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -3724,7 +5740,7 @@ func (m *EventOutgoingBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
+func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -3747,17 +5763,17 @@ func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventOutgoingBatchCanceled: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDepositClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventOutgoingBatchCanceled: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDepositClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeContract", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			var stringLen uint64
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3767,29 +5783,16 @@ func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.BridgeContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeChainId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
 			}
-			m.BridgeChainId = 0
+			m.EventHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3799,16 +5802,16 @@ func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BridgeChainId |= uint64(b&0x7F) << shift
+				m.EventHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
 			}
-			m.BatchId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3818,16 +5821,31 @@ func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationId == nil {
+				m.AttestationId = []byte{}
+			}
+			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
-			m.Nonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3837,66 +5855,29 @@ func (m *EventOutgoingBatchCanceled) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventValsetUpdateRequest: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValsetUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
-			m.ValsetNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3906,16 +5887,29 @@ func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetHeight", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
 			}
-			m.ValsetHeight = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3925,16 +5919,29 @@ func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMembers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -3944,29 +5951,29 @@ func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValsetMembers = append(m.ValsetMembers, &BridgeValidator{})
-			if err := m.ValsetMembers[len(m.ValsetMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -3994,13 +6001,11 @@ func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4028,7 +6033,7 @@ func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4051,7 +6056,7 @@ func (m *EventValsetUpdateRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
+func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4070,21 +6075,59 @@ func (m *EventSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSetOrchestratorAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSetOrchestratorAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawClaim: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
+			}
+			m.EventHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValidatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4094,27 +6137,48 @@ func (m *EventSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ValidatorAddress = string(dAtA[iNdEx:postIndex])
+			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationId == nil {
+				m.AttestationId = []byte{}
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+			}
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4142,11 +6206,11 @@ func (m *EventSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorEthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4174,7 +6238,7 @@ func (m *EventSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorEthAddress = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4197,7 +6261,7 @@ func (m *EventSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValsetConfirm) Unmarshal(dAtA []byte) error {
+func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4220,17 +6284,17 @@ func (m *EventValsetConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValsetConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventERC20DeployedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.ValsetNonce = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4240,16 +6304,16 @@ func (m *EventValsetConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetNonce |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
 			}
-			var stringLen uint64
+			m.EventHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4259,79 +6323,16 @@ func (m *EventValsetConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.EventHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventSendToEth) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventSendToEth: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
 			}
-			m.OutgoingTxId = 0
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4341,14 +6342,29 @@ func (m *EventSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutgoingTxId |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.AttestationId == nil {
+				m.AttestationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4376,11 +6392,11 @@ func (m *EventSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4408,11 +6424,11 @@ func (m *EventSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4440,13 +6456,11 @@ func (m *EventSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4474,65 +6488,13 @@ func (m *EventSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Symbol = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventConfirmBatch) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventConfirmBatch: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
 			}
-			m.BatchNonce = 0
+			m.Decimals = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4542,12 +6504,12 @@ func (m *EventConfirmBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				m.Decimals |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
+		case 9:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
@@ -4600,7 +6562,7 @@ func (m *EventConfirmBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventAttestationVote) Unmarshal(dAtA []byte) error {
+func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4623,10 +6585,10 @@ func (m *EventAttestationVote) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventAttestationVote: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValsetUpdateClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventAttestationVote: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValsetUpdateClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -4649,6 +6611,25 @@ func (m *EventAttestationVote) Unmarshal(dAtA []byte) error {
 				}
 			}
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
+			}
+			m.EventHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
 			}
@@ -4682,9 +6663,62 @@ func (m *EventAttestationVote) Unmarshal(dAtA []byte) error {
 				m.AttestationId = []byte{}
 			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			}
+			m.ValsetNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ValsetNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voter", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMembers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ValsetMembers = append(m.ValsetMembers, &BridgeValidator{})
+			if err := m.ValsetMembers[len(m.ValsetMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4712,7 +6746,73 @@ func (m *EventAttestationVote) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Voter = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4735,7 +6835,7 @@ func (m *EventAttestationVote) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
+func (m *EventCancelSendToEth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4758,36 +6858,17 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDepositClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventCancelSendToEth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDepositClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
-			}
-			m.EventNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
 			}
-			m.EventHeight = 0
+			m.OutgoingTxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4797,50 +6878,66 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventHeight |= uint64(b&0x7F) << shift
+				m.OutgoingTxId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			if byteLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventBumpSendToEthFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttestationId == nil {
-				m.AttestationId = []byte{}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventBumpSendToEthFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventBumpSendToEthFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
 			}
-			var stringLen uint64
+			m.OutgoingTxId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -4850,27 +6947,14 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.OutgoingTxId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4898,11 +6982,11 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4930,11 +7014,13 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			if err := m.FeeIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4962,13 +7048,63 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 8:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSubmitBadSignatureEvidence: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4996,11 +7132,11 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.BadEthSignature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignatureSubject", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5028,7 +7164,7 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.BadEthSignatureSubject = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5051,7 +7187,7 @@ func (m *EventDepositClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
+func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5074,17 +7210,17 @@ func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventValidatorSlash: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventValidatorSlash: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			m.EventNonce = 0
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5094,16 +7230,16 @@ func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.EventHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5113,16 +7249,29 @@ func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5132,48 +7281,27 @@ func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttestationId == nil {
-				m.AttestationId = []byte{}
-			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
-			}
-			m.BatchNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5201,11 +7329,11 @@ func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5233,7 +7361,7 @@ func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5256,7 +7384,7 @@ func (m *EventWithdrawClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
+func (m *EventDepositReceived) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5279,87 +7407,15 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventERC20DeployedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDepositReceived: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDepositReceived: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
-			}
-			m.EventNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
-			}
-			m.EventHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
-			}
-			var byteLen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				byteLen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if byteLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + byteLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttestationId == nil {
-				m.AttestationId = []byte{}
-			}
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5387,11 +7443,11 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5419,11 +7475,11 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.Receiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5451,11 +7507,63 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventWithdrawalsCompleted) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventWithdrawalsCompleted: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventWithdrawalsCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5483,32 +7591,13 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 9:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5518,23 +7607,25 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.Withdrawals = append(m.Withdrawals, &Withdrawal{})
+			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5557,7 +7648,7 @@ func (m *EventERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
+func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5580,17 +7671,17 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValsetUpdateClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: Withdrawal: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValsetUpdateClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: Withdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.EventNonce = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5600,16 +7691,29 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventHeight", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
 			}
-			m.EventHeight = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5619,16 +7723,29 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventHeight |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Receiver = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AttestationId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var byteLen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5638,31 +7755,81 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.AttestationId = append(m.AttestationId[:0], dAtA[iNdEx:postIndex]...)
-			if m.AttestationId == nil {
-				m.AttestationId = []byte{}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventValidatorJailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventValidatorJailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventValidatorJailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
-			m.ValsetNonce = 0
+			m.Reason = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5672,16 +7839,16 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetNonce |= uint64(b&0x7F) << shift
+				m.Reason |= JailReason(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetMembers", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
 			}
-			var msglen int
+			m.Power = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5691,29 +7858,14 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.Power |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ValsetMembers = append(m.ValsetMembers, &BridgeValidator{})
-			if err := m.ValsetMembers[len(m.ValsetMembers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5741,13 +7893,11 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5775,11 +7925,11 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5807,7 +7957,7 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
+			m.Moniker = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5830,7 +7980,7 @@ func (m *EventValsetUpdateClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventCancelSendToEth) Unmarshal(dAtA []byte) error {
+func (m *EventOutgoingLogicCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5853,17 +8003,115 @@ func (m *EventCancelSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventCancelSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventOutgoingLogicCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventOutgoingLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OutgoingTxId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			m.OutgoingTxId = 0
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5873,7 +8121,26 @@ func (m *EventCancelSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.OutgoingTxId |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -5899,7 +8166,7 @@ func (m *EventCancelSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+func (m *EventConfirmLogicCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5922,17 +8189,17 @@ func (m *EventSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventSubmitBadSignatureEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventConfirmLogicCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventConfirmLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -5942,27 +8209,48 @@ func (m *EventSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BadEthSignature = string(dAtA[iNdEx:postIndex])
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BadEthSignatureSubject", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field OrchestratorAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5990,7 +8278,7 @@ func (m *EventSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BadEthSignatureSubject = string(dAtA[iNdEx:postIndex])
+			m.OrchestratorAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6013,7 +8301,7 @@ func (m *EventSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
+func (m *EventLogicCallTimeout) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6036,36 +8324,17 @@ func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValidatorSlash: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventLogicCallTimeout: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValidatorSlash: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventLogicCallTimeout: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6075,61 +8344,31 @@ func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Reason = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
 			}
-			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			var stringLen uint64
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6139,29 +8378,16 @@ func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
 			}
-			var stringLen uint64
+			m.Timeout = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6171,24 +8397,11 @@ func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.Timeout |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6210,7 +8423,7 @@ func (m *EventValidatorSlash) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventDepositReceived) Unmarshal(dAtA []byte) error {
+func (m *EventDepositQueued) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6233,17 +8446,17 @@ func (m *EventDepositReceived) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventDepositReceived: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventDepositQueued: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventDepositReceived: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventDepositQueued: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositId", wireType)
 			}
-			var stringLen uint64
+			m.DepositId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6253,27 +8466,14 @@ func (m *EventDepositReceived) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.DepositId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6301,7 +8501,7 @@ func (m *EventDepositReceived) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
@@ -6337,59 +8537,9 @@ func (m *EventDepositReceived) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipEvents(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *EventWithdrawalsCompleted) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowEvents
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: EventWithdrawalsCompleted: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventWithdrawalsCompleted: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6417,13 +8567,13 @@ func (m *EventWithdrawalsCompleted) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Withdrawals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6433,26 +8583,43 @@ func (m *EventWithdrawalsCompleted) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthEvents
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthEvents
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Withdrawals = append(m.Withdrawals, &Withdrawal{})
-			if err := m.Withdrawals[len(m.Withdrawals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6474,7 +8641,7 @@ func (m *EventWithdrawalsCompleted) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Withdrawal) Unmarshal(dAtA []byte) error {
+func (m *EventQueuedDepositReleased) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6497,15 +8664,34 @@ func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Withdrawal: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQueuedDepositReleased: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Withdrawal: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQueuedDepositReleased: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositId", wireType)
+			}
+			m.DepositId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DepositId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6533,11 +8719,11 @@ func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Receiver", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6565,11 +8751,13 @@ func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Receiver = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6597,10 +8785,28 @@ func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Forced", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Forced = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipEvents(dAtA[iNdEx:])
@@ -6622,7 +8828,7 @@ func (m *Withdrawal) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *EventValidatorJailed) Unmarshal(dAtA []byte) error {
+func (m *EventQueuedDepositHeld) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6645,17 +8851,17 @@ func (m *EventValidatorJailed) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EventValidatorJailed: wiretype end group for non-group")
+			return fmt.Errorf("proto: EventQueuedDepositHeld: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EventValidatorJailed: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EventQueuedDepositHeld: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositId", wireType)
 			}
-			m.Reason = 0
+			m.DepositId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowEvents
@@ -6665,65 +8871,14 @@ func (m *EventValidatorJailed) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Reason |= JailReason(b&0x7F) << shift
+				m.DepositId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Power", wireType)
-			}
-			m.Power = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Power |= int64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ConsensusAddress", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowEvents
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthEvents
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthEvents
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.ConsensusAddress = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field OperatorAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6751,11 +8906,11 @@ func (m *EventValidatorJailed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.OperatorAddress = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 5:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Moniker", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6783,7 +8938,7 @@ func (m *EventValidatorJailed) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Moniker = string(dAtA[iNdEx:postIndex])
+			m.Reason = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
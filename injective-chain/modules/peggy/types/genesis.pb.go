@@ -42,6 +42,10 @@ type GenesisState struct {
 	LastObservedValset         Valset                         `protobuf:"bytes,14,opt,name=last_observed_valset,json=lastObservedValset,proto3" json:"last_observed_valset"`
 	EthereumBlacklist          []string                       `protobuf:"bytes,15,rep,name=ethereum_blacklist,json=ethereumBlacklist,proto3" json:"ethereum_blacklist,omitempty"`
 	RateLimits                 []*RateLimit                   `protobuf:"bytes,16,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
+	LogicCalls                 []*OutgoingLogicCall           `protobuf:"bytes,17,rep,name=logic_calls,json=logicCalls,proto3" json:"logic_calls,omitempty"`
+	LogicCallConfirms          []*MsgConfirmLogicCall         `protobuf:"bytes,18,rep,name=logic_call_confirms,json=logicCallConfirms,proto3" json:"logic_call_confirms,omitempty"`
+	QueuedDeposits             []*QueuedDeposit               `protobuf:"bytes,19,rep,name=queued_deposits,json=queuedDeposits,proto3" json:"queued_deposits,omitempty"`
+	LastQueuedDepositId        uint64                         `protobuf:"varint,20,opt,name=last_queued_deposit_id,json=lastQueuedDepositId,proto3" json:"last_queued_deposit_id,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -189,6 +193,34 @@ func (m *GenesisState) GetRateLimits() []*RateLimit {
 	return nil
 }
 
+func (m *GenesisState) GetLogicCalls() []*OutgoingLogicCall {
+	if m != nil {
+		return m.LogicCalls
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLogicCallConfirms() []*MsgConfirmLogicCall {
+	if m != nil {
+		return m.LogicCallConfirms
+	}
+	return nil
+}
+
+func (m *GenesisState) GetQueuedDeposits() []*QueuedDeposit {
+	if m != nil {
+		return m.QueuedDeposits
+	}
+	return nil
+}
+
+func (m *GenesisState) GetLastQueuedDepositId() uint64 {
+	if m != nil {
+		return m.LastQueuedDepositId
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.peggy.v1.GenesisState")
 }
@@ -196,51 +228,56 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/genesis.proto", fileDescriptor_3b8a70f18b346efa) }
 
 var fileDescriptor_3b8a70f18b346efa = []byte{
-	// 690 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x94, 0x5d, 0x4f, 0xdb, 0x3e,
-	0x14, 0xc6, 0xdb, 0x3f, 0xfc, 0x79, 0x31, 0x6f, 0xc3, 0xbc, 0x28, 0xaa, 0x44, 0xa9, 0x06, 0x9a,
-	0xb8, 0xa1, 0xa1, 0x65, 0xb7, 0x9b, 0x44, 0x19, 0x1a, 0x6c, 0x30, 0x50, 0x40, 0x9b, 0xb4, 0x9b,
-	0xc8, 0x49, 0x0e, 0xa9, 0xb7, 0x24, 0xae, 0x7c, 0xdc, 0x08, 0xbe, 0xc5, 0x3e, 0xd2, 0x2e, 0xb9,
-	0xe4, 0x72, 0x57, 0xd3, 0x04, 0x5f, 0x64, 0x8a, 0xe3, 0x94, 0x56, 0x4b, 0xb9, 0xb3, 0xf3, 0xfc,
-	0x9e, 0xe7, 0x9c, 0xda, 0xa7, 0x26, 0x0d, 0x9e, 0x7c, 0x03, 0x5f, 0xf1, 0x14, 0xec, 0x1e, 0x84,
-	0xe1, 0xad, 0x9d, 0xb6, 0xec, 0x10, 0x12, 0x40, 0x8e, 0xcd, 0x9e, 0x14, 0x4a, 0x50, 0x3a, 0x20,
-	0x9a, 0x9a, 0x68, 0xa6, 0xad, 0xda, 0x6a, 0x28, 0x42, 0xa1, 0x65, 0x3b, 0x5b, 0xe5, 0x64, 0xad,
-	0x5e, 0x92, 0xa5, 0x6e, 0x7b, 0x60, 0x92, 0x6a, 0x1b, 0x25, 0x7a, 0x8c, 0x21, 0x3e, 0x63, 0xf7,
-	0x98, 0xf2, 0xbb, 0x46, 0xdf, 0x2e, 0xd1, 0x99, 0x52, 0x80, 0x8a, 0x29, 0x2e, 0x12, 0x43, 0x6d,
-	0x96, 0x50, 0x3d, 0x26, 0x59, 0x5c, 0x94, 0xd9, 0x2a, 0x01, 0x24, 0x53, 0xe0, 0x46, 0x3c, 0xe6,
-	0xaa, 0xe8, 0xc5, 0x17, 0x18, 0x0b, 0xb4, 0x3d, 0x86, 0x60, 0xa7, 0x2d, 0x0f, 0x14, 0x6b, 0xd9,
-	0xbe, 0xe0, 0xa6, 0xca, 0xcb, 0x9f, 0x33, 0x64, 0xfe, 0x7d, 0x7e, 0x4c, 0x97, 0x8a, 0x29, 0xa0,
-	0x6d, 0x32, 0x95, 0x57, 0xb1, 0xaa, 0x8d, 0xea, 0xce, 0x5c, 0xbb, 0xd6, 0xfc, 0xf7, 0xd8, 0x9a,
-	0x17, 0x9a, 0x70, 0x0c, 0x49, 0x9b, 0x64, 0x25, 0x62, 0xa8, 0x5c, 0xe1, 0x21, 0xc8, 0x14, 0x02,
-	0x37, 0x11, 0x89, 0x0f, 0xd6, 0x7f, 0x8d, 0xea, 0xce, 0xa4, 0xb3, 0x9c, 0x49, 0xe7, 0x46, 0xf9,
-	0x94, 0x09, 0xf4, 0x35, 0x99, 0x4e, 0x59, 0x84, 0xa0, 0xd0, 0x9a, 0x68, 0x4c, 0x8c, 0x2b, 0xf2,
-	0x59, 0x23, 0x4e, 0x81, 0xd2, 0x33, 0xb2, 0x94, 0x2f, 0x5d, 0x5f, 0x24, 0xd7, 0x5c, 0xc6, 0x68,
-	0x4d, 0x6a, 0xf7, 0x76, 0x99, 0xfb, 0x0c, 0xc3, 0x3c, 0xe0, 0x30, 0x87, 0x9d, 0xc5, 0x74, 0x78,
-	0x8b, 0xf4, 0x0d, 0x99, 0xd6, 0x97, 0x02, 0x68, 0xfd, 0xaf, 0x63, 0xb6, 0xca, 0x62, 0xce, 0xfb,
-	0x2a, 0x14, 0x3c, 0x09, 0xaf, 0x6e, 0x3a, 0x19, 0xec, 0x14, 0x1e, 0xfa, 0x81, 0x2c, 0xea, 0xe5,
-	0x53, 0x33, 0x53, 0xe3, 0x53, 0xce, 0x30, 0x34, 0x75, 0xf3, 0x94, 0x05, 0x6d, 0x1d, 0xb4, 0x72,
-	0x48, 0xe6, 0x87, 0xee, 0x1f, 0xad, 0x69, 0x9d, 0xb4, 0x59, 0x96, 0x74, 0xf0, 0xc4, 0x39, 0x23,
-	0x26, 0x7a, 0x4d, 0xd6, 0x85, 0xcc, 0x5a, 0x53, 0x92, 0x29, 0x21, 0x5d, 0x16, 0x04, 0x12, 0x10,
-	0x01, 0xad, 0x19, 0x1d, 0x67, 0x8f, 0x69, 0xec, 0x12, 0xd4, 0xf9, 0x90, 0xef, 0xa0, 0xb0, 0x39,
-	0x6b, 0xa2, 0xec, 0x33, 0x3d, 0x26, 0x4b, 0x20, 0xfd, 0xf6, 0x9e, 0xab, 0x84, 0x1b, 0x40, 0x22,
-	0x62, 0xb4, 0x66, 0x75, 0x81, 0x46, 0x59, 0x81, 0x23, 0xe7, 0xb0, 0xbd, 0x77, 0x25, 0xde, 0x65,
-	0xa0, 0xb3, 0xa0, 0x8d, 0x66, 0x87, 0xf4, 0x0b, 0x59, 0xe9, 0x27, 0xf9, 0x79, 0x06, 0xae, 0x92,
-	0x2c, 0xc1, 0x6b, 0x90, 0x68, 0x11, 0x9d, 0xf6, 0xea, 0xd9, 0xdb, 0x30, 0xf0, 0xd5, 0x8d, 0x43,
-	0x07, 0x11, 0xc5, 0x47, 0xa4, 0x07, 0x64, 0x63, 0x74, 0x1e, 0x41, 0x75, 0x41, 0x42, 0x3f, 0x76,
-	0xbb, 0xc0, 0xc3, 0xae, 0xb2, 0xe6, 0xf4, 0x64, 0xd6, 0x86, 0x27, 0xf3, 0xc8, 0x20, 0xc7, 0x9a,
-	0xa0, 0xfb, 0x64, 0x3d, 0x8f, 0x30, 0x15, 0xdd, 0xfc, 0xb2, 0x79, 0x60, 0xcd, 0x6b, 0xaf, 0x1e,
-	0xf8, 0xa2, 0x1d, 0x7d, 0xa9, 0x27, 0x01, 0x6d, 0x91, 0xb5, 0x51, 0x53, 0x4f, 0x88, 0x28, 0xf3,
-	0x2c, 0x68, 0x0f, 0x1d, 0xf6, 0x5c, 0x08, 0x11, 0x9d, 0x04, 0xd4, 0x21, 0xab, 0xa3, 0xad, 0xe6,
-	0x53, 0x6a, 0x2d, 0x8e, 0xff, 0xf3, 0xe5, 0x63, 0xdd, 0x99, 0xbc, 0xfb, 0xbd, 0x59, 0x31, 0x99,
-	0xc6, 0x9c, 0x2b, 0x74, 0x97, 0xd0, 0xc1, 0x0f, 0xf6, 0x22, 0xe6, 0x7f, 0x8f, 0x38, 0x2a, 0x6b,
-	0xa9, 0x31, 0xb1, 0x33, 0xeb, 0x2c, 0x17, 0x4a, 0xa7, 0x10, 0xe8, 0x5b, 0x32, 0xf7, 0xf4, 0x6c,
-	0xa0, 0xf5, 0x42, 0x1f, 0xff, 0x46, 0x59, 0x65, 0x87, 0x29, 0x38, 0xcd, 0x28, 0x87, 0xc8, 0x62,
-	0x89, 0x1d, 0xb8, 0x7b, 0xa8, 0x57, 0xef, 0x1f, 0xea, 0xd5, 0x3f, 0x0f, 0xf5, 0xea, 0x8f, 0xc7,
-	0x7a, 0xe5, 0xfe, 0xb1, 0x5e, 0xf9, 0xf5, 0x58, 0xaf, 0x7c, 0xfd, 0x18, 0x72, 0xd5, 0xed, 0x7b,
-	0x4d, 0x5f, 0xc4, 0xf6, 0x49, 0x11, 0x77, 0xca, 0x3c, 0xb4, 0x07, 0xe1, 0xbb, 0xbe, 0x90, 0x30,
-	0xbc, 0xed, 0x32, 0x9e, 0xd8, 0xb1, 0x08, 0xfa, 0x11, 0xa0, 0x79, 0xd7, 0xf4, 0xd3, 0xeb, 0x4d,
-	0xe9, 0x07, 0x6b, 0xff, 0x6f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x47, 0x8f, 0x99, 0x54, 0xe9, 0x05,
-	0x00, 0x00,
+	// 781 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x95, 0xcd, 0x4e, 0xc3, 0x46,
+	0x10, 0xc7, 0x93, 0x42, 0xa1, 0x6c, 0x20, 0x69, 0x96, 0x0f, 0x59, 0x91, 0x08, 0x69, 0xa1, 0x2d,
+	0x17, 0x62, 0x12, 0x7a, 0x6d, 0x25, 0x12, 0x68, 0x09, 0x85, 0x42, 0x17, 0xd4, 0x4a, 0xbd, 0x58,
+	0x6b, 0x7b, 0x70, 0xdc, 0xda, 0xde, 0xe0, 0xd9, 0x44, 0xf0, 0x16, 0x7d, 0x2c, 0x8e, 0x1c, 0x7b,
+	0xaa, 0xaa, 0xf0, 0x22, 0x95, 0xd7, 0x5f, 0x89, 0xea, 0xe4, 0xb6, 0x9b, 0xf9, 0xff, 0x7f, 0x33,
+	0xde, 0x99, 0xdd, 0x90, 0x96, 0x1b, 0xfc, 0x01, 0x96, 0x74, 0x27, 0xa0, 0x8f, 0xc0, 0x71, 0x5e,
+	0xf5, 0x49, 0x47, 0x77, 0x20, 0x00, 0x74, 0xb1, 0x3d, 0x0a, 0x85, 0x14, 0x94, 0x66, 0x8a, 0xb6,
+	0x52, 0xb4, 0x27, 0x9d, 0xc6, 0x8e, 0x23, 0x1c, 0xa1, 0xc2, 0x7a, 0xb4, 0x8a, 0x95, 0x8d, 0x66,
+	0x01, 0x4b, 0xbe, 0x8e, 0x20, 0x21, 0x35, 0xf6, 0x0b, 0xe2, 0x3e, 0x3a, 0xb8, 0xc4, 0x6e, 0x72,
+	0x69, 0x0d, 0x93, 0xf8, 0x51, 0x41, 0x9c, 0x4b, 0x09, 0x28, 0xb9, 0x74, 0x45, 0x90, 0xa8, 0x0e,
+	0x0a, 0x54, 0x23, 0x1e, 0x72, 0x3f, 0x4d, 0x73, 0x58, 0x20, 0x08, 0xb9, 0x04, 0xc3, 0x73, 0x7d,
+	0x57, 0x2e, 0x11, 0x79, 0xc2, 0x71, 0x2d, 0xc3, 0xe2, 0x9e, 0x97, 0x16, 0x6c, 0x09, 0xf4, 0x05,
+	0xea, 0x26, 0x47, 0xd0, 0x27, 0x1d, 0x13, 0x24, 0xef, 0xe8, 0x96, 0x70, 0x93, 0x52, 0xbe, 0x9c,
+	0x12, 0xb2, 0xf9, 0x63, 0x7c, 0x96, 0x0f, 0x92, 0x4b, 0xa0, 0x5d, 0xb2, 0x16, 0x97, 0xa2, 0x95,
+	0x5b, 0xe5, 0xe3, 0x4a, 0xb7, 0xd1, 0xfe, 0xff, 0xd9, 0xb6, 0xef, 0x95, 0x82, 0x25, 0x4a, 0xda,
+	0x26, 0xdb, 0x1e, 0x47, 0x69, 0x08, 0x13, 0x21, 0x9c, 0x80, 0x6d, 0x04, 0x22, 0xb0, 0x40, 0xfb,
+	0xa4, 0x55, 0x3e, 0x5e, 0x65, 0xf5, 0x28, 0x74, 0x97, 0x44, 0x7e, 0x8e, 0x02, 0xf4, 0x5b, 0xb2,
+	0x3e, 0xe1, 0x1e, 0x82, 0x44, 0x6d, 0xa5, 0xb5, 0xb2, 0x28, 0xc9, 0xaf, 0x4a, 0xc2, 0x52, 0x29,
+	0xbd, 0x25, 0xb5, 0x78, 0x69, 0x58, 0x22, 0x78, 0x72, 0x43, 0x1f, 0xb5, 0x55, 0xe5, 0x3e, 0x2a,
+	0x72, 0xdf, 0xa2, 0x13, 0x03, 0xfa, 0xb1, 0x98, 0x55, 0x27, 0xb3, 0x5b, 0xa4, 0xdf, 0x91, 0x75,
+	0xd5, 0x39, 0x40, 0xed, 0x53, 0x85, 0x39, 0x2c, 0xc2, 0xdc, 0x8d, 0xa5, 0x23, 0xdc, 0xc0, 0x79,
+	0x7c, 0xe9, 0x45, 0x62, 0x96, 0x7a, 0xe8, 0x35, 0xa9, 0xaa, 0x65, 0x5e, 0xcc, 0xda, 0x62, 0xca,
+	0x2d, 0x3a, 0x49, 0xde, 0x98, 0xb2, 0xa5, 0xac, 0x59, 0x29, 0x7d, 0xb2, 0x39, 0x33, 0x24, 0xa8,
+	0xad, 0x2b, 0xd2, 0x41, 0x11, 0xe9, 0x3c, 0xd7, 0xb1, 0x39, 0x13, 0x7d, 0x22, 0x7b, 0x22, 0x8c,
+	0x4a, 0x93, 0x21, 0x97, 0x22, 0x34, 0xb8, 0x6d, 0x87, 0x80, 0x08, 0xa8, 0x7d, 0xa6, 0x70, 0xfa,
+	0x82, 0xc2, 0x1e, 0x40, 0xde, 0xcd, 0xf8, 0xce, 0x53, 0x1b, 0xdb, 0x15, 0x45, 0x3f, 0xd3, 0x2b,
+	0x52, 0x83, 0xd0, 0xea, 0x9e, 0x1a, 0x52, 0x18, 0x36, 0x04, 0xc2, 0x47, 0x6d, 0x43, 0x25, 0x68,
+	0x15, 0x25, 0xb8, 0x64, 0xfd, 0xee, 0xe9, 0xa3, 0xb8, 0x88, 0x84, 0x6c, 0x4b, 0x19, 0x93, 0x1d,
+	0xd2, 0xdf, 0xc8, 0xf6, 0x38, 0x88, 0xcf, 0xd3, 0x36, 0x64, 0xc8, 0x03, 0x7c, 0x82, 0x10, 0x35,
+	0xa2, 0x68, 0x5f, 0x2f, 0xed, 0x46, 0x22, 0x7e, 0x7c, 0x61, 0x34, 0x43, 0xa4, 0x3f, 0x22, 0x3d,
+	0x27, 0xfb, 0xf3, 0xf3, 0x08, 0x72, 0x08, 0x21, 0x8c, 0x7d, 0x63, 0x08, 0xae, 0x33, 0x94, 0x5a,
+	0x45, 0x4d, 0x66, 0x63, 0x76, 0x32, 0x2f, 0x13, 0xc9, 0x95, 0x52, 0xd0, 0x33, 0xb2, 0x17, 0x23,
+	0x92, 0x8c, 0x46, 0xdc, 0x6c, 0xd7, 0xd6, 0x36, 0x95, 0x57, 0x0d, 0x7c, 0x5a, 0x8e, 0x6a, 0xea,
+	0xc0, 0xa6, 0x1d, 0xb2, 0x3b, 0x6f, 0x1a, 0x09, 0xe1, 0x45, 0x9e, 0x2d, 0xe5, 0xa1, 0xb3, 0x9e,
+	0x7b, 0x21, 0xbc, 0x81, 0x4d, 0x19, 0xd9, 0x99, 0x2f, 0x35, 0x9e, 0x52, 0xad, 0xba, 0xf8, 0xf2,
+	0xc5, 0x63, 0xdd, 0x5b, 0x7d, 0xfb, 0xe7, 0xa0, 0x94, 0x30, 0x13, 0x73, 0x1c, 0xa1, 0x27, 0x84,
+	0x66, 0x1f, 0x6c, 0x7a, 0xdc, 0xfa, 0xd3, 0x73, 0x51, 0x6a, 0xb5, 0xd6, 0xca, 0xf1, 0x06, 0xab,
+	0xa7, 0x91, 0x5e, 0x1a, 0xa0, 0xdf, 0x93, 0x4a, 0xfe, 0xb6, 0xa0, 0xf6, 0xb9, 0x3a, 0xfe, 0xfd,
+	0xa2, 0xcc, 0x8c, 0x4b, 0xb8, 0x89, 0x54, 0x8c, 0x84, 0xe9, 0x12, 0xe9, 0x0f, 0xa4, 0x92, 0x3f,
+	0x3b, 0xa8, 0xd5, 0x95, 0xff, 0xab, 0x65, 0xed, 0xbb, 0x89, 0xe4, 0x7d, 0xee, 0x79, 0x8c, 0x78,
+	0xe9, 0x52, 0x8d, 0x43, 0xce, 0xc9, 0xaf, 0x15, 0x55, 0xbc, 0x6f, 0x96, 0x5f, 0xab, 0x9c, 0x58,
+	0xcf, 0x88, 0xd9, 0xf5, 0xba, 0x26, 0xb5, 0xe7, 0x31, 0x8c, 0xc1, 0x36, 0x6c, 0x18, 0x09, 0x8c,
+	0x3e, 0x72, 0x5b, 0x41, 0xbf, 0x28, 0x82, 0xfe, 0xa2, 0xa4, 0x17, 0xb1, 0x92, 0x55, 0x9f, 0x67,
+	0xb7, 0x98, 0xcd, 0xc5, 0x3c, 0x30, 0xea, 0xf1, 0x4e, 0x3e, 0x17, 0x73, 0x88, 0x81, 0xdd, 0x83,
+	0xb7, 0x69, 0xb3, 0xfc, 0x3e, 0x6d, 0x96, 0xff, 0x9d, 0x36, 0xcb, 0x7f, 0x7d, 0x34, 0x4b, 0xef,
+	0x1f, 0xcd, 0xd2, 0xdf, 0x1f, 0xcd, 0xd2, 0xef, 0x3f, 0x39, 0xae, 0x1c, 0x8e, 0xcd, 0xb6, 0x25,
+	0x7c, 0x7d, 0x90, 0xd6, 0x72, 0xc3, 0x4d, 0xd4, 0xb3, 0xca, 0x4e, 0x2c, 0x11, 0xc2, 0xec, 0x76,
+	0xc8, 0xdd, 0x40, 0xf7, 0x85, 0x3d, 0xf6, 0x00, 0x93, 0x97, 0x5f, 0xfd, 0x83, 0x99, 0x6b, 0xea,
+	0x49, 0x3f, 0xfb, 0x6f, 0x00, 0x53, 0x6e, 0x2c, 0x3a, 0x30, 0x07, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -263,6 +300,61 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastQueuedDepositId != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.LastQueuedDepositId))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa0
+	}
+	if len(m.QueuedDeposits) > 0 {
+		for iNdEx := len(m.QueuedDeposits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.QueuedDeposits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x9a
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for iNdEx := len(m.LogicCallConfirms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCallConfirms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x92
+		}
+	}
+	if len(m.LogicCalls) > 0 {
+		for iNdEx := len(m.LogicCalls) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.LogicCalls[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0x8a
+		}
+	}
 	if len(m.RateLimits) > 0 {
 		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -540,6 +632,27 @@ func (m *GenesisState) Size() (n int) {
 			n += 2 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.LogicCalls) > 0 {
+		for _, e := range m.LogicCalls {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.LogicCallConfirms) > 0 {
+		for _, e := range m.LogicCallConfirms {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.QueuedDeposits) > 0 {
+		for _, e := range m.QueuedDeposits {
+			l = e.Size()
+			n += 2 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.LastQueuedDepositId != 0 {
+		n += 2 + sovGenesis(uint64(m.LastQueuedDepositId))
+	}
 	return n
 }
 
@@ -1061,6 +1174,127 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCalls", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCalls = append(m.LogicCalls, &OutgoingLogicCall{})
+			if err := m.LogicCalls[len(m.LogicCalls)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallConfirms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallConfirms = append(m.LogicCallConfirms, &MsgConfirmLogicCall{})
+			if err := m.LogicCallConfirms[len(m.LogicCallConfirms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedDeposits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.QueuedDeposits = append(m.QueuedDeposits, &QueuedDeposit{})
+			if err := m.QueuedDeposits[len(m.QueuedDeposits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastQueuedDepositId", wireType)
+			}
+			m.LastQueuedDepositId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastQueuedDepositId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
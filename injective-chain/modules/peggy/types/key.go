@@ -1,6 +1,8 @@
 package types
 
 import (
+	"bytes"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/ethereum/go-ethereum/common"
 )
@@ -119,8 +121,45 @@ var (
 
 	RateLimitsKey       = []byte{0x1e}
 	MintAmountsERC20Key = []byte{0x1f}
+
+	// OutgoingLogicCallKey indexes outgoing logic calls
+	OutgoingLogicCallKey = []byte{0x20}
+
+	// LogicCallConfirmKey indexes validator confirmations by logic call
+	LogicCallConfirmKey = []byte{0x21}
+
+	// LastSlashedLogicCallBlock indexes the latest slashed logic call block height
+	LastSlashedLogicCallBlock = []byte{0x22}
+
+	// QueuedDepositKey indexes deposits held back by inbound rate limits
+	QueuedDepositKey = []byte{0x23}
+
+	// LastQueuedDepositIDKey indexes the last queued deposit id
+	LastQueuedDepositIDKey = []byte{0x24}
+
+	// QueuedDepositByTokenKey indexes the queued deposits released by the sliding window by token
+	QueuedDepositByTokenKey = []byte{0x25}
 )
 
+// GetQueuedDepositKey returns the following key format
+// prefix    id
+// [0x23][0 0 0 0 0 0 0 1]
+func GetQueuedDepositKey(id uint64) []byte {
+	return append(QueuedDepositKey, UInt64Bytes(id)...)
+}
+
+// GetQueuedDepositByTokenKey returns the following key format
+// prefix              token                        id
+// [0x25][0xc783df8a850f42e7F7e57013759C285caa701eB6][0 0 0 0 0 0 0 1]
+func GetQueuedDepositByTokenKey(tokenContract common.Address, id uint64) []byte {
+	return append(GetQueuedDepositByTokenPrefix(tokenContract), UInt64Bytes(id)...)
+}
+
+// GetQueuedDepositByTokenPrefix returns the prefix of the queued deposits of a token
+func GetQueuedDepositByTokenPrefix(tokenContract common.Address) []byte {
+	return append(bytes.Clone(QueuedDepositByTokenKey), tokenContract.Bytes()...)
+}
+
 func GetEthereumBlacklistStoreKey(addr common.Address) []byte {
 	return append(EthereumBlacklistKey, addr.Bytes()...)
 }
@@ -315,3 +354,28 @@ func GetMintAmountERC20Key(erc20 []byte) []byte {
 
 	return k
 }
+
+// GetOutgoingLogicCallKey returns the following key format
+// prefix     invalidation-id     invalidation-nonce
+// [0x20][32 bytes invalidation id][0 0 0 0 0 0 0 1]
+func GetOutgoingLogicCallKey(invalidationID []byte, invalidationNonce uint64) []byte {
+	buf := make([]byte, 0, len(OutgoingLogicCallKey)+len(invalidationID)+8)
+	buf = append(buf, OutgoingLogicCallKey...)
+	buf = append(buf, invalidationID...)
+	buf = append(buf, UInt64Bytes(invalidationNonce)...)
+
+	return buf
+}
+
+// GetLogicCallConfirmKey returns the following key format
+// prefix     invalidation-id     invalidation-nonce           validator-address
+// [0x21][32 bytes invalidation id][0 0 0 0 0 0 0 1][cosmos1ahx7f8wyertuus9r20284ej0asrs085case3kn]
+func GetLogicCallConfirmKey(invalidationID []byte, invalidationNonce uint64, validator sdk.AccAddress) []byte {
+	buf := make([]byte, 0, len(LogicCallConfirmKey)+len(invalidationID)+8+len(validator))
+	buf = append(buf, LogicCallConfirmKey...)
+	buf = append(buf, invalidationID...)
+	buf = append(buf, UInt64Bytes(invalidationNonce)...)
+	buf = append(buf, validator.Bytes()...)
+
+	return buf
+}
@@ -0,0 +1,162 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"math/big"
+	"strings"
+
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	accountsabi "github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+)
+
+// InvalidationIDLen is the length of a logic call invalidation id (bytes32 on Ethereum)
+const InvalidationIDLen = 32
+
+var (
+	_ sdk.Msg        = &MsgRequestLogicCall{}
+	_ sdk.Msg        = &MsgConfirmLogicCall{}
+	_ EthereumSigned = &OutgoingLogicCall{}
+)
+
+// GetCheckpoint gets the checkpoint signature from the given outgoing logic call
+func (c OutgoingLogicCall) GetCheckpoint(peggyIDstring string) common.Hash {
+	abi, err := accountsabi.JSON(strings.NewReader(OutgoingLogicCallCheckpointABIJSON))
+	if err != nil {
+		panic("Bad ABI constant!")
+	}
+
+	peggyID, err := strToFixByteArray(peggyIDstring)
+	if err != nil {
+		panic(err)
+	}
+
+	// Create the methodName argument which salts the signature
+	var logicCallMethodName [32]uint8
+	copy(logicCallMethodName[:], "logicCall")
+
+	var invalidationID [32]uint8
+	copy(invalidationID[:], c.InvalidationId)
+
+	abiEncodedCall, err := abi.Pack("submitLogicCall",
+		peggyID,
+		logicCallMethodName,
+		common.HexToAddress(c.LogicContractAddress),
+		c.Payload,
+		new(big.Int).SetUint64(c.Timeout),
+		invalidationID,
+		new(big.Int).SetUint64(c.InvalidationNonce),
+	)
+
+	// this should never happen outside of test since any case that could crash on encoding
+	// should be filtered above.
+	if err != nil {
+		panic(fmt.Sprintf("Error packing checkpoint! %s/n", err))
+	}
+
+	return crypto.Keccak256Hash(abiEncodedCall[4:])
+}
+
+// ValidateInvalidationID checks that the invalidation id fits the bytes32 used by the Peggy contract
+func ValidateInvalidationID(invalidationID []byte) error {
+	if len(invalidationID) != InvalidationIDLen {
+		return errors.Wrapf(ErrInvalidLogicCall, "invalidation id must be %d bytes long, got %d", InvalidationIDLen, len(invalidationID))
+	}
+
+	return nil
+}
+
+// DecodeInvalidationID decodes a hex encoded (optionally 0x prefixed) invalidation id
+func DecodeInvalidationID(s string) ([]byte, error) {
+	invalidationID, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
+	if err != nil {
+		return nil, errors.Wrapf(ErrInvalidLogicCall, "invalid invalidation id %s", s)
+	}
+
+	if err := ValidateInvalidationID(invalidationID); err != nil {
+		return nil, err
+	}
+
+	return invalidationID, nil
+}
+
+func (*MsgRequestLogicCall) Route() string { return RouterKey }
+
+func (*MsgRequestLogicCall) Type() string { return "request_logic_call" }
+
+func (msg *MsgRequestLogicCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgRequestLogicCall) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Sender)}
+}
+
+func (msg *MsgRequestLogicCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "invalid sender address: %s", msg.Sender)
+	}
+
+	if err := ValidateEthAddress(msg.LogicContractAddress); err != nil {
+		return errors.Wrap(err, "logic contract address")
+	}
+
+	if len(msg.Payload) == 0 {
+		return errors.Wrap(ErrInvalidLogicCall, "payload cannot be empty")
+	}
+
+	if msg.Timeout == 0 {
+		return errors.Wrap(ErrInvalidLogicCall, "timeout cannot be zero")
+	}
+
+	if err := ValidateInvalidationID(msg.InvalidationId); err != nil {
+		return err
+	}
+
+	if msg.InvalidationNonce == 0 {
+		return errors.Wrap(ErrInvalidLogicCall, "invalidation nonce cannot be zero")
+	}
+
+	return nil
+}
+
+// Route should return the name of the module
+func (msg MsgConfirmLogicCall) Route() string { return RouterKey }
+
+// Type should return the action
+func (msg MsgConfirmLogicCall) Type() string { return "confirm_logic_call" }
+
+// ValidateBasic performs stateless checks
+func (msg MsgConfirmLogicCall) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Orchestrator); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidAddress, msg.Orchestrator)
+	}
+	if err := ValidateEthAddress(msg.EthSigner); err != nil {
+		return errors.Wrap(err, "eth signer")
+	}
+	if _, err := DecodeInvalidationID(msg.InvalidationId); err != nil {
+		return err
+	}
+	if _, err := hex.DecodeString(msg.Signature); err != nil {
+		return errors.Wrapf(sdkerrors.ErrUnknownRequest, "Could not decode hex string %s", msg.Signature)
+	}
+	return nil
+}
+
+// GetSignBytes encodes the message for signing
+func (msg MsgConfirmLogicCall) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+// GetSigners defines whose signature is required
+func (msg MsgConfirmLogicCall) GetSigners() []sdk.AccAddress {
+	acc, err := sdk.AccAddressFromBech32(msg.Orchestrator)
+	if err != nil {
+		panic(err)
+	}
+	return []sdk.AccAddress{acc}
+}
@@ -0,0 +1,604 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: injective/peggy/v1/logic_call.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// OutgoingLogicCall represents an arbitrary contract call going from Peggy to
+// ETH. Once enough validators have signed over its checkpoint, any relayer can
+// submit it to the Peggy contract which executes the payload against the
+// logic contract.
+type OutgoingLogicCall struct {
+	// the Ethereum contract the payload is executed against
+	LogicContractAddress string `protobuf:"bytes,1,opt,name=logic_contract_address,json=logicContractAddress,proto3" json:"logic_contract_address,omitempty"`
+	// ABI encoded calldata passed to the logic contract
+	Payload []byte `protobuf:"bytes,2,opt,name=payload,proto3" json:"payload,omitempty"`
+	// the Ethereum block height after which the call can no longer be executed
+	Timeout uint64 `protobuf:"varint,3,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 32 byte identifier scoping the invalidation nonce on Ethereum
+	InvalidationId []byte `protobuf:"bytes,4,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	// nonce that must strictly increase for calls sharing the same
+	// invalidation_id
+	InvalidationNonce uint64 `protobuf:"varint,5,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	// the Injective block at which the call was requested
+	Block uint64 `protobuf:"varint,6,opt,name=block,proto3" json:"block,omitempty"`
+	// the account that requested the call
+	Requester string `protobuf:"bytes,7,opt,name=requester,proto3" json:"requester,omitempty"`
+}
+
+func (m *OutgoingLogicCall) Reset()         { *m = OutgoingLogicCall{} }
+func (m *OutgoingLogicCall) String() string { return proto.CompactTextString(m) }
+func (*OutgoingLogicCall) ProtoMessage()    {}
+func (*OutgoingLogicCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_c5837812115057a9, []int{0}
+}
+func (m *OutgoingLogicCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *OutgoingLogicCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_OutgoingLogicCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *OutgoingLogicCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_OutgoingLogicCall.Merge(m, src)
+}
+func (m *OutgoingLogicCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *OutgoingLogicCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_OutgoingLogicCall.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_OutgoingLogicCall proto.InternalMessageInfo
+
+func (m *OutgoingLogicCall) GetLogicContractAddress() string {
+	if m != nil {
+		return m.LogicContractAddress
+	}
+	return ""
+}
+
+func (m *OutgoingLogicCall) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
+	}
+	return nil
+}
+
+func (m *OutgoingLogicCall) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
+	}
+	return 0
+}
+
+func (m *OutgoingLogicCall) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
+	}
+	return nil
+}
+
+func (m *OutgoingLogicCall) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
+}
+
+func (m *OutgoingLogicCall) GetBlock() uint64 {
+	if m != nil {
+		return m.Block
+	}
+	return 0
+}
+
+func (m *OutgoingLogicCall) GetRequester() string {
+	if m != nil {
+		return m.Requester
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*OutgoingLogicCall)(nil), "injective.peggy.v1.OutgoingLogicCall")
+}
+
+func init() {
+	proto.RegisterFile("injective/peggy/v1/logic_call.proto", fileDescriptor_c5837812115057a9)
+}
+
+var fileDescriptor_c5837812115057a9 = []byte{
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0x4f, 0x6b, 0xdb, 0x30,
+	0x18, 0xc6, 0xe3, 0x2c, 0x7f, 0x88, 0x18, 0x1b, 0x11, 0x61, 0x68, 0x63, 0x98, 0x6c, 0x63, 0x2c,
+	0x97, 0xd8, 0x84, 0xed, 0xb4, 0x5b, 0x1b, 0x7a, 0x08, 0x0d, 0x2d, 0xa4, 0xb7, 0x5e, 0x8c, 0x2c,
+	0xbd, 0x38, 0x6a, 0x65, 0xc9, 0xb5, 0x64, 0x43, 0xbe, 0x45, 0x3f, 0x4a, 0x0f, 0xfd, 0x10, 0xa5,
+	0xa7, 0x1c, 0x7b, 0x2c, 0xc9, 0x17, 0x29, 0x91, 0x93, 0x34, 0xa5, 0xc7, 0xe7, 0xfd, 0xfd, 0xf4,
+	0x80, 0xde, 0x17, 0xfd, 0x12, 0xea, 0x0a, 0x98, 0x15, 0x25, 0x84, 0x19, 0x24, 0xc9, 0x22, 0x2c,
+	0x47, 0xa1, 0xd4, 0x89, 0x60, 0x11, 0xa3, 0x52, 0x06, 0x59, 0xae, 0xad, 0xc6, 0x78, 0x2f, 0x05,
+	0x4e, 0x0a, 0xca, 0xd1, 0xb7, 0xaf, 0x4c, 0x9b, 0x54, 0x9b, 0xc8, 0x19, 0x61, 0x15, 0x2a, 0xfd,
+	0xe7, 0x5d, 0x1d, 0x75, 0xcf, 0x0b, 0x9b, 0x68, 0xa1, 0x92, 0xe9, 0xa6, 0x6b, 0x4c, 0xa5, 0xc4,
+	0xff, 0xd0, 0x97, 0x6d, 0xb1, 0x56, 0x36, 0xa7, 0xcc, 0x46, 0x94, 0xf3, 0x1c, 0x8c, 0x21, 0x5e,
+	0xdf, 0x1b, 0x74, 0x66, 0x3d, 0x47, 0xc7, 0x5b, 0x78, 0x54, 0x31, 0x4c, 0x50, 0x3b, 0xa3, 0x0b,
+	0xa9, 0x29, 0x27, 0xf5, 0xbe, 0x37, 0xf8, 0x38, 0xdb, 0xc5, 0x0d, 0xb1, 0x22, 0x05, 0x5d, 0x58,
+	0xf2, 0xa1, 0xef, 0x0d, 0x1a, 0xb3, 0x5d, 0xc4, 0x7f, 0xd0, 0x67, 0xa1, 0x4a, 0x2a, 0x05, 0xa7,
+	0x56, 0x68, 0x15, 0x09, 0x4e, 0x1a, 0xee, 0xed, 0xa7, 0xc3, 0xf1, 0x84, 0xe3, 0x21, 0xc2, 0x6f,
+	0x44, 0xa5, 0x15, 0x03, 0xd2, 0x74, 0x6d, 0xdd, 0x43, 0x72, 0xb6, 0x01, 0xb8, 0x87, 0x9a, 0xb1,
+	0xd4, 0xec, 0x9a, 0xb4, 0x9c, 0x51, 0x05, 0xfc, 0x1d, 0x75, 0x72, 0xb8, 0x29, 0xc0, 0x58, 0xc8,
+	0x49, 0xdb, 0x7d, 0xe5, 0x75, 0xf0, 0xff, 0xf7, 0xe3, 0xfd, 0xf0, 0xc7, 0xfb, 0xf5, 0x05, 0x27,
+	0x76, 0x0e, 0x39, 0x14, 0xe9, 0x85, 0x48, 0x14, 0xf0, 0x63, 0x78, 0x58, 0xf9, 0xde, 0x72, 0xe5,
+	0x7b, 0xcf, 0x2b, 0xdf, 0xbb, 0x5d, 0xfb, 0xb5, 0xe5, 0xda, 0xaf, 0x3d, 0xad, 0xfd, 0xda, 0xe5,
+	0x69, 0x22, 0xec, 0xbc, 0x88, 0x03, 0xa6, 0xd3, 0x70, 0xb2, 0xeb, 0x99, 0xd2, 0xd8, 0x84, 0xfb,
+	0xd6, 0x21, 0xd3, 0x39, 0x1c, 0xc6, 0x39, 0x15, 0x2a, 0x4c, 0x35, 0x2f, 0x24, 0x98, 0xed, 0x59,
+	0xed, 0x22, 0x03, 0x13, 0xb7, 0xdc, 0x81, 0xfe, 0xbe, 0x0c, 0x00, 0x1e, 0x32, 0xf1, 0xf5, 0xf6,
+	0x01, 0x00, 0x00,
+}
+
+func (m *OutgoingLogicCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *OutgoingLogicCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *OutgoingLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Requester) > 0 {
+		i -= len(m.Requester)
+		copy(dAtA[i:], m.Requester)
+		i = encodeVarintLogicCall(dAtA, i, uint64(len(m.Requester)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Block != 0 {
+		i = encodeVarintLogicCall(dAtA, i, uint64(m.Block))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintLogicCall(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintLogicCall(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Timeout != 0 {
+		i = encodeVarintLogicCall(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintLogicCall(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.LogicContractAddress) > 0 {
+		i -= len(m.LogicContractAddress)
+		copy(dAtA[i:], m.LogicContractAddress)
+		i = encodeVarintLogicCall(dAtA, i, uint64(len(m.LogicContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintLogicCall(dAtA []byte, offset int, v uint64) int {
+	offset -= sovLogicCall(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *OutgoingLogicCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.LogicContractAddress)
+	if l > 0 {
+		n += 1 + l + sovLogicCall(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovLogicCall(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovLogicCall(uint64(m.Timeout))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovLogicCall(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovLogicCall(uint64(m.InvalidationNonce))
+	}
+	if m.Block != 0 {
+		n += 1 + sovLogicCall(uint64(m.Block))
+	}
+	l = len(m.Requester)
+	if l > 0 {
+		n += 1 + l + sovLogicCall(uint64(l))
+	}
+	return n
+}
+
+func sovLogicCall(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozLogicCall(x uint64) (n int) {
+	return sovLogicCall(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *OutgoingLogicCall) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowLogicCall
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: OutgoingLogicCall: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: OutgoingLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Block", wireType)
+			}
+			m.Block = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Block |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requester", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Requester = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipLogicCall(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthLogicCall
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipLogicCall(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowLogicCall
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowLogicCall
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthLogicCall
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupLogicCall
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthLogicCall
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthLogicCall        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowLogicCall          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupLogicCall = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1080,6 +1080,107 @@ func (m *MsgCancelSendToEthResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgCancelSendToEthResponse proto.InternalMessageInfo
 
+// MsgBumpSendToEthFee
+// This call allows the sender (and only the sender) to add to the bridge fee
+// of a withdrawal that has not been batched yet. The withdrawal keeps its
+// position among the withdrawals paying the same fee.
+type MsgBumpSendToEthFee struct {
+	TransactionId uint64 `protobuf:"varint,1,opt,name=transaction_id,json=transactionId,proto3" json:"transaction_id,omitempty"`
+	Sender        string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The amount added to the bridge fee, in the denom of the withdrawn token
+	FeeIncrease types.Coin `protobuf:"bytes,3,opt,name=fee_increase,json=feeIncrease,proto3" json:"fee_increase"`
+}
+
+func (m *MsgBumpSendToEthFee) Reset()         { *m = MsgBumpSendToEthFee{} }
+func (m *MsgBumpSendToEthFee) String() string { return proto.CompactTextString(m) }
+func (*MsgBumpSendToEthFee) ProtoMessage()    {}
+func (*MsgBumpSendToEthFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{18}
+}
+func (m *MsgBumpSendToEthFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBumpSendToEthFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBumpSendToEthFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBumpSendToEthFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBumpSendToEthFee.Merge(m, src)
+}
+func (m *MsgBumpSendToEthFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBumpSendToEthFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBumpSendToEthFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBumpSendToEthFee proto.InternalMessageInfo
+
+func (m *MsgBumpSendToEthFee) GetTransactionId() uint64 {
+	if m != nil {
+		return m.TransactionId
+	}
+	return 0
+}
+
+func (m *MsgBumpSendToEthFee) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgBumpSendToEthFee) GetFeeIncrease() types.Coin {
+	if m != nil {
+		return m.FeeIncrease
+	}
+	return types.Coin{}
+}
+
+type MsgBumpSendToEthFeeResponse struct {
+}
+
+func (m *MsgBumpSendToEthFeeResponse) Reset()         { *m = MsgBumpSendToEthFeeResponse{} }
+func (m *MsgBumpSendToEthFeeResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgBumpSendToEthFeeResponse) ProtoMessage()    {}
+func (*MsgBumpSendToEthFeeResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{19}
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgBumpSendToEthFeeResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgBumpSendToEthFeeResponse.Merge(m, src)
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgBumpSendToEthFeeResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgBumpSendToEthFeeResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgBumpSendToEthFeeResponse proto.InternalMessageInfo
+
 // This call allows anyone to submit evidence that a
 // validator has signed a valset, batch, or logic call that never
 // existed. Subject contains the batch, valset, or logic call.
@@ -1093,7 +1194,7 @@ func (m *MsgSubmitBadSignatureEvidence) Reset()         { *m = MsgSubmitBadSigna
 func (m *MsgSubmitBadSignatureEvidence) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidence) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidence) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{18}
+	return fileDescriptor_751daa04abed7ef4, []int{20}
 }
 func (m *MsgSubmitBadSignatureEvidence) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1150,7 +1251,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Reset()         { *m = MsgSubmit
 func (m *MsgSubmitBadSignatureEvidenceResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgSubmitBadSignatureEvidenceResponse) ProtoMessage()    {}
 func (*MsgSubmitBadSignatureEvidenceResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{19}
+	return fileDescriptor_751daa04abed7ef4, []int{21}
 }
 func (m *MsgSubmitBadSignatureEvidenceResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1195,7 +1296,7 @@ func (m *MsgValsetUpdatedClaim) Reset()         { *m = MsgValsetUpdatedClaim{} }
 func (m *MsgValsetUpdatedClaim) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaim) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaim) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{20}
+	return fileDescriptor_751daa04abed7ef4, []int{22}
 }
 func (m *MsgValsetUpdatedClaim) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1273,7 +1374,7 @@ func (m *MsgValsetUpdatedClaimResponse) Reset()         { *m = MsgValsetUpdatedC
 func (m *MsgValsetUpdatedClaimResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgValsetUpdatedClaimResponse) ProtoMessage()    {}
 func (*MsgValsetUpdatedClaimResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{21}
+	return fileDescriptor_751daa04abed7ef4, []int{23}
 }
 func (m *MsgValsetUpdatedClaimResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1315,7 +1416,7 @@ func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
 func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParams) ProtoMessage()    {}
 func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{22}
+	return fileDescriptor_751daa04abed7ef4, []int{24}
 }
 func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1365,7 +1466,7 @@ func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse
 func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateParamsResponse) ProtoMessage()    {}
 func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{23}
+	return fileDescriptor_751daa04abed7ef4, []int{25}
 }
 func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1407,7 +1508,7 @@ func (m *MsgBlacklistEthereumAddresses) Reset()         { *m = MsgBlacklistEther
 func (m *MsgBlacklistEthereumAddresses) String() string { return proto.CompactTextString(m) }
 func (*MsgBlacklistEthereumAddresses) ProtoMessage()    {}
 func (*MsgBlacklistEthereumAddresses) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{24}
+	return fileDescriptor_751daa04abed7ef4, []int{26}
 }
 func (m *MsgBlacklistEthereumAddresses) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1459,7 +1560,7 @@ func (m *MsgBlacklistEthereumAddressesResponse) Reset()         { *m = MsgBlackl
 func (m *MsgBlacklistEthereumAddressesResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgBlacklistEthereumAddressesResponse) ProtoMessage()    {}
 func (*MsgBlacklistEthereumAddressesResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{25}
+	return fileDescriptor_751daa04abed7ef4, []int{27}
 }
 func (m *MsgBlacklistEthereumAddressesResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1501,7 +1602,7 @@ func (m *MsgRevokeEthereumBlacklist) Reset()         { *m = MsgRevokeEthereumBla
 func (m *MsgRevokeEthereumBlacklist) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeEthereumBlacklist) ProtoMessage()    {}
 func (*MsgRevokeEthereumBlacklist) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{26}
+	return fileDescriptor_751daa04abed7ef4, []int{28}
 }
 func (m *MsgRevokeEthereumBlacklist) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1553,7 +1654,7 @@ func (m *MsgRevokeEthereumBlacklistResponse) Reset()         { *m = MsgRevokeEth
 func (m *MsgRevokeEthereumBlacklistResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRevokeEthereumBlacklistResponse) ProtoMessage()    {}
 func (*MsgRevokeEthereumBlacklistResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{27}
+	return fileDescriptor_751daa04abed7ef4, []int{29}
 }
 func (m *MsgRevokeEthereumBlacklistResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1600,13 +1701,29 @@ type MsgCreateRateLimit struct {
 	// length of the sliding window in which inbound (outbound) traffic is
 	// measured
 	RateLimitWindow uint64 `protobuf:"varint,7,opt,name=rate_limit_window,json=rateLimitWindow,proto3" json:"rate_limit_window,omitempty"`
+	// the notional USD limit imposed on all incoming traffic (per token). Zero
+	// disables inbound rate limiting
+	DepositLimitUsd cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=deposit_limit_usd,json=depositLimitUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deposit_limit_usd"`
+	// the notional USD limit imposed on incoming traffic of a single recipient
+	// (per token). Zero disables per-recipient limits
+	PerRecipientDepositLimitUsd cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=per_recipient_deposit_limit_usd,json=perRecipientDepositLimitUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"per_recipient_deposit_limit_usd"`
+	// oracle price used to value the ERC20 token in USD. token_price_id (Pyth)
+	// is used when not set
+	PriceSource *PriceSource `protobuf:"bytes,10,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	// optional oracle price used when the price_source price cannot be resolved
+	FallbackPriceSource *PriceSource `protobuf:"bytes,11,opt,name=fallback_price_source,json=fallbackPriceSource,proto3" json:"fallback_price_source,omitempty"`
+	// what happens to transfers when no price can be resolved
+	MissingPriceBehaviour MissingPriceBehaviour `protobuf:"varint,12,opt,name=missing_price_behaviour,json=missingPriceBehaviour,proto3,enum=injective.peggy.v1.MissingPriceBehaviour" json:"missing_price_behaviour,omitempty"`
+	// maximum age (in seconds) of the last known price when
+	// missing_price_behaviour is UseLastKnownPrice
+	MaxPriceAge uint64 `protobuf:"varint,13,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
 }
 
 func (m *MsgCreateRateLimit) Reset()         { *m = MsgCreateRateLimit{} }
 func (m *MsgCreateRateLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRateLimit) ProtoMessage()    {}
 func (*MsgCreateRateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{28}
+	return fileDescriptor_751daa04abed7ef4, []int{30}
 }
 func (m *MsgCreateRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1670,6 +1787,34 @@ func (m *MsgCreateRateLimit) GetRateLimitWindow() uint64 {
 	return 0
 }
 
+func (m *MsgCreateRateLimit) GetPriceSource() *PriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return nil
+}
+
+func (m *MsgCreateRateLimit) GetFallbackPriceSource() *PriceSource {
+	if m != nil {
+		return m.FallbackPriceSource
+	}
+	return nil
+}
+
+func (m *MsgCreateRateLimit) GetMissingPriceBehaviour() MissingPriceBehaviour {
+	if m != nil {
+		return m.MissingPriceBehaviour
+	}
+	return MissingPriceBehaviour_BlockOnMissingPrice
+}
+
+func (m *MsgCreateRateLimit) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
 type MsgCreateRateLimitResponse struct {
 }
 
@@ -1677,7 +1822,7 @@ func (m *MsgCreateRateLimitResponse) Reset()         { *m = MsgCreateRateLimitRe
 func (m *MsgCreateRateLimitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgCreateRateLimitResponse) ProtoMessage()    {}
 func (*MsgCreateRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{29}
+	return fileDescriptor_751daa04abed7ef4, []int{31}
 }
 func (m *MsgCreateRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1711,19 +1856,30 @@ type MsgUpdateRateLimit struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// token_address is the address of rate limited token
 	TokenAddress string `protobuf:"bytes,2,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
-	// new_token_price_id is the new Pyth price ID of the rate limited token
+	// new_token_price_id is the new Pyth price ID of the rate limited token. Left
+	// unchanged if empty
 	NewTokenPriceId string `protobuf:"bytes,3,opt,name=new_token_price_id,json=newTokenPriceId,proto3" json:"new_token_price_id,omitempty"`
 	// new_rate_limit_usd is the new notional limit (on withdrawals) in USD
 	NewRateLimitUsd cosmossdk_io_math.LegacyDec `protobuf:"bytes,4,opt,name=new_rate_limit_usd,json=newRateLimitUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"new_rate_limit_usd"`
 	// new_rate_limit_window is the new length of the sliding window
 	NewRateLimitWindow uint64 `protobuf:"varint,5,opt,name=new_rate_limit_window,json=newRateLimitWindow,proto3" json:"new_rate_limit_window,omitempty"`
+	// new_deposit_limit_usd is the new notional limit (on deposits) in USD. Zero
+	// disables inbound rate limiting. Left unchanged if not set
+	NewDepositLimitUsd cosmossdk_io_math.LegacyDec `protobuf:"bytes,6,opt,name=new_deposit_limit_usd,json=newDepositLimitUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"new_deposit_limit_usd"`
+	// new_per_recipient_deposit_limit_usd is the new notional limit (on deposits
+	// of a single recipient) in USD. Zero disables per-recipient limits. Left
+	// unchanged if not set
+	NewPerRecipientDepositLimitUsd cosmossdk_io_math.LegacyDec `protobuf:"bytes,7,opt,name=new_per_recipient_deposit_limit_usd,json=newPerRecipientDepositLimitUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"new_per_recipient_deposit_limit_usd"`
+	// new_price_config replaces the price sources, missing price behaviour and
+	// max price age of the rate limit. Left unchanged if not set
+	NewPriceConfig *RateLimitPriceConfig `protobuf:"bytes,8,opt,name=new_price_config,json=newPriceConfig,proto3" json:"new_price_config,omitempty"`
 }
 
 func (m *MsgUpdateRateLimit) Reset()         { *m = MsgUpdateRateLimit{} }
 func (m *MsgUpdateRateLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimit) ProtoMessage()    {}
 func (*MsgUpdateRateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{30}
+	return fileDescriptor_751daa04abed7ef4, []int{32}
 }
 func (m *MsgUpdateRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1780,6 +1936,13 @@ func (m *MsgUpdateRateLimit) GetNewRateLimitWindow() uint64 {
 	return 0
 }
 
+func (m *MsgUpdateRateLimit) GetNewPriceConfig() *RateLimitPriceConfig {
+	if m != nil {
+		return m.NewPriceConfig
+	}
+	return nil
+}
+
 type MsgUpdateRateLimitResponse struct {
 }
 
@@ -1787,7 +1950,7 @@ func (m *MsgUpdateRateLimitResponse) Reset()         { *m = MsgUpdateRateLimitRe
 func (m *MsgUpdateRateLimitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgUpdateRateLimitResponse) ProtoMessage()    {}
 func (*MsgUpdateRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{31}
+	return fileDescriptor_751daa04abed7ef4, []int{33}
 }
 func (m *MsgUpdateRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1827,7 +1990,7 @@ func (m *MsgRemoveRateLimit) Reset()         { *m = MsgRemoveRateLimit{} }
 func (m *MsgRemoveRateLimit) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRateLimit) ProtoMessage()    {}
 func (*MsgRemoveRateLimit) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{32}
+	return fileDescriptor_751daa04abed7ef4, []int{34}
 }
 func (m *MsgRemoveRateLimit) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1877,7 +2040,7 @@ func (m *MsgRemoveRateLimitResponse) Reset()         { *m = MsgRemoveRateLimitRe
 func (m *MsgRemoveRateLimitResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveRateLimitResponse) ProtoMessage()    {}
 func (*MsgRemoveRateLimitResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_751daa04abed7ef4, []int{33}
+	return fileDescriptor_751daa04abed7ef4, []int{35}
 }
 func (m *MsgRemoveRateLimitResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1906,1190 +2069,1435 @@ func (m *MsgRemoveRateLimitResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveRateLimitResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgSetOrchestratorAddresses)(nil), "injective.peggy.v1.MsgSetOrchestratorAddresses")
-	proto.RegisterType((*MsgSetOrchestratorAddressesResponse)(nil), "injective.peggy.v1.MsgSetOrchestratorAddressesResponse")
-	proto.RegisterType((*MsgValsetConfirm)(nil), "injective.peggy.v1.MsgValsetConfirm")
-	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "injective.peggy.v1.MsgValsetConfirmResponse")
-	proto.RegisterType((*MsgSendToEth)(nil), "injective.peggy.v1.MsgSendToEth")
-	proto.RegisterType((*MsgSendToEthResponse)(nil), "injective.peggy.v1.MsgSendToEthResponse")
-	proto.RegisterType((*MsgRequestBatch)(nil), "injective.peggy.v1.MsgRequestBatch")
-	proto.RegisterType((*MsgRequestBatchResponse)(nil), "injective.peggy.v1.MsgRequestBatchResponse")
-	proto.RegisterType((*MsgConfirmBatch)(nil), "injective.peggy.v1.MsgConfirmBatch")
-	proto.RegisterType((*MsgConfirmBatchResponse)(nil), "injective.peggy.v1.MsgConfirmBatchResponse")
-	proto.RegisterType((*MsgDepositClaim)(nil), "injective.peggy.v1.MsgDepositClaim")
-	proto.RegisterType((*MsgDepositClaimResponse)(nil), "injective.peggy.v1.MsgDepositClaimResponse")
-	proto.RegisterType((*MsgWithdrawClaim)(nil), "injective.peggy.v1.MsgWithdrawClaim")
-	proto.RegisterType((*MsgWithdrawClaimResponse)(nil), "injective.peggy.v1.MsgWithdrawClaimResponse")
-	proto.RegisterType((*MsgERC20DeployedClaim)(nil), "injective.peggy.v1.MsgERC20DeployedClaim")
-	proto.RegisterType((*MsgERC20DeployedClaimResponse)(nil), "injective.peggy.v1.MsgERC20DeployedClaimResponse")
-	proto.RegisterType((*MsgCancelSendToEth)(nil), "injective.peggy.v1.MsgCancelSendToEth")
-	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "injective.peggy.v1.MsgCancelSendToEthResponse")
-	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "injective.peggy.v1.MsgSubmitBadSignatureEvidence")
-	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "injective.peggy.v1.MsgSubmitBadSignatureEvidenceResponse")
-	proto.RegisterType((*MsgValsetUpdatedClaim)(nil), "injective.peggy.v1.MsgValsetUpdatedClaim")
-	proto.RegisterType((*MsgValsetUpdatedClaimResponse)(nil), "injective.peggy.v1.MsgValsetUpdatedClaimResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "injective.peggy.v1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.peggy.v1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgBlacklistEthereumAddresses)(nil), "injective.peggy.v1.MsgBlacklistEthereumAddresses")
-	proto.RegisterType((*MsgBlacklistEthereumAddressesResponse)(nil), "injective.peggy.v1.MsgBlacklistEthereumAddressesResponse")
-	proto.RegisterType((*MsgRevokeEthereumBlacklist)(nil), "injective.peggy.v1.MsgRevokeEthereumBlacklist")
-	proto.RegisterType((*MsgRevokeEthereumBlacklistResponse)(nil), "injective.peggy.v1.MsgRevokeEthereumBlacklistResponse")
-	proto.RegisterType((*MsgCreateRateLimit)(nil), "injective.peggy.v1.MsgCreateRateLimit")
-	proto.RegisterType((*MsgCreateRateLimitResponse)(nil), "injective.peggy.v1.MsgCreateRateLimitResponse")
-	proto.RegisterType((*MsgUpdateRateLimit)(nil), "injective.peggy.v1.MsgUpdateRateLimit")
-	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "injective.peggy.v1.MsgUpdateRateLimitResponse")
-	proto.RegisterType((*MsgRemoveRateLimit)(nil), "injective.peggy.v1.MsgRemoveRateLimit")
-	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "injective.peggy.v1.MsgRemoveRateLimitResponse")
+type MsgReleaseQueuedDeposits struct {
+	// authority is the address of peggy admin or governance account
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// ids of the queued deposits to release
+	DepositIds []uint64 `protobuf:"varint,2,rep,packed,name=deposit_ids,json=depositIds,proto3" json:"deposit_ids,omitempty"`
 }
 
-func init() { proto.RegisterFile("injective/peggy/v1/msgs.proto", fileDescriptor_751daa04abed7ef4) }
-
-var fileDescriptor_751daa04abed7ef4 = []byte{
-	// 2113 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x59, 0xcd, 0x6f, 0x1c, 0x49,
-	0x15, 0x4f, 0x7b, 0xc6, 0x4e, 0x5c, 0x63, 0xc7, 0xa4, 0xd7, 0x49, 0x26, 0x9d, 0xc4, 0x8e, 0xdb,
-	0xf9, 0xf0, 0x3a, 0x49, 0x4f, 0xec, 0xc0, 0x2e, 0x6b, 0x09, 0xa4, 0x8c, 0x1d, 0x84, 0xd9, 0x78,
-	0x89, 0xda, 0xc9, 0xae, 0xc4, 0xa5, 0xa9, 0xe9, 0x7e, 0xe9, 0x69, 0x3c, 0xdd, 0x35, 0x74, 0xd7,
-	0x8c, 0xf1, 0x01, 0x69, 0xd9, 0x1b, 0xcb, 0x61, 0x91, 0x38, 0x20, 0x90, 0xe0, 0x02, 0xd7, 0x95,
-	0x82, 0x94, 0x0b, 0xdc, 0x91, 0x56, 0x7b, 0x8a, 0xe0, 0x82, 0x10, 0x8a, 0x20, 0x41, 0xca, 0x9f,
-	0x01, 0xea, 0xaa, 0xea, 0x9a, 0xee, 0x9e, 0xee, 0xf1, 0x24, 0x6b, 0xe5, 0x62, 0x4d, 0xbd, 0x7a,
-	0x55, 0xf5, 0x7b, 0xdf, 0xef, 0xb5, 0xd1, 0x45, 0x2f, 0xf8, 0x11, 0xd8, 0xd4, 0xeb, 0x43, 0xa3,
-	0x0b, 0xae, 0x7b, 0xd0, 0xe8, 0xaf, 0x35, 0xfc, 0xc8, 0x8d, 0x8c, 0x6e, 0x48, 0x28, 0x51, 0x55,
-	0xb9, 0x6d, 0xb0, 0x6d, 0xa3, 0xbf, 0xa6, 0xcd, 0xbb, 0xc4, 0x25, 0x6c, 0xbb, 0x11, 0xff, 0xe2,
-	0x9c, 0xda, 0x05, 0x97, 0x10, 0xb7, 0x03, 0x0d, 0xdc, 0xf5, 0x1a, 0x38, 0x08, 0x08, 0xc5, 0xd4,
-	0x23, 0x81, 0xb8, 0x47, 0x3b, 0x27, 0x76, 0xd9, 0xaa, 0xd5, 0x7b, 0xd4, 0xc0, 0xc1, 0x81, 0xd8,
-	0x3a, 0x85, 0x7d, 0x2f, 0x20, 0x0d, 0xf6, 0x57, 0x90, 0x16, 0x6c, 0x12, 0xf9, 0x24, 0x6a, 0xb4,
-	0x70, 0x04, 0x8d, 0xfe, 0x5a, 0x0b, 0x28, 0x5e, 0x6b, 0xd8, 0xc4, 0x0b, 0xc4, 0xfe, 0x59, 0xb1,
-	0xef, 0x47, 0xae, 0xc0, 0x9b, 0x3c, 0xc3, 0x37, 0x2c, 0x8e, 0x8e, 0x2f, 0x92, 0x3b, 0x0b, 0x04,
-	0xa5, 0x07, 0x5d, 0x48, 0xf6, 0x17, 0x0b, 0xf6, 0xbb, 0x38, 0xc4, 0x7e, 0xc2, 0xb0, 0x5c, 0xc0,
-	0x10, 0x62, 0x0a, 0x56, 0xc7, 0xf3, 0x3d, 0xca, 0x99, 0xf4, 0xcf, 0x15, 0x74, 0x7e, 0x27, 0x72,
-	0x77, 0x81, 0x7e, 0x3f, 0xb4, 0xdb, 0x10, 0xd1, 0x10, 0x53, 0x12, 0xde, 0x71, 0x9c, 0x10, 0xa2,
-	0x08, 0x22, 0xf5, 0x0c, 0x9a, 0x8a, 0x20, 0x70, 0x20, 0xac, 0x2b, 0x97, 0x94, 0x95, 0x69, 0x53,
-	0xac, 0x54, 0x1d, 0xcd, 0x90, 0xd4, 0x81, 0xfa, 0x04, 0xdb, 0xcd, 0xd0, 0xd4, 0x45, 0x54, 0x03,
-	0xda, 0xb6, 0x30, 0xbf, 0xac, 0x5e, 0x61, 0x2c, 0x08, 0x68, 0x5b, 0x5c, 0xbf, 0xb1, 0xf6, 0xc9,
-	0xcb, 0xc7, 0xab, 0xe2, 0xc6, 0x4f, 0x5f, 0x3e, 0x5e, 0x5d, 0xe2, 0x38, 0x47, 0xe0, 0xd1, 0xaf,
-	0xa0, 0xe5, 0x11, 0xdb, 0x26, 0x44, 0x5d, 0x12, 0x44, 0xa0, 0xff, 0x59, 0x41, 0x5f, 0xdb, 0x89,
-	0xdc, 0x0f, 0x71, 0x27, 0x02, 0xba, 0x49, 0x82, 0x47, 0x5e, 0xe8, 0xab, 0xf3, 0x68, 0x32, 0x20,
-	0x81, 0x0d, 0x4c, 0x94, 0xaa, 0xc9, 0x17, 0x47, 0x22, 0x89, 0x7a, 0x01, 0x4d, 0x47, 0x9e, 0x1b,
-	0x60, 0xda, 0x0b, 0xa1, 0x5e, 0x65, 0xdb, 0x03, 0xc2, 0xc6, 0x8d, 0x58, 0xce, 0xcc, 0x8d, 0xb1,
-	0xb4, 0x67, 0xa4, 0xb4, 0x19, 0x98, 0xba, 0x86, 0xea, 0x79, 0x9a, 0x94, 0xeb, 0x99, 0x82, 0x66,
-	0x98, 0xfc, 0x81, 0xf3, 0x80, 0xdc, 0xa5, 0xed, 0x52, 0xfb, 0x9c, 0x43, 0x27, 0x62, 0xc4, 0x0e,
-	0x44, 0x54, 0x48, 0x74, 0x1c, 0x68, 0x7b, 0x0b, 0x22, 0xaa, 0xbe, 0x8b, 0xa6, 0xb0, 0x4f, 0x7a,
-	0x01, 0x65, 0x72, 0xd4, 0xd6, 0xcf, 0x19, 0xc2, 0xef, 0x62, 0xef, 0x35, 0x84, 0xf7, 0x1a, 0x9b,
-	0xc4, 0x0b, 0x9a, 0xd5, 0x2f, 0x9e, 0x2d, 0x1e, 0x33, 0x05, 0xbb, 0xfa, 0x6d, 0x84, 0x5a, 0xa1,
-	0xe7, 0xb8, 0x60, 0x3d, 0x02, 0x2e, 0xe5, 0x18, 0x87, 0xa7, 0xf9, 0x91, 0xef, 0x00, 0x6c, 0xe8,
-	0x39, 0x73, 0xab, 0x29, 0x73, 0x0b, 0x79, 0xf4, 0x33, 0x68, 0x3e, 0xbd, 0x96, 0x82, 0xff, 0x04,
-	0xcd, 0xed, 0x44, 0xae, 0x09, 0x3f, 0xee, 0x41, 0x44, 0x9b, 0x98, 0xda, 0xed, 0x21, 0xc3, 0x29,
-	0x05, 0x86, 0x9b, 0x47, 0x93, 0x0e, 0x04, 0xc4, 0x17, 0x3a, 0xe0, 0x8b, 0x8d, 0xeb, 0x85, 0xf6,
-	0x38, 0x2d, 0xe1, 0xa4, 0x9f, 0xd1, 0xcf, 0xa1, 0xb3, 0x39, 0x92, 0x04, 0xf5, 0x2f, 0x85, 0xa1,
-	0x12, 0x46, 0xe2, 0xa8, 0x8a, 0x9d, 0xec, 0x0a, 0x3a, 0x49, 0xc9, 0x1e, 0x04, 0x96, 0x4d, 0x02,
-	0x1a, 0x62, 0x3b, 0x31, 0xca, 0x2c, 0xa3, 0x6e, 0x0a, 0xa2, 0x7a, 0x11, 0xc5, 0x4e, 0x65, 0xc5,
-	0x9e, 0x03, 0xa1, 0x70, 0xb3, 0x69, 0xa0, 0xed, 0x5d, 0x46, 0x18, 0x92, 0xb8, 0x5a, 0x20, 0x71,
-	0xc6, 0x13, 0x27, 0xf3, 0x9e, 0x78, 0x98, 0xe4, 0x69, 0x51, 0x84, 0xe4, 0x69, 0x92, 0x94, 0xfc,
-	0xe7, 0x15, 0x26, 0xf9, 0x16, 0x74, 0x49, 0xe4, 0xd1, 0xcd, 0x0e, 0xf6, 0x7c, 0x16, 0x24, 0x7d,
-	0x08, 0xa8, 0x95, 0x96, 0x1f, 0x31, 0xd2, 0x07, 0x4c, 0x09, 0x4b, 0x68, 0xa6, 0xd5, 0x21, 0xf6,
-	0x9e, 0xd5, 0x06, 0xcf, 0x6d, 0x73, 0x15, 0x54, 0xcd, 0x1a, 0xa3, 0x7d, 0x97, 0x91, 0x0a, 0xf4,
-	0x54, 0x29, 0xd2, 0xd3, 0x37, 0xa4, 0x0b, 0x33, 0x15, 0x34, 0x2f, 0xc6, 0xae, 0xf6, 0xcf, 0x67,
-	0x8b, 0xa7, 0xb9, 0x33, 0x46, 0xce, 0x9e, 0xe1, 0x91, 0x86, 0x8f, 0x69, 0xdb, 0xd8, 0x0e, 0xa8,
-	0x74, 0xe0, 0x6b, 0x68, 0x0e, 0x68, 0x1b, 0x42, 0xe8, 0xf9, 0x96, 0x88, 0x1a, 0xae, 0xa1, 0x93,
-	0x09, 0x79, 0x97, 0x47, 0xcf, 0x35, 0x34, 0x27, 0x12, 0x73, 0x08, 0x36, 0x78, 0x7d, 0x08, 0xeb,
-	0x53, 0x9c, 0x91, 0x93, 0x4d, 0x41, 0x1d, 0xb2, 0xc8, 0xf1, 0x02, 0x8b, 0xa8, 0xa8, 0xea, 0x60,
-	0x8a, 0xeb, 0x27, 0xd8, 0x1e, 0xfb, 0xbd, 0xf1, 0xbd, 0x2f, 0x9f, 0xdc, 0x3c, 0x9f, 0x14, 0x28,
-	0x1e, 0x33, 0x77, 0x05, 0x04, 0xa6, 0xcc, 0x43, 0xcc, 0x94, 0xd6, 0xbb, 0x30, 0x53, 0x9a, 0x24,
-	0xcd, 0xf4, 0xd9, 0x04, 0x4b, 0x83, 0x1f, 0x79, 0xb4, 0xed, 0x84, 0x78, 0xff, 0xe8, 0xec, 0xb4,
-	0x88, 0x6a, 0xad, 0xd8, 0x21, 0xc4, 0x1d, 0x15, 0x7e, 0x07, 0x23, 0x7d, 0x50, 0xe2, 0xf0, 0xd5,
-	0x22, 0x43, 0xe6, 0xf5, 0x37, 0x39, 0xac, 0xbf, 0x8d, 0xf7, 0x5f, 0x47, 0x57, 0x83, 0xe4, 0x9a,
-	0x11, 0x5e, 0x24, 0xd7, 0x0c, 0x4d, 0x6a, 0xeb, 0xc5, 0x04, 0x3a, 0xbd, 0x13, 0xb9, 0x77, 0xcd,
-	0xcd, 0xf5, 0x5b, 0x5b, 0xd0, 0xed, 0x90, 0x03, 0x70, 0x8e, 0x4e, 0x65, 0x4b, 0x68, 0x46, 0xf8,
-	0x14, 0xcf, 0x48, 0xdc, 0xb1, 0x6b, 0x9c, 0xb6, 0x15, 0x93, 0xc6, 0x55, 0x9a, 0x8a, 0xaa, 0x01,
-	0xf6, 0x93, 0xe8, 0x66, 0xbf, 0x59, 0x1d, 0x38, 0xf0, 0x5b, 0xa4, 0x23, 0x1c, 0x55, 0xac, 0x54,
-	0x0d, 0x9d, 0x70, 0xc0, 0xf6, 0x7c, 0xdc, 0x89, 0x98, 0x73, 0x56, 0x4d, 0xb9, 0x1e, 0x52, 0xfe,
-	0x89, 0x02, 0xe5, 0xdf, 0x7f, 0x1d, 0xe5, 0x9f, 0x97, 0xca, 0x1f, 0xd6, 0xa5, 0xbe, 0x88, 0x2e,
-	0x16, 0x6e, 0x48, 0x33, 0xfc, 0x14, 0xa9, 0x71, 0xda, 0xc1, 0x81, 0x0d, 0x9d, 0x41, 0xa1, 0x8b,
-	0x75, 0x13, 0xe2, 0x20, 0xc2, 0x76, 0xdc, 0xa6, 0x59, 0x9e, 0x23, 0xac, 0x30, 0x9b, 0xa2, 0x6e,
-	0x3b, 0xa9, 0x7a, 0x38, 0x91, 0xae, 0x87, 0x1b, 0x2b, 0xb9, 0xda, 0x53, 0x1f, 0xa4, 0xbc, 0xec,
-	0x43, 0xfa, 0x05, 0xa4, 0x0d, 0x53, 0x25, 0xb8, 0xff, 0x28, 0x0c, 0xfe, 0x6e, 0xaf, 0xe5, 0x7b,
-	0xb4, 0x89, 0x9d, 0xdd, 0x24, 0xb5, 0xde, 0xed, 0x7b, 0x0e, 0xc4, 0xae, 0xf0, 0x10, 0x1d, 0x8f,
-	0x7a, 0xad, 0xb8, 0xf3, 0x62, 0x08, 0x6b, 0xeb, 0xf3, 0x06, 0xef, 0x25, 0x8d, 0xa4, 0x97, 0x34,
-	0xee, 0x04, 0x07, 0xcd, 0x2b, 0x5f, 0x3e, 0xb9, 0xb9, 0x34, 0xdc, 0xac, 0x4a, 0xed, 0xb2, 0x94,
-	0xef, 0x98, 0xc9, 0x5d, 0xd9, 0xbc, 0x3e, 0x91, 0xcb, 0xeb, 0x29, 0xb1, 0x2b, 0x19, 0xb1, 0x6f,
-	0xe7, 0xc4, 0x5e, 0x1e, 0x94, 0xdc, 0x52, 0x09, 0xf4, 0x6b, 0xe8, 0xca, 0x48, 0x06, 0xa9, 0x8c,
-	0xdf, 0x56, 0x58, 0xc0, 0xf0, 0x56, 0xe5, 0x61, 0xd7, 0xc1, 0xf4, 0x55, 0x02, 0xa6, 0xcf, 0x8e,
-	0x09, 0x0e, 0x11, 0x30, 0x9c, 0x56, 0x1c, 0x53, 0x95, 0xe1, 0x98, 0xfa, 0x16, 0x3a, 0xee, 0x83,
-	0xdf, 0x82, 0x30, 0xaa, 0x57, 0x2f, 0x55, 0x56, 0x6a, 0xeb, 0xcb, 0x46, 0x81, 0x4a, 0x9b, 0xac,
-	0x03, 0xf9, 0x10, 0x77, 0x3c, 0x27, 0xf6, 0x50, 0x33, 0x39, 0xa3, 0x36, 0xd1, 0x6c, 0x08, 0xfb,
-	0x38, 0x74, 0x2c, 0x51, 0x4d, 0x26, 0xc7, 0xa9, 0x26, 0x33, 0xfc, 0xcc, 0x1d, 0x5e, 0x53, 0x96,
-	0x90, 0x58, 0x5b, 0x2c, 0x48, 0x45, 0xf8, 0xd5, 0x38, 0xed, 0x41, 0x4c, 0x1a, 0xa7, 0x48, 0x7c,
-	0xd5, 0x38, 0x1b, 0x36, 0x81, 0x88, 0xb3, 0xe1, 0x0d, 0x69, 0xbd, 0xcf, 0x79, 0xf7, 0xc2, 0xf7,
-	0xee, 0xb3, 0xc9, 0x41, 0x7d, 0x07, 0x4d, 0xe3, 0x1e, 0x6d, 0x93, 0xd0, 0xa3, 0x07, 0xbc, 0xa1,
-	0x6a, 0xd6, 0xff, 0xf6, 0xe4, 0xe6, 0xbc, 0x68, 0xf2, 0x44, 0xbb, 0xbb, 0x4b, 0x43, 0x2f, 0x70,
-	0xcd, 0x01, 0xab, 0xfa, 0x4d, 0x34, 0xc5, 0x67, 0x0f, 0x66, 0xc8, 0xda, 0xba, 0x56, 0x64, 0x07,
-	0xfe, 0x46, 0xd2, 0x54, 0x72, 0x7e, 0x1e, 0x98, 0x83, 0x9b, 0xb2, 0x75, 0x2e, 0x8d, 0x4d, 0xd4,
-	0xb9, 0x34, 0x49, 0x8a, 0xf2, 0x3b, 0x1e, 0x95, 0xcd, 0x0e, 0xb6, 0xf7, 0x3a, 0x5e, 0x44, 0x13,
-	0xd5, 0x65, 0xe7, 0x18, 0xde, 0x55, 0x25, 0x7d, 0x32, 0x6f, 0xa9, 0x1a, 0xe8, 0xad, 0x56, 0x72,
-	0x2a, 0xe9, 0xef, 0x21, 0x96, 0xa2, 0xb2, 0x32, 0x6d, 0xaa, 0x72, 0x4b, 0x5e, 0x94, 0x44, 0x14,
-	0x3b, 0x9d, 0x8d, 0xa8, 0xf2, 0xd7, 0x45, 0x44, 0x95, 0x33, 0x48, 0x41, 0x7e, 0xad, 0xb0, 0xec,
-	0x63, 0x42, 0x9f, 0xec, 0x41, 0xc2, 0x26, 0xcf, 0x1d, 0x9d, 0x14, 0xb7, 0x72, 0x52, 0x5c, 0x4a,
-	0xf5, 0xbe, 0x85, 0x4f, 0xeb, 0x97, 0x91, 0x5e, 0xbe, 0x2b, 0xf1, 0xff, 0xa9, 0xc2, 0x93, 0x77,
-	0x08, 0x98, 0x82, 0x89, 0x29, 0xdc, 0x8b, 0x67, 0xcd, 0xd7, 0x76, 0xab, 0x65, 0xc4, 0x4b, 0x9f,
-	0x9c, 0xbc, 0xc4, 0x70, 0xc6, 0x88, 0xc9, 0xec, 0x25, 0xab, 0xa6, 0x2c, 0x74, 0x71, 0xa6, 0x98,
-	0x15, 0x55, 0x73, 0x2b, 0xa9, 0x76, 0x97, 0x13, 0xb6, 0x6e, 0xe8, 0xd9, 0x10, 0x17, 0x90, 0x6a,
-	0xea, 0xb2, 0xfb, 0x31, 0x71, 0xdb, 0x51, 0xb7, 0xd1, 0xc9, 0xc1, 0x8c, 0x6c, 0xf5, 0x22, 0x47,
-	0xe4, 0x84, 0x65, 0x91, 0x13, 0xce, 0x0f, 0xe7, 0x84, 0x7b, 0xe0, 0x62, 0xfb, 0x60, 0x0b, 0x6c,
-	0x73, 0x26, 0x4c, 0x24, 0x7e, 0x18, 0x39, 0xea, 0x0e, 0x7a, 0x0b, 0xb7, 0x22, 0xd2, 0xe9, 0x51,
-	0xb0, 0x7c, 0x2f, 0xa0, 0xfc, 0x4e, 0x9e, 0x20, 0x0e, 0xcb, 0x31, 0xa7, 0x92, 0x93, 0x3b, 0x5e,
-	0x40, 0xb9, 0x0e, 0x57, 0xd1, 0xa9, 0x14, 0xb2, 0x7d, 0x2f, 0x70, 0xc8, 0xbe, 0x28, 0xe9, 0x73,
-	0xf2, 0xdd, 0x8f, 0x18, 0x99, 0xb7, 0xf9, 0xd9, 0xa0, 0x4a, 0x15, 0xbc, 0xac, 0x71, 0x92, 0x82,
-	0x97, 0xa5, 0x4a, 0x8b, 0x3e, 0x9d, 0x60, 0x16, 0xe5, 0x61, 0xf7, 0x86, 0x2c, 0x7a, 0x1d, 0xa9,
-	0x01, 0xec, 0x5b, 0x39, 0x73, 0xf1, 0xca, 0x36, 0x17, 0xc0, 0xfe, 0x83, 0xb4, 0xc5, 0xee, 0x73,
-	0xe6, 0x9c, 0xd5, 0xaa, 0xe3, 0x5b, 0x2d, 0xbe, 0xd1, 0x4c, 0x1b, 0x6e, 0x0d, 0x9d, 0xce, 0xdd,
-	0x28, 0xb4, 0x3d, 0xc9, 0xb4, 0xad, 0xa6, 0xf9, 0xc7, 0x51, 0x78, 0x4e, 0x77, 0x42, 0xe1, 0x39,
-	0xaa, 0x54, 0xf8, 0xef, 0x15, 0xa6, 0x70, 0x13, 0x7c, 0xd2, 0x7f, 0x43, 0x0a, 0x1f, 0x0d, 0x3f,
-	0x87, 0x44, 0xc0, 0xcf, 0x51, 0x13, 0xf8, 0xeb, 0xff, 0x53, 0x51, 0x65, 0x27, 0x72, 0xd5, 0xcf,
-	0x14, 0x34, 0x9b, 0xfd, 0xfc, 0x72, 0xb9, 0xa8, 0x26, 0xe4, 0xbf, 0x74, 0x68, 0x37, 0xc6, 0xe1,
-	0x92, 0xca, 0x5a, 0xfd, 0xe4, 0xef, 0xff, 0xfd, 0xd5, 0xc4, 0x65, 0x5d, 0x6f, 0x14, 0x7c, 0xec,
-	0x12, 0x0d, 0x86, 0x2d, 0xde, 0xff, 0x58, 0x41, 0xd3, 0x83, 0x7e, 0xf2, 0x52, 0xc9, 0x3b, 0x92,
-	0x43, 0x5b, 0x39, 0x8c, 0x43, 0xa2, 0xb8, 0xc6, 0x50, 0x2c, 0xe9, 0x8b, 0x45, 0x28, 0xe2, 0xe6,
-	0xcb, 0xa2, 0xc4, 0x02, 0xda, 0x56, 0x7f, 0xa1, 0xa0, 0x99, 0xcc, 0x37, 0x8c, 0xe5, 0x92, 0x37,
-	0xd2, 0x4c, 0xda, 0xf5, 0x31, 0x98, 0x24, 0x96, 0xb7, 0x19, 0x96, 0x65, 0x7d, 0xa9, 0x08, 0x4b,
-	0xc8, 0x4f, 0x58, 0x6c, 0x50, 0x63, 0x68, 0x32, 0xdf, 0x2e, 0xca, 0xd0, 0xa4, 0x99, 0x4a, 0xd1,
-	0x14, 0x7e, 0x27, 0x18, 0x89, 0x46, 0x18, 0x26, 0x85, 0x26, 0xf3, 0x3d, 0xa1, 0x0c, 0x4d, 0x9a,
-	0xa9, 0x14, 0x4d, 0xe1, 0x38, 0x3c, 0x12, 0x8d, 0xc3, 0x4f, 0x58, 0x36, 0x7b, 0x3c, 0x76, 0xdf,
-	0xec, 0xd8, 0x5c, 0xe6, 0xbe, 0x19, 0xae, 0x52, 0xf7, 0x2d, 0x9e, 0x38, 0x47, 0xba, 0xef, 0xbe,
-	0x38, 0x22, 0x10, 0xfd, 0x41, 0x41, 0xa7, 0xd2, 0xdd, 0x1c, 0x47, 0xf5, 0xf6, 0xc8, 0x70, 0x49,
-	0xf7, 0x7d, 0xda, 0xda, 0xd8, 0xac, 0x12, 0xdf, 0x2d, 0x86, 0x6f, 0x55, 0x5f, 0x19, 0x11, 0x5e,
-	0x3d, 0x7e, 0x50, 0xa0, 0xfc, 0xa3, 0x82, 0xd4, 0x82, 0x01, 0xba, 0x0c, 0xe6, 0x30, 0x6b, 0x29,
-	0xcc, 0x11, 0x13, 0xe3, 0x48, 0x98, 0x10, 0xda, 0xeb, 0xb7, 0x2c, 0x47, 0x1c, 0x14, 0x30, 0xff,
-	0xa2, 0xa0, 0x7a, 0xe9, 0x37, 0xef, 0x46, 0x69, 0xe0, 0x17, 0x1f, 0xd0, 0xde, 0x7d, 0xc5, 0x03,
-	0x12, 0xf8, 0xd7, 0x19, 0x70, 0x43, 0xbf, 0x51, 0x9c, 0x38, 0xa8, 0x95, 0xee, 0xf8, 0x93, 0x0c,
-	0xae, 0xfe, 0x46, 0x41, 0x73, 0xf9, 0xf1, 0xf8, 0x6a, 0x59, 0x54, 0x66, 0xf9, 0x34, 0x63, 0x3c,
-	0x3e, 0x89, 0xd0, 0x60, 0x08, 0x57, 0xf4, 0xab, 0x85, 0x01, 0xcc, 0x0e, 0x59, 0xe9, 0x0c, 0xf7,
-	0x57, 0x05, 0x69, 0x23, 0x86, 0xe3, 0x32, 0xe3, 0x96, 0x1f, 0xd1, 0xde, 0x7b, 0xe5, 0x23, 0x12,
-	0xfc, 0x7b, 0x0c, 0xfc, 0x6d, 0x7d, 0xad, 0x50, 0xbd, 0xec, 0xbc, 0xd5, 0xc2, 0x8e, 0x25, 0xe7,
-	0x68, 0x0b, 0x12, 0xa0, 0x3f, 0x44, 0x33, 0x99, 0xc1, 0xa8, 0x2c, 0x19, 0xa5, 0x99, 0x4a, 0x93,
-	0x51, 0xd1, 0xcc, 0xa2, 0x7e, 0xaa, 0x20, 0x6d, 0xc4, 0xc0, 0x52, 0xa6, 0xa9, 0xf2, 0x23, 0xa5,
-	0x9a, 0x3a, 0x7c, 0xee, 0x50, 0x7f, 0xa6, 0xa0, 0xb3, 0x65, 0x43, 0x87, 0x51, 0x5a, 0x7e, 0x0a,
-	0xf9, 0xb5, 0x77, 0x5e, 0x8d, 0x5f, 0x62, 0xf0, 0xd0, 0x5c, 0x7e, 0x6e, 0x28, 0xf5, 0xea, 0x2c,
-	0x5f, 0xb9, 0x57, 0x17, 0x37, 0xb5, 0xf1, 0x53, 0xf9, 0x86, 0xf6, 0xea, 0x48, 0xdb, 0x1d, 0xfe,
-	0x54, 0x49, 0x3b, 0x17, 0x3f, 0x95, 0x6f, 0xe5, 0xae, 0x96, 0x2a, 0x28, 0xc3, 0xa7, 0x19, 0xe3,
-	0xf1, 0x25, 0x4f, 0x69, 0x93, 0x1f, 0xbf, 0x7c, 0xbc, 0xaa, 0x34, 0xe1, 0x8b, 0xe7, 0x0b, 0xca,
-	0xd3, 0xe7, 0x0b, 0xca, 0xbf, 0x9f, 0x2f, 0x28, 0xbf, 0x7c, 0xb1, 0x70, 0xec, 0xe9, 0x8b, 0x85,
-	0x63, 0xff, 0x78, 0xb1, 0x70, 0xec, 0x07, 0xef, 0xbb, 0x1e, 0x6d, 0xf7, 0x5a, 0x86, 0x4d, 0xfc,
-	0xc6, 0x76, 0x72, 0xf5, 0x3d, 0xdc, 0x8a, 0x06, 0xf1, 0x71, 0xd3, 0x26, 0x21, 0xa4, 0x97, 0x6d,
-	0xec, 0x05, 0x0d, 0x9f, 0x38, 0xbd, 0x0e, 0x44, 0x22, 0x78, 0xd8, 0x7f, 0x21, 0x5b, 0x53, 0xec,
-	0x73, 0xd6, 0xed, 0xff, 0x07, 0x00, 0x00, 0xff, 0xff, 0xaf, 0x2d, 0x3d, 0x67, 0x91, 0x1d, 0x00,
-	0x00,
+func (m *MsgReleaseQueuedDeposits) Reset()         { *m = MsgReleaseQueuedDeposits{} }
+func (m *MsgReleaseQueuedDeposits) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQueuedDeposits) ProtoMessage()    {}
+func (*MsgReleaseQueuedDeposits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{36}
 }
-
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
-
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error)
-	SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error)
-	RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error)
-	ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error)
-	DepositClaim(ctx context.Context, in *MsgDepositClaim, opts ...grpc.CallOption) (*MsgDepositClaimResponse, error)
-	WithdrawClaim(ctx context.Context, in *MsgWithdrawClaim, opts ...grpc.CallOption) (*MsgWithdrawClaimResponse, error)
-	ValsetUpdateClaim(ctx context.Context, in *MsgValsetUpdatedClaim, opts ...grpc.CallOption) (*MsgValsetUpdatedClaimResponse, error)
-	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
-	SetOrchestratorAddresses(ctx context.Context, in *MsgSetOrchestratorAddresses, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressesResponse, error)
-	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
-	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	// BlacklistEthereumAddresses adds Ethereum addresses to the peggy blacklist.
-	BlacklistEthereumAddresses(ctx context.Context, in *MsgBlacklistEthereumAddresses, opts ...grpc.CallOption) (*MsgBlacklistEthereumAddressesResponse, error)
-	// RevokeEthereumBlacklist removes Ethereum addresses from the peggy
-	// blacklist.
-	RevokeEthereumBlacklist(ctx context.Context, in *MsgRevokeEthereumBlacklist, opts ...grpc.CallOption) (*MsgRevokeEthereumBlacklistResponse, error)
-	// CreateRateLimit imposes a (notional) limit on withdrawals for a particular
-	// Peggy asset
-	CreateRateLimit(ctx context.Context, in *MsgCreateRateLimit, opts ...grpc.CallOption) (*MsgCreateRateLimitResponse, error)
-	// UpdateRateLimit updates the rate limit's metadata for a particular Peggy
-	// asset
-	UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
-	// RemoveRateLimit lifts the rate limit for a particular Peggy asset
-	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+func (m *MsgReleaseQueuedDeposits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgReleaseQueuedDeposits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseQueuedDeposits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+func (m *MsgReleaseQueuedDeposits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQueuedDeposits.Merge(m, src)
 }
-
-func (c *msgClient) ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error) {
-	out := new(MsgValsetConfirmResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ValsetConfirm", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReleaseQueuedDeposits) XXX_Size() int {
+	return m.Size()
 }
-
-func (c *msgClient) SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error) {
-	out := new(MsgSendToEthResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/SendToEth", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReleaseQueuedDeposits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQueuedDeposits.DiscardUnknown(m)
 }
 
-func (c *msgClient) RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error) {
-	out := new(MsgRequestBatchResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/RequestBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
-}
+var xxx_messageInfo_MsgReleaseQueuedDeposits proto.InternalMessageInfo
 
-func (c *msgClient) ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error) {
-	out := new(MsgConfirmBatchResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ConfirmBatch", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReleaseQueuedDeposits) GetAuthority() string {
+	if m != nil {
+		return m.Authority
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) DepositClaim(ctx context.Context, in *MsgDepositClaim, opts ...grpc.CallOption) (*MsgDepositClaimResponse, error) {
-	out := new(MsgDepositClaimResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/DepositClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReleaseQueuedDeposits) GetDepositIds() []uint64 {
+	if m != nil {
+		return m.DepositIds
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) WithdrawClaim(ctx context.Context, in *MsgWithdrawClaim, opts ...grpc.CallOption) (*MsgWithdrawClaimResponse, error) {
-	out := new(MsgWithdrawClaimResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/WithdrawClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+type MsgReleaseQueuedDepositsResponse struct {
 }
 
-func (c *msgClient) ValsetUpdateClaim(ctx context.Context, in *MsgValsetUpdatedClaim, opts ...grpc.CallOption) (*MsgValsetUpdatedClaimResponse, error) {
-	out := new(MsgValsetUpdatedClaimResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ValsetUpdateClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReleaseQueuedDepositsResponse) Reset()         { *m = MsgReleaseQueuedDepositsResponse{} }
+func (m *MsgReleaseQueuedDepositsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReleaseQueuedDepositsResponse) ProtoMessage()    {}
+func (*MsgReleaseQueuedDepositsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{37}
 }
-
-func (c *msgClient) ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error) {
-	out := new(MsgERC20DeployedClaimResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ERC20DeployedClaim", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReleaseQueuedDepositsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (c *msgClient) SetOrchestratorAddresses(ctx context.Context, in *MsgSetOrchestratorAddresses, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressesResponse, error) {
-	out := new(MsgSetOrchestratorAddressesResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/SetOrchestratorAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgReleaseQueuedDepositsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReleaseQueuedDepositsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-func (c *msgClient) CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error) {
-	out := new(MsgCancelSendToEthResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/CancelSendToEth", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReleaseQueuedDepositsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReleaseQueuedDepositsResponse.Merge(m, src)
 }
-
-func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error) {
-	out := new(MsgSubmitBadSignatureEvidenceResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/SubmitBadSignatureEvidence", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+func (m *MsgReleaseQueuedDepositsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReleaseQueuedDepositsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReleaseQueuedDepositsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgReleaseQueuedDepositsResponse proto.InternalMessageInfo
+
+// MsgRequestLogicCall
+// this message queues an arbitrary contract call on Ethereum. It can be sent by
+// the governance authority, one of the Peggy admins or any of the accounts
+// (e.g. wasm contracts) whitelisted in the logic_call_requesters param. The
+// validators then sign the call with a MsgConfirmLogicCall before a relayer
+// can submit it to the Peggy contract.
+// -------------
+type MsgRequestLogicCall struct {
+	// the Injective account requesting the call
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// the Ethereum contract the payload is executed against
+	LogicContractAddress string `protobuf:"bytes,2,opt,name=logic_contract_address,json=logicContractAddress,proto3" json:"logic_contract_address,omitempty"`
+	// ABI encoded calldata passed to the logic contract
+	Payload []byte `protobuf:"bytes,3,opt,name=payload,proto3" json:"payload,omitempty"`
+	// the Ethereum block height after which the call can no longer be executed
+	Timeout uint64 `protobuf:"varint,4,opt,name=timeout,proto3" json:"timeout,omitempty"`
+	// 32 byte identifier scoping the invalidation nonce on Ethereum
+	InvalidationId []byte `protobuf:"bytes,5,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	// nonce that must strictly increase for calls sharing the same
+	// invalidation_id
+	InvalidationNonce uint64 `protobuf:"varint,6,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+}
+
+func (m *MsgRequestLogicCall) Reset()         { *m = MsgRequestLogicCall{} }
+func (m *MsgRequestLogicCall) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestLogicCall) ProtoMessage()    {}
+func (*MsgRequestLogicCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{38}
+}
+func (m *MsgRequestLogicCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRequestLogicCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestLogicCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgRequestLogicCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestLogicCall.Merge(m, src)
+}
+func (m *MsgRequestLogicCall) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRequestLogicCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestLogicCall.DiscardUnknown(m)
 }
 
-func (c *msgClient) BlacklistEthereumAddresses(ctx context.Context, in *MsgBlacklistEthereumAddresses, opts ...grpc.CallOption) (*MsgBlacklistEthereumAddressesResponse, error) {
-	out := new(MsgBlacklistEthereumAddressesResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/BlacklistEthereumAddresses", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgRequestLogicCall proto.InternalMessageInfo
+
+func (m *MsgRequestLogicCall) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) RevokeEthereumBlacklist(ctx context.Context, in *MsgRevokeEthereumBlacklist, opts ...grpc.CallOption) (*MsgRevokeEthereumBlacklistResponse, error) {
-	out := new(MsgRevokeEthereumBlacklistResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/RevokeEthereumBlacklist", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestLogicCall) GetLogicContractAddress() string {
+	if m != nil {
+		return m.LogicContractAddress
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) CreateRateLimit(ctx context.Context, in *MsgCreateRateLimit, opts ...grpc.CallOption) (*MsgCreateRateLimitResponse, error) {
-	out := new(MsgCreateRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/CreateRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestLogicCall) GetPayload() []byte {
+	if m != nil {
+		return m.Payload
 	}
-	return out, nil
+	return nil
 }
 
-func (c *msgClient) UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
-	out := new(MsgUpdateRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/UpdateRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestLogicCall) GetTimeout() uint64 {
+	if m != nil {
+		return m.Timeout
 	}
-	return out, nil
+	return 0
 }
 
-func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
-	out := new(MsgRemoveRateLimitResponse)
-	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/RemoveRateLimit", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgRequestLogicCall) GetInvalidationId() []byte {
+	if m != nil {
+		return m.InvalidationId
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
-	SendToEth(context.Context, *MsgSendToEth) (*MsgSendToEthResponse, error)
-	RequestBatch(context.Context, *MsgRequestBatch) (*MsgRequestBatchResponse, error)
-	ConfirmBatch(context.Context, *MsgConfirmBatch) (*MsgConfirmBatchResponse, error)
-	DepositClaim(context.Context, *MsgDepositClaim) (*MsgDepositClaimResponse, error)
-	WithdrawClaim(context.Context, *MsgWithdrawClaim) (*MsgWithdrawClaimResponse, error)
-	ValsetUpdateClaim(context.Context, *MsgValsetUpdatedClaim) (*MsgValsetUpdatedClaimResponse, error)
-	ERC20DeployedClaim(context.Context, *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error)
-	SetOrchestratorAddresses(context.Context, *MsgSetOrchestratorAddresses) (*MsgSetOrchestratorAddressesResponse, error)
-	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
-	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	// BlacklistEthereumAddresses adds Ethereum addresses to the peggy blacklist.
-	BlacklistEthereumAddresses(context.Context, *MsgBlacklistEthereumAddresses) (*MsgBlacklistEthereumAddressesResponse, error)
-	// RevokeEthereumBlacklist removes Ethereum addresses from the peggy
-	// blacklist.
-	RevokeEthereumBlacklist(context.Context, *MsgRevokeEthereumBlacklist) (*MsgRevokeEthereumBlacklistResponse, error)
-	// CreateRateLimit imposes a (notional) limit on withdrawals for a particular
-	// Peggy asset
-	CreateRateLimit(context.Context, *MsgCreateRateLimit) (*MsgCreateRateLimitResponse, error)
-	// UpdateRateLimit updates the rate limit's metadata for a particular Peggy
-	// asset
-	UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
-	// RemoveRateLimit lifts the rate limit for a particular Peggy asset
-	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+func (m *MsgRequestLogicCall) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
+	}
+	return 0
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+type MsgRequestLogicCallResponse struct {
 }
 
-func (*UnimplementedMsgServer) ValsetConfirm(ctx context.Context, req *MsgValsetConfirm) (*MsgValsetConfirmResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValsetConfirm not implemented")
-}
-func (*UnimplementedMsgServer) SendToEth(ctx context.Context, req *MsgSendToEth) (*MsgSendToEthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SendToEth not implemented")
-}
-func (*UnimplementedMsgServer) RequestBatch(ctx context.Context, req *MsgRequestBatch) (*MsgRequestBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RequestBatch not implemented")
-}
-func (*UnimplementedMsgServer) ConfirmBatch(ctx context.Context, req *MsgConfirmBatch) (*MsgConfirmBatchResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBatch not implemented")
-}
-func (*UnimplementedMsgServer) DepositClaim(ctx context.Context, req *MsgDepositClaim) (*MsgDepositClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method DepositClaim not implemented")
-}
-func (*UnimplementedMsgServer) WithdrawClaim(ctx context.Context, req *MsgWithdrawClaim) (*MsgWithdrawClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method WithdrawClaim not implemented")
-}
-func (*UnimplementedMsgServer) ValsetUpdateClaim(ctx context.Context, req *MsgValsetUpdatedClaim) (*MsgValsetUpdatedClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ValsetUpdateClaim not implemented")
+func (m *MsgRequestLogicCallResponse) Reset()         { *m = MsgRequestLogicCallResponse{} }
+func (m *MsgRequestLogicCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRequestLogicCallResponse) ProtoMessage()    {}
+func (*MsgRequestLogicCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{39}
 }
-func (*UnimplementedMsgServer) ERC20DeployedClaim(ctx context.Context, req *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ERC20DeployedClaim not implemented")
+func (m *MsgRequestLogicCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) SetOrchestratorAddresses(ctx context.Context, req *MsgSetOrchestratorAddresses) (*MsgSetOrchestratorAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SetOrchestratorAddresses not implemented")
+func (m *MsgRequestLogicCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRequestLogicCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEth not implemented")
+func (m *MsgRequestLogicCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRequestLogicCallResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
+func (m *MsgRequestLogicCallResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgRequestLogicCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRequestLogicCallResponse.DiscardUnknown(m)
 }
-func (*UnimplementedMsgServer) BlacklistEthereumAddresses(ctx context.Context, req *MsgBlacklistEthereumAddresses) (*MsgBlacklistEthereumAddressesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method BlacklistEthereumAddresses not implemented")
+
+var xxx_messageInfo_MsgRequestLogicCallResponse proto.InternalMessageInfo
+
+// MsgConfirmLogicCall
+// this is the message sent by the validators when they wish to submit their
+// signatures over a pending logic call
+// -------------
+type MsgConfirmLogicCall struct {
+	// hex encoded invalidation id of the logic call
+	InvalidationId    string `protobuf:"bytes,1,opt,name=invalidation_id,json=invalidationId,proto3" json:"invalidation_id,omitempty"`
+	InvalidationNonce uint64 `protobuf:"varint,2,opt,name=invalidation_nonce,json=invalidationNonce,proto3" json:"invalidation_nonce,omitempty"`
+	EthSigner         string `protobuf:"bytes,3,opt,name=eth_signer,json=ethSigner,proto3" json:"eth_signer,omitempty"`
+	Orchestrator      string `protobuf:"bytes,4,opt,name=orchestrator,proto3" json:"orchestrator,omitempty"`
+	Signature         string `protobuf:"bytes,5,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *MsgConfirmLogicCall) Reset()         { *m = MsgConfirmLogicCall{} }
+func (m *MsgConfirmLogicCall) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmLogicCall) ProtoMessage()    {}
+func (*MsgConfirmLogicCall) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{40}
+}
+func (m *MsgConfirmLogicCall) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) RevokeEthereumBlacklist(ctx context.Context, req *MsgRevokeEthereumBlacklist) (*MsgRevokeEthereumBlacklistResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RevokeEthereumBlacklist not implemented")
+func (m *MsgConfirmLogicCall) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmLogicCall.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) CreateRateLimit(ctx context.Context, req *MsgCreateRateLimit) (*MsgCreateRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateRateLimit not implemented")
+func (m *MsgConfirmLogicCall) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmLogicCall.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimit not implemented")
+func (m *MsgConfirmLogicCall) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+func (m *MsgConfirmLogicCall) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmLogicCall.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
-}
+var xxx_messageInfo_MsgConfirmLogicCall proto.InternalMessageInfo
 
-func _Msg_ValsetConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgValsetConfirm)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ValsetConfirm(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/ValsetConfirm",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ValsetConfirm(ctx, req.(*MsgValsetConfirm))
+func (m *MsgConfirmLogicCall) GetInvalidationId() string {
+	if m != nil {
+		return m.InvalidationId
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_SendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSendToEth)
-	if err := dec(in); err != nil {
-		return nil, err
+func (m *MsgConfirmLogicCall) GetInvalidationNonce() uint64 {
+	if m != nil {
+		return m.InvalidationNonce
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SendToEth(ctx, in)
+	return 0
+}
+
+func (m *MsgConfirmLogicCall) GetEthSigner() string {
+	if m != nil {
+		return m.EthSigner
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/SendToEth",
+	return ""
+}
+
+func (m *MsgConfirmLogicCall) GetOrchestrator() string {
+	if m != nil {
+		return m.Orchestrator
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SendToEth(ctx, req.(*MsgSendToEth))
+	return ""
+}
+
+func (m *MsgConfirmLogicCall) GetSignature() string {
+	if m != nil {
+		return m.Signature
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_RequestBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRequestBatch)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).RequestBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/RequestBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RequestBatch(ctx, req.(*MsgRequestBatch))
+type MsgConfirmLogicCallResponse struct {
+}
+
+func (m *MsgConfirmLogicCallResponse) Reset()         { *m = MsgConfirmLogicCallResponse{} }
+func (m *MsgConfirmLogicCallResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgConfirmLogicCallResponse) ProtoMessage()    {}
+func (*MsgConfirmLogicCallResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_751daa04abed7ef4, []int{41}
+}
+func (m *MsgConfirmLogicCallResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgConfirmLogicCallResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgConfirmLogicCallResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgConfirmLogicCallResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgConfirmLogicCallResponse.Merge(m, src)
+}
+func (m *MsgConfirmLogicCallResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgConfirmLogicCallResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgConfirmLogicCallResponse.DiscardUnknown(m)
 }
 
-func _Msg_ConfirmBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgConfirmBatch)
-	if err := dec(in); err != nil {
+var xxx_messageInfo_MsgConfirmLogicCallResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgSetOrchestratorAddresses)(nil), "injective.peggy.v1.MsgSetOrchestratorAddresses")
+	proto.RegisterType((*MsgSetOrchestratorAddressesResponse)(nil), "injective.peggy.v1.MsgSetOrchestratorAddressesResponse")
+	proto.RegisterType((*MsgValsetConfirm)(nil), "injective.peggy.v1.MsgValsetConfirm")
+	proto.RegisterType((*MsgValsetConfirmResponse)(nil), "injective.peggy.v1.MsgValsetConfirmResponse")
+	proto.RegisterType((*MsgSendToEth)(nil), "injective.peggy.v1.MsgSendToEth")
+	proto.RegisterType((*MsgSendToEthResponse)(nil), "injective.peggy.v1.MsgSendToEthResponse")
+	proto.RegisterType((*MsgRequestBatch)(nil), "injective.peggy.v1.MsgRequestBatch")
+	proto.RegisterType((*MsgRequestBatchResponse)(nil), "injective.peggy.v1.MsgRequestBatchResponse")
+	proto.RegisterType((*MsgConfirmBatch)(nil), "injective.peggy.v1.MsgConfirmBatch")
+	proto.RegisterType((*MsgConfirmBatchResponse)(nil), "injective.peggy.v1.MsgConfirmBatchResponse")
+	proto.RegisterType((*MsgDepositClaim)(nil), "injective.peggy.v1.MsgDepositClaim")
+	proto.RegisterType((*MsgDepositClaimResponse)(nil), "injective.peggy.v1.MsgDepositClaimResponse")
+	proto.RegisterType((*MsgWithdrawClaim)(nil), "injective.peggy.v1.MsgWithdrawClaim")
+	proto.RegisterType((*MsgWithdrawClaimResponse)(nil), "injective.peggy.v1.MsgWithdrawClaimResponse")
+	proto.RegisterType((*MsgERC20DeployedClaim)(nil), "injective.peggy.v1.MsgERC20DeployedClaim")
+	proto.RegisterType((*MsgERC20DeployedClaimResponse)(nil), "injective.peggy.v1.MsgERC20DeployedClaimResponse")
+	proto.RegisterType((*MsgCancelSendToEth)(nil), "injective.peggy.v1.MsgCancelSendToEth")
+	proto.RegisterType((*MsgCancelSendToEthResponse)(nil), "injective.peggy.v1.MsgCancelSendToEthResponse")
+	proto.RegisterType((*MsgBumpSendToEthFee)(nil), "injective.peggy.v1.MsgBumpSendToEthFee")
+	proto.RegisterType((*MsgBumpSendToEthFeeResponse)(nil), "injective.peggy.v1.MsgBumpSendToEthFeeResponse")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidence)(nil), "injective.peggy.v1.MsgSubmitBadSignatureEvidence")
+	proto.RegisterType((*MsgSubmitBadSignatureEvidenceResponse)(nil), "injective.peggy.v1.MsgSubmitBadSignatureEvidenceResponse")
+	proto.RegisterType((*MsgValsetUpdatedClaim)(nil), "injective.peggy.v1.MsgValsetUpdatedClaim")
+	proto.RegisterType((*MsgValsetUpdatedClaimResponse)(nil), "injective.peggy.v1.MsgValsetUpdatedClaimResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "injective.peggy.v1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.peggy.v1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgBlacklistEthereumAddresses)(nil), "injective.peggy.v1.MsgBlacklistEthereumAddresses")
+	proto.RegisterType((*MsgBlacklistEthereumAddressesResponse)(nil), "injective.peggy.v1.MsgBlacklistEthereumAddressesResponse")
+	proto.RegisterType((*MsgRevokeEthereumBlacklist)(nil), "injective.peggy.v1.MsgRevokeEthereumBlacklist")
+	proto.RegisterType((*MsgRevokeEthereumBlacklistResponse)(nil), "injective.peggy.v1.MsgRevokeEthereumBlacklistResponse")
+	proto.RegisterType((*MsgCreateRateLimit)(nil), "injective.peggy.v1.MsgCreateRateLimit")
+	proto.RegisterType((*MsgCreateRateLimitResponse)(nil), "injective.peggy.v1.MsgCreateRateLimitResponse")
+	proto.RegisterType((*MsgUpdateRateLimit)(nil), "injective.peggy.v1.MsgUpdateRateLimit")
+	proto.RegisterType((*MsgUpdateRateLimitResponse)(nil), "injective.peggy.v1.MsgUpdateRateLimitResponse")
+	proto.RegisterType((*MsgRemoveRateLimit)(nil), "injective.peggy.v1.MsgRemoveRateLimit")
+	proto.RegisterType((*MsgRemoveRateLimitResponse)(nil), "injective.peggy.v1.MsgRemoveRateLimitResponse")
+	proto.RegisterType((*MsgReleaseQueuedDeposits)(nil), "injective.peggy.v1.MsgReleaseQueuedDeposits")
+	proto.RegisterType((*MsgReleaseQueuedDepositsResponse)(nil), "injective.peggy.v1.MsgReleaseQueuedDepositsResponse")
+	proto.RegisterType((*MsgRequestLogicCall)(nil), "injective.peggy.v1.MsgRequestLogicCall")
+	proto.RegisterType((*MsgRequestLogicCallResponse)(nil), "injective.peggy.v1.MsgRequestLogicCallResponse")
+	proto.RegisterType((*MsgConfirmLogicCall)(nil), "injective.peggy.v1.MsgConfirmLogicCall")
+	proto.RegisterType((*MsgConfirmLogicCallResponse)(nil), "injective.peggy.v1.MsgConfirmLogicCallResponse")
+}
+
+func init() { proto.RegisterFile("injective/peggy/v1/msgs.proto", fileDescriptor_751daa04abed7ef4) }
+
+var fileDescriptor_751daa04abed7ef4 = []byte{
+	// 2648 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xbc, 0x5a, 0x4d, 0x6c, 0xdc, 0xc6,
+	0xf5, 0x37, 0xa5, 0x95, 0x64, 0xcd, 0xae, 0x2c, 0x8b, 0x96, 0xec, 0x15, 0x15, 0xeb, 0x83, 0xb2,
+	0x2d, 0x59, 0xb2, 0x77, 0x2d, 0x25, 0xff, 0xe4, 0x1f, 0x01, 0x2d, 0xe0, 0x95, 0x1c, 0x54, 0x8d,
+	0x95, 0xb8, 0x54, 0x3e, 0x80, 0x5e, 0xd8, 0x59, 0xf2, 0x89, 0xcb, 0x6a, 0x49, 0x6e, 0xc9, 0xd9,
+	0x55, 0x84, 0xa2, 0x40, 0x9a, 0x5b, 0x53, 0x14, 0x29, 0xd0, 0x43, 0xd1, 0x02, 0xcd, 0xa5, 0x45,
+	0x6f, 0x01, 0x72, 0xc8, 0xa5, 0xbd, 0x16, 0x05, 0x82, 0x9c, 0x82, 0xf6, 0x52, 0x14, 0x45, 0xd0,
+	0xc6, 0x05, 0x72, 0xeb, 0xbd, 0xa7, 0x16, 0x9c, 0x19, 0xce, 0x92, 0x5c, 0x72, 0x45, 0x39, 0x6e,
+	0x2e, 0x86, 0xf8, 0xe6, 0xbd, 0x37, 0xbf, 0xf7, 0x31, 0x6f, 0xde, 0x9b, 0x35, 0xba, 0x6e, 0xbb,
+	0xdf, 0x05, 0x83, 0xd8, 0x3d, 0xa8, 0x77, 0xc0, 0xb2, 0x4e, 0xeb, 0xbd, 0xad, 0xba, 0x13, 0x58,
+	0x41, 0xad, 0xe3, 0x7b, 0xc4, 0x93, 0x65, 0xb1, 0x5c, 0xa3, 0xcb, 0xb5, 0xde, 0x96, 0x32, 0x6b,
+	0x79, 0x96, 0x47, 0x97, 0xeb, 0xe1, 0x5f, 0x8c, 0x53, 0x79, 0xc6, 0xf2, 0x3c, 0xab, 0x0d, 0x75,
+	0xdc, 0xb1, 0xeb, 0xd8, 0x75, 0x3d, 0x82, 0x89, 0xed, 0xb9, 0x5c, 0x8f, 0x32, 0xcf, 0x57, 0xe9,
+	0x57, 0xb3, 0x7b, 0x54, 0xc7, 0xee, 0x29, 0x5f, 0x9a, 0xc1, 0x8e, 0xed, 0x7a, 0x75, 0xfa, 0x2f,
+	0x27, 0x2d, 0x1a, 0x5e, 0xe0, 0x78, 0x41, 0xbd, 0x89, 0x03, 0xa8, 0xf7, 0xb6, 0x9a, 0x40, 0xf0,
+	0x56, 0xdd, 0xf0, 0x6c, 0x97, 0xaf, 0x5f, 0xe3, 0xeb, 0x4e, 0x60, 0x71, 0xbc, 0xd1, 0x36, 0x6c,
+	0x41, 0x67, 0xe8, 0xd8, 0x47, 0xa4, 0x33, 0xc3, 0x50, 0x72, 0xda, 0x81, 0x68, 0x7d, 0x29, 0x63,
+	0xbd, 0x83, 0x7d, 0xec, 0x44, 0x0c, 0xab, 0x19, 0x0c, 0x3e, 0x26, 0xa0, 0xb7, 0x6d, 0xc7, 0x26,
+	0x8c, 0x49, 0xfd, 0x40, 0x42, 0x0b, 0x07, 0x81, 0x75, 0x08, 0xe4, 0x55, 0xdf, 0x68, 0x41, 0x40,
+	0x7c, 0x4c, 0x3c, 0xff, 0xbe, 0x69, 0xfa, 0x10, 0x04, 0x10, 0xc8, 0x57, 0xd1, 0x78, 0x00, 0xae,
+	0x09, 0x7e, 0x55, 0x5a, 0x96, 0xd6, 0x27, 0x35, 0xfe, 0x25, 0xab, 0xa8, 0xe2, 0xc5, 0x04, 0xaa,
+	0x23, 0x74, 0x35, 0x41, 0x93, 0x97, 0x50, 0x19, 0x48, 0x4b, 0xc7, 0x4c, 0x59, 0x75, 0x94, 0xb2,
+	0x20, 0x20, 0x2d, 0xae, 0x7e, 0x67, 0xeb, 0x9d, 0x2f, 0x3e, 0xdc, 0xe0, 0x1a, 0xdf, 0xfd, 0xe2,
+	0xc3, 0x8d, 0x15, 0x86, 0x73, 0x08, 0x1e, 0xf5, 0x26, 0x5a, 0x1d, 0xb2, 0xac, 0x41, 0xd0, 0xf1,
+	0xdc, 0x00, 0xd4, 0xdf, 0x49, 0xe8, 0xf2, 0x41, 0x60, 0xbd, 0x81, 0xdb, 0x01, 0x90, 0x5d, 0xcf,
+	0x3d, 0xb2, 0x7d, 0x47, 0x9e, 0x45, 0x63, 0xae, 0xe7, 0x1a, 0x40, 0x4d, 0x29, 0x69, 0xec, 0xe3,
+	0xa9, 0x58, 0x22, 0x3f, 0x83, 0x26, 0x03, 0xdb, 0x72, 0x31, 0xe9, 0xfa, 0x50, 0x2d, 0xd1, 0xe5,
+	0x3e, 0x61, 0xe7, 0x4e, 0x68, 0x67, 0x42, 0x63, 0x68, 0xed, 0x55, 0x61, 0x6d, 0x02, 0xa6, 0xaa,
+	0xa0, 0x6a, 0x9a, 0x26, 0xec, 0xfa, 0x4c, 0x42, 0x15, 0x6a, 0xbf, 0x6b, 0xbe, 0xe6, 0x3d, 0x20,
+	0xad, 0xdc, 0xf8, 0xcc, 0xa3, 0x8b, 0x21, 0x62, 0x13, 0x02, 0xc2, 0x2d, 0x9a, 0x00, 0xd2, 0xda,
+	0x83, 0x80, 0xc8, 0x2f, 0xa0, 0x71, 0xec, 0x78, 0x5d, 0x97, 0x50, 0x3b, 0xca, 0xdb, 0xf3, 0x35,
+	0x9e, 0x77, 0x61, 0xf6, 0xd6, 0x78, 0xf6, 0xd6, 0x76, 0x3d, 0xdb, 0x6d, 0x94, 0x3e, 0xfe, 0x6c,
+	0xe9, 0x82, 0xc6, 0xd9, 0xe5, 0xaf, 0x23, 0xd4, 0xf4, 0x6d, 0xd3, 0x02, 0xfd, 0x08, 0x98, 0x95,
+	0x05, 0x84, 0x27, 0x99, 0xc8, 0x4b, 0x00, 0x3b, 0x6a, 0x2a, 0xdc, 0x72, 0x2c, 0xdc, 0xdc, 0x1e,
+	0xf5, 0x2a, 0x9a, 0x8d, 0x7f, 0x0b, 0xc3, 0xdf, 0x42, 0xd3, 0x07, 0x81, 0xa5, 0xc1, 0xf7, 0xba,
+	0x10, 0x90, 0x06, 0x26, 0x46, 0x6b, 0x20, 0x70, 0x52, 0x46, 0xe0, 0x66, 0xd1, 0x98, 0x09, 0xae,
+	0xe7, 0x70, 0x1f, 0xb0, 0x8f, 0x9d, 0xcd, 0xcc, 0x78, 0xcc, 0x09, 0x38, 0xf1, 0x6d, 0xd4, 0x79,
+	0x74, 0x2d, 0x45, 0x12, 0xa0, 0xfe, 0x26, 0x51, 0x54, 0x3c, 0x48, 0x0c, 0x55, 0x76, 0x92, 0xdd,
+	0x44, 0x97, 0x88, 0x77, 0x0c, 0xae, 0x6e, 0x78, 0x2e, 0xf1, 0xb1, 0x11, 0x05, 0x65, 0x8a, 0x52,
+	0x77, 0x39, 0x51, 0xbe, 0x8e, 0xc2, 0xa4, 0xd2, 0xc3, 0xcc, 0x01, 0x9f, 0xa7, 0xd9, 0x24, 0x90,
+	0xd6, 0x21, 0x25, 0x0c, 0x58, 0x5c, 0xca, 0xb0, 0x38, 0x91, 0x89, 0x63, 0xe9, 0x4c, 0x3c, 0xcb,
+	0xf2, 0xb8, 0x29, 0xdc, 0xf2, 0x38, 0x49, 0x58, 0xfe, 0xa3, 0x51, 0x6a, 0xf9, 0x1e, 0x74, 0xbc,
+	0xc0, 0x26, 0xbb, 0x6d, 0x6c, 0x3b, 0xf4, 0x90, 0xf4, 0xc0, 0x25, 0x7a, 0xdc, 0x7e, 0x44, 0x49,
+	0xaf, 0x50, 0x27, 0xac, 0xa0, 0x4a, 0xb3, 0xed, 0x19, 0xc7, 0x7a, 0x0b, 0x6c, 0xab, 0xc5, 0x5c,
+	0x50, 0xd2, 0xca, 0x94, 0xf6, 0x0d, 0x4a, 0xca, 0xf0, 0xd3, 0x68, 0x96, 0x9f, 0xfe, 0x4f, 0xa4,
+	0x30, 0x75, 0x41, 0xe3, 0x7a, 0x98, 0x6a, 0x7f, 0xfd, 0x6c, 0x69, 0x8e, 0x25, 0x63, 0x60, 0x1e,
+	0xd7, 0x6c, 0xaf, 0xee, 0x60, 0xd2, 0xaa, 0xed, 0xbb, 0x44, 0x24, 0xf0, 0x1a, 0x9a, 0x06, 0xd2,
+	0x02, 0x1f, 0xba, 0x8e, 0xce, 0x4f, 0x0d, 0xf3, 0xd0, 0xa5, 0x88, 0x7c, 0xc8, 0x4e, 0xcf, 0x1a,
+	0x9a, 0xe6, 0x85, 0xd9, 0x07, 0x03, 0xec, 0x1e, 0xf8, 0xd5, 0x71, 0xc6, 0xc8, 0xc8, 0x1a, 0xa7,
+	0x0e, 0x44, 0x64, 0x22, 0x23, 0x22, 0x32, 0x2a, 0x99, 0x98, 0xe0, 0xea, 0x45, 0xba, 0x46, 0xff,
+	0xde, 0xf9, 0xe6, 0x27, 0x1f, 0xdd, 0x5d, 0x88, 0x2e, 0x28, 0x76, 0x66, 0x1e, 0x70, 0x08, 0xd4,
+	0x99, 0x67, 0x84, 0x29, 0xee, 0x77, 0x1e, 0xa6, 0x38, 0x49, 0x84, 0xe9, 0xbd, 0x11, 0x5a, 0x06,
+	0xdf, 0xb4, 0x49, 0xcb, 0xf4, 0xf1, 0xc9, 0xd3, 0x8b, 0xd3, 0x12, 0x2a, 0x37, 0xc3, 0x84, 0xe0,
+	0x3a, 0x46, 0x99, 0x0e, 0x4a, 0x7a, 0x25, 0x27, 0xe1, 0x4b, 0x59, 0x81, 0x4c, 0xfb, 0x6f, 0x6c,
+	0xd0, 0x7f, 0x3b, 0x2f, 0x3f, 0x89, 0xaf, 0xfa, 0xc5, 0x35, 0x61, 0x3c, 0x2f, 0xae, 0x09, 0x9a,
+	0xf0, 0xd6, 0xe3, 0x11, 0x34, 0x77, 0x10, 0x58, 0x0f, 0xb4, 0xdd, 0xed, 0x7b, 0x7b, 0xd0, 0x69,
+	0x7b, 0xa7, 0x60, 0x3e, 0x3d, 0x97, 0xad, 0xa0, 0x0a, 0xcf, 0x29, 0x56, 0x91, 0x58, 0x62, 0x97,
+	0x19, 0x6d, 0x2f, 0x24, 0x15, 0x75, 0x9a, 0x8c, 0x4a, 0x2e, 0x76, 0xa2, 0xd3, 0x4d, 0xff, 0xa6,
+	0xf7, 0xc0, 0xa9, 0xd3, 0xf4, 0xda, 0x3c, 0x51, 0xf9, 0x97, 0xac, 0xa0, 0x8b, 0x26, 0x18, 0xb6,
+	0x83, 0xdb, 0x01, 0x4d, 0xce, 0x92, 0x26, 0xbe, 0x07, 0x9c, 0x7f, 0x31, 0xc3, 0xf9, 0x8f, 0x9e,
+	0xc4, 0xf9, 0x0b, 0xc2, 0xf9, 0x83, 0xbe, 0x54, 0x97, 0xd0, 0xf5, 0xcc, 0x05, 0x11, 0x86, 0x1f,
+	0x20, 0x39, 0x2c, 0x3b, 0xd8, 0x35, 0xa0, 0xdd, 0xbf, 0xe8, 0x42, 0xdf, 0xf8, 0xd8, 0x0d, 0xb0,
+	0x11, 0xb6, 0x69, 0xba, 0x6d, 0xf2, 0x28, 0x4c, 0xc5, 0xa8, 0xfb, 0x66, 0xec, 0x3e, 0x1c, 0x89,
+	0xdf, 0x87, 0x3b, 0xeb, 0xa9, 0xbb, 0xa7, 0xda, 0x2f, 0x79, 0xc9, 0x8d, 0xd4, 0x67, 0x90, 0x32,
+	0x48, 0x15, 0xe0, 0xfe, 0x20, 0xa1, 0x2b, 0x07, 0x81, 0xd5, 0xe8, 0x3a, 0x1d, 0xb1, 0xf8, 0x12,
+	0xc0, 0x97, 0x84, 0x27, 0x37, 0x50, 0xe5, 0x08, 0x40, 0xb7, 0x5d, 0xc3, 0x07, 0x1c, 0x40, 0xd1,
+	0x9b, 0xb9, 0x7c, 0x04, 0xb0, 0xcf, 0x65, 0x76, 0x6e, 0xa7, 0x4c, 0x9c, 0x17, 0x26, 0xa6, 0xd1,
+	0xaa, 0xd7, 0xd1, 0x42, 0x06, 0x59, 0x18, 0xf9, 0x0f, 0x89, 0xc6, 0xe8, 0xb0, 0xdb, 0x74, 0x6c,
+	0xd2, 0xc0, 0xe6, 0x61, 0x74, 0x7f, 0x3c, 0xe8, 0xd9, 0x26, 0x84, 0xf9, 0xfe, 0x3a, 0x9a, 0x08,
+	0xba, 0xcd, 0xb0, 0xbd, 0xa4, 0x76, 0x96, 0xb7, 0x67, 0x6b, 0xac, 0x61, 0xae, 0x45, 0x0d, 0x73,
+	0xed, 0xbe, 0x7b, 0xda, 0xb8, 0xf9, 0xc9, 0x47, 0x77, 0x57, 0x06, 0x3b, 0x72, 0x91, 0x42, 0xa1,
+	0x62, 0x30, 0xb5, 0x48, 0x57, 0xf2, 0xf2, 0x1a, 0x49, 0x5d, 0x5e, 0x31, 0xe7, 0x8d, 0x26, 0x62,
+	0xfb, 0x6c, 0xca, 0xf0, 0xd5, 0x7e, 0x5f, 0x91, 0x6b, 0x81, 0xba, 0x86, 0x6e, 0x0e, 0x65, 0x10,
+	0xce, 0xf8, 0xe5, 0x28, 0x9a, 0x13, 0xfd, 0xd8, 0xeb, 0x1d, 0x13, 0x93, 0xf3, 0x54, 0x85, 0x1e,
+	0x15, 0xe3, 0x1c, 0xbc, 0x2a, 0x30, 0x5a, 0x76, 0xe1, 0x18, 0x1d, 0x2c, 0x1c, 0x5f, 0x43, 0x13,
+	0x0e, 0x38, 0x4d, 0xf0, 0x83, 0x6a, 0x69, 0x79, 0x74, 0xbd, 0xbc, 0xbd, 0x5a, 0xcb, 0x70, 0x69,
+	0x83, 0xb6, 0x59, 0x6f, 0xe0, 0xb6, 0x6d, 0x86, 0xc7, 0x50, 0x8b, 0x64, 0xe4, 0x06, 0x9a, 0xf2,
+	0xe1, 0x04, 0xfb, 0xa6, 0xce, 0xaf, 0xcc, 0xb1, 0x22, 0x57, 0x66, 0x85, 0xc9, 0xdc, 0x67, 0x17,
+	0xe7, 0x0a, 0xe2, 0xdf, 0x3a, 0xad, 0x44, 0xbc, 0xc6, 0x94, 0x19, 0xed, 0xb5, 0x90, 0x54, 0xe4,
+	0x26, 0xfc, 0xb2, 0xc5, 0x64, 0x30, 0x04, 0xbc, 0x98, 0x0c, 0x2e, 0x88, 0xe8, 0x7d, 0xc0, 0x5a,
+	0x34, 0xb6, 0xf6, 0x88, 0x8e, 0x47, 0xf2, 0xf3, 0x68, 0x12, 0x77, 0x49, 0xcb, 0xf3, 0x6d, 0x72,
+	0xca, 0xba, 0xc6, 0x46, 0xf5, 0x4f, 0x1f, 0xdd, 0x9d, 0xe5, 0x87, 0x8d, 0xf7, 0xf4, 0x87, 0xc4,
+	0xb7, 0x5d, 0x4b, 0xeb, 0xb3, 0xca, 0xff, 0x8f, 0xc6, 0xd9, 0x80, 0x45, 0x03, 0x59, 0xde, 0x56,
+	0xb2, 0xe2, 0xc0, 0xf6, 0x88, 0x3a, 0x67, 0xc6, 0xcf, 0xaa, 0x4f, 0x5f, 0x53, 0xf2, 0x32, 0x8f,
+	0x63, 0xe3, 0x97, 0x79, 0x9c, 0x24, 0x4c, 0xf9, 0x15, 0x3b, 0x95, 0x8d, 0x36, 0x36, 0x8e, 0xdb,
+	0x76, 0x40, 0x22, 0xd7, 0x25, 0x87, 0x35, 0xd6, 0x3a, 0x46, 0xc3, 0x00, 0xfd, 0x92, 0xeb, 0xe8,
+	0x4a, 0x33, 0x92, 0x8a, 0x86, 0x18, 0x08, 0xad, 0x18, 0x5d, 0x9f, 0xd4, 0x64, 0xb1, 0x24, 0x14,
+	0x45, 0x27, 0x8a, 0x4a, 0x27, 0x4f, 0x54, 0xfe, 0xee, 0xfc, 0x44, 0xe5, 0x33, 0x08, 0x43, 0x7e,
+	0x2e, 0xd1, 0x12, 0xab, 0x41, 0xcf, 0x3b, 0x86, 0x88, 0x4d, 0xc8, 0x3d, 0x3d, 0x2b, 0xee, 0xa5,
+	0xac, 0x58, 0x8e, 0x35, 0xf8, 0x99, 0x5b, 0xab, 0x37, 0x90, 0x9a, 0xbf, 0x2a, 0xf0, 0xff, 0x64,
+	0x82, 0xdd, 0x50, 0x3e, 0x60, 0x02, 0x1a, 0x26, 0xf0, 0x30, 0x1c, 0xa8, 0x9f, 0x38, 0xad, 0x56,
+	0x11, 0xbb, 0xdf, 0xc5, 0x78, 0xc9, 0x27, 0x50, 0x4a, 0xe4, 0x52, 0xfd, 0xd6, 0x40, 0xdc, 0xe6,
+	0x61, 0xa5, 0x98, 0xe2, 0xad, 0xc1, 0x1e, 0x27, 0xca, 0x37, 0x22, 0xb6, 0x8e, 0x6f, 0x1b, 0x10,
+	0x5e, 0x43, 0xa5, 0x98, 0xb2, 0x47, 0x21, 0x71, 0xdf, 0x94, 0xf7, 0xd1, 0xa5, 0xfe, 0x43, 0x80,
+	0xde, 0x0d, 0x4c, 0x5e, 0x13, 0x56, 0x79, 0x4d, 0x58, 0x18, 0xac, 0x09, 0x0f, 0xc1, 0xc2, 0xc6,
+	0xe9, 0x1e, 0x18, 0x5a, 0xc5, 0x8f, 0x2c, 0x7e, 0x3d, 0x30, 0xe5, 0x03, 0x74, 0x05, 0x37, 0x03,
+	0xaf, 0xdd, 0x25, 0xa0, 0x3b, 0xb6, 0x4b, 0x98, 0xce, 0xea, 0x78, 0x91, 0x1a, 0x33, 0x13, 0x49,
+	0x1e, 0xd8, 0x2e, 0x61, 0x3e, 0xdc, 0x40, 0x33, 0x31, 0x64, 0x27, 0xb6, 0x6b, 0x7a, 0x27, 0xbc,
+	0x6f, 0x99, 0x16, 0xfb, 0xbe, 0x49, 0xc9, 0xf2, 0xab, 0x68, 0xc6, 0x64, 0x4d, 0x6f, 0xcc, 0x90,
+	0x8b, 0xc5, 0x0d, 0x99, 0xe6, 0xd2, 0xc2, 0x16, 0x1b, 0x2d, 0x75, 0xc0, 0x0f, 0x5b, 0x7e, 0xbb,
+	0x63, 0x87, 0x75, 0x7d, 0x50, 0xfd, 0x64, 0x71, 0xf5, 0x0b, 0x1d, 0xf0, 0xb5, 0x48, 0xd5, 0x5e,
+	0x6a, 0xab, 0x06, 0xaa, 0xb0, 0x08, 0x05, 0x5e, 0xd7, 0x37, 0xa0, 0x8a, 0x68, 0x41, 0x59, 0xca,
+	0x2c, 0x28, 0x21, 0xdf, 0x21, 0x65, 0xd3, 0xca, 0x9d, 0xfe, 0x87, 0x7c, 0x88, 0xe6, 0x8e, 0x70,
+	0xbb, 0xdd, 0xc4, 0xc6, 0xb1, 0x9e, 0x50, 0x56, 0x2e, 0xa6, 0xec, 0x4a, 0x24, 0x1d, 0x23, 0xca,
+	0x18, 0x5d, 0x73, 0xec, 0x20, 0xb0, 0x5d, 0x8b, 0xeb, 0x6c, 0x42, 0x0b, 0xf7, 0x6c, 0xaf, 0xeb,
+	0x57, 0x2b, 0xcb, 0xd2, 0xfa, 0xa5, 0xed, 0xdb, 0x59, 0x6a, 0x0f, 0x98, 0x08, 0x55, 0xd4, 0x88,
+	0x04, 0xb4, 0x39, 0x27, 0x8b, 0x2c, 0xab, 0x68, 0xca, 0xc1, 0x6f, 0x71, 0xf5, 0xd8, 0x82, 0xea,
+	0x14, 0xbb, 0xf3, 0x1c, 0xfc, 0x16, 0xe5, 0xbc, 0x6f, 0xf1, 0x39, 0x35, 0x59, 0x30, 0x63, 0x1d,
+	0x5b, 0xf2, 0xe0, 0x45, 0x1d, 0x5b, 0x92, 0x2a, 0x4e, 0xeb, 0xbf, 0x4a, 0x48, 0x16, 0x25, 0xf5,
+	0x2b, 0x3a, 0xad, 0x9b, 0x48, 0x76, 0xe1, 0x44, 0x4f, 0x1d, 0x45, 0xd6, 0xb5, 0x4c, 0xbb, 0x70,
+	0xf2, 0x5a, 0xfc, 0x34, 0x3e, 0x62, 0xcc, 0xa9, 0x13, 0x59, 0x3a, 0x47, 0x22, 0xbb, 0x70, 0xa2,
+	0xc5, 0x0f, 0xe5, 0x16, 0x9a, 0x4b, 0x69, 0xe4, 0x27, 0x69, 0x8c, 0x7a, 0x5a, 0x8e, 0xf3, 0xf3,
+	0xc3, 0xf4, 0x06, 0x13, 0x19, 0xcc, 0xf8, 0xf1, 0xe2, 0x38, 0x42, 0xbd, 0xe9, 0x44, 0xef, 0xa0,
+	0xd5, 0x50, 0xef, 0x59, 0xe7, 0x6a, 0xa2, 0xf8, 0x2e, 0x8b, 0x2e, 0x9c, 0x3c, 0x1a, 0x72, 0xb4,
+	0x34, 0x74, 0x99, 0xee, 0x48, 0xbd, 0x6e, 0x84, 0x8f, 0x17, 0x16, 0xad, 0x0a, 0xe5, 0xed, 0xf5,
+	0xac, 0xd4, 0x15, 0x8e, 0xa0, 0xe1, 0xa0, 0x8f, 0x1d, 0x96, 0x76, 0x29, 0xdc, 0xa3, 0xff, 0x3d,
+	0x3c, 0x1d, 0x53, 0x99, 0xc5, 0xd3, 0x31, 0x45, 0x15, 0xe9, 0xf8, 0xbe, 0x44, 0xd3, 0x51, 0x03,
+	0xc7, 0xeb, 0x7d, 0x45, 0xe9, 0x38, 0x1c, 0x7e, 0x0a, 0x09, 0x87, 0x9f, 0xa2, 0x0a, 0xf8, 0xbf,
+	0x95, 0xe8, 0x00, 0xad, 0x41, 0x1b, 0x70, 0x00, 0xdf, 0xea, 0x42, 0x17, 0x4c, 0x1e, 0x81, 0x27,
+	0x6f, 0xac, 0x96, 0x50, 0x39, 0x4a, 0x09, 0xdb, 0x64, 0x37, 0x7a, 0x49, 0x43, 0x9c, 0xb4, 0x6f,
+	0xf2, 0x87, 0xe2, 0xa4, 0x01, 0x8b, 0x31, 0x03, 0x32, 0xb0, 0xa8, 0x2a, 0x5a, 0xce, 0x5b, 0xeb,
+	0xb7, 0xf6, 0x23, 0xe8, 0x4a, 0xff, 0x6d, 0xef, 0xa1, 0x67, 0xd9, 0xc6, 0x2e, 0x6e, 0xb7, 0x73,
+	0x1f, 0x55, 0x9f, 0x43, 0x57, 0xdb, 0x21, 0x93, 0x98, 0xcf, 0x53, 0x5e, 0x9f, 0xa5, 0xab, 0xd1,
+	0x9c, 0x1e, 0x15, 0x83, 0x2a, 0x9a, 0xe8, 0xe0, 0xd3, 0xb6, 0x87, 0x59, 0x05, 0xa8, 0x68, 0xd1,
+	0x67, 0xb8, 0x42, 0x6c, 0x07, 0xbc, 0x2e, 0x1b, 0xf4, 0x4b, 0x5a, 0xf4, 0x19, 0x3e, 0x40, 0xd9,
+	0x6e, 0x8f, 0x35, 0xf3, 0x7c, 0x9e, 0x1c, 0xa3, 0xb2, 0x97, 0xe2, 0xe4, 0x7d, 0x53, 0xbe, 0x8b,
+	0xe4, 0x04, 0x23, 0x1b, 0x34, 0xc6, 0xa9, 0xb6, 0x99, 0xf8, 0x0a, 0x1d, 0x37, 0x86, 0xcc, 0x88,
+	0x69, 0x27, 0xf0, 0x19, 0x31, 0x4d, 0x16, 0xbe, 0xfb, 0x0f, 0x1b, 0x84, 0xf9, 0xeb, 0x60, 0xdf,
+	0x77, 0x19, 0xc8, 0x99, 0x13, 0x8b, 0x21, 0x1f, 0xc9, 0x41, 0xfe, 0xbf, 0x7f, 0x1a, 0xad, 0x67,
+	0x4e, 0x1f, 0xf3, 0xe9, 0xa7, 0xd1, 0xb4, 0x83, 0xd2, 0xe4, 0xc8, 0x41, 0xdb, 0xff, 0xbe, 0x8a,
+	0x46, 0x0f, 0x02, 0x4b, 0x7e, 0x4f, 0x42, 0x53, 0xc9, 0xdf, 0x21, 0x6e, 0x64, 0x5e, 0xa1, 0xa9,
+	0x27, 0x7f, 0xe5, 0x4e, 0x11, 0x2e, 0x11, 0x8e, 0x8d, 0x77, 0xfe, 0xfc, 0xcf, 0x9f, 0x8d, 0xdc,
+	0x50, 0xd5, 0x7a, 0xc6, 0xaf, 0x3e, 0x7c, 0x08, 0x35, 0xf8, 0xfe, 0x6f, 0x4b, 0x68, 0xb2, 0xff,
+	0xb0, 0xb2, 0x9c, 0xb3, 0x8f, 0xe0, 0x50, 0xd6, 0xcf, 0xe2, 0x10, 0x28, 0xd6, 0x28, 0x8a, 0x15,
+	0x75, 0x29, 0x0b, 0x45, 0x98, 0x75, 0x3a, 0xf1, 0x74, 0x20, 0x2d, 0xf9, 0xc7, 0x12, 0xaa, 0x24,
+	0x1e, 0xf3, 0x57, 0x73, 0xf6, 0x88, 0x33, 0x29, 0x9b, 0x05, 0x98, 0x04, 0x96, 0xdb, 0x14, 0xcb,
+	0xaa, 0xba, 0x92, 0x85, 0xc5, 0x67, 0x12, 0x3a, 0x7d, 0xb1, 0xa4, 0x68, 0x12, 0x8f, 0xf8, 0x79,
+	0x68, 0xe2, 0x4c, 0xca, 0x66, 0x01, 0xa6, 0x62, 0x68, 0x78, 0x60, 0x62, 0x68, 0x12, 0x0f, 0xeb,
+	0x79, 0x68, 0xe2, 0x4c, 0xca, 0x66, 0x01, 0xa6, 0x62, 0x68, 0xa2, 0x52, 0x6c, 0xd0, 0xcd, 0xc3,
+	0xf4, 0x4d, 0xbe, 0x1f, 0xe7, 0xa5, 0x6f, 0x82, 0x4b, 0xb9, 0x53, 0x84, 0xab, 0x58, 0xfa, 0x9e,
+	0x70, 0x11, 0x8e, 0xe8, 0xd7, 0x12, 0x9a, 0x89, 0x4f, 0xfc, 0x0c, 0xd5, 0xed, 0xa1, 0xc7, 0x25,
+	0xfe, 0x36, 0xa0, 0x6c, 0x15, 0x66, 0x15, 0xf8, 0xee, 0x51, 0x7c, 0x1b, 0xea, 0xfa, 0x90, 0xe3,
+	0xd5, 0x65, 0x82, 0x1c, 0xe5, 0x6f, 0x24, 0x24, 0x67, 0xbc, 0x24, 0xe7, 0xc1, 0x1c, 0x64, 0x55,
+	0xb6, 0x0a, 0xb3, 0x16, 0x83, 0x09, 0xbe, 0xb1, 0x7d, 0x4f, 0x37, 0xb9, 0x20, 0x87, 0xf9, 0x7b,
+	0x09, 0x55, 0x73, 0x7f, 0xfc, 0xad, 0xe7, 0x1e, 0xfc, 0x6c, 0x01, 0xe5, 0x85, 0x73, 0x0a, 0x08,
+	0xe0, 0xcf, 0x51, 0xe0, 0x35, 0xf5, 0x4e, 0x76, 0xe1, 0x20, 0x7a, 0xbc, 0x2e, 0x47, 0xb7, 0xae,
+	0xfc, 0x0b, 0x09, 0x4d, 0xa7, 0xdf, 0x89, 0x6f, 0xe5, 0x9d, 0xca, 0x24, 0x9f, 0x52, 0x2b, 0xc6,
+	0x27, 0x10, 0xd6, 0x28, 0xc2, 0x75, 0xf5, 0x56, 0xe6, 0x01, 0xa6, 0x42, 0x7a, 0xbc, 0xc2, 0xbd,
+	0x2f, 0xa1, 0xcb, 0x03, 0xaf, 0xc4, 0x6b, 0x39, 0x9b, 0xa6, 0x19, 0x95, 0x7a, 0x41, 0xc6, 0x62,
+	0x91, 0x6f, 0x76, 0x9d, 0x4e, 0x1c, 0x5c, 0xf8, 0xfb, 0xad, 0xfc, 0x47, 0x09, 0x29, 0x43, 0x5e,
+	0x78, 0xf3, 0xb2, 0x2f, 0x5f, 0x44, 0x79, 0xf1, 0xdc, 0x22, 0x02, 0xfe, 0x8b, 0x14, 0xfe, 0xb3,
+	0xea, 0x56, 0x66, 0xfc, 0xa9, 0xbc, 0xde, 0xc4, 0xa6, 0x2e, 0xae, 0x6b, 0x1d, 0x22, 0xa0, 0xdf,
+	0x41, 0x95, 0xc4, 0xeb, 0x5e, 0x5e, 0xb5, 0x8c, 0x33, 0x29, 0x9b, 0x05, 0x98, 0x22, 0x70, 0xf2,
+	0xbb, 0x12, 0x52, 0x86, 0xbc, 0xba, 0xe5, 0x79, 0x2a, 0x5f, 0x44, 0x79, 0xf1, 0xdc, 0x22, 0x02,
+	0xcc, 0x0f, 0x25, 0x74, 0x2d, 0xef, 0xe5, 0xac, 0x96, 0x7b, 0x3f, 0x66, 0xf2, 0x2b, 0xcf, 0x9f,
+	0x8f, 0x5f, 0x60, 0xb0, 0xd1, 0x74, 0xfa, 0xf1, 0x2b, 0xf7, 0xd8, 0x25, 0xf9, 0x94, 0x5a, 0x31,
+	0xbe, 0xf8, 0x56, 0xe9, 0xc9, 0xfd, 0xd6, 0xd0, 0xd8, 0x9d, 0xbd, 0x55, 0xce, 0x64, 0x16, 0x6e,
+	0x95, 0x9e, 0xca, 0x6e, 0xe5, 0x3a, 0x28, 0xc1, 0xa7, 0xd4, 0x8a, 0xf1, 0x89, 0xad, 0xbe, 0x8f,
+	0xe6, 0xb2, 0x27, 0xa8, 0x3b, 0xb9, 0x8a, 0x32, 0xb8, 0x95, 0xe7, 0xce, 0xc3, 0x2d, 0x36, 0x6f,
+	0xa3, 0xcb, 0x03, 0x13, 0xcf, 0xda, 0xf0, 0xce, 0x4a, 0x30, 0x2a, 0xf5, 0x82, 0x8c, 0xf1, 0xdd,
+	0x06, 0x67, 0x84, 0xe1, 0x9d, 0xd3, 0xd9, 0xbb, 0xe5, 0x35, 0xdd, 0xca, 0xd8, 0xdb, 0x5f, 0x7c,
+	0xb8, 0x21, 0x35, 0xe0, 0xe3, 0xcf, 0x17, 0xa5, 0x4f, 0x3f, 0x5f, 0x94, 0xfe, 0xfe, 0xf9, 0xa2,
+	0xf4, 0xd3, 0xc7, 0x8b, 0x17, 0x3e, 0x7d, 0xbc, 0x78, 0xe1, 0x2f, 0x8f, 0x17, 0x2f, 0x7c, 0xfb,
+	0x65, 0xcb, 0x26, 0xad, 0x6e, 0xb3, 0x66, 0x78, 0x4e, 0x7d, 0x3f, 0xd2, 0xfd, 0x10, 0x37, 0x83,
+	0x7e, 0xe1, 0xb9, 0x6b, 0x78, 0x3e, 0xc4, 0x3f, 0x5b, 0xd8, 0x76, 0xeb, 0x8e, 0x67, 0x76, 0xdb,
+	0x10, 0xf0, 0xaa, 0x44, 0xff, 0x23, 0x56, 0x73, 0x9c, 0xfe, 0xd8, 0xf5, 0xec, 0x7f, 0x07, 0x00,
+	0xac, 0x31, 0xb7, 0x76, 0x94, 0x26, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error)
+	SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error)
+	RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error)
+	ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error)
+	DepositClaim(ctx context.Context, in *MsgDepositClaim, opts ...grpc.CallOption) (*MsgDepositClaimResponse, error)
+	WithdrawClaim(ctx context.Context, in *MsgWithdrawClaim, opts ...grpc.CallOption) (*MsgWithdrawClaimResponse, error)
+	ValsetUpdateClaim(ctx context.Context, in *MsgValsetUpdatedClaim, opts ...grpc.CallOption) (*MsgValsetUpdatedClaimResponse, error)
+	ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error)
+	SetOrchestratorAddresses(ctx context.Context, in *MsgSetOrchestratorAddresses, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressesResponse, error)
+	CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error)
+	BumpSendToEthFee(ctx context.Context, in *MsgBumpSendToEthFee, opts ...grpc.CallOption) (*MsgBumpSendToEthFeeResponse, error)
+	SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// BlacklistEthereumAddresses adds Ethereum addresses to the peggy blacklist.
+	BlacklistEthereumAddresses(ctx context.Context, in *MsgBlacklistEthereumAddresses, opts ...grpc.CallOption) (*MsgBlacklistEthereumAddressesResponse, error)
+	// RevokeEthereumBlacklist removes Ethereum addresses from the peggy
+	// blacklist.
+	RevokeEthereumBlacklist(ctx context.Context, in *MsgRevokeEthereumBlacklist, opts ...grpc.CallOption) (*MsgRevokeEthereumBlacklistResponse, error)
+	//  CreateRateLimit imposes a (notional) limit on withdrawals for a particular
+	//  Peggy asset
+	CreateRateLimit(ctx context.Context, in *MsgCreateRateLimit, opts ...grpc.CallOption) (*MsgCreateRateLimitResponse, error)
+	//  UpdateRateLimit updates the rate limit's metadata for a particular Peggy
+	//  asset
+	UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error)
+	//  RemoveRateLimit lifts the rate limit for a particular Peggy asset
+	RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error)
+	//  ReleaseQueuedDeposits releases deposits held back by inbound rate
+	//  limits regardless of the remaining limits
+	ReleaseQueuedDeposits(ctx context.Context, in *MsgReleaseQueuedDeposits, opts ...grpc.CallOption) (*MsgReleaseQueuedDepositsResponse, error)
+	//  RequestLogicCall queues an arbitrary contract call to be executed on
+	//  Ethereum by the Peggy contract
+	RequestLogicCall(ctx context.Context, in *MsgRequestLogicCall, opts ...grpc.CallOption) (*MsgRequestLogicCallResponse, error)
+	//  ConfirmLogicCall submits a validator signature over a pending logic call
+	ConfirmLogicCall(ctx context.Context, in *MsgConfirmLogicCall, opts ...grpc.CallOption) (*MsgConfirmLogicCallResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) ValsetConfirm(ctx context.Context, in *MsgValsetConfirm, opts ...grpc.CallOption) (*MsgValsetConfirmResponse, error) {
+	out := new(MsgValsetConfirmResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ValsetConfirm", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ConfirmBatch(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/ConfirmBatch",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ConfirmBatch(ctx, req.(*MsgConfirmBatch))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_DepositClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgDepositClaim)
-	if err := dec(in); err != nil {
+func (c *msgClient) SendToEth(ctx context.Context, in *MsgSendToEth, opts ...grpc.CallOption) (*MsgSendToEthResponse, error) {
+	out := new(MsgSendToEthResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/SendToEth", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).DepositClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/DepositClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).DepositClaim(ctx, req.(*MsgDepositClaim))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_WithdrawClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgWithdrawClaim)
-	if err := dec(in); err != nil {
+func (c *msgClient) RequestBatch(ctx context.Context, in *MsgRequestBatch, opts ...grpc.CallOption) (*MsgRequestBatchResponse, error) {
+	out := new(MsgRequestBatchResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/RequestBatch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).WithdrawClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/WithdrawClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).WithdrawClaim(ctx, req.(*MsgWithdrawClaim))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ValsetUpdateClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgValsetUpdatedClaim)
-	if err := dec(in); err != nil {
+func (c *msgClient) ConfirmBatch(ctx context.Context, in *MsgConfirmBatch, opts ...grpc.CallOption) (*MsgConfirmBatchResponse, error) {
+	out := new(MsgConfirmBatchResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ConfirmBatch", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ValsetUpdateClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/ValsetUpdateClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ValsetUpdateClaim(ctx, req.(*MsgValsetUpdatedClaim))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_ERC20DeployedClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgERC20DeployedClaim)
-	if err := dec(in); err != nil {
+func (c *msgClient) DepositClaim(ctx context.Context, in *MsgDepositClaim, opts ...grpc.CallOption) (*MsgDepositClaimResponse, error) {
+	out := new(MsgDepositClaimResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/DepositClaim", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).ERC20DeployedClaim(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/ERC20DeployedClaim",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ERC20DeployedClaim(ctx, req.(*MsgERC20DeployedClaim))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SetOrchestratorAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSetOrchestratorAddresses)
-	if err := dec(in); err != nil {
+func (c *msgClient) WithdrawClaim(ctx context.Context, in *MsgWithdrawClaim, opts ...grpc.CallOption) (*MsgWithdrawClaimResponse, error) {
+	out := new(MsgWithdrawClaimResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/WithdrawClaim", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SetOrchestratorAddresses(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/SetOrchestratorAddresses",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SetOrchestratorAddresses(ctx, req.(*MsgSetOrchestratorAddresses))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_CancelSendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCancelSendToEth)
-	if err := dec(in); err != nil {
+func (c *msgClient) ValsetUpdateClaim(ctx context.Context, in *MsgValsetUpdatedClaim, opts ...grpc.CallOption) (*MsgValsetUpdatedClaimResponse, error) {
+	out := new(MsgValsetUpdatedClaimResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ValsetUpdateClaim", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).CancelSendToEth(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/CancelSendToEth",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CancelSendToEth(ctx, req.(*MsgCancelSendToEth))
-	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgSubmitBadSignatureEvidence)
-	if err := dec(in); err != nil {
+func (c *msgClient) ERC20DeployedClaim(ctx context.Context, in *MsgERC20DeployedClaim, opts ...grpc.CallOption) (*MsgERC20DeployedClaimResponse, error) {
+	out := new(MsgERC20DeployedClaimResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ERC20DeployedClaim", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) SetOrchestratorAddresses(ctx context.Context, in *MsgSetOrchestratorAddresses, opts ...grpc.CallOption) (*MsgSetOrchestratorAddressesResponse, error) {
+	out := new(MsgSetOrchestratorAddressesResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/SetOrchestratorAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/SubmitBadSignatureEvidence",
+	return out, nil
+}
+
+func (c *msgClient) CancelSendToEth(ctx context.Context, in *MsgCancelSendToEth, opts ...grpc.CallOption) (*MsgCancelSendToEthResponse, error) {
+	out := new(MsgCancelSendToEthResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/CancelSendToEth", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, req.(*MsgSubmitBadSignatureEvidence))
+	return out, nil
+}
+
+func (c *msgClient) BumpSendToEthFee(ctx context.Context, in *MsgBumpSendToEthFee, opts ...grpc.CallOption) (*MsgBumpSendToEthFeeResponse, error) {
+	out := new(MsgBumpSendToEthFeeResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/BumpSendToEthFee", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
+func (c *msgClient) SubmitBadSignatureEvidence(ctx context.Context, in *MsgSubmitBadSignatureEvidence, opts ...grpc.CallOption) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	out := new(MsgSubmitBadSignatureEvidenceResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/SubmitBadSignatureEvidence", in, out, opts...)
+	if err != nil {
 		return nil, err
 	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/UpdateParams",
+	return out, nil
+}
+
+func (c *msgClient) BlacklistEthereumAddresses(ctx context.Context, in *MsgBlacklistEthereumAddresses, opts ...grpc.CallOption) (*MsgBlacklistEthereumAddressesResponse, error) {
+	out := new(MsgBlacklistEthereumAddressesResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/BlacklistEthereumAddresses", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	return out, nil
+}
+
+func (c *msgClient) RevokeEthereumBlacklist(ctx context.Context, in *MsgRevokeEthereumBlacklist, opts ...grpc.CallOption) (*MsgRevokeEthereumBlacklistResponse, error) {
+	out := new(MsgRevokeEthereumBlacklistResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/RevokeEthereumBlacklist", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return interceptor(ctx, in, info, handler)
+	return out, nil
 }
 
-func _Msg_BlacklistEthereumAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgBlacklistEthereumAddresses)
+func (c *msgClient) CreateRateLimit(ctx context.Context, in *MsgCreateRateLimit, opts ...grpc.CallOption) (*MsgCreateRateLimitResponse, error) {
+	out := new(MsgCreateRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/CreateRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateRateLimit(ctx context.Context, in *MsgUpdateRateLimit, opts ...grpc.CallOption) (*MsgUpdateRateLimitResponse, error) {
+	out := new(MsgUpdateRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/UpdateRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RemoveRateLimit(ctx context.Context, in *MsgRemoveRateLimit, opts ...grpc.CallOption) (*MsgRemoveRateLimitResponse, error) {
+	out := new(MsgRemoveRateLimitResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/RemoveRateLimit", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ReleaseQueuedDeposits(ctx context.Context, in *MsgReleaseQueuedDeposits, opts ...grpc.CallOption) (*MsgReleaseQueuedDepositsResponse, error) {
+	out := new(MsgReleaseQueuedDepositsResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ReleaseQueuedDeposits", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) RequestLogicCall(ctx context.Context, in *MsgRequestLogicCall, opts ...grpc.CallOption) (*MsgRequestLogicCallResponse, error) {
+	out := new(MsgRequestLogicCallResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/RequestLogicCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ConfirmLogicCall(ctx context.Context, in *MsgConfirmLogicCall, opts ...grpc.CallOption) (*MsgConfirmLogicCallResponse, error) {
+	out := new(MsgConfirmLogicCallResponse)
+	err := c.cc.Invoke(ctx, "/injective.peggy.v1.Msg/ConfirmLogicCall", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	ValsetConfirm(context.Context, *MsgValsetConfirm) (*MsgValsetConfirmResponse, error)
+	SendToEth(context.Context, *MsgSendToEth) (*MsgSendToEthResponse, error)
+	RequestBatch(context.Context, *MsgRequestBatch) (*MsgRequestBatchResponse, error)
+	ConfirmBatch(context.Context, *MsgConfirmBatch) (*MsgConfirmBatchResponse, error)
+	DepositClaim(context.Context, *MsgDepositClaim) (*MsgDepositClaimResponse, error)
+	WithdrawClaim(context.Context, *MsgWithdrawClaim) (*MsgWithdrawClaimResponse, error)
+	ValsetUpdateClaim(context.Context, *MsgValsetUpdatedClaim) (*MsgValsetUpdatedClaimResponse, error)
+	ERC20DeployedClaim(context.Context, *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error)
+	SetOrchestratorAddresses(context.Context, *MsgSetOrchestratorAddresses) (*MsgSetOrchestratorAddressesResponse, error)
+	CancelSendToEth(context.Context, *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error)
+	BumpSendToEthFee(context.Context, *MsgBumpSendToEthFee) (*MsgBumpSendToEthFeeResponse, error)
+	SubmitBadSignatureEvidence(context.Context, *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error)
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// BlacklistEthereumAddresses adds Ethereum addresses to the peggy blacklist.
+	BlacklistEthereumAddresses(context.Context, *MsgBlacklistEthereumAddresses) (*MsgBlacklistEthereumAddressesResponse, error)
+	// RevokeEthereumBlacklist removes Ethereum addresses from the peggy
+	// blacklist.
+	RevokeEthereumBlacklist(context.Context, *MsgRevokeEthereumBlacklist) (*MsgRevokeEthereumBlacklistResponse, error)
+	//  CreateRateLimit imposes a (notional) limit on withdrawals for a particular
+	//  Peggy asset
+	CreateRateLimit(context.Context, *MsgCreateRateLimit) (*MsgCreateRateLimitResponse, error)
+	//  UpdateRateLimit updates the rate limit's metadata for a particular Peggy
+	//  asset
+	UpdateRateLimit(context.Context, *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error)
+	//  RemoveRateLimit lifts the rate limit for a particular Peggy asset
+	RemoveRateLimit(context.Context, *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error)
+	//  ReleaseQueuedDeposits releases deposits held back by inbound rate
+	//  limits regardless of the remaining limits
+	ReleaseQueuedDeposits(context.Context, *MsgReleaseQueuedDeposits) (*MsgReleaseQueuedDepositsResponse, error)
+	//  RequestLogicCall queues an arbitrary contract call to be executed on
+	//  Ethereum by the Peggy contract
+	RequestLogicCall(context.Context, *MsgRequestLogicCall) (*MsgRequestLogicCallResponse, error)
+	//  ConfirmLogicCall submits a validator signature over a pending logic call
+	ConfirmLogicCall(context.Context, *MsgConfirmLogicCall) (*MsgConfirmLogicCallResponse, error)
+}
+
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
+}
+
+func (*UnimplementedMsgServer) ValsetConfirm(ctx context.Context, req *MsgValsetConfirm) (*MsgValsetConfirmResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetConfirm not implemented")
+}
+func (*UnimplementedMsgServer) SendToEth(ctx context.Context, req *MsgSendToEth) (*MsgSendToEthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SendToEth not implemented")
+}
+func (*UnimplementedMsgServer) RequestBatch(ctx context.Context, req *MsgRequestBatch) (*MsgRequestBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestBatch not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmBatch(ctx context.Context, req *MsgConfirmBatch) (*MsgConfirmBatchResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmBatch not implemented")
+}
+func (*UnimplementedMsgServer) DepositClaim(ctx context.Context, req *MsgDepositClaim) (*MsgDepositClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DepositClaim not implemented")
+}
+func (*UnimplementedMsgServer) WithdrawClaim(ctx context.Context, req *MsgWithdrawClaim) (*MsgWithdrawClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method WithdrawClaim not implemented")
+}
+func (*UnimplementedMsgServer) ValsetUpdateClaim(ctx context.Context, req *MsgValsetUpdatedClaim) (*MsgValsetUpdatedClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ValsetUpdateClaim not implemented")
+}
+func (*UnimplementedMsgServer) ERC20DeployedClaim(ctx context.Context, req *MsgERC20DeployedClaim) (*MsgERC20DeployedClaimResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ERC20DeployedClaim not implemented")
+}
+func (*UnimplementedMsgServer) SetOrchestratorAddresses(ctx context.Context, req *MsgSetOrchestratorAddresses) (*MsgSetOrchestratorAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetOrchestratorAddresses not implemented")
+}
+func (*UnimplementedMsgServer) CancelSendToEth(ctx context.Context, req *MsgCancelSendToEth) (*MsgCancelSendToEthResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelSendToEth not implemented")
+}
+func (*UnimplementedMsgServer) BumpSendToEthFee(ctx context.Context, req *MsgBumpSendToEthFee) (*MsgBumpSendToEthFeeResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BumpSendToEthFee not implemented")
+}
+func (*UnimplementedMsgServer) SubmitBadSignatureEvidence(ctx context.Context, req *MsgSubmitBadSignatureEvidence) (*MsgSubmitBadSignatureEvidenceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SubmitBadSignatureEvidence not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
+func (*UnimplementedMsgServer) BlacklistEthereumAddresses(ctx context.Context, req *MsgBlacklistEthereumAddresses) (*MsgBlacklistEthereumAddressesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BlacklistEthereumAddresses not implemented")
+}
+func (*UnimplementedMsgServer) RevokeEthereumBlacklist(ctx context.Context, req *MsgRevokeEthereumBlacklist) (*MsgRevokeEthereumBlacklistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RevokeEthereumBlacklist not implemented")
+}
+func (*UnimplementedMsgServer) CreateRateLimit(ctx context.Context, req *MsgCreateRateLimit) (*MsgCreateRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRateLimit(ctx context.Context, req *MsgUpdateRateLimit) (*MsgUpdateRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) RemoveRateLimit(ctx context.Context, req *MsgRemoveRateLimit) (*MsgRemoveRateLimitResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveRateLimit not implemented")
+}
+func (*UnimplementedMsgServer) ReleaseQueuedDeposits(ctx context.Context, req *MsgReleaseQueuedDeposits) (*MsgReleaseQueuedDepositsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseQueuedDeposits not implemented")
+}
+func (*UnimplementedMsgServer) RequestLogicCall(ctx context.Context, req *MsgRequestLogicCall) (*MsgRequestLogicCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestLogicCall not implemented")
+}
+func (*UnimplementedMsgServer) ConfirmLogicCall(ctx context.Context, req *MsgConfirmLogicCall) (*MsgConfirmLogicCallResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ConfirmLogicCall not implemented")
+}
+
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
+}
+
+func _Msg_ValsetConfirm_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValsetConfirm)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).BlacklistEthereumAddresses(ctx, in)
+		return srv.(MsgServer).ValsetConfirm(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/BlacklistEthereumAddresses",
+		FullMethod: "/injective.peggy.v1.Msg/ValsetConfirm",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).BlacklistEthereumAddresses(ctx, req.(*MsgBlacklistEthereumAddresses))
+		return srv.(MsgServer).ValsetConfirm(ctx, req.(*MsgValsetConfirm))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RevokeEthereumBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRevokeEthereumBlacklist)
+func _Msg_SendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSendToEth)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RevokeEthereumBlacklist(ctx, in)
+		return srv.(MsgServer).SendToEth(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/RevokeEthereumBlacklist",
+		FullMethod: "/injective.peggy.v1.Msg/SendToEth",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RevokeEthereumBlacklist(ctx, req.(*MsgRevokeEthereumBlacklist))
+		return srv.(MsgServer).SendToEth(ctx, req.(*MsgSendToEth))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_CreateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateRateLimit)
+func _Msg_RequestBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestBatch)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).CreateRateLimit(ctx, in)
+		return srv.(MsgServer).RequestBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/CreateRateLimit",
+		FullMethod: "/injective.peggy.v1.Msg/RequestBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateRateLimit(ctx, req.(*MsgCreateRateLimit))
+		return srv.(MsgServer).RequestBatch(ctx, req.(*MsgRequestBatch))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateRateLimit)
+func _Msg_ConfirmBatch_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmBatch)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateRateLimit(ctx, in)
+		return srv.(MsgServer).ConfirmBatch(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/UpdateRateLimit",
+		FullMethod: "/injective.peggy.v1.Msg/ConfirmBatch",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateRateLimit(ctx, req.(*MsgUpdateRateLimit))
+		return srv.(MsgServer).ConfirmBatch(ctx, req.(*MsgConfirmBatch))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveRateLimit)
+func _Msg_DepositClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgDepositClaim)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+		return srv.(MsgServer).DepositClaim(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/injective.peggy.v1.Msg/RemoveRateLimit",
+		FullMethod: "/injective.peggy.v1.Msg/DepositClaim",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+		return srv.(MsgServer).DepositClaim(ctx, req.(*MsgDepositClaim))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "injective.peggy.v1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "ValsetConfirm",
-			Handler:    _Msg_ValsetConfirm_Handler,
-		},
-		{
-			MethodName: "SendToEth",
-			Handler:    _Msg_SendToEth_Handler,
-		},
-		{
-			MethodName: "RequestBatch",
-			Handler:    _Msg_RequestBatch_Handler,
-		},
-		{
-			MethodName: "ConfirmBatch",
-			Handler:    _Msg_ConfirmBatch_Handler,
-		},
-		{
-			MethodName: "DepositClaim",
-			Handler:    _Msg_DepositClaim_Handler,
-		},
-		{
-			MethodName: "WithdrawClaim",
-			Handler:    _Msg_WithdrawClaim_Handler,
-		},
-		{
-			MethodName: "ValsetUpdateClaim",
-			Handler:    _Msg_ValsetUpdateClaim_Handler,
-		},
-		{
-			MethodName: "ERC20DeployedClaim",
-			Handler:    _Msg_ERC20DeployedClaim_Handler,
-		},
-		{
-			MethodName: "SetOrchestratorAddresses",
-			Handler:    _Msg_SetOrchestratorAddresses_Handler,
-		},
-		{
-			MethodName: "CancelSendToEth",
-			Handler:    _Msg_CancelSendToEth_Handler,
-		},
-		{
-			MethodName: "SubmitBadSignatureEvidence",
-			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
-		},
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "BlacklistEthereumAddresses",
-			Handler:    _Msg_BlacklistEthereumAddresses_Handler,
-		},
-		{
-			MethodName: "RevokeEthereumBlacklist",
-			Handler:    _Msg_RevokeEthereumBlacklist_Handler,
-		},
-		{
-			MethodName: "CreateRateLimit",
-			Handler:    _Msg_CreateRateLimit_Handler,
-		},
-		{
-			MethodName: "UpdateRateLimit",
-			Handler:    _Msg_UpdateRateLimit_Handler,
-		},
-		{
-			MethodName: "RemoveRateLimit",
-			Handler:    _Msg_RemoveRateLimit_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "injective/peggy/v1/msgs.proto",
-}
-
-func (m *MsgSetOrchestratorAddresses) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_WithdrawClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgWithdrawClaim)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetOrchestratorAddresses) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetOrchestratorAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).WithdrawClaim(ctx, in)
 	}
-	if len(m.Orchestrator) > 0 {
-		i -= len(m.Orchestrator)
-		copy(dAtA[i:], m.Orchestrator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/WithdrawClaim",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).WithdrawClaim(ctx, req.(*MsgWithdrawClaim))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSetOrchestratorAddressesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ValsetUpdateClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgValsetUpdatedClaim)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSetOrchestratorAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSetOrchestratorAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).ValsetUpdateClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/ValsetUpdateClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ValsetUpdateClaim(ctx, req.(*MsgValsetUpdatedClaim))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_ERC20DeployedClaim_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgERC20DeployedClaim)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).ERC20DeployedClaim(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/ERC20DeployedClaim",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ERC20DeployedClaim(ctx, req.(*MsgERC20DeployedClaim))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
-		i--
-		dAtA[i] = 0x22
+func _Msg_SetOrchestratorAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetOrchestratorAddresses)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.EthAddress) > 0 {
-		i -= len(m.EthAddress)
-		copy(dAtA[i:], m.EthAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
-		i--
-		dAtA[i] = 0x1a
+	if interceptor == nil {
+		return srv.(MsgServer).SetOrchestratorAddresses(ctx, in)
 	}
-	if len(m.Orchestrator) > 0 {
-		i -= len(m.Orchestrator)
-		copy(dAtA[i:], m.Orchestrator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/SetOrchestratorAddresses",
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
-		i--
-		dAtA[i] = 0x8
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetOrchestratorAddresses(ctx, req.(*MsgSetOrchestratorAddresses))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CancelSendToEth_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCancelSendToEth)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).CancelSendToEth(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/CancelSendToEth",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CancelSendToEth(ctx, req.(*MsgCancelSendToEth))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_BumpSendToEthFee_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBumpSendToEthFee)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).BumpSendToEthFee(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/BumpSendToEthFee",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BumpSendToEthFee(ctx, req.(*MsgBumpSendToEthFee))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_SubmitBadSignatureEvidence_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSubmitBadSignatureEvidence)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSendToEth) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/SubmitBadSignatureEvidence",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SubmitBadSignatureEvidence(ctx, req.(*MsgSubmitBadSignatureEvidence))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	i--
-	dAtA[i] = 0x22
-	{
-		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	i--
-	dAtA[i] = 0x1a
-	if len(m.EthDest) > 0 {
-		i -= len(m.EthDest)
-		copy(dAtA[i:], m.EthDest)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthDest)))
-		i--
-		dAtA[i] = 0x12
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/UpdateParams",
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
 	}
-	return len(dAtA) - i, nil
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_BlacklistEthereumAddresses_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgBlacklistEthereumAddresses)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+	if interceptor == nil {
+		return srv.(MsgServer).BlacklistEthereumAddresses(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/BlacklistEthereumAddresses",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).BlacklistEthereumAddresses(ctx, req.(*MsgBlacklistEthereumAddresses))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RevokeEthereumBlacklist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRevokeEthereumBlacklist)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgRequestBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).RevokeEthereumBlacklist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/RevokeEthereumBlacklist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RevokeEthereumBlacklist(ctx, req.(*MsgRevokeEthereumBlacklist))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_CreateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Orchestrator) > 0 {
-		i -= len(m.Orchestrator)
-		copy(dAtA[i:], m.Orchestrator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).CreateRateLimit(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/CreateRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateRateLimit(ctx, req.(*MsgCreateRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestBatchResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_UpdateRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRateLimit)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/UpdateRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRateLimit(ctx, req.(*MsgUpdateRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestBatchResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_RemoveRateLimit_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveRateLimit)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).RemoveRateLimit(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/RemoveRateLimit",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveRateLimit(ctx, req.(*MsgRemoveRateLimit))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgRequestBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_ReleaseQueuedDeposits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReleaseQueuedDeposits)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReleaseQueuedDeposits(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/ReleaseQueuedDeposits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReleaseQueuedDeposits(ctx, req.(*MsgReleaseQueuedDeposits))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmBatch) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_RequestLogicCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRequestLogicCall)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	if interceptor == nil {
+		return srv.(MsgServer).RequestLogicCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/RequestLogicCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RequestLogicCall(ctx, req.(*MsgRequestLogicCall))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmBatch) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func _Msg_ConfirmLogicCall_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgConfirmLogicCall)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ConfirmLogicCall(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.peggy.v1.Msg/ConfirmLogicCall",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ConfirmLogicCall(ctx, req.(*MsgConfirmLogicCall))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgConfirmBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.peggy.v1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "ValsetConfirm",
+			Handler:    _Msg_ValsetConfirm_Handler,
+		},
+		{
+			MethodName: "SendToEth",
+			Handler:    _Msg_SendToEth_Handler,
+		},
+		{
+			MethodName: "RequestBatch",
+			Handler:    _Msg_RequestBatch_Handler,
+		},
+		{
+			MethodName: "ConfirmBatch",
+			Handler:    _Msg_ConfirmBatch_Handler,
+		},
+		{
+			MethodName: "DepositClaim",
+			Handler:    _Msg_DepositClaim_Handler,
+		},
+		{
+			MethodName: "WithdrawClaim",
+			Handler:    _Msg_WithdrawClaim_Handler,
+		},
+		{
+			MethodName: "ValsetUpdateClaim",
+			Handler:    _Msg_ValsetUpdateClaim_Handler,
+		},
+		{
+			MethodName: "ERC20DeployedClaim",
+			Handler:    _Msg_ERC20DeployedClaim_Handler,
+		},
+		{
+			MethodName: "SetOrchestratorAddresses",
+			Handler:    _Msg_SetOrchestratorAddresses_Handler,
+		},
+		{
+			MethodName: "CancelSendToEth",
+			Handler:    _Msg_CancelSendToEth_Handler,
+		},
+		{
+			MethodName: "BumpSendToEthFee",
+			Handler:    _Msg_BumpSendToEthFee_Handler,
+		},
+		{
+			MethodName: "SubmitBadSignatureEvidence",
+			Handler:    _Msg_SubmitBadSignatureEvidence_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "BlacklistEthereumAddresses",
+			Handler:    _Msg_BlacklistEthereumAddresses_Handler,
+		},
+		{
+			MethodName: "RevokeEthereumBlacklist",
+			Handler:    _Msg_RevokeEthereumBlacklist_Handler,
+		},
+		{
+			MethodName: "CreateRateLimit",
+			Handler:    _Msg_CreateRateLimit_Handler,
+		},
+		{
+			MethodName: "UpdateRateLimit",
+			Handler:    _Msg_UpdateRateLimit_Handler,
+		},
+		{
+			MethodName: "RemoveRateLimit",
+			Handler:    _Msg_RemoveRateLimit_Handler,
+		},
+		{
+			MethodName: "ReleaseQueuedDeposits",
+			Handler:    _Msg_ReleaseQueuedDeposits_Handler,
+		},
+		{
+			MethodName: "RequestLogicCall",
+			Handler:    _Msg_RequestLogicCall_Handler,
+		},
+		{
+			MethodName: "ConfirmLogicCall",
+			Handler:    _Msg_ConfirmLogicCall_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/peggy/v1/msgs.proto",
+}
+
+func (m *MsgSetOrchestratorAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetOrchestratorAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetOrchestratorAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
 		i--
-		dAtA[i] = 0x2a
+		dAtA[i] = 0x1a
 	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
 		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.EthSigner) > 0 {
-		i -= len(m.EthSigner)
-		copy(dAtA[i:], m.EthSigner)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSigner)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
 		dAtA[i] = 0x12
 	}
-	if m.Nonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgConfirmBatchResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSetOrchestratorAddressesResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3099,12 +3507,12 @@ func (m *MsgConfirmBatchResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgConfirmBatchResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSetOrchestratorAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgConfirmBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSetOrchestratorAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3112,7 +3520,7 @@ func (m *MsgConfirmBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositClaim) Marshal() (dAtA []byte, err error) {
+func (m *MsgValsetConfirm) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3122,75 +3530,46 @@ func (m *MsgDepositClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirm) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirm) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Data) > 0 {
-		i -= len(m.Data)
-		copy(dAtA[i:], m.Data)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Data)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x42
+		dAtA[i] = 0x22
+	}
+	if len(m.EthAddress) > 0 {
+		i -= len(m.EthAddress)
+		copy(dAtA[i:], m.EthAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthAddress)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
 		i--
-		dAtA[i] = 0x3a
-	}
-	if len(m.CosmosReceiver) > 0 {
-		i -= len(m.CosmosReceiver)
-		copy(dAtA[i:], m.CosmosReceiver)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.EthereumSender) > 0 {
-		i -= len(m.EthereumSender)
-		copy(dAtA[i:], m.EthereumSender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
 		i--
 		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositClaimResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgValsetConfirmResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3200,12 +3579,12 @@ func (m *MsgDepositClaimResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgDepositClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirmResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValsetConfirmResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3213,7 +3592,7 @@ func (m *MsgDepositClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawClaim) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToEth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3223,49 +3602,54 @@ func (m *MsgWithdrawClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEth) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Orchestrator) > 0 {
-		i -= len(m.Orchestrator)
-		copy(dAtA[i:], m.Orchestrator)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
-		i--
-		dAtA[i] = 0x2a
+	{
+		size, err := m.BridgeFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x22
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	if m.BatchNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+	i--
+	dAtA[i] = 0x1a
+	if len(m.EthDest) > 0 {
+		i -= len(m.EthDest)
+		copy(dAtA[i:], m.EthDest)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthDest)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x12
 	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgWithdrawClaimResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgSendToEthResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3275,12 +3659,12 @@ func (m *MsgWithdrawClaimResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3288,7 +3672,7 @@ func (m *MsgWithdrawClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgERC20DeployedClaim) Marshal() (dAtA []byte, err error) {
+func (m *MsgRequestBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3298,70 +3682,34 @@ func (m *MsgERC20DeployedClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgERC20DeployedClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRequestBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgERC20DeployedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRequestBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
 		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
 		i--
-		dAtA[i] = 0x42
-	}
-	if m.Decimals != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.Decimals))
-		i--
-		dAtA[i] = 0x38
-	}
-	if len(m.Symbol) > 0 {
-		i -= len(m.Symbol)
-		copy(dAtA[i:], m.Symbol)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Symbol)))
-		i--
-		dAtA[i] = 0x32
-	}
-	if len(m.Name) > 0 {
-		i -= len(m.Name)
-		copy(dAtA[i:], m.Name)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
-		i--
-		dAtA[i] = 0x2a
-	}
-	if len(m.TokenContract) > 0 {
-		i -= len(m.TokenContract)
-		copy(dAtA[i:], m.TokenContract)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if len(m.CosmosDenom) > 0 {
-		i -= len(m.CosmosDenom)
-		copy(dAtA[i:], m.CosmosDenom)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosDenom)))
-		i--
-		dAtA[i] = 0x1a
-	}
-	if m.BlockHeight != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
-		i--
-		dAtA[i] = 0x10
-	}
-	if m.EventNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
-		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgERC20DeployedClaimResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRequestBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3371,12 +3719,12 @@ func (m *MsgERC20DeployedClaimResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgERC20DeployedClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRequestBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgERC20DeployedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRequestBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3384,7 +3732,7 @@ func (m *MsgERC20DeployedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSendToEth) Marshal() (dAtA []byte, err error) {
+func (m *MsgConfirmBatch) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3394,104 +3742,53 @@ func (m *MsgCancelSendToEth) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCancelSendToEth) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConfirmBatch) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCancelSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConfirmBatch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x2a
 	}
-	if m.TransactionId != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.TransactionId))
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
 		i--
-		dAtA[i] = 0x8
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgCancelSendToEthResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgCancelSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgCancelSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+		dAtA[i] = 0x22
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgSubmitBadSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *MsgSubmitBadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+	if len(m.EthSigner) > 0 {
+		i -= len(m.EthSigner)
+		copy(dAtA[i:], m.EthSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSigner)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.Signature) > 0 {
-		i -= len(m.Signature)
-		copy(dAtA[i:], m.Signature)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if m.Subject != nil {
-		{
-			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintMsgs(dAtA, i, uint64(size))
-		}
+	if m.Nonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Nonce))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgSubmitBadSignatureEvidenceResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgConfirmBatchResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3501,12 +3798,12 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgConfirmBatchResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgConfirmBatchResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3514,7 +3811,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetUpdatedClaim) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3524,16 +3821,23 @@ func (m *MsgValsetUpdatedClaim) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetUpdatedClaim) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositClaim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetUpdatedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0x42
+	}
 	if len(m.Orchestrator) > 0 {
 		i -= len(m.Orchestrator)
 		copy(dAtA[i:], m.Orchestrator)
@@ -3541,45 +3845,40 @@ func (m *MsgValsetUpdatedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x3a
 	}
-	if len(m.RewardToken) > 0 {
-		i -= len(m.RewardToken)
-		copy(dAtA[i:], m.RewardToken)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.RewardToken)))
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosReceiver)))
 		i--
 		dAtA[i] = 0x32
 	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x2a
+	}
 	{
-		size := m.RewardAmount.Size()
+		size := m.Amount.Size()
 		i -= size
-		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
 			return 0, err
 		}
 		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.Members) > 0 {
-		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintMsgs(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
+	dAtA[i] = 0x22
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x1a
 	}
 	if m.BlockHeight != 0 {
 		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
 		i--
-		dAtA[i] = 0x18
-	}
-	if m.ValsetNonce != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.ValsetNonce))
-		i--
 		dAtA[i] = 0x10
 	}
 	if m.EventNonce != 0 {
@@ -3590,7 +3889,7 @@ func (m *MsgValsetUpdatedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetUpdatedClaimResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgDepositClaimResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3600,12 +3899,12 @@ func (m *MsgValsetUpdatedClaimResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgValsetUpdatedClaimResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgDepositClaimResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetUpdatedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgDepositClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3613,7 +3912,7 @@ func (m *MsgValsetUpdatedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3623,37 +3922,49 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawClaim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x22
+	}
+	if m.BatchNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BatchNonce))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgWithdrawClaimResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3663,12 +3974,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgWithdrawClaimResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgWithdrawClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3676,7 +3987,7 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBlacklistEthereumAddresses) Marshal() (dAtA []byte, err error) {
+func (m *MsgERC20DeployedClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3686,36 +3997,70 @@ func (m *MsgBlacklistEthereumAddresses) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgBlacklistEthereumAddresses) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgERC20DeployedClaim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBlacklistEthereumAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgERC20DeployedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlacklistAddresses) > 0 {
-		for iNdEx := len(m.BlacklistAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BlacklistAddresses[iNdEx])
-			copy(dAtA[i:], m.BlacklistAddresses[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.BlacklistAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x42
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+	if m.Decimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Decimals))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x38
+	}
+	if len(m.Symbol) > 0 {
+		i -= len(m.Symbol)
+		copy(dAtA[i:], m.Symbol)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Symbol)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.CosmosDenom) > 0 {
+		i -= len(m.CosmosDenom)
+		copy(dAtA[i:], m.CosmosDenom)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.CosmosDenom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgBlacklistEthereumAddressesResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgERC20DeployedClaimResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3725,12 +4070,12 @@ func (m *MsgBlacklistEthereumAddressesResponse) Marshal() (dAtA []byte, err erro
 	return dAtA[:n], nil
 }
 
-func (m *MsgBlacklistEthereumAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgERC20DeployedClaimResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBlacklistEthereumAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgERC20DeployedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3738,7 +4083,7 @@ func (m *MsgBlacklistEthereumAddressesResponse) MarshalToSizedBuffer(dAtA []byte
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeEthereumBlacklist) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSendToEth) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3748,36 +4093,32 @@ func (m *MsgRevokeEthereumBlacklist) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeEthereumBlacklist) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEth) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeEthereumBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEth) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.BlacklistAddresses) > 0 {
-		for iNdEx := len(m.BlacklistAddresses) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.BlacklistAddresses[iNdEx])
-			copy(dAtA[i:], m.BlacklistAddresses[iNdEx])
-			i = encodeVarintMsgs(dAtA, i, uint64(len(m.BlacklistAddresses[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
 	}
-	if len(m.Signer) > 0 {
-		i -= len(m.Signer)
-		copy(dAtA[i:], m.Signer)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+	if m.TransactionId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TransactionId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeEthereumBlacklistResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgCancelSendToEthResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3787,12 +4128,12 @@ func (m *MsgRevokeEthereumBlacklistResponse) Marshal() (dAtA []byte, err error)
 	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeEthereumBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRevokeEthereumBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgCancelSendToEthResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3800,7 +4141,7 @@ func (m *MsgRevokeEthereumBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *MsgBumpSendToEthFee) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3810,71 +4151,42 @@ func (m *MsgCreateRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBumpSendToEthFee) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBumpSendToEthFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.RateLimitWindow != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.RateLimitWindow))
-		i--
-		dAtA[i] = 0x38
-	}
 	{
-		size := m.AbsoluteMintLimit.Size()
-		i -= size
-		if _, err := m.AbsoluteMintLimit.MarshalTo(dAtA[i:]); err != nil {
+		size, err := m.FeeIncrease.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
 			return 0, err
 		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x32
-	{
-		size := m.RateLimitUsd.Size()
 		i -= size
-		if _, err := m.RateLimitUsd.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
 		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
 	i--
-	dAtA[i] = 0x2a
-	if len(m.TokenPriceId) > 0 {
-		i -= len(m.TokenPriceId)
-		copy(dAtA[i:], m.TokenPriceId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenPriceId)))
-		i--
-		dAtA[i] = 0x22
-	}
-	if m.TokenDecimals != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.TokenDecimals))
-		i--
-		dAtA[i] = 0x18
-	}
-	if len(m.TokenAddress) > 0 {
-		i -= len(m.TokenAddress)
-		copy(dAtA[i:], m.TokenAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenAddress)))
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+	if m.TransactionId != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TransactionId))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgBumpSendToEthFeeResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3884,12 +4196,12 @@ func (m *MsgCreateRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgCreateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgBumpSendToEthFeeResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgCreateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgBumpSendToEthFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3897,7 +4209,7 @@ func (m *MsgCreateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *MsgSubmitBadSignatureEvidence) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3907,57 +4219,47 @@ func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidence) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.NewRateLimitWindow != 0 {
-		i = encodeVarintMsgs(dAtA, i, uint64(m.NewRateLimitWindow))
-		i--
-		dAtA[i] = 0x28
-	}
-	{
-		size := m.NewRateLimitUsd.Size()
-		i -= size
-		if _, err := m.NewRateLimitUsd.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintMsgs(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x22
-	if len(m.NewTokenPriceId) > 0 {
-		i -= len(m.NewTokenPriceId)
-		copy(dAtA[i:], m.NewTokenPriceId)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewTokenPriceId)))
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0x1a
 	}
-	if len(m.TokenAddress) > 0 {
-		i -= len(m.TokenAddress)
-		copy(dAtA[i:], m.TokenAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenAddress)))
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
-}
-
-func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
+	if m.Subject != nil {
+		{
+			size, err := m.Subject.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
 	if err != nil {
@@ -3966,12 +4268,12 @@ func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgSubmitBadSignatureEvidenceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -3979,7 +4281,7 @@ func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+func (m *MsgValsetUpdatedClaim) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -3989,34 +4291,73 @@ func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValsetUpdatedClaim) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValsetUpdatedClaim) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.TokenAddress) > 0 {
-		i -= len(m.TokenAddress)
-		copy(dAtA[i:], m.TokenAddress)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenAddress)))
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
 		i--
-		dAtA[i] = 0x12
+		dAtA[i] = 0x3a
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+	if len(m.RewardToken) > 0 {
+		i -= len(m.RewardToken)
+		copy(dAtA[i:], m.RewardToken)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.RewardToken)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x32
+	}
+	{
+		size := m.RewardAmount.Size()
+		i -= size
+		if _, err := m.RewardAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if len(m.Members) > 0 {
+		for iNdEx := len(m.Members) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Members[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintMsgs(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.ValsetNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.ValsetNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x8
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgValsetUpdatedClaimResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -4026,12 +4367,12 @@ func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgValsetUpdatedClaimResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgValsetUpdatedClaimResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -4039,587 +4380,2544 @@ func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, err
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsgs(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgSetOrchestratorAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSetOrchestratorAddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgValsetConfirm) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgValsetConfirmResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendToEth) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBlacklistEthereumAddresses) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgBlacklistEthereumAddresses) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgBlacklistEthereumAddresses) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.BlacklistAddresses) > 0 {
+		for iNdEx := len(m.BlacklistAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlacklistAddresses[iNdEx])
+			copy(dAtA[i:], m.BlacklistAddresses[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.BlacklistAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.EthDest)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.BridgeFee.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgSendToEthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgBlacklistEthereumAddressesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRequestBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgBlacklistEthereumAddressesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRequestBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgBlacklistEthereumAddressesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgConfirmBatch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Nonce != 0 {
-		n += 1 + sovMsgs(uint64(m.Nonce))
-	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.EthSigner)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgRevokeEthereumBlacklist) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgConfirmBatchResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgRevokeEthereumBlacklist) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgDepositClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRevokeEthereumBlacklist) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.BlockHeight))
-	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = m.Amount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.EthereumSender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.CosmosReceiver)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.BlacklistAddresses) > 0 {
+		for iNdEx := len(m.BlacklistAddresses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.BlacklistAddresses[iNdEx])
+			copy(dAtA[i:], m.BlacklistAddresses[iNdEx])
+			i = encodeVarintMsgs(dAtA, i, uint64(len(m.BlacklistAddresses[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
 	}
-	l = len(m.Data)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
 	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgDepositClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRevokeEthereumBlacklistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgWithdrawClaim) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.BlockHeight))
-	}
-	if m.BatchNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.BatchNonce))
-	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgRevokeEthereumBlacklistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgWithdrawClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgRevokeEthereumBlacklistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgERC20DeployedClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
-	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x68
 	}
-	l = len(m.CosmosDenom)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.MissingPriceBehaviour != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.MissingPriceBehaviour))
+		i--
+		dAtA[i] = 0x60
 	}
-	l = len(m.TokenContract)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.FallbackPriceSource != nil {
+		{
+			size, err := m.FallbackPriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
 	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.PriceSource != nil {
+		{
+			size, err := m.PriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
 	}
-	l = len(m.Symbol)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	{
+		size := m.PerRecipientDepositLimitUsd.Size()
+		i -= size
+		if _, err := m.PerRecipientDepositLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	if m.Decimals != 0 {
-		n += 1 + sovMsgs(uint64(m.Decimals))
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DepositLimitUsd.Size()
+		i -= size
+		if _, err := m.DepositLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	i--
+	dAtA[i] = 0x42
+	if m.RateLimitWindow != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.RateLimitWindow))
+		i--
+		dAtA[i] = 0x38
 	}
-	return n
-}
-
-func (m *MsgERC20DeployedClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	{
+		size := m.AbsoluteMintLimit.Size()
+		i -= size
+		if _, err := m.AbsoluteMintLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgCancelSendToEth) Size() (n int) {
-	if m == nil {
-		return 0
+	i--
+	dAtA[i] = 0x32
+	{
+		size := m.RateLimitUsd.Size()
+		i -= size
+		if _, err := m.RateLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	var l int
-	_ = l
-	if m.TransactionId != 0 {
-		n += 1 + sovMsgs(uint64(m.TransactionId))
+	i--
+	dAtA[i] = 0x2a
+	if len(m.TokenPriceId) > 0 {
+		i -= len(m.TokenPriceId)
+		copy(dAtA[i:], m.TokenPriceId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenPriceId)))
+		i--
+		dAtA[i] = 0x22
 	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.TokenDecimals != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.TokenDecimals))
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCancelSendToEthResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgCreateRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.Subject != nil {
-		l = m.Subject.Size()
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Signature)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	return n
+func (m *MsgCreateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgCreateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgValsetUpdatedClaim) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	if m.EventNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.EventNonce))
+	if m.NewPriceConfig != nil {
+		{
+			size, err := m.NewPriceConfig.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintMsgs(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
 	}
-	if m.ValsetNonce != 0 {
-		n += 1 + sovMsgs(uint64(m.ValsetNonce))
+	{
+		size := m.NewPerRecipientDepositLimitUsd.Size()
+		i -= size
+		if _, err := m.NewPerRecipientDepositLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	if m.BlockHeight != 0 {
-		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	i--
+	dAtA[i] = 0x3a
+	{
+		size := m.NewDepositLimitUsd.Size()
+		i -= size
+		if _, err := m.NewDepositLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	if len(m.Members) > 0 {
-		for _, e := range m.Members {
-			l = e.Size()
-			n += 1 + l + sovMsgs(uint64(l))
+	i--
+	dAtA[i] = 0x32
+	if m.NewRateLimitWindow != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.NewRateLimitWindow))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size := m.NewRateLimitUsd.Size()
+		i -= size
+		if _, err := m.NewRateLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
 		}
+		i = encodeVarintMsgs(dAtA, i, uint64(size))
 	}
-	l = m.RewardAmount.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = len(m.RewardToken)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	i--
+	dAtA[i] = 0x22
+	if len(m.NewTokenPriceId) > 0 {
+		i -= len(m.NewTokenPriceId)
+		copy(dAtA[i:], m.NewTokenPriceId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.NewTokenPriceId)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = len(m.Orchestrator)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
-}
-
-func (m *MsgValsetUpdatedClaimResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
 	}
-	var l int
-	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgUpdateRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
+func (m *MsgUpdateRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgBlacklistEthereumAddresses) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	if len(m.BlacklistAddresses) > 0 {
-		for _, s := range m.BlacklistAddresses {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgBlacklistEthereumAddressesResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRateLimit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRevokeEthereumBlacklist) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRemoveRateLimit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.TokenAddress) > 0 {
+		i -= len(m.TokenAddress)
+		copy(dAtA[i:], m.TokenAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.TokenAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgRemoveRateLimitResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRemoveRateLimitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Signer)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReleaseQueuedDeposits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if len(m.BlacklistAddresses) > 0 {
-		for _, s := range m.BlacklistAddresses {
-			l = len(s)
-			n += 1 + l + sovMsgs(uint64(l))
-		}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQueuedDeposits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQueuedDeposits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.DepositIds) > 0 {
+		dAtA10 := make([]byte, len(m.DepositIds)*10)
+		var j9 int
+		for _, num := range m.DepositIds {
+			for num >= 1<<7 {
+				dAtA10[j9] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j9++
+			}
+			dAtA10[j9] = uint8(num)
+			j9++
+		}
+		i -= j9
+		copy(dAtA[i:], dAtA10[:j9])
+		i = encodeVarintMsgs(dAtA, i, uint64(j9))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRevokeEthereumBlacklistResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgReleaseQueuedDepositsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReleaseQueuedDepositsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReleaseQueuedDepositsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestLogicCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestLogicCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x30
 	}
-	l = len(m.TokenAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0x2a
 	}
-	if m.TokenDecimals != 0 {
-		n += 1 + sovMsgs(uint64(m.TokenDecimals))
+	if m.Timeout != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.Timeout))
+		i--
+		dAtA[i] = 0x20
 	}
-	l = len(m.TokenPriceId)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+	if len(m.Payload) > 0 {
+		i -= len(m.Payload)
+		copy(dAtA[i:], m.Payload)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Payload)))
+		i--
+		dAtA[i] = 0x1a
 	}
-	l = m.RateLimitUsd.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	l = m.AbsoluteMintLimit.Size()
-	n += 1 + l + sovMsgs(uint64(l))
-	if m.RateLimitWindow != 0 {
-		n += 1 + sovMsgs(uint64(m.RateLimitWindow))
+	if len(m.LogicContractAddress) > 0 {
+		i -= len(m.LogicContractAddress)
+		copy(dAtA[i:], m.LogicContractAddress)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.LogicContractAddress)))
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgCreateRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgRequestLogicCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgRequestLogicCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgRequestLogicCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateRateLimit) Size() (n int) {
+func (m *MsgConfirmLogicCall) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmLogicCall) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmLogicCall) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Orchestrator) > 0 {
+		i -= len(m.Orchestrator)
+		copy(dAtA[i:], m.Orchestrator)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.Orchestrator)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.EthSigner) > 0 {
+		i -= len(m.EthSigner)
+		copy(dAtA[i:], m.EthSigner)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.EthSigner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.InvalidationNonce != 0 {
+		i = encodeVarintMsgs(dAtA, i, uint64(m.InvalidationNonce))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.InvalidationId) > 0 {
+		i -= len(m.InvalidationId)
+		copy(dAtA[i:], m.InvalidationId)
+		i = encodeVarintMsgs(dAtA, i, uint64(len(m.InvalidationId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgConfirmLogicCallResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgConfirmLogicCallResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgConfirmLogicCallResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsgs(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsgs(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgSetOrchestratorAddresses) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Sender)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.TokenAddress)
+	l = len(m.Orchestrator)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = len(m.NewTokenPriceId)
+	l = len(m.EthAddress)
 	if l > 0 {
 		n += 1 + l + sovMsgs(uint64(l))
 	}
-	l = m.NewRateLimitUsd.Size()
+	return n
+}
+
+func (m *MsgSetOrchestratorAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgValsetConfirm) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValsetConfirmResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSendToEth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthDest)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
 	n += 1 + l + sovMsgs(uint64(l))
-	if m.NewRateLimitWindow != 0 {
-		n += 1 + sovMsgs(uint64(m.NewRateLimitWindow))
+	l = m.BridgeFee.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgSendToEthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRequestBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmBatch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Nonce != 0 {
+		n += 1 + sovMsgs(uint64(m.Nonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.EthSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmBatchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgDepositClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgDepositClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgWithdrawClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	if m.BatchNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.BatchNonce))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgWithdrawClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgERC20DeployedClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	l = len(m.CosmosDenom)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Symbol)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovMsgs(uint64(m.Decimals))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgERC20DeployedClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCancelSendToEth) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionId != 0 {
+		n += 1 + sovMsgs(uint64(m.TransactionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgCancelSendToEthResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBumpSendToEthFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.TransactionId != 0 {
+		n += 1 + sovMsgs(uint64(m.TransactionId))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.FeeIncrease.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgBumpSendToEthFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSubmitBadSignatureEvidence) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Subject != nil {
+		l = m.Subject.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSubmitBadSignatureEvidenceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgValsetUpdatedClaim) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.EventNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.EventNonce))
+	}
+	if m.ValsetNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.ValsetNonce))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovMsgs(uint64(m.BlockHeight))
+	}
+	if len(m.Members) > 0 {
+		for _, e := range m.Members {
+			l = e.Size()
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	l = m.RewardAmount.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = len(m.RewardToken)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgValsetUpdatedClaimResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgBlacklistEthereumAddresses) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.BlacklistAddresses) > 0 {
+		for _, s := range m.BlacklistAddresses {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgBlacklistEthereumAddressesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRevokeEthereumBlacklist) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.BlacklistAddresses) > 0 {
+		for _, s := range m.BlacklistAddresses {
+			l = len(s)
+			n += 1 + l + sovMsgs(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgRevokeEthereumBlacklistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.TokenDecimals != 0 {
+		n += 1 + sovMsgs(uint64(m.TokenDecimals))
+	}
+	l = len(m.TokenPriceId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.RateLimitUsd.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.AbsoluteMintLimit.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.RateLimitWindow != 0 {
+		n += 1 + sovMsgs(uint64(m.RateLimitWindow))
+	}
+	l = m.DepositLimitUsd.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.PerRecipientDepositLimitUsd.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.PriceSource != nil {
+		l = m.PriceSource.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.FallbackPriceSource != nil {
+		l = m.FallbackPriceSource.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.MissingPriceBehaviour != 0 {
+		n += 1 + sovMsgs(uint64(m.MissingPriceBehaviour))
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovMsgs(uint64(m.MaxPriceAge))
+	}
+	return n
+}
+
+func (m *MsgCreateRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.NewTokenPriceId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = m.NewRateLimitUsd.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.NewRateLimitWindow != 0 {
+		n += 1 + sovMsgs(uint64(m.NewRateLimitWindow))
+	}
+	l = m.NewDepositLimitUsd.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	l = m.NewPerRecipientDepositLimitUsd.Size()
+	n += 1 + l + sovMsgs(uint64(l))
+	if m.NewPriceConfig != nil {
+		l = m.NewPriceConfig.Size()
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveRateLimit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.TokenAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveRateLimitResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReleaseQueuedDeposits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if len(m.DepositIds) > 0 {
+		l = 0
+		for _, e := range m.DepositIds {
+			l += sovMsgs(uint64(e))
+		}
+		n += 1 + sovMsgs(uint64(l)) + l
+	}
+	return n
+}
+
+func (m *MsgReleaseQueuedDepositsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRequestLogicCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.LogicContractAddress)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Payload)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.Timeout != 0 {
+		n += 1 + sovMsgs(uint64(m.Timeout))
+	}
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	return n
+}
+
+func (m *MsgRequestLogicCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgConfirmLogicCall) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.InvalidationId)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	if m.InvalidationNonce != 0 {
+		n += 1 + sovMsgs(uint64(m.InvalidationNonce))
+	}
+	l = len(m.EthSigner)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Orchestrator)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovMsgs(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgConfirmLogicCallResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovMsgs(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMsgs(x uint64) (n int) {
+	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddresses: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetOrchestratorAddressesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetOrchestratorAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgValsetConfirmResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEth: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthDest", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthDest = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSendToEthResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRequestBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRequestBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmBatch: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+			}
+			m.Nonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Nonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgUpdateRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgRemoveRateLimit) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
-	}
-	l = len(m.TokenAddress)
-	if l > 0 {
-		n += 1 + l + sovMsgs(uint64(l))
+func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgConfirmBatchResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgConfirmBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *MsgRemoveRateLimitResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovMsgs(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozMsgs(x uint64) (n int) {
-	return sovMsgs(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4642,15 +6940,151 @@ func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddresses: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4678,9 +7112,9 @@ func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
@@ -4712,9 +7146,9 @@ func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 			}
 			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4742,7 +7176,7 @@ func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.Data = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4765,7 +7199,7 @@ func (m *MsgSetOrchestratorAddresses) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSetOrchestratorAddressesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4788,10 +7222,10 @@ func (m *MsgSetOrchestratorAddressesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgDepositClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSetOrchestratorAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgDepositClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -4815,7 +7249,7 @@ func (m *MsgSetOrchestratorAddressesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -4838,17 +7272,17 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetConfirm: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetConfirm: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.Nonce = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4858,16 +7292,16 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			var stringLen uint64
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -4877,27 +7311,33 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.BatchNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BatchNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 3:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4925,11 +7365,11 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthAddress = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -4957,7 +7397,7 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -4980,7 +7420,7 @@ func (m *MsgValsetConfirm) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5003,10 +7443,10 @@ func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetConfirmResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgWithdrawClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetConfirmResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgWithdrawClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5030,7 +7470,7 @@ func (m *MsgValsetConfirmResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
+func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5053,15 +7493,53 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgERC20DeployedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+			}
+			m.EventNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.EventNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5089,11 +7567,11 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthDest", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5121,13 +7599,13 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EthDest = string(dAtA[iNdEx:postIndex])
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5137,30 +7615,29 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Name = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BridgeFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5170,24 +7647,74 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.BridgeFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.Symbol = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
 			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5210,7 +7737,7 @@ func (m *MsgSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5233,10 +7760,10 @@ func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSendToEthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5260,7 +7787,7 @@ func (m *MsgSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5283,17 +7810,17 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSendToEth: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
 			}
-			var stringLen uint64
+			m.TransactionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5303,27 +7830,14 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				m.TransactionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5351,7 +7865,7 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5374,7 +7888,7 @@ func (m *MsgRequestBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5397,10 +7911,10 @@ func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRequestBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCancelSendToEthResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRequestBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCancelSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5424,7 +7938,7 @@ func (m *MsgRequestBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
+func (m *MsgBumpSendToEthFee) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5447,17 +7961,17 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmBatch: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBumpSendToEthFee: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmBatch: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBumpSendToEthFee: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Nonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
 			}
-			m.Nonce = 0
+			m.TransactionId = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5467,14 +7981,14 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Nonce |= uint64(b&0x7F) << shift
+				m.TransactionId |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5502,45 +8016,13 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthSigner = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeIncrease", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5550,55 +8032,24 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if err := m.FeeIncrease.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5621,7 +8072,7 @@ func (m *MsgConfirmBatch) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBumpSendToEthFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5644,10 +8095,10 @@ func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgConfirmBatchResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBumpSendToEthFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgConfirmBatchResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBumpSendToEthFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -5671,7 +8122,7 @@ func (m *MsgConfirmBatchResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5694,55 +8145,17 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
-			}
-			m.EventNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -5752,125 +8165,31 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			if m.Subject == nil {
+				m.Subject = &types1.Any{}
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.EthereumSender = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 7:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5898,11 +8217,11 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -5930,7 +8249,7 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Data = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -5953,7 +8272,7 @@ func (m *MsgDepositClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5976,10 +8295,10 @@ func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgDepositClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgDepositClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6003,7 +8322,7 @@ func (m *MsgDepositClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6026,10 +8345,10 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6053,9 +8372,9 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 			}
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
 			}
-			m.BlockHeight = 0
+			m.ValsetNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6065,16 +8384,16 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.ValsetNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BatchNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
 			}
-			m.BatchNonce = 0
+			m.BlockHeight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6084,14 +8403,48 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BatchNonce |= uint64(b&0x7F) << shift
+				m.BlockHeight |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Members = append(m.Members, &BridgeValidator{})
+			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6119,9 +8472,43 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
+			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
 			}
@@ -6174,7 +8561,7 @@ func (m *MsgWithdrawClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6197,10 +8584,10 @@ func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgWithdrawClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgWithdrawClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6224,7 +8611,7 @@ func (m *MsgWithdrawClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6247,149 +8634,15 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
-			}
-			m.EventNonce = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
-			}
-			m.BlockHeight = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CosmosDenom", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.CosmosDenom = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.TokenContract = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.Name = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 6:
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Symbol", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6417,32 +8670,13 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Symbol = string(dAtA[iNdEx:postIndex])
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
-			}
-			m.Decimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Decimals |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6452,23 +8686,24 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6491,7 +8726,7 @@ func (m *MsgERC20DeployedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6514,10 +8749,10 @@ func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgERC20DeployedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6541,7 +8776,7 @@ func (m *MsgERC20DeployedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
+func (m *MsgBlacklistEthereumAddresses) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6564,17 +8799,17 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEth: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlacklistEthereumAddresses: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEth: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlacklistEthereumAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TransactionId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
-			m.TransactionId = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6584,14 +8819,27 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TransactionId |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6619,7 +8867,7 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.BlacklistAddresses = append(m.BlacklistAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6642,7 +8890,7 @@ func (m *MsgCancelSendToEth) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgBlacklistEthereumAddressesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6665,10 +8913,10 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCancelSendToEthResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgBlacklistEthereumAddressesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCancelSendToEthResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgBlacklistEthereumAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6692,7 +8940,7 @@ func (m *MsgCancelSendToEthResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeEthereumBlacklist) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6715,51 +8963,15 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeEthereumBlacklist: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidence: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeEthereumBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Subject", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Subject == nil {
-				m.Subject = &types1.Any{}
-			}
-			if err := m.Subject.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6787,11 +8999,11 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signature = string(dAtA[iNdEx:postIndex])
+			m.Signer = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistAddresses", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -6819,7 +9031,7 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Sender = string(dAtA[iNdEx:postIndex])
+			m.BlacklistAddresses = append(m.BlacklistAddresses, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -6842,7 +9054,7 @@ func (m *MsgSubmitBadSignatureEvidence) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRevokeEthereumBlacklistResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6865,10 +9077,10 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRevokeEthereumBlacklistResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgSubmitBadSignatureEvidenceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRevokeEthereumBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -6892,7 +9104,7 @@ func (m *MsgSubmitBadSignatureEvidenceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6915,17 +9127,132 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaim: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDecimals", wireType)
+			}
+			m.TokenDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPriceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			m.EventNonce = 0
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPriceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsd", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6935,16 +9262,31 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.EventNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ValsetNonce", wireType)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			m.ValsetNonce = 0
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteMintLimit", wireType)
+			}
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6954,16 +9296,31 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ValsetNonce |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbsoluteMintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
 			}
-			m.BlockHeight = 0
+			m.RateLimitWindow = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6973,16 +9330,16 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockHeight |= uint64(b&0x7F) << shift
+				m.RateLimitWindow |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 4:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Members", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositLimitUsd", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -6992,29 +9349,29 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Members = append(m.Members, &BridgeValidator{})
-			if err := m.Members[len(m.Members)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.DepositLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 5:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardAmount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PerRecipientDepositLimitUsd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7042,15 +9399,15 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RewardAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.PerRecipientDepositLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 6:
+		case 10:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RewardToken", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7060,29 +9417,33 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RewardToken = string(dAtA[iNdEx:postIndex])
+			if m.PriceSource == nil {
+				m.PriceSource = &PriceSource{}
+			}
+			if err := m.PriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 7:
+		case 11:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPriceSource", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7092,24 +9453,66 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			if m.FallbackPriceSource == nil {
+				m.FallbackPriceSource = &PriceSource{}
+			}
+			if err := m.FallbackPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPriceBehaviour", wireType)
+			}
+			m.MissingPriceBehaviour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingPriceBehaviour |= MissingPriceBehaviour(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipMsgs(dAtA[iNdEx:])
@@ -7131,7 +9534,7 @@ func (m *MsgValsetUpdatedClaim) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgCreateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7154,10 +9557,10 @@ func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgCreateRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgValsetUpdatedClaimResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgCreateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7181,7 +9584,7 @@ func (m *MsgValsetUpdatedClaimResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7204,10 +9607,10 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7244,9 +9647,9 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7256,128 +9659,27 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgBlacklistEthereumAddresses) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBlacklistEthereumAddresses: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBlacklistEthereumAddresses: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenPriceId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7405,11 +9707,11 @@ func (m *MsgBlacklistEthereumAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			m.NewTokenPriceId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRateLimitUsd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7437,111 +9739,66 @@ func (m *MsgBlacklistEthereumAddresses) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlacklistAddresses = append(m.BlacklistAddresses, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
+			if err := m.NewRateLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthMsgs
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgBlacklistEthereumAddressesResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewRateLimitWindow", wireType)
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+			m.NewRateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewRateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewDepositLimitUsd", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgBlacklistEthereumAddressesResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgBlacklistEthereumAddressesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipMsgs(dAtA[iNdEx:])
-			if err != nil {
-				return err
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgRevokeEthereumBlacklist) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowMsgs
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if err := m.NewDepositLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeEthereumBlacklist: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeEthereumBlacklist: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			iNdEx = postIndex
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPerRecipientDepositLimitUsd", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7569,13 +9826,15 @@ func (m *MsgRevokeEthereumBlacklist) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Signer = string(dAtA[iNdEx:postIndex])
+			if err := m.NewPerRecipientDepositLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlacklistAddresses", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NewPriceConfig", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -7585,23 +9844,27 @@ func (m *MsgRevokeEthereumBlacklist) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.BlacklistAddresses = append(m.BlacklistAddresses, string(dAtA[iNdEx:postIndex]))
+			if m.NewPriceConfig == nil {
+				m.NewPriceConfig = &RateLimitPriceConfig{}
+			}
+			if err := m.NewPriceConfig.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -7624,7 +9887,7 @@ func (m *MsgRevokeEthereumBlacklist) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRevokeEthereumBlacklistResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7647,10 +9910,10 @@ func (m *MsgRevokeEthereumBlacklistResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRevokeEthereumBlacklistResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRevokeEthereumBlacklistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7674,7 +9937,7 @@ func (m *MsgRevokeEthereumBlacklistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7697,10 +9960,10 @@ func (m *MsgCreateRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -7767,94 +10030,109 @@ func (m *MsgCreateRateLimit) Unmarshal(dAtA []byte) error {
 			}
 			m.TokenAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenDecimals", wireType)
-			}
-			m.TokenDecimals = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.TokenDecimals |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPriceId", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPriceId = string(dAtA[iNdEx:postIndex])
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsd", wireType)
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsgs(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthMsgs
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
 			}
-			if postIndex > l {
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReleaseQueuedDeposits) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsgs
+			}
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 6:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReleaseQueuedDeposits: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReleaseQueuedDeposits: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteMintLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -7882,28 +10160,83 @@ func (m *MsgCreateRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbsoluteMintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
-			}
-			m.RateLimitWindow = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowMsgs
-				}
-				if iNdEx >= l {
+		case 2:
+			if wireType == 0 {
+				var v uint64
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint64(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.DepositIds = append(m.DepositIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowMsgs
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthMsgs
+				}
+				if postIndex > l {
 					return io.ErrUnexpectedEOF
 				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RateLimitWindow |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.DepositIds) == 0 {
+					m.DepositIds = make([]uint64, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint64
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowMsgs
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint64(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.DepositIds = append(m.DepositIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositIds", wireType)
 			}
 		default:
 			iNdEx = preIndex
@@ -7926,7 +10259,7 @@ func (m *MsgCreateRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReleaseQueuedDepositsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7949,10 +10282,10 @@ func (m *MsgCreateRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReleaseQueuedDepositsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReleaseQueuedDepositsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -7976,7 +10309,7 @@ func (m *MsgCreateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestLogicCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7999,15 +10332,15 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestLogicCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8035,11 +10368,11 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicContractAddress", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8067,13 +10400,13 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			m.LogicContractAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewTokenPriceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Payload", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8083,29 +10416,50 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewTokenPriceId = string(dAtA[iNdEx:postIndex])
+			m.Payload = append(m.Payload[:0], dAtA[iNdEx:postIndex]...)
+			if m.Payload == nil {
+				m.Payload = []byte{}
+			}
 			iNdEx = postIndex
 		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Timeout", wireType)
+			}
+			m.Timeout = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Timeout |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRateLimitUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
-			var stringLen uint64
+			var byteLen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8115,31 +10469,31 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				byteLen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if byteLen < 0 {
 				return ErrInvalidLengthMsgs
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + byteLen
 			if postIndex < 0 {
 				return ErrInvalidLengthMsgs
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.NewRateLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.InvalidationId = append(m.InvalidationId[:0], dAtA[iNdEx:postIndex]...)
+			if m.InvalidationId == nil {
+				m.InvalidationId = []byte{}
 			}
 			iNdEx = postIndex
-		case 5:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewRateLimitWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
 			}
-			m.NewRateLimitWindow = 0
+			m.InvalidationNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowMsgs
@@ -8149,7 +10503,7 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.NewRateLimitWindow |= uint64(b&0x7F) << shift
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
@@ -8175,7 +10529,7 @@ func (m *MsgUpdateRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgRequestLogicCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8198,10 +10552,10 @@ func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgRequestLogicCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgRequestLogicCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -8225,7 +10579,7 @@ func (m *MsgUpdateRateLimitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmLogicCall) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8248,15 +10602,15 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmLogicCall: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmLogicCall: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8284,11 +10638,30 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.InvalidationId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field InvalidationNonce", wireType)
+			}
+			m.InvalidationNonce = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.InvalidationNonce |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthSigner", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -8316,7 +10689,71 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			m.EthSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Orchestrator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Orchestrator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsgs
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsgs
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -8339,7 +10776,7 @@ func (m *MsgRemoveRateLimit) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgConfirmLogicCallResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -8362,10 +10799,10 @@ func (m *MsgRemoveRateLimitResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgConfirmLogicCallResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveRateLimitResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgConfirmLogicCallResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
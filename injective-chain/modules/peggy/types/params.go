@@ -35,6 +35,8 @@ func DefaultParams() *Params {
 		ClaimSlashingEnabled:          false,
 		Admins:                        nil,
 		SegregatedWalletAddress:       "",
+		SignedLogicCallsWindow:        10000,
+		LogicCallRequesters:           nil,
 	}
 }
 
@@ -108,6 +110,10 @@ func (p Params) ValidateBasic() error {
 		return errors.Wrap(err, "segregated wallet address")
 	}
 
+	if err := validateLogicCallRequesters(p.LogicCallRequesters); err != nil {
+		return errors.Wrap(err, "logic call requesters")
+	}
+
 	return nil
 }
 
@@ -326,6 +332,29 @@ func validateAdmins(i interface{}) error {
 	return nil
 }
 
+func validateLogicCallRequesters(i interface{}) error {
+	v, ok := i.([]string)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	requesters := make(map[string]struct{})
+
+	for _, requester := range v {
+		requesterAddr, err := sdk.AccAddressFromBech32(requester)
+		if err != nil {
+			return fmt.Errorf("invalid logic call requester address: %s", requester)
+		}
+
+		if _, found := requesters[requesterAddr.String()]; found {
+			return fmt.Errorf("duplicate logic call requester: %s", requester)
+		}
+		requesters[requesterAddr.String()] = struct{}{}
+	}
+
+	return nil
+}
+
 func validateSegregatedWallet(i interface{}) error {
 	str, ok := i.(string)
 	if !ok {
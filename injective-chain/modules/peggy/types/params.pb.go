@@ -51,6 +51,12 @@ type Params struct {
 	Admins                        []string                    `protobuf:"bytes,22,rep,name=admins,proto3" json:"admins,omitempty"`
 	// address for receiving Peggy Deposits from sanctioned Ethereum addresses
 	SegregatedWalletAddress string `protobuf:"bytes,23,opt,name=segregated_wallet_address,json=segregatedWalletAddress,proto3" json:"segregated_wallet_address,omitempty"`
+	// number of blocks validators have to sign a logic call before being jailed,
+	// zero disables logic call slashing
+	SignedLogicCallsWindow uint64 `protobuf:"varint,24,opt,name=signed_logic_calls_window,json=signedLogicCallsWindow,proto3" json:"signed_logic_calls_window,omitempty"`
+	// accounts (besides the authority and admins) allowed to request logic
+	// calls, e.g. wasm contracts
+	LogicCallRequesters []string `protobuf:"bytes,25,rep,name=logic_call_requesters,json=logicCallRequesters,proto3" json:"logic_call_requesters,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -212,6 +218,20 @@ func (m *Params) GetSegregatedWalletAddress() string {
 	return ""
 }
 
+func (m *Params) GetSignedLogicCallsWindow() uint64 {
+	if m != nil {
+		return m.SignedLogicCallsWindow
+	}
+	return 0
+}
+
+func (m *Params) GetLogicCallRequesters() []string {
+	if m != nil {
+		return m.LogicCallRequesters
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "injective.peggy.v1.Params")
 }
@@ -219,59 +239,62 @@ func init() {
 func init() { proto.RegisterFile("injective/peggy/v1/params.proto", fileDescriptor_f21ffdf8d29783da) }
 
 var fileDescriptor_f21ffdf8d29783da = []byte{
-	// 830 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xcf, 0x6f, 0x23, 0x35,
-	0x18, 0xcd, 0xb0, 0xa5, 0xdb, 0xba, 0x2d, 0xdd, 0xba, 0x49, 0xeb, 0xb6, 0x4b, 0x3a, 0x02, 0x09,
-	0x45, 0x2b, 0x98, 0x69, 0x0b, 0xe2, 0xb0, 0x08, 0x21, 0x92, 0x16, 0x6d, 0xc5, 0x1e, 0x50, 0x0a,
-	0x54, 0xe2, 0x62, 0x79, 0xc6, 0xdf, 0xce, 0x98, 0xce, 0x8c, 0xab, 0xb1, 0x93, 0xaa, 0x37, 0xce,
-	0x9c, 0xf8, 0x2b, 0x38, 0xf3, 0x67, 0xec, 0x71, 0x8f, 0x08, 0xa1, 0x0a, 0xb5, 0x07, 0xfe, 0x0d,
-	0xe4, 0x1f, 0x93, 0xa4, 0x81, 0x43, 0xc5, 0x25, 0x8a, 0xfd, 0xde, 0xfb, 0x3e, 0xe7, 0xd9, 0x79,
-	0x1f, 0xda, 0x17, 0xd5, 0x8f, 0x90, 0x6a, 0x31, 0x86, 0xf8, 0x12, 0xb2, 0xec, 0x3a, 0x1e, 0x1f,
-	0xc6, 0x97, 0xac, 0x66, 0xa5, 0x8a, 0x2e, 0x6b, 0xa9, 0x25, 0xc6, 0x13, 0x42, 0x64, 0x09, 0xd1,
-	0xf8, 0x70, 0xb7, 0x9d, 0xc9, 0x4c, 0x5a, 0x38, 0x36, 0xdf, 0x1c, 0x73, 0xb7, 0x9b, 0x4a, 0x55,
-	0x4a, 0x15, 0x27, 0x4c, 0x41, 0x3c, 0x3e, 0x4c, 0x40, 0xb3, 0xc3, 0x38, 0x95, 0xa2, 0xf2, 0xf8,
-	0x06, 0x2b, 0x45, 0x25, 0x63, 0xfb, 0xe9, 0xb6, 0xde, 0xfb, 0x75, 0x05, 0x2d, 0x7e, 0x63, 0xbb,
-	0xe1, 0x1d, 0xb4, 0x64, 0xeb, 0x53, 0xc1, 0x49, 0x10, 0x06, 0xbd, 0xe5, 0xe1, 0x63, 0xbb, 0x3e,
-	0xe5, 0xf8, 0x00, 0xb5, 0x53, 0x59, 0xe9, 0x9a, 0xa5, 0x9a, 0x2a, 0x39, 0xaa, 0x53, 0xa0, 0x39,
-	0x53, 0x39, 0x79, 0xcb, 0xd2, 0x70, 0x83, 0x9d, 0x59, 0xe8, 0x05, 0x53, 0x39, 0xfe, 0x14, 0x6d,
-	0x27, 0xb5, 0xe0, 0x19, 0x50, 0xd0, 0x39, 0xd4, 0x30, 0x2a, 0x29, 0xe3, 0xbc, 0x06, 0xa5, 0xc8,
-	0x23, 0x2b, 0xea, 0x38, 0xf8, 0xc4, 0xa3, 0x5f, 0x3a, 0x10, 0x7f, 0x80, 0xd6, 0xbd, 0x2e, 0xcd,
-	0x99, 0xa8, 0xcc, 0x59, 0x16, 0xc2, 0xa0, 0xb7, 0x30, 0x5c, 0x73, 0xdb, 0x03, 0xb3, 0x7b, 0xca,
-	0xf1, 0x11, 0xea, 0x28, 0x91, 0x55, 0xc0, 0xe9, 0x98, 0x15, 0x0a, 0xb4, 0xa2, 0x57, 0xa2, 0xe2,
-	0xf2, 0x8a, 0xbc, 0x6d, 0xd9, 0x9b, 0x0e, 0xfc, 0xde, 0x61, 0xe7, 0x16, 0x9a, 0xd1, 0x24, 0x4c,
-	0xa7, 0x39, 0x4c, 0x34, 0x8b, 0xb3, 0x9a, 0xbe, 0xc3, 0xbc, 0xe6, 0x00, 0xb5, 0xbd, 0x26, 0x2d,
-	0x98, 0x28, 0x27, 0x92, 0xc7, 0x56, 0x82, 0x1d, 0x36, 0xb0, 0xd0, 0x54, 0xa1, 0x59, 0x9d, 0x81,
-	0x76, 0x5d, 0xa8, 0x16, 0x25, 0xc8, 0x91, 0x26, 0x4b, 0x4e, 0xe1, 0x30, 0xdb, 0xe4, 0x5b, 0x87,
-	0xe0, 0x0f, 0x11, 0x66, 0x63, 0xa8, 0x59, 0x06, 0x34, 0x29, 0x64, 0x7a, 0x61, 0x25, 0x64, 0xd9,
-	0xf2, 0x9f, 0x78, 0xa4, 0x6f, 0x00, 0x23, 0xc0, 0x9f, 0xa3, 0xbd, 0x86, 0x3d, 0xb1, 0x76, 0x46,
-	0x86, 0xac, 0x8c, 0x78, 0x4a, 0x63, 0xef, 0x54, 0x7e, 0x8e, 0x3a, 0xaa, 0x60, 0x2a, 0xa7, 0xaf,
-	0xcc, 0x8d, 0x09, 0x59, 0x79, 0x03, 0xc9, 0x4a, 0x18, 0xf4, 0x56, 0xfb, 0xef, 0xbf, 0xbe, 0xd9,
-	0x6f, 0xfd, 0x71, 0xb3, 0xbf, 0xe7, 0x9e, 0x92, 0xe2, 0x17, 0x91, 0x90, 0x71, 0xc9, 0x74, 0x1e,
-	0xbd, 0x84, 0x8c, 0xa5, 0xd7, 0xc7, 0x90, 0x0e, 0x37, 0x6d, 0x85, 0xaf, 0x7c, 0x01, 0x67, 0x32,
-	0xfe, 0x0e, 0xb5, 0xe7, 0x0a, 0xdb, 0xdf, 0x4f, 0x56, 0x1f, 0x5e, 0x17, 0xdf, 0xab, 0x6b, 0x3d,
-	0xfa, 0x8f, 0xb2, 0xf6, 0x22, 0xc8, 0xda, 0xff, 0x2d, 0x6b, 0x2f, 0x0b, 0x17, 0x28, 0x9c, 0x2f,
-	0x2b, 0xab, 0x57, 0x85, 0x48, 0xb5, 0xa8, 0x32, 0xdf, 0xe2, 0x9d, 0x87, 0xb7, 0x78, 0xf7, 0x7e,
-	0x8b, 0x69, 0x29, 0xd7, 0x6d, 0x80, 0xba, 0xa3, 0x2a, 0x91, 0x15, 0xa7, 0x96, 0x67, 0x5a, 0xcc,
-	0x3d, 0xdb, 0x75, 0x7b, 0x6d, 0x7b, 0x8e, 0x75, 0xe6, 0x49, 0xf7, 0x9f, 0xef, 0xc5, 0xbf, 0x8e,
-	0x9c, 0x30, 0x6e, 0xde, 0x00, 0x35, 0xaf, 0x90, 0xe9, 0x51, 0x0d, 0xe4, 0xc9, 0xc3, 0x8f, 0xfc,
-	0x74, 0xce, 0x6c, 0x7e, 0xa2, 0xf3, 0xb3, 0xa6, 0x10, 0x7e, 0x86, 0x36, 0x9c, 0x98, 0x9a, 0xfc,
-	0xa0, 0x1c, 0x2a, 0x59, 0x92, 0x0d, 0xfb, 0xcf, 0x5d, 0x77, 0xc0, 0x40, 0x8a, 0xea, 0xd8, 0x6c,
-	0xe3, 0xcf, 0xd0, 0xee, 0x2c, 0x17, 0xea, 0xf4, 0xe8, 0x80, 0x36, 0x99, 0x40, 0xb0, 0x15, 0x6d,
-	0x4f, 0x45, 0x27, 0x06, 0x1f, 0x78, 0x18, 0x7f, 0x82, 0xb6, 0xac, 0xdb, 0x53, 0x67, 0xa0, 0x62,
-	0x49, 0x01, 0x9c, 0x6c, 0x86, 0x41, 0x6f, 0x69, 0xd8, 0xb6, 0x68, 0xe3, 0xc8, 0x89, 0xc3, 0xf0,
-	0x17, 0xe8, 0x69, 0x13, 0x13, 0x93, 0x5c, 0xd2, 0xac, 0xd6, 0x34, 0x07, 0x91, 0xe5, 0x9a, 0xb4,
-	0xad, 0x9d, 0x3b, 0x3e, 0x33, 0x9a, 0x78, 0x32, 0x8c, 0x17, 0x96, 0x80, 0x8f, 0xd1, 0x9a, 0xbb,
-	0x01, 0x5a, 0xc3, 0x15, 0xab, 0x39, 0xe9, 0x84, 0x41, 0x6f, 0xe5, 0x68, 0x27, 0x72, 0xe7, 0x8c,
-	0x4c, 0x84, 0x46, 0x3e, 0x42, 0x23, 0x73, 0xea, 0xfe, 0x82, 0x31, 0x75, 0xb8, 0xea, 0x54, 0x43,
-	0x2b, 0xc2, 0x5b, 0x68, 0x91, 0xf1, 0x52, 0x54, 0x8a, 0x6c, 0x85, 0x8f, 0x7a, 0xcb, 0x43, 0xbf,
-	0xc2, 0xcf, 0xd1, 0x8e, 0x82, 0xac, 0x86, 0x8c, 0x69, 0xe0, 0xf4, 0x8a, 0x15, 0x05, 0xe8, 0x49,
-	0xfe, 0x6d, 0x3b, 0x43, 0xa6, 0x84, 0x73, 0x8b, 0xfb, 0x04, 0x7c, 0xde, 0xf9, 0xe9, 0xcf, 0xb0,
-	0xf5, 0xf3, 0xdf, 0xbf, 0x3d, 0x5b, 0x75, 0xe3, 0xc0, 0xa5, 0x73, 0x1f, 0x5e, 0xdf, 0x76, 0x83,
-	0x37, 0xb7, 0xdd, 0xe0, 0xaf, 0xdb, 0x6e, 0xf0, 0xcb, 0x5d, 0xb7, 0xf5, 0xe6, 0xae, 0xdb, 0xfa,
-	0xfd, 0xae, 0xdb, 0xfa, 0xe1, 0xeb, 0x4c, 0xe8, 0x7c, 0x94, 0x44, 0xa9, 0x2c, 0xe3, 0xd3, 0x66,
-	0x54, 0xbc, 0x64, 0x89, 0x8a, 0x27, 0x83, 0xe3, 0xa3, 0x54, 0xd6, 0x30, 0xbb, 0x34, 0x29, 0x1a,
-	0x97, 0x92, 0x8f, 0x0a, 0x50, 0x7e, 0xec, 0xe8, 0xeb, 0x4b, 0x50, 0xc9, 0xa2, 0x1d, 0x0b, 0x1f,
-	0xff, 0x13, 0x00, 0x00, 0xff, 0xff, 0xec, 0xc3, 0x94, 0xd9, 0x96, 0x06, 0x00, 0x00,
+	// 874 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x95, 0x41, 0x6f, 0x23, 0x35,
+	0x14, 0xc7, 0x1b, 0xb6, 0x74, 0x5b, 0x6f, 0x4a, 0xb7, 0x6e, 0xd2, 0x3a, 0xed, 0x92, 0x46, 0x20,
+	0xa1, 0x68, 0x05, 0x33, 0x6d, 0x41, 0x48, 0x2c, 0x42, 0x88, 0xa4, 0x45, 0x5b, 0xd1, 0x03, 0x4a,
+	0x81, 0x4a, 0x5c, 0x2c, 0x8f, 0xfd, 0x76, 0xc6, 0x74, 0x66, 0x5c, 0x6c, 0x27, 0x55, 0x6f, 0x9c,
+	0x39, 0x71, 0xe1, 0x7b, 0xf0, 0x31, 0xf6, 0xb8, 0x47, 0x84, 0xd0, 0x0a, 0xb5, 0x07, 0xbe, 0x06,
+	0xb2, 0x3d, 0x93, 0xa4, 0x85, 0x43, 0xc5, 0xa5, 0xea, 0xcc, 0xef, 0xff, 0x7f, 0xcf, 0xf3, 0xec,
+	0xfc, 0x8d, 0x76, 0x65, 0xf9, 0x03, 0x70, 0x2b, 0x27, 0x10, 0x5f, 0x40, 0x9a, 0x5e, 0xc5, 0x93,
+	0xfd, 0xf8, 0x82, 0x69, 0x56, 0x98, 0xe8, 0x42, 0x2b, 0xab, 0x30, 0x9e, 0x0a, 0x22, 0x2f, 0x88,
+	0x26, 0xfb, 0xdb, 0xad, 0x54, 0xa5, 0xca, 0xe3, 0xd8, 0xfd, 0x17, 0x94, 0xdb, 0x5d, 0xae, 0x4c,
+	0xa1, 0x4c, 0x9c, 0x30, 0x03, 0xf1, 0x64, 0x3f, 0x01, 0xcb, 0xf6, 0x63, 0xae, 0x64, 0x59, 0xf1,
+	0x75, 0x56, 0xc8, 0x52, 0xc5, 0xfe, 0x6f, 0x78, 0xf5, 0xce, 0xaf, 0x4d, 0xb4, 0xf4, 0xb5, 0xef,
+	0x86, 0x3b, 0x68, 0xd9, 0xd7, 0xa7, 0x52, 0x90, 0x46, 0xaf, 0xd1, 0x5f, 0x19, 0x3d, 0xf4, 0xcf,
+	0xc7, 0x02, 0xef, 0xa1, 0x16, 0x57, 0xa5, 0xd5, 0x8c, 0x5b, 0x6a, 0xd4, 0x58, 0x73, 0xa0, 0x19,
+	0x33, 0x19, 0x79, 0xc3, 0xcb, 0x70, 0xcd, 0x4e, 0x3d, 0x7a, 0xce, 0x4c, 0x86, 0x3f, 0x46, 0x5b,
+	0x89, 0x96, 0x22, 0x05, 0x0a, 0x36, 0x03, 0x0d, 0xe3, 0x82, 0x32, 0x21, 0x34, 0x18, 0x43, 0x1e,
+	0x78, 0x53, 0x3b, 0xe0, 0xa3, 0x8a, 0x7e, 0x11, 0x20, 0x7e, 0x0f, 0xad, 0x55, 0x3e, 0x9e, 0x31,
+	0x59, 0xba, 0xb5, 0x2c, 0xf6, 0x1a, 0xfd, 0xc5, 0xd1, 0x6a, 0x78, 0x3d, 0x74, 0x6f, 0x8f, 0x05,
+	0x3e, 0x40, 0x6d, 0x23, 0xd3, 0x12, 0x04, 0x9d, 0xb0, 0xdc, 0x80, 0x35, 0xf4, 0x52, 0x96, 0x42,
+	0x5d, 0x92, 0x37, 0xbd, 0x7a, 0x23, 0xc0, 0xef, 0x02, 0x3b, 0xf3, 0x68, 0xce, 0x93, 0x30, 0xcb,
+	0x33, 0x98, 0x7a, 0x96, 0xe6, 0x3d, 0x83, 0xc0, 0x2a, 0xcf, 0x1e, 0x6a, 0x55, 0x1e, 0x9e, 0x33,
+	0x59, 0x4c, 0x2d, 0x0f, 0xbd, 0x05, 0x07, 0x36, 0xf4, 0x68, 0xe6, 0xb0, 0x4c, 0xa7, 0x60, 0x43,
+	0x17, 0x6a, 0x65, 0x01, 0x6a, 0x6c, 0xc9, 0x72, 0x70, 0x04, 0xe6, 0x9b, 0x7c, 0x13, 0x08, 0x7e,
+	0x1f, 0x61, 0x36, 0x01, 0xcd, 0x52, 0xa0, 0x49, 0xae, 0xf8, 0xb9, 0xb7, 0x90, 0x15, 0xaf, 0x7f,
+	0x5c, 0x91, 0x81, 0x03, 0xce, 0x80, 0x3f, 0x43, 0x3b, 0xb5, 0x7a, 0x3a, 0xda, 0x39, 0x1b, 0xf2,
+	0x36, 0x52, 0x49, 0xea, 0xf1, 0xce, 0xec, 0x67, 0xa8, 0x6d, 0x72, 0x66, 0x32, 0xfa, 0xc2, 0xed,
+	0x98, 0x54, 0x65, 0x35, 0x40, 0xf2, 0xa8, 0xd7, 0xe8, 0x37, 0x07, 0xef, 0xbe, 0x7c, 0xbd, 0xbb,
+	0xf0, 0xc7, 0xeb, 0xdd, 0x9d, 0x70, 0x94, 0x8c, 0x38, 0x8f, 0xa4, 0x8a, 0x0b, 0x66, 0xb3, 0xe8,
+	0x04, 0x52, 0xc6, 0xaf, 0x0e, 0x81, 0x8f, 0x36, 0x7c, 0x85, 0x2f, 0xab, 0x02, 0x61, 0xc8, 0xf8,
+	0x5b, 0xd4, 0xba, 0x53, 0xd8, 0x7f, 0x3f, 0x69, 0xde, 0xbf, 0x2e, 0xbe, 0x55, 0xd7, 0xcf, 0xe8,
+	0x3f, 0xca, 0xfa, 0x8d, 0x20, 0xab, 0xff, 0xb7, 0xac, 0xdf, 0x2c, 0x9c, 0xa3, 0xde, 0xdd, 0xb2,
+	0xaa, 0x7c, 0x91, 0x4b, 0x6e, 0x65, 0x99, 0x56, 0x2d, 0xde, 0xba, 0x7f, 0x8b, 0xb7, 0x6f, 0xb7,
+	0x98, 0x95, 0x0a, 0xdd, 0x86, 0xa8, 0x3b, 0x2e, 0x13, 0x55, 0x0a, 0xea, 0x75, 0xae, 0xc5, 0x9d,
+	0x63, 0xbb, 0xe6, 0xb7, 0x6d, 0x27, 0xa8, 0x4e, 0x2b, 0xd1, 0xed, 0xe3, 0x7b, 0xfe, 0xaf, 0x25,
+	0x27, 0x4c, 0xb8, 0x33, 0x40, 0xdd, 0x29, 0x64, 0x76, 0xac, 0x81, 0x3c, 0xbe, 0xff, 0x92, 0x9f,
+	0xdc, 0x19, 0xb6, 0x38, 0xb2, 0xd9, 0x69, 0x5d, 0x08, 0x3f, 0x45, 0xeb, 0xc1, 0x4c, 0x5d, 0x7e,
+	0x50, 0x01, 0xa5, 0x2a, 0xc8, 0xba, 0xff, 0xe5, 0xae, 0x05, 0x30, 0x54, 0xb2, 0x3c, 0x74, 0xaf,
+	0xf1, 0xa7, 0x68, 0x7b, 0x5e, 0x0b, 0x9a, 0x1f, 0xec, 0xd1, 0x3a, 0x13, 0x08, 0xf6, 0xa6, 0xad,
+	0x99, 0xe9, 0xc8, 0xf1, 0x61, 0x85, 0xf1, 0x47, 0x68, 0xd3, 0x4f, 0x7b, 0x36, 0x19, 0x28, 0x59,
+	0x92, 0x83, 0x20, 0x1b, 0xbd, 0x46, 0x7f, 0x79, 0xd4, 0xf2, 0xb4, 0x9e, 0xc8, 0x51, 0x60, 0xf8,
+	0x73, 0xf4, 0xa4, 0x8e, 0x89, 0x69, 0x2e, 0x59, 0xa6, 0x2d, 0xcd, 0x40, 0xa6, 0x99, 0x25, 0x2d,
+	0x3f, 0xce, 0x4e, 0x95, 0x19, 0x75, 0x3c, 0x39, 0xc5, 0x73, 0x2f, 0xc0, 0x87, 0x68, 0x35, 0xec,
+	0x00, 0xd5, 0x70, 0xc9, 0xb4, 0x20, 0xed, 0x5e, 0xa3, 0xff, 0xe8, 0xa0, 0x13, 0x85, 0x75, 0x46,
+	0x2e, 0x42, 0xa3, 0x2a, 0x42, 0x23, 0xb7, 0xea, 0xc1, 0xa2, 0x1b, 0xea, 0xa8, 0x19, 0x5c, 0x23,
+	0x6f, 0xc2, 0x9b, 0x68, 0x89, 0x89, 0x42, 0x96, 0x86, 0x6c, 0xf6, 0x1e, 0xf4, 0x57, 0x46, 0xd5,
+	0x13, 0x7e, 0x86, 0x3a, 0x06, 0x52, 0x0d, 0x29, 0xb3, 0x20, 0xe8, 0x25, 0xcb, 0x73, 0xb0, 0xd3,
+	0xfc, 0xdb, 0x0a, 0x03, 0x99, 0x09, 0xce, 0x3c, 0xaf, 0x13, 0xf0, 0x13, 0xd4, 0xa9, 0x12, 0x27,
+	0x57, 0xa9, 0xe4, 0x94, 0xb3, 0x3c, 0x9f, 0x1e, 0x13, 0xe2, 0xbf, 0x6b, 0x33, 0x08, 0x4e, 0x1c,
+	0x1f, 0x3a, 0x3c, 0x0b, 0xb8, 0x99, 0x87, 0x6a, 0xf8, 0x71, 0x0c, 0xc6, 0x82, 0x36, 0xa4, 0xe3,
+	0x57, 0xb7, 0x91, 0xd7, 0x86, 0xd1, 0x14, 0x3d, 0x6b, 0xff, 0xf4, 0x67, 0x6f, 0xe1, 0xe7, 0xbf,
+	0x7f, 0x7b, 0xda, 0x0c, 0xb7, 0x4f, 0xb8, 0x0c, 0x06, 0xf0, 0xf2, 0xba, 0xdb, 0x78, 0x75, 0xdd,
+	0x6d, 0xfc, 0x75, 0xdd, 0x6d, 0xfc, 0x72, 0xd3, 0x5d, 0x78, 0x75, 0xd3, 0x5d, 0xf8, 0xfd, 0xa6,
+	0xbb, 0xf0, 0xfd, 0x57, 0xa9, 0xb4, 0xd9, 0x38, 0x89, 0xb8, 0x2a, 0xe2, 0xe3, 0xfa, 0x66, 0x3a,
+	0x61, 0x89, 0x89, 0xa7, 0xf7, 0xd4, 0x07, 0x5c, 0x69, 0x98, 0x7f, 0x74, 0xa1, 0x1d, 0x17, 0x4a,
+	0x8c, 0x73, 0x30, 0xd5, 0x2d, 0x67, 0xaf, 0x2e, 0xc0, 0x24, 0x4b, 0xfe, 0x16, 0xfa, 0xf0, 0x9f,
+	0x01, 0x00, 0x47, 0xb5, 0x6f, 0x92, 0x05, 0x07, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -294,6 +317,24 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.LogicCallRequesters) > 0 {
+		for iNdEx := len(m.LogicCallRequesters) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.LogicCallRequesters[iNdEx])
+			copy(dAtA[i:], m.LogicCallRequesters[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.LogicCallRequesters[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xca
+		}
+	}
+	if m.SignedLogicCallsWindow != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SignedLogicCallsWindow))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xc0
+	}
 	if len(m.SegregatedWalletAddress) > 0 {
 		i -= len(m.SegregatedWalletAddress)
 		copy(dAtA[i:], m.SegregatedWalletAddress)
@@ -568,6 +609,15 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 2 + l + sovParams(uint64(l))
 	}
+	if m.SignedLogicCallsWindow != 0 {
+		n += 2 + sovParams(uint64(m.SignedLogicCallsWindow))
+	}
+	if len(m.LogicCallRequesters) > 0 {
+		for _, s := range m.LogicCallRequesters {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1219,6 +1269,57 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			}
 			m.SegregatedWalletAddress = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 24:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SignedLogicCallsWindow", wireType)
+			}
+			m.SignedLogicCallsWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SignedLogicCallsWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 25:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LogicCallRequesters", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LogicCallRequesters = append(m.LogicCallRequesters, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
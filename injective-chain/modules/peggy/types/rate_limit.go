@@ -33,10 +33,112 @@ func (l *RateLimit) TotalOutflow() sdkmath.Int {
 	return sum
 }
 
+// TotalInflowOf returns the amount deposited to the given recipient within the sliding window
+func (l *RateLimit) TotalInflowOf(recipient string) sdkmath.Int {
+	sum := sdkmath.ZeroInt()
+	for _, transfer := range l.Transfers {
+		if transfer.IsDeposit && transfer.Recipient == recipient {
+			sum = sum.Add(transfer.Amount)
+		}
+	}
+
+	return sum
+}
+
+// HasDepositLimit returns true if inbound traffic of the token is limited
+func (l *RateLimit) HasDepositLimit() bool {
+	return !l.DepositLimitUsd.IsNil() && l.DepositLimitUsd.IsPositive()
+}
+
+// HasPerRecipientDepositLimit returns true if inbound traffic of a single recipient is limited
+func (l *RateLimit) HasPerRecipientDepositLimit() bool {
+	return !l.PerRecipientDepositLimitUsd.IsNil() && l.PerRecipientDepositLimitUsd.IsPositive()
+}
+
+// GetQuoteOrDefault returns the quote symbol of the price source, USD if not set
+func (s *PriceSource) GetQuoteOrDefault() string {
+	if s.Quote == "" {
+		return "USD"
+	}
+
+	return s.Quote
+}
+
+// ValidateBasic performs stateless checks on the price source. The oracle type name is validated by the keeper.
+func (s *PriceSource) ValidateBasic() error {
+	if s.OracleType == "" {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "oracle_type cannot be empty")
+	}
+
+	if s.Base == "" {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "base cannot be empty")
+	}
+
+	switch strings.ToLower(s.OracleType) {
+	case "pyth":
+		if !isValidPythID(s.Base) {
+			return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidRequest, "invalid pyth price id: %s", s.Base)
+		}
+	case "provider":
+		if s.Provider == "" {
+			return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "provider cannot be empty for provider oracles")
+		}
+	}
+
+	return nil
+}
+
+func validatePriceSources(
+	tokenPriceID string,
+	priceSource, fallbackPriceSource *PriceSource,
+	behaviour MissingPriceBehaviour,
+	maxPriceAge uint64,
+) error {
+	if priceSource == nil && tokenPriceID == "" {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "either a token price id or a price source must be set")
+	}
+
+	return validatePriceConfig(priceSource, fallbackPriceSource, behaviour, maxPriceAge)
+}
+
+// ValidateBasic performs stateless checks on the price config. Whether the price resolves is checked by the keeper.
+func (c *RateLimitPriceConfig) ValidateBasic() error {
+	return validatePriceConfig(c.PriceSource, c.FallbackPriceSource, c.MissingPriceBehaviour, c.MaxPriceAge)
+}
+
+func validatePriceConfig(
+	priceSource, fallbackPriceSource *PriceSource,
+	behaviour MissingPriceBehaviour,
+	maxPriceAge uint64,
+) error {
+	if priceSource != nil {
+		if err := priceSource.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "price_source")
+		}
+	}
+
+	if fallbackPriceSource != nil {
+		if err := fallbackPriceSource.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "fallback_price_source")
+		}
+	}
+
+	if _, ok := MissingPriceBehaviour_name[int32(behaviour)]; !ok {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidRequest, "invalid missing price behaviour: %d", behaviour)
+	}
+
+	if behaviour == MissingPriceBehaviour_UseLastKnownPrice && maxPriceAge == 0 {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "max_price_age cannot be zero when using the last known price")
+	}
+
+	return nil
+}
+
 var (
 	_ sdk.Msg = &MsgCreateRateLimit{}
 	_ sdk.Msg = &MsgUpdateRateLimit{}
 	_ sdk.Msg = &MsgRemoveRateLimit{}
+	_ sdk.Msg = &MsgReleaseQueuedDeposits{}
 )
 
 func (*MsgCreateRateLimit) Route() string { return RouterKey }
@@ -81,7 +183,15 @@ func (msg *MsgCreateRateLimit) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidRequest, "absolute_mint_limit cannot be zero")
 	}
 
-	return nil
+	if !msg.DepositLimitUsd.IsNil() && msg.DepositLimitUsd.IsNegative() {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "deposit_limit_usd cannot be negative")
+	}
+
+	if !msg.PerRecipientDepositLimitUsd.IsNil() && msg.PerRecipientDepositLimitUsd.IsNegative() {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "per_recipient_deposit_limit_usd cannot be negative")
+	}
+
+	return validatePriceSources(msg.TokenPriceId, msg.PriceSource, msg.FallbackPriceSource, msg.MissingPriceBehaviour, msg.MaxPriceAge)
 }
 
 func (*MsgUpdateRateLimit) Route() string { return RouterKey }
@@ -117,6 +227,20 @@ func (msg *MsgUpdateRateLimit) ValidateBasic() error {
 		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidRequest, "new_rate_limit_window cannot be zero")
 	}
 
+	if !msg.NewDepositLimitUsd.IsNil() && msg.NewDepositLimitUsd.IsNegative() {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "new_deposit_limit_usd cannot be negative")
+	}
+
+	if !msg.NewPerRecipientDepositLimitUsd.IsNil() && msg.NewPerRecipientDepositLimitUsd.IsNegative() {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "new_per_recipient_deposit_limit_usd cannot be negative")
+	}
+
+	if msg.NewPriceConfig != nil {
+		if err := msg.NewPriceConfig.ValidateBasic(); err != nil {
+			return sdkerrors.Wrap(err, "new_price_config")
+		}
+	}
+
 	return nil
 }
 
@@ -144,6 +268,38 @@ func (msg *MsgRemoveRateLimit) ValidateBasic() error {
 	return nil
 }
 
+func (*MsgReleaseQueuedDeposits) Route() string { return RouterKey }
+
+func (*MsgReleaseQueuedDeposits) Type() string { return "release_queued_deposits" }
+
+func (msg *MsgReleaseQueuedDeposits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(msg))
+}
+
+func (msg *MsgReleaseQueuedDeposits) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{sdk.MustAccAddressFromBech32(msg.Authority)}
+}
+
+func (msg *MsgReleaseQueuedDeposits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidAddress, "invalid authority address: %s", msg.Authority)
+	}
+
+	if len(msg.DepositIds) == 0 {
+		return sdkerrors.Wrap(sdkerrortypes.ErrInvalidRequest, "deposit_ids cannot be empty")
+	}
+
+	seen := make(map[uint64]struct{}, len(msg.DepositIds))
+	for _, id := range msg.DepositIds {
+		if _, ok := seen[id]; ok {
+			return sdkerrors.Wrapf(sdkerrortypes.ErrInvalidRequest, "duplicate deposit id %d", id)
+		}
+		seen[id] = struct{}{}
+	}
+
+	return nil
+}
+
 func isValidPythID(s string) bool {
 	_, err := hex.DecodeString(strings.TrimPrefix(s, "0x"))
 	return err == nil
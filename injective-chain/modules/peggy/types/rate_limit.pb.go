@@ -24,12 +24,46 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// MissingPriceBehaviour defines how rate limits treat transfers of a token
+// whose price cannot be resolved
+type MissingPriceBehaviour int32
+
+const (
+	// transfers are rejected (withdrawals) or queued (deposits)
+	MissingPriceBehaviour_BlockOnMissingPrice MissingPriceBehaviour = 0
+	// transfers are not rate limited
+	MissingPriceBehaviour_AllowOnMissingPrice MissingPriceBehaviour = 1
+	// the last known price is used as long as it is not older than max_price_age
+	MissingPriceBehaviour_UseLastKnownPrice MissingPriceBehaviour = 2
+)
+
+var MissingPriceBehaviour_name = map[int32]string{
+	0: "BlockOnMissingPrice",
+	1: "AllowOnMissingPrice",
+	2: "UseLastKnownPrice",
+}
+
+var MissingPriceBehaviour_value = map[string]int32{
+	"BlockOnMissingPrice": 0,
+	"AllowOnMissingPrice": 1,
+	"UseLastKnownPrice":   2,
+}
+
+func (x MissingPriceBehaviour) String() string {
+	return proto.EnumName(MissingPriceBehaviour_name, int32(x))
+}
+
+func (MissingPriceBehaviour) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_f5e4b49160131e74, []int{0}
+}
+
 type RateLimit struct {
 	// address of the ERC20 token
 	TokenAddress string `protobuf:"bytes,1,opt,name=token_address,json=tokenAddress,proto3" json:"token_address,omitempty"`
 	// decimals of the ERC20 token
 	TokenDecimals uint32 `protobuf:"varint,2,opt,name=token_decimals,json=tokenDecimals,proto3" json:"token_decimals,omitempty"`
-	// a Pyth-specific ID used to obtain USD price of the ERC20 token
+	// a Pyth-specific ID used to obtain USD price of the ERC20 token. Only used
+	// when price_source is not set
 	TokenPriceId string `protobuf:"bytes,3,opt,name=token_price_id,json=tokenPriceId,proto3" json:"token_price_id,omitempty"`
 	// length of the sliding window in which inbound (outbound) traffic is
 	// measured
@@ -40,6 +74,26 @@ type RateLimit struct {
 	AbsoluteMintLimit cosmossdk_io_math.Int `protobuf:"bytes,6,opt,name=absolute_mint_limit,json=absoluteMintLimit,proto3,customtype=cosmossdk.io/math.Int" json:"absolute_mint_limit"`
 	// transfers that occurred within the sliding window
 	Transfers []*BridgeTransfer `protobuf:"bytes,7,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	// the notional USD limit imposed on all incoming traffic (per token). Zero
+	// disables inbound rate limiting
+	DepositLimitUsd cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=deposit_limit_usd,json=depositLimitUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"deposit_limit_usd"`
+	// the notional USD limit imposed on incoming traffic of a single recipient
+	// (per token). Zero disables per-recipient limits
+	PerRecipientDepositLimitUsd cosmossdk_io_math.LegacyDec `protobuf:"bytes,9,opt,name=per_recipient_deposit_limit_usd,json=perRecipientDepositLimitUsd,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"per_recipient_deposit_limit_usd"`
+	// oracle price used to value the ERC20 token in USD. Falls back to the Pyth
+	// price of token_price_id when not set
+	PriceSource *PriceSource `protobuf:"bytes,10,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	// oracle price used when the price_source price cannot be resolved
+	FallbackPriceSource *PriceSource `protobuf:"bytes,11,opt,name=fallback_price_source,json=fallbackPriceSource,proto3" json:"fallback_price_source,omitempty"`
+	// what happens to transfers when no price can be resolved
+	MissingPriceBehaviour MissingPriceBehaviour `protobuf:"varint,12,opt,name=missing_price_behaviour,json=missingPriceBehaviour,proto3,enum=injective.peggy.v1.MissingPriceBehaviour" json:"missing_price_behaviour,omitempty"`
+	// maximum age (in seconds) of the last known price when
+	// missing_price_behaviour is UseLastKnownPrice
+	MaxPriceAge uint64 `protobuf:"varint,13,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+	// last price successfully resolved for the ERC20 token
+	LastKnownPrice cosmossdk_io_math.LegacyDec `protobuf:"bytes,14,opt,name=last_known_price,json=lastKnownPrice,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"last_known_price"`
+	// block time (unix seconds) at which last_known_price was resolved
+	LastKnownPriceTimestamp int64 `protobuf:"varint,15,opt,name=last_known_price_timestamp,json=lastKnownPriceTimestamp,proto3" json:"last_known_price_timestamp,omitempty"`
 }
 
 func (m *RateLimit) Reset()         { *m = RateLimit{} }
@@ -110,6 +164,191 @@ func (m *RateLimit) GetTransfers() []*BridgeTransfer {
 	return nil
 }
 
+func (m *RateLimit) GetPriceSource() *PriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return nil
+}
+
+func (m *RateLimit) GetFallbackPriceSource() *PriceSource {
+	if m != nil {
+		return m.FallbackPriceSource
+	}
+	return nil
+}
+
+func (m *RateLimit) GetMissingPriceBehaviour() MissingPriceBehaviour {
+	if m != nil {
+		return m.MissingPriceBehaviour
+	}
+	return MissingPriceBehaviour_BlockOnMissingPrice
+}
+
+func (m *RateLimit) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
+func (m *RateLimit) GetLastKnownPriceTimestamp() int64 {
+	if m != nil {
+		return m.LastKnownPriceTimestamp
+	}
+	return 0
+}
+
+// PriceSource identifies an oracle price
+type PriceSource struct {
+	// name of the oracle type (pyth, stork, chainlinkdatastreams, provider,
+	// pricefeed or coinbase)
+	OracleType string `protobuf:"bytes,1,opt,name=oracle_type,json=oracleType,proto3" json:"oracle_type,omitempty"`
+	// base symbol of the price (price ID for Pyth, feed ID for Chainlink Data
+	// Streams, symbol for provider oracles)
+	Base string `protobuf:"bytes,2,opt,name=base,proto3" json:"base,omitempty"`
+	// quote symbol of the price. Defaults to USD
+	Quote string `protobuf:"bytes,3,opt,name=quote,proto3" json:"quote,omitempty"`
+	// name of the provider (provider oracles only)
+	Provider string `protobuf:"bytes,4,opt,name=provider,proto3" json:"provider,omitempty"`
+}
+
+func (m *PriceSource) Reset()         { *m = PriceSource{} }
+func (m *PriceSource) String() string { return proto.CompactTextString(m) }
+func (*PriceSource) ProtoMessage()    {}
+func (*PriceSource) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e4b49160131e74, []int{1}
+}
+func (m *PriceSource) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PriceSource) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PriceSource.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PriceSource) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PriceSource.Merge(m, src)
+}
+func (m *PriceSource) XXX_Size() int {
+	return m.Size()
+}
+func (m *PriceSource) XXX_DiscardUnknown() {
+	xxx_messageInfo_PriceSource.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PriceSource proto.InternalMessageInfo
+
+func (m *PriceSource) GetOracleType() string {
+	if m != nil {
+		return m.OracleType
+	}
+	return ""
+}
+
+func (m *PriceSource) GetBase() string {
+	if m != nil {
+		return m.Base
+	}
+	return ""
+}
+
+func (m *PriceSource) GetQuote() string {
+	if m != nil {
+		return m.Quote
+	}
+	return ""
+}
+
+func (m *PriceSource) GetProvider() string {
+	if m != nil {
+		return m.Provider
+	}
+	return ""
+}
+
+// RateLimitPriceConfig defines how the price of a rate limited token is
+// resolved
+type RateLimitPriceConfig struct {
+	// oracle price of the rate limited token. The Pyth price of the token price
+	// ID is used when not set
+	PriceSource *PriceSource `protobuf:"bytes,1,opt,name=price_source,json=priceSource,proto3" json:"price_source,omitempty"`
+	// oracle price used when price_source cannot be resolved
+	FallbackPriceSource *PriceSource `protobuf:"bytes,2,opt,name=fallback_price_source,json=fallbackPriceSource,proto3" json:"fallback_price_source,omitempty"`
+	// behaviour when no price can be resolved
+	MissingPriceBehaviour MissingPriceBehaviour `protobuf:"varint,3,opt,name=missing_price_behaviour,json=missingPriceBehaviour,proto3,enum=injective.peggy.v1.MissingPriceBehaviour" json:"missing_price_behaviour,omitempty"`
+	// maximum age (in seconds) of the last known price
+	MaxPriceAge uint64 `protobuf:"varint,4,opt,name=max_price_age,json=maxPriceAge,proto3" json:"max_price_age,omitempty"`
+}
+
+func (m *RateLimitPriceConfig) Reset()         { *m = RateLimitPriceConfig{} }
+func (m *RateLimitPriceConfig) String() string { return proto.CompactTextString(m) }
+func (*RateLimitPriceConfig) ProtoMessage()    {}
+func (*RateLimitPriceConfig) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e4b49160131e74, []int{2}
+}
+func (m *RateLimitPriceConfig) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitPriceConfig) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitPriceConfig.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitPriceConfig) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitPriceConfig.Merge(m, src)
+}
+func (m *RateLimitPriceConfig) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitPriceConfig) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitPriceConfig.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitPriceConfig proto.InternalMessageInfo
+
+func (m *RateLimitPriceConfig) GetPriceSource() *PriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return nil
+}
+
+func (m *RateLimitPriceConfig) GetFallbackPriceSource() *PriceSource {
+	if m != nil {
+		return m.FallbackPriceSource
+	}
+	return nil
+}
+
+func (m *RateLimitPriceConfig) GetMissingPriceBehaviour() MissingPriceBehaviour {
+	if m != nil {
+		return m.MissingPriceBehaviour
+	}
+	return MissingPriceBehaviour_BlockOnMissingPrice
+}
+
+func (m *RateLimitPriceConfig) GetMaxPriceAge() uint64 {
+	if m != nil {
+		return m.MaxPriceAge
+	}
+	return 0
+}
+
 type BridgeTransfer struct {
 	// quantity that was bridged (chain format)
 	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
@@ -117,13 +356,15 @@ type BridgeTransfer struct {
 	BlockNumber uint64 `protobuf:"varint,2,opt,name=block_number,json=blockNumber,proto3" json:"block_number,omitempty"`
 	// type of transfer (withdrawal/deposit)
 	IsDeposit bool `protobuf:"varint,3,opt,name=is_deposit,json=isDeposit,proto3" json:"is_deposit,omitempty"`
+	// Injective recipient of a deposit (empty for withdrawals)
+	Recipient string `protobuf:"bytes,4,opt,name=recipient,proto3" json:"recipient,omitempty"`
 }
 
 func (m *BridgeTransfer) Reset()         { *m = BridgeTransfer{} }
 func (m *BridgeTransfer) String() string { return proto.CompactTextString(m) }
 func (*BridgeTransfer) ProtoMessage()    {}
 func (*BridgeTransfer) Descriptor() ([]byte, []int) {
-	return fileDescriptor_f5e4b49160131e74, []int{1}
+	return fileDescriptor_f5e4b49160131e74, []int{3}
 }
 func (m *BridgeTransfer) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -166,9 +407,126 @@ func (m *BridgeTransfer) GetIsDeposit() bool {
 	return false
 }
 
+func (m *BridgeTransfer) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+// QueuedDeposit is a deposit that exceeded the inbound rate limit of its token.
+// Queued deposits are minted and sent to their recipient once the sliding
+// window has enough room left, or when released by a Peggy admin.
+type QueuedDeposit struct {
+	// unique identifier of the queued deposit
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// address of the ERC20 token
+	TokenContract string `protobuf:"bytes,2,opt,name=token_contract,json=tokenContract,proto3" json:"token_contract,omitempty"`
+	// quantity that was deposited (chain format)
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// Ethereum sender address
+	EthereumSender string `protobuf:"bytes,4,opt,name=ethereum_sender,json=ethereumSender,proto3" json:"ethereum_sender,omitempty"`
+	// Injective receiver address as found in the deposit claim
+	CosmosReceiver string `protobuf:"bytes,5,opt,name=cosmos_receiver,json=cosmosReceiver,proto3" json:"cosmos_receiver,omitempty"`
+	// event nonce of the deposit claim
+	EventNonce uint64 `protobuf:"varint,6,opt,name=event_nonce,json=eventNonce,proto3" json:"event_nonce,omitempty"`
+	// the Injective block at which the deposit was queued
+	QueuedAtBlock uint64 `protobuf:"varint,7,opt,name=queued_at_block,json=queuedAtBlock,proto3" json:"queued_at_block,omitempty"`
+	// true if the deposit is not released by the sliding window, because it
+	// alone exceeds the inbound limits of its token or its release failed. It
+	// waits for a Peggy admin to release it and doesn't hold back newer deposits
+	ManualRelease bool `protobuf:"varint,8,opt,name=manual_release,json=manualRelease,proto3" json:"manual_release,omitempty"`
+}
+
+func (m *QueuedDeposit) Reset()         { *m = QueuedDeposit{} }
+func (m *QueuedDeposit) String() string { return proto.CompactTextString(m) }
+func (*QueuedDeposit) ProtoMessage()    {}
+func (*QueuedDeposit) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f5e4b49160131e74, []int{4}
+}
+func (m *QueuedDeposit) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueuedDeposit) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueuedDeposit.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueuedDeposit) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueuedDeposit.Merge(m, src)
+}
+func (m *QueuedDeposit) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueuedDeposit) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueuedDeposit.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueuedDeposit proto.InternalMessageInfo
+
+func (m *QueuedDeposit) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *QueuedDeposit) GetTokenContract() string {
+	if m != nil {
+		return m.TokenContract
+	}
+	return ""
+}
+
+func (m *QueuedDeposit) GetEthereumSender() string {
+	if m != nil {
+		return m.EthereumSender
+	}
+	return ""
+}
+
+func (m *QueuedDeposit) GetCosmosReceiver() string {
+	if m != nil {
+		return m.CosmosReceiver
+	}
+	return ""
+}
+
+func (m *QueuedDeposit) GetEventNonce() uint64 {
+	if m != nil {
+		return m.EventNonce
+	}
+	return 0
+}
+
+func (m *QueuedDeposit) GetQueuedAtBlock() uint64 {
+	if m != nil {
+		return m.QueuedAtBlock
+	}
+	return 0
+}
+
+func (m *QueuedDeposit) GetManualRelease() bool {
+	if m != nil {
+		return m.ManualRelease
+	}
+	return false
+}
+
 func init() {
+	proto.RegisterEnum("injective.peggy.v1.MissingPriceBehaviour", MissingPriceBehaviour_name, MissingPriceBehaviour_value)
 	proto.RegisterType((*RateLimit)(nil), "injective.peggy.v1.RateLimit")
+	proto.RegisterType((*PriceSource)(nil), "injective.peggy.v1.PriceSource")
+	proto.RegisterType((*RateLimitPriceConfig)(nil), "injective.peggy.v1.RateLimitPriceConfig")
 	proto.RegisterType((*BridgeTransfer)(nil), "injective.peggy.v1.BridgeTransfer")
+	proto.RegisterType((*QueuedDeposit)(nil), "injective.peggy.v1.QueuedDeposit")
 }
 
 func init() {
@@ -176,36 +534,66 @@ func init() {
 }
 
 var fileDescriptor_f5e4b49160131e74 = []byte{
-	// 463 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x8b, 0x13, 0x31,
-	0x14, 0xc7, 0x3b, 0xb6, 0x56, 0x9b, 0xed, 0x56, 0x36, 0x2a, 0x0c, 0xca, 0xce, 0xd6, 0x56, 0xa1,
-	0x08, 0xce, 0xb0, 0x8a, 0x77, 0x2d, 0xbd, 0x14, 0xbb, 0x22, 0x83, 0x22, 0x78, 0x19, 0x32, 0xc9,
-	0x73, 0x1a, 0xdb, 0x24, 0x43, 0x92, 0xe9, 0xd2, 0x0f, 0xe0, 0xc1, 0x9b, 0x1f, 0x6b, 0x8f, 0x7b,
-	0x14, 0x0f, 0x8b, 0xb4, 0x5f, 0x44, 0x9a, 0x99, 0x76, 0x2b, 0x7b, 0xf0, 0x36, 0xf3, 0xcb, 0xef,
-	0xfd, 0x79, 0xc9, 0x7b, 0xa8, 0xcf, 0xe5, 0x37, 0xa0, 0x96, 0x2f, 0x20, 0xca, 0x21, 0xcb, 0x96,
-	0xd1, 0xe2, 0x34, 0xd2, 0xc4, 0x42, 0x32, 0xe7, 0x82, 0xdb, 0x30, 0xd7, 0xca, 0x2a, 0x8c, 0x77,
-	0x52, 0xe8, 0xa4, 0x70, 0x71, 0xfa, 0xe8, 0x41, 0xa6, 0x32, 0xe5, 0x8e, 0xa3, 0xcd, 0x57, 0x69,
-	0xf6, 0xbe, 0xd7, 0x51, 0x2b, 0x26, 0x16, 0x26, 0x9b, 0x6a, 0xdc, 0x47, 0x87, 0x56, 0xcd, 0x40,
-	0x26, 0x84, 0x31, 0x0d, 0xc6, 0xf8, 0x5e, 0xd7, 0x1b, 0xb4, 0xe2, 0xb6, 0x83, 0x6f, 0x4b, 0x86,
-	0x9f, 0xa1, 0x4e, 0x29, 0x31, 0xa0, 0x5c, 0x90, 0xb9, 0xf1, 0x6f, 0x75, 0xbd, 0xc1, 0x61, 0x5c,
-	0x96, 0x8e, 0x2a, 0x88, 0x9f, 0x6e, 0xb5, 0x5c, 0x73, 0x0a, 0x09, 0x67, 0x7e, 0x7d, 0x2f, 0xec,
-	0xc3, 0x06, 0x8e, 0x19, 0x7e, 0x8e, 0x8e, 0xae, 0xbb, 0x4f, 0xce, 0xb9, 0x64, 0xea, 0xdc, 0x6f,
-	0x74, 0xbd, 0x41, 0x23, 0xbe, 0xa7, 0xb7, 0x7d, 0x7d, 0x76, 0x18, 0x8f, 0x51, 0x67, 0xcf, 0x2d,
-	0x0c, 0xf3, 0x6f, 0x6f, 0x12, 0x87, 0xfd, 0x8b, 0xab, 0x93, 0xda, 0xef, 0xab, 0x93, 0xc7, 0x54,
-	0x19, 0xa1, 0x8c, 0x61, 0xb3, 0x90, 0xab, 0x48, 0x10, 0x3b, 0x0d, 0x27, 0x90, 0x11, 0xba, 0x1c,
-	0x01, 0x8d, 0xdb, 0xbb, 0xb4, 0x4f, 0x86, 0xe1, 0x33, 0x74, 0x9f, 0xa4, 0x46, 0xcd, 0x0b, 0x0b,
-	0x89, 0xe0, 0xd2, 0x96, 0x99, 0x7e, 0xd3, 0xe5, 0x1d, 0x57, 0x79, 0x0f, 0x6f, 0xe6, 0x8d, 0xa5,
-	0x8d, 0x8f, 0xb6, 0x95, 0x67, 0x5c, 0xda, 0xf2, 0xdd, 0xde, 0xa0, 0x96, 0xd5, 0x44, 0x9a, 0xaf,
-	0xa0, 0x8d, 0x7f, 0xa7, 0x5b, 0x1f, 0x1c, 0xbc, 0xec, 0x85, 0x37, 0x67, 0x10, 0x0e, 0x35, 0x67,
-	0x19, 0x7c, 0xac, 0xd4, 0xf8, 0xba, 0xa8, 0xf7, 0xc3, 0x43, 0x9d, 0x7f, 0x4f, 0xf1, 0x6b, 0xd4,
-	0x24, 0x42, 0x15, 0xd2, 0x96, 0x53, 0xf8, 0x5f, 0x5b, 0x95, 0x8c, 0x9f, 0xa0, 0x76, 0x3a, 0x57,
-	0x74, 0x96, 0xc8, 0x42, 0xa4, 0xa0, 0xdd, 0x70, 0x1a, 0xf1, 0x81, 0x63, 0xef, 0x1d, 0xc2, 0xc7,
-	0x08, 0x71, 0x93, 0x30, 0xc8, 0x95, 0xe1, 0xd6, 0x8d, 0xe5, 0x6e, 0xdc, 0xe2, 0x66, 0x54, 0x82,
-	0x21, 0x5c, 0xac, 0x02, 0xef, 0x72, 0x15, 0x78, 0x7f, 0x56, 0x81, 0xf7, 0x73, 0x1d, 0xd4, 0x2e,
-	0xd7, 0x41, 0xed, 0xd7, 0x3a, 0xa8, 0x7d, 0x79, 0x97, 0x71, 0x3b, 0x2d, 0xd2, 0x90, 0x2a, 0x11,
-	0x8d, 0xb7, 0xd7, 0x9b, 0x90, 0xd4, 0x44, 0xbb, 0xcb, 0xbe, 0xa0, 0x4a, 0xc3, 0xfe, 0xef, 0x94,
-	0x70, 0x19, 0x09, 0xc5, 0x8a, 0x39, 0x98, 0x6a, 0x65, 0xed, 0x32, 0x07, 0x93, 0x36, 0xdd, 0x06,
-	0xbe, 0xfa, 0x1b, 0x00, 0x00, 0xff, 0xff, 0x98, 0x80, 0xb9, 0xad, 0xd2, 0x02, 0x00, 0x00,
+	// 944 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0xcd, 0x6e, 0xdb, 0x46,
+	0x17, 0x35, 0x25, 0xc5, 0xb6, 0x46, 0x7f, 0xf6, 0xd8, 0x82, 0x09, 0xe7, 0x8b, 0xa4, 0x4f, 0xee,
+	0x8f, 0x1a, 0xa0, 0x12, 0xe2, 0xa2, 0xab, 0x6e, 0x6a, 0xc5, 0x1b, 0x23, 0x76, 0xd2, 0x8e, 0x1d,
+	0x14, 0xe8, 0x86, 0x1d, 0x91, 0xd7, 0xd4, 0x54, 0xe4, 0x0c, 0x33, 0x33, 0x94, 0xe3, 0xb7, 0xe8,
+	0x5b, 0xf4, 0x0d, 0xba, 0xed, 0x36, 0xcb, 0x2c, 0x8b, 0x2e, 0x82, 0xc2, 0x5e, 0xf7, 0x1d, 0x0a,
+	0x0e, 0x49, 0x49, 0xb6, 0xbc, 0x50, 0x81, 0x66, 0xc7, 0x39, 0x73, 0xee, 0x21, 0xef, 0x9d, 0x73,
+	0xef, 0x10, 0x1d, 0x30, 0xfe, 0x33, 0xb8, 0x9a, 0x4d, 0x61, 0x10, 0x81, 0xef, 0x5f, 0x0f, 0xa6,
+	0xcf, 0x06, 0x92, 0x6a, 0x70, 0x02, 0x16, 0x32, 0xdd, 0x8f, 0xa4, 0xd0, 0x02, 0xe3, 0x19, 0xa9,
+	0x6f, 0x48, 0xfd, 0xe9, 0xb3, 0xfd, 0x5d, 0x5f, 0xf8, 0xc2, 0x6c, 0x0f, 0x92, 0xa7, 0x94, 0xd9,
+	0xfd, 0x7b, 0x03, 0x95, 0x09, 0xd5, 0x70, 0x9a, 0x44, 0xe3, 0x03, 0x54, 0xd3, 0x62, 0x02, 0xdc,
+	0xa1, 0x9e, 0x27, 0x41, 0x29, 0xdb, 0xea, 0x58, 0xbd, 0x32, 0xa9, 0x1a, 0xf0, 0x28, 0xc5, 0xf0,
+	0xa7, 0xa8, 0x9e, 0x92, 0x3c, 0x70, 0x59, 0x48, 0x03, 0x65, 0x17, 0x3a, 0x56, 0xaf, 0x46, 0xd2,
+	0xd0, 0xe3, 0x0c, 0xc4, 0x9f, 0xe4, 0xb4, 0x48, 0x32, 0x17, 0x1c, 0xe6, 0xd9, 0xc5, 0x05, 0xb1,
+	0xef, 0x12, 0xf0, 0xc4, 0xc3, 0x4f, 0xd1, 0xf6, 0xfc, 0xeb, 0x9d, 0x2b, 0xc6, 0x3d, 0x71, 0x65,
+	0x97, 0x3a, 0x56, 0xaf, 0x44, 0x1a, 0x32, 0xff, 0xae, 0x1f, 0x0c, 0x8c, 0x4f, 0x50, 0x7d, 0x81,
+	0x1b, 0x2b, 0xcf, 0x7e, 0x94, 0x28, 0x0e, 0x0f, 0xde, 0x7d, 0x68, 0xaf, 0xfd, 0xf9, 0xa1, 0xfd,
+	0xd8, 0x15, 0x2a, 0x14, 0x4a, 0x79, 0x93, 0x3e, 0x13, 0x83, 0x90, 0xea, 0x71, 0xff, 0x14, 0x7c,
+	0xea, 0x5e, 0x1f, 0x83, 0x4b, 0xaa, 0x33, 0xb5, 0xd7, 0xca, 0xc3, 0x67, 0x68, 0x87, 0x8e, 0x94,
+	0x08, 0x62, 0x0d, 0x4e, 0xc8, 0xb8, 0x4e, 0x35, 0xed, 0x75, 0xa3, 0xf7, 0x24, 0xd3, 0x6b, 0x2e,
+	0xeb, 0x9d, 0x70, 0x4d, 0xb6, 0xf3, 0xc8, 0x33, 0xc6, 0x75, 0x5a, 0xb7, 0x6f, 0x51, 0x59, 0x4b,
+	0xca, 0xd5, 0x25, 0x48, 0x65, 0x6f, 0x74, 0x8a, 0xbd, 0xca, 0x61, 0xb7, 0xbf, 0x7c, 0x06, 0xfd,
+	0xa1, 0x64, 0x9e, 0x0f, 0x17, 0x19, 0x95, 0xcc, 0x83, 0xf0, 0x2b, 0xb4, 0xed, 0x41, 0x24, 0x14,
+	0xd3, 0x0b, 0xe9, 0x6d, 0xae, 0x9e, 0x5e, 0x23, 0x8b, 0x9e, 0x65, 0xc8, 0x50, 0x3b, 0x02, 0xe9,
+	0x48, 0x70, 0x59, 0xc4, 0x80, 0x6b, 0x67, 0x59, 0xbe, 0xbc, 0xba, 0xfc, 0xe3, 0x08, 0x24, 0xc9,
+	0xa5, 0x8e, 0xef, 0xbd, 0x6a, 0x88, 0xaa, 0xe9, 0x19, 0x2b, 0x11, 0x4b, 0x17, 0x6c, 0xd4, 0xb1,
+	0x7a, 0x95, 0xc3, 0xf6, 0x43, 0x05, 0x30, 0xc7, 0x7e, 0x6e, 0x68, 0xa4, 0x12, 0xcd, 0x17, 0xf8,
+	0x1c, 0x35, 0x2f, 0x69, 0x10, 0x8c, 0xa8, 0x3b, 0x71, 0xee, 0x88, 0x55, 0x56, 0x13, 0xdb, 0xc9,
+	0xa3, 0x17, 0x40, 0x4c, 0xd1, 0x5e, 0xc8, 0x94, 0x62, 0xdc, 0xcf, 0x34, 0x47, 0x30, 0xa6, 0x53,
+	0x26, 0x62, 0x69, 0x57, 0x3b, 0x56, 0xaf, 0x7e, 0xf8, 0xc5, 0x43, 0xb2, 0x67, 0x69, 0x88, 0x11,
+	0x1a, 0xe6, 0x01, 0xa4, 0x19, 0x3e, 0x04, 0xe3, 0x2e, 0xaa, 0x85, 0xf4, 0x6d, 0x26, 0x4f, 0x7d,
+	0xb0, 0x6b, 0xc6, 0xbb, 0x95, 0x90, 0xbe, 0x35, 0xcc, 0x23, 0x1f, 0xf0, 0x19, 0xda, 0x0a, 0xa8,
+	0xd2, 0xce, 0x84, 0x8b, 0xab, 0xac, 0x1d, 0xec, 0xfa, 0xea, 0xb5, 0xaf, 0x27, 0xc1, 0x2f, 0x92,
+	0x58, 0xa3, 0x88, 0xbf, 0x41, 0xfb, 0xf7, 0xe5, 0x1c, 0xcd, 0x42, 0x50, 0x9a, 0x86, 0x91, 0xdd,
+	0xe8, 0x58, 0xbd, 0x22, 0xd9, 0xbb, 0x1b, 0x73, 0x91, 0x6f, 0x77, 0x35, 0xaa, 0x2c, 0x56, 0xa8,
+	0x8d, 0x2a, 0x42, 0x52, 0x37, 0x00, 0x47, 0x5f, 0x47, 0x90, 0xb5, 0x3b, 0x4a, 0xa1, 0x8b, 0xeb,
+	0x08, 0x30, 0x46, 0xa5, 0x11, 0x55, 0x60, 0x5a, 0xbc, 0x4c, 0xcc, 0x33, 0xde, 0x45, 0x8f, 0xde,
+	0xc4, 0x42, 0x43, 0xd6, 0xd0, 0xe9, 0x02, 0xef, 0xa3, 0xcd, 0x48, 0x8a, 0x29, 0xf3, 0x40, 0x9a,
+	0x06, 0x2e, 0x93, 0xd9, 0xba, 0xfb, 0x5b, 0x01, 0xed, 0xce, 0xa6, 0x8c, 0x79, 0xff, 0x73, 0xc1,
+	0x2f, 0x99, 0xbf, 0x64, 0x1d, 0xeb, 0xbf, 0xb4, 0x4e, 0xe1, 0xe3, 0x58, 0xa7, 0xf8, 0xb1, 0xac,
+	0x53, 0x5a, 0xb2, 0x4e, 0xf7, 0x57, 0x0b, 0xd5, 0xef, 0x0e, 0x0d, 0xfc, 0x35, 0x5a, 0xa7, 0xa1,
+	0x88, 0xb9, 0xb6, 0xad, 0x55, 0xa6, 0x55, 0x46, 0xc6, 0xff, 0x47, 0xd5, 0x51, 0x20, 0xdc, 0x89,
+	0xc3, 0xe3, 0x70, 0x04, 0xd2, 0x14, 0xa7, 0x44, 0x2a, 0x06, 0x7b, 0x69, 0x20, 0xfc, 0x04, 0x21,
+	0xa6, 0xf2, 0x39, 0x61, 0xd2, 0xdc, 0x24, 0x65, 0xa6, 0xb2, 0x76, 0xc7, 0xff, 0x43, 0xe5, 0xd9,
+	0x34, 0xc9, 0x4e, 0x78, 0x0e, 0x74, 0x7f, 0x2f, 0xa0, 0xda, 0xf7, 0x31, 0xc4, 0xe0, 0xe5, 0xfc,
+	0x3a, 0x2a, 0x30, 0xcf, 0x7c, 0x64, 0x89, 0x14, 0x98, 0x37, 0xbf, 0x37, 0x5c, 0xc1, 0xb5, 0xa4,
+	0xae, 0xce, 0x4c, 0x95, 0xde, 0x1b, 0xcf, 0x33, 0x70, 0x21, 0xbf, 0xe2, 0xbf, 0xc9, 0xef, 0x73,
+	0xd4, 0x00, 0x3d, 0x06, 0x09, 0x71, 0xe8, 0x28, 0xe0, 0x73, 0x17, 0xd6, 0x73, 0xf8, 0xdc, 0xa0,
+	0x09, 0x31, 0x55, 0x4a, 0x66, 0x23, 0xb0, 0x29, 0xc8, 0xf4, 0x1a, 0x21, 0xf5, 0x14, 0x26, 0x19,
+	0x9a, 0xf4, 0x06, 0x4c, 0x93, 0xc9, 0xc9, 0x05, 0x77, 0xc1, 0xdc, 0x0d, 0x25, 0x82, 0x0c, 0xf4,
+	0x32, 0x41, 0xf0, 0x67, 0xa8, 0xf1, 0xc6, 0x64, 0xec, 0x50, 0xed, 0x98, 0x42, 0xda, 0x1b, 0x86,
+	0x54, 0x4b, 0xe1, 0x23, 0x3d, 0x4c, 0xc0, 0x24, 0xf1, 0x90, 0xf2, 0x98, 0x06, 0x8e, 0x84, 0x00,
+	0x92, 0x6e, 0xda, 0x34, 0xb5, 0xad, 0xa5, 0x28, 0x49, 0xc1, 0xa7, 0x3f, 0xa1, 0xe6, 0x83, 0xfe,
+	0xc1, 0x7b, 0x68, 0xc7, 0x08, 0xbd, 0xe2, 0x8b, 0xfb, 0x5b, 0x6b, 0xc9, 0xc6, 0x51, 0x10, 0x88,
+	0xab, 0x7b, 0x1b, 0x16, 0x6e, 0xa2, 0xed, 0xd7, 0x0a, 0x4e, 0xef, 0xcc, 0x80, 0xad, 0xc2, 0x10,
+	0xde, 0xdd, 0xb4, 0xac, 0xf7, 0x37, 0x2d, 0xeb, 0xaf, 0x9b, 0x96, 0xf5, 0xcb, 0x6d, 0x6b, 0xed,
+	0xfd, 0x6d, 0x6b, 0xed, 0x8f, 0xdb, 0xd6, 0xda, 0x8f, 0x2f, 0x7c, 0xa6, 0xc7, 0xf1, 0xa8, 0xef,
+	0x8a, 0x70, 0x70, 0x92, 0xfb, 0xfa, 0x94, 0x8e, 0xd4, 0x60, 0xe6, 0xf2, 0x2f, 0x5d, 0x21, 0x61,
+	0x71, 0x39, 0xa6, 0x8c, 0x0f, 0x42, 0xe1, 0xc5, 0x01, 0xa8, 0xec, 0x5f, 0x24, 0x19, 0x22, 0x6a,
+	0xb4, 0x6e, 0x7e, 0x2d, 0xbe, 0xfa, 0x67, 0x00, 0x6b, 0xea, 0xcd, 0x40, 0xab, 0x08, 0x00, 0x00,
 }
 
 func (m *RateLimit) Marshal() (dAtA []byte, err error) {
@@ -228,6 +616,75 @@ func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.LastKnownPriceTimestamp != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.LastKnownPriceTimestamp))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size := m.LastKnownPrice.Size()
+		i -= size
+		if _, err := m.LastKnownPrice.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x68
+	}
+	if m.MissingPriceBehaviour != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.MissingPriceBehaviour))
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.FallbackPriceSource != nil {
+		{
+			size, err := m.FallbackPriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRateLimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x5a
+	}
+	if m.PriceSource != nil {
+		{
+			size, err := m.PriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRateLimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x52
+	}
+	{
+		size := m.PerRecipientDepositLimitUsd.Size()
+		i -= size
+		if _, err := m.PerRecipientDepositLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.DepositLimitUsd.Size()
+		i -= size
+		if _, err := m.DepositLimitUsd.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
 	if len(m.Transfers) > 0 {
 		for iNdEx := len(m.Transfers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -289,7 +746,7 @@ func (m *RateLimit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *BridgeTransfer) Marshal() (dAtA []byte, err error) {
+func (m *PriceSource) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -299,51 +756,245 @@ func (m *BridgeTransfer) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *BridgeTransfer) MarshalTo(dAtA []byte) (int, error) {
+func (m *PriceSource) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *BridgeTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *PriceSource) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.IsDeposit {
+	if len(m.Provider) > 0 {
+		i -= len(m.Provider)
+		copy(dAtA[i:], m.Provider)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Provider)))
 		i--
-		if m.IsDeposit {
-			dAtA[i] = 1
-		} else {
-			dAtA[i] = 0
-		}
+		dAtA[i] = 0x22
+	}
+	if len(m.Quote) > 0 {
+		i -= len(m.Quote)
+		copy(dAtA[i:], m.Quote)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Quote)))
 		i--
-		dAtA[i] = 0x18
+		dAtA[i] = 0x1a
 	}
-	if m.BlockNumber != 0 {
-		i = encodeVarintRateLimit(dAtA, i, uint64(m.BlockNumber))
+	if len(m.Base) > 0 {
+		i -= len(m.Base)
+		copy(dAtA[i:], m.Base)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Base)))
 		i--
-		dAtA[i] = 0x10
+		dAtA[i] = 0x12
 	}
-	{
-		size := m.Amount.Size()
-		i -= size
-		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
-			return 0, err
-		}
-		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	if len(m.OracleType) > 0 {
+		i -= len(m.OracleType)
+		copy(dAtA[i:], m.OracleType)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.OracleType)))
+		i--
+		dAtA[i] = 0xa
 	}
-	i--
-	dAtA[i] = 0xa
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintRateLimit(dAtA []byte, offset int, v uint64) int {
-	offset -= sovRateLimit(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *RateLimitPriceConfig) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitPriceConfig) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitPriceConfig) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.MaxPriceAge != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.MaxPriceAge))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MissingPriceBehaviour != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.MissingPriceBehaviour))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.FallbackPriceSource != nil {
+		{
+			size, err := m.FallbackPriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRateLimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.PriceSource != nil {
+		{
+			size, err := m.PriceSource.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintRateLimit(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BridgeTransfer) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BridgeTransfer) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BridgeTransfer) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.IsDeposit {
+		i--
+		if m.IsDeposit {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.BlockNumber != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.BlockNumber))
+		i--
+		dAtA[i] = 0x10
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueuedDeposit) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueuedDeposit) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueuedDeposit) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ManualRelease {
+		i--
+		if m.ManualRelease {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.QueuedAtBlock != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.QueuedAtBlock))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.EventNonce != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.EventNonce))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.CosmosReceiver) > 0 {
+		i -= len(m.CosmosReceiver)
+		copy(dAtA[i:], m.CosmosReceiver)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.CosmosReceiver)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.EthereumSender) > 0 {
+		i -= len(m.EthereumSender)
+		copy(dAtA[i:], m.EthereumSender)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.EthereumSender)))
+		i--
+		dAtA[i] = 0x22
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintRateLimit(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.TokenContract) > 0 {
+		i -= len(m.TokenContract)
+		copy(dAtA[i:], m.TokenContract)
+		i = encodeVarintRateLimit(dAtA, i, uint64(len(m.TokenContract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintRateLimit(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRateLimit(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRateLimit(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
 	}
 	dAtA[offset] = uint8(v)
 	return base
@@ -378,33 +1029,966 @@ func (m *RateLimit) Size() (n int) {
 			n += 1 + l + sovRateLimit(uint64(l))
 		}
 	}
-	return n
-}
+	l = m.DepositLimitUsd.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = m.PerRecipientDepositLimitUsd.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	if m.PriceSource != nil {
+		l = m.PriceSource.Size()
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.FallbackPriceSource != nil {
+		l = m.FallbackPriceSource.Size()
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.MissingPriceBehaviour != 0 {
+		n += 1 + sovRateLimit(uint64(m.MissingPriceBehaviour))
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovRateLimit(uint64(m.MaxPriceAge))
+	}
+	l = m.LastKnownPrice.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	if m.LastKnownPriceTimestamp != 0 {
+		n += 1 + sovRateLimit(uint64(m.LastKnownPriceTimestamp))
+	}
+	return n
+}
+
+func (m *PriceSource) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.OracleType)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = len(m.Base)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = len(m.Quote)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = len(m.Provider)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitPriceConfig) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.PriceSource != nil {
+		l = m.PriceSource.Size()
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.FallbackPriceSource != nil {
+		l = m.FallbackPriceSource.Size()
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.MissingPriceBehaviour != 0 {
+		n += 1 + sovRateLimit(uint64(m.MissingPriceBehaviour))
+	}
+	if m.MaxPriceAge != 0 {
+		n += 1 + sovRateLimit(uint64(m.MaxPriceAge))
+	}
+	return n
+}
+
+func (m *BridgeTransfer) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	if m.BlockNumber != 0 {
+		n += 1 + sovRateLimit(uint64(m.BlockNumber))
+	}
+	if m.IsDeposit {
+		n += 2
+	}
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	return n
+}
+
+func (m *QueuedDeposit) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovRateLimit(uint64(m.Id))
+	}
+	l = len(m.TokenContract)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovRateLimit(uint64(l))
+	l = len(m.EthereumSender)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	l = len(m.CosmosReceiver)
+	if l > 0 {
+		n += 1 + l + sovRateLimit(uint64(l))
+	}
+	if m.EventNonce != 0 {
+		n += 1 + sovRateLimit(uint64(m.EventNonce))
+	}
+	if m.QueuedAtBlock != 0 {
+		n += 1 + sovRateLimit(uint64(m.QueuedAtBlock))
+	}
+	if m.ManualRelease {
+		n += 2
+	}
+	return n
+}
+
+func sovRateLimit(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRateLimit(x uint64) (n int) {
+	return sovRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RateLimit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenDecimals", wireType)
+			}
+			m.TokenDecimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TokenDecimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenPriceId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TokenPriceId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+			}
+			m.RateLimitWindow = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RateLimitWindow |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteMintLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AbsoluteMintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Transfers = append(m.Transfers, &BridgeTransfer{})
+			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DepositLimitUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.DepositLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PerRecipientDepositLimitUsd", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PerRecipientDepositLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceSource == nil {
+				m.PriceSource = &PriceSource{}
+			}
+			if err := m.PriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FallbackPriceSource == nil {
+				m.FallbackPriceSource = &PriceSource{}
+			}
+			if err := m.FallbackPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPriceBehaviour", wireType)
+			}
+			m.MissingPriceBehaviour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingPriceBehaviour |= MissingPriceBehaviour(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKnownPrice", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.LastKnownPrice.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastKnownPriceTimestamp", wireType)
+			}
+			m.LastKnownPriceTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastKnownPriceTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PriceSource) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PriceSource: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PriceSource: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Base", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Base = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Quote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Provider", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Provider = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RateLimitPriceConfig) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RateLimitPriceConfig: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RateLimitPriceConfig: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.PriceSource == nil {
+				m.PriceSource = &PriceSource{}
+			}
+			if err := m.PriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FallbackPriceSource", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.FallbackPriceSource == nil {
+				m.FallbackPriceSource = &PriceSource{}
+			}
+			if err := m.FallbackPriceSource.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MissingPriceBehaviour", wireType)
+			}
+			m.MissingPriceBehaviour = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MissingPriceBehaviour |= MissingPriceBehaviour(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxPriceAge", wireType)
+			}
+			m.MaxPriceAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxPriceAge |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *BridgeTransfer) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Amount.Size()
-	n += 1 + l + sovRateLimit(uint64(l))
-	if m.BlockNumber != 0 {
-		n += 1 + sovRateLimit(uint64(m.BlockNumber))
-	}
-	if m.IsDeposit {
-		n += 2
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovRateLimit(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozRateLimit(x uint64) (n int) {
-	return sovRateLimit(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *RateLimit) Unmarshal(dAtA []byte) error {
+func (m *BridgeTransfer) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -427,15 +2011,15 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RateLimit: wiretype end group for non-group")
+			return fmt.Errorf("proto: BridgeTransfer: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RateLimit: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: BridgeTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -463,13 +2047,15 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenDecimals", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
 			}
-			m.TokenDecimals = 0
+			m.BlockNumber = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimit
@@ -479,14 +2065,34 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.TokenDecimals |= uint32(b&0x7F) << shift
+				m.BlockNumber |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDeposit", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDeposit = bool(v != 0)
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field TokenPriceId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -514,13 +2120,63 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.TokenPriceId = string(dAtA[iNdEx:postIndex])
+			m.Recipient = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 4:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRateLimit(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRateLimit
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueuedDeposit) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRateLimit
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueuedDeposit: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueuedDeposit: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitWindow", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
 			}
-			m.RateLimitWindow = 0
+			m.Id = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimit
@@ -530,14 +2186,14 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RateLimitWindow |= uint64(b&0x7F) << shift
+				m.Id |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RateLimitUsd", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field TokenContract", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -565,13 +2221,11 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.RateLimitUsd.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.TokenContract = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field AbsoluteMintLimit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -599,15 +2253,15 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.AbsoluteMintLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 7:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Transfers", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EthereumSender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimit
@@ -617,79 +2271,27 @@ func (m *RateLimit) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthRateLimit
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthRateLimit
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Transfers = append(m.Transfers, &BridgeTransfer{})
-			if err := m.Transfers[len(m.Transfers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.EthereumSender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipRateLimit(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthRateLimit
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *BridgeTransfer) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowRateLimit
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: BridgeTransfer: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: BridgeTransfer: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CosmosReceiver", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -717,15 +2319,13 @@ func (m *BridgeTransfer) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.CosmosReceiver = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 6:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BlockNumber", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field EventNonce", wireType)
 			}
-			m.BlockNumber = 0
+			m.EventNonce = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowRateLimit
@@ -735,14 +2335,33 @@ func (m *BridgeTransfer) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BlockNumber |= uint64(b&0x7F) << shift
+				m.EventNonce |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
+		case 7:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDeposit", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field QueuedAtBlock", wireType)
+			}
+			m.QueuedAtBlock = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRateLimit
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.QueuedAtBlock |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ManualRelease", wireType)
 			}
 			var v int
 			for shift := uint(0); ; shift += 7 {
@@ -759,7 +2378,7 @@ func (m *BridgeTransfer) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-			m.IsDeposit = bool(v != 0)
+			m.ManualRelease = bool(v != 0)
 		default:
 			iNdEx = preIndex
 			skippy, err := skipRateLimit(dAtA[iNdEx:])
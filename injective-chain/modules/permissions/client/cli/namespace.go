@@ -0,0 +1,228 @@
+package cli
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	cliflags "github.com/InjectiveLabs/injective-core/cli/flags"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// ExportNamespaceCmd prints the full namespace of a denom as JSON, in the format accepted by create-namespace
+func ExportNamespaceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "export-namespace <denom>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Export the namespace of a denom to json",
+		Long: `Export the namespace of a denom, including its roles, actor roles, role managers, policy statuses,
+policy manager capabilities, role limits and contract hooks, to json. The output can be used to re-create the namespace
+with create-namespace or compared with the chain state with diff-namespace.`,
+		Example: `injectived query permissions export-namespace factory/inj1.../mytoken > namespace.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			ns, err := queryNamespace(clientCtx, args[0])
+			if err != nil {
+				return err
+			}
+
+			bz, err := json.MarshalIndent(ns, "", "  ")
+			if err != nil {
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), string(bz))
+			return err
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// DiffNamespaceCmd prints the changes between the namespace on chain and the namespace of a json file
+func DiffNamespaceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "diff-namespace <namespace.json>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Compare the namespace of a json file with the namespace on chain",
+		Long: `Compare the namespace of a json file, in the format of export-namespace, with the namespace of the same denom
+on chain. Entries only on chain are prefixed with "-", entries only in the file with "+" and changed entries with "~".`,
+		Example: `injectived query permissions diff-namespace namespace.json`,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			desired, err := readNamespaceFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			current, err := queryNamespace(clientCtx, desired.Denom)
+			if err != nil {
+				return err
+			}
+
+			diff := diffNamespaces(current, desired)
+			if len(diff) == 0 {
+				_, err = fmt.Fprintf(cmd.OutOrStdout(), "namespace %s is up to date\n", desired.Denom)
+				return err
+			}
+
+			_, err = fmt.Fprintln(cmd.OutOrStdout(), strings.Join(diff, "\n"))
+			return err
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func queryNamespace(clientCtx client.Context, denom string) (*types.Namespace, error) {
+	queryClient := types.NewQueryClient(clientCtx)
+	res, err := queryClient.Namespace(context.Background(), &types.QueryNamespaceRequest{Denom: denom})
+	if err != nil {
+		return nil, err
+	}
+
+	if res.Namespace == nil {
+		return nil, types.ErrUnknownDenom.Wrapf("denom %s", denom)
+	}
+
+	return res.Namespace, nil
+}
+
+func readNamespaceFile(path string) (*types.Namespace, error) {
+	file, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var ns types.Namespace
+	if err := json.Unmarshal(file, &ns); err != nil {
+		return nil, err
+	}
+
+	return &ns, nil
+}
+
+// diffNamespaces returns the changes to apply to the current namespace to get the desired one, one line per change
+func diffNamespaces(current, desired *types.Namespace) []string {
+	diff := make([]string, 0)
+
+	diff = append(diff, diffEntries("wasm_hook", []string{current.WasmHook}, []string{desired.WasmHook}, nonEmptyKey, identity)...)
+	diff = append(diff, diffEntries("evm_hook", []string{current.EvmHook}, []string{desired.EvmHook}, nonEmptyKey, identity)...)
+	diff = append(diff, diffEntries(
+		"voucher_expiry_seconds",
+		[]int64{current.VoucherExpirySeconds}, []int64{desired.VoucherExpirySeconds},
+		func(int64) string { return "" }, func(v int64) string { return strconv.FormatInt(v, 10) },
+	)...)
+
+	diff = append(diff, diffEntries("role", current.RolePermissions, desired.RolePermissions,
+		func(r *types.Role) string { return r.Name },
+		func(r *types.Role) string { return fmt.Sprintf("role_id=%d permissions=%d", r.RoleId, r.Permissions) },
+	)...)
+	diff = append(diff, diffEntries("actor_roles", current.ActorRoles, desired.ActorRoles,
+		func(a *types.ActorRoles) string { return a.Actor },
+		func(a *types.ActorRoles) string { return sortedJoin(a.Roles) },
+	)...)
+	diff = append(diff, diffEntries("actor_role_expiration", current.ActorRoleExpirations, desired.ActorRoleExpirations,
+		func(e *types.ActorRoleExpiration) string { return e.Actor + " " + e.Role },
+		func(e *types.ActorRoleExpiration) string { return strconv.FormatInt(e.ExpirationTimestamp, 10) },
+	)...)
+	diff = append(diff, diffEntries("role_limits", current.RoleLimits, desired.RoleLimits,
+		func(l *types.RoleLimits) string { return l.Role },
+		func(l *types.RoleLimits) string {
+			return fmt.Sprintf("send_limit=%v receive_limit=%v window_seconds=%d max_balance=%v", l.SendLimit, l.ReceiveLimit, l.WindowSeconds, l.MaxBalance)
+		},
+	)...)
+	diff = append(diff, diffEntries("role_manager", current.RoleManagers, desired.RoleManagers,
+		func(m *types.RoleManager) string { return m.Manager },
+		func(m *types.RoleManager) string { return sortedJoin(m.Roles) },
+	)...)
+	diff = append(diff, diffEntries("policy_status", current.PolicyStatuses, desired.PolicyStatuses,
+		func(p *types.PolicyStatus) string { return p.Action.String() },
+		func(p *types.PolicyStatus) string {
+			return fmt.Sprintf("is_disabled=%t is_sealed=%t", p.IsDisabled, p.IsSealed)
+		},
+	)...)
+	diff = append(diff, diffEntries("policy_manager_capability", current.PolicyManagerCapabilities, desired.PolicyManagerCapabilities,
+		func(c *types.PolicyManagerCapability) string { return c.Manager + " " + c.Action.String() },
+		func(c *types.PolicyManagerCapability) string {
+			return fmt.Sprintf("can_disable=%t can_seal=%t", c.CanDisable, c.CanSeal)
+		},
+	)...)
+
+	return diff
+}
+
+// diffEntries compares the entries of a namespace section by key, in key order
+func diffEntries[T any](section string, current, desired []T, key, value func(T) string) []string {
+	currentValues := make(map[string]string, len(current))
+	for _, entry := range current {
+		currentValues[key(entry)] = value(entry)
+	}
+
+	desiredValues := make(map[string]string, len(desired))
+	for _, entry := range desired {
+		desiredValues[key(entry)] = value(entry)
+	}
+
+	keys := make([]string, 0, len(currentValues)+len(desiredValues))
+	for k := range currentValues {
+		keys = append(keys, k)
+	}
+	for k := range desiredValues {
+		if _, ok := currentValues[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	diff := make([]string, 0)
+	for _, k := range keys {
+		currentValue, inCurrent := currentValues[k]
+		desiredValue, inDesired := desiredValues[k]
+		name := strings.TrimSpace(section + " " + k)
+
+		switch {
+		case !inDesired:
+			diff = append(diff, fmt.Sprintf("- %s: %s", name, currentValue))
+		case !inCurrent:
+			diff = append(diff, fmt.Sprintf("+ %s: %s", name, desiredValue))
+		case currentValue != desiredValue:
+			diff = append(diff, fmt.Sprintf("~ %s: %s -> %s", name, currentValue, desiredValue))
+		}
+	}
+
+	return diff
+}
+
+func sortedJoin(values []string) string {
+	sorted := slices.Clone(values)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
+
+// nonEmptyKey keys the scalar fields of a namespace, so that an unset field is reported as added or removed
+func nonEmptyKey(v string) string {
+	if v == "" {
+		return "unset"
+	}
+	return ""
+}
+
+func identity(v string) string { return v }
@@ -18,6 +18,15 @@ func GetQueryCmd() *cobra.Command {
 		GetNamespaceRoleActors(),
 		GetNamespaceAddressRoles(),
 		GetVouchersForAddress(),
+		GetExpiringActorRoles(),
+		GetVoucherOrigins(),
+		GetFrozenBalances(),
+		GetFrozenBalance(),
+		GetRoleLimits(),
+		GetActorTransferUsage(),
+		GetSimulateTransfer(),
+		ExportNamespaceCmd(),
+		DiffNamespaceCmd(),
 	)
 
 	return cmd
@@ -70,3 +79,59 @@ func GetVouchersForAddress() *cobra.Command {
 		&types.QueryVouchersRequest{}, nil, nil,
 	)
 }
+
+func GetExpiringActorRoles() *cobra.Command {
+	return cli.QueryCmd("expiring-roles <denom> <within_seconds>",
+		"Returns the actor role assignments in denom's namespace expiring within the given number of seconds",
+		types.NewQueryClient,
+		&types.QueryExpiringActorRolesRequest{}, nil, nil,
+	)
+}
+
+func GetRoleLimits() *cobra.Command {
+	return cli.QueryCmd("role-limits <denom>",
+		"Returns the transfer limits of the roles in denom's namespace",
+		types.NewQueryClient,
+		&types.QueryRoleLimitsRequest{}, nil, nil,
+	)
+}
+
+func GetActorTransferUsage() *cobra.Command {
+	return cli.QueryCmd("transfer-usage <denom> <actor>",
+		"Returns the amounts an actor sent and received within the windows of its limited roles in denom's namespace",
+		types.NewQueryClient,
+		&types.QueryActorTransferUsageRequest{}, nil, nil,
+	)
+}
+
+func GetSimulateTransfer() *cobra.Command {
+	return cli.QueryCmd("simulate-transfer <denom> <from> <to> <amount>",
+		"Returns whether a transfer of denom would pass the namespace restrictions and which check would fail",
+		types.NewQueryClient,
+		&types.QuerySimulateTransferRequest{}, nil, nil,
+	)
+}
+
+func GetVoucherOrigins() *cobra.Command {
+	return cli.QueryCmd("voucher-origins <denom> <address>",
+		"Returns the original senders of an address's voucher for denom",
+		types.NewQueryClient,
+		&types.QueryVoucherOriginsRequest{}, nil, nil,
+	)
+}
+
+func GetFrozenBalances() *cobra.Command {
+	return cli.QueryCmd("frozen-balances <denom>",
+		"Returns the frozen balances in denom's namespace",
+		types.NewQueryClient,
+		&types.QueryFrozenBalancesRequest{}, nil, nil,
+	)
+}
+
+func GetFrozenBalance() *cobra.Command {
+	return cli.QueryCmd("frozen-balance <denom> <address>",
+		"Returns the frozen and spendable balance of an address",
+		types.NewQueryClient,
+		&types.QueryFrozenBalanceRequest{}, nil, nil,
+	)
+}
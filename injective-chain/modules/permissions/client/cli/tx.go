@@ -13,6 +13,8 @@ import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
 )
 
+const FlagDenom = "denom"
+
 func GetTxCmd() *cobra.Command {
 	cmd := cli.ModuleRootCommand(types.ModuleName, false)
 
@@ -21,6 +23,11 @@ func GetTxCmd() *cobra.Command {
 		UpdateNamespaceCmd(),
 		UpdateNamespaceRolesCmd(),
 		ClaimVoucherCmd(),
+		UpdateRoleLimitsCmd(),
+		ClaimVouchersCmd(),
+		ReturnExpiredVouchersCmd(),
+		ReclaimVoucherCmd(),
+		SetFrozenBalanceCmd(),
 	)
 
 	return cmd
@@ -59,6 +66,9 @@ func CreateNamespaceCmd() *cobra.Command {
 					},
 				]
 			}
+
+		The output of export-namespace can be used as namespace.json, with --denom to re-create the namespace
+		under another denom.
 		`,
 
 		RunE: func(cmd *cobra.Command, args []string) error {
@@ -77,6 +87,15 @@ func CreateNamespaceCmd() *cobra.Command {
 				return err
 			}
 
+			denom, err := cmd.Flags().GetString(FlagDenom)
+			if err != nil {
+				return err
+			}
+
+			if denom != "" {
+				ns.Denom = denom
+			}
+
 			msg := &types.MsgCreateNamespace{
 				Sender:    clientCtx.GetFromAddress().String(),
 				Namespace: ns,
@@ -89,6 +108,7 @@ func CreateNamespaceCmd() *cobra.Command {
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
+	cmd.Flags().String(FlagDenom, "", "Create the namespace for this denom instead of the denom of the json file")
 	cliflags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
@@ -203,3 +223,107 @@ func ClaimVoucherCmd() *cobra.Command {
 
 	return cmd
 }
+
+func UpdateRoleLimitsCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-role-limits <role-limits.json>",
+		Args:  cobra.ExactArgs(1),
+		Short: "Update the transfer limits of namespace roles",
+		Long: `Update the transfer limits of namespace roles. Limits without any non-zero value are removed.
+
+		Example:
+		$ %s tx permissions update-role-limits role-limits.json \
+
+			Where role-limits.json contains:
+			{
+				"denom": "inj",
+				"role_limits": [
+					{
+						"role": "retail",
+						"send_limit": "1000000000",
+						"receive_limit": "0",
+						"window_seconds": 86400,
+						"max_balance": "5000000000"
+					}
+				]
+			}
+		`,
+
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			file, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := &types.MsgUpdateRoleLimits{}
+			if err := json.Unmarshal(file, msg); err != nil {
+				return err
+			}
+
+			msg.Sender = clientCtx.GetFromAddress().String()
+
+			if err := msg.ValidateBasic(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cliflags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func ClaimVouchersCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"claim-vouchers <denoms>",
+		"Claims the vouchers of the sender for multiple denoms",
+		&types.MsgClaimVouchers{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions claim-vouchers factory/inj1.../a,factory/inj1.../b`
+
+	return cmd
+}
+
+func ReturnExpiredVouchersCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"return-expired-vouchers <denom> <address>",
+		"Returns the expired parts of an address's voucher to their original senders",
+		&types.MsgReturnExpiredVouchers{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions return-expired-vouchers factory/inj1.../a inj1...`
+
+	return cmd
+}
+
+func ReclaimVoucherCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"reclaim-voucher <denom> <address>",
+		"Reclaims the voucher of an address to the sender, requires the RECLAIM_VOUCHERS permission",
+		&types.MsgReclaimVoucher{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions reclaim-voucher factory/inj1.../a inj1...`
+
+	return cmd
+}
+
+func SetFrozenBalanceCmd() *cobra.Command {
+	cmd := cli.TxCmd(
+		"set-frozen-balance <address> <amount>",
+		"Sets the frozen amount of an address's balance, requires the FREEZE_BALANCES permission. A zero amount unfreezes the balance",
+		&types.MsgSetFrozenBalance{}, nil, nil,
+	)
+
+	cmd.Example = `injectived tx permissions set-frozen-balance inj1... 1000factory/inj1.../a`
+
+	return cmd
+}
@@ -0,0 +1,207 @@
+package keeper
+
+import (
+	"slices"
+
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// maxExpiredActorRolesPrunedPerBlock bounds the work done by the BeginBlocker sweep. Expired assignments
+// left over are ignored by permission checks until they are pruned in one of the next blocks.
+const maxExpiredActorRolesPrunedPerBlock = 1000
+
+// GetActorRoleExpiration returns the expiration timestamp of the actor's role assignment, 0 if it never expires
+func (k Keeper) GetActorRoleExpiration(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32) int64 {
+	store := k.getActorRoleExpirationsStore(ctx, denom)
+	bz := store.Get(getActorRoleExpirationKey(actor, roleID))
+	if len(bz) == 0 {
+		return 0
+	}
+
+	return int64(sdk.BigEndianToUint64(bz))
+}
+
+// isActorRoleExpired returns true if the actor's role assignment has an expiration that is not after the block time
+func (k Keeper) isActorRoleExpired(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32) bool {
+	expiration := k.GetActorRoleExpiration(ctx, denom, actor, roleID)
+	return expiration != 0 && expiration <= ctx.BlockTime().Unix()
+}
+
+// getActiveActorRoleIDs returns the assigned role ids for this address, skipping expired assignments that have not been pruned yet
+func (k Keeper) getActiveActorRoleIDs(ctx sdk.Context, denom string, actor sdk.AccAddress) ([]uint32, error) {
+	roleIDs, err := k.GetActorRoleIDs(ctx, denom, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return slices.DeleteFunc(roleIDs, func(roleID uint32) bool {
+		return k.isActorRoleExpired(ctx, denom, actor, roleID)
+	}), nil
+}
+
+// setActorRoleExpiration sets the expiration of the actor's role assignment. An expiration of 0 removes it.
+func (k Keeper) setActorRoleExpiration(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32, expiration int64) {
+	k.deleteActorRoleExpiration(ctx, denom, actor, roleID)
+
+	if expiration == 0 {
+		return
+	}
+
+	store := k.getActorRoleExpirationsStore(ctx, denom)
+	store.Set(getActorRoleExpirationKey(actor, roleID), sdk.Uint64ToBigEndian(uint64(expiration)))
+
+	queue := k.getActorRoleExpirationQueueStore(ctx)
+	queue.Set(getActorRoleExpirationQueueKey(expiration, denom, actor, roleID), []byte{})
+}
+
+func (k Keeper) deleteActorRoleExpiration(ctx sdk.Context, denom string, actor sdk.AccAddress, roleID uint32) {
+	expiration := k.GetActorRoleExpiration(ctx, denom, actor, roleID)
+	if expiration == 0 {
+		return
+	}
+
+	store := k.getActorRoleExpirationsStore(ctx, denom)
+	store.Delete(getActorRoleExpirationKey(actor, roleID))
+
+	queue := k.getActorRoleExpirationQueueStore(ctx)
+	queue.Delete(getActorRoleExpirationQueueKey(expiration, denom, actor, roleID))
+}
+
+// GetAllActorRoleExpirations gathers all actor role expirations inside namespace for this denom
+func (k Keeper) GetAllActorRoleExpirations(ctx sdk.Context, denom string) ([]*types.ActorRoleExpiration, error) {
+	expirations := make([]*types.ActorRoleExpiration, 0)
+	roleIDToName := make(map[uint32]string)
+
+	store := k.getActorRoleExpirationsStore(ctx, denom)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		actor, roleID := parseActorRoleExpirationKey(iter.Key())
+
+		if _, ok := roleIDToName[roleID]; !ok {
+			role, err := k.GetRoleByID(ctx, denom, roleID)
+			if err != nil {
+				return nil, err
+			}
+			roleIDToName[roleID] = role.Name
+		}
+
+		expirations = append(expirations, &types.ActorRoleExpiration{
+			Actor:               actor.String(),
+			Role:                roleIDToName[roleID],
+			ExpirationTimestamp: int64(sdk.BigEndianToUint64(iter.Value())),
+		})
+	}
+
+	return expirations, nil
+}
+
+// GetExpiringActorRoles returns the actor role assignments of the namespace expiring within the given number of seconds
+// from the block time, ordered by expiration. Expired assignments that have not been pruned yet are included.
+func (k Keeper) GetExpiringActorRoles(ctx sdk.Context, denom string, withinSeconds int64) ([]*types.ActorRoleExpiration, error) {
+	expirations := make([]*types.ActorRoleExpiration, 0)
+	deadline := ctx.BlockTime().Unix() + withinSeconds
+
+	err := k.iterateActorRoleExpirationQueue(ctx, deadline, func(expiration int64, expDenom string, actor sdk.AccAddress, roleID uint32) (stop bool, err error) {
+		if expDenom != denom {
+			return false, nil
+		}
+
+		role, err := k.GetRoleByID(ctx, denom, roleID)
+		if err != nil {
+			return true, err
+		}
+
+		expirations = append(expirations, &types.ActorRoleExpiration{
+			Actor:               actor.String(),
+			Role:                role.Name,
+			ExpirationTimestamp: expiration,
+		})
+		return false, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return expirations, nil
+}
+
+// PruneExpiredActorRoles revokes the actor role assignments that expired at or before the block time
+func (k Keeper) PruneExpiredActorRoles(ctx sdk.Context) error {
+	type expiredActorRole struct {
+		expiration int64
+		denom      string
+		actor      sdk.AccAddress
+		roleID     uint32
+	}
+
+	expired := make([]expiredActorRole, 0)
+
+	err := k.iterateActorRoleExpirationQueue(ctx, ctx.BlockTime().Unix(), func(expiration int64, denom string, actor sdk.AccAddress, roleID uint32) (stop bool, err error) {
+		expired = append(expired, expiredActorRole{
+			expiration: expiration,
+			denom:      denom,
+			actor:      actor,
+			roleID:     roleID,
+		})
+		return len(expired) >= maxExpiredActorRolesPrunedPerBlock, nil
+	})
+
+	if err != nil {
+		return err
+	}
+
+	for _, e := range expired {
+		if err := k.revokeActorRoles(ctx, e.denom, e.actor, []uint32{e.roleID}); err != nil {
+			return err
+		}
+
+		var roleName string
+		if role, err := k.GetRoleByID(ctx, e.denom, e.roleID); err == nil {
+			roleName = role.Name
+		}
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventActorRoleExpired{
+			Denom:               e.denom,
+			Actor:               e.actor.String(),
+			Role:                roleName,
+			ExpirationTimestamp: e.expiration,
+		})
+	}
+
+	return nil
+}
+
+// iterateActorRoleExpirationQueue iterates over the actor role expirations up to and including the given timestamp, in order
+func (k Keeper) iterateActorRoleExpirationQueue(
+	ctx sdk.Context,
+	until int64,
+	cb func(expiration int64, denom string, actor sdk.AccAddress, roleID uint32) (stop bool, err error),
+) error {
+	if until < 0 {
+		return nil
+	}
+
+	queue := k.getActorRoleExpirationQueueStore(ctx)
+	iter := queue.Iterator(nil, storetypes.PrefixEndBytes(sdk.Uint64ToBigEndian(uint64(until))))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		stop, err := cb(parseActorRoleExpirationQueueKey(iter.Key()))
+		if err != nil {
+			return err
+		}
+
+		if stop {
+			return nil
+		}
+	}
+
+	return nil
+}
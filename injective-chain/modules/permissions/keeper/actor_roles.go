@@ -44,8 +44,8 @@ func (k Keeper) CheckPermissionsForAction(ctx sdk.Context, denom string, actor s
 
 // getTotalAllowedActionsForAddress returns the total allowed actions for the given address and denom
 func (k Keeper) getTotalAllowedActionsForAddress(ctx sdk.Context, denom string, actor sdk.AccAddress) (ActionBitMask, error) {
-	// check that action is allowed for address
-	roleIDs, err := k.GetActorRoleIDs(ctx, denom, actor)
+	// check that action is allowed for address, expired role assignments are ignored
+	roleIDs, err := k.getActiveActorRoleIDs(ctx, denom, actor)
 	if err != nil {
 		return 0, err
 	}
@@ -74,26 +74,20 @@ func (k Keeper) getTotalAllowedActionsForAddress(ctx sdk.Context, denom string,
 	return totalAllowedActions, nil
 }
 
-// GetAddressRoleNames returns all the assigned roles for this address. Returns EVERYONE role if no roles found for this address.
+// GetAddressRoleNames returns all the assigned, unexpired roles for this address. Returns EVERYONE role if no roles found for this address.
 func (k Keeper) GetAddressRoleNames(ctx sdk.Context, denom string, addr sdk.AccAddress) ([]string, error) {
-	store := k.getActorRolesStore(ctx, denom)
-	bz := store.Get(addr.Bytes())
-	if len(bz) == 0 {
-		return []string{types.EVERYONE}, nil
-	}
-	roleIDs := &types.RoleIDs{}
-
-	if err := proto.Unmarshal(bz, roleIDs); err != nil {
+	roleIDs, err := k.getActiveActorRoleIDs(ctx, denom, addr)
+	if err != nil {
 		return nil, err
 	}
 
-	if len(roleIDs.RoleIds) == 0 {
+	if len(roleIDs) == 0 {
 		return []string{types.EVERYONE}, nil
 	}
 
-	roleNames := make([]string, 0, len(roleIDs.RoleIds))
+	roleNames := make([]string, 0, len(roleIDs))
 
-	for _, roleID := range roleIDs.RoleIds {
+	for _, roleID := range roleIDs {
 		role, _ := k.GetRoleByID(ctx, denom, roleID)
 		roleNames = append(roleNames, role.Name)
 	}
@@ -287,6 +281,10 @@ func (k Keeper) revokeActorRoles(ctx sdk.Context, denom string, addr sdk.AccAddr
 		return slices.Contains(roleIDs, roleId)
 	})
 
+	for _, roleID := range roleIDs {
+		k.deleteActorRoleExpiration(ctx, denom, addr, roleID)
+	}
+
 	return k.setActorRoles(ctx, denom, addr, newRoleIDs)
 }
 
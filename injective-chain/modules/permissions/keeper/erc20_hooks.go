@@ -0,0 +1,36 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	erc20types "github.com/InjectiveLabs/injective-core/injective-chain/modules/erc20/types"
+)
+
+// Erc20Hooks keeps track of the bank denoms paired with erc20 contracts, so that the namespace of a paired denom
+// follows the enforced restrictions of its erc20 contract.
+type Erc20Hooks struct {
+	k *Keeper
+}
+
+var _ erc20types.Erc20Hooks = Erc20Hooks{}
+
+func (k *Keeper) Erc20Hooks() Erc20Hooks { return Erc20Hooks{k} }
+
+func (h Erc20Hooks) AfterTokenPairCreated(ctx sdk.Context, pair erc20types.TokenPair) {
+	h.k.getTokenPairDenomsStore(ctx).Set(common.HexToAddress(pair.Erc20Address).Bytes(), []byte(pair.BankDenom))
+}
+
+func (h Erc20Hooks) AfterTokenPairDeleted(ctx sdk.Context, pair erc20types.TokenPair) {
+	h.k.getTokenPairDenomsStore(ctx).Delete(common.HexToAddress(pair.Erc20Address).Bytes())
+}
+
+// GetTokenPairDenom returns the bank denom paired with an erc20 contract
+func (k Keeper) GetTokenPairDenom(ctx sdk.Context, erc20Address common.Address) (denom string, found bool) {
+	bz := k.getTokenPairDenomsStore(ctx).Get(erc20Address.Bytes())
+	if bz == nil {
+		return "", false
+	}
+
+	return string(bz), true
+}
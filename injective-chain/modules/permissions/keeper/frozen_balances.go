@@ -0,0 +1,113 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// GetFrozenBalance returns the frozen amount of the address's balance
+func (k Keeper) GetFrozenBalance(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdk.Coin, error) {
+	store := k.getFrozenBalancesStore(ctx, denom)
+	bz := store.Get(addr.Bytes())
+	if len(bz) == 0 {
+		return sdk.NewInt64Coin(denom, 0), nil
+	}
+
+	var amount math.Int
+	if err := amount.Unmarshal(bz); err != nil {
+		return sdk.NewInt64Coin(denom, 0), err
+	}
+
+	return sdk.NewCoin(denom, amount), nil
+}
+
+// setFrozenBalance replaces the frozen amount of the address's balance, a zero amount unfreezes it
+func (k Keeper) setFrozenBalance(ctx sdk.Context, addr sdk.AccAddress, frozen sdk.Coin) error {
+	store := k.getFrozenBalancesStore(ctx, frozen.Denom)
+
+	if frozen.IsZero() {
+		store.Delete(addr.Bytes())
+	} else {
+		bz, err := frozen.Amount.Marshal()
+		if err != nil {
+			return err
+		}
+		store.Set(addr.Bytes(), bz)
+	}
+
+	return nil
+}
+
+// GetFrozenBalances returns all frozen balances inside namespace for this denom
+func (k Keeper) GetFrozenBalances(ctx sdk.Context, denom string) ([]*types.FrozenBalance, error) {
+	store := k.getFrozenBalancesStore(ctx, denom)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	frozenBalances := make([]*types.FrozenBalance, 0)
+	for ; iter.Valid(); iter.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+
+		frozenBalances = append(frozenBalances, &types.FrozenBalance{
+			Address: sdk.AccAddress(iter.Key()).String(),
+			Amount:  sdk.NewCoin(denom, amount),
+		})
+	}
+	return frozenBalances, nil
+}
+
+func (k Keeper) getAllFrozenBalances(ctx sdk.Context) ([]*types.FrozenBalance, error) {
+	frozenBalances := make([]*types.FrozenBalance, 0)
+	for _, denom := range k.GetAllNamespaceDenoms(ctx) {
+		denomFrozenBalances, err := k.GetFrozenBalances(ctx, denom)
+		if err != nil {
+			return nil, err
+		}
+		frozenBalances = append(frozenBalances, denomFrozenBalances...)
+	}
+	return frozenBalances, nil
+}
+
+// GetSpendableBalance returns the address's balance minus its frozen amount, never negative
+func (k Keeper) GetSpendableBalance(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdk.Coin, error) {
+	frozen, err := k.GetFrozenBalance(ctx, denom, addr)
+	if err != nil {
+		return sdk.NewInt64Coin(denom, 0), err
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, addr, denom)
+	if balance.Amount.LTE(frozen.Amount) {
+		return sdk.NewInt64Coin(denom, 0), nil
+	}
+
+	return balance.Sub(frozen), nil
+}
+
+// checkFrozenBalance checks that the sent amount does not exceed the sender's spendable balance
+func (k Keeper) checkFrozenBalance(ctx sdk.Context, sender sdk.AccAddress, amount sdk.Coin) error {
+	frozen, err := k.GetFrozenBalance(ctx, amount.Denom, sender)
+	if err != nil {
+		return err
+	}
+
+	if frozen.IsZero() {
+		return nil
+	}
+
+	spendable, err := k.GetSpendableBalance(ctx, amount.Denom, sender)
+	if err != nil {
+		return err
+	}
+
+	if amount.Amount.GT(spendable.Amount) {
+		return errors.Wrapf(types.ErrBalanceFrozen, "%s exceeds the spendable balance %s of %s (frozen: %s)", amount, spendable, sender, frozen)
+	}
+
+	return nil
+}
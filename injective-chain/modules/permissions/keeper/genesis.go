@@ -28,6 +28,19 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 			panic(err)
 		}
 	}
+
+	for _, origin := range genState.VoucherOrigins {
+		if err := k.setVoucherOrigin(ctx, origin); err != nil {
+			panic(err)
+		}
+	}
+
+	for _, frozen := range genState.FrozenBalances {
+		address := sdk.MustAccAddressFromBech32(frozen.Address)
+		if err := k.setFrozenBalance(ctx, address, frozen.Amount); err != nil {
+			panic(err)
+		}
+	}
 }
 
 // ExportGenesis returns the permissions module's exported genesis.
@@ -51,5 +64,19 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	}
 
 	gs.Vouchers = vouchers
+
+	origins, err := k.getAllVoucherOrigins(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.VoucherOrigins = origins
+
+	frozenBalances, err := k.getAllFrozenBalances(ctx)
+	if err != nil {
+		panic(err)
+	}
+
+	gs.FrozenBalances = frozenBalances
 	return gs
 }
@@ -3,7 +3,9 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
 )
@@ -79,7 +81,7 @@ func (q queryServer) ActorsByRole(c context.Context, req *types.QueryActorsByRol
 	// inefficient but the only way for now since we don't index actors by roleID
 	err = q.IterateActorRoles(ctx, req.Denom, func(actor sdk.AccAddress, roleIDs []uint32) error {
 		for _, roleID := range roleIDs {
-			if roleID != role.RoleId {
+			if roleID != role.RoleId || q.isActorRoleExpired(ctx, req.Denom, actor, roleID) {
 				continue
 			}
 			actors = append(actors, actor.String())
@@ -211,3 +213,144 @@ func (q queryServer) PermissionsModuleState(c context.Context, req *types.QueryM
 	ctx := sdk.UnwrapSDKContext(c)
 	return &types.QueryModuleStateResponse{State: q.ExportGenesis(ctx)}, nil
 }
+
+func (q queryServer) ExpiringActorRoles(c context.Context, req *types.QueryExpiringActorRolesRequest) (*types.QueryExpiringActorRolesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.HasNamespace(ctx, req.Denom) {
+		return nil, types.ErrUnknownDenom
+	}
+
+	if req.WithinSeconds < 0 {
+		return nil, errors.Wrapf(sdkerrors.ErrInvalidRequest, "invalid window %d", req.WithinSeconds)
+	}
+
+	expirations, err := q.GetExpiringActorRoles(ctx, req.Denom, req.WithinSeconds)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryExpiringActorRolesResponse{Expirations: expirations}, nil
+}
+
+func (q queryServer) RoleLimits(c context.Context, req *types.QueryRoleLimitsRequest) (*types.QueryRoleLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.HasNamespace(ctx, req.Denom) {
+		return nil, types.ErrUnknownDenom
+	}
+
+	roleLimits, err := q.GetAllRoleLimits(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryRoleLimitsResponse{RoleLimits: roleLimits}, nil
+}
+
+func (q queryServer) ActorTransferUsage(c context.Context, req *types.QueryActorTransferUsageRequest) (*types.QueryActorTransferUsageResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.HasNamespace(ctx, req.Denom) {
+		return nil, types.ErrUnknownDenom
+	}
+
+	actor, err := sdk.AccAddressFromBech32(req.Actor)
+	if err != nil {
+		return nil, err
+	}
+
+	usages, err := q.GetActorTransferUsage(ctx, req.Denom, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryActorTransferUsageResponse{Usages: usages}, nil
+}
+
+func (q queryServer) VoucherOrigins(c context.Context, req *types.QueryVoucherOriginsRequest) (*types.QueryVoucherOriginsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	origins, err := q.GetVoucherOrigins(ctx, req.Denom, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryVoucherOriginsResponse{Origins: origins}, nil
+}
+
+func (q queryServer) FrozenBalances(c context.Context, req *types.QueryFrozenBalancesRequest) (*types.QueryFrozenBalancesResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.HasNamespace(ctx, req.Denom) {
+		return nil, types.ErrUnknownDenom
+	}
+
+	frozenBalances, err := q.GetFrozenBalances(ctx, req.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenBalancesResponse{FrozenBalances: frozenBalances}, nil
+}
+
+func (q queryServer) FrozenBalance(c context.Context, req *types.QueryFrozenBalanceRequest) (*types.QueryFrozenBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	addr, err := sdk.AccAddressFromBech32(req.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	frozen, err := q.GetFrozenBalance(ctx, req.Denom, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	spendable, err := q.GetSpendableBalance(ctx, req.Denom, addr)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFrozenBalanceResponse{
+		Frozen:    frozen,
+		Spendable: spendable,
+	}, nil
+}
+
+func (q queryServer) SimulateTransfer(c context.Context, req *types.QuerySimulateTransferRequest) (*types.QuerySimulateTransferResponse, error) {
+	if req == nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "empty request")
+	}
+
+	ctx := sdk.UnwrapSDKContext(c)
+	if !q.HasNamespace(ctx, req.Denom) {
+		return nil, types.ErrUnknownDenom
+	}
+
+	fromAddr, err := sdk.AccAddressFromBech32(req.FromAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	toAddr, err := sdk.AccAddressFromBech32(req.ToAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	if req.Amount.IsNil() || req.Amount.IsNegative() {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidCoins, "amount must not be negative")
+	}
+
+	amount := sdk.NewCoin(req.Denom, req.Amount)
+	failedCheck, err := q.Keeper.SimulateTransfer(ctx, fromAddr, toAddr, amount)
+	if err == nil {
+		return &types.QuerySimulateTransferResponse{Allowed: true}, nil
+	}
+
+	return &types.QuerySimulateTransferResponse{
+		Allowed:             false,
+		FailedCheck:         failedCheck,
+		Reason:              err.Error(),
+		ReroutableToVoucher: isReroutableError(err) && !q.IsEnforcedRestrictionsDenom(ctx, req.Denom),
+	}, nil
+}
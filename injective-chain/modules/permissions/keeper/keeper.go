@@ -106,6 +106,10 @@ func (k Keeper) IsEnforcedRestrictionsDenom(ctx sdk.Context, denom string) bool
 		if erc20types.DenomPrefix+contracts[i].ContractAddress.Hex() == denom {
 			return true
 		}
+		// the bank denom paired with an enforced contract follows its restrictions too
+		if pairedDenom, found := k.GetTokenPairDenom(ctx, contracts[i].ContractAddress); found && pairedDenom == denom {
+			return true
+		}
 	}
 	return false
 }
@@ -1,9 +1,15 @@
 package keeper
 
 import (
+	"bytes"
+	"encoding/binary"
+
 	"cosmossdk.io/store/prefix"
 	storetypes "cosmossdk.io/store/types"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
 )
 
 var (
@@ -16,6 +22,13 @@ var (
 	policyStatusKey              = []byte{0x07} // denom + action => PolicyStatus
 	policyManagerCapabilitiesKey = []byte{0x08} // denom + policyManager + Action => PolicyCapability
 	vouchersKey                  = []byte{0x09} // toAddr + fromAddr => Coins
+	actorRoleExpirationsKey      = []byte{0x0a} // denom + address + role_id => expiration timestamp
+	actorRoleExpirationQueueKey  = []byte{0x0b} // expiration timestamp + denom + address + role_id => nil
+	roleLimitsKey                = []byte{0x0c} // denom + role_id => RoleLimits
+	roleTransferUsageKey         = []byte{0x0d} // denom + role_id + address + direction + window bucket => amount
+	voucherOriginsKey            = []byte{0x0e} // denom + toAddr + created_at + fromAddr => amount
+	frozenBalancesKey            = []byte{0x0f} // denom + address => amount
+	tokenPairDenomsKey           = []byte{0x10} // erc20_address => denom, bank denoms paired with erc20 contracts
 	delim                        = []byte("|")
 )
 
@@ -105,3 +118,116 @@ func (k Keeper) getVouchersStoreForDenom(ctx sdk.Context, denom string) storetyp
 func getVoucherKey(denom string, address sdk.AccAddress) []byte {
 	return append(denomWithDelim(denom), address.Bytes()...)
 }
+
+// getActorRoleExpirationsStore returns the store prefix where the expirations of actor roles reside for specified denom
+func (k Keeper) getActorRoleExpirationsStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := actorRoleExpirationsKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// getActorRoleExpirationQueueStore returns the store prefix where actor role expirations are indexed by time
+func (k Keeper) getActorRoleExpirationQueueStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, actorRoleExpirationQueueKey)
+}
+
+func getActorRoleExpirationKey(actor sdk.AccAddress, roleID uint32) []byte {
+	return append(address.MustLengthPrefix(actor.Bytes()), types.Uint32ToLittleEndian(roleID)...)
+}
+
+func parseActorRoleExpirationKey(key []byte) (actor sdk.AccAddress, roleID uint32) {
+	addrLen := int(key[0])
+	return sdk.AccAddress(key[1 : 1+addrLen]), types.LittleEndianToUint32(key[1+addrLen:])
+}
+
+// getActorRoleExpirationQueueKey returns expiration timestamp + len prefixed denom + len prefixed actor + role id
+func getActorRoleExpirationQueueKey(expiration int64, denom string, actor sdk.AccAddress, roleID uint32) []byte {
+	key := sdk.Uint64ToBigEndian(uint64(expiration))
+	key = append(key, address.MustLengthPrefix([]byte(denom))...)
+	return append(key, getActorRoleExpirationKey(actor, roleID)...)
+}
+
+func parseActorRoleExpirationQueueKey(key []byte) (expiration int64, denom string, actor sdk.AccAddress, roleID uint32) {
+	expiration = int64(binary.BigEndian.Uint64(key[:8]))
+	denomLen := int(key[8])
+	denom = string(key[9 : 9+denomLen])
+	actor, roleID = parseActorRoleExpirationKey(key[9+denomLen:])
+	return expiration, denom, actor, roleID
+}
+
+// getRoleLimitsStore returns the store prefix where the transfer limits of roles reside for specified denom
+func (k Keeper) getRoleLimitsStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := roleLimitsKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// getRoleTransferUsageStore returns the store prefix where the transfer usage of actors resides for specified denom and role
+func (k Keeper) getRoleTransferUsageStore(ctx sdk.Context, denom string, roleID uint32) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := roleTransferUsageKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	keyPrefix = append(keyPrefix, types.Uint32ToLittleEndian(roleID)...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// getTransferUsagePrefix returns len prefixed actor + direction
+func getTransferUsagePrefix(actor sdk.AccAddress, direction transferDirection) []byte {
+	return append(address.MustLengthPrefix(actor.Bytes()), byte(direction))
+}
+
+func getTransferUsageKey(actor sdk.AccAddress, direction transferDirection, bucket uint64) []byte {
+	return append(getTransferUsagePrefix(actor, direction), sdk.Uint64ToBigEndian(bucket)...)
+}
+
+// getVoucherOriginsStore returns the store prefix where the original senders of an address's voucher reside
+func (k Keeper) getVoucherOriginsStore(ctx sdk.Context, denom string, addr sdk.AccAddress) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := voucherOriginsKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	keyPrefix = append(keyPrefix, address.MustLengthPrefix(addr.Bytes())...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// getAllVoucherOriginsStore returns the store prefix where the original senders of all vouchers reside
+func (k Keeper) getAllVoucherOriginsStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, voucherOriginsKey)
+}
+
+func getVoucherOriginKey(createdAt int64, sender sdk.AccAddress) []byte {
+	return append(sdk.Uint64ToBigEndian(uint64(createdAt)), sender.Bytes()...)
+}
+
+func parseVoucherOriginKey(key []byte) (createdAt int64, sender sdk.AccAddress) {
+	return int64(binary.BigEndian.Uint64(key[:8])), sdk.AccAddress(key[8:])
+}
+
+// parseFullVoucherOriginKey parses denom + delim + len prefixed address + created_at + sender
+func parseFullVoucherOriginKey(key []byte) (denom string, addr sdk.AccAddress, createdAt int64, sender sdk.AccAddress) {
+	delimIdx := bytes.Index(key, delim)
+	denom = string(key[:delimIdx])
+	key = key[delimIdx+len(delim):]
+
+	addrLen := int(key[0])
+	addr = sdk.AccAddress(key[1 : 1+addrLen])
+	createdAt, sender = parseVoucherOriginKey(key[1+addrLen:])
+	return denom, addr, createdAt, sender
+}
+
+// getFrozenBalancesStore returns the store prefix where the frozen balances reside for specified denom
+func (k Keeper) getFrozenBalancesStore(ctx sdk.Context, denom string) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	keyPrefix := frozenBalancesKey
+	keyPrefix = append(keyPrefix, denomWithDelim(denom)...)
+	return prefix.NewStore(store, keyPrefix)
+}
+
+// getTokenPairDenomsStore returns the store prefix where the bank denoms paired with erc20 contracts are stored
+func (k Keeper) getTokenPairDenomsStore(ctx sdk.Context) storetypes.KVStore {
+	store := ctx.KVStore(k.storeKey)
+	return prefix.NewStore(store, tokenPairDenomsKey)
+}
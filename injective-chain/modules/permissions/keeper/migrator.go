@@ -0,0 +1,35 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+type Migrator struct {
+	keeper Keeper
+}
+
+func NewMigrator(k Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+// Migrate1to2 adds permissive policy statuses for the actions introduced after existing namespaces were created
+// (e.g. RECLAIM_VOUCHERS and FREEZE_BALANCES), since actions without a policy status are considered disabled.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	for _, denom := range m.keeper.GetAllNamespaceDenoms(ctx) {
+		for _, action := range types.Actions {
+			if _, err := m.keeper.GetPolicyStatus(ctx, denom, action); err == nil {
+				continue
+			}
+
+			if err := m.keeper.setPolicyStatus(ctx, denom, types.NewPolicyStatus(action, false, false)); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
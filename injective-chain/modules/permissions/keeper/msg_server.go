@@ -149,6 +149,19 @@ func (k msgServer) UpdateNamespace(c context.Context, msg *types.MsgUpdateNamesp
 		}
 	}
 
+	if namespaceChanges.HasVoucherExpiryChange {
+		namespace, err := k.GetNamespace(ctx, denom, false)
+		if err != nil {
+			return nil, err
+		}
+
+		namespace.VoucherExpirySeconds = msg.VoucherExpiry.NewValue
+
+		if err := k.setNamespace(ctx, *namespace); err != nil {
+			return nil, errors.Wrap(err, "can't store updated namespace")
+		}
+	}
+
 	if namespaceChanges.HasRolePermissionsChange {
 		for _, role := range msg.RolePermissions {
 			if err := k.updateRole(ctx, denom, role); err != nil {
@@ -199,6 +212,15 @@ func (k msgServer) UpdateActorRoles(c context.Context, msg *types.MsgUpdateActor
 		return nil, err
 	}
 
+	// role => expiration of the assignments to add, 0 for permanent assignments
+	expirations := make(map[string]int64, len(msg.RoleActorsToAdd))
+	for _, roleActors := range msg.RoleActorsToAdd {
+		if roleActors.ExpirationTimestamp != 0 && roleActors.ExpirationTimestamp <= ctx.BlockTime().Unix() {
+			return nil, errors.Wrapf(types.ErrInvalidRole, "expiration timestamp %d of role %s is not in the future", roleActors.ExpirationTimestamp, roleActors.Role)
+		}
+		expirations[roleActors.Role] = roleActors.ExpirationTimestamp
+	}
+
 	actorRolesToAdd := types.RoleActorsToActorRoles(msg.RoleActorsToAdd)
 
 	for _, roleActors := range actorRolesToAdd {
@@ -211,6 +233,11 @@ func (k msgServer) UpdateActorRoles(c context.Context, msg *types.MsgUpdateActor
 		if err := k.addActorRoles(ctx, denom, actor, actorRoleIDs); err != nil {
 			return nil, err
 		}
+
+		// re-assigning a role replaces its previous expiration
+		for _, role := range roleActors.Roles {
+			k.setActorRoleExpiration(ctx, denom, actor, roleIDs[role], expirations[role])
+		}
 	}
 
 	actorRolesToRevoke := types.RoleActorsToActorRoles(msg.RoleActorsToRevoke)
@@ -235,20 +262,113 @@ func (k msgServer) ClaimVoucher(c context.Context, msg *types.MsgClaimVoucher) (
 
 	receiver := sdk.MustAccAddressFromBech32(msg.Sender)
 
-	voucher, err := k.GetVoucherForAddress(ctx, msg.Denom, receiver)
-	if err != nil {
+	if err := k.claimVoucher(ctx, receiver, msg.Denom); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgClaimVoucherResponse{}, nil
+}
+
+func (k msgServer) UpdateRoleLimits(c context.Context, msg *types.MsgUpdateRoleLimits) (*types.MsgUpdateRoleLimitsResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	denom := msg.Denom
+
+	if !k.HasNamespace(ctx, denom) {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "namespace for %s does not exist", denom)
+	}
+
+	// limits are part of a role's definition, so they are managed by the same actors as role permissions
+	if !k.HasPermissionsForAction(ctx, denom, sender, types.Action_MODIFY_ROLE_PERMISSIONS) {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "sender %s unauthorized for action %s", sender, types.Action_MODIFY_ROLE_PERMISSIONS)
+	}
+
+	for _, roleLimits := range msg.RoleLimits {
+		roleID, ok := k.GetRoleID(ctx, denom, roleLimits.Role)
+		if !ok {
+			return nil, types.ErrUnknownRole.Wrapf("role %s not found", roleLimits.Role)
+		}
+
+		if err := k.setRoleLimits(ctx, denom, roleID, roleLimits); err != nil {
+			return nil, err
+		}
+	}
+
+	return &types.MsgUpdateRoleLimitsResponse{}, nil
+}
+
+func (k msgServer) ClaimVouchers(c context.Context, msg *types.MsgClaimVouchers) (*types.MsgClaimVouchersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	receiver := sdk.MustAccAddressFromBech32(msg.Sender)
+
+	for _, denom := range msg.Denoms {
+		if err := k.claimVoucher(ctx, receiver, denom); err != nil {
+			return nil, errors.Wrapf(err, "can't claim %s voucher", denom)
+		}
+	}
+
+	return &types.MsgClaimVouchersResponse{}, nil
+}
+
+func (k msgServer) ReturnExpiredVouchers(c context.Context, msg *types.MsgReturnExpiredVouchers) (*types.MsgReturnExpiredVouchersResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// anyone can trigger the return, funds only ever go back to their original senders
+	addr := sdk.MustAccAddressFromBech32(msg.Address)
+
+	if _, err := k.returnExpiredVouchers(ctx, msg.Denom, addr); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgReturnExpiredVouchersResponse{}, nil
+}
+
+func (k msgServer) ReclaimVoucher(c context.Context, msg *types.MsgReclaimVoucher) (*types.MsgReclaimVoucherResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	denom := msg.Denom
+
+	if !k.HasNamespace(ctx, denom) {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "namespace for %s does not exist", denom)
+	}
+
+	if err := k.CheckPermissionsForAction(ctx, denom, sender, types.Action_RECLAIM_VOUCHERS); err != nil {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "sender %s unauthorized for action %s: %s", sender, types.Action_RECLAIM_VOUCHERS, err)
+	}
+
+	addr := sdk.MustAccAddressFromBech32(msg.Address)
+	if err := k.reclaimVoucher(ctx, sender, addr, denom); err != nil {
 		return nil, err
 	}
-	if voucher.IsZero() {
-		return nil, types.ErrVoucherNotFound
+
+	return &types.MsgReclaimVoucherResponse{}, nil
+}
+
+func (k msgServer) SetFrozenBalance(c context.Context, msg *types.MsgSetFrozenBalance) (*types.MsgSetFrozenBalanceResponse, error) {
+	ctx := sdk.UnwrapSDKContext(c)
+	sender := sdk.MustAccAddressFromBech32(msg.Sender)
+	denom := msg.Amount.Denom
+
+	if !k.HasNamespace(ctx, denom) {
+		return nil, errors.Wrapf(types.ErrUnknownDenom, "namespace for %s does not exist", denom)
+	}
+
+	if err := k.CheckPermissionsForAction(ctx, denom, sender, types.Action_FREEZE_BALANCES); err != nil {
+		return nil, errors.Wrapf(types.ErrUnauthorized, "sender %s unauthorized for action %s: %s", sender, types.Action_FREEZE_BALANCES, err)
 	}
 
-	// now claim voucher by sending funds from permissions module to receiver and then removing the voucher
-	// please note the user will not be able to claim if he still does not have permissions, since transfer hook will be called on this send again
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(voucher)); err != nil {
+	addr := sdk.MustAccAddressFromBech32(msg.Address)
+	if err := k.setFrozenBalance(ctx, addr, msg.Amount); err != nil {
 		return nil, err
 	}
-	k.deleteVoucher(ctx, receiver, msg.Denom)
 
-	return &types.MsgClaimVoucherResponse{}, nil
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventSetFrozenBalance{
+		Addr:   addr.String(),
+		Frozen: msg.Amount,
+		Sender: sender.String(),
+	})
+
+	return &types.MsgSetFrozenBalanceResponse{}, nil
 }
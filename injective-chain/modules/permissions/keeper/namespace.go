@@ -67,6 +67,16 @@ func (k Keeper) GetNamespace(ctx sdk.Context, denom string, includeFull bool) (*
 		return nil, err
 	}
 
+	actorRoleExpirations, err := k.GetAllActorRoleExpirations(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	roleLimits, err := k.GetAllRoleLimits(ctx, denom)
+	if err != nil {
+		return nil, err
+	}
+
 	roleManagers, err := k.GetAllRoleManagers(ctx, denom)
 	if err != nil {
 		return nil, err
@@ -84,6 +94,8 @@ func (k Keeper) GetNamespace(ctx sdk.Context, denom string, includeFull bool) (*
 
 	namespace.RolePermissions = roles
 	namespace.ActorRoles = actorRoles
+	namespace.ActorRoleExpirations = actorRoleExpirations
+	namespace.RoleLimits = roleLimits
 	namespace.RoleManagers = roleManagers
 	namespace.PolicyStatuses = policyStatuses
 	namespace.PolicyManagerCapabilities = policyManagerCapabilities
@@ -134,6 +146,29 @@ func (k Keeper) createNamespace(ctx sdk.Context, ns types.Namespace) error {
 		}
 	}
 
+	// store actor role expirations
+	for _, expiration := range ns.ActorRoleExpirations {
+		actor := sdk.MustAccAddressFromBech32(expiration.Actor)
+		roleID, ok := roleNameToRoleID[expiration.Role]
+		if !ok {
+			return types.ErrUnknownRole.Wrapf("role %s not found", expiration.Role)
+		}
+
+		k.setActorRoleExpiration(ctx, denom, actor, roleID, expiration.ExpirationTimestamp)
+	}
+
+	// store role limits
+	for _, roleLimits := range ns.RoleLimits {
+		roleID, ok := roleNameToRoleID[roleLimits.Role]
+		if !ok {
+			return types.ErrUnknownRole.Wrapf("role %s not found", roleLimits.Role)
+		}
+
+		if err := k.setRoleLimits(ctx, denom, roleID, roleLimits); err != nil {
+			return err
+		}
+	}
+
 	// store manager roles
 	for _, managerRoles := range ns.RoleManagers {
 		manager := sdk.MustAccAddressFromBech32(managerRoles.Manager)
@@ -163,6 +198,8 @@ func (k Keeper) createNamespace(ctx sdk.Context, ns types.Namespace) error {
 	// nil the values to not store it inside namespace storage
 	ns.RolePermissions = nil
 	ns.ActorRoles = nil
+	ns.ActorRoleExpirations = nil
+	ns.RoleLimits = nil
 	ns.RoleManagers = nil
 	ns.PolicyStatuses = nil
 	ns.PolicyManagerCapabilities = nil
@@ -0,0 +1,347 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
+)
+
+// transferDirection tells whether an actor sent or received the tracked amount
+type transferDirection byte
+
+const (
+	transferDirectionSend    transferDirection = 0x01
+	transferDirectionReceive transferDirection = 0x02
+)
+
+// roleLimitWindowBuckets is the number of buckets a rolling window is split into. Usage is tracked per bucket,
+// so the window rolls forward in steps of window_seconds / roleLimitWindowBuckets.
+const roleLimitWindowBuckets = 24
+
+// transferCharge is the role limited transfer usage is recorded against
+type transferCharge struct {
+	roleID        uint32
+	direction     transferDirection
+	windowSeconds int64
+}
+
+// GetRoleLimits returns the transfer limits of the role, nil if the role is not limited
+func (k Keeper) GetRoleLimits(ctx sdk.Context, denom string, roleID uint32) (*types.RoleLimits, error) {
+	store := k.getRoleLimitsStore(ctx, denom)
+	bz := store.Get(types.Uint32ToLittleEndian(roleID))
+	if len(bz) == 0 {
+		return nil, nil
+	}
+
+	var limits types.RoleLimits
+	if err := proto.Unmarshal(bz, &limits); err != nil {
+		return nil, err
+	}
+
+	return &limits, nil
+}
+
+// GetAllRoleLimits returns the transfer limits of all limited roles inside namespace for this denom
+func (k Keeper) GetAllRoleLimits(ctx sdk.Context, denom string) ([]*types.RoleLimits, error) {
+	roleLimits := make([]*types.RoleLimits, 0)
+	store := k.getRoleLimitsStore(ctx, denom)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var limits types.RoleLimits
+		if err := proto.Unmarshal(iter.Value(), &limits); err != nil {
+			return nil, err
+		}
+		roleLimits = append(roleLimits, &limits)
+	}
+
+	return roleLimits, nil
+}
+
+// setRoleLimits sets the transfer limits of the role, limits without any value set are removed.
+// Tracked usage is reset when the window of the role changes, since it was bucketed for the previous window.
+func (k Keeper) setRoleLimits(ctx sdk.Context, denom string, roleID uint32, limits *types.RoleLimits) error {
+	oldLimits, err := k.GetRoleLimits(ctx, denom, roleID)
+	if err != nil {
+		return err
+	}
+
+	store := k.getRoleLimitsStore(ctx, denom)
+	key := types.Uint32ToLittleEndian(roleID)
+
+	if !limits.HasLimits() {
+		store.Delete(key)
+		k.clearRoleTransferUsage(ctx, denom, roleID)
+		return nil
+	}
+
+	if oldLimits != nil && oldLimits.WindowSeconds != limits.WindowSeconds {
+		k.clearRoleTransferUsage(ctx, denom, roleID)
+	}
+
+	bz, err := proto.Marshal(limits)
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) clearRoleTransferUsage(ctx sdk.Context, denom string, roleID uint32) {
+	store := k.getRoleTransferUsageStore(ctx, denom, roleID)
+	iter := store.Iterator(nil, nil)
+
+	keysToRemove := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keysToRemove = append(keysToRemove, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keysToRemove {
+		store.Delete(key)
+	}
+}
+
+// getWindowBucket returns the bucket of the block time and the oldest bucket that still falls inside the window
+func getWindowBucket(ctx sdk.Context, windowSeconds int64) (bucket, firstBucket uint64) {
+	bucketSeconds := max(windowSeconds/roleLimitWindowBuckets, 1)
+	bucket = uint64(ctx.BlockTime().Unix() / bucketSeconds)
+	numBuckets := uint64((windowSeconds + bucketSeconds - 1) / bucketSeconds)
+
+	if bucket+1 < numBuckets {
+		return bucket, 0
+	}
+
+	return bucket, bucket + 1 - numBuckets
+}
+
+// getTransferUsage returns the amount the actor transferred in the given direction within the rolling window of the role
+func (k Keeper) getTransferUsage(ctx sdk.Context, denom string, charge transferCharge, actor sdk.AccAddress) math.Int {
+	usage := math.ZeroInt()
+	bucket, firstBucket := getWindowBucket(ctx, charge.windowSeconds)
+
+	store := k.getRoleTransferUsageStore(ctx, denom, charge.roleID)
+	iter := store.Iterator(
+		getTransferUsageKey(actor, charge.direction, firstBucket),
+		getTransferUsageKey(actor, charge.direction, bucket+1),
+	)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			continue
+		}
+		usage = usage.Add(amount)
+	}
+
+	return usage
+}
+
+// addTransferUsage adds the amount to the current window bucket of the actor and removes buckets that left the window
+func (k Keeper) addTransferUsage(ctx sdk.Context, denom string, charge transferCharge, actor sdk.AccAddress, amount math.Int) error {
+	bucket, firstBucket := getWindowBucket(ctx, charge.windowSeconds)
+	store := k.getRoleTransferUsageStore(ctx, denom, charge.roleID)
+
+	usagePrefix := getTransferUsagePrefix(actor, charge.direction)
+	iter := store.Iterator(usagePrefix, getTransferUsageKey(actor, charge.direction, firstBucket))
+
+	keysToRemove := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keysToRemove = append(keysToRemove, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keysToRemove {
+		store.Delete(key)
+	}
+
+	key := getTransferUsageKey(actor, charge.direction, bucket)
+	usage := math.ZeroInt()
+	if bz := store.Get(key); len(bz) > 0 {
+		if err := usage.Unmarshal(bz); err != nil {
+			return err
+		}
+	}
+
+	bz, err := usage.Add(amount).Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+// getRolesGrantingAction returns the active roles of the actor which allow the action, or the EVERYONE role if the actor has no roles
+func (k Keeper) getRolesGrantingAction(ctx sdk.Context, denom string, actor sdk.AccAddress, action types.Action) ([]*types.Role, error) {
+	roleIDs, err := k.getActiveActorRoleIDs(ctx, denom, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roleIDs) == 0 {
+		everyoneRoleID, _ := k.GetRoleID(ctx, denom, types.EVERYONE)
+		roleIDs = []uint32{everyoneRoleID}
+	}
+
+	roles := make([]*types.Role, 0, len(roleIDs))
+	for _, roleID := range roleIDs {
+		role, err := k.GetRoleByID(ctx, denom, roleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if ActionBitMask(role.Permissions).Has(action) {
+			roles = append(roles, role)
+		}
+	}
+
+	return roles, nil
+}
+
+// checkTransferLimit checks the send or receive limits of the actor's roles allowing the action. Roles are additive, so the actor
+// is only limited if all of these roles are, in which case the transfer is charged to the first role with enough room left in its window.
+// Returns nil if the actor is not limited.
+func (k Keeper) checkTransferLimit(ctx sdk.Context, denom string, actor sdk.AccAddress, action types.Action, amount math.Int) (*transferCharge, error) {
+	roles, err := k.getRolesGrantingAction(ctx, denom, actor, action)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roles) == 0 {
+		return nil, nil
+	}
+
+	direction := transferDirectionSend
+	if action == types.Action_RECEIVE {
+		direction = transferDirectionReceive
+	}
+
+	type limitedRole struct {
+		charge transferCharge
+		limit  math.Int
+	}
+
+	limitedRoles := make([]limitedRole, 0, len(roles))
+	for _, role := range roles {
+		limits, err := k.GetRoleLimits(ctx, denom, role.RoleId)
+		if err != nil {
+			return nil, err
+		}
+
+		var (
+			hasLimit bool
+			limit    math.Int
+		)
+		if limits != nil && direction == transferDirectionSend {
+			hasLimit, limit = limits.HasSendLimit(), limits.SendLimit
+		} else if limits != nil {
+			hasLimit, limit = limits.HasReceiveLimit(), limits.ReceiveLimit
+		}
+
+		if !hasLimit {
+			return nil, nil
+		}
+
+		limitedRoles = append(limitedRoles, limitedRole{
+			charge: transferCharge{roleID: role.RoleId, direction: direction, windowSeconds: limits.WindowSeconds},
+			limit:  limit,
+		})
+	}
+
+	for _, r := range limitedRoles {
+		usage := k.getTransferUsage(ctx, denom, r.charge, actor)
+		if usage.Add(amount).LTE(r.limit) {
+			charge := r.charge
+			return &charge, nil
+		}
+	}
+
+	return nil, errors.Wrapf(types.ErrTransferLimitExceeded, "%s of %s%s exceeds the limits of %s", action, amount, denom, actor)
+}
+
+// checkBalanceCap checks that the balance of the actor after receiving the amount stays within the highest balance cap of the
+// actor's roles allowing RECEIVE. The actor is not capped if one of these roles has no cap.
+func (k Keeper) checkBalanceCap(ctx sdk.Context, denom string, actor sdk.AccAddress, amount math.Int) error {
+	roles, err := k.getRolesGrantingAction(ctx, denom, actor, types.Action_RECEIVE)
+	if err != nil {
+		return err
+	}
+
+	if len(roles) == 0 {
+		return nil
+	}
+
+	maxBalance := math.ZeroInt()
+	for _, role := range roles {
+		limits, err := k.GetRoleLimits(ctx, denom, role.RoleId)
+		if err != nil {
+			return err
+		}
+
+		if limits == nil || !limits.HasMaxBalance() {
+			return nil
+		}
+
+		maxBalance = math.MaxInt(maxBalance, limits.MaxBalance)
+	}
+
+	balance := k.bankKeeper.GetBalance(ctx, actor, denom)
+	if balance.Amount.Add(amount).GT(maxBalance) {
+		return errors.Wrapf(types.ErrTransferLimitExceeded, "balance of %s would exceed the max balance of %s%s", actor, maxBalance, denom)
+	}
+
+	return nil
+}
+
+// recordTransferUsage adds the transferred amount to the usage of the role the transfer was charged to
+func (k Keeper) recordTransferUsage(ctx sdk.Context, denom string, charge *transferCharge, actor sdk.AccAddress, amount math.Int) error {
+	if charge == nil {
+		return nil
+	}
+
+	return k.addTransferUsage(ctx, denom, *charge, actor, amount)
+}
+
+// GetActorTransferUsage returns the amounts the actor transferred within the windows of its roles that have send or receive limits
+func (k Keeper) GetActorTransferUsage(ctx sdk.Context, denom string, actor sdk.AccAddress) ([]*types.RoleTransferUsage, error) {
+	roleIDs, err := k.getActiveActorRoleIDs(ctx, denom, actor)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(roleIDs) == 0 {
+		everyoneRoleID, _ := k.GetRoleID(ctx, denom, types.EVERYONE)
+		roleIDs = []uint32{everyoneRoleID}
+	}
+
+	usages := make([]*types.RoleTransferUsage, 0)
+	for _, roleID := range roleIDs {
+		limits, err := k.GetRoleLimits(ctx, denom, roleID)
+		if err != nil {
+			return nil, err
+		}
+
+		if limits == nil || limits.WindowSeconds == 0 {
+			continue
+		}
+
+		sendCharge := transferCharge{roleID: roleID, direction: transferDirectionSend, windowSeconds: limits.WindowSeconds}
+		receiveCharge := transferCharge{roleID: roleID, direction: transferDirectionReceive, windowSeconds: limits.WindowSeconds}
+
+		usages = append(usages, &types.RoleTransferUsage{
+			Role:          limits.Role,
+			Sent:          k.getTransferUsage(ctx, denom, sendCharge, actor),
+			Received:      k.getTransferUsage(ctx, denom, receiveCharge, actor),
+			WindowSeconds: limits.WindowSeconds,
+		})
+	}
+
+	return usages, nil
+}
@@ -26,19 +26,29 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 	// this is a hot-patch to not break contracts defined in exchange and insurance / distribution / etc modules that
 	// do not expect bank transfer to fail. Only reroute in case of restricted error or contract hook query error (aka fail-closed approach)
 	defer func() {
-		switch {
-		case errors.IsOf(err, types.ErrRestrictedAction, types.ErrInvalidWasmHook, types.ErrInvalidEVMHook, types.ErrContractHookError):
-			if !isEnforcedRestrictionDenom {
-				// should replace address with permissions module address and error with nil
-				newToAddr, err = k.rerouteToVoucherOnFail(ctx, newToAddr, amount, err)
-			}
-		default:
+		if isReroutableError(err) && !isEnforcedRestrictionDenom {
+			// should replace address with permissions module address and error with nil
+			newToAddr, err = k.rerouteToVoucherOnFail(ctx, fromAddr, newToAddr, amount, err)
 		}
 	}()
 
+	_, err = k.checkTransfer(sdkCtx, fromAddr, toAddr, amount, isEnforcedRestrictionDenom)
+	return toAddr, err
+}
+
+// isReroutableError returns true if a failed send can be rerouted to a voucher of the recipient
+func isReroutableError(err error) bool {
+	return errors.IsOf(err, types.ErrRestrictedAction, types.ErrTransferLimitExceeded, types.ErrInvalidWasmHook, types.ErrInvalidEVMHook, types.ErrContractHookError)
+}
+
+// checkTransfer runs the checks of the send restriction pipeline and records the transfer usage of the actors' roles.
+// It returns the check which failed along with its error.
+func (k Keeper) checkTransfer(
+	sdkCtx sdk.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin, isEnforcedRestrictionDenom bool,
+) (types.TransferCheck, error) {
 	// module to module sends should not be restricted except for tokens with enforced restrictions
 	if k.IsModuleAcc(fromAddr) && k.IsModuleAcc(toAddr) && !isEnforcedRestrictionDenom {
-		return toAddr, nil
+		return types.TransferCheck_TRANSFER_CHECK_NONE, nil
 	}
 
 	// find namespace for denom
@@ -46,7 +56,7 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 
 	// if namespace doesn't exist, then no restrictions are applied
 	if namespace == nil {
-		return toAddr, nil
+		return types.TransferCheck_TRANSFER_CHECK_NONE, nil
 	}
 
 	// tokenfactory module should always be allowed to receive tokens, since it's required in the event of a forced burn
@@ -54,27 +64,67 @@ func (k Keeper) SendRestrictionFn(ctx context.Context, fromAddr, toAddr sdk.AccA
 	isRecipientTfModule := toAddr.String() == k.tfModuleAddress
 	canSkipSendPermissionsCheck := isRecipientTfModule || k.IsModuleAcc(fromAddr)
 
+	// amount-based role limits are only applied to accounts, charges are recorded once the contract hooks passed
+	var (
+		sendCharge, receiveCharge *transferCharge
+		err                       error
+	)
+
 	if !canSkipSendPermissionsCheck {
 		if err := k.CheckPermissionsForAction(sdkCtx, namespace.Denom, fromAddr, types.Action_SEND); err != nil {
-			return toAddr, err
+			return types.TransferCheck_TRANSFER_CHECK_SEND_PERMISSION, err
+		}
+
+		// spendable balance = balance - frozen, forced burns through the tokenfactory module can still take frozen funds
+		if err := k.checkFrozenBalance(sdkCtx, fromAddr, amount); err != nil {
+			return types.TransferCheck_TRANSFER_CHECK_FROZEN_BALANCE, err
+		}
+
+		if sendCharge, err = k.checkTransferLimit(sdkCtx, namespace.Denom, fromAddr, types.Action_SEND, amount.Amount); err != nil {
+			return types.TransferCheck_TRANSFER_CHECK_SEND_LIMIT, err
 		}
 	}
 
 	if !isRecipientTfModule {
 		if err := k.CheckPermissionsForAction(sdkCtx, namespace.Denom, toAddr, types.Action_RECEIVE); err != nil {
-			return toAddr, err
+			return types.TransferCheck_TRANSFER_CHECK_RECEIVE_PERMISSION, err
+		}
+
+		if !k.IsModuleAcc(toAddr) {
+			if receiveCharge, err = k.checkTransferLimit(sdkCtx, namespace.Denom, toAddr, types.Action_RECEIVE, amount.Amount); err != nil {
+				return types.TransferCheck_TRANSFER_CHECK_RECEIVE_LIMIT, err
+			}
+
+			if err := k.checkBalanceCap(sdkCtx, namespace.Denom, toAddr, amount.Amount); err != nil {
+				return types.TransferCheck_TRANSFER_CHECK_BALANCE_CAP, err
+			}
 		}
 	}
 
 	if err := k.executeWasmHook(sdkCtx, namespace, fromAddr, toAddr, types.Action_RECEIVE, amount); err != nil {
-		return toAddr, err
+		return types.TransferCheck_TRANSFER_CHECK_WASM_HOOK, err
 	}
 
 	if err := k.ExecuteEvmHook(sdkCtx, namespace, fromAddr, toAddr, amount); err != nil {
-		return toAddr, err
+		return types.TransferCheck_TRANSFER_CHECK_EVM_HOOK, err
+	}
+
+	if err := k.recordTransferUsage(sdkCtx, namespace.Denom, sendCharge, fromAddr, amount.Amount); err != nil {
+		return types.TransferCheck_TRANSFER_CHECK_SEND_LIMIT, err
+	}
+
+	if err := k.recordTransferUsage(sdkCtx, namespace.Denom, receiveCharge, toAddr, amount.Amount); err != nil {
+		return types.TransferCheck_TRANSFER_CHECK_RECEIVE_LIMIT, err
 	}
 
-	return toAddr, nil
+	return types.TransferCheck_TRANSFER_CHECK_NONE, nil
+}
+
+// SimulateTransfer runs the send restriction pipeline, including the contract hooks which are only queried, on a cached
+// context so that no state is changed. It returns the check which would fail along with its error.
+func (k Keeper) SimulateTransfer(ctx sdk.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin) (types.TransferCheck, error) {
+	cacheCtx, _ := ctx.CacheContext()
+	return k.checkTransfer(cacheCtx, fromAddr, toAddr, amount, k.IsEnforcedRestrictionsDenom(ctx, amount.Denom))
 }
 
 // IsModuleAcc checks if a given address is a module account address
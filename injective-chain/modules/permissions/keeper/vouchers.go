@@ -3,6 +3,7 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/math"
 	"cosmossdk.io/store/prefix"
 
 	"github.com/InjectiveLabs/injective-core/injective-chain/modules/permissions/types"
@@ -20,7 +21,7 @@ import (
 // when their permissions allow the claim.
 // This is needed to not fail bank transfers from module to accounts and couple other cases in consensus code,
 // since our old codebase does not expect it to fail.
-func (k Keeper) rerouteToVoucherOnFail(ctx context.Context, toAddr sdk.AccAddress, amount sdk.Coin, origErr error) (newToAddr sdk.AccAddress, err error) {
+func (k Keeper) rerouteToVoucherOnFail(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amount sdk.Coin, origErr error) (newToAddr sdk.AccAddress, err error) {
 	sdkCtx := sdk.UnwrapSDKContext(ctx)
 
 	if doNotFailFast := ctx.Value(baseapp.DoNotFailFastSendContextKey); doNotFailFast == nil {
@@ -39,6 +40,11 @@ func (k Keeper) rerouteToVoucherOnFail(ctx context.Context, toAddr sdk.AccAddres
 		return toAddr, errors.Wrapf(err, "can't set voucher for address, tried to reroute token send after error: %s", origErr.Error())
 	}
 
+	// remember the sender so the funds can be returned once the voucher expires
+	if err := k.addVoucherOrigin(sdkCtx, toAddr, fromAddr, amount); err != nil {
+		return toAddr, errors.Wrapf(err, "can't set voucher origin for address, tried to reroute token send after error: %s", origErr.Error())
+	}
+
 	return authtypes.NewModuleAddress(types.ModuleName), nil
 }
 
@@ -133,3 +139,226 @@ func (k Keeper) getVouchersForDenom(ctx sdk.Context, denom string) ([]*types.Add
 	}
 	return vouchers, nil
 }
+
+// addVoucherOrigin records that the sender's funds were added to the address's voucher at the current block time
+func (k Keeper) addVoucherOrigin(ctx sdk.Context, addr, sender sdk.AccAddress, amount sdk.Coin) error {
+	store := k.getVoucherOriginsStore(ctx, amount.Denom, addr)
+	key := getVoucherOriginKey(ctx.BlockTime().Unix(), sender)
+
+	total := amount.Amount
+	if bz := store.Get(key); len(bz) > 0 {
+		var existing math.Int
+		if err := existing.Unmarshal(bz); err != nil {
+			return err
+		}
+		total = total.Add(existing)
+	}
+
+	bz, err := total.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store.Set(key, bz)
+	return nil
+}
+
+func (k Keeper) setVoucherOrigin(ctx sdk.Context, origin *types.VoucherOrigin) error {
+	addr := sdk.MustAccAddressFromBech32(origin.Address)
+	sender := sdk.MustAccAddressFromBech32(origin.Sender)
+
+	bz, err := origin.Amount.Amount.Marshal()
+	if err != nil {
+		return err
+	}
+
+	store := k.getVoucherOriginsStore(ctx, origin.Amount.Denom, addr)
+	store.Set(getVoucherOriginKey(origin.CreatedAt, sender), bz)
+	return nil
+}
+
+// GetVoucherOrigins returns the original senders of the address's voucher, ordered by creation time.
+// Vouchers created before origins were tracked have no origins.
+func (k Keeper) GetVoucherOrigins(ctx sdk.Context, denom string, addr sdk.AccAddress) ([]*types.VoucherOrigin, error) {
+	store := k.getVoucherOriginsStore(ctx, denom, addr)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	origins := make([]*types.VoucherOrigin, 0)
+	for ; iter.Valid(); iter.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+
+		createdAt, sender := parseVoucherOriginKey(iter.Key())
+		origins = append(origins, &types.VoucherOrigin{
+			Address:   addr.String(),
+			Sender:    sender.String(),
+			Amount:    sdk.NewCoin(denom, amount),
+			CreatedAt: createdAt,
+		})
+	}
+	return origins, nil
+}
+
+func (k Keeper) getAllVoucherOrigins(ctx sdk.Context) ([]*types.VoucherOrigin, error) {
+	store := k.getAllVoucherOriginsStore(ctx)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	origins := make([]*types.VoucherOrigin, 0)
+	for ; iter.Valid(); iter.Next() {
+		var amount math.Int
+		if err := amount.Unmarshal(iter.Value()); err != nil {
+			return nil, err
+		}
+
+		denom, addr, createdAt, sender := parseFullVoucherOriginKey(iter.Key())
+		origins = append(origins, &types.VoucherOrigin{
+			Address:   addr.String(),
+			Sender:    sender.String(),
+			Amount:    sdk.NewCoin(denom, amount),
+			CreatedAt: createdAt,
+		})
+	}
+	return origins, nil
+}
+
+func (k Keeper) deleteVoucherOrigins(ctx sdk.Context, denom string, addr sdk.AccAddress) {
+	store := k.getVoucherOriginsStore(ctx, denom, addr)
+	iter := store.Iterator(nil, nil)
+
+	keysToRemove := make([][]byte, 0)
+	for ; iter.Valid(); iter.Next() {
+		keysToRemove = append(keysToRemove, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keysToRemove {
+		store.Delete(key)
+	}
+}
+
+// claimVoucher sends the whole voucher of the address to it
+func (k Keeper) claimVoucher(ctx sdk.Context, receiver sdk.AccAddress, denom string) error {
+	voucher, err := k.GetVoucherForAddress(ctx, denom, receiver)
+	if err != nil {
+		return err
+	}
+	if voucher.IsZero() {
+		return types.ErrVoucherNotFound.Wrapf("no %s voucher for %s", denom, receiver)
+	}
+
+	// now claim voucher by sending funds from permissions module to receiver and then removing the voucher
+	// please note the user will not be able to claim if he still does not have permissions, since transfer hook will be called on this send again
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, receiver, sdk.NewCoins(voucher)); err != nil {
+		return err
+	}
+	k.deleteVoucher(ctx, receiver, denom)
+	k.deleteVoucherOrigins(ctx, denom, receiver)
+
+	return nil
+}
+
+// reclaimVoucher sends the whole voucher of the address to the reclaimer
+func (k Keeper) reclaimVoucher(ctx sdk.Context, reclaimer, addr sdk.AccAddress, denom string) error {
+	voucher, err := k.GetVoucherForAddress(ctx, denom, addr)
+	if err != nil {
+		return err
+	}
+	if voucher.IsZero() {
+		return types.ErrVoucherNotFound.Wrapf("no %s voucher for %s", denom, addr)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, reclaimer, sdk.NewCoins(voucher)); err != nil {
+		return err
+	}
+	k.deleteVoucher(ctx, addr, denom)
+	k.deleteVoucherOrigins(ctx, denom, addr)
+
+	// nolint:errcheck //ignored on purpose
+	ctx.EventManager().EmitTypedEvent(&types.EventVoucherReclaimed{
+		Addr:      addr.String(),
+		Reclaimer: reclaimer.String(),
+		Amount:    voucher,
+	})
+	return nil
+}
+
+// returnExpiredVouchers returns the expired parts of the address's voucher to their original senders. Parts sent by module
+// accounts are not returned, since modules do not expect to receive funds back, and parts that cannot be sent back (e.g. because the
+// sender lost the permission to receive) are kept. Returns the total amount returned.
+func (k Keeper) returnExpiredVouchers(ctx sdk.Context, denom string, addr sdk.AccAddress) (sdk.Coin, error) {
+	returned := types.NewEmptyVoucher(denom)
+
+	namespace, err := k.GetNamespace(ctx, denom, false)
+	if err != nil {
+		return returned, err
+	}
+
+	if namespace == nil || namespace.VoucherExpirySeconds == 0 {
+		return returned, types.ErrNoExpiredVouchers.Wrapf("vouchers for %s do not expire", denom)
+	}
+
+	origins, err := k.GetVoucherOrigins(ctx, denom, addr)
+	if err != nil {
+		return returned, err
+	}
+
+	voucher, err := k.GetVoucherForAddress(ctx, denom, addr)
+	if err != nil {
+		return returned, err
+	}
+
+	store := k.getVoucherOriginsStore(ctx, denom, addr)
+	expiredBefore := ctx.BlockTime().Unix() - namespace.VoucherExpirySeconds
+
+	for _, origin := range origins {
+		if origin.CreatedAt > expiredBefore {
+			// origins are ordered by creation time
+			break
+		}
+
+		sender := sdk.MustAccAddressFromBech32(origin.Sender)
+		if k.IsModuleAcc(sender) {
+			continue
+		}
+
+		// defensive, never return more than the voucher holds
+		amount := sdk.NewCoin(denom, math.MinInt(origin.Amount.Amount, voucher.Amount))
+		if amount.IsZero() {
+			break
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(cacheCtx, types.ModuleName, sender, sdk.NewCoins(amount)); err != nil {
+			k.Logger(ctx).Debug("failed to return expired voucher", "address", addr.String(), "sender", sender.String(), "error", err)
+			continue
+		}
+		writeCache()
+
+		store.Delete(getVoucherOriginKey(origin.CreatedAt, sender))
+		voucher = voucher.Sub(amount)
+		returned = returned.Add(amount)
+
+		// nolint:errcheck //ignored on purpose
+		ctx.EventManager().EmitTypedEvent(&types.EventVoucherReturned{
+			Addr:   addr.String(),
+			Sender: sender.String(),
+			Amount: amount,
+		})
+	}
+
+	if returned.IsZero() {
+		return returned, types.ErrNoExpiredVouchers.Wrapf("no returnable expired %s voucher for %s", denom, addr)
+	}
+
+	if voucher.IsZero() {
+		k.deleteVoucher(ctx, addr, denom)
+		k.deleteVoucherOrigins(ctx, denom, addr)
+		return returned, nil
+	}
+
+	return returned, k.setVoucher(ctx, addr, voucher)
+}
@@ -16,6 +16,11 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgCreateNamespace{}, "permissions/MsgCreateNamespace", nil)
 	cdc.RegisterConcrete(&MsgUpdateNamespace{}, "permissions/MsgUpdateNamespace", nil)
 	cdc.RegisterConcrete(&MsgClaimVoucher{}, "permissions/MsgClaimVoucher", nil)
+	cdc.RegisterConcrete(&MsgUpdateRoleLimits{}, "permissions/MsgUpdateRoleLimits", nil)
+	cdc.RegisterConcrete(&MsgClaimVouchers{}, "permissions/MsgClaimVouchers", nil)
+	cdc.RegisterConcrete(&MsgReturnExpiredVouchers{}, "permissions/MsgReturnExpiredVouchers", nil)
+	cdc.RegisterConcrete(&MsgReclaimVoucher{}, "permissions/MsgReclaimVoucher", nil)
+	cdc.RegisterConcrete(&MsgSetFrozenBalance{}, "permissions/MsgSetFrozenBalance", nil)
 }
 
 func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
@@ -24,6 +29,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgCreateNamespace{},
 		&MsgUpdateNamespace{},
 		&MsgClaimVoucher{},
+		&MsgUpdateRoleLimits{},
+		&MsgClaimVouchers{},
+		&MsgReturnExpiredVouchers{},
+		&MsgReclaimVoucher{},
+		&MsgSetFrozenBalance{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -25,4 +25,8 @@ var (
 	ErrUnauthorizedPolicyChange = errors.Register(ModuleName, 16, "unauthorized policy change")
 	ErrInvalidEVMHook           = errors.Register(ModuleName, 17, "invalid evm hook")
 	ErrInvalidERC20Denom        = errors.Register(ModuleName, 18, "invalid erc20 denom")
+	ErrInvalidRoleLimits        = errors.Register(ModuleName, 19, "invalid role limits")
+	ErrTransferLimitExceeded    = errors.Register(ModuleName, 20, "transfer limit exceeded")
+	ErrNoExpiredVouchers        = errors.Register(ModuleName, 21, "no expired vouchers")
+	ErrBalanceFrozen            = errors.Register(ModuleName, 22, "balance is frozen")
 )
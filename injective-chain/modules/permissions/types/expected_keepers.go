@@ -14,6 +14,7 @@ type BankKeeper interface {
 	PrependSendRestriction(restriction banktypes.SendRestrictionFn)
 	ClearSendRestriction()
 	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
 }
 
 type TokenFactoryKeeper interface {
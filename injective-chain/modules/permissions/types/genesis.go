@@ -63,5 +63,40 @@ func (gs GenesisState) Validate() error {
 		}
 	}
 
+	for _, origin := range gs.VoucherOrigins {
+		if _, err := sdk.AccAddressFromBech32(origin.Address); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid voucher origin address %s", origin.Address)
+		}
+
+		if _, err := sdk.AccAddressFromBech32(origin.Sender); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid voucher origin sender %s", origin.Sender)
+		}
+
+		if err := origin.Amount.Validate(); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid voucher origin amount: %s", err)
+		}
+	}
+
+	seenFrozenBalances := map[string]struct{}{}
+	for _, frozen := range gs.FrozenBalances {
+		if _, err := sdk.AccAddressFromBech32(frozen.Address); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid frozen balance address %s", frozen.Address)
+		}
+
+		if err := frozen.Amount.Validate(); err != nil || frozen.Amount.IsZero() {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid frozen amount %s for %s", frozen.Amount, frozen.Address)
+		}
+
+		if _, ok := seenDenoms[frozen.Amount.Denom]; !ok {
+			return errors.Wrapf(ErrInvalidGenesis, "frozen balance for denom %s without namespace", frozen.Amount.Denom)
+		}
+
+		key := frozen.Amount.Denom + "/" + frozen.Address
+		if _, ok := seenFrozenBalances[key]; ok {
+			return errors.Wrapf(ErrInvalidGenesis, "duplicate frozen balance of %s for %s", frozen.Amount.Denom, frozen.Address)
+		}
+		seenFrozenBalances[key] = struct{}{}
+	}
+
 	return nil
 }
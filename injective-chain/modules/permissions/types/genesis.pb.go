@@ -31,6 +31,10 @@ type GenesisState struct {
 	Namespaces []Namespace `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces"`
 	// vouchers defines the vouchers of the module
 	Vouchers []*AddressVoucher `protobuf:"bytes,3,rep,name=vouchers,proto3" json:"vouchers,omitempty"`
+	// voucher_origins defines the original senders of the vouchers
+	VoucherOrigins []*VoucherOrigin `protobuf:"bytes,4,rep,name=voucher_origins,json=voucherOrigins,proto3" json:"voucher_origins,omitempty"`
+	// frozen_balances defines the frozen balances of the module
+	FrozenBalances []*FrozenBalance `protobuf:"bytes,5,rep,name=frozen_balances,json=frozenBalances,proto3" json:"frozen_balances,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -87,6 +91,20 @@ func (m *GenesisState) GetVouchers() []*AddressVoucher {
 	return nil
 }
 
+func (m *GenesisState) GetVoucherOrigins() []*VoucherOrigin {
+	if m != nil {
+		return m.VoucherOrigins
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFrozenBalances() []*FrozenBalance {
+	if m != nil {
+		return m.FrozenBalances
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.permissions.v1beta1.GenesisState")
 }
@@ -96,26 +114,30 @@ func init() {
 }
 
 var fileDescriptor_5ff1982ce1793022 = []byte{
-	// 299 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x90, 0x41, 0x4b, 0xc3, 0x30,
-	0x14, 0x80, 0x1b, 0x27, 0x43, 0x32, 0x4f, 0xc5, 0xc3, 0x18, 0x18, 0x87, 0x20, 0x0c, 0x65, 0x09,
-	0x9b, 0xbf, 0xc0, 0x79, 0x90, 0x81, 0x0c, 0x9d, 0xe0, 0xc1, 0x5b, 0xda, 0x3d, 0xba, 0xa8, 0x6d,
-	0x4a, 0x5e, 0x5a, 0xf0, 0x5f, 0xf8, 0xb3, 0x76, 0xdc, 0xd1, 0x93, 0x48, 0x7b, 0xf7, 0x37, 0x88,
-	0x6d, 0x37, 0xeb, 0x65, 0xbd, 0x25, 0xf0, 0x7d, 0xdf, 0x7b, 0x3c, 0x7a, 0xa1, 0xa2, 0x67, 0xf0,
-	0xad, 0x4a, 0x41, 0xc4, 0x60, 0x42, 0x85, 0xa8, 0x74, 0x84, 0x22, 0x1d, 0x79, 0x60, 0xe5, 0x48,
-	0x04, 0x10, 0x01, 0x2a, 0xe4, 0xb1, 0xd1, 0x56, 0xbb, 0xc7, 0x5b, 0x98, 0xd7, 0x60, 0x5e, 0xc1,
-	0xbd, 0xa3, 0x40, 0x07, 0xba, 0x20, 0xc5, 0xef, 0xab, 0x94, 0x7a, 0xe7, 0xbb, 0x27, 0xc4, 0xd2,
-	0xc8, 0xb0, 0x1a, 0xd0, 0x13, 0x0d, 0x6c, 0x6d, 0x68, 0x21, 0x9c, 0x7e, 0x13, 0x7a, 0x78, 0x53,
-	0xee, 0xf8, 0x60, 0xa5, 0x05, 0xf7, 0x9a, 0xb6, 0xcb, 0x62, 0x97, 0xf4, 0xc9, 0xa0, 0x33, 0x3e,
-	0xe3, 0x3b, 0x77, 0xe6, 0x77, 0x05, 0x3c, 0xd9, 0x5f, 0x7d, 0x9e, 0x38, 0xf3, 0x4a, 0x75, 0x67,
-	0x94, 0x46, 0x32, 0x04, 0x8c, 0xa5, 0x0f, 0xd8, 0xdd, 0xeb, 0xb7, 0x06, 0x9d, 0xf1, 0xa0, 0x21,
-	0x34, 0xdb, 0x08, 0x55, 0xab, 0x56, 0x70, 0xa7, 0xf4, 0x20, 0xd5, 0x89, 0xbf, 0x04, 0x83, 0xdd,
-	0x56, 0x51, 0x1b, 0x36, 0xd4, 0xae, 0x16, 0x0b, 0x03, 0x88, 0x8f, 0xa5, 0x35, 0xdf, 0xea, 0x93,
-	0x97, 0x55, 0xc6, 0xc8, 0x3a, 0x63, 0xe4, 0x2b, 0x63, 0xe4, 0x3d, 0x67, 0xce, 0x3a, 0x67, 0xce,
-	0x47, 0xce, 0x9c, 0xa7, 0xfb, 0x40, 0xd9, 0x65, 0xe2, 0x71, 0x5f, 0x87, 0x62, 0xba, 0x89, 0xdf,
-	0x4a, 0x0f, 0xff, 0x8e, 0x3a, 0xf4, 0xb5, 0x81, 0xfa, 0x77, 0x29, 0x55, 0x24, 0x42, 0xbd, 0x48,
-	0x5e, 0x01, 0xff, 0x5d, 0xdc, 0xbe, 0xc5, 0x80, 0x5e, 0xbb, 0x38, 0xf2, 0xe5, 0x4f, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0x83, 0x97, 0x25, 0xeb, 0x25, 0x02, 0x00, 0x00,
+	// 356 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0xc1, 0x4a, 0xeb, 0x40,
+	0x14, 0x86, 0x93, 0xdb, 0xde, 0x72, 0x49, 0x2f, 0xbd, 0x10, 0xee, 0x22, 0x14, 0x8c, 0x45, 0x10,
+	0x8a, 0xda, 0x84, 0xd6, 0x27, 0xb0, 0x82, 0x52, 0x90, 0xaa, 0x11, 0x5d, 0xb8, 0x29, 0x93, 0xf4,
+	0x34, 0x1d, 0x6d, 0x66, 0xc2, 0x9c, 0x69, 0x40, 0x9f, 0xc2, 0x07, 0xf0, 0x81, 0xba, 0xec, 0xd2,
+	0x95, 0x48, 0xfb, 0x22, 0xd2, 0x49, 0x5a, 0xe3, 0xa6, 0x71, 0x37, 0x07, 0xfe, 0xef, 0xfb, 0xcf,
+	0xc0, 0x31, 0x0e, 0x29, 0x7b, 0x80, 0x40, 0xd2, 0x04, 0xdc, 0x18, 0x44, 0x44, 0x11, 0x29, 0x67,
+	0xe8, 0x26, 0x6d, 0x1f, 0x24, 0x69, 0xbb, 0x21, 0x30, 0x40, 0x8a, 0x4e, 0x2c, 0xb8, 0xe4, 0xe6,
+	0xce, 0x26, 0xec, 0xe4, 0xc2, 0x4e, 0x16, 0xae, 0xff, 0x0f, 0x79, 0xc8, 0x55, 0xd2, 0x5d, 0xbd,
+	0x52, 0xa8, 0x7e, 0xb0, 0xbd, 0x21, 0x26, 0x82, 0x44, 0x59, 0x41, 0xdd, 0x2d, 0xc8, 0xe6, 0x4a,
+	0x15, 0xb0, 0xf7, 0x5a, 0x32, 0xfe, 0x9e, 0xa7, 0x3b, 0xde, 0x48, 0x22, 0xc1, 0x3c, 0x35, 0x2a,
+	0xa9, 0xd1, 0xd2, 0x1b, 0x7a, 0xb3, 0xda, 0xd9, 0x77, 0xb6, 0xee, 0xec, 0x5c, 0xa9, 0x70, 0xb7,
+	0x3c, 0x7b, 0xdf, 0xd5, 0xbc, 0x0c, 0x35, 0xfb, 0x86, 0xc1, 0x48, 0x04, 0x18, 0x93, 0x00, 0xd0,
+	0xfa, 0xd5, 0x28, 0x35, 0xab, 0x9d, 0x66, 0x81, 0xa8, 0xbf, 0x06, 0x32, 0x57, 0xce, 0x60, 0xf6,
+	0x8c, 0x3f, 0x09, 0x9f, 0x06, 0x63, 0x10, 0x68, 0x95, 0x94, 0xad, 0x55, 0x60, 0x3b, 0x19, 0x0e,
+	0x05, 0x20, 0xde, 0xa5, 0x94, 0xb7, 0xc1, 0xcd, 0x5b, 0xe3, 0x5f, 0xf6, 0x1e, 0x70, 0x41, 0x43,
+	0xca, 0xd0, 0x2a, 0x2b, 0xe3, 0x51, 0x81, 0x31, 0x53, 0x5d, 0x2a, 0xc8, 0xab, 0x25, 0xf9, 0x51,
+	0x69, 0x47, 0x82, 0x3f, 0x03, 0x1b, 0xf8, 0x64, 0x42, 0xd8, 0xea, 0xdb, 0xbf, 0x7f, 0xa4, 0x3d,
+	0x53, 0x54, 0x37, 0x85, 0xbc, 0xda, 0x28, 0x3f, 0x62, 0xf7, 0x71, 0xb6, 0xb0, 0xf5, 0xf9, 0xc2,
+	0xd6, 0x3f, 0x16, 0xb6, 0xfe, 0xb2, 0xb4, 0xb5, 0xf9, 0xd2, 0xd6, 0xde, 0x96, 0xb6, 0x76, 0x7f,
+	0x1d, 0x52, 0x39, 0x9e, 0xfa, 0x4e, 0xc0, 0x23, 0xb7, 0xb7, 0x6e, 0xb8, 0x20, 0x3e, 0x7e, 0x9d,
+	0x40, 0x2b, 0xe0, 0x02, 0xf2, 0xe3, 0x98, 0x50, 0xe6, 0x46, 0x7c, 0x38, 0x9d, 0x00, 0x7e, 0xbb,
+	0x0f, 0xf9, 0x14, 0x03, 0xfa, 0x15, 0x75, 0x12, 0xc7, 0x9f, 0x03, 0x00, 0x03, 0x67, 0x09, 0x38,
+	0xd3, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -138,6 +160,34 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.FrozenBalances) > 0 {
+		for iNdEx := len(m.FrozenBalances) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FrozenBalances[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.VoucherOrigins) > 0 {
+		for iNdEx := len(m.VoucherOrigins) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.VoucherOrigins[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
 	if len(m.Vouchers) > 0 {
 		for iNdEx := len(m.Vouchers) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -210,6 +260,18 @@ func (m *GenesisState) Size() (n int) {
 			n += 1 + l + sovGenesis(uint64(l))
 		}
 	}
+	if len(m.VoucherOrigins) > 0 {
+		for _, e := range m.VoucherOrigins {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.FrozenBalances) > 0 {
+		for _, e := range m.FrozenBalances {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -349,6 +411,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherOrigins", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.VoucherOrigins = append(m.VoucherOrigins, &VoucherOrigin{})
+			if err := m.VoucherOrigins[len(m.VoucherOrigins)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FrozenBalances", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FrozenBalances = append(m.FrozenBalances, &FrozenBalance{})
+			if err := m.FrozenBalances[len(m.FrozenBalances)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -19,6 +19,12 @@ const (
 	TypeMsgCreateNamespace = "create_namespace"
 	TypeUpdateNamespace    = "update_namespace"
 	TypeMsgClaimVoucher    = "claim_voucher"
+
+	TypeMsgUpdateRoleLimits      = "update_role_limits"
+	TypeMsgClaimVouchers         = "claim_vouchers"
+	TypeMsgReturnExpiredVouchers = "return_expired_vouchers"
+	TypeMsgReclaimVoucher        = "reclaim_voucher"
+	TypeMsgSetFrozenBalance      = "set_frozen_balance"
 )
 
 var (
@@ -27,6 +33,11 @@ var (
 	_ sdk.Msg = &MsgUpdateNamespace{}
 	_ sdk.Msg = &MsgUpdateActorRoles{}
 	_ sdk.Msg = &MsgClaimVoucher{}
+	_ sdk.Msg = &MsgUpdateRoleLimits{}
+	_ sdk.Msg = &MsgClaimVouchers{}
+	_ sdk.Msg = &MsgReturnExpiredVouchers{}
+	_ sdk.Msg = &MsgReclaimVoucher{}
+	_ sdk.Msg = &MsgSetFrozenBalance{}
 )
 
 func (m MsgUpdateParams) Route() string { return routerKey }
@@ -119,6 +130,9 @@ func (msg MsgUpdateNamespace) ValidateBasic() error {
 			return ErrInvalidEVMHook
 		}
 	}
+	if msg.VoucherExpiry != nil && msg.VoucherExpiry.NewValue < 0 {
+		return ErrInvalidNamespace.Wrapf("invalid voucher expiry %d", msg.VoucherExpiry.NewValue)
+	}
 
 	namespace := Namespace{
 		Denom:                     msg.Denom,
@@ -156,6 +170,7 @@ type NamespaceUpdates struct {
 	HasRoleManagersChange    bool
 	HasPolicyStatusesChange  bool
 	HasPolicyManagersChange  bool
+	HasVoucherExpiryChange   bool
 	ChangeActions            []Action
 }
 
@@ -190,6 +205,12 @@ func (msg MsgUpdateNamespace) GetNamespaceUpdates() NamespaceUpdates {
 		changes.HasPolicyManagersChange = true
 	}
 
+	// the voucher expiry decides when funds can be returned to their senders, so it shares the reclaim capability
+	if msg.VoucherExpiry != nil {
+		actions = append(actions, Action_RECLAIM_VOUCHERS)
+		changes.HasVoucherExpiryChange = true
+	}
+
 	changes.ChangeActions = actions
 	return changes
 }
@@ -223,6 +244,10 @@ func (msg MsgUpdateActorRoles) ValidateBasic() error {
 		}
 		roles[roleName] = struct{}{}
 
+		if role.ExpirationTimestamp < 0 {
+			return ErrInvalidRole.Wrapf("invalid expiration timestamp %d for role %s", role.ExpirationTimestamp, roleName)
+		}
+
 		for _, actor := range role.Actors {
 			if _, err := sdk.AccAddressFromBech32(actor); err != nil {
 				return err
@@ -251,6 +276,10 @@ func (msg MsgUpdateActorRoles) ValidateBasic() error {
 		}
 		roles[roleName] = struct{}{}
 
+		if role.ExpirationTimestamp != 0 {
+			return ErrInvalidRole.Wrapf("expiration timestamp cannot be set for role %s to revoke", roleName)
+		}
+
 		for _, actor := range role.Actors {
 			if _, err := sdk.AccAddressFromBech32(actor); err != nil {
 				return err
@@ -331,3 +360,162 @@ func (m MsgClaimVoucher) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{addr}
 }
+
+func (m MsgUpdateRoleLimits) Route() string { return routerKey }
+
+func (m MsgUpdateRoleLimits) Type() string { return TypeMsgUpdateRoleLimits }
+
+func (msg MsgUpdateRoleLimits) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Denom == "" {
+		return ErrUnknownDenom
+	}
+
+	if len(msg.RoleLimits) == 0 {
+		return ErrInvalidRoleLimits.Wrap("no role limits to update")
+	}
+
+	roles := make(map[string]struct{}, len(msg.RoleLimits))
+	for _, roleLimits := range msg.RoleLimits {
+		if err := roleLimits.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := roles[roleLimits.Role]; ok {
+			return ErrInvalidRoleLimits.Wrapf("repeated limits for role %s", roleLimits.Role)
+		}
+		roles[roleLimits.Role] = struct{}{}
+	}
+
+	return nil
+}
+
+func (m *MsgUpdateRoleLimits) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgUpdateRoleLimits) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgClaimVouchers) Route() string { return routerKey }
+
+func (m MsgClaimVouchers) Type() string { return TypeMsgClaimVouchers }
+
+func (msg MsgClaimVouchers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if len(msg.Denoms) == 0 {
+		return fmt.Errorf("no denoms to claim")
+	}
+
+	for _, denom := range msg.Denoms {
+		if denom == "" {
+			return fmt.Errorf("invalid denom")
+		}
+	}
+
+	if chaintypes.HasDuplicate(msg.Denoms) {
+		return fmt.Errorf("duplicate denoms")
+	}
+	return nil
+}
+
+func (m *MsgClaimVouchers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgClaimVouchers) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgReturnExpiredVouchers) Route() string { return routerKey }
+
+func (m MsgReturnExpiredVouchers) Type() string { return TypeMsgReturnExpiredVouchers }
+
+func (msg MsgReturnExpiredVouchers) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Denom == "" {
+		return fmt.Errorf("invalid denom")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MsgReturnExpiredVouchers) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgReturnExpiredVouchers) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgReclaimVoucher) Route() string { return routerKey }
+
+func (m MsgReclaimVoucher) Type() string { return TypeMsgReclaimVoucher }
+
+func (msg MsgReclaimVoucher) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if msg.Denom == "" {
+		return fmt.Errorf("invalid denom")
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MsgReclaimVoucher) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgReclaimVoucher) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
+
+func (m MsgSetFrozenBalance) Route() string { return routerKey }
+
+func (m MsgSetFrozenBalance) Type() string { return TypeMsgSetFrozenBalance }
+
+func (msg MsgSetFrozenBalance) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Sender); err != nil {
+		return err
+	}
+
+	if _, err := sdk.AccAddressFromBech32(msg.Address); err != nil {
+		return err
+	}
+
+	if err := msg.Amount.Validate(); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *MsgSetFrozenBalance) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshal(m))
+}
+
+func (m MsgSetFrozenBalance) GetSigners() []sdk.AccAddress {
+	addr, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{addr}
+}
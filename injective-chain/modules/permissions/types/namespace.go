@@ -1,6 +1,8 @@
 package types
 
 import (
+	"slices"
+
 	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -85,6 +87,7 @@ func (n *Namespace) ValidateRoles(isForUpdate bool) error {
 	}
 
 	foundActors := make(map[string]struct{}, len(n.ActorRoles))
+	actorRolesByActor := make(map[string][]string, len(n.ActorRoles))
 	for _, actorRoles := range n.ActorRoles {
 		actor, err := sdk.AccAddressFromBech32(actorRoles.Actor)
 		if err != nil {
@@ -99,6 +102,7 @@ func (n *Namespace) ValidateRoles(isForUpdate bool) error {
 			return errors.Wrapf(ErrInvalidRole, "duplicate actor %s", actor)
 		}
 		foundActors[actor.String()] = struct{}{}
+		actorRolesByActor[actor.String()] = actorRoles.Roles
 
 		for _, role := range actorRoles.Roles {
 			if _, ok := foundRoleNames[role]; !ok {
@@ -111,6 +115,45 @@ func (n *Namespace) ValidateRoles(isForUpdate bool) error {
 		}
 	}
 
+	foundExpirations := make(map[string]struct{}, len(n.ActorRoleExpirations))
+	for _, expiration := range n.ActorRoleExpirations {
+		actor, err := sdk.AccAddressFromBech32(expiration.Actor)
+		if err != nil {
+			return errors.Wrapf(err, "invalid actor address %s", expiration.Actor)
+		}
+
+		if expiration.ExpirationTimestamp <= 0 {
+			return errors.Wrapf(ErrInvalidRole, "invalid expiration timestamp %d for actor %s", expiration.ExpirationTimestamp, actor)
+		}
+
+		actorRoles, ok := actorRolesByActor[actor.String()]
+		if !ok || !slices.Contains(actorRoles, expiration.Role) {
+			return errors.Wrapf(ErrInvalidRole, "actor %s is not assigned to role %s", actor, expiration.Role)
+		}
+
+		key := actor.String() + "/" + expiration.Role
+		if _, ok := foundExpirations[key]; ok {
+			return errors.Wrapf(ErrInvalidRole, "repeated expiration of role %s for actor %s", expiration.Role, actor)
+		}
+		foundExpirations[key] = struct{}{}
+	}
+
+	foundRoleLimits := make(map[string]struct{}, len(n.RoleLimits))
+	for _, roleLimits := range n.RoleLimits {
+		if err := roleLimits.Validate(); err != nil {
+			return err
+		}
+
+		if _, ok := foundRoleNames[roleLimits.Role]; !ok {
+			return errors.Wrapf(ErrUnknownRole, "role %s must be defined", roleLimits.Role)
+		}
+
+		if _, ok := foundRoleLimits[roleLimits.Role]; ok {
+			return errors.Wrapf(ErrInvalidRoleLimits, "repeated limits for role %s", roleLimits.Role)
+		}
+		foundRoleLimits[roleLimits.Role] = struct{}{}
+	}
+
 	foundRoleManagers := make(map[string]struct{}, len(n.RoleManagers))
 	for _, roleManager := range n.RoleManagers {
 		manager, err := sdk.AccAddressFromBech32(roleManager.Manager)
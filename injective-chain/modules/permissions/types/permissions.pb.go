@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
@@ -41,6 +42,10 @@ const (
 	Action_SEND Action = 8
 	// 16 is reserved for SUPER_BURN
 	Action_SUPER_BURN Action = 16
+	// 2^25 is reserved for FREEZE_BALANCES
+	Action_FREEZE_BALANCES Action = 33554432
+	// 2^26 is reserved for RECLAIM_VOUCHERS
+	Action_RECLAIM_VOUCHERS Action = 67108864
 	// 2^27 is reserved for MODIFY_POLICY_MANAGERS
 	Action_MODIFY_POLICY_MANAGERS Action = 134217728
 	// 2^28 is reserved for MODIFY_CONTRACT_HOOK
@@ -58,6 +63,8 @@ var Action_name = map[int32]string{
 	4:          "BURN",
 	8:          "SEND",
 	16:         "SUPER_BURN",
+	33554432:   "FREEZE_BALANCES",
+	67108864:   "RECLAIM_VOUCHERS",
 	134217728:  "MODIFY_POLICY_MANAGERS",
 	268435456:  "MODIFY_CONTRACT_HOOK",
 	536870912:  "MODIFY_ROLE_PERMISSIONS",
@@ -71,6 +78,8 @@ var Action_value = map[string]int32{
 	"BURN":                    4,
 	"SEND":                    8,
 	"SUPER_BURN":              16,
+	"FREEZE_BALANCES":         33554432,
+	"RECLAIM_VOUCHERS":        67108864,
 	"MODIFY_POLICY_MANAGERS":  134217728,
 	"MODIFY_CONTRACT_HOOK":    268435456,
 	"MODIFY_ROLE_PERMISSIONS": 536870912,
@@ -103,6 +112,13 @@ type Namespace struct {
 	PolicyManagerCapabilities []*PolicyManagerCapability `protobuf:"bytes,7,rep,name=policy_manager_capabilities,json=policyManagerCapabilities,proto3" json:"policy_manager_capabilities,omitempty"`
 	// The address of the EVM contract to map code-based permissions
 	EvmHook string `protobuf:"bytes,8,opt,name=evm_hook,json=evmHook,proto3" json:"evm_hook,omitempty"`
+	// expiration timestamps of time-bounded actor role assignments
+	ActorRoleExpirations []*ActorRoleExpiration `protobuf:"bytes,9,rep,name=actor_role_expirations,json=actorRoleExpirations,proto3" json:"actor_role_expirations,omitempty"`
+	// amount-based transfer limits for each role
+	RoleLimits []*RoleLimits `protobuf:"bytes,10,rep,name=role_limits,json=roleLimits,proto3" json:"role_limits,omitempty"`
+	// number of seconds after which vouchers can be returned to their original
+	// senders, 0 if vouchers never expire
+	VoucherExpirySeconds int64 `protobuf:"varint,11,opt,name=voucher_expiry_seconds,json=voucherExpirySeconds,proto3" json:"voucher_expiry_seconds,omitempty"`
 }
 
 func (m *Namespace) Reset()         { *m = Namespace{} }
@@ -194,6 +210,27 @@ func (m *Namespace) GetEvmHook() string {
 	return ""
 }
 
+func (m *Namespace) GetActorRoleExpirations() []*ActorRoleExpiration {
+	if m != nil {
+		return m.ActorRoleExpirations
+	}
+	return nil
+}
+
+func (m *Namespace) GetRoleLimits() []*RoleLimits {
+	if m != nil {
+		return m.RoleLimits
+	}
+	return nil
+}
+
+func (m *Namespace) GetVoucherExpirySeconds() int64 {
+	if m != nil {
+		return m.VoucherExpirySeconds
+	}
+	return 0
+}
+
 // AddressRoles defines roles for an actor
 type ActorRoles struct {
 	// The actor name
@@ -255,6 +292,10 @@ type RoleActors struct {
 	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
 	// List of actor names associated with the role
 	Actors []string `protobuf:"bytes,2,rep,name=actors,proto3" json:"actors,omitempty"`
+	// Optional unix timestamp (in seconds) at which the role assignment of the
+	// actors expires. Zero means the assignment never expires. Only applies when
+	// adding roles.
+	ExpirationTimestamp int64 `protobuf:"varint,3,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
 }
 
 func (m *RoleActors) Reset()         { *m = RoleActors{} }
@@ -304,6 +345,200 @@ func (m *RoleActors) GetActors() []string {
 	return nil
 }
 
+func (m *RoleActors) GetExpirationTimestamp() int64 {
+	if m != nil {
+		return m.ExpirationTimestamp
+	}
+	return 0
+}
+
+// ActorRoleExpiration defines when a role assignment of an actor expires
+type ActorRoleExpiration struct {
+	// The actor name
+	Actor string `protobuf:"bytes,1,opt,name=actor,proto3" json:"actor,omitempty"`
+	// The role name
+	Role string `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	// The unix timestamp (in seconds) at which the role assignment expires
+	ExpirationTimestamp int64 `protobuf:"varint,3,opt,name=expiration_timestamp,json=expirationTimestamp,proto3" json:"expiration_timestamp,omitempty"`
+}
+
+func (m *ActorRoleExpiration) Reset()         { *m = ActorRoleExpiration{} }
+func (m *ActorRoleExpiration) String() string { return proto.CompactTextString(m) }
+func (*ActorRoleExpiration) ProtoMessage()    {}
+func (*ActorRoleExpiration) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{3}
+}
+func (m *ActorRoleExpiration) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ActorRoleExpiration) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ActorRoleExpiration.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ActorRoleExpiration) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ActorRoleExpiration.Merge(m, src)
+}
+func (m *ActorRoleExpiration) XXX_Size() int {
+	return m.Size()
+}
+func (m *ActorRoleExpiration) XXX_DiscardUnknown() {
+	xxx_messageInfo_ActorRoleExpiration.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ActorRoleExpiration proto.InternalMessageInfo
+
+func (m *ActorRoleExpiration) GetActor() string {
+	if m != nil {
+		return m.Actor
+	}
+	return ""
+}
+
+func (m *ActorRoleExpiration) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *ActorRoleExpiration) GetExpirationTimestamp() int64 {
+	if m != nil {
+		return m.ExpirationTimestamp
+	}
+	return 0
+}
+
+// RoleLimits defines the amount-based transfer limits of a role. A zero limit
+// means the role is not limited.
+type RoleLimits struct {
+	// The role name
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// Maximum amount an actor may send within the rolling window
+	SendLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=send_limit,json=sendLimit,proto3,customtype=cosmossdk.io/math.Int" json:"send_limit"`
+	// Maximum amount an actor may receive within the rolling window
+	ReceiveLimit cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=receive_limit,json=receiveLimit,proto3,customtype=cosmossdk.io/math.Int" json:"receive_limit"`
+	// The length of the rolling window in seconds, required when a send or
+	// receive limit is set
+	WindowSeconds int64 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+	// Maximum balance an actor may hold after receiving tokens
+	MaxBalance cosmossdk_io_math.Int `protobuf:"bytes,5,opt,name=max_balance,json=maxBalance,proto3,customtype=cosmossdk.io/math.Int" json:"max_balance"`
+}
+
+func (m *RoleLimits) Reset()         { *m = RoleLimits{} }
+func (m *RoleLimits) String() string { return proto.CompactTextString(m) }
+func (*RoleLimits) ProtoMessage()    {}
+func (*RoleLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{4}
+}
+func (m *RoleLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleLimits.Merge(m, src)
+}
+func (m *RoleLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleLimits.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleLimits proto.InternalMessageInfo
+
+func (m *RoleLimits) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleLimits) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
+// RoleTransferUsage defines the amounts an actor transferred within the
+// rolling window of a limited role
+type RoleTransferUsage struct {
+	// The role name
+	Role string `protobuf:"bytes,1,opt,name=role,proto3" json:"role,omitempty"`
+	// The amount sent within the window
+	Sent cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=sent,proto3,customtype=cosmossdk.io/math.Int" json:"sent"`
+	// The amount received within the window
+	Received cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=received,proto3,customtype=cosmossdk.io/math.Int" json:"received"`
+	// The length of the rolling window in seconds
+	WindowSeconds int64 `protobuf:"varint,4,opt,name=window_seconds,json=windowSeconds,proto3" json:"window_seconds,omitempty"`
+}
+
+func (m *RoleTransferUsage) Reset()         { *m = RoleTransferUsage{} }
+func (m *RoleTransferUsage) String() string { return proto.CompactTextString(m) }
+func (*RoleTransferUsage) ProtoMessage()    {}
+func (*RoleTransferUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{5}
+}
+func (m *RoleTransferUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RoleTransferUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RoleTransferUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RoleTransferUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RoleTransferUsage.Merge(m, src)
+}
+func (m *RoleTransferUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *RoleTransferUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_RoleTransferUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RoleTransferUsage proto.InternalMessageInfo
+
+func (m *RoleTransferUsage) GetRole() string {
+	if m != nil {
+		return m.Role
+	}
+	return ""
+}
+
+func (m *RoleTransferUsage) GetWindowSeconds() int64 {
+	if m != nil {
+		return m.WindowSeconds
+	}
+	return 0
+}
+
 // RoleManager defines roles for a manager address
 type RoleManager struct {
 	// The manager name
@@ -316,7 +551,7 @@ func (m *RoleManager) Reset()         { *m = RoleManager{} }
 func (m *RoleManager) String() string { return proto.CompactTextString(m) }
 func (*RoleManager) ProtoMessage()    {}
 func (*RoleManager) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{3}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{6}
 }
 func (m *RoleManager) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -373,7 +608,7 @@ func (m *PolicyStatus) Reset()         { *m = PolicyStatus{} }
 func (m *PolicyStatus) String() string { return proto.CompactTextString(m) }
 func (*PolicyStatus) ProtoMessage()    {}
 func (*PolicyStatus) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{4}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{7}
 }
 func (m *PolicyStatus) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -438,7 +673,7 @@ func (m *Role) Reset()         { *m = Role{} }
 func (m *Role) String() string { return proto.CompactTextString(m) }
 func (*Role) ProtoMessage()    {}
 func (*Role) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{5}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{8}
 }
 func (m *Role) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -504,7 +739,7 @@ func (m *PolicyManagerCapability) Reset()         { *m = PolicyManagerCapability
 func (m *PolicyManagerCapability) String() string { return proto.CompactTextString(m) }
 func (*PolicyManagerCapability) ProtoMessage()    {}
 func (*PolicyManagerCapability) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{6}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{9}
 }
 func (m *PolicyManagerCapability) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -570,7 +805,7 @@ func (m *RoleIDs) Reset()         { *m = RoleIDs{} }
 func (m *RoleIDs) String() string { return proto.CompactTextString(m) }
 func (*RoleIDs) ProtoMessage()    {}
 func (*RoleIDs) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{7}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{10}
 }
 func (m *RoleIDs) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -618,7 +853,7 @@ func (m *AddressVoucher) Reset()         { *m = AddressVoucher{} }
 func (m *AddressVoucher) String() string { return proto.CompactTextString(m) }
 func (*AddressVoucher) ProtoMessage()    {}
 func (*AddressVoucher) Descriptor() ([]byte, []int) {
-	return fileDescriptor_6d25f3ecf3806c6c, []int{8}
+	return fileDescriptor_6d25f3ecf3806c6c, []int{11}
 }
 func (m *AddressVoucher) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -654,17 +889,138 @@ func (m *AddressVoucher) GetAddress() string {
 	return ""
 }
 
+// VoucherOrigin records the part of an address's voucher that was sent by a
+// given sender at a given time
+type VoucherOrigin struct {
+	// The Injective address that the voucher is for
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The original sender of the funds
+	Sender string `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	// The amount sent
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+	// The unix timestamp (in seconds) at which the funds were rerouted to the
+	// voucher
+	CreatedAt int64 `protobuf:"varint,4,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+}
+
+func (m *VoucherOrigin) Reset()         { *m = VoucherOrigin{} }
+func (m *VoucherOrigin) String() string { return proto.CompactTextString(m) }
+func (*VoucherOrigin) ProtoMessage()    {}
+func (*VoucherOrigin) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{12}
+}
+func (m *VoucherOrigin) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *VoucherOrigin) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_VoucherOrigin.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *VoucherOrigin) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_VoucherOrigin.Merge(m, src)
+}
+func (m *VoucherOrigin) XXX_Size() int {
+	return m.Size()
+}
+func (m *VoucherOrigin) XXX_DiscardUnknown() {
+	xxx_messageInfo_VoucherOrigin.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_VoucherOrigin proto.InternalMessageInfo
+
+func (m *VoucherOrigin) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *VoucherOrigin) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *VoucherOrigin) GetCreatedAt() int64 {
+	if m != nil {
+		return m.CreatedAt
+	}
+	return 0
+}
+
+// FrozenBalance defines the amount of an address's balance that cannot be sent
+type FrozenBalance struct {
+	// The Injective address whose balance is frozen
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// The frozen amount, which may exceed the current balance
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,2,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
+}
+
+func (m *FrozenBalance) Reset()         { *m = FrozenBalance{} }
+func (m *FrozenBalance) String() string { return proto.CompactTextString(m) }
+func (*FrozenBalance) ProtoMessage()    {}
+func (*FrozenBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6d25f3ecf3806c6c, []int{13}
+}
+func (m *FrozenBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FrozenBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FrozenBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FrozenBalance.Merge(m, src)
+}
+func (m *FrozenBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *FrozenBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_FrozenBalance.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FrozenBalance proto.InternalMessageInfo
+
+func (m *FrozenBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterEnum("injective.permissions.v1beta1.Action", Action_name, Action_value)
 	proto.RegisterType((*Namespace)(nil), "injective.permissions.v1beta1.Namespace")
 	proto.RegisterType((*ActorRoles)(nil), "injective.permissions.v1beta1.ActorRoles")
 	proto.RegisterType((*RoleActors)(nil), "injective.permissions.v1beta1.RoleActors")
+	proto.RegisterType((*ActorRoleExpiration)(nil), "injective.permissions.v1beta1.ActorRoleExpiration")
+	proto.RegisterType((*RoleLimits)(nil), "injective.permissions.v1beta1.RoleLimits")
+	proto.RegisterType((*RoleTransferUsage)(nil), "injective.permissions.v1beta1.RoleTransferUsage")
 	proto.RegisterType((*RoleManager)(nil), "injective.permissions.v1beta1.RoleManager")
 	proto.RegisterType((*PolicyStatus)(nil), "injective.permissions.v1beta1.PolicyStatus")
 	proto.RegisterType((*Role)(nil), "injective.permissions.v1beta1.Role")
 	proto.RegisterType((*PolicyManagerCapability)(nil), "injective.permissions.v1beta1.PolicyManagerCapability")
 	proto.RegisterType((*RoleIDs)(nil), "injective.permissions.v1beta1.RoleIDs")
 	proto.RegisterType((*AddressVoucher)(nil), "injective.permissions.v1beta1.AddressVoucher")
+	proto.RegisterType((*VoucherOrigin)(nil), "injective.permissions.v1beta1.VoucherOrigin")
+	proto.RegisterType((*FrozenBalance)(nil), "injective.permissions.v1beta1.FrozenBalance")
 }
 
 func init() {
@@ -672,63 +1028,86 @@ func init() {
 }
 
 var fileDescriptor_6d25f3ecf3806c6c = []byte{
-	// 881 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0x41, 0x6f, 0x1b, 0x45,
-	0x14, 0xf6, 0xda, 0xae, 0xd7, 0x79, 0x6e, 0x12, 0x33, 0x8a, 0x9a, 0x4d, 0x4b, 0x6d, 0xcb, 0x80,
-	0x08, 0x85, 0xda, 0x6a, 0x90, 0x10, 0x97, 0x4a, 0x38, 0xf6, 0x96, 0x2e, 0xc4, 0x6b, 0x33, 0x4e,
-	0x2a, 0x95, 0xcb, 0x6a, 0xbc, 0x1e, 0x25, 0x43, 0xbc, 0x3b, 0x2b, 0xcf, 0xc6, 0xc8, 0x37, 0xdf,
-	0xb9, 0x70, 0xe0, 0x2f, 0x70, 0xe5, 0x77, 0xf4, 0x98, 0x23, 0xe2, 0x10, 0xa1, 0xe4, 0xc6, 0xaf,
-	0x40, 0x33, 0x3b, 0x6b, 0x6f, 0x25, 0xda, 0x44, 0x9c, 0x3c, 0xef, 0x7d, 0xfb, 0x7d, 0xf3, 0xde,
-	0xf7, 0x9e, 0x77, 0xa1, 0xcd, 0xc2, 0x9f, 0xa8, 0x1f, 0xb3, 0x39, 0x6d, 0x47, 0x74, 0x16, 0x30,
-	0x21, 0x18, 0x0f, 0x45, 0x7b, 0xfe, 0x6c, 0x4c, 0x63, 0xf2, 0x2c, 0x9b, 0x6b, 0x45, 0x33, 0x1e,
-	0x73, 0xf4, 0x78, 0x45, 0x68, 0x65, 0x41, 0x4d, 0x78, 0x58, 0xf3, 0xb9, 0x08, 0xb8, 0x68, 0x8f,
-	0x89, 0xa0, 0x2b, 0x15, 0x9f, 0xb3, 0x30, 0xa1, 0x3f, 0xdc, 0x39, 0xe5, 0xa7, 0x5c, 0x1d, 0xdb,
-	0xf2, 0x94, 0x64, 0x9b, 0xbf, 0x15, 0x61, 0xc3, 0x25, 0x01, 0x15, 0x11, 0xf1, 0x29, 0xda, 0x81,
-	0x7b, 0x13, 0x1a, 0xf2, 0xc0, 0x32, 0x1a, 0xc6, 0xfe, 0x06, 0x4e, 0x02, 0xf4, 0x08, 0x36, 0x7e,
-	0x26, 0x22, 0xf0, 0xce, 0x38, 0x3f, 0xb7, 0xf2, 0x0a, 0x29, 0xcb, 0xc4, 0x4b, 0xce, 0xcf, 0x91,
-	0x0b, 0xd5, 0x19, 0x9f, 0x52, 0x2f, 0x53, 0x92, 0x55, 0x68, 0x14, 0xf6, 0x2b, 0x07, 0x1f, 0xb5,
-	0xde, 0x5b, 0x70, 0x0b, 0xf3, 0x29, 0xc5, 0xdb, 0x92, 0x3c, 0x5c, 0xa3, 0xe8, 0x3b, 0xa8, 0x10,
-	0x3f, 0xe6, 0x33, 0x4f, 0x02, 0xc2, 0x2a, 0x2a, 0xa9, 0xcf, 0x6e, 0x91, 0xea, 0x48, 0x86, 0xd4,
-	0x13, 0x18, 0xc8, 0xea, 0x8c, 0x06, 0xb0, 0xa9, 0x6a, 0x0b, 0x48, 0x48, 0x4e, 0xe9, 0x4c, 0x58,
-	0xf7, 0x94, 0xda, 0x93, 0x3b, 0x14, 0xd6, 0x4f, 0x28, 0xf8, 0xfe, 0x6c, 0x1d, 0x08, 0x74, 0x0c,
-	0xdb, 0x11, 0x9f, 0x32, 0x7f, 0xe1, 0x89, 0x98, 0xc4, 0x17, 0x82, 0x0a, 0xab, 0xa4, 0x24, 0x3f,
-	0xbf, 0x45, 0x72, 0xa8, 0x58, 0x23, 0x45, 0xc2, 0x5b, 0x51, 0x26, 0xa2, 0x02, 0xcd, 0xe1, 0x91,
-	0x56, 0xd5, 0x85, 0x7a, 0x3e, 0x89, 0xc8, 0x98, 0x4d, 0x59, 0xcc, 0xa8, 0xb0, 0x4c, 0x75, 0xc3,
-	0x57, 0x77, 0xba, 0x41, 0x57, 0xda, 0x4d, 0xf9, 0x0b, 0xbc, 0x17, 0xfd, 0x27, 0xc0, 0xa8, 0x40,
-	0x7b, 0x50, 0xa6, 0x73, 0x3d, 0xd6, 0xb2, 0x1a, 0xab, 0x49, 0xe7, 0x6a, 0xaa, 0xcd, 0xaf, 0x01,
-	0xd6, 0x9e, 0xca, 0xb5, 0x50, 0xae, 0xa6, 0x6b, 0xa1, 0x02, 0x99, 0x4d, 0x66, 0x94, 0x6f, 0x14,
-	0x64, 0x56, 0x05, 0x92, 0x29, 0x49, 0x8a, 0x2d, 0x10, 0x82, 0xa2, 0x4c, 0x6b, 0xa2, 0x3a, 0xa3,
-	0x07, 0x50, 0x52, 0x02, 0x29, 0x51, 0x47, 0xcd, 0xe7, 0x50, 0xc9, 0x38, 0x8f, 0x2c, 0x30, 0xb5,
-	0x1d, 0x9a, 0x9d, 0x86, 0xef, 0xb8, 0xf8, 0x17, 0x03, 0xee, 0x67, 0x6d, 0x46, 0xcf, 0xd5, 0x3d,
-	0x8c, 0x87, 0x8a, 0xbf, 0x75, 0xf0, 0xc9, 0xed, 0x4b, 0xc4, 0x78, 0x88, 0x35, 0x09, 0xd5, 0xa1,
-	0xc2, 0x84, 0x37, 0x61, 0x82, 0x8c, 0xa7, 0x74, 0xa2, 0xf6, 0xbe, 0x8c, 0x81, 0x89, 0x9e, 0xce,
-	0xc8, 0xbf, 0x05, 0x13, 0x9e, 0xa0, 0x44, 0xc2, 0x05, 0x05, 0x97, 0x99, 0x18, 0xa9, 0xb8, 0x79,
-	0x02, 0x45, 0xd9, 0x8c, 0x34, 0x20, 0x24, 0xc1, 0xca, 0x00, 0x79, 0x46, 0xbb, 0x60, 0xaa, 0xb5,
-	0x64, 0x89, 0xea, 0x26, 0x2e, 0xc9, 0xd0, 0x99, 0xa0, 0x06, 0x54, 0xde, 0xfe, 0x1b, 0x49, 0x30,
-	0x9b, 0x6a, 0xfe, 0x61, 0xc0, 0xee, 0x3b, 0x26, 0xfd, 0x1e, 0xc3, 0xd6, 0x4e, 0xe4, 0xff, 0xa7,
-	0x13, 0x3e, 0x09, 0x53, 0x2b, 0x74, 0xab, 0xe0, 0x93, 0x50, 0x5b, 0x21, 0x17, 0x49, 0x3e, 0x20,
-	0xad, 0xb0, 0x8a, 0x0a, 0x35, 0x7d, 0x12, 0x4a, 0x27, 0x9a, 0x1f, 0x83, 0x29, 0x7d, 0x70, 0x7a,
-	0x6a, 0xdd, 0x74, 0xdb, 0xc2, 0x32, 0x1a, 0x85, 0xfd, 0x4d, 0x6c, 0x26, 0x7d, 0x8b, 0xe6, 0xef,
-	0x06, 0x6c, 0x75, 0x26, 0x93, 0x19, 0x15, 0xe2, 0x15, 0xbf, 0xf0, 0xcf, 0x92, 0xf1, 0x93, 0x24,
-	0x93, 0x76, 0xa3, 0x43, 0xb4, 0x00, 0x73, 0x9e, 0x3c, 0xa4, 0xda, 0xa9, 0x1c, 0xec, 0xb5, 0x92,
-	0x57, 0x5f, 0x4b, 0xbe, 0xfa, 0x56, 0x4d, 0x74, 0x39, 0x0b, 0x0f, 0x7b, 0x6f, 0xae, 0xea, 0xb9,
-	0xbf, 0xae, 0xea, 0x9f, 0x9e, 0xb2, 0xf8, 0xec, 0x62, 0xdc, 0xf2, 0x79, 0xd0, 0xd6, 0xef, 0xc9,
-	0xe4, 0xe7, 0xa9, 0x98, 0x9c, 0xb7, 0xe3, 0x45, 0x44, 0x85, 0x22, 0xfc, 0x73, 0x55, 0xff, 0x40,
-	0x8b, 0x7f, 0xc1, 0x03, 0x16, 0xd3, 0x20, 0x8a, 0x17, 0x38, 0xbd, 0xef, 0xc9, 0xa5, 0x01, 0xa5,
-	0xc4, 0x1c, 0xb4, 0x0d, 0x95, 0x13, 0x77, 0x34, 0xb4, 0xbb, 0xce, 0x0b, 0xc7, 0xee, 0x55, 0x73,
-	0xa8, 0x0c, 0xc5, 0xbe, 0xe3, 0x1e, 0x57, 0x0d, 0x54, 0x01, 0x13, 0xdb, 0x5d, 0xdb, 0x79, 0x65,
-	0x57, 0xf3, 0x32, 0x7d, 0x78, 0x82, 0xdd, 0x6a, 0x51, 0x9e, 0x46, 0xb6, 0xdb, 0xab, 0x96, 0xd1,
-	0x16, 0xc0, 0xe8, 0x64, 0x68, 0x63, 0x4f, 0x21, 0x55, 0xf4, 0x18, 0x1e, 0xf4, 0x07, 0x3d, 0xe7,
-	0xc5, 0x6b, 0x6f, 0x38, 0x38, 0x72, 0xba, 0xaf, 0xbd, 0x7e, 0xc7, 0xed, 0x7c, 0x6b, 0xe3, 0x51,
-	0x75, 0xb9, 0x5c, 0x7e, 0x83, 0x3e, 0x84, 0x1d, 0x0d, 0x77, 0x07, 0xee, 0x31, 0xee, 0x74, 0x8f,
-	0xbd, 0x97, 0x83, 0xc1, 0xf7, 0x12, 0x5c, 0x1a, 0xa8, 0x0e, 0xbb, 0x1a, 0xc5, 0x83, 0x23, 0xdb,
-	0x1b, 0xda, 0xb8, 0xef, 0x8c, 0x46, 0xce, 0xc0, 0x55, 0xec, 0x65, 0x3e, 0x43, 0x57, 0x0f, 0x64,
-	0xb5, 0x97, 0xc5, 0xc3, 0xf3, 0x37, 0xd7, 0x35, 0xe3, 0xf2, 0xba, 0x66, 0xfc, 0x7d, 0x5d, 0x33,
-	0x7e, 0xbd, 0xa9, 0xe5, 0x2e, 0x6f, 0x6a, 0xb9, 0x3f, 0x6f, 0x6a, 0xb9, 0x1f, 0x7f, 0xc8, 0x78,
-	0xe6, 0xa4, 0xfb, 0x72, 0x44, 0xc6, 0x62, 0xfd, 0xe5, 0x7a, 0xea, 0xf3, 0x19, 0xcd, 0x86, 0x67,
-	0x84, 0x85, 0xed, 0x80, 0x4f, 0x2e, 0xa6, 0x54, 0xbc, 0xf5, 0x59, 0x53, 0x16, 0x8f, 0x4b, 0xea,
-	0xa3, 0xf3, 0xe5, 0xbf, 0x01, 0x00, 0x00, 0xff, 0xff, 0xab, 0x07, 0xd1, 0x6c, 0xfc, 0x06, 0x00,
-	0x00,
+	// 1256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x56, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0xda, 0xae, 0xff, 0x3c, 0xd7, 0x89, 0x3b, 0x0d, 0xc9, 0xb6, 0x25, 0x8e, 0x65, 0xa8,
+	0x08, 0x85, 0xda, 0x4a, 0x40, 0x08, 0x24, 0x8a, 0xb0, 0x9d, 0x0d, 0x5d, 0x48, 0xec, 0x30, 0x4e,
+	0x2a, 0xb5, 0x97, 0xd5, 0x78, 0x77, 0x48, 0x06, 0x7b, 0x77, 0xac, 0x9d, 0x8d, 0x5b, 0x73, 0x32,
+	0x47, 0x84, 0x90, 0xf8, 0x12, 0x5c, 0xf9, 0x04, 0x1c, 0x39, 0xf4, 0xd8, 0x23, 0xe2, 0x50, 0xa1,
+	0xf6, 0xc6, 0xa7, 0x40, 0x33, 0x3b, 0xfe, 0x53, 0x91, 0xa6, 0xa6, 0x12, 0x27, 0xcf, 0x7b, 0x6f,
+	0x7f, 0x6f, 0x7e, 0xef, 0xf7, 0x66, 0x9e, 0x07, 0x6a, 0x2c, 0xf8, 0x96, 0xba, 0x11, 0x1b, 0xd2,
+	0xda, 0x80, 0x86, 0x3e, 0x13, 0x82, 0xf1, 0x40, 0xd4, 0x86, 0xdb, 0x5d, 0x1a, 0x91, 0xed, 0x79,
+	0x5f, 0x75, 0x10, 0xf2, 0x88, 0xa3, 0x8d, 0x29, 0xa0, 0x3a, 0x1f, 0xd4, 0x80, 0xeb, 0x25, 0x97,
+	0x0b, 0x9f, 0x8b, 0x5a, 0x97, 0x08, 0x3a, 0xcd, 0xe2, 0x72, 0x16, 0xc4, 0xf0, 0xeb, 0xab, 0x27,
+	0xfc, 0x84, 0xab, 0x65, 0x4d, 0xae, 0x62, 0x6f, 0x65, 0x9c, 0x86, 0x5c, 0x8b, 0xf8, 0x54, 0x0c,
+	0x88, 0x4b, 0xd1, 0x2a, 0x5c, 0xf2, 0x68, 0xc0, 0x7d, 0xd3, 0x28, 0x1b, 0x5b, 0x39, 0x1c, 0x1b,
+	0xe8, 0x06, 0xe4, 0x1e, 0x12, 0xe1, 0x3b, 0xa7, 0x9c, 0xf7, 0xcc, 0x84, 0x8a, 0x64, 0xa5, 0xe3,
+	0x2e, 0xe7, 0x3d, 0xd4, 0x82, 0x62, 0xc8, 0xfb, 0xd4, 0x99, 0xa3, 0x64, 0x26, 0xcb, 0xc9, 0xad,
+	0xfc, 0xce, 0x5b, 0xd5, 0x0b, 0x09, 0x57, 0x31, 0xef, 0x53, 0xbc, 0x22, 0xc1, 0x87, 0xb3, 0x28,
+	0xfa, 0x12, 0xf2, 0xc4, 0x8d, 0x78, 0xe8, 0xc8, 0x80, 0x30, 0x53, 0x2a, 0xd5, 0xbb, 0xaf, 0x48,
+	0x55, 0x97, 0x08, 0x99, 0x4f, 0x60, 0x20, 0xd3, 0x35, 0x6a, 0x43, 0x41, 0x71, 0xf3, 0x49, 0x40,
+	0x4e, 0x68, 0x28, 0xcc, 0x4b, 0x2a, 0xdb, 0xad, 0x05, 0x88, 0x1d, 0xc4, 0x10, 0x7c, 0x39, 0x9c,
+	0x19, 0x02, 0x1d, 0xc1, 0xca, 0x80, 0xf7, 0x99, 0x3b, 0x72, 0x44, 0x44, 0xa2, 0x33, 0x41, 0x85,
+	0x99, 0x56, 0x29, 0xdf, 0x7b, 0x45, 0xca, 0x43, 0x85, 0xea, 0x28, 0x10, 0x5e, 0x1e, 0xcc, 0x59,
+	0x54, 0xa0, 0x21, 0xdc, 0xd0, 0x59, 0x35, 0x51, 0xc7, 0x25, 0x03, 0xd2, 0x65, 0x7d, 0x16, 0x31,
+	0x2a, 0xcc, 0x8c, 0xda, 0xe1, 0xa3, 0x85, 0x76, 0xd0, 0x4c, 0x9b, 0x13, 0xfc, 0x08, 0x5f, 0x1b,
+	0x9c, 0x1b, 0x60, 0x54, 0xa0, 0x6b, 0x90, 0xa5, 0x43, 0xdd, 0xd6, 0xac, 0x6a, 0x6b, 0x86, 0x0e,
+	0xe3, 0xae, 0x9e, 0xc2, 0xda, 0xac, 0x0b, 0x0e, 0x7d, 0x34, 0x60, 0x21, 0x89, 0x54, 0x6f, 0x73,
+	0x8a, 0xcd, 0xce, 0xa2, 0x0d, 0xb1, 0xa6, 0x50, 0xbc, 0x4a, 0xfe, 0xed, 0x54, 0xfd, 0x56, 0x7b,
+	0xf4, 0x99, 0xcf, 0x22, 0x61, 0xc2, 0x42, 0xfd, 0x96, 0x49, 0xf6, 0x15, 0x00, 0x43, 0x38, 0x5d,
+	0xa3, 0x0f, 0x61, 0x6d, 0xc8, 0xcf, 0xdc, 0x53, 0x1a, 0xc6, 0x94, 0x47, 0x8e, 0xa0, 0x2e, 0x0f,
+	0x3c, 0x61, 0xe6, 0xcb, 0xc6, 0x56, 0x12, 0xaf, 0xea, 0xa8, 0xda, 0x7f, 0xd4, 0x89, 0x63, 0x95,
+	0x8f, 0x01, 0x66, 0xe7, 0x47, 0x5e, 0x01, 0xc5, 0x73, 0x72, 0x05, 0x94, 0x21, 0xbd, 0xf1, 0x79,
+	0x4c, 0x94, 0x93, 0xd2, 0xab, 0x8c, 0x4a, 0x0f, 0x40, 0x82, 0x14, 0x5a, 0x20, 0x04, 0x29, 0xe9,
+	0xd6, 0x40, 0xb5, 0x46, 0x6b, 0x90, 0x56, 0x09, 0x26, 0x40, 0x6d, 0xa1, 0x6d, 0x58, 0x9d, 0x89,
+	0xea, 0x44, 0xcc, 0xa7, 0x22, 0x22, 0xfe, 0xc0, 0x4c, 0x2a, 0x9e, 0x57, 0x67, 0xb1, 0xa3, 0x49,
+	0xa8, 0x12, 0xc2, 0xd5, 0x73, 0x54, 0x7d, 0x09, 0xdf, 0x09, 0x97, 0xc4, 0x1c, 0x97, 0xd7, 0xd8,
+	0xf3, 0x87, 0x44, 0x5c, 0xa1, 0xd6, 0xf7, 0xbc, 0x0a, 0x3f, 0x05, 0x10, 0x34, 0xf0, 0xe2, 0xfe,
+	0xc5, 0xfb, 0x35, 0x36, 0x1e, 0x3f, 0xdd, 0x5c, 0xfa, 0xf3, 0xe9, 0xe6, 0x1b, 0xf1, 0x48, 0x12,
+	0x5e, 0xaf, 0xca, 0x78, 0xcd, 0x27, 0xd1, 0x69, 0xd5, 0x0e, 0x22, 0x9c, 0x93, 0x00, 0x95, 0x12,
+	0x35, 0xa0, 0x10, 0x52, 0x97, 0xb2, 0xa1, 0x3e, 0x00, 0x66, 0x72, 0x91, 0x04, 0x97, 0x35, 0x26,
+	0xce, 0x71, 0x13, 0x96, 0x1f, 0xb2, 0xc0, 0xe3, 0x0f, 0xa7, 0xdd, 0x4e, 0xa9, 0x8a, 0x0a, 0xb1,
+	0x57, 0xb7, 0x19, 0x7d, 0x06, 0x79, 0x9f, 0x3c, 0x72, 0xba, 0xa4, 0x4f, 0x02, 0x97, 0x9a, 0x97,
+	0x16, 0xd9, 0x08, 0x7c, 0xf2, 0xa8, 0x11, 0x03, 0x2a, 0xbf, 0x19, 0x70, 0x45, 0x6a, 0x71, 0x14,
+	0x92, 0x40, 0x7c, 0x43, 0xc3, 0x63, 0x41, 0x4e, 0xe8, 0xb9, 0x92, 0x6c, 0x43, 0x4a, 0xd0, 0x60,
+	0x41, 0x31, 0xd4, 0xa7, 0xe8, 0x13, 0xc8, 0xea, 0x9a, 0xbc, 0xc5, 0x24, 0x98, 0x7e, 0xbe, 0x60,
+	0xf9, 0x95, 0x3b, 0x90, 0x9f, 0x9b, 0x6b, 0xc8, 0x84, 0x8c, 0x1e, 0x36, 0x9a, 0xfa, 0xc4, 0x7c,
+	0xc9, 0x51, 0xff, 0xd1, 0x80, 0xcb, 0xf3, 0x43, 0x0c, 0xdd, 0x51, 0x27, 0x9b, 0xf1, 0x40, 0xe1,
+	0x97, 0x77, 0x6e, 0xbe, 0x7a, 0x22, 0xc8, 0x21, 0xa0, 0x41, 0x68, 0x13, 0xf2, 0x4c, 0x38, 0x1e,
+	0x13, 0xa4, 0xdb, 0xa7, 0x9e, 0x92, 0x2a, 0x8b, 0x81, 0x89, 0x5d, 0xed, 0x91, 0x7f, 0x3a, 0x4c,
+	0x38, 0x82, 0x92, 0xbe, 0x96, 0x24, 0x8b, 0xb3, 0x4c, 0x74, 0x94, 0x5d, 0x39, 0x86, 0x94, 0x2c,
+	0x46, 0xaa, 0x1f, 0x10, 0x7f, 0xaa, 0xbe, 0x5c, 0xa3, 0x75, 0xc8, 0xa8, 0x81, 0xc2, 0xe2, 0xac,
+	0x05, 0x9c, 0x96, 0xa6, 0xed, 0xa1, 0x32, 0xe4, 0x5f, 0xfc, 0x93, 0x92, 0xc1, 0x79, 0x57, 0xe5,
+	0x57, 0x03, 0xd6, 0x5f, 0x32, 0x47, 0x2f, 0x10, 0x6c, 0xa6, 0x44, 0xe2, 0x35, 0x95, 0x70, 0x49,
+	0x30, 0x91, 0x42, 0x97, 0x0a, 0x2e, 0x09, 0xb4, 0x14, 0x72, 0x4c, 0xcb, 0x0f, 0xa4, 0x14, 0xaa,
+	0xb5, 0x59, 0x9c, 0x71, 0x49, 0x20, 0x95, 0xa8, 0xbc, 0x0d, 0x19, 0xa9, 0x83, 0xbd, 0xab, 0x86,
+	0xb9, 0x2e, 0x5b, 0x98, 0x46, 0x39, 0xb9, 0x55, 0xc0, 0x99, 0xb8, 0x6e, 0x51, 0xf9, 0xc5, 0x80,
+	0xe5, 0xba, 0xe7, 0x85, 0x54, 0x88, 0x7b, 0xf1, 0x00, 0x94, 0xd5, 0x90, 0xd8, 0x33, 0xa9, 0x46,
+	0x9b, 0x68, 0x04, 0x19, 0x3d, 0x25, 0x55, 0x39, 0xf9, 0x9d, 0x6b, 0xd5, 0xf8, 0x04, 0x56, 0xe5,
+	0xc3, 0x62, 0x5a, 0x44, 0x93, 0xb3, 0xa0, 0xb1, 0xab, 0xcf, 0xe8, 0x3b, 0x27, 0x2c, 0x3a, 0x3d,
+	0xeb, 0x56, 0x5d, 0xee, 0xd7, 0xf4, 0x2b, 0x24, 0xfe, 0xb9, 0x2d, 0xbc, 0x5e, 0x2d, 0x1a, 0x0d,
+	0xa8, 0x50, 0x80, 0xbf, 0x9f, 0x6e, 0x5e, 0xd1, 0xc9, 0xdf, 0xe7, 0x3e, 0x8b, 0xa8, 0x3f, 0x88,
+	0x46, 0x78, 0xb2, 0x5f, 0xe5, 0x77, 0x03, 0x0a, 0x9a, 0x60, 0x3b, 0x64, 0x27, 0x2c, 0xb8, 0x80,
+	0xe6, 0x1a, 0xa4, 0xe5, 0x14, 0xd1, 0x2c, 0x73, 0x58, 0x5b, 0xa8, 0x0b, 0x69, 0xe2, 0xf3, 0xb3,
+	0x20, 0x9e, 0x24, 0x17, 0xb2, 0xaf, 0xfd, 0x47, 0xf6, 0x58, 0x67, 0x46, 0x1b, 0x00, 0x6e, 0x48,
+	0x49, 0x44, 0x3d, 0x87, 0x44, 0xfa, 0xb6, 0xe5, 0xb4, 0xa7, 0x1e, 0x55, 0x7e, 0x32, 0xa0, 0xb0,
+	0x17, 0xf2, 0xef, 0x68, 0xa0, 0x47, 0xc7, 0x05, 0x65, 0xcc, 0xe8, 0x26, 0xfe, 0x2f, 0xba, 0xb7,
+	0xbe, 0x4f, 0x40, 0x3a, 0x3e, 0x73, 0x68, 0x05, 0xf2, 0xc7, 0xad, 0xce, 0xa1, 0xd5, 0xb4, 0xf7,
+	0x6c, 0x6b, 0xb7, 0xb8, 0x84, 0xb2, 0x90, 0x3a, 0xb0, 0x5b, 0x47, 0x45, 0x03, 0xe5, 0x21, 0x83,
+	0xad, 0xa6, 0x65, 0xdf, 0xb3, 0x8a, 0x09, 0xe9, 0x6e, 0x1c, 0xe3, 0x56, 0x31, 0x25, 0x57, 0x1d,
+	0xab, 0xb5, 0x5b, 0xcc, 0xa2, 0x65, 0x80, 0xce, 0xf1, 0xa1, 0x85, 0x1d, 0x15, 0x29, 0xa2, 0x35,
+	0x58, 0xd9, 0xc3, 0x96, 0xf5, 0xc0, 0x72, 0x1a, 0xf5, 0xfd, 0x7a, 0xab, 0x69, 0x75, 0x8a, 0xe3,
+	0xf1, 0xb8, 0x88, 0xd6, 0xa1, 0x88, 0xad, 0xe6, 0x7e, 0xdd, 0x3e, 0x70, 0xee, 0xb5, 0x8f, 0x9b,
+	0x77, 0x2d, 0xac, 0x02, 0x65, 0xb4, 0x01, 0x6b, 0x07, 0xed, 0x5d, 0x7b, 0xef, 0xbe, 0x73, 0xd8,
+	0xde, 0xb7, 0x9b, 0xf7, 0x9d, 0x83, 0x7a, 0xab, 0xfe, 0x85, 0x0e, 0x7f, 0x8e, 0xde, 0x84, 0x55,
+	0x1d, 0x6e, 0xb6, 0x5b, 0x47, 0xb8, 0xde, 0x3c, 0x72, 0xee, 0xb6, 0xdb, 0x5f, 0xc9, 0xe0, 0xd8,
+	0x40, 0x9b, 0xb0, 0xae, 0xa3, 0xb8, 0xbd, 0x6f, 0x39, 0x87, 0x16, 0x3e, 0xb0, 0x3b, 0x1d, 0xbb,
+	0xdd, 0x52, 0xe8, 0x71, 0x62, 0x0e, 0xae, 0x3e, 0x98, 0xcf, 0x3d, 0x4e, 0x35, 0x7a, 0x8f, 0x9f,
+	0x95, 0x8c, 0x27, 0xcf, 0x4a, 0xc6, 0x5f, 0xcf, 0x4a, 0xc6, 0xcf, 0xcf, 0x4b, 0x4b, 0x4f, 0x9e,
+	0x97, 0x96, 0xfe, 0x78, 0x5e, 0x5a, 0x7a, 0xf0, 0xf5, 0x9c, 0x9c, 0xf6, 0xe4, 0xde, 0xee, 0x93,
+	0xae, 0x98, 0xbd, 0xcf, 0x6f, 0xbb, 0x3c, 0xa4, 0xf3, 0xe6, 0x29, 0x61, 0x41, 0xcd, 0xe7, 0xde,
+	0x59, 0x9f, 0x8a, 0x17, 0x1e, 0xef, 0x4a, 0xfd, 0x6e, 0x5a, 0x3d, 0xad, 0x3f, 0xf8, 0x67, 0x00,
+	0x3d, 0x36, 0x6e, 0xca, 0xe2, 0x0b, 0x00, 0x00,
 }
 
 func (m *Namespace) Marshal() (dAtA []byte, err error) {
@@ -751,6 +1130,39 @@ func (m *Namespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.VoucherExpirySeconds != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.VoucherExpirySeconds))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.RoleLimits) > 0 {
+		for iNdEx := len(m.RoleLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.ActorRoleExpirations) > 0 {
+		for iNdEx := len(m.ActorRoleExpirations) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ActorRoleExpirations[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintPermissions(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
 	if len(m.EvmHook) > 0 {
 		i -= len(m.EvmHook)
 		copy(dAtA[i:], m.EvmHook)
@@ -904,6 +1316,11 @@ func (m *RoleActors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Actors) > 0 {
 		for iNdEx := len(m.Actors) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.Actors[iNdEx])
@@ -923,7 +1340,7 @@ func (m *RoleActors) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *RoleManager) Marshal() (dAtA []byte, err error) {
+func (m *ActorRoleExpiration) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -933,31 +1350,193 @@ func (m *RoleManager) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *RoleManager) MarshalTo(dAtA []byte) (int, error) {
+func (m *ActorRoleExpiration) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *RoleManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ActorRoleExpiration) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Roles) > 0 {
-		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
-			i -= len(m.Roles[iNdEx])
-			copy(dAtA[i:], m.Roles[iNdEx])
-			i = encodeVarintPermissions(dAtA, i, uint64(len(m.Roles[iNdEx])))
-			i--
-			dAtA[i] = 0x12
-		}
+	if m.ExpirationTimestamp != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.ExpirationTimestamp))
+		i--
+		dAtA[i] = 0x18
 	}
-	if len(m.Manager) > 0 {
-		i -= len(m.Manager)
-		copy(dAtA[i:], m.Manager)
-		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Manager)))
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Role)))
 		i--
-		dAtA[i] = 0xa
+		dAtA[i] = 0x12
+	}
+	if len(m.Actor) > 0 {
+		i -= len(m.Actor)
+		copy(dAtA[i:], m.Actor)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Actor)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxBalance.Size()
+		i -= size
+		if _, err := m.MaxBalance.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x2a
+	if m.WindowSeconds != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.ReceiveLimit.Size()
+		i -= size
+		if _, err := m.ReceiveLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.SendLimit.Size()
+		i -= size
+		if _, err := m.SendLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleTransferUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleTransferUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleTransferUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.WindowSeconds != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.WindowSeconds))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Received.Size()
+		i -= size
+		if _, err := m.Received.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Sent.Size()
+		i -= size
+		if _, err := m.Sent.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Role) > 0 {
+		i -= len(m.Role)
+		copy(dAtA[i:], m.Role)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Role)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RoleManager) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RoleManager) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RoleManager) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Roles) > 0 {
+		for iNdEx := len(m.Roles) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Roles[iNdEx])
+			copy(dAtA[i:], m.Roles[iNdEx])
+			i = encodeVarintPermissions(dAtA, i, uint64(len(m.Roles[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Manager) > 0 {
+		i -= len(m.Manager)
+		copy(dAtA[i:], m.Manager)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Manager)))
+		i--
+		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
@@ -1186,6 +1765,98 @@ func (m *AddressVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *VoucherOrigin) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *VoucherOrigin) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *VoucherOrigin) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CreatedAt != 0 {
+		i = encodeVarintPermissions(dAtA, i, uint64(m.CreatedAt))
+		i--
+		dAtA[i] = 0x20
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FrozenBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FrozenBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FrozenBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintPermissions(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintPermissions(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintPermissions(dAtA []byte, offset int, v uint64) int {
 	offset -= sovPermissions(v)
 	base := offset
@@ -1245,6 +1916,21 @@ func (m *Namespace) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovPermissions(uint64(l))
 	}
+	if len(m.ActorRoleExpirations) > 0 {
+		for _, e := range m.ActorRoleExpirations {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if len(m.RoleLimits) > 0 {
+		for _, e := range m.RoleLimits {
+			l = e.Size()
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	if m.VoucherExpirySeconds != 0 {
+		n += 1 + sovPermissions(uint64(m.VoucherExpirySeconds))
+	}
 	return n
 }
 
@@ -1283,53 +1969,118 @@ func (m *RoleActors) Size() (n int) {
 			n += 1 + l + sovPermissions(uint64(l))
 		}
 	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpirationTimestamp))
+	}
 	return n
 }
 
-func (m *RoleManager) Size() (n int) {
+func (m *ActorRoleExpiration) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Manager)
+	l = len(m.Actor)
 	if l > 0 {
 		n += 1 + l + sovPermissions(uint64(l))
 	}
-	if len(m.Roles) > 0 {
-		for _, s := range m.Roles {
-			l = len(s)
-			n += 1 + l + sovPermissions(uint64(l))
-		}
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if m.ExpirationTimestamp != 0 {
+		n += 1 + sovPermissions(uint64(m.ExpirationTimestamp))
 	}
 	return n
 }
 
-func (m *PolicyStatus) Size() (n int) {
+func (m *RoleLimits) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.Action != 0 {
-		n += 1 + sovPermissions(uint64(m.Action))
-	}
-	if m.IsDisabled {
-		n += 2
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
 	}
-	if m.IsSealed {
-		n += 2
+	l = m.SendLimit.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	l = m.ReceiveLimit.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovPermissions(uint64(m.WindowSeconds))
 	}
+	l = m.MaxBalance.Size()
+	n += 1 + l + sovPermissions(uint64(l))
 	return n
 }
 
-func (m *Role) Size() (n int) {
+func (m *RoleTransferUsage) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Name)
+	l = len(m.Role)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = m.Sent.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	l = m.Received.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	if m.WindowSeconds != 0 {
+		n += 1 + sovPermissions(uint64(m.WindowSeconds))
+	}
+	return n
+}
+
+func (m *RoleManager) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Manager)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	if len(m.Roles) > 0 {
+		for _, s := range m.Roles {
+			l = len(s)
+			n += 1 + l + sovPermissions(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *PolicyStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Action != 0 {
+		n += 1 + sovPermissions(uint64(m.Action))
+	}
+	if m.IsDisabled {
+		n += 2
+	}
+	if m.IsSealed {
+		n += 2
+	}
+	return n
+}
+
+func (m *Role) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovPermissions(uint64(l))
 	}
@@ -1395,6 +2146,43 @@ func (m *AddressVoucher) Size() (n int) {
 	return n
 }
 
+func (m *VoucherOrigin) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	if m.CreatedAt != 0 {
+		n += 1 + sovPermissions(uint64(m.CreatedAt))
+	}
+	return n
+}
+
+func (m *FrozenBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovPermissions(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovPermissions(uint64(l))
+	return n
+}
+
 func sovPermissions(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1696,6 +2484,93 @@ func (m *Namespace) Unmarshal(dAtA []byte) error {
 			}
 			m.EvmHook = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActorRoleExpirations", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ActorRoleExpirations = append(m.ActorRoleExpirations, &ActorRoleExpiration{})
+			if err := m.ActorRoleExpirations[len(m.ActorRoleExpirations)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleLimits = append(m.RoleLimits, &RoleLimits{})
+			if err := m.RoleLimits[len(m.RoleLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherExpirySeconds", wireType)
+			}
+			m.VoucherExpirySeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VoucherExpirySeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1924,6 +2799,25 @@ func (m *RoleActors) Unmarshal(dAtA []byte) error {
 			}
 			m.Actors = append(m.Actors, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -1945,7 +2839,7 @@ func (m *RoleActors) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *RoleManager) Unmarshal(dAtA []byte) error {
+func (m *ActorRoleExpiration) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1968,15 +2862,15 @@ func (m *RoleManager) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: RoleManager: wiretype end group for non-group")
+			return fmt.Errorf("proto: ActorRoleExpiration: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleManager: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ActorRoleExpiration: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Actor", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2004,11 +2898,11 @@ func (m *RoleManager) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Actor = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2036,8 +2930,27 @@ func (m *RoleManager) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			m.Role = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpirationTimestamp", wireType)
+			}
+			m.ExpirationTimestamp = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpirationTimestamp |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -2059,7 +2972,7 @@ func (m *RoleManager) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PolicyStatus) Unmarshal(dAtA []byte) error {
+func (m *RoleLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2082,17 +2995,17 @@ func (m *PolicyStatus) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PolicyStatus: wiretype end group for non-group")
+			return fmt.Errorf("proto: RoleLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PolicyStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RoleLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
 			}
-			m.Action = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -2102,16 +3015,29 @@ func (m *PolicyStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Action |= Action(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsDisabled", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendLimit", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -2121,17 +3047,31 @@ func (m *PolicyStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsDisabled = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.SendLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field IsSealed", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceiveLimit", wireType)
 			}
-			var v int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -2141,12 +3081,858 @@ func (m *PolicyStatus) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.IsSealed = bool(v != 0)
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ReceiveLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxBalance", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxBalance.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleTransferUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleTransferUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleTransferUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Role", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Role = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sent", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Sent.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Received", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Received.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSeconds", wireType)
+			}
+			m.WindowSeconds = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSeconds |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleManager) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleManager: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleManager: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Roles", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Roles = append(m.Roles, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsDisabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsDisabled = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IsSealed", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IsSealed = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Role) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleId", wireType)
+			}
+			m.RoleId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RoleId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			}
+			m.Permissions = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Permissions |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *PolicyManagerCapability) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PolicyManagerCapability: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PolicyManagerCapability: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Manager = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			}
+			m.Action = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Action |= Action(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanDisable", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanDisable = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanSeal", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPermissions
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanSeal = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPermissions(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RoleIDs) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPermissions
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RoleIDs: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RoleIDs: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType == 0 {
+				var v uint32
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPermissions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= uint32(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.RoleIds = append(m.RoleIds, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowPermissions
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthPermissions
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthPermissions
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				var count int
+				for _, integer := range dAtA[iNdEx:postIndex] {
+					if integer < 128 {
+						count++
+					}
+				}
+				elementCount = count
+				if elementCount != 0 && len(m.RoleIds) == 0 {
+					m.RoleIds = make([]uint32, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v uint32
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowPermissions
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= uint32(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.RoleIds = append(m.RoleIds, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleIds", wireType)
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -2168,7 +3954,7 @@ func (m *PolicyStatus) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *Role) Unmarshal(dAtA []byte) error {
+func (m *AddressVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2191,15 +3977,15 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Role: wiretype end group for non-group")
+			return fmt.Errorf("proto: AddressVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Role: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: AddressVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2227,13 +4013,13 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Name = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
 			}
-			m.RoleId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -2243,30 +4029,25 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.RoleId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Permissions", wireType)
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
 			}
-			m.Permissions = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowPermissions
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.Permissions |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Voucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -2288,7 +4069,7 @@ func (m *Role) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *PolicyManagerCapability) Unmarshal(dAtA []byte) error {
+func (m *VoucherOrigin) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2311,15 +4092,15 @@ func (m *PolicyManagerCapability) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: PolicyManagerCapability: wiretype end group for non-group")
+			return fmt.Errorf("proto: VoucherOrigin: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: PolicyManagerCapability: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: VoucherOrigin: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Manager", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2347,13 +4128,13 @@ func (m *PolicyManagerCapability) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Manager = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Action", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			m.Action = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -2363,16 +4144,29 @@ func (m *PolicyManagerCapability) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.Action |= Action(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 3:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanDisable", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
-			var v int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -2382,17 +4176,30 @@ func (m *PolicyManagerCapability) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CanDisable = bool(v != 0)
+			if msglen < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPermissions
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CanSeal", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CreatedAt", wireType)
 			}
-			var v int
+			m.CreatedAt = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowPermissions
@@ -2402,138 +4209,11 @@ func (m *PolicyManagerCapability) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				v |= int(b&0x7F) << shift
+				m.CreatedAt |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			m.CanSeal = bool(v != 0)
-		default:
-			iNdEx = preIndex
-			skippy, err := skipPermissions(dAtA[iNdEx:])
-			if err != nil {
-				return err
-			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthPermissions
-			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
-			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *RoleIDs) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowPermissions
-			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
-			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
-			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: RoleIDs: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: RoleIDs: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
-			if wireType == 0 {
-				var v uint32
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPermissions
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					v |= uint32(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				m.RoleIds = append(m.RoleIds, v)
-			} else if wireType == 2 {
-				var packedLen int
-				for shift := uint(0); ; shift += 7 {
-					if shift >= 64 {
-						return ErrIntOverflowPermissions
-					}
-					if iNdEx >= l {
-						return io.ErrUnexpectedEOF
-					}
-					b := dAtA[iNdEx]
-					iNdEx++
-					packedLen |= int(b&0x7F) << shift
-					if b < 0x80 {
-						break
-					}
-				}
-				if packedLen < 0 {
-					return ErrInvalidLengthPermissions
-				}
-				postIndex := iNdEx + packedLen
-				if postIndex < 0 {
-					return ErrInvalidLengthPermissions
-				}
-				if postIndex > l {
-					return io.ErrUnexpectedEOF
-				}
-				var elementCount int
-				var count int
-				for _, integer := range dAtA[iNdEx:postIndex] {
-					if integer < 128 {
-						count++
-					}
-				}
-				elementCount = count
-				if elementCount != 0 && len(m.RoleIds) == 0 {
-					m.RoleIds = make([]uint32, 0, elementCount)
-				}
-				for iNdEx < postIndex {
-					var v uint32
-					for shift := uint(0); ; shift += 7 {
-						if shift >= 64 {
-							return ErrIntOverflowPermissions
-						}
-						if iNdEx >= l {
-							return io.ErrUnexpectedEOF
-						}
-						b := dAtA[iNdEx]
-						iNdEx++
-						v |= uint32(b&0x7F) << shift
-						if b < 0x80 {
-							break
-						}
-					}
-					m.RoleIds = append(m.RoleIds, v)
-				}
-			} else {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleIds", wireType)
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipPermissions(dAtA[iNdEx:])
@@ -2555,7 +4235,7 @@ func (m *RoleIDs) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *AddressVoucher) Unmarshal(dAtA []byte) error {
+func (m *FrozenBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2578,10 +4258,10 @@ func (m *AddressVoucher) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: AddressVoucher: wiretype end group for non-group")
+			return fmt.Errorf("proto: FrozenBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: AddressVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FrozenBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2618,7 +4298,7 @@ func (m *AddressVoucher) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Voucher", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2645,7 +4325,7 @@ func (m *AddressVoucher) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Voucher.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,59 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+)
+
+// MaxRoleLimitWindowSeconds bounds the rolling window of role limits to one year
+const MaxRoleLimitWindowSeconds = 365 * 24 * 60 * 60
+
+func isLimitSet(limit math.Int) bool {
+	return !limit.IsNil() && limit.IsPositive()
+}
+
+// HasSendLimit returns true if the role limits the amount sent within the window
+func (l *RoleLimits) HasSendLimit() bool {
+	return isLimitSet(l.SendLimit)
+}
+
+// HasReceiveLimit returns true if the role limits the amount received within the window
+func (l *RoleLimits) HasReceiveLimit() bool {
+	return isLimitSet(l.ReceiveLimit)
+}
+
+// HasMaxBalance returns true if the role caps the balance of its actors
+func (l *RoleLimits) HasMaxBalance() bool {
+	return isLimitSet(l.MaxBalance)
+}
+
+// HasLimits returns true if any of the limits is set
+func (l *RoleLimits) HasLimits() bool {
+	return l.HasSendLimit() || l.HasReceiveLimit() || l.HasMaxBalance()
+}
+
+func (l *RoleLimits) Validate() error {
+	if l.Role == "" {
+		return ErrInvalidRole.Wrap("role name cannot be empty")
+	}
+
+	for name, limit := range map[string]math.Int{
+		"send limit":    l.SendLimit,
+		"receive limit": l.ReceiveLimit,
+		"max balance":   l.MaxBalance,
+	} {
+		if !limit.IsNil() && limit.IsNegative() {
+			return errors.Wrapf(ErrInvalidRoleLimits, "%s of role %s cannot be negative", name, l.Role)
+		}
+	}
+
+	if l.WindowSeconds < 0 || l.WindowSeconds > MaxRoleLimitWindowSeconds {
+		return errors.Wrapf(ErrInvalidRoleLimits, "window of role %s must be between 0-%d seconds", l.Role, MaxRoleLimitWindowSeconds)
+	}
+
+	if (l.HasSendLimit() || l.HasReceiveLimit()) && l.WindowSeconds == 0 {
+		return errors.Wrapf(ErrInvalidRoleLimits, "window of role %s must be set for send and receive limits", l.Role)
+	}
+
+	return nil
+}
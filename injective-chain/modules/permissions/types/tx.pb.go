@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -232,6 +233,9 @@ type MsgUpdateNamespace struct {
 	PolicyManagerCapabilities []*PolicyManagerCapability `protobuf:"bytes,7,rep,name=policy_manager_capabilities,json=policyManagerCapabilities,proto3" json:"policy_manager_capabilities,omitempty"`
 	// address of EVM smart contract to apply code-based restrictions
 	EvmHook *MsgUpdateNamespace_SetContractHook `protobuf:"bytes,8,opt,name=evm_hook,json=evmHook,proto3" json:"evm_hook,omitempty"`
+	// number of seconds after which vouchers can be returned to their original
+	// senders, 0 if vouchers never expire
+	VoucherExpiry *MsgUpdateNamespace_SetVoucherExpiry `protobuf:"bytes,9,opt,name=voucher_expiry,json=voucherExpiry,proto3" json:"voucher_expiry,omitempty"`
 }
 
 func (m *MsgUpdateNamespace) Reset()         { *m = MsgUpdateNamespace{} }
@@ -323,6 +327,13 @@ func (m *MsgUpdateNamespace) GetEvmHook() *MsgUpdateNamespace_SetContractHook {
 	return nil
 }
 
+func (m *MsgUpdateNamespace) GetVoucherExpiry() *MsgUpdateNamespace_SetVoucherExpiry {
+	if m != nil {
+		return m.VoucherExpiry
+	}
+	return nil
+}
+
 type MsgUpdateNamespace_SetContractHook struct {
 	NewValue string `protobuf:"bytes,1,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
 }
@@ -367,6 +378,50 @@ func (m *MsgUpdateNamespace_SetContractHook) GetNewValue() string {
 	return ""
 }
 
+type MsgUpdateNamespace_SetVoucherExpiry struct {
+	NewValue int64 `protobuf:"varint,1,opt,name=new_value,json=newValue,proto3" json:"new_value,omitempty"`
+}
+
+func (m *MsgUpdateNamespace_SetVoucherExpiry) Reset()         { *m = MsgUpdateNamespace_SetVoucherExpiry{} }
+func (m *MsgUpdateNamespace_SetVoucherExpiry) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateNamespace_SetVoucherExpiry) ProtoMessage()    {}
+func (*MsgUpdateNamespace_SetVoucherExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{4, 1}
+}
+func (m *MsgUpdateNamespace_SetVoucherExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateNamespace_SetVoucherExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateNamespace_SetVoucherExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateNamespace_SetVoucherExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateNamespace_SetVoucherExpiry.Merge(m, src)
+}
+func (m *MsgUpdateNamespace_SetVoucherExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateNamespace_SetVoucherExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateNamespace_SetVoucherExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateNamespace_SetVoucherExpiry proto.InternalMessageInfo
+
+func (m *MsgUpdateNamespace_SetVoucherExpiry) GetNewValue() int64 {
+	if m != nil {
+		return m.NewValue
+	}
+	return 0
+}
+
 type MsgUpdateNamespaceResponse struct {
 }
 
@@ -601,954 +656,3081 @@ func (m *MsgClaimVoucherResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgClaimVoucherResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgUpdateParams)(nil), "injective.permissions.v1beta1.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.permissions.v1beta1.MsgUpdateParamsResponse")
-	proto.RegisterType((*MsgCreateNamespace)(nil), "injective.permissions.v1beta1.MsgCreateNamespace")
-	proto.RegisterType((*MsgCreateNamespaceResponse)(nil), "injective.permissions.v1beta1.MsgCreateNamespaceResponse")
-	proto.RegisterType((*MsgUpdateNamespace)(nil), "injective.permissions.v1beta1.MsgUpdateNamespace")
-	proto.RegisterType((*MsgUpdateNamespace_SetContractHook)(nil), "injective.permissions.v1beta1.MsgUpdateNamespace.SetContractHook")
-	proto.RegisterType((*MsgUpdateNamespaceResponse)(nil), "injective.permissions.v1beta1.MsgUpdateNamespaceResponse")
-	proto.RegisterType((*MsgUpdateActorRoles)(nil), "injective.permissions.v1beta1.MsgUpdateActorRoles")
-	proto.RegisterType((*MsgUpdateActorRolesResponse)(nil), "injective.permissions.v1beta1.MsgUpdateActorRolesResponse")
-	proto.RegisterType((*MsgClaimVoucher)(nil), "injective.permissions.v1beta1.MsgClaimVoucher")
-	proto.RegisterType((*MsgClaimVoucherResponse)(nil), "injective.permissions.v1beta1.MsgClaimVoucherResponse")
+type MsgUpdateRoleLimits struct {
+	// The sender's Injective address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// The namespace denom to which this updates are applied
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The limits to set for each role, limits without any non-zero value are
+	// removed
+	RoleLimits []*RoleLimits `protobuf:"bytes,3,rep,name=role_limits,json=roleLimits,proto3" json:"role_limits,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("injective/permissions/v1beta1/tx.proto", fileDescriptor_ab9bfdcab1d9b6fa)
+func (m *MsgUpdateRoleLimits) Reset()         { *m = MsgUpdateRoleLimits{} }
+func (m *MsgUpdateRoleLimits) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRoleLimits) ProtoMessage()    {}
+func (*MsgUpdateRoleLimits) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{10}
 }
-
-var fileDescriptor_ab9bfdcab1d9b6fa = []byte{
-	// 905 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x3f, 0x6f, 0xdb, 0x46,
-	0x14, 0x37, 0xe3, 0xc8, 0xb1, 0x2e, 0x4e, 0x95, 0x5c, 0x5d, 0x84, 0x96, 0x1b, 0xd9, 0x50, 0xd1,
-	0xc2, 0x51, 0x10, 0x12, 0x72, 0x01, 0x03, 0xd5, 0x66, 0x6b, 0x69, 0x81, 0x38, 0x4d, 0xe9, 0xd4,
-	0x43, 0x11, 0x54, 0x38, 0x91, 0x0f, 0x14, 0x2b, 0x91, 0x47, 0xdc, 0x9d, 0xe8, 0x6a, 0x4a, 0x91,
-	0x6e, 0x9d, 0xfa, 0x51, 0x3c, 0x74, 0x6d, 0xe7, 0x8c, 0x41, 0xa7, 0x4e, 0x41, 0x61, 0x0f, 0xde,
-	0xfb, 0x09, 0x0a, 0xde, 0x9d, 0x44, 0x9a, 0x56, 0x23, 0xa9, 0xce, 0x22, 0xf1, 0xfd, 0xfb, 0xbd,
-	0xf7, 0xbb, 0xf7, 0x1e, 0x79, 0xe8, 0xb3, 0x20, 0xfa, 0x01, 0x5c, 0x11, 0x24, 0x60, 0xc7, 0xc0,
-	0xc2, 0x80, 0xf3, 0x80, 0x46, 0xdc, 0x4e, 0x9a, 0x5d, 0x10, 0xa4, 0x69, 0x8b, 0x1f, 0xad, 0x98,
-	0x51, 0x41, 0xf1, 0x83, 0x89, 0x9f, 0x95, 0xf3, 0xb3, 0xb4, 0x5f, 0x75, 0xdd, 0xa7, 0x3e, 0x95,
-	0x9e, 0x76, 0xfa, 0xa4, 0x82, 0xaa, 0x35, 0x97, 0xf2, 0x90, 0x72, 0xbb, 0x4b, 0x38, 0x4c, 0x20,
-	0x5d, 0x1a, 0x44, 0x57, 0xec, 0x51, 0x7f, 0x62, 0x4f, 0x05, 0x6d, 0xbf, 0xaf, 0xed, 0x21, 0xf7,
-	0xed, 0xa4, 0x99, 0xfe, 0x69, 0xc3, 0x86, 0x32, 0x74, 0x54, 0x46, 0x25, 0x68, 0x53, 0xe3, 0xdd,
-	0x84, 0x62, 0xc2, 0x48, 0x38, 0xf6, 0xb5, 0x67, 0xf8, 0xe6, 0x88, 0xaa, 0x80, 0x7b, 0x24, 0x0c,
-	0x22, 0x6a, 0xcb, 0x5f, 0xa5, 0xaa, 0xff, 0x61, 0xa0, 0xca, 0x21, 0xf7, 0xbf, 0x8d, 0x3d, 0x22,
-	0xe0, 0x99, 0x44, 0xc7, 0x7b, 0xa8, 0x4c, 0x86, 0xa2, 0x47, 0x59, 0x20, 0x46, 0xa6, 0xb1, 0x6d,
-	0xec, 0x94, 0x0f, 0xcc, 0x3f, 0x7f, 0x7b, 0xbc, 0xae, 0x0b, 0xdd, 0xf7, 0x3c, 0x06, 0x9c, 0x1f,
-	0x09, 0x16, 0x44, 0xbe, 0x93, 0xb9, 0xe2, 0x36, 0x5a, 0x51, 0xf5, 0x99, 0x37, 0xb6, 0x8d, 0x9d,
-	0xdb, 0xbb, 0x9f, 0x5a, 0xef, 0x3c, 0x75, 0x4b, 0xa5, 0x3b, 0xb8, 0xf9, 0xfa, 0xed, 0xd6, 0x92,
-	0xa3, 0x43, 0x5b, 0xd6, 0xab, 0x8b, 0xd3, 0x46, 0x06, 0xfa, 0xcb, 0xc5, 0x69, 0x63, 0x33, 0xcf,
-	0xae, 0x50, 0x6c, 0x7d, 0x03, 0xdd, 0x2f, 0xa8, 0x1c, 0xe0, 0x31, 0x8d, 0x38, 0xd4, 0x7f, 0x37,
-	0x10, 0x3e, 0xe4, 0x7e, 0x9b, 0x01, 0x11, 0xf0, 0x94, 0x84, 0xc0, 0x63, 0xe2, 0x02, 0x7e, 0x88,
-	0x56, 0x38, 0x44, 0x1e, 0x30, 0xcd, 0xed, 0xde, 0x3f, 0x6f, 0xb7, 0xee, 0x8c, 0x48, 0x38, 0x68,
-	0xd5, 0x95, 0xbe, 0xee, 0x68, 0x07, 0xfc, 0x04, 0x95, 0xa3, 0x71, 0x9c, 0x26, 0xb5, 0x33, 0x83,
-	0xd4, 0x24, 0x8f, 0xe6, 0x95, 0x01, 0x28, 0x6a, 0x1a, 0x3a, 0xe5, 0x55, 0x2b, 0xf0, 0x2a, 0x14,
-	0x5a, 0xff, 0x18, 0x55, 0xaf, 0x6a, 0x27, 0xec, 0xce, 0x4a, 0x92, 0x9d, 0x62, 0xfe, 0xbf, 0xd8,
-	0xad, 0xa3, 0x92, 0x07, 0x11, 0x0d, 0x25, 0xb3, 0xb2, 0xa3, 0x04, 0xfc, 0x3d, 0x2a, 0x9f, 0x10,
-	0x1e, 0x76, 0x7a, 0x94, 0xf6, 0xcd, 0x65, 0xc9, 0x79, 0x7f, 0x06, 0xe7, 0xab, 0x65, 0x58, 0x47,
-	0x20, 0xda, 0x34, 0x12, 0x8c, 0xb8, 0xe2, 0x4b, 0x4a, 0xfb, 0xce, 0x6a, 0x8a, 0x99, 0x3e, 0xe1,
-	0xa7, 0xe8, 0x2e, 0xa3, 0x03, 0xe8, 0xe4, 0x80, 0xcc, 0x9b, 0xdb, 0xcb, 0x3b, 0xb7, 0x77, 0x3f,
-	0x99, 0x91, 0xc6, 0xa1, 0x03, 0x70, 0x2a, 0x69, 0xf0, 0xb3, 0xcc, 0x8a, 0xbf, 0x46, 0x77, 0x24,
-	0x5e, 0x48, 0x22, 0xe2, 0x03, 0xe3, 0x66, 0x49, 0x82, 0x35, 0xe6, 0x00, 0x3b, 0x54, 0x21, 0xce,
-	0x1a, 0xcb, 0x04, 0x8e, 0x9f, 0xa3, 0x4a, 0x4c, 0x07, 0x81, 0x3b, 0xea, 0x70, 0x41, 0xc4, 0x90,
-	0x03, 0x37, 0x57, 0x24, 0xe4, 0xa3, 0x59, 0xf3, 0x2c, 0xa3, 0x8e, 0x64, 0x90, 0xf3, 0x41, 0x9c,
-	0x93, 0x80, 0xe3, 0x04, 0x6d, 0x6a, 0x54, 0x5d, 0x68, 0xc7, 0x25, 0x31, 0xe9, 0x06, 0x83, 0x40,
-	0x04, 0xc0, 0xcd, 0x5b, 0x32, 0xc3, 0xde, 0x5c, 0x19, 0x74, 0xa5, 0xed, 0x71, 0xfc, 0xc8, 0xd9,
-	0x88, 0xa7, 0x1a, 0x02, 0xe0, 0xf8, 0x05, 0x5a, 0x85, 0x44, 0x77, 0x73, 0xf5, 0x7d, 0x75, 0xf3,
-	0x16, 0x24, 0xb2, 0x99, 0x55, 0x0b, 0x55, 0x0a, 0x36, 0xbc, 0x89, 0xca, 0x11, 0x9c, 0x74, 0x12,
-	0x32, 0x18, 0x82, 0x9a, 0x41, 0x67, 0x35, 0x82, 0x93, 0xe3, 0x54, 0x9e, 0xb9, 0x02, 0x85, 0xc4,
-	0x7a, 0x05, 0x0a, 0xda, 0x6c, 0xc1, 0x6f, 0xa0, 0x0f, 0x27, 0xe6, 0x7d, 0x57, 0x50, 0x96, 0x76,
-	0x95, 0x5f, 0x7f, 0x07, 0x8e, 0x11, 0x96, 0x33, 0x45, 0x52, 0x4c, 0xde, 0x11, 0xb4, 0x43, 0x3c,
-	0xcf, 0x5c, 0x96, 0x3d, 0x7a, 0x38, 0xc7, 0x60, 0xc9, 0x5a, 0xb8, 0x9a, 0x55, 0xf5, 0xfc, 0x9c,
-	0xee, 0x7b, 0x1e, 0x7e, 0x81, 0x3e, 0x2a, 0xe0, 0x32, 0x48, 0x68, 0x1f, 0xf4, 0xcc, 0x2e, 0x00,
-	0x8d, 0xf3, 0xd0, 0x8e, 0x04, 0x69, 0xd9, 0x85, 0xc3, 0xdd, 0x9a, 0x7a, 0xb8, 0xd9, 0x39, 0xd5,
-	0x1f, 0xa0, 0xcd, 0x29, 0xea, 0xc9, 0xf1, 0xbe, 0x94, 0x9f, 0x86, 0xf6, 0x80, 0x04, 0xe1, 0x31,
-	0x1d, 0xba, 0x3d, 0x60, 0xd7, 0x3e, 0xd9, 0xd6, 0xa3, 0x42, 0x8d, 0xc5, 0x77, 0x7b, 0x3e, 0x9b,
-	0x7e, 0xb7, 0xe7, 0x55, 0xe3, 0xda, 0x76, 0x7f, 0x2e, 0xa1, 0xe5, 0x43, 0xee, 0xe3, 0x04, 0xad,
-	0x5d, 0xfa, 0x76, 0x59, 0xf3, 0x0e, 0xb7, 0xf2, 0xaf, 0xee, 0x2d, 0xe6, 0x3f, 0xce, 0x8f, 0x5f,
-	0xa2, 0x4a, 0xf1, 0xbb, 0xd2, 0x9c, 0x0d, 0x55, 0x08, 0xa9, 0x7e, 0xb1, 0x70, 0x48, 0xbe, 0x80,
-	0xe2, 0xab, 0xbf, 0xb9, 0xf0, 0x62, 0xcf, 0x53, 0xc0, 0x7f, 0x2c, 0x1f, 0x7e, 0x65, 0xa0, 0xbb,
-	0x57, 0x36, 0x6f, 0x77, 0x5e, 0xbc, 0x2c, 0xa6, 0xda, 0x5a, 0x3c, 0x66, 0x52, 0x44, 0x82, 0xd6,
-	0x2e, 0xcd, 0xe7, 0x1c, 0xed, 0xcf, 0xfb, 0xcf, 0xd3, 0xfe, 0x69, 0xe3, 0x57, 0x2d, 0xfd, 0x74,
-	0x71, 0xda, 0x30, 0x0e, 0xfa, 0xaf, 0xcf, 0x6a, 0xc6, 0x9b, 0xb3, 0x9a, 0xf1, 0xf7, 0x59, 0xcd,
-	0xf8, 0xf5, 0xbc, 0xb6, 0xf4, 0xe6, 0xbc, 0xb6, 0xf4, 0xd7, 0x79, 0x6d, 0xe9, 0xbb, 0x6f, 0xfc,
-	0x40, 0xf4, 0x86, 0x5d, 0xcb, 0xa5, 0xa1, 0xfd, 0xd5, 0x38, 0xc5, 0x13, 0xd2, 0xe5, 0xd9, 0xa5,
-	0xed, 0xb1, 0x4b, 0x19, 0xe4, 0xc5, 0x1e, 0x09, 0x22, 0x3b, 0xa4, 0xde, 0x70, 0x00, 0xfc, 0xd2,
-	0x8d, 0x4e, 0x8c, 0x62, 0xe0, 0xdd, 0x15, 0x79, 0x63, 0xfb, 0xfc, 0xdf, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0x59, 0x0f, 0x9c, 0xb6, 0xf4, 0x0a, 0x00, 0x00,
+func (m *MsgUpdateRoleLimits) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRoleLimits) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRoleLimits.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateRoleLimits) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRoleLimits.Merge(m, src)
+}
+func (m *MsgUpdateRoleLimits) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRoleLimits) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRoleLimits.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_MsgUpdateRoleLimits proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *MsgUpdateRoleLimits) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
-	CreateNamespace(ctx context.Context, in *MsgCreateNamespace, opts ...grpc.CallOption) (*MsgCreateNamespaceResponse, error)
-	UpdateNamespace(ctx context.Context, in *MsgUpdateNamespace, opts ...grpc.CallOption) (*MsgUpdateNamespaceResponse, error)
-	UpdateActorRoles(ctx context.Context, in *MsgUpdateActorRoles, opts ...grpc.CallOption) (*MsgUpdateActorRolesResponse, error)
-	ClaimVoucher(ctx context.Context, in *MsgClaimVoucher, opts ...grpc.CallOption) (*MsgClaimVoucherResponse, error)
+func (m *MsgUpdateRoleLimits) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgUpdateRoleLimits) GetRoleLimits() []*RoleLimits {
+	if m != nil {
+		return m.RoleLimits
+	}
+	return nil
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+type MsgUpdateRoleLimitsResponse struct {
 }
 
-func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
-	out := new(MsgUpdateParamsResponse)
-	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateParams", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgUpdateRoleLimitsResponse) Reset()         { *m = MsgUpdateRoleLimitsResponse{} }
+func (m *MsgUpdateRoleLimitsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateRoleLimitsResponse) ProtoMessage()    {}
+func (*MsgUpdateRoleLimitsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{11}
+}
+func (m *MsgUpdateRoleLimitsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateRoleLimitsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateRoleLimitsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgUpdateRoleLimitsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateRoleLimitsResponse.Merge(m, src)
+}
+func (m *MsgUpdateRoleLimitsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateRoleLimitsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateRoleLimitsResponse.DiscardUnknown(m)
 }
 
-func (c *msgClient) CreateNamespace(ctx context.Context, in *MsgCreateNamespace, opts ...grpc.CallOption) (*MsgCreateNamespaceResponse, error) {
-	out := new(MsgCreateNamespaceResponse)
-	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/CreateNamespace", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MsgUpdateRoleLimitsResponse proto.InternalMessageInfo
+
+type MsgClaimVouchers struct {
+	// The sender's Injective address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// The token denoms of the vouchers to claim
+	Denoms []string `protobuf:"bytes,2,rep,name=denoms,proto3" json:"denoms,omitempty"`
 }
 
-func (c *msgClient) UpdateNamespace(ctx context.Context, in *MsgUpdateNamespace, opts ...grpc.CallOption) (*MsgUpdateNamespaceResponse, error) {
-	out := new(MsgUpdateNamespaceResponse)
-	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateNamespace", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimVouchers) Reset()         { *m = MsgClaimVouchers{} }
+func (m *MsgClaimVouchers) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVouchers) ProtoMessage()    {}
+func (*MsgClaimVouchers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{12}
+}
+func (m *MsgClaimVouchers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgClaimVouchers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVouchers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MsgClaimVouchers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVouchers.Merge(m, src)
+}
+func (m *MsgClaimVouchers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgClaimVouchers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVouchers.DiscardUnknown(m)
 }
 
-func (c *msgClient) UpdateActorRoles(ctx context.Context, in *MsgUpdateActorRoles, opts ...grpc.CallOption) (*MsgUpdateActorRolesResponse, error) {
-	out := new(MsgUpdateActorRolesResponse)
-	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateActorRoles", in, out, opts...)
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgClaimVouchers proto.InternalMessageInfo
+
+func (m *MsgClaimVouchers) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return out, nil
+	return ""
 }
 
-func (c *msgClient) ClaimVoucher(ctx context.Context, in *MsgClaimVoucher, opts ...grpc.CallOption) (*MsgClaimVoucherResponse, error) {
-	out := new(MsgClaimVoucherResponse)
-	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/ClaimVoucher", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MsgClaimVouchers) GetDenoms() []string {
+	if m != nil {
+		return m.Denoms
 	}
-	return out, nil
+	return nil
 }
 
-// MsgServer is the server API for Msg service.
-type MsgServer interface {
-	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
-	CreateNamespace(context.Context, *MsgCreateNamespace) (*MsgCreateNamespaceResponse, error)
-	UpdateNamespace(context.Context, *MsgUpdateNamespace) (*MsgUpdateNamespaceResponse, error)
-	UpdateActorRoles(context.Context, *MsgUpdateActorRoles) (*MsgUpdateActorRolesResponse, error)
-	ClaimVoucher(context.Context, *MsgClaimVoucher) (*MsgClaimVoucherResponse, error)
+type MsgClaimVouchersResponse struct {
 }
 
-// UnimplementedMsgServer can be embedded to have forward compatible implementations.
-type UnimplementedMsgServer struct {
+func (m *MsgClaimVouchersResponse) Reset()         { *m = MsgClaimVouchersResponse{} }
+func (m *MsgClaimVouchersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgClaimVouchersResponse) ProtoMessage()    {}
+func (*MsgClaimVouchersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{13}
 }
-
-func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+func (m *MsgClaimVouchersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedMsgServer) CreateNamespace(ctx context.Context, req *MsgCreateNamespace) (*MsgCreateNamespaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
+func (m *MsgClaimVouchersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgClaimVouchersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
 }
-func (*UnimplementedMsgServer) UpdateNamespace(ctx context.Context, req *MsgUpdateNamespace) (*MsgUpdateNamespaceResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespace not implemented")
+func (m *MsgClaimVouchersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgClaimVouchersResponse.Merge(m, src)
 }
-func (*UnimplementedMsgServer) UpdateActorRoles(ctx context.Context, req *MsgUpdateActorRoles) (*MsgUpdateActorRolesResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method UpdateActorRoles not implemented")
+func (m *MsgClaimVouchersResponse) XXX_Size() int {
+	return m.Size()
 }
-func (*UnimplementedMsgServer) ClaimVoucher(ctx context.Context, req *MsgClaimVoucher) (*MsgClaimVoucherResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method ClaimVoucher not implemented")
+func (m *MsgClaimVouchersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgClaimVouchersResponse.DiscardUnknown(m)
 }
 
-func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
-	s.RegisterService(&_Msg_serviceDesc, srv)
+var xxx_messageInfo_MsgClaimVouchersResponse proto.InternalMessageInfo
+
+type MsgReturnExpiredVouchers struct {
+	// The sender's Injective address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// The token denom of the voucher
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The Injective address that the voucher is for
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateParams",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+func (m *MsgReturnExpiredVouchers) Reset()         { *m = MsgReturnExpiredVouchers{} }
+func (m *MsgReturnExpiredVouchers) String() string { return proto.CompactTextString(m) }
+func (*MsgReturnExpiredVouchers) ProtoMessage()    {}
+func (*MsgReturnExpiredVouchers) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{14}
+}
+func (m *MsgReturnExpiredVouchers) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReturnExpiredVouchers) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReturnExpiredVouchers.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *MsgReturnExpiredVouchers) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReturnExpiredVouchers.Merge(m, src)
+}
+func (m *MsgReturnExpiredVouchers) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReturnExpiredVouchers) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReturnExpiredVouchers.DiscardUnknown(m)
 }
 
-func _Msg_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgCreateNamespace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).CreateNamespace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.permissions.v1beta1.Msg/CreateNamespace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).CreateNamespace(ctx, req.(*MsgCreateNamespace))
+var xxx_messageInfo_MsgReturnExpiredVouchers proto.InternalMessageInfo
+
+func (m *MsgReturnExpiredVouchers) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateNamespace)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateNamespace(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateNamespace",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateNamespace(ctx, req.(*MsgUpdateNamespace))
+func (m *MsgReturnExpiredVouchers) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_UpdateActorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateActorRoles)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).UpdateActorRoles(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateActorRoles",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).UpdateActorRoles(ctx, req.(*MsgUpdateActorRoles))
+func (m *MsgReturnExpiredVouchers) GetAddress() string {
+	if m != nil {
+		return m.Address
 	}
-	return interceptor(ctx, in, info, handler)
+	return ""
 }
 
-func _Msg_ClaimVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgClaimVoucher)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(MsgServer).ClaimVoucher(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/injective.permissions.v1beta1.Msg/ClaimVoucher",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).ClaimVoucher(ctx, req.(*MsgClaimVoucher))
-	}
-	return interceptor(ctx, in, info, handler)
+type MsgReturnExpiredVouchersResponse struct {
 }
 
-var _Msg_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "injective.permissions.v1beta1.Msg",
-	HandlerType: (*MsgServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "UpdateParams",
-			Handler:    _Msg_UpdateParams_Handler,
-		},
-		{
-			MethodName: "CreateNamespace",
-			Handler:    _Msg_CreateNamespace_Handler,
-		},
-		{
-			MethodName: "UpdateNamespace",
-			Handler:    _Msg_UpdateNamespace_Handler,
-		},
-		{
-			MethodName: "UpdateActorRoles",
-			Handler:    _Msg_UpdateActorRoles_Handler,
-		},
-		{
-			MethodName: "ClaimVoucher",
-			Handler:    _Msg_ClaimVoucher_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "injective/permissions/v1beta1/tx.proto",
+func (m *MsgReturnExpiredVouchersResponse) Reset()         { *m = MsgReturnExpiredVouchersResponse{} }
+func (m *MsgReturnExpiredVouchersResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReturnExpiredVouchersResponse) ProtoMessage()    {}
+func (*MsgReturnExpiredVouchersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{15}
 }
-
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReturnExpiredVouchersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReturnExpiredVouchersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReturnExpiredVouchersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReturnExpiredVouchersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReturnExpiredVouchersResponse.Merge(m, src)
+}
+func (m *MsgReturnExpiredVouchersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReturnExpiredVouchersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReturnExpiredVouchersResponse.DiscardUnknown(m)
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReturnExpiredVouchersResponse proto.InternalMessageInfo
+
+type MsgReclaimVoucher struct {
+	// The sender's Injective address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// The token denom of the voucher to reclaim
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+	// The Injective address that the voucher is for
+	Address string `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgReclaimVoucher) Reset()         { *m = MsgReclaimVoucher{} }
+func (m *MsgReclaimVoucher) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimVoucher) ProtoMessage()    {}
+func (*MsgReclaimVoucher) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{16}
+}
+func (m *MsgReclaimVoucher) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimVoucher) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimVoucher.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
-		i--
-		dAtA[i] = 0xa
+}
+func (m *MsgReclaimVoucher) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimVoucher.Merge(m, src)
+}
+func (m *MsgReclaimVoucher) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimVoucher) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimVoucher.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReclaimVoucher proto.InternalMessageInfo
+
+func (m *MsgReclaimVoucher) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return len(dAtA) - i, nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimVoucher) GetDenom() string {
+	if m != nil {
+		return m.Denom
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgReclaimVoucher) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgReclaimVoucherResponse struct {
 }
 
-func (m *MsgCreateNamespace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *MsgReclaimVoucherResponse) Reset()         { *m = MsgReclaimVoucherResponse{} }
+func (m *MsgReclaimVoucherResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReclaimVoucherResponse) ProtoMessage()    {}
+func (*MsgReclaimVoucherResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{17}
+}
+func (m *MsgReclaimVoucherResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReclaimVoucherResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReclaimVoucherResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *MsgReclaimVoucherResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReclaimVoucherResponse.Merge(m, src)
+}
+func (m *MsgReclaimVoucherResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReclaimVoucherResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReclaimVoucherResponse.DiscardUnknown(m)
 }
 
-func (m *MsgCreateNamespace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_MsgReclaimVoucherResponse proto.InternalMessageInfo
+
+type MsgSetFrozenBalance struct {
+	// The sender's Injective address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// The Injective address whose balance is frozen
+	Address string `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	// The total amount to freeze, replacing the previously frozen amount. A zero
+	// amount unfreezes the balance.
+	Amount github_com_cosmos_cosmos_sdk_types.Coin `protobuf:"bytes,3,opt,name=amount,proto3,customtype=github.com/cosmos/cosmos-sdk/types.Coin" json:"amount"`
 }
 
-func (m *MsgCreateNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+func (m *MsgSetFrozenBalance) Reset()         { *m = MsgSetFrozenBalance{} }
+func (m *MsgSetFrozenBalance) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFrozenBalance) ProtoMessage()    {}
+func (*MsgSetFrozenBalance) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{18}
+}
+func (m *MsgSetFrozenBalance) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetFrozenBalance) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFrozenBalance.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
-	}
-	i--
-	dAtA[i] = 0x12
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *MsgSetFrozenBalance) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFrozenBalance.Merge(m, src)
+}
+func (m *MsgSetFrozenBalance) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFrozenBalance) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFrozenBalance.DiscardUnknown(m)
 }
 
-func (m *MsgCreateNamespaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_MsgSetFrozenBalance proto.InternalMessageInfo
+
+func (m *MsgSetFrozenBalance) GetSender() string {
+	if m != nil {
+		return m.Sender
 	}
-	return dAtA[:n], nil
+	return ""
 }
 
-func (m *MsgCreateNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFrozenBalance) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
 }
 
-func (m *MsgCreateNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+type MsgSetFrozenBalanceResponse struct {
 }
 
-func (m *MsgUpdateNamespace) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (m *MsgSetFrozenBalanceResponse) Reset()         { *m = MsgSetFrozenBalanceResponse{} }
+func (m *MsgSetFrozenBalanceResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetFrozenBalanceResponse) ProtoMessage()    {}
+func (*MsgSetFrozenBalanceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ab9bfdcab1d9b6fa, []int{19}
 }
-
-func (m *MsgUpdateNamespace) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (m *MsgSetFrozenBalanceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (m *MsgUpdateNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.EvmHook != nil {
-		{
-			size, err := m.EvmHook.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
-		}
-		i--
-		dAtA[i] = 0x42
-	}
-	if len(m.PolicyManagerCapabilities) > 0 {
-		for iNdEx := len(m.PolicyManagerCapabilities) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PolicyManagerCapabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x3a
-		}
-	}
-	if len(m.PolicyStatuses) > 0 {
-		for iNdEx := len(m.PolicyStatuses) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.PolicyStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x32
-		}
-	}
-	if len(m.RoleManagers) > 0 {
-		for iNdEx := len(m.RoleManagers) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RoleManagers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.RolePermissions) > 0 {
-		for iNdEx := len(m.RolePermissions) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RolePermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x22
-		}
-	}
-	if m.WasmHook != nil {
-		{
-			size, err := m.WasmHook.MarshalToSizedBuffer(dAtA[:i])
-			if err != nil {
-				return 0, err
-			}
-			i -= size
-			i = encodeVarintTx(dAtA, i, uint64(size))
+func (m *MsgSetFrozenBalanceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetFrozenBalanceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
-		i--
-		dAtA[i] = 0x1a
+		return b[:n], nil
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+}
+func (m *MsgSetFrozenBalanceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetFrozenBalanceResponse.Merge(m, src)
+}
+func (m *MsgSetFrozenBalanceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetFrozenBalanceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetFrozenBalanceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetFrozenBalanceResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgUpdateParams)(nil), "injective.permissions.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.permissions.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgCreateNamespace)(nil), "injective.permissions.v1beta1.MsgCreateNamespace")
+	proto.RegisterType((*MsgCreateNamespaceResponse)(nil), "injective.permissions.v1beta1.MsgCreateNamespaceResponse")
+	proto.RegisterType((*MsgUpdateNamespace)(nil), "injective.permissions.v1beta1.MsgUpdateNamespace")
+	proto.RegisterType((*MsgUpdateNamespace_SetContractHook)(nil), "injective.permissions.v1beta1.MsgUpdateNamespace.SetContractHook")
+	proto.RegisterType((*MsgUpdateNamespace_SetVoucherExpiry)(nil), "injective.permissions.v1beta1.MsgUpdateNamespace.SetVoucherExpiry")
+	proto.RegisterType((*MsgUpdateNamespaceResponse)(nil), "injective.permissions.v1beta1.MsgUpdateNamespaceResponse")
+	proto.RegisterType((*MsgUpdateActorRoles)(nil), "injective.permissions.v1beta1.MsgUpdateActorRoles")
+	proto.RegisterType((*MsgUpdateActorRolesResponse)(nil), "injective.permissions.v1beta1.MsgUpdateActorRolesResponse")
+	proto.RegisterType((*MsgClaimVoucher)(nil), "injective.permissions.v1beta1.MsgClaimVoucher")
+	proto.RegisterType((*MsgClaimVoucherResponse)(nil), "injective.permissions.v1beta1.MsgClaimVoucherResponse")
+	proto.RegisterType((*MsgUpdateRoleLimits)(nil), "injective.permissions.v1beta1.MsgUpdateRoleLimits")
+	proto.RegisterType((*MsgUpdateRoleLimitsResponse)(nil), "injective.permissions.v1beta1.MsgUpdateRoleLimitsResponse")
+	proto.RegisterType((*MsgClaimVouchers)(nil), "injective.permissions.v1beta1.MsgClaimVouchers")
+	proto.RegisterType((*MsgClaimVouchersResponse)(nil), "injective.permissions.v1beta1.MsgClaimVouchersResponse")
+	proto.RegisterType((*MsgReturnExpiredVouchers)(nil), "injective.permissions.v1beta1.MsgReturnExpiredVouchers")
+	proto.RegisterType((*MsgReturnExpiredVouchersResponse)(nil), "injective.permissions.v1beta1.MsgReturnExpiredVouchersResponse")
+	proto.RegisterType((*MsgReclaimVoucher)(nil), "injective.permissions.v1beta1.MsgReclaimVoucher")
+	proto.RegisterType((*MsgReclaimVoucherResponse)(nil), "injective.permissions.v1beta1.MsgReclaimVoucherResponse")
+	proto.RegisterType((*MsgSetFrozenBalance)(nil), "injective.permissions.v1beta1.MsgSetFrozenBalance")
+	proto.RegisterType((*MsgSetFrozenBalanceResponse)(nil), "injective.permissions.v1beta1.MsgSetFrozenBalanceResponse")
+}
+
+func init() {
+	proto.RegisterFile("injective/permissions/v1beta1/tx.proto", fileDescriptor_ab9bfdcab1d9b6fa)
+}
+
+var fileDescriptor_ab9bfdcab1d9b6fa = []byte{
+	// 1256 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x58, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0xae, 0x37, 0xdb, 0x6c, 0x33, 0xdb, 0x4f, 0xd3, 0x65, 0x5d, 0x77, 0x9b, 0x56, 0xe1, 0xab,
+	0xdb, 0xa5, 0x31, 0x2d, 0xa8, 0xb0, 0xb9, 0xa0, 0x26, 0x02, 0x01, 0x6a, 0x97, 0xc5, 0x5d, 0x7a,
+	0x40, 0x2b, 0xa2, 0x89, 0x3d, 0x4a, 0x4d, 0x62, 0x8f, 0xe5, 0x99, 0xb8, 0x1b, 0x84, 0xb4, 0x68,
+	0xb9, 0x71, 0x82, 0x2b, 0x12, 0xbf, 0x80, 0x4b, 0x0f, 0x5c, 0xe1, 0xbc, 0xc7, 0x15, 0x5c, 0x10,
+	0x87, 0x0a, 0xb5, 0x87, 0x1e, 0x91, 0xf8, 0x01, 0x08, 0x79, 0x3c, 0xb1, 0x9d, 0x49, 0x68, 0xec,
+	0x6e, 0x2f, 0xad, 0x67, 0xe6, 0x7d, 0x9e, 0x79, 0x9e, 0x79, 0xe7, 0xe3, 0x55, 0xc0, 0xab, 0x96,
+	0xf3, 0x05, 0x32, 0xa8, 0xe5, 0x23, 0xcd, 0x45, 0x9e, 0x6d, 0x11, 0x62, 0x61, 0x87, 0x68, 0xfe,
+	0x46, 0x03, 0x51, 0xb8, 0xa1, 0xd1, 0x47, 0x65, 0xd7, 0xc3, 0x14, 0xcb, 0x4b, 0x51, 0x5c, 0x39,
+	0x11, 0x57, 0xe6, 0x71, 0xea, 0x7c, 0x13, 0x37, 0x31, 0x8b, 0xd4, 0x82, 0xaf, 0x10, 0xa4, 0x16,
+	0x0d, 0x4c, 0x6c, 0x4c, 0xb4, 0x06, 0x24, 0x28, 0xa2, 0x34, 0xb0, 0xe5, 0x0c, 0x8c, 0x3b, 0xad,
+	0x68, 0x3c, 0x68, 0xf0, 0xf1, 0x9b, 0x7c, 0xdc, 0x26, 0x4d, 0xcd, 0xdf, 0x08, 0xfe, 0xf1, 0x81,
+	0x85, 0x70, 0xa0, 0x1e, 0xce, 0x18, 0x36, 0xf8, 0xd0, 0xda, 0xf9, 0x86, 0x5c, 0xe8, 0x41, 0xbb,
+	0x17, 0xab, 0x8d, 0x88, 0x4d, 0x18, 0x0d, 0x01, 0x73, 0xd0, 0xb6, 0x1c, 0xac, 0xb1, 0xbf, 0x61,
+	0x57, 0xe9, 0x57, 0x09, 0xcc, 0xec, 0x92, 0xe6, 0xa7, 0xae, 0x09, 0x29, 0xba, 0xcf, 0xd8, 0xe5,
+	0x2d, 0x50, 0x80, 0x1d, 0x7a, 0x80, 0x3d, 0x8b, 0x76, 0x15, 0x69, 0x45, 0x5a, 0x2d, 0x54, 0x95,
+	0xdf, 0x7e, 0x5e, 0x9f, 0xe7, 0x42, 0xb7, 0x4d, 0xd3, 0x43, 0x84, 0xec, 0x51, 0xcf, 0x72, 0x9a,
+	0x7a, 0x1c, 0x2a, 0xd7, 0x40, 0x3e, 0xd4, 0xa7, 0x5c, 0x59, 0x91, 0x56, 0xaf, 0x6f, 0xbe, 0x52,
+	0x3e, 0x77, 0xd5, 0xcb, 0xe1, 0x74, 0xd5, 0xab, 0x4f, 0x8f, 0x97, 0xc7, 0x74, 0x0e, 0xad, 0x94,
+	0x9f, 0x9c, 0x1d, 0xad, 0xc5, 0xa4, 0xdf, 0x9e, 0x1d, 0xad, 0x2d, 0x26, 0xdd, 0x09, 0x62, 0x4b,
+	0x0b, 0xe0, 0xa6, 0xd0, 0xa5, 0x23, 0xe2, 0x62, 0x87, 0xa0, 0xd2, 0x2f, 0x12, 0x90, 0x77, 0x49,
+	0xb3, 0xe6, 0x21, 0x48, 0xd1, 0x3d, 0x68, 0x23, 0xe2, 0x42, 0x03, 0xc9, 0xb7, 0x41, 0x9e, 0x20,
+	0xc7, 0x44, 0x1e, 0xf7, 0x36, 0xf7, 0xcf, 0xf1, 0xf2, 0x54, 0x17, 0xda, 0xed, 0x4a, 0x29, 0xec,
+	0x2f, 0xe9, 0x3c, 0x40, 0xde, 0x01, 0x05, 0xa7, 0x87, 0xe3, 0xa6, 0x56, 0x47, 0x98, 0x8a, 0xe6,
+	0xe1, 0xbe, 0x62, 0x82, 0xd0, 0x1a, 0xa7, 0x0e, 0x7c, 0x15, 0x05, 0x5f, 0x82, 0xd0, 0xd2, 0x2d,
+	0xa0, 0x0e, 0xf6, 0x46, 0xee, 0xfe, 0xcd, 0x03, 0x39, 0x72, 0x7e, 0x21, 0x77, 0xf3, 0x60, 0xdc,
+	0x44, 0x0e, 0xb6, 0x99, 0xb3, 0x82, 0x1e, 0x36, 0xe4, 0xcf, 0x41, 0xe1, 0x10, 0x12, 0xbb, 0x7e,
+	0x80, 0x71, 0x4b, 0xc9, 0x31, 0xcf, 0xdb, 0x23, 0x3c, 0x0f, 0xca, 0x28, 0xef, 0x21, 0x5a, 0xc3,
+	0x0e, 0xf5, 0xa0, 0x41, 0x3f, 0xc0, 0xb8, 0xa5, 0x4f, 0x04, 0x9c, 0xc1, 0x97, 0x7c, 0x0f, 0xcc,
+	0x7a, 0xb8, 0x8d, 0xea, 0x09, 0x22, 0xe5, 0xea, 0x4a, 0x6e, 0xf5, 0xfa, 0xe6, 0x4b, 0x23, 0xa6,
+	0xd1, 0x71, 0x1b, 0xe9, 0x33, 0x01, 0xf8, 0x7e, 0x3c, 0x2a, 0x7f, 0x0c, 0xa6, 0x18, 0x9f, 0x0d,
+	0x1d, 0xd8, 0x44, 0x1e, 0x51, 0xc6, 0x19, 0xd9, 0x5a, 0x0a, 0xb2, 0xdd, 0x10, 0xa2, 0x4f, 0x7a,
+	0x71, 0x83, 0xc8, 0x0f, 0xc0, 0x8c, 0x8b, 0xdb, 0x96, 0xd1, 0xad, 0x13, 0x0a, 0x69, 0x87, 0x20,
+	0xa2, 0xe4, 0x19, 0xe5, 0x9d, 0x51, 0xfb, 0x99, 0xa1, 0xf6, 0x18, 0x48, 0x9f, 0x76, 0x13, 0x2d,
+	0x44, 0x64, 0x1f, 0x2c, 0x72, 0x56, 0x2e, 0xb4, 0x6e, 0x40, 0x17, 0x36, 0xac, 0xb6, 0x45, 0x2d,
+	0x44, 0x94, 0x6b, 0x6c, 0x86, 0xad, 0x54, 0x33, 0x70, 0xa5, 0xb5, 0x1e, 0xbe, 0xab, 0x2f, 0xb8,
+	0x43, 0x07, 0x2c, 0x44, 0xe4, 0x87, 0x60, 0x02, 0xf9, 0x3c, 0x9b, 0x13, 0x97, 0x95, 0xcd, 0x6b,
+	0xc8, 0x0f, 0x93, 0x69, 0x81, 0x69, 0x1f, 0x77, 0x8c, 0x03, 0xe4, 0xd5, 0xd1, 0x23, 0xd7, 0xf2,
+	0xba, 0x4a, 0x81, 0xcd, 0x51, 0xbd, 0xd0, 0x1c, 0xfb, 0x21, 0xd5, 0x7b, 0x8c, 0x49, 0x9f, 0xf2,
+	0x93, 0x4d, 0xb5, 0x0c, 0x66, 0x04, 0x19, 0xf2, 0x22, 0x28, 0x38, 0xe8, 0xb0, 0xee, 0xc3, 0x76,
+	0x07, 0x85, 0xdb, 0x5d, 0x9f, 0x70, 0xd0, 0xe1, 0x7e, 0xd0, 0x56, 0x35, 0x30, 0x2b, 0x52, 0x0e,
+	0x02, 0x72, 0x31, 0x60, 0xe4, 0xf1, 0x14, 0x04, 0xf3, 0xe3, 0x29, 0xf4, 0xc6, 0x97, 0xcf, 0x15,
+	0xf0, 0x42, 0x34, 0xbc, 0x6d, 0x50, 0xec, 0x05, 0x3b, 0x8e, 0x3c, 0xff, 0xf9, 0xdc, 0x07, 0x32,
+	0xdb, 0xef, 0x30, 0xe0, 0x24, 0x75, 0x8a, 0xeb, 0xd0, 0x34, 0x95, 0x1c, 0xdb, 0x3f, 0xb7, 0x53,
+	0x6c, 0x7a, 0xa6, 0x85, 0x84, 0xe7, 0x28, 0xfc, 0x7e, 0x80, 0xb7, 0x4d, 0x53, 0x7e, 0x08, 0x6e,
+	0x08, 0xbc, 0x1e, 0xf2, 0x71, 0x0b, 0x29, 0xe3, 0x59, 0xa9, 0xe5, 0x24, 0xb5, 0xce, 0x48, 0x2a,
+	0x9a, 0xb0, 0xb8, 0xcb, 0x43, 0x17, 0x37, 0x5e, 0xa7, 0xd2, 0x12, 0x58, 0x1c, 0xd2, 0x1d, 0x2d,
+	0xef, 0x63, 0xf6, 0x6c, 0xd5, 0xda, 0xd0, 0xb2, 0x79, 0x8a, 0x9f, 0x7b, 0x65, 0x2b, 0x77, 0x04,
+	0x8d, 0xe2, 0xbb, 0x93, 0x9c, 0x8d, 0xbf, 0x3b, 0xc9, 0xae, 0x48, 0xdb, 0xef, 0x52, 0x22, 0xf5,
+	0x81, 0xec, 0x1d, 0xcb, 0xb6, 0xe8, 0x25, 0xa4, 0xfe, 0x23, 0x70, 0x9d, 0xa5, 0xa8, 0xcd, 0xf8,
+	0x32, 0xe4, 0x3c, 0x14, 0xa0, 0x03, 0x2f, 0xfa, 0x4e, 0x99, 0x90, 0x18, 0xdc, 0x97, 0x90, 0x04,
+	0x67, 0xcf, 0xf4, 0x37, 0x12, 0x98, 0x15, 0x16, 0x24, 0x93, 0xe3, 0x17, 0x41, 0x9e, 0x99, 0x0c,
+	0x8a, 0x87, 0xdc, 0x6a, 0x41, 0xe7, 0xad, 0xca, 0xeb, 0x82, 0xce, 0x5b, 0xe7, 0x24, 0x85, 0x94,
+	0x54, 0xa0, 0x88, 0x7d, 0x91, 0xc2, 0x9f, 0x24, 0x36, 0xa8, 0x23, 0xda, 0xf1, 0x1c, 0x76, 0x21,
+	0x20, 0xf3, 0x22, 0x4a, 0x87, 0xe7, 0x46, 0x01, 0xd7, 0x60, 0x58, 0x18, 0xb1, 0x47, 0xb3, 0xa0,
+	0xf7, 0x9a, 0x95, 0xb7, 0x04, 0x07, 0x2f, 0x0b, 0x0e, 0x86, 0x0a, 0x2a, 0x95, 0xc0, 0xca, 0xff,
+	0x8d, 0x45, 0x8e, 0x7e, 0x94, 0xc0, 0x1c, 0x0b, 0x32, 0x2e, 0xf3, 0x1c, 0x9c, 0x63, 0x65, 0x5d,
+	0xb0, 0xb2, 0x34, 0x60, 0x25, 0xa9, 0xa4, 0xb4, 0x08, 0x16, 0x06, 0x3a, 0x23, 0xf1, 0x7f, 0x87,
+	0xa7, 0x64, 0x0f, 0xd1, 0xf7, 0x3d, 0xfc, 0x25, 0x72, 0xaa, 0xb0, 0x0d, 0x9d, 0x6c, 0x05, 0x4c,
+	0x42, 0xe8, 0x95, 0x3e, 0xa1, 0x72, 0x03, 0xe4, 0xa1, 0x8d, 0x3b, 0x0e, 0xe5, 0x15, 0xcc, 0x42,
+	0x99, 0x17, 0xaf, 0x41, 0x2d, 0x1f, 0x1d, 0x8d, 0x1a, 0xb6, 0x9c, 0xaa, 0x16, 0x94, 0x69, 0x7f,
+	0x1e, 0x2f, 0xbf, 0xd6, 0xb4, 0xe8, 0x41, 0xa7, 0x51, 0x36, 0xb0, 0xcd, 0x4b, 0x72, 0xfe, 0x6f,
+	0x9d, 0x98, 0x2d, 0x8d, 0x76, 0x5d, 0x44, 0x18, 0x40, 0xe7, 0xcc, 0x23, 0x4f, 0x90, 0xe8, 0x8c,
+	0x9f, 0x20, 0xb1, 0xbb, 0xb7, 0x20, 0x9b, 0x3f, 0x00, 0x90, 0xdb, 0x25, 0x4d, 0xd9, 0x07, 0x93,
+	0x7d, 0xe5, 0x78, 0x39, 0xed, 0x5b, 0x1a, 0xc6, 0xab, 0x5b, 0xd9, 0xe2, 0x7b, 0xf3, 0xcb, 0x8f,
+	0xc1, 0x8c, 0x58, 0x2a, 0x6f, 0x8c, 0xa6, 0x12, 0x20, 0xea, 0xdd, 0xcc, 0x90, 0xa4, 0x00, 0xb1,
+	0x9a, 0xdd, 0xc8, 0x5c, 0x47, 0xa8, 0x77, 0x33, 0x43, 0x22, 0x01, 0x4f, 0x24, 0x30, 0x3b, 0xf0,
+	0x60, 0x6f, 0xa6, 0xe5, 0x8b, 0x31, 0x6a, 0x25, 0x3b, 0x26, 0x12, 0xe1, 0x83, 0xc9, 0xbe, 0x67,
+	0x2d, 0x45, 0xfa, 0x93, 0xf1, 0xea, 0x56, 0xb6, 0xf8, 0x21, 0xe6, 0x13, 0x4f, 0x56, 0x6a, 0xf3,
+	0x31, 0x46, 0xad, 0x64, 0xc7, 0x44, 0x22, 0xba, 0x60, 0xaa, 0xff, 0x05, 0xd1, 0xb2, 0xb9, 0x21,
+	0xea, 0xdb, 0x19, 0x01, 0xd1, 0xd4, 0xdf, 0x4b, 0xe0, 0xc6, 0xf0, 0xb7, 0x21, 0x05, 0xe5, 0x50,
+	0xa0, 0xfa, 0xee, 0x05, 0x81, 0x91, 0xa6, 0xaf, 0xc0, 0xb4, 0x70, 0xb9, 0xbf, 0x91, 0x86, 0x32,
+	0x89, 0x50, 0xdf, 0xc9, 0x8a, 0xe8, 0xdb, 0x11, 0x03, 0xd7, 0x73, 0x8a, 0x1d, 0x21, 0x62, 0xd4,
+	0x4a, 0x76, 0x4c, 0x4f, 0x84, 0x3a, 0xfe, 0xf5, 0xd9, 0xd1, 0x9a, 0x54, 0x6d, 0x3d, 0x3d, 0x29,
+	0x4a, 0xcf, 0x4e, 0x8a, 0xd2, 0x5f, 0x27, 0x45, 0xe9, 0xbb, 0xd3, 0xe2, 0xd8, 0xb3, 0xd3, 0xe2,
+	0xd8, 0x1f, 0xa7, 0xc5, 0xb1, 0xcf, 0x3e, 0x49, 0xdc, 0xdb, 0x1f, 0xf6, 0xa6, 0xd9, 0x81, 0x0d,
+	0x12, 0xff, 0x3c, 0xb2, 0x6e, 0x60, 0x0f, 0x25, 0x9b, 0x07, 0xd0, 0x72, 0x34, 0x1b, 0x9b, 0x9d,
+	0x36, 0x22, 0x7d, 0xbf, 0x9d, 0xb0, 0x6b, 0xbe, 0x91, 0x67, 0xbf, 0x8d, 0xbc, 0xf9, 0xdf, 0x00,
+	0x36, 0x1b, 0x52, 0x94, 0x5e, 0x12, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	CreateNamespace(ctx context.Context, in *MsgCreateNamespace, opts ...grpc.CallOption) (*MsgCreateNamespaceResponse, error)
+	UpdateNamespace(ctx context.Context, in *MsgUpdateNamespace, opts ...grpc.CallOption) (*MsgUpdateNamespaceResponse, error)
+	UpdateActorRoles(ctx context.Context, in *MsgUpdateActorRoles, opts ...grpc.CallOption) (*MsgUpdateActorRolesResponse, error)
+	ClaimVoucher(ctx context.Context, in *MsgClaimVoucher, opts ...grpc.CallOption) (*MsgClaimVoucherResponse, error)
+	UpdateRoleLimits(ctx context.Context, in *MsgUpdateRoleLimits, opts ...grpc.CallOption) (*MsgUpdateRoleLimitsResponse, error)
+	ClaimVouchers(ctx context.Context, in *MsgClaimVouchers, opts ...grpc.CallOption) (*MsgClaimVouchersResponse, error)
+	ReturnExpiredVouchers(ctx context.Context, in *MsgReturnExpiredVouchers, opts ...grpc.CallOption) (*MsgReturnExpiredVouchersResponse, error)
+	ReclaimVoucher(ctx context.Context, in *MsgReclaimVoucher, opts ...grpc.CallOption) (*MsgReclaimVoucherResponse, error)
+	SetFrozenBalance(ctx context.Context, in *MsgSetFrozenBalance, opts ...grpc.CallOption) (*MsgSetFrozenBalanceResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	return out, nil
+}
+
+func (c *msgClient) CreateNamespace(ctx context.Context, in *MsgCreateNamespace, opts ...grpc.CallOption) (*MsgCreateNamespaceResponse, error) {
+	out := new(MsgCreateNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/CreateNamespace", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateNamespace_SetContractHook) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateNamespace(ctx context.Context, in *MsgUpdateNamespace, opts ...grpc.CallOption) (*MsgUpdateNamespaceResponse, error) {
+	out := new(MsgUpdateNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateNamespace", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateNamespace_SetContractHook) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateActorRoles(ctx context.Context, in *MsgUpdateActorRoles, opts ...grpc.CallOption) (*MsgUpdateActorRolesResponse, error) {
+	out := new(MsgUpdateActorRolesResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateActorRoles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateNamespace_SetContractHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.NewValue) > 0 {
-		i -= len(m.NewValue)
-		copy(dAtA[i:], m.NewValue)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValue)))
-		i--
-		dAtA[i] = 0xa
+func (c *msgClient) ClaimVoucher(ctx context.Context, in *MsgClaimVoucher, opts ...grpc.CallOption) (*MsgClaimVoucherResponse, error) {
+	out := new(MsgClaimVoucherResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/ClaimVoucher", in, out, opts...)
+	if err != nil {
+		return nil, err
 	}
-	return len(dAtA) - i, nil
+	return out, nil
 }
 
-func (m *MsgUpdateNamespaceResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) UpdateRoleLimits(ctx context.Context, in *MsgUpdateRoleLimits, opts ...grpc.CallOption) (*MsgUpdateRoleLimitsResponse, error) {
+	out := new(MsgUpdateRoleLimitsResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/UpdateRoleLimits", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ClaimVouchers(ctx context.Context, in *MsgClaimVouchers, opts ...grpc.CallOption) (*MsgClaimVouchersResponse, error) {
+	out := new(MsgClaimVouchersResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/ClaimVouchers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (c *msgClient) ReturnExpiredVouchers(ctx context.Context, in *MsgReturnExpiredVouchers, opts ...grpc.CallOption) (*MsgReturnExpiredVouchersResponse, error) {
+	out := new(MsgReturnExpiredVouchersResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/ReturnExpiredVouchers", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateActorRoles) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) ReclaimVoucher(ctx context.Context, in *MsgReclaimVoucher, opts ...grpc.CallOption) (*MsgReclaimVoucherResponse, error) {
+	out := new(MsgReclaimVoucherResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/ReclaimVoucher", in, out, opts...)
 	if err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
+	return out, nil
 }
 
-func (m *MsgUpdateActorRoles) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (c *msgClient) SetFrozenBalance(ctx context.Context, in *MsgSetFrozenBalance, opts ...grpc.CallOption) (*MsgSetFrozenBalanceResponse, error) {
+	out := new(MsgSetFrozenBalanceResponse)
+	err := c.cc.Invoke(ctx, "/injective.permissions.v1beta1.Msg/SetFrozenBalance", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
 }
 
-func (m *MsgUpdateActorRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.RoleActorsToRevoke) > 0 {
-		for iNdEx := len(m.RoleActorsToRevoke) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RoleActorsToRevoke[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x2a
-		}
-	}
-	if len(m.RoleActorsToAdd) > 0 {
-		for iNdEx := len(m.RoleActorsToAdd) - 1; iNdEx >= 0; iNdEx-- {
-			{
-				size, err := m.RoleActorsToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
-				if err != nil {
-					return 0, err
-				}
-				i -= size
-				i = encodeVarintTx(dAtA, i, uint64(size))
-			}
-			i--
-			dAtA[i] = 0x1a
-		}
-	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
-	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
-	}
-	return len(dAtA) - i, nil
+// MsgServer is the server API for Msg service.
+type MsgServer interface {
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	CreateNamespace(context.Context, *MsgCreateNamespace) (*MsgCreateNamespaceResponse, error)
+	UpdateNamespace(context.Context, *MsgUpdateNamespace) (*MsgUpdateNamespaceResponse, error)
+	UpdateActorRoles(context.Context, *MsgUpdateActorRoles) (*MsgUpdateActorRolesResponse, error)
+	ClaimVoucher(context.Context, *MsgClaimVoucher) (*MsgClaimVoucherResponse, error)
+	UpdateRoleLimits(context.Context, *MsgUpdateRoleLimits) (*MsgUpdateRoleLimitsResponse, error)
+	ClaimVouchers(context.Context, *MsgClaimVouchers) (*MsgClaimVouchersResponse, error)
+	ReturnExpiredVouchers(context.Context, *MsgReturnExpiredVouchers) (*MsgReturnExpiredVouchersResponse, error)
+	ReclaimVoucher(context.Context, *MsgReclaimVoucher) (*MsgReclaimVoucherResponse, error)
+	SetFrozenBalance(context.Context, *MsgSetFrozenBalance) (*MsgSetFrozenBalanceResponse, error)
 }
 
-func (m *MsgUpdateActorRolesResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+// UnimplementedMsgServer can be embedded to have forward compatible implementations.
+type UnimplementedMsgServer struct {
 }
 
-func (m *MsgUpdateActorRolesResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
-
-func (m *MsgUpdateActorRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func (*UnimplementedMsgServer) CreateNamespace(ctx context.Context, req *MsgCreateNamespace) (*MsgCreateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateNamespace not implemented")
 }
-
-func (m *MsgClaimVoucher) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
-	}
-	return dAtA[:n], nil
+func (*UnimplementedMsgServer) UpdateNamespace(ctx context.Context, req *MsgUpdateNamespace) (*MsgUpdateNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateNamespace not implemented")
+}
+func (*UnimplementedMsgServer) UpdateActorRoles(ctx context.Context, req *MsgUpdateActorRoles) (*MsgUpdateActorRolesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateActorRoles not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVoucher(ctx context.Context, req *MsgClaimVoucher) (*MsgClaimVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVoucher not implemented")
+}
+func (*UnimplementedMsgServer) UpdateRoleLimits(ctx context.Context, req *MsgUpdateRoleLimits) (*MsgUpdateRoleLimitsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateRoleLimits not implemented")
+}
+func (*UnimplementedMsgServer) ClaimVouchers(ctx context.Context, req *MsgClaimVouchers) (*MsgClaimVouchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ClaimVouchers not implemented")
+}
+func (*UnimplementedMsgServer) ReturnExpiredVouchers(ctx context.Context, req *MsgReturnExpiredVouchers) (*MsgReturnExpiredVouchersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReturnExpiredVouchers not implemented")
+}
+func (*UnimplementedMsgServer) ReclaimVoucher(ctx context.Context, req *MsgReclaimVoucher) (*MsgReclaimVoucherResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReclaimVoucher not implemented")
+}
+func (*UnimplementedMsgServer) SetFrozenBalance(ctx context.Context, req *MsgSetFrozenBalance) (*MsgSetFrozenBalanceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetFrozenBalance not implemented")
 }
 
-func (m *MsgClaimVoucher) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
+	s.RegisterService(&_Msg_serviceDesc, srv)
 }
 
-func (m *MsgClaimVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x12
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	if len(m.Sender) > 0 {
-		i -= len(m.Sender)
-		copy(dAtA[i:], m.Sender)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
-		i--
-		dAtA[i] = 0xa
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
 	}
-	return len(dAtA) - i, nil
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimVoucherResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Msg_CreateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgCreateNamespace)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *MsgClaimVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(MsgServer).CreateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/CreateNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).CreateNamespace(ctx, req.(*MsgCreateNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgClaimVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+func _Msg_UpdateNamespace_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateNamespace(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateNamespace",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateNamespace(ctx, req.(*MsgUpdateNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func _Msg_UpdateActorRoles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateActorRoles)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateActorRoles(ctx, in)
 	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateActorRoles",
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateActorRoles(ctx, req.(*MsgUpdateActorRoles))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVoucher)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/ClaimVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVoucher(ctx, req.(*MsgClaimVoucher))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNamespace) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_UpdateRoleLimits_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateRoleLimits)
+	if err := dec(in); err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateRoleLimits(ctx, in)
 	}
-	l = m.Namespace.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/UpdateRoleLimits",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateRoleLimits(ctx, req.(*MsgUpdateRoleLimits))
+	}
+	return interceptor(ctx, in, info, handler)
 }
 
-func (m *MsgCreateNamespaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func _Msg_ClaimVouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgClaimVouchers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ClaimVouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/ClaimVouchers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ClaimVouchers(ctx, req.(*MsgClaimVouchers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReturnExpiredVouchers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReturnExpiredVouchers)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReturnExpiredVouchers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/ReturnExpiredVouchers",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReturnExpiredVouchers(ctx, req.(*MsgReturnExpiredVouchers))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ReclaimVoucher_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReclaimVoucher)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ReclaimVoucher(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/ReclaimVoucher",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ReclaimVoucher(ctx, req.(*MsgReclaimVoucher))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetFrozenBalance_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetFrozenBalance)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetFrozenBalance(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.permissions.v1beta1.Msg/SetFrozenBalance",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetFrozenBalance(ctx, req.(*MsgSetFrozenBalance))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Msg_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "injective.permissions.v1beta1.Msg",
+	HandlerType: (*MsgServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
+		{
+			MethodName: "CreateNamespace",
+			Handler:    _Msg_CreateNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateNamespace",
+			Handler:    _Msg_UpdateNamespace_Handler,
+		},
+		{
+			MethodName: "UpdateActorRoles",
+			Handler:    _Msg_UpdateActorRoles_Handler,
+		},
+		{
+			MethodName: "ClaimVoucher",
+			Handler:    _Msg_ClaimVoucher_Handler,
+		},
+		{
+			MethodName: "UpdateRoleLimits",
+			Handler:    _Msg_UpdateRoleLimits_Handler,
+		},
+		{
+			MethodName: "ClaimVouchers",
+			Handler:    _Msg_ClaimVouchers_Handler,
+		},
+		{
+			MethodName: "ReturnExpiredVouchers",
+			Handler:    _Msg_ReturnExpiredVouchers_Handler,
+		},
+		{
+			MethodName: "ReclaimVoucher",
+			Handler:    _Msg_ReclaimVoucher_Handler,
+		},
+		{
+			MethodName: "SetFrozenBalance",
+			Handler:    _Msg_SetFrozenBalance_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "injective/permissions/v1beta1/tx.proto",
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateNamespace) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Namespace.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgCreateNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgCreateNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgCreateNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespace) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamespace) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespace) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.VoucherExpiry != nil {
+		{
+			size, err := m.VoucherExpiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.EvmHook != nil {
+		{
+			size, err := m.EvmHook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.PolicyManagerCapabilities) > 0 {
+		for iNdEx := len(m.PolicyManagerCapabilities) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PolicyManagerCapabilities[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.PolicyStatuses) > 0 {
+		for iNdEx := len(m.PolicyStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PolicyStatuses[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.RoleManagers) > 0 {
+		for iNdEx := len(m.RoleManagers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleManagers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RolePermissions) > 0 {
+		for iNdEx := len(m.RolePermissions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RolePermissions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.WasmHook != nil {
+		{
+			size, err := m.WasmHook.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintTx(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespace_SetContractHook) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamespace_SetContractHook) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespace_SetContractHook) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.NewValue) > 0 {
+		i -= len(m.NewValue)
+		copy(dAtA[i:], m.NewValue)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.NewValue)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespace_SetVoucherExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamespace_SetVoucherExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespace_SetVoucherExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.NewValue != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.NewValue))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateNamespaceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateNamespaceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateNamespaceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateActorRoles) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateActorRoles) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateActorRoles) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoleActorsToRevoke) > 0 {
+		for iNdEx := len(m.RoleActorsToRevoke) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleActorsToRevoke[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.RoleActorsToAdd) > 0 {
+		for iNdEx := len(m.RoleActorsToAdd) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleActorsToAdd[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateActorRolesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateActorRolesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateActorRolesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRoleLimits) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRoleLimits) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRoleLimits) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RoleLimits) > 0 {
+		for iNdEx := len(m.RoleLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RoleLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateRoleLimitsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateRoleLimitsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateRoleLimitsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVouchers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVouchers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVouchers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denoms) > 0 {
+		for iNdEx := len(m.Denoms) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Denoms[iNdEx])
+			copy(dAtA[i:], m.Denoms[iNdEx])
+			i = encodeVarintTx(dAtA, i, uint64(len(m.Denoms[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgClaimVouchersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgClaimVouchersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgClaimVouchersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReturnExpiredVouchers) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReturnExpiredVouchers) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReturnExpiredVouchers) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReturnExpiredVouchersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReturnExpiredVouchersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReturnExpiredVouchersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimVoucher) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimVoucher) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimVoucher) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgReclaimVoucherResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgReclaimVoucherResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgReclaimVoucherResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFrozenBalance) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFrozenBalance) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFrozenBalance) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetFrozenBalanceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetFrozenBalanceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetFrozenBalanceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgCreateNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Namespace.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgCreateNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateNamespace) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.WasmHook != nil {
+		l = m.WasmHook.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RolePermissions) > 0 {
+		for _, e := range m.RolePermissions {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RoleManagers) > 0 {
+		for _, e := range m.RoleManagers {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PolicyStatuses) > 0 {
+		for _, e := range m.PolicyStatuses {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.PolicyManagerCapabilities) > 0 {
+		for _, e := range m.PolicyManagerCapabilities {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.EvmHook != nil {
+		l = m.EvmHook.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.VoucherExpiry != nil {
+		l = m.VoucherExpiry.Size()
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNamespace_SetContractHook) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.NewValue)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateNamespace_SetVoucherExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.NewValue != 0 {
+		n += 1 + sovTx(uint64(m.NewValue))
+	}
+	return n
+}
+
+func (m *MsgUpdateNamespaceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateActorRoles) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RoleActorsToAdd) > 0 {
+		for _, e := range m.RoleActorsToAdd {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if len(m.RoleActorsToRevoke) > 0 {
+		for _, e := range m.RoleActorsToRevoke {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateActorRolesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgClaimVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateRoleLimits) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.RoleLimits) > 0 {
+		for _, e := range m.RoleLimits {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgUpdateRoleLimitsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgClaimVouchers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if len(m.Denoms) > 0 {
+		for _, s := range m.Denoms {
+			l = len(s)
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *MsgClaimVouchersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReturnExpiredVouchers) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReturnExpiredVouchersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgReclaimVoucher) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReclaimVoucherResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetFrozenBalance) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetFrozenBalanceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgCreateNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgCreateNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgCreateNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNamespace: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WasmHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.WasmHook == nil {
+				m.WasmHook = &MsgUpdateNamespace_SetContractHook{}
+			}
+			if err := m.WasmHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RolePermissions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RolePermissions = append(m.RolePermissions, &Role{})
+			if err := m.RolePermissions[len(m.RolePermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleManagers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleManagers = append(m.RoleManagers, &RoleManager{})
+			if err := m.RoleManagers[len(m.RoleManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyStatuses", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyStatuses = append(m.PolicyStatuses, &PolicyStatus{})
+			if err := m.PolicyStatuses[len(m.PolicyStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PolicyManagerCapabilities", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PolicyManagerCapabilities = append(m.PolicyManagerCapabilities, &PolicyManagerCapability{})
+			if err := m.PolicyManagerCapabilities[len(m.PolicyManagerCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EvmHook", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EvmHook == nil {
+				m.EvmHook = &MsgUpdateNamespace_SetContractHook{}
+			}
+			if err := m.EvmHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VoucherExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.VoucherExpiry == nil {
+				m.VoucherExpiry = &MsgUpdateNamespace_SetVoucherExpiry{}
+			}
+			if err := m.VoucherExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateNamespace_SetContractHook) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetContractHook: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetContractHook: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.NewValue = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	if m.WasmHook != nil {
-		l = m.WasmHook.Size()
-		n += 1 + l + sovTx(uint64(l))
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	if len(m.RolePermissions) > 0 {
-		for _, e := range m.RolePermissions {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+	return nil
+}
+func (m *MsgUpdateNamespace_SetVoucherExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.RoleManagers) > 0 {
-		for _, e := range m.RoleManagers {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SetVoucherExpiry: wiretype end group for non-group")
 		}
-	}
-	if len(m.PolicyStatuses) > 0 {
-		for _, e := range m.PolicyStatuses {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SetVoucherExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
-	}
-	if len(m.PolicyManagerCapabilities) > 0 {
-		for _, e := range m.PolicyManagerCapabilities {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+			}
+			m.NewValue = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.NewValue |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	if m.EvmHook != nil {
-		l = m.EvmHook.Size()
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
-
-func (m *MsgUpdateNamespace_SetContractHook) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.NewValue)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgUpdateNamespaceResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
+	return nil
 }
-
-func (m *MsgUpdateActorRoles) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	if len(m.RoleActorsToAdd) > 0 {
-		for _, e := range m.RoleActorsToAdd {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+func (m *MsgUpdateNamespaceResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
 		}
-	}
-	if len(m.RoleActorsToRevoke) > 0 {
-		for _, e := range m.RoleActorsToRevoke {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
 		}
 	}
-	return n
-}
-
-func (m *MsgUpdateActorRolesResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	return n
-}
-
-func (m *MsgClaimVoucher) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
-}
 
-func (m *MsgClaimVoucherResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateActorRoles) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1567,19 +3749,51 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if b < 0x80 {
 				break
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateActorRoles: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateActorRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -1607,11 +3821,11 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Authority = string(dAtA[iNdEx:postIndex])
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleActorsToAdd", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -1638,7 +3852,42 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RoleActorsToAdd = append(m.RoleActorsToAdd, &RoleActors{})
+			if err := m.RoleActorsToAdd[len(m.RoleActorsToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleActorsToRevoke", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RoleActorsToRevoke = append(m.RoleActorsToRevoke, &RoleActors{})
+			if err := m.RoleActorsToRevoke[len(m.RoleActorsToRevoke)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -1663,7 +3912,7 @@ func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateActorRolesResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1686,10 +3935,10 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateActorRolesResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateActorRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1713,7 +3962,7 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNamespace) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1736,10 +3985,10 @@ func (m *MsgCreateNamespace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNamespace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1776,9 +4025,9 @@ func (m *MsgCreateNamespace) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Namespace", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -1788,24 +4037,23 @@ func (m *MsgCreateNamespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Namespace.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -1828,7 +4076,7 @@ func (m *MsgCreateNamespace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgCreateNamespaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgClaimVoucherResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1851,10 +4099,10 @@ func (m *MsgCreateNamespaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgCreateNamespaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgClaimVoucherResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgCreateNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgClaimVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1878,7 +4126,7 @@ func (m *MsgCreateNamespaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateRoleLimits) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1901,10 +4149,10 @@ func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNamespace: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateRoleLimits: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNamespace: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateRoleLimits: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1973,7 +4221,7 @@ func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field WasmHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RoleLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -2000,120 +4248,116 @@ func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.WasmHook == nil {
-				m.WasmHook = &MsgUpdateNamespace_SetContractHook{}
-			}
-			if err := m.WasmHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RoleLimits = append(m.RoleLimits, &RoleLimits{})
+			if err := m.RoleLimits[len(m.RoleLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RolePermissions", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RolePermissions = append(m.RolePermissions, &Role{})
-			if err := m.RolePermissions[len(m.RolePermissions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateRoleLimitsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleManagers", wireType)
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
 			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateRoleLimitsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateRoleLimitsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
 			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
 				return ErrInvalidLengthTx
 			}
-			if postIndex > l {
+			if (iNdEx + skippy) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleManagers = append(m.RoleManagers, &RoleManager{})
-			if err := m.RoleManagers[len(m.RoleManagers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 6:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyStatuses", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVouchers) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
 			}
-			if postIndex > l {
+			if iNdEx >= l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PolicyStatuses = append(m.PolicyStatuses, &PolicyStatus{})
-			if err := m.PolicyStatuses[len(m.PolicyStatuses)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
 			}
-			iNdEx = postIndex
-		case 7:
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVouchers: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PolicyManagerCapabilities", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2123,31 +4367,29 @@ func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PolicyManagerCapabilities = append(m.PolicyManagerCapabilities, &PolicyManagerCapability{})
-			if err := m.PolicyManagerCapabilities[len(m.PolicyManagerCapabilities)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EvmHook", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Denoms", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2157,28 +4399,74 @@ func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.EvmHook == nil {
-				m.EvmHook = &MsgUpdateNamespace_SetContractHook{}
-			}
-			if err := m.EvmHook.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Denoms = append(m.Denoms, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			iNdEx = postIndex
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgClaimVouchersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgClaimVouchersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgClaimVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -2200,7 +4488,7 @@ func (m *MsgUpdateNamespace) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNamespace_SetContractHook) Unmarshal(dAtA []byte) error {
+func (m *MsgReturnExpiredVouchers) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2223,15 +4511,15 @@ func (m *MsgUpdateNamespace_SetContractHook) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: SetContractHook: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReturnExpiredVouchers: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: SetContractHook: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReturnExpiredVouchers: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field NewValue", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2259,7 +4547,71 @@ func (m *MsgUpdateNamespace_SetContractHook) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.NewValue = string(dAtA[iNdEx:postIndex])
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2282,7 +4634,7 @@ func (m *MsgUpdateNamespace_SetContractHook) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateNamespaceResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReturnExpiredVouchersResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2305,10 +4657,10 @@ func (m *MsgUpdateNamespaceResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateNamespaceResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReturnExpiredVouchersResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateNamespaceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReturnExpiredVouchersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2332,7 +4684,7 @@ func (m *MsgUpdateNamespaceResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateActorRoles) Unmarshal(dAtA []byte) error {
+func (m *MsgReclaimVoucher) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2355,10 +4707,10 @@ func (m *MsgUpdateActorRoles) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateActorRoles: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReclaimVoucher: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateActorRoles: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReclaimVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2427,43 +4779,9 @@ func (m *MsgUpdateActorRoles) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleActorsToAdd", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowTx
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthTx
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthTx
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			m.RoleActorsToAdd = append(m.RoleActorsToAdd, &RoleActors{})
-			if err := m.RoleActorsToAdd[len(m.RoleActorsToAdd)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 5:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RoleActorsToRevoke", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowTx
@@ -2473,25 +4791,23 @@ func (m *MsgUpdateActorRoles) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthTx
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthTx
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RoleActorsToRevoke = append(m.RoleActorsToRevoke, &RoleActors{})
-			if err := m.RoleActorsToRevoke[len(m.RoleActorsToRevoke)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.Address = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2514,7 +4830,7 @@ func (m *MsgUpdateActorRoles) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgUpdateActorRolesResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgReclaimVoucherResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2537,10 +4853,10 @@ func (m *MsgUpdateActorRolesResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgUpdateActorRolesResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgReclaimVoucherResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgUpdateActorRolesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgReclaimVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -2564,7 +4880,7 @@ func (m *MsgUpdateActorRolesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimVoucher) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFrozenBalance) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2587,10 +4903,10 @@ func (m *MsgClaimVoucher) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimVoucher: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFrozenBalance: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimVoucher: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFrozenBalance: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -2627,7 +4943,7 @@ func (m *MsgClaimVoucher) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -2655,7 +4971,40 @@ func (m *MsgClaimVoucher) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
@@ -2678,7 +5027,7 @@ func (m *MsgClaimVoucher) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgClaimVoucherResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgSetFrozenBalanceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -2701,10 +5050,10 @@ func (m *MsgClaimVoucherResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgClaimVoucherResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgSetFrozenBalanceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgClaimVoucherResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgSetFrozenBalanceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -21,6 +21,8 @@ const (
 		Action_BURN |
 		Action_SEND |
 		Action_SUPER_BURN |
+		Action_FREEZE_BALANCES |
+		Action_RECLAIM_VOUCHERS |
 		Action_MODIFY_POLICY_MANAGERS |
 		Action_MODIFY_CONTRACT_HOOK |
 		Action_MODIFY_ROLE_PERMISSIONS |
@@ -29,6 +31,8 @@ const (
 	DisallowedEveryoneActions = uint32(
 		Action_MINT |
 			Action_SUPER_BURN |
+			Action_FREEZE_BALANCES |
+			Action_RECLAIM_VOUCHERS |
 			Action_MODIFY_POLICY_MANAGERS |
 			Action_MODIFY_CONTRACT_HOOK |
 			Action_MODIFY_ROLE_PERMISSIONS |
@@ -39,7 +43,7 @@ const (
 
 var Actions = []Action{
 	Action_MINT, Action_RECEIVE, Action_BURN, Action_SEND, Action_SUPER_BURN,
-	Action_MODIFY_POLICY_MANAGERS, Action_MODIFY_CONTRACT_HOOK, Action_MODIFY_ROLE_PERMISSIONS, Action_MODIFY_ROLE_MANAGERS,
+	Action_FREEZE_BALANCES, Action_RECLAIM_VOUCHERS, Action_MODIFY_POLICY_MANAGERS, Action_MODIFY_CONTRACT_HOOK, Action_MODIFY_ROLE_PERMISSIONS, Action_MODIFY_ROLE_MANAGERS,
 }
 
 // EnforcedContract is a decoded representation of EnforcedRestrictionsEVMContract from Params
@@ -27,6 +27,7 @@ func GetQueryCmd() *cobra.Command {
 		GetParams(),
 		GetCmdDenomAuthorityMetadata(),
 		GetCmdDenomsFromCreator(),
+		GetCmdDenomSupplyInfo(),
 	)
 
 	return cmd
@@ -118,3 +119,38 @@ func GetCmdDenomsFromCreator() *cobra.Command {
 
 	return cmd
 }
+
+// GetCmdDenomSupplyInfo returns the max supply and the pending mint schedule for a queried denom
+func GetCmdDenomSupplyInfo() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "denom-supply-info [denom] [flags]",
+		Short: "Get the max supply and the pending mint schedule for a specific denom",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			parts := strings.Split(args[0], "/")
+			if len(parts) != 3 {
+				return fmt.Errorf("invalid tokenfactory denom: %s", args[0])
+			}
+
+			res, err := queryClient.DenomSupplyInfo(cmd.Context(), &types.QueryDenomSupplyInfoRequest{
+				Creator:  parts[1],
+				SubDenom: parts[2],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
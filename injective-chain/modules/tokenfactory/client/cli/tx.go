@@ -4,7 +4,9 @@ import (
 	"encoding/json"
 	"fmt"
 	"strconv"
+	"strings"
 
+	"cosmossdk.io/math"
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -20,6 +22,8 @@ import (
 
 const (
 	FlagAllowAdminBurn = "allow-admin-burn"
+	FlagMaxSupply      = "max-supply"
+	FlagScheduledMint  = "scheduled-mint"
 )
 
 // GetTxCmd returns the transaction commands for this module
@@ -39,6 +43,7 @@ func GetTxCmd() *cobra.Command {
 		// NewForceTransferCmd(),
 		NewChangeAdminCmd(),
 		NewSetDenomMetadataCmd(),
+		NewSetMaxSupplyCmd(),
 	)
 
 	return cmd
@@ -79,15 +84,66 @@ func NewCreateDenomCmd() *cobra.Command {
 				uint32(decimals),
 				allowAdminBurn,
 			)
+
+			maxSupplyStr, err := cmd.Flags().GetString(FlagMaxSupply)
+			if err != nil {
+				return err
+			}
+			if maxSupplyStr != "" {
+				maxSupply, ok := math.NewIntFromString(maxSupplyStr)
+				if !ok {
+					return fmt.Errorf("invalid max supply: %s", maxSupplyStr)
+				}
+				msg.MaxSupply = maxSupply
+			}
+
+			scheduledMints, err := cmd.Flags().GetStringArray(FlagScheduledMint)
+			if err != nil {
+				return err
+			}
+			for _, scheduledMintStr := range scheduledMints {
+				scheduledMint, err := parseScheduledMint(scheduledMintStr)
+				if err != nil {
+					return err
+				}
+				msg.MintSchedule = append(msg.MintSchedule, scheduledMint)
+			}
+
 			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
 		},
 	}
 
 	cmd.Flags().Bool(FlagAllowAdminBurn, false, "True if the token should allow admin burning")
+	cmd.Flags().String(FlagMaxSupply, "", "The hard cap on the total supply of the token, unlimited if empty")
+	cmd.Flags().StringArray(FlagScheduledMint, nil, "A scheduled mint as recipient:amount:unlock_unix_time, can be repeated")
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
 
+// parseScheduledMint parses a scheduled mint given as recipient:amount:unlock_unix_time
+func parseScheduledMint(scheduledMintStr string) (types.ScheduledMint, error) {
+	parts := strings.Split(scheduledMintStr, ":")
+	if len(parts) != 3 {
+		return types.ScheduledMint{}, fmt.Errorf("invalid scheduled mint %s, expected recipient:amount:unlock_unix_time", scheduledMintStr)
+	}
+
+	amount, ok := math.NewIntFromString(parts[1])
+	if !ok {
+		return types.ScheduledMint{}, fmt.Errorf("invalid scheduled mint amount: %s", parts[1])
+	}
+
+	unlockTime, err := strconv.ParseInt(parts[2], 10, 64)
+	if err != nil {
+		return types.ScheduledMint{}, fmt.Errorf("error parsing scheduled mint unlock time %v: %w", parts[2], err)
+	}
+
+	return types.ScheduledMint{
+		Recipient:  parts[0],
+		Amount:     amount,
+		UnlockTime: unlockTime,
+	}, nil
+}
+
 // NewMintCmd broadcast MsgMint
 func NewMintCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -294,3 +350,40 @@ func NewSetDenomMetadataCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+// NewSetMaxSupplyCmd broadcast MsgSetMaxSupply
+func NewSetMaxSupplyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-max-supply [denom] [max-supply] [flags]",
+		Short: "Sets or lowers the max supply of a factory-created denom. Must have admin authority to do so.",
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			txf, err := tx.NewFactoryCLI(clientCtx, cmd.Flags())
+			if err != nil {
+				return err
+			}
+			txf.WithTxConfig(clientCtx.TxConfig).WithAccountRetriever(clientCtx.AccountRetriever)
+
+			maxSupply, ok := math.NewIntFromString(args[1])
+			if !ok {
+				return fmt.Errorf("invalid max supply: %s", args[1])
+			}
+
+			msg := types.NewMsgSetMaxSupply(
+				clientCtx.GetFromAddress().String(),
+				args[0],
+				maxSupply,
+			)
+
+			return tx.GenerateOrBroadcastTxWithFactory(clientCtx, txf, msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
@@ -1,6 +1,7 @@
 package keeper
 
 import (
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/gogoproto/proto"
 
@@ -30,6 +31,11 @@ func (k Keeper) GetAuthorityMetadata(ctx sdk.Context, denom string) (types.Denom
 	if err != nil {
 		return types.DenomAuthorityMetadata{}, err
 	}
+
+	// denoms created before max supplies were introduced are uncapped
+	if metadata.MaxSupply.IsNil() {
+		metadata.MaxSupply = math.ZeroInt()
+	}
 	return metadata, nil
 }
 
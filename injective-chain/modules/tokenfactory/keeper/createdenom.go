@@ -3,6 +3,8 @@ package keeper
 import (
 	"fmt"
 
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 
@@ -10,18 +12,51 @@ import (
 )
 
 // createDenom creates a new denom in bank module after validating and charging creation fee
-func (k Keeper) createDenom(ctx sdk.Context, creatorAddr, subdenom, name, symbol string, decimals uint32, allowAdminBurn bool) (newTokenDenom string, err error) {
+func (k Keeper) createDenom(
+	ctx sdk.Context,
+	creatorAddr, subdenom, name, symbol string,
+	decimals uint32,
+	allowAdminBurn bool,
+	maxSupply math.Int,
+	mintSchedule []types.ScheduledMint,
+) (newTokenDenom string, err error) {
 	denom, err := k.validateCreateDenom(ctx, creatorAddr, subdenom)
 	if err != nil {
 		return "", err
 	}
 
+	for _, scheduledMint := range mintSchedule {
+		if scheduledMint.UnlockTime <= ctx.BlockTime().Unix() {
+			return "", errors.Wrapf(types.ErrInvalidMintSchedule, "unlock time %d is not in the future", scheduledMint.UnlockTime)
+		}
+	}
+
 	err = k.chargeForCreateDenom(ctx, creatorAddr)
 	if err != nil {
 		return "", err
 	}
 
 	err = k.createDenomAfterValidation(ctx, creatorAddr, denom, subdenom, name, symbol, decimals, allowAdminBurn)
+	if err != nil {
+		return "", err
+	}
+
+	if maxSupply.IsNil() {
+		maxSupply = math.ZeroInt()
+	}
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return "", err
+	}
+
+	authorityMetadata.MaxSupply = maxSupply
+	err = k.SetAuthorityMetadata(ctx, denom, authorityMetadata)
+	if err != nil {
+		return "", err
+	}
+
+	err = k.setMintSchedule(ctx, denom, mintSchedule)
 	return denom, err
 }
 
@@ -57,6 +92,7 @@ func (k Keeper) createDenomAfterValidation(ctx sdk.Context, creatorAddr, denom,
 	authorityMetadata := types.DenomAuthorityMetadata{
 		Admin:            creatorAddr,
 		AdminBurnAllowed: allowAdminBurn,
+		MaxSupply:        math.ZeroInt(),
 	}
 	err = k.SetAuthorityMetadata(ctx, denom, authorityMetadata)
 	if err != nil {
@@ -32,6 +32,10 @@ func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 		if err != nil {
 			panic(err)
 		}
+		err = k.setMintSchedule(ctx, genDenom.GetDenom(), genDenom.GetMintSchedule())
+		if err != nil {
+			panic(err)
+		}
 	}
 }
 
@@ -50,6 +54,10 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 		if err != nil {
 			panic(err)
 		}
+		mintSchedule, err := k.GetMintSchedule(ctx, denom)
+		if err != nil {
+			panic(err)
+		}
 
 		genDenoms = append(genDenoms, types.GenesisDenom{
 			Denom:             denom,
@@ -57,6 +65,7 @@ func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 			Name:              metadata.GetName(),
 			Symbol:            metadata.GetSymbol(),
 			Decimals:          metadata.GetDecimals(),
+			MintSchedule:      mintSchedule,
 		})
 	}
 
@@ -36,6 +36,33 @@ func (k Keeper) DenomsFromCreator(ctx context.Context, req *types.QueryDenomsFro
 	return &types.QueryDenomsFromCreatorResponse{Denoms: denoms}, nil
 }
 
+func (k Keeper) DenomSupplyInfo(ctx context.Context, req *types.QueryDenomSupplyInfoRequest) (*types.QueryDenomSupplyInfoResponse, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	denom := strings.Join([]string{types.ModuleDenomPrefix, req.Creator, req.SubDenom}, "/")
+	authorityMetadata, err := k.GetAuthorityMetadata(sdkCtx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	mintSchedule, err := k.GetMintSchedule(sdkCtx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	scheduledSupply, err := k.GetScheduledSupply(sdkCtx, denom)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryDenomSupplyInfoResponse{
+		MaxSupply:       authorityMetadata.MaxSupply,
+		Supply:          k.bankKeeper.GetSupply(sdkCtx, denom).Amount,
+		ScheduledSupply: scheduledSupply,
+		MintSchedule:    mintSchedule,
+	}, nil
+}
+
 func (k Keeper) TokenfactoryModuleState(c context.Context, _ *types.QueryModuleStateRequest) (*types.QueryModuleStateResponse, error) {
 	ctx := sdk.UnwrapSDKContext(c)
 
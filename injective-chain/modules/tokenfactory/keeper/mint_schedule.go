@@ -0,0 +1,184 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/tokenfactory/types"
+)
+
+// maxScheduledMintsPerBlock bounds the work done by the BeginBlocker. Unlocked mints left over
+// are executed in one of the next blocks.
+const maxScheduledMintsPerBlock = 100
+
+// scheduledMintRetryDelay is the delay in seconds after which a scheduled mint that failed is retried
+const scheduledMintRetryDelay = 60 * 60
+
+// GetMintSchedule returns the pending scheduled mints of the denom, ordered by their index
+func (k Keeper) GetMintSchedule(ctx sdk.Context, denom string) ([]types.ScheduledMint, error) {
+	schedule := make([]types.ScheduledMint, 0)
+	store := prefix.NewStore(k.GetDenomPrefixStore(ctx, denom), types.ScheduledMintPrefixKey)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var scheduledMint types.ScheduledMint
+		if err := proto.Unmarshal(iter.Value(), &scheduledMint); err != nil {
+			return nil, err
+		}
+		schedule = append(schedule, scheduledMint)
+	}
+
+	return schedule, nil
+}
+
+// GetScheduledSupply returns the total amount of the pending scheduled mints of the denom
+func (k Keeper) GetScheduledSupply(ctx sdk.Context, denom string) (math.Int, error) {
+	schedule, err := k.GetMintSchedule(ctx, denom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	total := math.ZeroInt()
+	for _, scheduledMint := range schedule {
+		total = total.Add(scheduledMint.Amount)
+	}
+
+	return total, nil
+}
+
+// setMintSchedule stores the scheduled mints of the denom and queues them by unlock time
+func (k Keeper) setMintSchedule(ctx sdk.Context, denom string, schedule []types.ScheduledMint) error {
+	denomStore := k.GetDenomPrefixStore(ctx, denom)
+	queue := ctx.KVStore(k.storeKey)
+
+	for i := range schedule {
+		index := uint32(i)
+		bz, err := proto.Marshal(&schedule[i])
+		if err != nil {
+			return err
+		}
+
+		denomStore.Set(types.GetScheduledMintKey(index), bz)
+		queue.Set(types.GetScheduledMintQueueKey(schedule[i].UnlockTime, index, denom), []byte{})
+	}
+
+	return nil
+}
+
+// checkMaxSupply checks that minting the amount keeps the total supply, including the pending scheduled mints,
+// within the max supply of the denom
+func (k Keeper) checkMaxSupply(ctx sdk.Context, denom string, authorityMetadata types.DenomAuthorityMetadata, amount math.Int) error {
+	if !authorityMetadata.HasMaxSupply() {
+		return nil
+	}
+
+	scheduledSupply, err := k.GetScheduledSupply(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	supply := k.bankKeeper.GetSupply(ctx, denom).Amount
+	if supply.Add(scheduledSupply).Add(amount).GT(authorityMetadata.MaxSupply) {
+		return errors.Wrapf(
+			types.ErrMaxSupplyExceeded,
+			"minting %v would exceed the max supply %v of %s (supply: %v, scheduled: %v)",
+			amount, authorityMetadata.MaxSupply, denom, supply, scheduledSupply,
+		)
+	}
+
+	return nil
+}
+
+// setMaxSupply sets the max supply of the denom. It can be set once for an uncapped denom and only lowered afterwards,
+// but never below the current supply plus the pending scheduled mints.
+func (k Keeper) setMaxSupply(ctx sdk.Context, denom string, maxSupply math.Int) error {
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	if authorityMetadata.HasMaxSupply() && maxSupply.GTE(authorityMetadata.MaxSupply) {
+		return errors.Wrapf(types.ErrInvalidMaxSupply, "max supply can only be lowered, current max supply is %v", authorityMetadata.MaxSupply)
+	}
+
+	scheduledSupply, err := k.GetScheduledSupply(ctx, denom)
+	if err != nil {
+		return err
+	}
+
+	minMaxSupply := k.bankKeeper.GetSupply(ctx, denom).Amount.Add(scheduledSupply)
+	if maxSupply.LT(minMaxSupply) {
+		return errors.Wrapf(types.ErrInvalidMaxSupply, "max supply cannot be lower than the current and scheduled supply %v", minMaxSupply)
+	}
+
+	authorityMetadata.MaxSupply = maxSupply
+	return k.SetAuthorityMetadata(ctx, denom, authorityMetadata)
+}
+
+// ExecuteUnlockedScheduledMints mints the scheduled amounts whose unlock time has been reached. Scheduled mints that
+// cannot be delivered to their recipient stay pending, so they keep counting towards the max supply, and are retried
+// after scheduledMintRetryDelay.
+func (k Keeper) ExecuteUnlockedScheduledMints(ctx sdk.Context) {
+	queue := ctx.KVStore(k.storeKey)
+	iter := queue.Iterator(types.ScheduledMintQueueKey, types.GetScheduledMintQueueTimePrefix(ctx.BlockTime().Unix()+1))
+
+	queueKeys := make([][]byte, 0)
+	for ; iter.Valid() && len(queueKeys) < maxScheduledMintsPerBlock; iter.Next() {
+		queueKeys = append(queueKeys, iter.Key())
+	}
+	iter.Close()
+
+	for _, queueKey := range queueKeys {
+		queue.Delete(queueKey)
+
+		index, denom := types.ParseScheduledMintQueueKey(queueKey)
+		k.executeScheduledMint(ctx, denom, index)
+	}
+}
+
+func (k Keeper) executeScheduledMint(ctx sdk.Context, denom string, index uint32) {
+	denomStore := k.GetDenomPrefixStore(ctx, denom)
+	key := types.GetScheduledMintKey(index)
+
+	bz := denomStore.Get(key)
+	if len(bz) == 0 {
+		return
+	}
+
+	var scheduledMint types.ScheduledMint
+	if err := proto.Unmarshal(bz, &scheduledMint); err != nil {
+		k.Logger(ctx).Error("failed to unmarshal scheduled mint", "denom", denom, "index", index, "error", err)
+		return
+	}
+
+	amount := sdk.NewCoin(denom, scheduledMint.Amount)
+	recipient := sdk.MustAccAddressFromBech32(scheduledMint.Recipient)
+
+	cacheCtx, writeCache := ctx.CacheContext()
+	if err := k.mintTo(cacheCtx, amount, recipient); err != nil {
+		k.Logger(ctx).Error("failed to execute scheduled mint", "denom", denom, "recipient", scheduledMint.Recipient, "error", err)
+
+		// the mint stays stored and reserved against the max supply until it can be delivered
+		retryTime := ctx.BlockTime().Unix() + scheduledMintRetryDelay
+		ctx.KVStore(k.storeKey).Set(types.GetScheduledMintQueueKey(retryTime, index, denom), []byte{})
+
+		_ = ctx.EventManager().EmitTypedEvent(&types.EventScheduledMintFailed{
+			Amount:    amount,
+			Recipient: scheduledMint.Recipient,
+			Reason:    err.Error(),
+			RetryTime: retryTime,
+		})
+		return
+	}
+	writeCache()
+	denomStore.Delete(key)
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventScheduledMint{
+		Amount:    amount,
+		Recipient: scheduledMint.Recipient,
+	})
+}
@@ -42,7 +42,17 @@ func (k msgServer) UpdateParams(c context.Context, msg *types.MsgUpdateParams) (
 func (k msgServer) CreateDenom(goCtx context.Context, msg *types.MsgCreateDenom) (*types.MsgCreateDenomResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	denom, err := k.createDenom(ctx, msg.Sender, msg.Subdenom, msg.GetName(), msg.GetSymbol(), msg.GetDecimals(), msg.GetAllowAdminBurn())
+	denom, err := k.createDenom(
+		ctx,
+		msg.Sender,
+		msg.Subdenom,
+		msg.GetName(),
+		msg.GetSymbol(),
+		msg.GetDecimals(),
+		msg.GetAllowAdminBurn(),
+		msg.MaxSupply,
+		msg.GetMintSchedule(),
+	)
 	if err != nil {
 		return nil, err
 	}
@@ -83,6 +93,10 @@ func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMi
 		return nil, types.ErrUnauthorized.Wrapf("sender %s, for %s action on denom: %s", sender, permissionstypes.Action_MINT, denom)
 	}
 
+	if err := k.checkMaxSupply(ctx, denom, authorityMetadata, msg.Amount.Amount); err != nil {
+		return nil, err
+	}
+
 	receiver := sender
 	if msg.Receiver != "" {
 		receiver = sdk.MustAccAddressFromBech32(msg.Receiver)
@@ -207,3 +221,28 @@ func (k msgServer) SetDenomMetadata(goCtx context.Context, msg *types.MsgSetDeno
 
 	return &types.MsgSetDenomMetadataResponse{}, nil
 }
+
+func (k msgServer) SetMaxSupply(goCtx context.Context, msg *types.MsgSetMaxSupply) (*types.MsgSetMaxSupplyResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	authorityMetadata, err := k.GetAuthorityMetadata(ctx, msg.Denom)
+	if err != nil {
+		return nil, err
+	}
+
+	if authorityMetadata.GetAdmin() == "" || msg.Sender != authorityMetadata.GetAdmin() {
+		return nil, types.ErrUnauthorized
+	}
+
+	err = k.setMaxSupply(ctx, msg.Denom, msg.MaxSupply)
+	if err != nil {
+		return nil, err
+	}
+
+	_ = ctx.EventManager().EmitTypedEvent(&types.EventSetMaxSupply{
+		Denom:     msg.Denom,
+		MaxSupply: msg.MaxSupply,
+	})
+
+	return &types.MsgSetMaxSupplyResponse{}, nil
+}
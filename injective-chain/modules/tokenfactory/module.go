@@ -35,7 +35,8 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
 
-	_ appmodule.AppModule = AppModule{}
+	_ appmodule.AppModule       = AppModule{}
+	_ appmodule.HasBeginBlocker = AppModule{}
 )
 
 const ConsensusVersion = 2
@@ -161,5 +162,11 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// BeginBlock executes the scheduled mints whose unlock time has been reached.
+func (am AppModule) BeginBlock(ctx context.Context) error {
+	am.keeper.ExecuteUnlockedScheduledMints(sdk.UnwrapSDKContext(ctx))
+	return nil
+}
+
 // ConsensusVersion implements ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return ConsensusVersion }
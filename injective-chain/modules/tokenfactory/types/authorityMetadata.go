@@ -1,6 +1,7 @@
 package types
 
 import (
+	"cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
@@ -11,5 +12,9 @@ func (metadata DenomAuthorityMetadata) Validate() error {
 			return err
 		}
 	}
+
+	if !metadata.MaxSupply.IsNil() && metadata.MaxSupply.IsNegative() {
+		return errors.Wrapf(ErrInvalidMaxSupply, "max supply cannot be negative: %v", metadata.MaxSupply)
+	}
 	return nil
 }
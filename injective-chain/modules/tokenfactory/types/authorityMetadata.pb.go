@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -32,6 +34,9 @@ type DenomAuthorityMetadata struct {
 	Admin string `protobuf:"bytes,1,opt,name=admin,proto3" json:"admin,omitempty" yaml:"admin"`
 	// true if the admin can burn tokens from other addresses
 	AdminBurnAllowed bool `protobuf:"varint,2,opt,name=admin_burn_allowed,json=adminBurnAllowed,proto3" json:"admin_burn_allowed,omitempty" yaml:"admin_burn_allowed"`
+	// the hard cap on the total supply of the denom, zero if the supply is
+	// unlimited. Once set, it can only be lowered.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
 }
 
 func (m *DenomAuthorityMetadata) Reset()         { *m = DenomAuthorityMetadata{} }
@@ -81,8 +86,67 @@ func (m *DenomAuthorityMetadata) GetAdminBurnAllowed() bool {
 	return false
 }
 
+// ScheduledMint defines an amount of a denom that is minted to the recipient
+// once the unlock time is reached.
+type ScheduledMint struct {
+	// The Injective address receiving the minted tokens
+	Recipient string `protobuf:"bytes,1,opt,name=recipient,proto3" json:"recipient,omitempty" yaml:"recipient"`
+	// The amount to mint
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount" yaml:"amount"`
+	// The unix timestamp (in seconds) after which the amount is minted
+	UnlockTime int64 `protobuf:"varint,3,opt,name=unlock_time,json=unlockTime,proto3" json:"unlock_time,omitempty" yaml:"unlock_time"`
+}
+
+func (m *ScheduledMint) Reset()         { *m = ScheduledMint{} }
+func (m *ScheduledMint) String() string { return proto.CompactTextString(m) }
+func (*ScheduledMint) ProtoMessage()    {}
+func (*ScheduledMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_525494b77b96b2d3, []int{1}
+}
+func (m *ScheduledMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledMint.Merge(m, src)
+}
+func (m *ScheduledMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledMint proto.InternalMessageInfo
+
+func (m *ScheduledMint) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *ScheduledMint) GetUnlockTime() int64 {
+	if m != nil {
+		return m.UnlockTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*DenomAuthorityMetadata)(nil), "injective.tokenfactory.v1beta1.DenomAuthorityMetadata")
+	proto.RegisterType((*ScheduledMint)(nil), "injective.tokenfactory.v1beta1.ScheduledMint")
 }
 
 func init() {
@@ -90,27 +154,37 @@ func init() {
 }
 
 var fileDescriptor_525494b77b96b2d3 = []byte{
-	// 305 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xb1, 0x4a, 0x33, 0x41,
-	0x10, 0xc7, 0xb3, 0x1f, 0x9f, 0xa2, 0x87, 0x45, 0x38, 0x44, 0x62, 0xc0, 0x4d, 0xb8, 0x42, 0xd2,
-	0x78, 0x4b, 0x10, 0x2c, 0xd2, 0xe5, 0xb0, 0x11, 0xb5, 0x49, 0x69, 0x13, 0x66, 0xf7, 0xd6, 0x64,
-	0xf5, 0x76, 0x27, 0xec, 0xee, 0x45, 0xee, 0x2d, 0x04, 0x5f, 0xc0, 0xc7, 0xb1, 0x4c, 0x69, 0x15,
-	0x24, 0x69, 0xac, 0xf3, 0x04, 0xe2, 0x9d, 0x86, 0xc4, 0x6e, 0xe6, 0x3f, 0xf3, 0xe3, 0x0f, 0xbf,
-	0xe0, 0x42, 0x99, 0x07, 0x29, 0xbc, 0x9a, 0x4a, 0xe6, 0xf1, 0x51, 0x9a, 0x7b, 0x10, 0x1e, 0x6d,
-	0xc1, 0xa6, 0x5d, 0x2e, 0x3d, 0x74, 0x19, 0xe4, 0x7e, 0x8c, 0x56, 0xf9, 0xe2, 0x56, 0x7a, 0x48,
-	0xc1, 0x43, 0x3c, 0xb1, 0xe8, 0x31, 0xa4, 0x6b, 0x2e, 0xde, 0xe4, 0xe2, 0x1f, 0xae, 0x79, 0x38,
-	0xc2, 0x11, 0x96, 0xaf, 0xec, 0x7b, 0xaa, 0xa8, 0x26, 0x15, 0xe8, 0x34, 0x3a, 0xc6, 0xc1, 0xc9,
-	0x75, 0x85, 0x40, 0x65, 0xaa, 0x7b, 0xf4, 0x42, 0x82, 0xa3, 0x4b, 0x69, 0x50, 0xf7, 0xff, 0xd6,
-	0x86, 0xa7, 0xc1, 0x0e, 0xa4, 0x5a, 0x99, 0x06, 0x69, 0x93, 0xce, 0x7e, 0x52, 0x5f, 0xcd, 0x5b,
-	0x07, 0x05, 0xe8, 0xac, 0x17, 0x95, 0x71, 0x34, 0xa8, 0xce, 0xe1, 0x75, 0x10, 0x96, 0xc3, 0x90,
-	0xe7, 0xd6, 0x0c, 0x21, 0xcb, 0xf0, 0x49, 0xa6, 0x8d, 0x7f, 0x6d, 0xd2, 0xd9, 0x4b, 0x4e, 0x56,
-	0xf3, 0xd6, 0xf1, 0x06, 0xb4, 0xf5, 0x13, 0x0d, 0xea, 0x65, 0x98, 0xe4, 0xd6, 0xf4, 0xab, 0xa8,
-	0xf7, 0xff, 0xf3, 0xb5, 0x45, 0x92, 0xec, 0x6d, 0x41, 0xc9, 0x6c, 0x41, 0xc9, 0xc7, 0x82, 0x92,
-	0xe7, 0x25, 0xad, 0xcd, 0x96, 0xb4, 0xf6, 0xbe, 0xa4, 0xb5, 0xbb, 0xc1, 0x48, 0xf9, 0x71, 0xce,
-	0x63, 0x81, 0x9a, 0x5d, 0xfd, 0x0a, 0xb9, 0x01, 0xee, 0xd8, 0x5a, 0xcf, 0x99, 0x40, 0x2b, 0x37,
-	0xd7, 0x31, 0x28, 0xc3, 0x34, 0xa6, 0x79, 0x26, 0xdd, 0xb6, 0x73, 0x5f, 0x4c, 0xa4, 0xe3, 0xbb,
-	0xa5, 0x8a, 0xf3, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xf5, 0x06, 0x42, 0x33, 0x9a, 0x01, 0x00,
-	0x00,
+	// 471 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xbf, 0x6e, 0xd4, 0x40,
+	0x10, 0xc6, 0xcf, 0x04, 0x22, 0x6e, 0x21, 0xd2, 0x61, 0x85, 0xe8, 0x12, 0x09, 0x3b, 0x72, 0x81,
+	0xd2, 0xc4, 0x56, 0x40, 0x02, 0x29, 0xa2, 0x20, 0x16, 0xcd, 0x09, 0xd2, 0x38, 0xa9, 0x68, 0xac,
+	0xf5, 0x7a, 0x39, 0x2f, 0xe7, 0xdd, 0xb1, 0xec, 0x71, 0x88, 0xdf, 0x82, 0x47, 0xe0, 0x21, 0x78,
+	0x88, 0x94, 0x11, 0x55, 0x44, 0x61, 0xa1, 0xbb, 0x86, 0xda, 0xe2, 0x01, 0x50, 0x76, 0xcd, 0xe5,
+	0x92, 0x86, 0x6e, 0x67, 0xbe, 0xf9, 0xed, 0xfc, 0xd1, 0x47, 0x5e, 0x09, 0xf5, 0x99, 0x33, 0x14,
+	0x67, 0x3c, 0x40, 0x98, 0x71, 0xf5, 0x89, 0x32, 0x84, 0xb2, 0x09, 0xce, 0x0e, 0x12, 0x8e, 0xf4,
+	0x20, 0xa0, 0x35, 0x66, 0x50, 0x0a, 0x6c, 0x8e, 0x39, 0xd2, 0x94, 0x22, 0xf5, 0x8b, 0x12, 0x10,
+	0x6c, 0x67, 0xc9, 0xf9, 0xab, 0x9c, 0xdf, 0x73, 0x3b, 0x9b, 0x53, 0x98, 0x82, 0x2e, 0x0d, 0xae,
+	0x5f, 0x86, 0xda, 0x71, 0x18, 0x54, 0x12, 0xaa, 0x20, 0xa1, 0x15, 0x5f, 0xb6, 0x60, 0x20, 0x54,
+	0xaf, 0x6f, 0x1b, 0x3d, 0x36, 0xa0, 0x09, 0x8c, 0xe4, 0xfd, 0xb1, 0xc8, 0xd6, 0x3b, 0xae, 0x40,
+	0x1e, 0xdd, 0x9d, 0xc8, 0x7e, 0x4e, 0x1e, 0xd0, 0x54, 0x0a, 0x35, 0xb6, 0x76, 0xad, 0xbd, 0x61,
+	0x38, 0xea, 0x5a, 0xf7, 0x71, 0x43, 0x65, 0x7e, 0xe8, 0xe9, 0xb4, 0x17, 0x19, 0xd9, 0x7e, 0x4f,
+	0x6c, 0xfd, 0x88, 0x93, 0xba, 0x54, 0x31, 0xcd, 0x73, 0xf8, 0xc2, 0xd3, 0xf1, 0xbd, 0x5d, 0x6b,
+	0xef, 0x61, 0xf8, 0xac, 0x6b, 0xdd, 0xed, 0x15, 0xe8, 0x56, 0x8d, 0x17, 0x8d, 0x74, 0x32, 0xac,
+	0x4b, 0x75, 0x64, 0x52, 0x76, 0x4c, 0x88, 0xa4, 0xe7, 0x71, 0x55, 0x17, 0x45, 0xde, 0x8c, 0xd7,
+	0x74, 0xe7, 0xb7, 0x17, 0xad, 0x3b, 0xf8, 0xd9, 0xba, 0x4f, 0xcd, 0xe4, 0x55, 0x3a, 0xf3, 0x05,
+	0x04, 0x92, 0x62, 0xe6, 0x4f, 0x14, 0x76, 0xad, 0xfb, 0xc4, 0x74, 0xb8, 0x01, 0xbd, 0x1f, 0xdf,
+	0xf7, 0x49, 0xbf, 0xe7, 0x44, 0x61, 0x34, 0x94, 0xf4, 0xfc, 0x44, 0x2b, 0x87, 0xf7, 0x7f, 0x7f,
+	0x73, 0x2d, 0xef, 0xca, 0x22, 0x1b, 0x27, 0x2c, 0xe3, 0x69, 0x9d, 0xf3, 0xf4, 0x58, 0x28, 0xb4,
+	0x5f, 0x90, 0x61, 0xc9, 0x99, 0x28, 0x04, 0x57, 0xd8, 0x6f, 0xbc, 0xd9, 0xb5, 0xee, 0xc8, 0x7c,
+	0xbd, 0x94, 0xbc, 0xe8, 0xa6, 0xcc, 0x3e, 0x25, 0xeb, 0x54, 0x42, 0xad, 0x50, 0x6f, 0x3b, 0x0c,
+	0xdf, 0xfc, 0x6f, 0xd0, 0x8d, 0xfe, 0x14, 0x1a, 0xba, 0x3b, 0x64, 0xff, 0x97, 0xfd, 0x9a, 0x3c,
+	0xaa, 0x55, 0x0e, 0x6c, 0x16, 0xa3, 0x90, 0x5c, 0xdf, 0x60, 0x2d, 0xdc, 0xea, 0x5a, 0xd7, 0x36,
+	0xf4, 0x8a, 0xe8, 0x45, 0xc4, 0x44, 0xa7, 0x42, 0x72, 0xb3, 0x5a, 0x98, 0x5f, 0xcc, 0x1d, 0xeb,
+	0x72, 0xee, 0x58, 0xbf, 0xe6, 0x8e, 0xf5, 0x75, 0xe1, 0x0c, 0x2e, 0x17, 0xce, 0xe0, 0x6a, 0xe1,
+	0x0c, 0x3e, 0x46, 0x53, 0x81, 0x59, 0x9d, 0xf8, 0x0c, 0x64, 0x30, 0xf9, 0xe7, 0xb3, 0x0f, 0x34,
+	0xa9, 0x82, 0xa5, 0xeb, 0xf6, 0x19, 0x94, 0x7c, 0x35, 0xcc, 0xa8, 0x50, 0x81, 0x84, 0xeb, 0x3b,
+	0x55, 0xb7, 0xad, 0x8c, 0x4d, 0xc1, 0xab, 0x64, 0x5d, 0xdb, 0xe8, 0xe5, 0xdf, 0x01, 0x00, 0xcf,
+	0x46, 0x54, 0x6e, 0xf1, 0x02, 0x00, 0x00,
 }
 
 func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
@@ -138,6 +212,39 @@ func (this *DenomAuthorityMetadata) Equal(that interface{}) bool {
 	if this.AdminBurnAllowed != that1.AdminBurnAllowed {
 		return false
 	}
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
+	}
+	return true
+}
+func (this *ScheduledMint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ScheduledMint)
+	if !ok {
+		that2, ok := that.(ScheduledMint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Recipient != that1.Recipient {
+		return false
+	}
+	if !this.Amount.Equal(that1.Amount) {
+		return false
+	}
+	if this.UnlockTime != that1.UnlockTime {
+		return false
+	}
 	return true
 }
 func (m *DenomAuthorityMetadata) Marshal() (dAtA []byte, err error) {
@@ -160,6 +267,16 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.AdminBurnAllowed {
 		i--
 		if m.AdminBurnAllowed {
@@ -180,6 +297,51 @@ func (m *DenomAuthorityMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *ScheduledMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.UnlockTime != 0 {
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(m.UnlockTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintAuthorityMetadata(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintAuthorityMetadata(dAtA []byte, offset int, v uint64) int {
 	offset -= sovAuthorityMetadata(v)
 	base := offset
@@ -204,6 +366,26 @@ func (m *DenomAuthorityMetadata) Size() (n int) {
 	if m.AdminBurnAllowed {
 		n += 2
 	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	return n
+}
+
+func (m *ScheduledMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovAuthorityMetadata(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovAuthorityMetadata(uint64(l))
+	if m.UnlockTime != 0 {
+		n += 1 + sovAuthorityMetadata(uint64(m.UnlockTime))
+	}
 	return n
 }
 
@@ -294,6 +476,175 @@ func (m *DenomAuthorityMetadata) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AdminBurnAllowed = bool(v != 0)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduledMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowAuthorityMetadata
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthAuthorityMetadata
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UnlockTime", wireType)
+			}
+			m.UnlockTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowAuthorityMetadata
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.UnlockTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipAuthorityMetadata(dAtA[iNdEx:])
@@ -18,6 +18,7 @@ func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgChangeAdmin{}, "injective/tokenfactory/change-admin", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "injective/tokenfactory/update-params", nil)
 	cdc.RegisterConcrete(&MsgSetDenomMetadata{}, "injective/tokenfactory/set-denom-metadata", nil)
+	cdc.RegisterConcrete(&MsgSetMaxSupply{}, "injective/tokenfactory/set-max-supply", nil)
 	cdc.RegisterConcrete(&Params{}, "injective/tokenfactory/Params", nil)
 
 }
@@ -31,6 +32,7 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		&MsgChangeAdmin{},
 		&MsgUpdateParams{},
 		&MsgSetDenomMetadata{},
+		&MsgSetMaxSupply{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
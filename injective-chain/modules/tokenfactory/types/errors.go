@@ -22,4 +22,7 @@ var (
 	ErrCreatorTooLong           = errors.Register(ModuleName, 11, fmt.Sprintf("creator too long, max length is %d bytes", MaxCreatorLength))
 	ErrDenomDoesNotExist        = errors.Register(ModuleName, 12, "denom does not exist")
 	ErrAmountNotPositive        = errors.Register(ModuleName, 13, "amount has to be positive")
+	ErrMaxSupplyExceeded        = errors.Register(ModuleName, 14, "max supply exceeded")
+	ErrInvalidMaxSupply         = errors.Register(ModuleName, 15, "invalid max supply")
+	ErrInvalidMintSchedule      = errors.Register(ModuleName, 16, "invalid mint schedule")
 )
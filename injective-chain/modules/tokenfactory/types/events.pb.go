@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	types "github.com/cosmos/cosmos-sdk/types"
 	types1 "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -301,12 +302,181 @@ func (m *EventSetDenomMetadata) GetMetadata() types1.Metadata {
 	return types1.Metadata{}
 }
 
+type EventSetMaxSupply struct {
+	Denom     string                `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+}
+
+func (m *EventSetMaxSupply) Reset()         { *m = EventSetMaxSupply{} }
+func (m *EventSetMaxSupply) String() string { return proto.CompactTextString(m) }
+func (*EventSetMaxSupply) ProtoMessage()    {}
+func (*EventSetMaxSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9fd0c5434c2a5b7, []int{5}
+}
+func (m *EventSetMaxSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventSetMaxSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventSetMaxSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventSetMaxSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventSetMaxSupply.Merge(m, src)
+}
+func (m *EventSetMaxSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventSetMaxSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventSetMaxSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventSetMaxSupply proto.InternalMessageInfo
+
+func (m *EventSetMaxSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+type EventScheduledMint struct {
+	Amount    types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	Recipient string     `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+}
+
+func (m *EventScheduledMint) Reset()         { *m = EventScheduledMint{} }
+func (m *EventScheduledMint) String() string { return proto.CompactTextString(m) }
+func (*EventScheduledMint) ProtoMessage()    {}
+func (*EventScheduledMint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9fd0c5434c2a5b7, []int{6}
+}
+func (m *EventScheduledMint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduledMint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduledMint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduledMint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduledMint.Merge(m, src)
+}
+func (m *EventScheduledMint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduledMint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduledMint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduledMint proto.InternalMessageInfo
+
+func (m *EventScheduledMint) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventScheduledMint) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+type EventScheduledMintFailed struct {
+	Amount    types.Coin `protobuf:"bytes,1,opt,name=amount,proto3" json:"amount"`
+	Recipient string     `protobuf:"bytes,2,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	Reason    string     `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// The unix timestamp (in seconds) after which the mint is retried
+	RetryTime int64 `protobuf:"varint,4,opt,name=retry_time,json=retryTime,proto3" json:"retry_time,omitempty"`
+}
+
+func (m *EventScheduledMintFailed) Reset()         { *m = EventScheduledMintFailed{} }
+func (m *EventScheduledMintFailed) String() string { return proto.CompactTextString(m) }
+func (*EventScheduledMintFailed) ProtoMessage()    {}
+func (*EventScheduledMintFailed) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b9fd0c5434c2a5b7, []int{7}
+}
+func (m *EventScheduledMintFailed) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EventScheduledMintFailed) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EventScheduledMintFailed.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EventScheduledMintFailed) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EventScheduledMintFailed.Merge(m, src)
+}
+func (m *EventScheduledMintFailed) XXX_Size() int {
+	return m.Size()
+}
+func (m *EventScheduledMintFailed) XXX_DiscardUnknown() {
+	xxx_messageInfo_EventScheduledMintFailed.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EventScheduledMintFailed proto.InternalMessageInfo
+
+func (m *EventScheduledMintFailed) GetAmount() types.Coin {
+	if m != nil {
+		return m.Amount
+	}
+	return types.Coin{}
+}
+
+func (m *EventScheduledMintFailed) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *EventScheduledMintFailed) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *EventScheduledMintFailed) GetRetryTime() int64 {
+	if m != nil {
+		return m.RetryTime
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*EventCreateDenom)(nil), "injective.tokenfactory.v1beta1.EventCreateDenom")
 	proto.RegisterType((*EventMint)(nil), "injective.tokenfactory.v1beta1.EventMint")
 	proto.RegisterType((*EventBurn)(nil), "injective.tokenfactory.v1beta1.EventBurn")
 	proto.RegisterType((*EventChangeAdmin)(nil), "injective.tokenfactory.v1beta1.EventChangeAdmin")
 	proto.RegisterType((*EventSetDenomMetadata)(nil), "injective.tokenfactory.v1beta1.EventSetDenomMetadata")
+	proto.RegisterType((*EventSetMaxSupply)(nil), "injective.tokenfactory.v1beta1.EventSetMaxSupply")
+	proto.RegisterType((*EventScheduledMint)(nil), "injective.tokenfactory.v1beta1.EventScheduledMint")
+	proto.RegisterType((*EventScheduledMintFailed)(nil), "injective.tokenfactory.v1beta1.EventScheduledMintFailed")
 }
 
 func init() {
@@ -314,36 +484,44 @@ func init() {
 }
 
 var fileDescriptor_b9fd0c5434c2a5b7 = []byte{
-	// 449 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x52, 0x4d, 0x6f, 0xd3, 0x40,
-	0x10, 0x8d, 0xf9, 0x08, 0xcd, 0x72, 0x00, 0xac, 0x82, 0x42, 0x10, 0x06, 0xf9, 0x84, 0x40, 0xd8,
-	0x2a, 0x48, 0x70, 0x44, 0x4d, 0x01, 0x09, 0x89, 0x5e, 0x02, 0x27, 0x2e, 0xd1, 0x7a, 0x3d, 0x4d,
-	0x96, 0x74, 0x67, 0xaa, 0xdd, 0x75, 0x8a, 0xff, 0x05, 0x3f, 0xab, 0xc7, 0x1c, 0x39, 0x21, 0x94,
-	0xfc, 0x11, 0xb4, 0xeb, 0xb5, 0x13, 0x84, 0x10, 0x52, 0x6f, 0x7e, 0x33, 0xef, 0xbd, 0xf1, 0x9b,
-	0x1d, 0xf6, 0x4c, 0xe2, 0x57, 0x10, 0x56, 0x2e, 0x21, 0xb7, 0xb4, 0x00, 0x3c, 0xe1, 0xc2, 0x92,
-	0xae, 0xf3, 0xe5, 0x41, 0x01, 0x96, 0x1f, 0xe4, 0xb0, 0x04, 0xb4, 0x26, 0x3b, 0xd3, 0x64, 0x29,
-	0x4e, 0x3a, 0x72, 0xb6, 0x4b, 0xce, 0x02, 0x79, 0xb4, 0x3f, 0xa3, 0x19, 0x79, 0x6a, 0xee, 0xbe,
-	0x1a, 0xd5, 0x28, 0x11, 0x64, 0x14, 0x99, 0xbc, 0xe0, 0x06, 0x3a, 0x5f, 0x41, 0x12, 0xff, 0xea,
-	0xe3, 0xa2, 0xeb, 0x3b, 0x10, 0xfa, 0xaf, 0xfe, 0xf3, 0x8b, 0xbc, 0xb2, 0x73, 0xd2, 0xd2, 0xd6,
-	0xc7, 0x60, 0x79, 0xc9, 0x2d, 0x6f, 0x74, 0xe9, 0x98, 0xdd, 0x7e, 0xe7, 0xfe, 0xfe, 0x48, 0x03,
-	0xb7, 0xf0, 0x16, 0x90, 0x54, 0x3c, 0x64, 0x37, 0xb8, 0x10, 0x54, 0xa1, 0x1d, 0x46, 0x8f, 0xa3,
-	0x27, 0x83, 0x49, 0x0b, 0xe3, 0x7d, 0x76, 0xbd, 0x74, 0x94, 0xe1, 0x15, 0x5f, 0x6f, 0x40, 0xfa,
-	0x8d, 0x0d, 0xbc, 0xc7, 0xb1, 0x44, 0x1b, 0xdf, 0x63, 0x7d, 0x25, 0xd1, 0x82, 0x0e, 0xda, 0x80,
-	0xe2, 0xd7, 0xac, 0xcf, 0x95, 0xf7, 0x74, 0xda, 0x9b, 0x2f, 0xee, 0x67, 0x4d, 0xa2, 0xcc, 0x25,
-	0x6e, 0x97, 0x93, 0x1d, 0x91, 0xc4, 0xf1, 0xb5, 0x8b, 0x9f, 0x8f, 0x7a, 0x93, 0x40, 0x8f, 0x47,
-	0x6c, 0x4f, 0x83, 0x00, 0xb9, 0x04, 0x3d, 0xbc, 0xea, 0x2d, 0x3b, 0x9c, 0xd6, 0x61, 0xf2, 0xb8,
-	0xd2, 0xe8, 0x26, 0x17, 0x95, 0xc6, 0xed, 0xe4, 0x06, 0x5d, 0x7e, 0xf2, 0x03, 0x36, 0x70, 0x16,
-	0xd3, 0x13, 0x4d, 0xaa, 0x1d, 0xed, 0x0a, 0xef, 0x35, 0xa9, 0xf4, 0x73, 0xbb, 0xb8, 0x39, 0xc7,
-	0x19, 0x1c, 0x96, 0x4a, 0xe2, 0x76, 0x3d, 0xd1, 0xce, 0x7a, 0xe2, 0xa7, 0xec, 0x0e, 0xc2, 0xf9,
-	0x94, 0x3b, 0xca, 0x94, 0x97, 0xa5, 0x06, 0x63, 0xc2, 0x02, 0x6f, 0x21, 0x9c, 0x7b, 0xe9, 0x61,
-	0x53, 0x4e, 0x91, 0xdd, 0xf5, 0xae, 0x9f, 0xc0, 0xfa, 0xb7, 0x68, 0x5f, 0xeb, 0x1f, 0xd6, 0x6f,
-	0xd8, 0x9e, 0x0a, 0x8c, 0x10, 0xee, 0xe1, 0x36, 0x1c, 0x2e, 0xba, 0x70, 0xad, 0x4d, 0x08, 0xd8,
-	0x89, 0xc6, 0xa7, 0x17, 0xeb, 0x24, 0x5a, 0xad, 0x93, 0xe8, 0xd7, 0x3a, 0x89, 0xbe, 0x6f, 0x92,
-	0xde, 0x6a, 0x93, 0xf4, 0x7e, 0x6c, 0x92, 0xde, 0x97, 0xc9, 0x4c, 0xda, 0x79, 0x55, 0x64, 0x82,
-	0x54, 0xfe, 0xa1, 0xbd, 0xad, 0x8f, 0xbc, 0x30, 0x79, 0x77, 0x69, 0xcf, 0x05, 0x69, 0xd8, 0x85,
-	0x73, 0x2e, 0x31, 0x57, 0x54, 0x56, 0xa7, 0x60, 0xfe, 0x3c, 0x43, 0x5b, 0x9f, 0x81, 0x29, 0xfa,
-	0xfe, 0xe6, 0x5e, 0xfe, 0x0e, 0x00, 0x00, 0xff, 0xff, 0x69, 0xd3, 0xee, 0x9b, 0x50, 0x03, 0x00,
-	0x00,
+	// 578 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x54, 0x41, 0x6f, 0xd3, 0x3c,
+	0x18, 0x6e, 0xbe, 0xed, 0x2b, 0x8b, 0x39, 0xc0, 0xa2, 0x0d, 0x85, 0x42, 0xb3, 0x29, 0xa7, 0x09,
+	0x44, 0xa2, 0x81, 0x04, 0x17, 0x24, 0xb4, 0x0e, 0x26, 0x4d, 0xa2, 0x97, 0x6e, 0x27, 0x2e, 0x95,
+	0x93, 0xbc, 0x6b, 0x4c, 0x6b, 0xbb, 0xb2, 0x9d, 0xae, 0xf9, 0x17, 0xfc, 0x0c, 0x7e, 0xca, 0x8e,
+	0x3b, 0x22, 0x0e, 0x13, 0x6a, 0xff, 0x08, 0xb2, 0xe3, 0xa4, 0x45, 0xd3, 0x84, 0x40, 0xe2, 0x96,
+	0xc7, 0x7e, 0xde, 0xe7, 0xf1, 0xf3, 0xbe, 0x76, 0xd0, 0x73, 0xc2, 0x3e, 0x43, 0xaa, 0xc8, 0x0c,
+	0x62, 0xc5, 0xc7, 0xc0, 0x2e, 0x70, 0xaa, 0xb8, 0x28, 0xe3, 0xd9, 0x61, 0x02, 0x0a, 0x1f, 0xc6,
+	0x30, 0x03, 0xa6, 0x64, 0x34, 0x15, 0x5c, 0x71, 0x2f, 0x68, 0xc8, 0xd1, 0x3a, 0x39, 0xb2, 0xe4,
+	0xce, 0xce, 0x88, 0x8f, 0xb8, 0xa1, 0xc6, 0xfa, 0xab, 0xaa, 0xea, 0x04, 0x29, 0x97, 0x94, 0xcb,
+	0x38, 0xc1, 0x12, 0x1a, 0xdd, 0x94, 0x13, 0x76, 0x6b, 0x9f, 0x8d, 0x9b, 0x7d, 0x0d, 0xec, 0xfe,
+	0xeb, 0xdf, 0x1c, 0x11, 0x17, 0x2a, 0xe7, 0x82, 0xa8, 0xb2, 0x0f, 0x0a, 0x67, 0x58, 0xe1, 0xaa,
+	0x2e, 0xec, 0xa1, 0x87, 0x1f, 0xf4, 0xe9, 0x8f, 0x05, 0x60, 0x05, 0xef, 0x81, 0x71, 0xea, 0xf9,
+	0xe8, 0x1e, 0x4e, 0x53, 0x5e, 0x30, 0xe5, 0x3b, 0xfb, 0xce, 0x81, 0x3b, 0xa8, 0xa1, 0xb7, 0x83,
+	0xfe, 0xcf, 0x34, 0xc5, 0xff, 0xcf, 0xac, 0x57, 0x20, 0x9c, 0x23, 0xd7, 0x68, 0xf4, 0x09, 0x53,
+	0xde, 0x23, 0xd4, 0xa6, 0x84, 0x29, 0x10, 0xb6, 0xd6, 0x22, 0xef, 0x0d, 0x6a, 0x63, 0x6a, 0x34,
+	0x75, 0xed, 0xfd, 0x97, 0x8f, 0xa3, 0x2a, 0x51, 0xa4, 0x13, 0xd7, 0xcd, 0x89, 0x8e, 0x39, 0x61,
+	0xbd, 0xcd, 0xab, 0x9b, 0xbd, 0xd6, 0xc0, 0xd2, 0xbd, 0x0e, 0xda, 0x12, 0x90, 0x02, 0x99, 0x81,
+	0xf0, 0x37, 0x8c, 0x64, 0x83, 0xc3, 0xd2, 0x3a, 0xf7, 0x0a, 0xc1, 0xb4, 0x73, 0x52, 0x08, 0xb6,
+	0x72, 0xae, 0xd0, 0xdf, 0x3b, 0x3f, 0x41, 0xae, 0x96, 0x18, 0x5e, 0x08, 0x4e, 0x6b, 0x6b, 0xbd,
+	0x70, 0x22, 0x38, 0x0d, 0xcf, 0xeb, 0xc6, 0xe5, 0x98, 0x8d, 0xe0, 0x28, 0xa3, 0x84, 0xad, 0xda,
+	0xe3, 0xac, 0xb5, 0xc7, 0x7b, 0x86, 0xb6, 0x19, 0x5c, 0x0e, 0xb1, 0xa6, 0x0c, 0x71, 0x96, 0x09,
+	0x90, 0xd2, 0x36, 0xf0, 0x01, 0x83, 0x4b, 0x53, 0x7a, 0x54, 0x2d, 0x87, 0x0c, 0xed, 0x1a, 0xd5,
+	0x33, 0x50, 0x66, 0x16, 0xf5, 0xb4, 0xee, 0x90, 0x7e, 0x87, 0xb6, 0xa8, 0x65, 0xd8, 0x70, 0xdd,
+	0x55, 0x38, 0x36, 0x6e, 0xc2, 0xd5, 0x32, 0x36, 0x60, 0x53, 0x14, 0x8e, 0xd0, 0x76, 0xed, 0xd7,
+	0xc7, 0xf3, 0xb3, 0x62, 0x3a, 0x9d, 0x94, 0x77, 0x78, 0xbd, 0x45, 0x88, 0xe2, 0xf9, 0x50, 0x1a,
+	0x4e, 0x75, 0xfe, 0x5e, 0x57, 0xcb, 0x7d, 0xbf, 0xd9, 0xdb, 0xad, 0x4c, 0x65, 0x36, 0x8e, 0x08,
+	0x8f, 0x29, 0x56, 0x79, 0x74, 0xca, 0xd4, 0xc0, 0xa5, 0xb5, 0x66, 0x38, 0x46, 0x5e, 0x65, 0x94,
+	0xe6, 0x90, 0x15, 0x13, 0xc8, 0xcc, 0x65, 0x59, 0x8d, 0xc6, 0xf9, 0xb3, 0xd1, 0x3c, 0x45, 0xae,
+	0x80, 0x94, 0x4c, 0x09, 0xd8, 0xb1, 0xba, 0x83, 0xd5, 0x42, 0xf8, 0xd5, 0x41, 0xfe, 0x6d, 0xb7,
+	0x13, 0x4c, 0x26, 0x90, 0xfd, 0x23, 0x4f, 0x7d, 0xfb, 0x04, 0x60, 0xc9, 0x99, 0xbd, 0x29, 0x16,
+	0x79, 0x5d, 0x84, 0x04, 0x28, 0x51, 0x0e, 0x15, 0xa1, 0xe0, 0x6f, 0xee, 0x3b, 0x07, 0x1b, 0xba,
+	0x4c, 0x89, 0xf2, 0x9c, 0x50, 0xe8, 0x4d, 0xae, 0x16, 0x81, 0x73, 0xbd, 0x08, 0x9c, 0x1f, 0x8b,
+	0xc0, 0xf9, 0xb2, 0x0c, 0x5a, 0xd7, 0xcb, 0xa0, 0xf5, 0x6d, 0x19, 0xb4, 0x3e, 0x0d, 0x46, 0x44,
+	0xe5, 0x45, 0x12, 0xa5, 0x9c, 0xc6, 0xa7, 0xf5, 0xe3, 0xfe, 0x88, 0x13, 0x19, 0x37, 0x4f, 0xfd,
+	0x45, 0xca, 0x05, 0xac, 0xc3, 0x1c, 0x13, 0x16, 0x53, 0xae, 0x23, 0xcb, 0x5f, 0xff, 0x03, 0xaa,
+	0x9c, 0x82, 0x4c, 0xda, 0xe6, 0xd1, 0xbf, 0xfa, 0x39, 0x00, 0x4d, 0x86, 0x38, 0x6e, 0xd1, 0x04,
+	0x00, 0x00,
 }
 
 func (m *EventCreateDenom) Marshal() (dAtA []byte, err error) {
@@ -554,6 +732,138 @@ func (m *EventSetDenomMetadata) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *EventSetMaxSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventSetMaxSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventSetMaxSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduledMint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduledMint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduledMint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *EventScheduledMintFailed) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *EventScheduledMintFailed) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EventScheduledMintFailed) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RetryTime != 0 {
+		i = encodeVarintEvents(dAtA, i, uint64(m.RetryTime))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintEvents(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size, err := m.Amount.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintEvents(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintEvents(dAtA []byte, offset int, v uint64) int {
 	offset -= sovEvents(v)
 	base := offset
@@ -652,6 +962,58 @@ func (m *EventSetDenomMetadata) Size() (n int) {
 	return n
 }
 
+func (m *EventSetMaxSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	return n
+}
+
+func (m *EventScheduledMint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	return n
+}
+
+func (m *EventScheduledMintFailed) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovEvents(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovEvents(uint64(l))
+	}
+	if m.RetryTime != 0 {
+		n += 1 + sovEvents(uint64(m.RetryTime))
+	}
+	return n
+}
+
 func sovEvents(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1295,6 +1657,403 @@ func (m *EventSetDenomMetadata) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *EventSetMaxSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventSetMaxSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventSetMaxSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduledMint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduledMint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduledMint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EventScheduledMintFailed) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowEvents
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EventScheduledMintFailed: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EventScheduledMintFailed: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthEvents
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RetryTime", wireType)
+			}
+			m.RetryTime = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowEvents
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RetryTime |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipEvents(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthEvents
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipEvents(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -45,6 +45,14 @@ func (gs GenesisState) Validate() error {
 				return errors.Wrapf(ErrInvalidAuthorityMetadata, "Invalid admin address (%s)", err)
 			}
 		}
+
+		if err := denom.AuthorityMetadata.Validate(); err != nil {
+			return errors.Wrap(ErrInvalidAuthorityMetadata, err.Error())
+		}
+
+		if err := ValidateMintSchedule(denom.MintSchedule, denom.AuthorityMetadata.MaxSupply); err != nil {
+			return errors.Wrapf(ErrInvalidGenesis, "invalid mint schedule for denom %s: %s", denom.GetDenom(), err)
+		}
 	}
 
 	return nil
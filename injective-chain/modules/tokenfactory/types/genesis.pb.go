@@ -91,6 +91,8 @@ type GenesisDenom struct {
 	Symbol string `protobuf:"bytes,4,opt,name=symbol,proto3" json:"symbol,omitempty" yaml:"symbol"`
 	// The number of decimals
 	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
+	// The pending scheduled mints
+	MintSchedule []ScheduledMint `protobuf:"bytes,6,rep,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule" yaml:"mint_schedule"`
 }
 
 func (m *GenesisDenom) Reset()         { *m = GenesisDenom{} }
@@ -161,6 +163,13 @@ func (m *GenesisDenom) GetDecimals() uint32 {
 	return 0
 }
 
+func (m *GenesisDenom) GetMintSchedule() []ScheduledMint {
+	if m != nil {
+		return m.MintSchedule
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.tokenfactory.v1beta1.GenesisState")
 	proto.RegisterType((*GenesisDenom)(nil), "injective.tokenfactory.v1beta1.GenesisDenom")
@@ -171,36 +180,38 @@ func init() {
 }
 
 var fileDescriptor_a7bae9323951328f = []byte{
-	// 455 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0x41, 0x6f, 0xd3, 0x30,
-	0x14, 0xc7, 0xeb, 0xae, 0xab, 0xc0, 0x5d, 0x19, 0x33, 0x20, 0x85, 0x49, 0x24, 0xc5, 0x48, 0x53,
-	0x11, 0x23, 0xd6, 0x86, 0xb4, 0xc3, 0x6e, 0x44, 0x95, 0x10, 0x12, 0x48, 0xc8, 0xdc, 0xb8, 0x4c,
-	0x4e, 0x6a, 0xda, 0x40, 0x1c, 0x57, 0xb1, 0x3b, 0x29, 0xdf, 0x80, 0x23, 0x1f, 0x81, 0x8f, 0xd3,
-	0xe3, 0x8e, 0x9c, 0x22, 0xd4, 0x5e, 0x38, 0xe7, 0x13, 0xa0, 0xda, 0x5e, 0xb4, 0x51, 0x69, 0xbd,
-	0xd9, 0xef, 0xfd, 0xfe, 0xef, 0xfd, 0xdf, 0xb3, 0xe1, 0x71, 0x9a, 0x7f, 0xe3, 0x89, 0x4e, 0x2f,
-	0x39, 0xd1, 0xf2, 0x3b, 0xcf, 0xbf, 0xb2, 0x44, 0xcb, 0xa2, 0x24, 0x97, 0x27, 0x31, 0xd7, 0xec,
-	0x84, 0x4c, 0x78, 0xce, 0x55, 0xaa, 0xc2, 0x59, 0x21, 0xb5, 0x44, 0x7e, 0x43, 0x87, 0x37, 0xe9,
-	0xd0, 0xd1, 0x87, 0x8f, 0x27, 0x72, 0x22, 0x0d, 0x4a, 0xd6, 0x27, 0xab, 0x3a, 0x3c, 0xdb, 0xd2,
-	0x83, 0xcd, 0xf5, 0x54, 0x16, 0xa9, 0x2e, 0x3f, 0x72, 0xcd, 0xc6, 0x4c, 0x33, 0xa7, 0x7b, 0xb5,
-	0x45, 0x37, 0x63, 0x05, 0x13, 0xce, 0x1a, 0x5e, 0x00, 0xb8, 0xf7, 0xce, 0x9a, 0xfd, 0xac, 0x99,
-	0xe6, 0x68, 0x04, 0xbb, 0x16, 0xf0, 0xc0, 0x00, 0x0c, 0x7b, 0xa7, 0x47, 0xe1, 0xdd, 0xe6, 0xc3,
-	0x4f, 0x86, 0x8e, 0x3a, 0x8b, 0x2a, 0x68, 0x51, 0xa7, 0x45, 0x05, 0x7c, 0xe0, 0xb8, 0x8b, 0x31,
-	0xcf, 0xa5, 0x50, 0x5e, 0x7b, 0xb0, 0x33, 0xec, 0x9d, 0x1e, 0x6f, 0xab, 0xe6, 0xbc, 0x8c, 0xd6,
-	0xa2, 0xe8, 0xd9, 0xba, 0x66, 0x5d, 0x05, 0x4f, 0x4a, 0x26, 0xb2, 0x73, 0x7c, 0xbb, 0x22, 0xa6,
-	0x7d, 0x17, 0x18, 0xd9, 0xfb, 0xa2, 0xdd, 0x8c, 0x62, 0x22, 0xe8, 0x08, 0xee, 0x1a, 0xd4, 0x4c,
-	0x72, 0x3f, 0x7a, 0x58, 0x57, 0xc1, 0x9e, 0xad, 0x64, 0xc2, 0x98, 0xda, 0x34, 0xfa, 0x01, 0x20,
-	0x6a, 0x96, 0x79, 0x21, 0xdc, 0x36, 0xbd, 0xb6, 0x99, 0xff, 0x6c, 0x9b, 0x63, 0xd3, 0xeb, 0xed,
-	0xff, 0x6f, 0x11, 0x3d, 0x77, 0xde, 0x9f, 0xda, 0x8e, 0x9b, 0xf5, 0x31, 0x3d, 0xd8, 0x78, 0x41,
-	0xf4, 0x02, 0x76, 0x72, 0x26, 0xb8, 0xb7, 0x63, 0x1c, 0xef, 0xd7, 0x55, 0xd0, 0xb3, 0xfa, 0x75,
-	0x14, 0x53, 0x93, 0x44, 0x2f, 0x61, 0x57, 0x95, 0x22, 0x96, 0x99, 0xd7, 0x31, 0xd8, 0x41, 0x5d,
-	0x05, 0x7d, 0x8b, 0xd9, 0x38, 0xa6, 0x0e, 0x40, 0x04, 0xde, 0x1b, 0xf3, 0x24, 0x15, 0x2c, 0x53,
-	0xde, 0xee, 0x00, 0x0c, 0xfb, 0xd1, 0xa3, 0xba, 0x0a, 0xf6, 0xaf, 0xb7, 0x60, 0x33, 0x98, 0x36,
-	0xd0, 0x79, 0xe7, 0xef, 0xaf, 0x00, 0x44, 0xd9, 0x62, 0xe9, 0x83, 0xab, 0xa5, 0x0f, 0xfe, 0x2c,
-	0x7d, 0xf0, 0x73, 0xe5, 0xb7, 0xae, 0x56, 0x7e, 0xeb, 0xf7, 0xca, 0x6f, 0x7d, 0xa1, 0x93, 0x54,
-	0x4f, 0xe7, 0x71, 0x98, 0x48, 0x41, 0xde, 0x5f, 0x2f, 0xe6, 0x03, 0x8b, 0x15, 0x69, 0xd6, 0xf4,
-	0x3a, 0x91, 0x05, 0xbf, 0x79, 0x9d, 0xb2, 0x34, 0x27, 0x42, 0x8e, 0xe7, 0x19, 0x57, 0xb7, 0xbf,
-	0xa4, 0x2e, 0x67, 0x5c, 0xc5, 0x5d, 0xf3, 0x15, 0xdf, 0xfc, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xb1,
-	0x74, 0x8d, 0xe9, 0x55, 0x03, 0x00, 0x00,
+	// 494 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xc1, 0x6e, 0xd3, 0x30,
+	0x18, 0xc7, 0x9b, 0xb5, 0xab, 0xc0, 0x6d, 0x19, 0x33, 0x43, 0x0a, 0x13, 0x24, 0x25, 0x48, 0x53,
+	0x11, 0x5b, 0xa2, 0x0d, 0x69, 0x87, 0xdd, 0x88, 0x2a, 0x21, 0x24, 0x26, 0xa1, 0xec, 0xc6, 0xa5,
+	0x72, 0x12, 0xd3, 0x1a, 0xe2, 0xb8, 0x8a, 0xdd, 0x49, 0x79, 0x03, 0x8e, 0x3c, 0x02, 0xcf, 0xc1,
+	0x13, 0xf4, 0xb8, 0x23, 0xa7, 0x08, 0xb5, 0x17, 0xce, 0x79, 0x02, 0x14, 0xdb, 0x8d, 0x56, 0x26,
+	0x2d, 0xb7, 0xfa, 0xf3, 0xef, 0xff, 0xff, 0xfe, 0xdf, 0xe7, 0x06, 0x1c, 0x93, 0xf4, 0x2b, 0x8e,
+	0x04, 0xb9, 0xc6, 0x9e, 0x60, 0xdf, 0x70, 0xfa, 0x05, 0x45, 0x82, 0x65, 0xb9, 0x77, 0x7d, 0x1a,
+	0x62, 0x81, 0x4e, 0xbd, 0x29, 0x4e, 0x31, 0x27, 0xdc, 0x9d, 0x67, 0x4c, 0x30, 0x68, 0xd5, 0xb4,
+	0x7b, 0x9b, 0x76, 0x35, 0x7d, 0x78, 0x30, 0x65, 0x53, 0x26, 0x51, 0xaf, 0xfa, 0xa5, 0x54, 0x87,
+	0xe7, 0x0d, 0x3d, 0xd0, 0x42, 0xcc, 0x58, 0x46, 0x44, 0x7e, 0x89, 0x05, 0x8a, 0x91, 0x40, 0x5a,
+	0xf7, 0xa6, 0x41, 0x37, 0x47, 0x19, 0xa2, 0x3a, 0x9a, 0xb3, 0x34, 0x40, 0xff, 0xbd, 0x0a, 0x7b,
+	0x25, 0x90, 0xc0, 0x70, 0x0c, 0xba, 0x0a, 0x30, 0x8d, 0xa1, 0x31, 0xea, 0x9d, 0x1d, 0xb9, 0xf7,
+	0x87, 0x77, 0x3f, 0x49, 0xda, 0xef, 0x2c, 0x0b, 0xbb, 0x15, 0x68, 0x2d, 0xcc, 0xc0, 0x23, 0xcd,
+	0x4d, 0x62, 0x9c, 0x32, 0xca, 0xcd, 0x9d, 0x61, 0x7b, 0xd4, 0x3b, 0x3b, 0x6e, 0x72, 0xd3, 0x59,
+	0xc6, 0x95, 0xc8, 0x7f, 0x51, 0x79, 0x96, 0x85, 0xfd, 0x34, 0x47, 0x34, 0xb9, 0x70, 0xb6, 0x1d,
+	0x9d, 0x60, 0xa0, 0x0b, 0x63, 0x75, 0xfe, 0xd5, 0xae, 0x47, 0x91, 0x15, 0x78, 0x04, 0x76, 0x25,
+	0x2a, 0x27, 0x79, 0xe8, 0x3f, 0x2e, 0x0b, 0xbb, 0xaf, 0x9c, 0x64, 0xd9, 0x09, 0xd4, 0x35, 0xfc,
+	0x6e, 0x00, 0x58, 0x2f, 0x73, 0x42, 0xf5, 0x36, 0xcd, 0x1d, 0x39, 0xff, 0x79, 0x53, 0x62, 0xd9,
+	0xeb, 0xdd, 0xff, 0x6f, 0xe1, 0xbf, 0xd4, 0xd9, 0x9f, 0xa9, 0x8e, 0x77, 0xfd, 0x9d, 0x60, 0xff,
+	0xce, 0x0b, 0xc2, 0x57, 0xa0, 0x93, 0x22, 0x8a, 0xcd, 0xb6, 0x4c, 0xbc, 0x57, 0x16, 0x76, 0x4f,
+	0xe9, 0xab, 0xaa, 0x13, 0xc8, 0x4b, 0xf8, 0x1a, 0x74, 0x79, 0x4e, 0x43, 0x96, 0x98, 0x1d, 0x89,
+	0xed, 0x97, 0x85, 0x3d, 0x50, 0x98, 0xaa, 0x3b, 0x81, 0x06, 0xa0, 0x07, 0x1e, 0xc4, 0x38, 0x22,
+	0x14, 0x25, 0xdc, 0xdc, 0x1d, 0x1a, 0xa3, 0x81, 0xff, 0xa4, 0x2c, 0xec, 0xbd, 0xcd, 0x16, 0xd4,
+	0x8d, 0x13, 0xd4, 0x10, 0x9c, 0x83, 0x01, 0x25, 0xa9, 0x98, 0xf0, 0x68, 0x86, 0xe3, 0x45, 0x82,
+	0xcd, 0xae, 0x7c, 0xb7, 0x93, 0xa6, 0x2d, 0x5c, 0x69, 0x3e, 0xbe, 0x24, 0xa9, 0xf0, 0x9f, 0xeb,
+	0xe1, 0x0f, 0x54, 0xa3, 0x2d, 0x47, 0x27, 0xe8, 0x57, 0xe7, 0x8d, 0xe0, 0xa2, 0xf3, 0xf7, 0xa7,
+	0x6d, 0xf8, 0xc9, 0x72, 0x65, 0x19, 0x37, 0x2b, 0xcb, 0xf8, 0xb3, 0xb2, 0x8c, 0x1f, 0x6b, 0xab,
+	0x75, 0xb3, 0xb6, 0x5a, 0xbf, 0xd7, 0x56, 0xeb, 0x73, 0x30, 0x25, 0x62, 0xb6, 0x08, 0xdd, 0x88,
+	0x51, 0xef, 0xc3, 0x26, 0xc4, 0x47, 0x14, 0x72, 0xaf, 0x8e, 0x74, 0x12, 0xb1, 0x0c, 0xdf, 0x3e,
+	0xce, 0x10, 0x49, 0x3d, 0xca, 0x2a, 0x7f, 0xbe, 0xfd, 0x11, 0x88, 0x7c, 0x8e, 0x79, 0xd8, 0x95,
+	0x7f, 0xfe, 0xb7, 0xff, 0x06, 0x00, 0x21, 0x58, 0x09, 0xe1, 0xc7, 0x03, 0x00, 0x00,
 }
 
 func (this *GenesisDenom) Equal(that interface{}) bool {
@@ -237,6 +248,14 @@ func (this *GenesisDenom) Equal(that interface{}) bool {
 	if this.Decimals != that1.Decimals {
 		return false
 	}
+	if len(this.MintSchedule) != len(that1.MintSchedule) {
+		return false
+	}
+	for i := range this.MintSchedule {
+		if !this.MintSchedule[i].Equal(&that1.MintSchedule[i]) {
+			return false
+		}
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -306,6 +325,20 @@ func (m *GenesisDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintSchedule) > 0 {
+		for iNdEx := len(m.MintSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
 	if m.Decimals != 0 {
 		i = encodeVarintGenesis(dAtA, i, uint64(m.Decimals))
 		i--
@@ -396,6 +429,12 @@ func (m *GenesisDenom) Size() (n int) {
 	if m.Decimals != 0 {
 		n += 1 + sovGenesis(uint64(m.Decimals))
 	}
+	if len(m.MintSchedule) > 0 {
+		for _, e := range m.MintSchedule {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -699,6 +738,40 @@ func (m *GenesisDenom) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedule = append(m.MintSchedule, ScheduledMint{})
+			if err := m.MintSchedule[len(m.MintSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
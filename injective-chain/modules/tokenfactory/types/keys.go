@@ -1,6 +1,7 @@
 package types
 
 import (
+	"encoding/binary"
 	"strings"
 )
 
@@ -30,6 +31,8 @@ var (
 	CreatorPrefixKey          = []byte{0x03}
 	AdminPrefixKey            = []byte{0x04}
 	ParamsKey                 = []byte{0x05}
+	ScheduledMintPrefixKey    = []byte{0x06}
+	ScheduledMintQueueKey     = []byte{0x07}
 )
 
 // GetDenomPrefixStore returns the store prefix where all the data associated with a specific denom
@@ -48,3 +51,24 @@ func GetCreatorPrefix(creator string) []byte {
 func GetCreatorsPrefix() []byte {
 	return append(CreatorPrefixKey, []byte(KeySeparator)...)
 }
+
+// GetScheduledMintKey returns the key of a scheduled mint within the denom prefix store
+func GetScheduledMintKey(index uint32) []byte {
+	return binary.BigEndian.AppendUint32(append([]byte{}, ScheduledMintPrefixKey...), index)
+}
+
+// GetScheduledMintQueueKey returns the key of a scheduled mint in the queue ordered by unlock time
+func GetScheduledMintQueueKey(unlockTime int64, index uint32, denom string) []byte {
+	return append(binary.BigEndian.AppendUint32(GetScheduledMintQueueTimePrefix(unlockTime), index), denom...)
+}
+
+// GetScheduledMintQueueTimePrefix returns the prefix of the scheduled mints queued at the given unlock time
+func GetScheduledMintQueueTimePrefix(unlockTime int64) []byte {
+	return binary.BigEndian.AppendUint64(append([]byte{}, ScheduledMintQueueKey...), uint64(unlockTime))
+}
+
+// ParseScheduledMintQueueKey returns the index and denom of a scheduled mint from its queue key
+func ParseScheduledMintQueueKey(key []byte) (index uint32, denom string) {
+	offset := len(ScheduledMintQueueKey) + 8
+	return binary.BigEndian.Uint32(key[offset : offset+4]), string(key[offset+4:])
+}
@@ -4,6 +4,7 @@ import (
 	"strings"
 
 	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
@@ -19,6 +20,7 @@ const (
 	TypeMsgChangeAdmin      = "change_admin"
 	TypeMsgSetDenomMetadata = "set_denom_metadata"
 	TypeMsgUpdateParams     = "update_params"
+	TypeMsgSetMaxSupply     = "set_max_supply"
 )
 
 var _ sdk.Msg = &MsgCreateDenom{}
@@ -27,6 +29,7 @@ var _ sdk.Msg = &MsgBurn{}
 var _ sdk.Msg = &MsgSetDenomMetadata{}
 var _ sdk.Msg = &MsgChangeAdmin{}
 var _ sdk.Msg = &MsgUpdateParams{}
+var _ sdk.Msg = &MsgSetMaxSupply{}
 
 func (m MsgUpdateParams) Route() string { return RouterKey }
 
@@ -62,6 +65,7 @@ func NewMsgCreateDenom(sender, subdenom, name, symbol string, decimals uint32, a
 		Symbol:         symbol,
 		Decimals:       decimals,
 		AllowAdminBurn: allowAdminBurn,
+		MaxSupply:      math.ZeroInt(),
 	}
 }
 
@@ -90,6 +94,14 @@ func (m MsgCreateDenom) ValidateBasic() error {
 		return errors.Wrapf(ErrInvalidDenom, "symbol cannot exceed %d characters", MaxSymbolLength)
 	}
 
+	if !m.MaxSupply.IsNil() && m.MaxSupply.IsNegative() {
+		return errors.Wrapf(ErrInvalidMaxSupply, "max supply cannot be negative: %v", m.MaxSupply)
+	}
+
+	if err := ValidateMintSchedule(m.MintSchedule, m.MaxSupply); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -275,3 +287,41 @@ func (m MsgSetDenomMetadata) GetSigners() []sdk.AccAddress {
 	sender, _ := sdk.AccAddressFromBech32(m.Sender)
 	return []sdk.AccAddress{sender}
 }
+
+// NewMsgSetMaxSupply creates a message to set the max supply of a denom
+func NewMsgSetMaxSupply(sender, denom string, maxSupply math.Int) *MsgSetMaxSupply {
+	return &MsgSetMaxSupply{
+		Sender:    sender,
+		Denom:     denom,
+		MaxSupply: maxSupply,
+	}
+}
+
+func (m MsgSetMaxSupply) Route() string { return RouterKey }
+func (m MsgSetMaxSupply) Type() string  { return TypeMsgSetMaxSupply }
+func (m MsgSetMaxSupply) ValidateBasic() error {
+	_, err := sdk.AccAddressFromBech32(m.Sender)
+	if err != nil {
+		return errors.Wrapf(sdkerrors.ErrInvalidAddress, "Invalid sender address (%s)", err)
+	}
+
+	_, _, err = DeconstructDenom(m.Denom)
+	if err != nil {
+		return err
+	}
+
+	if m.MaxSupply.IsNil() || !m.MaxSupply.IsPositive() {
+		return errors.Wrapf(ErrInvalidMaxSupply, "max supply must be positive: %v", m.MaxSupply)
+	}
+
+	return nil
+}
+
+func (m *MsgSetMaxSupply) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(m))
+}
+
+func (m MsgSetMaxSupply) GetSigners() []sdk.AccAddress {
+	sender, _ := sdk.AccAddressFromBech32(m.Sender)
+	return []sdk.AccAddress{sender}
+}
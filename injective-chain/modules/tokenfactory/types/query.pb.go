@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
@@ -310,6 +311,115 @@ func (m *QueryDenomsFromCreatorResponse) GetDenoms() []string {
 	return nil
 }
 
+// QueryDenomSupplyInfoRequest defines the request structure for the
+// DenomSupplyInfo gRPC query.
+type QueryDenomSupplyInfoRequest struct {
+	// The creator's Injective address
+	Creator string `protobuf:"bytes,1,opt,name=creator,proto3" json:"creator,omitempty" yaml:"creator"`
+	// The sub-denom
+	SubDenom string `protobuf:"bytes,2,opt,name=sub_denom,json=subDenom,proto3" json:"sub_denom,omitempty" yaml:"sub_denom"`
+}
+
+func (m *QueryDenomSupplyInfoRequest) Reset()         { *m = QueryDenomSupplyInfoRequest{} }
+func (m *QueryDenomSupplyInfoRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyInfoRequest) ProtoMessage()    {}
+func (*QueryDenomSupplyInfoRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{6}
+}
+func (m *QueryDenomSupplyInfoRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyInfoRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyInfoRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyInfoRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyInfoRequest.Merge(m, src)
+}
+func (m *QueryDenomSupplyInfoRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyInfoRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyInfoRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyInfoRequest proto.InternalMessageInfo
+
+func (m *QueryDenomSupplyInfoRequest) GetCreator() string {
+	if m != nil {
+		return m.Creator
+	}
+	return ""
+}
+
+func (m *QueryDenomSupplyInfoRequest) GetSubDenom() string {
+	if m != nil {
+		return m.SubDenom
+	}
+	return ""
+}
+
+// QueryDenomSupplyInfoResponse defines the response structure for the
+// DenomSupplyInfo gRPC query.
+type QueryDenomSupplyInfoResponse struct {
+	// The max supply, zero if the supply is unlimited
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// The current total supply
+	Supply cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=supply,proto3,customtype=cosmossdk.io/math.Int" json:"supply"`
+	// The total amount of the pending scheduled mints
+	ScheduledSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=scheduled_supply,json=scheduledSupply,proto3,customtype=cosmossdk.io/math.Int" json:"scheduled_supply"`
+	// The pending scheduled mints
+	MintSchedule []ScheduledMint `protobuf:"bytes,4,rep,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule"`
+}
+
+func (m *QueryDenomSupplyInfoResponse) Reset()         { *m = QueryDenomSupplyInfoResponse{} }
+func (m *QueryDenomSupplyInfoResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDenomSupplyInfoResponse) ProtoMessage()    {}
+func (*QueryDenomSupplyInfoResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_5a5ba391b550eeda, []int{7}
+}
+func (m *QueryDenomSupplyInfoResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDenomSupplyInfoResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDenomSupplyInfoResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryDenomSupplyInfoResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDenomSupplyInfoResponse.Merge(m, src)
+}
+func (m *QueryDenomSupplyInfoResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDenomSupplyInfoResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDenomSupplyInfoResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryDenomSupplyInfoResponse proto.InternalMessageInfo
+
+func (m *QueryDenomSupplyInfoResponse) GetMintSchedule() []ScheduledMint {
+	if m != nil {
+		return m.MintSchedule
+	}
+	return nil
+}
+
 // QueryModuleStateRequest is the request type for the
 // Query/TokenfactoryModuleState RPC method.
 type QueryModuleStateRequest struct {
@@ -319,7 +429,7 @@ func (m *QueryModuleStateRequest) Reset()         { *m = QueryModuleStateRequest
 func (m *QueryModuleStateRequest) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateRequest) ProtoMessage()    {}
 func (*QueryModuleStateRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a5ba391b550eeda, []int{6}
+	return fileDescriptor_5a5ba391b550eeda, []int{8}
 }
 func (m *QueryModuleStateRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -359,7 +469,7 @@ func (m *QueryModuleStateResponse) Reset()         { *m = QueryModuleStateRespon
 func (m *QueryModuleStateResponse) String() string { return proto.CompactTextString(m) }
 func (*QueryModuleStateResponse) ProtoMessage()    {}
 func (*QueryModuleStateResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_5a5ba391b550eeda, []int{7}
+	return fileDescriptor_5a5ba391b550eeda, []int{9}
 }
 func (m *QueryModuleStateResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -402,6 +512,8 @@ func init() {
 	proto.RegisterType((*QueryDenomAuthorityMetadataResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomAuthorityMetadataResponse")
 	proto.RegisterType((*QueryDenomsFromCreatorRequest)(nil), "injective.tokenfactory.v1beta1.QueryDenomsFromCreatorRequest")
 	proto.RegisterType((*QueryDenomsFromCreatorResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomsFromCreatorResponse")
+	proto.RegisterType((*QueryDenomSupplyInfoRequest)(nil), "injective.tokenfactory.v1beta1.QueryDenomSupplyInfoRequest")
+	proto.RegisterType((*QueryDenomSupplyInfoResponse)(nil), "injective.tokenfactory.v1beta1.QueryDenomSupplyInfoResponse")
 	proto.RegisterType((*QueryModuleStateRequest)(nil), "injective.tokenfactory.v1beta1.QueryModuleStateRequest")
 	proto.RegisterType((*QueryModuleStateResponse)(nil), "injective.tokenfactory.v1beta1.QueryModuleStateResponse")
 }
@@ -411,51 +523,62 @@ func init() {
 }
 
 var fileDescriptor_5a5ba391b550eeda = []byte{
-	// 703 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x4f, 0x13, 0x41,
-	0x18, 0xee, 0xa2, 0x54, 0x19, 0x3f, 0x02, 0x23, 0x11, 0x68, 0x74, 0xab, 0x63, 0x42, 0x10, 0xeb,
-	0x4e, 0x00, 0x83, 0xc6, 0x04, 0x8d, 0x0b, 0x6a, 0x8c, 0x12, 0x65, 0x35, 0x31, 0xd1, 0xc4, 0x66,
-	0xb6, 0x0c, 0xcb, 0x6a, 0x77, 0xa7, 0xec, 0xcc, 0x92, 0x34, 0x84, 0x8b, 0x5e, 0x3c, 0x9a, 0xf8,
-	0x0b, 0xfc, 0x07, 0xfe, 0x06, 0x4f, 0x78, 0x23, 0xf1, 0xa2, 0x97, 0xc6, 0x80, 0xbf, 0xa0, 0x47,
-	0x4f, 0xa6, 0x33, 0xd3, 0x0f, 0x58, 0xec, 0x16, 0xbd, 0xb5, 0x33, 0xcf, 0xf3, 0xbc, 0xef, 0xf3,
-	0xce, 0xf3, 0x66, 0xc1, 0xa4, 0x1f, 0xbe, 0xa6, 0x25, 0xe1, 0xaf, 0x53, 0x2c, 0xd8, 0x1b, 0x1a,
-	0xae, 0x90, 0x92, 0x60, 0x51, 0x15, 0xaf, 0x4f, 0xb9, 0x54, 0x90, 0x29, 0xbc, 0x16, 0xd3, 0xa8,
-	0x6a, 0x55, 0x22, 0x26, 0x18, 0x34, 0x5b, 0x58, 0xab, 0x13, 0x6b, 0x69, 0x6c, 0x6e, 0xd8, 0x63,
-	0x1e, 0x93, 0x50, 0xdc, 0xf8, 0xa5, 0x58, 0xb9, 0x73, 0x1e, 0x63, 0x5e, 0x99, 0x62, 0x52, 0xf1,
-	0x31, 0x09, 0x43, 0x26, 0x88, 0xf0, 0x59, 0xc8, 0xf5, 0xed, 0x64, 0x89, 0xf1, 0x80, 0x71, 0xec,
-	0x12, 0x4e, 0x55, 0xb1, 0x56, 0xe9, 0x0a, 0xf1, 0xfc, 0x50, 0x82, 0x35, 0x76, 0x36, 0xa5, 0x57,
-	0x12, 0x8b, 0x55, 0x16, 0xf9, 0xa2, 0xba, 0x48, 0x05, 0x59, 0x26, 0x82, 0x68, 0xde, 0x95, 0x14,
-	0x5e, 0x85, 0x44, 0x24, 0x68, 0x36, 0x54, 0x48, 0x01, 0x7b, 0x34, 0xa4, 0xdc, 0xd7, 0x68, 0x34,
-	0x0c, 0xe0, 0x52, 0xa3, 0xe9, 0x27, 0x52, 0xc2, 0xa1, 0x6b, 0x31, 0xe5, 0x02, 0xbd, 0x04, 0x67,
-	0xf6, 0x9c, 0xf2, 0x0a, 0x0b, 0x39, 0x85, 0x0b, 0x20, 0xab, 0x4a, 0x8d, 0x1a, 0x17, 0x8c, 0x89,
-	0x13, 0xd3, 0xe3, 0x56, 0xf7, 0x81, 0x5a, 0x8a, 0x6f, 0x1f, 0xdd, 0xaa, 0xe5, 0x33, 0x8e, 0xe6,
-	0xa2, 0x77, 0x06, 0x40, 0x52, 0x7d, 0x81, 0x86, 0x2c, 0xb8, 0xb3, 0xdf, 0xb3, 0xee, 0x01, 0x4e,
-	0x82, 0x63, 0xa5, 0x88, 0x12, 0xc1, 0x22, 0x59, 0x6d, 0xc0, 0x1e, 0xac, 0xd7, 0xf2, 0x27, 0xab,
-	0x24, 0x28, 0xdf, 0x44, 0xcb, 0x0d, 0x26, 0x72, 0x9a, 0x00, 0x38, 0x05, 0x06, 0x78, 0xec, 0x16,
-	0xe5, 0xf1, 0x68, 0x9f, 0x44, 0x0f, 0xd7, 0x6b, 0xf9, 0x41, 0x85, 0x6e, 0x5d, 0x21, 0xe7, 0x38,
-	0x8f, 0x5d, 0x59, 0x16, 0x7d, 0x36, 0xc0, 0xa5, 0xae, 0x5d, 0x68, 0xcf, 0xef, 0x0d, 0x00, 0x5b,
-	0xef, 0x52, 0x0c, 0xf4, 0xb5, 0x1e, 0xc0, 0x6c, 0xda, 0x00, 0x0e, 0x16, 0xb7, 0x2f, 0x36, 0x06,
-	0x52, 0xaf, 0xe5, 0xc7, 0x54, 0x83, 0x49, 0x7d, 0xe4, 0x0c, 0x25, 0xc2, 0x80, 0x16, 0xc1, 0xf9,
-	0x76, 0xc7, 0xfc, 0x5e, 0xc4, 0x82, 0x79, 0xe5, 0xbf, 0x39, 0xb2, 0xc2, 0xfe, 0x91, 0xc1, 0x7a,
-	0x2d, 0x7f, 0x5a, 0xd5, 0xd0, 0x17, 0xed, 0xa1, 0xa1, 0x87, 0xc0, 0xfc, 0x9b, 0x9c, 0xf6, 0x7e,
-	0x19, 0x64, 0xe5, 0xdc, 0x1a, 0xef, 0x7d, 0x64, 0x62, 0xc0, 0x1e, 0xaa, 0xd7, 0xf2, 0xa7, 0x3a,
-	0x5e, 0x80, 0x23, 0x47, 0x03, 0xd0, 0x18, 0x18, 0x91, 0x62, 0x8b, 0x6c, 0x39, 0x2e, 0xd3, 0xa7,
-	0x82, 0x08, 0xda, 0x0c, 0xd3, 0x2b, 0x30, 0x9a, 0xbc, 0xd2, 0x15, 0x6c, 0xd0, 0xcf, 0x1b, 0x07,
-	0x7a, 0x9e, 0x85, 0xb4, 0x79, 0xde, 0x57, 0xe1, 0x55, 0x22, 0x8a, 0x3a, 0xfd, 0x35, 0x0b, 0xfa,
-	0x65, 0x01, 0xf8, 0xc9, 0x00, 0x59, 0x15, 0x39, 0x38, 0x9d, 0xa6, 0x94, 0x4c, 0x7d, 0x6e, 0xe6,
-	0x50, 0x1c, 0xe5, 0x00, 0x59, 0x6f, 0xbf, 0xfd, 0xfa, 0xd8, 0x37, 0x01, 0xc7, 0x71, 0x4f, 0x4b,
-	0x0a, 0x7f, 0x1b, 0xe0, 0xec, 0xc1, 0xa9, 0x80, 0x76, 0x4f, 0xf5, 0xbb, 0x6e, 0x4d, 0x6e, 0xfe,
-	0xbf, 0x34, 0xb4, 0xa7, 0xe7, 0xd2, 0xd3, 0x12, 0x7c, 0x9c, 0xe6, 0x49, 0x3d, 0x3e, 0xde, 0xd0,
-	0x91, 0xda, 0xc4, 0x1b, 0xad, 0x5d, 0xdb, 0xc4, 0xc9, 0x54, 0xc3, 0x1f, 0x06, 0x18, 0x4a, 0xc4,
-	0x0d, 0xce, 0xf5, 0xde, 0xf3, 0x01, 0xa9, 0xcf, 0xdd, 0xfa, 0x57, 0xba, 0x76, 0x7b, 0x57, 0xba,
-	0xbd, 0x0d, 0xe7, 0x7a, 0x73, 0x5b, 0x5c, 0x89, 0x58, 0x50, 0xd4, 0x8e, 0xdb, 0xd6, 0xe1, 0x17,
-	0x03, 0x8c, 0x3c, 0xeb, 0xa0, 0x75, 0xc4, 0x1d, 0x5e, 0xef, 0xa9, 0xc5, 0xe4, 0xee, 0xe4, 0x6e,
-	0x1c, 0x9e, 0xa8, 0x5d, 0x5d, 0x93, 0xae, 0x2c, 0x58, 0x48, 0x73, 0x15, 0x48, 0x72, 0x51, 0xee,
-	0x92, 0x5d, 0xde, 0xda, 0x31, 0x8d, 0xed, 0x1d, 0xd3, 0xf8, 0xb9, 0x63, 0x1a, 0x1f, 0x76, 0xcd,
-	0xcc, 0xf6, 0xae, 0x99, 0xf9, 0xbe, 0x6b, 0x66, 0x5e, 0x38, 0x9e, 0x2f, 0x56, 0x63, 0xd7, 0x2a,
-	0xb1, 0x00, 0x3f, 0x68, 0x2a, 0x3e, 0x22, 0x2e, 0x6f, 0xeb, 0x5f, 0x2d, 0xb1, 0x88, 0x76, 0xfe,
-	0x5d, 0x25, 0x7e, 0xa8, 0xf5, 0xf9, 0xde, 0xe2, 0xa2, 0x5a, 0xa1, 0xdc, 0xcd, 0xca, 0x6f, 0xd0,
-	0xcc, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x34, 0x0e, 0x92, 0xf1, 0xc4, 0x07, 0x00, 0x00,
+	// 871 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x41, 0x6f, 0x1b, 0x45,
+	0x18, 0xf5, 0x3a, 0xad, 0xa9, 0xa7, 0x2d, 0x4d, 0x86, 0x40, 0x5d, 0xd3, 0xae, 0xcb, 0x22, 0x55,
+	0x26, 0xb8, 0xbb, 0x8a, 0x0b, 0x05, 0x41, 0x0b, 0xc2, 0x2d, 0xd0, 0x00, 0x16, 0xc9, 0x06, 0x09,
+	0x04, 0x12, 0xab, 0xb1, 0x3d, 0xb1, 0x97, 0x78, 0x77, 0x36, 0x3b, 0xb3, 0x51, 0xac, 0x28, 0x1c,
+	0xe0, 0xc2, 0x11, 0x89, 0x5f, 0xc0, 0x95, 0x13, 0x37, 0xee, 0x9c, 0x72, 0x8c, 0xc4, 0x05, 0x38,
+	0xac, 0x50, 0xc2, 0x2f, 0xf0, 0x91, 0x13, 0xda, 0x99, 0x59, 0xdb, 0xc9, 0xba, 0xd9, 0x8d, 0x73,
+	0x8b, 0x67, 0xbe, 0xf7, 0xbe, 0xf7, 0xbe, 0xd9, 0xef, 0x29, 0x60, 0xc9, 0x76, 0xbf, 0xc1, 0x6d,
+	0x66, 0x6f, 0x63, 0x83, 0x91, 0x4d, 0xec, 0x6e, 0xa0, 0x36, 0x23, 0xfe, 0xc0, 0xd8, 0x5e, 0x6e,
+	0x61, 0x86, 0x96, 0x8d, 0xad, 0x00, 0xfb, 0x03, 0xdd, 0xf3, 0x09, 0x23, 0x50, 0x1d, 0xd5, 0xea,
+	0x93, 0xb5, 0xba, 0xac, 0x2d, 0x2f, 0x76, 0x49, 0x97, 0xf0, 0x52, 0x23, 0xfa, 0x4b, 0xa0, 0xca,
+	0x37, 0xbb, 0x84, 0x74, 0xfb, 0xd8, 0x40, 0x9e, 0x6d, 0x20, 0xd7, 0x25, 0x0c, 0x31, 0x9b, 0xb8,
+	0x54, 0xde, 0x2e, 0xb5, 0x09, 0x75, 0x08, 0x35, 0x5a, 0x88, 0x62, 0xd1, 0x6c, 0xd4, 0xda, 0x43,
+	0x5d, 0xdb, 0xe5, 0xc5, 0xb2, 0xf6, 0x7e, 0x8a, 0x56, 0x14, 0xb0, 0x1e, 0xf1, 0x6d, 0x36, 0x68,
+	0x62, 0x86, 0x3a, 0x88, 0x21, 0x89, 0x7b, 0x35, 0x05, 0xe7, 0x21, 0x1f, 0x39, 0xb1, 0xa0, 0x5a,
+	0x4a, 0x71, 0x17, 0xbb, 0x98, 0xda, 0xb2, 0x5a, 0x5b, 0x04, 0x70, 0x2d, 0x12, 0xbd, 0xca, 0x29,
+	0x4c, 0xbc, 0x15, 0x60, 0xca, 0xb4, 0xaf, 0xc0, 0x73, 0xc7, 0x4e, 0xa9, 0x47, 0x5c, 0x8a, 0xe1,
+	0x63, 0x50, 0x10, 0xad, 0x4a, 0xca, 0x6d, 0xa5, 0x7a, 0xb9, 0x7e, 0x47, 0x3f, 0x7d, 0xa0, 0xba,
+	0xc0, 0x37, 0x2e, 0xec, 0x87, 0x95, 0x9c, 0x29, 0xb1, 0xda, 0xf7, 0x0a, 0xd0, 0x38, 0xfb, 0x63,
+	0xec, 0x12, 0xe7, 0xbd, 0x93, 0x9e, 0xa5, 0x06, 0xb8, 0x04, 0x9e, 0x69, 0xfb, 0x18, 0x31, 0xe2,
+	0xf3, 0x6e, 0xc5, 0xc6, 0xfc, 0x30, 0xac, 0x5c, 0x19, 0x20, 0xa7, 0xff, 0x96, 0xd6, 0x89, 0x90,
+	0x9a, 0x19, 0x17, 0xc0, 0x65, 0x50, 0xa4, 0x41, 0xcb, 0xe2, 0xc7, 0xa5, 0x3c, 0xaf, 0x5e, 0x1c,
+	0x86, 0x95, 0x79, 0x51, 0x3d, 0xba, 0xd2, 0xcc, 0x4b, 0x34, 0x68, 0xf1, 0xb6, 0xda, 0xaf, 0x0a,
+	0x78, 0xf9, 0x54, 0x15, 0xd2, 0xf3, 0x0f, 0x0a, 0x80, 0xa3, 0x77, 0xb1, 0x1c, 0x79, 0x2d, 0x07,
+	0x70, 0x3f, 0x6d, 0x00, 0xd3, 0xc9, 0x1b, 0x2f, 0x45, 0x03, 0x19, 0x86, 0x95, 0x1b, 0x42, 0x60,
+	0x92, 0x5f, 0x33, 0x17, 0x12, 0x1f, 0x83, 0xd6, 0x04, 0xb7, 0xc6, 0x8a, 0xe9, 0x07, 0x3e, 0x71,
+	0x1e, 0x09, 0xff, 0xf1, 0xc8, 0x6a, 0x27, 0x47, 0x06, 0x87, 0x61, 0xe5, 0x59, 0xd1, 0x43, 0x5e,
+	0x8c, 0x87, 0xa6, 0x7d, 0x0c, 0xd4, 0xa7, 0xd1, 0x49, 0xef, 0xaf, 0x80, 0x02, 0x9f, 0x5b, 0xf4,
+	0xde, 0x73, 0xd5, 0x62, 0x63, 0x61, 0x18, 0x56, 0xae, 0x4e, 0xbc, 0x00, 0xd5, 0x4c, 0x59, 0xa0,
+	0x7d, 0x0b, 0x5e, 0x1c, 0x93, 0xad, 0x07, 0x9e, 0xd7, 0x1f, 0xac, 0xb8, 0x1b, 0x64, 0x26, 0x65,
+	0xb3, 0x3c, 0xe7, 0x6f, 0x79, 0x70, 0x73, 0xba, 0x00, 0xe9, 0xe5, 0x01, 0x00, 0x0e, 0xda, 0xb1,
+	0x28, 0xbf, 0x91, 0x22, 0x6e, 0x45, 0xcf, 0xf0, 0x77, 0x58, 0x79, 0x5e, 0xec, 0x30, 0xed, 0x6c,
+	0xea, 0x36, 0x31, 0x1c, 0xc4, 0x7a, 0xfa, 0x8a, 0xcb, 0xcc, 0xa2, 0x83, 0x76, 0x04, 0x13, 0x7c,
+	0x1d, 0x14, 0x24, 0x32, 0x9f, 0x05, 0x29, 0x8b, 0xe1, 0x13, 0x30, 0x4f, 0xdb, 0x3d, 0xdc, 0x09,
+	0xfa, 0xb8, 0x13, 0xb7, 0x9e, 0xcb, 0x42, 0x70, 0x6d, 0x04, 0x93, 0x02, 0xbe, 0x00, 0x57, 0x1d,
+	0xdb, 0x65, 0x56, 0x7c, 0x5e, 0xba, 0x70, 0x7b, 0xae, 0x7a, 0xb9, 0x7e, 0x37, 0xed, 0x03, 0x5c,
+	0x8f, 0x79, 0x9a, 0xb6, 0xcb, 0xe4, 0x22, 0x5e, 0x89, 0x98, 0xe2, 0x0b, 0xed, 0x06, 0xb8, 0xce,
+	0x07, 0xd7, 0x24, 0xd1, 0xcf, 0x75, 0x86, 0x18, 0x8e, 0x63, 0xe0, 0x6b, 0x50, 0x4a, 0x5e, 0xc9,
+	0x79, 0x36, 0xc0, 0x45, 0x1a, 0x1d, 0xc8, 0x4d, 0xa8, 0xa5, 0x09, 0xf9, 0x50, 0xc4, 0x8e, 0x20,
+	0x11, 0xd0, 0xfa, 0x2f, 0x97, 0xc0, 0x45, 0xde, 0x00, 0xfe, 0xac, 0x80, 0x82, 0x08, 0x0b, 0x58,
+	0x4f, 0x63, 0x4a, 0xe6, 0x55, 0xf9, 0xde, 0x99, 0x30, 0xc2, 0x81, 0xa6, 0x7f, 0xf7, 0xc7, 0xbf,
+	0x3f, 0xe5, 0xab, 0xf0, 0x8e, 0x91, 0x29, 0x5e, 0xe1, 0x7f, 0x0a, 0x78, 0x61, 0xfa, 0x3e, 0xc3,
+	0x46, 0xa6, 0xfe, 0xa7, 0xe6, 0x5d, 0xf9, 0xd1, 0xb9, 0x38, 0xa4, 0xa7, 0xcf, 0xb9, 0xa7, 0x35,
+	0xf8, 0x69, 0x9a, 0x27, 0xb1, 0xb6, 0xc6, 0xae, 0x5c, 0xb9, 0x3d, 0x63, 0x77, 0xb4, 0x56, 0x7b,
+	0x46, 0x32, 0x8f, 0xe0, 0x5f, 0x0a, 0x58, 0x48, 0x04, 0x05, 0x7c, 0x98, 0x5d, 0xf3, 0x94, 0xbc,
+	0x2a, 0xbf, 0x33, 0x2b, 0x5c, 0xba, 0x7d, 0x9f, 0xbb, 0x7d, 0x17, 0x3e, 0xcc, 0xe6, 0xd6, 0xda,
+	0xf0, 0x89, 0x63, 0x49, 0xc7, 0x63, 0xeb, 0x30, 0x54, 0xc0, 0xb5, 0x13, 0xb1, 0x01, 0xdf, 0xce,
+	0x2e, 0x2d, 0x91, 0x76, 0xe5, 0x07, 0xb3, 0x81, 0xa5, 0xab, 0x55, 0xee, 0xea, 0x23, 0xf8, 0xe4,
+	0x5c, 0x6f, 0x28, 0xd2, 0xc6, 0xb2, 0x23, 0x33, 0xbf, 0x2b, 0xe0, 0xfa, 0x67, 0x13, 0x0c, 0x13,
+	0xfb, 0x0c, 0xdf, 0xc8, 0xa4, 0x35, 0x19, 0x0e, 0xe5, 0x37, 0xcf, 0x0e, 0x94, 0x06, 0x5f, 0xe3,
+	0x06, 0x75, 0x58, 0x4b, 0x33, 0xe8, 0x70, 0xb0, 0xc5, 0xc3, 0xa2, 0xd1, 0xdf, 0x3f, 0x54, 0x95,
+	0x83, 0x43, 0x55, 0xf9, 0xe7, 0x50, 0x55, 0x7e, 0x3c, 0x52, 0x73, 0x07, 0x47, 0x6a, 0xee, 0xcf,
+	0x23, 0x35, 0xf7, 0xa5, 0xd9, 0xb5, 0x59, 0x2f, 0x68, 0xe9, 0x6d, 0xe2, 0x18, 0x2b, 0x31, 0xe3,
+	0x27, 0xa8, 0x45, 0xc7, 0xfc, 0x77, 0xdb, 0xc4, 0xc7, 0x93, 0x3f, 0x7b, 0xc8, 0x76, 0x25, 0x3f,
+	0x3d, 0xde, 0x9c, 0x0d, 0x3c, 0x4c, 0x5b, 0x05, 0xfe, 0xef, 0xd1, 0xbd, 0xff, 0x07, 0x00, 0x9a,
+	0x15, 0x83, 0x3b, 0x5f, 0x0a, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -479,6 +602,9 @@ type QueryClient interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(ctx context.Context, in *QueryDenomsFromCreatorRequest, opts ...grpc.CallOption) (*QueryDenomsFromCreatorResponse, error)
+	// DenomSupplyInfo defines a gRPC query method for fetching the max supply
+	// and the pending mint schedule of a particular denom.
+	DenomSupplyInfo(ctx context.Context, in *QueryDenomSupplyInfoRequest, opts ...grpc.CallOption) (*QueryDenomSupplyInfoResponse, error)
 	// Retrieves the entire auction module's state
 	TokenfactoryModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error)
 }
@@ -518,6 +644,15 @@ func (c *queryClient) DenomsFromCreator(ctx context.Context, in *QueryDenomsFrom
 	return out, nil
 }
 
+func (c *queryClient) DenomSupplyInfo(ctx context.Context, in *QueryDenomSupplyInfoRequest, opts ...grpc.CallOption) (*QueryDenomSupplyInfoResponse, error) {
+	out := new(QueryDenomSupplyInfoResponse)
+	err := c.cc.Invoke(ctx, "/injective.tokenfactory.v1beta1.Query/DenomSupplyInfo", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *queryClient) TokenfactoryModuleState(ctx context.Context, in *QueryModuleStateRequest, opts ...grpc.CallOption) (*QueryModuleStateResponse, error) {
 	out := new(QueryModuleStateResponse)
 	err := c.cc.Invoke(ctx, "/injective.tokenfactory.v1beta1.Query/TokenfactoryModuleState", in, out, opts...)
@@ -538,6 +673,9 @@ type QueryServer interface {
 	// DenomsFromCreator defines a gRPC query method for fetching all
 	// denominations created by a specific admin/creator.
 	DenomsFromCreator(context.Context, *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error)
+	// DenomSupplyInfo defines a gRPC query method for fetching the max supply
+	// and the pending mint schedule of a particular denom.
+	DenomSupplyInfo(context.Context, *QueryDenomSupplyInfoRequest) (*QueryDenomSupplyInfoResponse, error)
 	// Retrieves the entire auction module's state
 	TokenfactoryModuleState(context.Context, *QueryModuleStateRequest) (*QueryModuleStateResponse, error)
 }
@@ -555,6 +693,9 @@ func (*UnimplementedQueryServer) DenomAuthorityMetadata(ctx context.Context, req
 func (*UnimplementedQueryServer) DenomsFromCreator(ctx context.Context, req *QueryDenomsFromCreatorRequest) (*QueryDenomsFromCreatorResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method DenomsFromCreator not implemented")
 }
+func (*UnimplementedQueryServer) DenomSupplyInfo(ctx context.Context, req *QueryDenomSupplyInfoRequest) (*QueryDenomSupplyInfoResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DenomSupplyInfo not implemented")
+}
 func (*UnimplementedQueryServer) TokenfactoryModuleState(ctx context.Context, req *QueryModuleStateRequest) (*QueryModuleStateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method TokenfactoryModuleState not implemented")
 }
@@ -617,6 +758,24 @@ func _Query_DenomsFromCreator_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_DenomSupplyInfo_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDenomSupplyInfoRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DenomSupplyInfo(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.tokenfactory.v1beta1.Query/DenomSupplyInfo",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DenomSupplyInfo(ctx, req.(*QueryDenomSupplyInfoRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 func _Query_TokenfactoryModuleState_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
 	in := new(QueryModuleStateRequest)
 	if err := dec(in); err != nil {
@@ -651,6 +810,10 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "DenomsFromCreator",
 			Handler:    _Query_DenomsFromCreator_Handler,
 		},
+		{
+			MethodName: "DenomSupplyInfo",
+			Handler:    _Query_DenomSupplyInfo_Handler,
+		},
 		{
 			MethodName: "TokenfactoryModuleState",
 			Handler:    _Query_TokenfactoryModuleState_Handler,
@@ -848,6 +1011,110 @@ func (m *QueryDenomsFromCreatorResponse) MarshalToSizedBuffer(dAtA []byte) (int,
 	return len(dAtA) - i, nil
 }
 
+func (m *QueryDenomSupplyInfoRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyInfoRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyInfoRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.SubDenom) > 0 {
+		i -= len(m.SubDenom)
+		copy(dAtA[i:], m.SubDenom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.SubDenom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Creator) > 0 {
+		i -= len(m.Creator)
+		copy(dAtA[i:], m.Creator)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Creator)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDenomSupplyInfoResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDenomSupplyInfoResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDenomSupplyInfoResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintSchedule) > 0 {
+		for iNdEx := len(m.MintSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.ScheduledSupply.Size()
+		i -= size
+		if _, err := m.ScheduledSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.Supply.Size()
+		i -= size
+		if _, err := m.Supply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
 func (m *QueryModuleStateRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -993,6 +1260,44 @@ func (m *QueryDenomsFromCreatorResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryDenomSupplyInfoRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Creator)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.SubDenom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryDenomSupplyInfoResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Supply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.ScheduledSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if len(m.MintSchedule) > 0 {
+		for _, e := range m.MintSchedule {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *QueryModuleStateRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -1515,6 +1820,306 @@ func (m *QueryDenomsFromCreatorResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryDenomSupplyInfoRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSupplyInfoRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSupplyInfoRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Creator", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Creator = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubDenom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SubDenom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDenomSupplyInfoResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDenomSupplyInfoResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDenomSupplyInfoResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Supply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Supply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ScheduledSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedule = append(m.MintSchedule, ScheduledMint{})
+			if err := m.MintSchedule[len(m.MintSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *QueryModuleStateRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
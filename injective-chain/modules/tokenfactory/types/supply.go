@@ -0,0 +1,53 @@
+package types
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// MaxScheduledMints is the maximum number of scheduled mints a denom can be created with
+const MaxScheduledMints = 100
+
+// HasMaxSupply returns true if the total supply of the denom is capped
+func (metadata DenomAuthorityMetadata) HasMaxSupply() bool {
+	return !metadata.MaxSupply.IsNil() && metadata.MaxSupply.IsPositive()
+}
+
+func (m ScheduledMint) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Recipient); err != nil {
+		return errors.Wrapf(ErrInvalidMintSchedule, "invalid recipient address (%s)", err)
+	}
+
+	if m.Amount.IsNil() || !m.Amount.IsPositive() {
+		return errors.Wrapf(ErrInvalidMintSchedule, "amount must be positive: %v", m.Amount)
+	}
+
+	if m.UnlockTime <= 0 {
+		return errors.Wrapf(ErrInvalidMintSchedule, "unlock time must be positive: %d", m.UnlockTime)
+	}
+
+	return nil
+}
+
+// ValidateMintSchedule validates the scheduled mints and checks that their total amount fits within the max supply,
+// if one is set
+func ValidateMintSchedule(schedule []ScheduledMint, maxSupply math.Int) error {
+	if len(schedule) > MaxScheduledMints {
+		return errors.Wrapf(ErrInvalidMintSchedule, "cannot have more than %d scheduled mints", MaxScheduledMints)
+	}
+
+	total := math.ZeroInt()
+	for _, scheduledMint := range schedule {
+		if err := scheduledMint.Validate(); err != nil {
+			return err
+		}
+		total = total.Add(scheduledMint.Amount)
+	}
+
+	if !maxSupply.IsNil() && maxSupply.IsPositive() && total.GT(maxSupply) {
+		return errors.Wrapf(ErrMaxSupplyExceeded, "scheduled mints total %v exceeds max supply %v", total, maxSupply)
+	}
+
+	return nil
+}
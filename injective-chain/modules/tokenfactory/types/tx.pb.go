@@ -5,6 +5,7 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
 	types "github.com/cosmos/cosmos-sdk/types"
@@ -55,6 +56,10 @@ type MsgCreateDenom struct {
 	Decimals uint32 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
 	// true if admins are allowed to burn tokens from other addresses
 	AllowAdminBurn bool `protobuf:"varint,6,opt,name=allow_admin_burn,json=allowAdminBurn,proto3" json:"allow_admin_burn,omitempty" yaml:"allow_admin_burn"`
+	// the hard cap on the total supply of the denom, zero for unlimited supply
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+	// amounts minted to the given recipients once their unlock times are reached
+	MintSchedule []ScheduledMint `protobuf:"bytes,8,rep,name=mint_schedule,json=mintSchedule,proto3" json:"mint_schedule" yaml:"mint_schedule"`
 }
 
 func (m *MsgCreateDenom) Reset()         { *m = MsgCreateDenom{} }
@@ -132,6 +137,13 @@ func (m *MsgCreateDenom) GetAllowAdminBurn() bool {
 	return false
 }
 
+func (m *MsgCreateDenom) GetMintSchedule() []ScheduledMint {
+	if m != nil {
+		return m.MintSchedule
+	}
+	return nil
+}
+
 // MsgCreateDenomResponse is the return value of MsgCreateDenom
 // It returns the full string of the newly created denom
 type MsgCreateDenomResponse struct {
@@ -722,6 +734,102 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetMaxSupply is the sdk.Msg type for allowing an admin account to set or
+// lower the max supply of a denom
+type MsgSetMaxSupply struct {
+	// The sender's Injective address
+	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty" yaml:"sender"`
+	// The denom
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	// The new max supply, must be lower than the current one if already set
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply" yaml:"max_supply"`
+}
+
+func (m *MsgSetMaxSupply) Reset()         { *m = MsgSetMaxSupply{} }
+func (m *MsgSetMaxSupply) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxSupply) ProtoMessage()    {}
+func (*MsgSetMaxSupply) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0b26fd7f19ce3c4, []int{12}
+}
+func (m *MsgSetMaxSupply) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxSupply) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxSupply.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxSupply) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxSupply.Merge(m, src)
+}
+func (m *MsgSetMaxSupply) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxSupply) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxSupply.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxSupply proto.InternalMessageInfo
+
+func (m *MsgSetMaxSupply) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetMaxSupply) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// MsgSetMaxSupplyResponse defines the response structure for an executed
+// MsgSetMaxSupply message.
+type MsgSetMaxSupplyResponse struct {
+}
+
+func (m *MsgSetMaxSupplyResponse) Reset()         { *m = MsgSetMaxSupplyResponse{} }
+func (m *MsgSetMaxSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetMaxSupplyResponse) ProtoMessage()    {}
+func (*MsgSetMaxSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b0b26fd7f19ce3c4, []int{13}
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetMaxSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetMaxSupplyResponse.Merge(m, src)
+}
+func (m *MsgSetMaxSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetMaxSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetMaxSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetMaxSupplyResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgCreateDenom)(nil), "injective.tokenfactory.v1beta1.MsgCreateDenom")
 	proto.RegisterType((*MsgCreateDenomResponse)(nil), "injective.tokenfactory.v1beta1.MsgCreateDenomResponse")
@@ -736,6 +844,8 @@ func init() {
 	proto.RegisterType((*MsgSetDenomMetadataResponse)(nil), "injective.tokenfactory.v1beta1.MsgSetDenomMetadataResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "injective.tokenfactory.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "injective.tokenfactory.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetMaxSupply)(nil), "injective.tokenfactory.v1beta1.MsgSetMaxSupply")
+	proto.RegisterType((*MsgSetMaxSupplyResponse)(nil), "injective.tokenfactory.v1beta1.MsgSetMaxSupplyResponse")
 }
 
 func init() {
@@ -743,74 +853,85 @@ func init() {
 }
 
 var fileDescriptor_b0b26fd7f19ce3c4 = []byte{
-	// 1063 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x56, 0xcf, 0x6f, 0xdc, 0x44,
-	0x14, 0x8e, 0x9b, 0x1f, 0x24, 0x93, 0xe6, 0x97, 0x93, 0x36, 0x1b, 0x47, 0x5d, 0x07, 0x17, 0xb5,
-	0x69, 0xaa, 0xb5, 0x95, 0x44, 0x6a, 0x45, 0xb8, 0x50, 0x37, 0x45, 0x20, 0x58, 0x09, 0xb9, 0xf4,
-	0x82, 0x90, 0x96, 0xd9, 0xf5, 0xd4, 0x31, 0x59, 0xcf, 0x44, 0x9e, 0x71, 0xd2, 0xdc, 0x10, 0xe2,
-	0xc4, 0x89, 0x0b, 0xff, 0x03, 0xc7, 0x1c, 0x38, 0xc3, 0x35, 0x12, 0x97, 0x8a, 0x13, 0x07, 0x64,
-	0xa1, 0xe4, 0x10, 0x71, 0xf5, 0x89, 0x23, 0x9a, 0x1f, 0xf6, 0x3a, 0xbb, 0x0d, 0xf5, 0xf6, 0xd2,
-	0x4b, 0xbb, 0x9e, 0xf7, 0x7d, 0xf3, 0xe6, 0x7d, 0xef, 0x7b, 0x33, 0x01, 0x77, 0x43, 0xfc, 0x0d,
-	0xea, 0xb0, 0xf0, 0x10, 0x39, 0x8c, 0xec, 0x23, 0xfc, 0x1c, 0x76, 0x18, 0x89, 0x8f, 0x9d, 0xc3,
-	0xcd, 0x36, 0x62, 0x70, 0xd3, 0x61, 0x2f, 0xec, 0x83, 0x98, 0x30, 0xa2, 0xd7, 0x0b, 0xa0, 0x5d,
-	0x06, 0xda, 0x0a, 0x68, 0x2c, 0x05, 0x24, 0x20, 0x02, 0xea, 0xf0, 0x5f, 0x92, 0x65, 0xd4, 0x3b,
-	0x84, 0x46, 0x84, 0x3a, 0x6d, 0x48, 0x51, 0xb1, 0x67, 0x87, 0x84, 0x78, 0x20, 0x8e, 0xf7, 0x8b,
-	0x38, 0xff, 0x50, 0xf1, 0x65, 0x15, 0x8f, 0x68, 0xe0, 0x1c, 0x6e, 0xf2, 0xff, 0x54, 0x60, 0x45,
-	0x06, 0x5a, 0x32, 0xa3, 0xfc, 0x50, 0xa1, 0xfb, 0xaf, 0x29, 0xe9, 0x00, 0xc6, 0x30, 0xca, 0xc1,
-	0x0b, 0x30, 0x0a, 0x31, 0x71, 0xc4, 0xbf, 0x72, 0xc9, 0xfa, 0xe7, 0x1a, 0x98, 0x6d, 0xd2, 0xe0,
-	0x71, 0x8c, 0x20, 0x43, 0xbb, 0x08, 0x93, 0x48, 0xbf, 0x07, 0x26, 0x28, 0xc2, 0x3e, 0x8a, 0x6b,
-	0xda, 0x9a, 0xb6, 0x3e, 0xe5, 0x2e, 0x64, 0xa9, 0x39, 0x73, 0x0c, 0xa3, 0xee, 0x8e, 0x25, 0xd7,
-	0x2d, 0x4f, 0x01, 0x74, 0x07, 0x4c, 0xd2, 0xa4, 0xed, 0x73, 0x5a, 0xed, 0x9a, 0x00, 0x2f, 0x66,
-	0xa9, 0x39, 0xa7, 0xc0, 0x2a, 0x62, 0x79, 0x05, 0x48, 0xbf, 0x0d, 0xc6, 0x30, 0x8c, 0x50, 0x6d,
-	0x54, 0x80, 0xe7, 0xb2, 0xd4, 0x9c, 0x96, 0x60, 0xbe, 0x6a, 0x79, 0x22, 0x28, 0x0e, 0x70, 0x1c,
-	0xb5, 0x49, 0xb7, 0x36, 0x36, 0x70, 0x00, 0xb1, 0xce, 0x0f, 0x20, 0x7e, 0xf0, 0x03, 0xf8, 0xa8,
-	0x13, 0x46, 0xb0, 0x4b, 0x6b, 0xe3, 0x6b, 0xda, 0xfa, 0x4c, 0xf9, 0x00, 0x79, 0xc4, 0xf2, 0x0a,
-	0x90, 0xfe, 0x04, 0xcc, 0xc3, 0x6e, 0x97, 0x1c, 0xb5, 0xa0, 0x1f, 0x85, 0xb8, 0xd5, 0x4e, 0x62,
-	0x5c, 0x9b, 0x58, 0xd3, 0xd6, 0x27, 0xdd, 0xd5, 0x2c, 0x35, 0x97, 0x25, 0xb1, 0x1f, 0x61, 0x79,
-	0xb3, 0x62, 0xe9, 0x11, 0x5f, 0x71, 0x93, 0x18, 0xef, 0x6c, 0x7f, 0x77, 0x71, 0xb2, 0xa1, 0x54,
-	0xf8, 0xe1, 0xe2, 0x64, 0xe3, 0xf6, 0x15, 0x6d, 0xe8, 0x08, 0x5d, 0x1b, 0x52, 0x87, 0xaf, 0xc0,
-	0xcd, 0xcb, 0x52, 0x7b, 0x88, 0x1e, 0x10, 0x4c, 0x91, 0xee, 0x82, 0x39, 0x8c, 0x8e, 0x5a, 0x82,
-	0xda, 0x92, 0x72, 0x4a, 0xed, 0x8d, 0x2c, 0x35, 0x6f, 0x2a, 0x85, 0x2e, 0x03, 0x2c, 0x6f, 0x06,
-	0xa3, 0xa3, 0x2f, 0xf8, 0x82, 0xd8, 0xcb, 0xfa, 0x4b, 0x03, 0xef, 0x34, 0x69, 0xd0, 0x0c, 0x31,
-	0x1b, 0xa6, 0x85, 0x1f, 0x83, 0x09, 0x18, 0x91, 0x04, 0x33, 0xd1, 0xc0, 0xe9, 0xad, 0x15, 0x5b,
-	0xf9, 0x8b, 0xbb, 0x38, 0x37, 0xbc, 0xfd, 0x98, 0x84, 0xd8, 0xbd, 0x71, 0x9a, 0x9a, 0x23, 0xbd,
-	0x9d, 0x24, 0xcd, 0xf2, 0x14, 0x9f, 0xf7, 0x22, 0x46, 0x1d, 0x14, 0x1e, 0xa2, 0x58, 0xf5, 0xb7,
-	0xd4, 0x8b, 0x3c, 0x62, 0x79, 0x05, 0x68, 0xe7, 0x7e, 0x9f, 0x88, 0xab, 0x57, 0x88, 0x18, 0x85,
-	0x98, 0x59, 0x0b, 0x60, 0x4e, 0x55, 0x97, 0xab, 0x66, 0xfd, 0x2b, 0x2b, 0xe6, 0x0d, 0x79, 0x3b,
-	0x15, 0x7f, 0x0a, 0xe6, 0xb8, 0x3d, 0x3e, 0x8a, 0x49, 0xf4, 0xc8, 0xf7, 0x63, 0x44, 0xa9, 0x2a,
-	0xfc, 0xdd, 0x2c, 0x35, 0x6b, 0x92, 0xc3, 0x01, 0xad, 0xe7, 0x31, 0x89, 0x5a, 0x50, 0x42, 0xac,
-	0x9f, 0x2f, 0x4e, 0x36, 0x34, 0xaf, 0x9f, 0x59, 0x59, 0x0d, 0x61, 0x48, 0xa9, 0x06, 0xaf, 0xbc,
-	0x50, 0xe3, 0x77, 0x4d, 0x4e, 0xf2, 0x1e, 0xc4, 0x01, 0x12, 0x4e, 0x1d, 0x46, 0x94, 0x3b, 0x60,
-	0xbc, 0x3c, 0xc6, 0xf3, 0x59, 0x6a, 0x5e, 0xcf, 0xa7, 0x48, 0xb8, 0x4d, 0x86, 0xf5, 0x4d, 0x30,
-	0xc5, 0x8d, 0x28, 0x66, 0x43, 0x15, 0xbb, 0x94, 0xa5, 0xe6, 0x7c, 0xcf, 0xa3, 0x22, 0x64, 0x79,
-	0x93, 0x18, 0xc9, 0x79, 0xa9, 0x3e, 0x2b, 0xe2, 0xe4, 0x0d, 0xc9, 0xaf, 0xc9, 0x59, 0xe9, 0x15,
-	0x53, 0xd4, 0xf9, 0xeb, 0x28, 0x58, 0x6c, 0xd2, 0xe0, 0x29, 0x62, 0xc2, 0xf7, 0x4d, 0xc4, 0xa0,
-	0x0f, 0x19, 0x1c, 0xa6, 0x58, 0x0f, 0x4c, 0x46, 0x8a, 0xa6, 0x3c, 0x70, 0xab, 0xe7, 0x01, 0xbc,
-	0x5f, 0x78, 0x20, 0xdf, 0xdb, 0x5d, 0x56, 0x3e, 0x50, 0x66, 0xce, 0xc9, 0x96, 0x57, 0xec, 0xa3,
-	0xff, 0xa4, 0x81, 0xc5, 0xde, 0x8d, 0xd1, 0xf2, 0x43, 0x0a, 0xdb, 0x5d, 0xe4, 0x0b, 0x8d, 0xa6,
-	0xb7, 0x9e, 0xd8, 0xff, 0xff, 0xa2, 0xd8, 0xaf, 0xa8, 0xc8, 0x2e, 0xae, 0x9b, 0x5d, 0xb5, 0x99,
-	0x5b, 0xcf, 0x52, 0xd3, 0x50, 0x5e, 0x1c, 0xcc, 0x65, 0x79, 0x0b, 0xb0, 0x9f, 0x62, 0x3c, 0x03,
-	0x0b, 0x03, 0xfb, 0xe8, 0x1f, 0x82, 0x59, 0xba, 0x47, 0x92, 0xae, 0x9f, 0x73, 0x85, 0x66, 0x93,
-	0xee, 0x4a, 0x96, 0x9a, 0x37, 0x94, 0x66, 0x97, 0xe2, 0x96, 0x37, 0x23, 0x17, 0xd4, 0x16, 0x3b,
-	0xef, 0xf7, 0x35, 0xf5, 0xde, 0x15, 0x4d, 0xa5, 0x88, 0xc9, 0xdb, 0xaf, 0x51, 0x88, 0x76, 0x0b,
-	0xac, 0xbe, 0xa2, 0xda, 0xa2, 0xbf, 0xa7, 0x9a, 0xf0, 0xf6, 0xb3, 0x03, 0x1f, 0x32, 0xf4, 0xb9,
-	0x78, 0xbe, 0xf4, 0x07, 0x60, 0x0a, 0x26, 0x6c, 0x8f, 0xc4, 0x21, 0x3b, 0x56, 0xed, 0xad, 0xfd,
-	0xf1, 0x4b, 0x63, 0x49, 0x35, 0x4d, 0x8d, 0xd0, 0x53, 0x16, 0x87, 0x38, 0xf0, 0x7a, 0x50, 0x7d,
-	0x17, 0x4c, 0xc8, 0x07, 0x50, 0xb5, 0xf9, 0xce, 0xeb, 0xda, 0x20, 0xf3, 0xb9, 0x63, 0xbc, 0xdf,
-	0x9e, 0xe2, 0xee, 0x3c, 0xe4, 0xb5, 0xf6, 0x76, 0xe5, 0xe5, 0xbe, 0x77, 0x45, 0xb9, 0x89, 0x38,
-	0x75, 0x43, 0x12, 0xad, 0x15, 0xb0, 0xdc, 0x57, 0x49, 0x5e, 0xe5, 0xd6, 0x6f, 0xe3, 0x60, 0xb4,
-	0x49, 0x03, 0x3d, 0x01, 0xd3, 0xe5, 0xb7, 0xd7, 0xae, 0xe0, 0x93, 0x12, 0xde, 0x78, 0x30, 0x1c,
-	0xbe, 0x78, 0x70, 0xbe, 0x06, 0x63, 0xe2, 0xa1, 0xb8, 0x5b, 0x81, 0xcf, 0x81, 0x86, 0x53, 0x11,
-	0x58, 0xce, 0x20, 0x2e, 0xe6, 0x2a, 0x19, 0x38, 0xb0, 0x52, 0x86, 0xf2, 0x85, 0x27, 0xa4, 0x2b,
-	0x5d, 0x76, 0x95, 0xa4, 0xeb, 0xe1, 0xab, 0x49, 0x37, 0x78, 0xff, 0xe8, 0xdf, 0x6b, 0x60, 0x7e,
-	0xe0, 0xf2, 0xd9, 0x7e, 0x83, 0xf9, 0x36, 0x3e, 0x78, 0x03, 0x52, 0x71, 0x8c, 0x17, 0xe0, 0xfa,
-	0xa5, 0x11, 0xa9, 0x22, 0x5f, 0x99, 0x60, 0x3c, 0x1c, 0x92, 0x90, 0x67, 0x36, 0xc6, 0xbf, 0xe5,
-	0x0f, 0x98, 0xdb, 0x3d, 0x3d, 0xab, 0x6b, 0x2f, 0xcf, 0xea, 0xda, 0xdf, 0x67, 0x75, 0xed, 0xc7,
-	0xf3, 0xfa, 0xc8, 0xcb, 0xf3, 0xfa, 0xc8, 0x9f, 0xe7, 0xf5, 0x91, 0x2f, 0xbd, 0x20, 0x64, 0x7b,
-	0x49, 0xdb, 0xee, 0x90, 0xc8, 0xf9, 0x24, 0xcf, 0xf1, 0x19, 0x6c, 0x53, 0xa7, 0xc8, 0xd8, 0xe8,
-	0x90, 0x18, 0x95, 0x3f, 0xf7, 0x60, 0x88, 0x9d, 0x88, 0xf8, 0x49, 0x17, 0xd1, 0xcb, 0x23, 0xc5,
-	0x8e, 0x0f, 0x10, 0x6d, 0x4f, 0x88, 0x3f, 0x57, 0xb7, 0xff, 0x0b, 0x00, 0x00, 0xff, 0xff, 0xaa,
-	0x21, 0x43, 0x44, 0xc3, 0x0b, 0x00, 0x00,
+	// 1233 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x57, 0xcf, 0x6f, 0x1b, 0xc5,
+	0x17, 0xcf, 0x7e, 0x93, 0xba, 0xce, 0xa4, 0x69, 0xe2, 0x6d, 0xda, 0x38, 0xdb, 0x6f, 0xbd, 0x61,
+	0x0b, 0x6d, 0xda, 0xca, 0x5e, 0x25, 0x11, 0x89, 0x30, 0x97, 0xd6, 0x4d, 0x11, 0x11, 0x58, 0x42,
+	0x1b, 0x7a, 0x41, 0x48, 0x66, 0xec, 0x9d, 0xda, 0x4b, 0xbc, 0x33, 0xd6, 0xce, 0x38, 0x89, 0x6f,
+	0x08, 0x71, 0xe2, 0xc4, 0x85, 0xff, 0x81, 0x63, 0x0e, 0xbd, 0x20, 0x21, 0xce, 0x91, 0xb8, 0x54,
+	0x3d, 0x21, 0x84, 0x56, 0x28, 0x39, 0xe4, 0xbe, 0x27, 0x0e, 0x1c, 0xd0, 0xfc, 0xd8, 0xf5, 0xda,
+	0x69, 0x1a, 0xbb, 0x02, 0x71, 0x49, 0x76, 0xe7, 0x7d, 0x3e, 0xef, 0xcd, 0x7b, 0xef, 0x33, 0x6f,
+	0xd6, 0xe0, 0xae, 0x87, 0xbf, 0x44, 0x0d, 0xe6, 0xed, 0x21, 0x9b, 0x91, 0x5d, 0x84, 0x9f, 0xc1,
+	0x06, 0x23, 0x41, 0xcf, 0xde, 0x5b, 0xad, 0x23, 0x06, 0x57, 0x6d, 0x76, 0x50, 0xea, 0x04, 0x84,
+	0x11, 0xbd, 0x90, 0x00, 0x4b, 0x69, 0x60, 0x49, 0x01, 0x8d, 0x85, 0x26, 0x69, 0x12, 0x01, 0xb5,
+	0xf9, 0x93, 0x64, 0x19, 0x85, 0x06, 0xa1, 0x3e, 0xa1, 0x76, 0x1d, 0x52, 0x94, 0xf8, 0x6c, 0x10,
+	0x0f, 0x9f, 0xb1, 0xe3, 0xdd, 0xc4, 0xce, 0x5f, 0x94, 0x7d, 0x51, 0xd9, 0x7d, 0xda, 0xb4, 0xf7,
+	0x56, 0xf9, 0x3f, 0x65, 0x58, 0x92, 0x86, 0x9a, 0x8c, 0x28, 0x5f, 0x94, 0xe9, 0xc1, 0x05, 0x29,
+	0x75, 0x60, 0x00, 0xfd, 0x18, 0xbc, 0x71, 0x01, 0x18, 0x76, 0x59, 0x8b, 0x04, 0x1e, 0xeb, 0x55,
+	0x11, 0x83, 0x2e, 0x64, 0x50, 0xf1, 0x72, 0xd0, 0xf7, 0x30, 0xb1, 0xc5, 0x5f, 0xb9, 0x64, 0xfd,
+	0x34, 0x05, 0xae, 0x56, 0x69, 0xf3, 0x71, 0x80, 0x20, 0x43, 0x5b, 0x08, 0x13, 0x5f, 0xbf, 0x07,
+	0x32, 0x14, 0x61, 0x17, 0x05, 0x79, 0x6d, 0x59, 0x5b, 0x99, 0xae, 0xe4, 0xa2, 0xd0, 0x9c, 0xed,
+	0x41, 0xbf, 0x5d, 0xb6, 0xe4, 0xba, 0xe5, 0x28, 0x80, 0x6e, 0x83, 0x2c, 0xed, 0xd6, 0x5d, 0x4e,
+	0xcb, 0xff, 0x4f, 0x80, 0xaf, 0x45, 0xa1, 0x39, 0xa7, 0xc0, 0xca, 0x62, 0x39, 0x09, 0x48, 0xbf,
+	0x0d, 0xa6, 0x30, 0xf4, 0x51, 0x7e, 0x52, 0x80, 0xe7, 0xa2, 0xd0, 0x9c, 0x91, 0x60, 0xbe, 0x6a,
+	0x39, 0xc2, 0x28, 0x36, 0xd0, 0xf3, 0xeb, 0xa4, 0x9d, 0x9f, 0x3a, 0xb3, 0x01, 0xb1, 0xce, 0x37,
+	0x20, 0x1e, 0xf8, 0x06, 0x5c, 0xd4, 0xf0, 0x7c, 0xd8, 0xa6, 0xf9, 0x4b, 0xcb, 0xda, 0xca, 0x6c,
+	0x7a, 0x03, 0xb1, 0xc5, 0x72, 0x12, 0x90, 0xfe, 0x04, 0xcc, 0xc3, 0x76, 0x9b, 0xec, 0xd7, 0xa0,
+	0xeb, 0x7b, 0xb8, 0x56, 0xef, 0x06, 0x38, 0x9f, 0x59, 0xd6, 0x56, 0xb2, 0x95, 0x9b, 0x51, 0x68,
+	0x2e, 0x4a, 0xe2, 0x30, 0xc2, 0x72, 0xae, 0x8a, 0xa5, 0x47, 0x7c, 0xa5, 0xd2, 0x0d, 0xb0, 0x5e,
+	0x03, 0xc0, 0x87, 0x07, 0x35, 0xda, 0xed, 0x74, 0xda, 0xbd, 0xfc, 0x65, 0xb1, 0xcd, 0x87, 0x47,
+	0xa1, 0x39, 0xf1, 0x5b, 0x68, 0x5e, 0x97, 0x8d, 0xa5, 0xee, 0x6e, 0xc9, 0x23, 0xb6, 0x0f, 0x59,
+	0xab, 0xb4, 0x8d, 0x59, 0x14, 0x9a, 0x39, 0xe9, 0xbd, 0x4f, 0xb4, 0x5e, 0x3e, 0x2f, 0x02, 0x25,
+	0x83, 0x6d, 0xcc, 0x9c, 0x69, 0x1f, 0x1e, 0xec, 0x08, 0x8b, 0xde, 0x01, 0xb3, 0xbe, 0x87, 0x59,
+	0x8d, 0x36, 0x5a, 0xc8, 0xed, 0xb6, 0x51, 0x3e, 0xbb, 0x3c, 0xb9, 0x32, 0xb3, 0x56, 0x2c, 0xbd,
+	0x5e, 0xd1, 0xa5, 0x1d, 0x85, 0x77, 0xab, 0x1e, 0x66, 0x95, 0xff, 0xf3, 0x2d, 0x45, 0xa1, 0xb9,
+	0xa0, 0x22, 0xa7, 0x3d, 0x5a, 0xce, 0x15, 0xfe, 0x1e, 0x13, 0xca, 0xeb, 0x5f, 0x9f, 0x1e, 0xde,
+	0x57, 0x8d, 0xfd, 0xf6, 0xf4, 0xf0, 0xfe, 0xed, 0x73, 0x44, 0xd6, 0x10, 0x52, 0x29, 0xca, 0xd6,
+	0x7e, 0x0e, 0x6e, 0x0c, 0xaa, 0xc7, 0x41, 0xb4, 0x43, 0x30, 0x45, 0x7a, 0x05, 0xcc, 0x61, 0xb4,
+	0x5f, 0x13, 0xd4, 0x9a, 0x54, 0x88, 0x94, 0x93, 0x11, 0x85, 0xe6, 0x0d, 0xd5, 0xf4, 0x41, 0x80,
+	0xe5, 0xcc, 0x62, 0xb4, 0xff, 0x29, 0x5f, 0x10, 0xbe, 0xac, 0xdf, 0x35, 0x70, 0xb9, 0x4a, 0x9b,
+	0x3c, 0x95, 0x71, 0x54, 0xf9, 0x21, 0xc8, 0x40, 0x9f, 0x74, 0x31, 0x13, 0x9a, 0x9c, 0x59, 0x5b,
+	0x2a, 0xa9, 0x1a, 0xf3, 0x03, 0x9d, 0x54, 0xea, 0x31, 0xf1, 0x70, 0xe5, 0xba, 0x2a, 0x90, 0xf2,
+	0x24, 0x69, 0x96, 0xa3, 0xf8, 0x5c, 0x5e, 0x01, 0x6a, 0x20, 0x6f, 0x0f, 0x05, 0x4a, 0xb2, 0x29,
+	0x79, 0xc5, 0x16, 0xcb, 0x49, 0x40, 0xe5, 0x07, 0x43, 0x45, 0xbc, 0x79, 0x4e, 0x11, 0x79, 0xe5,
+	0xad, 0x1c, 0x98, 0x53, 0xd9, 0xc5, 0x55, 0xb3, 0xfe, 0x94, 0x19, 0x0b, 0x8d, 0xfd, 0x27, 0x19,
+	0x7f, 0x04, 0xe6, 0xb8, 0xe2, 0x3f, 0x08, 0x88, 0xff, 0xc8, 0x75, 0x03, 0x44, 0xa9, 0x4a, 0xfc,
+	0xad, 0x28, 0x34, 0xf3, 0x92, 0xc3, 0x01, 0xb5, 0x67, 0x01, 0xf1, 0x6b, 0x50, 0x42, 0xac, 0x1f,
+	0x4e, 0x0f, 0xef, 0x6b, 0xce, 0x30, 0x73, 0xe4, 0x6a, 0x88, 0x33, 0x26, 0xab, 0xc1, 0x33, 0x4f,
+	0xaa, 0xf1, 0x8b, 0x26, 0x87, 0x53, 0x0b, 0xe2, 0x26, 0x12, 0x87, 0x6f, 0x9c, 0xa2, 0xdc, 0x01,
+	0x97, 0xd2, 0x93, 0x69, 0x3e, 0x0a, 0xcd, 0x2b, 0xf1, 0x60, 0x10, 0x6a, 0x93, 0x66, 0x7d, 0x15,
+	0x4c, 0x63, 0xa4, 0x8e, 0xbb, 0x4a, 0x76, 0x21, 0x0a, 0xcd, 0xf9, 0xbe, 0x46, 0x85, 0xc9, 0x72,
+	0xb2, 0x18, 0xc9, 0x11, 0x30, 0xfa, 0x59, 0x11, 0x3b, 0x2f, 0x4a, 0x7e, 0x5e, 0x9e, 0x95, 0x7e,
+	0x32, 0x49, 0x9e, 0x3f, 0x4f, 0x82, 0x6b, 0x55, 0xda, 0xdc, 0x41, 0x4c, 0xe8, 0x3e, 0x9e, 0xda,
+	0xe3, 0x24, 0xeb, 0x80, 0xac, 0xaf, 0x68, 0x4a, 0x03, 0xb7, 0xfa, 0x1a, 0xc0, 0xbb, 0x89, 0x06,
+	0x62, 0xdf, 0x95, 0x45, 0xa5, 0x03, 0x25, 0xe6, 0x98, 0x6c, 0x39, 0x89, 0x1f, 0xfd, 0x7b, 0x0d,
+	0x5c, 0xeb, 0x0f, 0xc1, 0x9a, 0xeb, 0x51, 0x58, 0x6f, 0x23, 0x57, 0xd4, 0x68, 0x66, 0xed, 0xc9,
+	0x45, 0xa3, 0xe8, 0x15, 0x19, 0x95, 0x92, 0x09, 0xba, 0xa5, 0x9c, 0x55, 0x0a, 0x51, 0x68, 0x1a,
+	0x4a, 0x8b, 0x67, 0x63, 0x59, 0x4e, 0x0e, 0x0e, 0x53, 0x8c, 0xa7, 0x20, 0x77, 0xc6, 0x8f, 0xfe,
+	0x10, 0x5c, 0xa5, 0x2d, 0xd2, 0x6d, 0xbb, 0x31, 0x57, 0xd4, 0x2c, 0x5b, 0x59, 0x8a, 0x42, 0xf3,
+	0xba, 0xaa, 0xd9, 0x80, 0xdd, 0x72, 0x66, 0xe5, 0x82, 0x72, 0x51, 0x7e, 0x6f, 0xa8, 0xa9, 0xf7,
+	0xce, 0x69, 0x2a, 0x45, 0x4c, 0x4e, 0xbf, 0x62, 0x52, 0xb4, 0x5b, 0xe0, 0xe6, 0x2b, 0xb2, 0x4d,
+	0xfa, 0x7b, 0xa4, 0x09, 0x6d, 0x3f, 0xed, 0xb8, 0x90, 0xa1, 0x4f, 0xc4, 0x4d, 0xae, 0x6f, 0x80,
+	0xe9, 0xe4, 0x9a, 0x56, 0xed, 0xcd, 0xbf, 0x7c, 0x5e, 0x5c, 0x50, 0x4d, 0x53, 0x47, 0x68, 0x87,
+	0x05, 0x1e, 0x6e, 0x3a, 0x7d, 0xa8, 0xbe, 0x05, 0x32, 0xf2, 0x5b, 0x40, 0xb5, 0xf9, 0xce, 0x45,
+	0x6d, 0x90, 0xf1, 0x2a, 0x53, 0xbc, 0xdf, 0x8e, 0xe2, 0x96, 0x37, 0x79, 0xae, 0x7d, 0xaf, 0x3c,
+	0xdd, 0xb7, 0xcf, 0x49, 0xb7, 0x2b, 0x76, 0x5d, 0x94, 0x44, 0x6b, 0x09, 0x2c, 0x0e, 0x65, 0x92,
+	0x64, 0xf9, 0x97, 0xcc, 0x72, 0x07, 0xb1, 0x6a, 0x72, 0x8d, 0xfd, 0x0b, 0xc7, 0x75, 0xf0, 0xea,
+	0x9d, 0xfc, 0xc7, 0xaf, 0xde, 0xf2, 0xbb, 0x43, 0x3a, 0x78, 0xe7, 0x35, 0x3a, 0xf0, 0xe1, 0x41,
+	0x51, 0x39, 0x94, 0x95, 0x49, 0x67, 0x1f, 0x57, 0x66, 0xed, 0xc7, 0x0c, 0x98, 0xac, 0xd2, 0xa6,
+	0xde, 0x05, 0x33, 0xe9, 0x0f, 0xad, 0xd2, 0x08, 0x27, 0x28, 0x85, 0x37, 0x36, 0xc6, 0xc3, 0x27,
+	0x57, 0xf1, 0x17, 0x60, 0x4a, 0x5c, 0xa1, 0x77, 0x47, 0xe0, 0x73, 0xa0, 0x61, 0x8f, 0x08, 0x4c,
+	0x47, 0x10, 0x57, 0xd6, 0x28, 0x11, 0x38, 0xd0, 0xb0, 0x47, 0x04, 0x26, 0x11, 0x78, 0xe9, 0x52,
+	0xd7, 0xc0, 0x48, 0xa5, 0xeb, 0xe3, 0x8d, 0x8d, 0xf1, 0xf0, 0x49, 0xd8, 0x6f, 0x34, 0x30, 0x7f,
+	0x66, 0x2c, 0xaf, 0xbf, 0xc1, 0xe4, 0x33, 0xde, 0x7f, 0x03, 0x52, 0xb2, 0x8d, 0x03, 0x70, 0x65,
+	0x60, 0x78, 0x8c, 0x52, 0xbe, 0x34, 0xc1, 0xd8, 0x1c, 0x93, 0x90, 0x8e, 0x3c, 0x70, 0xa0, 0xed,
+	0xd1, 0xd2, 0x48, 0x08, 0xc6, 0xe6, 0x98, 0x84, 0x38, 0xb2, 0x71, 0xe9, 0x2b, 0xfe, 0x51, 0x51,
+	0x69, 0x1f, 0x1d, 0x17, 0xb4, 0x17, 0xc7, 0x05, 0xed, 0x8f, 0xe3, 0x82, 0xf6, 0xdd, 0x49, 0x61,
+	0xe2, 0xc5, 0x49, 0x61, 0xe2, 0xd7, 0x93, 0xc2, 0xc4, 0x67, 0x4e, 0xd3, 0x63, 0xad, 0x6e, 0xbd,
+	0xd4, 0x20, 0xbe, 0xbd, 0x1d, 0xc7, 0xf8, 0x18, 0xd6, 0xa9, 0x9d, 0x44, 0x2c, 0x36, 0x48, 0x80,
+	0xd2, 0xaf, 0x2d, 0xe8, 0x61, 0xdb, 0x27, 0xfc, 0x83, 0x97, 0x0e, 0x9e, 0x66, 0xd6, 0xeb, 0x20,
+	0x5a, 0xcf, 0x88, 0x5f, 0x45, 0xeb, 0x7f, 0x0f, 0x00, 0x0f, 0x94, 0xe0, 0x5a, 0x62, 0x0e, 0x00,
+	0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -831,6 +952,7 @@ type MsgClient interface {
 	ChangeAdmin(ctx context.Context, in *MsgChangeAdmin, opts ...grpc.CallOption) (*MsgChangeAdminResponse, error)
 	SetDenomMetadata(ctx context.Context, in *MsgSetDenomMetadata, opts ...grpc.CallOption) (*MsgSetDenomMetadataResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	SetMaxSupply(ctx context.Context, in *MsgSetMaxSupply, opts ...grpc.CallOption) (*MsgSetMaxSupplyResponse, error)
 }
 
 type msgClient struct {
@@ -895,6 +1017,15 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetMaxSupply(ctx context.Context, in *MsgSetMaxSupply, opts ...grpc.CallOption) (*MsgSetMaxSupplyResponse, error) {
+	out := new(MsgSetMaxSupplyResponse)
+	err := c.cc.Invoke(ctx, "/injective.tokenfactory.v1beta1.Msg/SetMaxSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	CreateDenom(context.Context, *MsgCreateDenom) (*MsgCreateDenomResponse, error)
@@ -903,6 +1034,7 @@ type MsgServer interface {
 	ChangeAdmin(context.Context, *MsgChangeAdmin) (*MsgChangeAdminResponse, error)
 	SetDenomMetadata(context.Context, *MsgSetDenomMetadata) (*MsgSetDenomMetadataResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	SetMaxSupply(context.Context, *MsgSetMaxSupply) (*MsgSetMaxSupplyResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -927,6 +1059,9 @@ func (*UnimplementedMsgServer) SetDenomMetadata(ctx context.Context, req *MsgSet
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetMaxSupply(ctx context.Context, req *MsgSetMaxSupply) (*MsgSetMaxSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetMaxSupply not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1040,6 +1175,24 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetMaxSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetMaxSupply)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetMaxSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.tokenfactory.v1beta1.Msg/SetMaxSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetMaxSupply(ctx, req.(*MsgSetMaxSupply))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.tokenfactory.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1068,6 +1221,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetMaxSupply",
+			Handler:    _Msg_SetMaxSupply_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/tokenfactory/v1beta1/tx.proto",
@@ -1093,6 +1250,30 @@ func (m *MsgCreateDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.MintSchedule) > 0 {
+		for iNdEx := len(m.MintSchedule) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintSchedule[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x42
+		}
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
 	if m.AllowAdminBurn {
 		i--
 		if m.AllowAdminBurn {
@@ -1547,6 +1728,76 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSetMaxSupply) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxSupply) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxSupply) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetMaxSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetMaxSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetMaxSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTx(v)
 	base := offset
@@ -1586,6 +1837,14 @@ func (m *MsgCreateDenom) Size() (n int) {
 	if m.AllowAdminBurn {
 		n += 2
 	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if len(m.MintSchedule) > 0 {
+		for _, e := range m.MintSchedule {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -1752,6 +2011,34 @@ func (m *MsgUpdateParamsResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSetMaxSupply) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgSetMaxSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1954,6 +2241,74 @@ func (m *MsgCreateDenom) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.AllowAdminBurn = bool(v != 0)
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSchedule", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintSchedule = append(m.MintSchedule, ScheduledMint{})
+			if err := m.MintSchedule[len(m.MintSchedule)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -3083,6 +3438,204 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetMaxSupply) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxSupply: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxSupply: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetMaxSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetMaxSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetMaxSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
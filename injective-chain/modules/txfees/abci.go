@@ -33,6 +33,7 @@ func (h *BlockHandler) BeginBlocker(ctx sdk.Context) error {
 		h.keeper.Logger(ctx).Error("BeginBlocker: failed to check and set target gas", "error", err)
 		return err
 	}
+	h.keeper.BeginBlockBaseFee(ctx)
 
 	// Store current base fee in event
 	ctx.EventManager().EmitEvents(sdk.Events{
@@ -49,5 +50,6 @@ func (h *BlockHandler) EndBlocker(ctx sdk.Context) {
 	ctx, doneFn := metrics.ReportFuncCallAndTimingSdkCtx(ctx, h.svcTags)
 	defer doneFn()
 
-	h.keeper.CurFeeState.UpdateBaseFee(h.keeper.Logger(ctx), ctx.BlockHeight())
+	h.keeper.EndBlockBaseFee(ctx)
+	h.keeper.ProcessCollectedFees(ctx)
 }
@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/spf13/cobra"
@@ -18,6 +19,8 @@ func GetQueryCmd() *cobra.Command {
 	cmd.AddCommand(
 		GetParams(),
 		GetCmdQueryBaseFee(),
+		GetCmdQueryBaseFeeHistory(),
+		GetCmdQueryFeeDenomPrice(),
 	)
 
 	return cmd
@@ -75,3 +78,80 @@ func GetCmdQueryBaseFee() *cobra.Command {
 	cliflags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdQueryBaseFeeHistory queries the consensus base fees of past blocks
+func GetCmdQueryBaseFeeHistory() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "base-fee-history [block-count] [last-height]",
+		Short: "Query the base fee history",
+		Long:  "Gets the consensus base fees of the last block-count blocks up to last-height (defaults to the latest block)",
+		Args:  cobra.MaximumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryBaseFeeHistoryRequest{}
+			if len(args) > 0 {
+				req.BlockCount, err = strconv.ParseUint(args[0], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+			if len(args) > 1 {
+				req.LastHeight, err = strconv.ParseInt(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.BaseFeeHistory(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdQueryFeeDenomPrice queries the INJ price of a fee denom
+func GetCmdQueryFeeDenomPrice() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "fee-denom-price <denom> [gas]",
+		Short:   "Query the INJ price of a fee denom",
+		Long:    "Gets the price of one whole unit of a fee denom in INJ and the fee required in the denom for the given gas",
+		Example: "injectived query txfees fee-denom-price peggy0xdAC17F958D2ee523a2206206994597C13D831ec7 200000",
+		Args:    cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			queryClient := types.NewQueryClient(clientCtx)
+
+			req := &types.QueryFeeDenomPriceRequest{
+				Denom: args[0],
+			}
+			if len(args) > 1 {
+				req.Gas, err = strconv.ParseUint(args[1], 10, 64)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.FeeDenomPrice(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cliflags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
@@ -0,0 +1,155 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/types"
+)
+
+// GetBaseFee returns the consensus base fee of the current block, or the default base fee if none was set yet
+func (k *Keeper) GetBaseFee(ctx sdk.Context) math.LegacyDec {
+	bz := ctx.KVStore(k.storeKey).Get(types.BaseFeeKey)
+	if bz == nil {
+		params := k.GetParams(ctx)
+		return params.MinGasPrice.Mul(params.DefaultBaseFeeMultiplier)
+	}
+
+	var baseFee math.LegacyDec
+	if err := baseFee.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+
+	return baseFee
+}
+
+func (k *Keeper) SetBaseFee(ctx sdk.Context, baseFee math.LegacyDec) {
+	bz, err := baseFee.Marshal()
+	if err != nil {
+		panic(err)
+	}
+
+	ctx.KVStore(k.storeKey).Set(types.BaseFeeKey, bz)
+}
+
+// GetBlockFee returns the base fee record of the block at the given height, if it is still in the history
+func (k *Keeper) GetBlockFee(ctx sdk.Context, height int64) (types.BlockFee, bool) {
+	bz := ctx.KVStore(k.storeKey).Get(types.GetBlockFeeKey(height))
+	if bz == nil {
+		return types.BlockFee{}, false
+	}
+
+	var blockFee types.BlockFee
+	k.cdc.MustUnmarshal(bz, &blockFee)
+
+	return blockFee, true
+}
+
+func (k *Keeper) SetBlockFee(ctx sdk.Context, blockFee types.BlockFee) {
+	ctx.KVStore(k.storeKey).Set(types.GetBlockFeeKey(blockFee.Height), k.cdc.MustMarshal(&blockFee))
+}
+
+// GetBlockFees returns the base fee records between the given heights (inclusive), in ascending height order
+func (k *Keeper) GetBlockFees(ctx sdk.Context, fromHeight, toHeight int64) []types.BlockFee {
+	blockFees := make([]types.BlockFee, 0)
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockFeePrefixKey)
+	iter := store.Iterator(sdk.Uint64ToBigEndian(uint64(fromHeight)), sdk.Uint64ToBigEndian(uint64(toHeight)+1))
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var blockFee types.BlockFee
+		k.cdc.MustUnmarshal(iter.Value(), &blockFee)
+		blockFees = append(blockFees, blockFee)
+	}
+
+	return blockFees
+}
+
+// GetAllBlockFees returns the whole base fee history, in ascending height order
+func (k *Keeper) GetAllBlockFees(ctx sdk.Context) []types.BlockFee {
+	blockFees := make([]types.BlockFee, 0)
+
+	iter := storetypes.KVStorePrefixIterator(ctx.KVStore(k.storeKey), types.BlockFeePrefixKey)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		var blockFee types.BlockFee
+		k.cdc.MustUnmarshal(iter.Value(), &blockFee)
+		blockFees = append(blockFees, blockFee)
+	}
+
+	return blockFees
+}
+
+// pruneBlockFees deletes the base fee records older than the history length. Records left over after the history
+// length is lowered are pruned in one of the next blocks, a few at a time.
+func (k *Keeper) pruneBlockFees(ctx sdk.Context, height int64, historyLength uint64) {
+	const maxBlockFeesPrunedPerBlock = 100
+
+	oldestHeight := height - int64(historyLength) + 1
+	if oldestHeight <= 0 {
+		return
+	}
+
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.BlockFeePrefixKey)
+	iter := store.Iterator(nil, sdk.Uint64ToBigEndian(uint64(oldestHeight)))
+
+	keys := make([][]byte, 0)
+	for ; iter.Valid() && len(keys) < maxBlockFeesPrunedPerBlock; iter.Next() {
+		keys = append(keys, iter.Key())
+	}
+	iter.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+}
+
+// BeginBlockBaseFee resets the consensus base fee to the default base fee every reset interval and syncs the
+// mempool fee state with the consensus base fee
+func (k *Keeper) BeginBlockBaseFee(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	baseFee := k.GetBaseFee(ctx)
+
+	if ctx.BlockHeight()%params.ResetInterval == 0 {
+		baseFee = params.MinGasPrice.Mul(params.DefaultBaseFeeMultiplier)
+		k.SetBaseFee(ctx, baseFee)
+	}
+
+	k.CurFeeState.CurBaseFee = baseFee.Clone()
+}
+
+// EndBlockBaseFee records the base fee of the block and computes the base fee of the next block from the gas used
+// by the block, with the following equation:
+//
+//	baseFeeMultiplier = 1 + (gasUsed - targetGas) / targetGas * maxChangeRate
+//	newBaseFee = baseFee * baseFeeMultiplier
+//
+// The new base fee is bounded by the min gas price and max base fee.
+func (k *Keeper) EndBlockBaseFee(ctx sdk.Context) {
+	params := k.GetParams(ctx)
+	baseFee := k.GetBaseFee(ctx)
+	gasUsed := ctx.BlockGasMeter().GasConsumedToLimit()
+	targetGas := k.CurFeeState.TargetGas
+
+	k.SetBlockFee(ctx, types.BlockFee{
+		Height:    ctx.BlockHeight(),
+		BaseFee:   baseFee,
+		GasUsed:   gasUsed,
+		TargetGas: targetGas,
+	})
+	k.pruneBlockFees(ctx, ctx.BlockHeight(), params.BaseFeeHistoryLength)
+
+	gasDiff := math.LegacyNewDecFromInt(math.NewIntFromUint64(gasUsed)).Sub(math.LegacyNewDec(targetGas))
+	baseFeeIncrement := gasDiff.Quo(math.LegacyNewDec(targetGas)).Mul(params.MaxBlockChangeRate)
+	nextBaseFee := baseFee.Mul(math.LegacyOneDec().Add(baseFeeIncrement)).TruncateDec()
+
+	nextBaseFee = math.LegacyMaxDec(nextBaseFee, params.MinGasPrice)
+	nextBaseFee = math.LegacyMinDec(nextBaseFee, params.MinGasPrice.Mul(params.MaxBaseFeeMultiplier))
+
+	k.SetBaseFee(ctx, nextBaseFee)
+	k.CurFeeState.SetBaseFee(ctx.BlockHeight(), nextBaseFee)
+}
@@ -0,0 +1,142 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	auctiontypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/auction/types"
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	exchangev2 "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+// ProcessCollectedFees forwards the INJ held by the module to the auction module, like regular tx fees, and swaps the
+// fees collected in other denoms to INJ through their exchange market. Fee denoms without a market are burned.
+// Swaps are placed as market orders, so their INJ proceeds are forwarded in one of the next blocks.
+func (k *Keeper) ProcessCollectedFees(ctx sdk.Context) {
+	moduleAddress := k.accountKeeper.GetModuleAddress(types.ModuleName)
+
+	injBalance := k.bankKeeper.GetBalance(ctx, moduleAddress, chaintypes.InjectiveCoin)
+	if injBalance.IsPositive() {
+		if err := k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, auctiontypes.ModuleName, sdk.NewCoins(injBalance)); err != nil {
+			k.Logger(ctx).Error("failed to forward collected fees to the auction module", "amount", injBalance, "error", err)
+		}
+	}
+
+	for _, feeDenom := range k.GetParams(ctx).FeeDenoms {
+		balance := k.bankKeeper.GetBalance(ctx, moduleAddress, feeDenom.Denom)
+		if !balance.IsPositive() {
+			continue
+		}
+
+		cacheCtx, writeCache := ctx.CacheContext()
+
+		var err error
+		if feeDenom.HasSwapMarket() {
+			err = k.swapCollectedFees(cacheCtx, moduleAddress, feeDenom, balance)
+		} else {
+			err = k.burnCollectedFees(cacheCtx, balance)
+		}
+
+		if err != nil {
+			k.Logger(ctx).Error("failed to process collected fees", "amount", balance, "error", err)
+			ctx.EventManager().EmitEvent(sdk.NewEvent(
+				types.EventTypeCollectedFeesFailed,
+				sdk.NewAttribute(types.AttributeKeyAmount, balance.String()),
+				sdk.NewAttribute(types.AttributeKeyReason, err.Error()),
+			))
+			continue
+		}
+
+		writeCache()
+	}
+}
+
+func (k *Keeper) burnCollectedFees(ctx sdk.Context, amount sdk.Coin) error {
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, sdk.NewCoins(amount)); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCollectedFeesBurn,
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+	))
+
+	return nil
+}
+
+// swapCollectedFees places a market order swapping the collected fees to INJ, with a worst price bounded by the max
+// swap slippage of the fee denom around the current mid price. Balances too small to be traded are kept for later.
+func (k *Keeper) swapCollectedFees(ctx sdk.Context, moduleAddress sdk.AccAddress, feeDenom types.FeeDenom, amount sdk.Coin) error {
+	market, err := k.getFeeDenomMarket(ctx, feeDenom)
+	if err != nil {
+		return err
+	}
+
+	midPrice, _, _ := k.exchangeKeeper.GetSpotMidPriceAndTOB(ctx, market.MarketID())
+	if midPrice == nil || !midPrice.IsPositive() {
+		return fmt.Errorf("no mid price on market %s", feeDenom.MarketId)
+	}
+
+	var (
+		orderType exchangev2.OrderType
+		price     math.LegacyDec
+		quantity  math.LegacyDec
+	)
+
+	if market.BaseDenom == feeDenom.Denom {
+		// sell the fee denom for INJ
+		orderType = exchangev2.OrderType_SELL
+		price = quantizeDown(midPrice.Mul(math.LegacyOneDec().Sub(feeDenom.MaxSwapSlippage)), market.MinPriceTickSize)
+		quantity = quantizeDown(market.QuantityFromChainFormat(amount.Amount.ToLegacyDec()), market.MinQuantityTickSize)
+	} else {
+		// buy INJ with the fee denom, keeping enough margin for the taker fee
+		orderType = exchangev2.OrderType_BUY
+		price = quantizeDown(midPrice.Mul(math.LegacyOneDec().Add(feeDenom.MaxSwapSlippage)), market.MinPriceTickSize)
+		if price.IsPositive() {
+			notional := market.NotionalFromChainFormat(amount.Amount.ToLegacyDec())
+			quantity = quantizeDown(notional.Quo(price.Mul(math.LegacyOneDec().Add(market.TakerFeeRate))), market.MinQuantityTickSize)
+		}
+	}
+
+	if !price.IsPositive() || quantity.IsNil() || !quantity.IsPositive() || price.Mul(quantity).LT(market.MinNotional) {
+		return nil
+	}
+
+	subaccountID := exchangetypes.MustSdkAddressWithNonceToSubaccountID(moduleAddress, 0)
+	if _, err := k.exchangeMsgServer.CreateSpotMarketOrder(ctx, &exchangev2.MsgCreateSpotMarketOrder{
+		Sender: moduleAddress.String(),
+		Order: exchangev2.SpotOrder{
+			MarketId: feeDenom.MarketId,
+			OrderInfo: exchangev2.OrderInfo{
+				SubaccountId: subaccountID.Hex(),
+				FeeRecipient: moduleAddress.String(),
+				Price:        price,
+				Quantity:     quantity,
+			},
+			OrderType: orderType,
+		},
+	}); err != nil {
+		return err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCollectedFeesSwap,
+		sdk.NewAttribute(types.AttributeKeyAmount, amount.String()),
+		sdk.NewAttribute(types.AttributeKeyMarketID, feeDenom.MarketId),
+	))
+
+	return nil
+}
+
+// quantizeDown rounds the value down to a multiple of the tick size
+func quantizeDown(value, tickSize math.LegacyDec) math.LegacyDec {
+	if !tickSize.IsPositive() {
+		return value
+	}
+
+	return value.Quo(tickSize).TruncateDec().Mul(tickSize)
+}
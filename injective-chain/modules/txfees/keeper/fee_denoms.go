@@ -0,0 +1,105 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	exchangev2 "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+// GetFeeDenomPrice returns the price of one whole unit of the fee denom in INJ, along with the decimals of the fee denom
+func (k *Keeper) GetFeeDenomPrice(ctx sdk.Context, feeDenom types.FeeDenom) (math.LegacyDec, uint32, error) {
+	switch feeDenom.PriceSource {
+	case types.FeeDenomPriceSource_Oracle:
+		price := k.oracleKeeper.GetPrice(ctx, feeDenom.OracleType, feeDenom.OracleBase, feeDenom.OracleQuote)
+		if price == nil || !price.IsPositive() {
+			return math.LegacyDec{}, 0, errors.Wrapf(
+				types.ErrFeeDenomPriceNotFound,
+				"no %s oracle price for %s/%s", feeDenom.OracleType, feeDenom.OracleBase, feeDenom.OracleQuote,
+			)
+		}
+
+		return *price, feeDenom.Decimals, nil
+	case types.FeeDenomPriceSource_SpotMarket:
+		market, err := k.getFeeDenomMarket(ctx, feeDenom)
+		if err != nil {
+			return math.LegacyDec{}, 0, err
+		}
+
+		midPrice, _, _ := k.exchangeKeeper.GetSpotMidPriceAndTOB(ctx, market.MarketID())
+		if midPrice == nil || !midPrice.IsPositive() {
+			return math.LegacyDec{}, 0, errors.Wrapf(types.ErrFeeDenomPriceNotFound, "no mid price on market %s", feeDenom.MarketId)
+		}
+
+		if market.BaseDenom == feeDenom.Denom {
+			return *midPrice, market.BaseDecimals, nil
+		}
+
+		return math.LegacyOneDec().Quo(*midPrice), market.QuoteDecimals, nil
+	default:
+		return math.LegacyDec{}, 0, errors.Wrapf(types.ErrFeeDenomPriceNotFound, "invalid price source %s", feeDenom.PriceSource)
+	}
+}
+
+// ConvertToFeeDenom converts an amount of INJ into the amount of the fee denom with the same value, rounded up
+func (k *Keeper) ConvertToFeeDenom(ctx sdk.Context, feeDenom types.FeeDenom, injAmount math.Int) (math.Int, error) {
+	price, decimals, err := k.GetFeeDenomPrice(ctx, feeDenom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	amount := injAmount.ToLegacyDec().
+		Mul(math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, int(decimals)))).
+		Quo(math.LegacyNewDecFromInt(chaintypes.PowerReduction)).
+		Quo(price)
+
+	return amount.Ceil().TruncateInt(), nil
+}
+
+// ConvertFromFeeDenom converts an amount of the fee denom into the amount of INJ with the same value, rounded down
+func (k *Keeper) ConvertFromFeeDenom(ctx sdk.Context, feeDenom types.FeeDenom, amount math.Int) (math.Int, error) {
+	price, decimals, err := k.GetFeeDenomPrice(ctx, feeDenom)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	injAmount := amount.ToLegacyDec().
+		Mul(price).
+		Mul(math.LegacyNewDecFromInt(chaintypes.PowerReduction)).
+		Quo(math.LegacyNewDecFromInt(math.NewIntWithDecimal(1, int(decimals))))
+
+	return injAmount.TruncateInt(), nil
+}
+
+// IsFeeDenom returns true if the denom is accepted for tx fees in place of INJ
+func (k *Keeper) IsFeeDenom(ctx sdk.Context, denom string) bool {
+	_, ok := k.GetParams(ctx).GetFeeDenom(denom)
+	return ok
+}
+
+// getFeeDenomMarket returns the active spot market trading the fee denom against INJ
+func (k *Keeper) getFeeDenomMarket(ctx sdk.Context, feeDenom types.FeeDenom) (*exchangev2.SpotMarket, error) {
+	if k.exchangeKeeper == nil {
+		return nil, errors.Wrap(types.ErrFeeDenomPriceNotFound, "exchange keeper is not set")
+	}
+
+	market := k.exchangeKeeper.GetSpotMarketByID(ctx, common.HexToHash(feeDenom.MarketId))
+	if market == nil || !market.IsActive() {
+		return nil, errors.Wrapf(types.ErrFeeDenomPriceNotFound, "market %s is not active", feeDenom.MarketId)
+	}
+
+	isBaseMarket := market.BaseDenom == feeDenom.Denom && market.QuoteDenom == chaintypes.InjectiveCoin
+	isQuoteMarket := market.BaseDenom == chaintypes.InjectiveCoin && market.QuoteDenom == feeDenom.Denom
+	if !isBaseMarket && !isQuoteMarket {
+		return nil, errors.Wrapf(
+			types.ErrFeeDenomPriceNotFound,
+			"market %s does not trade %s against %s", feeDenom.MarketId, feeDenom.Denom, chaintypes.InjectiveCoin,
+		)
+	}
+
+	return market, nil
+}
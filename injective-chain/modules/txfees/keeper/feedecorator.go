@@ -72,7 +72,7 @@ func (mfd MempoolFeeDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate b
 	}
 
 	feeCoins := feeTx.GetFee()
-	if err := mfd.isSufficientFee(minBaseGasPrice, feeTx.GetGas(), feeCoins[0]); err != nil {
+	if err := mfd.isSufficientFee(ctx, txfeesParams, minBaseGasPrice, feeTx.GetGas(), feeCoins[0]); err != nil {
 		return ctx, err
 	}
 
@@ -96,7 +96,7 @@ func (mfd MempoolFeeDecorator) GetMinBaseGasPriceForTx(ctx sdk.Context, feeTx sd
 }
 
 // getValidatedFeeTx returns a FeeTx if the tx is a FeeTx, otherwise it returns an error
-// if the tx is a FeeTx, it also checks that the fee is valid (only INJ)
+// if the tx is a FeeTx, it also checks that the fee is valid (INJ or one of the fee denoms)
 // if there is no fee, it returns nil
 func (MempoolFeeDecorator) getValidatedFeeTx(ctx sdk.Context, tx sdk.Tx, txfeesParams types.Params) (sdk.FeeTx, error) {
 	// The SDK currently requires all txs to be FeeTx's in CheckTx, within its mempool fee decorator.
@@ -126,8 +126,9 @@ func (MempoolFeeDecorator) getValidatedFeeTx(ctx sdk.Context, tx sdk.Tx, txfeesP
 	}
 
 	// If there is a fee attached to the tx, make sure the fee denom is a denom accepted by the chain
-	if feeDenom := feeCoins.GetDenomByIndex(0); feeDenom != chaintypes.InjectiveCoin && feeDenom != "stake" {
-		return nil, errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee denom is not a valid denom (%s or stake): %s",
+	feeDenom := feeCoins.GetDenomByIndex(0)
+	if _, isFeeDenom := txfeesParams.GetFeeDenom(feeDenom); !isFeeDenom && feeDenom != chaintypes.InjectiveCoin && feeDenom != "stake" {
+		return nil, errorsmod.Wrapf(types.ErrInvalidFeeToken, "fee denom is not a valid denom (%s, stake or a fee denom): %s",
 			chaintypes.InjectiveCoin,
 			feeDenom,
 		)
@@ -148,7 +149,13 @@ func (mfd MempoolFeeDecorator) getMempool1559GasPrice(ctx sdk.Context, minBaseGa
 	return minBaseGasPrice
 }
 
-func (MempoolFeeDecorator) isSufficientFee(minBaseGasPrice math.LegacyDec, gasRequested uint64, feeCoin sdk.Coin) error {
+func (mfd MempoolFeeDecorator) isSufficientFee(
+	ctx sdk.Context,
+	txfeesParams types.Params,
+	minBaseGasPrice math.LegacyDec,
+	gasRequested uint64,
+	feeCoin sdk.Coin,
+) error {
 	// Determine the required fees by multiplying the required minimum gas
 	// price by the gas limit, where fee = ceil(minGasPrice * gasLimit).
 	// note we mutate this one line below, to avoid extra heap allocations.
@@ -156,6 +163,15 @@ func (MempoolFeeDecorator) isSufficientFee(minBaseGasPrice math.LegacyDec, gasRe
 	baseFeeAmt := glDec.MulMut(minBaseGasPrice).Ceil().RoundInt()
 	requiredBaseFee := chaintypes.NewInjectiveCoin(baseFeeAmt)
 
+	// fees paid in a fee denom must be worth the required INJ fee at the current fee denom price
+	if feeDenom, ok := txfeesParams.GetFeeDenom(feeCoin.Denom); ok {
+		requiredFeeAmt, err := mfd.TxFeesKeeper.ConvertToFeeDenom(ctx, feeDenom, baseFeeAmt)
+		if err != nil {
+			return err
+		}
+		requiredBaseFee = sdk.NewCoin(feeDenom.Denom, requiredFeeAmt)
+	}
+
 	// check to ensure that the convertedFee should always be greater than or equal to the requireBaseFee
 	if !(feeCoin.IsGTE(requiredBaseFee)) {
 		return errorsmod.Wrapf(sdkerrors.ErrInsufficientFee, "got: %s required: %s", feeCoin, requiredBaseFee)
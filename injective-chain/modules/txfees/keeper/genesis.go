@@ -8,10 +8,20 @@ import (
 
 func (k *Keeper) InitGenesis(ctx sdk.Context, data types.GenesisState) {
 	k.SetParams(ctx, data.Params)
+
+	if !data.BaseFee.IsNil() && data.BaseFee.IsPositive() {
+		k.SetBaseFee(ctx, data.BaseFee)
+	}
+
+	for _, blockFee := range data.BlockFees {
+		k.SetBlockFee(ctx, blockFee)
+	}
 }
 
 func (k *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: k.GetParams(ctx),
+		Params:    k.GetParams(ctx),
+		BaseFee:   k.GetBaseFee(ctx),
+		BlockFees: k.GetAllBlockFees(ctx),
 	}
 }
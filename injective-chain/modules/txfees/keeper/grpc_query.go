@@ -3,6 +3,8 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/InjectiveLabs/metrics"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 
@@ -42,18 +44,79 @@ func (q queryServer) Params(c context.Context, _ *types.QueryParamsRequest) (*ty
 	return res, nil
 }
 
-// since we only store current baseFee, this query can only return current BaseFee values, even if requested for historic blocks
 func (q queryServer) GetEipBaseFee(c context.Context, _ *types.QueryEipBaseFeeRequest) (*types.QueryEipBaseFeeResponse, error) {
 	_, doneFn := metrics.ReportFuncCallAndTimingCtx(c, q.svcTags)
 	defer doneFn()
 
+	// the base fee is kept in state, so historical queries return the base fee of the block following the queried height
 	sdkCtx := sdk.UnwrapSDKContext(c)
-	if sdkCtx.BlockHeight() < q.k.CurFeeState.GetCurrentBlockHeight()-2 { // we do not support historical queries since we only have current BaseFee in memory
-		return nil, types.ErrUnsupportedQueryParams
+	baseFee := q.k.GetBaseFee(sdkCtx)
+	return &types.QueryEipBaseFeeResponse{BaseFee: &types.EipBaseFee{BaseFee: baseFee}}, nil
+}
+
+func (q queryServer) BaseFeeHistory(c context.Context, req *types.QueryBaseFeeHistoryRequest) (*types.QueryBaseFeeHistoryResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, q.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.k.GetParams(ctx)
+
+	lastHeight := req.LastHeight
+	if lastHeight <= 0 || lastHeight >= ctx.BlockHeight() {
+		lastHeight = ctx.BlockHeight()
 	}
 
-	baseFee := q.k.CurFeeState.GetCurBaseFee()
-	return &types.QueryEipBaseFeeResponse{BaseFee: &types.EipBaseFee{BaseFee: baseFee}}, nil
+	blockCount := req.BlockCount
+	if blockCount == 0 || blockCount > params.BaseFeeHistoryLength {
+		blockCount = params.BaseFeeHistoryLength
+	}
+
+	fromHeight := max(lastHeight-int64(blockCount)+1, 1)
+	blockFees := q.k.GetBlockFees(ctx, fromHeight, lastHeight)
+
+	// the base fee of the latest block's successor is the current base fee
+	nextBaseFee := q.k.GetBaseFee(ctx)
+	if nextBlockFee, ok := q.k.GetBlockFee(ctx, lastHeight+1); ok {
+		nextBaseFee = nextBlockFee.BaseFee
+	}
+
+	return &types.QueryBaseFeeHistoryResponse{
+		BlockFees:   blockFees,
+		NextBaseFee: nextBaseFee,
+	}, nil
+}
+
+func (q queryServer) FeeDenomPrice(c context.Context, req *types.QueryFeeDenomPriceRequest) (*types.QueryFeeDenomPriceResponse, error) {
+	c, doneFn := metrics.ReportFuncCallAndTimingCtx(c, q.svcTags)
+	defer doneFn()
+
+	ctx := sdk.UnwrapSDKContext(c)
+	params := q.k.GetParams(ctx)
+
+	feeDenom, ok := params.GetFeeDenom(req.Denom)
+	if !ok {
+		return nil, errors.Wrapf(types.ErrInvalidFeeToken, "%s is not a fee denom", req.Denom)
+	}
+
+	price, _, err := q.k.GetFeeDenomPrice(ctx, feeDenom)
+	if err != nil {
+		return nil, err
+	}
+
+	gasPrice := params.MinGasPrice
+	if params.Mempool1559Enabled {
+		gasPrice = math.LegacyMaxDec(gasPrice, q.k.GetBaseFee(ctx))
+	}
+
+	requiredFee, err := q.k.ConvertToFeeDenom(ctx, feeDenom, gasPrice.MulInt64(int64(req.Gas)).Ceil().RoundInt())
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.QueryFeeDenomPriceResponse{
+		Price:       price,
+		RequiredFee: requiredFee,
+	}, nil
 }
 
 var _ osmosistypes.QueryServer = osmosisQueryServer{}
@@ -76,10 +139,6 @@ func (q osmosisQueryServer) GetEipBaseFee(
 	c context.Context, _ *osmosistypes.QueryEipBaseFeeRequest,
 ) (*osmosistypes.QueryEipBaseFeeResponse, error) {
 	sdkCtx := sdk.UnwrapSDKContext(c)
-	if sdkCtx.BlockHeight() < q.k.CurFeeState.GetCurrentBlockHeight()-2 { // we do not support historical queries since we only have current BaseFee in memory
-		return nil, types.ErrUnsupportedQueryParams
-	}
-
-	response := q.k.CurFeeState.GetCurBaseFee()
+	response := q.k.GetBaseFee(sdkCtx)
 	return &osmosistypes.QueryEipBaseFeeResponse{BaseFee: response}, nil
 }
@@ -16,9 +16,14 @@ type Keeper struct {
 	storeKey storetypes.StoreKey
 	cdc      codec.BinaryCodec
 
-	consensusKeeper types.ConsensusKeeper
-	dataDir         string
-	CurFeeState     *mempool1559.FeeState
+	consensusKeeper   types.ConsensusKeeper
+	accountKeeper     types.AccountKeeper
+	bankKeeper        types.BankKeeper
+	oracleKeeper      types.OracleKeeper
+	exchangeKeeper    types.ExchangeKeeper
+	exchangeMsgServer types.ExchangeMsgServer
+	dataDir           string
+	CurFeeState       *mempool1559.FeeState
 
 	svcTags   metrics.Tags
 	authority string
@@ -28,6 +33,9 @@ func NewKeeper(
 	cdc codec.BinaryCodec,
 	storeKey storetypes.StoreKey,
 	consensusKeeper types.ConsensusKeeper,
+	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
+	oracleKeeper types.OracleKeeper,
 	dataDir string,
 	authority string,
 ) Keeper {
@@ -35,6 +43,9 @@ func NewKeeper(
 		storeKey:        storeKey,
 		cdc:             cdc,
 		consensusKeeper: consensusKeeper,
+		accountKeeper:   accountKeeper,
+		bankKeeper:      bankKeeper,
+		oracleKeeper:    oracleKeeper,
 		dataDir:         dataDir,
 		// Initialize the EIP state with the default values. They will be updated in the BeginBlocker.
 		CurFeeState: mempool1559.DefaultFeeState(),
@@ -45,6 +56,13 @@ func NewKeeper(
 	}
 }
 
+// SetExchangeKeeper sets the exchange keeper used to price fee denoms and swap the collected fees.
+// Must be called before the module is created, since the module holds a copy of the keeper.
+func (k *Keeper) SetExchangeKeeper(exchangeKeeper types.ExchangeKeeper, exchangeMsgServer types.ExchangeMsgServer) {
+	k.exchangeKeeper = exchangeKeeper
+	k.exchangeMsgServer = exchangeMsgServer
+}
+
 func (*Keeper) Logger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", types.ModuleName)
 }
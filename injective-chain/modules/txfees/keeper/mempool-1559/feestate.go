@@ -16,8 +16,8 @@ const (
 	DefaultMinGasPrice = 160000000
 )
 
-// FeeState tracks the current base fee and totalGasWantedThisBlock
-// this structure is never written to state
+// FeeState tracks the current base fee and totalGasWantedThisBlock for the mempool.
+// this structure is never written to state, the base fee is synced from the consensus base fee kept by the keeper
 type FeeState struct {
 	currentBlockHeight      int64
 	totalGasWantedThisBlock int64
@@ -163,6 +163,13 @@ func (e *FeeState) UpdateBaseFee(logger log.Logger, height int64) {
 	}
 }
 
+// SetBaseFee sets the consensus base fee computed at the end of the block for the next block
+func (e *FeeState) SetBaseFee(height int64, baseFee math.LegacyDec) {
+	// N.B. we set the lastBlockHeight to height + 1, see UpdateBaseFee
+	e.currentBlockHeight = height + 1
+	e.CurBaseFee = baseFee.Clone()
+}
+
 // GetCurBaseFee returns a clone of the CurBaseFee to avoid overwriting the initial value in
 // the FeeState, we use this in the AnteHandler to Check transactions
 func (e *FeeState) GetCurBaseFee() math.LegacyDec {
@@ -0,0 +1,30 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	v2 "github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/migrations/v2"
+	v3 "github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/migrations/v3"
+)
+
+type Migrator struct {
+	keeper *Keeper
+}
+
+func NewMigrator(k *Keeper) Migrator {
+	return Migrator{
+		keeper: k,
+	}
+}
+
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	return v2.Migrate(ctx, m.keeper.accountKeeper)
+}
+
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	return v3.Migrate(
+		ctx,
+		ctx.KVStore(m.keeper.storeKey),
+		m.keeper.cdc,
+	)
+}
@@ -0,0 +1,70 @@
+package keeper
+
+import (
+	stdmath "math"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authante "github.com/cosmos/cosmos-sdk/x/auth/ante"
+
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+// NewTxFeeChecker returns a TxFeeChecker accepting fees paid in one of the fee denoms. Such fees are checked against
+// the validator's INJ min gas price and prioritized by their INJ value. Any other fee is checked by the default
+// cosmos checker.
+func NewTxFeeChecker(k *Keeper) authante.TxFeeChecker {
+	return func(ctx sdk.Context, tx sdk.Tx) (sdk.Coins, int64, error) {
+		feeTx, ok := tx.(sdk.FeeTx)
+		if !ok {
+			return nil, 0, errorsmod.Wrap(sdkerrors.ErrTxDecode, "Tx must be a FeeTx")
+		}
+
+		feeCoins := feeTx.GetFee()
+		if len(feeCoins) != 1 {
+			return authante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+		}
+
+		feeDenom, ok := k.GetParams(ctx).GetFeeDenom(feeCoins[0].Denom)
+		if !ok {
+			return authante.CheckTxFeeWithValidatorMinGasPrices(ctx, tx)
+		}
+
+		injValue, err := k.ConvertFromFeeDenom(ctx, feeDenom, feeCoins[0].Amount)
+		if err != nil {
+			return nil, 0, err
+		}
+
+		gas := feeTx.GetGas()
+		if ctx.IsCheckTx() {
+			if minGasPrice := ctx.MinGasPrices().AmountOf(chaintypes.InjectiveCoin); minGasPrice.IsPositive() {
+				requiredFee := minGasPrice.MulInt64(int64(gas)).Ceil().RoundInt()
+				if injValue.LT(requiredFee) {
+					return nil, 0, errorsmod.Wrapf(
+						sdkerrors.ErrInsufficientFee,
+						"insufficient fees; got: %s worth %s%s required: %s%s",
+						feeCoins, injValue, chaintypes.InjectiveCoin, requiredFee, chaintypes.InjectiveCoin,
+					)
+				}
+			}
+		}
+
+		return feeCoins, getTxPriority(injValue, gas), nil
+	}
+}
+
+// getTxPriority returns the priority of a tx by its gas price in INJ
+func getTxPriority(injValue math.Int, gas uint64) int64 {
+	if gas == 0 {
+		return 0
+	}
+
+	gasPrice := injValue.Quo(math.NewIntFromUint64(gas))
+	if !gasPrice.IsInt64() {
+		return stdmath.MaxInt64
+	}
+
+	return gasPrice.Int64()
+}
@@ -0,0 +1,27 @@
+package v2
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/types"
+)
+
+// Migrate grants the burner permission to the txfees module account, so that the fees collected in fee denoms
+// without a swap market can be burned
+func Migrate(ctx sdk.Context, accountKeeper types.AccountKeeper) error {
+	moduleAccount := accountKeeper.GetModuleAccount(ctx, types.ModuleName)
+	if moduleAccount == nil || moduleAccount.HasPermission(authtypes.Burner) {
+		return nil
+	}
+
+	baseAccount := authtypes.NewBaseAccount(
+		moduleAccount.GetAddress(),
+		moduleAccount.GetPubKey(),
+		moduleAccount.GetAccountNumber(),
+		moduleAccount.GetSequence(),
+	)
+	accountKeeper.SetModuleAccount(ctx, authtypes.NewModuleAccount(baseAccount, types.ModuleName, authtypes.Burner))
+
+	return nil
+}
@@ -0,0 +1,30 @@
+package v3
+
+import (
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/types"
+)
+
+// Migrate sets the base fee history length param introduced in v3 to its default value. The consensus base fee
+// starts from the default base fee, since the base fee tracked by each node so far may differ.
+func Migrate(
+	_ sdk.Context,
+	store storetypes.KVStore,
+	cdc codec.BinaryCodec,
+) error {
+	var currParams types.Params
+	cdc.MustUnmarshal(store.Get(types.ParamsKey), &currParams)
+
+	currParams.BaseFeeHistoryLength = types.DefaultBaseFeeHistoryLength
+
+	if err := currParams.Validate(); err != nil {
+		return err
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&currParams))
+
+	return nil
+}
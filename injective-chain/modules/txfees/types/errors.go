@@ -11,4 +11,5 @@ var (
 	ErrInvalidFeeToken        = errorsmod.Register(ModuleName, 1, "invalid fee token")
 	ErrTooManyFeeCoins        = errorsmod.Register(ModuleName, 2, "more than one coin in fee")
 	ErrUnsupportedQueryParams = errorsmod.Register(ModuleName, 3, "unsupported query param")
+	ErrFeeDenomPriceNotFound  = errorsmod.Register(ModuleName, 4, "fee denom price not found")
 )
@@ -1,6 +1,13 @@
 package types
 
 const (
-	EventTypeTxFees     = "txfees"
-	AttributeKeyBaseFee = "basefee"
+	EventTypeTxFees              = "txfees"
+	EventTypeCollectedFeesSwap   = "collected_fees_swap"
+	EventTypeCollectedFeesBurn   = "collected_fees_burn"
+	EventTypeCollectedFeesFailed = "collected_fees_failed"
+
+	AttributeKeyBaseFee  = "basefee"
+	AttributeKeyAmount   = "amount"
+	AttributeKeyMarketID = "market_id"
+	AttributeKeyReason   = "reason"
 )
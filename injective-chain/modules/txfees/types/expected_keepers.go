@@ -3,9 +3,43 @@ package types
 import (
 	"context"
 
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 	consensustypes "github.com/cosmos/cosmos-sdk/x/consensus/types"
+	"github.com/ethereum/go-ethereum/common"
+
+	exchangev2 "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types/v2"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 )
 
 type ConsensusKeeper interface {
 	Params(ctx context.Context, _ *consensustypes.QueryParamsRequest) (*consensustypes.QueryParamsResponse, error)
 }
+
+type AccountKeeper interface {
+	GetModuleAccount(ctx context.Context, moduleName string) sdk.ModuleAccountI
+	GetModuleAddress(moduleName string) sdk.AccAddress
+	SetModuleAccount(ctx context.Context, macc sdk.ModuleAccountI)
+}
+
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error
+}
+
+type OracleKeeper interface {
+	GetPrice(ctx sdk.Context, oracletype oracletypes.OracleType, base, quote string) *math.LegacyDec
+}
+
+type ExchangeKeeper interface {
+	GetSpotMarketByID(ctx sdk.Context, marketID common.Hash) *exchangev2.SpotMarket
+	GetSpotMidPriceAndTOB(ctx sdk.Context, marketID common.Hash) (midPrice, bestBuyPrice, bestSellPrice *math.LegacyDec)
+}
+
+// ExchangeMsgServer defines the exchange messages used to swap the collected fees
+type ExchangeMsgServer interface {
+	CreateSpotMarketOrder(
+		ctx context.Context, msg *exchangev2.MsgCreateSpotMarketOrder,
+	) (*exchangev2.MsgCreateSpotMarketOrderResponse, error)
+}
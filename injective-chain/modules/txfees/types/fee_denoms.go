@@ -0,0 +1,88 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	exchangetypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/exchange/types"
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+	chaintypes "github.com/InjectiveLabs/injective-core/injective-chain/types"
+)
+
+// MaxFeeDenomDecimals is the maximum number of decimals of a fee denom priced by the oracle
+const MaxFeeDenomDecimals = 18
+
+// HasSwapMarket returns true if the collected fees in this denom are swapped to INJ, false if they are burned
+func (f FeeDenom) HasSwapMarket() bool {
+	return f.MarketId != ""
+}
+
+func (f FeeDenom) Validate() error {
+	if err := sdk.ValidateDenom(f.Denom); err != nil {
+		return fmt.Errorf("invalid fee denom %s: %w", f.Denom, err)
+	}
+
+	if f.Denom == chaintypes.InjectiveCoin {
+		return fmt.Errorf("fee denom cannot be %s", chaintypes.InjectiveCoin)
+	}
+
+	if f.MarketId != "" && !exchangetypes.IsHexHash(f.MarketId) {
+		return fmt.Errorf("invalid market id for fee denom %s: %s", f.Denom, f.MarketId)
+	}
+
+	switch f.PriceSource {
+	case FeeDenomPriceSource_Oracle:
+		if f.OracleType == oracletypes.OracleType_Unspecified {
+			return fmt.Errorf("oracle type must be specified for fee denom %s", f.Denom)
+		}
+
+		if f.OracleBase == "" || f.OracleQuote == "" {
+			return fmt.Errorf("oracle base and quote must be specified for fee denom %s", f.Denom)
+		}
+
+		if f.Decimals > MaxFeeDenomDecimals {
+			return fmt.Errorf("decimals of fee denom %s cannot exceed %d", f.Denom, MaxFeeDenomDecimals)
+		}
+	case FeeDenomPriceSource_SpotMarket:
+		if f.MarketId == "" {
+			return fmt.Errorf("market id must be specified for fee denom %s", f.Denom)
+		}
+	default:
+		return fmt.Errorf("invalid price source for fee denom %s: %d", f.Denom, f.PriceSource)
+	}
+
+	if f.MaxSwapSlippage.IsNil() || f.MaxSwapSlippage.IsNegative() || f.MaxSwapSlippage.GTE(math.LegacyOneDec()) {
+		return fmt.Errorf("max_swap_slippage of fee denom %s must be between 0 and 1", f.Denom)
+	}
+
+	return nil
+}
+
+// GetFeeDenom returns the fee denom config of the denom, if it is accepted for tx fees
+func (p Params) GetFeeDenom(denom string) (FeeDenom, bool) {
+	for _, feeDenom := range p.FeeDenoms {
+		if feeDenom.Denom == denom {
+			return feeDenom, true
+		}
+	}
+
+	return FeeDenom{}, false
+}
+
+func (p Params) validateFeeDenoms() error {
+	seenDenoms := make(map[string]struct{}, len(p.FeeDenoms))
+	for _, feeDenom := range p.FeeDenoms {
+		if _, ok := seenDenoms[feeDenom.Denom]; ok {
+			return fmt.Errorf("duplicate fee denom: %s", feeDenom.Denom)
+		}
+		seenDenoms[feeDenom.Denom] = struct{}{}
+
+		if err := feeDenom.Validate(); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
@@ -1,15 +1,42 @@
 package types
 
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
 func NewGenesisState() GenesisState {
 	return GenesisState{}
 }
 
 func (gs GenesisState) Validate() error {
-	return gs.Params.Validate()
+	if err := gs.Params.Validate(); err != nil {
+		return err
+	}
+
+	if !gs.BaseFee.IsNil() && gs.BaseFee.IsNegative() {
+		return errors.New("base_fee cannot be negative")
+	}
+
+	for i, blockFee := range gs.BlockFees {
+		if i > 0 && blockFee.Height <= gs.BlockFees[i-1].Height {
+			return fmt.Errorf("block fees must be sorted by increasing height, got %d after %d", blockFee.Height, gs.BlockFees[i-1].Height)
+		}
+
+		if blockFee.BaseFee.IsNil() || blockFee.BaseFee.IsNegative() {
+			return fmt.Errorf("invalid base fee at height %d", blockFee.Height)
+		}
+	}
+
+	return nil
 }
 
 func DefaultGenesisState() *GenesisState {
 	return &GenesisState{
-		Params: DefaultParams(),
+		Params:    DefaultParams(),
+		BaseFee:   math.LegacyZeroDec(),
+		BlockFees: []BlockFee{},
 	}
 }
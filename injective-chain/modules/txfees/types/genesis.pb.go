@@ -4,6 +4,7 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -25,6 +26,10 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// the consensus base fee of the next block
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// the base fee history
+	BlockFees []BlockFee `protobuf:"bytes,3,rep,name=block_fees,json=blockFees,proto3" json:"block_fees"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -67,6 +72,13 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetBlockFees() []BlockFee {
+	if m != nil {
+		return m.BlockFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "injective.txfees.v1beta1.GenesisState")
 }
@@ -76,21 +88,27 @@ func init() {
 }
 
 var fileDescriptor_6434836fdedde04f = []byte{
-	// 224 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0xcb, 0xcc, 0xcb, 0x4a,
-	0x4d, 0x2e, 0xc9, 0x2c, 0x4b, 0xd5, 0x2f, 0xa9, 0x48, 0x4b, 0x4d, 0x2d, 0xd6, 0x2f, 0x33, 0x4c,
-	0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca,
-	0x2f, 0xc9, 0x17, 0x92, 0x80, 0xab, 0xd3, 0x83, 0xa8, 0xd3, 0x83, 0xaa, 0x93, 0x52, 0xc5, 0x69,
-	0x02, 0x54, 0x21, 0xd8, 0x00, 0x29, 0x91, 0xf4, 0xfc, 0xf4, 0x7c, 0x30, 0x53, 0x1f, 0xc4, 0x82,
-	0x88, 0x2a, 0xf9, 0x71, 0xf1, 0xb8, 0x43, 0xec, 0x09, 0x2e, 0x49, 0x2c, 0x49, 0x15, 0xb2, 0xe3,
-	0x62, 0x2b, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0x96, 0x60, 0x54, 0x60, 0xd4, 0xe0, 0x36, 0x52, 0xd0,
-	0xc3, 0x65, 0xaf, 0x5e, 0x00, 0x58, 0x9d, 0x13, 0xcb, 0x89, 0x7b, 0xf2, 0x0c, 0x41, 0x50, 0x5d,
-	0x4e, 0x69, 0x27, 0x1e, 0xc9, 0x31, 0x5e, 0x78, 0x24, 0xc7, 0xf8, 0xe0, 0x91, 0x1c, 0xe3, 0x84,
-	0xc7, 0x72, 0x0c, 0x17, 0x1e, 0xcb, 0x31, 0xdc, 0x78, 0x2c, 0xc7, 0x10, 0xe5, 0x93, 0x9e, 0x59,
-	0x92, 0x51, 0x9a, 0xa4, 0x97, 0x9c, 0x9f, 0xab, 0xef, 0x09, 0x33, 0xd3, 0x27, 0x31, 0xa9, 0x58,
-	0x1f, 0x6e, 0x83, 0x6e, 0x72, 0x7e, 0x51, 0x2a, 0x32, 0x37, 0x23, 0x31, 0x33, 0x4f, 0x3f, 0x37,
-	0x3f, 0xa5, 0x34, 0x27, 0xb5, 0x18, 0xe6, 0xb9, 0x92, 0xca, 0x82, 0xd4, 0xe2, 0x24, 0x36, 0xb0,
-	0xf3, 0x8d, 0x01, 0x01, 0x00, 0x00, 0xff, 0xff, 0x7e, 0xaf, 0xdf, 0x85, 0x3f, 0x01, 0x00, 0x00,
+	// 309 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xb1, 0x4e, 0x32, 0x41,
+	0x10, 0xc7, 0x6f, 0x3f, 0xbe, 0xa0, 0x2c, 0x56, 0x17, 0x8b, 0x0b, 0x26, 0xcb, 0x05, 0xa3, 0xa1,
+	0x71, 0x37, 0x60, 0x4f, 0x71, 0x31, 0x10, 0x13, 0x0a, 0x83, 0x9d, 0x8d, 0xd9, 0x5d, 0x86, 0x63,
+	0x85, 0x63, 0x09, 0xb3, 0x10, 0x79, 0x0b, 0x1f, 0x8b, 0x92, 0x4e, 0x63, 0x41, 0x0c, 0xbc, 0x88,
+	0xb9, 0xe3, 0x20, 0x36, 0x74, 0x33, 0xbb, 0xbf, 0xf9, 0xfd, 0x27, 0x43, 0x6f, 0xcd, 0xe4, 0x0d,
+	0xb4, 0x33, 0x0b, 0x10, 0xee, 0x7d, 0x00, 0x80, 0x62, 0xd1, 0x50, 0xe0, 0x64, 0x43, 0xc4, 0x30,
+	0x01, 0x34, 0xc8, 0xa7, 0x33, 0xeb, 0xac, 0x1f, 0x1c, 0x39, 0xbe, 0xe7, 0x78, 0xce, 0x55, 0x6e,
+	0x4e, 0x1a, 0x72, 0x30, 0x13, 0x54, 0x2e, 0x63, 0x1b, 0xdb, 0xac, 0x14, 0x69, 0xb5, 0x7f, 0xad,
+	0x7d, 0x12, 0x7a, 0xd1, 0xd9, 0x07, 0x3d, 0x3b, 0xe9, 0xc0, 0x6f, 0xd1, 0xe2, 0x54, 0xce, 0x64,
+	0x82, 0x01, 0x09, 0x49, 0xbd, 0xdc, 0x0c, 0xf9, 0xa9, 0x60, 0xfe, 0x94, 0x71, 0xd1, 0xff, 0xd5,
+	0xa6, 0xea, 0xf5, 0xf2, 0x29, 0xbf, 0x45, 0xcf, 0x95, 0x44, 0x78, 0x1d, 0x00, 0x04, 0xff, 0x42,
+	0x52, 0x2f, 0x45, 0xd7, 0xe9, 0xff, 0xf7, 0xa6, 0x7a, 0xa5, 0x2d, 0x26, 0x16, 0xb1, 0x3f, 0xe2,
+	0xc6, 0x8a, 0x44, 0xba, 0x21, 0xef, 0x42, 0x2c, 0xf5, 0xf2, 0x01, 0x74, 0xef, 0x2c, 0x1d, 0x6a,
+	0x03, 0xf8, 0x1d, 0x4a, 0xd5, 0xd8, 0xea, 0x51, 0x2a, 0xc0, 0xa0, 0x10, 0x16, 0xea, 0xe5, 0x66,
+	0xed, 0xf4, 0x0e, 0x51, 0xca, 0xb6, 0x01, 0xf2, 0x2d, 0x4a, 0x2a, 0xef, 0x31, 0x1a, 0xac, 0xb6,
+	0x8c, 0xac, 0xb7, 0x8c, 0xfc, 0x6c, 0x19, 0xf9, 0xd8, 0x31, 0x6f, 0xbd, 0x63, 0xde, 0xd7, 0x8e,
+	0x79, 0x2f, 0xdd, 0xd8, 0xb8, 0xe1, 0x5c, 0x71, 0x6d, 0x13, 0xf1, 0x78, 0x10, 0x77, 0xa5, 0x42,
+	0x71, 0x8c, 0xb9, 0xd3, 0x76, 0x06, 0x7f, 0xdb, 0xa1, 0x34, 0x13, 0x91, 0xd8, 0xfe, 0x7c, 0x0c,
+	0x78, 0x38, 0xb3, 0x5b, 0x4e, 0x01, 0x55, 0x31, 0x3b, 0xe4, 0xfd, 0xef, 0x00, 0x4f, 0xe6, 0xe2,
+	0x73, 0xc9, 0x01, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -113,6 +131,30 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.BlockFees) > 0 {
+		for iNdEx := len(m.BlockFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -145,6 +187,14 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BaseFee.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.BlockFees) > 0 {
+		for _, e := range m.BlockFees {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -216,6 +266,74 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFees", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.BlockFees = append(m.BlockFees, BlockFee{})
+			if err := m.BlockFees[len(m.BlockFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -1,5 +1,9 @@
 package types
 
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
 const (
 	// ModuleName defines the module name.
 	ModuleName   = "txfees"
@@ -16,5 +20,11 @@ const (
 )
 
 var (
-	ParamsKey = []byte{0x01}
+	ParamsKey         = []byte{0x01}
+	BaseFeeKey        = []byte{0x02} // key for the consensus base fee of the next block
+	BlockFeePrefixKey = []byte{0x03} // prefix for the base fee history, by height
 )
+
+func GetBlockFeeKey(height int64) []byte {
+	return append(BlockFeePrefixKey, sdk.Uint64ToBigEndian(uint64(height))...)
+}
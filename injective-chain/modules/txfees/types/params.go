@@ -2,12 +2,20 @@ package types
 
 import (
 	"errors"
+	"fmt"
 
 	"cosmossdk.io/math"
 
 	mempool1559 "github.com/InjectiveLabs/injective-core/injective-chain/modules/txfees/keeper/mempool-1559"
 )
 
+const (
+	// DefaultBaseFeeHistoryLength is the default number of blocks for which the base fee is kept in state
+	DefaultBaseFeeHistoryLength uint64 = 1024
+	// MaxBaseFeeHistoryLength is the maximum number of blocks for which the base fee can be kept in state
+	MaxBaseFeeHistoryLength uint64 = 100_000
+)
+
 // NewParams creates a new Params instance
 func NewParams() Params {
 	return Params{}
@@ -29,6 +37,8 @@ func DefaultParams() Params {
 		RecheckFeeHighBaseFee:                math.LegacyMustNewDecFromStr("2.3"),
 		RecheckFeeBaseFeeThresholdMultiplier: math.LegacyMustNewDecFromStr("4"),
 		MaxBlockChangeRate:                   math.LegacyMustNewDecFromStr("0.1"),
+		FeeDenoms:                            []FeeDenom{},
+		BaseFeeHistoryLength:                 DefaultBaseFeeHistoryLength,
 	}
 }
 
@@ -45,7 +55,11 @@ func (p Params) Validate() error {
 		return err
 	}
 
-	return p.validateExecutionTimeParameters()
+	if err := p.validateExecutionTimeParameters(); err != nil {
+		return err
+	}
+
+	return p.validateFeeDenoms()
 }
 
 func (p Params) validateGasParameters() error {
@@ -122,5 +136,9 @@ func (p Params) validateExecutionTimeParameters() error {
 		return errors.New("max_block_change_rate must be between 0 and 1")
 	}
 
+	if p.BaseFeeHistoryLength == 0 || p.BaseFeeHistoryLength > MaxBaseFeeHistoryLength {
+		return fmt.Errorf("base_fee_history_length must be between 1 and %d", MaxBaseFeeHistoryLength)
+	}
+
 	return nil
 }
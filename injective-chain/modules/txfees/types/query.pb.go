@@ -231,12 +231,211 @@ func (m *QueryEipBaseFeeResponse) GetBaseFee() *EipBaseFee {
 	return nil
 }
 
+type QueryFeeDenomPriceRequest struct {
+	Denom string `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty"`
+	// the gas used to compute the required fee, optional
+	Gas uint64 `protobuf:"varint,2,opt,name=gas,proto3" json:"gas,omitempty"`
+}
+
+func (m *QueryFeeDenomPriceRequest) Reset()         { *m = QueryFeeDenomPriceRequest{} }
+func (m *QueryFeeDenomPriceRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomPriceRequest) ProtoMessage()    {}
+func (*QueryFeeDenomPriceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d95f5619ed216c51, []int{5}
+}
+func (m *QueryFeeDenomPriceRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomPriceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomPriceRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomPriceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomPriceRequest.Merge(m, src)
+}
+func (m *QueryFeeDenomPriceRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomPriceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomPriceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomPriceRequest proto.InternalMessageInfo
+
+func (m *QueryFeeDenomPriceRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *QueryFeeDenomPriceRequest) GetGas() uint64 {
+	if m != nil {
+		return m.Gas
+	}
+	return 0
+}
+
+type QueryFeeDenomPriceResponse struct {
+	// the price of one whole unit of the fee denom in INJ
+	Price cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=price,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"price"`
+	// the fee required in the fee denom for the requested gas at the current
+	// base fee
+	RequiredFee cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=required_fee,json=requiredFee,proto3,customtype=cosmossdk.io/math.Int" json:"required_fee"`
+}
+
+func (m *QueryFeeDenomPriceResponse) Reset()         { *m = QueryFeeDenomPriceResponse{} }
+func (m *QueryFeeDenomPriceResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryFeeDenomPriceResponse) ProtoMessage()    {}
+func (*QueryFeeDenomPriceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d95f5619ed216c51, []int{6}
+}
+func (m *QueryFeeDenomPriceResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryFeeDenomPriceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryFeeDenomPriceResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryFeeDenomPriceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryFeeDenomPriceResponse.Merge(m, src)
+}
+func (m *QueryFeeDenomPriceResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryFeeDenomPriceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryFeeDenomPriceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryFeeDenomPriceResponse proto.InternalMessageInfo
+
+type QueryBaseFeeHistoryRequest struct {
+	// the number of blocks to return, capped by the base fee history length
+	BlockCount uint64 `protobuf:"varint,1,opt,name=block_count,json=blockCount,proto3" json:"block_count,omitempty"`
+	// the last block to return, defaults to the latest block
+	LastHeight int64 `protobuf:"varint,2,opt,name=last_height,json=lastHeight,proto3" json:"last_height,omitempty"`
+}
+
+func (m *QueryBaseFeeHistoryRequest) Reset()         { *m = QueryBaseFeeHistoryRequest{} }
+func (m *QueryBaseFeeHistoryRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryRequest) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d95f5619ed216c51, []int{7}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryRequest proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryRequest) GetBlockCount() uint64 {
+	if m != nil {
+		return m.BlockCount
+	}
+	return 0
+}
+
+func (m *QueryBaseFeeHistoryRequest) GetLastHeight() int64 {
+	if m != nil {
+		return m.LastHeight
+	}
+	return 0
+}
+
+type QueryBaseFeeHistoryResponse struct {
+	// the block fees in ascending height order
+	BlockFees []BlockFee `protobuf:"bytes,1,rep,name=block_fees,json=blockFees,proto3" json:"block_fees"`
+	// the base fee of the block following the last returned block
+	NextBaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=next_base_fee,json=nextBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"next_base_fee"`
+}
+
+func (m *QueryBaseFeeHistoryResponse) Reset()         { *m = QueryBaseFeeHistoryResponse{} }
+func (m *QueryBaseFeeHistoryResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBaseFeeHistoryResponse) ProtoMessage()    {}
+func (*QueryBaseFeeHistoryResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_d95f5619ed216c51, []int{8}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBaseFeeHistoryResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.Merge(m, src)
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBaseFeeHistoryResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBaseFeeHistoryResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBaseFeeHistoryResponse proto.InternalMessageInfo
+
+func (m *QueryBaseFeeHistoryResponse) GetBlockFees() []BlockFee {
+	if m != nil {
+		return m.BlockFees
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*EipBaseFee)(nil), "injective.txfees.v1beta1.EipBaseFee")
 	proto.RegisterType((*QueryParamsRequest)(nil), "injective.txfees.v1beta1.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "injective.txfees.v1beta1.QueryParamsResponse")
 	proto.RegisterType((*QueryEipBaseFeeRequest)(nil), "injective.txfees.v1beta1.QueryEipBaseFeeRequest")
 	proto.RegisterType((*QueryEipBaseFeeResponse)(nil), "injective.txfees.v1beta1.QueryEipBaseFeeResponse")
+	proto.RegisterType((*QueryFeeDenomPriceRequest)(nil), "injective.txfees.v1beta1.QueryFeeDenomPriceRequest")
+	proto.RegisterType((*QueryFeeDenomPriceResponse)(nil), "injective.txfees.v1beta1.QueryFeeDenomPriceResponse")
+	proto.RegisterType((*QueryBaseFeeHistoryRequest)(nil), "injective.txfees.v1beta1.QueryBaseFeeHistoryRequest")
+	proto.RegisterType((*QueryBaseFeeHistoryResponse)(nil), "injective.txfees.v1beta1.QueryBaseFeeHistoryResponse")
 }
 
 func init() {
@@ -244,36 +443,52 @@ func init() {
 }
 
 var fileDescriptor_d95f5619ed216c51 = []byte{
-	// 454 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0xcf, 0x6a, 0xd4, 0x40,
-	0x18, 0x4f, 0x8a, 0xae, 0x3a, 0x45, 0x84, 0xb1, 0xe8, 0x12, 0x25, 0xbb, 0x0c, 0x15, 0x8a, 0xb4,
-	0x33, 0x6e, 0x04, 0x0f, 0x1e, 0x14, 0x82, 0x7f, 0x10, 0xf6, 0x60, 0x17, 0xbc, 0xf4, 0x12, 0x26,
-	0xe9, 0xb7, 0xd9, 0xd1, 0x4d, 0x26, 0xcd, 0x4c, 0x8a, 0xb9, 0xfa, 0x02, 0x0a, 0xbe, 0x84, 0x0f,
-	0xe2, 0xa1, 0xc7, 0x82, 0x17, 0xf1, 0xb0, 0xc8, 0xae, 0x4f, 0xe0, 0x13, 0xc8, 0x4e, 0x12, 0xbb,
-	0xba, 0x04, 0xed, 0x2d, 0x99, 0xf9, 0xfd, 0xfb, 0x7e, 0xdf, 0xa0, 0x6d, 0x91, 0xbe, 0x86, 0x48,
-	0x8b, 0x63, 0x60, 0xfa, 0xed, 0x18, 0x40, 0xb1, 0xe3, 0x41, 0x08, 0x9a, 0x0f, 0xd8, 0x51, 0x01,
-	0x79, 0x49, 0xb3, 0x5c, 0x6a, 0x89, 0xbb, 0xbf, 0x51, 0xb4, 0x42, 0xd1, 0x1a, 0xe5, 0x6c, 0xc5,
-	0x32, 0x96, 0x06, 0xc4, 0x96, 0x5f, 0x15, 0xde, 0xb9, 0x1d, 0x4b, 0x19, 0x4f, 0x81, 0xf1, 0x4c,
-	0x30, 0x9e, 0xa6, 0x52, 0x73, 0x2d, 0x64, 0xaa, 0xea, 0xdb, 0x3b, 0xad, 0x9e, 0xb5, 0xb8, 0x81,
-	0x91, 0x00, 0xa1, 0xa7, 0x22, 0xf3, 0xb9, 0x82, 0x67, 0x00, 0x78, 0x1f, 0x5d, 0x0e, 0xb9, 0x82,
-	0x60, 0x0c, 0xd0, 0xb5, 0xfb, 0xf6, 0xce, 0x15, 0xff, 0xc1, 0xc9, 0xac, 0x67, 0x7d, 0x9b, 0xf5,
-	0x6e, 0x45, 0x52, 0x25, 0x52, 0xa9, 0xc3, 0x37, 0x54, 0x48, 0x96, 0x70, 0x3d, 0xa1, 0x43, 0x88,
-	0x79, 0x54, 0x3e, 0x81, 0xe8, 0xe7, 0xac, 0x77, 0xad, 0xe4, 0xc9, 0xf4, 0x21, 0x69, 0xc8, 0x64,
-	0x74, 0x29, 0xac, 0x24, 0xc9, 0x16, 0xc2, 0xfb, 0xcb, 0x21, 0x5f, 0xf2, 0x9c, 0x27, 0x6a, 0x04,
-	0x47, 0x05, 0x28, 0x4d, 0x5e, 0xa1, 0xeb, 0x7f, 0x9c, 0xaa, 0x4c, 0xa6, 0x0a, 0xf0, 0x23, 0xd4,
-	0xc9, 0xcc, 0x89, 0x71, 0xdf, 0xf4, 0xfa, 0xb4, 0xad, 0x13, 0x5a, 0x31, 0xfd, 0x0b, 0xcb, 0x7c,
-	0xa3, 0x9a, 0x45, 0xba, 0xe8, 0x86, 0x91, 0x3d, 0x1b, 0xa9, 0x31, 0x3c, 0x40, 0x37, 0xd7, 0x6e,
-	0x6a, 0xd3, 0xc7, 0x7f, 0x0d, 0xbd, 0xe9, 0x6d, 0xb7, 0xdb, 0xae, 0xf0, 0x9b, 0x11, 0xbd, 0xcf,
-	0x1b, 0xe8, 0xa2, 0x11, 0xc7, 0xef, 0x6d, 0xd4, 0xa9, 0x82, 0xe1, 0xdd, 0x76, 0x8d, 0xf5, 0x3e,
-	0x9c, 0xbd, 0xff, 0x44, 0x57, 0x91, 0xc9, 0xce, 0xbb, 0x2f, 0x3f, 0x3e, 0x6e, 0x10, 0xdc, 0x67,
-	0xad, 0x5b, 0xae, 0x1a, 0xc1, 0x9f, 0x6c, 0x74, 0xf5, 0x39, 0xe8, 0x95, 0x1d, 0xdf, 0xfb, 0x87,
-	0xd5, 0x5a, 0x77, 0xce, 0xe0, 0x1c, 0x8c, 0x3a, 0xa0, 0x67, 0x02, 0xee, 0xe2, 0xbb, 0xed, 0x01,
-	0xa3, 0x22, 0x0f, 0x40, 0x64, 0x41, 0xd3, 0xbd, 0x3f, 0x3e, 0x99, 0xbb, 0xf6, 0xe9, 0xdc, 0xb5,
-	0xbf, 0xcf, 0x5d, 0xfb, 0xc3, 0xc2, 0xb5, 0x4e, 0x17, 0xae, 0xf5, 0x75, 0xe1, 0x5a, 0x07, 0xc3,
-	0x58, 0xe8, 0x49, 0x11, 0xd2, 0x48, 0x26, 0xec, 0x45, 0xa3, 0x37, 0xe4, 0xa1, 0x3a, 0x53, 0xdf,
-	0x8b, 0x64, 0x0e, 0xab, 0xbf, 0x13, 0x2e, 0x52, 0x96, 0xc8, 0xc3, 0x62, 0x0a, 0xaa, 0xb1, 0xd6,
-	0x65, 0x06, 0x2a, 0xec, 0x98, 0x97, 0x7f, 0xff, 0x57, 0x00, 0x00, 0x00, 0xff, 0xff, 0xb9, 0xba,
-	0x1f, 0x67, 0x96, 0x03, 0x00, 0x00,
+	// 715 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x4f, 0x4f, 0xdb, 0x48,
+	0x14, 0x8f, 0x03, 0x61, 0x97, 0xc9, 0xb2, 0xbb, 0x9a, 0x65, 0x77, 0xb3, 0x61, 0x9b, 0x44, 0x2e,
+	0x95, 0xd2, 0x16, 0xec, 0x26, 0xd0, 0x4a, 0xed, 0xa1, 0xad, 0x02, 0xe5, 0x8f, 0xc4, 0x01, 0x2c,
+	0xf5, 0xc2, 0xa1, 0xd6, 0xd8, 0x79, 0x38, 0x2e, 0x89, 0xc7, 0x78, 0x26, 0x88, 0x5c, 0xfb, 0x05,
+	0x5a, 0xa9, 0xa7, 0x7e, 0x03, 0x2e, 0x55, 0xd5, 0x6f, 0xc1, 0x11, 0xa9, 0x97, 0xaa, 0x07, 0x54,
+	0x41, 0x3f, 0x41, 0x3f, 0x41, 0x35, 0xe3, 0x71, 0x80, 0x26, 0x16, 0xe1, 0xe6, 0x79, 0x7e, 0xbf,
+	0x3f, 0xef, 0xbd, 0x79, 0x36, 0x9a, 0xf5, 0x83, 0x97, 0xe0, 0x72, 0x7f, 0x1f, 0x4c, 0x7e, 0xb0,
+	0x03, 0xc0, 0xcc, 0xfd, 0x9a, 0x03, 0x9c, 0xd4, 0xcc, 0xbd, 0x2e, 0x44, 0x3d, 0x23, 0x8c, 0x28,
+	0xa7, 0xb8, 0xd0, 0xcf, 0x32, 0xe2, 0x2c, 0x43, 0x65, 0x15, 0xa7, 0x3d, 0xea, 0x51, 0x99, 0x64,
+	0x8a, 0xa7, 0x38, 0xbf, 0xf8, 0xbf, 0x47, 0xa9, 0xd7, 0x06, 0x93, 0x84, 0xbe, 0x49, 0x82, 0x80,
+	0x72, 0xc2, 0x7d, 0x1a, 0x30, 0xf5, 0xf6, 0x56, 0xaa, 0xa6, 0x22, 0x97, 0x69, 0xba, 0x8d, 0xd0,
+	0x33, 0x3f, 0x6c, 0x10, 0x06, 0x2b, 0x00, 0x78, 0x0b, 0xfd, 0xea, 0x10, 0x06, 0xf6, 0x0e, 0x40,
+	0x41, 0xab, 0x68, 0xd5, 0xc9, 0xc6, 0x83, 0xa3, 0x93, 0x72, 0xe6, 0xcb, 0x49, 0x79, 0xc6, 0xa5,
+	0xac, 0x43, 0x19, 0x6b, 0xee, 0x1a, 0x3e, 0x35, 0x3b, 0x84, 0xb7, 0x8c, 0x0d, 0xf0, 0x88, 0xdb,
+	0x5b, 0x06, 0xf7, 0xfb, 0x49, 0xf9, 0x8f, 0x1e, 0xe9, 0xb4, 0x1f, 0xe9, 0x09, 0x58, 0xb7, 0x7e,
+	0x71, 0x62, 0x4a, 0x7d, 0x1a, 0xe1, 0x2d, 0x51, 0xe4, 0x26, 0x89, 0x48, 0x87, 0x59, 0xb0, 0xd7,
+	0x05, 0xc6, 0xf5, 0xe7, 0xe8, 0xaf, 0x4b, 0x51, 0x16, 0xd2, 0x80, 0x01, 0x7e, 0x8c, 0x26, 0x42,
+	0x19, 0x91, 0xea, 0xf9, 0x7a, 0xc5, 0x48, 0xeb, 0x89, 0x11, 0x23, 0x1b, 0xe3, 0xc2, 0x9f, 0xa5,
+	0x50, 0x7a, 0x01, 0xfd, 0x23, 0x69, 0xcf, 0x4b, 0x4a, 0x04, 0xb7, 0xd1, 0xbf, 0x03, 0x6f, 0x94,
+	0xe8, 0x93, 0x9f, 0x8a, 0xce, 0xd7, 0x67, 0xd3, 0x65, 0x2f, 0xe0, 0xfb, 0x25, 0x2e, 0xa1, 0xff,
+	0x24, 0xf7, 0x0a, 0xc0, 0x32, 0x04, 0xb4, 0xb3, 0x19, 0xf9, 0x6e, 0x22, 0x8c, 0xa7, 0x51, 0xae,
+	0x29, 0x82, 0x71, 0x3f, 0xad, 0xf8, 0x80, 0xff, 0x44, 0x63, 0x1e, 0x61, 0x85, 0x6c, 0x45, 0xab,
+	0x8e, 0x5b, 0xe2, 0x51, 0x7f, 0xa7, 0xa1, 0xe2, 0x30, 0x16, 0x65, 0xf2, 0x21, 0xca, 0x85, 0x22,
+	0xa0, 0xc6, 0x72, 0x73, 0x84, 0xb1, 0x58, 0x31, 0x02, 0x3f, 0x45, 0xbf, 0x45, 0xb0, 0xd7, 0xf5,
+	0x23, 0x68, 0xca, 0x1a, 0xb3, 0x92, 0xe1, 0x86, 0x62, 0xf8, 0x7b, 0x90, 0x61, 0x3d, 0xe0, 0x56,
+	0x3e, 0x81, 0x88, 0x02, 0x5f, 0x28, 0x6b, 0xaa, 0xf2, 0x35, 0x9f, 0x71, 0x1a, 0xf5, 0x92, 0x0a,
+	0xcb, 0x28, 0xef, 0xb4, 0xa9, 0xbb, 0x6b, 0xbb, 0xb4, 0x1b, 0x70, 0x69, 0x70, 0xdc, 0x42, 0x32,
+	0xb4, 0x24, 0x22, 0x22, 0xa1, 0x4d, 0x18, 0xb7, 0x5b, 0xe0, 0x7b, 0x2d, 0x2e, 0xf5, 0xc7, 0x2c,
+	0x24, 0x42, 0x6b, 0x32, 0xa2, 0x7f, 0xd0, 0xd0, 0xcc, 0x50, 0x01, 0x55, 0xfc, 0x2a, 0x8a, 0xe9,
+	0x84, 0x7d, 0x71, 0x35, 0xc6, 0xaa, 0xf9, 0xba, 0x9e, 0x3e, 0xa3, 0x86, 0xc8, 0x5d, 0x01, 0x50,
+	0x97, 0x63, 0xd2, 0x51, 0x67, 0x86, 0x57, 0xd1, 0x54, 0x00, 0x07, 0xdc, 0xee, 0xcf, 0x3b, 0x3b,
+	0x7a, 0x37, 0xf3, 0x02, 0xa9, 0x0c, 0xd6, 0x0f, 0x73, 0x28, 0x27, 0x1d, 0xe3, 0xd7, 0x1a, 0x9a,
+	0x88, 0xef, 0x22, 0x9e, 0x4b, 0xb7, 0x34, 0xb8, 0x02, 0xc5, 0xf9, 0x11, 0xb3, 0xe3, 0x1e, 0xe8,
+	0xd5, 0x57, 0x9f, 0xbe, 0xbd, 0xcd, 0xea, 0xb8, 0x62, 0xa6, 0x2e, 0x76, 0xbc, 0x04, 0xf8, 0x50,
+	0x43, 0x53, 0xab, 0xc0, 0x2f, 0xac, 0xf5, 0xbd, 0x2b, 0xa4, 0x06, 0xd6, 0xa5, 0x58, 0xbb, 0x06,
+	0x42, 0x19, 0xac, 0x4b, 0x83, 0x73, 0xf8, 0x4e, 0xba, 0x41, 0xb7, 0x1b, 0xd9, 0xe0, 0x87, 0xfd,
+	0xf6, 0xe3, 0x8f, 0x1a, 0xfa, 0xfd, 0xf2, 0xcc, 0xf1, 0xe2, 0x15, 0xca, 0x43, 0xef, 0x60, 0xf1,
+	0xfe, 0x35, 0x51, 0xa3, 0x7b, 0x4e, 0xbc, 0xda, 0x2d, 0x65, 0xf0, 0xbd, 0x86, 0xa6, 0x2e, 0xed,
+	0x28, 0x5e, 0xb8, 0x42, 0x7c, 0xd8, 0x77, 0xa1, 0xb8, 0x78, 0x3d, 0x90, 0x32, 0x5c, 0x93, 0x86,
+	0xef, 0xe2, 0xdb, 0xe9, 0x86, 0x85, 0x57, 0xf9, 0x91, 0xb1, 0xe5, 0xfa, 0x37, 0x76, 0x8e, 0x4e,
+	0x4b, 0xda, 0xf1, 0x69, 0x49, 0xfb, 0x7a, 0x5a, 0xd2, 0xde, 0x9c, 0x95, 0x32, 0xc7, 0x67, 0xa5,
+	0xcc, 0xe7, 0xb3, 0x52, 0x66, 0x7b, 0xc3, 0xf3, 0x79, 0xab, 0xeb, 0x18, 0x2e, 0xed, 0x98, 0xeb,
+	0x09, 0xdd, 0x06, 0x71, 0xd8, 0x39, 0xf9, 0xbc, 0x4b, 0x23, 0xb8, 0x78, 0x6c, 0x11, 0x3f, 0x30,
+	0x3b, 0xb4, 0xd9, 0x6d, 0x03, 0x4b, 0x94, 0x79, 0x2f, 0x04, 0xe6, 0x4c, 0xc8, 0x1f, 0xca, 0xc2,
+	0x8f, 0x01, 0x00, 0xfe, 0xd4, 0x5c, 0x05, 0xed, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -293,6 +508,11 @@ type QueryClient interface {
 	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
 	// Returns the current fee market EIP fee.
 	GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequest, opts ...grpc.CallOption) (*QueryEipBaseFeeResponse, error)
+	// Returns the consensus base fees of past blocks
+	BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error)
+	// Returns the current price in INJ of a fee denom and the fee required in
+	// that denom for the given gas.
+	FeeDenomPrice(ctx context.Context, in *QueryFeeDenomPriceRequest, opts ...grpc.CallOption) (*QueryFeeDenomPriceResponse, error)
 }
 
 type queryClient struct {
@@ -321,6 +541,24 @@ func (c *queryClient) GetEipBaseFee(ctx context.Context, in *QueryEipBaseFeeRequ
 	return out, nil
 }
 
+func (c *queryClient) BaseFeeHistory(ctx context.Context, in *QueryBaseFeeHistoryRequest, opts ...grpc.CallOption) (*QueryBaseFeeHistoryResponse, error) {
+	out := new(QueryBaseFeeHistoryResponse)
+	err := c.cc.Invoke(ctx, "/injective.txfees.v1beta1.Query/BaseFeeHistory", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) FeeDenomPrice(ctx context.Context, in *QueryFeeDenomPriceRequest, opts ...grpc.CallOption) (*QueryFeeDenomPriceResponse, error) {
+	out := new(QueryFeeDenomPriceResponse)
+	err := c.cc.Invoke(ctx, "/injective.txfees.v1beta1.Query/FeeDenomPrice", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the tokenfactory module's
@@ -328,6 +566,11 @@ type QueryServer interface {
 	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
 	// Returns the current fee market EIP fee.
 	GetEipBaseFee(context.Context, *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error)
+	// Returns the consensus base fees of past blocks
+	BaseFeeHistory(context.Context, *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error)
+	// Returns the current price in INJ of a fee denom and the fee required in
+	// that denom for the given gas.
+	FeeDenomPrice(context.Context, *QueryFeeDenomPriceRequest) (*QueryFeeDenomPriceResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -340,6 +583,12 @@ func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsReq
 func (*UnimplementedQueryServer) GetEipBaseFee(ctx context.Context, req *QueryEipBaseFeeRequest) (*QueryEipBaseFeeResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetEipBaseFee not implemented")
 }
+func (*UnimplementedQueryServer) BaseFeeHistory(ctx context.Context, req *QueryBaseFeeHistoryRequest) (*QueryBaseFeeHistoryResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BaseFeeHistory not implemented")
+}
+func (*UnimplementedQueryServer) FeeDenomPrice(ctx context.Context, req *QueryFeeDenomPriceRequest) (*QueryFeeDenomPriceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method FeeDenomPrice not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -381,6 +630,42 @@ func _Query_GetEipBaseFee_Handler(srv interface{}, ctx context.Context, dec func
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_BaseFeeHistory_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBaseFeeHistoryRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BaseFeeHistory(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.txfees.v1beta1.Query/BaseFeeHistory",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BaseFeeHistory(ctx, req.(*QueryBaseFeeHistoryRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_FeeDenomPrice_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryFeeDenomPriceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).FeeDenomPrice(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/injective.txfees.v1beta1.Query/FeeDenomPrice",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).FeeDenomPrice(ctx, req.(*QueryFeeDenomPriceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "injective.txfees.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -393,6 +678,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GetEipBaseFee",
 			Handler:    _Query_GetEipBaseFee_Handler,
 		},
+		{
+			MethodName: "BaseFeeHistory",
+			Handler:    _Query_BaseFeeHistory_Handler,
+		},
+		{
+			MethodName: "FeeDenomPrice",
+			Handler:    _Query_FeeDenomPrice_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "injective/txfees/v1beta1/query.proto",
@@ -545,77 +838,563 @@ func (m *QueryEipBaseFeeResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *QueryFeeDenomPriceRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *EipBaseFee) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *QueryFeeDenomPriceRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomPriceRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.BaseFee.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.Gas != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Gas))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryFeeDenomPriceResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryFeeDenomPriceResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryFeeDenomPriceResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	{
+		size := m.RequiredFee.Size()
+		i -= size
+		if _, err := m.RequiredFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Price.Size()
+		i -= size
+		if _, err := m.Price.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeHistoryRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	if m.LastHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.LastHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.BlockCount != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryEipBaseFeeRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryBaseFeeHistoryResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBaseFeeHistoryResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.NextBaseFee.Size()
+		i -= size
+		if _, err := m.NextBaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.BlockFees) > 0 {
+		for iNdEx := len(m.BlockFees) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.BlockFees[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *EipBaseFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryEipBaseFeeRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryEipBaseFeeResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BaseFee != nil {
+		l = m.BaseFee.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryFeeDenomPriceRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Gas != 0 {
+		n += 1 + sovQuery(uint64(m.Gas))
+	}
+	return n
+}
+
+func (m *QueryFeeDenomPriceResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Price.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.RequiredFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBaseFeeHistoryRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BlockCount != 0 {
+		n += 1 + sovQuery(uint64(m.BlockCount))
+	}
+	if m.LastHeight != 0 {
+		n += 1 + sovQuery(uint64(m.LastHeight))
+	}
+	return n
+}
+
+func (m *QueryBaseFeeHistoryResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.BlockFees) > 0 {
+		for _, e := range m.BlockFees {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	l = m.NextBaseFee.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *EipBaseFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EipBaseFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EipBaseFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *QueryEipBaseFeeResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	if m.BaseFee != nil {
-		l = m.BaseFee.Size()
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
+	return nil
 }
+func (m *QueryEipBaseFeeRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEipBaseFeeRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEipBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *EipBaseFee) Unmarshal(dAtA []byte) error {
+func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -638,17 +1417,17 @@ func (m *EipBaseFee) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: EipBaseFee: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryEipBaseFeeResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: EipBaseFee: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryEipBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
 				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -658,22 +1437,24 @@ func (m *EipBaseFee) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
+			if m.BaseFee == nil {
+				m.BaseFee = &EipBaseFee{}
+			}
 			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
@@ -699,7 +1480,7 @@ func (m *EipBaseFee) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeDenomPriceRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -722,12 +1503,63 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeDenomPriceRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeDenomPriceRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Gas", wireType)
+			}
+			m.Gas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Gas |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -749,7 +1581,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryFeeDenomPriceResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -772,17 +1604,17 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryFeeDenomPriceResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryFeeDenomPriceResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Price", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -792,22 +1624,57 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Price.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RequiredFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RequiredFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -832,7 +1699,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEipBaseFeeRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeHistoryRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -855,12 +1722,50 @@ func (m *QueryEipBaseFeeRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEipBaseFeeRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEipBaseFeeRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeHistoryRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockCount", wireType)
+			}
+			m.BlockCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastHeight", wireType)
+			}
+			m.LastHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -882,7 +1787,7 @@ func (m *QueryEipBaseFeeRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryBaseFeeHistoryResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -905,15 +1810,15 @@ func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEipBaseFeeResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEipBaseFeeResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryBaseFeeHistoryResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockFees", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -940,10 +1845,42 @@ func (m *QueryEipBaseFeeResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.BaseFee == nil {
-				m.BaseFee = &EipBaseFee{}
+			m.BlockFees = append(m.BlockFees, BlockFee{})
+			if err := m.BlockFees[len(m.BlockFees)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
-			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field NextBaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.NextBaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6,6 +6,7 @@ package types
 import (
 	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	types "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +29,35 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// FeeDenomPriceSource defines where the price of a fee denom in INJ is taken
+// from
+type FeeDenomPriceSource int32
+
+const (
+	// the price is taken from the oracle module
+	FeeDenomPriceSource_Oracle FeeDenomPriceSource = 0
+	// the price is the mid price of an exchange spot market
+	FeeDenomPriceSource_SpotMarket FeeDenomPriceSource = 1
+)
+
+var FeeDenomPriceSource_name = map[int32]string{
+	0: "Oracle",
+	1: "SpotMarket",
+}
+
+var FeeDenomPriceSource_value = map[string]int32{
+	"Oracle":     0,
+	"SpotMarket": 1,
+}
+
+func (x FeeDenomPriceSource) String() string {
+	return proto.EnumName(FeeDenomPriceSource_name, int32(x))
+}
+
+func (FeeDenomPriceSource) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_43abc7238d07d36b, []int{0}
+}
+
 type Params struct {
 	MaxGasWantedPerTx                    uint64                      `protobuf:"varint,1,opt,name=max_gas_wanted_per_tx,json=maxGasWantedPerTx,proto3" json:"max_gas_wanted_per_tx,omitempty" yaml:"max_gas_wanted_per_tx"`
 	HighGasTxThreshold                   uint64                      `protobuf:"varint,2,opt,name=high_gas_tx_threshold,json=highGasTxThreshold,proto3" json:"high_gas_tx_threshold,omitempty" yaml:"high_gas_tx_threshold"`
@@ -42,6 +72,10 @@ type Params struct {
 	RecheckFeeLowBaseFee                 cosmossdk_io_math.LegacyDec `protobuf:"bytes,11,opt,name=recheck_fee_low_base_fee,json=recheckFeeLowBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recheck_fee_low_base_fee" yaml:"recheck_fee_low_base_fee"`
 	RecheckFeeHighBaseFee                cosmossdk_io_math.LegacyDec `protobuf:"bytes,12,opt,name=recheck_fee_high_base_fee,json=recheckFeeHighBaseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recheck_fee_high_base_fee" yaml:"recheck_fee_high_base_fee"`
 	RecheckFeeBaseFeeThresholdMultiplier cosmossdk_io_math.LegacyDec `protobuf:"bytes,13,opt,name=recheck_fee_base_fee_threshold_multiplier,json=recheckFeeBaseFeeThresholdMultiplier,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"recheck_fee_base_fee_threshold_multiplier" yaml:"recheck_fee_base_fee_threshold_multiplier"`
+	// the non-INJ denoms accepted as tx fees
+	FeeDenoms []FeeDenom `protobuf:"bytes,14,rep,name=fee_denoms,json=feeDenoms,proto3" json:"fee_denoms" yaml:"fee_denoms"`
+	// the number of past blocks for which the base fee is kept in state
+	BaseFeeHistoryLength uint64 `protobuf:"varint,15,opt,name=base_fee_history_length,json=baseFeeHistoryLength,proto3" json:"base_fee_history_length,omitempty" yaml:"base_fee_history_length"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -105,9 +139,200 @@ func (m *Params) GetResetInterval() int64 {
 	return 0
 }
 
+func (m *Params) GetFeeDenoms() []FeeDenom {
+	if m != nil {
+		return m.FeeDenoms
+	}
+	return nil
+}
+
+func (m *Params) GetBaseFeeHistoryLength() uint64 {
+	if m != nil {
+		return m.BaseFeeHistoryLength
+	}
+	return 0
+}
+
+// FeeDenom defines a non-INJ denom that can be used to pay tx fees. The
+// required INJ fee is converted to the fee denom at its current price in INJ.
+// The collected fees are swapped to INJ on the spot market if one is set, and
+// burned otherwise.
+type FeeDenom struct {
+	Denom       string              `protobuf:"bytes,1,opt,name=denom,proto3" json:"denom,omitempty" yaml:"denom"`
+	PriceSource FeeDenomPriceSource `protobuf:"varint,2,opt,name=price_source,json=priceSource,proto3,enum=injective.txfees.v1beta1.FeeDenomPriceSource" json:"price_source,omitempty" yaml:"price_source"`
+	// the oracle type, used with the Oracle price source
+	OracleType types.OracleType `protobuf:"varint,3,opt,name=oracle_type,json=oracleType,proto3,enum=injective.oracle.v1beta1.OracleType" json:"oracle_type,omitempty" yaml:"oracle_type"`
+	// the oracle symbol of the fee denom, used with the Oracle price source
+	OracleBase string `protobuf:"bytes,4,opt,name=oracle_base,json=oracleBase,proto3" json:"oracle_base,omitempty" yaml:"oracle_base"`
+	// the oracle symbol of INJ, used with the Oracle price source
+	OracleQuote string `protobuf:"bytes,5,opt,name=oracle_quote,json=oracleQuote,proto3" json:"oracle_quote,omitempty" yaml:"oracle_quote"`
+	// the decimals of the fee denom, used with the Oracle price source
+	Decimals uint32 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty" yaml:"decimals"`
+	// the spot market between the fee denom and INJ, used to price the fee denom
+	// with the SpotMarket price source and to swap the collected fees
+	MarketId string `protobuf:"bytes,7,opt,name=market_id,json=marketId,proto3" json:"market_id,omitempty" yaml:"market_id"`
+	// the max slippage from the fee denom price accepted when swapping the
+	// collected fees
+	MaxSwapSlippage cosmossdk_io_math.LegacyDec `protobuf:"bytes,8,opt,name=max_swap_slippage,json=maxSwapSlippage,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"max_swap_slippage" yaml:"max_swap_slippage"`
+}
+
+func (m *FeeDenom) Reset()         { *m = FeeDenom{} }
+func (m *FeeDenom) String() string { return proto.CompactTextString(m) }
+func (*FeeDenom) ProtoMessage()    {}
+func (*FeeDenom) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43abc7238d07d36b, []int{1}
+}
+func (m *FeeDenom) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FeeDenom) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FeeDenom.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FeeDenom) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FeeDenom.Merge(m, src)
+}
+func (m *FeeDenom) XXX_Size() int {
+	return m.Size()
+}
+func (m *FeeDenom) XXX_DiscardUnknown() {
+	xxx_messageInfo_FeeDenom.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FeeDenom proto.InternalMessageInfo
+
+func (m *FeeDenom) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetPriceSource() FeeDenomPriceSource {
+	if m != nil {
+		return m.PriceSource
+	}
+	return FeeDenomPriceSource_Oracle
+}
+
+func (m *FeeDenom) GetOracleType() types.OracleType {
+	if m != nil {
+		return m.OracleType
+	}
+	return types.OracleType_Unspecified
+}
+
+func (m *FeeDenom) GetOracleBase() string {
+	if m != nil {
+		return m.OracleBase
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetOracleQuote() string {
+	if m != nil {
+		return m.OracleQuote
+	}
+	return ""
+}
+
+func (m *FeeDenom) GetDecimals() uint32 {
+	if m != nil {
+		return m.Decimals
+	}
+	return 0
+}
+
+func (m *FeeDenom) GetMarketId() string {
+	if m != nil {
+		return m.MarketId
+	}
+	return ""
+}
+
+// BlockFee records the consensus base fee of a block along with the gas used
+// to compute the base fee of the next block
+type BlockFee struct {
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// the base fee in effect during the block
+	BaseFee cosmossdk_io_math.LegacyDec `protobuf:"bytes,2,opt,name=base_fee,json=baseFee,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"base_fee"`
+	// the gas used by the block
+	GasUsed uint64 `protobuf:"varint,3,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// the target gas of the block
+	TargetGas int64 `protobuf:"varint,4,opt,name=target_gas,json=targetGas,proto3" json:"target_gas,omitempty"`
+}
+
+func (m *BlockFee) Reset()         { *m = BlockFee{} }
+func (m *BlockFee) String() string { return proto.CompactTextString(m) }
+func (*BlockFee) ProtoMessage()    {}
+func (*BlockFee) Descriptor() ([]byte, []int) {
+	return fileDescriptor_43abc7238d07d36b, []int{2}
+}
+func (m *BlockFee) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *BlockFee) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_BlockFee.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *BlockFee) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_BlockFee.Merge(m, src)
+}
+func (m *BlockFee) XXX_Size() int {
+	return m.Size()
+}
+func (m *BlockFee) XXX_DiscardUnknown() {
+	xxx_messageInfo_BlockFee.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_BlockFee proto.InternalMessageInfo
+
+func (m *BlockFee) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *BlockFee) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *BlockFee) GetTargetGas() int64 {
+	if m != nil {
+		return m.TargetGas
+	}
+	return 0
+}
+
 func init() {
+	proto.RegisterEnum("injective.txfees.v1beta1.FeeDenomPriceSource", FeeDenomPriceSource_name, FeeDenomPriceSource_value)
+	golang_proto.RegisterEnum("injective.txfees.v1beta1.FeeDenomPriceSource", FeeDenomPriceSource_name, FeeDenomPriceSource_value)
 	proto.RegisterType((*Params)(nil), "injective.txfees.v1beta1.Params")
 	golang_proto.RegisterType((*Params)(nil), "injective.txfees.v1beta1.Params")
+	proto.RegisterType((*FeeDenom)(nil), "injective.txfees.v1beta1.FeeDenom")
+	golang_proto.RegisterType((*FeeDenom)(nil), "injective.txfees.v1beta1.FeeDenom")
+	proto.RegisterType((*BlockFee)(nil), "injective.txfees.v1beta1.BlockFee")
+	golang_proto.RegisterType((*BlockFee)(nil), "injective.txfees.v1beta1.BlockFee")
 }
 
 func init() {
@@ -118,57 +343,83 @@ func init() {
 }
 
 var fileDescriptor_43abc7238d07d36b = []byte{
-	// 791 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x95, 0xcf, 0x6b, 0xe3, 0x46,
-	0x14, 0xc7, 0xad, 0x26, 0x4d, 0x13, 0x25, 0x2e, 0x44, 0xb5, 0x1b, 0xe5, 0x47, 0x25, 0x23, 0xd2,
-	0xe2, 0x16, 0x6a, 0x35, 0x94, 0x1c, 0x9a, 0x5e, 0x8a, 0xd3, 0xd8, 0x0d, 0x75, 0xa8, 0x51, 0x02,
-	0x81, 0x5e, 0xc4, 0x58, 0x7e, 0x96, 0x94, 0x48, 0x1a, 0xa1, 0x19, 0x3b, 0x0a, 0x94, 0x42, 0x6f,
-	0x65, 0xf7, 0xb2, 0xb0, 0xa7, 0xbd, 0xed, 0x9f, 0x90, 0x3f, 0x61, 0x8f, 0x39, 0xe6, 0xb8, 0xec,
-	0xc1, 0x2c, 0xf1, 0x61, 0xf7, 0xec, 0xbf, 0x60, 0xd1, 0x48, 0x96, 0x95, 0xb5, 0xc9, 0xea, 0x62,
-	0x34, 0xdf, 0xf7, 0xe6, 0x3b, 0x9f, 0xf1, 0xcc, 0x9b, 0xc7, 0x7f, 0x6b, 0x7b, 0x17, 0x60, 0x50,
-	0x7b, 0x00, 0x2a, 0x0d, 0x7b, 0x00, 0x44, 0x1d, 0xec, 0x75, 0x80, 0xa2, 0xbd, 0x64, 0x58, 0xf3,
-	0x03, 0x4c, 0xb1, 0x20, 0xa6, 0x69, 0xb5, 0x44, 0x4f, 0xd2, 0xb6, 0xd6, 0x91, 0x6b, 0x7b, 0x58,
-	0x65, 0xbf, 0x71, 0xf2, 0x56, 0xc9, 0xc4, 0x26, 0x66, 0x9f, 0x6a, 0xf4, 0x95, 0xa8, 0x3b, 0x26,
-	0xc6, 0xa6, 0x03, 0x2a, 0xf2, 0x6d, 0x15, 0x79, 0x1e, 0xa6, 0x88, 0xda, 0xd8, 0x4b, 0x16, 0x50,
-	0x5e, 0xac, 0xf1, 0x4b, 0x6d, 0x14, 0x20, 0x97, 0x08, 0x1a, 0x5f, 0x76, 0x51, 0xa8, 0x9b, 0x88,
-	0xe8, 0x57, 0xc8, 0xa3, 0xd0, 0xd5, 0x7d, 0x08, 0x74, 0x1a, 0x8a, 0x5c, 0x85, 0xab, 0x2e, 0xd6,
-	0x2b, 0xe3, 0xa1, 0xbc, 0x73, 0x8d, 0x5c, 0xe7, 0x40, 0x99, 0x9b, 0xa6, 0x68, 0xeb, 0x2e, 0x0a,
-	0x9b, 0x88, 0x9c, 0x33, 0xb5, 0x0d, 0xc1, 0x59, 0x28, 0x9c, 0xf2, 0x65, 0xcb, 0x36, 0x2d, 0x96,
-	0x4d, 0x43, 0x9d, 0x5a, 0x01, 0x10, 0x0b, 0x3b, 0x5d, 0xf1, 0xb3, 0x8f, 0x3d, 0xe7, 0xa6, 0x29,
-	0x9a, 0x10, 0xe9, 0x4d, 0x44, 0xce, 0xc2, 0xb3, 0x89, 0x28, 0x3c, 0xe5, 0xf8, 0x6f, 0x5c, 0xdb,
-	0x63, 0xd9, 0x7e, 0x60, 0x1b, 0xa0, 0xf7, 0x70, 0xa0, 0x67, 0x0c, 0xc4, 0x85, 0x0a, 0x57, 0x5d,
-	0xa9, 0xff, 0x79, 0x3b, 0x94, 0x0b, 0x6f, 0x86, 0xf2, 0xb6, 0x81, 0x89, 0x8b, 0x09, 0xe9, 0x5e,
-	0xd6, 0x6c, 0xac, 0xba, 0x88, 0x5a, 0xb5, 0x16, 0x98, 0xc8, 0xb8, 0xfe, 0x1d, 0x8c, 0xf1, 0x50,
-	0xde, 0x4d, 0x36, 0xf5, 0x98, 0xa3, 0xa2, 0x6d, 0xb8, 0xb6, 0xd7, 0x44, 0xa4, 0x1d, 0x45, 0x1b,
-	0x38, 0xf8, 0x63, 0x82, 0x25, 0xfc, 0xc5, 0x7f, 0xe5, 0x82, 0xeb, 0x63, 0xec, 0xec, 0xed, 0xef,
-	0xff, 0xa2, 0x83, 0x87, 0x3a, 0x0e, 0x74, 0xc5, 0xc5, 0x0a, 0x57, 0x5d, 0xae, 0x4b, 0xe3, 0xa1,
-	0xbc, 0x95, 0xf8, 0xcf, 0x26, 0x29, 0x9a, 0x90, 0x51, 0x8f, 0x62, 0x51, 0xd0, 0xf9, 0xe2, 0x03,
-	0x16, 0xf1, 0x73, 0xb6, 0x9b, 0x5f, 0xf3, 0xed, 0xa6, 0x34, 0x67, 0x37, 0x8a, 0xb6, 0x9a, 0xa1,
-	0x17, 0xfe, 0xe7, 0xf8, 0xed, 0x2e, 0xf4, 0x50, 0xdf, 0xa1, 0x7a, 0x07, 0x11, 0xd0, 0x7b, 0x00,
-	0xba, 0xdb, 0x77, 0xa8, 0xed, 0x3b, 0x36, 0x04, 0xe2, 0x12, 0x5b, 0xef, 0x38, 0xdf, 0x7a, 0x4a,
-	0xbc, 0xde, 0x23, 0x7e, 0x8a, 0x26, 0x26, 0xd1, 0x3a, 0x22, 0xd0, 0x00, 0x38, 0x49, 0x43, 0xc2,
-	0x3f, 0xfc, 0x46, 0x74, 0x99, 0xe6, 0x51, 0x7c, 0xc1, 0x28, 0x8e, 0xf2, 0x51, 0x48, 0xd3, 0x8b,
-	0x39, 0x97, 0xa0, 0xe4, 0xa2, 0x70, 0x76, 0xf5, 0xdf, 0xf8, 0x2f, 0x03, 0x20, 0x40, 0x75, 0xdb,
-	0xa3, 0x10, 0x0c, 0x90, 0x23, 0x2e, 0x57, 0xb8, 0xea, 0x42, 0x7d, 0x73, 0x3c, 0x94, 0xcb, 0xb1,
-	0xe3, 0xc3, 0xb8, 0xa2, 0x15, 0x99, 0x70, 0x9c, 0x8c, 0x85, 0x41, 0x5c, 0x33, 0x1d, 0x07, 0x1b,
-	0x97, 0xba, 0x61, 0x21, 0xcf, 0x04, 0x3d, 0x40, 0x14, 0xc4, 0x15, 0x46, 0x7f, 0x98, 0x8f, 0x3e,
-	0x53, 0x56, 0x33, 0x4e, 0xd1, 0x1d, 0x41, 0x61, 0x3d, 0x92, 0x0f, 0x99, 0xaa, 0x21, 0x0a, 0xc2,
-	0x73, 0x8e, 0x97, 0x29, 0x0a, 0x4c, 0xa0, 0xc9, 0x0c, 0xe2, 0x23, 0x03, 0xa2, 0x4a, 0x34, 0xc0,
-	0xa3, 0x31, 0x02, 0xcf, 0x10, 0x4e, 0xf2, 0x21, 0x7c, 0x17, 0x23, 0x7c, 0xc2, 0x53, 0xd1, 0xb6,
-	0xe3, 0x0c, 0xc6, 0x73, 0x1a, 0xc5, 0xdb, 0x71, 0x98, 0x51, 0xfd, 0xcb, 0x8b, 0x01, 0x18, 0x16,
-	0x18, 0x97, 0xec, 0x00, 0x1c, 0x7c, 0x95, 0x9e, 0x86, 0xb8, 0xca, 0x68, 0x1a, 0xf9, 0x68, 0xe4,
-	0xc9, 0x9f, 0x3f, 0xdf, 0x4c, 0xd1, 0x4a, 0x49, 0xa8, 0x01, 0xd0, 0xc2, 0x57, 0xc9, 0xc9, 0x0a,
-	0xff, 0x71, 0xfc, 0x66, 0x76, 0x0e, 0x2b, 0xe0, 0x94, 0x60, 0x8d, 0x11, 0x34, 0xf3, 0x11, 0x54,
-	0x66, 0x09, 0x1e, 0xb8, 0x29, 0x5a, 0x79, 0x8a, 0x10, 0x3d, 0x06, 0x13, 0x86, 0x1b, 0x8e, 0xff,
-	0x3e, 0x3b, 0x2b, 0xbd, 0x8e, 0xe9, 0xa3, 0x96, 0xbd, 0xe4, 0x45, 0xc6, 0x74, 0x9e, 0x8f, 0xe9,
-	0xa7, 0x59, 0xa6, 0x47, 0xdd, 0x15, 0x6d, 0x77, 0xca, 0x98, 0xf0, 0xa5, 0xaf, 0xe8, 0xb4, 0x0c,
-	0x0e, 0xbe, 0x7e, 0xff, 0x52, 0xe6, 0x9e, 0xbc, 0xbb, 0xf9, 0xa1, 0x98, 0xb4, 0xa2, 0xb8, 0x21,
-	0xd4, 0x2f, 0x6e, 0xef, 0x25, 0xee, 0xee, 0x5e, 0xe2, 0xde, 0xde, 0x4b, 0xdc, 0xb3, 0x91, 0x54,
-	0x78, 0x35, 0x92, 0xb8, 0xbb, 0x91, 0x54, 0x78, 0x3d, 0x92, 0x0a, 0x7f, 0xb7, 0x4c, 0x9b, 0x5a,
-	0xfd, 0x4e, 0xcd, 0xc0, 0xae, 0x7a, 0x3c, 0xe9, 0x52, 0x2d, 0xd4, 0x21, 0x6a, 0xda, 0xb3, 0x7e,
-	0x34, 0x70, 0x00, 0xd9, 0xa1, 0x85, 0x6c, 0x4f, 0x75, 0x71, 0xb7, 0xef, 0x00, 0x99, 0xf4, 0x3d,
-	0x7a, 0xed, 0x03, 0xe9, 0x2c, 0xb1, 0x76, 0xf4, 0xf3, 0x87, 0x00, 0x00, 0x00, 0xff, 0xff, 0x37,
-	0x32, 0xb4, 0xd8, 0x18, 0x07, 0x00, 0x00,
+	// 1216 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x96, 0xcf, 0x6f, 0xdc, 0x44,
+	0x14, 0xc7, 0xe3, 0x26, 0x4d, 0x77, 0x27, 0x3f, 0x9a, 0x4e, 0x92, 0xc6, 0x49, 0xdb, 0xf5, 0x6a,
+	0x28, 0x55, 0xa8, 0xd4, 0x5d, 0x52, 0x54, 0x21, 0x82, 0x04, 0x68, 0xdb, 0x26, 0x8d, 0x48, 0xd5,
+	0x30, 0x09, 0xaa, 0x40, 0x20, 0x6b, 0xd6, 0xfb, 0x62, 0xbb, 0xb1, 0x3d, 0xc6, 0x33, 0x9b, 0x6c,
+	0x24, 0x84, 0xc4, 0x0d, 0xc1, 0x05, 0x89, 0x2b, 0x07, 0xfe, 0x84, 0xfe, 0x09, 0x1c, 0x7b, 0xac,
+	0x38, 0x21, 0x0e, 0x2b, 0xd4, 0x1c, 0xe0, 0xbc, 0x7f, 0x01, 0xf2, 0x8c, 0xd7, 0xeb, 0x90, 0x6d,
+	0xba, 0x97, 0xd5, 0xce, 0x7b, 0x6f, 0xbe, 0xef, 0xe3, 0xf1, 0x9b, 0xf7, 0x8c, 0xde, 0xf6, 0xa3,
+	0x67, 0xe0, 0x48, 0xff, 0x10, 0xea, 0xb2, 0xb3, 0x0f, 0x20, 0xea, 0x87, 0x6b, 0x4d, 0x90, 0x6c,
+	0x2d, 0x5b, 0xd6, 0xe2, 0x84, 0x4b, 0x8e, 0xcd, 0x3c, 0xac, 0x96, 0xd9, 0xb3, 0xb0, 0x95, 0x2b,
+	0x2c, 0xf4, 0x23, 0x5e, 0x57, 0xbf, 0x3a, 0x78, 0x65, 0xc1, 0xe5, 0x2e, 0x57, 0x7f, 0xeb, 0xe9,
+	0xbf, 0xcc, 0x7a, 0xdd, 0xe5, 0xdc, 0x0d, 0xa0, 0xce, 0x62, 0xbf, 0xce, 0xa2, 0x88, 0x4b, 0x26,
+	0x7d, 0x1e, 0x65, 0x09, 0x56, 0x0a, 0x1c, 0x3c, 0x61, 0x4e, 0x00, 0x39, 0x87, 0x5e, 0xea, 0x30,
+	0xf2, 0xc7, 0x0c, 0x9a, 0xdc, 0x61, 0x09, 0x0b, 0x05, 0xa6, 0x68, 0x31, 0x64, 0x1d, 0xdb, 0x65,
+	0xc2, 0x3e, 0x62, 0x91, 0x84, 0x96, 0x1d, 0x43, 0x62, 0xcb, 0x8e, 0x69, 0x54, 0x8d, 0xd5, 0x89,
+	0x46, 0xb5, 0xd7, 0xb5, 0xae, 0x1f, 0xb3, 0x30, 0x58, 0x27, 0x43, 0xc3, 0x08, 0xbd, 0x12, 0xb2,
+	0xce, 0x26, 0x13, 0x4f, 0x95, 0x75, 0x07, 0x92, 0xbd, 0x0e, 0xde, 0x45, 0x8b, 0x9e, 0xef, 0x7a,
+	0x2a, 0x5a, 0x76, 0x6c, 0xe9, 0x25, 0x20, 0x3c, 0x1e, 0xb4, 0xcc, 0x0b, 0xff, 0xd7, 0x1c, 0x1a,
+	0x46, 0x28, 0x4e, 0xed, 0x9b, 0x4c, 0xec, 0x75, 0xf6, 0xfa, 0x46, 0xfc, 0x93, 0x81, 0x6e, 0x84,
+	0x7e, 0xa4, 0xa2, 0xe3, 0xc4, 0x77, 0xc0, 0xde, 0xe7, 0x89, 0x5d, 0x10, 0x30, 0xc7, 0xab, 0xc6,
+	0x6a, 0xb9, 0xf1, 0xe9, 0x8b, 0xae, 0x35, 0xf6, 0x57, 0xd7, 0xba, 0xe6, 0x70, 0x11, 0x72, 0x21,
+	0x5a, 0x07, 0x35, 0x9f, 0xd7, 0x43, 0x26, 0xbd, 0xda, 0x36, 0xb8, 0xcc, 0x39, 0x7e, 0x00, 0x4e,
+	0xaf, 0x6b, 0xdd, 0xcc, 0x1e, 0xea, 0x3c, 0x45, 0x42, 0x97, 0x42, 0x3f, 0xda, 0x64, 0x62, 0x27,
+	0xf5, 0x6e, 0xf0, 0xe4, 0x51, 0x1f, 0x0b, 0x3f, 0x41, 0xf3, 0x21, 0x84, 0x31, 0xe7, 0xc1, 0xda,
+	0xbd, 0x7b, 0x1f, 0xd8, 0x10, 0xb1, 0x66, 0x00, 0x2d, 0x73, 0xa2, 0x6a, 0xac, 0x96, 0x1a, 0x95,
+	0x5e, 0xd7, 0x5a, 0xc9, 0xf4, 0xcf, 0x06, 0x11, 0x8a, 0x0b, 0xd6, 0x87, 0xda, 0x88, 0x6d, 0x34,
+	0x73, 0x8a, 0xc5, 0xbc, 0xa8, 0x9e, 0xe6, 0xc3, 0xd1, 0x9e, 0x66, 0x61, 0xc8, 0xd3, 0x10, 0x3a,
+	0x55, 0xa0, 0xc7, 0x3f, 0x18, 0xe8, 0x5a, 0x0b, 0xf6, 0x59, 0x3b, 0x90, 0x76, 0x93, 0x09, 0xb0,
+	0xf7, 0x01, 0xec, 0xb0, 0x1d, 0x48, 0x3f, 0x0e, 0x7c, 0x48, 0xcc, 0x49, 0x95, 0x6f, 0x6b, 0xb4,
+	0x7c, 0x44, 0xe7, 0x3b, 0x47, 0x8f, 0x50, 0x33, 0xf3, 0x36, 0x98, 0x80, 0x0d, 0x80, 0xc7, 0xb9,
+	0x0b, 0x7f, 0x8b, 0x96, 0xd2, 0x62, 0x1a, 0x46, 0x71, 0x49, 0x51, 0x3c, 0x1c, 0x8d, 0xa2, 0x32,
+	0x28, 0xcc, 0xa1, 0x04, 0x0b, 0x21, 0xeb, 0x9c, 0xcd, 0xfe, 0x09, 0x9a, 0x4d, 0x40, 0x80, 0xb4,
+	0xfd, 0x48, 0x42, 0x72, 0xc8, 0x02, 0xb3, 0x54, 0x35, 0x56, 0xc7, 0x1b, 0xcb, 0xbd, 0xae, 0xb5,
+	0xa8, 0x15, 0x4f, 0xfb, 0x09, 0x9d, 0x51, 0x86, 0xad, 0x6c, 0x8d, 0x0f, 0xf5, 0x9d, 0x69, 0x06,
+	0xdc, 0x39, 0xb0, 0x1d, 0x8f, 0x45, 0x2e, 0xd8, 0x09, 0x93, 0x60, 0x96, 0x15, 0xfd, 0xfd, 0xd1,
+	0xe8, 0x0b, 0xd7, 0xea, 0x8c, 0x52, 0x5a, 0x23, 0xac, 0xd3, 0x48, 0xcd, 0xf7, 0x95, 0x95, 0x32,
+	0x09, 0xf8, 0x17, 0x03, 0x59, 0x92, 0x25, 0x2e, 0xc8, 0x6c, 0x87, 0x88, 0x99, 0x03, 0xe9, 0x4d,
+	0x74, 0x20, 0x92, 0x1a, 0x01, 0x29, 0x84, 0xc7, 0xa3, 0x21, 0xdc, 0xd2, 0x08, 0x6f, 0xd0, 0x24,
+	0xf4, 0x9a, 0x8e, 0x50, 0x3c, 0xbb, 0xa9, 0x7f, 0x47, 0xbb, 0x15, 0xd5, 0x77, 0xc8, 0x4c, 0xc0,
+	0xf1, 0xc0, 0x39, 0x50, 0x2f, 0x20, 0xe0, 0x47, 0xf9, 0xdb, 0x30, 0xa7, 0x14, 0xcd, 0xc6, 0x68,
+	0x34, 0x56, 0xff, 0xf0, 0x87, 0x8b, 0x11, 0xba, 0x90, 0xb9, 0x36, 0x00, 0xb6, 0xf9, 0x51, 0xf6,
+	0x66, 0xf1, 0xf7, 0x06, 0x5a, 0x2e, 0xee, 0x51, 0x17, 0x38, 0x27, 0x98, 0x56, 0x04, 0x9b, 0xa3,
+	0x11, 0x54, 0xcf, 0x12, 0x9c, 0x52, 0x23, 0x74, 0x71, 0x80, 0x90, 0x36, 0x83, 0x3e, 0xc3, 0x73,
+	0x03, 0xbd, 0x53, 0xdc, 0x95, 0x97, 0x63, 0xde, 0xd4, 0x8a, 0x45, 0x3e, 0xa3, 0x98, 0x9e, 0x8e,
+	0xc6, 0xf4, 0xee, 0x59, 0xa6, 0x73, 0xd5, 0x09, 0xbd, 0x39, 0x60, 0xcc, 0xf8, 0xf2, 0x2e, 0x5a,
+	0xb8, 0x06, 0x5f, 0x21, 0x94, 0x4a, 0xb4, 0x20, 0xe2, 0xa1, 0x30, 0x67, 0xab, 0xe3, 0xab, 0x53,
+	0x77, 0x49, 0xed, 0x75, 0x03, 0xaa, 0xb6, 0x01, 0xf0, 0x20, 0x0d, 0x6d, 0x2c, 0xa7, 0xd8, 0xbd,
+	0xae, 0x75, 0x45, 0x73, 0x0d, 0x34, 0x08, 0x2d, 0xef, 0x67, 0x41, 0x02, 0x7f, 0x81, 0x96, 0x72,
+	0x4a, 0xcf, 0x17, 0x92, 0x27, 0xc7, 0x76, 0x00, 0x91, 0x2b, 0x3d, 0xf3, 0xb2, 0x1a, 0x02, 0x64,
+	0x70, 0x7f, 0x5f, 0x13, 0x48, 0xe8, 0x42, 0x53, 0xe3, 0x3f, 0xd2, 0xf6, 0x6d, 0x65, 0x5e, 0xbf,
+	0xfa, 0xef, 0x6f, 0x96, 0xf1, 0xe3, 0x3f, 0xcf, 0x6f, 0xcf, 0x64, 0xa3, 0x56, 0x4f, 0x32, 0xf2,
+	0x62, 0x02, 0x95, 0xfa, 0x94, 0xf8, 0x16, 0xba, 0xa8, 0xa8, 0xd4, 0x18, 0x2b, 0x37, 0xe6, 0x7a,
+	0x5d, 0x6b, 0xba, 0xdf, 0xb3, 0x22, 0x1e, 0x12, 0xaa, 0xdd, 0xd8, 0x47, 0xd3, 0xba, 0xf5, 0x0b,
+	0xde, 0x4e, 0x1c, 0x50, 0x13, 0x6a, 0xf6, 0xee, 0x9d, 0x37, 0x9f, 0x83, 0x6a, 0xaa, 0xbb, 0x6a,
+	0x53, 0x63, 0xa9, 0xd7, 0xb5, 0xe6, 0xb5, 0x7a, 0x51, 0x8c, 0xd0, 0xa9, 0x78, 0x10, 0x85, 0xbf,
+	0x46, 0x53, 0x7a, 0x08, 0xdb, 0xf2, 0x38, 0x06, 0x35, 0xad, 0x66, 0xef, 0xde, 0x2c, 0x64, 0xd2,
+	0xde, 0x3c, 0xd3, 0x13, 0xb5, 0xdc, 0x3b, 0x8e, 0xa1, 0x71, 0xb5, 0xd7, 0xb5, 0xb0, 0x4e, 0x50,
+	0x90, 0x20, 0x14, 0xf1, 0x3c, 0x06, 0xbf, 0x9f, 0xcb, 0xa7, 0xa7, 0xa6, 0x26, 0x51, 0x79, 0xc8,
+	0xc6, 0xd4, 0x99, 0x6f, 0x4c, 0xcb, 0x03, 0xaf, 0xa3, 0xe9, 0xcc, 0xf7, 0x4d, 0x9b, 0xcb, 0xfe,
+	0xe0, 0x29, 0x3c, 0x53, 0xd1, 0x4b, 0x68, 0x96, 0xe5, 0xb3, 0x74, 0x85, 0xeb, 0xa8, 0xd4, 0x02,
+	0xc7, 0x0f, 0x59, 0x20, 0xd4, 0x00, 0x99, 0x69, 0xcc, 0xf7, 0xba, 0xd6, 0xe5, 0xfe, 0x49, 0x6b,
+	0x0f, 0xa1, 0x79, 0x10, 0x5e, 0x43, 0xe5, 0x90, 0x25, 0x07, 0x69, 0x77, 0x6d, 0x65, 0xcd, 0x7e,
+	0xa1, 0xd7, 0xb5, 0xe6, 0xfa, 0xbd, 0x30, 0x73, 0x11, 0x5a, 0xd2, 0xff, 0xb7, 0x5a, 0xf8, 0x00,
+	0xa5, 0x9f, 0x18, 0xb6, 0x38, 0x62, 0xb1, 0x2d, 0x02, 0x3f, 0x8e, 0x99, 0x0b, 0xaa, 0x65, 0x97,
+	0x1b, 0x1f, 0x8f, 0x76, 0x85, 0xcc, 0x41, 0xa7, 0x3d, 0xa5, 0x42, 0xe8, 0xe5, 0x90, 0x75, 0x76,
+	0x8f, 0x58, 0xbc, 0x9b, 0x59, 0xd6, 0x27, 0xd2, 0xe2, 0x22, 0xbf, 0x1a, 0xa8, 0xa4, 0x9a, 0x5d,
+	0x7a, 0xb7, 0xaf, 0xa2, 0x49, 0x0f, 0x7c, 0xd7, 0x93, 0xaa, 0x96, 0xc6, 0x69, 0xb6, 0xc2, 0x1f,
+	0xa1, 0x52, 0xde, 0x65, 0x2e, 0x28, 0x9c, 0xb7, 0x46, 0xc0, 0xa1, 0x97, 0xb2, 0xa2, 0xc6, 0xcb,
+	0xa8, 0x94, 0xce, 0xea, 0xb6, 0x80, 0x96, 0x2a, 0x86, 0x09, 0x7a, 0xc9, 0x65, 0xe2, 0x73, 0x01,
+	0x2d, 0x7c, 0x03, 0xa1, 0xac, 0x27, 0xbb, 0x4c, 0xa8, 0x57, 0x39, 0x4e, 0xcb, 0xda, 0xb2, 0xc9,
+	0xc4, 0xed, 0x35, 0x34, 0x3f, 0xa4, 0x0c, 0x31, 0x42, 0x93, 0xba, 0x66, 0xe6, 0xc6, 0xf0, 0x2c,
+	0x42, 0xbb, 0x31, 0x97, 0x8f, 0xd5, 0x21, 0xce, 0x19, 0x8d, 0x67, 0x2f, 0x5e, 0x55, 0x8c, 0x97,
+	0xaf, 0x2a, 0xc6, 0xdf, 0xaf, 0x2a, 0xc6, 0xcf, 0x27, 0x95, 0xb1, 0xdf, 0x4f, 0x2a, 0xc6, 0xcb,
+	0x93, 0xca, 0xd8, 0x9f, 0x27, 0x95, 0xb1, 0x2f, 0xb7, 0x5d, 0x5f, 0x7a, 0xed, 0x66, 0xcd, 0xe1,
+	0x61, 0x7d, 0xab, 0x5f, 0x8f, 0xdb, 0xac, 0x29, 0xea, 0x79, 0x75, 0xde, 0x71, 0x78, 0x02, 0xc5,
+	0xa5, 0xc7, 0xfc, 0xa8, 0x1e, 0xf2, 0x56, 0x3b, 0x00, 0xd1, 0xff, 0xe8, 0x4d, 0xab, 0x52, 0x34,
+	0x27, 0xd5, 0x47, 0xe6, 0x7b, 0xff, 0x0d, 0x00, 0xb0, 0x24, 0x0a, 0x3c, 0x15, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -229,6 +480,62 @@ func (this *Params) Equal(that interface{}) bool {
 	if !this.RecheckFeeBaseFeeThresholdMultiplier.Equal(that1.RecheckFeeBaseFeeThresholdMultiplier) {
 		return false
 	}
+	if len(this.FeeDenoms) != len(that1.FeeDenoms) {
+		return false
+	}
+	for i := range this.FeeDenoms {
+		if !this.FeeDenoms[i].Equal(&that1.FeeDenoms[i]) {
+			return false
+		}
+	}
+	if this.BaseFeeHistoryLength != that1.BaseFeeHistoryLength {
+		return false
+	}
+	return true
+}
+func (this *FeeDenom) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*FeeDenom)
+	if !ok {
+		that2, ok := that.(FeeDenom)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Denom != that1.Denom {
+		return false
+	}
+	if this.PriceSource != that1.PriceSource {
+		return false
+	}
+	if this.OracleType != that1.OracleType {
+		return false
+	}
+	if this.OracleBase != that1.OracleBase {
+		return false
+	}
+	if this.OracleQuote != that1.OracleQuote {
+		return false
+	}
+	if this.Decimals != that1.Decimals {
+		return false
+	}
+	if this.MarketId != that1.MarketId {
+		return false
+	}
+	if !this.MaxSwapSlippage.Equal(that1.MaxSwapSlippage) {
+		return false
+	}
 	return true
 }
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -251,6 +558,25 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.BaseFeeHistoryLength != 0 {
+		i = encodeVarintTxfees(dAtA, i, uint64(m.BaseFeeHistoryLength))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.FeeDenoms) > 0 {
+		for iNdEx := len(m.FeeDenoms) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.FeeDenoms[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTxfees(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
 	{
 		size := m.RecheckFeeBaseFeeThresholdMultiplier.Size()
 		i -= size
@@ -369,6 +695,130 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *FeeDenom) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FeeDenom) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FeeDenom) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MaxSwapSlippage.Size()
+		i -= size
+		if _, err := m.MaxSwapSlippage.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	if len(m.MarketId) > 0 {
+		i -= len(m.MarketId)
+		copy(dAtA[i:], m.MarketId)
+		i = encodeVarintTxfees(dAtA, i, uint64(len(m.MarketId)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if m.Decimals != 0 {
+		i = encodeVarintTxfees(dAtA, i, uint64(m.Decimals))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.OracleQuote) > 0 {
+		i -= len(m.OracleQuote)
+		copy(dAtA[i:], m.OracleQuote)
+		i = encodeVarintTxfees(dAtA, i, uint64(len(m.OracleQuote)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.OracleBase) > 0 {
+		i -= len(m.OracleBase)
+		copy(dAtA[i:], m.OracleBase)
+		i = encodeVarintTxfees(dAtA, i, uint64(len(m.OracleBase)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.OracleType != 0 {
+		i = encodeVarintTxfees(dAtA, i, uint64(m.OracleType))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.PriceSource != 0 {
+		i = encodeVarintTxfees(dAtA, i, uint64(m.PriceSource))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintTxfees(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *BlockFee) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *BlockFee) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *BlockFee) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TargetGas != 0 {
+		i = encodeVarintTxfees(dAtA, i, uint64(m.TargetGas))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.GasUsed != 0 {
+		i = encodeVarintTxfees(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x18
+	}
+	{
+		size := m.BaseFee.Size()
+		i -= size
+		if _, err := m.BaseFee.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintTxfees(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Height != 0 {
+		i = encodeVarintTxfees(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTxfees(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTxfees(v)
 	base := offset
@@ -416,17 +866,82 @@ func (m *Params) Size() (n int) {
 	n += 1 + l + sovTxfees(uint64(l))
 	l = m.RecheckFeeBaseFeeThresholdMultiplier.Size()
 	n += 1 + l + sovTxfees(uint64(l))
+	if len(m.FeeDenoms) > 0 {
+		for _, e := range m.FeeDenoms {
+			l = e.Size()
+			n += 1 + l + sovTxfees(uint64(l))
+		}
+	}
+	if m.BaseFeeHistoryLength != 0 {
+		n += 1 + sovTxfees(uint64(m.BaseFeeHistoryLength))
+	}
 	return n
 }
 
-func sovTxfees(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTxfees(x uint64) (n int) {
-	return sovTxfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func (m *FeeDenom) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovTxfees(uint64(l))
+	}
+	if m.PriceSource != 0 {
+		n += 1 + sovTxfees(uint64(m.PriceSource))
+	}
+	if m.OracleType != 0 {
+		n += 1 + sovTxfees(uint64(m.OracleType))
+	}
+	l = len(m.OracleBase)
+	if l > 0 {
+		n += 1 + l + sovTxfees(uint64(l))
+	}
+	l = len(m.OracleQuote)
+	if l > 0 {
+		n += 1 + l + sovTxfees(uint64(l))
+	}
+	if m.Decimals != 0 {
+		n += 1 + sovTxfees(uint64(m.Decimals))
+	}
+	l = len(m.MarketId)
+	if l > 0 {
+		n += 1 + l + sovTxfees(uint64(l))
+	}
+	l = m.MaxSwapSlippage.Size()
+	n += 1 + l + sovTxfees(uint64(l))
+	return n
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
+
+func (m *BlockFee) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovTxfees(uint64(m.Height))
+	}
+	l = m.BaseFee.Size()
+	n += 1 + l + sovTxfees(uint64(l))
+	if m.GasUsed != 0 {
+		n += 1 + sovTxfees(uint64(m.GasUsed))
+	}
+	if m.TargetGas != 0 {
+		n += 1 + sovTxfees(uint64(m.TargetGas))
+	}
+	return n
+}
+
+func sovTxfees(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTxfees(x uint64) (n int) {
+	return sovTxfees(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
 		preIndex := iNdEx
@@ -837,6 +1352,469 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FeeDenoms", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.FeeDenoms = append(m.FeeDenoms, FeeDenom{})
+			if err := m.FeeDenoms[len(m.FeeDenoms)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFeeHistoryLength", wireType)
+			}
+			m.BaseFeeHistoryLength = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BaseFeeHistoryLength |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *FeeDenom) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FeeDenom: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FeeDenom: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PriceSource", wireType)
+			}
+			m.PriceSource = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PriceSource |= FeeDenomPriceSource(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleType", wireType)
+			}
+			m.OracleType = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OracleType |= types.OracleType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleBase", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleBase = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OracleQuote", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.OracleQuote = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Decimals", wireType)
+			}
+			m.Decimals = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Decimals |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MarketId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MarketId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSwapSlippage", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSwapSlippage.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTxfees(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *BlockFee) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTxfees
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: BlockFee: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: BlockFee: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BaseFee", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTxfees
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BaseFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TargetGas", wireType)
+			}
+			m.TargetGas = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTxfees
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TargetGas |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTxfees(dAtA[iNdEx:])
@@ -20,9 +20,9 @@ func (s *Orchestrator) runBatchCreator(ctx context.Context) (err error) {
 
 	s.logger.WithField("loop_duration", s.cfg.LoopDuration.String()).Debugln("starting BatchCreator...")
 
-	return loops.RunLoop(ctx, s.cfg.LoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.LoopDuration, s.instrumentLoop(loopBatchCreator, s.cfg.LoopDuration, func() error {
 		return bc.requestTokenBatches(ctx)
-	})
+	}))
 }
 
 type batchCreator struct {
@@ -51,6 +51,10 @@ func (l *batchCreator) requestTokenBatches(ctx context.Context) error {
 
 	for _, fee := range fees {
 		ok, err := l.checkFee(ctx, fee)
+		if l.cfg.MinBatchFeeUSD != 0 {
+			l.metrics.reportFeeCheck(loopBatchCreator, ok, err)
+		}
+
 		if err != nil {
 			l.Log().WithError(err).Warningln("error checking batch")
 			continue
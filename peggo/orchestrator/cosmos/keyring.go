@@ -2,6 +2,7 @@ package cosmos
 
 import (
 	"bytes"
+	"context"
 	"crypto/rand"
 	"encoding/hex"
 	"io"
@@ -17,6 +18,7 @@ import (
 	"github.com/InjectiveLabs/injective-core/injective-chain/crypto/ethsecp256k1"
 	"github.com/InjectiveLabs/injective-core/injective-chain/crypto/hd"
 	injcodec "github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/codec"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/remotesigner"
 )
 
 const (
@@ -31,6 +33,9 @@ type KeyringConfig struct {
 	KeyPassphrase,
 	PrivateKey string
 	UseLedger bool
+
+	// RemoteSigner, if set, signs with the KeyFrom account held by the remote signer
+	RemoteSigner *remotesigner.Client
 }
 
 type Keyring struct {
@@ -40,6 +45,10 @@ type Keyring struct {
 }
 
 func NewKeyring(cfg KeyringConfig) (Keyring, error) {
+	if cfg.RemoteSigner != nil {
+		return newRemoteKeyring(cfg)
+	}
+
 	if withPK := cfg.PrivateKey != ""; withPK {
 		return newInMemoryKeyring(cfg)
 	}
@@ -96,6 +105,29 @@ func newInMemoryKeyring(cfg KeyringConfig) (Keyring, error) {
 	return k, nil
 }
 
+func newRemoteKeyring(cfg KeyringConfig) (Keyring, error) {
+	if cfg.UseLedger || cfg.PrivateKey != "" {
+		return Keyring{}, errors.New("cannot use remote signer together with Ledger or private key")
+	}
+
+	addr, err := cosmostypes.AccAddressFromBech32(cfg.KeyFrom)
+	if err != nil {
+		return Keyring{}, errors.Wrap(err, "remote signer requires the Cosmos from address in Bech32")
+	}
+
+	kr, err := remotesigner.NewCosmosKeyring(context.Background(), cfg.RemoteSigner, addr, DefaultKeyName)
+	if err != nil {
+		return Keyring{}, errors.Wrap(err, "failed to initialize remote signer keyring")
+	}
+
+	k := Keyring{
+		Keyring: kr,
+		Addr:    addr,
+	}
+
+	return k, nil
+}
+
 func newKeyringFromDir(cfg KeyringConfig) (Keyring, error) {
 	if len(cfg.KeyFrom) == 0 {
 		return Keyring{}, errors.New("insufficient cosmos details provided")
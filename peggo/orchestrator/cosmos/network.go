@@ -91,3 +91,17 @@ func NewNetwork(
 
 	return net, nil
 }
+
+// NewQueryNetwork connects to Injective without a keyring. The returned client can only query
+// Peggy module state and is used by read-only tooling (e.g. claim replay).
+func NewQueryNetwork(cfg NetworkConfig) (peggy.QueryClient, error) {
+	clientCtx, err := client.NewClientContext(cfg.CosmosGRPC,
+		client.WithChainID(cfg.ChainID),
+		client.WithCometURI(cfg.TendermintRPC),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return peggy.NewQueryClient(peggytypes.NewQueryClient(clientCtx.GRPCClient)), nil
+}
@@ -5,7 +5,6 @@ import (
 	"sync"
 	"time"
 
-	sdkmath "cosmossdk.io/math"
 	"github.com/InjectiveLabs/coretracer"
 	cosmostypes "github.com/cosmos/cosmos-sdk/types"
 	cosmostx "github.com/cosmos/cosmos-sdk/types/tx"
@@ -24,6 +23,7 @@ type BroadcastClient interface {
 	SetOrchestratorAddresses(ctx context.Context, ethFrom gethcommon.Address, orchAddr cosmostypes.AccAddress) error
 	SendValsetConfirm(ctx context.Context, ethFrom gethcommon.Address, peggyID gethcommon.Hash, valset *peggytypes.Valset) error
 	SendBatchConfirm(ctx context.Context, ethFrom gethcommon.Address, peggyID gethcommon.Hash, batch *peggytypes.OutgoingTxBatch) error
+	SendLogicCallConfirm(ctx context.Context, ethFrom gethcommon.Address, peggyID gethcommon.Hash, call *peggytypes.OutgoingLogicCall) error
 	SendRequestBatch(ctx context.Context, denom string) error
 	SendDepositClaim(ctx context.Context, deposit *peggyevents.PeggySendToInjectiveEvent) error
 	SendWithdrawalClaim(ctx context.Context, withdrawal *peggyevents.PeggyTransactionBatchExecutedEvent) error
@@ -192,6 +192,50 @@ func (c *broadcastClient) SendBatchConfirm(
 	return nil
 }
 
+func (c *broadcastClient) SendLogicCallConfirm(
+	ctx context.Context,
+	ethFrom gethcommon.Address,
+	peggyID gethcommon.Hash,
+	call *peggytypes.OutgoingLogicCall,
+) error {
+	defer coretracer.Trace(&ctx, c.svcTags)()
+
+	confirmHash := peggy.EncodeLogicCallConfirm(peggyID, call)
+	signature, err := c.ethSignFn(ethFrom, confirmHash.Bytes())
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return errors.New("failed to sign logic call")
+	}
+
+	// MsgConfirmLogicCall
+	// This message includes the logic call identifiers as well as an Ethereum
+	// signature over the logic call checkpoint by the validator
+	// -------------
+	msg := &peggytypes.MsgConfirmLogicCall{
+		Orchestrator:      c.FromAddress().String(),
+		InvalidationId:    gethcommon.Bytes2Hex(call.InvalidationId),
+		InvalidationNonce: call.InvalidationNonce,
+		Signature:         gethcommon.Bytes2Hex(signature),
+		EthSigner:         ethFrom.Hex(),
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+
+	_, resp, err := c.ChainClient.BroadcastMsg(cosmostx.BroadcastMode_BROADCAST_MODE_SYNC, msg)
+	if err != nil {
+		return errors.Wrap(err, "failed to broadcast MsgConfirmLogicCall")
+	}
+
+	if resp.TxResponse.Code != 0 {
+		return errors.Errorf("failed to broadcast MsgConfirmLogicCall: %s", resp.TxResponse.RawLog)
+	}
+
+	time.Sleep(broadcastMsgSleepDuration)
+
+	return nil
+}
+
 func (c *broadcastClient) SendRequestBatch(ctx context.Context, denom string) error {
 	defer coretracer.Trace(&ctx, c.svcTags)()
 
@@ -242,16 +286,7 @@ func (c *broadcastClient) SendDepositClaim(ctx context.Context, deposit *peggyev
 		"token_contract": deposit.TokenContract.Hex(),
 	}).Debugln("observed SendToInjectiveEvent")
 
-	msg := &peggytypes.MsgDepositClaim{
-		EventNonce:     deposit.EventNonce.Uint64(),
-		BlockHeight:    deposit.Raw.BlockNumber,
-		TokenContract:  deposit.TokenContract.Hex(),
-		Amount:         sdkmath.NewIntFromBigInt(deposit.Amount),
-		EthereumSender: deposit.Sender.Hex(),
-		CosmosReceiver: cosmostypes.AccAddress(deposit.Destination[12:32]).String(),
-		Orchestrator:   c.ChainClient.FromAddress().String(),
-		Data:           "",
-	}
+	msg := NewDepositClaim(deposit, c.ChainClient.FromAddress())
 
 	c.mux.Lock()
 	defer c.mux.Unlock()
@@ -289,13 +324,7 @@ func (c *broadcastClient) SendWithdrawalClaim(ctx context.Context, withdrawal *p
 
 	// WithdrawClaim claims that a batch of withdrawal
 	// operations on the bridge contract was executed.
-	msg := &peggytypes.MsgWithdrawClaim{
-		EventNonce:    withdrawal.EventNonce.Uint64(),
-		BatchNonce:    withdrawal.BatchNonce.Uint64(),
-		BlockHeight:   withdrawal.Raw.BlockNumber,
-		TokenContract: withdrawal.Token.Hex(),
-		Orchestrator:  c.FromAddress().String(),
-	}
+	msg := NewWithdrawalClaim(withdrawal, c.FromAddress())
 
 	c.mux.Lock()
 	defer c.mux.Unlock()
@@ -334,23 +363,7 @@ func (c *broadcastClient) SendValsetClaim(ctx context.Context, vs *peggyevents.P
 		"reward_token":  vs.RewardToken.Hex(),
 	}).Debugln("observed ValsetUpdatedEvent")
 
-	members := make([]*peggytypes.BridgeValidator, len(vs.Validators))
-	for i, val := range vs.Validators {
-		members[i] = &peggytypes.BridgeValidator{
-			EthereumAddress: val.Hex(),
-			Power:           vs.Powers[i].Uint64(),
-		}
-	}
-
-	msg := &peggytypes.MsgValsetUpdatedClaim{
-		EventNonce:   vs.EventNonce.Uint64(),
-		ValsetNonce:  vs.NewValsetNonce.Uint64(),
-		BlockHeight:  vs.Raw.BlockNumber,
-		RewardAmount: sdkmath.NewIntFromBigInt(vs.RewardAmount),
-		RewardToken:  vs.RewardToken.Hex(),
-		Members:      members,
-		Orchestrator: c.FromAddress().String(),
-	}
+	msg := NewValsetClaim(vs, c.FromAddress())
 
 	c.mux.Lock()
 	defer c.mux.Unlock()
@@ -389,16 +402,7 @@ func (c *broadcastClient) SendERC20DeployedClaim(ctx context.Context, erc20 *peg
 		"decimals":       erc20.Decimals,
 	}).Debugln("observed ERC20DeployedEvent")
 
-	msg := &peggytypes.MsgERC20DeployedClaim{
-		EventNonce:    erc20.EventNonce.Uint64(),
-		BlockHeight:   erc20.Raw.BlockNumber,
-		CosmosDenom:   erc20.CosmosDenom,
-		TokenContract: erc20.TokenContract.Hex(),
-		Name:          erc20.Name,
-		Symbol:        erc20.Symbol,
-		Decimals:      uint64(erc20.Decimals),
-		Orchestrator:  c.FromAddress().String(),
-	}
+	msg := NewERC20DeployedClaim(erc20, c.FromAddress())
 
 	c.mux.Lock()
 	defer c.mux.Unlock()
@@ -0,0 +1,69 @@
+package peggy
+
+import (
+	sdkmath "cosmossdk.io/math"
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+
+	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+	peggyevents "github.com/InjectiveLabs/injective-core/peggo/solidity/wrappers/Peggy"
+)
+
+// The constructors below derive the claim an orchestrator submits for a given Peggy.sol event.
+// They are shared by the broadcast client and the read-only claim replay tooling so that both
+// always agree on the claim contents (and therefore on the claim hash).
+
+func NewDepositClaim(deposit *peggyevents.PeggySendToInjectiveEvent, orchestrator cosmostypes.AccAddress) *peggytypes.MsgDepositClaim {
+	return &peggytypes.MsgDepositClaim{
+		EventNonce:     deposit.EventNonce.Uint64(),
+		BlockHeight:    deposit.Raw.BlockNumber,
+		TokenContract:  deposit.TokenContract.Hex(),
+		Amount:         sdkmath.NewIntFromBigInt(deposit.Amount),
+		EthereumSender: deposit.Sender.Hex(),
+		CosmosReceiver: cosmostypes.AccAddress(deposit.Destination[12:32]).String(),
+		Orchestrator:   orchestrator.String(),
+		Data:           "",
+	}
+}
+
+func NewWithdrawalClaim(withdrawal *peggyevents.PeggyTransactionBatchExecutedEvent, orchestrator cosmostypes.AccAddress) *peggytypes.MsgWithdrawClaim {
+	return &peggytypes.MsgWithdrawClaim{
+		EventNonce:    withdrawal.EventNonce.Uint64(),
+		BatchNonce:    withdrawal.BatchNonce.Uint64(),
+		BlockHeight:   withdrawal.Raw.BlockNumber,
+		TokenContract: withdrawal.Token.Hex(),
+		Orchestrator:  orchestrator.String(),
+	}
+}
+
+func NewValsetClaim(vs *peggyevents.PeggyValsetUpdatedEvent, orchestrator cosmostypes.AccAddress) *peggytypes.MsgValsetUpdatedClaim {
+	members := make([]*peggytypes.BridgeValidator, len(vs.Validators))
+	for i, val := range vs.Validators {
+		members[i] = &peggytypes.BridgeValidator{
+			EthereumAddress: val.Hex(),
+			Power:           vs.Powers[i].Uint64(),
+		}
+	}
+
+	return &peggytypes.MsgValsetUpdatedClaim{
+		EventNonce:   vs.EventNonce.Uint64(),
+		ValsetNonce:  vs.NewValsetNonce.Uint64(),
+		BlockHeight:  vs.Raw.BlockNumber,
+		RewardAmount: sdkmath.NewIntFromBigInt(vs.RewardAmount),
+		RewardToken:  vs.RewardToken.Hex(),
+		Members:      members,
+		Orchestrator: orchestrator.String(),
+	}
+}
+
+func NewERC20DeployedClaim(erc20 *peggyevents.PeggyERC20DeployedEvent, orchestrator cosmostypes.AccAddress) *peggytypes.MsgERC20DeployedClaim {
+	return &peggytypes.MsgERC20DeployedClaim{
+		EventNonce:    erc20.EventNonce.Uint64(),
+		BlockHeight:   erc20.Raw.BlockNumber,
+		CosmosDenom:   erc20.CosmosDenom,
+		TokenContract: erc20.TokenContract.Hex(),
+		Name:          erc20.Name,
+		Symbol:        erc20.Symbol,
+		Decimals:      uint64(erc20.Decimals),
+		Orchestrator:  orchestrator.String(),
+	}
+}
@@ -17,6 +17,7 @@ type QueryClient interface {
 	PeggyParams(ctx context.Context) (*peggytypes.Params, error)
 	LastClaimEventByAddr(ctx context.Context, validatorAccountAddress cosmostypes.AccAddress) (*peggytypes.LastClaimEvent, error)
 	GetValidatorAddress(ctx context.Context, addr gethcommon.Address) (cosmostypes.AccAddress, error)
+	GetOrchestratorAddress(ctx context.Context, addr gethcommon.Address) (cosmostypes.AccAddress, error)
 
 	ValsetAt(ctx context.Context, nonce uint64) (*peggytypes.Valset, error)
 	CurrentValset(ctx context.Context) (*peggytypes.Valset, error)
@@ -29,6 +30,10 @@ type QueryClient interface {
 	UnbatchedTokensWithFees(ctx context.Context) ([]*peggytypes.BatchFees, error)
 	TransactionBatchSignatures(ctx context.Context, nonce uint64, tokenContract gethcommon.Address) ([]*peggytypes.MsgConfirmBatch, error)
 
+	OldestUnsignedLogicCall(ctx context.Context, valAccountAddress cosmostypes.AccAddress) (*peggytypes.OutgoingLogicCall, error)
+	LatestLogicCalls(ctx context.Context) ([]*peggytypes.OutgoingLogicCall, error)
+	LogicCallSignatures(ctx context.Context, invalidationID []byte, invalidationNonce uint64) ([]*peggytypes.MsgConfirmLogicCall, error)
+
 	ModuleState(ctx context.Context) (*peggytypes.GenesisState, error)
 }
 
@@ -213,6 +218,66 @@ func (c queryClient) TransactionBatchSignatures(ctx context.Context, nonce uint6
 	return resp.Confirms, nil
 }
 
+func (c queryClient) OldestUnsignedLogicCall(ctx context.Context, valAccountAddress cosmostypes.AccAddress) (*peggytypes.OutgoingLogicCall, error) {
+	defer coretracer.Trace(&ctx, c.svcTags)()
+
+	req := &peggytypes.QueryLastPendingLogicCallByAddrRequest{
+		Address: valAccountAddress.String(),
+	}
+
+	resp, err := c.QueryClient.LastPendingLogicCallByAddr(ctx, req)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "failed to query LastPendingLogicCallByAddr from daemon")
+	}
+
+	if resp == nil {
+		coretracer.TraceError(ctx, ErrNotFound)
+		return nil, ErrNotFound
+	}
+
+	return resp.Call, nil
+}
+
+func (c queryClient) LatestLogicCalls(ctx context.Context) ([]*peggytypes.OutgoingLogicCall, error) {
+	defer coretracer.Trace(&ctx, c.svcTags)()
+
+	resp, err := c.QueryClient.OutgoingLogicCalls(ctx, &peggytypes.QueryOutgoingLogicCallsRequest{})
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "failed to query OutgoingLogicCalls from daemon")
+	}
+
+	if resp == nil {
+		coretracer.TraceError(ctx, ErrNotFound)
+		return nil, ErrNotFound
+	}
+
+	return resp.Calls, nil
+}
+
+func (c queryClient) LogicCallSignatures(ctx context.Context, invalidationID []byte, invalidationNonce uint64) ([]*peggytypes.MsgConfirmLogicCall, error) {
+	defer coretracer.Trace(&ctx, c.svcTags)()
+
+	req := &peggytypes.QueryLogicCallConfirmsRequest{
+		InvalidationId:    gethcommon.Bytes2Hex(invalidationID),
+		InvalidationNonce: invalidationNonce,
+	}
+
+	resp, err := c.QueryClient.LogicCallConfirms(ctx, req)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "failed to query LogicCallConfirms from daemon")
+	}
+
+	if resp == nil {
+		coretracer.TraceError(ctx, ErrNotFound)
+		return nil, ErrNotFound
+	}
+
+	return resp.Confirms, nil
+}
+
 func (c queryClient) LastClaimEventByAddr(ctx context.Context, validatorAccountAddress cosmostypes.AccAddress) (*peggytypes.LastClaimEvent, error) {
 	defer coretracer.Trace(&ctx, c.svcTags)()
 
@@ -279,6 +344,34 @@ func (c queryClient) GetValidatorAddress(ctx context.Context, addr gethcommon.Ad
 	return valAddr, nil
 }
 
+func (c queryClient) GetOrchestratorAddress(ctx context.Context, addr gethcommon.Address) (cosmostypes.AccAddress, error) {
+	defer coretracer.Trace(&ctx, c.svcTags)()
+
+	req := &peggytypes.QueryDelegateKeysByEthAddress{
+		EthAddress: addr.Hex(),
+	}
+
+	resp, err := c.QueryClient.GetDelegateKeyByEth(ctx, req)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "failed to query GetDelegateKeyByEth from client")
+	}
+
+	if resp == nil {
+		coretracer.TraceError(ctx, ErrNotFound)
+		return nil, ErrNotFound
+	}
+
+	orchAddr, err := cosmostypes.AccAddressFromBech32(resp.OrchestratorAddress)
+	if err != nil {
+		err := errors.Wrapf(err, "failed to decode orchestrator address: %v", resp.OrchestratorAddress)
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	return orchAddr, nil
+}
+
 func (c queryClient) ModuleState(ctx context.Context) (*peggytypes.GenesisState, error) {
 	defer coretracer.Trace(&ctx, c.svcTags)()
 
@@ -23,6 +23,10 @@ type EVMCommitter interface {
 		recipient common.Address,
 		txData []byte,
 	) (txHash common.Hash, err error)
+
+	// ReplaceStuckTxs re-sends the transactions that are still pending after the configured
+	// number of blocks with the same nonce and bumped fees.
+	ReplaceStuckTxs(ctx context.Context) error
 }
 
 type EVMCommitterOption func(o *options) error
@@ -31,14 +35,26 @@ type options struct {
 	GasPrice   decimal.Decimal
 	GasLimit   uint64
 	RPCTimeout time.Duration
+
+	// DynamicFeeTx enables EIP-1559 (type-2) transactions
+	DynamicFeeTx bool
+	// ReplaceAfterBlocks is the number of blocks after which a pending tx is replaced, 0 disables replacements
+	ReplaceAfterBlocks uint64
+	// FeeBumpPercent is the fee increase applied to replacement txs
+	FeeBumpPercent uint64
 }
 
+// minFeeBumpPercent is the minimum fee increase accepted by geth's txpool for replacement txs
+const minFeeBumpPercent = 10
+
 func defaultOptions() *options {
 	v, _ := decimal.NewFromString("20")
 	return &options{
-		GasPrice:   v.Shift(9), // 20 gwei
-		GasLimit:   1000000,
-		RPCTimeout: 10 * time.Second,
+		GasPrice:       v.Shift(9), // 20 gwei
+		GasLimit:       1000000,
+		RPCTimeout:     10 * time.Second,
+		DynamicFeeTx:   false,
+		FeeBumpPercent: 15,
 	}
 }
 
@@ -120,3 +136,22 @@ func TxBroadcastTimeout(dur time.Duration) EVMCommitterOption {
 		return nil
 	}
 }
+
+func OptionDynamicFeeTx(enabled bool) EVMCommitterOption {
+	return func(o *options) error {
+		o.DynamicFeeTx = enabled
+		return nil
+	}
+}
+
+func OptionTxReplacement(afterBlocks, feeBumpPercent uint64) EVMCommitterOption {
+	return func(o *options) error {
+		if afterBlocks > 0 && feeBumpPercent < minFeeBumpPercent {
+			return errors.Errorf("fee bump must be at least %d%% for replacement txs to be accepted, got %d%%", minFeeBumpPercent, feeBumpPercent)
+		}
+
+		o.ReplaceAfterBlocks = afterBlocks
+		o.FeeBumpPercent = feeBumpPercent
+		return nil
+	}
+}
@@ -4,13 +4,13 @@ import (
 	"context"
 	"math/big"
 	"strings"
+	"sync"
 
 	"github.com/InjectiveLabs/coretracer"
 	"github.com/ethereum/go-ethereum"
 
 	"github.com/ethereum/go-ethereum/accounts/abi/bind"
 	"github.com/ethereum/go-ethereum/common"
-	"github.com/ethereum/go-ethereum/core/types"
 	"github.com/pkg/errors"
 	log "github.com/xlab/suplog"
 
@@ -38,6 +38,7 @@ func NewEthCommitter(
 		fromSigner:            fromSigner,
 		evmProvider:           evmProvider,
 		nonceCache:            util.NewNonceCache(),
+		pendingTxs:            make(map[uint64]*pendingTx),
 	}
 
 	if err := applyOptions(committer.committerOpts, committerOpts...); err != nil {
@@ -63,6 +64,10 @@ type ethCommitter struct {
 	evmProvider           provider.EVMProviderWithRet
 	nonceCache            util.NonceCache
 
+	chainID      *big.Int
+	pendingTxs   map[uint64]*pendingTx
+	pendingTxMux sync.Mutex
+
 	svcTags coretracer.Tags
 }
 
@@ -91,36 +96,25 @@ func (e *ethCommitter) SendTx(
 	}
 
 	// Figure out the gas price values
-	suggestedGasPrice, err := e.evmProvider.SuggestGasPrice(opts.Context)
+	fees, err := e.suggestFees(opts.Context)
 	if err != nil {
-		coretracer.TraceError(ctx, err)
-		return common.Hash{}, errors.Errorf("failed to suggest gas price: %v", err)
-	}
-
-	// Suggested gas price is not accurate. Increment by multiplying with gasprice adjustment factor
-	incrementedPrice := big.NewFloat(0).Mul(new(big.Float).SetInt(suggestedGasPrice), big.NewFloat(e.ethGasPriceAdjustment))
-
-	// set gasprice to incremented gas price.
-	gasPrice := new(big.Int)
-	incrementedPrice.Int(gasPrice)
-
-	opts.GasPrice = gasPrice
-
-	//The gas price should be less than max gas price
-	maxGasPrice := big.NewInt(int64(e.ethMaxGasPrice))
-	if opts.GasPrice.Cmp(maxGasPrice) > 0 {
-		err = errors.Errorf("Suggested gas price %v is greater than max gas price %v", opts.GasPrice.Int64(), maxGasPrice.Int64())
 		coretracer.TraceError(ctx, err)
 		return common.Hash{}, err
 	}
 
+	opts.GasPrice = fees.GasPrice
+	opts.GasTipCap = fees.GasTipCap
+	opts.GasFeeCap = fees.GasFeeCap
+
 	// estimate gas limit
 	msg := ethereum.CallMsg{
-		From:     opts.From,
-		To:       &recipient,
-		GasPrice: gasPrice,
-		Value:    new(big.Int),
-		Data:     txData,
+		From:      opts.From,
+		To:        &recipient,
+		GasPrice:  fees.GasPrice,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
+		Value:     new(big.Int),
+		Data:      txData,
 	}
 
 	gasLimit, err := e.evmProvider.EstimateGas(opts.Context, msg)
@@ -150,7 +144,11 @@ func (e *ethCommitter) SendTx(
 			opts.Nonce = big.NewInt(nonce)
 			opts.Context, _ = context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
 
-			tx := types.NewTransaction(opts.Nonce.Uint64(), recipient, nil, opts.GasLimit, opts.GasPrice, txData)
+			tx, err := e.newTx(opts.Context, opts.Nonce.Uint64(), recipient, opts.GasLimit, fees, txData)
+			if err != nil {
+				return err
+			}
+
 			signedTx, err := opts.Signer(opts.From, tx)
 			if err != nil {
 				err := errors.Wrap(err, "failed to sign transaction")
@@ -164,6 +162,7 @@ func (e *ethCommitter) SendTx(
 				// override with a real hash from node resp
 				txHash = txHashRet
 				e.nonceCache.Incr(e.fromAddress)
+				e.trackPendingTx(opts.Context, signedTx)
 				return nil
 			} else {
 				log.WithFields(log.Fields{
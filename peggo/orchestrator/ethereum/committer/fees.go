@@ -0,0 +1,196 @@
+package committer
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+)
+
+var errMaxGasPriceReached = errors.New("fees cannot be bumped above max gas price")
+
+// txFees holds the fee values of a tx: GasPrice for legacy txs, GasTipCap and GasFeeCap for EIP-1559 txs
+type txFees struct {
+	GasPrice  *big.Int
+	GasTipCap *big.Int
+	GasFeeCap *big.Int
+}
+
+func (f txFees) isDynamic() bool {
+	return f.GasFeeCap != nil
+}
+
+func feesOf(tx *types.Transaction) txFees {
+	if tx.Type() == types.DynamicFeeTxType {
+		return txFees{
+			GasTipCap: tx.GasTipCap(),
+			GasFeeCap: tx.GasFeeCap(),
+		}
+	}
+
+	return txFees{
+		GasPrice: tx.GasPrice(),
+	}
+}
+
+// suggestFees returns the fees for a new tx. With dynamic fee txs enabled, the tip is the suggested tip cap
+// times the gas price adjustment and the fee cap leaves room for the base fee to double. Chains without a
+// base fee fall back to legacy txs.
+func (e *ethCommitter) suggestFees(ctx context.Context) (txFees, error) {
+	maxGasPrice := big.NewInt(e.ethMaxGasPrice)
+
+	if e.committerOpts.DynamicFeeTx {
+		header, err := e.evmProvider.HeaderByNumber(ctx, nil)
+		if err != nil {
+			return txFees{}, errors.Wrap(err, "failed to get latest header")
+		}
+
+		if header.BaseFee != nil {
+			suggestedTipCap, err := e.evmProvider.SuggestGasTipCap(ctx)
+			if err != nil {
+				return txFees{}, errors.Errorf("failed to suggest gas tip cap: %v", err)
+			}
+
+			gasTipCap := e.adjust(suggestedTipCap)
+			if gasTipCap.Cmp(maxGasPrice) > 0 {
+				return txFees{}, errors.Errorf("Suggested gas tip cap %v is greater than max gas price %v", gasTipCap, maxGasPrice)
+			}
+
+			minFeeCap := new(big.Int).Add(header.BaseFee, gasTipCap)
+			if minFeeCap.Cmp(maxGasPrice) > 0 {
+				return txFees{}, errors.Errorf("Base fee %v plus gas tip cap %v is greater than max gas price %v", header.BaseFee, gasTipCap, maxGasPrice)
+			}
+
+			gasFeeCap := new(big.Int).Add(new(big.Int).Mul(header.BaseFee, big.NewInt(2)), gasTipCap)
+			if gasFeeCap.Cmp(maxGasPrice) > 0 {
+				gasFeeCap = maxGasPrice
+			}
+
+			return txFees{
+				GasTipCap: gasTipCap,
+				GasFeeCap: gasFeeCap,
+			}, nil
+		}
+
+		log.Debugln("latest header has no base fee, falling back to legacy transactions")
+	}
+
+	suggestedGasPrice, err := e.evmProvider.SuggestGasPrice(ctx)
+	if err != nil {
+		return txFees{}, errors.Errorf("failed to suggest gas price: %v", err)
+	}
+
+	// Suggested gas price is not accurate. Increment by multiplying with gasprice adjustment factor
+	gasPrice := e.adjust(suggestedGasPrice)
+
+	//The gas price should be less than max gas price
+	if gasPrice.Cmp(maxGasPrice) > 0 {
+		return txFees{}, errors.Errorf("Suggested gas price %v is greater than max gas price %v", gasPrice.Int64(), maxGasPrice.Int64())
+	}
+
+	return txFees{
+		GasPrice: gasPrice,
+	}, nil
+}
+
+// bumpFees returns the fees of a replacement for the given tx, increased by the fee bump percentage and by
+// at least the current network fees. errMaxGasPriceReached is returned if the bump would exceed the max gas price.
+func (e *ethCommitter) bumpFees(ctx context.Context, tx *types.Transaction) (txFees, error) {
+	var (
+		maxGasPrice = big.NewInt(e.ethMaxGasPrice)
+		prev        = feesOf(tx)
+	)
+
+	// the network fees may have risen more than the bump itself
+	current, err := e.suggestFees(ctx)
+	if err != nil {
+		current = txFees{}
+	}
+
+	if !prev.isDynamic() {
+		gasPrice := bigMax(e.bump(prev.GasPrice), current.GasPrice)
+		if gasPrice.Cmp(maxGasPrice) > 0 {
+			return txFees{}, errMaxGasPriceReached
+		}
+
+		return txFees{
+			GasPrice: gasPrice,
+		}, nil
+	}
+
+	var (
+		gasTipCap = bigMax(e.bump(prev.GasTipCap), current.GasTipCap)
+		gasFeeCap = bigMax(e.bump(prev.GasFeeCap), current.GasFeeCap)
+	)
+
+	if gasFeeCap.Cmp(maxGasPrice) > 0 {
+		gasFeeCap = maxGasPrice
+	}
+
+	// both caps must be bumped for the replacement to be accepted by the txpool
+	if gasFeeCap.Cmp(e.bump(prev.GasFeeCap)) < 0 || gasTipCap.Cmp(gasFeeCap) > 0 {
+		return txFees{}, errMaxGasPriceReached
+	}
+
+	return txFees{
+		GasTipCap: gasTipCap,
+		GasFeeCap: gasFeeCap,
+	}, nil
+}
+
+func (e *ethCommitter) newTx(
+	ctx context.Context,
+	nonce uint64,
+	recipient common.Address,
+	gasLimit uint64,
+	fees txFees,
+	txData []byte,
+) (*types.Transaction, error) {
+	if !fees.isDynamic() {
+		return types.NewTransaction(nonce, recipient, nil, gasLimit, fees.GasPrice, txData), nil
+	}
+
+	if e.chainID == nil {
+		chainID, err := e.evmProvider.ChainID(ctx)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to get chain ID")
+		}
+
+		e.chainID = chainID
+	}
+
+	return types.NewTx(&types.DynamicFeeTx{
+		ChainID:   e.chainID,
+		Nonce:     nonce,
+		GasTipCap: fees.GasTipCap,
+		GasFeeCap: fees.GasFeeCap,
+		Gas:       gasLimit,
+		To:        &recipient,
+		Value:     new(big.Int),
+		Data:      txData,
+	}), nil
+}
+
+func (e *ethCommitter) adjust(v *big.Int) *big.Int {
+	adjusted := big.NewFloat(0).Mul(new(big.Float).SetInt(v), big.NewFloat(e.ethGasPriceAdjustment))
+
+	res := new(big.Int)
+	adjusted.Int(res)
+	return res
+}
+
+func (e *ethCommitter) bump(v *big.Int) *big.Int {
+	res := new(big.Int).Mul(v, new(big.Int).SetUint64(100+e.committerOpts.FeeBumpPercent))
+	return res.Quo(res, big.NewInt(100))
+}
+
+func bigMax(a, b *big.Int) *big.Int {
+	if b != nil && b.Cmp(a) > 0 {
+		return b
+	}
+
+	return a
+}
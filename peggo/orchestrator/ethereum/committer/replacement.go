@@ -0,0 +1,144 @@
+package committer
+
+import (
+	"context"
+	"sort"
+	"strings"
+
+	"github.com/InjectiveLabs/coretracer"
+	"github.com/InjectiveLabs/metrics"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+)
+
+// pendingTx is a tx sent by the committer that has not been observed as mined yet
+type pendingTx struct {
+	tx           *types.Transaction
+	sentAtBlock  uint64
+	replacements int
+}
+
+func (e *ethCommitter) trackPendingTx(ctx context.Context, tx *types.Transaction) {
+	if e.committerOpts.ReplaceAfterBlocks == 0 {
+		return
+	}
+
+	header, err := e.evmProvider.HeaderByNumber(ctx, nil)
+	if err != nil {
+		log.WithError(err).Warningln("failed to get latest header, tx will not be replaced if stuck")
+		return
+	}
+
+	e.pendingTxMux.Lock()
+	defer e.pendingTxMux.Unlock()
+
+	e.pendingTxs[tx.Nonce()] = &pendingTx{
+		tx:          tx,
+		sentAtBlock: header.Number.Uint64(),
+	}
+}
+
+func (e *ethCommitter) ReplaceStuckTxs(ctx context.Context) error {
+	if e.committerOpts.ReplaceAfterBlocks == 0 {
+		return nil
+	}
+
+	defer coretracer.Trace(&ctx, e.svcTags)()
+
+	header, err := e.evmProvider.HeaderByNumber(ctx, nil)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return errors.Wrap(err, "failed to get latest header")
+	}
+
+	confirmedNonce, err := e.evmProvider.NonceAt(ctx, e.fromAddress, nil)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return errors.Wrap(err, "failed to get confirmed nonce")
+	}
+
+	latestBlock := header.Number.Uint64()
+
+	// serialize with SendTx, so that no new tx is signed while replacing
+	return e.nonceCache.Serialize(e.fromAddress, func() error {
+		e.pendingTxMux.Lock()
+		defer e.pendingTxMux.Unlock()
+
+		nonces := make([]uint64, 0, len(e.pendingTxs))
+		for nonce := range e.pendingTxs {
+			nonces = append(nonces, nonce)
+		}
+
+		sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+
+		for _, nonce := range nonces {
+			ptx := e.pendingTxs[nonce]
+
+			if nonce < confirmedNonce {
+				delete(e.pendingTxs, nonce)
+				continue
+			}
+
+			if latestBlock < ptx.sentAtBlock+e.committerOpts.ReplaceAfterBlocks {
+				continue
+			}
+
+			if err := e.replaceTx(ctx, ptx, latestBlock); err != nil {
+				if strings.Contains(err.Error(), "nonce too low") {
+					// mined in the meantime
+					delete(e.pendingTxs, nonce)
+					continue
+				}
+
+				metrics.ReportClosureFuncError("EthTxReplacement", metrics.Tags{"svc": "eth_committer"})
+				log.WithFields(log.Fields{
+					"nonce":   nonce,
+					"tx_hash": ptx.tx.Hash().Hex(),
+				}).WithError(err).Warningln("failed to replace stuck tx")
+			}
+		}
+
+		return nil
+	})
+}
+
+func (e *ethCommitter) replaceTx(ctx context.Context, ptx *pendingTx, latestBlock uint64) error {
+	fees, err := e.bumpFees(ctx, ptx.tx)
+	if err != nil {
+		return err
+	}
+
+	tx, err := e.newTx(ctx, ptx.tx.Nonce(), *ptx.tx.To(), ptx.tx.Gas(), fees, ptx.tx.Data())
+	if err != nil {
+		return err
+	}
+
+	signedTx, err := e.fromSigner(e.fromAddress, tx)
+	if err != nil {
+		return errors.Wrap(err, "failed to sign transaction")
+	}
+
+	sendCtx, cancelFn := context.WithTimeout(ctx, e.committerOpts.RPCTimeout)
+	defer cancelFn()
+
+	txHash, err := e.evmProvider.SendTransactionWithRet(sendCtx, signedTx)
+	if err != nil {
+		return err
+	}
+
+	log.WithFields(log.Fields{
+		"nonce":        signedTx.Nonce(),
+		"prev_tx_hash": ptx.tx.Hash().Hex(),
+		"tx_hash":      txHash.Hex(),
+		"replacements": ptx.replacements + 1,
+	}).Infoln("replaced stuck tx with bumped fees")
+
+	metrics.ReportClosureFuncCall("EthTxReplacement", metrics.Tags{"svc": "eth_committer"})
+
+	ptx.tx = signedTx
+	ptx.sentAtBlock = latestBlock
+	ptx.replacements++
+
+	return nil
+}
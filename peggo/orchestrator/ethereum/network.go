@@ -28,6 +28,12 @@ type NetworkConfig struct {
 	MaxGasPrice           string
 	PendingTxWaitDuration string
 	EthNodeAlchemyWS      string
+	DynamicFeeTx          bool
+	TxReplaceAfterBlocks  uint64
+	TxFeeBumpPercent      uint64
+	EthNodeFallbackRPCs   []string
+	MaxBlockLag           uint64
+	EventQuorum           int
 }
 
 // Network is the orchestrator's reference endpoint to the Ethereum network
@@ -66,7 +72,39 @@ type Network interface {
 		confirms []*peggytypes.MsgConfirmBatch,
 	) (*gethcommon.Hash, error)
 
+	GetLogicCallNonce(ctx context.Context, invalidationID gethcommon.Hash) (*big.Int, error)
+	SendLogicCall(ctx context.Context,
+		currentValset *peggytypes.Valset,
+		call *peggytypes.OutgoingLogicCall,
+		confirms []*peggytypes.MsgConfirmLogicCall,
+	) (*gethcommon.Hash, error)
+
 	TokenDecimals(ctx context.Context, tokenContract gethcommon.Address) (uint8, error)
+
+	ReplaceStuckTxs(ctx context.Context) error
+}
+
+// newEVMProvider connects to the primary Ethereum RPC and, if configured, to the fallback RPCs.
+// With fallbacks, calls fail over between endpoints and event queries require EventQuorum matching results.
+func newEVMProvider(cfg NetworkConfig) (provider.EVMProviderWithRet, error) {
+	if len(cfg.EthNodeFallbackRPCs) == 0 {
+		evmRPC, err := rpc.Dial(cfg.EthNodeRPC)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to connect to ethereum RPC: %s", cfg.EthNodeRPC)
+		}
+
+		return provider.NewEVMProvider(evmRPC), nil
+	}
+
+	endpoints, err := provider.DialEndpoints(append([]string{cfg.EthNodeRPC}, cfg.EthNodeFallbackRPCs...))
+	if err != nil {
+		return nil, err
+	}
+
+	return provider.NewMultiEVMProvider(endpoints, provider.MultiProviderConfig{
+		MaxBlockLag: cfg.MaxBlockLag,
+		EventQuorum: cfg.EventQuorum,
+	})
 }
 
 type network struct {
@@ -82,9 +120,9 @@ func NewNetwork(
 	signerFn bind.SignerFn,
 	cfg NetworkConfig,
 ) (Network, error) {
-	evmRPC, err := rpc.Dial(cfg.EthNodeRPC)
+	evmProvider, err := newEVMProvider(cfg)
 	if err != nil {
-		return nil, errors.Wrapf(err, "failed to connect to ethereum RPC: %s", cfg.EthNodeRPC)
+		return nil, err
 	}
 
 	ethCommitter, err := committer.NewEthCommitter(
@@ -92,7 +130,9 @@ func NewNetwork(
 		cfg.GasPriceAdjustment,
 		cfg.MaxGasPrice,
 		signerFn,
-		provider.NewEVMProvider(evmRPC),
+		evmProvider,
+		committer.OptionDynamicFeeTx(cfg.DynamicFeeTx),
+		committer.OptionTxReplacement(cfg.TxReplaceAfterBlocks, cfg.TxFeeBumpPercent),
 	)
 	if err != nil {
 		return nil, err
@@ -125,6 +165,32 @@ func NewNetwork(
 	return n, nil
 }
 
+// NewQueryNetwork connects to Ethereum without a signer. The returned network must only be used
+// for queries (events, nonces, headers) by read-only tooling, such as claim replay.
+func NewQueryNetwork(peggyContractAddr gethcommon.Address, cfg NetworkConfig) (Network, error) {
+	evmProvider, err := newEVMProvider(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	ethCommitter, err := committer.NewEthCommitter(gethcommon.Address{}, 1, "0", nil, evmProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	peggyContract, err := peggy.NewPeggyContract(ethCommitter, peggyContractAddr, peggy.PendingTxInputList{}, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	n := &network{
+		PeggyContract: peggyContract,
+		svcTags:       coretracer.NewTag("svc", "peggy_eth"),
+	}
+
+	return n, nil
+}
+
 func (n *network) TokenDecimals(ctx context.Context, tokenContract gethcommon.Address) (uint8, error) {
 	defer coretracer.Trace(&ctx, n.svcTags)()
 
@@ -169,6 +235,12 @@ func (n *network) GetTxBatchNonce(ctx context.Context, erc20ContractAddress geth
 	return n.PeggyContract.GetTxBatchNonce(ctx, erc20ContractAddress, n.FromAddr)
 }
 
+func (n *network) GetLogicCallNonce(ctx context.Context, invalidationID gethcommon.Hash) (*big.Int, error) {
+	defer coretracer.Trace(&ctx, n.svcTags)()
+
+	return n.PeggyContract.GetLogicCallNonce(ctx, invalidationID, n.FromAddr)
+}
+
 func (n *network) GetSendToInjectiveEvents(
 	ctx context.Context,
 	startBlock,
@@ -119,6 +119,39 @@ func EncodeTxBatchConfirm(peggyID common.Hash, batch *peggytypes.OutgoingTxBatch
 	return common.BytesToHash(hash.Bytes())
 }
 
+// EncodeLogicCallConfirm takes the required input data and produces the required signature to confirm a logic
+// call on the Peggy Ethereum contract. This value will then be signed before being
+// submitted to Cosmos, verified, and then relayed to Ethereum
+func EncodeLogicCallConfirm(peggyID common.Hash, call *peggytypes.OutgoingLogicCall) common.Hash {
+	abi, err := abi.JSON(strings.NewReader(OutgoingLogicCallConfirmABIJSON))
+	if err != nil {
+		log.Fatalln("bad ABI constant")
+	}
+
+	// Create the methodName argument which salts the signature
+	var logicCallMethodName [32]uint8
+	copy(logicCallMethodName[:], "logicCall")
+
+	abiEncodedCall, err := abi.Pack("logicCall",
+		peggyID,
+		logicCallMethodName,
+		common.HexToAddress(call.LogicContractAddress),
+		call.Payload,
+		new(big.Int).SetUint64(call.Timeout),
+		common.BytesToHash(call.InvalidationId),
+		new(big.Int).SetUint64(call.InvalidationNonce),
+	)
+
+	// this should never happen outside of test since any case that could crash on encoding
+	// should be filtered above.
+	if err != nil {
+		log.WithError(err).Errorln("Error packing logicCall!")
+		return common.Hash{}
+	}
+
+	return crypto.Keccak256Hash(abiEncodedCall[4:])
+}
+
 const (
 	// ValsetConfirmABIJSON = `[{
 	//     "name": "checkpoint",
@@ -167,4 +200,19 @@ const (
             { "internalType": "uint256",   "name": "_batchTimeout",  "type": "uint256" }
         ]
     }]`
+
+	OutgoingLogicCallConfirmABIJSON = `[{
+        "name": "logicCall",
+        "stateMutability": "pure",
+        "type": "function",
+        "inputs": [
+            { "internalType": "bytes32", "name": "_peggyId",              "type": "bytes32" },
+            { "internalType": "bytes32", "name": "_methodName",           "type": "bytes32" },
+            { "internalType": "address", "name": "_logicContractAddress", "type": "address" },
+            { "internalType": "bytes",   "name": "_payload",              "type": "bytes" },
+            { "internalType": "uint256", "name": "_timeout",              "type": "uint256" },
+            { "internalType": "bytes32", "name": "_invalidationId",       "type": "bytes32" },
+            { "internalType": "uint256", "name": "_invalidationNonce",    "type": "uint256" }
+        ]
+    }]`
 )
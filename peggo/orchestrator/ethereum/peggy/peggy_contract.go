@@ -54,6 +54,19 @@ type PeggyContract interface {
 		callerAddress common.Address,
 	) (*big.Int, error)
 
+	SendLogicCall(
+		ctx context.Context,
+		currentValset *peggytypes.Valset,
+		call *peggytypes.OutgoingLogicCall,
+		confirms []*peggytypes.MsgConfirmLogicCall,
+	) (*common.Hash, error)
+
+	GetLogicCallNonce(
+		ctx context.Context,
+		invalidationID common.Hash,
+		callerAddress common.Address,
+	) (*big.Int, error)
+
 	GetValsetNonce(
 		ctx context.Context,
 		callerAddress common.Address,
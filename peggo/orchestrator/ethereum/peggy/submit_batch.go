@@ -177,22 +177,38 @@ func checkBatchSigsAndRepack(
 		return
 	}
 
-	signerToSig := make(map[string]*peggytypes.MsgConfirmBatch, len(confirms))
+	signerToSig := make(map[string]string, len(confirms))
 	for _, sig := range confirms {
-		signerToSig[sig.EthSigner] = sig
+		signerToSig[sig.EthSigner] = sig.Signature
 	}
 
+	return checkSigsAndRepack(valset, signerToSig)
+}
+
+// checkSigsAndRepack orders the signatures (keyed by Ethereum signer) by the valset members
+// and verifies they carry enough voting power to pass on the Peggy contract
+func checkSigsAndRepack(
+	valset *peggytypes.Valset,
+	signerToSig map[string]string,
+) (
+	validators []common.Address,
+	powers []*big.Int,
+	v []uint8,
+	r []common.Hash,
+	s []common.Hash,
+	err error,
+) {
 	powerOfGoodSigs := new(big.Int)
 
 	for _, m := range valset.Members {
 		mPower := big.NewInt(0).SetUint64(m.Power)
-		if sig, ok := signerToSig[m.EthereumAddress]; ok && sig.EthSigner == m.EthereumAddress {
+		if sig, ok := signerToSig[m.EthereumAddress]; ok {
 			powerOfGoodSigs.Add(powerOfGoodSigs, mPower)
 
 			validators = append(validators, common.HexToAddress(m.EthereumAddress))
 			powers = append(powers, mPower)
 
-			sigV, sigR, sigS := sigToVRS(sig.Signature)
+			sigV, sigR, sigS := sigToVRS(sig)
 			v = append(v, sigV)
 			r = append(r, sigR)
 			s = append(s, sigS)
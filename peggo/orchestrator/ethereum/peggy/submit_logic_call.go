@@ -0,0 +1,95 @@
+package peggy
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/InjectiveLabs/coretracer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+	wrappers "github.com/InjectiveLabs/injective-core/peggo/solidity/wrappers/Peggy"
+)
+
+func (s *peggyContract) SendLogicCall(
+	ctx context.Context,
+	currentValset *peggytypes.Valset,
+	call *peggytypes.OutgoingLogicCall,
+	confirms []*peggytypes.MsgConfirmLogicCall,
+) (*common.Hash, error) {
+	defer coretracer.Trace(&ctx, s.svcTags)()
+
+	log.WithFields(log.Fields{
+		"logic_contract":     call.LogicContractAddress,
+		"invalidation_id":    common.Bytes2Hex(call.InvalidationId),
+		"invalidation_nonce": call.InvalidationNonce,
+		"confirmations":      len(confirms),
+	}).Infoln("checking signatures and submitting logic call")
+
+	if len(confirms) == 0 {
+		err := errors.New("no signatures in logic call confirmation")
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	signerToSig := make(map[string]string, len(confirms))
+	for _, sig := range confirms {
+		signerToSig[sig.EthSigner] = sig.Signature
+	}
+
+	validators, powers, sigV, sigR, sigS, err := checkSigsAndRepack(currentValset, signerToSig)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "confirmations check failed")
+	}
+
+	currentValsetArgs := ValsetArgs{
+		Validators:   validators,
+		Powers:       powers,
+		ValsetNonce:  new(big.Int).SetUint64(currentValset.Nonce),
+		RewardAmount: currentValset.RewardAmount.BigInt(),
+		RewardToken:  common.HexToAddress(currentValset.RewardToken),
+	}
+
+	logicCallArgs := wrappers.LogicCallArgs{
+		LogicContractAddress: common.HexToAddress(call.LogicContractAddress),
+		Payload:              call.Payload,
+		TimeOut:              new(big.Int).SetUint64(call.Timeout),
+		InvalidationId:       common.BytesToHash(call.InvalidationId),
+		InvalidationNonce:    new(big.Int).SetUint64(call.InvalidationNonce),
+	}
+
+	// Solidity function signature
+	// function submitLogicCall(
+	// 		ValsetArgs memory _currentValset,
+	// 		uint8[] memory _v,
+	// 		bytes32[] memory _r,
+	// 		bytes32[] memory _s,
+	// 		LogicCallArgs memory _args
+	// )
+	txData, err := peggyABI.Pack("submitLogicCall",
+		currentValsetArgs,
+		sigV, sigR, sigS,
+		logicCallArgs,
+	)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		log.WithError(err).Errorln("ABI Pack (Peggy submitLogicCall) method")
+		return nil, err
+	}
+
+	// Checking in pending txs(mempool) if tx with same input is already submitted
+	if s.pendingTxInputList.IsPendingTxInput(txData, s.pendingTxWaitDuration) {
+		return nil, errors.New("Transaction with same logic call input data is already present in mempool")
+	}
+
+	txHash, err := s.SendTx(ctx, s.peggyAddress, txData)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	return &txHash, nil
+}
@@ -32,6 +32,27 @@ func (s *peggyContract) GetTxBatchNonce(
 	return nonce, nil
 }
 
+// Gets the latest executed logic call nonce for the given invalidation id
+func (s *peggyContract) GetLogicCallNonce(
+	ctx context.Context,
+	invalidationID common.Hash,
+	callerAddress common.Address,
+) (*big.Int, error) {
+	defer coretracer.Trace(&ctx, s.svcTags)()
+
+	nonce, err := s.ethPeggy.LastLogicCallNonce(&bind.CallOpts{
+		From:    callerAddress,
+		Context: ctx,
+	}, invalidationID)
+
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "LastLogicCallNonce call failed")
+	}
+
+	return nonce, nil
+}
+
 // Gets the latest validator set nonce
 func (s *peggyContract) GetValsetNonce(
 	ctx context.Context,
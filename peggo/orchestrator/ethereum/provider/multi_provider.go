@@ -0,0 +1,374 @@
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/binary"
+	"math/big"
+	"sync"
+	"time"
+
+	"github.com/InjectiveLabs/coretracer"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+
+	"github.com/ethereum/go-ethereum"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/rpc"
+)
+
+const (
+	defaultHeadCheckInterval = 15 * time.Second
+	defaultHeadCheckTimeout  = 5 * time.Second
+)
+
+var ErrNoQuorum = errors.New("Ethereum endpoints did not reach quorum")
+
+// Endpoint is a named Ethereum RPC endpoint used by the multi-endpoint provider
+type Endpoint struct {
+	URL      string
+	Provider EVMProviderWithRet
+}
+
+type MultiProviderConfig struct {
+	// MaxBlockLag is the number of blocks an endpoint head can lag behind the highest head before it is skipped
+	MaxBlockLag uint64
+	// EventQuorum is the number of endpoints that must return identical logs for event queries
+	EventQuorum int
+	// HeadCheckInterval is how often endpoint heads are compared
+	HeadCheckInterval time.Duration
+}
+
+// multiEVMProvider spreads calls over several Ethereum endpoints. Calls go to the first healthy endpoint
+// and fail over to the next one on transport errors. An endpoint is healthy if its head is within
+// MaxBlockLag blocks of the highest head. Log queries, which back the oracle's event queries, are sent
+// to every healthy endpoint and only succeed if EventQuorum of them return identical logs.
+type multiEVMProvider struct {
+	endpoints []*endpointState
+	cfg       MultiProviderConfig
+
+	lastHeadCheck time.Time
+	headCheckMux  sync.Mutex
+
+	svcTags coretracer.Tags
+}
+
+type endpointState struct {
+	Endpoint
+
+	healthy bool
+	head    uint64
+}
+
+func NewMultiEVMProvider(endpoints []Endpoint, cfg MultiProviderConfig) (EVMProviderWithRet, error) {
+	if len(endpoints) == 0 {
+		return nil, errors.New("no Ethereum endpoints provided")
+	}
+
+	if cfg.EventQuorum < 1 || cfg.EventQuorum > len(endpoints) {
+		return nil, errors.Errorf("event quorum must be between 1 and the number of endpoints (%d), got %d", len(endpoints), cfg.EventQuorum)
+	}
+
+	if cfg.HeadCheckInterval == 0 {
+		cfg.HeadCheckInterval = defaultHeadCheckInterval
+	}
+
+	p := &multiEVMProvider{
+		endpoints: make([]*endpointState, 0, len(endpoints)),
+		cfg:       cfg,
+		svcTags:   coretracer.NewTag("svc", "eth_multi_provider"),
+	}
+
+	for _, e := range endpoints {
+		p.endpoints = append(p.endpoints, &endpointState{
+			Endpoint: e,
+			healthy:  true,
+		})
+	}
+
+	return p, nil
+}
+
+// DialEndpoints connects to every given Ethereum RPC URL
+func DialEndpoints(urls []string) ([]Endpoint, error) {
+	endpoints := make([]Endpoint, 0, len(urls))
+	for _, url := range urls {
+		rc, err := rpc.Dial(url)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to connect to ethereum RPC: %s", url)
+		}
+
+		endpoints = append(endpoints, Endpoint{
+			URL:      url,
+			Provider: NewEVMProvider(rc),
+		})
+	}
+
+	return endpoints, nil
+}
+
+// healthyEndpoints returns the endpoints whose head is not lagging, in configuration order. If no endpoint
+// is healthy, all endpoints are returned so that calls still get a chance to succeed.
+func (p *multiEVMProvider) healthyEndpoints(ctx context.Context) []*endpointState {
+	p.headCheckMux.Lock()
+	defer p.headCheckMux.Unlock()
+
+	if time.Since(p.lastHeadCheck) >= p.cfg.HeadCheckInterval {
+		p.checkHeads(ctx)
+		p.lastHeadCheck = time.Now()
+	}
+
+	healthy := make([]*endpointState, 0, len(p.endpoints))
+	for _, e := range p.endpoints {
+		if e.healthy {
+			healthy = append(healthy, e)
+		}
+	}
+
+	if len(healthy) == 0 {
+		return p.endpoints
+	}
+
+	return healthy
+}
+
+func (p *multiEVMProvider) checkHeads(ctx context.Context) {
+	var wg sync.WaitGroup
+	errs := make([]error, len(p.endpoints))
+
+	for i, e := range p.endpoints {
+		wg.Add(1)
+		go func(i int, e *endpointState) {
+			defer wg.Done()
+
+			headCtx, cancelFn := context.WithTimeout(ctx, defaultHeadCheckTimeout)
+			defer cancelFn()
+
+			header, err := e.Provider.HeaderByNumber(headCtx, nil)
+			if err != nil {
+				errs[i] = err
+				return
+			}
+
+			e.head = header.Number.Uint64()
+		}(i, e)
+	}
+
+	wg.Wait()
+
+	var highestHead uint64
+	for i, e := range p.endpoints {
+		if errs[i] == nil {
+			highestHead = max(highestHead, e.head)
+		}
+	}
+
+	for i, e := range p.endpoints {
+		healthy := errs[i] == nil && e.head+p.cfg.MaxBlockLag >= highestHead
+		if healthy != e.healthy {
+			log.WithFields(log.Fields{
+				"endpoint":     e.URL,
+				"head":         e.head,
+				"highest_head": highestHead,
+				"healthy":      healthy,
+			}).WithError(errs[i]).Warningln("Ethereum endpoint health changed")
+		}
+
+		e.healthy = healthy
+	}
+}
+
+// isEndpointErr returns true for errors caused by the endpoint itself rather than by the request,
+// i.e. errors on which another endpoint should be tried
+func isEndpointErr(err error) bool {
+	if err == nil || errors.Is(err, context.Canceled) || errors.Is(err, ethereum.NotFound) {
+		return false
+	}
+
+	// JSON-RPC errors are returned by a node that processed the request (reverts, nonce errors, etc.)
+	var rpcErr rpc.Error
+	return !errors.As(err, &rpcErr)
+}
+
+// callWithFailover runs fn on healthy endpoints until one of them succeeds or fails with a request error
+func callWithFailover[T any](ctx context.Context, p *multiEVMProvider, fn func(EVMProviderWithRet) (T, error)) (res T, err error) {
+	for _, e := range p.healthyEndpoints(ctx) {
+		res, err = fn(e.Provider)
+		if !isEndpointErr(err) {
+			return res, err
+		}
+
+		log.WithField("endpoint", e.URL).WithError(err).Warningln("Ethereum endpoint call failed, failing over")
+	}
+
+	return res, err
+}
+
+func (p *multiEVMProvider) FilterLogs(ctx context.Context, q ethereum.FilterQuery) ([]types.Log, error) {
+	defer coretracer.Trace(&ctx, p.svcTags)()
+
+	endpoints := p.healthyEndpoints(ctx)
+	if len(endpoints) < p.cfg.EventQuorum {
+		err := errors.Wrapf(ErrNoQuorum, "only %d healthy endpoints for quorum of %d", len(endpoints), p.cfg.EventQuorum)
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	type result struct {
+		logs []types.Log
+		err  error
+	}
+
+	var wg sync.WaitGroup
+	results := make([]result, len(endpoints))
+
+	for i, e := range endpoints {
+		wg.Add(1)
+		go func(i int, e *endpointState) {
+			defer wg.Done()
+
+			logs, err := e.Provider.FilterLogs(ctx, q)
+			results[i] = result{logs: logs, err: err}
+		}(i, e)
+	}
+
+	wg.Wait()
+
+	votes := make(map[common.Hash]int)
+	for i, r := range results {
+		if r.err != nil {
+			log.WithField("endpoint", endpoints[i].URL).WithError(r.err).Warningln("failed to filter logs")
+			continue
+		}
+
+		digest := logsDigest(r.logs)
+		votes[digest]++
+
+		if votes[digest] >= p.cfg.EventQuorum {
+			return r.logs, nil
+		}
+	}
+
+	err := errors.Wrapf(ErrNoQuorum, "%d endpoints queried for logs in blocks %v - %v", len(endpoints), q.FromBlock, q.ToBlock)
+	coretracer.TraceError(ctx, err)
+	return nil, err
+}
+
+// logsDigest hashes the identity and content of logs, so that results from different endpoints can be compared
+func logsDigest(logs []types.Log) common.Hash {
+	h := sha256.New()
+	for i := range logs {
+		l := &logs[i]
+		h.Write(l.BlockHash.Bytes())
+		h.Write(l.TxHash.Bytes())
+		h.Write(binary.BigEndian.AppendUint64(nil, uint64(l.Index)))
+		h.Write(l.Address.Bytes())
+		for _, topic := range l.Topics {
+			h.Write(topic.Bytes())
+		}
+		h.Write(l.Data)
+		if l.Removed {
+			h.Write([]byte{1})
+		}
+	}
+
+	return common.BytesToHash(h.Sum(nil))
+}
+
+func (p *multiEVMProvider) SubscribeFilterLogs(ctx context.Context, q ethereum.FilterQuery, ch chan<- types.Log) (ethereum.Subscription, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (ethereum.Subscription, error) {
+		return e.SubscribeFilterLogs(ctx, q, ch)
+	})
+}
+
+func (p *multiEVMProvider) CodeAt(ctx context.Context, contract common.Address, blockNumber *big.Int) ([]byte, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) ([]byte, error) {
+		return e.CodeAt(ctx, contract, blockNumber)
+	})
+}
+
+func (p *multiEVMProvider) CallContract(ctx context.Context, call ethereum.CallMsg, blockNumber *big.Int) ([]byte, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) ([]byte, error) {
+		return e.CallContract(ctx, call, blockNumber)
+	})
+}
+
+func (p *multiEVMProvider) ChainID(ctx context.Context) (*big.Int, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (*big.Int, error) {
+		return e.ChainID(ctx)
+	})
+}
+
+func (p *multiEVMProvider) NonceAt(ctx context.Context, account common.Address, blockNumber *big.Int) (uint64, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (uint64, error) {
+		return e.NonceAt(ctx, account, blockNumber)
+	})
+}
+
+func (p *multiEVMProvider) PendingNonceAt(ctx context.Context, account common.Address) (uint64, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (uint64, error) {
+		return e.PendingNonceAt(ctx, account)
+	})
+}
+
+func (p *multiEVMProvider) PendingCodeAt(ctx context.Context, account common.Address) ([]byte, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) ([]byte, error) {
+		return e.PendingCodeAt(ctx, account)
+	})
+}
+
+func (p *multiEVMProvider) EstimateGas(ctx context.Context, msg ethereum.CallMsg) (uint64, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (uint64, error) {
+		return e.EstimateGas(ctx, msg)
+	})
+}
+
+func (p *multiEVMProvider) SuggestGasTipCap(ctx context.Context) (*big.Int, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (*big.Int, error) {
+		return e.SuggestGasTipCap(ctx)
+	})
+}
+
+func (p *multiEVMProvider) SuggestGasPrice(ctx context.Context) (*big.Int, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (*big.Int, error) {
+		return e.SuggestGasPrice(ctx)
+	})
+}
+
+func (p *multiEVMProvider) TransactionByHash(ctx context.Context, hash common.Hash) (*types.Transaction, bool, error) {
+	type txWithStatus struct {
+		tx        *types.Transaction
+		isPending bool
+	}
+
+	res, err := callWithFailover(ctx, p, func(e EVMProviderWithRet) (txWithStatus, error) {
+		tx, isPending, err := e.TransactionByHash(ctx, hash)
+		return txWithStatus{tx: tx, isPending: isPending}, err
+	})
+
+	return res.tx, res.isPending, err
+}
+
+func (p *multiEVMProvider) TransactionReceipt(ctx context.Context, txHash common.Hash) (*types.Receipt, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (*types.Receipt, error) {
+		return e.TransactionReceipt(ctx, txHash)
+	})
+}
+
+func (p *multiEVMProvider) SendTransaction(ctx context.Context, tx *types.Transaction) error {
+	_, err := p.SendTransactionWithRet(ctx, tx)
+	return err
+}
+
+func (p *multiEVMProvider) HeaderByNumber(ctx context.Context, number *big.Int) (*types.Header, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (*types.Header, error) {
+		return e.HeaderByNumber(ctx, number)
+	})
+}
+
+func (p *multiEVMProvider) SendTransactionWithRet(ctx context.Context, tx *types.Transaction) (common.Hash, error) {
+	return callWithFailover(ctx, p, func(e EVMProviderWithRet) (common.Hash, error) {
+		return e.SendTransactionWithRet(ctx, tx)
+	})
+}
@@ -7,6 +7,7 @@ import (
 	"errors"
 	"fmt"
 	"net/http"
+	"sort"
 	"time"
 
 	"github.com/InjectiveLabs/coretracer"
@@ -15,6 +16,10 @@ import (
 
 const (
 	HealthCheckURI = "/health"
+	StatusURI      = "/status"
+
+	// a loop is considered stuck if it has not succeeded for this many intervals
+	maxMissedLoopIntervals = 3
 )
 
 // Status represents the orchestrator's overall health status with respect to some Peggy network
@@ -66,6 +71,26 @@ func (s *Orchestrator) RunHealthCheckServer(port uint64) error {
 		}
 	})
 
+	mux.HandleFunc(StatusURI, func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("Content-Type", "application/json")
+
+		ctx, cancel := context.WithTimeout(r.Context(), 10*time.Second)
+		defer cancel()
+
+		report := s.checkStatusReport(ctx, time.Since(start))
+		if report.Healthy {
+			w.WriteHeader(http.StatusOK)
+		} else {
+			w.WriteHeader(http.StatusServiceUnavailable)
+		}
+
+		if err := json.NewEncoder(w).Encode(report); err != nil {
+			s.logger.Errorln("failed to encode status response: ", err)
+		}
+	})
+
+	mux.Handle(MetricsURI, s.metrics.handler())
+
 	err := http.ListenAndServe(fmt.Sprintf("0.0.0.0:%d", port), mux)
 	if err != nil && errors.Is(err, http.ErrServerClosed) {
 		return nil
@@ -130,3 +155,72 @@ func (s *Orchestrator) checkHealthStatus(ctx context.Context) (Status, error) {
 
 	return status, nil
 }
+
+// StatusReport is the detailed orchestrator status served on StatusURI. If the orchestrator
+// is unhealthy, Reasons explain why.
+type StatusReport struct {
+	Status
+
+	Healthy bool                  `json:"healthy"`
+	Reasons []string              `json:"reasons,omitempty"`
+	Mode    string                `json:"mode"`
+	Loops   map[string]LoopStatus `json:"loops"`
+}
+
+func (s *Orchestrator) checkStatusReport(ctx context.Context, uptime time.Duration) StatusReport {
+	defer coretracer.Trace(&ctx, s.svcTags)()
+
+	report := StatusReport{
+		Mode:  "validator",
+		Loops: s.loops.snapshot(),
+	}
+
+	if s.cfg.RelayerOnlyMode {
+		report.Mode = "relayer"
+	}
+
+	status, err := s.checkHealthStatus(ctx)
+	if err != nil {
+		report.Reasons = append(report.Reasons, err.Error())
+	} else {
+		s.metrics.networkLastObservedNonce.Set(float64(status.LastObservedEventNonceByNetwork))
+		s.metrics.pendingValsetsToSign.Set(float64(len(status.PendingValidatorSetsToSign)))
+
+		if !s.cfg.RelayerOnlyMode {
+			if !status.IsPartOfTheCurrentSet {
+				report.Reasons = append(report.Reasons, "orchestrator Ethereum address is not part of the current validator set")
+			}
+
+			if status.LastObservedEventNonceByNetwork > status.LastObservedEventNonceByOrchestrator {
+				lag := status.LastObservedEventNonceByNetwork - status.LastObservedEventNonceByOrchestrator
+				report.Reasons = append(report.Reasons, fmt.Sprintf("orchestrator has not claimed the last %d Ethereum events observed by the network", lag))
+			}
+		}
+	}
+
+	status.Uptime = uptime.String()
+	report.Status = status
+
+	loopNames := make([]string, 0, len(report.Loops))
+	for name := range report.Loops {
+		loopNames = append(loopNames, name)
+	}
+
+	sort.Strings(loopNames)
+
+	for _, name := range loopNames {
+		loop := report.Loops[name]
+		maxSilence := maxMissedLoopIntervals * loop.Interval
+
+		switch {
+		case loop.LastSuccess.IsZero() && uptime > maxSilence:
+			report.Reasons = append(report.Reasons, fmt.Sprintf("%s loop has not succeeded since start (last error: %s)", name, loop.LastError))
+		case !loop.LastSuccess.IsZero() && time.Since(loop.LastSuccess) > maxSilence:
+			report.Reasons = append(report.Reasons, fmt.Sprintf("%s loop last succeeded %s ago (last error: %s)", name, time.Since(loop.LastSuccess).Round(time.Second), loop.LastError))
+		}
+	}
+
+	report.Healthy = len(report.Reasons) == 0
+
+	return report
+}
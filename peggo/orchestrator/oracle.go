@@ -37,9 +37,10 @@ func (s *Orchestrator) runOracle(ctx context.Context, lastObservedBlock uint64)
 
 	s.logger.WithField("loop_duration", s.cfg.LoopDuration.String()).Debugln("starting Oracle...")
 
-	return loops.RunLoop(ctx, s.cfg.LoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.LoopDuration, s.instrumentLoop(loopOracle, s.cfg.LoopDuration, func() error {
+		oracle.refreshNetworkMetrics(ctx)
 		return oracle.observeEthEvents(ctx)
-	})
+	}))
 }
 
 type oracle struct {
@@ -53,6 +54,21 @@ func (l *oracle) Log() log.Logger {
 	return l.logger.WithField("loop", "Oracle")
 }
 
+// refreshNetworkMetrics updates the gauges tracking the Injective side of the bridge, so they don't
+// depend on the status endpoint being polled
+func (l *oracle) refreshNetworkMetrics(ctx context.Context) {
+	defer coretracer.Trace(&ctx, l.svcTags)()
+
+	state, err := l.injective.ModuleState(ctx)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		l.Log().WithError(err).Warningln("failed to get peggy module state")
+		return
+	}
+
+	l.metrics.networkLastObservedNonce.Set(float64(state.LastObservedNonce))
+}
+
 func (l *oracle) observeEthEvents(ctx context.Context) error {
 	defer coretracer.Trace(&ctx, l.svcTags)()
 
@@ -82,6 +98,8 @@ func (l *oracle) observeEthEvents(ctx context.Context) error {
 		return err
 	}
 
+	l.metrics.ethHeadHeight.Set(float64(latestHeight))
+
 	// not enough blocks on ethereum yet
 	if latestHeight <= ethBlockConfirmationDelay {
 		l.Log().Debugln("not enough blocks on Ethereum")
@@ -116,6 +134,8 @@ func (l *oracle) observeEthEvents(ctx context.Context) error {
 		return err
 	}
 
+	l.metrics.lastClaimedEventNonce.Set(float64(lastClaim.EthereumEventNonce))
+
 	newEvents := filterEvents(events, lastClaim.EthereumEventNonce)
 	sort.Slice(newEvents, func(i, j int) bool {
 		return newEvents[i].Nonce() < newEvents[j].Nonce()
@@ -129,6 +149,7 @@ func (l *oracle) observeEthEvents(ctx context.Context) error {
 		}).Infoln("no new events on Ethereum")
 
 		l.lastRecordedEthEventHeight = latestHeight
+		l.metrics.ethLastObservedHeight.Set(float64(latestHeight))
 		l.resetQueryRange()
 
 		return nil
@@ -164,6 +185,8 @@ func (l *oracle) observeEthEvents(ctx context.Context) error {
 
 	lastEvent := newEvents[len(newEvents)-1]
 	l.lastRecordedEthEventHeight = lastEvent.BlockHeight()
+	l.metrics.ethLastObservedHeight.Set(float64(l.lastRecordedEthEventHeight))
+	l.metrics.lastClaimedEventNonce.Set(float64(lastEvent.Nonce()))
 	l.resetQueryRange()
 
 	return nil
@@ -13,13 +13,9 @@ import (
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum"
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/loops"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/pricefeed"
 )
 
-// PriceFeed provides token price for a given contract address
-type PriceFeed interface {
-	QueryUSDPrice(ctx context.Context, address gethcommon.Address) (float64, error)
-}
-
 type Config struct {
 	CosmosAddr           cosmostypes.AccAddress
 	EthereumAddr         gethcommon.Address
@@ -31,6 +27,7 @@ type Config struct {
 	RelayerLoopDuration  time.Duration
 	RelayValsets         bool
 	RelayBatches         bool
+	RelayLogicCalls      bool
 	RelayerOnlyMode      bool
 }
 
@@ -42,13 +39,16 @@ type Orchestrator struct {
 
 	injective cosmos.Network
 	ethereum  ethereum.Network
-	priceFeed PriceFeed
+	priceFeed pricefeed.PriceFeed
+
+	metrics *promMetrics
+	loops   loopStates
 }
 
 func NewOrchestrator(
 	inj cosmos.Network,
 	eth ethereum.Network,
-	priceFeed PriceFeed,
+	priceFeed pricefeed.PriceFeed,
 	cfg Config,
 ) (*Orchestrator, error) {
 	o := &Orchestrator{
@@ -59,6 +59,8 @@ func NewOrchestrator(
 		priceFeed:   priceFeed,
 		cfg:         cfg,
 		maxAttempts: 10,
+		metrics:     newPromMetrics(),
+		loops:       loopStates{states: make(map[string]*LoopStatus)},
 	}
 
 	return o, nil
@@ -0,0 +1,59 @@
+package pricefeed
+
+import (
+	"context"
+	"strings"
+
+	"github.com/InjectiveLabs/coretracer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+	log "github.com/xlab/suplog"
+)
+
+// NamedPriceFeed is a price feed in a fallback chain
+type NamedPriceFeed struct {
+	Name string
+	PriceFeed
+}
+
+// FallbackPriceFeed queries its feeds in order and returns the first price found
+type FallbackPriceFeed struct {
+	feeds []NamedPriceFeed
+
+	logger  log.Logger
+	svcTags coretracer.Tags
+}
+
+func NewFallbackPriceFeed(feeds ...NamedPriceFeed) *FallbackPriceFeed {
+	return &FallbackPriceFeed{
+		feeds:   feeds,
+		logger:  log.WithField("svc", "price_feed"),
+		svcTags: coretracer.NewTag("oracle_provider", "fallback"),
+	}
+}
+
+func (p *FallbackPriceFeed) QueryUSDPrice(ctx context.Context, erc20Contract common.Address) (float64, error) {
+	defer coretracer.Trace(&ctx, p.svcTags)()
+
+	errs := make([]string, 0, len(p.feeds))
+	for _, feed := range p.feeds {
+		price, err := feed.QueryUSDPrice(ctx, erc20Contract)
+		if err == nil {
+			return price, nil
+		}
+
+		// a missing price is expected for feeds that only cover some tokens
+		if !errors.Is(err, ErrPriceNotFound) {
+			p.logger.WithError(err).WithFields(log.Fields{
+				"feed":       feed.Name,
+				"token_addr": erc20Contract.String(),
+			}).Warningln("price feed failed, trying next one")
+		}
+
+		errs = append(errs, feed.Name+": "+err.Error())
+	}
+
+	err := errors.Errorf("all price feeds failed for %s: %s", erc20Contract.String(), strings.Join(errs, "; "))
+	coretracer.TraceError(ctx, err)
+	return zeroPrice, err
+}
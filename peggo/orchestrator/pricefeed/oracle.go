@@ -0,0 +1,122 @@
+package pricefeed
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/coretracer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+
+	oracletypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/oracle/types"
+)
+
+// OraclePair identifies an Injective oracle price of a token
+type OraclePair struct {
+	OracleType oracletypes.OracleType
+	Base       string
+	Quote      string
+}
+
+// OraclePriceFeed reads token prices from the Injective oracle module (Pyth, Stork, Chainlink Data Streams, etc.)
+type OraclePriceFeed struct {
+	client oracletypes.QueryClient
+	pairs  map[common.Address]OraclePair
+
+	// maxPriceAge rejects prices not updated for longer, 0 disables the check
+	maxPriceAge time.Duration
+
+	svcTags coretracer.Tags
+}
+
+func NewOraclePriceFeed(client oracletypes.QueryClient, pairs map[common.Address]OraclePair, maxPriceAge time.Duration) *OraclePriceFeed {
+	return &OraclePriceFeed{
+		client:      client,
+		pairs:       pairs,
+		maxPriceAge: maxPriceAge,
+		svcTags:     coretracer.NewTag("oracle_provider", "injective_oracle"),
+	}
+}
+
+func (p *OraclePriceFeed) QueryUSDPrice(ctx context.Context, erc20Contract common.Address) (float64, error) {
+	defer coretracer.Trace(&ctx, p.svcTags)()
+
+	pair, ok := p.pairs[erc20Contract]
+	if !ok {
+		err := errors.Wrapf(ErrPriceNotFound, "no oracle pair configured for %s", erc20Contract.String())
+		coretracer.TraceError(ctx, err)
+		return zeroPrice, err
+	}
+
+	resp, err := p.client.OraclePrice(ctx, &oracletypes.QueryOraclePriceRequest{
+		OracleType: pair.OracleType,
+		Base:       pair.Base,
+		Quote:      pair.Quote,
+	})
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return zeroPrice, errors.Wrapf(err, "failed to query %s oracle price %s/%s", pair.OracleType.String(), pair.Base, pair.Quote)
+	}
+
+	state := resp.PricePairState
+	if state == nil || state.PairPrice.IsNil() || !state.PairPrice.IsPositive() {
+		err := errors.Wrapf(ErrPriceNotFound, "%s oracle price %s/%s is not set", pair.OracleType.String(), pair.Base, pair.Quote)
+		coretracer.TraceError(ctx, err)
+		return zeroPrice, err
+	}
+
+	if p.maxPriceAge > 0 {
+		updatedAt := state.BaseTimestamp
+		if state.QuoteTimestamp != 0 {
+			updatedAt = min(updatedAt, state.QuoteTimestamp)
+		}
+
+		if age := time.Since(time.Unix(updatedAt, 0)); age > p.maxPriceAge {
+			err := errors.Errorf("%s oracle price %s/%s is stale (updated %s ago)", pair.OracleType.String(), pair.Base, pair.Quote, age.Round(time.Second))
+			coretracer.TraceError(ctx, err)
+			return zeroPrice, err
+		}
+	}
+
+	price, err := state.PairPrice.Float64()
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return zeroPrice, err
+	}
+
+	return price, nil
+}
+
+// ParseOraclePairs parses a comma-separated list of <erc20>=<oracle_type>:<base>:<quote> entries
+func ParseOraclePairs(s string) (map[common.Address]OraclePair, error) {
+	pairs := make(map[common.Address]OraclePair)
+	if strings.TrimSpace(s) == "" {
+		return pairs, nil
+	}
+
+	for _, entry := range strings.Split(s, ",") {
+		contract, pairStr, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || !common.IsHexAddress(contract) {
+			return nil, errors.Errorf("invalid oracle pair entry %q, expected <erc20>=<oracle_type>:<base>:<quote>", entry)
+		}
+
+		parts := strings.SplitN(pairStr, ":", 3)
+		if len(parts) != 3 || parts[1] == "" || parts[2] == "" {
+			return nil, errors.Errorf("invalid oracle pair %q, expected <oracle_type>:<base>:<quote>", pairStr)
+		}
+
+		oracleType, err := oracletypes.GetOracleType(parts[0])
+		if err != nil {
+			return nil, err
+		}
+
+		pairs[common.HexToAddress(contract)] = OraclePair{
+			OracleType: oracleType,
+			Base:       parts[1],
+			Quote:      parts[2],
+		}
+	}
+
+	return pairs, nil
+}
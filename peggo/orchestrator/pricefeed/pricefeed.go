@@ -0,0 +1,28 @@
+package pricefeed
+
+import (
+	"context"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+const (
+	FeedCoingecko = "coingecko"
+	FeedOracle    = "oracle"
+	FeedStatic    = "static"
+)
+
+var ErrPriceNotFound = errors.New("no price for token")
+
+// PriceFeed provides the USD price of an ERC20 token
+type PriceFeed interface {
+	QueryUSDPrice(ctx context.Context, erc20Contract common.Address) (float64, error)
+}
+
+var (
+	_ PriceFeed = &CoingeckoPriceFeed{}
+	_ PriceFeed = &OraclePriceFeed{}
+	_ PriceFeed = &StaticPriceFeed{}
+	_ PriceFeed = &FallbackPriceFeed{}
+)
@@ -0,0 +1,54 @@
+package pricefeed
+
+import (
+	"context"
+	"strconv"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/pkg/errors"
+)
+
+// StaticPriceFeed returns fixed USD prices from configuration, e.g. for stablecoins or as a last resort
+type StaticPriceFeed struct {
+	prices map[common.Address]float64
+}
+
+func NewStaticPriceFeed(prices map[common.Address]float64) *StaticPriceFeed {
+	return &StaticPriceFeed{
+		prices: prices,
+	}
+}
+
+func (p *StaticPriceFeed) QueryUSDPrice(_ context.Context, erc20Contract common.Address) (float64, error) {
+	price, ok := p.prices[erc20Contract]
+	if !ok {
+		return zeroPrice, errors.Wrapf(ErrPriceNotFound, "no static price configured for %s", erc20Contract.String())
+	}
+
+	return price, nil
+}
+
+// ParseStaticPrices parses a comma-separated list of <erc20>=<usd price> entries
+func ParseStaticPrices(s string) (map[common.Address]float64, error) {
+	prices := make(map[common.Address]float64)
+	if strings.TrimSpace(s) == "" {
+		return prices, nil
+	}
+
+	for _, entry := range strings.Split(s, ",") {
+		contract, priceStr, ok := strings.Cut(strings.TrimSpace(entry), "=")
+		if !ok || !common.IsHexAddress(contract) {
+			return nil, errors.Errorf("invalid static price entry %q, expected <erc20>=<usd price>", entry)
+		}
+
+		price, err := strconv.ParseFloat(priceStr, 64)
+		if err != nil || price <= 0 {
+			return nil, errors.Errorf("invalid static price %q for %s", priceStr, contract)
+		}
+
+		prices[common.HexToAddress(contract)] = price
+	}
+
+	return prices, nil
+}
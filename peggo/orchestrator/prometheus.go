@@ -0,0 +1,203 @@
+package orchestrator
+
+import (
+	"net/http"
+	"sync"
+	"time"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/collectors"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+const (
+	MetricsURI = "/metrics"
+
+	metricsNamespace = "peggo"
+
+	loopOracle       = "oracle"
+	loopSigner       = "signer"
+	loopBatchCreator = "batch_creator"
+	loopRelayer      = "relayer"
+
+	relayKindValset    = "valset"
+	relayKindBatch     = "batch"
+	relayKindLogicCall = "logic_call"
+
+	outcomeSuccess = "success"
+	outcomeFailure = "failure"
+
+	feeDecisionAccepted = "accepted"
+	feeDecisionRejected = "rejected"
+	feeDecisionError    = "error"
+)
+
+// promMetrics are the Prometheus metrics exposed by the orchestrator on MetricsURI
+type promMetrics struct {
+	registry *prometheus.Registry
+
+	loopLastSuccess *prometheus.GaugeVec
+	loopErrors      *prometheus.CounterVec
+
+	ethHeadHeight            prometheus.Gauge
+	ethLastObservedHeight    prometheus.Gauge
+	lastClaimedEventNonce    prometheus.Gauge
+	pendingValsetsToSign     prometheus.Gauge
+	pendingBatchesToSign     prometheus.Gauge
+	relayerTxs               *prometheus.CounterVec
+	feeCheckDecisions        *prometheus.CounterVec
+	networkLastObservedNonce prometheus.Gauge
+}
+
+func newPromMetrics() *promMetrics {
+	m := &promMetrics{
+		registry: prometheus.NewRegistry(),
+
+		loopLastSuccess: prometheus.NewGaugeVec(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "loop_last_success_timestamp_seconds",
+			Help:      "Unix time of the last successful iteration of each loop",
+		}, []string{"loop"}),
+		loopErrors: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "loop_errors_total",
+			Help:      "Number of failed loop iterations",
+		}, []string{"loop"}),
+		ethHeadHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "eth_head_height",
+			Help:      "Latest Ethereum block height seen by the oracle",
+		}),
+		ethLastObservedHeight: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "eth_last_observed_height",
+			Help:      "Ethereum block height up to which the oracle has observed events",
+		}),
+		lastClaimedEventNonce: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "last_claimed_event_nonce",
+			Help:      "Nonce of the last Ethereum event claimed by this orchestrator",
+		}),
+		networkLastObservedNonce: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "network_last_observed_event_nonce",
+			Help:      "Nonce of the last Ethereum event observed by the Injective chain",
+		}),
+		pendingValsetsToSign: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "pending_valsets_to_sign",
+			Help:      "Number of validator set updates awaiting this validator's signature",
+		}),
+		pendingBatchesToSign: prometheus.NewGauge(prometheus.GaugeOpts{
+			Namespace: metricsNamespace,
+			Name:      "pending_batches_to_sign",
+			Help:      "Number of token batches awaiting this validator's signature",
+		}),
+		relayerTxs: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "relayer_txs_total",
+			Help:      "Number of Ethereum txs sent by the relayer, by kind and outcome",
+		}, []string{"kind", "outcome"}),
+		feeCheckDecisions: prometheus.NewCounterVec(prometheus.CounterOpts{
+			Namespace: metricsNamespace,
+			Name:      "fee_check_decisions_total",
+			Help:      "Number of batch fee profitability checks, by loop and decision",
+		}, []string{"loop", "decision"}),
+	}
+
+	m.registry.MustRegister(
+		collectors.NewGoCollector(),
+		collectors.NewProcessCollector(collectors.ProcessCollectorOpts{}),
+		m.loopLastSuccess,
+		m.loopErrors,
+		m.ethHeadHeight,
+		m.ethLastObservedHeight,
+		m.lastClaimedEventNonce,
+		m.networkLastObservedNonce,
+		m.pendingValsetsToSign,
+		m.pendingBatchesToSign,
+		m.relayerTxs,
+		m.feeCheckDecisions,
+	)
+
+	return m
+}
+
+func (m *promMetrics) handler() http.Handler {
+	return promhttp.HandlerFor(m.registry, promhttp.HandlerOpts{})
+}
+
+func (m *promMetrics) reportRelayerTx(kind string, err error) {
+	outcome := outcomeSuccess
+	if err != nil {
+		outcome = outcomeFailure
+	}
+
+	m.relayerTxs.WithLabelValues(kind, outcome).Inc()
+}
+
+func (m *promMetrics) reportFeeCheck(loop string, ok bool, err error) {
+	decision := feeDecisionRejected
+	switch {
+	case err != nil:
+		decision = feeDecisionError
+	case ok:
+		decision = feeDecisionAccepted
+	}
+
+	m.feeCheckDecisions.WithLabelValues(loop, decision).Inc()
+}
+
+// LoopStatus tracks the outcome of a loop's iterations for the status endpoint
+type LoopStatus struct {
+	Interval    time.Duration `json:"-"`
+	LastSuccess time.Time     `json:"last_success"`
+	LastError   string        `json:"last_error,omitempty"`
+	LastErrorAt time.Time     `json:"last_error_at,omitempty"`
+}
+
+type loopStates struct {
+	mux    sync.RWMutex
+	states map[string]*LoopStatus
+}
+
+func (s *loopStates) snapshot() map[string]LoopStatus {
+	s.mux.RLock()
+	defer s.mux.RUnlock()
+
+	snapshot := make(map[string]LoopStatus, len(s.states))
+	for name, state := range s.states {
+		snapshot[name] = *state
+	}
+
+	return snapshot
+}
+
+// instrumentLoop wraps a loop iteration to record its outcome in metrics and in the status report
+func (s *Orchestrator) instrumentLoop(name string, interval time.Duration, fn func() error) func() error {
+	s.loops.mux.Lock()
+	s.loops.states[name] = &LoopStatus{Interval: interval}
+	s.loops.mux.Unlock()
+
+	return func() error {
+		err := fn()
+
+		s.loops.mux.Lock()
+		state := s.loops.states[name]
+		if err != nil {
+			state.LastError = err.Error()
+			state.LastErrorAt = time.Now()
+		} else {
+			state.LastSuccess = time.Now()
+		}
+		s.loops.mux.Unlock()
+
+		if err != nil {
+			s.metrics.loopErrors.WithLabelValues(name).Inc()
+		} else {
+			s.metrics.loopLastSuccess.WithLabelValues(name).SetToCurrentTime()
+		}
+
+		return err
+	}
+}
@@ -23,7 +23,7 @@ const (
 )
 
 func (s *Orchestrator) runRelayer(ctx context.Context) error {
-	if noRelay := !s.cfg.RelayValsets && !s.cfg.RelayBatches; noRelay {
+	if noRelay := !s.cfg.RelayValsets && !s.cfg.RelayBatches && !s.cfg.RelayLogicCalls; noRelay {
 		return nil
 	}
 
@@ -36,11 +36,12 @@ func (s *Orchestrator) runRelayer(ctx context.Context) error {
 		"loop_duration":        s.cfg.RelayerLoopDuration.String(),
 		"relay_token_batches":  r.cfg.RelayBatches,
 		"relay_validator_sets": s.cfg.RelayValsets,
+		"relay_logic_calls":    s.cfg.RelayLogicCalls,
 	}).Debugln("starting Relayer...")
 
-	return loops.RunLoop(ctx, s.cfg.RelayerLoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.RelayerLoopDuration, s.instrumentLoop(loopRelayer, s.cfg.RelayerLoopDuration, func() error {
 		return r.relay(ctx)
-	})
+	}))
 }
 
 type relayer struct {
@@ -55,6 +56,11 @@ func (l *relayer) Log() log.Logger {
 func (l *relayer) relay(ctx context.Context) error {
 	defer coretracer.Trace(&ctx, l.svcTags)()
 
+	// replace our own txs that are stuck in the mempool before relaying anything new
+	if err := l.ethereum.ReplaceStuckTxs(ctx); err != nil {
+		l.Log().WithError(err).Warningln("failed to replace stuck Ethereum txs")
+	}
+
 	ethValset, err := l.getLatestEthValset(ctx)
 	if err != nil {
 		coretracer.TraceError(ctx, err)
@@ -79,6 +85,14 @@ func (l *relayer) relay(ctx context.Context) error {
 		})
 	}
 
+	if l.cfg.RelayLogicCalls {
+		pg.Go(func() error {
+			return l.retry(ctx, func() error {
+				return l.relayLogicCall(ctx, ethValset)
+			})
+		})
+	}
+
 	if pg.Initialized() {
 		if err := pg.Wait(); err != nil {
 			return err
@@ -150,6 +164,7 @@ func (l *relayer) relayValset(ctx context.Context, latestEthValset *peggytypes.V
 	}
 
 	txHash, err := l.ethereum.SendEthValsetUpdate(ctx, latestEthValset, latestConfirmedValset, confirmations)
+	l.metrics.reportRelayerTx(relayKindValset, err)
 	if err != nil {
 		coretracer.TraceError(ctx, err)
 		return err
@@ -234,6 +249,7 @@ func (l *relayer) relayTokenBatch(ctx context.Context, latestEthValset *peggytyp
 			}
 
 			txHash, err := l.ethereum.SendTransactionBatch(ctx, latestEthValset, batch, sigs)
+			l.metrics.reportRelayerTx(relayKindBatch, err)
 			if err != nil {
 				// we try to move on the next batch
 				l.Log().WithError(err).WithField("batch_nonce", batch.BatchNonce).Warningln("failed to submit batch to Ethereum")
@@ -250,6 +266,72 @@ func (l *relayer) relayTokenBatch(ctx context.Context, latestEthValset *peggytyp
 	return nil
 }
 
+func (l *relayer) relayLogicCall(ctx context.Context, latestEthValset *peggytypes.Valset) error {
+	defer coretracer.Trace(&ctx, l.svcTags)()
+
+	calls, err := l.injective.LatestLogicCalls(ctx)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return err
+	}
+
+	h, err := l.ethereum.GetHeaderByNumber(ctx, nil)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return err
+	}
+
+	latestEthHeight := h.Number.Uint64()
+	for _, call := range calls {
+		// filter timed out calls
+		if call.Timeout <= latestEthHeight {
+			continue
+		}
+
+		invalidationID := gethcommon.BytesToHash(call.InvalidationId)
+		logger := l.Log().WithFields(log.Fields{
+			"invalidation_id":    invalidationID.Hex(),
+			"invalidation_nonce": call.InvalidationNonce,
+		})
+
+		latestEthNonce, err := l.ethereum.GetLogicCallNonce(ctx, invalidationID)
+		if err != nil {
+			logger.WithError(err).Warningln("failed to get latest logic call nonce from Ethereum")
+			continue
+		}
+
+		// skip calls that were already executed or invalidated by a newer one
+		if call.InvalidationNonce <= latestEthNonce.Uint64() {
+			continue
+		}
+
+		sigs, err := l.injective.LogicCallSignatures(ctx, call.InvalidationId, call.InvalidationNonce)
+		if err != nil {
+			logger.WithError(err).Warningln("failed to get logic call signatures")
+			continue
+		}
+
+		if len(sigs) == 0 {
+			continue
+		}
+
+		txHash, err := l.ethereum.SendLogicCall(ctx, latestEthValset, call, sigs)
+		l.metrics.reportRelayerTx(relayKindLogicCall, err)
+		if err != nil {
+			// we try to move on the next logic call
+			logger.WithError(err).Warningln("failed to submit logic call to Ethereum")
+			continue
+		}
+
+		logger.WithField("tx_hash", txHash.Hex()).Infoln("sent outgoing logic call to Ethereum")
+
+		// a logic call was sent successfully, return early
+		return nil
+	}
+
+	return nil
+}
+
 func (l *relayer) shouldRelayBatch(ctx context.Context, batch *peggytypes.OutgoingTxBatch) bool {
 	defer coretracer.Trace(&ctx, l.svcTags)()
 
@@ -293,6 +375,7 @@ func (l *relayer) shouldRelayBatch(ctx context.Context, batch *peggytypes.Outgoi
 
 		price, err := l.priceFeed.QueryUSDPrice(ctx, gethcommon.HexToAddress(batch.TokenContract))
 		if err != nil {
+			l.metrics.reportFeeCheck(loopRelayer, false, err)
 			coretracer.TraceError(ctx, err)
 			l.Log().WithError(err).Warningln("failed to query USD price")
 			return false
@@ -301,6 +384,7 @@ func (l *relayer) shouldRelayBatch(ctx context.Context, batch *peggytypes.Outgoi
 
 		tokenDecimals, err := l.ethereum.TokenDecimals(ctx, gethcommon.HexToAddress(batch.TokenContract))
 		if err != nil {
+			l.metrics.reportFeeCheck(loopRelayer, false, err)
 			coretracer.TraceError(ctx, err)
 			l.Log().WithError(err).Warningln("failed to get token decimals")
 			return false
@@ -312,6 +396,8 @@ func (l *relayer) shouldRelayBatch(ctx context.Context, batch *peggytypes.Outgoi
 			totalFee  = decimal.NewFromBigInt(fees.BigInt(), -1*int32(tokenDecimals)).Mul(priceUSD)
 		)
 
+		l.metrics.reportFeeCheck(loopRelayer, !totalFee.LessThan(minFeeUSD), nil)
+
 		if totalFee.LessThan(minFeeUSD) {
 			l.Log().WithFields(log.Fields{
 				"batch_nonce": batch.BatchNonce,
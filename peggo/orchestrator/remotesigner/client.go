@@ -0,0 +1,235 @@
+package remotesigner
+
+import (
+	"bytes"
+	"context"
+	"crypto/ecdsa"
+	"encoding/json"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/InjectiveLabs/coretracer"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+)
+
+const (
+	publicKeysPath = "/api/v1/eth1/publicKeys"
+	signPath       = "/api/v1/eth1/sign/"
+	upcheckPath    = "/upcheck"
+
+	defaultTimeout = 10 * time.Second
+)
+
+// SignRequest is the body of a signing request. Data is the hex encoded message to sign, the signer
+// signs its keccak256 hash like Web3Signer does.
+type SignRequest struct {
+	Data string `json:"data"`
+}
+
+type Config struct {
+	URL       string
+	AuthToken string
+	Timeout   time.Duration
+}
+
+// Client talks to a remote signer exposing a Web3Signer-style eth1 HTTP API:
+//
+//	GET  /upcheck                           -> "OK"
+//	GET  /api/v1/eth1/publicKeys            -> ["0x04..."] (uncompressed secp256k1 public keys)
+//	POST /api/v1/eth1/sign/{publicKey}      -> "0x..." (65 byte [R || S || V] signature of keccak256(data))
+//
+// Private keys never leave the signer, the client only sends the messages to sign.
+type Client struct {
+	url       string
+	authToken string
+	http      *http.Client
+
+	svcTags coretracer.Tags
+}
+
+func NewClient(cfg Config) (*Client, error) {
+	if cfg.URL == "" {
+		return nil, errors.New("remote signer URL is not set")
+	}
+
+	if cfg.Timeout == 0 {
+		cfg.Timeout = defaultTimeout
+	}
+
+	c := &Client{
+		url:       strings.TrimSuffix(cfg.URL, "/"),
+		authToken: cfg.AuthToken,
+		http:      &http.Client{Timeout: cfg.Timeout},
+		svcTags:   coretracer.NewTag("svc", "remote_signer"),
+	}
+
+	return c, nil
+}
+
+// Upcheck returns an error if the remote signer is not reachable
+func (c *Client) Upcheck(ctx context.Context) error {
+	_, err := c.do(ctx, http.MethodGet, upcheckPath, nil)
+	return err
+}
+
+// PublicKeys returns all public keys available on the remote signer
+func (c *Client) PublicKeys(ctx context.Context) ([]*ecdsa.PublicKey, error) {
+	defer coretracer.Trace(&ctx, c.svcTags)()
+
+	resp, err := c.do(ctx, http.MethodGet, publicKeysPath, nil)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	var keysHex []string
+	if err := json.Unmarshal(resp, &keysHex); err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "failed to decode public keys")
+	}
+
+	keys := make([]*ecdsa.PublicKey, 0, len(keysHex))
+	for _, keyHex := range keysHex {
+		key, err := decodePublicKey(keyHex)
+		if err != nil {
+			coretracer.TraceError(ctx, err)
+			return nil, err
+		}
+
+		keys = append(keys, key)
+	}
+
+	return keys, nil
+}
+
+// PublicKeyOf returns the remote public key matching the Ethereum address. Cosmos (ethsecp256k1) accounts
+// share the same address bytes, so this also resolves Injective accounts.
+func (c *Client) PublicKeyOf(ctx context.Context, addr common.Address) (*ecdsa.PublicKey, error) {
+	keys, err := c.PublicKeys(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, key := range keys {
+		if crypto.PubkeyToAddress(*key) == addr {
+			return key, nil
+		}
+	}
+
+	return nil, errors.Errorf("remote signer has no key for address %s", addr.String())
+}
+
+// SignData signs the keccak256 hash of data with the key identified by pubKey. Web3Signer always hashes
+// the data it is given, so callers must pass the pre-image of the digest they want signed. The returned
+// signature is in [R || S || V] format with V being 0 or 1, and is checked to be produced by pubKey.
+func (c *Client) SignData(ctx context.Context, pubKey *ecdsa.PublicKey, data []byte) ([]byte, error) {
+	defer coretracer.Trace(&ctx, c.svcTags)()
+
+	if len(data) == 0 {
+		err := errors.New("no data to sign")
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	body, err := json.Marshal(SignRequest{
+		Data: hexutil.Encode(data),
+	})
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	resp, err := c.do(ctx, http.MethodPost, signPath+hexutil.Encode(crypto.FromECDSAPub(pubKey)), body)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	sig, err := hexutil.Decode(strings.Trim(strings.TrimSpace(string(resp)), `"`))
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "failed to decode signature")
+	}
+
+	if len(sig) != crypto.SignatureLength {
+		err := errors.Errorf("invalid signature length %d", len(sig))
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	// Web3Signer returns V as 27/28
+	if sig[crypto.RecoveryIDOffset] >= 27 {
+		sig[crypto.RecoveryIDOffset] -= 27
+	}
+
+	recovered, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	if err != nil {
+		coretracer.TraceError(ctx, err)
+		return nil, errors.Wrap(err, "failed to recover signer public key")
+	}
+
+	if !recovered.Equal(pubKey) {
+		err := errors.New("remote signer returned a signature from a different key")
+		coretracer.TraceError(ctx, err)
+		return nil, err
+	}
+
+	return sig, nil
+}
+
+func (c *Client) do(ctx context.Context, method, path string, body []byte) ([]byte, error) {
+	req, err := http.NewRequestWithContext(ctx, method, c.url+path, bytes.NewReader(body))
+	if err != nil {
+		return nil, err
+	}
+
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	if c.authToken != "" {
+		req.Header.Set("Authorization", "Bearer "+c.authToken)
+	}
+
+	resp, err := c.http.Do(req)
+	if err != nil {
+		return nil, errors.Wrapf(err, "remote signer request %s %s failed", method, path)
+	}
+
+	defer resp.Body.Close()
+
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to read remote signer response")
+	}
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, errors.Errorf("remote signer request %s %s failed with status %d: %s", method, path, resp.StatusCode, string(respBody))
+	}
+
+	return respBody, nil
+}
+
+func decodePublicKey(keyHex string) (*ecdsa.PublicKey, error) {
+	bz, err := hexutil.Decode(keyHex)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid public key %s", keyHex)
+	}
+
+	// accept both compressed and uncompressed encodings
+	if len(bz) == 33 {
+		return crypto.DecompressPubkey(bz)
+	}
+
+	key, err := crypto.UnmarshalPubkey(bz)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid public key %s", keyHex)
+	}
+
+	return key, nil
+}
@@ -0,0 +1,81 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/require"
+)
+
+const testAuthToken = "secret"
+
+func newTestClient(t *testing.T) (*Client, common.Address) {
+	t.Helper()
+
+	key, err := crypto.GenerateKey()
+	require.NoError(t, err)
+
+	srv := httptest.NewServer(NewMockServer(testAuthToken, key))
+	t.Cleanup(srv.Close)
+
+	c, err := NewClient(Config{URL: srv.URL, AuthToken: testAuthToken})
+	require.NoError(t, err)
+
+	return c, crypto.PubkeyToAddress(key.PublicKey)
+}
+
+func TestSignDataRecoversSigner(t *testing.T) {
+	c, addr := newTestClient(t)
+	ctx := context.Background()
+
+	pubKey, err := c.PublicKeyOf(ctx, addr)
+	require.NoError(t, err)
+
+	data := []byte("sign bytes of a cosmos tx")
+	sig, err := c.SignData(ctx, pubKey, data)
+	require.NoError(t, err)
+
+	recovered, err := crypto.SigToPub(crypto.Keccak256(data), sig)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*recovered))
+}
+
+func TestEthereumSignerRecoversSigner(t *testing.T) {
+	c, addr := newTestClient(t)
+	chainID := big.NewInt(11155111)
+
+	signerFn, personalSignFn, err := EthereumSigner(context.Background(), c, chainID.Uint64(), addr)
+	require.NoError(t, err)
+
+	// personal sign must produce an EIP-191 signature of the confirm hash
+	confirmHash := crypto.Keccak256([]byte("valset confirm"))
+	sig, err := personalSignFn(addr, confirmHash)
+	require.NoError(t, err)
+
+	recovered, err := crypto.SigToPub(accounts.TextHash(confirmHash), sig)
+	require.NoError(t, err)
+	require.Equal(t, addr, crypto.PubkeyToAddress(*recovered))
+
+	to := common.HexToAddress("0x6B175474E89094C44Da98b954EedeAC495271d0F")
+	txs := []types.TxData{
+		&types.LegacyTx{Nonce: 1, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Value: big.NewInt(1)},
+		&types.AccessListTx{ChainID: chainID, Nonce: 2, GasPrice: big.NewInt(1e9), Gas: 21000, To: &to, Data: []byte{0x1}},
+		&types.DynamicFeeTx{ChainID: chainID, Nonce: 3, GasTipCap: big.NewInt(1e9), GasFeeCap: big.NewInt(2e9), Gas: 50000, Data: []byte{0x2}},
+	}
+
+	txSigner := types.LatestSignerForChainID(chainID)
+	for _, txData := range txs {
+		signedTx, err := signerFn(addr, types.NewTx(txData))
+		require.NoError(t, err)
+
+		sender, err := types.Sender(txSigner, signedTx)
+		require.NoError(t, err)
+		require.Equal(t, addr, sender)
+	}
+}
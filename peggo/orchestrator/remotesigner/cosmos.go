@@ -0,0 +1,81 @@
+package remotesigner
+
+import (
+	"context"
+	"crypto/ecdsa"
+
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/injective-core/injective-chain/crypto/ethsecp256k1"
+	"github.com/InjectiveLabs/injective-core/injective-chain/crypto/hd"
+	injcodec "github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/codec"
+)
+
+var _ keyring.Keyring = &cosmosKeyring{}
+
+// cosmosKeyring is an in-memory keyring that only holds the public key of the remote account
+// and delegates all signing to the remote signer
+type cosmosKeyring struct {
+	keyring.Keyring
+
+	client       *Client
+	keyName      string
+	addr         sdk.AccAddress
+	pubKey       *ecdsa.PublicKey
+	cosmosPubKey cryptotypes.PubKey
+}
+
+// NewCosmosKeyring returns a keyring holding a single key named keyName for the account addr,
+// whose signatures are produced by the remote signer
+func NewCosmosKeyring(ctx context.Context, c *Client, addr sdk.AccAddress, keyName string) (keyring.Keyring, error) {
+	pubKey, err := c.PublicKeyOf(ctx, common.BytesToAddress(addr.Bytes()))
+	if err != nil {
+		return nil, err
+	}
+
+	cosmosPubKey := &ethsecp256k1.PubKey{Key: crypto.CompressPubkey(pubKey)}
+
+	kr := keyring.NewInMemory(injcodec.Codec(), hd.EthSecp256k1Option())
+	if _, err := kr.SaveOfflineKey(keyName, cosmosPubKey); err != nil {
+		return nil, errors.Wrap(err, "failed to save remote public key")
+	}
+
+	k := &cosmosKeyring{
+		Keyring:      kr,
+		client:       c,
+		keyName:      keyName,
+		addr:         addr,
+		pubKey:       pubKey,
+		cosmosPubKey: cosmosPubKey,
+	}
+
+	return k, nil
+}
+
+func (k *cosmosKeyring) Sign(uid string, msg []byte, _ signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	if uid != k.keyName {
+		return nil, nil, errors.Errorf("key %s not found in remote keyring", uid)
+	}
+
+	// ethsecp256k1 signs the keccak256 hash of the sign bytes, which the remote signer computes
+	sig, err := k.client.SignData(context.Background(), k.pubKey, msg)
+	if err != nil {
+		return nil, nil, errors.Wrap(err, "failed to sign Cosmos tx with remote signer")
+	}
+
+	return sig, k.cosmosPubKey, nil
+}
+
+func (k *cosmosKeyring) SignByAddress(address sdk.Address, msg []byte, signMode signing.SignMode) ([]byte, cryptotypes.PubKey, error) {
+	if !k.addr.Equals(address) {
+		return nil, nil, errors.Errorf("address %s not found in remote keyring", address.String())
+	}
+
+	return k.Sign(k.keyName, msg, signMode)
+}
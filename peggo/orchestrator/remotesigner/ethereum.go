@@ -0,0 +1,114 @@
+package remotesigner
+
+import (
+	"context"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts"
+	"github.com/ethereum/go-ethereum/accounts/abi/bind"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/ethereum/go-ethereum/rlp"
+	"github.com/pkg/errors"
+
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum/keystore"
+)
+
+// EthereumSigner returns the transaction and personal message signing functions for the given address,
+// backed by the remote signer. The chain ID may be 0 if transactions are never signed.
+func EthereumSigner(
+	ctx context.Context,
+	c *Client,
+	chainID uint64,
+	from common.Address,
+) (bind.SignerFn, keystore.PersonalSignFn, error) {
+	pubKey, err := c.PublicKeyOf(ctx, from)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	txSigner := types.LatestSignerForChainID(new(big.Int).SetUint64(chainID))
+
+	signerFn := func(addr common.Address, tx *types.Transaction) (*types.Transaction, error) {
+		if addr != from {
+			return nil, bind.ErrNotAuthorized
+		}
+
+		preimage, err := txSigningPreimage(txSigner, tx)
+		if err != nil {
+			return nil, err
+		}
+
+		sig, err := c.SignData(context.Background(), pubKey, preimage)
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign transaction with remote signer")
+		}
+
+		return tx.WithSignature(txSigner, sig)
+	}
+
+	personalSignFn := func(addr common.Address, data []byte) ([]byte, error) {
+		if addr != from {
+			return nil, errors.New("from address mismatch")
+		}
+
+		// the signer hashes the prefixed message into the EIP-191 text hash
+		_, msg := accounts.TextAndHash(data)
+
+		sig, err := c.SignData(context.Background(), pubKey, []byte(msg))
+		if err != nil {
+			return nil, errors.Wrap(err, "failed to sign message with remote signer")
+		}
+
+		return sig, nil
+	}
+
+	return signerFn, personalSignFn, nil
+}
+
+// txSigningPreimage returns the bytes whose keccak256 hash is the signing hash of tx,
+// as the remote signer only signs hashes of the data it is sent
+func txSigningPreimage(txSigner types.Signer, tx *types.Transaction) ([]byte, error) {
+	var (
+		preimage []byte
+		err      error
+	)
+
+	switch tx.Type() {
+	case types.LegacyTxType:
+		if txSigner.ChainID().Sign() == 0 {
+			preimage, err = rlp.EncodeToBytes([]any{
+				tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+			})
+		} else {
+			preimage, err = rlp.EncodeToBytes([]any{
+				tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(),
+				txSigner.ChainID(), uint(0), uint(0),
+			})
+		}
+	case types.AccessListTxType:
+		preimage, err = rlp.EncodeToBytes([]any{
+			txSigner.ChainID(), tx.Nonce(), tx.GasPrice(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		preimage = append([]byte{types.AccessListTxType}, preimage...)
+	case types.DynamicFeeTxType:
+		preimage, err = rlp.EncodeToBytes([]any{
+			txSigner.ChainID(), tx.Nonce(), tx.GasTipCap(), tx.GasFeeCap(), tx.Gas(), tx.To(), tx.Value(), tx.Data(), tx.AccessList(),
+		})
+		preimage = append([]byte{types.DynamicFeeTxType}, preimage...)
+	default:
+		return nil, errors.Errorf("remote signer does not support transactions of type %d", tx.Type())
+	}
+
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to encode transaction")
+	}
+
+	// guards against the encoding drifting from the one go-ethereum signs
+	if crypto.Keccak256Hash(preimage) != txSigner.Hash(tx) {
+		return nil, errors.New("transaction pre-image does not match its signing hash")
+	}
+
+	return preimage, nil
+}
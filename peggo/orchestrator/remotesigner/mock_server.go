@@ -0,0 +1,99 @@
+package remotesigner
+
+import (
+	"crypto/ecdsa"
+	"encoding/json"
+	"net/http"
+	"strings"
+
+	"github.com/ethereum/go-ethereum/common/hexutil"
+	"github.com/ethereum/go-ethereum/crypto"
+	log "github.com/xlab/suplog"
+)
+
+var _ http.Handler = &MockServer{}
+
+// MockServer is an in-process remote signer holding plaintext private keys. It implements the same API
+// as the Client expects and is meant for tests and local setups only, never for production keys.
+type MockServer struct {
+	keys      map[string]*ecdsa.PrivateKey
+	authToken string
+}
+
+func NewMockServer(authToken string, keys ...*ecdsa.PrivateKey) *MockServer {
+	s := &MockServer{
+		keys:      make(map[string]*ecdsa.PrivateKey, len(keys)),
+		authToken: authToken,
+	}
+
+	for _, key := range keys {
+		s.keys[hexutil.Encode(crypto.FromECDSAPub(&key.PublicKey))] = key
+	}
+
+	return s
+}
+
+func (s *MockServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	if s.authToken != "" && r.Header.Get("Authorization") != "Bearer "+s.authToken {
+		http.Error(w, "unauthorized", http.StatusUnauthorized)
+		return
+	}
+
+	switch {
+	case r.Method == http.MethodGet && r.URL.Path == upcheckPath:
+		_, _ = w.Write([]byte("OK"))
+	case r.Method == http.MethodGet && r.URL.Path == publicKeysPath:
+		s.publicKeys(w)
+	case r.Method == http.MethodPost && strings.HasPrefix(r.URL.Path, signPath):
+		s.sign(w, r, strings.TrimPrefix(r.URL.Path, signPath))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+func (s *MockServer) publicKeys(w http.ResponseWriter) {
+	keys := make([]string, 0, len(s.keys))
+	for pubKey := range s.keys {
+		keys = append(keys, pubKey)
+	}
+
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(keys)
+}
+
+func (s *MockServer) sign(w http.ResponseWriter, r *http.Request, identifier string) {
+	key, ok := s.keys[strings.ToLower(identifier)]
+	if !ok {
+		http.Error(w, "key not found", http.StatusNotFound)
+		return
+	}
+
+	var req SignRequest
+	if err := json.NewDecoder(r.Body).Decode(&req); err != nil {
+		http.Error(w, "invalid request body", http.StatusBadRequest)
+		return
+	}
+
+	data, err := hexutil.Decode(req.Data)
+	if err != nil || len(data) == 0 {
+		http.Error(w, "data must be hex encoded", http.StatusBadRequest)
+		return
+	}
+
+	// Web3Signer signs the keccak256 hash of the data, never the data itself
+	sig, err := crypto.Sign(crypto.Keccak256(data), key)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+
+	// respond like Web3Signer does, with V as 27/28
+	sig[crypto.RecoveryIDOffset] += 27
+
+	log.WithFields(log.Fields{
+		"address": crypto.PubkeyToAddress(key.PublicKey).String(),
+	}).Debugln("mock remote signer signed data")
+
+	w.Header().Set("Content-Type", "text/plain")
+	_, _ = w.Write([]byte(hexutil.Encode(sig)))
+}
@@ -0,0 +1,248 @@
+package orchestrator
+
+import (
+	"bytes"
+	"context"
+	"sort"
+
+	cosmostypes "github.com/cosmos/cosmos-sdk/types"
+	"github.com/pkg/errors"
+
+	peggytypes "github.com/InjectiveLabs/injective-core/injective-chain/modules/peggy/types"
+	injcodec "github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/codec"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/cosmos/peggy"
+	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/ethereum"
+)
+
+// ClaimStatus describes how a re-derived claim compares to the claims this validator made on Injective.
+type ClaimStatus string
+
+const (
+	// ClaimMatched means the validator voted on an attestation with the same claim hash.
+	ClaimMatched ClaimStatus = "matched"
+	// ClaimMismatched means the validator voted on an attestation for the same event nonce, but with a different claim.
+	ClaimMismatched ClaimStatus = "mismatched"
+	// ClaimNotSubmitted means the event nonce is above the validator's last claimed nonce.
+	ClaimNotSubmitted ClaimStatus = "not_submitted"
+	// ClaimPruned means the attestation was observed and pruned, so the claimed contents can no longer be compared.
+	ClaimPruned ClaimStatus = "pruned"
+	// ClaimNotVoted means attestations for the event nonce exist but none of them carries the validator's vote.
+	ClaimNotVoted ClaimStatus = "not_voted"
+	// ClaimUnexpected means the validator voted for an event in the block range that cannot be found on Ethereum.
+	ClaimUnexpected ClaimStatus = "unexpected"
+)
+
+// ClaimDiff is a single row of a claim replay.
+type ClaimDiff struct {
+	EventNonce uint64
+	Status     ClaimStatus
+	Derived    peggytypes.EthereumClaim // claim re-derived from Ethereum events, nil for ClaimUnexpected
+	OnChain    peggytypes.EthereumClaim // claim the validator voted for, nil if unknown
+}
+
+// IsMismatch reports whether the diff indicates a disagreement between Ethereum and the validator's claims.
+func (d ClaimDiff) IsMismatch() bool {
+	switch d.Status {
+	case ClaimMismatched, ClaimNotVoted, ClaimUnexpected:
+		return true
+	default:
+		return false
+	}
+}
+
+// LatestConfirmedEthHeight returns the latest Ethereum block the oracle would consider for claims.
+func LatestConfirmedEthHeight(ctx context.Context, eth ethereum.Network) (uint64, error) {
+	h, err := eth.GetHeaderByNumber(ctx, nil)
+	if err != nil {
+		return 0, errors.Wrap(err, "failed to get latest ethereum header")
+	}
+
+	latestHeight := h.Number.Uint64()
+	if latestHeight <= ethBlockConfirmationDelay {
+		return 0, errors.New("not enough blocks on Ethereum")
+	}
+
+	return latestHeight - ethBlockConfirmationDelay, nil
+}
+
+// DeriveClaims re-derives every claim the oracle would submit for Peggy.sol events emitted in
+// [startBlock, endBlock]. Claims are sorted by event nonce. Nothing is broadcast.
+func DeriveClaims(
+	ctx context.Context,
+	eth ethereum.Network,
+	orchestrator cosmostypes.AccAddress,
+	startBlock,
+	endBlock uint64,
+) ([]peggytypes.EthereumClaim, error) {
+	if startBlock > endBlock {
+		return nil, errors.Errorf("invalid block range: %d > %d", startBlock, endBlock)
+	}
+
+	var claims []peggytypes.EthereumClaim
+	for from := startBlock; from <= endBlock; from += defaultBlocksToSearch {
+		to := min(from+defaultBlocksToSearch-1, endBlock)
+
+		depositEvents, err := eth.GetSendToInjectiveEvents(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		withdrawalEvents, err := eth.GetTransactionBatchExecutedEvents(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		erc20DeploymentEvents, err := eth.GetPeggyERC20DeployedEvents(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		valsetUpdateEvents, err := eth.GetValsetUpdatedEvents(ctx, from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, e := range depositEvents {
+			claims = append(claims, peggy.NewDepositClaim(e, orchestrator))
+		}
+
+		for _, e := range withdrawalEvents {
+			claims = append(claims, peggy.NewWithdrawalClaim(e, orchestrator))
+		}
+
+		for _, e := range valsetUpdateEvents {
+			// the valset set in the Peggy.sol constructor is emitted with nonce 0 and is never claimed
+			if e.EventNonce.Uint64() == 0 {
+				continue
+			}
+
+			claims = append(claims, peggy.NewValsetClaim(e, orchestrator))
+		}
+
+		for _, e := range erc20DeploymentEvents {
+			claims = append(claims, peggy.NewERC20DeployedClaim(e, orchestrator))
+		}
+	}
+
+	sort.Slice(claims, func(i, j int) bool {
+		return claims[i].GetEventNonce() < claims[j].GetEventNonce()
+	})
+
+	return claims, nil
+}
+
+// ReplayClaims re-derives the claims for [startBlock, endBlock] and compares them against the
+// attestations the validator voted on. Only queries are made, nothing is broadcast.
+func ReplayClaims(
+	ctx context.Context,
+	injective peggy.QueryClient,
+	eth ethereum.Network,
+	orchestrator cosmostypes.AccAddress,
+	validator cosmostypes.ValAddress,
+	startBlock,
+	endBlock uint64,
+) ([]ClaimDiff, error) {
+	derived, err := DeriveClaims(ctx, eth, orchestrator, startBlock, endBlock)
+	if err != nil {
+		return nil, err
+	}
+
+	lastClaim, err := injective.LastClaimEventByAddr(ctx, orchestrator)
+	if err != nil {
+		return nil, err
+	}
+
+	state, err := injective.ModuleState(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	type attestation struct {
+		claim peggytypes.EthereumClaim
+		voted bool
+	}
+
+	var (
+		registry     = injcodec.Codec().InterfaceRegistry()
+		validatorStr = validator.String()
+		attestations = make(map[uint64][]attestation)
+	)
+
+	for _, att := range state.Attestations {
+		var claim peggytypes.EthereumClaim
+		if err := registry.UnpackAny(att.Claim, &claim); err != nil {
+			return nil, errors.Wrap(err, "failed to unpack attestation claim")
+		}
+
+		voted := false
+		for _, v := range att.Votes {
+			if v == validatorStr {
+				voted = true
+				break
+			}
+		}
+
+		nonce := claim.GetEventNonce()
+		attestations[nonce] = append(attestations[nonce], attestation{claim: claim, voted: voted})
+	}
+
+	diffs := make([]ClaimDiff, 0, len(derived))
+	derivedNonces := make(map[uint64]struct{}, len(derived))
+
+	for _, claim := range derived {
+		nonce := claim.GetEventNonce()
+		derivedNonces[nonce] = struct{}{}
+
+		diff := ClaimDiff{EventNonce: nonce, Derived: claim}
+		for _, att := range attestations[nonce] {
+			if att.voted {
+				diff.OnChain = att.claim
+				break
+			}
+		}
+
+		switch {
+		case diff.OnChain != nil && bytes.Equal(diff.OnChain.ClaimHash(), claim.ClaimHash()):
+			diff.Status = ClaimMatched
+		case diff.OnChain != nil:
+			diff.Status = ClaimMismatched
+		case nonce > lastClaim.EthereumEventNonce:
+			diff.Status = ClaimNotSubmitted
+		case len(attestations[nonce]) == 0 && nonce < state.LastObservedNonce:
+			diff.Status = ClaimPruned
+		default:
+			diff.Status = ClaimNotVoted
+		}
+
+		diffs = append(diffs, diff)
+	}
+
+	// votes for events in the block range that do not exist on Ethereum
+	for nonce, atts := range attestations {
+		if _, ok := derivedNonces[nonce]; ok {
+			continue
+		}
+
+		for _, att := range atts {
+			if !att.voted {
+				continue
+			}
+
+			if h := att.claim.GetBlockHeight(); h < startBlock || h > endBlock {
+				continue
+			}
+
+			diffs = append(diffs, ClaimDiff{
+				EventNonce: nonce,
+				Status:     ClaimUnexpected,
+				OnChain:    att.claim,
+			})
+		}
+	}
+
+	sort.SliceStable(diffs, func(i, j int) bool {
+		return diffs[i].EventNonce < diffs[j].EventNonce
+	})
+
+	return diffs, nil
+}
@@ -11,7 +11,7 @@ import (
 	"github.com/InjectiveLabs/injective-core/peggo/orchestrator/loops"
 )
 
-// runSigner simply signs off on any batches or validator sets provided by the validator
+// runSigner simply signs off on any batches, logic calls or validator sets provided by the validator
 // since these are provided directly by a trusted Injective node they can simply be assumed to be
 // valid and signed off on.
 func (s *Orchestrator) runSigner(ctx context.Context, peggyID gethcommon.Hash) error {
@@ -23,9 +23,9 @@ func (s *Orchestrator) runSigner(ctx context.Context, peggyID gethcommon.Hash) e
 
 	s.logger.WithField("loop_duration", s.cfg.LoopDuration.String()).Debugln("starting Signer...")
 
-	return loops.RunLoop(ctx, s.cfg.LoopDuration, func() error {
+	return loops.RunLoop(ctx, s.cfg.LoopDuration, s.instrumentLoop(loopSigner, s.cfg.LoopDuration, func() error {
 		return signer.sign(ctx)
-	})
+	}))
 }
 
 type signer struct {
@@ -49,6 +49,10 @@ func (l *signer) sign(ctx context.Context) error {
 		return err
 	}
 
+	if err := l.signLogicCalls(ctx); err != nil {
+		return err
+	}
+
 	return nil
 }
 
@@ -66,6 +70,8 @@ func (l *signer) signValidatorSets(ctx context.Context) error {
 		return err
 	}
 
+	l.metrics.pendingValsetsToSign.Set(float64(len(valsets)))
+
 	if len(valsets) == 0 {
 		l.Log().Infoln("no validator set to confirm")
 		return nil
@@ -102,10 +108,18 @@ func (l *signer) signNewBatch(ctx context.Context) error {
 	}
 
 	if oldestUnsignedBatch == nil {
+		l.metrics.pendingBatchesToSign.Set(0)
 		l.Log().Infoln("no token batch to confirm")
 		return nil
 	}
 
+	unsignedBatches, err := l.countUnsignedBatches(ctx)
+	if err != nil {
+		l.Log().WithError(err).Warningln("failed to count unsigned batches")
+	} else {
+		l.metrics.pendingBatchesToSign.Set(float64(unsignedBatches))
+	}
+
 	if err := l.retry(ctx, func() error {
 		return l.injective.SendBatchConfirm(ctx,
 			l.cfg.EthereumAddr,
@@ -124,3 +138,72 @@ func (l *signer) signNewBatch(ctx context.Context) error {
 
 	return nil
 }
+
+// countUnsignedBatches returns the number of outgoing batches not yet confirmed by this orchestrator
+func (l *signer) countUnsignedBatches(ctx context.Context) (int, error) {
+	batches, err := l.injective.LatestTransactionBatches(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	orchestrator := l.cfg.CosmosAddr.String()
+
+	unsigned := 0
+	for _, batch := range batches {
+		confirms, err := l.injective.TransactionBatchSignatures(ctx, batch.BatchNonce, gethcommon.HexToAddress(batch.TokenContract))
+		if err != nil {
+			return 0, err
+		}
+
+		signed := false
+		for _, confirm := range confirms {
+			if confirm.Orchestrator == orchestrator {
+				signed = true
+				break
+			}
+		}
+
+		if !signed {
+			unsigned++
+		}
+	}
+
+	return unsigned, nil
+}
+
+func (l *signer) signLogicCalls(ctx context.Context) error {
+	defer coretracer.Trace(&ctx, l.svcTags)()
+
+	var oldestUnsignedCall *peggytypes.OutgoingLogicCall
+	getCallFn := func() error {
+		oldestUnsignedCall, _ = l.injective.OldestUnsignedLogicCall(ctx, l.cfg.CosmosAddr)
+		return nil
+	}
+
+	if err := l.retry(ctx, getCallFn); err != nil {
+		return err
+	}
+
+	if oldestUnsignedCall == nil {
+		l.Log().Infoln("no logic call to confirm")
+		return nil
+	}
+
+	if err := l.retry(ctx, func() error {
+		return l.injective.SendLogicCallConfirm(ctx,
+			l.cfg.EthereumAddr,
+			l.peggyID,
+			oldestUnsignedCall,
+		)
+	}); err != nil {
+		return err
+	}
+
+	l.Log().WithFields(log.Fields{
+		"logic_contract":     oldestUnsignedCall.LogicContractAddress,
+		"invalidation_id":    gethcommon.Bytes2Hex(oldestUnsignedCall.InvalidationId),
+		"invalidation_nonce": oldestUnsignedCall.InvalidationNonce,
+	}).Infoln("confirmed logic call on Injective")
+
+	return nil
+}
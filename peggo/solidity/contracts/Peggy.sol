@@ -28,6 +28,21 @@ struct ValsetArgs {
     address rewardToken;
 }
 
+// This is used purely to avoid stack too deep errors
+// represents everything about a given logic call
+struct LogicCallArgs {
+    // the arbitrary contract address the payload is executed against
+    address logicContractAddress;
+    // the ABI encoded calldata passed to the logic contract
+    bytes payload;
+    // a block height beyond which this call is not valid
+    uint256 timeOut;
+    // the invalidation nonce is scoped by this id, calls sharing it
+    // must be executed with strictly increasing nonces
+    bytes32 invalidationId;
+    uint256 invalidationNonce;
+}
+
 // Don't change the order of state for working upgrades.
 // AND BE AWARE OF INHERITANCE VARIABLES!
 // Inherited contracts contain storage slots and must be accounted for in any upgrades
@@ -88,6 +103,14 @@ contract Peggy is
         uint256[] _powers
     );
 
+    // LogicCallEvent does not include the field _eventNonce because it is never submitted to the Cosmos
+    // module. Logic calls are removed from the Cosmos side once they time out.
+    event LogicCallEvent(
+        bytes32 indexed _invalidationId,
+        uint256 indexed _invalidationNonce,
+        bytes _returnData
+    );
+
     function initialize(
         // A unique identifier for this peggy instance to use in signatures
         bytes32 _peggyId,
@@ -154,6 +177,14 @@ contract Peggy is
         return state_lastBatchNonces[_erc20Address];
     }
 
+    function lastLogicCallNonce(bytes32 _invalidationId)
+        public
+        view
+        returns (uint256)
+    {
+        return state_invalidationMapping[_invalidationId];
+    }
+
     // Utility function to verify geth style signatures
     function verifySig(
         address _signer,
@@ -451,6 +482,93 @@ contract Peggy is
         }
     }
 
+    // submitLogicCall executes an arbitrary call against a logic contract. It is approved by the current
+    // Cosmos validator set. Anyone can call this function, but they must supply valid signatures of
+    // state_powerThreshold of the current valset over the logic call.
+    function submitLogicCall(
+        // The validators that approve the call
+        ValsetArgs memory _currentValset,
+        // These are arrays of the parts of the validators signatures
+        uint8[] memory _v,
+        bytes32[] memory _r,
+        bytes32[] memory _s,
+        LogicCallArgs memory _args
+    ) external nonReentrant whenNotPaused {
+        // CHECKS scoped to reduce stack depth
+        {
+            // Check that the call has not timed out
+            require(
+                block.number < _args.timeOut,
+                "Timed out"
+            );
+
+            // Check that the invalidation nonce is higher than the last nonce for this invalidation id
+            require(
+                state_invalidationMapping[_args.invalidationId] <
+                    _args.invalidationNonce,
+                "New invalidation nonce must be greater than the current nonce"
+            );
+
+            // Check that current validators, powers, and signatures (v,r,s) set is well-formed
+            require(
+                _currentValset.validators.length ==
+                    _currentValset.powers.length &&
+                    _currentValset.validators.length == _v.length &&
+                    _currentValset.validators.length == _r.length &&
+                    _currentValset.validators.length == _s.length,
+                "Malformed current validator set"
+            );
+
+            // Check that the supplied current validator set matches the saved checkpoint
+            require(
+                makeCheckpoint(_currentValset, state_peggyId) ==
+                    state_lastValsetCheckpoint,
+                "Supplied current validators and powers do not match checkpoint."
+            );
+
+            // Check that enough current validators have signed off on the logic call
+            checkValidatorSignatures(
+                _currentValset.validators,
+                _currentValset.powers,
+                _v,
+                _r,
+                _s,
+                keccak256(
+                    abi.encode(
+                        state_peggyId,
+                        // bytes32 encoding of "logicCall"
+                        0x6c6f67696343616c6c0000000000000000000000000000000000000000000000,
+                        _args.logicContractAddress,
+                        _args.payload,
+                        _args.timeOut,
+                        _args.invalidationId,
+                        _args.invalidationNonce
+                    )
+                ),
+                state_powerThreshold
+            );
+        }
+
+        // ACTIONS
+
+        // Update invalidation nonce
+        state_invalidationMapping[_args.invalidationId] = _args
+            .invalidationNonce;
+
+        // Make call to logic contract
+        bytes memory returnData = Address.functionCall(
+            _args.logicContractAddress,
+            _args.payload
+        );
+
+        // LOGS
+        emit LogicCallEvent(
+            _args.invalidationId,
+            _args.invalidationNonce,
+            returnData
+        );
+    }
+
     function sendToInjective(
         address _tokenContract,
         bytes32 _destination,
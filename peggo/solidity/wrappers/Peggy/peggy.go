@@ -29,6 +29,15 @@ var (
 	_ = abi.ConvertType
 )
 
+// LogicCallArgs is an auto generated low-level Go binding around an user-defined struct.
+type LogicCallArgs struct {
+	LogicContractAddress common.Address
+	Payload              []byte
+	TimeOut              *big.Int
+	InvalidationId       [32]byte
+	InvalidationNonce    *big.Int
+}
+
 // ValsetArgs is an auto generated low-level Go binding around an user-defined struct.
 type ValsetArgs struct {
 	Validators   []common.Address
@@ -40,8 +49,8 @@ type ValsetArgs struct {
 
 // PeggyMetaData contains all meta data concerning the Peggy contract.
 var PeggyMetaData = &bind.MetaData{
-	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_cosmosDenom\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"}],\"name\":\"ERC20DeployedEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_destination\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_data\",\"type\":\"string\"}],\"name\":\"SendToInjectiveEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"_batchNonce\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"}],\"name\":\"TransactionBatchExecutedEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"_newValsetNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_rewardAmount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_rewardToken\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"_powers\",\"type\":\"uint256[]\"}],\"name\":\"ValsetUpdatedEvent\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"name\":\"deployERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"emergencyPause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"emergencyUnpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOwnershipExpiryTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_peggyId\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_powerThreshold\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_powers\",\"type\":\"uint256[]\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isOwnershipExpired\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_erc20Address\",\"type\":\"address\"}],\"name\":\"lastBatchNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnershipAfterExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_destination\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_data\",\"type\":\"string\"}],\"name\":\"sendToInjective\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"state_invalidationMapping\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"state_lastBatchNonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastEventNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastValsetCheckpoint\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastValsetNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_peggyId\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_powerThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"internalType\":\"uint8[]\",\"name\":\"_v\",\"type\":\"uint8[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_r\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_s\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"address[]\",\"name\":\"_destinations\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_fees\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"_batchNonce\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_batchTimeout\",\"type\":\"uint256\"}],\"name\":\"submitBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_newValset\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"internalType\":\"uint8[]\",\"name\":\"_v\",\"type\":\"uint8[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_r\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_s\",\"type\":\"bytes32[]\"}],\"name\":\"updateValset\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
-	Bin: "0x60806040525f606b555f606c553480156016575f5ffd5b505f60665f6101000a81548160ff0219169083151502179055506001606781905550614062806100455f395ff3fe608060405234801561000f575f5ffd5b5060043610610140575f3560e01c80638c64865f116100b6578063c359a2121161007a578063c359a212146102f2578063df97174b1461030e578063e5a2b5d21461033e578063f2b533071461035c578063f2fde38b1461037a578063f79556371461039657610140565b80638c64865f146102745780638da5cb5b1461027e578063a5352f5b1461029c578063b24614f2146102b8578063b56561fe146102d457610140565b80635c975abb116101085780635c975abb146101c457806369dd3908146101e2578063715018a61461020057806373b205471461020a5780637dfb6f8614610228578063817474181461025857610140565b8063011b2174146101445780631ee7a108146101745780634a4e3bd51461019257806351858e271461019c5780635afe97bb146101a6575b5f5ffd5b61015e6004803603810190610159919061212e565b6103b2565b60405161016b9190612171565b60405180910390f35b61017c6103f8565b6040516101899190612171565b60405180910390f35b61019a610410565b005b6101a4610496565b005b6101ae61051c565b6040516101bb91906121a4565b60405180910390f35b6101cc61052c565b6040516101d991906121a4565b60405180910390f35b6101ea610541565b6040516101f791906121d5565b60405180910390f35b610208610547565b005b6102126105cd565b60405161021f9190612171565b60405180910390f35b610242600480360381019061023d9190612218565b6105d3565b60405161024f9190612171565b60405180910390f35b610272600480360381019061026d91906126fc565b6105e8565b005b61027c610a5c565b005b610286610aad565b60405161029391906128a8565b60405180910390f35b6102b660048036038101906102b19190612991565b610ad5565b005b6102d260048036038101906102cd9190612af5565b610fa9565b005b6102dc6110fd565b6040516102e99190612171565b60405180910390f35b61030c60048036038101906103079190612c23565b611103565b005b6103286004803603810190610323919061212e565b611410565b6040516103359190612171565b60405180910390f35b610346611425565b6040516103539190612171565b60405180910390f35b61036461142b565b60405161037191906121d5565b60405180910390f35b610394600480360381019061038f919061212e565b611431565b005b6103b060048036038101906103ab9190612cc6565b6115d9565b005b5f60695f8373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20549050919050565b5f6302f4bd0060345461040b9190612db7565b905090565b610418611614565b73ffffffffffffffffffffffffffffffffffffffff16610436610aad565b73ffffffffffffffffffffffffffffffffffffffff161461048c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161048390612e44565b60405180910390fd5b61049461161b565b565b61049e611614565b73ffffffffffffffffffffffffffffffffffffffff166104bc610aad565b73ffffffffffffffffffffffffffffffffffffffff1614610512576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161050990612e44565b60405180910390fd5b61051a6116bb565b565b5f6105256103f8565b4211905090565b5f60665f9054906101000a900460ff16905090565b606d5481565b61054f611614565b73ffffffffffffffffffffffffffffffffffffffff1661056d610aad565b73ffffffffffffffffffffffffffffffffffffffff16146105c3576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016105ba90612e44565b60405180910390fd5b6105cb61175d565b565b606c5481565b606a602052805f5260405f205f915090505481565b60026067540361062d576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161062490612eac565b60405180910390fd5b600260678190555061063d61052c565b1561067d576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161067490612f14565b60405180910390fd5b8260695f8473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f2054106106fc576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016106f390612fa2565b60405180910390fd5b80431061073e576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161073590613030565b60405180910390fd5b8960200151518a5f01515114801561075a575088518a5f015151145b801561076a575087518a5f015151145b801561077a575086518a5f015151145b6107b9576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107b090613098565b60405180910390fd5b6068546107c88b606d5461181a565b14610808576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016107ff90613126565b60405180910390fd5b8451865114801561081a575083518651145b610859576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016108509061318e565b60405180910390fd5b6108c78a5f01518b602001518b8b8b606d547f7472616e73616374696f6e4261746368000000000000000000000000000000008d8d8d8d8d8d6040516020016108a998979695949392919061335c565b60405160208183030381529060405280519060200120606e54611895565b8260695f8473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20819055505f5f5f90505b87518110156109ac5761097787828151811061092e5761092d6133ed565b5b6020026020010151898381518110610949576109486133ed565b5b60200260200101518673ffffffffffffffffffffffffffffffffffffffff16611a099092919063ffffffff16565b85818151811061098a576109896133ed565b5b60200260200101518261099d9190612db7565b9150808060010191505061090f565b505f8111156109e1576109e033828573ffffffffffffffffffffffffffffffffffffffff16611a099092919063ffffffff16565b5b506001606c546109f19190612db7565b606c819055508173ffffffffffffffffffffffffffffffffffffffff16837f02c7e81975f8edb86e2a0c038b7b86a49c744236abf0f6177ff5afc6986ab708606c54604051610a409190612171565b60405180910390a3600160678190555050505050505050505050565b610a6461051c565b610aa3576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610a9a90613464565b60405180910390fd5b610aab61175d565b565b5f60335f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905090565b610add61052c565b15610b1d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610b1490612f14565b60405180910390fd5b8660400135886040013511610b67576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610b5e906134f2565b60405180910390fd5b878060200190610b77919061351c565b905088805f0190610b88919061357e565b905014610bca576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610bc19061362a565b60405180910390fd5b868060200190610bda919061351c565b905087805f0190610beb919061357e565b9050148015610c0c57508585905087805f0190610c08919061357e565b9050145b8015610c2a57508383905087805f0190610c26919061357e565b9050145b8015610c4857508181905087805f0190610c44919061357e565b9050145b610c87576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c7e90613098565b60405180910390fd5b606854610c9f88610c9790613648565b606d5461181a565b14610cdf576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610cd690613126565b60405180910390fd5b5f610cf589610ced90613648565b606d5461181a565b9050610e6388805f0190610d09919061357e565b808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f82011690508083019250505050505050898060200190610d58919061351c565b808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f820116905080830192505050505050508989808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f820116905080830192505050505050508888808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f820116905080830192505050505050508787808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f8201169050808301925050505050505086606e54611895565b806068819055508860400135606b819055505f73ffffffffffffffffffffffffffffffffffffffff16896080016020810190610e9f919061212e565b73ffffffffffffffffffffffffffffffffffffffff1614158015610ec757505f896060013514155b15610f0e57610f0d338a606001358b6080016020810190610ee8919061212e565b73ffffffffffffffffffffffffffffffffffffffff16611a099092919063ffffffff16565b5b6001606c54610f1d9190612db7565b606c8190555088604001357f76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a606c548b606001358c6080016020810190610f64919061212e565b8d805f0190610f73919061357e565b8f8060200190610f83919061351c565b604051610f969796959493929190613748565b60405180910390a2505050505050505050565b610fb161052c565b15610ff1576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610fe890612f14565b60405180910390fd5b600260675403611036576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161102d90612eac565b60405180910390fd5b600260678190555061106b3330858873ffffffffffffffffffffffffffffffffffffffff16611a8f909392919063ffffffff16565b6001606c5461107a9190612db7565b606c81905550833373ffffffffffffffffffffffffffffffffffffffff168673ffffffffffffffffffffffffffffffffffffffff167f21db205faf35053e62272e44562f34122e27a986e21a1030f6bfc21d96eaad0986606c5487876040516110e694939291906137e5565b60405180910390a460016067819055505050505050565b606b5481565b5f60019054906101000a900460ff168061112857505f5f9054906101000a900460ff16155b611167576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161115e90613893565b60405180910390fd5b5f5f60019054906101000a900460ff1615905080156111b45760015f60016101000a81548160ff02191690831515021790555060015f5f6101000a81548160ff0219169083151502179055505b6111bc611b18565b6111c4611bec565b82829050858590501461120c576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161120390613098565b60405180910390fd5b5f5f90505f5f90505b8484905081101561125b57848482818110611233576112326133ed565b5b90506020020135826112459190612db7565b915087821161125b578080600101915050611215565b5086811161129e576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161129590613921565b60405180910390fd5b6112a6612081565b6040518060a001604052808888808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f8201169050808301925050505050505081526020018686808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f8201169050808301925050505050505081526020015f81526020015f81526020015f73ffffffffffffffffffffffffffffffffffffffff1681525090505f611370828b61181a565b905089606d8190555088606e81905550806068819055506001606c546113969190612db7565b606c81905550606b547f76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a606c545f5f8c8c8c8c6040516113dc9796959493929190613978565b60405180910390a25050508015611407575f5f60016101000a81548160ff0219169083151502179055505b50505050505050565b6069602052805f5260405f205f915090505481565b606e5481565b60685481565b611439611614565b73ffffffffffffffffffffffffffffffffffffffff16611457610aad565b73ffffffffffffffffffffffffffffffffffffffff16146114ad576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016114a490612e44565b60405180910390fd5b5f73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff160361151b576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161151290613a4b565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff1660335f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a38060335f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b6040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161160b90613ab3565b60405180910390fd5b5f33905090565b61162361052c565b611662576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161165990613b1b565b60405180910390fd5b5f60665f6101000a81548160ff0219169083151502179055507f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa6116a4611614565b6040516116b191906128a8565b60405180910390a1565b6116c361052c565b15611703576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016116fa90612f14565b60405180910390fd5b600160665f6101000a81548160ff0219169083151502179055507f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258611746611614565b60405161175391906128a8565b60405180910390a1565b5f73ffffffffffffffffffffffffffffffffffffffff1660335f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a35f60335f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550565b5f5f7f636865636b706f696e74000000000000000000000000000000000000000000005f1b90505f83828660400151875f0151886020015189606001518a608001516040516020016118729796959493929190613b39565b604051602081830303815290604052805190602001209050809250505092915050565b5f5f90505f5f90505b88518110156119bc575f8782815181106118bb576118ba6133ed565b5b602002602001015160ff16146119af576119408982815181106118e1576118e06133ed565b5b6020026020010151858984815181106118fd576118fc6133ed565b5b6020026020010151898581518110611918576119176133ed565b5b6020026020010151898681518110611933576119326133ed565b5b6020026020010151611d6d565b61197f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161197690613c24565b60405180910390fd5b878181518110611992576119916133ed565b5b6020026020010151826119a59190612db7565b91508282116119bc575b808060010191505061189e565b508181116119ff576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016119f690613921565b60405180910390fd5b5050505050505050565b611a8a8363a9059cbb60e01b8484604051602401611a28929190613c42565b604051602081830303815290604052907bffffffffffffffffffffffffffffffffffffffffffffffffffffffff19166020820180517bffffffffffffffffffffffffffffffffffffffffffffffffffffffff8381831617835250505050611e1e565b505050565b611b12846323b872dd60e01b858585604051602401611ab093929190613c69565b604051602081830303815290604052907bffffffffffffffffffffffffffffffffffffffffffffffffffffffff19166020820180517bffffffffffffffffffffffffffffffffffffffffffffffffffffffff8381831617835250505050611e1e565b50505050565b5f60019054906101000a900460ff1680611b3d57505f5f9054906101000a900460ff16155b611b7c576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611b7390613893565b60405180910390fd5b5f5f60019054906101000a900460ff161590508015611bc95760015f60016101000a81548160ff02191690831515021790555060015f5f6101000a81548160ff0219169083151502179055505b8015611be9575f5f60016101000a81548160ff0219169083151502179055505b50565b5f60019054906101000a900460ff1680611c1157505f5f9054906101000a900460ff16155b611c50576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611c4790613893565b60405180910390fd5b5f5f60019054906101000a900460ff161590508015611c9d5760015f60016101000a81548160ff02191690831515021790555060015f5f6101000a81548160ff0219169083151502179055505b5f611ca6611614565b90508060335f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550426034819055508073ffffffffffffffffffffffffffffffffffffffff165f73ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a3508015611d6a575f5f60016101000a81548160ff0219169083151502179055505b50565b5f5f85604051602001611d809190613d12565b6040516020818303038152906040528051906020012090506001818686866040515f8152602001604052604051611dba9493929190613d46565b6020604051602081039080840390855afa158015611dda573d5f5f3e3d5ffd5b5050506020604051035173ffffffffffffffffffffffffffffffffffffffff168773ffffffffffffffffffffffffffffffffffffffff161491505095945050505050565b5f611e7f826040518060400160405280602081526020017f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c65648152508573ffffffffffffffffffffffffffffffffffffffff16611ee39092919063ffffffff16565b90505f81511115611ede5780806020019051810190611e9e9190613db3565b611edd576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611ed490613e4e565b60405180910390fd5b5b505050565b6060611ef184845f85611efa565b90509392505050565b606082471015611f3f576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611f3690613edc565b60405180910390fd5b611f488561200a565b611f87576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611f7e90613f44565b60405180910390fd5b5f5f8673ffffffffffffffffffffffffffffffffffffffff168587604051611faf9190613fb4565b5f6040518083038185875af1925050503d805f8114611fe9576040519150601f19603f3d011682016040523d82523d5f602084013e611fee565b606091505b5091509150611ffe82828661201b565b92505050949350505050565b5f5f823b90505f8111915050919050565b6060831561202b5782905061207a565b5f8351111561203d5782518084602001fd5b816040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401612071919061400c565b60405180910390fd5b9392505050565b6040518060a0016040528060608152602001606081526020015f81526020015f81526020015f73ffffffffffffffffffffffffffffffffffffffff1681525090565b5f604051905090565b5f5ffd5b5f5ffd5b5f73ffffffffffffffffffffffffffffffffffffffff82169050919050565b5f6120fd826120d4565b9050919050565b61210d816120f3565b8114612117575f5ffd5b50565b5f8135905061212881612104565b92915050565b5f60208284031215612143576121426120cc565b5b5f6121508482850161211a565b91505092915050565b5f819050919050565b61216b81612159565b82525050565b5f6020820190506121845f830184612162565b92915050565b5f8115159050919050565b61219e8161218a565b82525050565b5f6020820190506121b75f830184612195565b92915050565b5f819050919050565b6121cf816121bd565b82525050565b5f6020820190506121e85f8301846121c6565b92915050565b6121f7816121bd565b8114612201575f5ffd5b50565b5f81359050612212816121ee565b92915050565b5f6020828403121561222d5761222c6120cc565b5b5f61223a84828501612204565b91505092915050565b5f5ffd5b5f601f19601f8301169050919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52604160045260245ffd5b61228d82612247565b810181811067ffffffffffffffff821117156122ac576122ab612257565b5b80604052505050565b5f6122be6120c3565b90506122ca8282612284565b919050565b5f5ffd5b5f5ffd5b5f67ffffffffffffffff8211156122f1576122f0612257565b5b602082029050602081019050919050565b5f5ffd5b5f612318612313846122d7565b6122b5565b9050808382526020820190506020840283018581111561233b5761233a612302565b5b835b818110156123645780612350888261211a565b84526020840193505060208101905061233d565b5050509392505050565b5f82601f830112612382576123816122d3565b5b8135612392848260208601612306565b91505092915050565b5f67ffffffffffffffff8211156123b5576123b4612257565b5b602082029050602081019050919050565b6123cf81612159565b81146123d9575f5ffd5b50565b5f813590506123ea816123c6565b92915050565b5f6124026123fd8461239b565b6122b5565b9050808382526020820190506020840283018581111561242557612424612302565b5b835b8181101561244e578061243a88826123dc565b845260208401935050602081019050612427565b5050509392505050565b5f82601f83011261246c5761246b6122d3565b5b813561247c8482602086016123f0565b91505092915050565b5f60a0828403121561249a57612499612243565b5b6124a460a06122b5565b90505f82013567ffffffffffffffff8111156124c3576124c26122cf565b5b6124cf8482850161236e565b5f83015250602082013567ffffffffffffffff8111156124f2576124f16122cf565b5b6124fe84828501612458565b6020830152506040612512848285016123dc565b6040830152506060612526848285016123dc565b606083015250608061253a8482850161211a565b60808301525092915050565b5f67ffffffffffffffff8211156125605761255f612257565b5b602082029050602081019050919050565b5f60ff82169050919050565b61258681612571565b8114612590575f5ffd5b50565b5f813590506125a18161257d565b92915050565b5f6125b96125b484612546565b6122b5565b905080838252602082019050602084028301858111156125dc576125db612302565b5b835b8181101561260557806125f18882612593565b8452602084019350506020810190506125de565b5050509392505050565b5f82601f830112612623576126226122d3565b5b81356126338482602086016125a7565b91505092915050565b5f67ffffffffffffffff82111561265657612655612257565b5b602082029050602081019050919050565b5f6126796126748461263c565b6122b5565b9050808382526020820190506020840283018581111561269c5761269b612302565b5b835b818110156126c557806126b18882612204565b84526020840193505060208101905061269e565b5050509392505050565b5f82601f8301126126e3576126e26122d3565b5b81356126f3848260208601612667565b91505092915050565b5f5f5f5f5f5f5f5f5f5f6101408b8d03121561271b5761271a6120cc565b5b5f8b013567ffffffffffffffff811115612738576127376120d0565b5b6127448d828e01612485565b9a505060208b013567ffffffffffffffff811115612765576127646120d0565b5b6127718d828e0161260f565b99505060408b013567ffffffffffffffff811115612792576127916120d0565b5b61279e8d828e016126cf565b98505060608b013567ffffffffffffffff8111156127bf576127be6120d0565b5b6127cb8d828e016126cf565b97505060808b013567ffffffffffffffff8111156127ec576127eb6120d0565b5b6127f88d828e01612458565b96505060a08b013567ffffffffffffffff811115612819576128186120d0565b5b6128258d828e0161236e565b95505060c08b013567ffffffffffffffff811115612846576128456120d0565b5b6128528d828e01612458565b94505060e06128638d828e016123dc565b9350506101006128758d828e0161211a565b9250506101206128878d828e016123dc565b9150509295989b9194979a5092959850565b6128a2816120f3565b82525050565b5f6020820190506128bb5f830184612899565b92915050565b5f5ffd5b5f60a082840312156128da576128d96128c1565b5b81905092915050565b5f5ffd5b5f5f83601f8401126128fc576128fb6122d3565b5b8235905067ffffffffffffffff811115612919576129186128e3565b5b60208301915083602082028301111561293557612934612302565b5b9250929050565b5f5f83601f840112612951576129506122d3565b5b8235905067ffffffffffffffff81111561296e5761296d6128e3565b5b60208301915083602082028301111561298a57612989612302565b5b9250929050565b5f5f5f5f5f5f5f5f60a0898b0312156129ad576129ac6120cc565b5b5f89013567ffffffffffffffff8111156129ca576129c96120d0565b5b6129d68b828c016128c5565b985050602089013567ffffffffffffffff8111156129f7576129f66120d0565b5b612a038b828c016128c5565b975050604089013567ffffffffffffffff811115612a2457612a236120d0565b5b612a308b828c016128e7565b9650965050606089013567ffffffffffffffff811115612a5357612a526120d0565b5b612a5f8b828c0161293c565b9450945050608089013567ffffffffffffffff811115612a8257612a816120d0565b5b612a8e8b828c0161293c565b92509250509295985092959890939650565b5f5f83601f840112612ab557612ab46122d3565b5b8235905067ffffffffffffffff811115612ad257612ad16128e3565b5b602083019150836001820283011115612aee57612aed612302565b5b9250929050565b5f5f5f5f5f60808688031215612b0e57612b0d6120cc565b5b5f612b1b8882890161211a565b9550506020612b2c88828901612204565b9450506040612b3d888289016123dc565b935050606086013567ffffffffffffffff811115612b5e57612b5d6120d0565b5b612b6a88828901612aa0565b92509250509295509295909350565b5f5f83601f840112612b8e57612b8d6122d3565b5b8235905067ffffffffffffffff811115612bab57612baa6128e3565b5b602083019150836020820283011115612bc757612bc6612302565b5b9250929050565b5f5f83601f840112612be357612be26122d3565b5b8235905067ffffffffffffffff811115612c0057612bff6128e3565b5b602083019150836020820283011115612c1c57612c1b612302565b5b9250929050565b5f5f5f5f5f5f60808789031215612c3d57612c3c6120cc565b5b5f612c4a89828a01612204565b9650506020612c5b89828a016123dc565b955050604087013567ffffffffffffffff811115612c7c57612c7b6120d0565b5b612c8889828a01612b79565b9450945050606087013567ffffffffffffffff811115612cab57612caa6120d0565b5b612cb789828a01612bce565b92509250509295509295509295565b5f5f5f5f5f5f5f6080888a031215612ce157612ce06120cc565b5b5f88013567ffffffffffffffff811115612cfe57612cfd6120d0565b5b612d0a8a828b01612aa0565b9750975050602088013567ffffffffffffffff811115612d2d57612d2c6120d0565b5b612d398a828b01612aa0565b9550955050604088013567ffffffffffffffff811115612d5c57612d5b6120d0565b5b612d688a828b01612aa0565b93509350506060612d7b8a828b01612593565b91505092959891949750929550565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52601160045260245ffd5b5f612dc182612159565b9150612dcc83612159565b9250828201905080821115612de457612de3612d8a565b5b92915050565b5f82825260208201905092915050565b7f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65725f82015250565b5f612e2e602083612dea565b9150612e3982612dfa565b602082019050919050565b5f6020820190508181035f830152612e5b81612e22565b9050919050565b7f5265656e7472616e637947756172643a207265656e7472616e742063616c6c005f82015250565b5f612e96601f83612dea565b9150612ea182612e62565b602082019050919050565b5f6020820190508181035f830152612ec381612e8a565b9050919050565b7f5061757361626c653a20706175736564000000000000000000000000000000005f82015250565b5f612efe601083612dea565b9150612f0982612eca565b602082019050919050565b5f6020820190508181035f830152612f2b81612ef2565b9050919050565b7f4e6577206261746368206e6f6e6365206d7573742062652067726561746572205f8201527f7468616e207468652063757272656e74206e6f6e636500000000000000000000602082015250565b5f612f8c603683612dea565b9150612f9782612f32565b604082019050919050565b5f6020820190508181035f830152612fb981612f80565b9050919050565b7f42617463682074696d656f7574206d75737420626520677265617465722074685f8201527f616e207468652063757272656e7420626c6f636b206865696768740000000000602082015250565b5f61301a603b83612dea565b915061302582612fc0565b604082019050919050565b5f6020820190508181035f8301526130478161300e565b9050919050565b7f4d616c666f726d65642063757272656e742076616c696461746f7220736574005f82015250565b5f613082601f83612dea565b915061308d8261304e565b602082019050919050565b5f6020820190508181035f8301526130af81613076565b9050919050565b7f537570706c6965642063757272656e742076616c696461746f727320616e64205f8201527f706f7765727320646f206e6f74206d6174636820636865636b706f696e742e00602082015250565b5f613110603f83612dea565b915061311b826130b6565b604082019050919050565b5f6020820190508181035f83015261313d81613104565b9050919050565b7f4d616c666f726d6564206261746368206f66207472616e73616374696f6e73005f82015250565b5f613178601f83612dea565b915061318382613144565b602082019050919050565b5f6020820190508181035f8301526131a58161316c565b9050919050565b5f819050919050565b5f819050919050565b5f6131d86131d36131ce846131ac565b6131b5565b612159565b9050919050565b6131e8816131be565b82525050565b5f81519050919050565b5f82825260208201905092915050565b5f819050602082019050919050565b61322081612159565b82525050565b5f6132318383613217565b60208301905092915050565b5f602082019050919050565b5f613253826131ee565b61325d81856131f8565b935061326883613208565b805f5b8381101561329857815161327f8882613226565b975061328a8361323d565b92505060018101905061326b565b5085935050505092915050565b5f81519050919050565b5f82825260208201905092915050565b5f819050602082019050919050565b6132d7816120f3565b82525050565b5f6132e883836132ce565b60208301905092915050565b5f602082019050919050565b5f61330a826132a5565b61331481856132af565b935061331f836132bf565b805f5b8381101561334f57815161333688826132dd565b9750613341836132f4565b925050600181019050613322565b5085935050505092915050565b5f610100820190506133705f83018b6121c6565b61337d602083018a6131df565b818103604083015261338f8189613249565b905081810360608301526133a38188613300565b905081810360808301526133b78187613249565b90506133c660a0830186612162565b6133d360c0830185612899565b6133e060e0830184612162565b9998505050505050505050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52603260045260245ffd5b7f4f776e657273686970206e6f74207965742065787069726564000000000000005f82015250565b5f61344e601983612dea565b91506134598261341a565b602082019050919050565b5f6020820190508181035f83015261347b81613442565b9050919050565b7f4e65772076616c736574206e6f6e6365206d75737420626520677265617465725f8201527f207468616e207468652063757272656e74206e6f6e6365000000000000000000602082015250565b5f6134dc603783612dea565b91506134e782613482565b604082019050919050565b5f6020820190508181035f830152613509816134d0565b9050919050565b5f5ffd5b5f5ffd5b5f5ffd5b5f5f8335600160200384360303811261353857613537613510565b5b80840192508235915067ffffffffffffffff82111561355a57613559613514565b5b60208301925060208202360383131561357657613575613518565b5b509250929050565b5f5f8335600160200384360303811261359a57613599613510565b5b80840192508235915067ffffffffffffffff8211156135bc576135bb613514565b5b6020830192506020820236038313156135d8576135d7613518565b5b509250929050565b7f4d616c666f726d6564206e65772076616c696461746f722073657400000000005f82015250565b5f613614601b83612dea565b915061361f826135e0565b602082019050919050565b5f6020820190508181035f83015261364181613608565b9050919050565b5f6136533683612485565b9050919050565b5f819050919050565b5f613671602084018461211a565b905092915050565b5f602082019050919050565b5f61369083856132af565b935061369b8261365a565b805f5b858110156136d3576136b08284613663565b6136ba88826132dd565b97506136c583613679565b92505060018101905061369e565b5085925050509392505050565b5f5ffd5b82818337505050565b5f6136f883856131f8565b93507f07ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff83111561372b5761372a6136e0565b5b60208302925061373c8385846136e4565b82840190509392505050565b5f60a08201905061375b5f83018a612162565b6137686020830189612162565b6137756040830188612899565b8181036060830152613788818688613685565b9050818103608083015261379d8184866136ed565b905098975050505050505050565b828183375f83830152505050565b5f6137c48385612dea565b93506137d18385846137ab565b6137da83612247565b840190509392505050565b5f6060820190506137f85f830187612162565b6138056020830186612162565b81810360408301526138188184866137b9565b905095945050505050565b7f496e697469616c697a61626c653a20636f6e747261637420697320616c7265615f8201527f647920696e697469616c697a6564000000000000000000000000000000000000602082015250565b5f61387d602e83612dea565b915061388882613823565b604082019050919050565b5f6020820190508181035f8301526138aa81613871565b9050919050565b7f5375626d69747465642076616c696461746f7220736574207369676e617475725f8201527f657320646f206e6f74206861766520656e6f75676820706f7765722e00000000602082015250565b5f61390b603c83612dea565b9150613916826138b1565b604082019050919050565b5f6020820190508181035f830152613938816138ff565b9050919050565b5f819050919050565b5f61396261395d6139588461393f565b6131b5565b612159565b9050919050565b61397281613948565b82525050565b5f60a08201905061398b5f83018a612162565b6139986020830189613969565b6139a56040830188612899565b81810360608301526139b8818688613685565b905081810360808301526139cd8184866136ed565b905098975050505050505050565b7f4f776e61626c653a206e6577206f776e657220697320746865207a65726f20615f8201527f6464726573730000000000000000000000000000000000000000000000000000602082015250565b5f613a35602683612dea565b9150613a40826139db565b604082019050919050565b5f6020820190508181035f830152613a6281613a29565b9050919050565b7f4e6f7420696d706c656d656e74656400000000000000000000000000000000005f82015250565b5f613a9d600f83612dea565b9150613aa882613a69565b602082019050919050565b5f6020820190508181035f830152613aca81613a91565b9050919050565b7f5061757361626c653a206e6f74207061757365640000000000000000000000005f82015250565b5f613b05601483612dea565b9150613b1082613ad1565b602082019050919050565b5f6020820190508181035f830152613b3281613af9565b9050919050565b5f60e082019050613b4c5f83018a6121c6565b613b5960208301896121c6565b613b666040830188612162565b8181036060830152613b788187613300565b90508181036080830152613b8c8186613249565b9050613b9b60a0830185612162565b613ba860c0830184612899565b98975050505050505050565b7f56616c696461746f72207369676e617475726520646f6573206e6f74206d61745f8201527f63682e0000000000000000000000000000000000000000000000000000000000602082015250565b5f613c0e602383612dea565b9150613c1982613bb4565b604082019050919050565b5f6020820190508181035f830152613c3b81613c02565b9050919050565b5f604082019050613c555f830185612899565b613c626020830184612162565b9392505050565b5f606082019050613c7c5f830186612899565b613c896020830185612899565b613c966040830184612162565b949350505050565b5f81905092915050565b7f19457468657265756d205369676e6564204d6573736167653a0a3332000000005f82015250565b5f613cdc601c83613c9e565b9150613ce782613ca8565b601c82019050919050565b5f819050919050565b613d0c613d07826121bd565b613cf2565b82525050565b5f613d1c82613cd0565b9150613d288284613cfb565b60208201915081905092915050565b613d4081612571565b82525050565b5f608082019050613d595f8301876121c6565b613d666020830186613d37565b613d7360408301856121c6565b613d8060608301846121c6565b95945050505050565b613d928161218a565b8114613d9c575f5ffd5b50565b5f81519050613dad81613d89565b92915050565b5f60208284031215613dc857613dc76120cc565b5b5f613dd584828501613d9f565b91505092915050565b7f5361666545524332303a204552433230206f7065726174696f6e20646964206e5f8201527f6f74207375636365656400000000000000000000000000000000000000000000602082015250565b5f613e38602a83612dea565b9150613e4382613dde565b604082019050919050565b5f6020820190508181035f830152613e6581613e2c565b9050919050565b7f416464726573733a20696e73756666696369656e742062616c616e636520666f5f8201527f722063616c6c0000000000000000000000000000000000000000000000000000602082015250565b5f613ec6602683612dea565b9150613ed182613e6c565b604082019050919050565b5f6020820190508181035f830152613ef381613eba565b9050919050565b7f416464726573733a2063616c6c20746f206e6f6e2d636f6e74726163740000005f82015250565b5f613f2e601d83612dea565b9150613f3982613efa565b602082019050919050565b5f6020820190508181035f830152613f5b81613f22565b9050919050565b5f81519050919050565b5f81905092915050565b8281835e5f83830152505050565b5f613f8e82613f62565b613f988185613f6c565b9350613fa8818560208601613f76565b80840191505092915050565b5f613fbf8284613f84565b915081905092915050565b5f81519050919050565b5f613fde82613fca565b613fe88185612dea565b9350613ff8818560208601613f76565b61400181612247565b840191505092915050565b5f6020820190508181035f8301526140248184613fd4565b90509291505056fea26469706673582212207953c2df95c798701d3c0165f2dd43c7ab67e600075e46f0ae8a9091228b208564736f6c634300081d0033",
+	ABI: "[{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_cosmosDenom\",\"type\":\"string\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_name\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_symbol\",\"type\":\"string\"},{\"indexed\":false,\"internalType\":\"uint8\",\"name\":\"_decimals\",\"type\":\"uint8\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"}],\"name\":\"ERC20DeployedEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_invalidationId\",\"type\":\"bytes32\"},{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"_invalidationNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"bytes\",\"name\":\"_returnData\",\"type\":\"bytes\"}],\"name\":\"LogicCallEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"previousOwner\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"OwnershipTransferred\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Paused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_sender\",\"type\":\"address\"},{\"indexed\":true,\"internalType\":\"bytes32\",\"name\":\"_destination\",\"type\":\"bytes32\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"string\",\"name\":\"_data\",\"type\":\"string\"}],\"name\":\"SendToInjectiveEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"_batchNonce\",\"type\":\"uint256\"},{\"indexed\":true,\"internalType\":\"address\",\"name\":\"_token\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"}],\"name\":\"TransactionBatchExecutedEvent\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":false,\"internalType\":\"address\",\"name\":\"account\",\"type\":\"address\"}],\"name\":\"Unpaused\",\"type\":\"event\"},{\"anonymous\":false,\"inputs\":[{\"indexed\":true,\"internalType\":\"uint256\",\"name\":\"_newValsetNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_eventNonce\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"uint256\",\"name\":\"_rewardAmount\",\"type\":\"uint256\"},{\"indexed\":false,\"internalType\":\"address\",\"name\":\"_rewardToken\",\"type\":\"address\"},{\"indexed\":false,\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\"},{\"indexed\":false,\"internalType\":\"uint256[]\",\"name\":\"_powers\",\"type\":\"uint256[]\"}],\"name\":\"ValsetUpdatedEvent\",\"type\":\"event\"},{\"inputs\":[{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"string\",\"name\":\"\",\"type\":\"string\"},{\"internalType\":\"uint8\",\"name\":\"\",\"type\":\"uint8\"}],\"name\":\"deployERC20\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"emergencyPause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"emergencyUnpause\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"getOwnershipExpiryTimestamp\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_peggyId\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_powerThreshold\",\"type\":\"uint256\"},{\"internalType\":\"address[]\",\"name\":\"_validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_powers\",\"type\":\"uint256[]\"}],\"name\":\"initialize\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"isOwnershipExpired\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_erc20Address\",\"type\":\"address\"}],\"name\":\"lastBatchNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"_invalidationId\",\"type\":\"bytes32\"}],\"name\":\"lastLogicCallNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"owner\",\"outputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"paused\",\"outputs\":[{\"internalType\":\"bool\",\"name\":\"\",\"type\":\"bool\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"renounceOwnershipAfterExpiry\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"internalType\":\"bytes32\",\"name\":\"_destination\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"_amount\",\"type\":\"uint256\"},{\"internalType\":\"string\",\"name\":\"_data\",\"type\":\"string\"}],\"name\":\"sendToInjective\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"name\":\"state_invalidationMapping\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"\",\"type\":\"address\"}],\"name\":\"state_lastBatchNonces\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastEventNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastValsetCheckpoint\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_lastValsetNonce\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_peggyId\",\"outputs\":[{\"internalType\":\"bytes32\",\"name\":\"\",\"type\":\"bytes32\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[],\"name\":\"state_powerThreshold\",\"outputs\":[{\"internalType\":\"uint256\",\"name\":\"\",\"type\":\"uint256\"}],\"stateMutability\":\"view\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"internalType\":\"uint8[]\",\"name\":\"_v\",\"type\":\"uint8[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_r\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_s\",\"type\":\"bytes32[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_amounts\",\"type\":\"uint256[]\"},{\"internalType\":\"address[]\",\"name\":\"_destinations\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"_fees\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"_batchNonce\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"_tokenContract\",\"type\":\"address\"},{\"internalType\":\"uint256\",\"name\":\"_batchTimeout\",\"type\":\"uint256\"}],\"name\":\"submitBatch\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"internalType\":\"uint8[]\",\"name\":\"_v\",\"type\":\"uint8[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_r\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_s\",\"type\":\"bytes32[]\"},{\"components\":[{\"internalType\":\"address\",\"name\":\"logicContractAddress\",\"type\":\"address\"},{\"internalType\":\"bytes\",\"name\":\"payload\",\"type\":\"bytes\"},{\"internalType\":\"uint256\",\"name\":\"timeOut\",\"type\":\"uint256\"},{\"internalType\":\"bytes32\",\"name\":\"invalidationId\",\"type\":\"bytes32\"},{\"internalType\":\"uint256\",\"name\":\"invalidationNonce\",\"type\":\"uint256\"}],\"internalType\":\"structLogicCallArgs\",\"name\":\"_args\",\"type\":\"tuple\"}],\"name\":\"submitLogicCall\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"internalType\":\"address\",\"name\":\"newOwner\",\"type\":\"address\"}],\"name\":\"transferOwnership\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"},{\"inputs\":[{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_newValset\",\"type\":\"tuple\"},{\"components\":[{\"internalType\":\"address[]\",\"name\":\"validators\",\"type\":\"address[]\"},{\"internalType\":\"uint256[]\",\"name\":\"powers\",\"type\":\"uint256[]\"},{\"internalType\":\"uint256\",\"name\":\"valsetNonce\",\"type\":\"uint256\"},{\"internalType\":\"uint256\",\"name\":\"rewardAmount\",\"type\":\"uint256\"},{\"internalType\":\"address\",\"name\":\"rewardToken\",\"type\":\"address\"}],\"internalType\":\"structValsetArgs\",\"name\":\"_currentValset\",\"type\":\"tuple\"},{\"internalType\":\"uint8[]\",\"name\":\"_v\",\"type\":\"uint8[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_r\",\"type\":\"bytes32[]\"},{\"internalType\":\"bytes32[]\",\"name\":\"_s\",\"type\":\"bytes32[]\"}],\"name\":\"updateValset\",\"outputs\":[],\"stateMutability\":\"nonpayable\",\"type\":\"function\"}]",
+	Bin: "0x60806040525f606b555f606c553480156016575f5ffd5b505f60665f6101000a81548160ff021916908315150217905550600160678190555061487f806100455f395ff3fe608060405234801561000f575f5ffd5b5060043610610156575f3560e01c80638da5cb5b116100c1578063c9d194d51161007a578063c9d194d514610340578063df97174b14610370578063e5a2b5d2146103a0578063f2b53307146103be578063f2fde38b146103dc578063f7955637146103f857610156565b80638da5cb5b14610294578063a5352f5b146102b2578063ad05ee99146102ce578063b24614f2146102ea578063b56561fe14610306578063c359a2121461032457610156565b806369dd39081161011357806369dd3908146101f8578063715018a61461021657806373b20547146102205780637dfb6f861461023e578063817474181461026e5780638c64865f1461028a57610156565b8063011b21741461015a5780631ee7a1081461018a5780634a4e3bd5146101a857806351858e27146101b25780635afe97bb146101bc5780635c975abb146101da575b5f5ffd5b610174600480360381019061016f91906124f6565b610414565b6040516101819190612539565b60405180910390f35b61019261045a565b60405161019f9190612539565b60405180910390f35b6101b0610472565b005b6101ba6104f8565b005b6101c461057e565b6040516101d1919061256c565b60405180910390f35b6101e261058e565b6040516101ef919061256c565b60405180910390f35b6102006105a3565b60405161020d919061259d565b60405180910390f35b61021e6105a9565b005b61022861062f565b6040516102359190612539565b60405180910390f35b610258600480360381019061025391906125e0565b610635565b6040516102659190612539565b60405180910390f35b61028860048036038101906102839190612ac4565b61064a565b005b610292610abe565b005b61029c610b0f565b6040516102a99190612c70565b60405180910390f35b6102cc60048036038101906102c79190612d59565b610b37565b005b6102e860048036038101906102e39190612fbd565b61100b565b005b61030460048036038101906102ff9190613115565b61130d565b005b61030e611461565b60405161031b9190612539565b60405180910390f35b61033e60048036038101906103399190613243565b611467565b005b61035a600480360381019061035591906125e0565b611774565b6040516103679190612539565b60405180910390f35b61038a600480360381019061038591906124f6565b61178e565b6040516103979190612539565b60405180910390f35b6103a86117a3565b6040516103b59190612539565b60405180910390f35b6103c66117a9565b6040516103d3919061259d565b60405180910390f35b6103f660048036038101906103f191906124f6565b6117af565b005b610412600480360381019061040d91906132e6565b611957565b005b5f60695f8373ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20549050919050565b5f6302f4bd0060345461046d91906133d7565b905090565b61047a611992565b73ffffffffffffffffffffffffffffffffffffffff16610498610b0f565b73ffffffffffffffffffffffffffffffffffffffff16146104ee576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016104e590613464565b60405180910390fd5b6104f6611999565b565b610500611992565b73ffffffffffffffffffffffffffffffffffffffff1661051e610b0f565b73ffffffffffffffffffffffffffffffffffffffff1614610574576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161056b90613464565b60405180910390fd5b61057c611a39565b565b5f61058761045a565b4211905090565b5f60665f9054906101000a900460ff16905090565b606d5481565b6105b1611992565b73ffffffffffffffffffffffffffffffffffffffff166105cf610b0f565b73ffffffffffffffffffffffffffffffffffffffff1614610625576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161061c90613464565b60405180910390fd5b61062d611adb565b565b606c5481565b606a602052805f5260405f205f915090505481565b60026067540361068f576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610686906134cc565b60405180910390fd5b600260678190555061069f61058e565b156106df576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016106d690613534565b60405180910390fd5b8260695f8473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20541061075e576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610755906135c2565b60405180910390fd5b8043106107a0576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161079790613650565b60405180910390fd5b8960200151518a5f0151511480156107bc575088518a5f015151145b80156107cc575087518a5f015151145b80156107dc575086518a5f015151145b61081b576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610812906136b8565b60405180910390fd5b60685461082a8b606d54611b98565b1461086a576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161086190613746565b60405180910390fd5b8451865114801561087c575083518651145b6108bb576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016108b2906137ae565b60405180910390fd5b6109298a5f01518b602001518b8b8b606d547f7472616e73616374696f6e4261746368000000000000000000000000000000008d8d8d8d8d8d60405160200161090b98979695949392919061397c565b60405160208183030381529060405280519060200120606e54611c13565b8260695f8473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff1681526020019081526020015f20819055505f5f5f90505b8751811015610a0e576109d98782815181106109905761098f613a0d565b5b60200260200101518983815181106109ab576109aa613a0d565b5b60200260200101518673ffffffffffffffffffffffffffffffffffffffff16611d879092919063ffffffff16565b8581815181106109ec576109eb613a0d565b5b6020026020010151826109ff91906133d7565b91508080600101915050610971565b505f811115610a4357610a4233828573ffffffffffffffffffffffffffffffffffffffff16611d879092919063ffffffff16565b5b506001606c54610a5391906133d7565b606c819055508173ffffffffffffffffffffffffffffffffffffffff16837f02c7e81975f8edb86e2a0c038b7b86a49c744236abf0f6177ff5afc6986ab708606c54604051610aa29190612539565b60405180910390a3600160678190555050505050505050505050565b610ac661057e565b610b05576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610afc90613a84565b60405180910390fd5b610b0d611adb565b565b5f60335f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff16905090565b610b3f61058e565b15610b7f576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610b7690613534565b60405180910390fd5b8660400135886040013511610bc9576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610bc090613b12565b60405180910390fd5b878060200190610bd99190613b3c565b905088805f0190610bea9190613b9e565b905014610c2c576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610c2390613c4a565b60405180910390fd5b868060200190610c3c9190613b3c565b905087805f0190610c4d9190613b9e565b9050148015610c6e57508585905087805f0190610c6a9190613b9e565b9050145b8015610c8c57508383905087805f0190610c889190613b9e565b9050145b8015610caa57508181905087805f0190610ca69190613b9e565b9050145b610ce9576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610ce0906136b8565b60405180910390fd5b606854610d0188610cf990613c68565b606d54611b98565b14610d41576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401610d3890613746565b60405180910390fd5b5f610d5789610d4f90613c68565b606d54611b98565b9050610ec588805f0190610d6b9190613b9e565b808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f82011690508083019250505050505050898060200190610dba9190613b3c565b808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f820116905080830192505050505050508989808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f820116905080830192505050505050508888808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f820116905080830192505050505050508787808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f8201169050808301925050505050505086606e54611c13565b806068819055508860400135606b819055505f73ffffffffffffffffffffffffffffffffffffffff16896080016020810190610f0191906124f6565b73ffffffffffffffffffffffffffffffffffffffff1614158015610f2957505f896060013514155b15610f7057610f6f338a606001358b6080016020810190610f4a91906124f6565b73ffffffffffffffffffffffffffffffffffffffff16611d879092919063ffffffff16565b5b6001606c54610f7f91906133d7565b606c8190555088604001357f76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a606c548b606001358c6080016020810190610fc691906124f6565b8d805f0190610fd59190613b9e565b8f8060200190610fe59190613b3c565b604051610ff89796959493929190613d68565b60405180910390a2505050505050505050565b600260675403611050576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611047906134cc565b60405180910390fd5b600260678190555061106061058e565b156110a0576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161109790613534565b60405180910390fd5b806040015143106110e6576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016110dd90613e15565b60405180910390fd5b8060800151606a5f836060015181526020019081526020015f205410611141576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161113890613ea3565b60405180910390fd5b846020015151855f01515114801561115d57508351855f015151145b801561116d57508251855f015151145b801561117d57508151855f015151145b6111bc576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016111b3906136b8565b60405180910390fd5b6068546111cb86606d54611b98565b1461120b576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161120290613746565b60405180910390fd5b61128a855f01518660200151868686606d547f6c6f67696343616c6c0000000000000000000000000000000000000000000000885f015189602001518a604001518b606001518c6080015160405160200161126c9796959493929190613f5a565b60405160208183030381529060405280519060200120606e54611c13565b8060800151606a5f836060015181526020019081526020015f20819055505f6112ba825f01518360200151611e0d565b9050816080015182606001517fa883e07679a07bb797038e569126609a84d689a3e2e132d6ad03ee22d7db6158836040516112f59190613fce565b60405180910390a35060016067819055505050505050565b61131561058e565b15611355576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161134c90613534565b60405180910390fd5b60026067540361139a576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611391906134cc565b60405180910390fd5b60026067819055506113cf3330858873ffffffffffffffffffffffffffffffffffffffff16611e57909392919063ffffffff16565b6001606c546113de91906133d7565b606c81905550833373ffffffffffffffffffffffffffffffffffffffff168673ffffffffffffffffffffffffffffffffffffffff167f21db205faf35053e62272e44562f34122e27a986e21a1030f6bfc21d96eaad0986606c54878760405161144a949392919061401a565b60405180910390a460016067819055505050505050565b606b5481565b5f60019054906101000a900460ff168061148c57505f5f9054906101000a900460ff16155b6114cb576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016114c2906140c8565b60405180910390fd5b5f5f60019054906101000a900460ff1615905080156115185760015f60016101000a81548160ff02191690831515021790555060015f5f6101000a81548160ff0219169083151502179055505b611520611ee0565b611528611fb4565b828290508585905014611570576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611567906136b8565b60405180910390fd5b5f5f90505f5f90505b848490508110156115bf5784848281811061159757611596613a0d565b5b90506020020135826115a991906133d7565b91508782116115bf578080600101915050611579565b50868111611602576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016115f990614156565b60405180910390fd5b61160a612449565b6040518060a001604052808888808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f8201169050808301925050505050505081526020018686808060200260200160405190810160405280939291908181526020018383602002808284375f81840152601f19601f8201169050808301925050505050505081526020015f81526020015f81526020015f73ffffffffffffffffffffffffffffffffffffffff1681525090505f6116d4828b611b98565b905089606d8190555088606e81905550806068819055506001606c546116fa91906133d7565b606c81905550606b547f76d08978c024a4bf8cbb30c67fd78fcaa1827cbc533e4e175f36d07e64ccf96a606c545f5f8c8c8c8c60405161174097969594939291906141ad565b60405180910390a2505050801561176b575f5f60016101000a81548160ff0219169083151502179055505b50505050505050565b5f606a5f8381526020019081526020015f20549050919050565b6069602052805f5260405f205f915090505481565b606e5481565b60685481565b6117b7611992565b73ffffffffffffffffffffffffffffffffffffffff166117d5610b0f565b73ffffffffffffffffffffffffffffffffffffffff161461182b576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161182290613464565b60405180910390fd5b5f73ffffffffffffffffffffffffffffffffffffffff168173ffffffffffffffffffffffffffffffffffffffff1603611899576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161189090614280565b60405180910390fd5b8073ffffffffffffffffffffffffffffffffffffffff1660335f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a38060335f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff16021790555050565b6040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611989906142e8565b60405180910390fd5b5f33905090565b6119a161058e565b6119e0576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016119d790614350565b60405180910390fd5b5f60665f6101000a81548160ff0219169083151502179055507f5db9ee0a495bf2e6ff9c91a7834c1ba4fdd244a5e8aa4e537bd38aeae4b073aa611a22611992565b604051611a2f9190612c70565b60405180910390a1565b611a4161058e565b15611a81576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611a7890613534565b60405180910390fd5b600160665f6101000a81548160ff0219169083151502179055507f62e78cea01bee320cd4e420270b5ea74000d11b0c9f74754ebdbfc544b05a258611ac4611992565b604051611ad19190612c70565b60405180910390a1565b5f73ffffffffffffffffffffffffffffffffffffffff1660335f9054906101000a900473ffffffffffffffffffffffffffffffffffffffff1673ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a35f60335f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550565b5f5f7f636865636b706f696e74000000000000000000000000000000000000000000005f1b90505f83828660400151875f0151886020015189606001518a60800151604051602001611bf0979695949392919061436e565b604051602081830303815290604052805190602001209050809250505092915050565b5f5f90505f5f90505b8851811015611d3a575f878281518110611c3957611c38613a0d565b5b602002602001015160ff1614611d2d57611cbe898281518110611c5f57611c5e613a0d565b5b602002602001015185898481518110611c7b57611c7a613a0d565b5b6020026020010151898581518110611c9657611c95613a0d565b5b6020026020010151898681518110611cb157611cb0613a0d565b5b6020026020010151612135565b611cfd576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611cf490614459565b60405180910390fd5b878181518110611d1057611d0f613a0d565b5b602002602001015182611d2391906133d7565b9150828211611d3a575b8080600101915050611c1c565b50818111611d7d576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611d7490614156565b60405180910390fd5b5050505050505050565b611e088363a9059cbb60e01b8484604051602401611da6929190614477565b604051602081830303815290604052907bffffffffffffffffffffffffffffffffffffffffffffffffffffffff19166020820180517bffffffffffffffffffffffffffffffffffffffffffffffffffffffff83818316178352505050506121e6565b505050565b6060611e4f83836040518060400160405280601e81526020017f416464726573733a206c6f772d6c6576656c2063616c6c206661696c656400008152506122ab565b905092915050565b611eda846323b872dd60e01b858585604051602401611e789392919061449e565b604051602081830303815290604052907bffffffffffffffffffffffffffffffffffffffffffffffffffffffff19166020820180517bffffffffffffffffffffffffffffffffffffffffffffffffffffffff83818316178352505050506121e6565b50505050565b5f60019054906101000a900460ff1680611f0557505f5f9054906101000a900460ff16155b611f44576040517f08c379a0000000000000000000000000000000000000000000000000000000008152600401611f3b906140c8565b60405180910390fd5b5f5f60019054906101000a900460ff161590508015611f915760015f60016101000a81548160ff02191690831515021790555060015f5f6101000a81548160ff0219169083151502179055505b8015611fb1575f5f60016101000a81548160ff0219169083151502179055505b50565b5f60019054906101000a900460ff1680611fd957505f5f9054906101000a900460ff16155b612018576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161200f906140c8565b60405180910390fd5b5f5f60019054906101000a900460ff1615905080156120655760015f60016101000a81548160ff02191690831515021790555060015f5f6101000a81548160ff0219169083151502179055505b5f61206e611992565b90508060335f6101000a81548173ffffffffffffffffffffffffffffffffffffffff021916908373ffffffffffffffffffffffffffffffffffffffff160217905550426034819055508073ffffffffffffffffffffffffffffffffffffffff165f73ffffffffffffffffffffffffffffffffffffffff167f8be0079c531659141344cd1fd0a4f28419497f9722a3daafe3b4186f6b6457e060405160405180910390a3508015612132575f5f60016101000a81548160ff0219169083151502179055505b50565b5f5f856040516020016121489190614547565b6040516020818303038152906040528051906020012090506001818686866040515f8152602001604052604051612182949392919061457b565b6020604051602081039080840390855afa1580156121a2573d5f5f3e3d5ffd5b5050506020604051035173ffffffffffffffffffffffffffffffffffffffff168773ffffffffffffffffffffffffffffffffffffffff161491505095945050505050565b5f612247826040518060400160405280602081526020017f5361666545524332303a206c6f772d6c6576656c2063616c6c206661696c65648152508573ffffffffffffffffffffffffffffffffffffffff166122ab9092919063ffffffff16565b90505f815111156122a6578080602001905181019061226691906145e8565b6122a5576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161229c90614683565b60405180910390fd5b5b505050565b60606122b984845f856122c2565b90509392505050565b606082471015612307576040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016122fe90614711565b60405180910390fd5b612310856123d2565b61234f576040517f08c379a000000000000000000000000000000000000000000000000000000000815260040161234690614779565b60405180910390fd5b5f5f8673ffffffffffffffffffffffffffffffffffffffff16858760405161237791906147d1565b5f6040518083038185875af1925050503d805f81146123b1576040519150601f19603f3d011682016040523d82523d5f602084013e6123b6565b606091505b50915091506123c68282866123e3565b92505050949350505050565b5f5f823b90505f8111915050919050565b606083156123f357829050612442565b5f835111156124055782518084602001fd5b816040517f08c379a00000000000000000000000000000000000000000000000000000000081526004016124399190614829565b60405180910390fd5b9392505050565b6040518060a0016040528060608152602001606081526020015f81526020015f81526020015f73ffffffffffffffffffffffffffffffffffffffff1681525090565b5f604051905090565b5f5ffd5b5f5ffd5b5f73ffffffffffffffffffffffffffffffffffffffff82169050919050565b5f6124c58261249c565b9050919050565b6124d5816124bb565b81146124df575f5ffd5b50565b5f813590506124f0816124cc565b92915050565b5f6020828403121561250b5761250a612494565b5b5f612518848285016124e2565b91505092915050565b5f819050919050565b61253381612521565b82525050565b5f60208201905061254c5f83018461252a565b92915050565b5f8115159050919050565b61256681612552565b82525050565b5f60208201905061257f5f83018461255d565b92915050565b5f819050919050565b61259781612585565b82525050565b5f6020820190506125b05f83018461258e565b92915050565b6125bf81612585565b81146125c9575f5ffd5b50565b5f813590506125da816125b6565b92915050565b5f602082840312156125f5576125f4612494565b5b5f612602848285016125cc565b91505092915050565b5f5ffd5b5f601f19601f8301169050919050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52604160045260245ffd5b6126558261260f565b810181811067ffffffffffffffff821117156126745761267361261f565b5b80604052505050565b5f61268661248b565b9050612692828261264c565b919050565b5f5ffd5b5f5ffd5b5f67ffffffffffffffff8211156126b9576126b861261f565b5b602082029050602081019050919050565b5f5ffd5b5f6126e06126db8461269f565b61267d565b90508083825260208201905060208402830185811115612703576127026126ca565b5b835b8181101561272c578061271888826124e2565b845260208401935050602081019050612705565b5050509392505050565b5f82601f83011261274a5761274961269b565b5b813561275a8482602086016126ce565b91505092915050565b5f67ffffffffffffffff82111561277d5761277c61261f565b5b602082029050602081019050919050565b61279781612521565b81146127a1575f5ffd5b50565b5f813590506127b28161278e565b92915050565b5f6127ca6127c584612763565b61267d565b905080838252602082019050602084028301858111156127ed576127ec6126ca565b5b835b81811015612816578061280288826127a4565b8452602084019350506020810190506127ef565b5050509392505050565b5f82601f8301126128345761283361269b565b5b81356128448482602086016127b8565b91505092915050565b5f60a082840312156128625761286161260b565b5b61286c60a061267d565b90505f82013567ffffffffffffffff81111561288b5761288a612697565b5b61289784828501612736565b5f83015250602082013567ffffffffffffffff8111156128ba576128b9612697565b5b6128c684828501612820565b60208301525060406128da848285016127a4565b60408301525060606128ee848285016127a4565b6060830152506080612902848285016124e2565b60808301525092915050565b5f67ffffffffffffffff8211156129285761292761261f565b5b602082029050602081019050919050565b5f60ff82169050919050565b61294e81612939565b8114612958575f5ffd5b50565b5f8135905061296981612945565b92915050565b5f61298161297c8461290e565b61267d565b905080838252602082019050602084028301858111156129a4576129a36126ca565b5b835b818110156129cd57806129b9888261295b565b8452602084019350506020810190506129a6565b5050509392505050565b5f82601f8301126129eb576129ea61269b565b5b81356129fb84826020860161296f565b91505092915050565b5f67ffffffffffffffff821115612a1e57612a1d61261f565b5b602082029050602081019050919050565b5f612a41612a3c84612a04565b61267d565b90508083825260208201905060208402830185811115612a6457612a636126ca565b5b835b81811015612a8d5780612a7988826125cc565b845260208401935050602081019050612a66565b5050509392505050565b5f82601f830112612aab57612aaa61269b565b5b8135612abb848260208601612a2f565b91505092915050565b5f5f5f5f5f5f5f5f5f5f6101408b8d031215612ae357612ae2612494565b5b5f8b013567ffffffffffffffff811115612b0057612aff612498565b5b612b0c8d828e0161284d565b9a505060208b013567ffffffffffffffff811115612b2d57612b2c612498565b5b612b398d828e016129d7565b99505060408b013567ffffffffffffffff811115612b5a57612b59612498565b5b612b668d828e01612a97565b98505060608b013567ffffffffffffffff811115612b8757612b86612498565b5b612b938d828e01612a97565b97505060808b013567ffffffffffffffff811115612bb457612bb3612498565b5b612bc08d828e01612820565b96505060a08b013567ffffffffffffffff811115612be157612be0612498565b5b612bed8d828e01612736565b95505060c08b013567ffffffffffffffff811115612c0e57612c0d612498565b5b612c1a8d828e01612820565b94505060e0612c2b8d828e016127a4565b935050610100612c3d8d828e016124e2565b925050610120612c4f8d828e016127a4565b9150509295989b9194979a5092959850565b612c6a816124bb565b82525050565b5f602082019050612c835f830184612c61565b92915050565b5f5ffd5b5f60a08284031215612ca257612ca1612c89565b5b81905092915050565b5f5ffd5b5f5f83601f840112612cc457612cc361269b565b5b8235905067ffffffffffffffff811115612ce157612ce0612cab565b5b602083019150836020820283011115612cfd57612cfc6126ca565b5b9250929050565b5f5f83601f840112612d1957612d1861269b565b5b8235905067ffffffffffffffff811115612d3657612d35612cab565b5b602083019150836020820283011115612d5257612d516126ca565b5b9250929050565b5f5f5f5f5f5f5f5f60a0898b031215612d7557612d74612494565b5b5f89013567ffffffffffffffff811115612d9257612d91612498565b5b612d9e8b828c01612c8d565b985050602089013567ffffffffffffffff811115612dbf57612dbe612498565b5b612dcb8b828c01612c8d565b975050604089013567ffffffffffffffff811115612dec57612deb612498565b5b612df88b828c01612caf565b9650965050606089013567ffffffffffffffff811115612e1b57612e1a612498565b5b612e278b828c01612d04565b9450945050608089013567ffffffffffffffff811115612e4a57612e49612498565b5b612e568b828c01612d04565b92509250509295985092959890939650565b5f5ffd5b5f67ffffffffffffffff821115612e8657612e8561261f565b5b612e8f8261260f565b9050602081019050919050565b828183375f83830152505050565b5f612ebc612eb784612e6c565b61267d565b905082815260208101848484011115612ed857612ed7612e68565b5b612ee3848285612e9c565b509392505050565b5f82601f830112612eff57612efe61269b565b5b8135612f0f848260208601612eaa565b91505092915050565b5f60a08284031215612f2d57612f2c61260b565b5b612f3760a061267d565b90505f612f46848285016124e2565b5f83015250602082013567ffffffffffffffff811115612f6957612f68612697565b5b612f7584828501612eeb565b6020830152506040612f89848285016127a4565b6040830152506060612f9d848285016125cc565b6060830152506080612fb1848285016127a4565b60808301525092915050565b5f5f5f5f5f60a08688031215612fd657612fd5612494565b5b5f86013567ffffffffffffffff811115612ff357612ff2612498565b5b612fff8882890161284d565b955050602086013567ffffffffffffffff8111156130205761301f612498565b5b61302c888289016129d7565b945050604086013567ffffffffffffffff81111561304d5761304c612498565b5b61305988828901612a97565b935050606086013567ffffffffffffffff81111561307a57613079612498565b5b61308688828901612a97565b925050608086013567ffffffffffffffff8111156130a7576130a6612498565b5b6130b388828901612f18565b9150509295509295909350565b5f5f83601f8401126130d5576130d461269b565b5b8235905067ffffffffffffffff8111156130f2576130f1612cab565b5b60208301915083600182028301111561310e5761310d6126ca565b5b9250929050565b5f5f5f5f5f6080868803121561312e5761312d612494565b5b5f61313b888289016124e2565b955050602061314c888289016125cc565b945050604061315d888289016127a4565b935050606086013567ffffffffffffffff81111561317e5761317d612498565b5b61318a888289016130c0565b92509250509295509295909350565b5f5f83601f8401126131ae576131ad61269b565b5b8235905067ffffffffffffffff8111156131cb576131ca612cab565b5b6020830191508360208202830111156131e7576131e66126ca565b5b9250929050565b5f5f83601f8401126132035761320261269b565b5b8235905067ffffffffffffffff8111156132205761321f612cab565b5b60208301915083602082028301111561323c5761323b6126ca565b5b9250929050565b5f5f5f5f5f5f6080878903121561325d5761325c612494565b5b5f61326a89828a016125cc565b965050602061327b89828a016127a4565b955050604087013567ffffffffffffffff81111561329c5761329b612498565b5b6132a889828a01613199565b9450945050606087013567ffffffffffffffff8111156132cb576132ca612498565b5b6132d789828a016131ee565b92509250509295509295509295565b5f5f5f5f5f5f5f6080888a03121561330157613300612494565b5b5f88013567ffffffffffffffff81111561331e5761331d612498565b5b61332a8a828b016130c0565b9750975050602088013567ffffffffffffffff81111561334d5761334c612498565b5b6133598a828b016130c0565b9550955050604088013567ffffffffffffffff81111561337c5761337b612498565b5b6133888a828b016130c0565b9350935050606061339b8a828b0161295b565b91505092959891949750929550565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52601160045260245ffd5b5f6133e182612521565b91506133ec83612521565b9250828201905080821115613404576134036133aa565b5b92915050565b5f82825260208201905092915050565b7f4f776e61626c653a2063616c6c6572206973206e6f7420746865206f776e65725f82015250565b5f61344e60208361340a565b91506134598261341a565b602082019050919050565b5f6020820190508181035f83015261347b81613442565b9050919050565b7f5265656e7472616e637947756172643a207265656e7472616e742063616c6c005f82015250565b5f6134b6601f8361340a565b91506134c182613482565b602082019050919050565b5f6020820190508181035f8301526134e3816134aa565b9050919050565b7f5061757361626c653a20706175736564000000000000000000000000000000005f82015250565b5f61351e60108361340a565b9150613529826134ea565b602082019050919050565b5f6020820190508181035f83015261354b81613512565b9050919050565b7f4e6577206261746368206e6f6e6365206d7573742062652067726561746572205f8201527f7468616e207468652063757272656e74206e6f6e636500000000000000000000602082015250565b5f6135ac60368361340a565b91506135b782613552565b604082019050919050565b5f6020820190508181035f8301526135d9816135a0565b9050919050565b7f42617463682074696d656f7574206d75737420626520677265617465722074685f8201527f616e207468652063757272656e7420626c6f636b206865696768740000000000602082015250565b5f61363a603b8361340a565b9150613645826135e0565b604082019050919050565b5f6020820190508181035f8301526136678161362e565b9050919050565b7f4d616c666f726d65642063757272656e742076616c696461746f7220736574005f82015250565b5f6136a2601f8361340a565b91506136ad8261366e565b602082019050919050565b5f6020820190508181035f8301526136cf81613696565b9050919050565b7f537570706c6965642063757272656e742076616c696461746f727320616e64205f8201527f706f7765727320646f206e6f74206d6174636820636865636b706f696e742e00602082015250565b5f613730603f8361340a565b915061373b826136d6565b604082019050919050565b5f6020820190508181035f83015261375d81613724565b9050919050565b7f4d616c666f726d6564206261746368206f66207472616e73616374696f6e73005f82015250565b5f613798601f8361340a565b91506137a382613764565b602082019050919050565b5f6020820190508181035f8301526137c58161378c565b9050919050565b5f819050919050565b5f819050919050565b5f6137f86137f36137ee846137cc565b6137d5565b612521565b9050919050565b613808816137de565b82525050565b5f81519050919050565b5f82825260208201905092915050565b5f819050602082019050919050565b61384081612521565b82525050565b5f6138518383613837565b60208301905092915050565b5f602082019050919050565b5f6138738261380e565b61387d8185613818565b935061388883613828565b805f5b838110156138b857815161389f8882613846565b97506138aa8361385d565b92505060018101905061388b565b5085935050505092915050565b5f81519050919050565b5f82825260208201905092915050565b5f819050602082019050919050565b6138f7816124bb565b82525050565b5f61390883836138ee565b60208301905092915050565b5f602082019050919050565b5f61392a826138c5565b61393481856138cf565b935061393f836138df565b805f5b8381101561396f57815161395688826138fd565b975061396183613914565b925050600181019050613942565b5085935050505092915050565b5f610100820190506139905f83018b61258e565b61399d602083018a6137ff565b81810360408301526139af8189613869565b905081810360608301526139c38188613920565b905081810360808301526139d78187613869565b90506139e660a083018661252a565b6139f360c0830185612c61565b613a0060e083018461252a565b9998505050505050505050565b7f4e487b71000000000000000000000000000000000000000000000000000000005f52603260045260245ffd5b7f4f776e657273686970206e6f74207965742065787069726564000000000000005f82015250565b5f613a6e60198361340a565b9150613a7982613a3a565b602082019050919050565b5f6020820190508181035f830152613a9b81613a62565b9050919050565b7f4e65772076616c736574206e6f6e6365206d75737420626520677265617465725f8201527f207468616e207468652063757272656e74206e6f6e6365000000000000000000602082015250565b5f613afc60378361340a565b9150613b0782613aa2565b604082019050919050565b5f6020820190508181035f830152613b2981613af0565b9050919050565b5f5ffd5b5f5ffd5b5f5ffd5b5f5f83356001602003843603038112613b5857613b57613b30565b5b80840192508235915067ffffffffffffffff821115613b7a57613b79613b34565b5b602083019250602082023603831315613b9657613b95613b38565b5b509250929050565b5f5f83356001602003843603038112613bba57613bb9613b30565b5b80840192508235915067ffffffffffffffff821115613bdc57613bdb613b34565b5b602083019250602082023603831315613bf857613bf7613b38565b5b509250929050565b7f4d616c666f726d6564206e65772076616c696461746f722073657400000000005f82015250565b5f613c34601b8361340a565b9150613c3f82613c00565b602082019050919050565b5f6020820190508181035f830152613c6181613c28565b9050919050565b5f613c73368361284d565b9050919050565b5f819050919050565b5f613c9160208401846124e2565b905092915050565b5f602082019050919050565b5f613cb083856138cf565b9350613cbb82613c7a565b805f5b85811015613cf357613cd08284613c83565b613cda88826138fd565b9750613ce583613c99565b925050600181019050613cbe565b5085925050509392505050565b5f5ffd5b82818337505050565b5f613d188385613818565b93507f07ffffffffffffffffffffffffffffffffffffffffffffffffffffffffffffff831115613d4b57613d4a613d00565b5b602083029250613d5c838584613d04565b82840190509392505050565b5f60a082019050613d7b5f83018a61252a565b613d88602083018961252a565b613d956040830188612c61565b8181036060830152613da8818688613ca5565b90508181036080830152613dbd818486613d0d565b905098975050505050505050565b7f54696d6564206f757400000000000000000000000000000000000000000000005f82015250565b5f613dff60098361340a565b9150613e0a82613dcb565b602082019050919050565b5f6020820190508181035f830152613e2c81613df3565b9050919050565b7f4e657720696e76616c69646174696f6e206e6f6e6365206d75737420626520675f8201527f726561746572207468616e207468652063757272656e74206e6f6e6365000000602082015250565b5f613e8d603d8361340a565b9150613e9882613e33565b604082019050919050565b5f6020820190508181035f830152613eba81613e81565b9050919050565b5f819050919050565b5f613ee4613edf613eda84613ec1565b6137d5565b612521565b9050919050565b613ef481613eca565b82525050565b5f81519050919050565b5f82825260208201905092915050565b8281835e5f83830152505050565b5f613f2c82613efa565b613f368185613f04565b9350613f46818560208601613f14565b613f4f8161260f565b840191505092915050565b5f60e082019050613f6d5f83018a61258e565b613f7a6020830189613eeb565b613f876040830188612c61565b8181036060830152613f998187613f22565b9050613fa8608083018661252a565b613fb560a083018561258e565b613fc260c083018461252a565b98975050505050505050565b5f6020820190508181035f830152613fe68184613f22565b905092915050565b5f613ff9838561340a565b9350614006838584612e9c565b61400f8361260f565b840190509392505050565b5f60608201905061402d5f83018761252a565b61403a602083018661252a565b818103604083015261404d818486613fee565b905095945050505050565b7f496e697469616c697a61626c653a20636f6e747261637420697320616c7265615f8201527f647920696e697469616c697a6564000000000000000000000000000000000000602082015250565b5f6140b2602e8361340a565b91506140bd82614058565b604082019050919050565b5f6020820190508181035f8301526140df816140a6565b9050919050565b7f5375626d69747465642076616c696461746f7220736574207369676e617475725f8201527f657320646f206e6f74206861766520656e6f75676820706f7765722e00000000602082015250565b5f614140603c8361340a565b915061414b826140e6565b604082019050919050565b5f6020820190508181035f83015261416d81614134565b9050919050565b5f819050919050565b5f61419761419261418d84614174565b6137d5565b612521565b9050919050565b6141a78161417d565b82525050565b5f60a0820190506141c05f83018a61252a565b6141cd602083018961419e565b6141da6040830188612c61565b81810360608301526141ed818688613ca5565b90508181036080830152614202818486613d0d565b905098975050505050505050565b7f4f776e61626c653a206e6577206f776e657220697320746865207a65726f20615f8201527f6464726573730000000000000000000000000000000000000000000000000000602082015250565b5f61426a60268361340a565b915061427582614210565b604082019050919050565b5f6020820190508181035f8301526142978161425e565b9050919050565b7f4e6f7420696d706c656d656e74656400000000000000000000000000000000005f82015250565b5f6142d2600f8361340a565b91506142dd8261429e565b602082019050919050565b5f6020820190508181035f8301526142ff816142c6565b9050919050565b7f5061757361626c653a206e6f74207061757365640000000000000000000000005f82015250565b5f61433a60148361340a565b915061434582614306565b602082019050919050565b5f6020820190508181035f8301526143678161432e565b9050919050565b5f60e0820190506143815f83018a61258e565b61438e602083018961258e565b61439b604083018861252a565b81810360608301526143ad8187613920565b905081810360808301526143c18186613869565b90506143d060a083018561252a565b6143dd60c0830184612c61565b98975050505050505050565b7f56616c696461746f72207369676e617475726520646f6573206e6f74206d61745f8201527f63682e0000000000000000000000000000000000000000000000000000000000602082015250565b5f61444360238361340a565b915061444e826143e9565b604082019050919050565b5f6020820190508181035f83015261447081614437565b9050919050565b5f60408201905061448a5f830185612c61565b614497602083018461252a565b9392505050565b5f6060820190506144b15f830186612c61565b6144be6020830185612c61565b6144cb604083018461252a565b949350505050565b5f81905092915050565b7f19457468657265756d205369676e6564204d6573736167653a0a3332000000005f82015250565b5f614511601c836144d3565b915061451c826144dd565b601c82019050919050565b5f819050919050565b61454161453c82612585565b614527565b82525050565b5f61455182614505565b915061455d8284614530565b60208201915081905092915050565b61457581612939565b82525050565b5f60808201905061458e5f83018761258e565b61459b602083018661456c565b6145a8604083018561258e565b6145b5606083018461258e565b95945050505050565b6145c781612552565b81146145d1575f5ffd5b50565b5f815190506145e2816145be565b92915050565b5f602082840312156145fd576145fc612494565b5b5f61460a848285016145d4565b91505092915050565b7f5361666545524332303a204552433230206f7065726174696f6e20646964206e5f8201527f6f74207375636365656400000000000000000000000000000000000000000000602082015250565b5f61466d602a8361340a565b915061467882614613565b604082019050919050565b5f6020820190508181035f83015261469a81614661565b9050919050565b7f416464726573733a20696e73756666696369656e742062616c616e636520666f5f8201527f722063616c6c0000000000000000000000000000000000000000000000000000602082015250565b5f6146fb60268361340a565b9150614706826146a1565b604082019050919050565b5f6020820190508181035f830152614728816146ef565b9050919050565b7f416464726573733a2063616c6c20746f206e6f6e2d636f6e74726163740000005f82015250565b5f614763601d8361340a565b915061476e8261472f565b602082019050919050565b5f6020820190508181035f83015261479081614757565b9050919050565b5f81905092915050565b5f6147ab82613efa565b6147b58185614797565b93506147c5818560208601613f14565b80840191505092915050565b5f6147dc82846147a1565b915081905092915050565b5f81519050919050565b5f6147fb826147e7565b614805818561340a565b9350614815818560208601613f14565b61481e8161260f565b840191505092915050565b5f6020820190508181035f83015261484181846147f1565b90509291505056fea26469706673582212202f63e1b1a77ff01077df3897b10869a062682b0abd6937aa9cf0904af848b6fe64736f6c634300081e0033",
 }
 
 // PeggyABI is the input ABI used to generate the binding from.
@@ -304,6 +313,37 @@ func (_Peggy *PeggyCallerSession) LastBatchNonce(_erc20Address common.Address) (
 	return _Peggy.Contract.LastBatchNonce(&_Peggy.CallOpts, _erc20Address)
 }
 
+// LastLogicCallNonce is a free data retrieval call binding the contract method 0xc9d194d5.
+//
+// Solidity: function lastLogicCallNonce(bytes32 _invalidationId) view returns(uint256)
+func (_Peggy *PeggyCaller) LastLogicCallNonce(opts *bind.CallOpts, _invalidationId [32]byte) (*big.Int, error) {
+	var out []interface{}
+	err := _Peggy.contract.Call(opts, &out, "lastLogicCallNonce", _invalidationId)
+
+	if err != nil {
+		return *new(*big.Int), err
+	}
+
+	out0 := *abi.ConvertType(out[0], new(*big.Int)).(**big.Int)
+
+	return out0, err
+
+}
+
+// LastLogicCallNonce is a free data retrieval call binding the contract method 0xc9d194d5.
+//
+// Solidity: function lastLogicCallNonce(bytes32 _invalidationId) view returns(uint256)
+func (_Peggy *PeggySession) LastLogicCallNonce(_invalidationId [32]byte) (*big.Int, error) {
+	return _Peggy.Contract.LastLogicCallNonce(&_Peggy.CallOpts, _invalidationId)
+}
+
+// LastLogicCallNonce is a free data retrieval call binding the contract method 0xc9d194d5.
+//
+// Solidity: function lastLogicCallNonce(bytes32 _invalidationId) view returns(uint256)
+func (_Peggy *PeggyCallerSession) LastLogicCallNonce(_invalidationId [32]byte) (*big.Int, error) {
+	return _Peggy.Contract.LastLogicCallNonce(&_Peggy.CallOpts, _invalidationId)
+}
+
 // Owner is a free data retrieval call binding the contract method 0x8da5cb5b.
 //
 // Solidity: function owner() view returns(address)
@@ -751,6 +791,27 @@ func (_Peggy *PeggyTransactorSession) SubmitBatch(_currentValset ValsetArgs, _v
 	return _Peggy.Contract.SubmitBatch(&_Peggy.TransactOpts, _currentValset, _v, _r, _s, _amounts, _destinations, _fees, _batchNonce, _tokenContract, _batchTimeout)
 }
 
+// SubmitLogicCall is a paid mutator transaction binding the contract method 0xad05ee99.
+//
+// Solidity: function submitLogicCall((address[],uint256[],uint256,uint256,address) _currentValset, uint8[] _v, bytes32[] _r, bytes32[] _s, (address,bytes,uint256,bytes32,uint256) _args) returns()
+func (_Peggy *PeggyTransactor) SubmitLogicCall(opts *bind.TransactOpts, _currentValset ValsetArgs, _v []uint8, _r [][32]byte, _s [][32]byte, _args LogicCallArgs) (*types.Transaction, error) {
+	return _Peggy.contract.Transact(opts, "submitLogicCall", _currentValset, _v, _r, _s, _args)
+}
+
+// SubmitLogicCall is a paid mutator transaction binding the contract method 0xad05ee99.
+//
+// Solidity: function submitLogicCall((address[],uint256[],uint256,uint256,address) _currentValset, uint8[] _v, bytes32[] _r, bytes32[] _s, (address,bytes,uint256,bytes32,uint256) _args) returns()
+func (_Peggy *PeggySession) SubmitLogicCall(_currentValset ValsetArgs, _v []uint8, _r [][32]byte, _s [][32]byte, _args LogicCallArgs) (*types.Transaction, error) {
+	return _Peggy.Contract.SubmitLogicCall(&_Peggy.TransactOpts, _currentValset, _v, _r, _s, _args)
+}
+
+// SubmitLogicCall is a paid mutator transaction binding the contract method 0xad05ee99.
+//
+// Solidity: function submitLogicCall((address[],uint256[],uint256,uint256,address) _currentValset, uint8[] _v, bytes32[] _r, bytes32[] _s, (address,bytes,uint256,bytes32,uint256) _args) returns()
+func (_Peggy *PeggyTransactorSession) SubmitLogicCall(_currentValset ValsetArgs, _v []uint8, _r [][32]byte, _s [][32]byte, _args LogicCallArgs) (*types.Transaction, error) {
+	return _Peggy.Contract.SubmitLogicCall(&_Peggy.TransactOpts, _currentValset, _v, _r, _s, _args)
+}
+
 // TransferOwnership is a paid mutator transaction binding the contract method 0xf2fde38b.
 //
 // Solidity: function transferOwnership(address newOwner) returns()
@@ -942,6 +1003,160 @@ func (_Peggy *PeggyFilterer) ParseERC20DeployedEvent(log types.Log) (*PeggyERC20
 	return event, nil
 }
 
+// PeggyLogicCallEventIterator is returned from FilterLogicCallEvent and is used to iterate over the raw logs and unpacked data for LogicCallEvent events raised by the Peggy contract.
+type PeggyLogicCallEventIterator struct {
+	Event *PeggyLogicCallEvent // Event containing the contract specifics and raw log
+
+	contract *bind.BoundContract // Generic contract to use for unpacking event data
+	event    string              // Event name to use for unpacking event data
+
+	logs chan types.Log        // Log channel receiving the found contract events
+	sub  ethereum.Subscription // Subscription for errors, completion and termination
+	done bool                  // Whether the subscription completed delivering logs
+	fail error                 // Occurred error to stop iteration
+}
+
+// Next advances the iterator to the subsequent event, returning whether there
+// are any more events found. In case of a retrieval or parsing error, false is
+// returned and Error() can be queried for the exact failure.
+func (it *PeggyLogicCallEventIterator) Next() bool {
+	// If the iterator failed, stop iterating
+	if it.fail != nil {
+		return false
+	}
+	// If the iterator completed, deliver directly whatever's available
+	if it.done {
+		select {
+		case log := <-it.logs:
+			it.Event = new(PeggyLogicCallEvent)
+			if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+				it.fail = err
+				return false
+			}
+			it.Event.Raw = log
+			return true
+
+		default:
+			return false
+		}
+	}
+	// Iterator still in progress, wait for either a data or an error event
+	select {
+	case log := <-it.logs:
+		it.Event = new(PeggyLogicCallEvent)
+		if err := it.contract.UnpackLog(it.Event, it.event, log); err != nil {
+			it.fail = err
+			return false
+		}
+		it.Event.Raw = log
+		return true
+
+	case err := <-it.sub.Err():
+		it.done = true
+		it.fail = err
+		return it.Next()
+	}
+}
+
+// Error returns any retrieval or parsing error occurred during filtering.
+func (it *PeggyLogicCallEventIterator) Error() error {
+	return it.fail
+}
+
+// Close terminates the iteration process, releasing any pending underlying
+// resources.
+func (it *PeggyLogicCallEventIterator) Close() error {
+	it.sub.Unsubscribe()
+	return nil
+}
+
+// PeggyLogicCallEvent represents a LogicCallEvent event raised by the Peggy contract.
+type PeggyLogicCallEvent struct {
+	InvalidationId    [32]byte
+	InvalidationNonce *big.Int
+	ReturnData        []byte
+	Raw               types.Log // Blockchain specific contextual infos
+}
+
+// FilterLogicCallEvent is a free log retrieval operation binding the contract event 0xa883e07679a07bb797038e569126609a84d689a3e2e132d6ad03ee22d7db6158.
+//
+// Solidity: event LogicCallEvent(bytes32 indexed _invalidationId, uint256 indexed _invalidationNonce, bytes _returnData)
+func (_Peggy *PeggyFilterer) FilterLogicCallEvent(opts *bind.FilterOpts, _invalidationId [][32]byte, _invalidationNonce []*big.Int) (*PeggyLogicCallEventIterator, error) {
+
+	var _invalidationIdRule []interface{}
+	for _, _invalidationIdItem := range _invalidationId {
+		_invalidationIdRule = append(_invalidationIdRule, _invalidationIdItem)
+	}
+	var _invalidationNonceRule []interface{}
+	for _, _invalidationNonceItem := range _invalidationNonce {
+		_invalidationNonceRule = append(_invalidationNonceRule, _invalidationNonceItem)
+	}
+
+	logs, sub, err := _Peggy.contract.FilterLogs(opts, "LogicCallEvent", _invalidationIdRule, _invalidationNonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return &PeggyLogicCallEventIterator{contract: _Peggy.contract, event: "LogicCallEvent", logs: logs, sub: sub}, nil
+}
+
+// WatchLogicCallEvent is a free log subscription operation binding the contract event 0xa883e07679a07bb797038e569126609a84d689a3e2e132d6ad03ee22d7db6158.
+//
+// Solidity: event LogicCallEvent(bytes32 indexed _invalidationId, uint256 indexed _invalidationNonce, bytes _returnData)
+func (_Peggy *PeggyFilterer) WatchLogicCallEvent(opts *bind.WatchOpts, sink chan<- *PeggyLogicCallEvent, _invalidationId [][32]byte, _invalidationNonce []*big.Int) (event.Subscription, error) {
+
+	var _invalidationIdRule []interface{}
+	for _, _invalidationIdItem := range _invalidationId {
+		_invalidationIdRule = append(_invalidationIdRule, _invalidationIdItem)
+	}
+	var _invalidationNonceRule []interface{}
+	for _, _invalidationNonceItem := range _invalidationNonce {
+		_invalidationNonceRule = append(_invalidationNonceRule, _invalidationNonceItem)
+	}
+
+	logs, sub, err := _Peggy.contract.WatchLogs(opts, "LogicCallEvent", _invalidationIdRule, _invalidationNonceRule)
+	if err != nil {
+		return nil, err
+	}
+	return event.NewSubscription(func(quit <-chan struct{}) error {
+		defer sub.Unsubscribe()
+		for {
+			select {
+			case log := <-logs:
+				// New log arrived, parse the event and forward to the user
+				event := new(PeggyLogicCallEvent)
+				if err := _Peggy.contract.UnpackLog(event, "LogicCallEvent", log); err != nil {
+					return err
+				}
+				event.Raw = log
+
+				select {
+				case sink <- event:
+				case err := <-sub.Err():
+					return err
+				case <-quit:
+					return nil
+				}
+			case err := <-sub.Err():
+				return err
+			case <-quit:
+				return nil
+			}
+		}
+	}), nil
+}
+
+// ParseLogicCallEvent is a log parse operation binding the contract event 0xa883e07679a07bb797038e569126609a84d689a3e2e132d6ad03ee22d7db6158.
+//
+// Solidity: event LogicCallEvent(bytes32 indexed _invalidationId, uint256 indexed _invalidationNonce, bytes _returnData)
+func (_Peggy *PeggyFilterer) ParseLogicCallEvent(log types.Log) (*PeggyLogicCallEvent, error) {
+	event := new(PeggyLogicCallEvent)
+	if err := _Peggy.contract.UnpackLog(event, "LogicCallEvent", log); err != nil {
+		return nil, err
+	}
+	event.Raw = log
+	return event, nil
+}
+
 // PeggyOwnershipTransferredIterator is returned from FilterOwnershipTransferred and is used to iterate over the raw logs and unpacked data for OwnershipTransferred events raised by the Peggy contract.
 type PeggyOwnershipTransferredIterator struct {
 	Event *PeggyOwnershipTransferred // Event containing the contract specifics and raw log